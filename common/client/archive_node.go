@@ -0,0 +1,35 @@
+package client
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// archiveNode wraps a primary Node which retains the full history of the chain. MultiNode only uses it for the
+// queries which full nodes cannot serve, like calls at blocks whose state was pruned and traces, so that the
+// latest-state traffic stays on the full nodes.
+type archiveNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	Node[CHAIN_ID, HEAD, RPC]
+}
+
+// NewArchiveNode marks n as an archive node. n is started and monitored like any other primary node, but it is
+// never selected as the active node, nor used to broadcast transactions.
+func NewArchiveNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](n Node[CHAIN_ID, HEAD, RPC]) Node[CHAIN_ID, HEAD, RPC] {
+	return &archiveNode[CHAIN_ID, HEAD, RPC]{n}
+}
+
+func isArchive[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](n Node[CHAIN_ID, HEAD, RPC]) bool {
+	_, ok := n.(*archiveNode[CHAIN_ID, HEAD, RPC])
+	return ok
+}
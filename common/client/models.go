@@ -18,6 +18,7 @@ const (
 	InsufficientFunds                        // Tx was rejected due to insufficient funds.
 	ExceedsMaxFee                            // Attempt's fee was higher than the node's limit and got rejected.
 	FeeOutOfValidRange                       // This error is returned when we use a fee price suggested from an RPC, but the network rejects the attempt due to an invalid range(mostly used by L2 chains). Retry by requesting a new suggested fee price.
+	TxTypeUnsupported                        // The RPC does not support the type of the transaction, e.g. dynamic fee transactions on chains without EIP-1559. Retry with a legacy transaction.
 )
 
 type NodeTier int
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/big"
 	"sync"
 	"time"
@@ -61,10 +62,18 @@ type MultiNode[
 	Close() error
 	NodeStates() map[string]string
 	SelectNodeRPC() (RPC_CLIENT, error)
+	// SelectArchiveNodeRPC returns an RPC of a live archive node, if the query at blockNumber must be served by one
+	// because blockNumber is at least pruningHorizon blocks behind the highest block of the live nodes. A nil
+	// blockNumber means that the query needs an archive node whatever its block, like a trace. ok is false if the
+	// query can be served by full nodes, or if no archive node is live.
+	SelectArchiveNodeRPC(blockNumber *big.Int, pruningHorizon int64) (rpc RPC_CLIENT, ok bool)
 
 	BatchCallContextAll(ctx context.Context, b []any) error
 	ConfiguredChainID() CHAIN_ID
 	IsL2() bool
+	// UpdateNodes closes and removes the nodes and sendonlys with the given names, then starts and adds the given
+	// nodes and sendonlys.
+	UpdateNodes(ctx context.Context, removed []string, nodes []Node[CHAIN_ID, HEAD, RPC_CLIENT], sendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) error
 }
 
 type multiNode[
@@ -82,19 +91,26 @@ type multiNode[
 	RPC_CLIENT RPC[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD],
 ] struct {
 	services.StateMachine
-	nodes               []Node[CHAIN_ID, HEAD, RPC_CLIENT]
-	sendonlys           []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
 	chainID             CHAIN_ID
 	chainType           config.ChainType
 	lggr                logger.SugaredLogger
 	selectionMode       string
 	noNewHeadsThreshold time.Duration
-	nodeSelector        NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
 	leaseDuration       time.Duration
 	leaseTicker         *time.Ticker
 	chainFamily         string
 	reportInterval      time.Duration
 
+	// updateMu serializes UpdateNodes, and nodesMu guards the nodes and their selectors which it replaces
+	updateMu          sync.Mutex
+	nodesMu           sync.RWMutex
+	nodes             []Node[CHAIN_ID, HEAD, RPC_CLIENT]
+	sendonlys         []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
+	nodeSelector      NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
+	writeNodeSelector NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
+	// archiveNodeSelector selects among the archive nodes, and is nil if there are none
+	archiveNodeSelector NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
+
 	activeMu   sync.RWMutex
 	activeNode Node[CHAIN_ID, HEAD, RPC_CLIENT]
 
@@ -129,26 +145,22 @@ func NewMultiNode[
 	chainFamily string,
 	sendOnlyErrorParser func(err error) SendTxReturnCode,
 ) MultiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT] {
-	nodeSelector := newNodeSelector(selectionMode, nodes)
-
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
 	// aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 	const reportInterval = 6500 * time.Millisecond
 	c := &multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]{
-		nodes:               nodes,
-		sendonlys:           sendonlys,
 		chainID:             chainID,
 		chainType:           chainType,
 		lggr:                logger.Sugared(lggr).Named("MultiNode").With("chainID", chainID.String()),
 		selectionMode:       selectionMode,
 		noNewHeadsThreshold: noNewHeadsThreshold,
-		nodeSelector:        nodeSelector,
 		chStop:              make(services.StopChan),
 		leaseDuration:       leaseDuration,
 		chainFamily:         chainFamily,
 		sendOnlyErrorParser: sendOnlyErrorParser,
 		reportInterval:      reportInterval,
 	}
+	c.setNodes(nodes, sendonlys)
 
 	c.lggr.Debugf("The MultiNode is configured to use NodeSelectionMode: %s", selectionMode)
 
@@ -161,34 +173,12 @@ func NewMultiNode[
 // return any error if the nodes aren't available
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) Dial(ctx context.Context) error {
 	return c.StartOnce("MultiNode", func() (merr error) {
-		if len(c.nodes) == 0 {
+		nodes, sendonlys := c.getNodes()
+		if len(nodes) == 0 {
 			return fmt.Errorf("no available nodes for chain %s", c.chainID.String())
 		}
-		var ms services.MultiStart
-		for _, n := range c.nodes {
-			if n.ConfiguredChainID().String() != c.chainID.String() {
-				return ms.CloseBecause(fmt.Errorf("node %s has configured chain ID %s which does not match multinode configured chain ID of %s", n.String(), n.ConfiguredChainID().String(), c.chainID.String()))
-			}
-			rawNode, ok := n.(*node[CHAIN_ID, HEAD, RPC_CLIENT])
-			if ok {
-				// This is a bit hacky but it allows the node to be aware of
-				// pool state and prevent certain state transitions that might
-				// otherwise leave no nodes available. It is better to have one
-				// node in a degraded state than no nodes at all.
-				rawNode.nLiveNodes = c.nLiveNodes
-			}
-			// node will handle its own redialing and automatic recovery
-			if err := ms.Start(ctx, n); err != nil {
-				return err
-			}
-		}
-		for _, s := range c.sendonlys {
-			if s.ConfiguredChainID().String() != c.chainID.String() {
-				return ms.CloseBecause(fmt.Errorf("sendonly node %s has configured chain ID %s which does not match multinode configured chain ID of %s", s.String(), s.ConfiguredChainID().String(), c.chainID.String()))
-			}
-			if err := ms.Start(ctx, s); err != nil {
-				return err
-			}
+		if err := c.startNodes(ctx, nodes, sendonlys); err != nil {
+			return err
 		}
 		c.wg.Add(1)
 		go c.runLoop()
@@ -211,10 +201,158 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 		close(c.chStop)
 		c.wg.Wait()
 
-		return services.CloseAll(services.MultiCloser(c.nodes), services.MultiCloser(c.sendonlys))
+		nodes, sendonlys := c.getNodes()
+		return services.CloseAll(services.MultiCloser(nodes), services.MultiCloser(sendonlys))
 	})
 }
 
+// startNodes starts the nodes and sendonlys, and closes the ones already started if any fails to start.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) startNodes(ctx context.Context, nodes []Node[CHAIN_ID, HEAD, RPC_CLIENT], sendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) error {
+	var ms services.MultiStart
+	for _, n := range nodes {
+		if n.ConfiguredChainID().String() != c.chainID.String() {
+			return ms.CloseBecause(fmt.Errorf("node %s has configured chain ID %s which does not match multinode configured chain ID of %s", n.String(), n.ConfiguredChainID().String(), c.chainID.String()))
+		}
+		rawNode, ok := unwrapNode(n).(*node[CHAIN_ID, HEAD, RPC_CLIENT])
+		if ok {
+			// This is a bit hacky but it allows the node to be aware of
+			// pool state and prevent certain state transitions that might
+			// otherwise leave no nodes available. It is better to have one
+			// node in a degraded state than no nodes at all.
+			rawNode.nLiveNodes = c.nLiveNodes
+		}
+		// node will handle its own redialing and automatic recovery
+		if err := ms.Start(ctx, n); err != nil {
+			return err
+		}
+	}
+	for _, s := range sendonlys {
+		if s.ConfiguredChainID().String() != c.chainID.String() {
+			return ms.CloseBecause(fmt.Errorf("sendonly node %s has configured chain ID %s which does not match multinode configured chain ID of %s", s.String(), s.ConfiguredChainID().String(), c.chainID.String()))
+		}
+		if err := ms.Start(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getNodes returns the current nodes and sendonlys.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) getNodes() ([]Node[CHAIN_ID, HEAD, RPC_CLIENT], []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) {
+	c.nodesMu.RLock()
+	defer c.nodesMu.RUnlock()
+	return c.nodes, c.sendonlys
+}
+
+// getSelectors returns the current node selector, and the one for writable nodes.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) getSelectors() (NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT], NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]) {
+	c.nodesMu.RLock()
+	defer c.nodesMu.RUnlock()
+	return c.nodeSelector, c.writeNodeSelector
+}
+
+// getArchiveSelector returns the current selector of archive nodes, which is nil if there are none.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) getArchiveSelector() NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT] {
+	c.nodesMu.RLock()
+	defer c.nodesMu.RUnlock()
+	return c.archiveNodeSelector
+}
+
+// setNodes replaces the nodes and sendonlys, and their selectors.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) setNodes(nodes []Node[CHAIN_ID, HEAD, RPC_CLIENT], sendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) {
+	var fullNodes, writeNodes, archiveNodes []Node[CHAIN_ID, HEAD, RPC_CLIENT]
+	for _, n := range nodes {
+		if isArchive(n) {
+			archiveNodes = append(archiveNodes, n)
+			continue
+		}
+		fullNodes = append(fullNodes, n)
+		if !isReadOnly(n) {
+			writeNodes = append(writeNodes, n)
+		}
+	}
+	nodeSelector := newNodeSelector(c.selectionMode, fullNodes)
+	writeNodeSelector := nodeSelector
+	if len(writeNodes) != len(fullNodes) {
+		writeNodeSelector = newNodeSelector(c.selectionMode, writeNodes)
+	}
+	var archiveNodeSelector NodeSelector[CHAIN_ID, HEAD, RPC_CLIENT]
+	if len(archiveNodes) > 0 {
+		archiveNodeSelector = newNodeSelector(c.selectionMode, archiveNodes)
+	}
+
+	c.nodesMu.Lock()
+	defer c.nodesMu.Unlock()
+	c.nodes, c.sendonlys = nodes, sendonlys
+	c.nodeSelector, c.writeNodeSelector, c.archiveNodeSelector = nodeSelector, writeNodeSelector, archiveNodeSelector
+}
+
+// UpdateNodes closes and removes the nodes and sendonlys with the given names, then starts and adds the given nodes
+// and sendonlys. Updates are rejected if no primary node would be left. Callers relying on the active node are
+// switched to another one if it is removed.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) UpdateNodes(ctx context.Context, removed []string, nodes []Node[CHAIN_ID, HEAD, RPC_CLIENT], sendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) error {
+	if err := c.Ready(); err != nil {
+		return err
+	}
+	c.updateMu.Lock()
+	defer c.updateMu.Unlock()
+
+	isRemoved := make(map[string]bool, len(removed))
+	for _, name := range removed {
+		isRemoved[name] = true
+	}
+	currentNodes, currentSendonlys := c.getNodes()
+	var keptNodes []Node[CHAIN_ID, HEAD, RPC_CLIENT]
+	var keptSendonlys []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
+	var closed []io.Closer
+	names := make(map[string]bool)
+	for _, n := range currentNodes {
+		if isRemoved[n.Name()] {
+			closed = append(closed, n)
+			continue
+		}
+		keptNodes = append(keptNodes, n)
+		names[n.Name()] = true
+	}
+	for _, s := range currentSendonlys {
+		if isRemoved[s.Name()] {
+			closed = append(closed, s)
+			continue
+		}
+		keptSendonlys = append(keptSendonlys, s)
+		names[s.Name()] = true
+	}
+	for _, n := range nodes {
+		if names[n.Name()] {
+			return fmt.Errorf("duplicate node name %s", n.Name())
+		}
+		names[n.Name()] = true
+	}
+	for _, s := range sendonlys {
+		if names[s.Name()] {
+			return fmt.Errorf("duplicate node name %s", s.Name())
+		}
+		names[s.Name()] = true
+	}
+	if len(keptNodes)+len(nodes) == 0 {
+		return fmt.Errorf("no available nodes for chain %s", c.chainID.String())
+	}
+
+	if err := c.startNodes(ctx, nodes, sendonlys); err != nil {
+		return err
+	}
+	c.setNodes(append(keptNodes, nodes...), append(keptSendonlys, sendonlys...))
+
+	c.activeMu.Lock()
+	if c.activeNode != nil && isRemoved[c.activeNode.Name()] {
+		c.activeNode = nil
+	}
+	c.activeMu.Unlock()
+
+	c.lggr.Infow("Updated nodes", "removed", removed, "added", len(nodes)+len(sendonlys))
+	return services.CloseAll(closed...)
+}
+
 // SelectNodeRPC returns an RPC of an active node. If there are no active nodes it returns an error.
 // Call this method from your chain-specific client implementation to access any chain-specific rpc calls.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) SelectNodeRPC() (rpc RPC_CLIENT, err error) {
@@ -226,6 +364,25 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 
 }
 
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) SelectArchiveNodeRPC(blockNumber *big.Int, pruningHorizon int64) (rpc RPC_CLIENT, ok bool) {
+	archiveNodeSelector := c.getArchiveSelector()
+	if archiveNodeSelector == nil {
+		return rpc, false
+	}
+	if blockNumber != nil {
+		_, highest, _ := c.nLiveNodes()
+		if highest-blockNumber.Int64() < pruningHorizon {
+			return rpc, false
+		}
+	}
+	n := archiveNodeSelector.Select()
+	if n == nil {
+		c.lggr.Warnw("No live archive RPC nodes available, falling back to full nodes", "NodeSelectionMode", archiveNodeSelector.Name())
+		return rpc, false
+	}
+	return n.RPC(), true
+}
+
 // selectNode returns the active Node, if it is still nodeStateAlive, otherwise it selects a new one from the NodeSelector.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) selectNode() (node Node[CHAIN_ID, HEAD, RPC_CLIENT], err error) {
 	c.activeMu.RLock()
@@ -243,10 +400,11 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 		return // another goroutine beat us here
 	}
 
-	c.activeNode = c.nodeSelector.Select()
+	nodeSelector, _ := c.getSelectors()
+	c.activeNode = nodeSelector.Select()
 
 	if c.activeNode == nil {
-		c.lggr.Criticalw("No live RPC nodes available", "NodeSelectionMode", c.nodeSelector.Name())
+		c.lggr.Criticalw("No live RPC nodes available", "NodeSelectionMode", nodeSelector.Name())
 		errmsg := fmt.Errorf("no live nodes available for chain %s", c.chainID.String())
 		c.SvcErrBuffer.Append(errmsg)
 		err = ErroringNodeError
@@ -255,11 +413,42 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	return c.activeNode, err
 }
 
+// selectWriteNode returns the node to be used for broadcasting transactions. This is the active node, unless it is
+// read-only, in which case the best writable primary node is selected instead.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) selectWriteNode() (node Node[CHAIN_ID, HEAD, RPC_CLIENT], err error) {
+	node, err = c.selectNode()
+	if err != nil || !isReadOnly(node) {
+		return node, err
+	}
+	_, writeNodeSelector := c.getSelectors()
+	node = writeNodeSelector.Select()
+	if node == nil {
+		c.lggr.Criticalw("No live writable RPC nodes available", "NodeSelectionMode", writeNodeSelector.Name())
+		errmsg := fmt.Errorf("no live writable nodes available for chain %s", c.chainID.String())
+		c.SvcErrBuffer.Append(errmsg)
+		return nil, ErroringNodeError
+	}
+	return node, nil
+}
+
+// writeNodes returns every node which may be used to broadcast transactions, including sendonlys.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) writeNodes() (all []SendOnlyNode[CHAIN_ID, RPC_CLIENT]) {
+	nodes, sendonlys := c.getNodes()
+	for _, n := range nodes {
+		if isReadOnly(n) || isArchive(n) {
+			continue
+		}
+		all = append(all, n)
+	}
+	return append(all, sendonlys...)
+}
+
 // nLiveNodes returns the number of currently alive nodes, as well as the highest block number and greatest total difficulty.
 // totalDifficulty will be 0 if all nodes return nil.
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) nLiveNodes() (nLiveNodes int, blockNumber int64, totalDifficulty *big.Int) {
 	totalDifficulty = big.NewInt(0)
-	for _, n := range c.nodes {
+	nodes, _ := c.getNodes()
+	for _, n := range nodes {
 		if s, num, td := n.StateAndLatest(); s == nodeStateAlive {
 			nLiveNodes++
 			if num > blockNumber {
@@ -274,8 +463,10 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) checkLease() {
-	bestNode := c.nodeSelector.Select()
-	for _, n := range c.nodes {
+	nodeSelector, _ := c.getSelectors()
+	nodes, _ := c.getNodes()
+	bestNode := nodeSelector.Select()
+	for _, n := range nodes {
 		// Terminate client subscriptions. Services are responsible for reconnecting, which will be routed to the new
 		// best node. Only terminate connections with more than 1 subscription to account for the aliveLoop subscription
 		if n.State() == nodeStateAlive && n != bestNode && n.SubscribersCount() > 1 {
@@ -332,8 +523,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 
 	var total, dead int
 	counts := make(map[nodeState]int)
-	nodeStates := make([]nodeWithState, len(c.nodes))
-	for i, n := range c.nodes {
+	nodes, _ := c.getNodes()
+	nodeStates := make([]nodeWithState, len(nodes))
+	for i, n := range nodes {
 		state := n.State()
 		nodeStates[i] = nodeWithState{n.String(), state.String()}
 		total++
@@ -383,13 +575,8 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
-	main, selectionErr := c.selectNode()
-	var all []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
-	for _, n := range c.nodes {
-		all = append(all, n)
-	}
-	all = append(all, c.sendonlys...)
-	for _, n := range all {
+	main, selectionErr := c.selectWriteNode()
+	for _, n := range c.writeNodes() {
 		if n == main {
 			// main node is used at the end for the return value
 			continue
@@ -513,10 +700,11 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) NodeStates() (states map[string]string) {
 	states = make(map[string]string)
-	for _, n := range c.nodes {
+	nodes, sendonlys := c.getNodes()
+	for _, n := range nodes {
 		states[n.Name()] = n.State().String()
 	}
-	for _, s := range c.sendonlys {
+	for _, s := range sendonlys {
 		states[s.Name()] = s.State().String()
 	}
 	return
@@ -538,7 +726,7 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	fee FEE,
 	fromAddress ADDR,
 ) (txhash string, err error) {
-	n, err := c.selectNode()
+	n, err := c.selectWriteNode()
 	if err != nil {
 		return txhash, err
 	}
@@ -546,13 +734,8 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT]) SendTransaction(ctx context.Context, tx TX) error {
-	main, nodeError := c.selectNode()
-	var all []SendOnlyNode[CHAIN_ID, RPC_CLIENT]
-	for _, n := range c.nodes {
-		all = append(all, n)
-	}
-	all = append(all, c.sendonlys...)
-	for _, n := range all {
+	main, nodeError := c.selectWriteNode()
+	for _, n := range c.writeNodes() {
 		if n == main {
 			// main node is used at the end for the return value
 			continue
@@ -347,6 +347,66 @@ func TestMultiNode_CheckLease(t *testing.T) {
 	})
 }
 
+func TestMultiNode_UpdateNodes(t *testing.T) {
+	t.Parallel()
+
+	chainID := types.RandomID()
+	newNamedNode := func(name string) *mockNode[types.ID, types.Head[Hashable], multiNodeRPCClient] {
+		node := newHealthyNode(t, chainID)
+		node.On("Name").Return(name).Maybe()
+		return node
+	}
+	node1, node2 := newNamedNode("node_1"), newNamedNode("node_2")
+	sendOnly := newMockSendOnlyNode[types.ID, multiNodeRPCClient](t)
+	sendOnly.On("Name").Return("send_only").Maybe()
+	sendOnly.On("State").Return(nodeStateAlive).Maybe()
+
+	mn := newTestMultiNode(t, multiNodeOpts{
+		selectionMode: NodeSelectionModeRoundRobin,
+		chainID:       chainID,
+		nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{node1, node2},
+	})
+	require.Error(t, mn.UpdateNodes(tests.Context(t), nil, nil, nil), "not started")
+	require.NoError(t, mn.Dial(tests.Context(t)))
+	defer func() { assert.NoError(t, mn.Close()) }()
+
+	active, err := mn.selectNode()
+	require.NoError(t, err)
+	require.Equal(t, node1, active)
+
+	t.Run("duplicate name", func(t *testing.T) {
+		duplicate := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		duplicate.On("Name").Return("node_2")
+		err := mn.UpdateNodes(tests.Context(t), nil, []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{duplicate}, nil)
+		require.EqualError(t, err, "duplicate node name node_2")
+	})
+
+	t.Run("no nodes left", func(t *testing.T) {
+		err := mn.UpdateNodes(tests.Context(t), []string{"node_1", "node_2"}, nil, nil)
+		require.EqualError(t, err, fmt.Sprintf("no available nodes for chain %s", chainID))
+	})
+
+	t.Run("replaces nodes", func(t *testing.T) {
+		node3 := newNamedNode("node_3")
+		sendOnly.On("ConfiguredChainID").Return(chainID).Once()
+		sendOnly.On("Start", mock.Anything).Return(nil).Once()
+		sendOnly.On("Close").Return(nil).Once()
+		err := mn.UpdateNodes(tests.Context(t), []string{"node_1"}, []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{node3},
+			[]SendOnlyNode[types.ID, multiNodeRPCClient]{sendOnly})
+		require.NoError(t, err)
+		node1.AssertCalled(t, "Close")
+
+		assert.Equal(t, map[string]string{
+			"node_2":    nodeStateAlive.String(),
+			"node_3":    nodeStateAlive.String(),
+			"send_only": nodeStateAlive.String(),
+		}, mn.NodeStates())
+		active, err := mn.selectNode()
+		require.NoError(t, err)
+		assert.NotEqual(t, node1, active)
+	})
+}
+
 func TestMultiNode_selectNode(t *testing.T) {
 	t.Parallel()
 	t.Run("Returns same node, if it's still healthy", func(t *testing.T) {
@@ -632,4 +692,114 @@ func TestMultiNode_SendTransaction(t *testing.T) {
 		tests.AssertLogEventually(t, observedLogs, "Sendonly node sent transaction")
 		tests.AssertLogEventually(t, observedLogs, "RPC returned error")
 	})
+	t.Run("Never broadcasts through read-only nodes", func(t *testing.T) {
+		// read-only node is selected as the active node but has no expectations set on its RPC
+		readOnlyRPC := newMultiNodeRPCClient(t)
+		readOnly := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		readOnly.On("RPC").Return(readOnlyRPC).Maybe()
+		readOnlyNode := NewReadOnlyNode[types.ID, types.Head[Hashable], multiNodeRPCClient](readOnly)
+
+		writeRPC := newMultiNodeRPCClient(t)
+		writeRPC.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Once()
+		writeNode := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		writeNode.On("RPC").Return(writeRPC)
+
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(readOnlyNode).Once()
+		writeNodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		writeNodeSelector.On("Select").Return(writeNode).Once()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{readOnlyNode, writeNode},
+		})
+		mn.nodeSelector = nodeSelector
+		mn.writeNodeSelector = writeNodeSelector
+
+		err := mn.SendTransaction(tests.Context(t), nil)
+		require.NoError(t, err)
+	})
+	t.Run("Fails if no writable node is available", func(t *testing.T) {
+		readOnlyNode := NewReadOnlyNode[types.ID, types.Head[Hashable], multiNodeRPCClient](newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t))
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(readOnlyNode).Once()
+		writeNodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		writeNodeSelector.On("Select").Return(nil).Once()
+		writeNodeSelector.On("Name").Return("MockedNodeSelector").Once()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{readOnlyNode},
+		})
+		mn.nodeSelector = nodeSelector
+		mn.writeNodeSelector = writeNodeSelector
+
+		err := mn.SendTransaction(tests.Context(t), nil)
+		require.EqualError(t, err, ErroringNodeError.Error())
+	})
+}
+
+func TestMultiNode_SelectArchiveNodeRPC(t *testing.T) {
+	t.Parallel()
+	newArchive := func(t *testing.T) (Node[types.ID, types.Head[Hashable], multiNodeRPCClient], multiNodeRPCClient) {
+		rpc := newMultiNodeRPCClient(t)
+		node := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		node.On("RPC").Return(rpc).Maybe()
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("StateAndLatest").Return(nodeStateAlive, int64(1000), big.NewInt(1000)).Maybe()
+		return NewArchiveNode[types.ID, types.Head[Hashable], multiNodeRPCClient](node), rpc
+	}
+	newFull := func(t *testing.T) Node[types.ID, types.Head[Hashable], multiNodeRPCClient] {
+		node := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("StateAndLatest").Return(nodeStateAlive, int64(1000), big.NewInt(1000)).Maybe()
+		return node
+	}
+	t.Run("Archive nodes are only selected for historical queries", func(t *testing.T) {
+		archive, archiveRPC := newArchive(t)
+		full := newFull(t)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{archive, full},
+		})
+
+		// archive nodes are never the active node, nor used to broadcast
+		assert.Equal(t, full, mn.nodeSelector.Select())
+		assert.Equal(t, full, mn.writeNodeSelector.Select())
+		assert.Equal(t, []SendOnlyNode[types.ID, multiNodeRPCClient]{full}, mn.writeNodes())
+
+		_, ok := mn.SelectArchiveNodeRPC(big.NewInt(900), 128)
+		assert.False(t, ok, "recent blocks are served by full nodes")
+		rpc, ok := mn.SelectArchiveNodeRPC(big.NewInt(872), 128)
+		require.True(t, ok)
+		assert.Equal(t, archiveRPC, rpc)
+		rpc, ok = mn.SelectArchiveNodeRPC(nil, 0)
+		require.True(t, ok)
+		assert.Equal(t, archiveRPC, rpc)
+	})
+	t.Run("Falls back to full nodes without archive nodes", func(t *testing.T) {
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{newFull(t)},
+		})
+		assert.Nil(t, mn.archiveNodeSelector)
+		_, ok := mn.SelectArchiveNodeRPC(nil, 0)
+		assert.False(t, ok)
+	})
+	t.Run("Falls back to full nodes if no archive node is live", func(t *testing.T) {
+		archive, _ := newArchive(t)
+		nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(nil).Once()
+		nodeSelector.On("Name").Return("MockedNodeSelector").Once()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, types.Head[Hashable], multiNodeRPCClient]{archive, newFull(t)},
+		})
+		mn.archiveNodeSelector = nodeSelector
+		_, ok := mn.SelectArchiveNodeRPC(nil, 0)
+		assert.False(t, ok)
+	})
 }
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// readOnlyNode wraps a primary Node which must only be used for reads (calls, log queries, subscriptions).
+// MultiNode never broadcasts transactions through it, so that operators can restrict writes to trusted endpoints
+// while spreading read load across other providers.
+type readOnlyNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	Node[CHAIN_ID, HEAD, RPC]
+}
+
+// NewReadOnlyNode marks n as read-only. n is otherwise used exactly like any other primary node.
+func NewReadOnlyNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](n Node[CHAIN_ID, HEAD, RPC]) Node[CHAIN_ID, HEAD, RPC] {
+	return &readOnlyNode[CHAIN_ID, HEAD, RPC]{n}
+}
+
+func isReadOnly[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](n Node[CHAIN_ID, HEAD, RPC]) bool {
+	_, ok := n.(*readOnlyNode[CHAIN_ID, HEAD, RPC])
+	return ok
+}
+
+// unwrapNode returns the underlying node if n is read-only or an archive node
+func unwrapNode[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](n Node[CHAIN_ID, HEAD, RPC]) Node[CHAIN_ID, HEAD, RPC] {
+	switch w := n.(type) {
+	case *readOnlyNode[CHAIN_ID, HEAD, RPC]:
+		return w.Node
+	case *archiveNode[CHAIN_ID, HEAD, RPC]:
+		return w.Node
+	}
+	return n
+}
@@ -10,6 +10,9 @@ type Opt int
 const (
 	// OptForceRefetch forces the estimator to bust a cache if necessary
 	OptForceRefetch Opt = iota
+	// OptTxTypeUnsupported tells the estimator that dynamic fee transactions were rejected by the chain, so that it
+	// falls back to legacy fees
+	OptTxTypeUnsupported
 )
 
 type Fee fmt.Stringer
@@ -0,0 +1,27 @@
+package headtracker
+
+import (
+	"context"
+
+	htrktypes "github.com/smartcontractkit/chainlink/v2/common/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type depthFinalityProvider[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] struct {
+	config htrktypes.Config
+}
+
+// NewDepthFinalityProvider returns a FinalityProvider which considers every block at least FinalityDepth below the
+// latest head to be finalized.
+func NewDepthFinalityProvider[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable](config htrktypes.Config) types.FinalityProvider[H, BLOCK_HASH] {
+	return &depthFinalityProvider[H, BLOCK_HASH]{config: config}
+}
+
+func (p *depthFinalityProvider[H, BLOCK_HASH]) LatestFinalizedBlock(_ context.Context, latest H) (int64, error) {
+	finalized := latest.BlockNumber() - int64(p.config.FinalityDepth())
+	if finalized < 0 {
+		// the genesis block is always final
+		return 0, nil
+	}
+	return finalized, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -28,11 +29,25 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is EVM.FinalityDepth or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promFinalizedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The latest finalized block number, as determined by the chain's finality provider",
+	}, []string{"evmChainID"})
+
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of blocks replaced by each observed re-org",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100, 250, 500, 1000},
+	}, []string{"evmChainID"})
 )
 
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
 const HeadsBufferSize = 10
 
+// maxRecentReorgs is the number of observed re-orgs kept in memory
+const maxRecentReorgs = 100
+
 type HeadTracker[
 	HTH htrktypes.Head[BLOCK_HASH, ID],
 	S types.Subscription,
@@ -48,6 +63,11 @@ type HeadTracker[
 	chainID         ID
 	config          htrktypes.Config
 	htConfig        htrktypes.HeadTrackerConfig
+	finality        types.FinalityProvider[HTH, BLOCK_HASH]
+	latestFinalized atomic.Int64
+
+	reorgsMu sync.RWMutex
+	reorgs   []types.Reorg[BLOCK_HASH]
 
 	backfillMB   *mailbox.Mailbox[HTH]
 	broadcastMB  *mailbox.Mailbox[HTH]
@@ -68,6 +88,7 @@ func NewHeadTracker[
 	client htrktypes.Client[HTH, S, ID, BLOCK_HASH],
 	config htrktypes.Config,
 	htConfig htrktypes.HeadTrackerConfig,
+	finality types.FinalityProvider[HTH, BLOCK_HASH],
 	headBroadcaster types.HeadBroadcaster[HTH, BLOCK_HASH],
 	headSaver types.HeadSaver[HTH, BLOCK_HASH],
 	mailMon *mailbox.Monitor,
@@ -75,12 +96,13 @@ func NewHeadTracker[
 ) types.HeadTracker[HTH, BLOCK_HASH] {
 	chStop := make(chan struct{})
 	lggr = logger.Named(lggr, "HeadTracker")
-	return &HeadTracker[HTH, S, ID, BLOCK_HASH]{
+	ht := &HeadTracker[HTH, S, ID, BLOCK_HASH]{
 		headBroadcaster: headBroadcaster,
 		client:          client,
 		chainID:         client.ConfiguredChainID(),
 		config:          config,
 		htConfig:        htConfig,
+		finality:        finality,
 		log:             logger.Sugared(lggr),
 		backfillMB:      mailbox.NewSingle[HTH](),
 		broadcastMB:     mailbox.New[HTH](HeadsBufferSize),
@@ -90,6 +112,8 @@ func NewHeadTracker[
 		mailMon:         mailMon,
 		getNilHead:      getNilHead,
 	}
+	ht.latestFinalized.Store(-1)
+	return ht
 }
 
 // Start starts HeadTracker service.
@@ -176,6 +200,23 @@ func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) LatestChain() HTH {
 	return ht.headSaver.LatestChain()
 }
 
+func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) LatestFinalizedBlock() int64 {
+	return ht.latestFinalized.Load()
+}
+
+func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) RecentReorgs(limit int) []types.Reorg[BLOCK_HASH] {
+	ht.reorgsMu.RLock()
+	defer ht.reorgsMu.RUnlock()
+	if limit <= 0 || limit > len(ht.reorgs) {
+		limit = len(ht.reorgs)
+	}
+	reorgs := make([]types.Reorg[BLOCK_HASH], limit)
+	for i := range reorgs {
+		reorgs[i] = ht.reorgs[len(ht.reorgs)-1-i]
+	}
+	return reorgs
+}
+
 func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) getInitialHead(ctx context.Context) (HTH, error) {
 	head, err := ht.client.HeadByNumber(ctx, nil)
 	if err != nil {
@@ -214,6 +255,9 @@ func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) handleNewHead(ctx context.Context
 		if !headWithChain.IsValid() {
 			return fmt.Errorf("HeadTracker#handleNewHighestHead headWithChain was unexpectedly nil")
 		}
+		if prevHead.IsValid() {
+			ht.detectReorg(prevHead, headWithChain)
+		}
 		ht.backfillMB.Deliver(headWithChain)
 		ht.broadcastMB.Deliver(headWithChain)
 	} else if head.BlockNumber() == prevHead.BlockNumber() {
@@ -234,6 +278,65 @@ func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) handleNewHead(ctx context.Context
 	return nil
 }
 
+// detectReorg records a re-org if the new longest chain no longer contains the previous head.
+func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) detectReorg(prevHead, headWithChain HTH) {
+	var zero BLOCK_HASH
+	hash := headWithChain.HashAtHeight(prevHead.BlockNumber())
+	if hash == zero || hash == prevHead.BlockHash() {
+		// either the previous head is still part of the chain, or not enough of the new chain is known to tell
+		return
+	}
+
+	depth := int64(prevHead.ChainLength())
+	for h := prevHead.GetParent(); h != nil; h = h.GetParent() {
+		hash = headWithChain.HashAtHeight(h.BlockNumber())
+		if hash == h.BlockHash() || hash == zero {
+			// found the common ancestor, or reached the start of the new chain
+			depth = prevHead.BlockNumber() - h.BlockNumber()
+			break
+		}
+	}
+
+	reorg := types.Reorg[BLOCK_HASH]{
+		BlockNumber: headWithChain.BlockNumber(),
+		Depth:       depth,
+		OldHead:     prevHead.BlockHash(),
+		NewHead:     headWithChain.BlockHash(),
+		Detected:    time.Now(),
+	}
+	promReorgDepth.WithLabelValues(ht.chainID.String()).Observe(float64(depth))
+	ht.reorgsMu.Lock()
+	ht.reorgs = append(ht.reorgs, reorg)
+	if len(ht.reorgs) > maxRecentReorgs {
+		ht.reorgs = ht.reorgs[len(ht.reorgs)-maxRecentReorgs:]
+	}
+	ht.reorgsMu.Unlock()
+
+	l := ht.log.With("blockNum", reorg.BlockNumber, "depth", depth, "head", reorg.NewHead, "prevHead", reorg.OldHead)
+	if finalized := ht.latestFinalized.Load(); finalized >= 0 && prevHead.BlockNumber()-depth < finalized {
+		l.Criticalw("Got re-org of finalized blocks. This means that either the finality provider of the chain is misconfigured, or the chain violated its finality guarantees. This node may not function correctly without manual intervention.", "latestFinalized", finalized)
+		ht.SvcErrBuffer.Append(errors.New("got re-org of finalized blocks"))
+		return
+	}
+	l.Infow("Got re-org")
+}
+
+// updateLatestFinalized queries the finality provider for the latest finalized block. It never moves backwards.
+func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) updateLatestFinalized(ctx context.Context, head HTH) {
+	finalized, err := ht.finality.LatestFinalizedBlock(ctx, head)
+	if err != nil {
+		if ctx.Err() == nil {
+			ht.log.Warnw("Failed to determine latest finalized block", "blockNum", head.BlockNumber(), "err", err)
+		}
+		return
+	}
+	if finalized <= ht.latestFinalized.Load() {
+		return
+	}
+	ht.latestFinalized.Store(finalized)
+	promFinalizedHead.WithLabelValues(ht.chainID.String()).Set(float64(finalized))
+}
+
 func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) broadcastLoop() {
 	defer ht.wgDone.Done()
 
@@ -296,6 +399,7 @@ func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop() {
 					} else if ctx.Err() != nil {
 						break
 					}
+					ht.updateLatestFinalized(ctx, head)
 				}
 			}
 		}
@@ -0,0 +1,81 @@
+// Package logctx carries a standard set of logging fields through a context.Context, so that every log line emitted
+// while serving a request can be attributed to the chain, job, contract binding and transaction it belongs to.
+//
+// Fields are added to the context where they become known, e.g. by the pipeline runner or the TxManager, and picked up
+// by any code further down the call stack which logs with Fields(ctx).
+package logctx
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// Standard field names. Use these rather than ad-hoc keys, so that logs can be filtered uniformly across subsystems.
+const (
+	ChainID      = "chainID"
+	JobID        = "jobID"
+	ContractName = "contractName"
+	ReadName     = "readName"
+	TxID         = "txID"
+)
+
+type fieldsKey struct{}
+
+// With returns a copy of ctx carrying keyvals in addition to the fields already carried by ctx. A key which is already
+// present is overwritten in place, so that the field order stays stable.
+func With(ctx context.Context, keyvals ...interface{}) context.Context {
+	if len(keyvals)%2 != 0 {
+		panic("expected even number of arguments")
+	}
+	prev := Fields(ctx)
+	fields := make([]interface{}, len(prev), len(prev)+len(keyvals))
+	copy(fields, prev)
+outer:
+	for i := 0; i < len(keyvals); i += 2 {
+		for j := 0; j < len(fields); j += 2 {
+			if fields[j] == keyvals[i] {
+				fields[j+1] = keyvals[i+1]
+				continue outer
+			}
+		}
+		fields = append(fields, keyvals[i], keyvals[i+1])
+	}
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// WithChainID returns a copy of ctx carrying the chain ID field.
+func WithChainID(ctx context.Context, chainID string) context.Context {
+	return With(ctx, ChainID, chainID)
+}
+
+// WithJobID returns a copy of ctx carrying the job ID field.
+func WithJobID(ctx context.Context, jobID int32) context.Context {
+	return With(ctx, JobID, jobID)
+}
+
+// WithBinding returns a copy of ctx carrying the contract and read names of a ChainReader binding.
+func WithBinding(ctx context.Context, contractName, readName string) context.Context {
+	return With(ctx, ContractName, contractName, ReadName, readName)
+}
+
+// WithTxID returns a copy of ctx carrying the TxManager transaction ID field.
+func WithTxID(ctx context.Context, txID int64) context.Context {
+	return With(ctx, TxID, txID)
+}
+
+// Fields returns the fields carried by ctx as alternating keys and values, suitable for passing to With or ...w
+// logging methods. The returned slice must not be modified.
+func Fields(ctx context.Context) []interface{} {
+	fields, _ := ctx.Value(fieldsKey{}).([]interface{})
+	return fields
+}
+
+// Logger returns l with the fields carried by ctx attached.
+func Logger(ctx context.Context, l logger.Logger) logger.Logger {
+	fields := Fields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return logger.With(l, fields...)
+}
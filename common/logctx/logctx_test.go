@@ -0,0 +1,46 @@
+package logctx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+func TestWith(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assert.Empty(t, Fields(ctx))
+
+	parent := WithChainID(WithJobID(ctx, 7), "42")
+	assert.Equal(t, []interface{}{JobID, int32(7), ChainID, "42"}, Fields(parent))
+
+	child := WithTxID(WithJobID(parent, 8), 99)
+	assert.Equal(t, []interface{}{JobID, int32(8), ChainID, "42", TxID, int64(99)}, Fields(child))
+	// the parent is not modified
+	assert.Equal(t, []interface{}{JobID, int32(7), ChainID, "42"}, Fields(parent))
+
+	assert.Equal(t, []interface{}{ContractName, "median", ReadName, "latestRound"}, Fields(WithBinding(ctx, "median", "latestRound")))
+
+	assert.Panics(t, func() { With(ctx, "odd") })
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	lggr, observed := logger.TestObserved(t, zapcore.DebugLevel)
+	ctx := WithBinding(WithChainID(context.Background(), "42"), "median", "latestRound")
+
+	Logger(context.Background(), lggr).Debug("without fields")
+	Logger(ctx, lggr).Debug("with fields")
+
+	logs := observed.All()
+	require.Len(t, logs, 2)
+	assert.Empty(t, logs[0].ContextMap())
+	assert.Equal(t, map[string]interface{}{ChainID: "42", ContractName: "median", ReadName: "latestRound"}, logs[1].ContextMap())
+}
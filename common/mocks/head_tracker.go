@@ -88,6 +88,24 @@ func (_m *HeadTracker[H, BLOCK_HASH]) LatestChain() H {
 	return r0
 }
 
+// LatestFinalizedBlock provides a mock function with given fields:
+func (_m *HeadTracker[H, BLOCK_HASH]) LatestFinalizedBlock() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestFinalizedBlock")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // Name provides a mock function with given fields:
 func (_m *HeadTracker[H, BLOCK_HASH]) Name() string {
 	ret := _m.Called()
@@ -124,6 +142,26 @@ func (_m *HeadTracker[H, BLOCK_HASH]) Ready() error {
 	return r0
 }
 
+// RecentReorgs provides a mock function with given fields: limit
+func (_m *HeadTracker[H, BLOCK_HASH]) RecentReorgs(limit int) []types.Reorg[BLOCK_HASH] {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecentReorgs")
+	}
+
+	var r0 []types.Reorg[BLOCK_HASH]
+	if rf, ok := ret.Get(0).(func(int) []types.Reorg[BLOCK_HASH]); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Reorg[BLOCK_HASH])
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *HeadTracker[H, BLOCK_HASH]) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
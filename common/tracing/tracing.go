@@ -0,0 +1,99 @@
+// Package tracing traces the work of a job end to end with OpenTelemetry: from the trigger of a job run (a log, a cron
+// tick, an OCR round), through its pipeline tasks and the RPC calls they make, to the broadcast and confirmation of the
+// transactions it creates, so that a single trace breaks down the latency of a report landing on-chain.
+//
+// Spans are created with the global tracer provider, which is configured by the Tracing config. While tracing is
+// disabled, it is a no-op and so is everything in this package.
+//
+// The trace context crosses the TxManager queue in the transaction meta: it is injected with Inject when a transaction
+// is created, and extracted with Extract by the broadcaster and confirmer.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+)
+
+const instrumentationName = "github.com/smartcontractkit/chainlink/v2"
+
+// Standard attribute keys, in addition to the logctx fields which are added to every span.
+const (
+	// Trigger is the kind of event which started a job run, like "log", "cron" or "ocr".
+	Trigger = attribute.Key("trigger")
+	TxHash  = attribute.Key("txHash")
+)
+
+// Start starts a span, as a child of the span of ctx if any, and returns a copy of ctx carrying it. The logctx fields
+// of ctx are added to the attributes of the span.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(append(fieldAttributes(ctx), attrs...)...))
+}
+
+// End ends span, with an error status if err is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Record records a span which started at start and ends now, like a call which was timed by the caller.
+func Record(ctx context.Context, name string, start time.Time, err error, attrs ...attribute.KeyValue) {
+	_, span := otel.Tracer(instrumentationName).Start(ctx, name, trace.WithTimestamp(start),
+		trace.WithAttributes(append(fieldAttributes(ctx), attrs...)...))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject returns the W3C trace context of the span of ctx, or nil if ctx is not being traced.
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier
+}
+
+// Extract returns a copy of ctx carrying the remote span of a trace context returned by Inject, so that spans started
+// from it join its trace.
+func Extract(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+func fieldAttributes(ctx context.Context) []attribute.KeyValue {
+	fields := logctx.Fields(ctx)
+	attrs := make([]attribute.KeyValue, 0, len(fields)/2)
+	for i := 0; i+1 < len(fields); i += 2 {
+		key := attribute.Key(fmt.Sprint(fields[i]))
+		switch v := fields[i+1].(type) {
+		case string:
+			attrs = append(attrs, key.String(v))
+		case int32:
+			attrs = append(attrs, key.Int64(int64(v)))
+		case int64:
+			attrs = append(attrs, key.Int64(v))
+		case int:
+			attrs = append(attrs, key.Int(v))
+		default:
+			attrs = append(attrs, key.String(fmt.Sprint(v)))
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+)
+
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return recorder
+}
+
+func TestStart(t *testing.T) {
+	recorder := newRecorder(t)
+
+	ctx := logctx.WithChainID(logctx.WithJobID(context.Background(), 7), "42")
+	ctx, span := Start(ctx, "job.run", Trigger.String("cron"))
+	Record(ctx, "evm.rpc.CallContract", time.Now().Add(-time.Second), errors.New("reverted"))
+	End(span, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	rpc, run := spans[0], spans[1]
+	assert.Equal(t, "job.run", run.Name())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int64(logctx.JobID, 7),
+		attribute.String(logctx.ChainID, "42"),
+		Trigger.String("cron"),
+	}, run.Attributes())
+	assert.Equal(t, codes.Unset, run.Status().Code)
+
+	assert.Equal(t, "evm.rpc.CallContract", rpc.Name())
+	assert.Equal(t, run.SpanContext().SpanID(), rpc.Parent().SpanID())
+	assert.Equal(t, codes.Error, rpc.Status().Code)
+	assert.Equal(t, "reverted", rpc.Status().Description)
+	assert.GreaterOrEqual(t, rpc.EndTime().Sub(rpc.StartTime()), time.Second)
+}
+
+func TestInjectExtract(t *testing.T) {
+	recorder := newRecorder(t)
+
+	assert.Nil(t, Inject(context.Background()))
+	assert.Equal(t, context.Background(), Extract(context.Background(), nil))
+
+	ctx, span := Start(context.Background(), "ocr.transmit")
+	traceContext := Inject(ctx)
+	require.Contains(t, traceContext, "traceparent")
+	End(span, nil)
+
+	_, child := Start(Extract(context.Background(), traceContext), "txm.broadcast")
+	End(child, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+	assert.True(t, spans[1].Parent().IsRemote())
+}
@@ -22,6 +22,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/common/client"
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -337,6 +339,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) moni
 
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
+	ctx = logctx.WithChainID(ctx, eb.chainID.String())
 
 	if eb.autoSyncSequence {
 		eb.lggr.Debugw("Auto-syncing sequence", "address", addr.String())
@@ -563,9 +566,12 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		return fmt.Errorf("invariant violation: expected transaction %v to be in_progress, it was %s", etx.ID, etx.State), false
 	}
 
+	ctx = logctx.WithTxID(ctx, etx.ID)
 	lgr := etx.GetLogger(logger.With(eb.lggr, "fee", attempt.TxFee))
 	lgr.Infow("Sending transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
+	ctx, span := tracing.Start(etx.WithTraceContext(ctx), "txm.broadcast", tracing.TxHash.String(attempt.Hash.String()))
 	errType, err := eb.client.SendTransactionReturnCode(ctx, etx, attempt, lgr)
+	tracing.End(span, err)
 
 	if errType != client.Fatal {
 		etx.InitialBroadcastAt = &initialBroadcastAt
@@ -648,6 +654,8 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		return err, true
 	case client.FeeOutOfValidRange:
 		return eb.tryAgainWithNewEstimation(ctx, lgr, err, etx, attempt, initialBroadcastAt)
+	case client.TxTypeUnsupported:
+		return eb.tryAgainWithLegacyType(ctx, lgr, err, etx, attempt, initialBroadcastAt)
 	case client.Unsupported:
 		return err, false
 	case client.ExceedsMaxFee:
@@ -760,6 +768,25 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) tryA
 	return eb.saveTryAgainAttempt(ctx, lgr, etx, attempt, replacementAttempt, initialBroadcastAt, fee, feeLimit)
 }
 
+// tryAgainWithLegacyType replaces an attempt whose type was rejected by the node with a legacy one. The estimator is
+// told that the type is unsupported, so that the following transactions of the chain are legacy ones too.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) tryAgainWithLegacyType(ctx context.Context, lgr logger.Logger, txError error, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], initialBroadcastAt time.Time) (err error, retryable bool) {
+	if attempt.TxType == 0x0 {
+		err = fmt.Errorf("node rejected the type of a legacy transaction, which cannot fall back to another type. Node returned error: %v", txError.Error())
+		logger.Sugared(eb.lggr).AssumptionViolation(err.Error())
+		return err, false
+	}
+
+	replacementAttempt, fee, feeLimit, retryable, err := eb.NewTxAttemptWithType(ctx, etx, lgr, 0x0, feetypes.OptTxTypeUnsupported)
+	if err != nil {
+		return fmt.Errorf("tryAgainWithLegacyType failed to build new attempt: %w", err), retryable
+	}
+	lgr.Warnw("Node rejected the transaction type, will try again with a legacy transaction",
+		"etxID", etx.ID, "err", txError, "txType", attempt.TxType, "newGasPrice", fee, "newGasLimit", feeLimit)
+
+	return eb.saveTryAgainAttempt(ctx, lgr, etx, attempt, replacementAttempt, initialBroadcastAt, fee, feeLimit)
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) saveTryAgainAttempt(ctx context.Context, lgr logger.Logger, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], replacementAttempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], initialBroadcastAt time.Time, newFee FEE, newFeeLimit uint32) (err error, retyrable bool) {
 	if err = eb.txStore.SaveReplacementInProgressAttempt(ctx, attempt, &replacementAttempt); err != nil {
 		return fmt.Errorf("tryAgainWithNewFee failed: %w", err), true
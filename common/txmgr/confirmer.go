@@ -12,6 +12,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/chains/label"
@@ -24,6 +25,8 @@ import (
 	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	iutils "github.com/smartcontractkit/chainlink/v2/common/internal/utils"
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -140,6 +143,7 @@ type Confirmer[
 
 	nConsecutiveBlocksChainTooShort int
 	isReceiptNil                    func(R) bool
+	inclusionSLA                    *inclusionSLA
 }
 
 func NewConfirmer[
@@ -164,6 +168,7 @@ func NewConfirmer[
 	isReceiptNil func(R) bool,
 ) *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	lggr = logger.Named(lggr, "Confirmer")
+	chainID := client.ConfiguredChainID()
 	return &Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
 		txStore:          txStore,
 		lggr:             logger.Sugared(lggr),
@@ -174,10 +179,11 @@ func NewConfirmer[
 		feeConfig:        feeConfig,
 		txConfig:         txConfig,
 		dbConfig:         dbConfig,
-		chainID:          client.ConfiguredChainID(),
+		chainID:          chainID,
 		ks:               keystore,
 		mb:               mailbox.NewSingle[HEAD](),
 		isReceiptNil:     isReceiptNil,
+		inclusionSLA:     newInclusionSLA(lggr, chainID.String(), txConfig.InclusionTarget()),
 	}
 }
 
@@ -242,7 +248,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Nam
 }
 
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealthReport() map[string]error {
-	return map[string]error{ec.Name(): ec.Healthy()}
+	return map[string]error{ec.Name(): errors.Join(ec.Healthy(), ec.inclusionSLA.Healthy())}
 }
 
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) runLoop() {
@@ -273,7 +279,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) run
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ProcessHead(ctx context.Context, head types.Head[BLOCK_HASH]) error {
 	ctx, cancel := context.WithTimeout(ctx, processHeadTimeout)
 	defer cancel()
-	return ec.processHead(ctx, head)
+	return ec.processHead(logctx.WithChainID(ctx, ec.chainID.String()), head)
 }
 
 // NOTE: This SHOULD NOT be run concurrently or it could behave badly
@@ -500,7 +506,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 		allReceipts = append(allReceipts, receipts...)
 	}
 
-	observeUntilTxConfirmed(ec.chainID, attempts, allReceipts)
+	observeUntilTxConfirmed(ctx, ec.chainID, ec.inclusionSLA, attempts, allReceipts)
 
 	return nil
 }
@@ -816,9 +822,12 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) han
 		return fmt.Errorf("invariant violation: expected tx_attempt %v to be in_progress, it was %s", attempt.ID, attempt.State)
 	}
 
+	ctx = logctx.WithTxID(ctx, etx.ID)
 	now := time.Now()
 	lggr.Debugw("Sending transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
+	ctx, span := tracing.Start(etx.WithTraceContext(ctx), "txm.rebroadcast", tracing.TxHash.String(attempt.Hash.String()))
 	errType, sendError := ec.client.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+	tracing.End(span, sendError)
 
 	switch errType {
 	case client.Underpriced:
@@ -1120,8 +1129,8 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Res
 	return nil
 }
 
-// observeUntilTxConfirmed observes the promBlocksUntilTxConfirmed metric for each confirmed
-// transaction.
+// observeUntilTxConfirmed observes the promBlocksUntilTxConfirmed metric, records the
+// confirmation span, and the inclusion time of the job, for each confirmed transaction.
 func observeUntilTxConfirmed[
 	CHAIN_ID types.ID,
 	ADDR types.Hashable,
@@ -1129,7 +1138,7 @@ func observeUntilTxConfirmed[
 	R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH],
 	SEQ types.Sequence,
 	FEE feetypes.Fee,
-](chainID CHAIN_ID, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], receipts []R) {
+](ctx context.Context, chainID CHAIN_ID, sla *inclusionSLA, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], receipts []R) {
 	for _, attempt := range attempts {
 		for _, r := range receipts {
 			if attempt.Hash.String() != r.GetTxHash().String() {
@@ -1159,6 +1168,19 @@ func observeUntilTxConfirmed[
 					WithLabelValues(chainID.String()).
 					Observe(float64(blocksElapsed))
 			}
+
+			// The confirmation span covers the time from the first broadcast of the tx until its receipt was fetched.
+			broadcastAt := attempt.Tx.CreatedAt
+			if attempt.Tx.InitialBroadcastAt != nil {
+				broadcastAt = *attempt.Tx.InitialBroadcastAt
+			}
+			tracing.Record(logctx.WithTxID(attempt.Tx.WithTraceContext(ctx), attempt.Tx.ID), "txm.confirm", broadcastAt, nil,
+				tracing.TxHash.String(attempt.Hash.String()), attribute.Int64("blockNumber", r.GetBlockNumber().Int64()))
+
+			// Only transactions created by jobs count toward their inclusion SLA.
+			if meta, err := attempt.Tx.GetMeta(); err == nil && meta != nil && meta.JobID != nil && attempt.Tx.InitialBroadcastAt != nil {
+				sla.observe(*meta.JobID, time.Since(*attempt.Tx.InitialBroadcastAt))
+			}
 		}
 	}
 }
@@ -0,0 +1,138 @@
+package txmgr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+const (
+	// inclusionWindowSize is the number of the most recent inclusions of each job over which its p95 and burn rate
+	// are computed.
+	inclusionWindowSize = 100
+	// minInclusionSamples is the number of inclusions a job needs before its p95 is checked against the target, so
+	// that a single slow transaction does not degrade the health.
+	minInclusionSamples = 20
+	// inclusionErrorBudget is the fraction of the inclusions allowed to exceed the target, since the target is a p95.
+	inclusionErrorBudget = 0.05
+)
+
+var (
+	promJobInclusionTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tx_manager_job_inclusion_time_seconds",
+		Help:    "The time from the first broadcast of a transaction to its inclusion in a block, per job.",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"chainID", "jobID"})
+	promJobInclusionBurnRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_job_inclusion_slo_burn_rate",
+		Help: "The rate at which a job consumes the error budget of its inclusion SLO, over its most recent transactions. Above 1, more than 5% of them took longer than Transactions.InclusionTarget to be included.",
+	}, []string{"chainID", "jobID"})
+)
+
+// inclusionSLA tracks the time transactions take from their first broadcast to their inclusion in a block, per job,
+// against a p95 target.
+type inclusionSLA struct {
+	lggr    logger.SugaredLogger
+	chainID string
+	// target is the p95 inclusion time of each job, or 0 if there is none
+	target time.Duration
+
+	mu   sync.Mutex
+	jobs map[int32]*inclusionWindow
+}
+
+// inclusionWindow is a ring buffer of the most recent inclusion times of a job.
+type inclusionWindow struct {
+	durations []time.Duration
+	next      int
+}
+
+func newInclusionSLA(lggr logger.Logger, chainID string, target time.Duration) *inclusionSLA {
+	return &inclusionSLA{
+		lggr:    logger.Sugared(lggr),
+		chainID: chainID,
+		target:  target,
+		jobs:    map[int32]*inclusionWindow{},
+	}
+}
+
+// observe records that a transaction of the job was included duration after its first broadcast.
+func (s *inclusionSLA) observe(jobID int32, duration time.Duration) {
+	label := strconv.FormatInt(int64(jobID), 10)
+	promJobInclusionTime.WithLabelValues(s.chainID, label).Observe(duration.Seconds())
+	if s.target <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.jobs[jobID]
+	if !ok {
+		w = &inclusionWindow{}
+		s.jobs[jobID] = w
+	}
+	if len(w.durations) < inclusionWindowSize {
+		w.durations = append(w.durations, duration)
+	} else {
+		w.durations[w.next] = duration
+		w.next = (w.next + 1) % inclusionWindowSize
+	}
+	promJobInclusionBurnRate.WithLabelValues(s.chainID, label).Set(w.burnRate(s.target))
+	if duration > s.target {
+		s.lggr.Debugw("Transaction inclusion exceeded the target", "jobID", jobID, "duration", duration, "target", s.target)
+	}
+}
+
+// Healthy returns an error naming the jobs whose p95 inclusion time exceeds the target.
+func (s *inclusionSLA) Healthy() error {
+	if s.target <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var breaches []string
+	for jobID, w := range s.jobs {
+		if len(w.durations) < minInclusionSamples {
+			continue
+		}
+		if p95 := w.percentile(0.95); p95 > s.target {
+			breaches = append(breaches, fmt.Sprintf("job %d: %s", jobID, p95.Round(time.Millisecond)))
+		}
+	}
+	if len(breaches) == 0 {
+		return nil
+	}
+	sort.Strings(breaches)
+	return fmt.Errorf("p95 transaction inclusion time exceeds the target of %s (%s), the gas settings of these jobs may be too low", s.target, strings.Join(breaches, ", "))
+}
+
+func (w *inclusionWindow) percentile(p float64) time.Duration {
+	sorted := make([]time.Duration, len(w.durations))
+	copy(sorted, w.durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// burnRate returns the fraction of the inclusions exceeding the target, relative to the error budget.
+func (w *inclusionWindow) burnRate(target time.Duration) float64 {
+	var over int
+	for _, d := range w.durations {
+		if d > target {
+			over++
+		}
+	}
+	return float64(over) / float64(len(w.durations)) / inclusionErrorBudget
+}
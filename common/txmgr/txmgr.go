@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 	nullv4 "gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -18,6 +19,8 @@ import (
 
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	iutils "github.com/smartcontractkit/chainlink/v2/common/internal/utils"
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -472,6 +475,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Trigger(ad
 
 // CreateTransaction inserts a new transaction
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTransaction(ctx context.Context, txRequest txmgrtypes.TxRequest[ADDR, TX_HASH]) (tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	lggr := b.logger.With(logctx.Fields(ctx)...)
+	ctx, span := tracing.Start(ctx, "txm.create")
+	defer func() { tracing.End(span, err) }()
 	// Check for existing Tx with IdempotencyKey. If found, return the Tx and do nothing
 	// Skipping CreateTransaction to avoid double send
 	if txRequest.IdempotencyKey != nil {
@@ -481,7 +487,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 			return tx, fmt.Errorf("Failed to search for transaction with IdempotencyKey: %w", err)
 		}
 		if existingTx != nil {
-			b.logger.Infow("Found a Tx with IdempotencyKey. Returning existing Tx without creating a new one.", "IdempotencyKey", *txRequest.IdempotencyKey)
+			lggr.Infow("Found a Tx with IdempotencyKey. Returning existing Tx without creating a new one.", "IdempotencyKey", *txRequest.IdempotencyKey, logctx.TxID, existingTx.ID)
 			return *existingTx, nil
 		}
 	}
@@ -504,7 +510,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 			txRequest.ToAddress = txRequest.ForwarderAddress
 			txRequest.EncodedPayload = fwdPayload
 		} else {
-			b.logger.Errorf("Failed to use forwarder set upstream: %w", fwdErr.Error())
+			lggr.Errorf("Failed to use forwarder set upstream: %w", fwdErr.Error())
 		}
 	}
 
@@ -513,10 +519,19 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
 	}
 
+	if traceContext := tracing.Inject(ctx); traceContext != nil {
+		if txRequest.Meta == nil {
+			txRequest.Meta = &txmgrtypes.TxMeta[ADDR, TX_HASH]{}
+		}
+		txRequest.Meta.TraceContext = traceContext
+	}
+
 	tx, err = b.txStore.CreateTransaction(ctx, txRequest, b.chainID)
 	if err != nil {
 		return tx, err
 	}
+	lggr.Debugw("Created transaction", logctx.TxID, tx.ID)
+	span.SetAttributes(attribute.Int64(logctx.TxID, tx.ID))
 
 	// Trigger the Broadcaster to check for new transaction
 	b.broadcaster.Trigger(txRequest.FromAddress)
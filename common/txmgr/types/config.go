@@ -63,6 +63,7 @@ type ConfirmerDatabaseConfig interface {
 type ConfirmerTransactionsConfig interface {
 	MaxInFlight() uint32
 	ForwardersEnabled() bool
+	InclusionTarget() time.Duration
 }
 
 type ResenderChainConfig interface {
@@ -18,6 +18,8 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
 
@@ -154,6 +156,10 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
 	SeqNumbers []uint64 `json:"SeqNumbers,omitempty"`
+
+	// TraceContext is the trace context of the creator of the tx, if it was traced, so that the broadcast and
+	// confirmation of the tx join its trace.
+	TraceContext map[string]string `json:"TraceContext,omitempty"`
 }
 
 type TxAttempt[
@@ -253,10 +259,19 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetMeta() (*TxMeta[A
 	return &m, nil
 }
 
+// WithTraceContext returns a copy of ctx carrying the trace context of the meta, if any.
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) WithTraceContext(ctx context.Context) context.Context {
+	meta, err := e.GetMeta()
+	if err != nil || meta == nil {
+		return ctx
+	}
+	return tracing.Extract(ctx, meta.TraceContext)
+}
+
 // GetLogger returns a new logger with metadata fields.
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger.Logger) logger.SugaredLogger {
 	lgr = logger.With(lgr,
-		"txID", e.ID,
+		logctx.TxID, e.ID,
 		"sequence", e.Sequence,
 		"checker", e.TransmitChecker,
 		"feeLimit", e.FeeLimit,
@@ -269,7 +284,7 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger
 	}
 
 	if meta != nil {
-		lgr = logger.With(lgr, "jobID", meta.JobID)
+		lgr = logger.With(lgr, logctx.JobID, meta.JobID)
 
 		if meta.RequestTxHash != nil {
 			lgr = logger.With(lgr, "requestTxHash", *meta.RequestTxHash)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 )
@@ -16,6 +17,32 @@ type HeadTracker[H Head[BLOCK_HASH], BLOCK_HASH Hashable] interface {
 	// (used for testing)
 	Backfill(ctx context.Context, headWithChain H, depth uint) (err error)
 	LatestChain() H
+	// LatestFinalizedBlock returns the number of the latest finalized block, as determined by the chain's
+	// FinalityProvider, or -1 if it is not known yet.
+	LatestFinalizedBlock() int64
+	// RecentReorgs returns up to limit of the most recently observed re-orgs, newest first.
+	RecentReorgs(limit int) []Reorg[BLOCK_HASH]
+}
+
+// FinalityProvider determines which block of a chain is finalized. The default implementations either use a fixed
+// depth below the latest head, or the chain's `finalized` block tag. Chains with their own finality rules (e.g. L2s
+// which finalize once a batch is confirmed on L1) can provide their own.
+type FinalityProvider[H Head[BLOCK_HASH], BLOCK_HASH Hashable] interface {
+	// LatestFinalizedBlock returns the number of the latest finalized block, given the latest head with as much of its
+	// chain as is currently known.
+	LatestFinalizedBlock(ctx context.Context, latest H) (int64, error)
+}
+
+// Reorg describes a re-org observed by the HeadTracker, i.e. a new longest chain that does not contain the previous one.
+type Reorg[BLOCK_HASH Hashable] struct {
+	// BlockNumber is the number of the new head which caused the re-org
+	BlockNumber int64
+	// Depth is the number of blocks of the previous chain that were replaced. If the common ancestor of both chains
+	// is not known, it is a lower bound.
+	Depth    int64
+	OldHead  BLOCK_HASH
+	NewHead  BLOCK_HASH
+	Detected time.Time
 }
 
 // HeadTrackable is implemented by the core txm,
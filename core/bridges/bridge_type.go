@@ -10,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -17,10 +19,11 @@ import (
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
 type BridgeTypeRequest struct {
-	Name                   BridgeName    `json:"name"`
-	URL                    models.WebURL `json:"url"`
-	Confirmations          uint32        `json:"confirmations"`
-	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	Name                   BridgeName     `json:"name"`
+	URL                    models.WebURL  `json:"url"`
+	Confirmations          uint32         `json:"confirmations"`
+	MinimumContractPayment *assets.Link   `json:"minimumContractPayment"`
+	ResponseSchema         ResponseSchema `json:"responseSchema,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -60,8 +63,11 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	ResponseSchema         ResponseSchema
+	// Tenant is the tenant which owns the bridge, or null if it is node-wide.
+	Tenant    null.String
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -78,21 +84,22 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	}
 
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, nil
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingToken:          incomingToken,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+	}, &BridgeType{
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingTokenHash:      hash,
+		Salt:                   salt,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		ResponseSchema:         btr.ResponseSchema,
+	}, nil
 }
 
 // AuthenticateBridgeType returns true if the passed token matches its
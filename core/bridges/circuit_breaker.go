@@ -0,0 +1,239 @@
+package bridges
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ErrCircuitOpen is returned instead of sending a request to a bridge whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("bridge circuit breaker is open")
+
+var (
+	promBridgeCircuitState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_circuit_breaker_state",
+		Help: "State of the circuit breaker of the bridge, scoped by name: 0 closed, 1 half open, 2 open",
+	},
+		[]string{"name"},
+	)
+	promBridgeCircuitTrips = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_circuit_breaker_trips_total",
+		Help: "Number of times the circuit breaker of the bridge opened, scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeCircuitRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_circuit_breaker_rejections_total",
+		Help: "Requests to the bridge rejected by its open circuit breaker, scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeHealthScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_health_score",
+		Help: "Exponentially weighted success rate of the requests to the bridge, from 0 to 1, scoped by name",
+	},
+		[]string{"name"},
+	)
+)
+
+// CircuitState is the state of the circuit breaker of a bridge.
+type CircuitState string
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects all requests, until the open timeout elapses.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single probe request through, which closes the breaker if it succeeds.
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// healthScoreWeight is the weight of the latest request in the health score of a bridge.
+const healthScoreWeight = 0.1
+
+// Health is a snapshot of the health of a bridge.
+type Health struct {
+	State CircuitState
+	// Score is the exponentially weighted success rate of the requests to the bridge, from 0 to 1.
+	Score               float64
+	ConsecutiveFailures uint32
+	// OpenedAt is when the breaker last opened, and RecoversAt when it lets a probe request through. Both are zero
+	// while the breaker is closed.
+	OpenedAt   time.Time
+	RecoversAt time.Time
+	// RecoveredAt is when the breaker last closed after being open, or zero if it never opened.
+	RecoveredAt time.Time
+}
+
+// Healthy returns true if requests are sent to the bridge.
+func (h Health) Healthy() bool {
+	return h.State == CircuitClosed
+}
+
+type circuitBreaker struct {
+	state       CircuitState
+	score       float64
+	failures    uint32
+	probing     bool
+	openedAt    time.Time
+	recoveredAt time.Time
+}
+
+// CircuitBreakers tracks the health of the bridges, and fails requests to the bridges which are failing or too slow
+// fast. Each bridge has its own breaker, which opens after FailureThreshold consecutive failures, lets a probe
+// request through after OpenTimeout, and closes again once a probe succeeds. Health is scored even if the breakers
+// are disabled.
+type CircuitBreakers struct {
+	cfg  config.BridgeCircuitBreaker
+	lggr logger.Logger
+	now  func() time.Time
+
+	mu       sync.Mutex
+	breakers map[BridgeName]*circuitBreaker
+}
+
+// NewCircuitBreakers returns the circuit breakers of the bridges.
+func NewCircuitBreakers(cfg config.BridgeCircuitBreaker, lggr logger.Logger) *CircuitBreakers {
+	return &CircuitBreakers{
+		cfg:      cfg,
+		lggr:     lggr.Named("BridgeCircuitBreakers"),
+		now:      time.Now,
+		breakers: make(map[BridgeName]*circuitBreaker),
+	}
+}
+
+func (c *CircuitBreakers) breaker(name BridgeName) *circuitBreaker {
+	b, ok := c.breakers[name]
+	if !ok {
+		b = &circuitBreaker{state: CircuitClosed, score: 1}
+		c.breakers[name] = b
+	}
+	return b
+}
+
+// Allow returns an error wrapping ErrCircuitOpen if no request may be sent to the bridge. Otherwise, the outcome of
+// the request must be passed to Record.
+func (c *CircuitBreakers) Allow(name BridgeName) error {
+	if !c.cfg.Enabled() {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b := c.breaker(name)
+	switch b.state {
+	case CircuitOpen:
+		recoversAt := b.openedAt.Add(c.cfg.OpenTimeout())
+		if c.now().Before(recoversAt) {
+			promBridgeCircuitRejections.WithLabelValues(name.String()).Inc()
+			return fmt.Errorf("%w: bridge %s is unhealthy until %s", ErrCircuitOpen, name, recoversAt.Format(time.RFC3339))
+		}
+		c.setState(name, b, CircuitHalfOpen)
+		b.probing = true
+	case CircuitHalfOpen:
+		if b.probing {
+			promBridgeCircuitRejections.WithLabelValues(name.String()).Inc()
+			return fmt.Errorf("%w: bridge %s is being probed", ErrCircuitOpen, name)
+		}
+		b.probing = true
+	case CircuitClosed:
+	}
+	return nil
+}
+
+// Record records the outcome of a request to the bridge. Requests slower than the latency SLO count as failed.
+func (c *CircuitBreakers) Record(name BridgeName, elapsed time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b := c.breaker(name)
+	failed := err != nil || (c.cfg.LatencySLO() > 0 && elapsed > c.cfg.LatencySLO())
+	outcome := 1.0
+	if failed {
+		outcome = 0
+	}
+	b.score = (1-healthScoreWeight)*b.score + healthScoreWeight*outcome
+	promBridgeHealthScore.WithLabelValues(name.String()).Set(b.score)
+
+	if !failed {
+		b.failures = 0
+		if b.state == CircuitHalfOpen {
+			b.probing = false
+			b.recoveredAt = c.now()
+			c.setState(name, b, CircuitClosed)
+			c.lggr.Infow("Bridge recovered, circuit breaker closed", "bridge", name)
+		}
+		return
+	}
+
+	b.failures++
+	if !c.cfg.Enabled() {
+		return
+	}
+	switch b.state {
+	case CircuitHalfOpen:
+		b.probing = false
+		c.open(name, b, err, elapsed)
+	case CircuitClosed:
+		if b.failures >= c.cfg.FailureThreshold() {
+			c.open(name, b, err, elapsed)
+		}
+	case CircuitOpen:
+		// A request sent before the breaker opened.
+	}
+}
+
+func (c *CircuitBreakers) open(name BridgeName, b *circuitBreaker, err error, elapsed time.Duration) {
+	b.openedAt = c.now()
+	c.setState(name, b, CircuitOpen)
+	promBridgeCircuitTrips.WithLabelValues(name.String()).Inc()
+	c.lggr.Warnw("Bridge is unhealthy, circuit breaker opened",
+		"bridge", name,
+		"consecutiveFailures", b.failures,
+		"err", err,
+		"elapsed", elapsed,
+		"recoversAt", b.openedAt.Add(c.cfg.OpenTimeout()),
+	)
+}
+
+func (c *CircuitBreakers) setState(name BridgeName, b *circuitBreaker, state CircuitState) {
+	b.state = state
+	var v float64
+	switch state {
+	case CircuitHalfOpen:
+		v = 1
+	case CircuitOpen:
+		v = 2
+	case CircuitClosed:
+	}
+	promBridgeCircuitState.WithLabelValues(name.String()).Set(v)
+}
+
+// Health returns the health of the bridge. Bridges which were never requested are healthy.
+func (c *CircuitBreakers) Health(name BridgeName) Health {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.breakers[name]
+	if !ok {
+		return Health{State: CircuitClosed, Score: 1}
+	}
+	h := Health{
+		State:               b.state,
+		Score:               b.score,
+		ConsecutiveFailures: b.failures,
+		RecoveredAt:         b.recoveredAt,
+	}
+	if b.state != CircuitClosed {
+		h.OpenedAt = b.openedAt
+		h.RecoversAt = b.openedAt.Add(c.cfg.OpenTimeout())
+	}
+	return h
+}
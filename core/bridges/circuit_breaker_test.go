@@ -0,0 +1,118 @@
+package bridges_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+func newCircuitBreakers(t *testing.T, enabled bool, latencySLO time.Duration) (*bridges.CircuitBreakers, *time.Time) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.BridgeCircuitBreaker.Enabled = &enabled
+		threshold := uint32(3)
+		c.JobPipeline.BridgeCircuitBreaker.FailureThreshold = &threshold
+		c.JobPipeline.BridgeCircuitBreaker.LatencySLO = commonconfig.MustNewDuration(latencySLO)
+		c.JobPipeline.BridgeCircuitBreaker.OpenTimeout = commonconfig.MustNewDuration(time.Minute)
+	})
+	breakers := bridges.NewCircuitBreakers(cfg.JobPipeline().BridgeCircuitBreaker(), logger.TestLogger(t))
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	breakers.SetNow(func() time.Time { return now })
+	return breakers, &now
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("adapter")
+	errBridge := errors.New("bridge is down")
+	breakers, now := newCircuitBreakers(t, true, 0)
+	start := *now
+
+	assert.Equal(t, bridges.Health{State: bridges.CircuitClosed, Score: 1}, breakers.Health(name))
+
+	// Successes reset the consecutive failures.
+	for _, err := range []error{errBridge, errBridge, nil, errBridge, errBridge} {
+		require.NoError(t, breakers.Allow(name))
+		breakers.Record(name, time.Second, err)
+	}
+	h := breakers.Health(name)
+	assert.True(t, h.Healthy())
+	assert.Equal(t, uint32(2), h.ConsecutiveFailures)
+
+	require.NoError(t, breakers.Allow(name))
+	breakers.Record(name, time.Second, errBridge)
+	h = breakers.Health(name)
+	assert.False(t, h.Healthy())
+	assert.Equal(t, bridges.CircuitOpen, h.State)
+	assert.Equal(t, start, h.OpenedAt)
+	assert.Equal(t, start.Add(time.Minute), h.RecoversAt)
+	assert.InDelta(t, 0.9*0.9*0.9*0.9*0.9*0.9+0.1*0.9*0.9*0.9, h.Score, 1e-9)
+
+	err := breakers.Allow(name)
+	require.ErrorIs(t, err, bridges.ErrCircuitOpen)
+	assert.EqualError(t, err, "bridge circuit breaker is open: bridge adapter is unhealthy until 2021-01-01T00:01:00Z")
+
+	// A failed probe opens the breaker again.
+	*now = start.Add(time.Minute)
+	require.NoError(t, breakers.Allow(name))
+	assert.Equal(t, bridges.CircuitHalfOpen, breakers.Health(name).State)
+	require.ErrorIs(t, breakers.Allow(name), bridges.ErrCircuitOpen)
+	breakers.Record(name, time.Second, errBridge)
+	h = breakers.Health(name)
+	assert.Equal(t, bridges.CircuitOpen, h.State)
+	assert.Equal(t, start.Add(time.Minute), h.OpenedAt)
+
+	// A successful probe closes it.
+	*now = start.Add(2 * time.Minute)
+	require.NoError(t, breakers.Allow(name))
+	breakers.Record(name, time.Second, nil)
+	h = breakers.Health(name)
+	assert.True(t, h.Healthy())
+	assert.Zero(t, h.ConsecutiveFailures)
+	assert.Zero(t, h.OpenedAt)
+	assert.Zero(t, h.RecoversAt)
+	assert.Equal(t, start.Add(2*time.Minute), h.RecoveredAt)
+	require.NoError(t, breakers.Allow(name))
+
+	// Other bridges are unaffected.
+	assert.Equal(t, bridges.Health{State: bridges.CircuitClosed, Score: 1}, breakers.Health("other"))
+}
+
+func TestCircuitBreakers_LatencySLO(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("adapter")
+	breakers, _ := newCircuitBreakers(t, true, time.Second)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, breakers.Allow(name))
+		breakers.Record(name, 2*time.Second, nil)
+	}
+	assert.Equal(t, bridges.CircuitOpen, breakers.Health(name).State)
+	require.ErrorIs(t, breakers.Allow(name), bridges.ErrCircuitOpen)
+}
+
+func TestCircuitBreakers_Disabled(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("adapter")
+	breakers, _ := newCircuitBreakers(t, false, 0)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, breakers.Allow(name))
+		breakers.Record(name, time.Second, errors.New("bridge is down"))
+	}
+	h := breakers.Health(name)
+	assert.True(t, h.Healthy())
+	assert.Equal(t, uint32(10), h.ConsecutiveFailures)
+	assert.Less(t, h.Score, 0.5)
+}
@@ -16,6 +16,8 @@ import (
 type ExternalInitiatorRequest struct {
 	Name string         `json:"name"`
 	URL  *models.WebURL `json:"url,omitempty"`
+	// GRPC makes the external initiator connect to the node over gRPC instead of being notified at its URL.
+	GRPC bool `json:"grpc,omitempty"`
 }
 
 // ExternalInitiator represents a user that can initiate runs remotely
@@ -28,6 +30,7 @@ type ExternalInitiator struct {
 	HashedSecret   string
 	OutgoingSecret string
 	OutgoingToken  string
+	GRPC           bool
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -48,6 +51,7 @@ func NewExternalInitiator(
 	return &ExternalInitiator{
 		Name:           strings.ToLower(eir.Name),
 		URL:            eir.URL,
+		GRPC:           eir.GRPC,
 		AccessKey:      eia.AccessKey,
 		HashedSecret:   hashedSecret,
 		Salt:           salt,
@@ -0,0 +1,8 @@
+package bridges
+
+import "time"
+
+// SetNow replaces the clock of the circuit breakers.
+func (c *CircuitBreakers) SetNow(now func() time.Time) {
+	c.now = now
+}
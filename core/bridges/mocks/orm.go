@@ -308,6 +308,43 @@ func (_m *ORM) GetCachedResponse(dotId string, specId int32, maxElapsed time.Dur
 	return r0, r1
 }
 
+// TenantBridgeTypes provides a mock function with given fields: tenant, offset, limit
+func (_m *ORM) TenantBridgeTypes(tenant string, offset int, limit int) ([]bridges.BridgeType, int, error) {
+	ret := _m.Called(tenant, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantBridgeTypes")
+	}
+
+	var r0 []bridges.BridgeType
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]bridges.BridgeType, int, error)); ok {
+		return rf(tenant, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []bridges.BridgeType); ok {
+		r0 = rf(tenant, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridges.BridgeType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(tenant, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(tenant, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // UpdateBridgeType provides a mock function with given fields: bt, btr
 func (_m *ORM) UpdateBridgeType(bt *bridges.BridgeType, btr *bridges.BridgeTypeRequest) error {
 	ret := _m.Called(bt, btr)
@@ -21,6 +21,7 @@ type ORM interface {
 	FindBridges(name []BridgeName) (bts []BridgeType, err error)
 	DeleteBridgeType(bt *BridgeType) error
 	BridgeTypes(offset int, limit int) ([]BridgeType, int, error)
+	TenantBridgeTypes(tenant string, offset int, limit int) ([]BridgeType, int, error)
 	CreateBridgeType(bt *BridgeType) error
 	UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error
 
@@ -126,12 +127,22 @@ func (o *orm) DeleteBridgeType(bt *BridgeType) error {
 // BridgeTypes returns bridge types ordered by name filtered limited by the
 // passed params.
 func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count int, err error) {
+	return o.bridgeTypes("", offset, limit)
+}
+
+// TenantBridgeTypes is like BridgeTypes, for the bridges of a tenant.
+func (o *orm) TenantBridgeTypes(tenant string, offset int, limit int) (bridges []BridgeType, count int, err error) {
+	return o.bridgeTypes(tenant, offset, limit)
+}
+
+// bridgeTypes returns the bridge types of tenant, or all bridge types if tenant is empty.
+func (o *orm) bridgeTypes(tenant string, offset int, limit int) (bridges []BridgeType, count int, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		if err = tx.Get(&count, "SELECT COUNT(*) FROM bridge_types"); err != nil {
+		if err = tx.Get(&count, "SELECT COUNT(*) FROM bridge_types WHERE $1 = '' OR tenant = $1", tenant); err != nil {
 			return errors.Wrap(err, "BridgeTypes failed to get count")
 		}
-		sql := `SELECT * FROM bridge_types ORDER BY name asc LIMIT $1 OFFSET $2;`
-		if err = tx.Select(&bridges, sql, limit, offset); err != nil {
+		sql := `SELECT * FROM bridge_types WHERE $1 = '' OR tenant = $1 ORDER BY name asc LIMIT $2 OFFSET $3;`
+		if err = tx.Select(&bridges, sql, tenant, limit, offset); err != nil {
 			return errors.Wrap(err, "BridgeTypes failed to load bridge_types")
 		}
 		return nil
@@ -142,8 +153,8 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, response_schema, tenant, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :response_schema, :tenant, now(), now())
 	RETURNING *;`
 	err := o.q.Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
@@ -160,10 +171,15 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 	return errors.Wrap(err, "CreateBridgeType failed")
 }
 
-// UpdateBridgeType updates the bridge type.
+// UpdateBridgeType updates the bridge type. The response schema of the bridge
+// is kept if the request has none, and removed if the request has an empty one.
 func (o *orm) UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.q.Get(bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	responseSchema := btr.ResponseSchema
+	if responseSchema == nil {
+		responseSchema = bt.ResponseSchema
+	}
+	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, response_schema = $4 WHERE name = $5 RETURNING *"
+	err := o.q.Get(bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, responseSchema, bt.Name)
 	if err == nil {
 		o.bridgeTypesCache.Store(bt.Name, *bt)
 	}
@@ -213,8 +229,8 @@ func (o *orm) ExternalInitiators(offset int, limit int) (exis []ExternalInitiato
 
 // CreateExternalInitiator inserts a new external initiator
 func (o *orm) CreateExternalInitiator(externalInitiator *ExternalInitiator) (err error) {
-	query := `INSERT INTO external_initiators (name, url, access_key, salt, hashed_secret, outgoing_secret, outgoing_token, created_at, updated_at)
-	VALUES (:name, :url, :access_key, :salt, :hashed_secret, :outgoing_secret, :outgoing_token, now(), now())
+	query := `INSERT INTO external_initiators (name, url, access_key, salt, hashed_secret, outgoing_secret, outgoing_token, grpc, created_at, updated_at)
+	VALUES (:name, :url, :access_key, :salt, :hashed_secret, :outgoing_secret, :outgoing_token, :grpc, now(), now())
 	RETURNING *
 	`
 	err = o.q.Transaction(func(tx pg.Queryer) error {
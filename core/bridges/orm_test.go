@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/jmoiron/sqlx"
 
@@ -68,6 +69,36 @@ func TestORM_FindBridges(t *testing.T) {
 	require.Error(t, err, bts)
 }
 
+func TestORM_TenantBridgeTypes(t *testing.T) {
+	t.Parallel()
+	db, orm := setupORM(t)
+
+	_, err := db.Exec(`INSERT INTO tenants (name, created_at) VALUES ('team-a', now())`)
+	require.NoError(t, err)
+
+	bt := bridges.BridgeType{
+		Name:   "bridge1",
+		URL:    cltest.WebURL(t, "https://bridge1.com"),
+		Tenant: null.StringFrom("team-a"),
+	}
+	require.NoError(t, orm.CreateBridgeType(&bt))
+	bt2 := bridges.BridgeType{
+		Name: "bridge2",
+		URL:  cltest.WebURL(t, "https://bridge2.com"),
+	}
+	require.NoError(t, orm.CreateBridgeType(&bt2))
+
+	bts, count, err := orm.TenantBridgeTypes("team-a", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, bts, 1)
+	assert.Equal(t, null.StringFrom("team-a"), bts[0].Tenant)
+
+	_, count, err = orm.BridgeTypes(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
 func TestORM_FindBridge(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +167,31 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.Len(t, bs, 0)
 }
 
+func TestORM_UpdateBridgeType_ResponseSchema(t *testing.T) {
+	_, orm := setupORM(t)
+
+	schema := bridges.ResponseSchema{{Path: "data.result", Type: bridges.ResponseFieldDecimal}}
+	bridge := &bridges.BridgeType{
+		Name:           "schema",
+		URL:            cltest.WebURL(t, "http:/oneurl.com"),
+		ResponseSchema: schema,
+	}
+	require.NoError(t, orm.CreateBridgeType(bridge))
+	assert.Equal(t, schema, bridge.ResponseSchema)
+
+	// The schema is kept by updates without one.
+	require.NoError(t, orm.UpdateBridgeType(bridge, &bridges.BridgeTypeRequest{URL: cltest.WebURL(t, "http:/updatedurl.com")}))
+	assert.Equal(t, schema, bridge.ResponseSchema)
+
+	schema = bridges.ResponseSchema{{Path: "data.ok", Type: bridges.ResponseFieldBool, Optional: true}}
+	require.NoError(t, orm.UpdateBridgeType(bridge, &bridges.BridgeTypeRequest{URL: bridge.URL, ResponseSchema: schema}))
+	assert.Equal(t, schema, bridge.ResponseSchema)
+
+	// An empty schema removes it.
+	require.NoError(t, orm.UpdateBridgeType(bridge, &bridges.BridgeTypeRequest{URL: bridge.URL, ResponseSchema: bridges.ResponseSchema{}}))
+	assert.Empty(t, bridge.ResponseSchema)
+}
+
 func TestORM_TestCachedResponse(t *testing.T) {
 	cfg := configtest.NewGeneralConfig(t, nil)
 	db := pgtest.NewSqlxDB(t)
@@ -0,0 +1,255 @@
+package bridges
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+)
+
+// ErrResponseSchema is returned when a bridge response does not match the response schema of the bridge, which
+// usually means that the adapter changed the shape of its responses.
+var ErrResponseSchema = errors.New("bridge response does not match its schema")
+
+// ResponseFieldType is the type a field of a bridge response is validated and coerced against.
+type ResponseFieldType string
+
+const (
+	// ResponseFieldString accepts strings, and coerces numbers and booleans to strings.
+	ResponseFieldString ResponseFieldType = "string"
+	// ResponseFieldDecimal accepts numbers, and coerces strings holding a decimal number to numbers.
+	ResponseFieldDecimal ResponseFieldType = "decimal"
+	// ResponseFieldInt accepts integral numbers, and coerces strings holding an integer to numbers.
+	ResponseFieldInt ResponseFieldType = "int"
+	// ResponseFieldBool accepts booleans, and coerces the strings "true" and "false" to booleans.
+	ResponseFieldBool ResponseFieldType = "bool"
+	// ResponseFieldObject accepts objects.
+	ResponseFieldObject ResponseFieldType = "object"
+	// ResponseFieldArray accepts arrays.
+	ResponseFieldArray ResponseFieldType = "array"
+)
+
+// ResponseField is a field of the JSON responses of a bridge. Path is the dot separated keypath of the field, where
+// numeric segments index arrays, e.g. "data.prices.0".
+type ResponseField struct {
+	Path     string            `json:"path"`
+	Type     ResponseFieldType `json:"type"`
+	Optional bool              `json:"optional,omitempty"`
+}
+
+// ResponseSchema lists the fields the responses of a bridge must have. The responses of bridges without a schema are
+// not validated.
+type ResponseSchema []ResponseField
+
+// Validate checks that the fields of the schema have valid, unique paths and known types.
+func (s ResponseSchema) Validate() (err error) {
+	paths := make(map[string]struct{}, len(s))
+	for i, f := range s {
+		if f.Path == "" || strings.Contains("."+f.Path+".", "..") {
+			err = multierr.Append(err, errors.Errorf("field %d: invalid path %q", i, f.Path))
+		} else if _, ok := paths[f.Path]; ok {
+			err = multierr.Append(err, errors.Errorf("field %d: duplicate path %q", i, f.Path))
+		}
+		paths[f.Path] = struct{}{}
+		switch f.Type {
+		case ResponseFieldString, ResponseFieldDecimal, ResponseFieldInt, ResponseFieldBool, ResponseFieldObject, ResponseFieldArray:
+		default:
+			err = multierr.Append(err, errors.Errorf("field %d: unknown type %q", i, f.Type))
+		}
+	}
+	return err
+}
+
+// Apply validates the response against the schema, and returns it with its fields coerced to their types. The error
+// wraps ErrResponseSchema if the response does not match the schema.
+func (s ResponseSchema) Apply(response []byte) ([]byte, error) {
+	if len(s) == 0 {
+		return response, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(response))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON: %v", ErrResponseSchema, err)
+	}
+
+	var merr error
+	for _, f := range s {
+		merr = multierr.Append(merr, f.apply(doc))
+	}
+	if merr != nil {
+		return nil, fmt.Errorf("%w: %v", ErrResponseSchema, merr)
+	}
+	return json.Marshal(doc)
+}
+
+func (f ResponseField) apply(doc interface{}) error {
+	segments := strings.Split(f.Path, ".")
+	parent := doc
+	for _, segment := range segments[:len(segments)-1] {
+		v, ok := child(parent, segment)
+		if !ok || v == nil {
+			return f.missing()
+		}
+		parent = v
+	}
+	key := segments[len(segments)-1]
+	v, ok := child(parent, key)
+	if !ok || v == nil {
+		return f.missing()
+	}
+	coerced, err := f.Type.coerce(v)
+	if err != nil {
+		return errors.Wrapf(err, "field %s", f.Path)
+	}
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[key] = coerced
+	case []interface{}:
+		i, _ := strconv.Atoi(key)
+		p[i] = coerced
+	}
+	return nil
+}
+
+func (f ResponseField) missing() error {
+	if f.Optional {
+		return nil
+	}
+	return errors.Errorf("field %s: missing", f.Path)
+}
+
+func child(v interface{}, segment string) (interface{}, bool) {
+	switch c := v.(type) {
+	case map[string]interface{}:
+		value, ok := c[segment]
+		return value, ok
+	case []interface{}:
+		i, err := strconv.Atoi(segment)
+		if err != nil || i < 0 || i >= len(c) {
+			return nil, false
+		}
+		return c[i], true
+	default:
+		return nil, false
+	}
+}
+
+func (t ResponseFieldType) coerce(v interface{}) (interface{}, error) {
+	switch t {
+	case ResponseFieldString:
+		switch value := v.(type) {
+		case string:
+			return value, nil
+		case json.Number:
+			return value.String(), nil
+		case bool:
+			return strconv.FormatBool(value), nil
+		}
+	case ResponseFieldDecimal:
+		switch value := v.(type) {
+		case json.Number:
+			return value, nil
+		case string:
+			d, err := decimal.NewFromString(strings.TrimSpace(value))
+			if err != nil {
+				return nil, errors.Errorf("expected decimal, got string %q", value)
+			}
+			return json.Number(d.String()), nil
+		}
+	case ResponseFieldInt:
+		switch value := v.(type) {
+		case json.Number:
+			if n, ok := parseInt(value.String()); ok {
+				return json.Number(n.String()), nil
+			}
+			return nil, errors.Errorf("expected int, got number %s", value)
+		case string:
+			if n, ok := parseInt(strings.TrimSpace(value)); ok {
+				return json.Number(n.String()), nil
+			}
+			return nil, errors.Errorf("expected int, got string %q", value)
+		}
+	case ResponseFieldBool:
+		switch value := v.(type) {
+		case bool:
+			return value, nil
+		case string:
+			switch value {
+			case "true":
+				return true, nil
+			case "false":
+				return false, nil
+			}
+			return nil, errors.Errorf("expected bool, got string %q", value)
+		}
+	case ResponseFieldObject:
+		if _, ok := v.(map[string]interface{}); ok {
+			return v, nil
+		}
+	case ResponseFieldArray:
+		if _, ok := v.([]interface{}); ok {
+			return v, nil
+		}
+	}
+	return nil, errors.Errorf("expected %s, got %s", t, jsonType(v))
+}
+
+// parseInt parses integers, including those written with an exponent or a zero fraction, e.g. 1e3 or 2.0.
+func parseInt(s string) (*big.Int, bool) {
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		return n, true
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil || !d.Equal(d.Truncate(0)) {
+		return nil, false
+	}
+	return d.BigInt(), true
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	default:
+		return "null"
+	}
+}
+
+// Value returns this instance serialized for database storage.
+func (s ResponseSchema) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+// Scan reads the database value and returns an instance.
+func (s *ResponseSchema) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to ResponseSchema", value, value)
+	}
+}
@@ -0,0 +1,127 @@
+package bridges_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+func TestResponseSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, bridges.ResponseSchema(nil).Validate())
+	require.NoError(t, bridges.ResponseSchema{
+		{Path: "data.result", Type: bridges.ResponseFieldDecimal},
+		{Path: "data.prices.0", Type: bridges.ResponseFieldInt, Optional: true},
+	}.Validate())
+
+	err := bridges.ResponseSchema{
+		{Path: "", Type: bridges.ResponseFieldString},
+		{Path: "data..result", Type: bridges.ResponseFieldString},
+		{Path: "data.", Type: bridges.ResponseFieldString},
+		{Path: "data", Type: "float"},
+		{Path: "data", Type: bridges.ResponseFieldObject},
+	}.Validate()
+	assert.EqualError(t, err, `field 0: invalid path ""; field 1: invalid path "data..result"; field 2: invalid path "data."; field 3: unknown type "float"; field 4: duplicate path "data"`)
+}
+
+func TestResponseSchema_Apply(t *testing.T) {
+	t.Parallel()
+
+	schema := bridges.ResponseSchema{
+		{Path: "data.result", Type: bridges.ResponseFieldDecimal},
+		{Path: "data.timestamp", Type: bridges.ResponseFieldInt},
+		{Path: "data.symbol", Type: bridges.ResponseFieldString},
+		{Path: "data.open", Type: bridges.ResponseFieldBool},
+		{Path: "data.prices", Type: bridges.ResponseFieldArray},
+		{Path: "data.prices.1", Type: bridges.ResponseFieldDecimal},
+		{Path: "meta", Type: bridges.ResponseFieldObject, Optional: true},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		response string
+		exp      string
+		err      string
+	}{
+		{
+			name:     "typed",
+			response: `{"data":{"result":9700.5,"timestamp":1700000000,"symbol":"BTC","open":true,"prices":[1,2.5]},"meta":{}}`,
+			exp:      `{"data":{"result":9700.5,"timestamp":1700000000,"symbol":"BTC","open":true,"prices":[1,2.5]},"meta":{}}`,
+		},
+		{
+			name:     "coerced",
+			response: `{"data":{"result":"9700.50","timestamp":"1.7e9","symbol":42,"open":"false","prices":[1,"2.5"],"extra":"kept"}}`,
+			exp:      `{"data":{"result":9700.5,"timestamp":1700000000,"symbol":"42","open":false,"prices":[1,2.5],"extra":"kept"}}`,
+		},
+		{
+			name:     "large numbers",
+			response: `{"data":{"result":"123456789012345678901234567890.123456789","timestamp":123456789012345678901234567890,"symbol":"BTC","open":true,"prices":[0,1]}}`,
+			exp:      `{"data":{"result":123456789012345678901234567890.123456789,"timestamp":123456789012345678901234567890,"symbol":"BTC","open":true,"prices":[0,1]}}`,
+		},
+		{
+			name:     "missing",
+			response: `{"data":{"result":1,"timestamp":1,"symbol":"BTC","open":true,"prices":[1]}}`,
+			err:      "bridge response does not match its schema: field data.prices.1: missing",
+		},
+		{
+			name:     "changed shape",
+			response: `{"data":{"price":{"result":1},"timestamp":1.5,"symbol":{},"open":"yes","prices":{},"result":null},"meta":[]}`,
+			err: "bridge response does not match its schema: field data.result: missing; field data.timestamp: expected int, got number 1.5; " +
+				`field data.symbol: expected string, got object; field data.open: expected bool, got string "yes"; ` +
+				"field data.prices: expected array, got object; field data.prices.1: missing; field meta: expected object, got array",
+		},
+		{
+			name:     "invalid JSON",
+			response: `{"data":`,
+			err:      "bridge response does not match its schema: invalid JSON: unexpected EOF",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			coerced, err := schema.Apply([]byte(tc.response))
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.True(t, errors.Is(err, bridges.ErrResponseSchema))
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.exp, string(coerced))
+		})
+	}
+
+	t.Run("no schema", func(t *testing.T) {
+		response := []byte(`not JSON`)
+		coerced, err := bridges.ResponseSchema(nil).Apply(response)
+		require.NoError(t, err)
+		assert.Equal(t, response, coerced)
+	})
+}
+
+func TestResponseSchema_JSON(t *testing.T) {
+	t.Parallel()
+
+	var btr bridges.BridgeTypeRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"adapter","url":"http://example.com","responseSchema":[{"path":"data.result","type":"decimal"},{"path":"data.ok","type":"bool","optional":true}]}`), &btr))
+	assert.Equal(t, bridges.ResponseSchema{
+		{Path: "data.result", Type: bridges.ResponseFieldDecimal},
+		{Path: "data.ok", Type: bridges.ResponseFieldBool, Optional: true},
+	}, btr.ResponseSchema)
+
+	var schema bridges.ResponseSchema
+	require.NoError(t, schema.Scan([]byte(`[{"path":"data","type":"object"}]`)))
+	assert.Equal(t, bridges.ResponseSchema{{Path: "data", Type: bridges.ResponseFieldObject}}, schema)
+	require.NoError(t, schema.Scan(nil))
+	assert.Nil(t, schema)
+
+	v, err := bridges.ResponseSchema{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
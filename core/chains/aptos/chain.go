@@ -0,0 +1,155 @@
+package aptos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+
+	gotoml "github.com/pelletier/go-toml/v2"
+
+	common "github.com/smartcontractkit/chainlink-common/pkg/chains"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Chain is the embedded Aptos chain. It only reads the state of accounts, while transactions and products are
+// served by the Aptos LOOPP.
+type Chain struct {
+	services.StateMachine
+	id      string
+	cfg     *TOMLConfig
+	lggr    logger.Logger
+	clients []*Client
+	nonces  *Nonces
+}
+
+var _ loop.RelayerExt = (*Chain)(nil)
+
+func NewChain(cfg *TOMLConfig, lggr logger.Logger) (*Chain, error) {
+	if !cfg.IsEnabled() {
+		return nil, fmt.Errorf("cannot create new chain with ID %s: %w", *cfg.ChainID, chains.ErrChainDisabled)
+	}
+	c := &Chain{
+		id:   *cfg.ChainID,
+		cfg:  cfg,
+		lggr: lggr.Named("Chain"),
+	}
+	for _, n := range cfg.Nodes {
+		c.clients = append(c.clients, NewClient((*url.URL)(n.URL), cfg.RequestTimeout()))
+	}
+	if len(c.clients) == 0 {
+		return nil, fmt.Errorf("no nodes for chain %s", c.id)
+	}
+	c.nonces = NewNonces(c)
+	return c, nil
+}
+
+func (c *Chain) ID() string { return c.id }
+
+func (c *Chain) Config() *TOMLConfig { return c.cfg }
+
+// Nonces returns the sequence numbers of the transactions of the accounts on this chain.
+func (c *Chain) Nonces() *Nonces { return c.nonces }
+
+// SequenceNumber returns the sequence number of the next transaction of account, from the first node which responds.
+func (c *Chain) SequenceNumber(ctx context.Context, account string) (seq uint64, err error) {
+	for _, client := range c.clients {
+		var err2 error
+		if seq, err2 = client.SequenceNumber(ctx, account); err2 == nil {
+			return seq, nil
+		}
+		err = errors.Join(err, err2)
+	}
+	return 0, fmt.Errorf("failed to get the sequence number of %s: %w", account, err)
+}
+
+// Balance returns the APT balance of account in octas, from the first node which responds.
+func (c *Chain) Balance(ctx context.Context, account string) (*big.Int, error) {
+	var err error
+	for _, client := range c.clients {
+		balance, err2 := client.Balance(ctx, account)
+		if err2 == nil {
+			return balance, nil
+		}
+		err = errors.Join(err, err2)
+	}
+	return nil, fmt.Errorf("failed to get the balance of %s: %w", account, err)
+}
+
+func (c *Chain) Name() string { return c.lggr.Name() }
+
+func (c *Chain) Start(ctx context.Context) error {
+	return c.StartOnce("Chain", func() error {
+		c.lggr.Debugw("Starting", "nodes", len(c.clients))
+		return nil
+	})
+}
+
+func (c *Chain) Close() error {
+	return c.StopOnce("Chain", func() error { return nil })
+}
+
+func (c *Chain) HealthReport() map[string]error {
+	return map[string]error{c.Name(): c.Healthy()}
+}
+
+func (c *Chain) GetChainStatus(ctx context.Context) (types.ChainStatus, error) {
+	toml, err := c.cfg.TOMLString()
+	if err != nil {
+		return types.ChainStatus{}, err
+	}
+	return types.ChainStatus{
+		ID:      c.id,
+		Enabled: c.cfg.IsEnabled(),
+		Config:  toml,
+	}, nil
+}
+
+// listNodeStatuses reports nodes as Alive when they serve this chain, and Unreachable otherwise.
+func (c *Chain) listNodeStatuses(ctx context.Context, start, end int) ([]types.NodeStatus, int, error) {
+	nodes := c.cfg.Nodes
+	total := len(nodes)
+	if start >= total {
+		return nil, total, common.ErrOutOfRange
+	}
+	if end > total {
+		end = total
+	}
+	stats := make([]types.NodeStatus, 0, end-start)
+	for i, n := range nodes[start:end] {
+		toml, err := gotoml.Marshal(n)
+		if err != nil {
+			return nil, -1, err
+		}
+		state := "Alive"
+		if info, err := c.clients[start+i].LedgerInfo(ctx); err != nil {
+			state = "Unreachable"
+		} else if strconv.Itoa(int(info.ChainID)) != c.id {
+			state = "InvalidChainID"
+		}
+		stats = append(stats, types.NodeStatus{
+			ChainID: c.id,
+			Name:    *n.Name,
+			Config:  string(toml),
+			State:   state,
+		})
+	}
+	return stats, total, nil
+}
+
+func (c *Chain) ListNodeStatuses(ctx context.Context, pageSize int32, pageToken string) (stats []types.NodeStatus, nextPageToken string, total int, err error) {
+	return common.ListNodeStatuses(int(pageSize), pageToken, func(start, end int) ([]types.NodeStatus, int, error) {
+		return c.listNodeStatuses(ctx, start, end)
+	})
+}
+
+func (c *Chain) Transact(ctx context.Context, from, to string, amount *big.Int, balanceCheck bool) error {
+	return chains.ErrLOOPPUnsupported
+}
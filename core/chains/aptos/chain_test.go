@@ -0,0 +1,61 @@
+package aptos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	cfg := &TOMLConfig{
+		ChainID: ptr("2"),
+		Nodes: Nodes{
+			{Name: ptr("primary"), URL: (*config.URL)(newTestNode(t))},
+			{Name: ptr("offline"), URL: config.MustParseURL("http://127.0.0.1:1")},
+		},
+	}
+	cfg.SetDefaults()
+	chain, err := NewChain(cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	balance, err := chain.Balance(ctx, testAccount)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789", balance.String())
+	seq, err := chain.Nonces().Next(ctx, testAccount)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), seq)
+
+	status, err := chain.GetChainStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2", status.ID)
+	assert.True(t, status.Enabled)
+
+	stats, _, total, err := chain.ListNodeStatuses(ctx, 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, stats, 2)
+	assert.Equal(t, "Alive", stats[0].State)
+	assert.Equal(t, "Unreachable", stats[1].State)
+
+	relayer := NewRelayer(logger.TestLogger(t), chain)
+	require.NoError(t, relayer.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+	_, err = relayer.NewMedianProvider(types.RelayArgs{}, types.PluginArgs{})
+	assert.ErrorIs(t, err, ErrProductUnsupported)
+
+	cfg.Enabled = ptr(false)
+	_, err = NewChain(cfg, logger.TestLogger(t))
+	assert.ErrorIs(t, err, chains.ErrChainDisabled)
+}
+
+func ptr[T any](t T) *T { return &t }
@@ -0,0 +1,146 @@
+package aptos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// coinStore is the resource holding the APT balance of an account.
+const coinStore = "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>"
+
+// APIError is an error response of the REST API of a node.
+type APIError struct {
+	StatusCode int
+	Message    string `json:"message"`
+	ErrorCode  string `json:"error_code"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("aptos node responded with status %d: %s: %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+// notFound returns true if err is the error of a missing account, or of a missing resource of an account.
+func notFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && (apiErr.ErrorCode == "account_not_found" || apiErr.ErrorCode == "resource_not_found")
+}
+
+// LedgerInfo is the state of the chain, as seen by a node.
+type LedgerInfo struct {
+	ChainID       uint8
+	LedgerVersion uint64
+	BlockHeight   uint64
+}
+
+// Client is a client of the REST API of an Aptos node.
+type Client struct {
+	url  *url.URL
+	http *http.Client
+}
+
+// NewClient returns a Client of the REST API at u, e.g. https://fullnode.mainnet.aptoslabs.com/v1.
+func NewClient(u *url.URL, requestTimeout time.Duration) *Client {
+	return &Client{
+		url:  u,
+		http: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// LedgerInfo returns the chain ID and the latest ledger version and block height of the node.
+func (c *Client) LedgerInfo(ctx context.Context) (LedgerInfo, error) {
+	var resp struct {
+		ChainID       uint8  `json:"chain_id"`
+		LedgerVersion string `json:"ledger_version"`
+		BlockHeight   string `json:"block_height"`
+	}
+	if err := c.get(ctx, &resp); err != nil {
+		return LedgerInfo{}, err
+	}
+	info := LedgerInfo{ChainID: resp.ChainID}
+	var err error
+	if info.LedgerVersion, err = strconv.ParseUint(resp.LedgerVersion, 10, 64); err != nil {
+		return LedgerInfo{}, fmt.Errorf("invalid ledger_version: %w", err)
+	}
+	if info.BlockHeight, err = strconv.ParseUint(resp.BlockHeight, 10, 64); err != nil {
+		return LedgerInfo{}, fmt.Errorf("invalid block_height: %w", err)
+	}
+	return info, nil
+}
+
+// SequenceNumber returns the sequence number of the next transaction of account, which is zero for accounts which do
+// not exist yet.
+func (c *Client) SequenceNumber(ctx context.Context, account string) (uint64, error) {
+	var resp struct {
+		SequenceNumber string `json:"sequence_number"`
+	}
+	if err := c.get(ctx, &resp, "accounts", account); notFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	seq, err := strconv.ParseUint(resp.SequenceNumber, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sequence_number: %w", err)
+	}
+	return seq, nil
+}
+
+// Balance returns the APT balance of account in octas, which is zero for accounts which do not exist or do not hold
+// APT.
+func (c *Client) Balance(ctx context.Context, account string) (*big.Int, error) {
+	var resp struct {
+		Data struct {
+			Coin struct {
+				Value string `json:"value"`
+			} `json:"coin"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, &resp, "accounts", account, "resource", coinStore); notFound(err) {
+		return big.NewInt(0), nil
+	} else if err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(resp.Data.Coin.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance: %q", resp.Data.Coin.Value)
+	}
+	return balance, nil
+}
+
+// get decodes the JSON response to a GET request of the path made of elems into resp.
+func (c *Client) get(ctx context.Context, resp any, elems ...string) error {
+	u := c.url.JoinPath(elems...)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response of %s: %w", u.Path, err)
+	}
+	if res.StatusCode != http.StatusOK {
+		apiErr := &APIError{StatusCode: res.StatusCode}
+		if err = json.Unmarshal(body, apiErr); err != nil {
+			apiErr.Message = string(body)
+		}
+		return apiErr
+	}
+	if err = json.Unmarshal(body, resp); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", u.Path, err)
+	}
+	return nil
+}
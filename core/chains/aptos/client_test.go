@@ -0,0 +1,82 @@
+package aptos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+const testAccount = "0x8f396e4246b2ba87b51c0739ef5ea4f26515a98375308c31ac2ec1e42142a57f"
+
+func newTestNode(t *testing.T) *url.URL {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"chain_id":2,"epoch":"7","ledger_version":"100","block_height":"42"}`))
+	})
+	mux.HandleFunc("/v1/accounts/"+testAccount, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"sequence_number":"5","authentication_key":"` + testAccount + `"}`))
+	})
+	mux.HandleFunc("/v1/accounts/"+testAccount+"/resource/"+coinStore, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type":"` + coinStore + `","data":{"coin":{"value":"123456789"}}}`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Account not found","error_code":"account_not_found","vm_error_code":null}`))
+	})
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	u, err := url.Parse(s.URL + "/v1")
+	require.NoError(t, err)
+	return u
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	c := NewClient(newTestNode(t), time.Second)
+
+	info, err := c.LedgerInfo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, LedgerInfo{ChainID: 2, LedgerVersion: 100, BlockHeight: 42}, info)
+
+	seq, err := c.SequenceNumber(ctx, testAccount)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), seq)
+
+	balance, err := c.Balance(ctx, testAccount)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789", balance.String())
+
+	t.Run("account not found", func(t *testing.T) {
+		seq, err := c.SequenceNumber(ctx, "0x1234")
+		require.NoError(t, err)
+		assert.Zero(t, seq)
+
+		balance, err := c.Balance(ctx, "0x1234")
+		require.NoError(t, err)
+		assert.Zero(t, balance.Sign())
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message":"overloaded","error_code":"internal_error"}`))
+		}))
+		t.Cleanup(s.Close)
+		u, err := url.Parse(s.URL)
+		require.NoError(t, err)
+
+		_, err = NewClient(u, time.Second).Balance(ctx, testAccount)
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		assert.Equal(t, "internal_error", apiErr.ErrorCode)
+	})
+}
@@ -0,0 +1,164 @@
+package aptos
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+)
+
+// DefaultRequestTimeout is the default timeout of the requests to the REST API of the nodes.
+const DefaultRequestTimeout = 10 * time.Second
+
+// ChainConfig is the config shared by the nodes of a chain.
+type ChainConfig struct {
+	RequestTimeout *config.Duration
+}
+
+func (c *ChainConfig) SetDefaults() {
+	if c.RequestTimeout == nil {
+		c.RequestTimeout = config.MustNewDuration(DefaultRequestTimeout)
+	}
+}
+
+type Node struct {
+	Name *string
+	URL  *config.URL
+}
+
+type TOMLConfigs []*TOMLConfig
+
+func (cs TOMLConfigs) ValidateConfig() (err error) {
+	return cs.validateKeys()
+}
+
+func (cs TOMLConfigs) validateKeys() (err error) {
+	// Unique chain IDs
+	chainIDs := config.UniqueStrings{}
+	for i, c := range cs {
+		if chainIDs.IsDupe(c.ChainID) {
+			err = multierr.Append(err, config.NewErrDuplicate(fmt.Sprintf("%d.ChainID", i), *c.ChainID))
+		}
+	}
+
+	// Unique node names
+	names := config.UniqueStrings{}
+	for i, c := range cs {
+		for j, n := range c.Nodes {
+			if names.IsDupe(n.Name) {
+				err = multierr.Append(err, config.NewErrDuplicate(fmt.Sprintf("%d.Nodes.%d.Name", i, j), *n.Name))
+			}
+		}
+	}
+
+	// Unique URLs
+	urls := config.UniqueStrings{}
+	for i, c := range cs {
+		for j, n := range c.Nodes {
+			u := (*url.URL)(n.URL)
+			if urls.IsDupeFmt(u) {
+				err = multierr.Append(err, config.NewErrDuplicate(fmt.Sprintf("%d.Nodes.%d.URL", i, j), u.String()))
+			}
+		}
+	}
+	return
+}
+
+func (cs *TOMLConfigs) SetFrom(fs *TOMLConfigs) (err error) {
+	if err1 := fs.validateKeys(); err1 != nil {
+		return err1
+	}
+	for _, f := range *fs {
+		if f.ChainID == nil {
+			*cs = append(*cs, f)
+		} else if i := slices.IndexFunc(*cs, func(c *TOMLConfig) bool {
+			return c.ChainID != nil && *c.ChainID == *f.ChainID
+		}); i == -1 {
+			*cs = append(*cs, f)
+		} else {
+			(*cs)[i].SetFrom(f)
+		}
+	}
+	return
+}
+
+type TOMLConfig struct {
+	ChainID *string
+	// Do not access directly. Use [IsEnabled]
+	Enabled *bool
+	ChainConfig
+	Nodes Nodes
+}
+
+func (c *TOMLConfig) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
+func (c *TOMLConfig) SetFrom(f *TOMLConfig) {
+	if f.ChainID != nil {
+		c.ChainID = f.ChainID
+	}
+	if f.Enabled != nil {
+		c.Enabled = f.Enabled
+	}
+	if f.ChainConfig.RequestTimeout != nil {
+		c.ChainConfig.RequestTimeout = f.ChainConfig.RequestTimeout
+	}
+	c.Nodes.SetFrom(&f.Nodes)
+}
+
+func (c *TOMLConfig) ValidateConfig() (err error) {
+	if c.ChainID == nil {
+		err = multierr.Append(err, config.ErrMissing{Name: "ChainID", Msg: "required for all chains"})
+	} else if *c.ChainID == "" {
+		err = multierr.Append(err, config.ErrEmpty{Name: "ChainID", Msg: "required for all chains"})
+	}
+
+	if len(c.Nodes) == 0 {
+		err = multierr.Append(err, config.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
+	}
+
+	return
+}
+
+func (c *TOMLConfig) TOMLString() (string, error) {
+	b, err := toml.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (c *TOMLConfig) RequestTimeout() time.Duration {
+	return c.ChainConfig.RequestTimeout.Duration()
+}
+
+type Nodes []*Node
+
+func (ns *Nodes) SetFrom(fs *Nodes) {
+	for _, f := range *fs {
+		if f.Name == nil {
+			*ns = append(*ns, f)
+		} else if i := slices.IndexFunc(*ns, func(n *Node) bool {
+			return n.Name != nil && *n.Name == *f.Name
+		}); i == -1 {
+			*ns = append(*ns, f)
+		} else {
+			setFromNode((*ns)[i], f)
+		}
+	}
+}
+
+func setFromNode(n, f *Node) {
+	if f.Name != nil {
+		n.Name = f.Name
+	}
+	if f.URL != nil {
+		n.URL = f.URL
+	}
+}
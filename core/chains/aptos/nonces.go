@@ -0,0 +1,47 @@
+package aptos
+
+import (
+	"context"
+	"sync"
+)
+
+type sequenceNumberClient interface {
+	SequenceNumber(ctx context.Context, account string) (uint64, error)
+}
+
+// Nonces hands out the sequence numbers of the transactions of accounts. The sequence number of an account is loaded
+// from the chain the first time, and then incremented locally, so that transactions can be sent before the previous
+// ones are committed.
+type Nonces struct {
+	client sequenceNumberClient
+
+	mu   sync.Mutex
+	next map[string]uint64
+}
+
+func NewNonces(client sequenceNumberClient) *Nonces {
+	return &Nonces{client: client, next: make(map[string]uint64)}
+}
+
+// Next returns the sequence number of the next transaction of account.
+func (n *Nonces) Next(ctx context.Context, account string) (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	seq, ok := n.next[account]
+	if !ok {
+		var err error
+		if seq, err = n.client.SequenceNumber(ctx, account); err != nil {
+			return 0, err
+		}
+	}
+	n.next[account] = seq + 1
+	return seq, nil
+}
+
+// Reset drops the local sequence number of account, so that it is loaded from the chain again, e.g. after a
+// transaction was rejected with SEQUENCE_NUMBER_TOO_OLD or expired.
+func (n *Nonces) Reset(account string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.next, account)
+}
@@ -0,0 +1,39 @@
+package aptos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type fakeSequenceNumbers map[string]uint64
+
+func (f fakeSequenceNumbers) SequenceNumber(_ context.Context, account string) (uint64, error) {
+	return f[account], nil
+}
+
+func TestNonces(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	onchain := fakeSequenceNumbers{"a": 3, "b": 7}
+	nonces := NewNonces(onchain)
+
+	for _, exp := range []uint64{3, 4, 5} {
+		seq, err := nonces.Next(ctx, "a")
+		require.NoError(t, err)
+		assert.Equal(t, exp, seq)
+	}
+	seq, err := nonces.Next(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), seq)
+
+	onchain["a"] = 4
+	nonces.Reset("a")
+	seq, err = nonces.Next(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), seq)
+}
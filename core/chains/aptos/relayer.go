@@ -0,0 +1,70 @@
+package aptos
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ErrProductUnsupported is returned by the embedded Relayer for every product, which require the Aptos LOOPP.
+var ErrProductUnsupported = errors.New("aptos products are only supported by the Aptos LOOPP")
+
+// Relayer is the embedded Aptos relayer. It is a stub which does not serve any product, so that Aptos chains can be
+// configured and monitored before the products are implemented by the Aptos LOOPP, which replaces it when
+// CL_APTOS_CMD is set.
+type Relayer struct {
+	services.StateMachine
+	lggr  logger.Logger
+	chain *Chain
+}
+
+var _ types.Relayer = (*Relayer)(nil) //nolint:staticcheck
+
+func NewRelayer(lggr logger.Logger, chain *Chain) *Relayer {
+	return &Relayer{
+		lggr:  lggr.Named("Relayer"),
+		chain: chain,
+	}
+}
+
+func (r *Relayer) Name() string { return r.lggr.Name() }
+
+func (r *Relayer) Start(ctx context.Context) error {
+	return r.StartOnce("AptosRelayer", func() error {
+		return r.chain.Start(ctx)
+	})
+}
+
+func (r *Relayer) Close() error {
+	return r.StopOnce("AptosRelayer", r.chain.Close)
+}
+
+func (r *Relayer) HealthReport() map[string]error {
+	report := map[string]error{r.Name(): r.Healthy()}
+	services.CopyHealth(report, r.chain.HealthReport())
+	return report
+}
+
+func (r *Relayer) NewConfigProvider(types.RelayArgs) (types.ConfigProvider, error) {
+	return nil, ErrProductUnsupported
+}
+
+func (r *Relayer) NewMedianProvider(types.RelayArgs, types.PluginArgs) (types.MedianProvider, error) {
+	return nil, ErrProductUnsupported
+}
+
+func (r *Relayer) NewMercuryProvider(types.RelayArgs, types.PluginArgs) (types.MercuryProvider, error) {
+	return nil, ErrProductUnsupported
+}
+
+func (r *Relayer) NewFunctionsProvider(types.RelayArgs, types.PluginArgs) (types.FunctionsProvider, error) {
+	return nil, ErrProductUnsupported
+}
+
+func (r *Relayer) NewAutomationProvider(types.RelayArgs, types.PluginArgs) (types.AutomationProvider, error) {
+	return nil, ErrProductUnsupported
+}
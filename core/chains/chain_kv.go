@@ -3,6 +3,7 @@ package chains
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"golang.org/x/exp/maps"
 
@@ -10,7 +11,8 @@ import (
 )
 
 type ChainsKV[T types.ChainService] struct {
-	// note: this is read only after construction so no need for mutex
+	mu sync.RWMutex
+	// note: this only changes when chains are enabled or disabled at runtime
 	chains map[string]T
 }
 
@@ -22,11 +24,15 @@ func NewChainsKV[T types.ChainService](cs map[string]T) *ChainsKV[T] {
 	}
 }
 func (c *ChainsKV[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return len(c.chains)
 }
 
 // Get return [ErrNoSuchChainID] if [id] is not found
 func (c *ChainsKV[T]) Get(id string) (T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	var dflt T
 	chn, exist := c.chains[id]
 	if !exist {
@@ -39,6 +45,8 @@ func (c *ChainsKV[T]) List(ids ...string) ([]T, error) {
 	if len(ids) == 0 {
 		return c.Slice(), nil
 	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 
 	var (
 		result []T
@@ -59,5 +67,21 @@ func (c *ChainsKV[T]) List(ids ...string) ([]T, error) {
 }
 
 func (c *ChainsKV[T]) Slice() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return maps.Values(c.chains)
 }
+
+// Put adds the chain with the given id, replacing any existing one.
+func (c *ChainsKV[T]) Put(id string, chn T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.chains[id] = chn
+}
+
+// Delete removes the chain with the given id, if any.
+func (c *ChainsKV[T]) Delete(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.chains, id)
+}
@@ -54,6 +54,17 @@ func Test_ChainKV(t *testing.T) {
 	cs, err = kv.List("no such id")
 	assert.Error(t, err)
 	assert.Len(t, cs, 0)
+
+	// delete and put back the chain
+	kv.Delete(testChainID)
+	_, err = kv.Get(testChainID)
+	assert.ErrorIs(t, err, chains.ErrNoSuchChainID)
+	assert.Equal(t, kv.Len(), 0)
+
+	kv.Put(testChainID, testChain)
+	c, err = kv.Get(testChainID)
+	assert.NoError(t, err)
+	assert.Equal(t, c, testChain)
 }
 
 type testChainService struct {
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// missingStateRegex matches the errors of full nodes queried for state or logs which they have pruned, as returned
+// by geth, erigon, nethermind and besu.
+var missingStateRegex = regexp.MustCompile(`(?i)missing trie node|required historical state unavailable|historical state not available|state (is )?not available|pruned`)
+
+// isMissingStateError returns true if err means that the node no longer has the state of the queried block.
+func isMissingStateError(err error) bool {
+	return err != nil && missingStateRegex.MatchString(err.Error())
+}
+
+// blockArgIndexes are the positions of the block parameter of the state queries, which are routed to archive nodes
+// by the age of the block.
+var blockArgIndexes = map[string]int{
+	"eth_call":                1,
+	"eth_getBalance":          1,
+	"eth_getCode":             1,
+	"eth_getTransactionCount": 1,
+	"eth_getStorageAt":        2,
+	"eth_getProof":            2,
+}
+
+// isTraceMethod returns true for the methods which replay transactions, and which only archive nodes can serve for
+// all but the most recent blocks.
+func isTraceMethod(method string) bool {
+	return strings.HasPrefix(method, "trace_") || strings.HasPrefix(method, "debug_trace")
+}
+
+// archiveQuery runs query on an archive node if blockNumber is beyond the pruning horizon, and otherwise runs
+// fullQuery on the full nodes, retrying it on an archive node if they have pruned the state. A nil or negative
+// blockNumber, like latest or pending, is always served by the full nodes.
+func archiveQuery[T any](c *chainClient, name string, blockNumber *big.Int, query func(rpc RPCCLient) (T, error), fullQuery func() (T, error)) (T, error) {
+	if blockNumber != nil && blockNumber.Sign() >= 0 {
+		if rpc, ok := c.multiNode.SelectArchiveNodeRPC(blockNumber, c.pruningHorizon); ok {
+			return query(rpc)
+		}
+	}
+	result, err := fullQuery()
+	if isMissingStateError(err) {
+		if rpc, ok := c.multiNode.SelectArchiveNodeRPC(nil, 0); ok {
+			c.logger.Debugw("Retrying query on an archive node, after missing state on a full node", "query", name, "blockNumber", blockNumber, "err", err)
+			return query(rpc)
+		}
+	}
+	return result, err
+}
+
+// selectArchiveRPCForCall returns an archive node RPC if the raw call must be served by one: traces always are, and
+// state queries are if their block is beyond the pruning horizon.
+func (c *chainClient) selectArchiveRPCForCall(method string, args []interface{}) (RPCCLient, bool) {
+	if isTraceMethod(method) {
+		return c.multiNode.SelectArchiveNodeRPC(nil, 0)
+	}
+	i, ok := blockArgIndexes[method]
+	if !ok || i >= len(args) {
+		return nil, false
+	}
+	blockNumber := parseBlockArg(args[i])
+	if blockNumber == nil || blockNumber.Sign() < 0 {
+		return nil, false
+	}
+	return c.multiNode.SelectArchiveNodeRPC(blockNumber, c.pruningHorizon)
+}
+
+// parseBlockArg returns the number of a block parameter, or nil if it is a tag like latest or a block hash.
+func parseBlockArg(arg interface{}) *big.Int {
+	switch v := arg.(type) {
+	case string:
+		n, err := hexutil.DecodeBig(v)
+		if err != nil {
+			return nil
+		}
+		return n
+	case *big.Int:
+		return v
+	case *hexutil.Big:
+		return v.ToInt()
+	case hexutil.Uint64:
+		return new(big.Int).SetUint64(uint64(v))
+	case rpc.BlockNumber:
+		return big.NewInt(v.Int64())
+	}
+	return nil
+}
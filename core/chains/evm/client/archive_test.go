@@ -0,0 +1,62 @@
+package client
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMissingStateError(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		err error
+		exp bool
+	}{
+		{nil, false},
+		{errors.New("missing trie node 0b8f1d9e (path ) state 0x0b8f1d9e is not available, not found"), true},
+		{errors.New("required historical state unavailable (reexec=128)"), true},
+		{errors.New("historical state not available in path scheme yet"), true},
+		{errors.New("execution reverted"), false},
+		{errors.New("header not found"), false},
+	} {
+		assert.Equal(t, tt.exp, isMissingStateError(tt.err), tt.err)
+	}
+}
+
+func TestIsTraceMethod(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isTraceMethod("trace_transaction"))
+	assert.True(t, isTraceMethod("debug_traceTransaction"))
+	assert.True(t, isTraceMethod("debug_traceCall"))
+	assert.False(t, isTraceMethod("debug_getRawBlock"))
+	assert.False(t, isTraceMethod("eth_call"))
+}
+
+func TestParseBlockArg(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		arg  interface{}
+		exp  *big.Int
+	}{
+		{"hex", "0x10", big.NewInt(16)},
+		{"latest", "latest", nil},
+		{"big", big.NewInt(7), big.NewInt(7)},
+		{"hexutil big", (*hexutil.Big)(big.NewInt(7)), big.NewInt(7)},
+		{"hexutil uint64", hexutil.Uint64(7), big.NewInt(7)},
+		{"block number", rpc.BlockNumber(7), big.NewInt(7)},
+		{"pending block number", rpc.PendingBlockNumber, big.NewInt(-1)},
+		{"object", map[string]interface{}{"blockHash": "0x01"}, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, parseBlockArg(tt.arg))
+		})
+	}
+}
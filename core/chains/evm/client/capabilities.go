@@ -0,0 +1,295 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// logsRangeProbes are the eth_getLogs block ranges tried, largest first, when detecting a node's log query limit
+var logsRangeProbes = []int64{10_000, 2_000, 500, 100}
+
+// Capabilities is the profile of optional features supported by an RPC node, as detected by ProbeCapabilities.
+type Capabilities struct {
+	// ChainID is the chain ID reported by the node
+	ChainID *big.Int
+	// FinalityTag is true if the node supports the `finalized` block tag
+	FinalityTag bool
+	// DynamicFees is true if the latest block of the node has a base fee, i.e. the chain supports EIP-1559
+	DynamicFees bool
+	// MaxLogsBlockRange is the largest eth_getLogs block range which succeeded, or zero if none did
+	MaxLogsBlockRange int64
+	// Debug is true if the debug_* namespace is available
+	Debug bool
+	// Trace is true if the trace_* namespace is available
+	Trace bool
+	// TxPool is true if the txpool_* namespace is available
+	TxPool bool
+	// ProbedAt is when the profile was captured
+	ProbedAt time.Time
+}
+
+// CapabilityCaller is the RPC method used to probe a node.
+type CapabilityCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// ProbeCapabilities detects the Capabilities of the node behind c. An error is only returned if the node could not be
+// queried at all; unsupported features are reported as such in the profile.
+func ProbeCapabilities(ctx context.Context, c CapabilityCaller) (caps Capabilities, err error) {
+	var chainID hexutil.Big
+	if err = c.CallContext(ctx, &chainID, "eth_chainId"); err != nil {
+		return caps, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	caps.ChainID = chainID.ToInt()
+
+	var latest hexutil.Uint64
+	if err = c.CallContext(ctx, &latest, "eth_blockNumber"); err != nil {
+		return caps, fmt.Errorf("failed to fetch latest block number: %w", err)
+	}
+
+	var finalized map[string]interface{}
+	caps.FinalityTag = c.CallContext(ctx, &finalized, "eth_getBlockByNumber", rpc.FinalizedBlockNumber.String(), false) == nil && finalized != nil
+
+	var latestBlock map[string]interface{}
+	caps.DynamicFees = c.CallContext(ctx, &latestBlock, "eth_getBlockByNumber", rpc.LatestBlockNumber.String(), false) == nil && latestBlock["baseFeePerGas"] != nil
+
+	for _, r := range logsRangeProbes {
+		from := int64(latest) - r + 1
+		if from < 0 {
+			from = 0
+		}
+		var logs []interface{}
+		if c.CallContext(ctx, &logs, "eth_getLogs", map[string]interface{}{
+			"fromBlock": hexutil.EncodeBig(big.NewInt(from)),
+			"toBlock":   hexutil.EncodeUint64(uint64(latest)),
+			"address":   common.Address{},
+		}) == nil {
+			caps.MaxLogsBlockRange = r
+			break
+		}
+	}
+
+	var ignored interface{}
+	caps.Debug = methodAvailable(c.CallContext(ctx, &ignored, "debug_traceTransaction", common.Hash{}, map[string]interface{}{}))
+	caps.Trace = methodAvailable(c.CallContext(ctx, &ignored, "trace_transaction", common.Hash{}))
+	caps.TxPool = methodAvailable(c.CallContext(ctx, &ignored, "txpool_status"))
+	caps.ProbedAt = time.Now()
+	return caps, nil
+}
+
+// methodAvailable returns false if err indicates the method does not exist. Any other error (e.g. transaction not
+// found) means the method is served.
+func methodAvailable(err error) bool {
+	if err == nil {
+		return true
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"method not found", "does not exist", "not available", "not supported", "unsupported method"} {
+		if strings.Contains(msg, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// CapabilityConfig is the subset of chain config which is checked against detected capabilities.
+type CapabilityConfig interface {
+	FinalityTagEnabled() bool
+}
+
+// NodeCapabilities reports on the capabilities of the nodes of a chain.
+type NodeCapabilities interface {
+	// AllNodes returns true if every node has been probed and its profile satisfies fn.
+	AllNodes(fn func(Capabilities) bool) bool
+}
+
+// CapabilityMonitor probes every configured node once it becomes reachable, keeps the resulting profiles and reports
+// mismatches with the chain config as unhealthy.
+type CapabilityMonitor struct {
+	services.StateMachine
+	lggr     logger.SugaredLogger
+	chainID  *big.Int
+	cfg      CapabilityConfig
+	orm      CapabilityORM
+	nodes    map[string]CapabilityCaller
+	interval time.Duration
+	onProbed []func(name string, caps Capabilities)
+
+	mu       sync.RWMutex
+	profiles map[string]Capabilities
+	// probed holds the nodes probed since start, as opposed to the profiles loaded from the database
+	probed map[string]bool
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ NodeCapabilities = (*CapabilityMonitor)(nil)
+
+// NewCapabilityMonitor returns a monitor for nodes, keyed by node name. Nodes which cannot be reached are retried
+// every interval until probed successfully. Profiles are persisted to orm, if not nil, and the persisted profiles are
+// used until the nodes are probed again.
+func NewCapabilityMonitor(lggr logger.Logger, chainID *big.Int, cfg CapabilityConfig, orm CapabilityORM, nodes map[string]CapabilityCaller, interval time.Duration) *CapabilityMonitor {
+	return &CapabilityMonitor{
+		lggr:     logger.Sugared(logger.Named(lggr, "CapabilityMonitor")),
+		chainID:  chainID,
+		cfg:      cfg,
+		orm:      orm,
+		nodes:    nodes,
+		interval: interval,
+		profiles: make(map[string]Capabilities),
+		probed:   make(map[string]bool),
+		stopCh:   make(services.StopChan),
+	}
+}
+
+// OnProbed registers fn to be called each time a node profile is captured or loaded, so that subsystems can adapt to it.
+// Must be called before Start.
+func (m *CapabilityMonitor) OnProbed(fn func(name string, caps Capabilities)) {
+	m.onProbed = append(m.onProbed, fn)
+}
+
+func (m *CapabilityMonitor) Name() string { return m.lggr.Name() }
+
+func (m *CapabilityMonitor) Start(ctx context.Context) error {
+	return m.StartOnce("CapabilityMonitor", func() error {
+		if m.orm != nil {
+			persisted, err := m.orm.SelectCapabilities(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to load node capabilities: %w", err)
+			}
+			for name, caps := range persisted {
+				if _, ok := m.nodes[name]; ok {
+					m.setProfile(name, caps)
+				}
+			}
+		}
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *CapabilityMonitor) Close() error {
+	return m.StopOnce("CapabilityMonitor", func() error {
+		close(m.stopCh)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *CapabilityMonitor) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		if m.probeAll(ctx) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// probeAll probes every node that has not been probed since start, and returns true once all nodes are probed.
+func (m *CapabilityMonitor) probeAll(ctx context.Context) (done bool) {
+	done = true
+	for name, c := range m.nodes {
+		if m.probed[name] {
+			continue
+		}
+		caps, err := ProbeCapabilities(ctx, c)
+		if err != nil {
+			m.lggr.Debugw("Failed to probe node capabilities, will retry", "node", name, "err", err)
+			done = false
+			continue
+		}
+		m.lggr.Infow("Detected node capabilities", "node", name, "chainID", caps.ChainID, "finalityTag", caps.FinalityTag,
+			"dynamicFees", caps.DynamicFees, "maxLogsBlockRange", caps.MaxLogsBlockRange, "debug", caps.Debug, "trace", caps.Trace,
+			"txpool", caps.TxPool)
+		m.probed[name] = true
+		m.setProfile(name, caps)
+		if m.orm != nil {
+			if err = m.orm.UpsertCapabilities(ctx, name, caps); err != nil {
+				m.lggr.Errorw("Failed to persist node capabilities", "node", name, "err", err)
+			}
+		}
+	}
+	return
+}
+
+func (m *CapabilityMonitor) setProfile(name string, caps Capabilities) {
+	m.mu.Lock()
+	m.profiles[name] = caps
+	m.mu.Unlock()
+	for _, fn := range m.onProbed {
+		fn(name, caps)
+	}
+}
+
+// Capabilities returns the profile of the named node, if it has been probed.
+func (m *CapabilityMonitor) Capabilities(name string) (Capabilities, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	caps, ok := m.profiles[name]
+	return caps, ok
+}
+
+// AllNodes returns true if every node has a profile which satisfies fn. A nil monitor has no profiles.
+func (m *CapabilityMonitor) AllNodes(fn func(Capabilities) bool) bool {
+	if m == nil {
+		return false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for name := range m.nodes {
+		caps, ok := m.profiles[name]
+		if !ok || !fn(caps) {
+			return false
+		}
+	}
+	return len(m.nodes) > 0
+}
+
+// HealthReport reports an error for every probed node which does not match the chain config.
+func (m *CapabilityMonitor) HealthReport() map[string]error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	report := map[string]error{m.Name(): m.Healthy()}
+	for name, caps := range m.profiles {
+		report[m.Name()+"."+name] = m.checkProfile(caps)
+	}
+	return report
+}
+
+func (m *CapabilityMonitor) checkProfile(caps Capabilities) (err error) {
+	if caps.ChainID.Cmp(m.chainID) != 0 {
+		err = errors.Join(err, fmt.Errorf("node chain ID %s does not match configured chain ID %s", caps.ChainID, m.chainID))
+	}
+	if m.cfg.FinalityTagEnabled() && !caps.FinalityTag {
+		err = errors.Join(err, errors.New("FinalityTagEnabled is set but node does not support the finalized block tag"))
+	}
+	return
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// CapabilityORM persists the capability profiles of the nodes of a chain, keyed by node name.
+type CapabilityORM interface {
+	// UpsertCapabilities saves the profile of the named node, replacing any previous one
+	UpsertCapabilities(ctx context.Context, name string, caps Capabilities) error
+	// SelectCapabilities returns the saved profiles of all nodes of the chain
+	SelectCapabilities(ctx context.Context) (map[string]Capabilities, error)
+}
+
+type capabilityORM struct {
+	q       pg.Q
+	chainID ubig.Big
+}
+
+var _ CapabilityORM = (*capabilityORM)(nil)
+
+func NewCapabilityORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, chainID big.Int) CapabilityORM {
+	return &capabilityORM{pg.NewQ(db, logger.Named(lggr, "CapabilityORM"), cfg), ubig.Big(chainID)}
+}
+
+type capabilitiesRow struct {
+	NodeName          string    `db:"node_name"`
+	NodeChainID       ubig.Big  `db:"node_chain_id"`
+	FinalityTag       bool      `db:"finality_tag"`
+	DynamicFees       bool      `db:"dynamic_fees"`
+	MaxLogsBlockRange int64     `db:"max_logs_block_range"`
+	Debug             bool      `db:"debug"`
+	Trace             bool      `db:"trace"`
+	TxPool            bool      `db:"txpool"`
+	ProbedAt          time.Time `db:"probed_at"`
+}
+
+func (o *capabilityORM) UpsertCapabilities(ctx context.Context, name string, caps Capabilities) error {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err := q.ExecQ(`
+	INSERT INTO evm.node_capabilities (evm_chain_id, node_name, node_chain_id, finality_tag, dynamic_fees, max_logs_block_range, debug, trace, txpool, probed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	ON CONFLICT (evm_chain_id, node_name) DO UPDATE SET
+		node_chain_id = EXCLUDED.node_chain_id,
+		finality_tag = EXCLUDED.finality_tag,
+		dynamic_fees = EXCLUDED.dynamic_fees,
+		max_logs_block_range = EXCLUDED.max_logs_block_range,
+		debug = EXCLUDED.debug,
+		trace = EXCLUDED.trace,
+		txpool = EXCLUDED.txpool,
+		probed_at = EXCLUDED.probed_at`,
+		o.chainID, name, ubig.New(caps.ChainID), caps.FinalityTag, caps.DynamicFees, caps.MaxLogsBlockRange, caps.Debug, caps.Trace, caps.TxPool, caps.ProbedAt)
+	return errors.Wrap(err, "UpsertCapabilities failed")
+}
+
+func (o *capabilityORM) SelectCapabilities(ctx context.Context) (map[string]Capabilities, error) {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	var rows []capabilitiesRow
+	if err := q.Select(&rows, `SELECT node_name, node_chain_id, finality_tag, dynamic_fees, max_logs_block_range, debug, trace, txpool, probed_at
+	FROM evm.node_capabilities WHERE evm_chain_id = $1`, o.chainID); err != nil {
+		return nil, errors.Wrap(err, "SelectCapabilities failed")
+	}
+	profiles := make(map[string]Capabilities, len(rows))
+	for _, r := range rows {
+		profiles[r.NodeName] = Capabilities{
+			ChainID:           r.NodeChainID.ToInt(),
+			FinalityTag:       r.FinalityTag,
+			DynamicFees:       r.DynamicFees,
+			MaxLogsBlockRange: r.MaxLogsBlockRange,
+			Debug:             r.Debug,
+			Trace:             r.Trace,
+			TxPool:            r.TxPool,
+			ProbedAt:          r.ProbedAt,
+		}
+	}
+	return profiles, nil
+}
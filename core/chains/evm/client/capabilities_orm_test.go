@@ -0,0 +1,46 @@
+package client_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestCapabilityORM(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.Test(t)
+	orm := client.NewCapabilityORM(db, lggr, pgtest.NewQConfig(true), *testutils.FixtureChainID)
+	otherChain := client.NewCapabilityORM(db, lggr, pgtest.NewQConfig(true), *big.NewInt(1))
+	ctx := testutils.Context(t)
+
+	probedAt := time.Now().UTC().Truncate(time.Second)
+	caps := client.Capabilities{ChainID: testutils.FixtureChainID, FinalityTag: true, MaxLogsBlockRange: 2_000, Debug: true, ProbedAt: probedAt}
+	require.NoError(t, orm.UpsertCapabilities(ctx, "primary", caps))
+	require.NoError(t, otherChain.UpsertCapabilities(ctx, "primary", client.Capabilities{ChainID: big.NewInt(1), ProbedAt: probedAt}))
+
+	profiles, err := orm.SelectCapabilities(ctx)
+	require.NoError(t, err)
+	require.Len(t, profiles, 1)
+	assert.Equal(t, caps.ChainID.String(), profiles["primary"].ChainID.String())
+	assert.True(t, profiles["primary"].FinalityTag)
+	assert.Equal(t, int64(2_000), profiles["primary"].MaxLogsBlockRange)
+	assert.True(t, probedAt.Equal(profiles["primary"].ProbedAt))
+
+	caps.MaxLogsBlockRange = 100
+	caps.DynamicFees = true
+	require.NoError(t, orm.UpsertCapabilities(ctx, "primary", caps))
+	profiles, err = orm.SelectCapabilities(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), profiles["primary"].MaxLogsBlockRange)
+	assert.True(t, profiles["primary"].DynamicFees)
+}
@@ -0,0 +1,152 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type capabilityCallerFunc func(method string, args ...interface{}) (json.RawMessage, error)
+
+func (f capabilityCallerFunc) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	raw, err := f(method, args...)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, result)
+}
+
+type finalityTagConfig bool
+
+func (f finalityTagConfig) FinalityTagEnabled() bool { return bool(f) }
+
+func newCapabilityCaller(withFinality bool, maxLogsRange int64) client.CapabilityCaller {
+	return capabilityCallerFunc(func(method string, args ...interface{}) (json.RawMessage, error) {
+		switch method {
+		case "eth_chainId":
+			return json.RawMessage(`"0x2a"`), nil
+		case "eth_blockNumber":
+			return json.RawMessage(`"0x100000"`), nil
+		case "eth_getBlockByNumber":
+			if args[0] == "latest" {
+				return json.RawMessage(`{"number":"0x100000","baseFeePerGas":"0x7"}`), nil
+			}
+			if !withFinality {
+				return nil, errors.New("invalid block tag")
+			}
+			return json.RawMessage(`{"number":"0xff000"}`), nil
+		case "eth_getLogs":
+			q := args[0].(map[string]interface{})
+			from, _ := new(big.Int).SetString(q["fromBlock"].(string)[2:], 16)
+			if 0x100000-from.Int64()+1 > maxLogsRange {
+				return nil, errors.New("block range too large")
+			}
+			return json.RawMessage(`[]`), nil
+		case "debug_traceTransaction":
+			return nil, errors.New("transaction not found")
+		case "txpool_status":
+			return json.RawMessage(`{}`), nil
+		default:
+			return nil, errors.New("the method " + method + " does not exist/is not available")
+		}
+	})
+}
+
+func TestProbeCapabilities(t *testing.T) {
+	t.Parallel()
+
+	caps, err := client.ProbeCapabilities(testutils.Context(t), newCapabilityCaller(true, 2_000))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), caps.ChainID.Int64())
+	assert.True(t, caps.FinalityTag)
+	assert.True(t, caps.DynamicFees)
+	assert.Equal(t, int64(2_000), caps.MaxLogsBlockRange)
+	assert.True(t, caps.Debug)
+	assert.False(t, caps.Trace)
+	assert.True(t, caps.TxPool)
+
+	t.Run("unreachable node", func(t *testing.T) {
+		_, err := client.ProbeCapabilities(testutils.Context(t), capabilityCallerFunc(func(string, ...interface{}) (json.RawMessage, error) {
+			return nil, errors.New("connection refused")
+		}))
+		require.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func TestCapabilityMonitor(t *testing.T) {
+	t.Parallel()
+
+	nodes := map[string]client.CapabilityCaller{
+		"ok":          newCapabilityCaller(true, 10_000),
+		"no-finality": newCapabilityCaller(false, 100),
+	}
+	orm := &capabilityORM{profiles: map[string]client.Capabilities{
+		"ok":      {ChainID: big.NewInt(42), MaxLogsBlockRange: 500},
+		"removed": {ChainID: big.NewInt(42)},
+	}}
+	m := client.NewCapabilityMonitor(logger.Test(t), big.NewInt(1), finalityTagConfig(true), orm, nodes, testutils.TestInterval)
+	probed := make(chan string, len(nodes))
+	m.OnProbed(func(name string, _ client.Capabilities) { probed <- name })
+	require.NoError(t, m.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, m.Close()) })
+
+	// the persisted profile of "ok" is loaded first, the one of the removed node is ignored
+	require.Equal(t, "ok", <-probed)
+	for range nodes {
+		<-probed
+	}
+	caps, ok := m.Capabilities("no-finality")
+	require.True(t, ok)
+	assert.Equal(t, int64(100), caps.MaxLogsBlockRange)
+	caps, ok = m.Capabilities("ok")
+	require.True(t, ok)
+	assert.Equal(t, int64(10_000), caps.MaxLogsBlockRange)
+	_, ok = m.Capabilities("removed")
+	assert.False(t, ok)
+	assert.Equal(t, int64(100), orm.get("no-finality").MaxLogsBlockRange)
+	assert.Equal(t, int64(10_000), orm.get("ok").MaxLogsBlockRange)
+
+	assert.True(t, m.AllNodes(func(caps client.Capabilities) bool { return caps.Debug }))
+	assert.False(t, m.AllNodes(func(caps client.Capabilities) bool { return caps.FinalityTag }))
+	assert.False(t, (*client.CapabilityMonitor)(nil).AllNodes(func(client.Capabilities) bool { return true }))
+
+	report := m.HealthReport()
+	// both nodes report chain ID 42, which mismatches the configured chain
+	require.ErrorContains(t, report[m.Name()+".ok"], "does not match configured chain ID 1")
+	require.ErrorContains(t, report[m.Name()+".no-finality"], "does not support the finalized block tag")
+}
+
+type capabilityORM struct {
+	mu       sync.Mutex
+	profiles map[string]client.Capabilities
+}
+
+func (o *capabilityORM) UpsertCapabilities(_ context.Context, name string, caps client.Capabilities) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.profiles[name] = caps
+	return nil
+}
+
+func (o *capabilityORM) SelectCapabilities(context.Context) (map[string]client.Capabilities, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return maps.Clone(o.profiles), nil
+}
+
+func (o *capabilityORM) get(name string) client.Capabilities {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.profiles[name]
+}
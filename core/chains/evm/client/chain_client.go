@@ -38,6 +38,8 @@ type chainClient struct {
 		RPCCLient,
 	]
 	logger logger.SugaredLogger
+	// pruningHorizon is the number of recent blocks whose state full nodes retain. Older queries go to archive nodes.
+	pruningHorizon int64
 }
 
 func NewChainClient(
@@ -45,6 +47,7 @@ func NewChainClient(
 	selectionMode string,
 	leaseDuration time.Duration,
 	noNewHeadsThreshold time.Duration,
+	pruningHorizon uint32,
 	nodes []commonclient.Node[*big.Int, *evmtypes.Head, RPCCLient],
 	sendonlys []commonclient.SendOnlyNode[*big.Int, RPCCLient],
 	chainID *big.Int,
@@ -76,13 +79,23 @@ func NewChainClient(
 		ClassifySendOnlyError,
 	)
 	return &chainClient{
-		multiNode: multiNode,
-		logger:    logger.Sugared(lggr),
+		multiNode:      multiNode,
+		logger:         logger.Sugared(lggr),
+		pruningHorizon: int64(pruningHorizon),
 	}
 }
 
+// UpdateNodes closes and removes the nodes with the given names, then starts and adds the given nodes.
+func (c *chainClient) UpdateNodes(ctx context.Context, removed []string, nodes []commonclient.Node[*big.Int, *evmtypes.Head, RPCCLient], sendonlys []commonclient.SendOnlyNode[*big.Int, RPCCLient]) error {
+	return c.multiNode.UpdateNodes(ctx, removed, nodes, sendonlys)
+}
+
 func (c *chainClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	return c.multiNode.BalanceAt(ctx, account, blockNumber)
+	return archiveQuery(c, "BalanceAt", blockNumber, func(rpc RPCCLient) (*big.Int, error) {
+		return rpc.BalanceAt(ctx, account, blockNumber)
+	}, func() (*big.Int, error) {
+		return c.multiNode.BalanceAt(ctx, account, blockNumber)
+	})
 }
 
 func (c *chainClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
@@ -120,11 +133,25 @@ func (c *chainClient) BlockByNumber(ctx context.Context, number *big.Int) (b *ty
 }
 
 func (c *chainClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	return c.multiNode.CallContext(ctx, result, method, args...)
+	if rpc, ok := c.selectArchiveRPCForCall(method, args); ok {
+		return rpc.CallContext(ctx, result, method, args...)
+	}
+	err := c.multiNode.CallContext(ctx, result, method, args...)
+	if isMissingStateError(err) {
+		if rpc, ok := c.multiNode.SelectArchiveNodeRPC(nil, 0); ok {
+			c.logger.Debugw("Retrying call on an archive node, after missing state on a full node", "method", method, "err", err)
+			return rpc.CallContext(ctx, result, method, args...)
+		}
+	}
+	return err
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return c.multiNode.CallContract(ctx, msg, blockNumber)
+	return archiveQuery(c, "CallContract", blockNumber, func(rpc RPCCLient) ([]byte, error) {
+		return rpc.CallContract(ctx, msg, blockNumber)
+	}, func() ([]byte, error) {
+		return c.multiNode.CallContract(ctx, msg, blockNumber)
+	})
 }
 
 // TODO-1663: change this to actual ChainID() call once client.go is deprecated.
@@ -138,7 +165,11 @@ func (c *chainClient) Close() {
 }
 
 func (c *chainClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return c.multiNode.CodeAt(ctx, account, blockNumber)
+	return archiveQuery(c, "CodeAt", blockNumber, func(rpc RPCCLient) ([]byte, error) {
+		return rpc.CodeAt(ctx, account, blockNumber)
+	}, func() ([]byte, error) {
+		return c.multiNode.CodeAt(ctx, account, blockNumber)
+	})
 }
 
 func (c *chainClient) ConfiguredChainID() *big.Int {
@@ -153,7 +184,12 @@ func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (u
 	return c.multiNode.EstimateGas(ctx, call)
 }
 func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	return c.multiNode.FilterEvents(ctx, q)
+	// logs filtered by block hash are served by the full nodes, since the age of the block is unknown
+	return archiveQuery(c, "FilterLogs", q.FromBlock, func(rpc RPCCLient) ([]types.Log, error) {
+		return rpc.FilterEvents(ctx, q)
+	}, func() ([]types.Log, error) {
+		return c.multiNode.FilterEvents(ctx, q)
+	})
 }
 
 func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *types.Header, err error) {
@@ -221,7 +257,11 @@ func (c *chainClient) SendTransactionReturnCode(ctx context.Context, tx *types.T
 }
 
 func (c *chainClient) SequenceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (evmtypes.Nonce, error) {
-	return c.multiNode.SequenceAt(ctx, account, blockNumber)
+	return archiveQuery(c, "SequenceAt", blockNumber, func(rpc RPCCLient) (evmtypes.Nonce, error) {
+		return rpc.SequenceAt(ctx, account, blockNumber)
+	}, func() (evmtypes.Nonce, error) {
+		return c.multiNode.SequenceAt(ctx, account, blockNumber)
+	})
 }
 
 func (c *chainClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (s ethereum.Subscription, err error) {
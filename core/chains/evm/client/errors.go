@@ -60,6 +60,9 @@ const (
 	L2FeeTooHigh
 	L2Full
 	TransactionAlreadyMined
+	// TxTypeNotSupported is returned by nodes of chains which do not support the type of the transaction, most commonly
+	// dynamic fee transactions on chains without EIP-1559.
+	TxTypeNotSupported
 	Fatal
 )
 
@@ -89,6 +92,7 @@ var geth = ClientErrors{
 	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)transaction underpriced$`),
 	InsufficientEth:                   regexp.MustCompile(`(: |^)(insufficient funds for transfer|insufficient funds for gas \* price \+ value|insufficient balance for transfer)$`),
 	TxFeeExceedsCap:                   regexp.MustCompile(`(: |^)tx fee \([0-9\.]+ [a-zA-Z]+\) exceeds the configured cap \([0-9\.]+ [a-zA-Z]+\)$`),
+	TxTypeNotSupported:                regexp.MustCompile(`(: |^)(transaction type not supported|unsupported transaction type|eip-1559 transactions are not supported)`),
 	Fatal:                             gethFatal,
 }
 
@@ -300,6 +304,12 @@ func (s *SendError) IsL2Full() bool {
 	return s.is(L2Full)
 }
 
+// IsTxTypeNotSupported indicates that the node does not support the type of the transaction, e.g. dynamic fee
+// transactions on chains without EIP-1559, so it must be sent as a legacy transaction instead.
+func (s *SendError) IsTxTypeNotSupported() bool {
+	return s.is(TxTypeNotSupported)
+}
+
 // IsTimeout indicates if the error was caused by an exceeded context deadline
 func (s *SendError) IsTimeout() bool {
 	if s == nil {
@@ -423,6 +433,10 @@ func ClassifySendError(err error, lggr logger.SugaredLogger, tx *types.Transacti
 		// Attempt is thrown away in this case; we don't need it since it never got accepted by a node
 		return commonclient.Fatal
 	}
+	if sendError.IsTxTypeNotSupported() && tx.Type() != types.LegacyTxType {
+		lggr.Warnw(fmt.Sprintf("Transaction type 0x%d is not supported by the node, it will be sent as a legacy transaction", tx.Type()), "err", sendError, "etx", tx)
+		return commonclient.TxTypeUnsupported
+	}
 	if sendError.IsNonceTooLowError() || sendError.IsTransactionAlreadyMined() {
 		lggr.Debugw("Transaction already confirmed for this nonce: %d", tx.Nonce(), "err", sendError, "etx", tx)
 		// Nonce too low indicated that a transaction at this nonce was confirmed already.
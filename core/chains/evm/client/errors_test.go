@@ -1,11 +1,17 @@
 package client_test
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 )
 
@@ -266,6 +272,26 @@ func Test_Eth_Errors(t *testing.T) {
 		assert.False(t, err.L2FeeTooLow())
 	})
 
+	t.Run("IsTxTypeNotSupported", func(t *testing.T) {
+		tests := []errorCase{
+			{"transaction type not supported", true, "Geth"},
+			{"rpc error: transaction type not supported", true, "Erigon"},
+			{"unsupported transaction type", true, "Geth"},
+			{"eip-1559 transactions are not supported", true, "Geth"},
+			{"transaction underpriced", false, "Geth"},
+		}
+		for _, test := range tests {
+			t.Run(test.network, func(t *testing.T) {
+				err = evmclient.NewSendErrorS(test.message)
+				assert.Equal(t, test.expect, err.IsTxTypeNotSupported())
+				assert.False(t, err.Fatal())
+				err = newSendErrorWrapped(test.message)
+				assert.Equal(t, test.expect, err.IsTxTypeNotSupported())
+			})
+		}
+		assert.False(t, randomError.IsTxTypeNotSupported())
+	})
+
 	t.Run("moonriver errors", func(t *testing.T) {
 		err := evmclient.NewSendErrorS("primary http (http://***REDACTED***:9933) call failed: submit transaction to pool failed: Pool(Stale)")
 		assert.True(t, err.IsNonceTooLowError())
@@ -354,3 +380,16 @@ func Test_Eth_Errors_Fatal(t *testing.T) {
 		})
 	}
 }
+
+func Test_ClassifySendError_TxTypeNotSupported(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Sugared(logger.Test(t))
+	err := errors.New("transaction type not supported")
+	dynamic := types.NewTx(&types.DynamicFeeTx{GasFeeCap: big.NewInt(2), GasTipCap: big.NewInt(1)})
+	assert.Equal(t, commonclient.TxTypeUnsupported, evmclient.ClassifySendError(err, lggr, dynamic, common.Address{}, false))
+
+	// a legacy transaction cannot fall back any further
+	legacy := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(1)})
+	assert.Equal(t, commonclient.Unknown, evmclient.ClassifySendError(err, lggr, legacy, common.Address{}, false))
+}
@@ -22,6 +22,7 @@ type TestNodePoolConfig struct {
 	NodeSelectionMode        string
 	NodeSyncThreshold        uint32
 	NodeLeaseDuration        time.Duration
+	NodePruningHorizon       uint32
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -31,6 +32,7 @@ func (tc TestNodePoolConfig) SyncThreshold() uint32        { return tc.NodeSyncT
 func (tc TestNodePoolConfig) LeaseDuration() time.Duration {
 	return tc.NodeLeaseDuration
 }
+func (tc TestNodePoolConfig) PruningHorizon() uint32 { return tc.NodePruningHorizon }
 
 func NewClientWithTestNode(t *testing.T, nodePoolCfg config.NodePool, noNewHeadsThreshold time.Duration, rpcUrl string, rpcHTTPURL *url.URL, sendonlyRPCURLs []url.URL, id int32, chainID *big.Int) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
@@ -106,7 +108,7 @@ func NewChainClientWithTestNode(
 	}
 
 	var chainType commonconfig.ChainType
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, primaries, sendonlys, chainID, chainType)
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, 0, primaries, sendonlys, chainID, chainType)
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -122,7 +124,7 @@ func NewChainClientWithEmptyNode(
 	lggr := logger.Test(t)
 
 	var chainType commonconfig.ChainType
-	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, nil, nil, chainID, chainType)
+	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, 0, nil, nil, chainID, chainType)
 	t.Cleanup(c.Close)
 	return c
 }
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// Bounds for the adaptive poll interval used to emulate subscriptions on HTTP-only nodes. The interval converges on
+// half of the observed block time, so that new heads are seen reasonably quickly without hammering the RPC.
+const (
+	httpPollIntervalInitial = time.Second
+	httpPollIntervalMin     = 250 * time.Millisecond
+	httpPollIntervalMax     = 15 * time.Second
+)
+
+var _ ethereum.Subscription = (*pollingSubscription)(nil)
+
+// pollingSubscription emulates an eth_subscribe subscription for nodes without a websocket endpoint. Like a websocket
+// subscription, a poll failure is delivered on Err and ends the subscription.
+type pollingSubscription struct {
+	stopCh services.StopChan
+	errCh  chan error
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+func newPollingSubscription(poll func(ctx context.Context) error) *pollingSubscription {
+	s := &pollingSubscription{
+		stopCh: make(services.StopChan),
+		errCh:  make(chan error, 1),
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ctx, cancel := s.stopCh.NewCtx()
+		defer cancel()
+		if err := poll(ctx); err != nil && ctx.Err() == nil {
+			s.errCh <- err
+		}
+	}()
+	return s
+}
+
+func (s *pollingSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.stopCh)
+		s.wg.Wait()
+		close(s.errCh)
+	})
+}
+
+func (s *pollingSubscription) Err() <-chan error {
+	return s.errCh
+}
+
+// adaptivePollInterval tracks the time between new blocks to derive the next poll interval
+type adaptivePollInterval struct {
+	interval    time.Duration
+	lastBlockAt time.Time
+}
+
+func newAdaptivePollInterval() *adaptivePollInterval {
+	return &adaptivePollInterval{interval: httpPollIntervalInitial}
+}
+
+// newBlock records that a new block was observed
+func (a *adaptivePollInterval) newBlock(now time.Time) {
+	if !a.lastBlockAt.IsZero() {
+		// exponentially weighted towards half the observed block time
+		a.interval = (a.interval + now.Sub(a.lastBlockAt)/2) / 2
+		if a.interval < httpPollIntervalMin {
+			a.interval = httpPollIntervalMin
+		} else if a.interval > httpPollIntervalMax {
+			a.interval = httpPollIntervalMax
+		}
+	}
+	a.lastBlockAt = now
+}
+
+func (a *adaptivePollInterval) wait(ctx context.Context) bool {
+	t := time.NewTimer(a.interval)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// pollHeads emulates a newHeads subscription by polling for the latest block
+func (r *rpcClient) pollHeads(ch chan<- *evmtypes.Head) *pollingSubscription {
+	return newPollingSubscription(func(ctx context.Context) error {
+		interval := newAdaptivePollInterval()
+		var latest int64 = -1
+		for {
+			head, err := r.BlockByNumber(ctx, nil)
+			if err != nil {
+				return err
+			}
+			if head != nil && head.Number > latest {
+				latest = head.Number
+				interval.newBlock(time.Now())
+				select {
+				case ch <- head:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+			if !interval.wait(ctx) {
+				return nil
+			}
+		}
+	})
+}
+
+// pollFilterLogs emulates a logs subscription by querying for logs in every new block range
+func (r *rpcClient) pollFilterLogs(q ethereum.FilterQuery, ch chan<- types.Log) *pollingSubscription {
+	return newPollingSubscription(func(ctx context.Context) error {
+		interval := newAdaptivePollInterval()
+		// like websocket log subscriptions, only logs in blocks after the subscription was made are delivered
+		latest, err := r.BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+		for {
+			if !interval.wait(ctx) {
+				return nil
+			}
+			current, err := r.BlockNumber(ctx)
+			if err != nil {
+				return err
+			}
+			if current <= latest {
+				continue
+			}
+			interval.newBlock(time.Now())
+			rq := q
+			rq.BlockHash = nil
+			rq.FromBlock = new(big.Int).SetUint64(latest + 1)
+			rq.ToBlock = new(big.Int).SetUint64(current)
+			logs, err := r.FilterLogs(ctx, rq)
+			if err != nil {
+				return err
+			}
+			for _, l := range logs {
+				select {
+				case ch <- l:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+			latest = current
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package client_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// newHTTPOnlyServer serves a chain which advances by one block per eth_blockNumber or eth_getBlockByNumber call, with
+// one log in every block.
+func newHTTPOnlyServer(t *testing.T) *url.URL {
+	var latest atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			return
+		}
+		var result string
+		switch req.Method {
+		case "eth_blockNumber":
+			result = fmt.Sprintf(`"%s"`, hexutil.EncodeUint64(uint64(latest.Add(1))))
+		case "eth_getBlockByNumber":
+			n := latest.Add(1)
+			result = fmt.Sprintf(`{"number":"%s","hash":"0x%064x","parentHash":"0x%064x"}`, hexutil.EncodeUint64(uint64(n)), n, n-1)
+		case "eth_getLogs":
+			var q struct {
+				FromBlock hexutil.Uint64 `json:"fromBlock"`
+				ToBlock   hexutil.Uint64 `json:"toBlock"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params[0], &q))
+			logs := []json.RawMessage{}
+			for n := q.FromBlock; n <= q.ToBlock; n++ {
+				logs = append(logs, json.RawMessage(fmt.Sprintf(`{"address":"0x%040x","topics":[],"data":"0x","blockNumber":"%s","transactionHash":"0x%064x","transactionIndex":"0x0","blockHash":"0x%064x","logIndex":"0x0","removed":false}`,
+					0, n.String(), uint64(n), uint64(n))))
+			}
+			b, err := json.Marshal(logs)
+			require.NoError(t, err)
+			result = string(b)
+		default:
+			t.Errorf("unexpected method %s", req.Method)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result)
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(ts.Close)
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestRPCClient_HTTPOnly(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(t *testing.T) client.RPCCLient {
+		rpc := client.NewRPCClient(logger.Test(t), url.URL{}, newHTTPOnlyServer(t), "http-only", 1, big.NewInt(42), commonclient.Primary)
+		require.NoError(t, rpc.Dial(testutils.Context(t)))
+		t.Cleanup(rpc.Close)
+		return rpc
+	}
+
+	t.Run("polls for new heads", func(t *testing.T) {
+		rpc := newClient(t)
+		ch := make(chan *evmtypes.Head)
+		sub, err := rpc.Subscribe(testutils.Context(t), ch, "newHeads")
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		var prev int64
+		for i := 0; i < 2; i++ {
+			select {
+			case h := <-ch:
+				assert.Greater(t, h.Number, prev)
+				assert.Equal(t, int64(42), h.EVMChainID.Int64())
+				prev = h.Number
+			case err := <-sub.Err():
+				t.Fatal(err)
+			case <-testutils.Context(t).Done():
+				t.Fatal("timed out waiting for head")
+			}
+		}
+	})
+
+	t.Run("rejects unsupported subscriptions", func(t *testing.T) {
+		rpc := newClient(t)
+		_, err := rpc.Subscribe(testutils.Context(t), make(chan *evmtypes.Head), "newPendingTransactions")
+		require.ErrorContains(t, err, "not supported over HTTP")
+	})
+
+	t.Run("polls for logs", func(t *testing.T) {
+		rpc := newClient(t)
+		ch := make(chan types.Log)
+		sub, err := rpc.SubscribeFilterLogs(testutils.Context(t), ethereum.FilterQuery{}, ch)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		select {
+		case l := <-ch:
+			// logs are only delivered for blocks after the subscription was made
+			assert.Greater(t, l.BlockNumber, uint64(1))
+		case err := <-sub.Err():
+			t.Fatal(err)
+		case <-testutils.Context(t).Done():
+			t.Fatal("timed out waiting for log")
+		}
+	})
+
+	t.Run("unsubscribe closes Err", func(t *testing.T) {
+		rpc := newClient(t)
+		sub, err := rpc.SubscribeFilterLogs(testutils.Context(t), ethereum.FilterQuery{}, make(chan types.Log))
+		require.NoError(t, err)
+		sub.Unsubscribe()
+		_, ok := <-sub.Err()
+		assert.False(t, ok)
+	})
+}
@@ -18,10 +18,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
@@ -453,7 +456,7 @@ func (n *node) CallContext(ctx context.Context, result interface{}, method strin
 		return err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With(
+	lggr := n.newRqLggr(ctx).With(
 		"method", method,
 		"args", args,
 	)
@@ -467,7 +470,7 @@ func (n *node) CallContext(ctx context.Context, result interface{}, method strin
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "CallContext")
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "CallContext")
 
 	return err
 }
@@ -478,7 +481,7 @@ func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 		return err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("nBatchElems", len(b), "batchElems", b)
+	lggr := n.newRqLggr(ctx).With("nBatchElems", len(b), "batchElems", b)
 
 	lggr.Trace("RPC call: evmclient.Client#BatchCallContext")
 	start := time.Now()
@@ -489,7 +492,7 @@ func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "BatchCallContext")
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "BatchCallContext")
 
 	return err
 }
@@ -500,7 +503,7 @@ func (n *node) EthSubscribe(ctx context.Context, channel chan<- *evmtypes.Head,
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("args", args)
+	lggr := n.newRqLggr(ctx).With("args", args)
 
 	lggr.Debug("RPC call: evmclient.Client#EthSubscribe")
 	start := time.Now()
@@ -510,7 +513,7 @@ func (n *node) EthSubscribe(ctx context.Context, channel chan<- *evmtypes.Head,
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "EthSubscribe")
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "EthSubscribe")
 
 	return sub, err
 }
@@ -523,7 +526,7 @@ func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (rece
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("txHash", txHash)
+	lggr := n.newRqLggr(ctx).With("txHash", txHash)
 
 	lggr.Debug("RPC call: evmclient.Client#TransactionReceipt")
 
@@ -537,7 +540,7 @@ func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (rece
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "TransactionReceipt",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "TransactionReceipt",
 		"receipt", receipt,
 	)
 
@@ -550,7 +553,7 @@ func (n *node) TransactionByHash(ctx context.Context, txHash common.Hash) (tx *t
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("txHash", txHash)
+	lggr := n.newRqLggr(ctx).With("txHash", txHash)
 
 	lggr.Debug("RPC call: evmclient.Client#TransactionByHash")
 
@@ -564,7 +567,7 @@ func (n *node) TransactionByHash(ctx context.Context, txHash common.Hash) (tx *t
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "TransactionByHash",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "TransactionByHash",
 		"receipt", tx,
 	)
 
@@ -577,7 +580,7 @@ func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *typ
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("number", number)
+	lggr := n.newRqLggr(ctx).With("number", number)
 
 	lggr.Debug("RPC call: evmclient.Client#HeaderByNumber")
 	start := time.Now()
@@ -590,7 +593,7 @@ func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *typ
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "HeaderByNumber", "header", header)
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "HeaderByNumber", "header", header)
 
 	return
 }
@@ -601,7 +604,7 @@ func (n *node) HeaderByHash(ctx context.Context, hash common.Hash) (header *type
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("hash", hash)
+	lggr := n.newRqLggr(ctx).With("hash", hash)
 
 	lggr.Debug("RPC call: evmclient.Client#HeaderByHash")
 	start := time.Now()
@@ -614,7 +617,7 @@ func (n *node) HeaderByHash(ctx context.Context, hash common.Hash) (header *type
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "HeaderByHash",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "HeaderByHash",
 		"header", header,
 	)
 
@@ -627,7 +630,7 @@ func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error
 		return err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("tx", tx)
+	lggr := n.newRqLggr(ctx).With("tx", tx)
 
 	lggr.Debug("RPC call: evmclient.Client#SendTransaction")
 	start := time.Now()
@@ -638,7 +641,7 @@ func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "SendTransaction")
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "SendTransaction")
 
 	return err
 }
@@ -650,7 +653,7 @@ func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonc
 		return 0, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("account", account)
+	lggr := n.newRqLggr(ctx).With("account", account)
 
 	lggr.Debug("RPC call: evmclient.Client#PendingNonceAt")
 	start := time.Now()
@@ -663,7 +666,7 @@ func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonc
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "PendingNonceAt",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "PendingNonceAt",
 		"nonce", nonce,
 	)
 
@@ -679,7 +682,7 @@ func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber
 		return 0, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("account", account, "blockNumber", blockNumber)
+	lggr := n.newRqLggr(ctx).With("account", account, "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#NonceAt")
 	start := time.Now()
@@ -692,7 +695,7 @@ func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "NonceAt",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "NonceAt",
 		"nonce", nonce,
 	)
 
@@ -705,7 +708,7 @@ func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("account", account)
+	lggr := n.newRqLggr(ctx).With("account", account)
 
 	lggr.Debug("RPC call: evmclient.Client#PendingCodeAt")
 	start := time.Now()
@@ -718,7 +721,7 @@ func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "PendingCodeAt",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "PendingCodeAt",
 		"code", code,
 	)
 
@@ -731,7 +734,7 @@ func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("account", account, "blockNumber", blockNumber)
+	lggr := n.newRqLggr(ctx).With("account", account, "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#CodeAt")
 	start := time.Now()
@@ -744,7 +747,7 @@ func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "CodeAt",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "CodeAt",
 		"code", code,
 	)
 
@@ -757,7 +760,7 @@ func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint
 		return 0, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("call", call)
+	lggr := n.newRqLggr(ctx).With("call", call)
 
 	lggr.Debug("RPC call: evmclient.Client#EstimateGas")
 	start := time.Now()
@@ -770,7 +773,7 @@ func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "EstimateGas",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "EstimateGas",
 		"gas", gas,
 	)
 
@@ -783,7 +786,7 @@ func (n *node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error)
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr()
+	lggr := n.newRqLggr(ctx)
 
 	lggr.Debug("RPC call: evmclient.Client#SuggestGasPrice")
 	start := time.Now()
@@ -796,7 +799,7 @@ func (n *node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error)
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "SuggestGasPrice",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "SuggestGasPrice",
 		"price", price,
 	)
 
@@ -809,7 +812,7 @@ func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumb
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("callMsg", msg, "blockNumber", blockNumber)
+	lggr := n.newRqLggr(ctx).With("callMsg", msg, "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#CallContract")
 	start := time.Now()
@@ -822,7 +825,7 @@ func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumb
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "CallContract",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "CallContract",
 		"val", val,
 	)
 
@@ -836,7 +839,7 @@ func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Blo
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("number", number)
+	lggr := n.newRqLggr(ctx).With("number", number)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockByNumber")
 	start := time.Now()
@@ -849,7 +852,7 @@ func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Blo
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "BlockByNumber",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "BlockByNumber",
 		"block", b,
 	)
 
@@ -862,7 +865,7 @@ func (n *node) BlockByHash(ctx context.Context, hash common.Hash) (b *types.Bloc
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("hash", hash)
+	lggr := n.newRqLggr(ctx).With("hash", hash)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockByHash")
 	start := time.Now()
@@ -875,7 +878,7 @@ func (n *node) BlockByHash(ctx context.Context, hash common.Hash) (b *types.Bloc
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "BlockByHash",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "BlockByHash",
 		"block", b,
 	)
 
@@ -888,7 +891,7 @@ func (n *node) BlockNumber(ctx context.Context) (height uint64, err error) {
 		return 0, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr()
+	lggr := n.newRqLggr(ctx)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockNumber")
 	start := time.Now()
@@ -901,7 +904,7 @@ func (n *node) BlockNumber(ctx context.Context) (height uint64, err error) {
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "BlockNumber",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "BlockNumber",
 		"height", height,
 	)
 
@@ -914,7 +917,7 @@ func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumbe
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("account", account.Hex(), "blockNumber", blockNumber)
+	lggr := n.newRqLggr(ctx).With("account", account.Hex(), "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#BalanceAt")
 	start := time.Now()
@@ -927,7 +930,7 @@ func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumbe
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "BalanceAt",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "BalanceAt",
 		"balance", balance,
 	)
 
@@ -940,7 +943,7 @@ func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []type
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("q", q)
+	lggr := n.newRqLggr(ctx).With("q", q)
 
 	lggr.Debug("RPC call: evmclient.Client#FilterLogs")
 	start := time.Now()
@@ -953,7 +956,7 @@ func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []type
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "FilterLogs",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "FilterLogs",
 		"log", l,
 	)
 
@@ -966,7 +969,7 @@ func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery,
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr().With("q", q)
+	lggr := n.newRqLggr(ctx).With("q", q)
 
 	lggr.Debug("RPC call: evmclient.Client#SubscribeFilterLogs")
 	start := time.Now()
@@ -977,7 +980,7 @@ func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery,
 	err = n.wrapWS(err)
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "SubscribeFilterLogs")
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "SubscribeFilterLogs")
 
 	return
 }
@@ -988,7 +991,7 @@ func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr()
+	lggr := n.newRqLggr(ctx)
 
 	lggr.Debug("RPC call: evmclient.Client#SuggestGasTipCap")
 	start := time.Now()
@@ -1001,7 +1004,7 @@ func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error
 	}
 	duration := time.Since(start)
 
-	n.logResult(lggr, err, duration, n.getRPCDomain(), "SuggestGasTipCap",
+	n.logResult(ctx, lggr, err, duration, n.getRPCDomain(), "SuggestGasTipCap",
 		"tipCap", tipCap,
 	)
 
@@ -1010,12 +1013,13 @@ func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error
 
 func (n *node) ChainID() (chainID *big.Int) { return n.chainID }
 
-// newRqLggr generates a new logger with a unique request ID
-func (n *node) newRqLggr() logger.SugaredLogger {
-	return n.rpcLog.With("requestID", uuid.New())
+// newRqLggr generates a new logger with a unique request ID, and the logging fields carried by ctx
+func (n *node) newRqLggr(ctx context.Context) logger.SugaredLogger {
+	return n.rpcLog.With(logctx.Fields(ctx)...).With("requestID", uuid.New())
 }
 
 func (n *node) logResult(
+	ctx context.Context,
 	lggr logger.Logger,
 	err error,
 	callDuration time.Duration,
@@ -1024,6 +1028,8 @@ func (n *node) logResult(
 	results ...interface{},
 ) {
 	slggr := logger.Sugared(lggr).With("duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
+	tracing.Record(ctx, "evm.rpc."+callName, time.Now().Add(-callDuration), err,
+		attribute.String("rpcNode", n.name), attribute.String("rpcDomain", rpcDomain))
 	promEVMPoolRPCNodeCalls.WithLabelValues(n.chainID.String(), n.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(n.chainID.String(), n.name).Inc()
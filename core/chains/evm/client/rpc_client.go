@@ -16,11 +16,14 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	commontypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -123,6 +126,11 @@ func (r *rpcClient) Dial(callerCtx context.Context) error {
 	}
 	lggr.Debugw("RPC dial: evmclient.Client#dial")
 
+	if r.httpOnly() {
+		// subscriptions are emulated by polling over HTTP, see pollHeads and pollFilterLogs
+		return r.DialHTTP()
+	}
+
 	wsrpc, err := rpc.DialWebsocket(ctx, r.ws.uri.String(), "")
 	if err != nil {
 		promEVMPoolRPCNodeDialsFailed.WithLabelValues(r.chainID.String(), r.name).Inc()
@@ -143,6 +151,11 @@ func (r *rpcClient) Dial(callerCtx context.Context) error {
 	return nil
 }
 
+// httpOnly returns true if the node has no websocket endpoint configured
+func (r *rpcClient) httpOnly() bool {
+	return r.ws.uri == (url.URL{}) && r.http != nil
+}
+
 // Not thread-safe, pure dial.
 // DialHTTP doesn't actually make any external HTTP calls
 // It can only return error if the URL is malformed.
@@ -195,6 +208,7 @@ func (r *rpcClient) String() string {
 }
 
 func (r *rpcClient) logResult(
+	ctx context.Context,
 	lggr logger.Logger,
 	err error,
 	callDuration time.Duration,
@@ -203,6 +217,8 @@ func (r *rpcClient) logResult(
 	results ...interface{},
 ) {
 	lggr = logger.With(lggr, "duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
+	tracing.Record(ctx, "evm.rpc."+callName, time.Now().Add(-callDuration), err,
+		attribute.String("rpcNode", r.name), attribute.String("rpcDomain", rpcDomain))
 	promEVMPoolRPCNodeCalls.WithLabelValues(r.chainID.String(), r.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(r.chainID.String(), r.name).Inc()
@@ -296,7 +312,7 @@ func (r *rpcClient) CallContext(ctx context.Context, result interface{}, method
 		return err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With(
+	lggr := r.newRqLggr(ctx).With(
 		"method", method,
 		"args", args,
 	)
@@ -310,7 +326,7 @@ func (r *rpcClient) CallContext(ctx context.Context, result interface{}, method
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContext")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "CallContext")
 
 	return err
 }
@@ -325,7 +341,7 @@ func (r *rpcClient) BatchCallContext(ctx context.Context, b []any) error {
 		batch[i] = arg.(rpc.BatchElem)
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("nBatchElems", len(b), "batchElems", b)
+	lggr := r.newRqLggr(ctx).With("nBatchElems", len(b), "batchElems", b)
 
 	lggr.Trace("RPC call: evmclient.Client#BatchCallContext")
 	start := time.Now()
@@ -336,7 +352,7 @@ func (r *rpcClient) BatchCallContext(ctx context.Context, b []any) error {
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BatchCallContext")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BatchCallContext")
 
 	return err
 }
@@ -347,17 +363,29 @@ func (r *rpcClient) Subscribe(ctx context.Context, channel chan<- *evmtypes.Head
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("args", args)
+	lggr := r.newRqLggr(ctx).With("args", args)
 
 	lggr.Debug("RPC call: evmclient.Client#EthSubscribe")
 	start := time.Now()
-	sub, err := ws.rpc.EthSubscribe(ctx, channel, args...)
-	if err == nil {
-		r.registerSub(sub)
+	var sub commontypes.Subscription
+	if ws.rpc == nil && r.httpOnly() {
+		if len(args) == 0 || args[0] != "newHeads" {
+			return nil, errors.Errorf("subscription %v is not supported over HTTP", args)
+		}
+		psub := r.pollHeads(channel)
+		r.registerSub(psub)
+		sub = psub
+	} else {
+		var rsub *rpc.ClientSubscription
+		rsub, err = ws.rpc.EthSubscribe(ctx, channel, args...)
+		if err == nil {
+			r.registerSub(rsub)
+			sub = rsub
+		}
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "EthSubscribe")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "EthSubscribe")
 
 	return sub, err
 }
@@ -382,7 +410,7 @@ func (r *rpcClient) TransactionReceiptGeth(ctx context.Context, txHash common.Ha
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("txHash", txHash)
+	lggr := r.newRqLggr(ctx).With("txHash", txHash)
 
 	lggr.Debug("RPC call: evmclient.Client#TransactionReceipt")
 
@@ -396,7 +424,7 @@ func (r *rpcClient) TransactionReceiptGeth(ctx context.Context, txHash common.Ha
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "TransactionReceipt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "TransactionReceipt",
 		"receipt", receipt,
 	)
 
@@ -408,7 +436,7 @@ func (r *rpcClient) TransactionByHash(ctx context.Context, txHash common.Hash) (
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("txHash", txHash)
+	lggr := r.newRqLggr(ctx).With("txHash", txHash)
 
 	lggr.Debug("RPC call: evmclient.Client#TransactionByHash")
 
@@ -422,7 +450,7 @@ func (r *rpcClient) TransactionByHash(ctx context.Context, txHash common.Hash) (
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "TransactionByHash",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "TransactionByHash",
 		"receipt", tx,
 	)
 
@@ -435,7 +463,7 @@ func (r *rpcClient) HeaderByNumber(ctx context.Context, number *big.Int) (header
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("number", number)
+	lggr := r.newRqLggr(ctx).With("number", number)
 
 	lggr.Debug("RPC call: evmclient.Client#HeaderByNumber")
 	start := time.Now()
@@ -448,7 +476,7 @@ func (r *rpcClient) HeaderByNumber(ctx context.Context, number *big.Int) (header
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "HeaderByNumber", "header", header)
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "HeaderByNumber", "header", header)
 
 	return
 }
@@ -459,7 +487,7 @@ func (r *rpcClient) HeaderByHash(ctx context.Context, hash common.Hash) (header
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("hash", hash)
+	lggr := r.newRqLggr(ctx).With("hash", hash)
 
 	lggr.Debug("RPC call: evmclient.Client#HeaderByHash")
 	start := time.Now()
@@ -472,7 +500,7 @@ func (r *rpcClient) HeaderByHash(ctx context.Context, hash common.Hash) (header
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "HeaderByHash",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "HeaderByHash",
 		"header", header,
 	)
 
@@ -512,7 +540,7 @@ func (r *rpcClient) BlockByHashGeth(ctx context.Context, hash common.Hash) (bloc
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("hash", hash)
+	lggr := r.newRqLggr(ctx).With("hash", hash)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockByHash")
 	start := time.Now()
@@ -525,7 +553,7 @@ func (r *rpcClient) BlockByHashGeth(ctx context.Context, hash common.Hash) (bloc
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BlockByHash",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BlockByHash",
 		"block", block,
 	)
 
@@ -538,7 +566,7 @@ func (r *rpcClient) BlockByNumberGeth(ctx context.Context, number *big.Int) (blo
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("number", number)
+	lggr := r.newRqLggr(ctx).With("number", number)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockByNumber")
 	start := time.Now()
@@ -551,7 +579,7 @@ func (r *rpcClient) BlockByNumberGeth(ctx context.Context, number *big.Int) (blo
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BlockByNumber",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BlockByNumber",
 		"block", block,
 	)
 
@@ -564,7 +592,7 @@ func (r *rpcClient) SendTransaction(ctx context.Context, tx *types.Transaction)
 		return err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("tx", tx)
+	lggr := r.newRqLggr(ctx).With("tx", tx)
 
 	lggr.Debug("RPC call: evmclient.Client#SendTransaction")
 	start := time.Now()
@@ -575,7 +603,7 @@ func (r *rpcClient) SendTransaction(ctx context.Context, tx *types.Transaction)
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "SendTransaction")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SendTransaction")
 
 	return err
 }
@@ -604,7 +632,7 @@ func (r *rpcClient) PendingSequenceAt(ctx context.Context, account common.Addres
 		return 0, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("account", account)
+	lggr := r.newRqLggr(ctx).With("account", account)
 
 	lggr.Debug("RPC call: evmclient.Client#PendingNonceAt")
 	start := time.Now()
@@ -620,7 +648,7 @@ func (r *rpcClient) PendingSequenceAt(ctx context.Context, account common.Addres
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "PendingNonceAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "PendingNonceAt",
 		"nonce", nonce,
 	)
 
@@ -636,7 +664,7 @@ func (r *rpcClient) SequenceAt(ctx context.Context, account common.Address, bloc
 		return 0, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("account", account, "blockNumber", blockNumber)
+	lggr := r.newRqLggr(ctx).With("account", account, "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#NonceAt")
 	start := time.Now()
@@ -652,7 +680,7 @@ func (r *rpcClient) SequenceAt(ctx context.Context, account common.Address, bloc
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "NonceAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "NonceAt",
 		"nonce", nonce,
 	)
 
@@ -665,7 +693,7 @@ func (r *rpcClient) PendingCodeAt(ctx context.Context, account common.Address) (
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("account", account)
+	lggr := r.newRqLggr(ctx).With("account", account)
 
 	lggr.Debug("RPC call: evmclient.Client#PendingCodeAt")
 	start := time.Now()
@@ -678,7 +706,7 @@ func (r *rpcClient) PendingCodeAt(ctx context.Context, account common.Address) (
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "PendingCodeAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "PendingCodeAt",
 		"code", code,
 	)
 
@@ -691,7 +719,7 @@ func (r *rpcClient) CodeAt(ctx context.Context, account common.Address, blockNum
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("account", account, "blockNumber", blockNumber)
+	lggr := r.newRqLggr(ctx).With("account", account, "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#CodeAt")
 	start := time.Now()
@@ -704,7 +732,7 @@ func (r *rpcClient) CodeAt(ctx context.Context, account common.Address, blockNum
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "CodeAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "CodeAt",
 		"code", code,
 	)
 
@@ -718,7 +746,7 @@ func (r *rpcClient) EstimateGas(ctx context.Context, c interface{}) (gas uint64,
 	}
 	defer cancel()
 	call := c.(ethereum.CallMsg)
-	lggr := r.newRqLggr().With("call", call)
+	lggr := r.newRqLggr(ctx).With("call", call)
 
 	lggr.Debug("RPC call: evmclient.Client#EstimateGas")
 	start := time.Now()
@@ -731,7 +759,7 @@ func (r *rpcClient) EstimateGas(ctx context.Context, c interface{}) (gas uint64,
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "EstimateGas",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "EstimateGas",
 		"gas", gas,
 	)
 
@@ -744,7 +772,7 @@ func (r *rpcClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err er
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr()
+	lggr := r.newRqLggr(ctx)
 
 	lggr.Debug("RPC call: evmclient.Client#SuggestGasPrice")
 	start := time.Now()
@@ -757,7 +785,7 @@ func (r *rpcClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err er
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "SuggestGasPrice",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SuggestGasPrice",
 		"price", price,
 	)
 
@@ -770,7 +798,7 @@ func (r *rpcClient) CallContract(ctx context.Context, msg interface{}, blockNumb
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("callMsg", msg, "blockNumber", blockNumber)
+	lggr := r.newRqLggr(ctx).With("callMsg", msg, "blockNumber", blockNumber)
 	message := msg.(ethereum.CallMsg)
 
 	lggr.Debug("RPC call: evmclient.Client#CallContract")
@@ -784,7 +812,7 @@ func (r *rpcClient) CallContract(ctx context.Context, msg interface{}, blockNumb
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContract",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "CallContract",
 		"val", val,
 	)
 
@@ -804,7 +832,7 @@ func (r *rpcClient) BlockNumber(ctx context.Context) (height uint64, err error)
 		return 0, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr()
+	lggr := r.newRqLggr(ctx)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockNumber")
 	start := time.Now()
@@ -817,7 +845,7 @@ func (r *rpcClient) BlockNumber(ctx context.Context) (height uint64, err error)
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BlockNumber",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BlockNumber",
 		"height", height,
 	)
 
@@ -830,7 +858,7 @@ func (r *rpcClient) BalanceAt(ctx context.Context, account common.Address, block
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("account", account.Hex(), "blockNumber", blockNumber)
+	lggr := r.newRqLggr(ctx).With("account", account.Hex(), "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#BalanceAt")
 	start := time.Now()
@@ -843,7 +871,7 @@ func (r *rpcClient) BalanceAt(ctx context.Context, account common.Address, block
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "BalanceAt",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "BalanceAt",
 		"balance", balance,
 	)
 
@@ -889,7 +917,7 @@ func (r *rpcClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l [
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("q", q)
+	lggr := r.newRqLggr(ctx).With("q", q)
 
 	lggr.Debug("RPC call: evmclient.Client#FilterLogs")
 	start := time.Now()
@@ -902,7 +930,7 @@ func (r *rpcClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l [
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "FilterLogs",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "FilterLogs",
 		"log", l,
 	)
 
@@ -920,18 +948,23 @@ func (r *rpcClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQu
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr().With("q", q)
+	lggr := r.newRqLggr(ctx).With("q", q)
 
 	lggr.Debug("RPC call: evmclient.Client#SubscribeFilterLogs")
 	start := time.Now()
-	sub, err = ws.geth.SubscribeFilterLogs(ctx, q, ch)
-	if err == nil {
+	if ws.geth == nil && r.httpOnly() {
+		sub = r.pollFilterLogs(q, ch)
 		r.registerSub(sub)
+	} else {
+		sub, err = ws.geth.SubscribeFilterLogs(ctx, q, ch)
+		if err == nil {
+			r.registerSub(sub)
+		}
+		err = r.wrapWS(err)
 	}
-	err = r.wrapWS(err)
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "SubscribeFilterLogs")
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SubscribeFilterLogs")
 
 	return
 }
@@ -942,7 +975,7 @@ func (r *rpcClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err
 		return nil, err
 	}
 	defer cancel()
-	lggr := r.newRqLggr()
+	lggr := r.newRqLggr(ctx)
 
 	lggr.Debug("RPC call: evmclient.Client#SuggestGasTipCap")
 	start := time.Now()
@@ -955,7 +988,7 @@ func (r *rpcClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err
 	}
 	duration := time.Since(start)
 
-	r.logResult(lggr, err, duration, r.getRPCDomain(), "SuggestGasTipCap",
+	r.logResult(ctx, lggr, err, duration, r.getRPCDomain(), "SuggestGasTipCap",
 		"tipCap", tipCap,
 	)
 
@@ -979,9 +1012,9 @@ func (r *rpcClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
 	return
 }
 
-// newRqLggr generates a new logger with a unique request ID
-func (r *rpcClient) newRqLggr() logger.SugaredLogger {
-	return r.rpcLog.With("requestID", uuid.New())
+// newRqLggr generates a new logger with a unique request ID, and the logging fields carried by ctx
+func (r *rpcClient) newRqLggr(ctx context.Context) logger.SugaredLogger {
+	return r.rpcLog.With(logctx.Fields(ctx)...).With("requestID", uuid.New())
 }
 
 func wrapCallError(err error, tp string) error {
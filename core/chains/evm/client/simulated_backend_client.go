@@ -302,7 +302,11 @@ func (c *SimulatedBackendClient) SubscribeNewHead(
 			case h := <-ch:
 				var head *evmtypes.Head
 				if h != nil {
-					head = &evmtypes.Head{Difficulty: h.Difficulty, Timestamp: time.Unix(int64(h.Time), 0), Number: h.Number.Int64(), Hash: h.Hash(), ParentHash: h.ParentHash, Parent: lastHead, EVMChainID: ubig.New(c.chainId)}
+					head = &evmtypes.Head{Difficulty: h.Difficulty, Timestamp: time.Unix(int64(h.Time), 0), Number: h.Number.Int64(), Hash: h.Hash(), ParentHash: h.ParentHash, EVMChainID: ubig.New(c.chainId)}
+					// after a reorg, the last head is not the parent of the new one
+					if lastHead != nil && lastHead.Hash == h.ParentHash {
+						head.Parent = lastHead
+					}
 					lastHead = head
 				}
 				select {
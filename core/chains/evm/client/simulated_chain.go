@@ -0,0 +1,318 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
+
+const (
+	// SimulatedChainGasLimit is the gas limit of the blocks of simulated chains.
+	SimulatedChainGasLimit = 30_000_000
+	// DefaultSimulatedBlockInterval is how often simulated chains produce blocks, until changed with SetBlockInterval.
+	DefaultSimulatedBlockInterval = time.Second
+)
+
+var (
+	// SimulatedChainID is the only chain ID supported by simulated chains, since it is fixed by the simulated backend.
+	SimulatedChainID = big.NewInt(1337)
+	// simulatedGenesisBalance is the balance of the accounts funded at genesis, 1M ETH.
+	simulatedGenesisBalance = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(1e18))
+)
+
+// SimulatedChain is an in-process EVM chain with controllable block production, reorgs and gas prices, which the
+// node runs against instead of RPC nodes when Insecure.SimulatedEVMChains is enabled. Blocks are produced every
+// block interval, or only on demand with Mine when the interval is zero.
+//
+// Transactions are rejected like a geth node would: transactions priced below the minimum gas price set with
+// SetMinGasPrice are underpriced, so that gas spikes can be simulated.
+type SimulatedChain struct {
+	*SimulatedBackendClient
+	services.StateMachine
+	lggr   logger.SugaredLogger
+	faucet *ecdsa.PrivateKey
+
+	// mu serializes the changes to the chain, so that mining, reorgs and funding do not interleave.
+	mu          sync.Mutex
+	interval    time.Duration
+	minGasPrice *big.Int
+
+	resetCh chan struct{}
+	stopCh  services.StopChan
+	wg      sync.WaitGroup
+}
+
+var _ Client = (*SimulatedChain)(nil)
+
+// NewSimulatedChain returns a simulated chain, with the accounts in funded given a large balance at genesis.
+func NewSimulatedChain(lggr logger.Logger, chainID *big.Int, funded []common.Address) (*SimulatedChain, error) {
+	if chainID.Cmp(SimulatedChainID) != 0 {
+		return nil, fmt.Errorf("simulated chains must have chain ID %s, got %s", SimulatedChainID, chainID)
+	}
+	faucet, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate faucet key: %w", err)
+	}
+	alloc := core.GenesisAlloc{crypto.PubkeyToAddress(faucet.PublicKey): {Balance: new(big.Int).Mul(simulatedGenesisBalance, big.NewInt(1_000))}}
+	for _, addr := range funded {
+		alloc[addr] = core.GenesisAccount{Balance: simulatedGenesisBalance}
+	}
+	b := backends.NewSimulatedBackend(alloc, SimulatedChainGasLimit)
+	return &SimulatedChain{
+		SimulatedBackendClient: NewSimulatedBackendClient(nil, b, chainID),
+		lggr:                   logger.Sugared(logger.Named(lggr, "SimulatedChain")),
+		faucet:                 faucet,
+		interval:               DefaultSimulatedBlockInterval,
+		resetCh:                make(chan struct{}, 1),
+		stopCh:                 make(services.StopChan),
+	}, nil
+}
+
+// Dial starts the block production.
+func (c *SimulatedChain) Dial(context.Context) error {
+	return c.StartOnce("SimulatedChain", func() error {
+		c.lggr.Warnw("Running against a simulated chain, which must never be used in production", "chainID", c.chainId)
+		c.wg.Add(1)
+		go c.run()
+		return nil
+	})
+}
+
+// Close stops the block production, and discards the chain.
+func (c *SimulatedChain) Close() {
+	_ = c.StopOnce("SimulatedChain", func() error {
+		close(c.stopCh)
+		c.wg.Wait()
+		return c.b.Close()
+	})
+}
+
+func (c *SimulatedChain) run() {
+	defer c.wg.Done()
+	for {
+		var (
+			timer *time.Timer
+			tick  <-chan time.Time
+		)
+		if interval := c.BlockInterval(); interval > 0 {
+			timer = time.NewTimer(interval)
+			tick = timer.C
+		}
+		select {
+		case <-c.stopCh:
+		case <-c.resetCh:
+		case <-tick:
+			c.Mine(1)
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		select {
+		case <-c.stopCh:
+			return
+		default:
+		}
+	}
+}
+
+// BlockInterval returns how often blocks are produced. Zero means only on demand.
+func (c *SimulatedChain) BlockInterval() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.interval
+}
+
+// SetBlockInterval changes how often blocks are produced. Zero stops the block production, so that blocks are only
+// produced on demand with Mine.
+func (c *SimulatedChain) SetBlockInterval(interval time.Duration) {
+	c.mu.Lock()
+	c.interval = interval
+	c.mu.Unlock()
+	select {
+	case c.resetCh <- struct{}{}:
+	default:
+	}
+}
+
+// Head returns the number of the latest block.
+func (c *SimulatedChain) Head() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.currentBlockNumber().Int64()
+}
+
+// Mine produces n blocks, including the pending transactions in the first one, and returns the latest head number.
+func (c *SimulatedChain) Mine(n int) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := 0; i < n; i++ {
+		c.b.Commit()
+	}
+	return c.currentBlockNumber().Int64()
+}
+
+// Reorg replaces the latest depth blocks with depth+1 new empty blocks. The transactions of the replaced blocks, and
+// the pending ones, are dropped, so they must be sent again to be mined. It returns the latest head number.
+func (c *SimulatedChain) Reorg(ctx context.Context, depth int) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	head := c.currentBlockNumber().Int64()
+	if depth < 1 || int64(depth) > head {
+		return 0, fmt.Errorf("reorg depth must be between 1 and the latest head number %d, got %d", head, depth)
+	}
+	ancestor, err := c.b.HeaderByNumber(ctx, big.NewInt(head-int64(depth)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the common ancestor of the reorg: %w", err)
+	}
+	c.b.Rollback()
+	if err = c.b.Fork(ctx, ancestor.Hash()); err != nil {
+		return 0, fmt.Errorf("failed to fork: %w", err)
+	}
+	// shift the time of the new blocks, otherwise they would be identical to the replaced empty blocks
+	if err = c.b.AdjustTime(time.Second); err != nil {
+		return 0, fmt.Errorf("failed to fork: %w", err)
+	}
+	for i := 0; i <= depth; i++ {
+		c.b.Commit()
+	}
+	newHead := c.currentBlockNumber().Int64()
+	c.lggr.Infow("Simulated reorg", "depth", depth, "ancestor", ancestor.Number, "head", newHead)
+	return newHead, nil
+}
+
+// MinGasPrice returns the minimum gas price of transactions, or nil if there is none apart from the base fee.
+func (c *SimulatedChain) MinGasPrice() *big.Int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.minGasPrice
+}
+
+// SetMinGasPrice sets the minimum gas price of transactions, which is also suggested as the gas price and tip cap,
+// to simulate a gas spike. Nil removes the minimum.
+func (c *SimulatedChain) SetMinGasPrice(price *big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.minGasPrice = price
+}
+
+// Fund sends amount from a faucet account to address, and returns the hash of the transaction. It is mined with the
+// next block.
+func (c *SimulatedChain) Fund(ctx context.Context, address common.Address, amount *big.Int) (common.Hash, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	from := crypto.PubkeyToAddress(c.faucet.PublicKey)
+	nonce, err := c.b.PendingNonceAt(ctx, from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	gasPrice, err := c.suggestGasPrice(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	tx, err := types.SignNewTx(c.faucet, types.LatestSignerForChainID(c.chainId), &types.LegacyTx{
+		Nonce:    nonce,
+		To:       &address,
+		Value:    amount,
+		Gas:      21_000,
+		GasPrice: new(big.Int).Mul(gasPrice, big.NewInt(2)),
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err = c.b.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// SuggestGasPrice returns the higher of the minimum gas price and the base fee of the pending block.
+func (c *SimulatedChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.suggestGasPrice(ctx)
+}
+
+func (c *SimulatedChain) suggestGasPrice(ctx context.Context) (*big.Int, error) {
+	price, err := c.b.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.minGasPrice != nil && c.minGasPrice.Cmp(price) > 0 {
+		return c.minGasPrice, nil
+	}
+	return price, nil
+}
+
+// SuggestGasTipCap returns the minimum gas price, if any.
+func (c *SimulatedChain) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	if minPrice := c.MinGasPrice(); minPrice != nil {
+		return minPrice, nil
+	}
+	return c.b.SuggestGasTipCap(ctx)
+}
+
+// SendTransactionReturnCode sends tx, and classifies the errors like those of a geth node.
+func (c *SimulatedChain) SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (commonclient.SendTxReturnCode, error) {
+	err := c.SendTransaction(ctx, tx)
+	return ClassifySendError(err, c.lggr, tx, fromAddress, false), err
+}
+
+// SendTransaction adds tx to the pending block, or returns the error a geth node would. Like the simulated backend
+// client, transactions with a nonce which is already used are ignored, since the backend cannot replace them.
+func (c *SimulatedChain) SendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	sender, err := types.Sender(types.LatestSignerForChainID(c.chainId), tx)
+	if err != nil {
+		return fmt.Errorf("invalid sender: %w", err)
+	}
+	if tx.Gas() > SimulatedChainGasLimit {
+		return errors.New("exceeds block gas limit")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	minPrice, err := c.suggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	if tx.GasFeeCap().Cmp(minPrice) < 0 {
+		return errors.New("transaction underpriced")
+	}
+	nonce, err := c.b.PendingNonceAt(ctx, sender)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() < nonce {
+		return nil
+	}
+	if tx.Nonce() > nonce {
+		return errors.New("nonce too high")
+	}
+	balance, err := c.b.BalanceAt(ctx, sender, nil)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(tx.Cost()) < 0 {
+		return errors.New("insufficient funds for gas * price + value")
+	}
+	// the backend panics on transactions which cannot be applied, which a node must survive
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid transaction: %v", r)
+		}
+	}()
+	return c.b.SendTransaction(ctx, tx)
+}
@@ -0,0 +1,152 @@
+package client_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func newSimulatedChain(t *testing.T) (*client.SimulatedChain, *types.Transaction) {
+	key, from := testutils.NewPrivateKeyAndAddress(t)
+	c, err := client.NewSimulatedChain(logger.Test(t), client.SimulatedChainID, []common.Address{from})
+	require.NoError(t, err)
+	c.SetBlockInterval(0)
+	require.NoError(t, c.Dial(testutils.Context(t)))
+	t.Cleanup(c.Close)
+
+	to := testutils.NewAddress()
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(client.SimulatedChainID), &types.LegacyTx{
+		Nonce:    0,
+		To:       &to,
+		Value:    big.NewInt(1),
+		Gas:      21_000,
+		GasPrice: big.NewInt(10e9),
+	})
+	require.NoError(t, err)
+	return c, tx
+}
+
+func TestNewSimulatedChain_invalidChainID(t *testing.T) {
+	_, err := client.NewSimulatedChain(logger.Test(t), big.NewInt(1), nil)
+	require.ErrorContains(t, err, "simulated chains must have chain ID 1337")
+}
+
+func TestSimulatedChain_Mine(t *testing.T) {
+	c, tx := newSimulatedChain(t)
+	ctx := testutils.Context(t)
+
+	code, err := c.SendTransactionReturnCode(ctx, tx, testutils.NewAddress())
+	require.NoError(t, err)
+	require.Equal(t, commonclient.Successful, code)
+
+	assert.Equal(t, int64(3), c.Mine(3))
+	assert.Equal(t, int64(3), c.Head())
+	receipt, err := c.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), receipt.BlockNumber)
+}
+
+func TestSimulatedChain_BlockInterval(t *testing.T) {
+	c, _ := newSimulatedChain(t)
+	heads := make(chan *evmtypes.Head, 10)
+	sub, err := c.SubscribeNewHead(testutils.Context(t), heads)
+	require.NoError(t, err)
+	t.Cleanup(sub.Unsubscribe)
+
+	c.SetBlockInterval(10 * time.Millisecond)
+	for i := int64(1); i <= 3; i++ {
+		select {
+		case h := <-heads:
+			assert.Equal(t, i, h.Number)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for head")
+		}
+	}
+	assert.Equal(t, 10*time.Millisecond, c.BlockInterval())
+}
+
+func TestSimulatedChain_Reorg(t *testing.T) {
+	c, tx := newSimulatedChain(t)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, c.SendTransaction(ctx, tx))
+	c.Mine(5)
+	old, err := c.HeadByNumber(ctx, big.NewInt(3))
+	require.NoError(t, err)
+
+	_, err = c.Reorg(ctx, 0)
+	require.Error(t, err)
+	_, err = c.Reorg(ctx, 6)
+	require.Error(t, err)
+
+	head, err := c.Reorg(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), head)
+	reorged, err := c.HeadByNumber(ctx, big.NewInt(3))
+	require.NoError(t, err)
+	assert.NotEqual(t, old.Hash, reorged.Hash)
+
+	// the transaction was in a replaced block, so it is dropped
+	_, err = c.TransactionReceipt(ctx, tx.Hash())
+	require.Error(t, err)
+	require.NoError(t, c.SendTransaction(ctx, tx))
+	c.Mine(1)
+	receipt, err := c.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(7), receipt.BlockNumber)
+}
+
+func TestSimulatedChain_MinGasPrice(t *testing.T) {
+	c, tx := newSimulatedChain(t)
+	ctx := testutils.Context(t)
+
+	c.SetMinGasPrice(big.NewInt(100e9))
+	price, err := c.SuggestGasPrice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100e9), price)
+	tip, err := c.SuggestGasTipCap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100e9), tip)
+
+	code, err := c.SendTransactionReturnCode(ctx, tx, testutils.NewAddress())
+	require.EqualError(t, err, "transaction underpriced")
+	assert.Equal(t, commonclient.Underpriced, code)
+
+	c.SetMinGasPrice(nil)
+	assert.Nil(t, c.MinGasPrice())
+	require.NoError(t, c.SendTransaction(ctx, tx))
+}
+
+func TestSimulatedChain_Fund(t *testing.T) {
+	c, _ := newSimulatedChain(t)
+	ctx := testutils.Context(t)
+	to := testutils.NewAddress()
+
+	_, err := c.Fund(ctx, to, big.NewInt(1e18))
+	require.NoError(t, err)
+	c.Mine(1)
+	balance, err := c.BalanceAt(ctx, to, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e18), balance)
+
+	key, from := testutils.NewPrivateKeyAndAddress(t)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(client.SimulatedChainID), &types.LegacyTx{
+		To: &to, Value: big.NewInt(1), Gas: 21_000, GasPrice: big.NewInt(10e9),
+	})
+	require.NoError(t, err)
+	code, err := c.SendTransactionReturnCode(ctx, tx, from)
+	require.EqualError(t, err, "insufficient funds for gas * price + value")
+	assert.Equal(t, commonclient.InsufficientFunds, code)
+}
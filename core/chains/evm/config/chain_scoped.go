@@ -2,6 +2,7 @@ package config
 
 import (
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/multierr"
@@ -20,7 +21,7 @@ import (
 func NewTOMLChainScopedConfig(appCfg config.AppConfig, tomlConfig *toml.EVMConfig, lggr logger.Logger) *ChainScoped {
 	return &ChainScoped{
 		AppConfig: appCfg,
-		evmConfig: &evmConfig{c: tomlConfig},
+		evmConfig: newEVMConfig(tomlConfig),
 		lggr:      lggr}
 }
 
@@ -37,7 +38,20 @@ func (c *ChainScoped) EVM() EVM {
 }
 
 func (c *ChainScoped) Nodes() toml.EVMNodes {
-	return c.evmConfig.c.Nodes
+	return c.evmConfig.c().Nodes
+}
+
+// Reload replaces the nodes and the gas estimator bounds of the chain with the ones of cfg. The other settings of cfg
+// are ignored, since they only take effect on restart.
+func (c *ChainScoped) Reload(cfg *toml.EVMConfig) {
+	reloaded := *c.evmConfig.c()
+	reloaded.Nodes = cfg.Nodes
+	reloaded.GasEstimator.PriceDefault = cfg.GasEstimator.PriceDefault
+	reloaded.GasEstimator.PriceMin = cfg.GasEstimator.PriceMin
+	reloaded.GasEstimator.PriceMax = cfg.GasEstimator.PriceMax
+	reloaded.GasEstimator.BumpMin = cfg.GasEstimator.BumpMin
+	reloaded.GasEstimator.LimitMax = cfg.GasEstimator.LimitMax
+	c.evmConfig.cfg.Store(&reloaded)
 }
 
 func (c *ChainScoped) BlockEmissionIdleWarningThreshold() time.Duration {
@@ -63,129 +77,148 @@ func (c *ChainScoped) Validate() (err error) {
 }
 
 type evmConfig struct {
-	c *toml.EVMConfig
+	// cfg is replaced as a whole when reloaded, see ChainScoped.Reload
+	cfg atomic.Pointer[toml.EVMConfig]
+}
+
+func newEVMConfig(c *toml.EVMConfig) *evmConfig {
+	e := &evmConfig{}
+	e.cfg.Store(c)
+	return e
+}
+
+func (e *evmConfig) c() *toml.EVMConfig {
+	return e.cfg.Load()
 }
 
 func (e *evmConfig) IsEnabled() bool {
-	return e.c.IsEnabled()
+	return e.c().IsEnabled()
 }
 
 func (e *evmConfig) TOMLString() (string, error) {
-	return e.c.TOMLString()
+	return e.c().TOMLString()
 }
 
 func (e *evmConfig) BalanceMonitor() BalanceMonitor {
-	return &balanceMonitorConfig{c: e.c.BalanceMonitor}
+	return &balanceMonitorConfig{c: e.c().BalanceMonitor}
 }
 
 func (e *evmConfig) Transactions() Transactions {
-	return &transactionsConfig{c: e.c.Transactions}
+	return &transactionsConfig{c: e.c().Transactions}
 }
 
 func (e *evmConfig) HeadTracker() HeadTracker {
-	return &headTrackerConfig{c: e.c.HeadTracker}
+	return &headTrackerConfig{c: e.c().HeadTracker}
+}
+
+func (e *evmConfig) HeadLagMonitor() HeadLagMonitor {
+	return &headLagMonitorConfig{c: e.c().HeadLagMonitor}
 }
 
 func (e *evmConfig) OCR() OCR {
-	return &ocrConfig{c: e.c.OCR}
+	return &ocrConfig{c: e.c().OCR}
 }
 
 func (e *evmConfig) OCR2() OCR2 {
-	return &ocr2Config{c: e.c.OCR2}
+	return &ocr2Config{c: e.c().OCR2}
+}
+
+func (e *evmConfig) VRFSubscriptionMonitor() VRFSubscriptionMonitor {
+	return &vrfSubscriptionMonitorConfig{c: e.c().VRFSubscriptionMonitor}
 }
 
 func (e *evmConfig) GasEstimator() GasEstimator {
-	return &gasEstimatorConfig{c: e.c.GasEstimator, blockDelay: e.c.RPCBlockQueryDelay, transactionsMaxInFlight: e.c.Transactions.MaxInFlight, k: e.c.KeySpecific}
+	return &gasEstimatorConfig{evm: e, blockDelay: e.c().RPCBlockQueryDelay, transactionsMaxInFlight: e.c().Transactions.MaxInFlight, k: e.c().KeySpecific}
 }
 
 func (e *evmConfig) AutoCreateKey() bool {
-	return *e.c.AutoCreateKey
+	return *e.c().AutoCreateKey
 }
 
 func (e *evmConfig) BlockBackfillDepth() uint64 {
-	return uint64(*e.c.BlockBackfillDepth)
+	return uint64(*e.c().BlockBackfillDepth)
 }
 
 func (e *evmConfig) BlockBackfillSkip() bool {
-	return *e.c.BlockBackfillSkip
+	return *e.c().BlockBackfillSkip
 }
 
 func (e *evmConfig) LogBackfillBatchSize() uint32 {
-	return *e.c.LogBackfillBatchSize
+	return *e.c().LogBackfillBatchSize
 }
 
 func (e *evmConfig) LogPollInterval() time.Duration {
-	return e.c.LogPollInterval.Duration()
+	return e.c().LogPollInterval.Duration()
 }
 
 func (e *evmConfig) FinalityDepth() uint32 {
-	return *e.c.FinalityDepth
+	return *e.c().FinalityDepth
 }
 
 func (e *evmConfig) FinalityTagEnabled() bool {
-	return *e.c.FinalityTagEnabled
+	return *e.c().FinalityTagEnabled
 }
 
 func (e *evmConfig) LogKeepBlocksDepth() uint32 {
-	return *e.c.LogKeepBlocksDepth
+	return *e.c().LogKeepBlocksDepth
 }
 
 func (e *evmConfig) NonceAutoSync() bool {
-	return *e.c.NonceAutoSync
+	return *e.c().NonceAutoSync
 }
 
 func (e *evmConfig) RPCDefaultBatchSize() uint32 {
-	return *e.c.RPCDefaultBatchSize
+	return *e.c().RPCDefaultBatchSize
 }
 
 func (e *evmConfig) BlockEmissionIdleWarningThreshold() time.Duration {
-	return e.c.NoNewHeadsThreshold.Duration()
+	return e.c().NoNewHeadsThreshold.Duration()
 }
 
 func (e *evmConfig) ChainType() commonconfig.ChainType {
-	if e.c.ChainType == nil {
+	if e.c().ChainType == nil {
 		return ""
 	}
-	return commonconfig.ChainType(*e.c.ChainType)
+	return commonconfig.ChainType(*e.c().ChainType)
 }
 
 func (e *evmConfig) ChainID() *big.Int {
-	return e.c.ChainID.ToInt()
+	return e.c().ChainID.ToInt()
 }
 
 func (e *evmConfig) MinIncomingConfirmations() uint32 {
-	return *e.c.MinIncomingConfirmations
+	return *e.c().MinIncomingConfirmations
 }
 
 func (e *evmConfig) NodePool() NodePool {
-	return &nodePoolConfig{c: e.c.NodePool}
+	return &nodePoolConfig{c: e.c().NodePool}
 }
 
 func (e *evmConfig) NodeNoNewHeadsThreshold() time.Duration {
-	return e.c.NoNewHeadsThreshold.Duration()
+	return e.c().NoNewHeadsThreshold.Duration()
 }
 
 func (e *evmConfig) MinContractPayment() *assets.Link {
-	return e.c.MinContractPayment
+	return e.c().MinContractPayment
 }
 
 func (e *evmConfig) FlagsContractAddress() string {
-	if e.c.FlagsContractAddress == nil {
+	if e.c().FlagsContractAddress == nil {
 		return ""
 	}
-	return e.c.FlagsContractAddress.String()
+	return e.c().FlagsContractAddress.String()
 }
 
 func (e *evmConfig) LinkContractAddress() string {
-	if e.c.LinkContractAddress == nil {
+	if e.c().LinkContractAddress == nil {
 		return ""
 	}
-	return e.c.LinkContractAddress.String()
+	return e.c().LinkContractAddress.String()
 }
 
 func (e *evmConfig) OperatorFactoryAddress() string {
-	if e.c.OperatorFactoryAddress == nil {
+	if e.c().OperatorFactoryAddress == nil {
 		return ""
 	}
-	return e.c.OperatorFactoryAddress.String()
+	return e.c().OperatorFactoryAddress.String()
 }
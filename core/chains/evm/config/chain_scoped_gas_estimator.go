@@ -8,12 +8,17 @@ import (
 )
 
 type gasEstimatorConfig struct {
-	c                       toml.GasEstimator
+	evm                     *evmConfig
 	k                       toml.KeySpecificConfig
 	blockDelay              *uint16
 	transactionsMaxInFlight *uint32
 }
 
+// c returns the latest gas estimator config, since its bounds are reloadable.
+func (g *gasEstimatorConfig) c() *toml.GasEstimator {
+	return &g.evm.c().GasEstimator
+}
+
 func (g *gasEstimatorConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei {
 	var keySpecific *assets.Wei
 	for i := range g.k {
@@ -24,88 +29,111 @@ func (g *gasEstimatorConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei {
 		}
 	}
 
-	chainSpecific := g.c.PriceMax
+	chainSpecific := g.c().PriceMax
 	if keySpecific != nil && keySpecific.Cmp(chainSpecific) < 0 {
 		return keySpecific
 	}
 
-	return g.c.PriceMax
+	return g.c().PriceMax
+}
+
+// BumpStrategyKey returns how the fees of the transactions of the key with address addr are bumped, with the bump
+// percent and min of the chain unless they are overridden for the key.
+func (g *gasEstimatorConfig) BumpStrategyKey(addr gethcommon.Address) BumpStrategy {
+	s := BumpStrategy{Percent: g.BumpPercent(), Min: g.BumpMin()}
+	for i := range g.k {
+		ks := g.k[i]
+		if ks.Key.Address() != addr {
+			continue
+		}
+		if v := ks.GasEstimator.BumpStrategy; v != nil {
+			s.Mode = *v
+		}
+		if v := ks.GasEstimator.BumpPercent; v != nil {
+			s.Percent = *v
+		}
+		if v := ks.GasEstimator.BumpMin; v != nil {
+			s.Min = v
+		}
+		break
+	}
+	return s
 }
 
 func (g *gasEstimatorConfig) BlockHistory() BlockHistory {
-	return &blockHistoryConfig{c: g.c.BlockHistory, blockDelay: g.blockDelay, bumpThreshold: g.c.BumpThreshold}
+	return &blockHistoryConfig{c: g.c().BlockHistory, blockDelay: g.blockDelay, bumpThreshold: g.c().BumpThreshold}
 }
 
 func (g *gasEstimatorConfig) EIP1559DynamicFees() bool {
-	return *g.c.EIP1559DynamicFees
+	return *g.c().EIP1559DynamicFees
 }
 
 func (g *gasEstimatorConfig) BumpPercent() uint16 {
-	return *g.c.BumpPercent
+	return *g.c().BumpPercent
 }
 
 func (g *gasEstimatorConfig) BumpThreshold() uint64 {
-	return uint64(*g.c.BumpThreshold)
+	return uint64(*g.c().BumpThreshold)
 }
 
 func (g *gasEstimatorConfig) BumpTxDepth() uint32 {
-	if g.c.BumpTxDepth != nil {
-		return *g.c.BumpTxDepth
+	if g.c().BumpTxDepth != nil {
+		return *g.c().BumpTxDepth
 	}
 	return *g.transactionsMaxInFlight
 }
 
 func (g *gasEstimatorConfig) BumpMin() *assets.Wei {
-	return g.c.BumpMin
+	return g.c().BumpMin
 }
 
 func (g *gasEstimatorConfig) FeeCapDefault() *assets.Wei {
-	return g.c.FeeCapDefault
+	return g.c().FeeCapDefault
 }
 
 func (g *gasEstimatorConfig) LimitDefault() uint32 {
-	return *g.c.LimitDefault
+	return *g.c().LimitDefault
 }
 
 func (g *gasEstimatorConfig) LimitMax() uint32 {
-	return *g.c.LimitMax
+	return *g.c().LimitMax
 }
 
 func (g *gasEstimatorConfig) LimitMultiplier() float32 {
-	f, _ := g.c.LimitMultiplier.BigFloat().Float32()
+	f, _ := g.c().LimitMultiplier.BigFloat().Float32()
 	return f
 }
 
 func (g *gasEstimatorConfig) LimitTransfer() uint32 {
-	return *g.c.LimitTransfer
+	return *g.c().LimitTransfer
 }
 
 func (g *gasEstimatorConfig) PriceDefault() *assets.Wei {
-	return g.c.PriceDefault
+	return g.c().PriceDefault
 }
 
 func (g *gasEstimatorConfig) PriceMin() *assets.Wei {
-	return g.c.PriceMin
+	return g.c().PriceMin
 }
 
 func (g *gasEstimatorConfig) PriceMax() *assets.Wei {
-	return g.c.PriceMax
+	return g.c().PriceMax
 }
 
 func (g *gasEstimatorConfig) TipCapDefault() *assets.Wei {
-	return g.c.TipCapDefault
+	return g.c().TipCapDefault
 }
 
 func (g *gasEstimatorConfig) TipCapMin() *assets.Wei {
-	return g.c.TipCapMin
+	return g.c().TipCapMin
 }
 
 func (g *gasEstimatorConfig) Mode() string {
-	return *g.c.Mode
+	return *g.c().Mode
 }
 
 func (g *gasEstimatorConfig) LimitJobType() LimitJobType {
-	return &limitJobTypeConfig{c: g.c.LimitJobType}
+	return &limitJobTypeConfig{c: g.c().LimitJobType}
 }
 
 type limitJobTypeConfig struct {
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+type headLagMonitorConfig struct {
+	c toml.HeadLagMonitor
+}
+
+func (m *headLagMonitorConfig) Enabled() bool {
+	return *m.c.Enabled
+}
+
+func (m *headLagMonitorConfig) PollInterval() time.Duration {
+	return m.c.PollInterval.Duration()
+}
+
+func (m *headLagMonitorConfig) MaxBlocksBehind() uint32 {
+	return *m.c.MaxBlocksBehind
+}
+
+func (m *headLagMonitorConfig) MaxTimeBehind() time.Duration {
+	return m.c.MaxTimeBehind.Duration()
+}
+
+func (m *headLagMonitorConfig) ReferenceURLs() []*url.URL {
+	urls := make([]*url.URL, len(m.c.ReferenceURLs))
+	for i, u := range m.c.ReferenceURLs {
+		urls[i] = u.URL()
+	}
+	return urls
+}
@@ -12,6 +12,14 @@ func (o *ocr2Automation) GasLimit() uint32 {
 	return *o.c.GasLimit
 }
 
+type ocr2CalldataCompression struct {
+	c toml.CalldataCompression
+}
+
+func (o *ocr2CalldataCompression) Mode() string {
+	return *o.c.Mode
+}
+
 type ocr2Config struct {
 	c toml.OCR2
 }
@@ -20,6 +28,10 @@ func (o *ocr2Config) Automation() OCR2Automation {
 	return &ocr2Automation{c: o.c.Automation}
 }
 
+func (o *ocr2Config) CalldataCompression() OCR2CalldataCompression {
+	return &ocr2CalldataCompression{c: o.c.CalldataCompression}
+}
+
 func (o *ocr2Config) ContractConfirmations() uint16 {
 	return uint16(*o.c.Automation.GasLimit)
 }
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"net/url"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -33,3 +36,62 @@ func (t *transactionsConfig) MaxInFlight() uint32 {
 func (t *transactionsConfig) MaxQueued() uint64 {
 	return uint64(*t.c.MaxQueued)
 }
+
+func (t *transactionsConfig) InclusionTarget() time.Duration {
+	return t.c.InclusionTarget.Duration()
+}
+
+func (t *transactionsConfig) AccountAbstraction() AccountAbstraction {
+	return &accountAbstractionConfig{c: t.c.AccountAbstraction}
+}
+
+type accountAbstractionConfig struct {
+	c toml.AccountAbstraction
+}
+
+func (a *accountAbstractionConfig) Enabled() bool {
+	return *a.c.Enabled
+}
+
+func (a *accountAbstractionConfig) Mode() string {
+	return *a.c.Mode
+}
+
+func (a *accountAbstractionConfig) BundlerURL() *url.URL {
+	if a.c.BundlerURL == nil {
+		return nil
+	}
+	return a.c.BundlerURL.URL()
+}
+
+func (a *accountAbstractionConfig) EntryPoint() gethcommon.Address {
+	if a.c.EntryPoint == nil {
+		return gethcommon.Address{}
+	}
+	return a.c.EntryPoint.Address()
+}
+
+func (a *accountAbstractionConfig) Account() gethcommon.Address {
+	if a.c.Account == nil {
+		return gethcommon.Address{}
+	}
+	return a.c.Account.Address()
+}
+
+func (a *accountAbstractionConfig) Paymaster() gethcommon.Address {
+	if a.c.Paymaster == nil {
+		return gethcommon.Address{}
+	}
+	return a.c.Paymaster.Address()
+}
+
+func (a *accountAbstractionConfig) PaymasterInput() []byte {
+	if a.c.PaymasterInput == nil {
+		return nil
+	}
+	return *a.c.PaymasterInput
+}
+
+func (a *accountAbstractionConfig) PollPeriod() time.Duration {
+	return a.c.PollPeriod.Duration()
+}
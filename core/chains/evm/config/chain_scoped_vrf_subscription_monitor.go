@@ -0,0 +1,35 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+type vrfSubscriptionMonitorConfig struct {
+	c toml.VRFSubscriptionMonitor
+}
+
+func (m *vrfSubscriptionMonitorConfig) Enabled() bool {
+	return *m.c.Enabled
+}
+
+func (m *vrfSubscriptionMonitorConfig) PollInterval() time.Duration {
+	return m.c.PollInterval.Duration()
+}
+
+func (m *vrfSubscriptionMonitorConfig) CostWindow() time.Duration {
+	return m.c.CostWindow.Duration()
+}
+
+func (m *vrfSubscriptionMonitorConfig) RunwayThreshold() time.Duration {
+	return m.c.RunwayThreshold.Duration()
+}
+
+func (m *vrfSubscriptionMonitorConfig) AlertWebhookURL() *url.URL {
+	if m.c.AlertWebhookURL == nil {
+		return nil
+	}
+	return m.c.AlertWebhookURL.URL()
+}
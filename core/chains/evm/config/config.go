@@ -2,6 +2,7 @@ package config
 
 import (
 	"math/big"
+	"net/url"
 	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -14,12 +15,14 @@ import (
 
 type EVM interface {
 	HeadTracker() HeadTracker
+	HeadLagMonitor() HeadLagMonitor
 	BalanceMonitor() BalanceMonitor
 	Transactions() Transactions
 	GasEstimator() GasEstimator
 	OCR() OCR
 	OCR2() OCR2
 	NodePool() NodePool
+	VRFSubscriptionMonitor() VRFSubscriptionMonitor
 
 	AutoCreateKey() bool
 	BlockBackfillDepth() uint64
@@ -56,18 +59,39 @@ type OCR interface {
 
 type OCR2 interface {
 	Automation() OCR2Automation
+	CalldataCompression() OCR2CalldataCompression
 }
 
 type OCR2Automation interface {
 	GasLimit() uint32
 }
 
+type OCR2CalldataCompression interface {
+	Mode() string
+}
+
 type HeadTracker interface {
 	HistoryDepth() uint32
 	MaxBufferSize() uint32
 	SamplingInterval() time.Duration
 }
 
+type HeadLagMonitor interface {
+	Enabled() bool
+	PollInterval() time.Duration
+	MaxBlocksBehind() uint32
+	MaxTimeBehind() time.Duration
+	ReferenceURLs() []*url.URL
+}
+
+type VRFSubscriptionMonitor interface {
+	Enabled() bool
+	PollInterval() time.Duration
+	CostWindow() time.Duration
+	RunwayThreshold() time.Duration
+	AlertWebhookURL() *url.URL
+}
+
 type BalanceMonitor interface {
 	Enabled() bool
 }
@@ -79,6 +103,30 @@ type Transactions interface {
 	ReaperThreshold() time.Duration
 	MaxInFlight() uint32
 	MaxQueued() uint64
+	InclusionTarget() time.Duration
+	AccountAbstraction() AccountAbstraction
+}
+
+const (
+	// AccountAbstractionModeUserOp submits transactions as ERC-4337 user operations of a smart account, through a bundler.
+	AccountAbstractionModeUserOp = "userop"
+	// AccountAbstractionModePaymaster submits transactions as the chain-native transactions whose fees are paid by a
+	// paymaster, like the EIP-712 transactions of zkSync.
+	AccountAbstractionModePaymaster = "paymaster"
+)
+
+// AccountAbstraction configures the submission of transactions whose fees are not paid from the native balance of the
+// sending keys.
+type AccountAbstraction interface {
+	Enabled() bool
+	// Mode is one of the AccountAbstractionMode constants.
+	Mode() string
+	BundlerURL() *url.URL
+	EntryPoint() gethcommon.Address
+	Account() gethcommon.Address
+	Paymaster() gethcommon.Address
+	PaymasterInput() []byte
+	PollPeriod() time.Duration
 }
 
 //go:generate mockery --quiet --name GasEstimator --output ./mocks/ --case=underscore
@@ -103,6 +151,26 @@ type GasEstimator interface {
 	PriceMin() *assets.Wei
 	Mode() string
 	PriceMaxKey(gethcommon.Address) *assets.Wei
+	BumpStrategyKey(gethcommon.Address) BumpStrategy
+}
+
+const (
+	// BumpModePercent bumps fees by the bump percent.
+	BumpModePercent = "Percent"
+	// BumpModeFixed bumps fees by the bump min.
+	BumpModeFixed = "Fixed"
+	// BumpModeOracle bumps fees to the current estimate, or by the bump percent if that is higher, since nodes reject
+	// replacements which are not priced high enough above the original.
+	BumpModeOracle = "Oracle"
+)
+
+// BumpStrategy is how the fees of the transactions of a key are bumped.
+type BumpStrategy struct {
+	// Mode is one of the BumpMode constants, or empty for the default bumping of the chain: to the largest of the
+	// percentage bump, the fixed bump and the current estimate.
+	Mode    string
+	Percent uint16
+	Min     *assets.Wei
 }
 
 type LimitJobType interface {
@@ -130,6 +198,7 @@ type NodePool interface {
 	SelectionMode() string
 	SyncThreshold() uint32
 	LeaseDuration() time.Duration
+	PruningHorizon() uint32
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -14,6 +14,7 @@ import (
 	configurl "github.com/smartcontractkit/chainlink-common/pkg/config"
 	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -214,6 +215,32 @@ func TestChainScopedConfig(t *testing.T) {
 		})
 	})
 
+	t.Run("BumpStrategyKey", func(t *testing.T) {
+		addr, percentAddr := testutils.NewAddress(), testutils.NewAddress()
+		gcfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].GasEstimator.BumpPercent = ptr[uint16](20)
+			c.EVM[0].GasEstimator.BumpMin = assets.GWei(5)
+			c.EVM[0].KeySpecific = toml.KeySpecificConfig{
+				{Key: ptr(ethkey.EIP55AddressFromAddress(addr)),
+					GasEstimator: toml.KeySpecificGasEstimator{
+						BumpStrategy: ptr(evmconfig.BumpModeFixed),
+						BumpMin:      assets.GWei(50),
+					},
+				},
+				{Key: ptr(ethkey.EIP55AddressFromAddress(percentAddr)),
+					GasEstimator: toml.KeySpecificGasEstimator{
+						BumpStrategy: ptr(evmconfig.BumpModePercent),
+					},
+				},
+			}
+		})
+		ge := evmtest.NewChainScopedConfig(t, gcfg).EVM().GasEstimator()
+
+		assert.Equal(t, evmconfig.BumpStrategy{Mode: evmconfig.BumpModeFixed, Percent: 20, Min: assets.GWei(50)}, ge.BumpStrategyKey(addr))
+		assert.Equal(t, evmconfig.BumpStrategy{Mode: evmconfig.BumpModePercent, Percent: 20, Min: assets.GWei(5)}, ge.BumpStrategyKey(percentAddr))
+		assert.Equal(t, evmconfig.BumpStrategy{Percent: 20, Min: assets.GWei(5)}, ge.BumpStrategyKey(testutils.NewAddress()))
+	})
+
 	t.Run("LinkContractAddress", func(t *testing.T) {
 		t.Run("uses chain-specific default value when nothing is set", func(t *testing.T) {
 			assert.Equal(t, "", cfg.EVM().LinkContractAddress())
@@ -289,6 +316,31 @@ func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	assert.Equal(t, assets.NewWeiI(1), ge.TipCapMin())
 }
 
+func TestChainScopedConfig_Reload(t *testing.T) {
+	t.Parallel()
+	gcfg := configtest.NewGeneralConfig(t, nil)
+	cfg, ok := evmtest.NewChainScopedConfig(t, gcfg).(*evmconfig.ChainScoped)
+	require.True(t, ok)
+
+	ge := cfg.EVM().GasEstimator()
+	require.Equal(t, assets.GWei(20), ge.PriceDefault())
+
+	reloaded := *gcfg.EVMConfigs()[0]
+	reloaded.GasEstimator.PriceDefault = assets.GWei(30)
+	reloaded.GasEstimator.PriceMax = assets.GWei(600)
+	reloaded.GasEstimator.BumpPercent = ptr[uint16](50)
+	node := *reloaded.Nodes[0]
+	node.Name = ptr("secondary")
+	reloaded.Nodes = append(toml.EVMNodes{}, reloaded.Nodes[0], &node)
+	cfg.Reload(&reloaded)
+
+	assert.Equal(t, assets.GWei(30), ge.PriceDefault())
+	assert.Equal(t, assets.GWei(600), ge.PriceMax())
+	assert.Equal(t, uint16(20), ge.BumpPercent(), "only the gas estimator bounds are reloaded")
+	assert.Len(t, cfg.Nodes(), 2)
+	assert.Equal(t, assets.GWei(20), gcfg.EVMConfigs()[0].GasEstimator.PriceDefault, "the general config is not modified")
+}
+
 func TestChainScopedConfig_BSCDefaults(t *testing.T) {
 	chainID := big.NewInt(56)
 	gcfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, secrets *chainlink.Secrets) {
@@ -40,6 +40,24 @@ func (_m *ChainScopedConfig) AppID() uuid.UUID {
 	return r0
 }
 
+// AptosEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) AptosEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AptosEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // AuditLogger provides a mock function with given fields:
 func (_m *ChainScopedConfig) AuditLogger() coreconfig.AuditLogger {
 	ret := _m.Called()
@@ -80,6 +98,26 @@ func (_m *ChainScopedConfig) AutoPprof() coreconfig.AutoPprof {
 	return r0
 }
 
+// BalanceMonitor provides a mock function with given fields:
+func (_m *ChainScopedConfig) BalanceMonitor() coreconfig.BalanceMonitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BalanceMonitor")
+	}
+
+	var r0 coreconfig.BalanceMonitor
+	if rf, ok := ret.Get(0).(func() coreconfig.BalanceMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(coreconfig.BalanceMonitor)
+		}
+	}
+
+	return r0
+}
+
 // CosmosEnabled provides a mock function with given fields:
 func (_m *ChainScopedConfig) CosmosEnabled() bool {
 	ret := _m.Called()
@@ -292,6 +330,26 @@ func (_m *ChainScopedConfig) Keeper() coreconfig.Keeper {
 	return r0
 }
 
+// Keystore provides a mock function with given fields:
+func (_m *ChainScopedConfig) Keystore() coreconfig.Keystore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keystore")
+	}
+
+	var r0 coreconfig.Keystore
+	if rf, ok := ret.Get(0).(func() coreconfig.Keystore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(coreconfig.Keystore)
+		}
+	}
+
+	return r0
+}
+
 // Log provides a mock function with given fields:
 func (_m *ChainScopedConfig) Log() coreconfig.Log {
 	ret := _m.Called()
@@ -74,6 +74,24 @@ func (_m *GasEstimator) BumpPercent() uint16 {
 	return r0
 }
 
+// BumpStrategyKey provides a mock function with given fields: _a0
+func (_m *GasEstimator) BumpStrategyKey(_a0 common.Address) config.BumpStrategy {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BumpStrategyKey")
+	}
+
+	var r0 config.BumpStrategy
+	if rf, ok := ret.Get(0).(func(common.Address) config.BumpStrategy); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(config.BumpStrategy)
+	}
+
+	return r0
+}
+
 // BumpThreshold provides a mock function with given fields:
 func (_m *GasEstimator) BumpThreshold() uint64 {
 	ret := _m.Called()
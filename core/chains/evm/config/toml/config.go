@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/shopspring/decimal"
@@ -249,6 +250,67 @@ func (cs EVMConfigs) NodeStatuses(chainIDs ...string) (ns []commontypes.NodeStat
 	return
 }
 
+// EVMProfiles are named sets of Chain fields, which EVMConfigs inherit from by setting Profile.
+type EVMProfiles []*EVMProfile
+
+// EVMProfile is a partial Chain. Unset fields fall back to the defaults of each inheriting chain.
+type EVMProfile struct {
+	Name *string
+	Chain
+}
+
+func (ps EVMProfiles) validateKeys() (err error) {
+	names := commonconfig.UniqueStrings{}
+	for i, p := range ps {
+		if p.Name == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("%d.Name", i), Msg: "required for all profiles"})
+		} else if *p.Name == "" {
+			err = multierr.Append(err, commonconfig.ErrEmpty{Name: fmt.Sprintf("%d.Name", i), Msg: "required for all profiles"})
+		} else if names.IsDupe(p.Name) {
+			err = multierr.Append(err, commonconfig.NewErrDuplicate(fmt.Sprintf("%d.Name", i), *p.Name))
+		}
+	}
+	return
+}
+
+// SetFrom merges the profiles of fs into ps, by name.
+func (ps *EVMProfiles) SetFrom(fs *EVMProfiles) (err error) {
+	if err1 := fs.validateKeys(); err1 != nil {
+		return err1
+	}
+	for _, f := range *fs {
+		if i := slices.IndexFunc(*ps, func(p *EVMProfile) bool {
+			return *p.Name == *f.Name
+		}); i == -1 {
+			*ps = append(*ps, f)
+		} else {
+			(*ps)[i].Chain.SetFrom(&f.Chain)
+		}
+	}
+	return
+}
+
+// Chain returns the Chain fields of the named profile, or nil if there is none.
+func (ps EVMProfiles) Chain(name string) *Chain {
+	for _, p := range ps {
+		if *p.Name == name {
+			return &p.Chain
+		}
+	}
+	return nil
+}
+
+// ValidateProfiles returns an error if any of the configs inherits from a profile which is not in ps.
+func (cs EVMConfigs) ValidateProfiles(ps EVMProfiles) (err error) {
+	for i, c := range cs {
+		if c.Profile != nil && ps.Chain(*c.Profile) == nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("%d.Profile", i), Value: *c.Profile,
+				Msg: "must be the Name of an EVMProfiles entry"})
+		}
+	}
+	return
+}
+
 type EVMNodes []*Node
 
 func (ns *EVMNodes) SetFrom(fs *EVMNodes) {
@@ -268,6 +330,7 @@ func (ns *EVMNodes) SetFrom(fs *EVMNodes) {
 type EVMConfig struct {
 	ChainID *big.Big
 	Enabled *bool
+	Profile *string
 	Chain
 	Nodes EVMNodes
 }
@@ -283,6 +346,9 @@ func (c *EVMConfig) SetFrom(f *EVMConfig) {
 	if f.Enabled != nil {
 		c.Enabled = f.Enabled
 	}
+	if f.Profile != nil {
+		c.Profile = f.Profile
+	}
 	c.Chain.SetFrom(&f.Chain)
 	c.Nodes.SetFrom(&f.Nodes)
 }
@@ -313,17 +379,30 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 	if len(c.Nodes) == 0 {
 		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
 	} else {
-		var hasPrimary bool
+		var hasPrimary, hasFullPrimary, hasWritablePrimary bool
 		for _, n := range c.Nodes {
 			if n.SendOnly != nil && *n.SendOnly {
 				continue
 			}
 			hasPrimary = true
-			break
+			if n.Archive != nil && *n.Archive {
+				continue
+			}
+			hasFullPrimary = true
+			if n.ReadOnly == nil || !*n.ReadOnly {
+				hasWritablePrimary = true
+				break
+			}
 		}
 		if !hasPrimary {
 			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes",
-				Msg: "must have at least one primary node with WSURL"})
+				Msg: "must have at least one primary node"})
+		} else if !hasFullPrimary {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes",
+				Msg: "must have at least one primary node which is not Archive"})
+		} else if !hasWritablePrimary {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes",
+				Msg: "must have at least one primary node which is not ReadOnly"})
 		}
 	}
 
@@ -364,10 +443,13 @@ type Chain struct {
 	BalanceMonitor BalanceMonitor    `toml:",omitempty"`
 	GasEstimator   GasEstimator      `toml:",omitempty"`
 	HeadTracker    HeadTracker       `toml:",omitempty"`
+	HeadLagMonitor HeadLagMonitor    `toml:",omitempty"`
 	KeySpecific    KeySpecificConfig `toml:",omitempty"`
 	NodePool       NodePool          `toml:",omitempty"`
 	OCR            OCR               `toml:",omitempty"`
 	OCR2           OCR2              `toml:",omitempty"`
+
+	VRFSubscriptionMonitor VRFSubscriptionMonitor `toml:",omitempty"`
 }
 
 func (c *Chain) ValidateConfig() (err error) {
@@ -396,6 +478,11 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MinIncomingConfirmations", Value: *c.MinIncomingConfirmations,
 			Msg: "must be greater than or equal to 1"})
 	}
+	if aa := c.Transactions.AccountAbstraction; aa.Enabled != nil && *aa.Enabled && aa.Mode != nil && *aa.Mode == "paymaster" &&
+		chainType != config.ChainZkSync {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Transactions.AccountAbstraction.Mode", Value: *aa.Mode,
+			Msg: fmt.Sprintf("paymaster transactions are only supported by ChainType %s", config.ChainZkSync)})
+	}
 	return
 }
 
@@ -406,6 +493,9 @@ type Transactions struct {
 	ReaperInterval       *commonconfig.Duration
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
+	InclusionTarget      *commonconfig.Duration
+
+	AccountAbstraction AccountAbstraction `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -427,14 +517,92 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	if v := f.InclusionTarget; v != nil {
+		t.InclusionTarget = v
+	}
+	t.AccountAbstraction.setFrom(&f.AccountAbstraction)
+}
+
+type AccountAbstraction struct {
+	Enabled        *bool
+	Mode           *string
+	BundlerURL     *commonconfig.URL
+	EntryPoint     *ethkey.EIP55Address
+	Account        *ethkey.EIP55Address
+	Paymaster      *ethkey.EIP55Address
+	PaymasterInput *hexutil.Bytes
+	PollPeriod     *commonconfig.Duration
+}
+
+func (a *AccountAbstraction) setFrom(f *AccountAbstraction) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.Mode; v != nil {
+		a.Mode = v
+	}
+	if v := f.BundlerURL; v != nil {
+		a.BundlerURL = v
+	}
+	if v := f.EntryPoint; v != nil {
+		a.EntryPoint = v
+	}
+	if v := f.Account; v != nil {
+		a.Account = v
+	}
+	if v := f.Paymaster; v != nil {
+		a.Paymaster = v
+	}
+	if v := f.PaymasterInput; v != nil {
+		a.PaymasterInput = v
+	}
+	if v := f.PollPeriod; v != nil {
+		a.PollPeriod = v
+	}
+}
+
+func (a *AccountAbstraction) ValidateConfig() (err error) {
+	if a.Enabled == nil || !*a.Enabled {
+		return
+	}
+	var mode string
+	if a.Mode != nil {
+		mode = *a.Mode
+	}
+	switch mode {
+	case "userop":
+		if a.BundlerURL == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "BundlerURL", Msg: "required for userop mode"})
+		}
+		if a.EntryPoint == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "EntryPoint", Msg: "required for userop mode"})
+		}
+		if a.Account == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Account", Msg: "required for userop mode"})
+		}
+	case "paymaster":
+		if a.Paymaster == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Paymaster", Msg: "required for paymaster mode"})
+		}
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: mode,
+			Msg: "must be one of userop or paymaster"})
+	}
+	if a.PollPeriod != nil && a.PollPeriod.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PollPeriod", Value: a.PollPeriod,
+			Msg: "must be greater than zero"})
+	}
+	return
 }
 
 type OCR2 struct {
-	Automation Automation `toml:",omitempty"`
+	Automation          Automation          `toml:",omitempty"`
+	CalldataCompression CalldataCompression `toml:",omitempty"`
 }
 
 func (o *OCR2) setFrom(f *OCR2) {
 	o.Automation.setFrom(&f.Automation)
+	o.CalldataCompression.setFrom(&f.CalldataCompression)
 }
 
 type Automation struct {
@@ -447,6 +615,29 @@ func (a *Automation) setFrom(f *Automation) {
 	}
 }
 
+type CalldataCompression struct {
+	Mode *string
+}
+
+func (c *CalldataCompression) setFrom(f *CalldataCompression) {
+	if v := f.Mode; v != nil {
+		c.Mode = v
+	}
+}
+
+func (c *CalldataCompression) ValidateConfig() (err error) {
+	if c.Mode == nil {
+		return
+	}
+	switch *c.Mode {
+	case "None", "LibZip":
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: *c.Mode,
+			Msg: "must be None or LibZip"})
+	}
+	return
+}
+
 type BalanceMonitor struct {
 	Enabled *bool
 }
@@ -656,13 +847,54 @@ type KeySpecific struct {
 }
 
 type KeySpecificGasEstimator struct {
-	PriceMax *assets.Wei
+	PriceMax     *assets.Wei
+	BumpStrategy *string
+	BumpPercent  *uint16
+	BumpMin      *assets.Wei
+}
+
+func (e *KeySpecificGasEstimator) ValidateConfig() (err error) {
+	if e.BumpStrategy == nil {
+		if e.BumpPercent != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BumpPercent", Value: *e.BumpPercent,
+				Msg: "requires BumpStrategy to be set"})
+		}
+		if e.BumpMin != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BumpMin", Value: e.BumpMin,
+				Msg: "requires BumpStrategy to be set"})
+		}
+		return
+	}
+	switch *e.BumpStrategy {
+	case "Percent", "Fixed", "Oracle":
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BumpStrategy", Value: *e.BumpStrategy,
+			Msg: "must be one of Percent, Fixed or Oracle"})
+	}
+	if e.BumpPercent != nil && uint64(*e.BumpPercent) < legacypool.DefaultConfig.PriceBump {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BumpPercent", Value: *e.BumpPercent,
+			Msg: fmt.Sprintf("may not be less than Geth's default of %d", legacypool.DefaultConfig.PriceBump)})
+	}
+	if e.BumpMin != nil && e.BumpMin.IsZero() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BumpMin", Value: e.BumpMin,
+			Msg: "must be greater than zero"})
+	}
+	return
 }
 
 func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	if v := f.PriceMax; v != nil {
 		e.PriceMax = v
 	}
+	if v := f.BumpStrategy; v != nil {
+		e.BumpStrategy = v
+	}
+	if v := f.BumpPercent; v != nil {
+		e.BumpPercent = v
+	}
+	if v := f.BumpMin; v != nil {
+		e.BumpMin = v
+	}
 }
 
 type HeadTracker struct {
@@ -683,12 +915,97 @@ func (t *HeadTracker) setFrom(f *HeadTracker) {
 	}
 }
 
+type HeadLagMonitor struct {
+	Enabled         *bool
+	PollInterval    *commonconfig.Duration
+	MaxBlocksBehind *uint32
+	MaxTimeBehind   *commonconfig.Duration
+	ReferenceURLs   []*commonconfig.URL
+}
+
+func (m *HeadLagMonitor) setFrom(f *HeadLagMonitor) {
+	if v := f.Enabled; v != nil {
+		m.Enabled = v
+	}
+	if v := f.PollInterval; v != nil {
+		m.PollInterval = v
+	}
+	if v := f.MaxBlocksBehind; v != nil {
+		m.MaxBlocksBehind = v
+	}
+	if v := f.MaxTimeBehind; v != nil {
+		m.MaxTimeBehind = v
+	}
+	if v := f.ReferenceURLs; v != nil {
+		m.ReferenceURLs = v
+	}
+}
+
+func (m *HeadLagMonitor) ValidateConfig() (err error) {
+	if m.PollInterval != nil && m.PollInterval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PollInterval", Value: *m.PollInterval,
+			Msg: "must be greater than 0"})
+	}
+	for i, u := range m.ReferenceURLs {
+		switch u.Scheme {
+		case "http", "https", "ws", "wss":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("ReferenceURLs[%d]", i), Value: u.Scheme,
+				Msg: "must be http, https, ws or wss"})
+		}
+	}
+	return
+}
+
+type VRFSubscriptionMonitor struct {
+	Enabled         *bool
+	PollInterval    *commonconfig.Duration
+	CostWindow      *commonconfig.Duration
+	RunwayThreshold *commonconfig.Duration
+	AlertWebhookURL *commonconfig.URL
+}
+
+func (m *VRFSubscriptionMonitor) setFrom(f *VRFSubscriptionMonitor) {
+	if v := f.Enabled; v != nil {
+		m.Enabled = v
+	}
+	if v := f.PollInterval; v != nil {
+		m.PollInterval = v
+	}
+	if v := f.CostWindow; v != nil {
+		m.CostWindow = v
+	}
+	if v := f.RunwayThreshold; v != nil {
+		m.RunwayThreshold = v
+	}
+	if v := f.AlertWebhookURL; v != nil {
+		m.AlertWebhookURL = v
+	}
+}
+
+func (m *VRFSubscriptionMonitor) ValidateConfig() (err error) {
+	if m.PollInterval != nil && m.PollInterval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PollInterval", Value: *m.PollInterval,
+			Msg: "must be greater than 0"})
+	}
+	if m.CostWindow != nil && m.CostWindow.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "CostWindow", Value: *m.CostWindow,
+			Msg: "must be greater than 0"})
+	}
+	if u := m.AlertWebhookURL; u != nil && u.Scheme != "http" && u.Scheme != "https" {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "AlertWebhookURL", Value: u.Scheme,
+			Msg: "must be http or https"})
+	}
+	return
+}
+
 type NodePool struct {
 	PollFailureThreshold *uint32
 	PollInterval         *commonconfig.Duration
 	SelectionMode        *string
 	SyncThreshold        *uint32
 	LeaseDuration        *commonconfig.Duration
+	PruningHorizon       *uint32
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -707,6 +1024,9 @@ func (p *NodePool) setFrom(f *NodePool) {
 	if v := f.LeaseDuration; v != nil {
 		p.LeaseDuration = v
 	}
+	if v := f.PruningHorizon; v != nil {
+		p.PruningHorizon = v
+	}
 }
 
 type OCR struct {
@@ -744,6 +1064,8 @@ type Node struct {
 	WSURL    *commonconfig.URL
 	HTTPURL  *commonconfig.URL
 	SendOnly *bool
+	ReadOnly *bool
+	Archive  *bool
 	Order    *int32
 }
 
@@ -758,15 +1080,14 @@ func (n *Node) ValidateConfig() (err error) {
 	if n.SendOnly != nil {
 		sendOnly = *n.SendOnly
 	}
-	if n.WSURL == nil {
-		if !sendOnly {
-			err = multierr.Append(err, commonconfig.ErrMissing{Name: "WSURL", Msg: "required for primary nodes"})
-		}
-	} else if n.WSURL.IsZero() {
-		if !sendOnly {
-			err = multierr.Append(err, commonconfig.ErrEmpty{Name: "WSURL", Msg: "required for primary nodes"})
-		}
-	} else {
+	if sendOnly && n.ReadOnly != nil && *n.ReadOnly {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReadOnly", Value: *n.ReadOnly, Msg: "cannot be set together with SendOnly"})
+	}
+	if sendOnly && n.Archive != nil && *n.Archive {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Archive", Value: *n.Archive, Msg: "cannot be set together with SendOnly"})
+	}
+	// WSURL is optional: primary nodes without one run in HTTP-only mode, polling for heads and logs
+	if n.WSURL != nil && !n.WSURL.IsZero() {
 		switch n.WSURL.Scheme {
 		case "ws", "wss":
 		default:
@@ -809,6 +1130,12 @@ func (n *Node) SetFrom(f *Node) {
 	if f.SendOnly != nil {
 		n.SendOnly = f.SendOnly
 	}
+	if f.ReadOnly != nil {
+		n.ReadOnly = f.ReadOnly
+	}
+	if f.Archive != nil {
+		n.Archive = f.Archive
+	}
 	if f.Order != nil {
 		n.Order = f.Order
 	}
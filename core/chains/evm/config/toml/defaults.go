@@ -174,7 +174,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	}
 
 	c.HeadTracker.setFrom(&f.HeadTracker)
+	c.HeadLagMonitor.setFrom(&f.HeadLagMonitor)
 	c.NodePool.setFrom(&f.NodePool)
 	c.OCR.setFrom(&f.OCR)
 	c.OCR2.setFrom(&f.OCR2)
+	c.VRFSubscriptionMonitor.setFrom(&f.VRFSubscriptionMonitor)
 }
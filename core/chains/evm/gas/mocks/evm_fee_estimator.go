@@ -78,6 +78,24 @@ func (_m *EvmFeeEstimator) Close() error {
 	return r0
 }
 
+// DynamicFeesUnsupported provides a mock function with given fields:
+func (_m *EvmFeeEstimator) DynamicFeesUnsupported() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DynamicFeesUnsupported")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, opts
 func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint32, maxFeePrice *assets.Wei, opts ...types.Opt) (gas.EvmFee, uint32, error) {
 	_va := make([]interface{}, len(opts))
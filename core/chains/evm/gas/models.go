@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -39,6 +41,9 @@ type EvmFeeEstimator interface {
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint32, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (*big.Int, error)
+	// DynamicFeesUnsupported returns true once the chain turned out not to support dynamic fees, although they are
+	// enabled. Dynamic fees of in-flight transactions are then bumped as legacy ones.
+	DynamicFeesUnsupported() bool
 }
 
 // NewEstimator returns the estimator for a given config
@@ -159,6 +164,35 @@ func (fee EvmFee) ValidDynamic() bool {
 	return fee.DynamicFeeCap != nil && fee.DynamicTipCap != nil
 }
 
+// AsLegacy converts a dynamic fee to a legacy one, whose gas price is the fee cap since it is the most the transaction
+// could pay per gas. Legacy fees are returned unchanged.
+func (fee EvmFee) AsLegacy() EvmFee {
+	if fee.Legacy != nil || !fee.ValidDynamic() {
+		return fee
+	}
+	return EvmFee{Legacy: fee.DynamicFeeCap}
+}
+
+// EffectivePrice returns the price a transaction with fee would pay per gas in a block with baseFee. For dynamic fees,
+// this is the base fee plus the tip, capped by the fee cap. baseFee may be nil if unknown, in which case only the tip
+// is counted.
+func (fee EvmFee) EffectivePrice(baseFee *assets.Wei) (*assets.Wei, error) {
+	if fee.Legacy != nil {
+		return fee.Legacy, nil
+	}
+	if fee.DynamicTipCap == nil {
+		return nil, errors.New("fee has neither a gas price nor a tip cap")
+	}
+	price := fee.DynamicTipCap
+	if baseFee != nil {
+		price = price.Add(baseFee)
+	}
+	if fee.DynamicFeeCap != nil && price.Cmp(fee.DynamicFeeCap) > 0 {
+		price = fee.DynamicFeeCap
+	}
+	return price, nil
+}
+
 // WrappedEvmEstimator provides a struct that wraps the EVM specific dynamic and legacy estimators into one estimator that conforms to the generic FeeEstimator
 type WrappedEvmEstimator struct {
 	services.StateMachine
@@ -166,6 +200,10 @@ type WrappedEvmEstimator struct {
 	EvmEstimator
 	EIP1559Enabled bool
 	l1Oracle       rollups.L1Oracle
+
+	// dynamicFeesDisabled is set once the chain turns out not to support dynamic fees, because its heads have no base
+	// fee or a node rejected a dynamic fee transaction, so that legacy fees are used from then on.
+	dynamicFeesDisabled atomic.Bool
 }
 
 var _ EvmFeeEstimator = (*WrappedEvmEstimator)(nil)
@@ -241,9 +279,39 @@ func (e *WrappedEvmEstimator) L1Oracle() rollups.L1Oracle {
 	return e.l1Oracle
 }
 
+func (e *WrappedEvmEstimator) DynamicFeesUnsupported() bool {
+	return e.dynamicFeesDisabled.Load()
+}
+
+func (e *WrappedEvmEstimator) dynamicFees() bool {
+	return e.EIP1559Enabled && !e.dynamicFeesDisabled.Load()
+}
+
+// DisableDynamicFees falls back to legacy fees for the rest of the life of the estimator, e.g. once the RPC nodes turn
+// out to serve blocks without a base fee.
+func (e *WrappedEvmEstimator) DisableDynamicFees(reason string) {
+	if e.EIP1559Enabled && e.dynamicFeesDisabled.CompareAndSwap(false, true) {
+		e.lggr.Warnw("Chain does not support EIP-1559 dynamic fees, falling back to legacy transactions. "+
+			"Set EVM.GasEstimator.EIP1559DynamicFees = false to use legacy transactions from the start", "reason", reason)
+	}
+}
+
+// OnNewLongestChain detects chains without EIP-1559 from their heads, which have no base fee, before forwarding the head
+// to the estimator.
+func (e *WrappedEvmEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	if head != nil && head.BaseFeePerGas == nil && e.dynamicFees() {
+		e.DisableDynamicFees(fmt.Sprintf("head %d has no base fee", head.Number))
+	}
+	e.EvmEstimator.OnNewLongestChain(ctx, head)
+}
+
 func (e *WrappedEvmEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint32, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (fee EvmFee, chainSpecificFeeLimit uint32, err error) {
+	if slices.Contains(opts, feetypes.OptTxTypeUnsupported) {
+		e.DisableDynamicFees("a node rejected a dynamic fee transaction")
+	}
+
 	// get dynamic fee
-	if e.EIP1559Enabled {
+	if e.dynamicFees() {
 		var dynamicFee DynamicFee
 		dynamicFee, chainSpecificFeeLimit, err = e.EvmEstimator.GetDynamicFee(ctx, feeLimit, maxFeePrice)
 		fee.DynamicFeeCap = dynamicFee.FeeCap
@@ -263,7 +331,7 @@ func (e *WrappedEvmEstimator) GetMaxCost(ctx context.Context, amount assets.Eth,
 	}
 
 	var gasPrice *assets.Wei
-	if e.EIP1559Enabled {
+	if fees.ValidDynamic() {
 		gasPrice = fees.DynamicFeeCap
 	} else {
 		gasPrice = fees.Legacy
@@ -282,6 +350,12 @@ func (e *WrappedEvmEstimator) BumpFee(ctx context.Context, originalFee EvmFee, f
 	}
 
 	// bump fee based on what fee the tx has previously used (not based on config)
+	// dynamic originals are converted to legacy ones mid-flight on chains which turned out not to support them
+	if originalFee.ValidDynamic() && e.DynamicFeesUnsupported() {
+		originalFee = originalFee.AsLegacy()
+		attempts = legacyPriorAttempts(attempts)
+	}
+
 	// bump dynamic original
 	if originalFee.ValidDynamic() {
 		var bumpedDynamic DynamicFee
@@ -300,6 +374,16 @@ func (e *WrappedEvmEstimator) BumpFee(ctx context.Context, originalFee EvmFee, f
 	return
 }
 
+// legacyPriorAttempts returns the attempts of legacy type, since the legacy bumping only supports them.
+func legacyPriorAttempts(attempts []EvmPriorAttempt) (legacy []EvmPriorAttempt) {
+	for _, a := range attempts {
+		if a.TxType == 0x0 || a.TxType == 0x1 {
+			legacy = append(legacy, a)
+		}
+	}
+	return
+}
+
 // Config defines an interface for configuration in the gas package
 //
 //go:generate mockery --quiet --name Config --output ./mocks/ --case=underscore
@@ -452,6 +536,55 @@ func bumpDynamicFee(cfg bumpConfig, feeCapBufferBlocks uint16, lggr logger.Sugar
 	return DynamicFee{FeeCap: bumpedFeeCap, TipCap: bumpedTipCap}, nil
 }
 
+// BumpFeeWithStrategy bumps originalFee with strategy, for keys which do not use the default bumping of the chain.
+// currentFee is the current estimate, which is followed by the Oracle mode, and may be empty if there is none of the
+// type of originalFee.
+func BumpFeeWithStrategy(strategy evmconfig.BumpStrategy, originalFee, currentFee EvmFee, maxFeePrice *assets.Wei) (EvmFee, error) {
+	bump := func(original, current *assets.Wei) *assets.Wei {
+		switch strategy.Mode {
+		case evmconfig.BumpModeFixed:
+			return original.Add(strategy.Min)
+		case evmconfig.BumpModeOracle:
+			bumped := original.AddPercentage(strategy.Percent)
+			if current != nil && current.Cmp(bumped) > 0 {
+				return current
+			}
+			return bumped
+		}
+		return original.AddPercentage(strategy.Percent)
+	}
+	check := func(feeType string, bumped, original *assets.Wei) error {
+		if bumped.Cmp(maxFeePrice) > 0 {
+			return errors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped %s of %s would exceed configured max gas price of %s (original %s was %s, bump strategy %s). %s",
+				feeType, bumped, maxFeePrice, feeType, original, strategy.Mode, label.NodeConnectivityProblemWarning)
+		} else if bumped.Cmp(original) <= 0 {
+			return errors.Wrapf(commonfee.ErrBump, "bumped %s of %s is less than or equal to original %s of %s with bump strategy %s",
+				feeType, bumped, feeType, original, strategy.Mode)
+		}
+		return nil
+	}
+
+	if originalFee.Legacy != nil {
+		price := bump(originalFee.Legacy, currentFee.Legacy)
+		if err := check("gas price", price, originalFee.Legacy); err != nil {
+			return EvmFee{}, err
+		}
+		return EvmFee{Legacy: price}, nil
+	}
+	if !originalFee.ValidDynamic() {
+		return EvmFee{}, errors.New("only one dynamic or legacy fee can be defined")
+	}
+	tipCap := bump(originalFee.DynamicTipCap, currentFee.DynamicTipCap)
+	if err := check("tip cap", tipCap, originalFee.DynamicTipCap); err != nil {
+		return EvmFee{}, err
+	}
+	feeCap := assets.WeiMax(bump(originalFee.DynamicFeeCap, currentFee.DynamicFeeCap), tipCap)
+	if err := check("fee cap", feeCap, originalFee.DynamicFeeCap); err != nil {
+		return EvmFee{}, err
+	}
+	return EvmFee{DynamicFeeCap: feeCap, DynamicTipCap: tipCap}, nil
+}
+
 func bumpFeePrice(originalFeePrice *assets.Wei, feeBumpPercent uint16, feeBumpUnits *assets.Wei) *assets.Wei {
 	bumpedFeePrice := assets.MaxWei(
 		originalFeePrice.AddPercentage(feeBumpPercent),
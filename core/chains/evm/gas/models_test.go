@@ -10,10 +10,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
 	rollupMocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 )
 
@@ -222,3 +226,140 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		require.NotNil(t, report[mockEstimatorName])
 	})
 }
+
+func TestWrappedEvmEstimator_TxTypeNegotiation(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	legacyFee := assets.NewWeiI(10)
+	dynamicFee := gas.DynamicFee{FeeCap: assets.NewWeiI(20), TipCap: assets.NewWeiI(1)}
+
+	newEstimator := func(t *testing.T) (*mocks.EvmEstimator, gas.EvmFeeEstimator) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetDynamicFee", mock.Anything, mock.Anything, mock.Anything).Return(dynamicFee, uint32(10), nil).Maybe()
+		est.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(legacyFee, uint32(10), nil).Maybe()
+		return est, gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, true, nil)
+	}
+
+	t.Run("heads without base fee", func(t *testing.T) {
+		est, estimator := newEstimator(t)
+		est.On("OnNewLongestChain", mock.Anything, mock.Anything).Twice()
+		estimator.OnNewLongestChain(ctx, &evmtypes.Head{Number: 1, BaseFeePerGas: assets.NewWeiI(5)})
+		assert.False(t, estimator.DynamicFeesUnsupported())
+
+		estimator.OnNewLongestChain(ctx, &evmtypes.Head{Number: 2})
+		assert.True(t, estimator.DynamicFeesUnsupported())
+		fee, _, err := estimator.GetFee(ctx, nil, 10, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.EvmFee{Legacy: legacyFee}, fee)
+	})
+
+	t.Run("rejected by a node", func(t *testing.T) {
+		_, estimator := newEstimator(t)
+		fee, _, err := estimator.GetFee(ctx, nil, 10, nil)
+		require.NoError(t, err)
+		assert.True(t, fee.ValidDynamic())
+
+		fee, _, err = estimator.GetFee(ctx, nil, 10, nil, feetypes.OptTxTypeUnsupported)
+		require.NoError(t, err)
+		assert.Equal(t, gas.EvmFee{Legacy: legacyFee}, fee)
+		// the following transactions are legacy ones too
+		fee, _, err = estimator.GetFee(ctx, nil, 10, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.EvmFee{Legacy: legacyFee}, fee)
+	})
+
+	t.Run("bumps dynamic fees as legacy ones", func(t *testing.T) {
+		est, estimator := newEstimator(t)
+		_, _, err := estimator.GetFee(ctx, nil, 10, nil, feetypes.OptTxTypeUnsupported)
+		require.NoError(t, err)
+
+		legacyAttempt := gas.EvmPriorAttempt{TxType: 0x0, GasPrice: assets.NewWeiI(15)}
+		attempts := []gas.EvmPriorAttempt{{TxType: 0x2, DynamicFee: dynamicFee}, legacyAttempt}
+		est.On("BumpLegacyGas", mock.Anything, dynamicFee.FeeCap, uint32(10), mock.Anything, []gas.EvmPriorAttempt{legacyAttempt}).
+			Return(assets.NewWeiI(24), uint32(10), nil).Once()
+		bumped, _, err := estimator.BumpFee(ctx, gas.EvmFee{DynamicFeeCap: dynamicFee.FeeCap, DynamicTipCap: dynamicFee.TipCap}, 10, nil, attempts)
+		require.NoError(t, err)
+		assert.Equal(t, gas.EvmFee{Legacy: assets.NewWeiI(24)}, bumped)
+	})
+
+	t.Run("not enabled", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("OnNewLongestChain", mock.Anything, mock.Anything).Once()
+		estimator := gas.NewWrappedEvmEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, false, nil)
+		estimator.OnNewLongestChain(ctx, &evmtypes.Head{Number: 1})
+		assert.False(t, estimator.DynamicFeesUnsupported())
+	})
+}
+
+func TestBumpFeeWithStrategy(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.GWei(100)
+	legacy := gas.EvmFee{Legacy: assets.GWei(10)}
+	dynamic := gas.EvmFee{DynamicFeeCap: assets.GWei(20), DynamicTipCap: assets.GWei(2)}
+	strategy := func(mode string) evmconfig.BumpStrategy {
+		return evmconfig.BumpStrategy{Mode: mode, Percent: 20, Min: assets.GWei(5)}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		strategy evmconfig.BumpStrategy
+		original gas.EvmFee
+		current  gas.EvmFee
+		exp      gas.EvmFee
+	}{
+		{"percent", strategy(evmconfig.BumpModePercent), legacy, gas.EvmFee{}, gas.EvmFee{Legacy: assets.GWei(12)}},
+		{"fixed", strategy(evmconfig.BumpModeFixed), legacy, gas.EvmFee{}, gas.EvmFee{Legacy: assets.GWei(15)}},
+		{"oracle below percent", strategy(evmconfig.BumpModeOracle), legacy, gas.EvmFee{Legacy: assets.GWei(11)}, gas.EvmFee{Legacy: assets.GWei(12)}},
+		{"oracle above percent", strategy(evmconfig.BumpModeOracle), legacy, gas.EvmFee{Legacy: assets.GWei(30)}, gas.EvmFee{Legacy: assets.GWei(30)}},
+		{"oracle without estimate", strategy(evmconfig.BumpModeOracle), legacy, gas.EvmFee{}, gas.EvmFee{Legacy: assets.GWei(12)}},
+		{"dynamic percent", strategy(evmconfig.BumpModePercent), dynamic, gas.EvmFee{}, gas.EvmFee{DynamicFeeCap: assets.GWei(24), DynamicTipCap: assets.NewWeiI(2_400_000_000)}},
+		{"dynamic fixed", strategy(evmconfig.BumpModeFixed), dynamic, gas.EvmFee{}, gas.EvmFee{DynamicFeeCap: assets.GWei(25), DynamicTipCap: assets.GWei(7)}},
+		{"dynamic oracle", strategy(evmconfig.BumpModeOracle), dynamic, gas.EvmFee{DynamicFeeCap: assets.GWei(40), DynamicTipCap: assets.GWei(3)}, gas.EvmFee{DynamicFeeCap: assets.GWei(40), DynamicTipCap: assets.GWei(3)}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			bumped, err := gas.BumpFeeWithStrategy(tt.strategy, tt.original, tt.current, maxPrice)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp.String(), bumped.String())
+		})
+	}
+
+	t.Run("exceeds max price", func(t *testing.T) {
+		_, err := gas.BumpFeeWithStrategy(strategy(evmconfig.BumpModeOracle), legacy, gas.EvmFee{Legacy: assets.GWei(101)}, maxPrice)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+	})
+
+	t.Run("does not bump", func(t *testing.T) {
+		s := strategy(evmconfig.BumpModeFixed)
+		s.Min = assets.NewWeiI(0)
+		_, err := gas.BumpFeeWithStrategy(s, legacy, gas.EvmFee{}, maxPrice)
+		require.ErrorIs(t, err, commonfee.ErrBump)
+	})
+}
+
+func TestEvmFee_EffectivePrice(t *testing.T) {
+	t.Parallel()
+
+	price, err := gas.EvmFee{Legacy: assets.NewWeiI(10)}.EffectivePrice(assets.NewWeiI(100))
+	require.NoError(t, err)
+	assert.Equal(t, assets.NewWeiI(10), price)
+
+	dynamic := gas.EvmFee{DynamicTipCap: assets.NewWeiI(2), DynamicFeeCap: assets.NewWeiI(50)}
+	price, err = dynamic.EffectivePrice(assets.NewWeiI(30))
+	require.NoError(t, err)
+	assert.Equal(t, assets.NewWeiI(32), price)
+
+	// capped by the fee cap
+	price, err = dynamic.EffectivePrice(assets.NewWeiI(60))
+	require.NoError(t, err)
+	assert.Equal(t, assets.NewWeiI(50), price)
+
+	// without a base fee only the tip is known
+	price, err = dynamic.EffectivePrice(nil)
+	require.NoError(t, err)
+	assert.Equal(t, assets.NewWeiI(2), price)
+
+	_, err = gas.EvmFee{}.EffectivePrice(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package headtracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
+	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// FinalityConfig represents a subset of options needed to select a FinalityProvider
+type FinalityConfig interface {
+	Config
+	FinalityTagEnabled() bool
+}
+
+// FinalityProviderFactory creates the FinalityProvider of a chain.
+type FinalityProviderFactory func(client evmclient.Client, config FinalityConfig) httypes.FinalityProvider
+
+var finalityProviders = struct {
+	sync.RWMutex
+	byChainType map[commonconfig.ChainType]FinalityProviderFactory
+}{byChainType: map[commonconfig.ChainType]FinalityProviderFactory{}}
+
+// RegisterFinalityProvider registers the factory used to create the FinalityProvider of chains of the given type.
+// It is intended to be called from an init func; registering twice for the same chain type panics.
+func RegisterFinalityProvider(chainType commonconfig.ChainType, f FinalityProviderFactory) {
+	finalityProviders.Lock()
+	defer finalityProviders.Unlock()
+	if _, exists := finalityProviders.byChainType[chainType]; exists {
+		panic(fmt.Sprintf("finality provider already registered for chain type %q", chainType))
+	}
+	finalityProviders.byChainType[chainType] = f
+}
+
+// NewFinalityProvider returns the FinalityProvider registered for the chain type. If there is none, the `finalized`
+// block tag is used when FinalityTagEnabled is set, and FinalityDepth otherwise.
+func NewFinalityProvider(chainType commonconfig.ChainType, client evmclient.Client, config FinalityConfig) httypes.FinalityProvider {
+	finalityProviders.RLock()
+	f, ok := finalityProviders.byChainType[chainType]
+	finalityProviders.RUnlock()
+	if ok {
+		return f(client, config)
+	}
+	if config.FinalityTagEnabled() {
+		return NewTagFinalityProvider(client)
+	}
+	return NewDepthFinalityProvider(config)
+}
+
+// NewDepthFinalityProvider returns a FinalityProvider which considers every block at least FinalityDepth below the
+// latest head to be finalized.
+func NewDepthFinalityProvider(config Config) httypes.FinalityProvider {
+	return headtracker.NewDepthFinalityProvider[*evmtypes.Head, common.Hash](config)
+}
+
+type tagFinalityProvider struct {
+	client evmclient.Client
+}
+
+// NewTagFinalityProvider returns a FinalityProvider which fetches the block with the `finalized` tag from the RPC.
+func NewTagFinalityProvider(client evmclient.Client) httypes.FinalityProvider {
+	return &tagFinalityProvider{client: client}
+}
+
+func (p *tagFinalityProvider) LatestFinalizedBlock(ctx context.Context, _ *evmtypes.Head) (int64, error) {
+	var head *evmtypes.Head
+	if err := p.client.CallContext(ctx, &head, "eth_getBlockByNumber", rpc.FinalizedBlockNumber.String(), false); err != nil {
+		return 0, fmt.Errorf("failed to fetch finalized block: %w", err)
+	}
+	if head == nil {
+		return 0, errors.New("got nil finalized block")
+	}
+	return head.Number, nil
+}
@@ -0,0 +1,86 @@
+package headtracker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink/v2/common/config"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+)
+
+type finalityConfig struct {
+	finalityDepth      uint32
+	finalityTagEnabled bool
+}
+
+func (c finalityConfig) BlockEmissionIdleWarningThreshold() time.Duration { return 0 }
+func (c finalityConfig) FinalityDepth() uint32                            { return c.finalityDepth }
+func (c finalityConfig) FinalityTagEnabled() bool                         { return c.finalityTagEnabled }
+
+type fixedFinalityProvider int64
+
+func (p fixedFinalityProvider) LatestFinalizedBlock(context.Context, *evmtypes.Head) (int64, error) {
+	return int64(p), nil
+}
+
+func TestNewFinalityProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	t.Run("depth", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		p := headtracker.NewFinalityProvider("", ethClient, finalityConfig{finalityDepth: 10})
+
+		finalized, err := p.LatestFinalizedBlock(ctx, cltest.Head(25))
+		require.NoError(t, err)
+		assert.Equal(t, int64(15), finalized)
+
+		finalized, err = p.LatestFinalizedBlock(ctx, cltest.Head(5))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), finalized)
+	})
+
+	t.Run("finality tag", func(t *testing.T) {
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", "finalized", false).
+			Run(func(args mock.Arguments) {
+				head := args.Get(1).(**evmtypes.Head)
+				*head = cltest.Head(20)
+			}).
+			Return(nil).Once()
+		p := headtracker.NewFinalityProvider("", ethClient, finalityConfig{finalityDepth: 10, finalityTagEnabled: true})
+
+		finalized, err := p.LatestFinalizedBlock(ctx, cltest.Head(25))
+		require.NoError(t, err)
+		assert.Equal(t, int64(20), finalized)
+	})
+
+	t.Run("registered", func(t *testing.T) {
+		chainType := commonconfig.ChainType("finality-test")
+		headtracker.RegisterFinalityProvider(chainType, func(evmclient.Client, headtracker.FinalityConfig) httypes.FinalityProvider {
+			return fixedFinalityProvider(7)
+		})
+		assert.Panics(t, func() {
+			headtracker.RegisterFinalityProvider(chainType, nil)
+		})
+
+		ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+		p := headtracker.NewFinalityProvider(chainType, ethClient, finalityConfig{finalityDepth: 10, finalityTagEnabled: true})
+
+		finalized, err := p.LatestFinalizedBlock(ctx, cltest.Head(25))
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), finalized)
+	})
+}
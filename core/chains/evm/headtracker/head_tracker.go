@@ -27,6 +27,7 @@ func NewHeadTracker(
 	ethClient evmclient.Client,
 	config Config,
 	htConfig HeadTrackerConfig,
+	finality httypes.FinalityProvider,
 	headBroadcaster httypes.HeadBroadcaster,
 	headSaver httypes.HeadSaver,
 	mailMon *mailbox.Monitor,
@@ -36,6 +37,7 @@ func NewHeadTracker(
 		ethClient,
 		config,
 		htConfig,
+		finality,
 		headBroadcaster,
 		headSaver,
 		mailMon,
@@ -56,4 +58,6 @@ func (*nullTracker) SetLogLevel(zapcore.Level)      {}
 func (*nullTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error) {
 	return nil
 }
-func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
+func (*nullTracker) LatestChain() *evmtypes.Head            { return nil }
+func (*nullTracker) LatestFinalizedBlock() int64            { return -1 }
+func (*nullTracker) RecentReorgs(limit int) []httypes.Reorg { return nil }
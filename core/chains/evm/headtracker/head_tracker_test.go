@@ -699,6 +699,14 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 		assert.Equal(t, c.Timestamp.Unix(), h.Timestamp.UTC().Unix())
 		assert.Equal(t, c.Number, h.Number)
 	}
+
+	// the forked chain replaced blocks 2 to 4
+	reorgs := ht.headTracker.RecentReorgs(0)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, int64(5), reorgs[0].BlockNumber)
+	assert.Equal(t, int64(3), reorgs[0].Depth)
+	assert.Equal(t, blocks.Head(4).Hash, reorgs[0].OldHead)
+	assert.Equal(t, blocksForked.Head(5).Hash, reorgs[0].NewHead)
 }
 
 func TestHeadTracker_Backfill(t *testing.T) {
@@ -998,7 +1006,7 @@ func createHeadTracker(t *testing.T, ethClient evmclient.Client, config headtrac
 	mailMon := mailboxtest.NewMonitor(t)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
-		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon),
+		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, headtracker.NewDepthFinalityProvider(config), hb, hs, mailMon),
 		headBroadcaster: hb,
 		headSaver:       hs,
 		mailMon:         mailMon,
@@ -1011,7 +1019,7 @@ func createHeadTrackerWithNeverSleeper(t *testing.T, ethClient evmclient.Client,
 	hb := headtracker.NewHeadBroadcaster(lggr)
 	hs := headtracker.NewHeadSaver(lggr, orm, evmcfg.EVM(), evmcfg.EVM().HeadTracker())
 	mailMon := mailboxtest.NewMonitor(t)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, evmcfg.EVM(), evmcfg.EVM().HeadTracker(), hb, hs, mailMon)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, evmcfg.EVM(), evmcfg.EVM().HeadTracker(), headtracker.NewDepthFinalityProvider(evmcfg.EVM()), hb, hs, mailMon)
 	_, err := hs.Load(testutils.Context(t))
 	require.NoError(t, err)
 	return &headTrackerUniverse{
@@ -1029,7 +1037,7 @@ func createHeadTrackerWithChecker(t *testing.T, ethClient evmclient.Client, conf
 	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
 	hb.Subscribe(checker)
 	mailMon := mailboxtest.NewMonitor(t)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, headtracker.NewDepthFinalityProvider(config), hb, hs, mailMon)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
 		headTracker:     ht,
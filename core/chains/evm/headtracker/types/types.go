@@ -23,4 +23,6 @@ type (
 	HeadTrackable           = commontypes.HeadTrackable[*evmtypes.Head, common.Hash]
 	HeadListener            = commontypes.HeadListener[*evmtypes.Head, common.Hash]
 	HeadBroadcaster         = commontypes.HeadBroadcaster[*evmtypes.Head, common.Hash]
+	FinalityProvider        = commontypes.FinalityProvider[*evmtypes.Head, common.Hash]
+	Reorg                   = commontypes.Reorg[common.Hash]
 )
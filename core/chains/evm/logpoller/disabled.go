@@ -39,6 +39,8 @@ func (disabled) UnregisterFilter(name string, qopts ...pg.QOpt) error { return E
 
 func (disabled) HasFilter(name string) bool { return false }
 
+func (disabled) FilterByName(name string) (Filter, bool) { return Filter{}, false }
+
 func (disabled) LatestBlock(qopts ...pg.QOpt) (LogPollerBlock, error) {
 	return LogPollerBlock{}, ErrDisabled
 }
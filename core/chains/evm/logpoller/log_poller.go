@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -39,6 +41,7 @@ type LogPoller interface {
 	RegisterFilter(filter Filter, qopts ...pg.QOpt) error
 	UnregisterFilter(name string, qopts ...pg.QOpt) error
 	HasFilter(name string) bool
+	FilterByName(name string) (Filter, bool)
 	LatestBlock(qopts ...pg.QOpt) (LogPollerBlock, error)
 	GetBlocksRange(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error)
 
@@ -106,6 +109,8 @@ type logPoller struct {
 	backfillBatchSize        int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
 	backupPollerNextBlock    int64
+	backfillBatchSizeLimit   atomic.Int64 // upper bound on backfillBatchSize detected from the RPC node, zero if unknown
+	finalityTagUnsupported   atomic.Bool  // set if the RPC node turns out not to support the finalized block tag
 
 	filterMu        sync.RWMutex
 	filters         map[string]Filter
@@ -157,6 +162,32 @@ type Filter struct {
 	EventSigs evmtypes.HashArray
 	Addresses evmtypes.AddressArray
 	Retention time.Duration
+	Priority  FilterPriority // order of the backfill of the filter, when filters of several priorities are registered
+}
+
+// FilterPriority orders the backfills of filters, so that the logs of critical filters are available first when many
+// filters are registered at once, e.g. on bootstrap of a node.
+type FilterPriority int16
+
+const (
+	// FilterPriorityDeferred is for filters whose logs are not needed to serve requests, like analytics.
+	FilterPriorityDeferred FilterPriority = -1
+	// FilterPriorityNormal is the default priority.
+	FilterPriorityNormal FilterPriority = 0
+	// FilterPriorityCritical is for filters whose logs are needed to participate in OCR or automation.
+	FilterPriorityCritical FilterPriority = 1
+)
+
+func (p FilterPriority) String() string {
+	switch p {
+	case FilterPriorityDeferred:
+		return "deferred"
+	case FilterPriorityNormal:
+		return "normal"
+	case FilterPriorityCritical:
+		return "critical"
+	}
+	return strconv.Itoa(int(p))
 }
 
 // FilterName is a suggested convenience function for clients to construct unique filter names
@@ -241,12 +272,12 @@ func (lp *logPoller) RegisterFilter(filter Filter, qopts ...pg.QOpt) error {
 	defer lp.filterMu.Unlock()
 
 	if existingFilter, ok := lp.filters[filter.Name]; ok {
-		if existingFilter.Contains(&filter) {
+		if existingFilter.Contains(&filter) && existingFilter.Priority == filter.Priority {
 			// Nothing new in this Filter
 			lp.lggr.Warnw("Filter already present, no-op", "name", filter.Name, "filter", filter)
 			return nil
 		}
-		lp.lggr.Warnw("Updating existing filter with more events or addresses", "name", filter.Name, "filter", filter)
+		lp.lggr.Warnw("Updating existing filter with more events or addresses, or another priority", "name", filter.Name, "filter", filter)
 	}
 
 	if err := lp.orm.InsertFilter(filter, qopts...); err != nil {
@@ -287,12 +318,55 @@ func (lp *logPoller) HasFilter(name string) bool {
 	return ok
 }
 
+// FilterByName returns the active filter with the given name, if the log poller has one.
+func (lp *logPoller) FilterByName(name string) (Filter, bool) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+
+	filter, ok := lp.filters[name]
+	return filter, ok
+}
+
 func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
 	if !lp.filterDirty {
 		return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{lp.cachedEventSigs}, Addresses: lp.cachedAddresses}
 	}
+	addresses, eventSigs := mergeFilters(lp.filters, nil)
+	lp.cachedAddresses = addresses
+	lp.cachedEventSigs = eventSigs
+	lp.filterDirty = false
+	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{eventSigs}, Addresses: addresses}
+}
+
+// priorityFilter returns the query of the filters with the given priority only.
+func (lp *logPoller) priorityFilter(from, to *big.Int, priority FilterPriority) ethereum.FilterQuery {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	addresses, eventSigs := mergeFilters(lp.filters, &priority)
+	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, Topics: [][]common.Hash{eventSigs}, Addresses: addresses}
+}
+
+// filterPriorities returns the priorities of the registered filters, from the highest, and how many filters have each.
+func (lp *logPoller) filterPriorities() ([]FilterPriority, map[FilterPriority]int) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	counts := make(map[FilterPriority]int)
+	for _, filter := range lp.filters {
+		counts[filter.Priority]++
+	}
+	priorities := make([]FilterPriority, 0, len(counts))
+	for p := range counts {
+		priorities = append(priorities, p)
+	}
+	sort.Slice(priorities, func(i, j int) bool { return priorities[i] > priorities[j] })
+	return priorities, counts
+}
+
+// mergeFilters returns the sorted addresses and event sigs of filters, or of those with the given priority only if it is
+// not nil.
+func mergeFilters(filters map[string]Filter, priority *FilterPriority) ([]common.Address, []common.Hash) {
 	var (
 		addresses  []common.Address
 		eventSigs  []common.Hash
@@ -300,7 +374,10 @@ func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 		eventSigMp = make(map[common.Hash]struct{})
 	)
 	// Merge filters.
-	for _, filter := range lp.filters {
+	for _, filter := range filters {
+		if priority != nil && filter.Priority != *priority {
+			continue
+		}
 		for _, addr := range filter.Addresses {
 			addressMp[addr] = struct{}{}
 		}
@@ -327,10 +404,7 @@ func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 		addresses = []common.Address{common.HexToAddress("0x0000000000000000000000000000000000000000")}
 		eventSigs = []common.Hash{}
 	}
-	lp.cachedAddresses = addresses
-	lp.cachedEventSigs = eventSigs
-	lp.filterDirty = false
-	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{eventSigs}, Addresses: addresses}
+	return addresses, eventSigs
 }
 
 // Replay signals that the poller should resume from a new block.
@@ -646,17 +720,67 @@ func (lp *logPoller) blocksFromLogs(ctx context.Context, logs []types.Log) (bloc
 	return lp.GetBlocksRange(ctx, numbers)
 }
 
+// LimitBackfillBatchSize caps the block range of backfill queries to limit, e.g. the eth_getLogs range limit detected
+// on the RPC node. Only ever lowers the current limit.
+func (lp *logPoller) LimitBackfillBatchSize(limit int64) {
+	for {
+		cur := lp.backfillBatchSizeLimit.Load()
+		if limit <= 0 || (cur > 0 && cur <= limit) {
+			return
+		}
+		if lp.backfillBatchSizeLimit.CompareAndSwap(cur, limit) {
+			lp.lggr.Infow("Limiting backfill batch size to the detected RPC eth_getLogs range", "limit", limit, "LogBackfillBatchSize", lp.backfillBatchSize)
+			return
+		}
+	}
+}
+
+// DisableFinalityTag falls back to finalityDepth if useFinalityTag is set but the RPC node does not support the
+// finalized block tag.
+func (lp *logPoller) DisableFinalityTag() {
+	if lp.useFinalityTag && lp.finalityTagUnsupported.CompareAndSwap(false, true) {
+		lp.lggr.Warnw("RPC node does not support the finalized block tag, falling back to finality depth", "finalityDepth", lp.finalityDepth)
+	}
+}
+
 const jsonRpcLimitExceeded = -32005 // See https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1474.md
 
 // backfill will query FilterLogs in batches for logs in the
 // block range [start, end] and save them to the db.
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
+// If filters of several priorities are registered, the range is backfilled for each priority in turn, from the
+// highest, so that the logs of critical filters are available before those of deferred ones.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	priorities, counts := lp.filterPriorities()
+	if len(priorities) <= 1 {
+		return lp.backfillFilter(ctx, start, end, func(from, to *big.Int) ethereum.FilterQuery {
+			return lp.Filter(from, to, nil)
+		}, nil)
+	}
+	for _, priority := range priorities {
+		priority := priority
+		progress := newBackfillProgress(lp.ec.ConfiguredChainID(), priority, start, end)
+		lp.lggr.Infow("Backfilling logs of filters", "priority", priority, "filters", counts[priority], "start", start, "end", end)
+		err := lp.backfillFilter(ctx, start, end, func(from, to *big.Int) ethereum.FilterQuery {
+			return lp.priorityFilter(from, to, priority)
+		}, progress)
+		if err != nil {
+			return err
+		}
+		lp.lggr.Infow("Finished backfilling logs of filters", "priority", priority, "filters", counts[priority], "start", start, "end", end, "logs", progress.logs)
+	}
+	return nil
+}
+
+func (lp *logPoller) backfillFilter(ctx context.Context, start, end int64, filter func(from, to *big.Int) ethereum.FilterQuery, progress *backfillProgress) error {
 	batchSize := lp.backfillBatchSize
+	if limit := lp.backfillBatchSizeLimit.Load(); limit > 0 && batchSize > limit {
+		batchSize = limit
+	}
 	for from := start; from <= end; from += batchSize {
 		to := mathutil.Min(from+batchSize-1, end)
-		gethLogs, err := lp.ec.FilterLogs(ctx, lp.Filter(big.NewInt(from), big.NewInt(to), nil))
+		gethLogs, err := lp.ec.FilterLogs(ctx, filter(big.NewInt(from), big.NewInt(to)))
 		if err != nil {
 			var rpcErr client.JsonError
 			if errors.As(err, &rpcErr) {
@@ -674,6 +798,7 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 			from -= batchSize // counteract +=batchSize on next loop iteration, so starting block does not change
 			continue
 		}
+		progress.update(to, len(gethLogs))
 		if len(gethLogs) == 0 {
 			continue
 		}
@@ -868,7 +993,7 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 // Otherwise, we return last finalized block number returned from chain
 func (lp *logPoller) latestBlocks(ctx context.Context) (*evmtypes.Head, int64, error) {
 	// If finality is not enabled, we can only fetch the latest block
-	if !lp.useFinalityTag {
+	if !lp.useFinalityTag || lp.finalityTagUnsupported.Load() {
 		// Example:
 		// finalityDepth = 2
 		// Blocks: 1->2->3->4->5(latestBlock)
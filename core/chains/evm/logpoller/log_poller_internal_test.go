@@ -10,11 +10,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -71,31 +73,31 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	require.Equal(t, 1, len(f.Addresses))
 	assert.Equal(t, common.HexToAddress("0x0000000000000000000000000000000000000000"), f.Addresses[0])
 
-	err := lp.RegisterFilter(Filter{"Emitter Log 1", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}, 0})
+	err := lp.RegisterFilter(Filter{"Emitter Log 1", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}, 0, 0})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1}, lp.Filter(nil, nil, nil).Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}}, lp.Filter(nil, nil, nil).Topics)
 	validateFiltersTable(t, lp, orm)
 
 	// Should de-dupe EventSigs
-	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0})
+	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0, 0})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter(nil, nil, nil).Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter(nil, nil, nil).Topics)
 	validateFiltersTable(t, lp, orm)
 
 	// Should de-dupe Addresses
-	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2 dupe", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0})
+	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2 dupe", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0, 0})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter(nil, nil, nil).Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter(nil, nil, nil).Topics)
 	validateFiltersTable(t, lp, orm)
 
 	// Address required.
-	err = lp.RegisterFilter(Filter{"no address", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{}, 0})
+	err = lp.RegisterFilter(Filter{"no address", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{}, 0, 0})
 	require.Error(t, err)
 	// Event required
-	err = lp.RegisterFilter(Filter{"No event", []common.Hash{}, []common.Address{a1}, 0})
+	err = lp.RegisterFilter(Filter{"No event", []common.Hash{}, []common.Address{a1}, 0, 0})
 	require.Error(t, err)
 	validateFiltersTable(t, lp, orm)
 
@@ -504,6 +506,59 @@ func Test_latestBlockAndFinalityDepth(t *testing.T) {
 	})
 }
 
+func TestLogPoller_BackfillPriorities(t *testing.T) {
+	critical, normal, deferred := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	event := EmitterABI.Events["Log1"].ID
+	chainID := testutils.NewRandomEVMChainID()
+
+	var queries []ethereum.FilterQuery
+	ec := evmclimocks.NewClient(t)
+	ec.On("ConfiguredChainID").Return(chainID)
+	ec.On("FilterLogs", mock.Anything, mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+		queries = append(queries, args.Get(1).(ethereum.FilterQuery))
+	})
+
+	lp := NewLogPoller(nil, ec, logger.Test(t), time.Hour, false, 2, 10, 2, 1000)
+	lp.filters = map[string]Filter{
+		"deferred": {Name: "deferred", EventSigs: []common.Hash{event}, Addresses: []common.Address{deferred}, Priority: FilterPriorityDeferred},
+		"normal":   {Name: "normal", EventSigs: []common.Hash{event}, Addresses: []common.Address{normal}},
+		"critical": {Name: "critical", EventSigs: []common.Hash{event}, Addresses: []common.Address{critical}, Priority: FilterPriorityCritical},
+	}
+	require.NoError(t, lp.backfill(testutils.Context(t), 1, 15))
+
+	// each priority backfills the whole range in turn, from the highest
+	require.Len(t, queries, 6)
+	for i, addr := range []common.Address{critical, critical, normal, normal, deferred, deferred} {
+		assert.Equal(t, []common.Address{addr}, queries[i].Addresses)
+	}
+	assert.Equal(t, big.NewInt(1), queries[0].FromBlock)
+	assert.Equal(t, big.NewInt(10), queries[0].ToBlock)
+	assert.Equal(t, big.NewInt(11), queries[1].FromBlock)
+	assert.Equal(t, big.NewInt(15), queries[1].ToBlock)
+
+	for _, priority := range []FilterPriority{FilterPriorityCritical, FilterPriorityNormal, FilterPriorityDeferred} {
+		assert.Equal(t, float64(0), testutil.ToFloat64(lpBackfillRemainingBlocks.WithLabelValues(chainID.String(), priority.String())))
+	}
+
+	t.Run("single priority", func(t *testing.T) {
+		queries = nil
+		delete(lp.filters, "critical")
+		delete(lp.filters, "deferred")
+		lp.filters["other"] = Filter{Name: "other", EventSigs: []common.Hash{event}, Addresses: []common.Address{critical}}
+		lp.filterDirty = true
+		require.NoError(t, lp.backfill(testutils.Context(t), 1, 5))
+		require.Len(t, queries, 1)
+		assert.Len(t, queries[0].Addresses, 2)
+	})
+}
+
+func TestFilterPriority_String(t *testing.T) {
+	assert.Equal(t, "critical", FilterPriorityCritical.String())
+	assert.Equal(t, "normal", FilterPriorityNormal.String())
+	assert.Equal(t, "deferred", FilterPriorityDeferred.String())
+	assert.Equal(t, "5", FilterPriority(5).String())
+}
+
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.Test(b)
 	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, false, 2, 3, 2, 1000)
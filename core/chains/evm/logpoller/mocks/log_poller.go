@@ -39,6 +39,34 @@ func (_m *LogPoller) Close() error {
 	return r0
 }
 
+// FilterByName provides a mock function with given fields: name
+func (_m *LogPoller) FilterByName(name string) (logpoller.Filter, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterByName")
+	}
+
+	var r0 logpoller.Filter
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (logpoller.Filter, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) logpoller.Filter); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(logpoller.Filter)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetBlocksRange provides a mock function with given fields: ctx, numbers, qopts
 func (_m *LogPoller) GetBlocksRange(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]logpoller.LogPollerBlock, error) {
 	_va := make([]interface{}, len(qopts))
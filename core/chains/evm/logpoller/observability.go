@@ -62,8 +62,44 @@ var (
 		Name: "log_poller_blocks_inserted",
 		Help: "Counter to track number of blocks inserted by Log Poller",
 	}, []string{"evmChainID"})
+	lpBackfillRemainingBlocks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_backfill_remaining_blocks",
+		Help: "Number of blocks left to backfill for the filters of each priority",
+	}, []string{"evmChainID", "priority"})
+	lpBackfillLogs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_poller_backfill_logs",
+		Help: "Counter to track number of logs found by backfills for the filters of each priority",
+	}, []string{"evmChainID", "priority"})
 )
 
+// backfillProgress reports the progress of the backfill of the filters of a priority.
+type backfillProgress struct {
+	end       int64
+	logs      int
+	remaining prometheus.Gauge
+	found     prometheus.Counter
+}
+
+func newBackfillProgress(chainID *big.Int, priority FilterPriority, start, end int64) *backfillProgress {
+	p := &backfillProgress{
+		end:       end,
+		remaining: lpBackfillRemainingBlocks.WithLabelValues(chainID.String(), priority.String()),
+		found:     lpBackfillLogs.WithLabelValues(chainID.String(), priority.String()),
+	}
+	p.remaining.Set(float64(end - start + 1))
+	return p
+}
+
+// update records that the blocks up to and including to were backfilled, finding logs logs.
+func (p *backfillProgress) update(to int64, logs int) {
+	if p == nil {
+		return
+	}
+	p.logs += logs
+	p.remaining.Set(float64(p.end - to))
+	p.found.Add(float64(logs))
+}
+
 // ObservedORM is a decorator layer for ORM used by LogPoller, responsible for pushing Prometheus metrics reporting duration and size of result set for the queries.
 // It doesn't change internal logic, because all calls are delegated to the origin ORM
 type ObservedORM struct {
@@ -75,11 +111,12 @@ type ObservedORM struct {
 	chainId        string
 }
 
-// NewObservedORM creates an observed version of log poller's ORM created by NewORM
+// NewObservedORM creates an observed version of log poller's ORM created by NewORM, which selects logs from replica
+// if set.
 // Please see ObservedLogPoller for more details on how latencies are measured
-func NewObservedORM(chainID *big.Int, db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) *ObservedORM {
+func NewObservedORM(chainID *big.Int, db *sqlx.DB, replica *pg.ReplicaDB, lggr logger.Logger, cfg pg.QConfig) *ObservedORM {
 	return &ObservedORM{
-		ORM:            NewORM(chainID, db, lggr, cfg),
+		ORM:            NewORM(chainID, db, lggr, cfg).WithReplica(replica),
 		queryDuration:  lpQueryDuration,
 		datasetSize:    lpQueryDataSets,
 		logsInserted:   lpLogsInserted,
@@ -147,7 +147,7 @@ func createObservedORM(t *testing.T, chainId int64) *ObservedORM {
 	lggr, _ := logger.TestObserved(t, zapcore.ErrorLevel)
 	db := pgtest.NewSqlxDB(t)
 	return NewObservedORM(
-		big.NewInt(chainId), db, lggr, pgtest.NewQConfig(true),
+		big.NewInt(chainId), db, nil, lggr, pgtest.NewQConfig(true),
 	)
 }
 
@@ -58,6 +58,7 @@ type DbORM struct {
 	chainID *big.Int
 	q       pg.Q
 	lggr    logger.Logger
+	replica *pg.ReplicaDB
 }
 
 // NewORM creates a DbORM scoped to chainID.
@@ -71,6 +72,19 @@ func NewORM(chainID *big.Int, db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) *
 	}
 }
 
+// WithReplica returns a copy of the ORM which selects logs from the replica, while it keeps up with the primary.
+// Blocks and filters, which the log poller uses to track its own state, are always read from the primary.
+func (o *DbORM) WithReplica(replica *pg.ReplicaDB) *DbORM {
+	c := *o
+	c.replica = replica
+	return &c
+}
+
+// readQ returns a Q for selecting logs, which may run on the replica.
+func (o *DbORM) readQ(qopts ...pg.QOpt) pg.Q {
+	return o.replica.ReadQ(o.q.WithOpts(qopts...))
+}
+
 // InsertBlock is idempotent to support replays.
 func (o *DbORM) InsertBlock(blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64, qopts ...pg.QOpt) error {
 	args, err := newQueryArgs(o.chainID).
@@ -97,6 +111,7 @@ func (o *DbORM) InsertFilter(filter Filter, qopts ...pg.QOpt) (err error) {
 	args, err := newQueryArgs(o.chainID).
 		withCustomArg("name", filter.Name).
 		withCustomArg("retention", filter.Retention).
+		withCustomArg("priority", filter.Priority).
 		withAddressArray(filter.Addresses).
 		withEventSigArray(filter.EventSigs).
 		toArgs()
@@ -107,13 +122,13 @@ func (o *DbORM) InsertFilter(filter Filter, qopts ...pg.QOpt) (err error) {
 	// https://github.com/jmoiron/sqlx/issues/91, https://github.com/jmoiron/sqlx/issues/428
 	return o.q.WithOpts(qopts...).ExecQNamed(`
 		INSERT INTO evm.log_poller_filters
-	  		(name, evm_chain_id, retention, created_at, address, event)
+	  		(name, evm_chain_id, retention, priority, created_at, address, event)
 		SELECT * FROM
-			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, NOW()) x,
+			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, :priority ::::SMALLINT, NOW()) x,
 			(SELECT unnest(:address_array ::::BYTEA[]) addr) a,
 			(SELECT unnest(:event_sig_array ::::BYTEA[]) ev) e
 		ON CONFLICT (name, evm_chain_id, address, event) 
-		DO UPDATE SET retention=:retention ::::BIGINT`, args)
+		DO UPDATE SET retention=:retention ::::BIGINT, priority=:priority ::::SMALLINT`, args)
 }
 
 // DeleteFilter removes all events,address pairs associated with the Filter
@@ -129,7 +144,8 @@ func (o *DbORM) LoadFilters(qopts ...pg.QOpt) (map[string]Filter, error) {
 	err := q.Select(&rows, `SELECT name,
 			ARRAY_AGG(DISTINCT address)::BYTEA[] AS addresses, 
 			ARRAY_AGG(DISTINCT event)::BYTEA[] AS event_sigs,
-			MAX(retention) AS retention
+			MAX(retention) AS retention,
+			MAX(priority) AS priority
 		FROM evm.log_poller_filters WHERE evm_chain_id = $1
 		GROUP BY name`, ubig.New(o.chainID))
 	filters := make(map[string]Filter)
@@ -182,7 +198,7 @@ func (o *DbORM) SelectLatestLogByEventSigWithConfs(eventSig common.Hash, address
 			AND block_number <= %s
 			ORDER BY (block_number, log_index) DESC LIMIT 1`, nestedBlockNumberQuery(confs))
 	var l Log
-	if err := o.q.WithOpts(qopts...).GetNamed(query, &l, args); err != nil {
+	if err := o.readQ(qopts...).GetNamed(query, &l, args); err != nil {
 		return nil, err
 	}
 	return &l, nil
@@ -345,7 +361,7 @@ func (o *DbORM) SelectLogs(start, end int64, address common.Address, eventSig co
 		return nil, err
 	}
 	var logs []Log
-	err = o.q.WithOpts(qopts...).SelectNamed(&logs, `
+	err = o.readQ(qopts...).SelectNamed(&logs, `
 		SELECT * FROM evm.logs 
 			WHERE evm_chain_id = :evm_chain_id 
 			AND address = :address
@@ -379,7 +395,7 @@ func (o *DbORM) SelectLogsCreatedAfter(address common.Address, eventSig common.H
 				ORDER BY (block_number, log_index)`, nestedBlockNumberQuery(confs))
 
 	var logs []Log
-	if err = o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err = o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -398,7 +414,7 @@ func (o *DbORM) SelectLogsWithSigs(start, end int64, address common.Address, eve
 		return nil, err
 	}
 
-	q := o.q.WithOpts(qopts...)
+	q := o.readQ(qopts...)
 	err = q.SelectNamed(&logs, `
 			SELECT * FROM evm.logs
 				WHERE evm_chain_id = :evm_chain_id
@@ -456,7 +472,7 @@ func (o *DbORM) SelectLatestLogEventSigsAddrsWithConfs(fromBlock int64, addresse
 		)
 		ORDER BY block_number ASC`, nestedBlockNumberQuery(confs))
 	var logs []Log
-	if err := o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err := o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, errors.Wrap(err, "failed to execute query")
 	}
 	return logs, nil
@@ -481,7 +497,7 @@ func (o *DbORM) SelectLatestBlockByEventSigsAddrsWithConfs(fromBlock int64, even
 			AND block_number > :start_block 
 			AND block_number <= %s`, nestedBlockNumberQuery(confs))
 	var blockNumber int64
-	if err := o.q.WithOpts(qopts...).GetNamed(query, &blockNumber, args); err != nil {
+	if err := o.readQ(qopts...).GetNamed(query, &blockNumber, args); err != nil {
 		return 0, err
 	}
 	return blockNumber, nil
@@ -506,7 +522,7 @@ func (o *DbORM) SelectLogsDataWordRange(address common.Address, eventSig common.
 			AND block_number <= %s
 			ORDER BY (block_number, log_index)`, nestedBlockNumberQuery(confs))
 	var logs []Log
-	if err := o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err := o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -530,7 +546,7 @@ func (o *DbORM) SelectLogsDataWordGreaterThan(address common.Address, eventSig c
 			AND block_number <= %s
 			ORDER BY (block_number, log_index)`, nestedBlockNumberQuery(confs))
 	var logs []Log
-	if err = o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err = o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -556,7 +572,7 @@ func (o *DbORM) SelectLogsDataWordBetween(address common.Address, eventSig commo
 			AND block_number <= %s
 			ORDER BY (block_number, log_index)`, nestedBlockNumberQuery(confs))
 	var logs []Log
-	if err = o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err = o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -580,7 +596,7 @@ func (o *DbORM) SelectIndexedLogsTopicGreaterThan(address common.Address, eventS
 			AND block_number <= %s
 			ORDER BY (block_number, log_index)`, nestedBlockNumberQuery(confs))
 	var logs []Log
-	if err = o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err = o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -606,7 +622,7 @@ func (o *DbORM) SelectIndexedLogsTopicRange(address common.Address, eventSig com
 				AND block_number <= %s
 			ORDER BY (evm.logs.block_number, evm.logs.log_index)`, nestedBlockNumberQuery(confs))
 	var logs []Log
-	if err := o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err := o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -630,7 +646,7 @@ func (o *DbORM) SelectIndexedLogs(address common.Address, eventSig common.Hash,
 			AND block_number <= %s
 			ORDER BY (block_number, log_index)`, nestedBlockNumberQuery(confs))
 	var logs []Log
-	if err := o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err := o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -648,7 +664,7 @@ func (o *DbORM) SelectIndexedLogsByBlockRange(start, end int64, address common.A
 		return nil, err
 	}
 	var logs []Log
-	err = o.q.WithOpts(qopts...).SelectNamed(&logs, `
+	err = o.readQ(qopts...).SelectNamed(&logs, `
 		SELECT * FROM evm.logs 
 				WHERE evm_chain_id = :evm_chain_id 
 				AND address = :address
@@ -685,7 +701,7 @@ func (o *DbORM) SelectIndexedLogsCreatedAfter(address common.Address, eventSig c
 			ORDER BY (block_number, log_index)`, nestedBlockNumberQuery(confs))
 
 	var logs []Log
-	if err = o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err = o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -701,7 +717,7 @@ func (o *DbORM) SelectIndexedLogsByTxHash(address common.Address, eventSig commo
 		return nil, err
 	}
 	var logs []Log
-	err = o.q.WithOpts(qopts...).SelectNamed(&logs, `
+	err = o.readQ(qopts...).SelectNamed(&logs, `
 		SELECT * FROM evm.logs 
 			WHERE evm_chain_id = :evm_chain_id
 			AND address = :address
@@ -749,7 +765,7 @@ func (o *DbORM) SelectIndexedLogsWithSigsExcluding(sigA, sigB common.Hash, topic
 		AND		   b.block_number <= %s
 		ORDER BY block_number,log_index ASC`, nestedQuery, nestedQuery)
 	var logs []Log
-	if err := o.q.WithOpts(qopts...).SelectNamed(&logs, query, args); err != nil {
+	if err := o.readQ(qopts...).SelectNamed(&logs, query, args); err != nil {
 		return nil, err
 	}
 	return logs, nil
@@ -0,0 +1,213 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	promHeadLagBlocks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_lag_blocks_behind",
+		Help: "The number of blocks the latest processed head is behind the latest head of the reference",
+	}, []string{"evmChainID", "reference"})
+	promHeadLagSeconds = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_lag_seconds_behind",
+		Help: "The number of seconds the timestamp of the latest processed head is behind the timestamp of the latest head of the reference",
+	}, []string{"evmChainID", "reference"})
+)
+
+// HeadLagReference is a source of the latest head of the chain, e.g. another configured node.
+type HeadLagReference interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// HeadLagMonitor periodically compares the latest head processed by the node against the latest head of each
+// reference, and reports the node as unhealthy if it falls more than the configured number of blocks or seconds
+// behind any of them.
+type HeadLagMonitor struct {
+	services.StateMachine
+	lggr       logger.SugaredLogger
+	chainID    string
+	cfg        config.HeadLagMonitor
+	references map[string]HeadLagReference
+
+	latest atomic.Pointer[evmtypes.Head]
+
+	mu   sync.RWMutex
+	lags map[string]error
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewHeadLagMonitor returns a monitor for references, keyed by name, plus every URL in cfg.ReferenceURLs.
+func NewHeadLagMonitor(lggr logger.Logger, chainID *big.Int, cfg config.HeadLagMonitor, references map[string]HeadLagReference) *HeadLagMonitor {
+	refs := make(map[string]HeadLagReference, len(references)+len(cfg.ReferenceURLs()))
+	for name, r := range references {
+		refs[name] = r
+	}
+	for _, u := range cfg.ReferenceURLs() {
+		// only the host is used as name, since the rest of the URL commonly contains an API key
+		refs[u.Host] = &urlReference{url: u}
+	}
+	return &HeadLagMonitor{
+		lggr:       logger.Sugared(logger.Named(lggr, "HeadLagMonitor")),
+		chainID:    chainID.String(),
+		cfg:        cfg,
+		references: refs,
+		lags:       make(map[string]error),
+		stopCh:     make(services.StopChan),
+	}
+}
+
+func (m *HeadLagMonitor) Name() string { return m.lggr.Name() }
+
+func (m *HeadLagMonitor) Start(context.Context) error {
+	return m.StartOnce("HeadLagMonitor", func() error {
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *HeadLagMonitor) Close() error {
+	return m.StopOnce("HeadLagMonitor", func() error {
+		close(m.stopCh)
+		m.wg.Wait()
+		for _, r := range m.references {
+			if u, ok := r.(*urlReference); ok {
+				u.close()
+			}
+		}
+		return nil
+	})
+}
+
+// OnNewLongestChain records the latest head processed by the node.
+func (m *HeadLagMonitor) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	m.latest.Store(head)
+}
+
+func (m *HeadLagMonitor) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(m.cfg.PollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.checkAll(ctx)
+		}
+	}
+}
+
+// checkAll compares the latest processed head against every reference. References which cannot be queried keep
+// their previous result, since that is not a problem of the node itself.
+func (m *HeadLagMonitor) checkAll(ctx context.Context) {
+	local := m.latest.Load()
+	if local == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.PollInterval())
+	defer cancel()
+	for name, r := range m.references {
+		var head *evmtypes.Head
+		if err := r.CallContext(ctx, &head, "eth_getBlockByNumber", rpc.LatestBlockNumber.String(), false); err != nil {
+			m.lggr.Debugw("Failed to fetch latest head of reference", "reference", name, "err", err)
+			continue
+		} else if head == nil {
+			m.lggr.Debugw("Reference returned nil head", "reference", name)
+			continue
+		}
+		lag := m.check(name, local, head)
+
+		m.mu.Lock()
+		prev := m.lags[name]
+		m.lags[name] = lag
+		m.mu.Unlock()
+		if lag != nil && prev == nil {
+			m.lggr.Warnw("Node is lagging behind reference", "reference", name, "err", lag)
+		} else if lag == nil && prev != nil {
+			m.lggr.Infow("Node caught up with reference", "reference", name)
+		}
+	}
+}
+
+func (m *HeadLagMonitor) check(name string, local, reference *evmtypes.Head) error {
+	blocksBehind := reference.Number - local.Number
+	timeBehind := reference.Timestamp.Sub(local.Timestamp)
+	promHeadLagBlocks.WithLabelValues(m.chainID, name).Set(float64(max(blocksBehind, 0)))
+	promHeadLagSeconds.WithLabelValues(m.chainID, name).Set(max(timeBehind, 0).Seconds())
+
+	if maxBlocks := m.cfg.MaxBlocksBehind(); maxBlocks > 0 && blocksBehind > int64(maxBlocks) {
+		return fmt.Errorf("latest head %d is %d blocks behind reference %s at %d, more than MaxBlocksBehind %d",
+			local.Number, blocksBehind, name, reference.Number, maxBlocks)
+	}
+	if maxTime := m.cfg.MaxTimeBehind(); maxTime > 0 && timeBehind > maxTime {
+		return fmt.Errorf("latest head %d is %s behind reference %s at %d, more than MaxTimeBehind %s",
+			local.Number, timeBehind, name, reference.Number, maxTime)
+	}
+	return nil
+}
+
+// HealthReport reports an error for every reference the node is lagging behind.
+func (m *HeadLagMonitor) HealthReport() map[string]error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	report := map[string]error{m.Name(): m.Healthy()}
+	for name, lag := range m.lags {
+		report[m.Name()+"."+name] = lag
+	}
+	return report
+}
+
+// urlReference is a reference RPC which is dialed on first use.
+type urlReference struct {
+	url *url.URL
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+func (r *urlReference) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	r.mu.Lock()
+	if r.client == nil {
+		c, err := rpc.DialContext(ctx, r.url.String())
+		if err != nil {
+			r.mu.Unlock()
+			return fmt.Errorf("failed to dial reference: %w", err)
+		}
+		r.client = c
+	}
+	c := r.client
+	r.mu.Unlock()
+	return c.CallContext(ctx, result, method, args...)
+}
+
+func (r *urlReference) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.client != nil {
+		r.client.Close()
+		r.client = nil
+	}
+}
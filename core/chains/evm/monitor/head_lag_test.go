@@ -0,0 +1,95 @@
+package monitor_test
+
+import (
+	"context"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type headLagConfig struct {
+	maxBlocksBehind uint32
+	maxTimeBehind   time.Duration
+}
+
+func (c headLagConfig) Enabled() bool                { return true }
+func (c headLagConfig) PollInterval() time.Duration  { return testutils.TestInterval }
+func (c headLagConfig) MaxBlocksBehind() uint32      { return c.maxBlocksBehind }
+func (c headLagConfig) MaxTimeBehind() time.Duration { return c.maxTimeBehind }
+func (c headLagConfig) ReferenceURLs() []*url.URL    { return nil }
+
+type headLagReference struct {
+	head atomic.Pointer[evmtypes.Head]
+}
+
+func (r *headLagReference) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	*result.(**evmtypes.Head) = r.head.Load()
+	return nil
+}
+
+func TestHeadLagMonitor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	head := func(n int64, age time.Duration) *evmtypes.Head {
+		return &evmtypes.Head{Number: n, Timestamp: now.Add(-age)}
+	}
+
+	for _, tt := range []struct {
+		name      string
+		cfg       headLagConfig
+		reference *evmtypes.Head
+		expErr    string
+	}{
+		{"in sync", headLagConfig{maxBlocksBehind: 5, maxTimeBehind: time.Minute}, head(103, time.Hour-10*time.Second), ""},
+		{"blocks behind", headLagConfig{maxBlocksBehind: 5}, head(110, 0), "is 10 blocks behind reference other at 110, more than MaxBlocksBehind 5"},
+		{"time behind", headLagConfig{maxTimeBehind: time.Minute}, head(101, time.Hour-2*time.Minute), "behind reference other at 101, more than MaxTimeBehind 1m0s"},
+		{"checks disabled", headLagConfig{}, head(1000, 0), ""},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ref := &headLagReference{}
+			ref.head.Store(tt.reference)
+			m := monitor.NewHeadLagMonitor(logger.Test(t), testutils.FixtureChainID, tt.cfg, map[string]monitor.HeadLagReference{"other": ref})
+			servicetest.Run(t, m)
+			m.OnNewLongestChain(testutils.Context(t), head(100, time.Hour))
+
+			g := gomega.NewWithT(t)
+			g.Eventually(func() map[string]error { return m.HealthReport() }, testutils.WaitTimeout(t)).Should(gomega.HaveKey(m.Name() + ".other"))
+			err := m.HealthReport()[m.Name()+".other"]
+			if tt.expErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expErr)
+			}
+		})
+	}
+
+	t.Run("recovers", func(t *testing.T) {
+		t.Parallel()
+
+		ref := &headLagReference{}
+		ref.head.Store(head(110, 0))
+		m := monitor.NewHeadLagMonitor(logger.Test(t), testutils.FixtureChainID, headLagConfig{maxBlocksBehind: 5}, map[string]monitor.HeadLagReference{"other": ref})
+		servicetest.Run(t, m)
+		m.OnNewLongestChain(testutils.Context(t), head(100, 0))
+
+		g := gomega.NewWithT(t)
+		g.Eventually(func() error { return m.HealthReport()[m.Name()+".other"] }, testutils.WaitTimeout(t)).Should(gomega.HaveOccurred())
+		m.OnNewLongestChain(testutils.Context(t), head(110, 0))
+		g.Eventually(func() error { return m.HealthReport()[m.Name()+".other"] }, testutils.WaitTimeout(t)).ShouldNot(gomega.HaveOccurred())
+	})
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
@@ -30,6 +32,9 @@ type evmTxAttemptBuilder struct {
 	feeConfig evmTxAttemptBuilderFeeConfig
 	keystore  TxAttemptSigner[common.Address]
 	gas.EvmFeeEstimator
+	calldata CalldataTransformer
+	envelope TxEnvelope
+	digests  DigestSigner
 }
 
 type evmTxAttemptBuilderFeeConfig interface {
@@ -37,10 +42,39 @@ type evmTxAttemptBuilderFeeConfig interface {
 	TipCapMin() *assets.Wei
 	PriceMin() *assets.Wei
 	PriceMaxKey(common.Address) *assets.Wei
+	BumpStrategyKey(common.Address) evmconfig.BumpStrategy
+	LimitMultiplier() float32
 }
 
 func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeConfig, keystore TxAttemptSigner[common.Address], estimator gas.EvmFeeEstimator) *evmTxAttemptBuilder {
-	return &evmTxAttemptBuilder{chainID, feeConfig, keystore, estimator}
+	return &evmTxAttemptBuilder{chainID: chainID, feeConfig: feeConfig, keystore: keystore, EvmFeeEstimator: estimator}
+}
+
+// WithCalldataTransformer sets the transformer applied to the encoded payload of every attempt built. A nil
+// transformer leaves the payload untouched.
+func (c *evmTxAttemptBuilder) WithCalldataTransformer(t CalldataTransformer) *evmTxAttemptBuilder {
+	c.calldata = t
+	return c
+}
+
+// WithTxEnvelope sets the envelope sealing every attempt built, with digests signed by signer. A nil envelope signs
+// the standard attempts.
+func (c *evmTxAttemptBuilder) WithTxEnvelope(e TxEnvelope, signer DigestSigner) *evmTxAttemptBuilder {
+	c.envelope = e
+	c.digests = signer
+	return c
+}
+
+// payload returns the calldata that will actually be signed and broadcast for etx
+func (c *evmTxAttemptBuilder) payload(etx Tx) ([]byte, error) {
+	if c.calldata == nil {
+		return etx.EncodedPayload, nil
+	}
+	payload, err := c.calldata.TransformCalldata(etx, etx.EncodedPayload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to transform calldata for transaction %v", etx.ID)
+	}
+	return payload, nil
 }
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
@@ -57,12 +91,16 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 // used for L2 re-estimation on broadcasting (note EIP1559 must be disabled otherwise this will fail with mismatched fees + tx type)
 func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx, lggr logger.Logger, txType int, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint32, retryable bool, err error) {
 	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
-	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, opts...)
+	payload, err := c.payload(etx)
+	if err != nil {
+		return attempt, fee, feeLimit, false, err // transformer errors are deterministic, not retryable
+	}
+	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, payload, etx.FeeLimit, keySpecificMaxGasPriceWei, opts...)
 	if err != nil {
 		return attempt, fee, feeLimit, true, errors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
 
-	attempt, retryable, err = c.NewCustomTxAttempt(etx, fee, feeLimit, txType, lggr)
+	attempt, retryable, err = c.NewCustomTxAttempt(etx, fee, feeLimit, negotiateTxType(txType, fee), lggr)
 	return attempt, fee, feeLimit, retryable, err
 }
 
@@ -71,15 +109,53 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, priorAttempts []TxAttempt, lggr logger.Logger) (attempt TxAttempt, bumpedFee gas.EvmFee, bumpedFeeLimit uint32, retryable bool, err error) {
 	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
 
-	bumpedFee, bumpedFeeLimit, err = c.EvmFeeEstimator.BumpFee(ctx, previousAttempt.TxFee, etx.FeeLimit, keySpecificMaxGasPriceWei, newEvmPriorAttempts(priorAttempts))
+	if strategy := c.feeConfig.BumpStrategyKey(etx.FromAddress); strategy.Mode != "" {
+		previousFee := previousAttempt.TxFee
+		if previousFee.ValidDynamic() && c.EvmFeeEstimator.DynamicFeesUnsupported() {
+			previousFee = previousFee.AsLegacy()
+		}
+		bumpedFee, bumpedFeeLimit, err = c.bumpFeeWithStrategy(ctx, etx, previousFee, strategy, keySpecificMaxGasPriceWei)
+	} else {
+		bumpedFee, bumpedFeeLimit, err = c.EvmFeeEstimator.BumpFee(ctx, previousAttempt.TxFee, etx.FeeLimit, keySpecificMaxGasPriceWei, newEvmPriorAttempts(priorAttempts))
+	}
 	if err != nil {
 		return attempt, bumpedFee, bumpedFeeLimit, true, errors.Wrap(err, "failed to bump fee") // estimator errors are retryable
 	}
 
-	attempt, retryable, err = c.NewCustomTxAttempt(etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
+	attempt, retryable, err = c.NewCustomTxAttempt(etx, bumpedFee, bumpedFeeLimit, negotiateTxType(previousAttempt.TxType, bumpedFee), lggr)
 	return attempt, bumpedFee, bumpedFeeLimit, retryable, err
 }
 
+// negotiateTxType returns the legacy type for dynamic fee attempts which the estimator priced with a legacy fee, since
+// the chain turned out not to support dynamic fees. This converts in-flight transactions on their next bump.
+func negotiateTxType(txType int, fee gas.EvmFee) int {
+	if txType == 0x2 && fee.Legacy != nil && !fee.ValidDynamic() {
+		return 0x0
+	}
+	return txType
+}
+
+// bumpFeeWithStrategy bumps previousFee with the bump strategy of the key of etx, instead of the default bumping of
+// the estimator. Only the Oracle mode needs the current estimate.
+func (c *evmTxAttemptBuilder) bumpFeeWithStrategy(ctx context.Context, etx Tx, previousFee gas.EvmFee, strategy evmconfig.BumpStrategy, maxFeePrice *assets.Wei) (gas.EvmFee, uint32, error) {
+	var current gas.EvmFee
+	if strategy.Mode == evmconfig.BumpModeOracle {
+		payload, err := c.payload(etx)
+		if err != nil {
+			return gas.EvmFee{}, 0, err
+		}
+		if current, _, err = c.EvmFeeEstimator.GetFee(ctx, payload, etx.FeeLimit, maxFeePrice); err != nil {
+			return gas.EvmFee{}, 0, err
+		}
+	}
+	bumped, err := gas.BumpFeeWithStrategy(strategy, previousFee, current, maxFeePrice)
+	if err != nil {
+		return gas.EvmFee{}, 0, err
+	}
+	feeLimit, err := commonfee.ApplyMultiplier(etx.FeeLimit, c.feeConfig.LimitMultiplier())
+	return bumped, feeLimit, err
+}
+
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(etx Tx, fee gas.EvmFee, gasLimit uint32, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
@@ -145,6 +221,10 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(etx Tx, fee gas.DynamicFee, g
 	if err = validateDynamicFeeGas(c.feeConfig, c.feeConfig.TipCapMin(), fee, gasLimit, etx); err != nil {
 		return attempt, errors.Wrap(err, "error validating gas")
 	}
+	payload, err := c.payload(etx)
+	if err != nil {
+		return attempt, err
+	}
 
 	d := newDynamicFeeTransaction(
 		uint64(*etx.Sequence),
@@ -154,7 +234,7 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(etx Tx, fee gas.DynamicFee, g
 		&c.chainID,
 		fee.TipCap,
 		fee.FeeCap,
-		etx.EncodedPayload,
+		payload,
 	)
 	tx := types.NewTx(&d)
 	attempt, err = c.newSignedAttempt(etx, tx)
@@ -230,6 +310,10 @@ func (c *evmTxAttemptBuilder) newLegacyAttempt(etx Tx, gasPrice *assets.Wei, gas
 	if err = validateLegacyGas(c.feeConfig, c.feeConfig.PriceMin(), gasPrice, gasLimit, etx); err != nil {
 		return attempt, errors.Wrap(err, "error validating gas")
 	}
+	payload, err := c.payload(etx)
+	if err != nil {
+		return attempt, err
+	}
 
 	tx := newLegacyTransaction(
 		uint64(*etx.Sequence),
@@ -237,11 +321,11 @@ func (c *evmTxAttemptBuilder) newLegacyAttempt(etx Tx, gasPrice *assets.Wei, gas
 		&etx.Value,
 		gasLimit,
 		gasPrice,
-		etx.EncodedPayload,
+		payload,
 	)
 
 	transaction := types.NewTx(&tx)
-	hash, signedTxBytes, err := c.SignTx(etx.FromAddress, transaction)
+	hash, signedTxBytes, err := c.seal(etx, transaction)
 	if err != nil {
 		return attempt, errors.Wrapf(err, "error using account %s to sign transaction %v", etx.FromAddress, etx.ID)
 	}
@@ -276,7 +360,7 @@ func validateLegacyGas(kse keySpecificEstimator, minGasPriceWei, gasPrice *asset
 }
 
 func (c *evmTxAttemptBuilder) newSignedAttempt(etx Tx, tx *types.Transaction) (attempt TxAttempt, err error) {
-	hash, signedTxBytes, err := c.SignTx(etx.FromAddress, tx)
+	hash, signedTxBytes, err := c.seal(etx, tx)
 	if err != nil {
 		return attempt, errors.Wrapf(err, "error using account %s to sign transaction %v", etx.FromAddress.String(), etx.ID)
 	}
@@ -314,6 +398,19 @@ func (c *evmTxAttemptBuilder) SignTx(address common.Address, tx *types.Transacti
 	return txHash, rlp.Bytes(), nil
 }
 
+// seal signs the attempt tx of etx, or seals it with the envelope if there is one.
+func (c *evmTxAttemptBuilder) seal(etx Tx, tx *types.Transaction) (common.Hash, []byte, error) {
+	if c.envelope == nil {
+		return c.SignTx(etx.FromAddress, tx)
+	}
+	if c.digests == nil {
+		return common.Hash{}, nil, errors.New("tx envelope requires a digest signer")
+	}
+	return c.envelope.Seal(etx, tx, &c.chainID, func(digest common.Hash) ([]byte, error) {
+		return c.digests.SignDigest(etx.FromAddress, digest)
+	})
+}
+
 func newEvmPriorAttempts(attempts []TxAttempt) (prior []gas.EvmPriorAttempt) {
 	for i := range attempts {
 		priorAttempt := gas.EvmPriorAttempt{
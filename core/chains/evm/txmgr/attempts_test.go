@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"testing"
@@ -15,7 +16,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
@@ -36,6 +39,7 @@ type feeConfig struct {
 	tipCapMin          *assets.Wei
 	priceMin           *assets.Wei
 	priceMax           *assets.Wei
+	bumpStrategy       evmconfig.BumpStrategy
 }
 
 func newFeeConfig() *feeConfig {
@@ -50,6 +54,10 @@ func (g *feeConfig) EIP1559DynamicFees() bool                        { return g.
 func (g *feeConfig) TipCapMin() *assets.Wei                          { return g.tipCapMin }
 func (g *feeConfig) PriceMin() *assets.Wei                           { return g.priceMin }
 func (g *feeConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei { return g.priceMax }
+func (g *feeConfig) BumpStrategyKey(addr gethcommon.Address) evmconfig.BumpStrategy {
+	return g.bumpStrategy
+}
+func (g *feeConfig) LimitMultiplier() float32 { return 1 }
 
 func TestTxm_SignTx(t *testing.T) {
 	t.Parallel()
@@ -284,3 +292,174 @@ func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 		assert.True(t, retryable)
 	})
 }
+
+func TestTxm_EvmTxAttemptBuilder_BumpStrategy(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	lggr := logger.Test(t)
+	ctx := testutils.Context(t)
+	var n evmtypes.Nonce
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, FeeLimit: 100}
+	previous := txmgr.TxAttempt{TxFee: gas.EvmFee{Legacy: assets.GWei(10)}, TxType: 0x0}
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", addr, mock.Anything, big.NewInt(1)).Return(types.NewTx(&types.LegacyTx{}), nil)
+
+	t.Run("fixed", func(t *testing.T) {
+		gc := newFeeConfig()
+		gc.priceMax = assets.GWei(100)
+		gc.bumpStrategy = evmconfig.BumpStrategy{Mode: evmconfig.BumpModeFixed, Percent: 10, Min: assets.GWei(5)}
+		// the estimator is not consulted
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, gasmocks.NewEvmFeeEstimator(t))
+
+		_, fee, limit, _, err := cks.NewBumpTxAttempt(ctx, etx, previous, nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(15), fee.Legacy)
+		assert.Equal(t, uint32(100), limit)
+	})
+
+	t.Run("oracle", func(t *testing.T) {
+		gc := newFeeConfig()
+		gc.priceMax = assets.GWei(100)
+		gc.bumpStrategy = evmconfig.BumpStrategy{Mode: evmconfig.BumpModeOracle, Percent: 10, Min: assets.GWei(5)}
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, uint32(100), assets.GWei(100)).Return(gas.EvmFee{Legacy: assets.GWei(40)}, uint32(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		_, fee, _, _, err := cks.NewBumpTxAttempt(ctx, etx, previous, nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(40), fee.Legacy)
+	})
+}
+
+func TestTxm_EvmTxAttemptBuilder_LegacyFallback(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	lggr := logger.Test(t)
+	ctx := testutils.Context(t)
+	var n evmtypes.Nonce
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, FeeLimit: 100}
+	previous := txmgr.TxAttempt{TxFee: gas.EvmFee{DynamicFeeCap: assets.GWei(20), DynamicTipCap: assets.GWei(1)}, TxType: 0x2}
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", addr, mock.Anything, big.NewInt(1)).Return(types.NewTx(&types.LegacyTx{}), nil)
+	gc := newFeeConfig()
+	gc.priceMax = assets.GWei(100)
+
+	t.Run("new attempt priced with a legacy fee", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, uint32(100), mock.Anything, feetypes.OptTxTypeUnsupported).Return(gas.EvmFee{Legacy: assets.GWei(10)}, uint32(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		attempt, _, _, _, err := cks.NewTxAttemptWithType(ctx, etx, lggr, 0x2, feetypes.OptTxTypeUnsupported)
+		require.NoError(t, err)
+		assert.Equal(t, 0x0, attempt.TxType)
+		assert.Equal(t, assets.GWei(10), attempt.TxFee.Legacy)
+	})
+
+	t.Run("in-flight attempt bumped as legacy", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("BumpFee", mock.Anything, previous.TxFee, uint32(100), mock.Anything, mock.Anything).Return(gas.EvmFee{Legacy: assets.GWei(24)}, uint32(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		attempt, _, _, _, err := cks.NewBumpTxAttempt(ctx, etx, previous, nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x0, attempt.TxType)
+		assert.Equal(t, assets.GWei(24), attempt.TxFee.Legacy)
+	})
+
+	t.Run("in-flight attempt bumped as legacy with a bump strategy", func(t *testing.T) {
+		gc := newFeeConfig()
+		gc.priceMax = assets.GWei(100)
+		gc.bumpStrategy = evmconfig.BumpStrategy{Mode: evmconfig.BumpModeFixed, Min: assets.GWei(5)}
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("DynamicFeesUnsupported").Return(true).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		attempt, _, _, _, err := cks.NewBumpTxAttempt(ctx, etx, previous, nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x0, attempt.TxType)
+		assert.Equal(t, assets.GWei(25), attempt.TxFee.Legacy)
+	})
+}
+
+func TestTxm_EvmTxAttemptBuilder_CalldataTransformer(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	lggr := logger.Test(t)
+	gc := newFeeConfig()
+	gc.priceMax = assets.NewWeiI(50)
+	wrap := txmgr.CalldataTransformerFunc(func(etx txmgr.Tx, payload []byte) ([]byte, error) {
+		return append([]byte{0xff}, payload...), nil
+	})
+
+	t.Run("signs the transformed payload", func(t *testing.T) {
+		kst := ksmocks.NewEth(t)
+		kst.On("SignTx", addr, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return bytes.Equal(tx.Data(), []byte{0xff, 0xff, 1, 2, 3})
+		}), big.NewInt(1)).Return(types.NewTx(&types.LegacyTx{}), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, nil).
+			WithCalldataTransformer(txmgr.ChainCalldataTransformers{wrap, wrap})
+
+		var n evmtypes.Nonce
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, EncodedPayload: []byte{1, 2, 3}}
+		_, _, err := cks.NewCustomTxAttempt(etx, gas.EvmFee{Legacy: assets.NewWeiI(25)}, 100, 0x0, lggr)
+		require.NoError(t, err)
+		// the stored payload is left untouched
+		assert.Equal(t, []byte{1, 2, 3}, etx.EncodedPayload)
+	})
+
+	t.Run("transformer errors are not retryable", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, ksmocks.NewEth(t), est).
+			WithCalldataTransformer(txmgr.CalldataTransformerFunc(func(etx txmgr.Tx, payload []byte) ([]byte, error) {
+				return nil, errors.New("boom")
+			}))
+
+		_, _, _, retryable, err := cks.NewTxAttempt(testutils.Context(t), txmgr.Tx{FromAddress: addr}, lggr)
+		require.ErrorContains(t, err, "boom")
+		assert.False(t, retryable)
+	})
+}
+
+type testEnvelope struct{}
+
+func (testEnvelope) Seal(etx txmgr.Tx, tx *types.Transaction, chainID *big.Int, sign txmgr.DigestSignFunc) (gethcommon.Hash, []byte, error) {
+	sig, err := sign(gethcommon.BytesToHash(tx.Data()))
+	if err != nil {
+		return gethcommon.Hash{}, nil, err
+	}
+	return gethcommon.BytesToHash(sig), append([]byte{0x71}, sig...), nil
+}
+
+func TestTxm_EvmTxAttemptBuilder_TxEnvelope(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	lggr := logger.Test(t)
+	gc := newFeeConfig()
+	gc.priceMax = assets.NewWeiI(50)
+	var n evmtypes.Nonce
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, EncodedPayload: []byte{1, 2, 3}}
+
+	t.Run("seals the attempt", func(t *testing.T) {
+		kst := ksmocks.NewEth(t)
+		kst.On("SignDigest", addr, gethcommon.BytesToHash([]byte{1, 2, 3})).Return([]byte{0xaa}, nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, nil).WithTxEnvelope(testEnvelope{}, kst)
+
+		attempt, _, err := cks.NewCustomTxAttempt(etx, gas.EvmFee{Legacy: assets.NewWeiI(25)}, 100, 0x0, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x71, 0xaa}, attempt.SignedRawTx)
+		assert.Equal(t, gethcommon.BytesToHash([]byte{0xaa}), attempt.Hash)
+		// the fee type of the attempt is kept for bumping
+		assert.Equal(t, 0x0, attempt.TxType)
+	})
+
+	t.Run("requires a digest signer", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, ksmocks.NewEth(t), nil).WithTxEnvelope(testEnvelope{}, nil)
+
+		_, _, err := cks.NewCustomTxAttempt(etx, gas.EvmFee{Legacy: assets.NewWeiI(25)}, 100, 0x0, lggr)
+		require.ErrorContains(t, err, "tx envelope requires a digest signer")
+	})
+}
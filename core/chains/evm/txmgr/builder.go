@@ -1,6 +1,7 @@
 package txmgr
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
@@ -31,6 +32,7 @@ func NewTxm(
 	logPoller logpoller.LogPoller,
 	keyStore keystore.Eth,
 	estimator gas.EvmFeeEstimator,
+	capabilities evmclient.NodeCapabilities,
 ) (txm TxManager,
 	err error,
 ) {
@@ -41,9 +43,20 @@ func NewTxm(
 	} else {
 		lggr.Info("EvmForwarderManager: Disabled")
 	}
-	checker := &CheckerFactory{Client: client}
+	checker := &CheckerFactory{Client: client, Capabilities: capabilities}
+	envelope := TxEnvelopeForChainType(chainConfig.ChainType())
+	if aa := txConfig.AccountAbstraction(); aa.Enabled() && aa.Mode() == config.AccountAbstractionModePaymaster {
+		newEnvelope := PaymasterEnvelopeForChainType(chainConfig.ChainType())
+		if newEnvelope == nil {
+			return nil, fmt.Errorf("paymaster transactions are not supported by chain type %q", chainConfig.ChainType())
+		}
+		envelope = newEnvelope(aa.Paymaster(), aa.PaymasterInput())
+		lggr.Infow("Fees of transactions are paid by paymaster", "paymaster", aa.Paymaster())
+	}
 	// create tx attempt builder
-	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
+	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator).
+		WithCalldataTransformer(CalldataTransformerForChainType(chainConfig.ChainType())).
+		WithTxEnvelope(envelope, keyStore)
 	txStore := NewTxStore(db, lggr, dbConfig)
 	txNonceSyncer := NewNonceSyncer(txStore, lggr, client)
 
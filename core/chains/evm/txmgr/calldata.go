@@ -0,0 +1,61 @@
+package txmgr
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/common/config"
+)
+
+// CalldataTransformer rewrites the encoded payload of a transaction immediately before an attempt is built and signed.
+// It is used to support chains that require compressed or wrapped calldata without the need for bespoke transmitters.
+// Implementations must be deterministic: the same tx must always produce the same payload, since attempts are rebuilt
+// on every fee bump.
+type CalldataTransformer interface {
+	TransformCalldata(etx Tx, payload []byte) ([]byte, error)
+}
+
+// CalldataTransformerFunc adapts a plain function to the CalldataTransformer interface.
+type CalldataTransformerFunc func(etx Tx, payload []byte) ([]byte, error)
+
+func (f CalldataTransformerFunc) TransformCalldata(etx Tx, payload []byte) ([]byte, error) {
+	return f(etx, payload)
+}
+
+// ChainCalldataTransformers applies each transformer in order, feeding the output of one into the next.
+// This allows e.g. envelope wrapping to be followed by compression.
+type ChainCalldataTransformers []CalldataTransformer
+
+func (c ChainCalldataTransformers) TransformCalldata(etx Tx, payload []byte) ([]byte, error) {
+	var err error
+	for i, t := range c {
+		payload, err = t.TransformCalldata(etx, payload)
+		if err != nil {
+			return nil, fmt.Errorf("calldata transformer %d failed: %w", i, err)
+		}
+	}
+	return payload, nil
+}
+
+var calldataTransformers = struct {
+	sync.RWMutex
+	byChainType map[config.ChainType]CalldataTransformer
+}{byChainType: map[config.ChainType]CalldataTransformer{}}
+
+// RegisterCalldataTransformer registers the transformer used for all transactions on chains of the given type.
+// It is intended to be called from an init func; registering twice for the same chain type panics.
+func RegisterCalldataTransformer(chainType config.ChainType, t CalldataTransformer) {
+	calldataTransformers.Lock()
+	defer calldataTransformers.Unlock()
+	if _, exists := calldataTransformers.byChainType[chainType]; exists {
+		panic(fmt.Sprintf("calldata transformer already registered for chain type %q", chainType))
+	}
+	calldataTransformers.byChainType[chainType] = t
+}
+
+// CalldataTransformerForChainType returns the registered transformer for the chain type, or nil if there is none.
+func CalldataTransformerForChainType(chainType config.ChainType) CalldataTransformer {
+	calldataTransformers.RLock()
+	defer calldataTransformers.RUnlock()
+	return calldataTransformers.byChainType[chainType]
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -76,7 +78,13 @@ func (c *evmTxmClient) BatchSendTransactions(
 			defer wg.Done()
 
 			// convert to tx for logging purposes - exits early if error occurs
-			tx, signedErr := GetGethSignedTx(attempts[i].SignedRawTx)
+			var tx *types.Transaction
+			var signedErr error
+			if isSealed(attempts[i].SignedRawTx) {
+				tx = unsealedTx(attempts[i])
+			} else {
+				tx, signedErr = GetGethSignedTx(attempts[i].SignedRawTx)
+			}
 			if signedErr != nil {
 				signedErrMsg := fmt.Sprintf("failed to process tx (index %d)", i)
 				lggr.Errorw(signedErrMsg, "err", signedErr)
@@ -94,6 +102,9 @@ func (c *evmTxmClient) BatchSendTransactions(
 }
 
 func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, attempt TxAttempt, lggr logger.SugaredLogger) (commonclient.SendTxReturnCode, error) {
+	if isSealed(attempt.SignedRawTx) {
+		return c.sendSealedTransaction(ctx, etx, attempt, lggr)
+	}
 	signedTx, err := GetGethSignedTx(attempt.SignedRawTx)
 	if err != nil {
 		lggr.Criticalw("Fatal error signing transaction", "err", err, "etx", etx)
@@ -102,6 +113,14 @@ func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, at
 	return c.client.SendTransactionReturnCode(ctx, signedTx, etx.FromAddress)
 }
 
+// sendSealedTransaction broadcasts an attempt sealed by a TxEnvelope as is, since it cannot be decoded into a
+// types.Transaction.
+func (c *evmTxmClient) sendSealedTransaction(ctx context.Context, etx Tx, attempt TxAttempt, lggr logger.SugaredLogger) (commonclient.SendTxReturnCode, error) {
+	var hash common.Hash
+	err := c.client.CallContext(ctx, &hash, "eth_sendRawTransaction", hexutil.Encode(attempt.SignedRawTx))
+	return client.ClassifySendError(err, lggr, unsealedTx(attempt), etx.FromAddress, c.client.IsL2()), err
+}
+
 func (c *evmTxmClient) PendingNonceAt(ctx context.Context, fromAddress common.Address) (n evmtypes.Nonce, err error) {
 	nextNonce, err := c.client.PendingNonceAt(ctx, fromAddress)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/common/config"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 )
 
 // ChainConfig encompasses config used by txmgr package
@@ -33,6 +34,8 @@ type FeeConfig interface {
 	PriceMax() *assets.Wei
 	PriceMin() *assets.Wei
 	PriceMaxKey(gethcommon.Address) *assets.Wei
+	BumpStrategyKey(gethcommon.Address) evmconfig.BumpStrategy
+	LimitMultiplier() float32
 }
 
 type DatabaseConfig interface {
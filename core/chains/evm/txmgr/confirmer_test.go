@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
@@ -178,6 +179,42 @@ func TestEthConfirmer_Lifecycle(t *testing.T) {
 	require.NoError(t, ec.XXXTestCloseInternal())
 }
 
+func TestEthConfirmer_InclusionSLA(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.InclusionTarget = commonconfig.MustNewDuration(time.Minute)
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := ksmocks.NewEth(t)
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything).Return(nil, nil)
+	ge := evmcfg.EVM().GasEstimator()
+	ec := txmgr.NewEvmConfirmer(nil, txmgr.NewEvmTxmClient(ethClient), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, nil, logger.Test(t))
+	servicetest.Run(t, ec)
+
+	// the p95 is only checked once the job has enough inclusions
+	for i := 0; i < 19; i++ {
+		ec.XXXTestObserveInclusion(1, 5*time.Minute)
+	}
+	require.NoError(t, ec.HealthReport()[ec.Name()])
+
+	// job 1 now has enough inclusions, and most of them exceed the target
+	ec.XXXTestObserveInclusion(1, 10*time.Second)
+	for i := 0; i < 20; i++ {
+		ec.XXXTestObserveInclusion(2, 10*time.Second)
+	}
+	err := ec.HealthReport()[ec.Name()]
+	require.ErrorContains(t, err, "p95 transaction inclusion time exceeds the target of 1m0s (job 1: 5m0s)")
+	require.NotContains(t, err.Error(), "job 2")
+
+	// the oldest inclusions leave the window as new ones are observed
+	for i := 0; i < 100; i++ {
+		ec.XXXTestObserveInclusion(1, 10*time.Second)
+	}
+	require.NoError(t, ec.HealthReport()[ec.Name()])
+}
+
 func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 	t.Parallel()
 
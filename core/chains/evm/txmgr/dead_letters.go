@@ -0,0 +1,50 @@
+package txmgr
+
+import (
+	"strings"
+	"time"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+// DeadLetterClass classifies the error which made a dead-lettered transaction fatal, to tell operators what must be
+// fixed before it is requeued.
+type DeadLetterClass string
+
+const (
+	DeadLetterInsufficientFunds DeadLetterClass = "insufficient_funds"
+	DeadLetterFeeCapExceeded    DeadLetterClass = "fee_cap_exceeded"
+	DeadLetterUnderpriced       DeadLetterClass = "underpriced"
+	DeadLetterNonce             DeadLetterClass = "nonce"
+	DeadLetterAbandoned         DeadLetterClass = "abandoned"
+	DeadLetterOther             DeadLetterClass = "other"
+)
+
+// DeadLetter is a fatally errored transaction, which is kept with its payload until it is requeued.
+type DeadLetter struct {
+	Tx             Tx
+	Classification DeadLetterClass
+	DeadLetteredAt time.Time
+}
+
+// ClassifyDeadLetter classifies the error of a fatally errored transaction, as returned by the RPC node or set by the
+// txmgr.
+func ClassifyDeadLetter(errMsg string) DeadLetterClass {
+	lower := strings.ToLower(errMsg)
+	if strings.Contains(lower, "abandoned") || strings.Contains(lower, "fromaddress for this tx was deleted") {
+		return DeadLetterAbandoned
+	}
+	sendErr := evmclient.NewSendErrorS(errMsg)
+	switch {
+	case sendErr.IsInsufficientEth():
+		return DeadLetterInsufficientFunds
+	case sendErr.IsTxFeeExceedsCap(), sendErr.IsL2FeeTooHigh():
+		return DeadLetterFeeCapExceeded
+	case sendErr.IsTerminallyUnderpriced(), sendErr.L2FeeTooLow():
+		return DeadLetterUnderpriced
+	case sendErr.IsNonceTooLowError(), sendErr.IsNonceTooHighError():
+		return DeadLetterNonce
+	default:
+		return DeadLetterOther
+	}
+}
@@ -0,0 +1,28 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+func TestClassifyDeadLetter(t *testing.T) {
+	for _, tt := range []struct {
+		errMsg string
+		exp    txmgr.DeadLetterClass
+	}{
+		{"insufficient funds for gas * price + value", txmgr.DeadLetterInsufficientFunds},
+		{"tx fee (1.10 ether) exceeds the configured cap (1.00 ether)", txmgr.DeadLetterFeeCapExceeded},
+		{"transaction underpriced", txmgr.DeadLetterUnderpriced},
+		{"nonce too low", txmgr.DeadLetterNonce},
+		{"The FromAddress for this Tx was deleted before this Tx could be broadcast to the chain.", txmgr.DeadLetterAbandoned},
+		{"fromAddress for this Tx was deleted, and existing attempts onchain didn't finalize within 24 hours, thus this Tx was abandoned.", txmgr.DeadLetterAbandoned},
+		{"something exploded", txmgr.DeadLetterOther},
+	} {
+		t.Run(tt.errMsg, func(t *testing.T) {
+			assert.Equal(t, tt.exp, txmgr.ClassifyDeadLetter(tt.errMsg))
+		})
+	}
+}
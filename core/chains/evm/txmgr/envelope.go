@@ -0,0 +1,117 @@
+package txmgr
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink/v2/common/config"
+)
+
+// TxEnvelope seals the attempts of transactions on chains which require a transaction format other than the standard
+// legacy and dynamic fee transactions, like the EIP-712 transactions of zkSync with paymaster fields. It is used to
+// support such chains with a per-chain adapter instead of a bespoke broadcaster.
+// The sealed transaction must be an EIP-2718 typed transaction, which is broadcast as is with eth_sendRawTransaction.
+type TxEnvelope interface {
+	// Seal returns the hash and the signed raw bytes of the attempt of etx. tx is the standard attempt, which is not
+	// signed, and carries the nonce, fees, gas limit and payload of the attempt. sign signs digests with the key of
+	// etx.FromAddress.
+	Seal(etx Tx, tx *types.Transaction, chainID *big.Int, sign DigestSignFunc) (hash common.Hash, signedRawTx []byte, err error)
+}
+
+// DigestSignFunc signs a digest, returning the signature in the [R || S || V] format where V is 0 or 1.
+type DigestSignFunc func(digest common.Hash) ([]byte, error)
+
+// DigestSigner signs digests with the keys of the node.
+type DigestSigner interface {
+	SignDigest(fromAddress common.Address, digest common.Hash) ([]byte, error)
+}
+
+var txEnvelopes = struct {
+	sync.RWMutex
+	byChainType map[config.ChainType]TxEnvelope
+}{byChainType: map[config.ChainType]TxEnvelope{}}
+
+// RegisterTxEnvelope registers the envelope sealing all transactions on chains of the given type.
+// It is intended to be called from an init func; registering twice for the same chain type panics.
+func RegisterTxEnvelope(chainType config.ChainType, e TxEnvelope) {
+	txEnvelopes.Lock()
+	defer txEnvelopes.Unlock()
+	if _, exists := txEnvelopes.byChainType[chainType]; exists {
+		panic(fmt.Sprintf("tx envelope already registered for chain type %q", chainType))
+	}
+	txEnvelopes.byChainType[chainType] = e
+}
+
+// TxEnvelopeForChainType returns the registered envelope for the chain type, or nil if there is none.
+func TxEnvelopeForChainType(chainType config.ChainType) TxEnvelope {
+	txEnvelopes.RLock()
+	defer txEnvelopes.RUnlock()
+	return txEnvelopes.byChainType[chainType]
+}
+
+// PaymasterEnvelopeFunc returns the envelope sealing transactions whose fees are paid by paymaster, which is called with
+// input to validate them.
+type PaymasterEnvelopeFunc func(paymaster common.Address, input []byte) TxEnvelope
+
+var paymasterEnvelopes = struct {
+	sync.RWMutex
+	byChainType map[config.ChainType]PaymasterEnvelopeFunc
+}{byChainType: map[config.ChainType]PaymasterEnvelopeFunc{}}
+
+// RegisterPaymasterEnvelope registers the envelope of the native paymaster transactions of chains of the given type,
+// which is used instead of the envelope of the chain type when paymaster transactions are configured.
+// It is intended to be called from an init func; registering twice for the same chain type panics.
+func RegisterPaymasterEnvelope(chainType config.ChainType, fn PaymasterEnvelopeFunc) {
+	paymasterEnvelopes.Lock()
+	defer paymasterEnvelopes.Unlock()
+	if _, exists := paymasterEnvelopes.byChainType[chainType]; exists {
+		panic(fmt.Sprintf("paymaster envelope already registered for chain type %q", chainType))
+	}
+	paymasterEnvelopes.byChainType[chainType] = fn
+}
+
+// PaymasterEnvelopeForChainType returns the registered paymaster envelope for the chain type, or nil if there is none.
+func PaymasterEnvelopeForChainType(chainType config.ChainType) PaymasterEnvelopeFunc {
+	paymasterEnvelopes.RLock()
+	defer paymasterEnvelopes.RUnlock()
+	return paymasterEnvelopes.byChainType[chainType]
+}
+
+// isSealed returns true if signedRawTx was sealed by a TxEnvelope. Standard attempts are RLP encoded, so they start
+// with a string or list prefix, while sealed attempts start with their EIP-2718 type, which is below 0x80.
+func isSealed(signedRawTx []byte) bool {
+	return len(signedRawTx) > 0 && signedRawTx[0] < 0x80
+}
+
+// unsealedTx returns the standard, unsigned, transaction of a sealed attempt, which stands in for it where a
+// types.Transaction is expected, like in logs and the classification of send errors.
+func unsealedTx(attempt TxAttempt) *types.Transaction {
+	var nonce uint64
+	if attempt.Tx.Sequence != nil {
+		nonce = uint64(*attempt.Tx.Sequence)
+	}
+	to := attempt.Tx.ToAddress
+	if attempt.TxType == 0x2 {
+		return types.NewTx(&types.DynamicFeeTx{
+			Nonce:     nonce,
+			GasTipCap: attempt.TxFee.DynamicTipCap.ToInt(),
+			GasFeeCap: attempt.TxFee.DynamicFeeCap.ToInt(),
+			Gas:       uint64(attempt.ChainSpecificFeeLimit),
+			To:        &to,
+			Value:     &attempt.Tx.Value,
+			Data:      attempt.Tx.EncodedPayload,
+		})
+	}
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: attempt.TxFee.Legacy.ToInt(),
+		Gas:      uint64(attempt.ChainSpecificFeeLimit),
+		To:       &to,
+		Value:    &attempt.Tx.Value,
+		Data:     attempt.Tx.EncodedPayload,
+	})
+}
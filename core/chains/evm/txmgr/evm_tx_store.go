@@ -59,6 +59,8 @@ type TxStoreWebApi interface {
 	TransactionsWithAttempts(offset, limit int) ([]Tx, int, error)
 	FindTxAttempt(hash common.Hash) (*TxAttempt, error)
 	FindTxWithAttempts(etxID int64) (etx Tx, err error)
+	DeadLetters(offset, limit int) ([]DeadLetter, int, error)
+	RequeueDeadLetter(ctx context.Context, etxID int64) (*Tx, error)
 }
 
 type TestEvmTxStore interface {
@@ -1577,10 +1579,77 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 		}
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err := pkgerrors.Wrap(tx.Get(&dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+		if err := tx.Get(&dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID); err != nil {
+			return pkgerrors.Wrap(err, "saveFatallyErroredTransaction failed to save eth_tx")
+		}
 		dbEtx.ToTx(etx)
-		return err
+		_, err := tx.Exec(`INSERT INTO evm.tx_dead_letters (tx_id, classification, created_at) VALUES ($1, $2, NOW()) ON CONFLICT (tx_id) DO NOTHING`, etx.ID, ClassifyDeadLetter(etx.Error.String))
+		return pkgerrors.Wrap(err, "saveFatallyErroredTransaction failed to dead-letter eth_tx")
+	})
+}
+
+type dbDeadLetter struct {
+	DbEthTx
+	Classification DeadLetterClass
+	DeadLetteredAt time.Time
+}
+
+// DeadLetters returns the dead-lettered transactions, most recently dead-lettered first.
+func (o *evmTxStore) DeadLetters(offset, limit int) (letters []DeadLetter, count int, err error) {
+	if err = o.q.Get(&count, `SELECT count(*) FROM evm.tx_dead_letters`); err != nil {
+		return
+	}
+	var dbLetters []dbDeadLetter
+	sql := `SELECT evm.txes.*, evm.tx_dead_letters.classification, evm.tx_dead_letters.created_at AS dead_lettered_at FROM evm.tx_dead_letters
+INNER JOIN evm.txes ON evm.txes.id = evm.tx_dead_letters.tx_id
+ORDER BY evm.tx_dead_letters.created_at DESC, evm.tx_dead_letters.tx_id DESC LIMIT $1 OFFSET $2`
+	if err = o.q.Select(&dbLetters, sql, limit, offset); err != nil {
+		return
+	}
+	letters = make([]DeadLetter, len(dbLetters))
+	for i, l := range dbLetters {
+		l.ToTx(&letters[i].Tx)
+		letters[i].Classification = l.Classification
+		letters[i].DeadLetteredAt = l.DeadLetteredAt
+	}
+	return
+}
+
+// RequeueDeadLetter moves a dead-lettered transaction back to unstarted, so that it is broadcast again. The pipeline
+// run which created it was already resumed with its fatal error, so it is no longer resumed by the transaction.
+// It returns sql.ErrNoRows if the transaction is not dead-lettered.
+func (o *evmTxStore) RequeueDeadLetter(ctx context.Context, etxID int64) (*Tx, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	qq := o.q.WithOpts(pg.WithParentCtx(ctx))
+	var etx Tx
+	err := qq.Transaction(func(tx pg.Queryer) error {
+		res, err := tx.Exec(`DELETE FROM evm.tx_dead_letters WHERE tx_id = $1`, etxID)
+		if err != nil {
+			return pkgerrors.Wrap(err, "RequeueDeadLetter failed to delete dead letter")
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return sql.ErrNoRows
+		}
+		var dbEtx DbEthTx
+		err = tx.Get(&dbEtx, `UPDATE evm.txes SET state='unstarted', error=NULL, nonce=NULL, broadcast_at=NULL, initial_broadcast_at=NULL,
+pipeline_task_run_id=NULL, signal_callback=FALSE, callback_completed=FALSE
+WHERE id = $1 AND state = 'fatal_error' RETURNING *`, etxID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkgerrors.Errorf("transaction %d is dead-lettered but not fatally errored", etxID)
+		} else if err != nil {
+			return pkgerrors.Wrap(err, "RequeueDeadLetter failed to update eth_tx")
+		}
+		dbEtx.ToTx(&etx)
+		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return &etx, nil
 }
 
 // Updates eth attempt from in_progress to broadcast. Also updates the eth tx to unconfirmed.
@@ -1918,13 +1987,14 @@ AND evm_chain_id = $4`, minBlockNumberToKeep, limit, timeThreshold, chainID.Stri
 	if err != nil {
 		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes batch delete of confirmed evm.txes failed")
 	}
-	// Delete old 'fatal_error' evm.txes
+	// Delete old 'fatal_error' evm.txes, apart from the dead-lettered ones which are kept until they are requeued
 	err = pg.Batch(func(_, limit uint) (count uint, err error) {
 		res, err := qq.Exec(`
 DELETE FROM evm.txes
 WHERE created_at < $1
 AND state = 'fatal_error'
-AND evm_chain_id = $2`, timeThreshold, chainID.String())
+AND evm_chain_id = $2
+AND NOT EXISTS (SELECT 1 FROM evm.tx_dead_letters WHERE tx_id = evm.txes.id)`, timeThreshold, chainID.String())
 		if err != nil {
 			return count, pkgerrors.Wrap(err, "ReapTxes failed to delete old fatally errored evm.txes")
 		}
@@ -1299,6 +1299,57 @@ func TestORM_UpdateTxFatalError(t *testing.T) {
 	})
 }
 
+func TestORM_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := newTestChainScopedConfig(t)
+	txStore := cltest.NewTestTxStore(t, db, cfg.Database())
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ctx := testutils.Context(t)
+
+	etx := mustInsertInProgressEthTxWithAttempt(t, txStore, 13, fromAddress)
+	etx.Error = null.StringFrom("insufficient funds for gas * price + value")
+	require.NoError(t, txStore.UpdateTxFatalError(ctx, &etx))
+	// transactions which were not errored by the txmgr, like abandoned ones, are not dead-lettered
+	mustInsertFatalErrorEthTx(t, txStore, fromAddress)
+
+	letters, count, err := txStore.DeadLetters(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Len(t, letters, 1)
+	assert.Equal(t, etx.ID, letters[0].Tx.ID)
+	assert.Equal(t, txmgr.DeadLetterInsufficientFunds, letters[0].Classification)
+	assert.Equal(t, "insufficient funds for gas * price + value", letters[0].Tx.Error.String)
+
+	t.Run("dead letters are not reaped", func(t *testing.T) {
+		require.NoError(t, txStore.ReapTxHistory(ctx, 0, time.Now().Add(time.Hour), &cltest.FixtureChainID))
+		_, count, err := txStore.DeadLetters(0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		txes, err := txStore.GetFatalTransactions(ctx)
+		require.NoError(t, err)
+		require.Len(t, txes, 1)
+		assert.Equal(t, etx.ID, txes[0].ID)
+	})
+
+	t.Run("requeue", func(t *testing.T) {
+		requeued, err := txStore.RequeueDeadLetter(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, requeued.State)
+		assert.False(t, requeued.Error.Valid)
+		assert.Equal(t, etx.EncodedPayload, requeued.EncodedPayload)
+
+		_, count, err := txStore.DeadLetters(0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+
+		_, err = txStore.RequeueDeadLetter(ctx, etx.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
 func TestORM_UpdateTxAttemptInProgressToBroadcast(t *testing.T) {
 	t.Parallel()
 
@@ -49,6 +49,7 @@ type TestEvmConfig struct {
 	ResendAfterThreshold time.Duration
 	BumpThreshold        uint64
 	MaxQueued            uint64
+	InclusionTarget      time.Duration
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
@@ -89,6 +90,9 @@ func (g *TestGasEstimatorConfig) LimitJobType() evmconfig.LimitJobType {
 func (g *TestGasEstimatorConfig) PriceMaxKey(addr common.Address) *assets.Wei {
 	return assets.NewWeiI(42)
 }
+func (g *TestGasEstimatorConfig) BumpStrategyKey(addr common.Address) evmconfig.BumpStrategy {
+	return evmconfig.BumpStrategy{Percent: g.BumpPercent(), Min: g.BumpMin()}
+}
 
 func (e *TestEvmConfig) GasEstimator() evmconfig.GasEstimator {
 	return &TestGasEstimatorConfig{bumpThreshold: e.BumpThreshold}
@@ -125,6 +129,16 @@ func (t *transactionsConfig) MaxQueued() uint64                   { return t.e.M
 func (t *transactionsConfig) ReaperInterval() time.Duration       { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration      { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) InclusionTarget() time.Duration      { return t.e.InclusionTarget }
+func (*transactionsConfig) AccountAbstraction() evmconfig.AccountAbstraction {
+	return &accountAbstractionConfig{}
+}
+
+type accountAbstractionConfig struct {
+	evmconfig.AccountAbstraction
+}
+
+func (*accountAbstractionConfig) Enabled() bool { return false }
 
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
@@ -2,6 +2,7 @@ package txmgr
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -44,13 +45,15 @@ var (
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
 type CheckerFactory struct {
 	Client evmclient.Client
+	// Capabilities of the nodes behind Client, optional
+	Capabilities evmclient.NodeCapabilities
 }
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		return &SimulateChecker{Client: c.Client, Capabilities: c.Capabilities}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, errors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -120,6 +123,8 @@ func (noChecker) Check(
 // SimulateChecker simulates transactions, producing an error if they revert on chain.
 type SimulateChecker struct {
 	Client evmclient.Client
+	// Capabilities of the nodes behind Client, optional. Reverts are traced if all nodes support debug or trace calls.
+	Capabilities evmclient.NodeCapabilities
 }
 
 // Check satisfies the TransmitChecker interface.
@@ -149,7 +154,8 @@ func (s *SimulateChecker) Check(
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
 			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String(),
+				"trace", s.traceCall(ctx, l, callArg))
 			return errors.Errorf("transaction reverted during simulation: %s", jErr.String())
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
@@ -161,6 +167,26 @@ func (s *SimulateChecker) Check(
 	return nil
 }
 
+// traceCall returns the call trace of callArg on the latest block, or nil if the nodes do not support tracing calls.
+func (s *SimulateChecker) traceCall(ctx context.Context, l logger.SugaredLogger, callArg map[string]interface{}) (trace json.RawMessage) {
+	if s.Capabilities == nil {
+		return nil
+	}
+	var err error
+	if s.Capabilities.AllNodes(func(caps evmclient.Capabilities) bool { return caps.Debug }) {
+		err = s.Client.CallContext(ctx, &trace, "debug_traceCall", callArg, evmclient.ToBlockNumArg(nil), map[string]interface{}{"tracer": "callTracer"})
+	} else if s.Capabilities.AllNodes(func(caps evmclient.Capabilities) bool { return caps.Trace }) {
+		err = s.Client.CallContext(ctx, &trace, "trace_call", callArg, []string{"trace"}, evmclient.ToBlockNumArg(nil))
+	} else {
+		return nil
+	}
+	if err != nil {
+		l.Debugw("Failed to trace reverted transaction", "err", err)
+		return nil
+	}
+	return trace
+}
+
 // VRFV1Checker is an implementation of TransmitChecker that checks whether a VRF V1 fulfillment
 // has already been fulfilled.
 type VRFV1Checker struct {
@@ -160,6 +160,27 @@ func TestTransmitCheckers(t *testing.T) {
 			require.EqualError(t, err, expErrMsg)
 		})
 
+		t.Run("revert with trace", func(t *testing.T) {
+			checker := txmgr.SimulateChecker{Client: client, Capabilities: debugNodes(true)}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "latest").
+				Return(&evmclient.JsonError{Code: 42, Message: "oh no, it reverted"}).Once()
+			client.On("CallContext", mock.Anything,
+				mock.Anything, "debug_traceCall", mock.Anything, "latest",
+				map[string]interface{}{"tracer": "callTracer"}).Return(nil).Once()
+
+			require.ErrorContains(t, checker.Check(ctx, log, tx, attempt), "transaction reverted during simulation")
+		})
+
+		t.Run("revert without trace support", func(t *testing.T) {
+			checker := txmgr.SimulateChecker{Client: client, Capabilities: debugNodes(false)}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "latest").
+				Return(&evmclient.JsonError{Code: 42, Message: "oh no, it reverted"}).Once()
+
+			require.ErrorContains(t, checker.Check(ctx, log, tx, attempt), "transaction reverted during simulation")
+		})
+
 		t.Run("non revert error", func(t *testing.T) {
 			client.On("CallContext", mock.Anything,
 				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
@@ -373,3 +394,10 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 }
+
+// debugNodes are nodes which all support the debug namespace, or none of which supports any tracing.
+type debugNodes bool
+
+func (d debugNodes) AllNodes(fn func(evmclient.Capabilities) bool) bool {
+	return fn(evmclient.Capabilities{Debug: bool(d)})
+}
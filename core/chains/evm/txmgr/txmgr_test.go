@@ -16,6 +16,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/jmoiron/sqlx"
@@ -24,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
@@ -72,7 +76,8 @@ func makeTestEvmTxm(
 		lggr,
 		lp,
 		keyStore,
-		estimator)
+		estimator,
+		nil)
 }
 
 func TestTxm_SendNativeToken_DoesNotSendToZero(t *testing.T) {
@@ -296,6 +301,31 @@ func TestTxm_CreateTransaction(t *testing.T) {
 		require.Equal(t, checker, c)
 	})
 
+	t.Run("meta carries the trace context of a traced creator", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM evm.txes`)
+		evmConfig.MaxQueued = uint64(1)
+		prev := otel.GetTracerProvider()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider())
+		t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+		ctx, span := tracing.Start(testutils.Context(t), "test")
+		defer span.End()
+		etx, err := txm.CreateTransaction(ctx, txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			FeeLimit:       gasLimit,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+
+		m, err := etx.GetMeta()
+		require.NoError(t, err)
+		require.NotNil(t, m)
+		require.Contains(t, m.TraceContext, "traceparent")
+		assert.Equal(t, span.SpanContext().TraceID(), trace.SpanContextFromContext(etx.WithTraceContext(testutils.Context(t))).TraceID())
+	})
+
 	t.Run("forwards tx when a proper forwarder is set up", func(t *testing.T) {
 		pgtest.MustExec(t, db, `DELETE FROM evm.txes`)
 		pgtest.MustExec(t, db, `DELETE FROM evm.forwarders`)
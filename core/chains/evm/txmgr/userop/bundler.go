@@ -0,0 +1,78 @@
+package userop
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// GasEstimate is the gas of a user operation, as estimated by a bundler.
+type GasEstimate struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+// Receipt is the outcome of a user operation which was included on chain.
+type Receipt struct {
+	UserOpHash common.Hash `json:"userOpHash"`
+	Success    bool        `json:"success"`
+	Reason     string      `json:"reason"`
+	Receipt    struct {
+		TransactionHash common.Hash `json:"transactionHash"`
+	} `json:"receipt"`
+}
+
+// Bundler is a client of the ERC-4337 RPC methods of a bundler.
+type Bundler struct {
+	rpc *rpc.Client
+}
+
+// DialBundler returns a client of the bundler at url.
+func DialBundler(ctx context.Context, url string) (*Bundler, error) {
+	c, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial bundler")
+	}
+	return &Bundler{rpc: c}, nil
+}
+
+// SendUserOperation submits op to the mempool of the bundler, returning its hash.
+func (b *Bundler) SendUserOperation(ctx context.Context, op UserOperation, entryPoint common.Address) (hash common.Hash, err error) {
+	err = b.rpc.CallContext(ctx, &hash, "eth_sendUserOperation", op, entryPoint)
+	return
+}
+
+// EstimateUserOperationGas estimates the gas of op, which must carry a signature of the expected length.
+func (b *Bundler) EstimateUserOperationGas(ctx context.Context, op UserOperation, entryPoint common.Address) (est GasEstimate, err error) {
+	err = b.rpc.CallContext(ctx, &est, "eth_estimateUserOperationGas", op, entryPoint)
+	if err == nil && (est.PreVerificationGas == nil || est.VerificationGasLimit == nil || est.CallGasLimit == nil) {
+		err = errors.New("incomplete gas estimate")
+	}
+	return
+}
+
+// GetUserOperationReceipt returns the receipt of the user operation with hash, or nil if it was not included yet.
+func (b *Bundler) GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*Receipt, error) {
+	var raw json.RawMessage
+	if err := b.rpc.CallContext(ctx, &raw, "eth_getUserOperationReceipt", hash); err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var r Receipt
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return nil, errors.Wrap(err, "failed to decode receipt")
+	}
+	return &r, nil
+}
+
+// Close closes the connection to the bundler.
+func (b *Bundler) Close() {
+	b.rpc.Close()
+}
@@ -0,0 +1,120 @@
+// Package userop submits the transactions of the EVM transaction manager as ERC-4337 user operations of a smart
+// account, through a bundler, so that the sending keys do not need to hold the native gas token of the chain. The keys
+// only sign user operations, as the owners of the account, while the account, or its paymaster, pays the fees.
+package userop
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOperation is a user operation of the v0.6 EntryPoint.
+type UserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+type userOperationJSON struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+// MarshalJSON encodes op as expected by the eth_ namespace of bundlers, with hex quantities.
+func (op UserOperation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(userOperationJSON{
+		Sender:               op.Sender,
+		Nonce:                hexBig(op.Nonce),
+		InitCode:             nonNilBytes(op.InitCode),
+		CallData:             nonNilBytes(op.CallData),
+		CallGasLimit:         hexBig(op.CallGasLimit),
+		VerificationGasLimit: hexBig(op.VerificationGasLimit),
+		PreVerificationGas:   hexBig(op.PreVerificationGas),
+		MaxFeePerGas:         hexBig(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: hexBig(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     nonNilBytes(op.PaymasterAndData),
+		Signature:            nonNilBytes(op.Signature),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (op *UserOperation) UnmarshalJSON(b []byte) error {
+	var j userOperationJSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	*op = UserOperation{
+		Sender:               j.Sender,
+		Nonce:                j.Nonce.ToInt(),
+		InitCode:             j.InitCode,
+		CallData:             j.CallData,
+		CallGasLimit:         j.CallGasLimit.ToInt(),
+		VerificationGasLimit: j.VerificationGasLimit.ToInt(),
+		PreVerificationGas:   j.PreVerificationGas.ToInt(),
+		MaxFeePerGas:         j.MaxFeePerGas.ToInt(),
+		MaxPriorityFeePerGas: j.MaxPriorityFeePerGas.ToInt(),
+		PaymasterAndData:     j.PaymasterAndData,
+		Signature:            j.Signature,
+	}
+	return nil
+}
+
+// Hash returns the hash of op which is signed by the owner of its sender, as computed by EntryPoint.getUserOpHash.
+func (op UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed := crypto.Keccak256(
+		common.LeftPadBytes(op.Sender.Bytes(), 32),
+		word(op.Nonce),
+		crypto.Keccak256(op.InitCode),
+		crypto.Keccak256(op.CallData),
+		word(op.CallGasLimit),
+		word(op.VerificationGasLimit),
+		word(op.PreVerificationGas),
+		word(op.MaxFeePerGas),
+		word(op.MaxPriorityFeePerGas),
+		crypto.Keccak256(op.PaymasterAndData),
+	)
+	return crypto.Keccak256Hash(packed, common.LeftPadBytes(entryPoint.Bytes(), 32), word(chainID))
+}
+
+// word returns the ABI encoding of i as a uint256.
+func word(i *big.Int) []byte {
+	if i == nil {
+		return make([]byte, 32)
+	}
+	return common.LeftPadBytes(i.Bytes(), 32)
+}
+
+func hexBig(i *big.Int) *hexutil.Big {
+	if i == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return (*hexutil.Big)(i)
+}
+
+func nonNilBytes(b []byte) hexutil.Bytes {
+	if b == nil {
+		return hexutil.Bytes{}
+	}
+	return b
+}
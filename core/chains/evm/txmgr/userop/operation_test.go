@@ -0,0 +1,85 @@
+package userop
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestUserOperation_Hash(t *testing.T) {
+	t.Parallel()
+
+	op := UserOperation{
+		Sender:               testutils.NewAddress(),
+		Nonce:                big.NewInt(3),
+		CallData:             []byte{1, 2, 3},
+		CallGasLimit:         big.NewInt(100000),
+		VerificationGasLimit: big.NewInt(200000),
+		PreVerificationGas:   big.NewInt(50000),
+		MaxFeePerGas:         big.NewInt(2e9),
+		MaxPriorityFeePerGas: big.NewInt(1e9),
+		PaymasterAndData:     []byte{4, 5},
+		Signature:            []byte{6},
+	}
+	entryPoint := testutils.NewAddress()
+	chainID := big.NewInt(137)
+
+	// the hash of EntryPoint.getUserOpHash, with the ABI encoding of the packed operation
+	mustType := func(s string) abi.Type {
+		typ, err := abi.NewType(s, "", nil)
+		require.NoError(t, err)
+		return typ
+	}
+	address, uint256, bytes32 := mustType("address"), mustType("uint256"), mustType("bytes32")
+	packed, err := abi.Arguments{{Type: address}, {Type: uint256}, {Type: bytes32}, {Type: bytes32}, {Type: uint256}, {Type: uint256},
+		{Type: uint256}, {Type: uint256}, {Type: uint256}, {Type: bytes32}}.Pack(
+		op.Sender, op.Nonce, crypto.Keccak256Hash(op.InitCode), crypto.Keccak256Hash(op.CallData), op.CallGasLimit,
+		op.VerificationGasLimit, op.PreVerificationGas, op.MaxFeePerGas, op.MaxPriorityFeePerGas, crypto.Keccak256Hash(op.PaymasterAndData))
+	require.NoError(t, err)
+	encoded, err := abi.Arguments{{Type: bytes32}, {Type: address}, {Type: uint256}}.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256Hash(encoded), op.Hash(entryPoint, chainID))
+
+	// the signature is not hashed
+	signed := op
+	signed.Signature = []byte{7}
+	assert.Equal(t, op.Hash(entryPoint, chainID), signed.Hash(entryPoint, chainID))
+	assert.NotEqual(t, op.Hash(entryPoint, chainID), op.Hash(entryPoint, big.NewInt(1)))
+}
+
+func TestUserOperation_JSON(t *testing.T) {
+	t.Parallel()
+
+	op := UserOperation{
+		Sender:               testutils.NewAddress(),
+		Nonce:                big.NewInt(3),
+		CallData:             []byte{1, 2, 3},
+		CallGasLimit:         big.NewInt(100000),
+		VerificationGasLimit: big.NewInt(200000),
+		PreVerificationGas:   big.NewInt(50000),
+		MaxFeePerGas:         big.NewInt(2e9),
+		MaxPriorityFeePerGas: big.NewInt(1e9),
+		Signature:            []byte{6},
+	}
+	b, err := json.Marshal(op)
+	require.NoError(t, err)
+
+	var fields map[string]string
+	require.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, "0x3", fields["nonce"])
+	assert.Equal(t, "0x", fields["initCode"])
+	assert.Equal(t, "0x010203", fields["callData"])
+	assert.Equal(t, "0x186a0", fields["callGasLimit"])
+
+	var decoded UserOperation
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, op.Hash(op.Sender, big.NewInt(1)), decoded.Hash(op.Sender, big.NewInt(1)))
+	assert.Equal(t, op.Signature, decoded.Signature)
+}
@@ -0,0 +1,121 @@
+package userop
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// State is the state of a user operation.
+type State string
+
+const (
+	// StatePending is the state of user operations which were submitted to the bundler, but not included yet.
+	StatePending State = "pending"
+	// StateIncluded is the state of user operations which were included and executed successfully.
+	StateIncluded State = "included"
+	// StateFailed is the state of user operations which were rejected by the bundler, or reverted on chain.
+	StateFailed State = "failed"
+)
+
+// Operation is a user operation submitted on behalf of a transaction request, and its status.
+type Operation struct {
+	ID             int64
+	EVMChainID     big.Big        `db:"evm_chain_id"`
+	Hash           common.Hash    `db:"hash"`
+	Sender         common.Address `db:"sender"`
+	Owner          common.Address `db:"owner"`
+	ToAddress      common.Address `db:"to_address"`
+	Value          big.Big        `db:"value"`
+	CallData       []byte         `db:"call_data"`
+	Nonce          big.Big        `db:"nonce"`
+	IdempotencyKey *string        `db:"idempotency_key"`
+	State          State          `db:"state"`
+	TxHash         *common.Hash   `db:"tx_hash"`
+	Error          null.String    `db:"error"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+// ORM stores the user operations of the chains and tracks their status.
+type ORM interface {
+	CreateOperation(ctx context.Context, op *Operation) error
+	FindOperationByIdempotencyKey(ctx context.Context, key string) (*Operation, error)
+	FindOperations(ctx context.Context, chainID big.Big, offset, limit int) ([]Operation, int, error)
+	PendingOperations(ctx context.Context, chainID big.Big) ([]Operation, error)
+	MaxPendingNonce(ctx context.Context, chainID big.Big, sender common.Address) (*big.Big, error)
+	MarkIncluded(ctx context.Context, id int64, txHash common.Hash) error
+	MarkFailed(ctx context.Context, id int64, txHash *common.Hash, reason string) error
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{pg.NewQ(db, lggr, cfg)}
+}
+
+// CreateOperation inserts op, setting its id and timestamps.
+func (o *orm) CreateOperation(ctx context.Context, op *Operation) error {
+	stmt := `INSERT INTO evm.user_operations (evm_chain_id, hash, sender, owner, to_address, value, call_data, nonce, idempotency_key, state, tx_hash, error, created_at, updated_at)
+VALUES (:evm_chain_id, :hash, :sender, :owner, :to_address, :value, :call_data, :nonce, :idempotency_key, :state, :tx_hash, :error, now(), now())
+RETURNING id, created_at, updated_at`
+	return errors.Wrap(o.q.WithOpts(pg.WithParentCtx(ctx)).GetNamed(stmt, op, op), "failed to insert user operation")
+}
+
+// FindOperationByIdempotencyKey returns the operation created for key, or nil if there is none.
+func (o *orm) FindOperationByIdempotencyKey(ctx context.Context, key string) (*Operation, error) {
+	var op Operation
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).Get(&op, `SELECT * FROM evm.user_operations WHERE idempotency_key = $1`, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return &op, err
+}
+
+// FindOperations returns a page of the operations of the chain, latest first, and the total count.
+func (o *orm) FindOperations(ctx context.Context, chainID big.Big, offset, limit int) (ops []Operation, count int, err error) {
+	err = o.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, `SELECT count(*) FROM evm.user_operations WHERE evm_chain_id = $1`, chainID); err != nil {
+			return errors.Wrap(err, "failed to count user operations")
+		}
+		return tx.Select(&ops, `SELECT * FROM evm.user_operations WHERE evm_chain_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3`, chainID, limit, offset)
+	}, pg.OptReadOnlyTx())
+	return
+}
+
+// PendingOperations returns the pending operations of the chain, oldest first.
+func (o *orm) PendingOperations(ctx context.Context, chainID big.Big) (ops []Operation, err error) {
+	err = o.q.WithOpts(pg.WithParentCtx(ctx)).Select(&ops, `SELECT * FROM evm.user_operations WHERE evm_chain_id = $1 AND state = 'pending' ORDER BY id`, chainID)
+	return
+}
+
+// MaxPendingNonce returns the highest nonce of the pending operations of sender, or nil if it has none.
+func (o *orm) MaxPendingNonce(ctx context.Context, chainID big.Big, sender common.Address) (nonce *big.Big, err error) {
+	err = o.q.WithOpts(pg.WithParentCtx(ctx)).Get(&nonce, `SELECT max(nonce) FROM evm.user_operations WHERE evm_chain_id = $1 AND sender = $2 AND state = 'pending'`, chainID, sender)
+	return
+}
+
+// MarkIncluded marks the operation with id as included by the transaction with txHash.
+func (o *orm) MarkIncluded(ctx context.Context, id int64, txHash common.Hash) error {
+	_, err := o.q.WithOpts(pg.WithParentCtx(ctx)).Exec(`UPDATE evm.user_operations SET state = 'included', tx_hash = $2, updated_at = now() WHERE id = $1`, id, txHash)
+	return err
+}
+
+// MarkFailed marks the operation with id as failed for reason, with the transaction which included it, if any.
+func (o *orm) MarkFailed(ctx context.Context, id int64, txHash *common.Hash, reason string) error {
+	_, err := o.q.WithOpts(pg.WithParentCtx(ctx)).Exec(`UPDATE evm.user_operations SET state = 'failed', tx_hash = $2, error = $3, updated_at = now() WHERE id = $1`, id, txHash, reason)
+	return err
+}
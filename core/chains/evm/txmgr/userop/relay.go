@@ -0,0 +1,296 @@
+package userop
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+var (
+	entryPointABI = evmtypes.MustGetABI(`[{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]}]`)
+	accountABI    = evmtypes.MustGetABI(`[{"type":"function","name":"execute","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]}]`)
+
+	// dummySignature stands in for the signature of user operations while their gas is estimated, since the gas of
+	// their validation depends on its length.
+	dummySignature = common.FromHex("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+)
+
+// Client is the subset of the EVM client used by the relay.
+type Client interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// BundlerClient is the subset of the ERC-4337 RPC methods used by the relay.
+type BundlerClient interface {
+	SendUserOperation(ctx context.Context, op UserOperation, entryPoint common.Address) (common.Hash, error)
+	EstimateUserOperationGas(ctx context.Context, op UserOperation, entryPoint common.Address) (GasEstimate, error)
+	GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*Receipt, error)
+}
+
+var _ BundlerClient = (*Bundler)(nil)
+
+// Relay submits transaction requests as the user operations of the smart account of a chain, signed by the keys
+// sending the requests, and tracks their status until they are included.
+type Relay struct {
+	services.StateMachine
+	lggr     logger.Logger
+	orm      ORM
+	bundler  BundlerClient
+	client   Client
+	signer   txmgr.DigestSigner
+	chainID  *big.Int
+	cfg      evmconfig.AccountAbstraction
+	priceMax *assets.Wei
+
+	sendMu sync.Mutex // serializes the allocation of nonces
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewRelay returns a relay of the chain with chainID, as configured by cfg. The fee caps of user operations are
+// bounded by priceMax.
+func NewRelay(lggr logger.Logger, orm ORM, bundler BundlerClient, client Client, signer txmgr.DigestSigner, chainID *big.Int, cfg evmconfig.AccountAbstraction, priceMax *assets.Wei) *Relay {
+	return &Relay{
+		lggr:     logger.Named(lggr, "UserOpRelay"),
+		orm:      orm,
+		bundler:  bundler,
+		client:   client,
+		signer:   signer,
+		chainID:  chainID,
+		cfg:      cfg,
+		priceMax: priceMax,
+		stopCh:   make(services.StopChan),
+	}
+}
+
+func (r *Relay) Start(context.Context) error {
+	return r.StartOnce("UserOpRelay", func() error {
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Relay) Close() error {
+	return r.StopOnce("UserOpRelay", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *Relay) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *Relay) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+// Send submits req as a user operation of the configured account, signed by req.FromAddress, which must own the
+// account. Requests with an idempotency key which was already sent return the existing operation.
+func (r *Relay) Send(ctx context.Context, req txmgr.TxRequest) (*Operation, error) {
+	if req.IdempotencyKey != nil {
+		existing, err := r.orm.FindOperationByIdempotencyKey(ctx, *req.IdempotencyKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find user operation")
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+
+	callData, err := accountABI.Pack("execute", req.ToAddress, &req.Value, req.EncodedPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode call")
+	}
+	maxFee, tipCap, err := r.fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entryPoint := r.cfg.EntryPoint()
+	op := UserOperation{
+		Sender:               r.cfg.Account(),
+		CallData:             callData,
+		MaxFeePerGas:         maxFee,
+		MaxPriorityFeePerGas: tipCap,
+		Signature:            dummySignature,
+	}
+	if paymaster := r.cfg.Paymaster(); paymaster != (common.Address{}) {
+		op.PaymasterAndData = append(paymaster.Bytes(), r.cfg.PaymasterInput()...)
+	}
+
+	r.sendMu.Lock()
+	defer r.sendMu.Unlock()
+
+	if op.Nonce, err = r.nextNonce(ctx, op.Sender); err != nil {
+		return nil, err
+	}
+	est, err := r.bundler.EstimateUserOperationGas(ctx, op, entryPoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to estimate user operation gas")
+	}
+	op.PreVerificationGas = est.PreVerificationGas.ToInt()
+	op.VerificationGasLimit = est.VerificationGasLimit.ToInt()
+	op.CallGasLimit = est.CallGasLimit.ToInt()
+	if feeLimit := new(big.Int).SetUint64(uint64(req.FeeLimit)); op.CallGasLimit.Cmp(feeLimit) < 0 {
+		op.CallGasLimit = feeLimit
+	}
+
+	hash := op.Hash(entryPoint, r.chainID)
+	// accounts validate the signature of the owner over the EIP-191 message of the hash
+	sig, err := r.signer.SignDigest(req.FromAddress, common.BytesToHash(accounts.TextHash(hash.Bytes())))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign user operation")
+	}
+	sig[64] += 27
+	op.Signature = sig
+
+	sent, err := r.bundler.SendUserOperation(ctx, op, entryPoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send user operation")
+	}
+	if sent != hash {
+		r.lggr.Warnw("Bundler returned an unexpected user operation hash", "expected", hash, "got", sent)
+	}
+
+	stored := &Operation{
+		EVMChainID:     *ubig.New(r.chainID),
+		Hash:           hash,
+		Sender:         op.Sender,
+		Owner:          req.FromAddress,
+		ToAddress:      req.ToAddress,
+		Value:          *ubig.New(&req.Value),
+		CallData:       req.EncodedPayload,
+		Nonce:          *ubig.New(op.Nonce),
+		IdempotencyKey: req.IdempotencyKey,
+		State:          StatePending,
+	}
+	if err = r.orm.CreateOperation(ctx, stored); err != nil {
+		return nil, err
+	}
+	r.lggr.Infow("Sent user operation", "hash", hash, "sender", op.Sender, "owner", req.FromAddress, "to", req.ToAddress, "nonce", op.Nonce)
+	return stored, nil
+}
+
+// nextNonce returns the next nonce of sender, after its pending operations.
+func (r *Relay) nextNonce(ctx context.Context, sender common.Address) (*big.Int, error) {
+	entryPoint := r.cfg.EntryPoint()
+	data, err := entryPointABI.Pack("getNonce", sender, new(big.Int))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode getNonce")
+	}
+	out, err := r.client.CallContract(ctx, ethereum.CallMsg{To: &entryPoint, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get nonce")
+	}
+	res, err := entryPointABI.Unpack("getNonce", out)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode nonce")
+	}
+	nonce := res[0].(*big.Int)
+
+	pending, err := r.orm.MaxPendingNonce(ctx, *ubig.New(r.chainID), sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pending nonce")
+	}
+	if pending != nil {
+		if next := new(big.Int).Add(pending.ToInt(), big.NewInt(1)); next.Cmp(nonce) > 0 {
+			nonce = next
+		}
+	}
+	return nonce, nil
+}
+
+// fees returns the fee caps of user operations, which fall back to the gas price on chains without dynamic fees.
+func (r *Relay) fees(ctx context.Context) (maxFee, tipCap *big.Int, err error) {
+	gasPrice, err := r.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get gas price")
+	}
+	tipCap, err = r.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		r.lggr.Debugw("Failed to get gas tip cap, using the gas price", "err", err)
+		tipCap = gasPrice
+		maxFee = new(big.Int).Set(gasPrice)
+	} else {
+		maxFee = new(big.Int).Add(gasPrice, tipCap)
+	}
+	if r.priceMax != nil {
+		if priceMax := r.priceMax.ToInt(); maxFee.Cmp(priceMax) > 0 {
+			maxFee = priceMax
+		}
+		if tipCap.Cmp(maxFee) > 0 {
+			tipCap = maxFee
+		}
+	}
+	return maxFee, tipCap, nil
+}
+
+func (r *Relay) run() {
+	defer r.wg.Done()
+	ctx, cancel := r.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(r.cfg.PollPeriod())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.poll(ctx)
+		}
+	}
+}
+
+// poll updates the status of the pending operations from their receipts.
+func (r *Relay) poll(ctx context.Context) {
+	ops, err := r.orm.PendingOperations(ctx, *ubig.New(r.chainID))
+	if err != nil {
+		r.lggr.Errorw("Failed to load pending user operations", "err", err)
+		return
+	}
+	for _, op := range ops {
+		receipt, err := r.bundler.GetUserOperationReceipt(ctx, op.Hash)
+		if err != nil {
+			r.lggr.Warnw("Failed to get user operation receipt", "hash", op.Hash, "err", err)
+			continue
+		}
+		if receipt == nil {
+			continue
+		}
+		txHash := receipt.Receipt.TransactionHash
+		if receipt.Success {
+			err = r.orm.MarkIncluded(ctx, op.ID, txHash)
+			r.lggr.Infow("User operation included", "hash", op.Hash, "txHash", txHash)
+		} else {
+			reason := receipt.Reason
+			if reason == "" {
+				reason = "user operation reverted"
+			}
+			err = r.orm.MarkFailed(ctx, op.ID, &txHash, reason)
+			r.lggr.Warnw("User operation failed", "hash", op.Hash, "txHash", txHash, "reason", reason)
+		}
+		if err != nil {
+			r.lggr.Errorw("Failed to update user operation", "hash", op.Hash, "err", err)
+		}
+	}
+}
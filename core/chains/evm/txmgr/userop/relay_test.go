@@ -0,0 +1,257 @@
+package userop
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type aaConfig struct {
+	entryPoint, account, paymaster common.Address
+}
+
+func (c aaConfig) Enabled() bool              { return true }
+func (c aaConfig) Mode() string               { return "userop" }
+func (c aaConfig) BundlerURL() *url.URL       { return nil }
+func (c aaConfig) EntryPoint() common.Address { return c.entryPoint }
+func (c aaConfig) Account() common.Address    { return c.account }
+func (c aaConfig) Paymaster() common.Address  { return c.paymaster }
+func (c aaConfig) PaymasterInput() []byte     { return []byte{0xaa} }
+func (c aaConfig) PollPeriod() time.Duration  { return 10 * time.Millisecond }
+
+type keySigner map[common.Address]func(common.Hash) ([]byte, error)
+
+func (s keySigner) SignDigest(from common.Address, digest common.Hash) ([]byte, error) {
+	return s[from](digest)
+}
+
+type fakeClient struct {
+	nonce *big.Int
+}
+
+func (c *fakeClient) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return common.LeftPadBytes(c.nonce.Bytes(), 32), nil
+}
+func (c *fakeClient) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return assets.GWei(10).ToInt(), nil
+}
+func (c *fakeClient) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return assets.GWei(1).ToInt(), nil
+}
+
+type memORM struct {
+	mu  sync.Mutex
+	ops []Operation
+}
+
+func (o *memORM) CreateOperation(_ context.Context, op *Operation) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	op.ID = int64(len(o.ops) + 1)
+	op.CreatedAt = time.Now()
+	o.ops = append(o.ops, *op)
+	return nil
+}
+
+func (o *memORM) FindOperationByIdempotencyKey(_ context.Context, key string) (*Operation, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, op := range o.ops {
+		if op.IdempotencyKey != nil && *op.IdempotencyKey == key {
+			return &op, nil
+		}
+	}
+	return nil, nil
+}
+
+func (o *memORM) FindOperations(context.Context, ubig.Big, int, int) ([]Operation, int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.ops, len(o.ops), nil
+}
+
+func (o *memORM) PendingOperations(context.Context, ubig.Big) (ops []Operation, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, op := range o.ops {
+		if op.State == StatePending {
+			ops = append(ops, op)
+		}
+	}
+	return
+}
+
+func (o *memORM) MaxPendingNonce(_ context.Context, _ ubig.Big, sender common.Address) (nonce *ubig.Big, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, op := range o.ops {
+		if op.State == StatePending && op.Sender == sender && (nonce == nil || op.Nonce.Cmp(nonce) > 0) {
+			n := op.Nonce
+			nonce = &n
+		}
+	}
+	return
+}
+
+func (o *memORM) MarkIncluded(_ context.Context, id int64, txHash common.Hash) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops[id-1].State = StateIncluded
+	o.ops[id-1].TxHash = &txHash
+	return nil
+}
+
+func (o *memORM) MarkFailed(_ context.Context, id int64, txHash *common.Hash, reason string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops[id-1].State = StateFailed
+	o.ops[id-1].TxHash = txHash
+	o.ops[id-1].Error = null.StringFrom(reason)
+	return nil
+}
+
+func (o *memORM) operation(id int64) Operation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.ops[id-1]
+}
+
+// bundlerService serves the eth_ methods of a bundler.
+type bundlerService struct {
+	entryPoint common.Address
+	chainID    *big.Int
+
+	mu       sync.Mutex
+	sent     []UserOperation
+	receipts map[common.Hash]*Receipt
+}
+
+func (s *bundlerService) SendUserOperation(op UserOperation, entryPoint common.Address) (common.Hash, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, op)
+	return op.Hash(entryPoint, s.chainID), nil
+}
+
+func (s *bundlerService) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) GasEstimate {
+	return GasEstimate{
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(45000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(60000)),
+	}
+}
+
+func (s *bundlerService) GetUserOperationReceipt(hash common.Hash) *Receipt {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.receipts[hash]
+}
+
+func TestRelay(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	signer := keySigner{owner: func(digest common.Hash) ([]byte, error) { return crypto.Sign(digest[:], key) }}
+	chainID := big.NewInt(137)
+	cfg := aaConfig{entryPoint: testutils.NewAddress(), account: testutils.NewAddress(), paymaster: testutils.NewAddress()}
+
+	service := &bundlerService{entryPoint: cfg.entryPoint, chainID: chainID, receipts: map[common.Hash]*Receipt{}}
+	rpcSrv := rpc.NewServer()
+	t.Cleanup(rpcSrv.Stop)
+	require.NoError(t, rpcSrv.RegisterName("eth", service))
+	ts := httptest.NewServer(rpcSrv)
+	t.Cleanup(ts.Close)
+	bundler, err := DialBundler(testutils.Context(t), ts.URL)
+	require.NoError(t, err)
+	t.Cleanup(bundler.Close)
+
+	orm := &memORM{}
+	relay := NewRelay(logger.TestLogger(t), orm, bundler, &fakeClient{nonce: big.NewInt(5)}, signer, chainID, cfg, assets.GWei(100))
+	to := testutils.NewAddress()
+	req := txmgr.TxRequest{FromAddress: owner, ToAddress: to, EncodedPayload: []byte{1, 2, 3}, Value: *big.NewInt(7), FeeLimit: 500000}
+
+	op1, err := relay.Send(testutils.Context(t), req)
+	require.NoError(t, err)
+	assert.Equal(t, StatePending, op1.State)
+	assert.Equal(t, int64(5), op1.Nonce.Int64())
+	assert.Equal(t, owner, op1.Owner)
+	assert.Equal(t, cfg.account, op1.Sender)
+
+	require.Len(t, service.sent, 1)
+	sent := service.sent[0]
+	assert.Equal(t, op1.Hash, sent.Hash(cfg.entryPoint, chainID))
+	assert.Equal(t, append(cfg.paymaster.Bytes(), 0xaa), sent.PaymasterAndData)
+	assert.Equal(t, big.NewInt(500000), sent.CallGasLimit)
+	assert.Equal(t, big.NewInt(100000), sent.VerificationGasLimit)
+	assert.Equal(t, assets.GWei(11).ToInt(), sent.MaxFeePerGas)
+	assert.Equal(t, assets.GWei(1).ToInt(), sent.MaxPriorityFeePerGas)
+
+	// the account executes the request
+	args, err := accountABI.Methods["execute"].Inputs.Unpack(sent.CallData[4:])
+	require.NoError(t, err)
+	assert.Equal(t, to, args[0])
+	assert.Equal(t, big.NewInt(7), args[1])
+	assert.Equal(t, []byte{1, 2, 3}, args[2])
+
+	// the owner signs the EIP-191 message of the hash
+	sig := append([]byte{}, sent.Signature...)
+	require.Len(t, sig, 65)
+	require.True(t, sig[64] == 27 || sig[64] == 28)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash(op1.Hash.Bytes()), sig)
+	require.NoError(t, err)
+	assert.Equal(t, owner, crypto.PubkeyToAddress(*pub))
+
+	// nonces follow the pending operations
+	req.IdempotencyKey = ptr("test-1")
+	op2, err := relay.Send(testutils.Context(t), req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), op2.Nonce.Int64())
+	again, err := relay.Send(testutils.Context(t), req)
+	require.NoError(t, err)
+	assert.Equal(t, op2.ID, again.ID)
+	assert.Len(t, service.sent, 2)
+
+	t.Run("tracks status", func(t *testing.T) {
+		txHash := evmutils.NewHash()
+		service.mu.Lock()
+		service.receipts[op1.Hash] = &Receipt{UserOpHash: op1.Hash, Success: true}
+		service.receipts[op1.Hash].Receipt.TransactionHash = txHash
+		service.receipts[op2.Hash] = &Receipt{UserOpHash: op2.Hash, Reason: "AA23 reverted"}
+		service.mu.Unlock()
+
+		require.NoError(t, relay.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, relay.Close()) })
+
+		require.Eventually(t, func() bool {
+			return orm.operation(op1.ID).State == StateIncluded && orm.operation(op2.ID).State == StateFailed
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, &txHash, orm.operation(op1.ID).TxHash)
+		assert.Equal(t, "AA23 reverted", orm.operation(op2.ID).Error.String)
+	})
+}
+
+func ptr[T any](v T) *T { return &v }
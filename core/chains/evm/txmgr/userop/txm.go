@@ -0,0 +1,62 @@
+package userop
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+var _ txmgr.TxManager = (*TxManager)(nil)
+
+// TxManager is a transaction manager which submits the transactions created with CreateTransaction as user
+// operations, through the relay. Other transactions, like native token transfers, are still sent by the wrapped
+// transaction manager.
+//
+// Since user operations are not stored as transactions, the transactions returned by CreateTransaction have no id,
+// and their status is tracked with the user operations instead.
+type TxManager struct {
+	txmgr.TxManager
+	relay *Relay
+}
+
+// NewTxManager returns a transaction manager wrapping txm, which submits user operations through relay.
+func NewTxManager(txm txmgr.TxManager, relay *Relay) *TxManager {
+	return &TxManager{TxManager: txm, relay: relay}
+}
+
+func (t *TxManager) Start(ctx context.Context) error {
+	var ms services.MultiStart
+	return ms.Start(ctx, t.TxManager, t.relay)
+}
+
+func (t *TxManager) Close() error {
+	return services.CloseAll(t.relay, t.TxManager)
+}
+
+func (t *TxManager) HealthReport() map[string]error {
+	report := t.TxManager.HealthReport()
+	services.CopyHealth(report, t.relay.HealthReport())
+	return report
+}
+
+// CreateTransaction submits req as a user operation, returning a transaction which stands in for it.
+func (t *TxManager) CreateTransaction(ctx context.Context, req txmgr.TxRequest) (txmgr.Tx, error) {
+	op, err := t.relay.Send(ctx, req)
+	if err != nil {
+		return txmgr.Tx{}, err
+	}
+	return txmgr.Tx{
+		IdempotencyKey: op.IdempotencyKey,
+		FromAddress:    op.Owner,
+		ToAddress:      op.ToAddress,
+		EncodedPayload: op.CallData,
+		Value:          *op.Value.ToInt(),
+		FeeLimit:       req.FeeLimit,
+		CreatedAt:      op.CreatedAt,
+		State:          txmgrcommon.TxUnconfirmed,
+		ChainID:        op.EVMChainID.ToInt(),
+	}, nil
+}
@@ -0,0 +1,166 @@
+// Package zksync seals the attempts of the EVM transaction manager as the EIP-712 transactions of zkSync Era, which
+// carry the zkSync specific fields like the gas per pubdata limit and the paymaster paying the fees. It registers the
+// envelope of the paymaster transactions of zkSync chains.
+package zksync
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/common/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+const (
+	// TxType is the EIP-2718 type of the EIP-712 transactions.
+	TxType = 0x71
+	// DefaultGasPerPubdata is the default limit of the gas paid per byte of pubdata published to L1.
+	DefaultGasPerPubdata = 50000
+)
+
+// PaymasterParams are the paymaster paying the fees of a transaction, and the input of its validation.
+type PaymasterParams struct {
+	Paymaster common.Address
+	Input     []byte
+}
+
+var _ txmgr.TxEnvelope = (*Envelope)(nil)
+
+func init() {
+	txmgr.RegisterPaymasterEnvelope(config.ChainZkSync, func(paymaster common.Address, input []byte) txmgr.TxEnvelope {
+		params := &PaymasterParams{Paymaster: paymaster, Input: input}
+		return &Envelope{Paymaster: func(txmgr.Tx) *PaymasterParams { return params }}
+	})
+}
+
+// Envelope seals attempts as EIP-712 transactions.
+type Envelope struct {
+	// GasPerPubdata is the limit of the gas paid per byte of pubdata, or DefaultGasPerPubdata if zero.
+	GasPerPubdata uint64
+	// Paymaster returns the paymaster of etx, or nil if the sender pays the fees. If Paymaster is nil, the senders pay
+	// the fees of all transactions.
+	Paymaster func(etx txmgr.Tx) *PaymasterParams
+}
+
+var eip712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	},
+	"Transaction": {
+		{Name: "txType", Type: "uint256"},
+		{Name: "from", Type: "uint256"},
+		{Name: "to", Type: "uint256"},
+		{Name: "gasLimit", Type: "uint256"},
+		{Name: "gasPerPubdataByteLimit", Type: "uint256"},
+		{Name: "maxFeePerGas", Type: "uint256"},
+		{Name: "maxPriorityFeePerGas", Type: "uint256"},
+		{Name: "paymaster", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "value", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+		{Name: "factoryDeps", Type: "bytes32[]"},
+		{Name: "paymasterInput", Type: "bytes"},
+	},
+}
+
+// Seal implements txmgr.TxEnvelope. Legacy attempts are sealed with their gas price as both fee caps.
+func (e *Envelope) Seal(etx txmgr.Tx, tx *types.Transaction, chainID *big.Int, sign txmgr.DigestSignFunc) (common.Hash, []byte, error) {
+	if tx.To() == nil {
+		return common.Hash{}, nil, errors.New("contract creations cannot be sealed")
+	}
+	gasPerPubdata := e.GasPerPubdata
+	if gasPerPubdata == 0 {
+		gasPerPubdata = DefaultGasPerPubdata
+	}
+	var paymaster PaymasterParams
+	if e.Paymaster != nil {
+		if p := e.Paymaster(etx); p != nil {
+			paymaster = *p
+		}
+	}
+
+	digest, err := Digest(etx.FromAddress, tx, chainID, gasPerPubdata, paymaster)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	sig, err := sign(digest)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return common.Hash{}, nil, errors.Errorf("invalid signature length %d", len(sig))
+	}
+
+	var paymasterField []interface{}
+	if paymaster.Paymaster != (common.Address{}) {
+		paymasterField = []interface{}{paymaster.Paymaster, paymaster.Input}
+	}
+	fields := []interface{}{
+		tx.Nonce(),
+		tx.GasTipCap(),
+		tx.GasFeeCap(),
+		tx.Gas(),
+		*tx.To(),
+		tx.Value(),
+		tx.Data(),
+		uint64(sig[64]),
+		new(big.Int).SetBytes(sig[:32]),
+		new(big.Int).SetBytes(sig[32:64]),
+		chainID,
+		etx.FromAddress,
+		gasPerPubdata,
+		[][]byte{}, // factory deps
+		[]byte{},   // custom signature
+		paymasterField,
+	}
+	encoded, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return common.Hash{}, nil, errors.Wrap(err, "failed to encode transaction")
+	}
+
+	// the hash of an EIP-712 transaction commits to its digest and its signature
+	hash := crypto.Keccak256Hash(digest[:], crypto.Keccak256(sig))
+	return hash, append([]byte{TxType}, encoded...), nil
+}
+
+// Digest returns the EIP-712 hash of the transaction tx from the address from, which is signed by its sender.
+func Digest(from common.Address, tx *types.Transaction, chainID *big.Int, gasPerPubdata uint64, paymaster PaymasterParams) (common.Hash, error) {
+	typedData := apitypes.TypedData{
+		Types:       eip712Types,
+		PrimaryType: "Transaction",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "zkSync",
+			Version: "2",
+			ChainId: (*math.HexOrDecimal256)(chainID),
+		},
+		Message: apitypes.TypedDataMessage{
+			"txType":                 big.NewInt(TxType),
+			"from":                   new(big.Int).SetBytes(from.Bytes()),
+			"to":                     new(big.Int).SetBytes(tx.To().Bytes()),
+			"gasLimit":               new(big.Int).SetUint64(tx.Gas()),
+			"gasPerPubdataByteLimit": new(big.Int).SetUint64(gasPerPubdata),
+			"maxFeePerGas":           tx.GasFeeCap(),
+			"maxPriorityFeePerGas":   tx.GasTipCap(),
+			"paymaster":              new(big.Int).SetBytes(paymaster.Paymaster.Bytes()),
+			"nonce":                  new(big.Int).SetUint64(tx.Nonce()),
+			"value":                  tx.Value(),
+			"data":                   tx.Data(),
+			"factoryDeps":            []interface{}{},
+			"paymasterInput":         paymaster.Input,
+		},
+	}
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to hash transaction")
+	}
+	return common.BytesToHash(digest), nil
+}
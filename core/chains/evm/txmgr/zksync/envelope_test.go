@@ -0,0 +1,114 @@
+package zksync_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/common/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/zksync"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestEnvelope_Seal(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := testutils.NewAddress()
+	paymaster := testutils.NewAddress()
+	chainID := big.NewInt(324)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     7,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(250000000),
+		Gas:       500000,
+		To:        &to,
+		Value:     big.NewInt(0),
+		Data:      []byte{1, 2, 3},
+	})
+	sign := func(digest common.Hash) ([]byte, error) {
+		return crypto.Sign(digest[:], key)
+	}
+
+	for _, tt := range []struct {
+		name      string
+		paymaster *zksync.PaymasterParams
+	}{
+		{name: "sender pays"},
+		{name: "paymaster", paymaster: &zksync.PaymasterParams{Paymaster: paymaster, Input: []byte{4, 5}}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &zksync.Envelope{Paymaster: func(txmgr.Tx) *zksync.PaymasterParams { return tt.paymaster }}
+			hash, raw, err := e.Seal(txmgr.Tx{FromAddress: from}, tx, chainID, sign)
+			require.NoError(t, err)
+			require.Equal(t, byte(zksync.TxType), raw[0])
+
+			var fields []rlp.RawValue
+			require.NoError(t, rlp.DecodeBytes(raw[1:], &fields))
+			require.Len(t, fields, 16)
+			var gotFrom common.Address
+			require.NoError(t, rlp.DecodeBytes(fields[11], &gotFrom))
+			assert.Equal(t, from, gotFrom)
+			var gasPerPubdata uint64
+			require.NoError(t, rlp.DecodeBytes(fields[12], &gasPerPubdata))
+			assert.Equal(t, uint64(zksync.DefaultGasPerPubdata), gasPerPubdata)
+			var paymasterField []rlp.RawValue
+			require.NoError(t, rlp.DecodeBytes(fields[15], &paymasterField))
+
+			var params zksync.PaymasterParams
+			if tt.paymaster != nil {
+				params = *tt.paymaster
+				require.Len(t, paymasterField, 2)
+			} else {
+				require.Empty(t, paymasterField)
+			}
+			digest, err := zksync.Digest(from, tx, chainID, zksync.DefaultGasPerPubdata, params)
+			require.NoError(t, err)
+
+			// the signature in the fields is that of the digest by the sender
+			var v uint64
+			var r, s big.Int
+			require.NoError(t, rlp.DecodeBytes(fields[7], &v))
+			require.NoError(t, rlp.DecodeBytes(fields[8], &r))
+			require.NoError(t, rlp.DecodeBytes(fields[9], &s))
+			sig := make([]byte, 65)
+			r.FillBytes(sig[:32])
+			s.FillBytes(sig[32:64])
+			sig[64] = byte(v)
+			pub, err := crypto.SigToPub(digest[:], sig)
+			require.NoError(t, err)
+			assert.Equal(t, from, crypto.PubkeyToAddress(*pub))
+			assert.Equal(t, crypto.Keccak256Hash(digest[:], crypto.Keccak256(sig)), hash)
+		})
+	}
+
+	t.Run("paymaster changes the digest", func(t *testing.T) {
+		d1, err := zksync.Digest(from, tx, chainID, zksync.DefaultGasPerPubdata, zksync.PaymasterParams{})
+		require.NoError(t, err)
+		d2, err := zksync.Digest(from, tx, chainID, zksync.DefaultGasPerPubdata, zksync.PaymasterParams{Paymaster: paymaster})
+		require.NoError(t, err)
+		assert.NotEqual(t, d1, d2)
+	})
+}
+
+func TestPaymasterEnvelope(t *testing.T) {
+	t.Parallel()
+
+	newEnvelope := txmgr.PaymasterEnvelopeForChainType(config.ChainZkSync)
+	require.NotNil(t, newEnvelope)
+	paymaster := testutils.NewAddress()
+	e, ok := newEnvelope(paymaster, []byte{4, 5}).(*zksync.Envelope)
+	require.True(t, ok)
+	assert.Equal(t, &zksync.PaymasterParams{Paymaster: paymaster, Input: []byte{4, 5}}, e.Paymaster(txmgr.Tx{}))
+
+	assert.Nil(t, txmgr.PaymasterEnvelopeForChainType(config.ChainArbitrum))
+}
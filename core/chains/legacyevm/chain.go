@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"reflect"
+	"slices"
+	"sync"
 	"time"
 
 	gotoml "github.com/pelletier/go-toml/v2"
@@ -37,6 +40,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 //go:generate mockery --quiet --name Chain --output ./mocks/ --case=underscore
@@ -54,6 +58,9 @@ type Chain interface {
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() logpoller.LogPoller
 	GasEstimator() gas.EvmFeeEstimator
+	// ReloadConfig applies the reloadable subset of cfg to the running chain: its nodes and its gas estimator bounds.
+	// It returns the names of the services which were re-initialized.
+	ReloadConfig(ctx context.Context, cfg *toml.EVMConfig) (reinitialized []string, err error)
 }
 
 var (
@@ -123,6 +130,14 @@ type chain struct {
 	balanceMonitor  monitor.BalanceMonitor
 	keyStore        keystore.Eth
 	gasEstimator    gas.EvmFeeEstimator
+	// capabilityMonitor is nil unless the client is built from node config
+	capabilityMonitor *evmclient.CapabilityMonitor
+	// headLagMonitor is nil unless enabled
+	headLagMonitor *monitor.HeadLagMonitor
+
+	reloadMu sync.Mutex
+	// nextNodeID is the ID of the next node added by a reload
+	nextNodeID int32
 }
 
 type errChainDisabled struct {
@@ -167,6 +182,8 @@ type ChainOpts struct {
 	GasEstimator gas.EvmFeeEstimator
 
 	*sqlx.DB
+	// ReplicaDB optionally serves log poller reads from a read-only replica.
+	ReplicaDB *pg.ReplicaDB
 
 	// TODO BCF-2513 remove test code from the API
 	// Gen-functions are useful for dependency injection by tests
@@ -215,12 +232,25 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	chainID, chainType := cfg.EVM().ChainID(), cfg.EVM().ChainType()
 	l := opts.Logger
 	var client evmclient.Client
+	var capabilityMonitor *evmclient.CapabilityMonitor
+	var rpcs map[string]evmclient.RPCCLient
 	if !cfg.EVMRPCEnabled() {
 		client = evmclient.NewNullClient(chainID, l)
-	} else if opts.GenEthClient == nil {
-		client = newEthClientFromCfg(cfg.EVM().NodePool(), cfg.EVM().NodeNoNewHeadsThreshold(), l, chainID, chainType, nodes)
-	} else {
+	} else if opts.GenEthClient != nil {
 		client = opts.GenEthClient(chainID)
+	} else if cfg.Insecure().SimulatedEVMChains() {
+		// the enabled keys are funded at genesis, so that the node can send transactions right away
+		funded, err := opts.KeyStore.EnabledAddressesForChain(chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the keys of simulated chain with ID %s: %w", chainID.String(), err)
+		}
+		client, err = evmclient.NewSimulatedChain(l, chainID, funded)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		client, rpcs = newEthClientFromCfg(cfg.EVM().NodePool(), cfg.EVM().NodeNoNewHeadsThreshold(), l, chainID, chainType, nodes)
+		capabilityMonitor = newCapabilityMonitor(l, chainID, cfg.EVM(), evmclient.NewCapabilityORM(opts.DB, l, cfg.Database(), *chainID), rpcs)
 	}
 
 	db := opts.DB
@@ -232,7 +262,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	} else if opts.GenHeadTracker == nil {
 		orm := headtracker.NewORM(db, l, cfg.Database(), *chainID)
 		headSaver = headtracker.NewHeadSaver(l, orm, cfg.EVM(), cfg.EVM().HeadTracker())
-		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, opts.MailMon)
+		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headtracker.NewFinalityProvider(chainType, client, cfg.EVM()), headBroadcaster, headSaver, opts.MailMon)
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
@@ -243,7 +273,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 			logPoller = opts.GenLogPoller(chainID)
 		} else {
 			logPoller = logpoller.NewLogPoller(
-				logpoller.NewObservedORM(chainID, db, l, cfg.Database()),
+				logpoller.NewObservedORM(chainID, db, opts.ReplicaDB, l, cfg.Database()),
 				client,
 				l,
 				cfg.EVM().LogPollInterval(),
@@ -255,12 +285,33 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		}
 	}
 
+	type capabilityAwareLogPoller interface {
+		LimitBackfillBatchSize(int64)
+		DisableFinalityTag()
+	}
+	if lp, ok := logPoller.(capabilityAwareLogPoller); ok && capabilityMonitor != nil {
+		capabilityMonitor.OnProbed(func(_ string, caps evmclient.Capabilities) {
+			lp.LimitBackfillBatchSize(caps.MaxLogsBlockRange)
+			if !caps.FinalityTag {
+				lp.DisableFinalityTag()
+			}
+		})
+	}
+
 	// note: gas estimator is started as a part of the txm
-	txm, gasEstimator, err := newEvmTxm(db, cfg.EVM(), cfg.EVMRPCEnabled(), cfg.Database(), cfg.Database().Listener(), client, l, logPoller, opts)
+	txm, gasEstimator, err := newEvmTxm(db, cfg.EVM(), cfg.EVMRPCEnabled(), cfg.Database(), cfg.Database().Listener(), client, l, logPoller, capabilityMonitor, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate EvmTxm for chain with ID %s: %w", chainID.String(), err)
 	}
 
+	if ge, ok := gasEstimator.(interface{ DisableDynamicFees(reason string) }); ok && capabilityMonitor != nil {
+		capabilityMonitor.OnProbed(func(name string, caps evmclient.Capabilities) {
+			if !caps.DynamicFees {
+				ge.DisableDynamicFees(fmt.Sprintf("node %s serves blocks without a base fee", name))
+			}
+		})
+	}
+
 	headBroadcaster.Subscribe(txm)
 
 	// Highest seen head height is used as part of the start of LogBroadcaster backfill range
@@ -275,6 +326,12 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	var headLagMonitor *monitor.HeadLagMonitor
+	if cfg.EVMRPCEnabled() && cfg.EVM().HeadLagMonitor().Enabled() {
+		headLagMonitor = newHeadLagMonitor(l, chainID, cfg.EVM().HeadLagMonitor(), rpcs)
+		headBroadcaster.Subscribe(headLagMonitor)
+	}
+
 	var logBroadcaster log.Broadcaster
 	if !cfg.EVMRPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -293,18 +350,21 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	headBroadcaster.Subscribe(logBroadcaster)
 
 	return &chain{
-		id:              chainID,
-		cfg:             cfg,
-		client:          client,
-		txm:             txm,
-		logger:          l,
-		headBroadcaster: headBroadcaster,
-		headTracker:     headTracker,
-		logBroadcaster:  logBroadcaster,
-		logPoller:       logPoller,
-		balanceMonitor:  balanceMonitor,
-		keyStore:        opts.KeyStore,
-		gasEstimator:    gasEstimator,
+		id:                chainID,
+		cfg:               cfg,
+		client:            client,
+		txm:               txm,
+		logger:            l,
+		headBroadcaster:   headBroadcaster,
+		headTracker:       headTracker,
+		logBroadcaster:    logBroadcaster,
+		logPoller:         logPoller,
+		balanceMonitor:    balanceMonitor,
+		keyStore:          opts.KeyStore,
+		gasEstimator:      gasEstimator,
+		capabilityMonitor: capabilityMonitor,
+		headLagMonitor:    headLagMonitor,
+		nextNodeID:        int32(len(nodes)),
 	}, nil
 }
 
@@ -331,6 +391,16 @@ func (c *chain) Start(ctx context.Context) error {
 				return err
 			}
 		}
+		if c.capabilityMonitor != nil {
+			if err := ms.Start(ctx, c.capabilityMonitor); err != nil {
+				return err
+			}
+		}
+		if c.headLagMonitor != nil {
+			if err := ms.Start(ctx, c.headLagMonitor); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -344,6 +414,14 @@ func (c *chain) Close() error {
 			c.logger.Debug("Chain: stopping balance monitor")
 			merr = c.balanceMonitor.Close()
 		}
+		if c.capabilityMonitor != nil {
+			c.logger.Debug("Chain: stopping capability monitor")
+			merr = multierr.Combine(merr, c.capabilityMonitor.Close())
+		}
+		if c.headLagMonitor != nil {
+			c.logger.Debug("Chain: stopping head lag monitor")
+			merr = multierr.Combine(merr, c.headLagMonitor.Close())
+		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
@@ -387,6 +465,12 @@ func (c *chain) HealthReport() map[string]error {
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
+	if c.capabilityMonitor != nil {
+		services.CopyHealth(report, c.capabilityMonitor.HealthReport())
+	}
+	if c.headLagMonitor != nil {
+		services.CopyHealth(report, c.headLagMonitor.HealthReport())
+	}
 
 	return report
 }
@@ -456,6 +540,64 @@ func (c *chain) ListNodeStatuses(ctx context.Context, pageSize int32, pageToken
 	return common.ListNodeStatuses(int(pageSize), pageToken, c.listNodeStatuses)
 }
 
+func (c *chain) ReloadConfig(ctx context.Context, cfg *toml.EVMConfig) (reinitialized []string, err error) {
+	c.reloadMu.Lock()
+	defer c.reloadMu.Unlock()
+
+	removed, added := diffNodes(c.cfg.Nodes(), cfg.Nodes)
+	if len(removed) > 0 || len(added) > 0 {
+		if !c.cfg.EVMRPCEnabled() {
+			return nil, fmt.Errorf("cannot reload the nodes of chain %s: EVM RPC is disabled", c.id)
+		}
+		updater, ok := c.client.(interface {
+			UpdateNodes(context.Context, []string, []commonclient.Node[*big.Int, *evmtypes.Head, evmclient.RPCCLient], []commonclient.SendOnlyNode[*big.Int, evmclient.RPCCLient]) error
+		})
+		if !ok {
+			return nil, fmt.Errorf("cannot reload the nodes of chain %s: client does not support it", c.id)
+		}
+		var primaries []commonclient.Node[*big.Int, *evmtypes.Head, evmclient.RPCCLient]
+		var sendonlys []commonclient.SendOnlyNode[*big.Int, evmclient.RPCCLient]
+		for _, node := range added {
+			primary, sendonly, _ := newNodeFromCfg(c.cfg.EVM().NodePool(), c.cfg.EVM().NodeNoNewHeadsThreshold(), c.logger, c.id, node, c.nextNodeID)
+			c.nextNodeID++
+			if sendonly != nil {
+				sendonlys = append(sendonlys, sendonly)
+			} else {
+				primaries = append(primaries, primary)
+			}
+		}
+		if err = updater.UpdateNodes(ctx, removed, primaries, sendonlys); err != nil {
+			return nil, fmt.Errorf("failed to reload the nodes of chain %s: %w", c.id, err)
+		}
+		// The capability and head lag monitors keep the RPC clients they were started with.
+		reinitialized = append(reinitialized, fmt.Sprintf("EVM.%s.MultiNode", c.id))
+	}
+
+	c.cfg.Reload(cfg)
+	return reinitialized, nil
+}
+
+// diffNodes returns the names of the nodes which were removed or changed, and the nodes which were added or changed.
+func diffNodes(current, nodes []*toml.Node) (removed []string, added []*toml.Node) {
+	byName := make(map[string]*toml.Node, len(nodes))
+	for _, n := range nodes {
+		byName[*n.Name] = n
+	}
+	existing := make(map[string]bool, len(current))
+	for _, n := range current {
+		existing[*n.Name] = true
+		if m, ok := byName[*n.Name]; !ok || !reflect.DeepEqual(n, m) {
+			removed = append(removed, *n.Name)
+		}
+	}
+	for _, n := range nodes {
+		if !existing[*n.Name] || slices.Contains(removed, *n.Name) {
+			added = append(added, n)
+		}
+	}
+	return
+}
+
 func (c *chain) ID() *big.Int                             { return c.id }
 func (c *chain) Client() evmclient.Client                 { return c.client }
 func (c *chain) Config() evmconfig.ChainScopedConfig      { return c.cfg }
@@ -468,27 +610,69 @@ func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
 func (c *chain) GasEstimator() gas.EvmFeeEstimator        { return c.gasEstimator }
 
-func newEthClientFromCfg(cfg evmconfig.NodePool, noNewHeadsThreshold time.Duration, lggr logger.Logger, chainID *big.Int, chainType commonconfig.ChainType, nodes []*toml.Node) evmclient.Client {
-	var empty url.URL
+// capabilityProbeInterval is how often nodes which could not be reached are re-probed for their capabilities
+const capabilityProbeInterval = time.Minute
+
+func newCapabilityMonitor(lggr logger.Logger, chainID *big.Int, cfg evmclient.CapabilityConfig, orm evmclient.CapabilityORM, rpcs map[string]evmclient.RPCCLient) *evmclient.CapabilityMonitor {
+	nodes := make(map[string]evmclient.CapabilityCaller, len(rpcs))
+	for name, rpc := range rpcs {
+		nodes[name] = rpc
+	}
+	return evmclient.NewCapabilityMonitor(lggr, chainID, cfg, orm, nodes, capabilityProbeInterval)
+}
+
+// newHeadLagMonitor returns a monitor which uses every configured primary node as reference, in addition to the
+// configured reference URLs.
+func newHeadLagMonitor(lggr logger.Logger, chainID *big.Int, cfg evmconfig.HeadLagMonitor, rpcs map[string]evmclient.RPCCLient) *monitor.HeadLagMonitor {
+	references := make(map[string]monitor.HeadLagReference, len(rpcs))
+	for name, rpc := range rpcs {
+		references[name] = rpc
+	}
+	return monitor.NewHeadLagMonitor(lggr, chainID, cfg, references)
+}
+
+func newEthClientFromCfg(cfg evmconfig.NodePool, noNewHeadsThreshold time.Duration, lggr logger.Logger, chainID *big.Int, chainType commonconfig.ChainType, nodes []*toml.Node) (evmclient.Client, map[string]evmclient.RPCCLient) {
+	rpcs := make(map[string]evmclient.RPCCLient)
 	var primaries []commonclient.Node[*big.Int, *evmtypes.Head, evmclient.RPCCLient]
 	var sendonlys []commonclient.SendOnlyNode[*big.Int, evmclient.RPCCLient]
 	for i, node := range nodes {
-		if node.SendOnly != nil && *node.SendOnly {
-			name := fmt.Sprintf("eth-sendonly-rpc-%d", i)
-			rpc := evmclient.NewRPCClient(lggr, empty, (*url.URL)(node.HTTPURL), name, int32(i), chainID,
-				commonclient.Secondary)
-			sendonly := commonclient.NewSendOnlyNode[*big.Int, evmclient.RPCCLient](lggr, (url.URL)(*node.HTTPURL),
-				*node.Name, chainID, rpc)
+		primary, sendonly, rpc := newNodeFromCfg(cfg, noNewHeadsThreshold, lggr, chainID, node, int32(i))
+		if sendonly != nil {
 			sendonlys = append(sendonlys, sendonly)
 		} else {
-			name := fmt.Sprintf("eth-primary-rpc-%d", i)
-			rpc := evmclient.NewRPCClient(lggr, (url.URL)(*node.WSURL), (*url.URL)(node.HTTPURL), name, int32(i),
-				chainID, commonclient.Primary)
-			primaryNode := commonclient.NewNode[*big.Int, *evmtypes.Head, evmclient.RPCCLient](cfg, noNewHeadsThreshold,
-				lggr, (url.URL)(*node.WSURL), (*url.URL)(node.HTTPURL), *node.Name, int32(i), chainID, *node.Order,
-				rpc, "EVM")
-			primaries = append(primaries, primaryNode)
+			rpcs[*node.Name] = rpc
+			primaries = append(primaries, primary)
 		}
 	}
-	return evmclient.NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), noNewHeadsThreshold, primaries, sendonlys, chainID, chainType)
+	return evmclient.NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), noNewHeadsThreshold, cfg.PruningHorizon(), primaries, sendonlys, chainID, chainType), rpcs
+}
+
+// newNodeFromCfg returns either a primary node and its RPC client, or a send-only node.
+func newNodeFromCfg(cfg evmconfig.NodePool, noNewHeadsThreshold time.Duration, lggr logger.Logger, chainID *big.Int, node *toml.Node, id int32) (commonclient.Node[*big.Int, *evmtypes.Head, evmclient.RPCCLient], commonclient.SendOnlyNode[*big.Int, evmclient.RPCCLient], evmclient.RPCCLient) {
+	var empty url.URL
+	if node.SendOnly != nil && *node.SendOnly {
+		name := fmt.Sprintf("eth-sendonly-rpc-%d", id)
+		rpc := evmclient.NewRPCClient(lggr, empty, (*url.URL)(node.HTTPURL), name, id, chainID,
+			commonclient.Secondary)
+		sendonly := commonclient.NewSendOnlyNode[*big.Int, evmclient.RPCCLient](lggr, (url.URL)(*node.HTTPURL),
+			*node.Name, chainID, rpc)
+		return nil, sendonly, nil
+	}
+	name := fmt.Sprintf("eth-primary-rpc-%d", id)
+	// nodes without a WSURL are HTTP-only
+	wsURL := empty
+	if node.WSURL != nil {
+		wsURL = (url.URL)(*node.WSURL)
+	}
+	rpc := evmclient.NewRPCClient(lggr, wsURL, (*url.URL)(node.HTTPURL), name, id,
+		chainID, commonclient.Primary)
+	primaryNode := commonclient.NewNode[*big.Int, *evmtypes.Head, evmclient.RPCCLient](cfg, noNewHeadsThreshold,
+		lggr, wsURL, (*url.URL)(node.HTTPURL), *node.Name, id, chainID, *node.Order,
+		rpc, "EVM")
+	if node.Archive != nil && *node.Archive {
+		primaryNode = commonclient.NewArchiveNode(primaryNode)
+	} else if node.ReadOnly != nil && *node.ReadOnly {
+		primaryNode = commonclient.NewReadOnlyNode(primaryNode)
+	}
+	return primaryNode, nil, rpc
 }
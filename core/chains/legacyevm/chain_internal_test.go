@@ -0,0 +1,32 @@
+package legacyevm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+func Test_diffNodes(t *testing.T) {
+	t.Parallel()
+
+	node := func(name, url string) *toml.Node {
+		return &toml.Node{Name: &name, HTTPURL: commonconfig.MustParseURL(url)}
+	}
+	current := []*toml.Node{node("a", "http://a"), node("b", "http://b"), node("c", "http://c")}
+
+	removed, added := diffNodes(current, []*toml.Node{node("a", "http://a"), node("b", "http://b")})
+	assert.Equal(t, []string{"c"}, removed)
+	assert.Empty(t, added)
+
+	removed, added = diffNodes(current, []*toml.Node{node("a", "http://a"), node("b", "http://b2"), node("c", "http://c"), node("d", "http://d")})
+	assert.Equal(t, []string{"b"}, removed)
+	assert.Equal(t, []*toml.Node{node("b", "http://b2"), node("d", "http://d")}, added)
+
+	removed, added = diffNodes(current, []*toml.Node{node("c", "http://c"), node("b", "http://b"), node("a", "http://a")})
+	assert.Empty(t, removed)
+	assert.Empty(t, added)
+}
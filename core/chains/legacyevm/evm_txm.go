@@ -1,7 +1,9 @@
 package legacyevm
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
@@ -10,6 +12,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/userop"
+	// registers the paymaster transactions of zkSync
+	_ "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/zksync"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -22,6 +27,7 @@ func newEvmTxm(
 	client evmclient.Client,
 	lggr logger.Logger,
 	logPoller logpoller.LogPoller,
+	capabilities evmclient.NodeCapabilities,
 	opts ChainRelayExtenderConfig,
 ) (txm txmgr.TxManager,
 	estimator gas.EvmFeeEstimator,
@@ -49,21 +55,49 @@ func newEvmTxm(
 		estimator = opts.GenGasEstimator(chainID)
 	}
 
+	txConfig := cfg.Transactions()
+	if opts.AppConfig.Database().Maintenance().Enabled() {
+		txConfig = maintainedTransactions{txConfig}
+	}
+
 	if opts.GenTxManager == nil {
 		txm, err = txmgr.NewTxm(
 			db,
 			cfg,
 			txmgr.NewEvmTxmFeeConfig(cfg.GasEstimator()),
-			cfg.Transactions(),
+			txConfig,
 			databaseConfig,
 			listenerConfig,
 			client,
 			lggr,
 			logPoller,
 			opts.KeyStore,
-			estimator)
+			estimator,
+			capabilities)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
+	if err != nil {
+		return
+	}
+
+	if aa := txConfig.AccountAbstraction(); aa.Enabled() && aa.Mode() == evmconfig.AccountAbstractionModeUserOp {
+		var bundler *userop.Bundler
+		bundler, err = userop.DialBundler(context.Background(), aa.BundlerURL().String())
+		if err != nil {
+			return nil, nil, err
+		}
+		lggr.Infow("Submitting transactions as user operations", "account", aa.Account(), "entryPoint", aa.EntryPoint())
+		orm := userop.NewORM(db, lggr, databaseConfig)
+		relay := userop.NewRelay(lggr, orm, bundler, client, opts.KeyStore, chainID, aa, cfg.GasEstimator().PriceMax())
+		txm = userop.NewTxManager(txm, relay)
+	}
 	return
 }
+
+// maintainedTransactions disables the reaper, since old transactions are pruned by the database maintenance service.
+type maintainedTransactions struct {
+	evmconfig.Transactions
+}
+
+func (maintainedTransactions) ReaperInterval() time.Duration { return 0 }
@@ -28,6 +28,8 @@ import (
 
 	monitor "github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
 
+	toml "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 
 	types "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -384,6 +386,36 @@ func (_m *Chain) Ready() error {
 	return r0
 }
 
+// ReloadConfig provides a mock function with given fields: ctx, cfg
+func (_m *Chain) ReloadConfig(ctx context.Context, cfg *toml.EVMConfig) ([]string, error) {
+	ret := _m.Called(ctx, cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *toml.EVMConfig) ([]string, error)); ok {
+		return rf(ctx, cfg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *toml.EVMConfig) []string); ok {
+		r0 = rf(ctx, cfg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *toml.EVMConfig) error); ok {
+		r1 = rf(ctx, cfg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Chain) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
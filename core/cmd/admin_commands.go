@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +32,22 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 			Usage:  "Change your API password remotely",
 			Action: s.ChangePassword,
 		},
+		{
+			Name:   "drain",
+			Usage:  "Stop the node from accepting new work before it is terminated, and report when the work in flight is done",
+			Action: s.Drain,
+			Flags: []cli.Flag{
+				cli.DurationFlag{
+					Name:  "timeout",
+					Usage: "how long to wait for the work in flight, after which the node is ready to terminate regardless",
+					Value: time.Minute,
+				},
+				cli.BoolFlag{
+					Name:  "wait",
+					Usage: "wait until the node is ready to terminate",
+				},
+			},
+		},
 		{
 			Name:   "login",
 			Usage:  "Login to remote client by creating a session cookie",
@@ -43,6 +61,14 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 					Name:  "bypass-version-check",
 					Usage: "Bypass versioning check for compatibility of remote node",
 				},
+				cli.StringFlag{
+					Name:  "totp",
+					Usage: "current code of the authenticator app, if the API user enrolled TOTP",
+				},
+				cli.StringFlag{
+					Name:  "recovery-code",
+					Usage: "one-time recovery code, used instead of a TOTP code",
+				},
 			},
 		},
 		{
@@ -50,6 +76,7 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 			Usage:  "Delete any local sessions",
 			Action: s.Logout,
 		},
+		initMFASubCmd(s),
 		{
 			Name:   "profile",
 			Usage:  "Collects profile metrics from the node.",
@@ -97,6 +124,10 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 							Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view'.",
 							Required: true,
 						},
+						cli.StringFlag{
+							Name:  "tenant",
+							Usage: "Tenant of new user, who then only has access to the jobs, bridges and keys of the tenant through the GraphQL API. Node-wide if empty.",
+						},
 					},
 				},
 				{
@@ -138,12 +169,13 @@ type AdminUsersPresenter struct {
 	presenters.UserResource
 }
 
-var adminUsersTableHeaders = []string{"Email", "Role", "Has API token", "Created at", "Updated at"}
+var adminUsersTableHeaders = []string{"Email", "Role", "Tenant", "Has API token", "Created at", "Updated at"}
 
 func (p *AdminUsersPresenter) ToRow() []string {
 	row := []string{
 		p.ID,
 		string(p.Role),
+		p.Tenant.String,
 		p.HasActiveApiToken,
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
@@ -222,10 +254,12 @@ func (s *Shell) CreateUser(c *cli.Context) (err error) {
 		Email    string `json:"email"`
 		Role     string `json:"role"`
 		Password string `json:"password"`
+		Tenant   string `json:"tenant,omitempty"`
 	}{
 		Email:    c.String("email"),
 		Role:     c.String("role"),
 		Password: pwd,
+		Tenant:   c.String("tenant"),
 	}
 
 	requestData, err := json.Marshal(request)
@@ -311,6 +345,75 @@ func (s *Shell) Status(c *cli.Context) error {
 	return s.renderAPIResponse(resp, &HealthCheckPresenters{})
 }
 
+type DrainStatusPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.DrainStatusResource
+}
+
+// RenderTable implements TableRenderer
+func (p *DrainStatusPresenter) RenderTable(rt RendererTable) error {
+	optional := func(t *time.Time) string {
+		if t == nil {
+			return "n/a"
+		}
+		return t.Format(time.RFC3339)
+	}
+	headers := []string{"Draining", "Started At", "Deadline", "In Flight Runs", "Pending Txs", "Ready To Terminate", "Timed Out"}
+	rows := [][]string{{
+		strconv.FormatBool(p.Draining),
+		optional(p.StartedAt),
+		optional(p.Deadline),
+		strconv.Itoa(p.InFlightRuns),
+		strconv.Itoa(p.PendingTxs),
+		strconv.FormatBool(p.ReadyToTerminate),
+		strconv.FormatBool(p.TimedOut),
+	}}
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// drainPollInterval is how often the drain status is polled while waiting.
+var drainPollInterval = time.Second
+
+// Drain starts draining the node, and waits until it is ready to terminate if requested.
+func (s *Shell) Drain(c *cli.Context) (err error) {
+	ctx := s.ctx()
+	resp, err := s.HTTP.Post(ctx, "/v2/drain?timeout="+url.QueryEscape(c.Duration("timeout").String()), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var presenter DrainStatusPresenter
+	for c.Bool("wait") {
+		err = s.deserializeAPIResponse(resp, &presenter, &jsonapi.Links{})
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+		if err != nil {
+			return s.errorOut(err)
+		}
+		if presenter.ReadyToTerminate {
+			return s.errorOut(s.Render(&presenter, "Drain status"))
+		}
+		s.Logger.Infow("Waiting for the work in flight", "inFlightRuns", presenter.InFlightRuns, "pendingTxs", presenter.PendingTxs)
+		select {
+		case <-ctx.Done():
+			return s.errorOut(ctx.Err())
+		case <-time.After(drainPollInterval):
+		}
+		if resp, err = s.HTTP.Get(ctx, "/v2/drain"); err != nil {
+			return s.errorOut(err)
+		}
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &presenter, "Drain status")
+}
+
 // Profile will collect pprof metrics and store them in a folder.
 func (s *Shell) Profile(c *cli.Context) error {
 	ctx := s.ctx()
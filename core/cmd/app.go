@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/sinks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -140,6 +141,22 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for remotely taking admin related actions",
 			Subcommands: initAdminSubCmds(s),
 		},
+		{
+			Name:   "apply",
+			Usage:  "Reconcile bridges, jobs, chains, RPC nodes and API users against a desired-state document",
+			Action: s.Apply,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "file, f",
+					Usage:    "`path` of the YAML or JSON document",
+					Required: true,
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "only print the changes which would be made",
+				},
+			},
+		},
 		{
 			Name:        "attempts",
 			Aliases:     []string{"txas"},
@@ -189,6 +206,7 @@ func NewApp(s *Shell) *cli.App {
 				initCSAKeysSubCmd(s),
 				initOCRKeysSubCmd(s),
 				initOCR2KeysSubCmd(s),
+				initMasterKeySubCmd(s),
 
 				keysCommand("Cosmos", NewCosmosKeysClient(s)),
 				keysCommand("Solana", NewSolanaKeysClient(s)),
@@ -252,6 +270,11 @@ func NewApp(s *Shell) *cli.App {
 					return err
 				}
 
+				sinkCfgs, err := sinks.NewConfigs(s.Config.Log().Sinks())
+				if err != nil {
+					return err
+				}
+
 				lggrCfg := logger.Config{
 					LogLevel:       s.Config.Log().Level(),
 					Dir:            s.Config.Log().File().Dir(),
@@ -260,6 +283,7 @@ func NewApp(s *Shell) *cli.App {
 					FileMaxSizeMB:  int(logFileMaxSizeMB),
 					FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
 					FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
+					Sinks:          sinkCfgs,
 				}
 				l, closeFn := lggrCfg.New()
 
@@ -304,11 +328,36 @@ func NewApp(s *Shell) *cli.App {
 				initStarkNetNodeSubCmd(s),
 			},
 		},
+		{
+			Name:        "p2p",
+			Usage:       "Commands for diagnosing the P2P network.",
+			Subcommands: initP2PSubCmds(s),
+		},
 		{
 			Name:        "forwarders",
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "vrf",
+			Usage:       "Commands for managing VRF requests.",
+			Subcommands: initVRFSubCmds(s),
+		},
+		{
+			Name:        "upkeeps",
+			Usage:       "Commands for diagnosing Automation upkeeps.",
+			Subcommands: initUpkeepsSubCmds(s),
+		},
+		{
+			Name:        "functions",
+			Usage:       "Commands for managing Functions secrets.",
+			Subcommands: initFunctionsSubCmds(s),
+		},
+		{
+			Name:        "support",
+			Usage:       "Commands for gathering diagnostics of the node for support tickets.",
+			Subcommands: initSupportSubCmds(s),
+		},
 	}...)
 	return app
 }
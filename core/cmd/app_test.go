@@ -2,12 +2,15 @@ package cmd
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
+	"github.com/smartcontractkit/chainlink/v2/core/config/secretsprovider"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
@@ -55,6 +58,17 @@ func withDefaults(t *testing.T, c chainlink.Config, s chainlink.Secrets) chainli
 	return cfg
 }
 
+// ignoreReloadState ignores the state the config keeps to reload the files it was loaded from, which only depends on
+// how it was loaded. Secrets resolvers hold the clients of secret managers, so only their presence is compared.
+var ignoreReloadState = cmp.Options{
+	cmp.Exporter(func(reflect.Type) bool { return true }),
+	cmp.FilterPath(func(p cmp.Path) bool {
+		f, ok := p.Last().(cmp.StructField)
+		return ok && (f.Name() == "reloadOpts" || f.Name() == "secretsStrings")
+	}, cmp.Ignore()),
+	cmp.Comparer(func(a, b *secretsprovider.Resolver) bool { return (a == nil) == (b == nil) }),
+}
+
 func Test_initServerConfig(t *testing.T) {
 	type args struct {
 		opts         *chainlink.GeneralConfigOpts
@@ -239,7 +253,7 @@ func Test_initServerConfig(t *testing.T) {
 				t.Errorf("loadOpts() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if tt.wantCfg != nil {
-				assert.Equal(t, tt.wantCfg, cfg)
+				assert.Empty(t, cmp.Diff(tt.wantCfg, cfg, ignoreReloadState))
 			}
 		})
 	}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type ProvisioningResultPresenter struct {
+	JAID
+	presenters.ProvisioningResultResource
+}
+
+// FriendlyChanges lists the changes of the fields, on one line each. Multi-line values like job definitions are not
+// repeated in tables.
+func (p *ProvisioningResultPresenter) FriendlyChanges() string {
+	var lines []string
+	for _, c := range p.Changes {
+		if strings.Contains(c.From, "\n") || strings.Contains(c.To, "\n") {
+			lines = append(lines, c.Field)
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s -> %s", c.Field, c.From, c.To))
+	}
+	return strings.Join(lines, "\n")
+}
+
+type ProvisioningResultPresenters []ProvisioningResultPresenter
+
+// RenderTable implements TableRenderer
+func (ps ProvisioningResultPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Kind", "Name", "Action", "Changes", "Incoming Token", "Error"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.Kind,
+			p.Name,
+			p.Action,
+			p.FriendlyChanges(),
+			p.IncomingToken,
+			p.Error,
+		})
+	}
+
+	render("Resources", table)
+	return nil
+}
+
+// Apply reconciles the resources of the node against the desired-state document of the file
+func (s *Shell) Apply(c *cli.Context) (err error) {
+	doc, err := os.ReadFile(c.String("file"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	// JSON is valid YAML too
+	request, err := yaml.YAMLToJSON(doc)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid document"))
+	}
+
+	path := "/v2/apply"
+	if c.Bool("dry-run") {
+		path += "?dryRun=true"
+	}
+	resp, err := s.HTTP.Post(s.ctx(), path, bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var results ProvisioningResultPresenters
+	if err = s.renderAPIResponse(resp, &results); err != nil {
+		return err
+	}
+	var failed int
+	for _, r := range results {
+		if r.Action == presenters.ProvisioningError {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return s.errorOut(errors.Errorf("failed to apply %d of %d resources", failed, len(results)))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// ChainReaderReportPresenter presents the result of a ChainReader conformance check
+type ChainReaderReportPresenter struct {
+	evm.ConformanceReport
+}
+
+// ToRows returns one row per read
+func (p *ChainReaderReportPresenter) ToRows() [][]string {
+	var rows [][]string
+	for _, r := range p.Results {
+		values, err := json.Marshal(r.Values)
+		if err != nil {
+			values = []byte(fmt.Sprint(r.Values))
+		}
+		rows = append(rows, []string{
+			r.ContractName,
+			r.ReadName,
+			r.ReadType,
+			string(r.Status),
+			r.Latency.String(),
+			string(values),
+			r.Error,
+		})
+	}
+	return rows
+}
+
+// RenderTable implements TableRenderer
+func (p *ChainReaderReportPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Contract", "Read", "Type", "Status", "Latency", "Values", "Error"}
+	renderList(headers, p.ToRows(), rt.Writer)
+	return nil
+}
+
+// conformanceClient adds the raw RPC calls, which proxies are resolved with, to an ethclient.
+type conformanceClient struct {
+	*ethclient.Client
+}
+
+func (c conformanceClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.Client.Client().CallContext(ctx, result, method, args...)
+}
+
+// ChainReaderReport executes every read of a ChainReader config against a deployed contract and reports the results.
+func (s *Shell) ChainReaderReport(c *cli.Context) error {
+	ctx := s.ctx()
+
+	b, err := os.ReadFile(c.String("reader-config"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to read ChainReader config"))
+	}
+	var cfg evmtypes.ChainReaderConfig
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to parse ChainReader config"))
+	}
+
+	addressHex := c.String("address")
+	if !gethCommon.IsHexAddress(addressHex) {
+		return s.errorOut(errors.Errorf("invalid address: %s", addressHex))
+	}
+
+	chainID := c.String("evmChainID")
+	var rpcURL string
+	for _, chain := range s.Config.EVMConfigs() {
+		if chainID != "" && chain.ChainID.String() != chainID {
+			continue
+		}
+		for _, n := range chain.Nodes {
+			if n.HTTPURL != nil {
+				rpcURL = n.HTTPURL.String()
+				break
+			}
+		}
+		break
+	}
+	if rpcURL == "" {
+		return s.errorOut(errors.Errorf("no EVM node with HTTPURL configured for chain %q", chainID))
+	}
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to dial RPC node"))
+	}
+	defer client.Close()
+
+	report, err := evm.RunChainReaderConformance(ctx, conformanceClient{client}, cfg, gethCommon.HexToAddress(addressHex), c.Uint64("event-lookback"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	title := "ChainReader conformance report for " + report.Address.Hex()
+	if report.Implementation != nil {
+		title += " (proxy to " + report.Implementation.Hex() + ")"
+	}
+	if err = s.Render(&ChainReaderReportPresenter{report}, title); err != nil {
+		return s.errorOut(err)
+	}
+	if !report.Passed() {
+		return s.errorOut(errors.New("one or more reads failed"))
+	}
+	return nil
+}
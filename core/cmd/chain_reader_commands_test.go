@@ -0,0 +1,92 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+)
+
+func TestShell_ChainReaderReport(t *testing.T) {
+	t.Parallel()
+
+	// paused() returns true, everything else reverts, and the contract is not a proxy
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		resp := `"0x0000000000000000000000000000000000000000000000000000000000000001"`
+		switch req.Method {
+		case "eth_call":
+		case "eth_getStorageAt":
+			// not a proxy
+			resp = `"0x0000000000000000000000000000000000000000000000000000000000000000"`
+		default:
+			resp = `"0x1"`
+		}
+		_, err := fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, resp)
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(ts.Close)
+
+	chainID := newRandChainID()
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = evmcfg.EVMConfigs{{
+			ChainID: chainID,
+			Chain:   evmcfg.Defaults(chainID),
+			Nodes: evmcfg.EVMNodes{{
+				Name:    ptr("primary"),
+				HTTPURL: commonconfig.MustParseURL(ts.URL),
+			}},
+		}}
+	})
+
+	readerConfig := filepath.Join(t.TempDir(), "reader.json")
+	require.NoError(t, os.WriteFile(readerConfig, []byte(`{"chainContractReaders": {"Token": {
+		"contractABI": "[{\"type\":\"function\",\"name\":\"paused\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"paused\",\"type\":\"bool\"}]}]",
+		"chainReaderDefinitions": {"Paused": {"chainSpecificName": "paused", "returnValues": ["paused"], "readType": 0}}
+	}}}`), 0600))
+
+	r := &cltest.RendererMock{}
+	client := cmd.Shell{
+		Config:   cfg,
+		Renderer: r,
+		Logger:   logger.TestLogger(t),
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ChainReaderReport, set, "")
+	require.NoError(t, set.Set("reader-config", readerConfig))
+	require.NoError(t, set.Set("address", testutils.NewAddress().Hex()))
+	require.NoError(t, set.Set("evmChainID", chainID.String()))
+
+	require.NoError(t, client.ChainReaderReport(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	report := r.Renders[0].(*cmd.ChainReaderReportPresenter)
+	require.Len(t, report.Results, 1)
+	assert.Equal(t, evm.ConformancePass, report.Results[0].Status)
+	assert.Equal(t, true, report.Results[0].Values["paused"])
+	assertTableRenders(t, r)
+}
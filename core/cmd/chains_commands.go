@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/urfave/cli"
+	"go.uber.org/multierr"
 )
 
 var chainHeaders = []string{"ID", "Enabled", "Config"}
@@ -25,6 +28,18 @@ func chainCommand(typ string, client ChainClient, chainID cli.Flag) cli.Command
 				Usage:  fmt.Sprintf("List all existing %s chains", typ),
 				Action: client.IndexChains,
 			},
+			{
+				Name:   "enable",
+				Usage:  "Enable a chain disabled at runtime",
+				Action: client.EnableChain,
+				Flags:  []cli.Flag{chainID},
+			},
+			{
+				Name:   "disable",
+				Usage:  "Disable a chain and its jobs at runtime, until it is enabled again or the node restarts",
+				Action: client.DisableChain,
+				Flags:  []cli.Flag{chainID},
+			},
 		},
 	}
 }
@@ -32,24 +47,54 @@ func chainCommand(typ string, client ChainClient, chainID cli.Flag) cli.Command
 // ChainClient is a generic client interface for any type of chain.
 type ChainClient interface {
 	IndexChains(c *cli.Context) error
+	EnableChain(c *cli.Context) error
+	DisableChain(c *cli.Context) error
 }
 
-type chainClient[P TableRenderer] struct {
+type chainClient[P, P2 TableRenderer] struct {
 	*Shell
 	path string
 }
 
 // newChainClient returns a new ChainClient for a particular type of chains.Config.
-// P is a TableRenderer corresponding to R, and P2 is the slice variant (type P2 []P).
-func newChainClient[P TableRenderer](s *Shell, name string) ChainClient {
-	return &chainClient[P]{
+// P is a TableRenderer for a single chain, and P2 is the slice variant (type P2 []P).
+func newChainClient[P, P2 TableRenderer](s *Shell, name string) ChainClient {
+	return &chainClient[P, P2]{
 		Shell: s,
 		path:  "/v2/chains/" + name,
 	}
 }
 
 // IndexChains returns all chains.
-func (cli *chainClient[P]) IndexChains(c *cli.Context) (err error) {
-	var p P
+func (cli *chainClient[P, P2]) IndexChains(c *cli.Context) (err error) {
+	var p P2
 	return cli.getPage(cli.path, c.Int("page"), &p)
 }
+
+// EnableChain enables a chain disabled at runtime.
+func (cli *chainClient[P, P2]) EnableChain(c *cli.Context) (err error) {
+	return cli.setChainEnabled(c, "enable")
+}
+
+// DisableChain disables a chain at runtime.
+func (cli *chainClient[P, P2]) DisableChain(c *cli.Context) (err error) {
+	return cli.setChainEnabled(c, "disable")
+}
+
+func (cli *chainClient[P, P2]) setChainEnabled(c *cli.Context, action string) (err error) {
+	if !c.IsSet("id") {
+		return cli.errorOut(errors.New("must pass the id of the chain"))
+	}
+	resp, err := cli.HTTP.Post(cli.ctx(), fmt.Sprintf("%s/%s/%s", cli.path, c.String("id"), action), bytes.NewBufferString("{}"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var p P
+	return cli.renderAPIResponse(resp, &p)
+}
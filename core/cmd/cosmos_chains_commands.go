@@ -44,5 +44,5 @@ func (ps CosmosChainPresenters) RenderTable(rt RendererTable) error {
 }
 
 func CosmosChainClient(s *Shell) ChainClient {
-	return newChainClient[CosmosChainPresenters](s, "cosmos")
+	return newChainClient[CosmosChainPresenter, CosmosChainPresenters](s, "cosmos")
 }
@@ -44,5 +44,5 @@ func (ps EVMChainPresenters) RenderTable(rt RendererTable) error {
 }
 
 func EVMChainClient(s *Shell) ChainClient {
-	return newChainClient[EVMChainPresenters](s, "evm")
+	return newChainClient[EVMChainPresenter, EVMChainPresenters](s, "evm")
 }
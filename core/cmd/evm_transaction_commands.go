@@ -62,6 +62,22 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "dead-letters",
+				Usage:  "List the dead-lettered Ethereum Transactions, which were fatally errored and are kept until they are requeued",
+				Action: s.IndexDeadLetterTransactions,
+				Flags: []cli.Flag{
+					cli.IntFlag{
+						Name:  "page",
+						Usage: "page of results to display",
+					},
+				},
+			},
+			{
+				Name:   "requeue",
+				Usage:  "Requeue the dead-lettered Ethereum Transaction <id>, once the cause of its fatal error is fixed",
+				Action: s.RequeueDeadLetterTransaction,
+			},
 		},
 	}
 }
@@ -131,6 +147,69 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+type DeadLetterTxPresenters []presenters.DeadLetterEthTxResource
+
+// RenderTable implements TableRenderer
+func (ps DeadLetterTxPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "From", "To", "Classification", "Error", "Dead-lettered at"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.ID,
+			p.From.Hex(),
+			p.To.Hex(),
+			p.Classification,
+			p.Error,
+			p.DeadLetteredAt.String(),
+		})
+	}
+
+	render("Dead-lettered Ethereum Transactions", table)
+	return nil
+}
+
+type RequeuedTxPresenter struct {
+	JAID
+	presenters.EthTxResource
+}
+
+// RenderTable implements TableRenderer
+func (p *RequeuedTxPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "From", "To", "State"})
+	table.Append([]string{
+		p.ID,
+		p.From.Hex(),
+		p.To.Hex(),
+		p.State,
+	})
+
+	render("Requeued Ethereum Transaction", table)
+	return nil
+}
+
+// IndexDeadLetterTransactions returns the list of dead-lettered transactions, most recently dead-lettered first,
+// taking an optional page parameter
+func (s *Shell) IndexDeadLetterTransactions(c *cli.Context) error {
+	return s.getPage("/v2/transactions/evm/dead_letters", c.Int("page"), &DeadLetterTxPresenters{})
+}
+
+// RequeueDeadLetterTransaction moves the given dead-lettered transaction back to the queue
+func (s *Shell) RequeueDeadLetterTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the transaction"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/transactions/evm/dead_letters/%s/requeue", c.Args().First()), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RequeuedTxPresenter{})
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
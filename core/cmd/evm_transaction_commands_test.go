@@ -11,8 +11,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"gopkg.in/guregu/null.v4"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -90,6 +92,43 @@ func TestShell_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestShell_DeadLetterTransactions(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	txStore := cltest.NewTestTxStore(t, app.GetSqlxDB(), app.GetConfig().Database())
+	tx := cltest.NewEthTx(from)
+	tx.State = txmgrcommon.TxInProgress
+	nonce := evmtypes.Nonce(0)
+	tx.Sequence = &nonce
+	require.NoError(t, txStore.InsertTx(&tx))
+	tx.Error = null.StringFrom("nonce too low")
+	require.NoError(t, txStore.UpdateTxFatalError(testutils.Context(t), &tx))
+
+	set := flag.NewFlagSet("test dead letters", 0)
+	flagSetApplyFromAction(client.IndexDeadLetterTransactions, set, "")
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.IndexDeadLetterTransactions(c))
+
+	renderedLetters := *r.Renders[0].(*cmd.DeadLetterTxPresenters)
+	require.Len(t, renderedLetters, 1)
+	assert.Equal(t, fmt.Sprint(tx.ID), renderedLetters[0].ID)
+	assert.Equal(t, "nonce", renderedLetters[0].Classification)
+
+	set = flag.NewFlagSet("test requeue", 0)
+	flagSetApplyFromAction(client.RequeueDeadLetterTransaction, set, "")
+	require.NoError(t, set.Parse([]string{fmt.Sprint(tx.ID)}))
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.RequeueDeadLetterTransaction(c))
+
+	renderedTx := *r.Renders[1].(*cmd.RequeuedTxPresenter)
+	assert.Equal(t, "unstarted", renderedTx.State)
+}
+
 func TestShell_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"strconv"
+
 	"github.com/urfave/cli"
 
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -12,6 +14,12 @@ func initInitiatorsSubCmds(s *Shell) []cli.Command {
 			Name:   "create",
 			Usage:  "Create an authentication key for a user of External Initiators",
 			Action: s.CreateExternalInitiator,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "grpc",
+					Usage: "the external initiator connects to the node over gRPC instead of being notified at its url",
+				},
+			},
 		},
 		{
 			Name:   "destroy",
@@ -32,7 +40,7 @@ type ExternalInitiatorPresenter struct {
 }
 
 func (eip *ExternalInitiatorPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
+	table := rt.newTable([]string{"ID", "Name", "URL", "GRPC", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
 	table.Append(eip.ToRow())
 	render("External Initiator:", table)
 	return nil
@@ -47,6 +55,7 @@ func (eip *ExternalInitiatorPresenter) ToRow() []string {
 		eip.ID,
 		eip.Name,
 		urlS,
+		strconv.FormatBool(eip.GRPC),
 		eip.AccessKey,
 		eip.OutgoingToken,
 		eip.CreatedAt.String(),
@@ -57,7 +66,7 @@ func (eip *ExternalInitiatorPresenter) ToRow() []string {
 type ExternalInitiatorPresenters []ExternalInitiatorPresenter
 
 func (eips *ExternalInitiatorPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
+	table := rt.newTable([]string{"ID", "Name", "URL", "GRPC", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
 	for _, eip := range *eips {
 		table.Append(eip.ToRow())
 	}
@@ -34,6 +34,7 @@ func TestExternalInitiatorPresenter_RenderTable(t *testing.T) {
 			URL:           url,
 			AccessKey:     accessKey,
 			OutgoingToken: outgoingToken,
+			GRPC:          true,
 			CreatedAt:     createdAt,
 			UpdatedAt:     updatedAt,
 		},
@@ -47,6 +48,7 @@ func TestExternalInitiatorPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url.String())
 	assert.Contains(t, output, accessKey)
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "true")
 
 	// Render many resources
 	buffer.Reset()
@@ -58,4 +60,5 @@ func TestExternalInitiatorPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url.String())
 	assert.Contains(t, output, accessKey)
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "true")
 }
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initFunctionsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "secrets",
+			Usage: "Commands for managing DON-hosted secrets",
+			Subcommands: cli.Commands{
+				{
+					Name:   "rotate",
+					Usage:  "Re-encrypt and re-upload the DON-hosted secrets configured for rotation, regardless of their expiration",
+					Action: s.RotateFunctionsSecrets,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "job-id, jobID",
+							Usage: "ID of the Functions job, if left empty, all Functions jobs with secrets rotation are considered",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type FunctionsSecretsRotationPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.FunctionsSecretsRotationResource
+}
+
+var functionsSecretsRotationHeaders = []string{"Job ID", "Owner", "Slot ID", "Previous Version", "Version", "Expiration", "Error"}
+
+// ToRow presents the FunctionsSecretsRotationResource as a slice of strings.
+func (p *FunctionsSecretsRotationPresenter) ToRow() []string {
+	return []string{
+		strconv.Itoa(int(p.JobID)),
+		p.Owner.Hex(),
+		strconv.FormatUint(uint64(p.SlotID), 10),
+		strconv.FormatUint(p.PreviousVersion, 10),
+		strconv.FormatUint(p.Version, 10),
+		strconv.FormatInt(p.Expiration, 10),
+		p.Error,
+	}
+}
+
+// FunctionsSecretsRotationPresenters implements TableRenderer for a slice of FunctionsSecretsRotationPresenter.
+type FunctionsSecretsRotationPresenters []FunctionsSecretsRotationPresenter
+
+// RenderTable implements TableRenderer
+func (ps FunctionsSecretsRotationPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(functionsSecretsRotationHeaders, rows, rt.Writer)
+	return nil
+}
+
+// RotateFunctionsSecrets rotates the DON-hosted secrets of Functions jobs.
+func (s *Shell) RotateFunctionsSecrets(c *cli.Context) (err error) {
+	path := "/v2/functions/secrets/rotate"
+	if jobID := c.String("job-id"); jobID != "" {
+		path += "?" + url.Values{"jobID": {jobID}}.Encode()
+	}
+	resp, err := s.HTTP.Post(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenters FunctionsSecretsRotationPresenters
+	return s.renderAPIResponse(resp, &presenters, "Rotated Functions secrets")
+}
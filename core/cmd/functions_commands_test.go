@@ -0,0 +1,44 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestFunctionsSecretsRotationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		owner  = common.HexToAddress("0x2aF4dd6A0F2E6F0C0dD2a4734a0b5A5bC6b9aC41")
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.FunctionsSecretsRotationPresenter{
+		JAID: cmd.NewJAID("1"),
+		FunctionsSecretsRotationResource: presenters.FunctionsSecretsRotationResource{
+			JobID:           7,
+			Owner:           owner,
+			SlotID:          2,
+			PreviousVersion: 41,
+			Version:         42,
+			Expiration:      1700000000000,
+			Error:           "failed to upload secrets",
+		},
+	}
+
+	require.NoError(t, cmd.FunctionsSecretsRotationPresenters{p}.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, owner.Hex())
+	assert.Contains(t, output, "41")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "1700000000000")
+	assert.Contains(t, output, "failed to upload secrets")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/policy"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -39,16 +42,92 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Name:   "create",
 			Usage:  "Create a job",
 			Action: s.CreateJob,
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "var",
+					Usage: "value of a variable declared by a job spec template, as name=value (can be repeated)",
+				},
+			},
+		},
+		{
+			Name:   "lint",
+			Usage:  "Check a job spec against the policy rules of the node, without creating the job",
+			Action: s.LintJob,
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  "var",
+					Usage: "value of a variable declared by a job spec template, as name=value (can be repeated)",
+				},
+			},
 		},
 		{
 			Name:   "delete",
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "restart",
+			Usage:  "Restart the services of a job, lifting its quarantine if they failed to start too many times",
+			Action: s.RestartJob,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object of pipeline variables overriding those provided by the node, e.g. '{\"jobRun\": {\"meta\": {}}}'",
+				},
+				cli.DurationFlag{
+					Name:  "timeout",
+					Usage: "maximum time to wait for the run to finish",
+				},
+			},
+		},
+		{
+			Name:   "replay-run",
+			Usage:  "Execute a finished job run again with the inputs it captured, as a new run linked to it",
+			Action: s.ReplayPipelineRun,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "job-id",
+					Usage:    "ID of the job of the run",
+					Required: true,
+				},
+				cli.BoolFlag{
+					Name:  "execute-on-chain-writes",
+					Usage: "execute the ethtx tasks, instead of using their results in the original run",
+				},
+			},
+		},
+		{
+			Name:   "migrate-ocr",
+			Usage:  "Convert an OCR job into the equivalent OCR2 median (or bootstrap) job, and optionally replace it",
+			Action: s.MigrateOCRJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "id",
+					Usage:    "ID of the OCR job to migrate",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "contract-id",
+					Usage: "address of the OCR2 aggregator, defaults to the OCR contract address",
+				},
+				cli.StringFlag{
+					Name:  "ocr-key-bundle-id",
+					Usage: "OCR2 key bundle ID, defaults to the OCR2.KeyBundleID of the node",
+				},
+				cli.StringFlag{
+					Name:  "juels-per-fee-coin-source",
+					Usage: "pipeline, or path to a file containing the pipeline, computing the LINK per native token (required for median jobs)",
+				},
+				cli.BoolFlag{
+					Name:  "replace",
+					Usage: "delete the OCR job and create the OCR2 job in a single transaction",
+				},
+			},
 		},
 	}
 }
@@ -164,6 +243,10 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 		if p.GatewaySpec != nil {
 			return p.GatewaySpec.CreatedAt.Format(time.RFC3339)
 		}
+	case presenters.LOOPJobSpec:
+		if p.LOOPSpec != nil {
+			return p.LOOPSpec.CreatedAt.Format(time.RFC3339)
+		}
 	default:
 		return "unknown"
 	}
@@ -226,19 +309,26 @@ func (s *Shell) ShowJob(c *cli.Context) (err error) {
 }
 
 // CreateJob creates a job
-// Valid input is a TOML string or a path to TOML file
+// Valid input is a TOML string or a path to TOML file, which can be a spec template
+// whose variables are given with --var
 func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	if !c.Args().Present() {
 		return s.errorOut(errors.New("must pass in TOML or filepath"))
 	}
 
-	tomlString, err := getTOMLString(c.Args().First())
+	tomlString, err := getJobSpecString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	templateVars, err := parseTemplateVars(c)
 	if err != nil {
 		return s.errorOut(err)
 	}
 
 	request, err := json.Marshal(web.CreateJobRequest{
-		TOML: tomlString,
+		TOML:         tomlString,
+		TemplateVars: templateVars,
 	})
 	if err != nil {
 		return s.errorOut(err)
@@ -268,6 +358,85 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// parseTemplateVars returns the template variables of the --var flags.
+func parseTemplateVars(c *cli.Context) (templateVars map[string]string, err error) {
+	for _, v := range c.StringSlice("var") {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok || name == "" {
+			return nil, errors.Errorf("invalid template variable %q, must be name=value", v)
+		}
+		if templateVars == nil {
+			templateVars = map[string]string{}
+		}
+		templateVars[name] = value
+	}
+	return
+}
+
+type JobPolicyViolationPresenter struct {
+	JAID
+	presenters.JobPolicyViolationResource
+}
+
+type JobPolicyViolationPresenters []JobPolicyViolationPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobPolicyViolationPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Rule", "Severity", "Message"})
+	for _, p := range ps {
+		table.Append([]string{p.Rule, p.Severity, p.Message})
+	}
+
+	render("Policy Violations", table)
+	return nil
+}
+
+// LintJob checks a job spec against the policy rules of the node, and fails if it violates a rule with the error
+// severity.
+func (s *Shell) LintJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getJobSpecString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	templateVars, err := parseTemplateVars(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CreateJobRequest{
+		TOML:         tomlString,
+		TemplateVars: templateVars,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/lint", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var violations JobPolicyViolationPresenters
+	if err = s.renderAPIResponse(resp, &violations); err != nil {
+		return err
+	}
+	for _, v := range violations {
+		if v.Severity == string(policy.SeverityError) {
+			return s.errorOut(errors.New("job spec violates policy"))
+		}
+	}
+	return nil
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -286,12 +455,116 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// RestartJob restarts the services of a job
+func (s *Shell) RestartJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/restart", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job restarted")
+}
+
+// OCRJobMigrationPresenter wraps the JSONAPI OCR Job Migration Resource and adds rendering functionality
+type OCRJobMigrationPresenter struct {
+	JAID
+	presenters.OCRJobMigrationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *OCRJobMigrationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"OCR Job ID", "Replaced", "OCR2 Job ID"})
+	table.Append([]string{p.ID, fmt.Sprintf("%v", p.Replaced), p.JobID})
+	render("OCR Job Migration", table)
+
+	fmt.Println(p.TOML)
+	return nil
+}
+
+// MigrateOCRJob converts an OCR job into the equivalent OCR2 job, printing its spec,
+// and replaces the OCR job with it if requested.
+func (s *Shell) MigrateOCRJob(c *cli.Context) (err error) {
+	request := web.MigrateOCRJobRequest{
+		ContractID:     c.String("contract-id"),
+		OCRKeyBundleID: c.String("ocr-key-bundle-id"),
+		Replace:        c.Bool("replace"),
+	}
+	if source := c.String("juels-per-fee-coin-source"); source != "" {
+		request.JuelsPerFeeCoinSource, err = getPipelineString(source)
+		if err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.String("id")+"/migrate-ocr", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if request.Replace {
+		return s.renderAPIResponse(resp, &OCRJobMigrationPresenter{}, "OCR job replaced")
+	}
+	return s.renderAPIResponse(resp, &OCRJobMigrationPresenter{}, "OCR job converted, use --replace to replace it")
+}
+
+// getJobSpecString returns the TOML spec, or the content of the file at the given path.
+// Spec templates cannot be validated as TOML until they are rendered by the node.
+func getJobSpecString(s string) (string, error) {
+	if job.IsSpecTemplate(s) {
+		return s, nil
+	}
+	return getTOMLString(s)
+}
+
+// getPipelineString returns the pipeline, or the content of the file at the given path.
+func getPipelineString(s string) (string, error) {
+	if _, err := pipeline.Parse(s); err == nil {
+		return s, nil
+	}
+	buf, err := fromFile(s)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("invalid pipeline or file not found '%s'", s)
+	} else if err != nil {
+		return "", fmt.Errorf("error reading from file '%s': %v", s, err)
+	}
+	return buf.String(), nil
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
 		return s.errorOut(errors.New("Must pass the job id to trigger a run"))
 	}
-	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/runs", nil)
+	var body io.Reader
+	if vars := c.String("vars"); vars != "" {
+		if !json.Valid([]byte(vars)) {
+			return s.errorOut(errors.New("vars must be valid JSON"))
+		}
+		body = strings.NewReader(vars)
+	}
+	path := "/v2/jobs/" + c.Args().First() + "/runs"
+	if timeout := c.Duration("timeout"); timeout > 0 {
+		path += "?timeout=" + timeout.String()
+	}
+	resp, err := s.HTTP.Post(s.ctx(), path, body)
 	if err != nil {
 		return s.errorOut(err)
 	}
@@ -305,3 +578,27 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// ReplayPipelineRun executes a finished job run again, based on the run ID
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the id of the run to replay"))
+	}
+	path := "/v2/jobs/" + c.String("job-id") + "/runs/" + c.Args().First() + "/replay"
+	if c.Bool("execute-on-chain-writes") {
+		path += "?stubOnChainWrites=false"
+	}
+	resp, err := s.HTTP.Post(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully replayed")
+	return err
+}
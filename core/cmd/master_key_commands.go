@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initMasterKeySubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "master",
+		Usage: "Remote commands for administering the master key of the keystore envelope encryption",
+		Subcommands: cli.Commands{
+			{
+				Name:  "rotate",
+				Usage: format(`Re-encrypts the keystore with a new master key, used by the node from then on.`),
+				Description: format(`The keystore is re-encrypted with a new data key, wrapped by the new master key.
+				Update Keystore.MasterKeyFile or Keystore.AWSKMSKeyID with the new master key before restarting the node.`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "file",
+						Usage: "`PATH` on the node of the file holding the new master key, as 32 hex encoded bytes",
+					},
+					cli.StringFlag{
+						Name:  "aws-kms-key-id",
+						Usage: "`ID` of the AWS KMS key to use as the new master key",
+					},
+				},
+				Action: s.RotateMasterKey,
+			},
+		},
+	}
+}
+
+type MasterKeyPresenter struct {
+	JAID
+	presenters.MasterKeyResource
+}
+
+// RenderTable implements TableRenderer
+func (p *MasterKeyPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"Master key ID"}, [][]string{{p.ID}}, rt.Writer)
+	return nil
+}
+
+// RotateMasterKey switches the keystore envelope encryption to a new master key
+func (s *Shell) RotateMasterKey(c *cli.Context) (err error) {
+	request := web.MasterKeyRotateRequest{
+		MasterKeyFile: c.String("file"),
+		AWSKMSKeyID:   c.String("aws-kms-key-id"),
+	}
+	if (request.MasterKeyFile == "") == (request.AWSKMSKeyID == "") {
+		return s.errorOut(errors.New("Must specify exactly one of --file or --aws-kms-key-id"))
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keys/master/rotate", bytes.NewReader(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &MasterKeyPresenter{}, "Rotated keystore master key")
+}
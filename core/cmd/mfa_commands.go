@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initMFASubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "mfa",
+		Usage: "Enroll TOTP multi-factor authentication for the current API user, and manage their recovery codes",
+		Subcommands: cli.Commands{
+			{
+				Name:  "totp",
+				Usage: "Enroll a TOTP authenticator app",
+				Subcommands: cli.Commands{
+					{
+						Name:   "enroll",
+						Usage:  "Begin the enrollment with a new TOTP secret, to add to an authenticator app",
+						Action: s.BeginTOTPEnrollment,
+					},
+					{
+						Name:   "confirm",
+						Usage:  "Confirm the enrollment with a code of the authenticator app, and print the recovery codes",
+						Action: s.ConfirmTOTPEnrollment,
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:     "code",
+								Usage:    "current code of the authenticator app",
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:  "recovery-codes",
+				Usage: "Manage the one-time recovery codes, which replace a TOTP code on login",
+				Subcommands: cli.Commands{
+					{
+						Name:   "regenerate",
+						Usage:  "Replace the recovery codes with new ones",
+						Action: s.RegenerateRecoveryCodes,
+					},
+				},
+			},
+		},
+	}
+}
+
+type TOTPEnrollmentPresenter struct {
+	JAID
+	presenters.TOTPEnrollmentResource
+}
+
+// RenderTable implements TableRenderer
+func (p *TOTPEnrollmentPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"Secret", "URL"}, [][]string{{p.Secret, p.URL}}, rt.Writer)
+	return nil
+}
+
+type RecoveryCodesPresenter struct {
+	JAID
+	presenters.RecoveryCodesResource
+}
+
+// RenderTable implements TableRenderer
+func (p *RecoveryCodesPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"Recovery codes"}, [][]string{{strings.Join(p.Codes, "\n")}}, rt.Writer)
+	_, err := rt.Write([]byte("Store the recovery codes safely, they are only shown once.\n"))
+	return err
+}
+
+// BeginTOTPEnrollment begins the TOTP enrollment of the current user
+func (s *Shell) BeginTOTPEnrollment(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/enroll_totp", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &TOTPEnrollmentPresenter{}, "Add the secret to an authenticator app, then confirm the enrollment with `chainlink admin mfa totp confirm`")
+}
+
+// ConfirmTOTPEnrollment confirms the pending TOTP enrollment of the current user
+func (s *Shell) ConfirmTOTPEnrollment(c *cli.Context) (err error) {
+	code := c.String("code")
+	if code == "" {
+		return s.errorOut(errors.New("Must specify the --code of the authenticator app"))
+	}
+	requestData, err := json.Marshal(web.ConfirmTOTPRequest{Code: code})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/enroll_totp/confirm", bytes.NewReader(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RecoveryCodesPresenter{}, "Enrolled TOTP")
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the current user
+func (s *Shell) RegenerateRecoveryCodes(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/recovery_codes", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &RecoveryCodesPresenter{}, "Regenerated recovery codes")
+}
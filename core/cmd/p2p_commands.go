@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initP2PSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "peers",
+			Usage:  "List the remote peers, their connection state and the traffic with them of each OCR instance",
+			Action: s.ListP2PPeers,
+		},
+	}
+}
+
+type P2PPeerPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.P2PPeerResource
+}
+
+var p2pPeerHeaders = []string{"Peer ID", "Connected", "Last Connected At", "Dial Failures", "Last Dial Error", "Config Digest", "Messages Sent", "Messages Received", "Bytes Sent", "Bytes Received", "Sent/s", "Received/s"}
+
+// ToRows presents the P2PPeerResource as a row per OCR instance, or a single row if the peer is not an oracle of any.
+func (p *P2PPeerPresenter) ToRows() [][]string {
+	optional := func(t *time.Time) string {
+		if t == nil {
+			return "n/a"
+		}
+		return t.Format(time.RFC3339)
+	}
+	peer := []string{
+		p.GetID(),
+		strconv.FormatBool(p.Connected),
+		optional(p.LastConnectedAt),
+		strconv.FormatInt(p.DialFailures, 10),
+		p.LastDialError,
+	}
+	if len(p.Instances) == 0 {
+		return [][]string{append(peer, "", "", "", "", "", "", "")}
+	}
+	var rows [][]string
+	for _, t := range p.Instances {
+		rows = append(rows, append(append([]string{}, peer...),
+			t.ConfigDigest,
+			strconv.FormatInt(t.MessagesSent, 10),
+			strconv.FormatInt(t.MessagesReceived, 10),
+			strconv.FormatInt(t.BytesSent, 10),
+			strconv.FormatInt(t.BytesReceived, 10),
+			strconv.FormatFloat(t.MessagesSentPerSecond, 'f', 2, 64),
+			strconv.FormatFloat(t.MessagesReceivedPerSecond, 'f', 2, 64),
+		))
+	}
+	return rows
+}
+
+// RenderTable implements TableRenderer
+func (p *P2PPeerPresenter) RenderTable(rt RendererTable) error {
+	renderList(p2pPeerHeaders, p.ToRows(), rt.Writer)
+	return nil
+}
+
+// P2PPeerPresenters implements TableRenderer for a slice of P2PPeerPresenter.
+type P2PPeerPresenters []P2PPeerPresenter
+
+// RenderTable implements TableRenderer
+func (ps P2PPeerPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRows()...)
+	}
+	renderList(p2pPeerHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListP2PPeers lists the remote peers of the node.
+func (s *Shell) ListP2PPeers(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/p2p/peers")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var peers P2PPeerPresenters
+	return s.renderAPIResponse(resp, &peers, "P2P peers")
+}
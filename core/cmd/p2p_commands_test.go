@@ -0,0 +1,52 @@
+package cmd_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestP2PPeerPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.P2PPeerPresenters{
+		{
+			JAID: cmd.NewJAID("peer1"),
+			P2PPeerResource: presenters.P2PPeerResource{
+				Connected: true,
+				Instances: []presenters.P2PInstanceTrafficResult{
+					{ConfigDigest: "000a", MessagesSent: 3, MessagesSentPerSecond: 0.5},
+					{ConfigDigest: "000b", BytesReceived: 1024},
+				},
+			},
+		},
+		{
+			JAID: cmd.NewJAID("peer2"),
+			P2PPeerResource: presenters.P2PPeerResource{
+				DialFailures:  2,
+				LastDialError: "i/o timeout",
+			},
+		},
+	}
+
+	require.NoError(t, ps.RenderTable(r))
+	output := buffer.String()
+	assert.Equal(t, 2, strings.Count(output, "peer1"))
+	assert.Contains(t, output, "000a")
+	assert.Contains(t, output, "000b")
+	assert.Contains(t, output, "0.50")
+	assert.Contains(t, output, "1024")
+	assert.Contains(t, output, "i/o timeout")
+	assert.Contains(t, output, "n/a") // last connected at
+}
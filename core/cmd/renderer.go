@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/olekukonko/tablewriter"
@@ -142,10 +143,11 @@ func renderList(fields []string, items [][]string, writer io.Writer) {
 }
 
 func (rt RendererTable) renderExternalInitiatorAuthentication(eia webpresenters.ExternalInitiatorAuthentication) error {
-	table := rt.newTable([]string{"Name", "URL", "AccessKey", "Secret", "OutgoingToken", "OutgoingSecret"})
+	table := rt.newTable([]string{"Name", "URL", "GRPC", "AccessKey", "Secret", "OutgoingToken", "OutgoingSecret"})
 	table.Append([]string{
 		eia.Name,
 		eia.URL.String(),
+		strconv.FormatBool(eia.GRPC),
 		eia.AccessKey,
 		eia.Secret,
 		eia.OutgoingToken,
@@ -42,7 +42,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/envelope"
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/versioning"
@@ -152,7 +154,16 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, err
 	}
 
-	keyStore := keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg.Database())
+	replicaDB, err := newReplicaDB(cfg, db, appLggr)
+	if err != nil {
+		return nil, err
+	}
+
+	masterKey, err := envelope.NewMasterKey(cfg.Keystore().MasterKeyFile(), cfg.Keystore().AWSKMSKeyID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load keystore master key")
+	}
+	keyStore := keystore.NewWithMasterKey(db, utils.GetScryptParams(cfg), masterKey, appLggr, cfg.Database())
 	mailMon := mailbox.NewMonitor(cfg.AppID().String(), appLggr.Named("Mailbox"))
 
 	loopRegistry := plugins.NewLoopRegistry(appLggr, cfg.Tracing())
@@ -173,7 +184,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 
 	evmFactoryCfg := chainlink.EVMFactoryConfig{
 		CSAETHKeystore: keyStore,
-		ChainOpts:      legacyevm.ChainOpts{AppConfig: cfg, MailMon: mailMon, DB: db},
+		ChainOpts:      legacyevm.ChainOpts{AppConfig: cfg, MailMon: mailMon, DB: db, ReplicaDB: replicaDB},
 	}
 	// evm always enabled for backward compatibility
 	// TODO BCF-2510 this needs to change in order to clear the path for EVM extraction
@@ -203,6 +214,13 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		initOps = append(initOps, chainlink.InitStarknet(ctx, relayerFactory, starkCfg))
 
 	}
+	if cfg.AptosEnabled() {
+		aptosCfg := chainlink.AptosFactoryConfig{
+			Keystore:    keyStore.Aptos(),
+			TOMLConfigs: cfg.AptosConfigs(),
+		}
+		initOps = append(initOps, chainlink.InitAptos(ctx, relayerFactory, aptosCfg))
+	}
 
 	relayChainInterops, err := chainlink.NewCoreRelayerChainInteroperators(initOps...)
 	if err != nil {
@@ -221,6 +239,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 	return chainlink.NewApplication(chainlink.ApplicationOpts{
 		Config:                     cfg,
 		SqlxDB:                     db,
+		ReplicaDB:                  replicaDB,
 		KeyStore:                   keyStore,
 		RelayerChainInteroperators: relayChainInterops,
 		MailMon:                    mailMon,
@@ -237,6 +256,22 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 	})
 }
 
+// newReplicaDB returns a ReplicaDB for the read-only replica of the database, or nil if none is configured.
+func newReplicaDB(cfg chainlink.GeneralConfig, db *sqlx.DB, lggr logger.Logger) (*pg.ReplicaDB, error) {
+	replicaCfg := cfg.Database().Replica()
+	if replicaCfg.URL() == nil {
+		return nil, nil
+	}
+	uri := *replicaCfg.URL()
+	appID := cfg.AppID()
+	static.SetConsumerName(&uri, "Replica", &appID)
+	replica, err := pg.NewReplicaConnection(uri, cfg.Database().Dialect(), cfg.Database())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open database replica")
+	}
+	return pg.NewReplicaDB(db, replica, replicaCfg, lggr), nil
+}
+
 // handleNodeVersioning is a setup-time helper to encapsulate version changes and db migration
 func handleNodeVersioning(ctx context.Context, db *sqlx.DB, appLggr logger.Logger, rootDir string, cfg config.Database, healthReportPort uint16) error {
 	var err error
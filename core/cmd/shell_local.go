@@ -4,6 +4,7 @@ import (
 	"context"
 	crand "crypto/rand"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math/big"
@@ -41,6 +42,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -155,6 +157,55 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 			Name:   "validate",
 			Usage:  "Validate the TOML configuration and secrets that are passed as flags to the `node` command. Prints the full effective configuration, with defaults included",
 			Action: s.ConfigFileValidate,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "lint",
+					Usage: "also report deprecated fields, EVM finality settings conflicting with the chain defaults, and RPC nodes which cannot be reached or serve another chain",
+				},
+				cli.BoolFlag{
+					Name:  "skip-rpc",
+					Usage: "skip the lint checks which dial the RPC nodes",
+				},
+				cli.DurationFlag{
+					Name:  "rpc-timeout",
+					Usage: "timeout of the lint checks of each RPC node",
+					Value: 10 * time.Second,
+				},
+				cli.BoolFlag{
+					Name:  "json",
+					Usage: "print the validation errors and lint findings as JSON instead of the configuration",
+				},
+				cli.BoolFlag{
+					Name:  "fail-on-warnings",
+					Usage: "exit with an error if any lint finding is a warning",
+				},
+			},
+		},
+		{
+			Name:   "chain-reader-report",
+			Usage:  "Execute every read of a ChainReader config against a deployed contract, using the RPC nodes of the EVM chain in the TOML configuration, and report whether each one decodes",
+			Action: s.ChainReaderReport,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "reader-config, r",
+					Usage:    "JSON file holding the ChainReader config, as embedded in a job spec's relayConfig.chainReader",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "address, a",
+					Usage:    "The address (in hex format) of the deployed contract",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "evmChainID, evm-chain-id",
+					Usage: "Chain ID of the contract. If left blank, the first configured EVM chain will be used.",
+				},
+				cli.Uint64Flag{
+					Name:  "event-lookback",
+					Usage: "number of recent blocks to search for events",
+					Value: 10_000,
+				},
+			},
 		},
 		{
 			Name:        "db",
@@ -345,8 +396,31 @@ func (s *Shell) runNode(c *cli.Context) error {
 		os.Exit(-1)
 	})
 
+	// A warm standby opens the DB without the lease, and takes it before loading the application, which writes to the DB
+	lock := cfg.Database().Lock()
+	standby := lock.LockingMode() == "lease" && lock.WarmStandby()
+	open := ldb.Open
+	if standby {
+		open = ldb.OpenStandby
+	}
+
 	// Try opening DB connection and acquiring DB locks at once
-	if err := ldb.Open(rootCtx); err != nil {
+	err := open(rootCtx)
+	if r, ok := s.Config.(interface{ RefetchSecrets() error }); ok && pg.IsAuthError(err) {
+		// The credentials fetched from a secrets provider may have been rotated since
+		if rerr := r.RefetchSecrets(); rerr != nil {
+			lggr.Debugw("Not refetching secrets", "err", rerr)
+		} else {
+			lggr.Warnw("Database rejected the credentials, retrying with refetched secrets", "err", err)
+			ldb = pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+			open = ldb.Open
+			if standby {
+				open = ldb.OpenStandby
+			}
+			err = open(rootCtx)
+		}
+	}
+	if err != nil {
 		// If not successful, we know neither locks nor connection remains opened
 		return s.errorOut(errors.Wrap(err, "opening db"))
 	}
@@ -355,6 +429,21 @@ func (s *Shell) runNode(c *cli.Context) error {
 	// From now on, DB locks and DB connection will be released on every return.
 	// Keep watching on logger.Fatal* calls and os.Exit(), because defer will not be executed.
 
+	if standby && cfg.Database().MigrateDatabase() {
+		// Never migrate the DB under the feet of the leader
+		if pending, perr := migrate.Pending(rootCtx, ldb.DB().DB); perr != nil || pending {
+			lggr.Warnw("Database migrations may be pending, taking the lease before loading the application", "err", perr)
+			if err = ldb.TakeLease(rootCtx); err != nil {
+				return s.errorOut(errors.Wrap(err, "taking database lease"))
+			}
+		}
+	}
+	if standby {
+		if err = s.waitForLease(rootCtx, ldb, lggr); err != nil {
+			return s.errorOut(errors.Wrap(err, "taking database lease"))
+		}
+	}
+
 	app, err := s.AppFactory.NewApplication(rootCtx, s.Config, s.Logger, ldb.DB())
 	if err != nil {
 		return s.errorOut(errors.Wrap(err, "fatal error instantiating application"))
@@ -368,6 +457,12 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return errors.Wrap(err, "error authenticating keystore")
 	}
 
+	if lock.LockingMode() == "lease" {
+		if err = app.GetHealthChecker().Register(ldb); err != nil {
+			return errors.Wrap(err, "error registering database lease health check")
+		}
+	}
+
 	legacyEVMChains := app.GetRelayers().LegacyEVMChains()
 
 	if s.Config.EVMEnabled() {
@@ -437,6 +532,12 @@ func (s *Shell) runNode(c *cli.Context) error {
 			return errors.Wrap(err2, "failed to ensure starknet key")
 		}
 	}
+	if s.Config.AptosEnabled() {
+		err2 := app.GetKeyStore().Aptos().EnsureKey()
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to ensure aptos key")
+		}
+	}
 
 	err2 := app.GetKeyStore().CSA().EnsureKey()
 	if err2 != nil {
@@ -479,6 +580,16 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return nil
 	})
 
+	grp.Go(func() error {
+		shutdown.HandleReload(grpCtx, func() {
+			lggr.Info("Reloading config due to SIGHUP signal received...")
+			if _, errInternal := app.ReloadConfig(grpCtx); errInternal != nil {
+				lggr.Errorw("Failed to reload config", "err", errInternal)
+			}
+		})
+		return nil
+	})
+
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
 	grp.Go(func() error {
@@ -495,6 +606,35 @@ func (s *Shell) runNode(c *cli.Context) error {
 	return grp.Wait()
 }
 
+// waitForLease blocks until ldb takes the lease, while serving the health checks of the standby.
+func (s *Shell) waitForLease(ctx context.Context, ldb pg.LockedDB, lggr logger.SugaredLogger) error {
+	if ldb.Ready() == nil {
+		return nil
+	}
+	lggr.Info("Waiting for the database lease as a warm standby")
+
+	ws := s.Config.WebServer()
+	if ws.HTTPPort() != 0 {
+		server := createServer(web.NewStandbyRouter(ldb, lggr), fmt.Sprintf("%s:%d", ws.ListenIP(), ws.HTTPPort()), ws.HTTPWriteTimeout())
+		go func() {
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				lggr.Errorw("Failed to serve standby health checks", "err", err)
+			}
+		}()
+		defer func() {
+			sctx, cancel := context.WithTimeout(context.Background(), ws.HTTPWriteTimeout())
+			defer cancel()
+			lggr.ErrorIfFn(func() error { return server.Shutdown(sctx) }, "Error shutting down standby server")
+		}()
+	}
+
+	if err := ldb.TakeLease(ctx); err != nil {
+		return err
+	}
+	lggr.Info("Took the database lease, starting up")
+	return nil
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure tls sub directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
@@ -687,17 +827,96 @@ func (ps HealthCheckPresenters) RenderTable(rt RendererTable) error {
 
 var errDBURLMissing = errors.New("You must set CL_DATABASE_URL env variable or provide a secrets TOML with Database.URL set. HINT: If you are running this to set up your local test database, try CL_DATABASE_URL=postgresql://postgres@localhost:5432/chainlink_test?sslmode=disable")
 
+// ConfigValidationReport is the output of `node validate --json`.
+type ConfigValidationReport struct {
+	// Valid is false if the configuration is invalid, or any lint finding is an error.
+	Valid    bool                      `json:"valid"`
+	Findings []chainlink.ConfigFinding `json:"findings"`
+}
+
 // ConfigValidate validate the client configuration and pretty-prints results
-func (s *Shell) ConfigFileValidate(_ *cli.Context) error {
-	fn := func(f string, params ...any) { fmt.Printf(f, params...) }
-	s.Config.LogConfiguration(fn, fn)
-	if err := s.configExitErr(s.Config.Validate); err != nil {
-		return err
+func (s *Shell) ConfigFileValidate(c *cli.Context) error {
+	if !c.Bool("lint") && !c.Bool("json") {
+		fn := func(f string, params ...any) { fmt.Printf(f, params...) }
+		s.Config.LogConfiguration(fn, fn)
+		if err := s.configExitErr(s.Config.Validate); err != nil {
+			return err
+		}
+		fmt.Println("Valid configuration.")
+		return nil
+	}
+
+	report := ConfigValidationReport{Findings: []chainlink.ConfigFinding{}}
+	if err := s.Config.Validate(); err != nil {
+		for _, e := range flattenErrors(err) {
+			report.Findings = append(report.Findings, chainlink.ConfigFinding{Severity: chainlink.ConfigFindingError, Code: "invalid", Message: e.Error()})
+		}
+	}
+	if c.Bool("lint") {
+		findings, err := chainlink.LintConfig(s.ctx(), s.Config, chainlink.ConfigLintOpts{SkipRPC: c.Bool("skip-rpc"), RPCTimeout: c.Duration("rpc-timeout")})
+		if err != nil {
+			return s.errorOut(fmt.Errorf("failed to lint configuration: %w", err))
+		}
+		report.Findings = append(report.Findings, findings...)
+	}
+
+	var errCount, warnCount int
+	for _, f := range report.Findings {
+		switch f.Severity {
+		case chainlink.ConfigFindingError:
+			errCount++
+		case chainlink.ConfigFindingWarning:
+			warnCount++
+		}
+	}
+	report.Valid = errCount == 0
+
+	if c.Bool("json") {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return s.errorOut(err)
+		}
+		fmt.Println(string(b))
+	} else {
+		fn := func(f string, params ...any) { fmt.Printf(f, params...) }
+		s.Config.LogConfiguration(fn, fn)
+		for _, f := range report.Findings {
+			fmt.Printf("%s: %s", strings.ToUpper(string(f.Severity)), f.Code)
+			if f.Field != "" {
+				fmt.Printf(" (%s)", f.Field)
+			}
+			fmt.Printf(": %s\n", f.Message)
+			if f.Suggestion != "" {
+				fmt.Printf("\tsuggestion: %s\n", f.Suggestion)
+			}
+		}
+	}
+
+	if errCount > 0 || (c.Bool("fail-on-warnings") && warnCount > 0) {
+		return s.errorOut(fmt.Errorf("invalid configuration: %d errors and %d warnings found", errCount, warnCount))
+	}
+	if !c.Bool("json") {
+		fmt.Println("Valid configuration.")
 	}
-	fmt.Println("Valid configuration.")
 	return nil
 }
 
+// flattenErrors returns the leaves of the tree of joined errors. Errors wrapping a single error are kept whole.
+func flattenErrors(err error) (errs []error) {
+	switch u := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, e := range u.Unwrap() {
+			errs = append(errs, flattenErrors(e)...)
+		}
+		return
+	case interface{ Unwrap() error }:
+		if errs = flattenErrors(u.Unwrap()); len(errs) > 1 {
+			return
+		}
+	}
+	return []error{err}
+}
+
 // ValidateDB is a BeforeFunc to run prior to database sub commands
 // the ctx must be that of the last subcommand to be validated
 func (s *Shell) validateDB(c *cli.Context) error {
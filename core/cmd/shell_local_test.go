@@ -1,10 +1,15 @@
 package cmd_test
 
 import (
+	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"math/big"
+	"net/http"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	chainlinkmocks "github.com/smartcontractkit/chainlink/v2/core/services/chainlink/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	evmrelayer "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
 	"github.com/smartcontractkit/chainlink/v2/core/store/dialects"
@@ -35,6 +41,8 @@ import (
 
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/google/uuid"
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -150,6 +158,73 @@ func TestShell_RunNodeWithPasswords(t *testing.T) {
 	}
 }
 
+type appFactoryFunc func() (chainlink.Application, error)
+
+func (f appFactoryFunc) NewApplication(context.Context, chainlink.GeneralConfig, logger.Logger, *sqlx.DB) (chainlink.Application, error) {
+	return f()
+}
+
+func TestShell_RunNode_WarmStandby(t *testing.T) {
+	testutils.SkipShortDB(t)
+	port := freeport.GetOne(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		s.Password.Keystore = models.NewSecret("dummy")
+		c.EVM = nil
+		c.Insecure.OCRDevelopmentMode = nil
+		c.Database.MigrateOnStartup = ptr(false)
+		c.Database.Lock.Enabled = ptr(true)
+		c.Database.Lock.LeaseDuration = commonconfig.MustNewDuration(10 * time.Second)
+		c.Database.Lock.LeaseRefreshInterval = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.Database.Lock.WarmStandby = ptr(true)
+		c.WebServer.HTTPPort = ptr(uint16(port))
+	})
+	lggr := logger.TestLogger(t)
+
+	// the leader is another node, holding the lease
+	leader := pg.NewLockedDB(uuid.New(), cfg.Database(), cfg.Database().Lock(), lggr)
+	require.NoError(t, leader.Open(testutils.Context(t)))
+
+	var released atomic.Bool
+	errLoaded := errors.New("application loaded")
+	client := cmd.Shell{
+		Config: cfg,
+		Runner: cltest.EmptyRunner{},
+		AppFactory: appFactoryFunc(func() (chainlink.Application, error) {
+			// loading the application writes to the DB, so it must wait for the lease
+			assert.True(t, released.Load(), "application loaded before taking the lease")
+			return nil, errLoaded
+		}),
+		Logger: lggr,
+	}
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RunNode, set, "")
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.RunNode(cli.NewContext(nil, set, nil))
+	}()
+
+	// the standby serves its health checks while it waits
+	require.Eventually(t, func() bool {
+		resp, err := http.Get(fmt.Sprintf("http://localhost:%d/readyz", port))
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode == http.StatusServiceUnavailable
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+
+	released.Store(true)
+	require.NoError(t, leader.Close())
+
+	select {
+	case err := <-errCh:
+		require.ErrorContains(t, err, errLoaded.Error())
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("standby did not take the lease")
+	}
+}
+
 func TestShell_RunNodeWithAPICredentialsFile(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -42,6 +42,11 @@ func initRemoteConfigSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "reload",
+			Usage:  "Reload the config and secrets files, and apply the changes which do not require a restart",
+			Action: s.ReloadConfig,
+		},
 		{
 			Name:   "loglevel",
 			Usage:  "Set log level",
@@ -93,6 +98,7 @@ func (s *Shell) CreateExternalInitiator(c *cli.Context) (err error) {
 
 	var request bridges.ExternalInitiatorRequest
 	request.Name = c.Args().Get(0)
+	request.GRPC = c.Bool("grpc")
 
 	// process optional URL
 	if c.NArg() == 2 {
@@ -180,6 +186,8 @@ func (s *Shell) RemoteLogin(c *cli.Context) error {
 	if err != nil {
 		return s.errorOut(err)
 	}
+	sessionRequest.TOTPCode = c.String("totp")
+	sessionRequest.RecoveryCode = c.String("recovery-code")
 	_, err = s.CookieAuthenticator.Authenticate(s.ctx(), sessionRequest)
 	if err != nil {
 		return s.errorOut(err)
@@ -337,6 +345,45 @@ func (s *Shell) configV2Str(userOnly bool) (string, error) {
 	return configV2Resource.Config, nil
 }
 
+// ConfigReloadPresenter implements TableRenderer for a ConfigReloadResource
+type ConfigReloadPresenter struct {
+	web.ConfigReloadResource
+}
+
+// RenderTable implements TableRenderer
+func (p *ConfigReloadPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Field", "From", "To", "Applied"})
+	for _, c := range p.Applied {
+		table.Append([]string{c.Field, c.From, c.To, "true"})
+	}
+	for _, c := range p.RestartRequired {
+		table.Append([]string{c.Field, c.From, c.To, "false (restart required)"})
+	}
+	render("Config Changes", table)
+
+	table = rt.newTable([]string{"Service"})
+	for _, svc := range p.Reinitialized {
+		table.Append([]string{svc})
+	}
+	render("Reinitialized Services", table)
+	return nil
+}
+
+// ReloadConfig reloads the config and secrets files of the node
+func (s *Shell) ReloadConfig(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/config/reload", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ConfigReloadPresenter{})
+}
+
 func normalizePassword(password string) string {
 	return url.QueryEscape(strings.TrimSpace(password))
 }
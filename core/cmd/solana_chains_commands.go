@@ -44,5 +44,5 @@ func (ps SolanaChainPresenters) RenderTable(rt RendererTable) error {
 }
 
 func SolanaChainClient(s *Shell) ChainClient {
-	return newChainClient[SolanaChainPresenters](s, "solana")
+	return newChainClient[SolanaChainPresenter, SolanaChainPresenters](s, "solana")
 }
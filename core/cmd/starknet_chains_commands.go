@@ -44,5 +44,5 @@ func (ps StarkNetChainPresenters) RenderTable(rt RendererTable) error {
 }
 
 func StarkNetChainClient(s *Shell) ChainClient {
-	return newChainClient[StarkNetChainPresenters](s, "starknet")
+	return newChainClient[StarkNetChainPresenter, StarkNetChainPresenters](s, "starknet")
 }
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func initSupportSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "bundle",
+			Usage: "Creates an archive of the diagnostics of the node, to attach to support tickets",
+			Description: "The archive contains the effective config with the URLs and headers redacted, the health checks, the recent error logs, " +
+				"the state of the RPC nodes, the IDs of the keys, and the LogPoller lag and transaction queue of each EVM chain.",
+			Action: s.CreateSupportBundle,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "output, o",
+					Usage: "path of the archive, defaults to the name given by the node, in the working directory",
+				},
+			},
+		},
+	}
+}
+
+// CreateSupportBundle downloads a support bundle from the node.
+func (s *Shell) CreateSupportBundle(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/support_bundle", nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error creating support bundle: %w", httpError(resp)))
+	}
+
+	output := c.String("output")
+	if output == "" {
+		_, params, perr := mime.ParseMediaType(resp.Header.Get("Content-Disposition"))
+		if perr != nil || params["filename"] == "" {
+			return s.errorOut(errors.New("node did not name the support bundle, specify --output/-o flag"))
+		}
+		// only the base name is used, so that the node cannot choose where the bundle is written
+		output = filepath.Base(params["filename"])
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+	if err = utils.WriteFileWithMaxPerms(output, b, 0o600); err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", output))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("Created support bundle %s\n", output))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	return nil
+}
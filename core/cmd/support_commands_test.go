@@ -0,0 +1,37 @@
+package cmd_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestShell_CreateSupportBundle(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	output := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	set := flag.NewFlagSet("test support bundle", 0)
+	flagSetApplyFromAction(client.CreateSupportBundle, set, "")
+	require.NoError(t, set.Set("output", output))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CreateSupportBundle(c))
+
+	f, err := os.Open(output)
+	require.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+	gr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	h, err := tar.NewReader(gr).Next()
+	require.NoError(t, err)
+	require.Equal(t, "config.toml", filepath.Base(h.Name))
+}
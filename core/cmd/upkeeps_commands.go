@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initUpkeepsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "simulate",
+			Usage:  "Simulate the check of an upkeep at the latest block, to diagnose why it is not performed",
+			Action: s.SimulateUpkeep,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "registry",
+					Usage: "address of the registry of the upkeep",
+				},
+				cli.Int64Flag{
+					Name:  "evm-chain-id",
+					Usage: "chain ID of the registry, if left empty, the only EVM chain is used",
+				},
+				cli.StringFlag{
+					Name:  "trigger-data",
+					Usage: "hex encoded trigger data passed to checkUpkeep, e.g. the encoded log of a log trigger upkeep (v2.1 registries only)",
+				},
+				cli.StringSliceFlag{
+					Name:  "callback-value",
+					Usage: "hex encoded value passed to checkCallback instead of calling checkUpkeep, can be repeated (v2.1 registries only)",
+				},
+				cli.StringFlag{
+					Name:  "callback-extra-data",
+					Usage: "hex encoded extra data passed to checkCallback (v2.1 registries only)",
+				},
+			},
+		},
+	}
+}
+
+type UpkeepSimulationPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.UpkeepSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *UpkeepSimulationPresenter) RenderTable(rt RendererTable) error {
+	optional := func(s *string) string {
+		if s == nil {
+			return "n/a"
+		}
+		return *s
+	}
+	headers := []string{"Upkeep ID", "Registry", "Version", "Block", "Upkeep Needed", "Failure Reason", "Check Gas Used", "Gas Limit", "Perform Gas Used", "Perform Succeeded", "Perform Data"}
+	rows := [][]string{{
+		p.GetID(),
+		p.RegistryAddress,
+		p.RegistryVersion,
+		strconv.FormatInt(p.BlockNumber, 10),
+		strconv.FormatBool(p.UpkeepNeeded),
+		p.FailureReason,
+		optional(p.CheckGasUsed),
+		optional(p.GasLimit),
+		optional(p.PerformGasUsed),
+		strconv.FormatBool(p.PerformSimulationSucceeded),
+		p.PerformData,
+	}}
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// SimulateUpkeep simulates the check of an upkeep through its registry.
+func (s *Shell) SimulateUpkeep(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the upkeep to simulate"))
+	}
+	if c.String("registry") == "" {
+		return s.errorOut(errors.New("must pass the address of the registry in '--registry'"))
+	}
+
+	body := web.SimulateUpkeepRequest{
+		RegistryAddress: c.String("registry"),
+		CallbackValues:  c.StringSlice("callback-value"),
+	}
+	if c.IsSet("trigger-data") {
+		triggerData := c.String("trigger-data")
+		body.TriggerData = &triggerData
+	}
+	if c.IsSet("callback-extra-data") {
+		extraData := c.String("callback-extra-data")
+		body.CallbackExtraData = &extraData
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	v := url.Values{}
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/upkeeps/%s/simulate?%s", url.PathEscape(c.Args().First()), v.Encode()), bytes.NewReader(b))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenter UpkeepSimulationPresenter
+	return s.renderAPIResponse(resp, &presenter, "Upkeep simulation")
+}
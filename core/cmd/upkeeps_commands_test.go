@@ -0,0 +1,40 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestUpkeepSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		gasLimit = "500000"
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.UpkeepSimulationPresenter{
+		JAID: cmd.NewJAID("42"),
+		UpkeepSimulationResource: presenters.UpkeepSimulationResource{
+			RegistryVersion: "v2.1",
+			FailureReason:   "UPKEEP_NOT_NEEDED",
+			GasLimit:        &gasLimit,
+			PerformData:     "0x",
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "v2.1")
+	assert.Contains(t, output, "UPKEEP_NOT_NEEDED")
+	assert.Contains(t, output, gasLimit)
+	assert.Contains(t, output, "n/a") // check gas used
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initVRFSubCmds(s *Shell) []cli.Command {
+	jobIDFlag := cli.StringFlag{
+		Name:  "job-id, jobID",
+		Usage: "ID of the VRF job, if left empty, all VRF V2 and V2 Plus jobs are considered",
+	}
+	return []cli.Command{
+		{
+			Name:  "requests",
+			Usage: "Commands for inspecting and fulfilling pending VRF requests",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "List pending VRF requests and why they have not been fulfilled yet",
+					Action: s.ListVRFRequests,
+					Flags:  []cli.Flag{jobIDFlag},
+				},
+				{
+					Name:   "fulfill",
+					Usage:  "Fulfill a pending VRF request, regardless of the balance of its subscription",
+					Action: s.FulfillVRFRequest,
+					Flags:  []cli.Flag{jobIDFlag},
+				},
+			},
+		},
+	}
+}
+
+type VRFRequestPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFRequestResource
+}
+
+var vrfRequestHeaders = []string{"Request ID", "Job ID", "Sub ID", "Sub Balance", "Max Fee", "Block", "Confirmed At", "Attempts", "Simulation Error", "Reason"}
+
+// ToRow presents the VRFRequestResource as a slice of strings.
+func (p *VRFRequestPresenter) ToRow() []string {
+	optional := func(s *string) string {
+		if s == nil {
+			return "unknown"
+		}
+		return *s
+	}
+	return []string{
+		p.GetID(),
+		strconv.Itoa(int(p.JobID)),
+		p.SubID,
+		optional(p.SubBalance),
+		optional(p.MaxFee),
+		strconv.FormatUint(p.BlockNumber, 10),
+		strconv.FormatUint(p.ConfirmedAtBlock, 10),
+		strconv.Itoa(p.Attempts),
+		p.SimulationError,
+		p.Reason,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFRequestPresenter) RenderTable(rt RendererTable) error {
+	renderList(vrfRequestHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// VRFRequestPresenters implements TableRenderer for a slice of VRFRequestPresenter.
+type VRFRequestPresenters []VRFRequestPresenter
+
+// RenderTable implements TableRenderer
+func (ps VRFRequestPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(vrfRequestHeaders, rows, rt.Writer)
+	return nil
+}
+
+type VRFRequestFulfillmentPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFRequestFulfillmentResource
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFRequestFulfillmentPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Request ID", "Job ID", "Eth Tx ID", "From", "To"}
+	rows := [][]string{{
+		p.GetID(),
+		strconv.Itoa(int(p.JobID)),
+		strconv.FormatInt(p.EthTxID, 10),
+		p.FromAddress.Hex(),
+		p.ToAddress.Hex(),
+	}}
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// ListVRFRequests lists the pending requests of VRF V2 and V2 Plus jobs.
+func (s *Shell) ListVRFRequests(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/vrf/requests"+vrfRequestsQuery(c))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenters VRFRequestPresenters
+	return s.renderAPIResponse(resp, &presenters, "Pending VRF requests")
+}
+
+// FulfillVRFRequest enqueues the fulfillment of a pending VRF request.
+func (s *Shell) FulfillVRFRequest(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the request to fulfill"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/vrf/requests/%s/fulfill%s", url.PathEscape(c.Args().First()), vrfRequestsQuery(c)), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenter VRFRequestFulfillmentPresenter
+	return s.renderAPIResponse(resp, &presenter, "Enqueued VRF fulfillment")
+}
+
+func vrfRequestsQuery(c *cli.Context) string {
+	if jobID := c.String("job-id"); jobID != "" {
+		return "?" + url.Values{"jobID": {jobID}}.Encode()
+	}
+	return ""
+}
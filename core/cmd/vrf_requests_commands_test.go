@@ -0,0 +1,42 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestVRFRequestPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		balance = "1000000"
+		buffer  = bytes.NewBufferString("")
+		r       = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.VRFRequestPresenter{
+		JAID: cmd.NewJAID("4242"),
+		VRFRequestResource: presenters.VRFRequestResource{
+			JobID:           7,
+			SubID:           "99",
+			SubBalance:      &balance,
+			SimulationError: "execution reverted",
+			Reason:          "insufficient subscription balance",
+		},
+	}
+
+	require.NoError(t, cmd.VRFRequestPresenters{p}.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "4242")
+	assert.Contains(t, output, "99")
+	assert.Contains(t, output, balance)
+	assert.Contains(t, output, "unknown") // max fee
+	assert.Contains(t, output, "execution reverted")
+	assert.Contains(t, output, "insufficient subscription balance")
+}
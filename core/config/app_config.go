@@ -25,6 +25,7 @@ type AppConfig interface {
 	CosmosEnabled() bool
 	SolanaEnabled() bool
 	StarkNetEnabled() bool
+	AptosEnabled() bool
 
 	Validate() error
 	ValidateDB() error
@@ -35,12 +36,14 @@ type AppConfig interface {
 
 	AuditLogger() AuditLogger
 	AutoPprof() AutoPprof
+	BalanceMonitor() BalanceMonitor
 	Database() Database
 	Feature() Feature
 	FluxMonitor() FluxMonitor
 	Insecure() Insecure
 	JobPipeline() JobPipeline
 	Keeper() Keeper
+	Keystore() Keystore
 	Log() Log
 	Mercury() Mercury
 	OCR() OCR
@@ -0,0 +1,14 @@
+package config
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type BalanceMonitor interface {
+	Enabled() bool
+	PollPeriod() time.Duration
+	// LowBalance returns the threshold in whole tokens for the chain family network, or zero if there is none.
+	LowBalance(network string) decimal.Decimal
+}
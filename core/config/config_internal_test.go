@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -83,3 +84,28 @@ func TestStore_boolParser(t *testing.T) {
 	_, err = parse.Bool("")
 	assert.Error(t, err)
 }
+
+func TestTimeWindow(t *testing.T) {
+	var w TimeWindow
+	require.NoError(t, w.UnmarshalText([]byte("02:00-04:30")))
+	assert.Equal(t, TimeWindow{Start: 2 * time.Hour, End: 4*time.Hour + 30*time.Minute}, w)
+	assert.Equal(t, "02:00-04:30", w.String())
+	assert.Equal(t, 150*time.Minute, w.Duration())
+	assert.True(t, w.Contains(time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)))
+	assert.False(t, w.Contains(time.Date(2024, 1, 1, 4, 30, 0, 0, time.UTC)))
+	assert.False(t, w.Contains(time.Date(2024, 1, 1, 3, 0, 0, 0, time.FixedZone("CET", 3600*3))), "times are compared in UTC")
+
+	require.NoError(t, w.UnmarshalText([]byte("23:00-01:00")))
+	assert.Equal(t, 2*time.Hour, w.Duration())
+	assert.True(t, w.Contains(time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC)))
+	assert.True(t, w.Contains(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)))
+	assert.False(t, w.Contains(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+
+	require.NoError(t, w.UnmarshalText(nil))
+	assert.True(t, w.IsZero())
+	assert.Equal(t, "", w.String())
+
+	for _, s := range []string{"02:00", "2am-4am", "02:00-24:00", "03:00-03:00"} {
+		assert.Error(t, w.UnmarshalText([]byte(s)), s)
+	}
+}
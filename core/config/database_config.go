@@ -1,7 +1,9 @@
 package config
 
 import (
+	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/store/dialects"
@@ -19,6 +21,8 @@ type Lock interface {
 	LockingMode() string
 	LeaseDuration() time.Duration
 	LeaseRefreshInterval() time.Duration
+	// WarmStandby is true if the node waits for the lease after starting up, rather than before.
+	WarmStandby() bool
 }
 
 type Listener interface {
@@ -27,10 +31,52 @@ type Listener interface {
 	FallbackPollInterval() time.Duration
 }
 
+type Replica interface {
+	URL() *url.URL
+	MaxLag() time.Duration
+	LagCheckInterval() time.Duration
+}
+
+type MaintenancePolicy interface {
+	Retention() time.Duration
+	Vacuum() bool
+}
+
+type Maintenance interface {
+	Enabled() bool
+	Interval() time.Duration
+	Window() *TimeWindow
+	BatchSize() uint32
+
+	PipelineRuns() MaintenancePolicy
+	Logs() MaintenancePolicy
+	Transactions() MaintenancePolicy
+	Heads() MaintenancePolicy
+	Archive() Archive
+}
+
+// Archive is the object store which pruned pipeline runs are archived to.
+type Archive interface {
+	Enabled() bool
+	URL() *url.URL
+	// Endpoint overrides the endpoint of the object store, if set.
+	Endpoint() *url.URL
+}
+
+type Partitions interface {
+	CheckInterval() time.Duration
+	Premake() uint32
+	LogsBlockRange() uint64
+	PipelineRunsInterval() time.Duration
+}
+
 type Database interface {
 	Backup() Backup
 	Listener() Listener
 	Lock() Lock
+	Maintenance() Maintenance
+	Partitions() Partitions
+	Replica() Replica
 
 	DefaultIdleInTxSessionTimeout() time.Duration
 	DefaultLockTimeout() time.Duration
@@ -42,3 +88,73 @@ type Database interface {
 	MigrateDatabase() bool
 	URL() url.URL
 }
+
+// TimeWindow is a daily window of time in UTC, like 01:00-05:00. Windows which end before they start wrap around
+// midnight. The zero TimeWindow is unset, and formatted as an empty string.
+type TimeWindow struct {
+	Start, End time.Duration // since midnight
+}
+
+func (w TimeWindow) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+func (w *TimeWindow) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*w = TimeWindow{}
+		return nil
+	}
+	start, end, ok := strings.Cut(string(b), "-")
+	if !ok {
+		return fmt.Errorf("invalid time window %q: must be formatted like 01:00-05:00", b)
+	}
+	var err error
+	if w.Start, err = parseTimeOfDay(start); err != nil {
+		return fmt.Errorf("invalid time window start: %w", err)
+	}
+	if w.End, err = parseTimeOfDay(end); err != nil {
+		return fmt.Errorf("invalid time window end: %w", err)
+	}
+	if w.Start == w.End {
+		return fmt.Errorf("invalid time window %q: must not be empty", b)
+	}
+	return nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// IsZero returns true if the window is unset.
+func (w TimeWindow) IsZero() bool {
+	return w == TimeWindow{}
+}
+
+func (w TimeWindow) String() string {
+	if w.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", int(w.Start.Hours()), int(w.Start.Minutes())%60, int(w.End.Hours()), int(w.End.Minutes())%60)
+}
+
+// Duration returns the length of the window.
+func (w TimeWindow) Duration() time.Duration {
+	if w.End < w.Start {
+		return 24*time.Hour - w.Start + w.End
+	}
+	return w.End - w.Start
+}
+
+// Contains returns true if t is within the window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	d := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.End < w.Start {
+		return d >= w.Start || d < w.End
+	}
+	return d >= w.Start && d < w.End
+}
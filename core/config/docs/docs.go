@@ -32,13 +32,15 @@ var (
 	chainsSolanaTOML string
 	//go:embed chains-starknet.toml
 	chainsStarknetTOML string
+	//go:embed chains-aptos.toml
+	chainsAptosTOML string
 
 	//go:embed example-config.toml
 	exampleConfig string
 	//go:embed example-secrets.toml
 	exampleSecrets string
 
-	docsTOML = coreTOML + chainsEVMTOML + chainsCosmosTOML + chainsSolanaTOML + chainsStarknetTOML
+	docsTOML = coreTOML + chainsEVMTOML + chainsCosmosTOML + chainsSolanaTOML + chainsStarknetTOML + chainsAptosTOML
 )
 
 // GenerateConfig returns MarkDown documentation generated from core.toml & chains-*.toml.
@@ -48,7 +50,7 @@ func GenerateConfig() (string, error) {
 This document describes the TOML format for configuration.
 
 See also [SECRETS.md](SECRETS.md)
-`, exampleConfig)
+`+interpolationDocs+reloadDocs+profileDocs, exampleConfig)
 }
 
 // GenerateSecrets returns MarkDown documentation generated from secrets.toml.
@@ -60,9 +62,71 @@ This document describes the TOML format for secrets.
 Each secret has an alternative corresponding environment variable.
 
 See also [CONFIG.md](CONFIG.md)
-`, exampleSecrets)
+`+interpolationDocs+secretsProviderDocs, exampleSecrets)
 }
 
+const interpolationDocs = `
+## Environment variables
+
+Values can reference environment variables, which are interpolated when the TOML is loaded:
+- ` + "`${NAME}`" + ` is replaced with the value of ` + "`NAME`" + `, and fails to start the node if ` + "`NAME`" + ` is not set.
+- ` + "`${NAME:-default}`" + ` is replaced with ` + "`default`" + ` if ` + "`NAME`" + ` is not set or empty.
+- ` + "`${NAME:?message}`" + ` fails to start the node with ` + "`message`" + ` if ` + "`NAME`" + ` is not set or empty.
+- ` + "`$${`" + ` is replaced with a literal ` + "`${`" + `.
+
+Values are escaped when referenced from strings, e.g. ` + "`URL = \"${DATABASE_URL}\"`" + `, and inserted verbatim elsewhere, e.g. ` + "`HTTPPort = ${PORT}`" + `. References in comments are ignored.
+`
+
+const secretsProviderDocs = `
+## Secret managers
+
+Values can also reference secrets stored in an external secret manager, which are fetched when the secrets TOML is loaded:
+- ` + "`${vault:<path>#<key>}`" + ` reads ` + "`<key>`" + ` of the HashiCorp Vault secret at the API path ` + "`<path>`" + `, like ` + "`secret/data/chainlink`" + ` for the ` + "`chainlink`" + ` secret of a KV v2 engine mounted at ` + "`secret`" + `. Vault is configured with ` + "`VAULT_ADDR`" + `, ` + "`VAULT_TOKEN`" + ` and optionally ` + "`VAULT_NAMESPACE`" + `.
+- ` + "`${aws:<name>}`" + ` reads the AWS Secrets Manager secret with the name or ARN ` + "`<name>`" + `. AWS is configured with ` + "`AWS_REGION`" + `, ` + "`AWS_ACCESS_KEY_ID`" + `, ` + "`AWS_SECRET_ACCESS_KEY`" + ` and optionally ` + "`AWS_SESSION_TOKEN`" + `.
+- ` + "`${gcp:<name>}`" + ` reads the Google Cloud Secret Manager secret ` + "`<name>`" + `, like ` + "`projects/my-project/secrets/chainlink`" + ` for its latest version. GCP is authenticated with ` + "`GOOGLE_OAUTH_ACCESS_TOKEN`" + `, or else with the service account of the instance.
+
+A ` + "`#<key>`" + ` suffix reads a key of a secret holding a JSON object, as Vault secrets always do. Each secret is fetched once, even if referenced by multiple keys. If the database rejects the fetched credentials at startup, e.g. because they were rotated, the secrets are fetched again and the connection is retried once.
+`
+
+const reloadDocs = `
+## Reloading
+
+The config and secrets files are reloaded on ` + "`SIGHUP`" + `, ` + "`POST /v2/config/reload`" + ` or ` + "`chainlink config reload`" + `. The changes since the files were last loaded are applied without restarting the node for:
+- ` + "`Log.Level`" + `
+- ` + "`Database.LogQueries`" + `
+- ` + "`Feature.UICSAKeys`" + `
+- ` + "`EVM.Nodes`" + `, where added and changed nodes are started before the removed ones are closed. The head lag and capability monitors keep using the nodes they started with.
+- ` + "`EVM.GasEstimator.PriceDefault`" + `, ` + "`PriceMin`" + `, ` + "`PriceMax`" + `, ` + "`BumpMin`" + ` and ` + "`LimitMax`" + `
+
+Other changes, including any change to the secrets, are reported but only take effect once the node is restarted. Each reload is recorded in the audit log with the changed fields, URLs redacted, and the re-initialized services.
+`
+
+const profileDocs = `
+## Profiles
+
+Chains sharing most of their settings can inherit them from a profile, instead of repeating them. A profile is an ` + "`[[EVMProfiles]]`" + ` entry with a unique ` + "`Name`" + ` and any of the fields of ` + "`[[EVM]]`" + `, except ` + "`ChainID`" + `, ` + "`Enabled`" + `, ` + "`Profile`" + ` and ` + "`Nodes`" + `. A chain inherits from the profile named by its ` + "`Profile`" + `, and its own fields override those of the profile, which override the defaults of its chain ID:
+` + "```toml" + `
+[[EVMProfiles]]
+Name = 'optimism-l2-defaults'
+FinalityDepth = 200
+LogPollInterval = '2s'
+
+[EVMProfiles.GasEstimator]
+Mode = 'SuggestedPrice'
+
+[[EVM]]
+ChainID = '10'
+Profile = 'optimism-l2-defaults'
+
+[[EVM]]
+ChainID = '8453'
+Profile = 'optimism-l2-defaults'
+FinalityDepth = 300
+` + "```" + `
+
+Profiles from multiple config files are merged by ` + "`Name`" + `. The effective configuration lists the fields each chain resolved from its profile, rather than the profiles.
+`
+
 // generateDocs returns MarkDown documentation generated from the TOML string.
 func generateDocs(toml, header, example string) (string, error) {
 	items, err := parseTOMLDocs(toml)
@@ -106,7 +170,7 @@ type table struct {
 
 func newTable(line string, desc lines) *table {
 	t := &table{
-		name:  strings.Trim(line, "[]"),
+		name:  strings.Trim(strings.Trim(line, fieldExample), "[]"),
 		codes: []string{line},
 		desc:  desc,
 	}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/config/docs"
@@ -59,7 +60,8 @@ func TestDoc(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
 		ks := evmcfg.KeySpecific{Key: new(ethkey.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei),
+				BumpStrategy: new(string), BumpPercent: new(uint16), BumpMin: new(assets.Wei)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
@@ -88,6 +90,18 @@ func TestDoc(t *testing.T) {
 		docDefaults.LinkContractAddress = nil
 		docDefaults.OperatorFactoryAddress = nil
 
+		// the alert webhook is optional
+		require.Zero(t, *docDefaults.VRFSubscriptionMonitor.AlertWebhookURL)
+		docDefaults.VRFSubscriptionMonitor.AlertWebhookURL = nil
+
+		// account abstraction is configured per chain
+		aa := &docDefaults.Transactions.AccountAbstraction
+		require.Zero(t, *aa.BundlerURL)
+		require.Zero(t, *aa.Account)
+		require.Zero(t, *aa.Paymaster)
+		require.Empty(t, *aa.PaymasterInput)
+		aa.BundlerURL, aa.Account, aa.Paymaster, aa.PaymasterInput = nil, nil, nil, nil
+
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
 
@@ -111,6 +125,13 @@ func TestDoc(t *testing.T) {
 
 		assertTOML(t, fallbackDefaults.Chain, defaults.Starknet[0].Chain)
 	})
+
+	t.Run("Aptos", func(t *testing.T) {
+		var fallbackDefaults aptos.TOMLConfig
+		fallbackDefaults.SetDefaults()
+
+		assertTOML(t, fallbackDefaults.ChainConfig, defaults.Aptos[0].ChainConfig)
+	})
 }
 
 func assertTOML[T any](t *testing.T, fallback, docs T) {
@@ -14,6 +14,9 @@ var (
 	MedianPluginCmd   = Var("CL_MEDIAN_CMD")
 	SolanaPluginCmd   = Var("CL_SOLANA_CMD")
 	StarknetPluginCmd = Var("CL_STARKNET_CMD")
+	AptosPluginCmd    = Var("CL_APTOS_CMD")
+	// JobPluginCmds are the commands of the plugins implementing job types, as comma separated name=cmd pairs.
+	JobPluginCmds = Var("CL_JOB_PLUGINS")
 	// PrometheusDiscoveryHostName is the externally accessible hostname
 	// published by the node in the `/discovery` endpoint. Generally, it is expected to match
 	// the public hostname of node.
@@ -33,6 +36,7 @@ var (
 	DatabaseAllowSimplePasswords = Var("CL_DATABASE_ALLOW_SIMPLE_PASSWORDS")
 	DatabaseURL                  = Secret("CL_DATABASE_URL")
 	DatabaseBackupURL            = Secret("CL_DATABASE_BACKUP_URL")
+	DatabaseReplicaURL           = Secret("CL_DATABASE_REPLICA_URL")
 	PasswordKeystore             = Secret("CL_PASSWORD_KEYSTORE")
 	PasswordVRF                  = Secret("CL_PASSWORD_VRF")
 	PyroscopeAuthToken           = Secret("CL_PYROSCOPE_AUTH_TOKEN")
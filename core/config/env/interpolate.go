@@ -0,0 +1,223 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"go.uber.org/multierr"
+)
+
+var nameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Interpolate replaces the environment variable references in the TOML document with their values:
+//   - ${NAME} is replaced with the value of NAME, and is an error if NAME is not set.
+//   - ${NAME:-default} is replaced with default if NAME is not set or empty.
+//   - ${NAME:?message} is an error with the message if NAME is not set or empty.
+//   - $${ is replaced with a literal ${.
+//
+// Values are escaped when referenced from strings, and inserted verbatim elsewhere. References in comments are left
+// as is. All the invalid references are returned as a single error.
+func Interpolate(toml string) (string, error) {
+	return interpolate(toml, os.LookupEnv, nil)
+}
+
+// Fetcher returns the secret referenced by ${provider:ref}, like ${vault:secret/data/chainlink#password}. ok is false
+// if there is no such provider.
+type Fetcher func(provider, ref string) (value string, ok bool, err error)
+
+// InterpolateSecrets is like Interpolate, but also replaces the references to the secrets of the providers of fetch.
+func InterpolateSecrets(toml string, fetch Fetcher) (string, error) {
+	return interpolate(toml, os.LookupEnv, fetch)
+}
+
+type tomlContext int
+
+const (
+	contextBare tomlContext = iota
+	contextComment
+	contextBasicString
+	contextMultilineBasicString
+	contextLiteralString
+	contextMultilineLiteralString
+)
+
+func interpolate(toml string, lookup func(string) (string, bool), fetch Fetcher) (string, error) {
+	if !strings.Contains(toml, "${") {
+		return toml, nil
+	}
+
+	var sb strings.Builder
+	var merr error
+	ctx, line := contextBare, 1
+	for i := 0; i < len(toml); {
+		rest := toml[i:]
+		if ctx != contextComment {
+			if strings.HasPrefix(rest, "$${") {
+				sb.WriteString("${")
+				i += 3
+				continue
+			}
+			if strings.HasPrefix(rest, "${") {
+				end := strings.IndexByte(firstLine(rest), '}')
+				if end == -1 {
+					merr = multierr.Append(merr, fmt.Errorf("line %d: unterminated reference %q", line, firstLine(rest)))
+					sb.WriteString("${")
+					i += 2
+					continue
+				}
+				value, err := resolve(rest[2:end], lookup, fetch)
+				if err == nil {
+					value, err = quote(ctx, value)
+				}
+				if err != nil {
+					merr = multierr.Append(merr, fmt.Errorf("line %d: %w", line, err))
+				}
+				sb.WriteString(value)
+				i += end + 1
+				continue
+			}
+		}
+
+		next := 1
+		switch ctx {
+		case contextBare:
+			switch {
+			case rest[0] == '#':
+				ctx = contextComment
+			case strings.HasPrefix(rest, `"""`):
+				ctx, next = contextMultilineBasicString, 3
+			case rest[0] == '"':
+				ctx = contextBasicString
+			case strings.HasPrefix(rest, "'''"):
+				ctx, next = contextMultilineLiteralString, 3
+			case rest[0] == '\'':
+				ctx = contextLiteralString
+			}
+		case contextComment:
+			if rest[0] == '\n' {
+				ctx = contextBare
+			}
+		case contextBasicString:
+			switch rest[0] {
+			case '\\':
+				next = min(2, len(rest))
+			case '"', '\n':
+				ctx = contextBare
+			}
+		case contextMultilineBasicString:
+			switch {
+			case rest[0] == '\\':
+				next = min(2, len(rest))
+			case strings.HasPrefix(rest, `"""`):
+				ctx, next = contextBare, 3
+			}
+		case contextLiteralString:
+			if rest[0] == '\'' || rest[0] == '\n' {
+				ctx = contextBare
+			}
+		case contextMultilineLiteralString:
+			if strings.HasPrefix(rest, "'''") {
+				ctx, next = contextBare, 3
+			}
+		}
+		line += strings.Count(rest[:next], "\n")
+		sb.WriteString(rest[:next])
+		i += next
+	}
+	return sb.String(), merr
+}
+
+// resolve returns the value of the reference, without its ${ and }.
+func resolve(ref string, lookup func(string) (string, bool), fetch Fetcher) (string, error) {
+	if provider, secret, ok := strings.Cut(ref, ":"); ok && fetch != nil && !strings.HasPrefix(secret, "-") && !strings.HasPrefix(secret, "?") {
+		if value, ok, err := fetch(provider, secret); ok {
+			return value, err
+		}
+	}
+	name, op, arg := ref, "", ""
+	if i := strings.Index(ref, ":"); i != -1 {
+		name, op = ref[:i], ref[i:min(i+2, len(ref))]
+		arg = ref[len(name)+len(op):]
+	}
+	if !nameRegex.MatchString(name) {
+		return "", fmt.Errorf("invalid reference ${%s}: invalid environment variable name %q", ref, name)
+	}
+
+	value, ok := lookup(name)
+	switch op {
+	case "":
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+	case ":-":
+		if value == "" {
+			value = arg
+		}
+	case ":?":
+		if value == "" {
+			if arg == "" {
+				arg = "required"
+			}
+			return "", fmt.Errorf("environment variable %s: %s", name, arg)
+		}
+	default:
+		return "", fmt.Errorf("invalid reference ${%s}: expected ${%s}, ${%s:-default} or ${%s:?message}", ref, name, name, name)
+	}
+	return value, nil
+}
+
+// quote returns the value escaped for the context it is inserted into.
+func quote(ctx tomlContext, value string) (string, error) {
+	switch ctx {
+	case contextBasicString, contextMultilineBasicString:
+		return escapeBasic(value), nil
+	case contextLiteralString:
+		if strings.ContainsAny(value, "'\r\n") {
+			return "", fmt.Errorf("value cannot be inserted into a literal string: contains a single quote or a newline")
+		}
+	case contextMultilineLiteralString:
+		if strings.Contains(value, "'''") {
+			return "", fmt.Errorf("value cannot be inserted into a multi-line literal string: contains '''")
+		}
+	case contextBare, contextComment:
+	}
+	return value, nil
+}
+
+func escapeBasic(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\r':
+			sb.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&sb, `\u%04X`, r)
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	return sb.String()
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i != -1 {
+		return s[:i]
+	}
+	return s
+}
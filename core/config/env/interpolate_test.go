@@ -0,0 +1,120 @@
+package env
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolate(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]string{
+		"URL":    "https://example.com",
+		"PORT":   "6689",
+		"EMPTY":  "",
+		"QUOTED": `pa"ss\word` + "\n",
+		"SINGLE": "it's",
+	}
+	lookup := func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+
+	for _, tc := range []struct {
+		name string
+		toml string
+		exp  string
+		err  string
+	}{
+		{
+			name: "none",
+			toml: "URL = 'https://example.com' # $ {",
+			exp:  "URL = 'https://example.com' # $ {",
+		},
+		{
+			name: "strings",
+			toml: `URL = "${URL}/path"` + "\nLiteral = '${URL}'\nMultiline = \"\"\"\n${URL}\"\"\"\nMultilineLiteral = '''${URL}'''",
+			exp:  `URL = "https://example.com/path"` + "\nLiteral = 'https://example.com'\nMultiline = \"\"\"\nhttps://example.com\"\"\"\nMultilineLiteral = '''https://example.com'''",
+		},
+		{
+			name: "bare",
+			toml: "HTTPPort = ${PORT}",
+			exp:  "HTTPPort = 6689",
+		},
+		{
+			name: "escaped",
+			toml: `Password = "${QUOTED}"`,
+			exp:  `Password = "pa\"ss\\word\n"`,
+		},
+		{
+			name: "default",
+			toml: `A = "${EMPTY:-a}"` + "\n" + `B = "${MISSING:-b}"` + "\n" + `C = "${URL:-c}"` + "\n" + `D = "${MISSING:-}"`,
+			exp:  `A = "a"` + "\n" + `B = "b"` + "\n" + `C = "https://example.com"` + "\n" + `D = ""`,
+		},
+		{
+			name: "required",
+			toml: `A = "${URL:?must be set}"` + "\n" + `B = "${EMPTY}"`,
+			exp:  `A = "https://example.com"` + "\n" + `B = ""`,
+		},
+		{
+			name: "literal dollar",
+			toml: `A = "$${URL}" # ${MISSING}` + "\n" + `B = "\"${URL}"`,
+			exp:  `A = "${URL}" # ${MISSING}` + "\n" + `B = "\"https://example.com"`,
+		},
+		{
+			name: "errors",
+			toml: `A = "${MISSING}"` + "\n" + `B = "${EMPTY:?set it}"` + "\n" + `C = "${MISSING:?}"` + "\n" + `D = "${1NAME}"` + "\n" +
+				`E = "${URL-x}"` + "\n" + `F = "${URL:+x}"` + "\n" + `G = '${SINGLE}'` + "\n" + `H = "${URL"`,
+			err: "line 1: environment variable MISSING is not set; line 2: environment variable EMPTY: set it; " +
+				"line 3: environment variable MISSING: required; " +
+				`line 4: invalid reference ${1NAME}: invalid environment variable name "1NAME"; ` +
+				`line 5: invalid reference ${URL-x}: invalid environment variable name "URL-x"; ` +
+				"line 6: invalid reference ${URL:+x}: expected ${URL}, ${URL:-default} or ${URL:?message}; " +
+				"line 7: value cannot be inserted into a literal string: contains a single quote or a newline; " +
+				`line 8: unterminated reference "${URL\""`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := interpolate(tc.toml, lookup, nil)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, got)
+		})
+	}
+}
+
+func TestInterpolateSecrets(t *testing.T) {
+	t.Parallel()
+
+	lookup := func(name string) (string, bool) {
+		if name == "URL" {
+			return "https://example.com", true
+		}
+		return "", false
+	}
+	fetch := func(provider, ref string) (string, bool, error) {
+		switch {
+		case provider != "vault":
+			return "", false, nil
+		case ref == "secret/data/chainlink#password":
+			return `pa"ss`, true, nil
+		}
+		return "", true, errors.New("not found")
+	}
+
+	got, err := interpolate(`A = "${vault:secret/data/chainlink#password}"`+"\n"+`B = "${URL}"`+"\n"+`C = "${URL:-x}"`, lookup, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, `A = "pa\"ss"`+"\n"+`B = "https://example.com"`+"\n"+`C = "https://example.com"`, got)
+
+	_, err = interpolate(`A = "${vault:secret/data/missing}"`+"\n"+`B = "${aws:name}"`, lookup, fetch)
+	assert.EqualError(t, err, "line 1: not found; line 2: invalid reference ${aws:name}: expected ${aws}, ${aws:-default} or ${aws:?message}")
+}
@@ -5,4 +5,5 @@ type Insecure interface {
 	OCRDevelopmentMode() bool
 	DisableRateLimiting() bool
 	InfiniteDepthQueries() bool
+	SimulatedEVMChains() bool
 }
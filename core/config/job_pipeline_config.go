@@ -1,6 +1,7 @@
 package config
 
 import (
+	"net"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -13,6 +14,37 @@ type JobPipeline interface {
 	MaxSuccessfulRuns() uint64
 	ReaperInterval() time.Duration
 	ReaperThreshold() time.Duration
+	ReplayOnChainWritesEnabled() bool
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
+	ExternalInitiatorGRPC() ExternalInitiatorGRPC
+	BridgeCircuitBreaker() BridgeCircuitBreaker
+	PolicyRules() []JobPolicyRule
+}
+
+// JobPolicyRule is a rule which job specs are checked against when jobs are created. See package policy.
+type JobPolicyRule interface {
+	Name() string
+	Expr() string
+	// Severity is "error" to reject violating job specs, or "warning" to only report them.
+	Severity() string
+	// JobTypes restricts the rule to jobs of these types, or applies it to all jobs if empty.
+	JobTypes() []string
+}
+
+type ExternalInitiatorGRPC interface {
+	Enabled() bool
+	ListenIP() net.IP
+	Port() uint16
+	CertPath() string
+	KeyPath() string
+	ClientCAPath() string
+	HeartbeatInterval() time.Duration
+}
+
+type BridgeCircuitBreaker interface {
+	Enabled() bool
+	FailureThreshold() uint32
+	LatencySLO() time.Duration
+	OpenTimeout() time.Duration
 }
@@ -0,0 +1,6 @@
+package config
+
+type Keystore interface {
+	MasterKeyFile() string
+	AWSKMSKeyID() string
+}
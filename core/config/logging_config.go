@@ -1,6 +1,9 @@
 package config
 
 import (
+	"net/url"
+	"time"
+
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -13,6 +16,19 @@ type File interface {
 	MaxBackups() int64
 }
 
+type LogSink interface {
+	Name() string
+	Type() string
+	URL() *url.URL
+	Level() zapcore.Level
+	Topic() string
+	BufferSize() uint32
+	BatchSize() uint32
+	FlushInterval() time.Duration
+	DropWhenFull() bool
+	Labels() map[string]string
+}
+
 type Log interface {
 	DefaultLevel() zapcore.Level
 	JSONConsole() bool
@@ -20,4 +36,5 @@ type Log interface {
 	UnixTimestamps() bool
 
 	File() File
+	Sinks() []LogSink
 }
@@ -9,6 +9,8 @@ import (
 	time "time"
 
 	url "net/url"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // TelemetryIngress is an autogenerated mock type for the TelemetryIngress type
@@ -144,6 +146,42 @@ func (_m *TelemetryIngress) ServerPubKey() string {
 	return r0
 }
 
+// SpillDir provides a mock function with given fields:
+func (_m *TelemetryIngress) SpillDir() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpillDir")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SpillMaxSize provides a mock function with given fields:
+func (_m *TelemetryIngress) SpillMaxSize() utils.FileSize {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpillMaxSize")
+	}
+
+	var r0 utils.FileSize
+	if rf, ok := ret.Get(0).(func() utils.FileSize); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(utils.FileSize)
+	}
+
+	return r0
+}
+
 // URL provides a mock function with given fields:
 func (_m *TelemetryIngress) URL() *url.URL {
 	ret := _m.Called()
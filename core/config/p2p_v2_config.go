@@ -9,6 +9,7 @@ import (
 type V2 interface {
 	Enabled() bool
 	AnnounceAddresses() []string
+	AnnounceAddressesRefreshInterval() commonconfig.Duration
 	DefaultBootstrappers() (locators []ocrcommontypes.BootstrapperLocator)
 	DeltaDial() commonconfig.Duration
 	DeltaReconcile() commonconfig.Duration
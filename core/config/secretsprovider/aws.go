@@ -0,0 +1,102 @@
+package secretsprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/cloudauth"
+)
+
+// AWS fetches secrets from AWS Secrets Manager. Paths are secret names or ARNs, and binary secrets are returned
+// as is.
+type AWS struct {
+	client *http.Client
+	region string
+	// endpoint overrides the regional endpoint.
+	endpoint string
+	creds    cloudauth.AWSCredentials
+	now      func() time.Time
+}
+
+// NewAWSFromEnv returns an AWS configured with the standard AWS_REGION (or AWS_DEFAULT_REGION), AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN and AWS_ENDPOINT_URL_SECRETS_MANAGER environment variables.
+func NewAWSFromEnv(client *http.Client) *AWS {
+	return &AWS{
+		client:   client,
+		region:   cloudauth.AWSRegionFromEnv(),
+		endpoint: os.Getenv("AWS_ENDPOINT_URL_SECRETS_MANAGER"),
+		creds:    cloudauth.AWSCredentialsFromEnv(),
+		now:      time.Now,
+	}
+}
+
+func (a *AWS) Fetch(ctx context.Context, path string) (string, error) {
+	region := a.region
+	// arn:aws:secretsmanager:<region>:<account>:secret:<name>
+	if parts := strings.Split(path, ":"); len(parts) > 3 && parts[0] == "arn" {
+		region = parts[3]
+	}
+	if region == "" {
+		return "", errors.New("AWS_REGION is not set")
+	}
+	if !a.creds.Valid() {
+		return "", errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+	}
+	endpoint := a.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", region)
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": path})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(endpoint, "/")+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	cloudauth.SignV4(req, body, a.creds, region, "secretsmanager", a.now())
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secrets manager responded with %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+
+	var secret struct {
+		SecretString *string
+		SecretBinary *string
+	}
+	if err = json.Unmarshal(b, &secret); err != nil {
+		return "", fmt.Errorf("failed to decode secrets manager response: %w", err)
+	}
+	switch {
+	case secret.SecretString != nil:
+		return *secret.SecretString, nil
+	case secret.SecretBinary != nil:
+		v, err := base64.StdEncoding.DecodeString(*secret.SecretBinary)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode binary secret: %w", err)
+		}
+		return string(v), nil
+	}
+	return "", errors.New("secret has no value")
+}
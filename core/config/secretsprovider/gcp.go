@@ -0,0 +1,83 @@
+package secretsprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/cloudauth"
+)
+
+// GCP fetches secrets from Google Cloud Secret Manager. Paths are secret or secret version names, like
+// projects/my-project/secrets/chainlink, which resolves to its latest version.
+type GCP struct {
+	client *http.Client
+	// endpoint overrides the Secret Manager endpoint.
+	endpoint string
+	tokens   *cloudauth.GCPTokenSource
+}
+
+// NewGCPFromEnv returns a GCP authenticated with the GOOGLE_OAUTH_ACCESS_TOKEN environment variable if set, or else
+// with the service account of the instance, from the metadata server at GCE_METADATA_HOST.
+func NewGCPFromEnv(client *http.Client) *GCP {
+	return &GCP{
+		client:   client,
+		endpoint: "https://secretmanager.googleapis.com",
+		tokens:   cloudauth.NewGCPTokenSourceFromEnv(client),
+	}
+}
+
+func (g *GCP) Fetch(ctx context.Context, path string) (string, error) {
+	if !strings.Contains(path, "/versions/") {
+		path += "/versions/latest"
+	}
+	secret, status, err := g.access(ctx, path)
+	if (status == http.StatusUnauthorized || status == http.StatusForbidden) && !g.tokens.Static() {
+		// The cached token may have been revoked, so retry once with a new one.
+		g.tokens.Invalidate()
+		secret, _, err = g.access(ctx, path)
+	}
+	return secret, err
+}
+
+func (g *GCP) access(ctx context.Context, path string) (secret string, status int, err error) {
+	token, err := g.tokens.Token(ctx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get access token: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(g.endpoint, "/")+"/v1/"+strings.TrimLeft(path, "/")+":access", nil)
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", resp.StatusCode, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", resp.StatusCode, fmt.Errorf("secret manager responded with %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+
+	var body struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err = json.Unmarshal(b, &body); err != nil {
+		return "", resp.StatusCode, fmt.Errorf("failed to decode secret manager response: %w", err)
+	}
+	v, err := base64.StdEncoding.DecodeString(body.Payload.Data)
+	if err != nil {
+		return "", resp.StatusCode, fmt.Errorf("failed to decode secret payload: %w", err)
+	}
+	return string(v), resp.StatusCode, nil
+}
@@ -0,0 +1,116 @@
+// Package secretsprovider fetches the secrets referenced from the secrets TOML, like ${vault:secret/data/chainlink#password},
+// from external secret managers.
+package secretsprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout bounds the fetch of each secret.
+const DefaultTimeout = 30 * time.Second
+
+// Provider fetches secrets from an external secret manager.
+type Provider interface {
+	// Fetch returns the secret at path, as stored by the secret manager.
+	Fetch(ctx context.Context, path string) (string, error)
+}
+
+// Resolver fetches secrets from providers by name, and caches them so that each secret is fetched once, even if
+// referenced multiple times.
+type Resolver struct {
+	providers map[string]Provider
+	timeout   time.Duration
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// NewResolver returns a Resolver for the providers, keyed by the name used in references.
+func NewResolver(providers map[string]Provider) *Resolver {
+	return &Resolver{providers: providers, timeout: DefaultTimeout, cache: make(map[string]string)}
+}
+
+// NewDefaultResolver returns a Resolver for the vault, aws and gcp providers, configured from the environment.
+// Providers are only checked for configuration once referenced.
+func NewDefaultResolver() *Resolver {
+	return NewResolver(map[string]Provider{
+		"vault": NewVaultFromEnv(http.DefaultClient),
+		"aws":   NewAWSFromEnv(http.DefaultClient),
+		"gcp":   NewGCPFromEnv(http.DefaultClient),
+	})
+}
+
+// Fetch returns the secret referenced by ref, as path#key, from the named provider. If key is set, the secret must be
+// a JSON object and the value of key is returned. ok is false if there is no such provider.
+func (r *Resolver) Fetch(provider, ref string) (value string, ok bool, err error) {
+	p, ok := r.providers[provider]
+	if !ok {
+		return "", false, nil
+	}
+	path, key, hasKey := strings.Cut(ref, "#")
+	if path == "" {
+		return "", true, fmt.Errorf("invalid reference ${%s:%s}: empty path", provider, ref)
+	}
+
+	cacheKey := provider + ":" + path
+	r.mu.Lock()
+	secret, cached := r.cache[cacheKey]
+	r.mu.Unlock()
+	if !cached {
+		ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+		defer cancel()
+		secret, err = p.Fetch(ctx, path)
+		if err != nil {
+			return "", true, fmt.Errorf("failed to fetch secret %s from %s: %w", path, provider, err)
+		}
+		r.mu.Lock()
+		r.cache[cacheKey] = secret
+		r.mu.Unlock()
+	}
+
+	if !hasKey {
+		return secret, true, nil
+	}
+	value, err = jsonKey(secret, key)
+	if err != nil {
+		return "", true, fmt.Errorf("secret %s from %s: %w", path, provider, err)
+	}
+	return value, true, nil
+}
+
+// Fetched returns true if any secret was fetched since the last Refresh.
+func (r *Resolver) Fetched() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.cache) > 0
+}
+
+// Refresh drops the cached secrets, so that they are fetched again, e.g. after being rejected because they were rotated.
+func (r *Resolver) Refresh() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache = make(map[string]string)
+}
+
+// jsonKey returns the value of key in the JSON object secret. Values which are not strings are returned as JSON.
+func jsonKey(secret, key string) (string, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(secret), &m); err != nil {
+		return "", fmt.Errorf("cannot read key %q: not a JSON object", key)
+	}
+	raw, ok := m[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found", key)
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	return string(raw), nil
+}
@@ -0,0 +1,193 @@
+package secretsprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/cloudauth"
+)
+
+type fakeProvider map[string]string
+
+func (f fakeProvider) Fetch(ctx context.Context, path string) (string, error) {
+	s, ok := f[path]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	delete(f, path)
+	return s, nil
+}
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	p := fakeProvider{"db": `{"user":"chainlink","password":"p4ss","port":5432}`, "token": "t0ken"}
+	r := NewResolver(map[string]Provider{"fake": p})
+
+	_, ok, err := r.Fetch("other", "db#password")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, r.Fetched())
+
+	for key, exp := range map[string]string{"password": "p4ss", "user": "chainlink", "port": "5432"} {
+		v, ok, err := r.Fetch("fake", "db#"+key)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, exp, v)
+	}
+	v, _, err := r.Fetch("fake", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "t0ken", v)
+	assert.True(t, r.Fetched())
+
+	_, _, err = r.Fetch("fake", "db#missing")
+	assert.EqualError(t, err, `secret db from fake: key "missing" not found`)
+	_, _, err = r.Fetch("fake", "token#key")
+	assert.EqualError(t, err, `secret token from fake: cannot read key "key": not a JSON object`)
+
+	// the fake provider serves each secret once, so fetches after a refresh fail
+	r.Refresh()
+	assert.False(t, r.Fetched())
+	_, _, err = r.Fetch("fake", "db#password")
+	assert.EqualError(t, err, "failed to fetch secret db from fake: not found")
+}
+
+func TestVault(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" || r.Header.Get("X-Vault-Namespace") != "ns" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/chainlink":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"p4ss"},"metadata":{"version":3}}}`))
+		case "/v1/kv/chainlink":
+			_, _ = w.Write([]byte(`{"data":{"password":"p4ss"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	v := &Vault{client: srv.Client(), addr: srv.URL, token: "root", namespace: "ns"}
+	for _, path := range []string{"secret/data/chainlink", "kv/chainlink"} {
+		s, err := v.Fetch(context.Background(), path)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"password":"p4ss"}`, s)
+	}
+	_, err := v.Fetch(context.Background(), "secret/data/missing")
+	assert.EqualError(t, err, `vault responded with 404 Not Found: {"errors":[]}`)
+
+	v.token = "expired"
+	_, err = v.Fetch(context.Background(), "secret/data/chainlink")
+	assert.EqualError(t, err, `vault responded with 403 Forbidden: {"errors":["permission denied"]}`)
+
+	_, err = (&Vault{}).Fetch(context.Background(), "secret/data/chainlink")
+	assert.EqualError(t, err, "VAULT_ADDR is not set")
+}
+
+func TestAWS(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(auth, "/eu-west-1/secretsmanager/aws4_request") ||
+			r.Header.Get("X-Amz-Security-Token") != "session" || r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"UnrecognizedClientException"}`))
+			return
+		}
+		var body struct{ SecretId string }
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch body.SecretId {
+		case "prod/chainlink":
+			_, _ = w.Write([]byte(`{"Name":"prod/chainlink","SecretString":"{\"password\":\"p4ss\"}"}`))
+		case "arn:aws:secretsmanager:eu-west-1:123456789012:secret:binary":
+			_, _ = w.Write([]byte(`{"Name":"binary","SecretBinary":"` + base64.StdEncoding.EncodeToString([]byte("b1n")) + `"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	a := &AWS{client: srv.Client(), region: "eu-west-1", endpoint: srv.URL, now: time.Now,
+		creds: cloudauth.AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "session"}}
+	s, err := a.Fetch(context.Background(), "prod/chainlink")
+	require.NoError(t, err)
+	assert.Equal(t, `{"password":"p4ss"}`, s)
+
+	a.region = ""
+	s, err = a.Fetch(context.Background(), "arn:aws:secretsmanager:eu-west-1:123456789012:secret:binary")
+	require.NoError(t, err)
+	assert.Equal(t, "b1n", s)
+
+	_, err = a.Fetch(context.Background(), "missing")
+	assert.EqualError(t, err, "AWS_REGION is not set")
+	a.region = "eu-west-1"
+	_, err = a.Fetch(context.Background(), "missing")
+	assert.EqualError(t, err, `secrets manager responded with 400 Bad Request: {"__type":"ResourceNotFoundException"}`)
+}
+
+func TestGCP(t *testing.T) {
+	t.Parallel()
+
+	var tokens int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/computeMetadata/v1/instance/service-accounts/default/token" {
+			if r.Header.Get("Metadata-Flavor") != "Google" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			tokens++
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": []string{"revoked", "valid"}[min(tokens-1, 1)], "expires_in": 3600})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/projects/p/secrets/chainlink/versions/latest:access", "/v1/projects/p/secrets/chainlink/versions/2:access":
+			_, _ = w.Write([]byte(`{"payload":{"data":"` + base64.StdEncoding.EncodeToString([]byte("p4ss")) + `"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	g := &GCP{client: srv.Client(), endpoint: srv.URL, tokens: cloudauth.NewGCPTokenSource(srv.Client(), "", strings.TrimPrefix(srv.URL, "http://"))}
+	s, err := g.Fetch(context.Background(), "projects/p/secrets/chainlink")
+	require.NoError(t, err)
+	assert.Equal(t, "p4ss", s)
+	assert.Equal(t, 2, tokens, "revoked token is replaced")
+
+	s, err = g.Fetch(context.Background(), "projects/p/secrets/chainlink/versions/2")
+	require.NoError(t, err)
+	assert.Equal(t, "p4ss", s)
+	assert.Equal(t, 2, tokens, "token is cached")
+
+	_, err = g.Fetch(context.Background(), "projects/p/secrets/missing")
+	assert.EqualError(t, err, "secret manager responded with 404 Not Found: ")
+
+	g.tokens = cloudauth.NewGCPTokenSource(srv.Client(), "static", "")
+	_, err = g.Fetch(context.Background(), "projects/p/secrets/chainlink")
+	assert.EqualError(t, err, "secret manager responded with 401 Unauthorized: ")
+}
@@ -0,0 +1,86 @@
+package secretsprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Vault fetches secrets from the HashiCorp Vault HTTP API. Paths are API paths, like secret/data/chainlink for the
+// chainlink secret of a KV v2 engine mounted at secret, and their data is returned as a JSON object.
+type Vault struct {
+	client    *http.Client
+	addr      string
+	token     string
+	namespace string
+}
+
+// NewVaultFromEnv returns a Vault configured with the standard VAULT_ADDR, VAULT_TOKEN and VAULT_NAMESPACE
+// environment variables.
+func NewVaultFromEnv(client *http.Client) *Vault {
+	return &Vault{
+		client:    client,
+		addr:      os.Getenv("VAULT_ADDR"),
+		token:     os.Getenv("VAULT_TOKEN"),
+		namespace: os.Getenv("VAULT_NAMESPACE"),
+	}
+}
+
+func (v *Vault) Fetch(ctx context.Context, path string) (string, error) {
+	if v.addr == "" {
+		return "", errors.New("VAULT_ADDR is not set")
+	}
+	if v.token == "" {
+		return "", errors.New("VAULT_TOKEN is not set")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(v.addr, "/")+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault responded with %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+
+	var body struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err = json.Unmarshal(b, &body); err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	data := body.Data
+	// KV v2 nests the secret under data, next to its metadata.
+	if nested, ok := data["data"]; ok {
+		if _, ok = data["metadata"]; ok {
+			data = nil
+			if err = json.Unmarshal(nested, &data); err != nil {
+				return "", fmt.Errorf("failed to decode vault secret: %w", err)
+			}
+		}
+	}
+	if data == nil {
+		return "", errors.New("vault secret has no data")
+	}
+	b, err = json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 //go:generate mockery --quiet --name TelemetryIngress --output ./mocks/ --case=underscore --filename telemetry_ingress.go
@@ -15,6 +17,8 @@ type TelemetryIngress interface {
 	SendInterval() time.Duration
 	SendTimeout() time.Duration
 	UseBatchSend() bool
+	SpillMaxSize() utils.FileSize
+	SpillDir() string
 	Endpoints() []TelemetryIngressEndpoint
 
 	ServerPubKey() string // Deprecated: Use TelemetryIngressEndpoint.ServerPubKey instead, this field will be removed in future versions
@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/policy"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -55,6 +59,8 @@ type Core struct {
 	Insecure         Insecure         `toml:",omitempty"`
 	Tracing          Tracing          `toml:",omitempty"`
 	Mercury          Mercury          `toml:",omitempty"`
+	BalanceMonitor   BalanceMonitor   `toml:",omitempty"`
+	Keystore         Keystore         `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -90,6 +96,8 @@ func (c *Core) SetFrom(f *Core) {
 	c.Sentry.setFrom(&f.Sentry)
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
+	c.BalanceMonitor.setFrom(&f.BalanceMonitor)
+	c.Keystore.setFrom(&f.Keystore)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -118,6 +126,7 @@ func dbURLPasswordComplexity(err error) string {
 type DatabaseSecrets struct {
 	URL                  *models.SecretURL
 	BackupURL            *models.SecretURL
+	ReplicaURL           *models.SecretURL
 	AllowSimplePasswords *bool
 }
 
@@ -165,6 +174,11 @@ func (d *DatabaseSecrets) validateConfig(buildMode string) (err error) {
 			err = multierr.Append(err, configutils.ErrInvalid{Name: "BackupURL", Value: "*****", Msg: dbURLPasswordComplexity(verr)})
 		}
 	}
+	if d.ReplicaURL != nil && !*d.AllowSimplePasswords {
+		if verr := validateDBURL((url.URL)(*d.ReplicaURL)); verr != nil {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "ReplicaURL", Value: "*****", Msg: dbURLPasswordComplexity(verr)})
+		}
+	}
 	return err
 }
 
@@ -180,6 +194,9 @@ func (d *DatabaseSecrets) SetFrom(f *DatabaseSecrets) (err error) {
 	if v := f.BackupURL; v != nil {
 		d.BackupURL = v
 	}
+	if v := f.ReplicaURL; v != nil {
+		d.ReplicaURL = v
+	}
 	if v := f.URL; v != nil {
 		d.URL = v
 	}
@@ -195,6 +212,10 @@ func (d *DatabaseSecrets) validateMerge(f *DatabaseSecrets) (err error) {
 		err = multierr.Append(err, configutils.ErrOverride{Name: "BackupURL"})
 	}
 
+	if d.ReplicaURL != nil && f.ReplicaURL != nil {
+		err = multierr.Append(err, configutils.ErrOverride{Name: "ReplicaURL"})
+	}
+
 	if d.URL != nil && f.URL != nil {
 		err = multierr.Append(err, configutils.ErrOverride{Name: "URL"})
 	}
@@ -320,9 +341,12 @@ type Database struct {
 	MaxOpenConns                  *int64
 	MigrateOnStartup              *bool
 
-	Backup   DatabaseBackup   `toml:",omitempty"`
-	Listener DatabaseListener `toml:",omitempty"`
-	Lock     DatabaseLock     `toml:",omitempty"`
+	Backup      DatabaseBackup      `toml:",omitempty"`
+	Listener    DatabaseListener    `toml:",omitempty"`
+	Lock        DatabaseLock        `toml:",omitempty"`
+	Maintenance DatabaseMaintenance `toml:",omitempty"`
+	Partitions  DatabasePartitions  `toml:",omitempty"`
+	Replica     DatabaseReplica     `toml:",omitempty"`
 }
 
 func (d *Database) setFrom(f *Database) {
@@ -351,6 +375,9 @@ func (d *Database) setFrom(f *Database) {
 	d.Backup.setFrom(&f.Backup)
 	d.Listener.setFrom(&f.Listener)
 	d.Lock.setFrom(&f.Lock)
+	d.Maintenance.setFrom(&f.Maintenance)
+	d.Partitions.setFrom(&f.Partitions)
+	d.Replica.setFrom(&f.Replica)
 }
 
 type DatabaseListener struct {
@@ -375,6 +402,7 @@ type DatabaseLock struct {
 	Enabled              *bool
 	LeaseDuration        *commonconfig.Duration
 	LeaseRefreshInterval *commonconfig.Duration
+	WarmStandby          *bool
 }
 
 func (l *DatabaseLock) Mode() string {
@@ -402,6 +430,174 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 	if v := f.LeaseRefreshInterval; v != nil {
 		l.LeaseRefreshInterval = v
 	}
+	if v := f.WarmStandby; v != nil {
+		l.WarmStandby = v
+	}
+}
+
+type DatabaseMaintenance struct {
+	Enabled   *bool
+	Interval  *commonconfig.Duration
+	Window    *config.TimeWindow
+	BatchSize *uint32
+
+	PipelineRuns MaintenancePolicy `toml:",omitempty"`
+	Logs         MaintenancePolicy `toml:",omitempty"`
+	Transactions MaintenancePolicy `toml:",omitempty"`
+	Heads        MaintenancePolicy `toml:",omitempty"`
+	Archive      DatabaseArchive   `toml:",omitempty"`
+}
+
+func (m *DatabaseMaintenance) ValidateConfig() (err error) {
+	if m.Interval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Interval", Value: m.Interval.String(),
+			Msg: "must be greater than zero"})
+	} else if m.Window != nil && !m.Window.IsZero() && m.Window.Duration() < m.Interval.Duration() {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Window", Value: m.Window.String(),
+			Msg: fmt.Sprintf("must be at least as long as Interval (%s)", m.Interval.String())})
+	}
+	if m.BatchSize != nil && *m.BatchSize == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "BatchSize", Value: *m.BatchSize,
+			Msg: "must be greater than zero"})
+	}
+	return
+}
+
+func (m *DatabaseMaintenance) setFrom(f *DatabaseMaintenance) {
+	if v := f.Enabled; v != nil {
+		m.Enabled = v
+	}
+	if v := f.Interval; v != nil {
+		m.Interval = v
+	}
+	if v := f.Window; v != nil {
+		m.Window = v
+	}
+	if v := f.BatchSize; v != nil {
+		m.BatchSize = v
+	}
+	m.PipelineRuns.setFrom(&f.PipelineRuns)
+	m.Logs.setFrom(&f.Logs)
+	m.Transactions.setFrom(&f.Transactions)
+	m.Heads.setFrom(&f.Heads)
+	m.Archive.setFrom(&f.Archive)
+}
+
+// MaintenancePolicy is the retention policy of a table.
+type MaintenancePolicy struct {
+	Retention *commonconfig.Duration
+	Vacuum    *bool
+}
+
+func (p *MaintenancePolicy) setFrom(f *MaintenancePolicy) {
+	if v := f.Retention; v != nil {
+		p.Retention = v
+	}
+	if v := f.Vacuum; v != nil {
+		p.Vacuum = v
+	}
+}
+
+// DatabaseArchive configures the object store which pruned pipeline runs are archived to.
+type DatabaseArchive struct {
+	Enabled  *bool
+	URL      *commonconfig.URL
+	Endpoint *commonconfig.URL
+}
+
+func (a *DatabaseArchive) ValidateConfig() (err error) {
+	if a.Enabled == nil || !*a.Enabled {
+		return
+	}
+	if a.URL.IsZero() {
+		return configutils.ErrMissing{Name: "URL", Msg: "must be set when Archive is enabled"}
+	}
+	switch scheme := a.URL.URL().Scheme; scheme {
+	case "s3", "gs", "file":
+	default:
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "URL", Value: a.URL.String(),
+			Msg: "must be an s3://, gs:// or file:// URL"})
+	}
+	return
+}
+
+func (a *DatabaseArchive) setFrom(f *DatabaseArchive) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.URL; v != nil {
+		a.URL = v
+	}
+	if v := f.Endpoint; v != nil {
+		a.Endpoint = v
+	}
+}
+
+type DatabasePartitions struct {
+	CheckInterval        *commonconfig.Duration
+	Premake              *uint32
+	LogsBlockRange       *uint64
+	PipelineRunsInterval *commonconfig.Duration
+}
+
+func (p *DatabasePartitions) ValidateConfig() (err error) {
+	if p.CheckInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "CheckInterval", Value: p.CheckInterval.String(),
+			Msg: "must be greater than zero"})
+	}
+	if p.Premake != nil && *p.Premake == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Premake", Value: *p.Premake,
+			Msg: "must be greater than zero"})
+	}
+	if p.LogsBlockRange != nil && *p.LogsBlockRange == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "LogsBlockRange", Value: *p.LogsBlockRange,
+			Msg: "must be greater than zero"})
+	}
+	if p.PipelineRunsInterval.Duration() < time.Hour {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PipelineRunsInterval", Value: p.PipelineRunsInterval.String(),
+			Msg: "must be at least 1h"})
+	}
+	return
+}
+
+func (p *DatabasePartitions) setFrom(f *DatabasePartitions) {
+	if v := f.CheckInterval; v != nil {
+		p.CheckInterval = v
+	}
+	if v := f.Premake; v != nil {
+		p.Premake = v
+	}
+	if v := f.LogsBlockRange; v != nil {
+		p.LogsBlockRange = v
+	}
+	if v := f.PipelineRunsInterval; v != nil {
+		p.PipelineRunsInterval = v
+	}
+}
+
+// DatabaseReplica
+//
+// Note: url is stored in Secrets.DatabaseReplicaURL
+type DatabaseReplica struct {
+	MaxLag           *commonconfig.Duration
+	LagCheckInterval *commonconfig.Duration
+}
+
+func (r *DatabaseReplica) ValidateConfig() (err error) {
+	if r.LagCheckInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "LagCheckInterval", Value: r.LagCheckInterval.String(),
+			Msg: "must be greater than zero"})
+	}
+	return
+}
+
+func (r *DatabaseReplica) setFrom(f *DatabaseReplica) {
+	if v := f.MaxLag; v != nil {
+		r.MaxLag = v
+	}
+	if v := f.LagCheckInterval; v != nil {
+		r.LagCheckInterval = v
+	}
 }
 
 // DatabaseBackup
@@ -437,6 +633,8 @@ type TelemetryIngress struct {
 	SendInterval *commonconfig.Duration
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
+	SpillMaxSize *utils.FileSize
+	SpillDir     *string
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
 
 	URL          *commonconfig.URL `toml:",omitempty"` // Deprecated: Use TelemetryIngressEndpoint.URL instead, this field will be removed in future versions
@@ -472,6 +670,12 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	if v := f.SpillMaxSize; v != nil {
+		t.SpillMaxSize = v
+	}
+	if v := f.SpillDir; v != nil {
+		t.SpillDir = v
+	}
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
@@ -557,7 +761,8 @@ type Log struct {
 	JSONConsole *bool
 	UnixTS      *bool
 
-	File LogFile `toml:",omitempty"`
+	File  LogFile   `toml:",omitempty"`
+	Sinks []LogSink `toml:",omitempty"`
 }
 
 func (l *Log) setFrom(f *Log) {
@@ -571,6 +776,61 @@ func (l *Log) setFrom(f *Log) {
 		l.UnixTS = v
 	}
 	l.File.setFrom(&f.File)
+	if v := f.Sinks; v != nil {
+		l.Sinks = v
+	}
+}
+
+func (l *Log) ValidateConfig() (err error) {
+	names := make(map[string]struct{}, len(l.Sinks))
+	for i, s := range l.Sinks {
+		if s.Name == nil || *s.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Sinks[%d].Name", i), Msg: "required for all sinks"})
+		} else if _, ok := names[*s.Name]; ok {
+			err = multierr.Append(err, configutils.NewErrDuplicate(fmt.Sprintf("Sinks[%d].Name", i), *s.Name))
+		} else {
+			names[*s.Name] = struct{}{}
+		}
+		if s.Type == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Sinks[%d].Type", i), Msg: "must be one of: loki, kafka, otlp"})
+		} else {
+			switch *s.Type {
+			case "loki", "otlp":
+			case "kafka":
+				if s.Topic == nil || *s.Topic == "" {
+					err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Sinks[%d].Topic", i), Msg: "required for kafka sinks"})
+				}
+			default:
+				err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Sinks[%d].Type", i), Value: *s.Type, Msg: "must be one of: loki, kafka, otlp"})
+			}
+		}
+		if s.URL == nil || s.URL.IsZero() {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Sinks[%d].URL", i), Msg: "required for all sinks"})
+		}
+		if s.BufferSize != nil && *s.BufferSize == 0 {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Sinks[%d].BufferSize", i), Value: *s.BufferSize, Msg: "must be greater than zero"})
+		}
+		if s.BatchSize != nil && *s.BatchSize == 0 {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Sinks[%d].BatchSize", i), Value: *s.BatchSize, Msg: "must be greater than zero"})
+		}
+		if s.FlushInterval != nil && s.FlushInterval.Duration() <= 0 {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Sinks[%d].FlushInterval", i), Value: s.FlushInterval.String(), Msg: "must be greater than zero"})
+		}
+	}
+	return
+}
+
+type LogSink struct {
+	Name          *string
+	Type          *string
+	URL           *commonconfig.URL
+	Level         *LogLevel
+	Topic         *string
+	BufferSize    *uint32
+	BatchSize     *uint32
+	FlushInterval *commonconfig.Duration
+	DropWhenFull  *bool
+	Labels        map[string]string `toml:",omitempty"`
 }
 
 type LogFile struct {
@@ -609,10 +869,12 @@ type WebServer struct {
 	StartTimeout            *commonconfig.Duration
 	ListenIP                *net.IP
 
-	LDAP      WebServerLDAP      `toml:",omitempty"`
-	MFA       WebServerMFA       `toml:",omitempty"`
-	RateLimit WebServerRateLimit `toml:",omitempty"`
-	TLS       WebServerTLS       `toml:",omitempty"`
+	LDAP         WebServerLDAP         `toml:",omitempty"`
+	MFA          WebServerMFA          `toml:",omitempty"`
+	RateLimit    WebServerRateLimit    `toml:",omitempty"`
+	PublicStatus WebServerPublicStatus `toml:",omitempty"`
+	RouteLimits  WebServerRouteLimits  `toml:",omitempty"`
+	TLS          WebServerTLS          `toml:",omitempty"`
 }
 
 func (w *WebServer) setFrom(f *WebServer) {
@@ -656,6 +918,8 @@ func (w *WebServer) setFrom(f *WebServer) {
 	w.LDAP.setFrom(&f.LDAP)
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
+	w.PublicStatus.setFrom(&f.PublicStatus)
+	w.RouteLimits.setFrom(&f.RouteLimits)
 	w.TLS.setFrom(&f.TLS)
 }
 
@@ -694,8 +958,9 @@ func (w *WebServer) ValidateConfig() (err error) {
 }
 
 type WebServerMFA struct {
-	RPID     *string
-	RPOrigin *string
+	RPID            *string
+	RPOrigin        *string
+	RequiredForRole *string
 }
 
 func (w *WebServerMFA) setFrom(f *WebServerMFA) {
@@ -705,6 +970,19 @@ func (w *WebServerMFA) setFrom(f *WebServerMFA) {
 	if v := f.RPOrigin; v != nil {
 		w.RPOrigin = v
 	}
+	if v := f.RequiredForRole; v != nil {
+		w.RequiredForRole = v
+	}
+}
+
+func (w *WebServerMFA) ValidateConfig() (err error) {
+	if w.RequiredForRole == nil || *w.RequiredForRole == "" {
+		return
+	}
+	if _, rerr := sessions.GetUserRole(*w.RequiredForRole); rerr != nil {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "RequiredForRole", Value: *w.RequiredForRole, Msg: rerr.Error()})
+	}
+	return
 }
 
 type WebServerRateLimit struct {
@@ -729,6 +1007,89 @@ func (w *WebServerRateLimit) setFrom(f *WebServerRateLimit) {
 	}
 }
 
+type WebServerPublicStatus struct {
+	Enabled         *bool
+	RateLimit       *int64
+	RateLimitPeriod *commonconfig.Duration
+}
+
+func (w *WebServerPublicStatus) setFrom(f *WebServerPublicStatus) {
+	if v := f.Enabled; v != nil {
+		w.Enabled = v
+	}
+	if v := f.RateLimit; v != nil {
+		w.RateLimit = v
+	}
+	if v := f.RateLimitPeriod; v != nil {
+		w.RateLimitPeriod = v
+	}
+}
+
+type WebServerRouteLimits struct {
+	TrustedProxies *[]string
+
+	Sessions         WebServerRouteLimit `toml:",omitempty"`
+	GraphQLMutations WebServerRouteLimit `toml:",omitempty"`
+	RunTriggers      WebServerRouteLimit `toml:",omitempty"`
+}
+
+func (w *WebServerRouteLimits) setFrom(f *WebServerRouteLimits) {
+	if v := f.TrustedProxies; v != nil {
+		w.TrustedProxies = v
+	}
+	w.Sessions.setFrom(&f.Sessions)
+	w.GraphQLMutations.setFrom(&f.GraphQLMutations)
+	w.RunTriggers.setFrom(&f.RunTriggers)
+}
+
+func (w *WebServerRouteLimits) ValidateConfig() (err error) {
+	if w.TrustedProxies != nil {
+		err = multierr.Append(err, validateCIDRs("TrustedProxies", *w.TrustedProxies))
+	}
+	return
+}
+
+// WebServerRouteLimit is the IP allow-list and the rate limit of a route.
+type WebServerRouteLimit struct {
+	AllowedCIDRs    *[]string
+	RateLimit       *int64
+	RateLimitPeriod *commonconfig.Duration
+}
+
+func (w *WebServerRouteLimit) setFrom(f *WebServerRouteLimit) {
+	if v := f.AllowedCIDRs; v != nil {
+		w.AllowedCIDRs = v
+	}
+	if v := f.RateLimit; v != nil {
+		w.RateLimit = v
+	}
+	if v := f.RateLimitPeriod; v != nil {
+		w.RateLimitPeriod = v
+	}
+}
+
+func (w *WebServerRouteLimit) ValidateConfig() (err error) {
+	if w.AllowedCIDRs != nil {
+		err = multierr.Append(err, validateCIDRs("AllowedCIDRs", *w.AllowedCIDRs))
+	}
+	if w.RateLimit != nil && *w.RateLimit < 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "RateLimit", Value: *w.RateLimit, Msg: "must not be negative"})
+	}
+	if w.RateLimit != nil && *w.RateLimit > 0 && w.RateLimitPeriod != nil && w.RateLimitPeriod.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "RateLimitPeriod", Value: *w.RateLimitPeriod, Msg: "must be positive when RateLimit is set"})
+	}
+	return
+}
+
+func validateCIDRs(name string, cidrs []string) (err error) {
+	for i, cidr := range cidrs {
+		if _, perr := netip.ParsePrefix(cidr); perr != nil {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("%s[%d]", name, i), Value: cidr, Msg: perr.Error()})
+		}
+	}
+	return
+}
+
 type WebServerTLS struct {
 	CertPath      *string
 	ForceRedirect *bool
@@ -864,14 +1225,18 @@ func (w *WebServerSecrets) SetFrom(f *WebServerSecrets) error {
 }
 
 type JobPipeline struct {
-	ExternalInitiatorsEnabled *bool
-	MaxRunDuration            *commonconfig.Duration
-	MaxSuccessfulRuns         *uint64
-	ReaperInterval            *commonconfig.Duration
-	ReaperThreshold           *commonconfig.Duration
-	ResultWriteQueueDepth     *uint32
+	ExternalInitiatorsEnabled  *bool
+	MaxRunDuration             *commonconfig.Duration
+	MaxSuccessfulRuns          *uint64
+	ReaperInterval             *commonconfig.Duration
+	ReaperThreshold            *commonconfig.Duration
+	ReplayOnChainWritesEnabled *bool
+	ResultWriteQueueDepth      *uint32
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest           JobPipelineHTTPRequest           `toml:",omitempty"`
+	ExternalInitiatorGRPC JobPipelineExternalInitiatorGRPC `toml:",omitempty"`
+	BridgeCircuitBreaker  JobPipelineBridgeCircuitBreaker  `toml:",omitempty"`
+	PolicyRules           []JobPipelinePolicyRule          `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -890,11 +1255,55 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 	if v := f.ReaperThreshold; v != nil {
 		j.ReaperThreshold = v
 	}
+	if v := f.ReplayOnChainWritesEnabled; v != nil {
+		j.ReplayOnChainWritesEnabled = v
+	}
 	if v := f.ResultWriteQueueDepth; v != nil {
 		j.ResultWriteQueueDepth = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.ExternalInitiatorGRPC.setFrom(&f.ExternalInitiatorGRPC)
+	j.BridgeCircuitBreaker.setFrom(&f.BridgeCircuitBreaker)
+	if v := f.PolicyRules; v != nil {
+		j.PolicyRules = v
+	}
+}
+
+func (j *JobPipeline) ValidateConfig() (err error) {
+	if *j.ExternalInitiatorGRPC.Enabled && !*j.ExternalInitiatorsEnabled {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ExternalInitiatorGRPC.Enabled", Value: true, Msg: "requires ExternalInitiatorsEnabled"})
+	}
+	names := make(map[string]struct{}, len(j.PolicyRules))
+	for i, r := range j.PolicyRules {
+		if r.Name == nil || *r.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("PolicyRules[%d].Name", i), Msg: "required for all rules"})
+		} else if _, ok := names[*r.Name]; ok {
+			err = multierr.Append(err, configutils.NewErrDuplicate(fmt.Sprintf("PolicyRules[%d].Name", i), *r.Name))
+		} else {
+			names[*r.Name] = struct{}{}
+		}
+		if r.Expr == nil || *r.Expr == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("PolicyRules[%d].Expr", i), Msg: "required for all rules"})
+		} else if _, perr := policy.Parse(*r.Expr); perr != nil {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("PolicyRules[%d].Expr", i), Value: *r.Expr, Msg: perr.Error()})
+		}
+		if r.Severity != nil {
+			switch policy.Severity(*r.Severity) {
+			case policy.SeverityError, policy.SeverityWarning:
+			default:
+				err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("PolicyRules[%d].Severity", i), Value: *r.Severity, Msg: "must be one of: error, warning"})
+			}
+		}
+	}
+	return
+}
 
+// JobPipelinePolicyRule is a rule which job specs are checked against when jobs are created.
+type JobPipelinePolicyRule struct {
+	Name     *string
+	Expr     *string
+	Severity *string
+	JobTypes []string `toml:",omitempty"`
 }
 
 type JobPipelineHTTPRequest struct {
@@ -911,6 +1320,94 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineExternalInitiatorGRPC struct {
+	Enabled           *bool
+	ListenIP          *net.IP
+	Port              *uint16
+	CertPath          *string
+	KeyPath           *string
+	ClientCAPath      *string
+	HeartbeatInterval *commonconfig.Duration
+}
+
+func (j *JobPipelineExternalInitiatorGRPC) setFrom(f *JobPipelineExternalInitiatorGRPC) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.ListenIP; v != nil {
+		j.ListenIP = v
+	}
+	if v := f.Port; v != nil {
+		j.Port = v
+	}
+	if v := f.CertPath; v != nil {
+		j.CertPath = v
+	}
+	if v := f.KeyPath; v != nil {
+		j.KeyPath = v
+	}
+	if v := f.ClientCAPath; v != nil {
+		j.ClientCAPath = v
+	}
+	if v := f.HeartbeatInterval; v != nil {
+		j.HeartbeatInterval = v
+	}
+}
+
+func (j *JobPipelineExternalInitiatorGRPC) ValidateConfig() (err error) {
+	if !*j.Enabled {
+		return
+	}
+	if *j.CertPath == "" {
+		err = multierr.Append(err, configutils.ErrEmpty{Name: "CertPath", Msg: "must be provided and non-empty"})
+	}
+	if *j.KeyPath == "" {
+		err = multierr.Append(err, configutils.ErrEmpty{Name: "KeyPath", Msg: "must be provided and non-empty"})
+	}
+	if *j.ClientCAPath == "" {
+		err = multierr.Append(err, configutils.ErrEmpty{Name: "ClientCAPath", Msg: "must be provided and non-empty"})
+	}
+	if j.HeartbeatInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "HeartbeatInterval", Value: j.HeartbeatInterval.Duration(), Msg: "must be positive"})
+	}
+	return
+}
+
+type JobPipelineBridgeCircuitBreaker struct {
+	Enabled          *bool
+	FailureThreshold *uint32
+	LatencySLO       *commonconfig.Duration
+	OpenTimeout      *commonconfig.Duration
+}
+
+func (j *JobPipelineBridgeCircuitBreaker) setFrom(f *JobPipelineBridgeCircuitBreaker) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.LatencySLO; v != nil {
+		j.LatencySLO = v
+	}
+	if v := f.OpenTimeout; v != nil {
+		j.OpenTimeout = v
+	}
+}
+
+func (j *JobPipelineBridgeCircuitBreaker) ValidateConfig() (err error) {
+	if !*j.Enabled {
+		return
+	}
+	if *j.FailureThreshold == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "FailureThreshold", Value: 0, Msg: "must be positive"})
+	}
+	if j.OpenTimeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OpenTimeout", Value: j.OpenTimeout.Duration(), Msg: "must be positive"})
+	}
+	return
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
@@ -1061,12 +1558,13 @@ func (p *P2P) setFrom(f *P2P) {
 }
 
 type P2PV2 struct {
-	Enabled              *bool
-	AnnounceAddresses    *[]string
-	DefaultBootstrappers *[]ocrcommontypes.BootstrapperLocator
-	DeltaDial            *commonconfig.Duration
-	DeltaReconcile       *commonconfig.Duration
-	ListenAddresses      *[]string
+	Enabled                          *bool
+	AnnounceAddresses                *[]string
+	AnnounceAddressesRefreshInterval *commonconfig.Duration
+	DefaultBootstrappers             *[]ocrcommontypes.BootstrapperLocator
+	DeltaDial                        *commonconfig.Duration
+	DeltaReconcile                   *commonconfig.Duration
+	ListenAddresses                  *[]string
 }
 
 func (p *P2PV2) setFrom(f *P2PV2) {
@@ -1076,6 +1574,9 @@ func (p *P2PV2) setFrom(f *P2PV2) {
 	if v := f.AnnounceAddresses; v != nil {
 		p.AnnounceAddresses = v
 	}
+	if v := f.AnnounceAddressesRefreshInterval; v != nil {
+		p.AnnounceAddressesRefreshInterval = v
+	}
 	if v := f.DefaultBootstrappers; v != nil {
 		p.DefaultBootstrappers = v
 	}
@@ -1246,6 +1747,7 @@ type Insecure struct {
 	OCRDevelopmentMode   *bool
 	InfiniteDepthQueries *bool
 	DisableRateLimiting  *bool
+	SimulatedEVMChains   *bool
 }
 
 func (ins *Insecure) ValidateConfig() (err error) {
@@ -1269,6 +1771,10 @@ func (ins *Insecure) validateConfig(buildMode string) (err error) {
 	if ins.DisableRateLimiting != nil && *ins.DisableRateLimiting {
 		err = multierr.Append(err, configutils.ErrInvalid{Name: "DisableRateLimiting", Value: *ins.DisableRateLimiting, Msg: "insecure configs are not allowed on secure builds"})
 	}
+	// SimulatedEVMChains is allowed on dev/test builds.
+	if ins.SimulatedEVMChains != nil && *ins.SimulatedEVMChains && buildMode == build.Prod {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "SimulatedEVMChains", Value: *ins.SimulatedEVMChains, Msg: "insecure configs are not allowed on secure builds"})
+	}
 	return err
 }
 
@@ -1285,6 +1791,9 @@ func (ins *Insecure) setFrom(f *Insecure) {
 	if v := f.OCRDevelopmentMode; v != nil {
 		ins.OCRDevelopmentMode = f.OCRDevelopmentMode
 	}
+	if v := f.SimulatedEVMChains; v != nil {
+		ins.SimulatedEVMChains = f.SimulatedEVMChains
+	}
 }
 
 type MercuryCache struct {
@@ -1555,3 +2064,96 @@ func isValidHostname(hostname string) bool {
 func isValidFilePath(path string) bool {
 	return len(path) > 0 && len(path) < 4096
 }
+
+type BalanceMonitor struct {
+	Enabled    *bool
+	PollPeriod *commonconfig.Duration
+	LowBalance BalanceMonitorLowBalance `toml:",omitempty"`
+}
+
+func (b *BalanceMonitor) setFrom(f *BalanceMonitor) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.PollPeriod; v != nil {
+		b.PollPeriod = v
+	}
+	b.LowBalance.setFrom(&f.LowBalance)
+}
+
+func (b *BalanceMonitor) ValidateConfig() (err error) {
+	if b.Enabled == nil || !*b.Enabled {
+		return
+	}
+	if b.PollPeriod != nil && b.PollPeriod.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PollPeriod", Value: b.PollPeriod.String(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
+// BalanceMonitorLowBalance holds the per chain family thresholds, in whole tokens, below which an account balance is
+// flagged as low.
+type BalanceMonitorLowBalance struct {
+	EVM      *decimal.Decimal
+	Solana   *decimal.Decimal
+	Cosmos   *decimal.Decimal
+	StarkNet *decimal.Decimal
+	Aptos    *decimal.Decimal
+}
+
+func (l *BalanceMonitorLowBalance) setFrom(f *BalanceMonitorLowBalance) {
+	if v := f.EVM; v != nil {
+		l.EVM = v
+	}
+	if v := f.Solana; v != nil {
+		l.Solana = v
+	}
+	if v := f.Cosmos; v != nil {
+		l.Cosmos = v
+	}
+	if v := f.StarkNet; v != nil {
+		l.StarkNet = v
+	}
+	if v := f.Aptos; v != nil {
+		l.Aptos = v
+	}
+}
+
+func (l *BalanceMonitorLowBalance) ValidateConfig() (err error) {
+	for _, t := range []struct {
+		name string
+		v    *decimal.Decimal
+	}{
+		{"EVM", l.EVM},
+		{"Solana", l.Solana},
+		{"Cosmos", l.Cosmos},
+		{"StarkNet", l.StarkNet},
+		{"Aptos", l.Aptos},
+	} {
+		if t.v != nil && t.v.IsNegative() {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: t.name, Value: t.v.String(), Msg: "must not be negative"})
+		}
+	}
+	return
+}
+
+type Keystore struct {
+	MasterKeyFile *string
+	AWSKMSKeyID   *string
+}
+
+func (k *Keystore) setFrom(f *Keystore) {
+	if v := f.MasterKeyFile; v != nil {
+		k.MasterKeyFile = v
+	}
+	if v := f.AWSKMSKeyID; v != nil {
+		k.AWSKMSKeyID = v
+	}
+}
+
+func (k *Keystore) ValidateConfig() (err error) {
+	if k.MasterKeyFile != nil && *k.MasterKeyFile != "" && k.AWSKMSKeyID != nil && *k.AWSKMSKeyID != "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "AWSKMSKeyID", Value: *k.AWSKMSKeyID, Msg: "must be empty when MasterKeyFile is set"})
+	}
+	return
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -577,5 +578,109 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	}
 }
 
+func TestLog_ValidateSinks(t *testing.T) {
+	lokiURL := commonconfig.MustParseURL("http://loki:3100/loki/api/v1/push")
+	tests := []struct {
+		name    string
+		sinks   []LogSink
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:  "valid",
+			sinks: []LogSink{{Name: ptr("loki"), Type: ptr("loki"), URL: lokiURL}, {Name: ptr("kafka"), Type: ptr("kafka"), URL: lokiURL, Topic: ptr("logs")}},
+		},
+		{
+			name:    "duplicate name",
+			sinks:   []LogSink{{Name: ptr("loki"), Type: ptr("loki"), URL: lokiURL}, {Name: ptr("loki"), Type: ptr("otlp"), URL: lokiURL}},
+			wantErr: true,
+			errMsg:  "Sinks[1].Name: invalid value (loki): duplicate - must be unique",
+		},
+		{
+			name:    "invalid type",
+			sinks:   []LogSink{{Name: ptr("loki"), Type: ptr("elastic"), URL: lokiURL}},
+			wantErr: true,
+			errMsg:  "Sinks[0].Type: invalid value (elastic): must be one of: loki, kafka, otlp",
+		},
+		{
+			name:    "kafka without topic",
+			sinks:   []LogSink{{Name: ptr("kafka"), Type: ptr("kafka"), URL: lokiURL}},
+			wantErr: true,
+			errMsg:  "Sinks[0].Topic: missing: required for kafka sinks",
+		},
+		{
+			name:    "missing URL",
+			sinks:   []LogSink{{Name: ptr("loki"), Type: ptr("loki")}},
+			wantErr: true,
+			errMsg:  "Sinks[0].URL: missing: required for all sinks",
+		},
+		{
+			name:    "zero batch size",
+			sinks:   []LogSink{{Name: ptr("loki"), Type: ptr("loki"), URL: lokiURL, BatchSize: ptr[uint32](0)}},
+			wantErr: true,
+			errMsg:  "Sinks[0].BatchSize: invalid value (0): must be greater than zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &Log{Sinks: tt.sinks}
+
+			err := l.ValidateConfig()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebServerRouteLimit_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  WebServerRouteLimit
+		errMsg string
+	}{
+		{
+			name:  "valid",
+			limit: WebServerRouteLimit{AllowedCIDRs: &[]string{"10.0.0.0/8", "2001:db8::/32"}, RateLimit: ptr[int64](5), RateLimitPeriod: commonconfig.MustNewDuration(time.Minute)},
+		},
+		{
+			name:  "disabled",
+			limit: WebServerRouteLimit{AllowedCIDRs: &[]string{}, RateLimit: ptr[int64](0), RateLimitPeriod: commonconfig.MustNewDuration(0)},
+		},
+		{
+			name:   "invalid CIDR",
+			limit:  WebServerRouteLimit{AllowedCIDRs: &[]string{"10.0.0.0/8", "10.0.0.1"}},
+			errMsg: `AllowedCIDRs[1]: invalid value (10.0.0.1): netip.ParsePrefix("10.0.0.1"): no '/'`,
+		},
+		{
+			name:   "negative rate limit",
+			limit:  WebServerRouteLimit{RateLimit: ptr[int64](-1)},
+			errMsg: "RateLimit: invalid value (-1): must not be negative",
+		},
+		{
+			name:   "zero period",
+			limit:  WebServerRouteLimit{RateLimit: ptr[int64](5), RateLimitPeriod: commonconfig.MustNewDuration(0)},
+			errMsg: "RateLimitPeriod: invalid value (0s): must be positive when RateLimit is set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limit.ValidateConfig()
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
@@ -27,9 +27,35 @@ type RateLimit interface {
 	UnauthenticatedPeriod() time.Duration
 }
 
+type PublicStatus interface {
+	Enabled() bool
+	RateLimit() int64
+	RateLimitPeriod() time.Duration
+}
+
+// RouteLimits restricts the routes most exposed to brute force and abuse.
+type RouteLimits interface {
+	// TrustedProxies returns the CIDRs of the reverse proxies whose X-Forwarded-For header is trusted for the client IP.
+	TrustedProxies() []string
+	Sessions() RouteLimit
+	GraphQLMutations() RouteLimit
+	RunTriggers() RouteLimit
+}
+
+// RouteLimit is the IP allow-list and the rate limit of a route.
+type RouteLimit interface {
+	// AllowedCIDRs returns the CIDRs of the client IPs allowed to call the route, or none to allow any client.
+	AllowedCIDRs() []string
+	// RateLimit returns the number of requests per RateLimitPeriod allowed from each client IP, or 0 for no limit.
+	RateLimit() int64
+	RateLimitPeriod() time.Duration
+}
+
 type MFA interface {
 	RPID() string
 	RPOrigin() string
+	// RequiredForRole returns the lowest role for which MFA is enforced, or "" if it is not enforced.
+	RequiredForRole() string
 }
 
 type LDAP interface {
@@ -72,6 +98,8 @@ type WebServer interface {
 
 	TLS() TLS
 	RateLimit() RateLimit
+	PublicStatus() PublicStatus
+	RouteLimits() RouteLimits
 	MFA() MFA
 	LDAP() LDAP
 }
@@ -3,9 +3,12 @@
 package mocks
 
 import (
-	big "math/big"
-
 	audit "github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	archive "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/archive"
+
+	balancemonitor "github.com/smartcontractkit/chainlink/v2/core/services/balancemonitor"
+
+	big "math/big"
 
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
@@ -13,8 +16,18 @@ import (
 
 	context "context"
 
+	dbmaintenance "github.com/smartcontractkit/chainlink/v2/core/services/dbmaintenance"
+
+	feeaccounting "github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting"
+
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	functions "github.com/smartcontractkit/chainlink/v2/core/services/functions"
+
+	gateway "github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+
+	http "net/http"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -23,22 +36,34 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	ocrcommon "github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 
+	pkgtypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
 
+	relay "github.com/smartcontractkit/chainlink/v2/core/services/relay"
+
 	services "github.com/smartcontractkit/chainlink/v2/core/services"
 
 	sessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 
 	sqlx "github.com/jmoiron/sqlx"
 
+	tenancy "github.com/smartcontractkit/chainlink/v2/core/tenancy"
+
+	time "time"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 
 	uuid "github.com/google/uuid"
 
+	v2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
 	zapcore "go.uber.org/zap/zapcore"
@@ -87,6 +112,26 @@ func (_m *Application) AuthenticationProvider() sessions.AuthenticationProvider
 	return r0
 }
 
+// BalanceMonitor provides a mock function with given fields:
+func (_m *Application) BalanceMonitor() balancemonitor.Monitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BalanceMonitor")
+	}
+
+	var r0 balancemonitor.Monitor
+	if rf, ok := ret.Get(0).(func() balancemonitor.Monitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(balancemonitor.Monitor)
+		}
+	}
+
+	return r0
+}
+
 // BasicAdminUsersORM provides a mock function with given fields:
 func (_m *Application) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	ret := _m.Called()
@@ -107,6 +152,26 @@ func (_m *Application) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return r0
 }
 
+// BridgeCircuitBreakers provides a mock function with given fields:
+func (_m *Application) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakers")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -127,6 +192,26 @@ func (_m *Application) BridgeORM() bridges.ORM {
 	return r0
 }
 
+// DatabaseMaintenance provides a mock function with given fields:
+func (_m *Application) DatabaseMaintenance() dbmaintenance.Maintenance {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DatabaseMaintenance")
+	}
+
+	var r0 dbmaintenance.Maintenance
+	if rf, ok := ret.Get(0).(func() dbmaintenance.Maintenance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmaintenance.Maintenance)
+		}
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -145,6 +230,42 @@ func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	return r0
 }
 
+// Drain provides a mock function with given fields: ctx, timeout
+func (_m *Application) Drain(ctx context.Context, timeout time.Duration) chainlink.DrainStatus {
+	ret := _m.Called(ctx, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 chainlink.DrainStatus
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) chainlink.DrainStatus); ok {
+		r0 = rf(ctx, timeout)
+	} else {
+		r0 = ret.Get(0).(chainlink.DrainStatus)
+	}
+
+	return r0
+}
+
+// DrainStatus provides a mock function with given fields: ctx
+func (_m *Application) DrainStatus(ctx context.Context) chainlink.DrainStatus {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainStatus")
+	}
+
+	var r0 chainlink.DrainStatus
+	if rf, ok := ret.Get(0).(func(context.Context) chainlink.DrainStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(chainlink.DrainStatus)
+	}
+
+	return r0
+}
+
 // EVMORM provides a mock function with given fields:
 func (_m *Application) EVMORM() types.Configs {
 	ret := _m.Called()
@@ -165,6 +286,66 @@ func (_m *Application) EVMORM() types.Configs {
 	return r0
 }
 
+// FunctionsListeners provides a mock function with given fields:
+func (_m *Application) FunctionsListeners() map[int32]functions.FunctionsListener {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FunctionsListeners")
+	}
+
+	var r0 map[int32]functions.FunctionsListener
+	if rf, ok := ret.Get(0).(func() map[int32]functions.FunctionsListener); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]functions.FunctionsListener)
+		}
+	}
+
+	return r0
+}
+
+// FunctionsSecretsRotators provides a mock function with given fields:
+func (_m *Application) FunctionsSecretsRotators() map[int32]functions.SecretsRotator {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FunctionsSecretsRotators")
+	}
+
+	var r0 map[int32]functions.SecretsRotator
+	if rf, ok := ret.Get(0).(func() map[int32]functions.SecretsRotator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]functions.SecretsRotator)
+		}
+	}
+
+	return r0
+}
+
+// Gateways provides a mock function with given fields:
+func (_m *Application) Gateways() map[int32]gateway.Gateway {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Gateways")
+	}
+
+	var r0 map[int32]gateway.Gateway
+	if rf, ok := ret.Get(0).(func() map[int32]gateway.Gateway); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]gateway.Gateway)
+		}
+	}
+
+	return r0
+}
+
 // GetAuditLogger provides a mock function with given fields:
 func (_m *Application) GetAuditLogger() audit.AuditLogger {
 	ret := _m.Called()
@@ -443,6 +624,36 @@ func (_m *Application) JobSpawner() job.Spawner {
 	return r0
 }
 
+// P2PPeers provides a mock function with given fields:
+func (_m *Application) P2PPeers() ([]ocrcommon.P2PPeer, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for P2PPeers")
+	}
+
+	var r0 []ocrcommon.P2PPeer
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]ocrcommon.P2PPeer, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []ocrcommon.P2PPeer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ocrcommon.P2PPeer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -463,6 +674,54 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// ReloadConfig provides a mock function with given fields: ctx
+func (_m *Application) ReloadConfig(ctx context.Context) (*chainlink.ConfigReload, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 *chainlink.ConfigReload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*chainlink.ConfigReload, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *chainlink.ConfigReload); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chainlink.ConfigReload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceJob provides a mock function with given fields: ctx, jobID, _a2
+func (_m *Application) ReplaceJob(ctx context.Context, jobID int32, _a2 *job.Job) error {
+	ret := _m.Called(ctx, jobID, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *job.Job) error); ok {
+		r0 = rf(ctx, jobID, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -481,6 +740,56 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ReplayJobRun provides a mock function with given fields: ctx, runID, stubOnChainWrites
+func (_m *Application) ReplayJobRun(ctx context.Context, runID int64, stubOnChainWrites bool) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, runID, stubOnChainWrites)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayJobRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) (*pipeline.Run, error)); ok {
+		return rf(ctx, runID, stubOnChainWrites)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) *pipeline.Run); ok {
+		r0 = rf(ctx, runID, stubOnChainWrites)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, bool) error); ok {
+		r1 = rf(ctx, runID, stubOnChainWrites)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicaJobORM provides a mock function with given fields:
+func (_m *Application) ReplicaJobORM() job.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplicaJobORM")
+	}
+
+	var r0 job.ORM
+	if rf, ok := ret.Get(0).(func() job.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(job.ORM)
+		}
+	}
+
+	return r0
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -499,9 +808,29 @@ func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result
 	return r0
 }
 
-// RunJobV2 provides a mock function with given fields: ctx, jobID, meta
-func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
-	ret := _m.Called(ctx, jobID, meta)
+// RunArchive provides a mock function with given fields:
+func (_m *Application) RunArchive() archive.Archiver {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunArchive")
+	}
+
+	var r0 archive.Archiver
+	if rf, ok := ret.Get(0).(func() archive.Archiver); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(archive.Archiver)
+		}
+	}
+
+	return r0
+}
+
+// RunJobV2 provides a mock function with given fields: ctx, jobID, meta, overrides
+func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}, overrides map[string]interface{}) (int64, error) {
+	ret := _m.Called(ctx, jobID, meta, overrides)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RunJobV2")
@@ -509,17 +838,17 @@ func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[strin
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}) (int64, error)); ok {
-		return rf(ctx, jobID, meta)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}, map[string]interface{}) (int64, error)); ok {
+		return rf(ctx, jobID, meta, overrides)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}) int64); ok {
-		r0 = rf(ctx, jobID, meta)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}, map[string]interface{}) int64); ok {
+		r0 = rf(ctx, jobID, meta, overrides)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, map[string]interface{}) error); ok {
-		r1 = rf(ctx, jobID, meta)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, map[string]interface{}, map[string]interface{}) error); ok {
+		r1 = rf(ctx, jobID, meta, overrides)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -575,6 +904,34 @@ func (_m *Application) SecretGenerator() chainlink.SecretGenerator {
 	return r0
 }
 
+// SetChainEnabled provides a mock function with given fields: ctx, id, enabled
+func (_m *Application) SetChainEnabled(ctx context.Context, id relay.ID, enabled bool) (pkgtypes.ChainStatus, error) {
+	ret := _m.Called(ctx, id, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetChainEnabled")
+	}
+
+	var r0 pkgtypes.ChainStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, relay.ID, bool) (pkgtypes.ChainStatus, error)); ok {
+		return rf(ctx, id, enabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, relay.ID, bool) pkgtypes.ChainStatus); ok {
+		r0 = rf(ctx, id, enabled)
+	} else {
+		r0 = ret.Get(0).(pkgtypes.ChainStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, relay.ID, bool) error); ok {
+		r1 = rf(ctx, id, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetLogLevel provides a mock function with given fields: lvl
 func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	ret := _m.Called(lvl)
@@ -593,6 +950,36 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// ShadowRunJob provides a mock function with given fields: ctx, _a1, overrides
+func (_m *Application) ShadowRunJob(ctx context.Context, _a1 job.Job, overrides map[string]interface{}) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, _a1, overrides)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShadowRunJob")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) (*pipeline.Run, error)); ok {
+		return rf(ctx, _a1, overrides)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) *pipeline.Run); ok {
+		r0 = rf(ctx, _a1, overrides)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}) error); ok {
+		r1 = rf(ctx, _a1, overrides)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -629,6 +1016,46 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// TenancyORM provides a mock function with given fields:
+func (_m *Application) TenancyORM() tenancy.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenancyORM")
+	}
+
+	var r0 tenancy.ORM
+	if rf, ok := ret.Get(0).(func() tenancy.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(tenancy.ORM)
+		}
+	}
+
+	return r0
+}
+
+// TxFeeAccounting provides a mock function with given fields:
+func (_m *Application) TxFeeAccounting() feeaccounting.Accounting {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxFeeAccounting")
+	}
+
+	var r0 feeaccounting.Accounting
+	if rf, ok := ret.Get(0).(func() feeaccounting.Accounting); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(feeaccounting.Accounting)
+		}
+	}
+
+	return r0
+}
+
 // TxmStorageService provides a mock function with given fields:
 func (_m *Application) TxmStorageService() txmgr.EvmTxStore {
 	ret := _m.Called()
@@ -649,6 +1076,64 @@ func (_m *Application) TxmStorageService() txmgr.EvmTxStore {
 	return r0
 }
 
+// VRFRequestManagers provides a mock function with given fields:
+func (_m *Application) VRFRequestManagers() map[int32]v2.RequestManager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VRFRequestManagers")
+	}
+
+	var r0 map[int32]v2.RequestManager
+	if rf, ok := ret.Get(0).(func() map[int32]v2.RequestManager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]v2.RequestManager)
+		}
+	}
+
+	return r0
+}
+
+// VRFSubscriptionMonitors provides a mock function with given fields:
+func (_m *Application) VRFSubscriptionMonitors() map[int32]v2.SubscriptionReporter {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VRFSubscriptionMonitors")
+	}
+
+	var r0 map[int32]v2.SubscriptionReporter
+	if rf, ok := ret.Get(0).(func() map[int32]v2.SubscriptionReporter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]v2.SubscriptionReporter)
+		}
+	}
+
+	return r0
+}
+
+// VerifyWebhookSignature provides a mock function with given fields: jobUUID, header, body
+func (_m *Application) VerifyWebhookSignature(jobUUID uuid.UUID, header http.Header, body []byte) error {
+	ret := _m.Called(jobUUID, header, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyWebhookSignature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, http.Header, []byte) error); ok {
+		r0 = rf(jobUUID, header, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
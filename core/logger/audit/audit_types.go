@@ -11,6 +11,10 @@ const (
 	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
 	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
 	AuthSessionDeleted      EventID = "SESSION_DELETED"
+	AuthSessionRevoked      EventID = "SESSION_REVOKED"
+	AuthUserSessionsRevoked EventID = "USER_SESSIONS_REVOKED"
+
+	AuthRecoveryCodesRegenerated EventID = "AUTH_RECOVERY_CODES_REGENERATED"
 
 	PasswordResetAttemptFailedMismatch EventID = "PASSWORD_RESET_ATTEMPT_FAILED_MISMATCH"
 	PasswordResetSuccess               EventID = "PASSWORD_RESET_SUCCESS"
@@ -48,16 +52,25 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystoreMasterKeyRotated EventID = "KEYSTORE_MASTER_KEY_ROTATED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionRequeued   EventID = "ETH_TRANSACTION_REQUEUED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
-	JobCreated EventID = "JOB_CREATED"
-	JobDeleted EventID = "JOB_DELETED"
+	JobCreated         EventID = "JOB_CREATED"
+	JobDeleted         EventID = "JOB_DELETED"
+	JobMigrated        EventID = "JOB_MIGRATED"
+	JobRestarted       EventID = "JOB_RESTARTED"
+	JobVersionStaged   EventID = "JOB_VERSION_STAGED"
+	JobVersionSwitched EventID = "JOB_VERSION_SWITCHED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
 	ChainDeleted     EventID = "CHAIN_DELETED"
+	ChainEnabled     EventID = "CHAIN_ENABLED"
+	ChainDisabled    EventID = "CHAIN_DISABLED"
 
 	ChainRpcNodeAdded   EventID = "CHAIN_RPC_NODE_ADDED"
 	ChainRpcNodeDeleted EventID = "CHAIN_RPC_NODE_DELETED"
@@ -69,6 +82,15 @@ const (
 	ForwarderCreated EventID = "FORWARDER_CREATED"
 	ForwarderDeleted EventID = "FORWARDER_DELETED"
 
+	VRFRequestFulfilled EventID = "VRF_REQUEST_FULFILLED"
+
+	FunctionsSecretsRotated  EventID = "FUNCTIONS_SECRETS_ROTATED"
+	FunctionsRequestReplayed EventID = "FUNCTIONS_REQUEST_REPLAYED"
+
+	GatewayHandlerAdded         EventID = "GATEWAY_HANDLER_ADDED"
+	GatewayHandlerEnabledSet    EventID = "GATEWAY_HANDLER_ENABLED_SET"
+	GatewayHandlerPolicyUpdated EventID = "GATEWAY_HANDLER_POLICY_UPDATED"
+
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
@@ -84,8 +106,16 @@ const (
 
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
+	JobRunReplayed    EventID = "JOB_RUN_REPLAYED"
 
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
+	SupportBundleCreated    EventID = "SUPPORT_BUNDLE_CREATED"
+
+	NodeDrainStarted EventID = "NODE_DRAIN_STARTED"
+
+	SimulatedChainUpdated EventID = "SIMULATED_CHAIN_UPDATED"
+	SimulatedChainReorged EventID = "SIMULATED_CHAIN_REORGED"
+	SimulatedChainFunded  EventID = "SIMULATED_CHAIN_FUNDED"
 
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
 )
@@ -165,6 +165,8 @@ type Config struct {
 	FileMaxSizeMB  int
 	FileMaxAgeDays int
 	FileMaxBackups int // files
+	// Sinks ship structured logs to external systems, in addition to the console and the log file.
+	Sinks []SinkConfig
 
 	diskSpaceAvailableFn diskSpaceAvailableFn
 	diskPollConfig       zapDiskPollConfig
@@ -189,14 +191,23 @@ func (c *Config) New() (Logger, func() error) {
 		closeLogger func() error
 		err         error
 	)
+	sinkCores, closeSinks := newSinkCores(c.Sinks, c.UnixTS)
 	if !c.DebugLogsToDisk() {
-		l, closeLogger, err = newDefaultLogger(cfg, c.UnixTS)
+		l, closeLogger, err = newDefaultLogger(cfg, c.UnixTS, sinkCores...)
 	} else {
-		l, closeLogger, err = newRotatingFileLogger(cfg, *c)
+		l, closeLogger, err = newRotatingFileLogger(cfg, *c, sinkCores...)
 	}
 	if err != nil {
 		log.Fatal(err)
 	}
+	if len(sinkCores) > 0 {
+		closeCores := closeLogger
+		closeLogger = func() error {
+			// Flush the entries buffered for the sinks before the console and file are closed.
+			closeSinks()
+			return closeCores()
+		}
+	}
 
 	l = newSentryLogger(l)
 	l = newPrometheusLogger(l)
@@ -240,11 +251,14 @@ func newZapConfigBase() zap.Config {
 	return cfg
 }
 
-func newDefaultLogger(zcfg zap.Config, unixTS bool) (Logger, func() error, error) {
+func newDefaultLogger(zcfg zap.Config, unixTS bool, cores ...zapcore.Core) (Logger, func() error, error) {
 	core, coreCloseFn, err := newDefaultLoggingCore(zcfg, unixTS)
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(cores) > 0 {
+		core = zapcore.NewTee(append(cores, core)...)
+	}
 
 	l, loggerCloseFn, err := newLoggerForCore(zcfg, core)
 	if err != nil {
@@ -0,0 +1,260 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	sinkDroppedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_sink_dropped_count",
+		Help: "Number of log entries dropped by a log sink, because its buffer was full or it failed to send them",
+	}, []string{"sink"})
+	sinkSentCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_sink_sent_count",
+		Help: "Number of log entries sent by a log sink",
+	}, []string{"sink"})
+)
+
+// sinkSendTimeout bounds how long a sink may take to send a batch.
+const sinkSendTimeout = 10 * time.Second
+
+// Sink ships structured log entries to an external system, like Loki, Kafka or an OpenTelemetry collector.
+type Sink interface {
+	// Send ships a batch of entries, in the order they were logged.
+	Send(ctx context.Context, entries []SinkEntry) error
+}
+
+// SinkEntry is a log entry, as received by a Sink.
+type SinkEntry struct {
+	Time    time.Time
+	Level   zapcore.Level
+	Logger  string
+	Caller  string
+	Message string
+	// Fields are the structured fields of the entry, including those of its logger.
+	Fields map[string]interface{}
+	// JSON is the entry encoded as a JSON object, like in JSONConsole mode.
+	JSON []byte
+}
+
+// SinkConfig configures a Sink of the logger.
+type SinkConfig struct {
+	Name string
+	Sink Sink
+	// Level is the minimum level of the entries sent to the sink, independent of the level of the logger.
+	Level zapcore.Level
+	// BufferSize is how many entries are buffered while they are sent.
+	BufferSize int
+	// BatchSize is the maximum number of entries of each call to Send.
+	BatchSize int
+	// FlushInterval is how long entries may wait in the buffer before they are sent in a partial batch.
+	FlushInterval time.Duration
+	// DropWhenFull drops entries while the buffer is full. Otherwise, logging blocks until the sink catches up.
+	DropWhenFull bool
+}
+
+// sinkCore is a zapcore.Core which buffers entries for a Sink, and sends them in batches from its own goroutine, so
+// that slow sinks do not slow down logging unless configured to.
+type sinkCore struct {
+	*sinkWorker
+	enc zapcore.Encoder
+	// fields are the fields of the core, added by With.
+	fields []zapcore.Field
+}
+
+var _ zapcore.Core = &sinkCore{}
+
+func newSinkCore(cfg SinkConfig, unixTS bool) *sinkCore {
+	w := &sinkWorker{
+		cfg:     cfg,
+		entries: make(chan SinkEntry, cfg.BufferSize),
+		done:    make(chan struct{}),
+		dropped: sinkDroppedCounter.WithLabelValues(cfg.Name),
+		sent:    sinkSentCounter.WithLabelValues(cfg.Name),
+	}
+	go w.run()
+	return &sinkCore{sinkWorker: w, enc: zapcore.NewJSONEncoder(makeEncoderConfig(unixTS))}
+}
+
+func (c *sinkCore) Enabled(lvl zapcore.Level) bool {
+	return c.cfg.Level.Enabled(lvl)
+}
+
+func (c *sinkCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.enc = c.enc.Clone()
+	for _, f := range fields {
+		f.AddTo(clone.enc)
+	}
+	clone.fields = append(append(make([]zapcore.Field, 0, len(c.fields)+len(fields)), c.fields...), fields...)
+	return &clone
+}
+
+func (c *sinkCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *sinkCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.enc.EncodeEntry(ent, fields)
+	if err != nil {
+		return err
+	}
+	defer buf.Free()
+
+	m := zapcore.NewMapObjectEncoder()
+	for _, f := range c.fields {
+		f.AddTo(m)
+	}
+	for _, f := range fields {
+		f.AddTo(m)
+	}
+	e := SinkEntry{
+		Time:    ent.Time,
+		Level:   ent.Level,
+		Logger:  ent.LoggerName,
+		Message: ent.Message,
+		Fields:  m.Fields,
+		JSON:    trimNewline(buf),
+	}
+	if ent.Caller.Defined {
+		e.Caller = ent.Caller.TrimmedPath()
+	}
+	c.push(e)
+	return nil
+}
+
+func (c *sinkCore) Sync() error {
+	return nil
+}
+
+func trimNewline(buf *buffer.Buffer) []byte {
+	b := buf.Bytes()
+	if n := len(b); n > 0 && b[n-1] == '\n' {
+		b = b[:n-1]
+	}
+	return append([]byte(nil), b...)
+}
+
+// sinkWorker sends the buffered entries of a sink. It is shared by the cores derived with With.
+type sinkWorker struct {
+	cfg     SinkConfig
+	entries chan SinkEntry
+	done    chan struct{}
+	dropped prometheus.Counter
+	sent    prometheus.Counter
+
+	mu     sync.RWMutex
+	closed bool
+	// failing is true while sending fails, so that only the first failure is reported.
+	failing bool
+}
+
+func (w *sinkWorker) push(e SinkEntry) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		w.dropped.Inc()
+		return
+	}
+	if !w.cfg.DropWhenFull {
+		w.entries <- e
+		return
+	}
+	select {
+	case w.entries <- e:
+	default:
+		w.dropped.Inc()
+	}
+}
+
+func (w *sinkWorker) run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]SinkEntry, 0, w.cfg.BatchSize)
+	for {
+		select {
+		case e, ok := <-w.entries:
+			if !ok {
+				w.send(batch)
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) < w.cfg.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		w.send(batch)
+		batch = make([]SinkEntry, 0, w.cfg.BatchSize)
+	}
+}
+
+func (w *sinkWorker) send(batch []SinkEntry) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sinkSendTimeout)
+	defer cancel()
+	if err := w.cfg.Sink.Send(ctx, batch); err != nil {
+		w.dropped.Add(float64(len(batch)))
+		// The logger cannot log its own failures, so they are reported on stderr.
+		if !w.failing {
+			w.failing = true
+			fmt.Fprintf(os.Stderr, "Log sink %s failed to send %d entries, dropping them until it recovers: %v\n", w.cfg.Name, len(batch), err)
+		}
+		return
+	}
+	if w.failing {
+		w.failing = false
+		fmt.Fprintf(os.Stderr, "Log sink %s recovered\n", w.cfg.Name)
+	}
+	w.sent.Add(float64(len(batch)))
+}
+
+// close stops accepting entries, and waits until the buffered ones are sent.
+func (w *sinkWorker) close() {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return
+	}
+	w.closed = true
+	close(w.entries)
+	w.mu.Unlock()
+	<-w.done
+}
+
+// newSinkCores returns the cores of the sinks, and a func which flushes and closes them.
+func newSinkCores(cfgs []SinkConfig, unixTS bool) ([]zapcore.Core, func()) {
+	var (
+		cores   []zapcore.Core
+		workers []*sinkWorker
+	)
+	for _, cfg := range cfgs {
+		c := newSinkCore(cfg, unixTS)
+		cores = append(cores, c)
+		workers = append(workers, c.sinkWorker)
+	}
+	return cores, func() {
+		for _, w := range workers {
+			w.close()
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type fakeSink struct {
+	mu      sync.Mutex
+	batches [][]SinkEntry
+	block   chan struct{}
+}
+
+func (f *fakeSink) Send(ctx context.Context, entries []SinkEntry) error {
+	if f.block != nil {
+		<-f.block
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, entries)
+	return nil
+}
+
+func (f *fakeSink) entries() (es []SinkEntry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, b := range f.batches {
+		es = append(es, b...)
+	}
+	return
+}
+
+func TestSinkCore(t *testing.T) {
+	sink := &fakeSink{}
+	cores, closeFn := newSinkCores([]SinkConfig{{
+		Name:          "fake",
+		Sink:          sink,
+		Level:         zapcore.WarnLevel,
+		BufferSize:    10,
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+		DropWhenFull:  true,
+	}}, false)
+	lggr := zap.New(cores[0]).Named("Test").Sugar().With("job", 1)
+
+	lggr.Info("filtered")
+	lggr.Warnw("first", "attempt", 2)
+	lggr.Error("second")
+	lggr.Warn("third")
+	closeFn()
+
+	entries := sink.entries()
+	require.Len(t, entries, 3)
+	assert.Len(t, sink.batches, 2, "expected a full batch, and a partial one on close")
+
+	e := entries[0]
+	assert.Equal(t, "first", e.Message)
+	assert.Equal(t, zapcore.WarnLevel, e.Level)
+	assert.Equal(t, "Test", e.Logger)
+	assert.Equal(t, map[string]interface{}{"job": int64(1), "attempt": int64(2)}, e.Fields)
+	var m map[string]interface{}
+	require.NoError(t, json.Unmarshal(e.JSON, &m))
+	assert.Equal(t, "first", m["msg"])
+	assert.Equal(t, float64(1), m["job"])
+
+	// closed sinks drop
+	lggr.Error("after close")
+	assert.Len(t, sink.entries(), 3)
+}
+
+func TestSinkCore_dropWhenFull(t *testing.T) {
+	sink := &fakeSink{block: make(chan struct{})}
+	cores, closeFn := newSinkCores([]SinkConfig{{
+		Name:          "fake",
+		Sink:          sink,
+		Level:         zapcore.DebugLevel,
+		BufferSize:    1,
+		BatchSize:     1,
+		FlushInterval: time.Hour,
+		DropWhenFull:  true,
+	}}, false)
+	lggr := zap.New(cores[0]).Sugar()
+
+	for i := 0; i < 10; i++ {
+		lggr.Info("entry")
+	}
+	close(sink.block)
+	closeFn()
+
+	// at most one entry being sent, and one buffered
+	assert.LessOrEqual(t, len(sink.entries()), 2)
+}
@@ -0,0 +1,62 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Kafka produces entries to a topic through a Kafka REST proxy (v2 API), like http://rest-proxy:8082. Each entry is a
+// JSON record, with the configured labels added to its fields.
+type Kafka struct {
+	client *http.Client
+	url    string
+	labels map[string]string
+}
+
+func NewKafka(client *http.Client, proxyURL, topic string, labels map[string]string) *Kafka {
+	return &Kafka{client: client, url: strings.TrimRight(proxyURL, "/") + "/topics/" + url.PathEscape(topic), labels: labels}
+}
+
+type kafkaRecord struct {
+	Value json.RawMessage `json:"value"`
+}
+
+func (k *Kafka) Send(ctx context.Context, entries []logger.SinkEntry) error {
+	var body struct {
+		Records []kafkaRecord `json:"records"`
+	}
+	for _, e := range entries {
+		value, err := k.value(e)
+		if err != nil {
+			return err
+		}
+		body.Records = append(body.Records, kafkaRecord{Value: value})
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return post(ctx, k.client, k.url, "application/vnd.kafka.json.v2+json", b)
+}
+
+// value returns the JSON of the entry, with the labels added.
+func (k *Kafka) value(e logger.SinkEntry) (json.RawMessage, error) {
+	if len(k.labels) == 0 {
+		return e.JSON, nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(e.JSON, &m); err != nil {
+		return nil, err
+	}
+	for key, v := range k.labels {
+		if _, ok := m[key]; !ok {
+			m[key] = v
+		}
+	}
+	return json.Marshal(m)
+}
@@ -0,0 +1,63 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Loki pushes entries to the push API of Grafana Loki, like http://loki:3100/loki/api/v1/push. Entries are sent as
+// JSON lines, in streams labeled with their level and the configured labels.
+type Loki struct {
+	client *http.Client
+	url    string
+	labels map[string]string
+}
+
+func NewLoki(client *http.Client, url string, labels map[string]string) *Loki {
+	return &Loki{client: client, url: url, labels: labels}
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (l *Loki) Send(ctx context.Context, entries []logger.SinkEntry) error {
+	streams := make(map[string]*lokiStream)
+	for _, e := range entries {
+		level := e.Level.String()
+		s, ok := streams[level]
+		if !ok {
+			labels := map[string]string{"level": level}
+			for k, v := range l.labels {
+				labels[k] = v
+			}
+			s = &lokiStream{Stream: labels}
+			streams[level] = s
+		}
+		s.Values = append(s.Values, [2]string{strconv.FormatInt(e.Time.UnixNano(), 10), string(e.JSON)})
+	}
+
+	levels := make([]string, 0, len(streams))
+	for level := range streams {
+		levels = append(levels, level)
+	}
+	sort.Strings(levels)
+	var body struct {
+		Streams []*lokiStream `json:"streams"`
+	}
+	for _, level := range levels {
+		body.Streams = append(body.Streams, streams[level])
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return post(ctx, l.client, strings.TrimRight(l.url, "/"), "application/json", b)
+}
@@ -0,0 +1,145 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// OTLP exports entries to an OpenTelemetry collector with OTLP/HTTP in JSON encoding, like
+// http://collector:4318/v1/logs. The configured labels become attributes of the resource.
+type OTLP struct {
+	client *http.Client
+	url    string
+	labels map[string]string
+}
+
+func NewOTLP(client *http.Client, url string, labels map[string]string) *OTLP {
+	return &OTLP{client: client, url: url, labels: labels}
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           otlpValue       `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes,omitempty"`
+}
+
+func (o *OTLP) Send(ctx context.Context, entries []logger.SinkEntry) error {
+	resource := []otlpAttribute{stringAttribute("service.name", "chainlink")}
+	for _, k := range sortedKeys(o.labels) {
+		resource = append(resource, stringAttribute(k, o.labels[k]))
+	}
+	records := make([]otlpLogRecord, 0, len(entries))
+	for _, e := range entries {
+		attrs := []otlpAttribute{stringAttribute("logger", e.Logger)}
+		if e.Caller != "" {
+			attrs = append(attrs, stringAttribute("caller", e.Caller))
+		}
+		for _, k := range sortedKeys(e.Fields) {
+			attrs = append(attrs, otlpAttribute{Key: k, Value: newOTLPValue(e.Fields[k])})
+		}
+		msg := e.Message
+		records = append(records, otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(e.Time.UnixNano(), 10),
+			SeverityNumber: severityNumber(e.Level),
+			SeverityText:   e.Level.CapitalString(),
+			Body:           otlpValue{StringValue: &msg},
+			Attributes:     attrs,
+		})
+	}
+
+	body := map[string]interface{}{
+		"resourceLogs": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{"attributes": resource},
+			"scopeLogs": []interface{}{map[string]interface{}{
+				"scope":      map[string]string{"name": "chainlink"},
+				"logRecords": records,
+			}},
+		}},
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return post(ctx, o.client, o.url, "application/json", b)
+}
+
+// severityNumber returns the OpenTelemetry severity number of the level.
+func severityNumber(lvl zapcore.Level) int {
+	switch lvl {
+	case zapcore.DebugLevel:
+		return 5
+	case zapcore.InfoLevel:
+		return 9
+	case zapcore.WarnLevel:
+		return 13
+	case zapcore.ErrorLevel:
+		return 17
+	case zapcore.DPanicLevel:
+		return 20
+	case zapcore.PanicLevel:
+		return 21
+	case zapcore.FatalLevel:
+		return 24
+	default:
+		return 1
+	}
+}
+
+func stringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func newOTLPValue(v interface{}) otlpValue {
+	switch t := v.(type) {
+	case string:
+		return otlpValue{StringValue: &t}
+	case bool:
+		return otlpValue{BoolValue: &t}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		s := fmt.Sprint(t)
+		return otlpValue{IntValue: &s}
+	case float32:
+		f := float64(t)
+		return otlpValue{DoubleValue: &f}
+	case float64:
+		return otlpValue{DoubleValue: &t}
+	}
+	var s string
+	if b, err := json.Marshal(v); err == nil {
+		s = string(b)
+	} else {
+		s = fmt.Sprint(v)
+	}
+	return otlpValue{StringValue: &s}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,79 @@
+// Package sinks implements the log sinks of the [[Log.Sinks]] config: Loki, Kafka and OTLP collectors.
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	TypeLoki  = "loki"
+	TypeKafka = "kafka"
+	TypeOTLP  = "otlp"
+)
+
+// New returns the Sink of cfg.
+func New(cfg config.LogSink, client *http.Client) (logger.Sink, error) {
+	u := cfg.URL()
+	if u == nil {
+		return nil, fmt.Errorf("log sink %s has no URL", cfg.Name())
+	}
+	switch cfg.Type() {
+	case TypeLoki:
+		return NewLoki(client, u.String(), cfg.Labels()), nil
+	case TypeKafka:
+		return NewKafka(client, u.String(), cfg.Topic(), cfg.Labels()), nil
+	case TypeOTLP:
+		return NewOTLP(client, u.String(), cfg.Labels()), nil
+	default:
+		return nil, fmt.Errorf("log sink %s has unsupported type %q", cfg.Name(), cfg.Type())
+	}
+}
+
+// NewConfigs returns the logger configs of the sinks.
+func NewConfigs(cfgs []config.LogSink) ([]logger.SinkConfig, error) {
+	var sinkCfgs []logger.SinkConfig
+	for _, cfg := range cfgs {
+		sink, err := New(cfg, http.DefaultClient)
+		if err != nil {
+			return nil, err
+		}
+		sinkCfgs = append(sinkCfgs, logger.SinkConfig{
+			Name:          cfg.Name(),
+			Sink:          sink,
+			Level:         cfg.Level(),
+			BufferSize:    int(cfg.BufferSize()),
+			BatchSize:     int(cfg.BatchSize()),
+			FlushInterval: cfg.FlushInterval(),
+			DropWhenFull:  cfg.DropWhenFull(),
+		})
+	}
+	return sinkCfgs, nil
+}
+
+// post sends body to url. Credentials of the URL, if any, are sent with basic authentication.
+func post(ctx context.Context, client *http.Client, url, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s responded with %s: %s", req.URL.Redacted(), resp.Status, strings.TrimSpace(string(b)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
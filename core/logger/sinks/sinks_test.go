@@ -0,0 +1,128 @@
+package sinks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var testEntries = []logger.SinkEntry{
+	{
+		Time:    time.Unix(0, 1700000000000000000),
+		Level:   zapcore.InfoLevel,
+		Logger:  "Test",
+		Caller:  "test/test.go:1",
+		Message: "hello",
+		Fields:  map[string]interface{}{"job": int64(1), "ok": true},
+		JSON:    []byte(`{"level":"info","msg":"hello","job":1,"ok":true}`),
+	},
+	{
+		Time:    time.Unix(0, 1700000000000000001),
+		Level:   zapcore.ErrorLevel,
+		Logger:  "Test",
+		Message: "failed",
+		JSON:    []byte(`{"level":"error","msg":"failed"}`),
+	},
+}
+
+// newServer returns a server which records the body of the last request, and responds with status.
+func newServer(t *testing.T, status int) (*httptest.Server, func() (*http.Request, map[string]interface{})) {
+	var (
+		req  *http.Request
+		body map[string]interface{}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req = r
+		require.NoError(t, json.Unmarshal(b, &body))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() (*http.Request, map[string]interface{}) { return req, body }
+}
+
+func TestLoki_Send(t *testing.T) {
+	srv, last := newServer(t, http.StatusNoContent)
+	l := NewLoki(srv.Client(), srv.URL+"/loki/api/v1/push", map[string]string{"env": "test"})
+
+	require.NoError(t, l.Send(testutils.Context(t), testEntries))
+
+	req, body := last()
+	assert.Equal(t, "/loki/api/v1/push", req.URL.Path)
+	assert.Equal(t, map[string]interface{}{"streams": []interface{}{
+		map[string]interface{}{
+			"stream": map[string]interface{}{"env": "test", "level": "error"},
+			"values": []interface{}{[]interface{}{"1700000000000000001", `{"level":"error","msg":"failed"}`}},
+		},
+		map[string]interface{}{
+			"stream": map[string]interface{}{"env": "test", "level": "info"},
+			"values": []interface{}{[]interface{}{"1700000000000000000", `{"level":"info","msg":"hello","job":1,"ok":true}`}},
+		},
+	}}, body)
+}
+
+func TestKafka_Send(t *testing.T) {
+	srv, last := newServer(t, http.StatusOK)
+	k := NewKafka(srv.Client(), srv.URL+"/", "chainlink-logs", map[string]string{"env": "test"})
+
+	require.NoError(t, k.Send(testutils.Context(t), testEntries))
+
+	req, body := last()
+	assert.Equal(t, "/topics/chainlink-logs", req.URL.Path)
+	assert.Equal(t, "application/vnd.kafka.json.v2+json", req.Header.Get("Content-Type"))
+	records := body["records"].([]interface{})
+	require.Len(t, records, 2)
+	assert.Equal(t, map[string]interface{}{"level": "info", "msg": "hello", "job": float64(1), "ok": true, "env": "test"},
+		records[0].(map[string]interface{})["value"])
+}
+
+func TestOTLP_Send(t *testing.T) {
+	srv, last := newServer(t, http.StatusOK)
+	o := NewOTLP(srv.Client(), srv.URL+"/v1/logs", map[string]string{"env": "test"})
+
+	require.NoError(t, o.Send(testutils.Context(t), testEntries))
+
+	req, body := last()
+	assert.Equal(t, "/v1/logs", req.URL.Path)
+	resourceLogs := body["resourceLogs"].([]interface{})[0].(map[string]interface{})
+	resource := resourceLogs["resource"].(map[string]interface{})["attributes"].([]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "service.name", "value": map[string]interface{}{"stringValue": "chainlink"}},
+		map[string]interface{}{"key": "env", "value": map[string]interface{}{"stringValue": "test"}},
+	}, resource)
+	records := resourceLogs["scopeLogs"].([]interface{})[0].(map[string]interface{})["logRecords"].([]interface{})
+	require.Len(t, records, 2)
+	assert.Equal(t, map[string]interface{}{
+		"timeUnixNano":   "1700000000000000000",
+		"severityNumber": float64(9),
+		"severityText":   "INFO",
+		"body":           map[string]interface{}{"stringValue": "hello"},
+		"attributes": []interface{}{
+			map[string]interface{}{"key": "logger", "value": map[string]interface{}{"stringValue": "Test"}},
+			map[string]interface{}{"key": "caller", "value": map[string]interface{}{"stringValue": "test/test.go:1"}},
+			map[string]interface{}{"key": "job", "value": map[string]interface{}{"intValue": "1"}},
+			map[string]interface{}{"key": "ok", "value": map[string]interface{}{"boolValue": true}},
+		},
+	}, records[0])
+	assert.Equal(t, float64(17), records[1].(map[string]interface{})["severityNumber"])
+}
+
+func TestSend_error(t *testing.T) {
+	srv, _ := newServer(t, http.StatusTooManyRequests)
+	l := NewLoki(srv.Client(), srv.URL, nil)
+
+	err := l.Send(testutils.Context(t), testEntries)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "429 Too Many Requests")
+}
@@ -0,0 +1,181 @@
+package balancemonitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+
+	caigotypes "github.com/smartcontractkit/caigo/types"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/adapters"
+	pkgsolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+	starkchain "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/chain"
+	"github.com/smartcontractkit/chainlink-starknet/relayer/pkg/starknet"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+// AccountsFunc returns the accounts of the node on a chain, e.g. its keys and the transmitters of its jobs.
+type AccountsFunc func(ctx context.Context) ([]string, error)
+
+// StarkNetFeeToken is the address of the ETH ERC20 contract, in which StarkNet fees are paid.
+const StarkNetFeeToken = "0x049d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7"
+
+type chain struct {
+	id       relay.ID
+	token    Token
+	accounts AccountsFunc
+	balance  func(ctx context.Context, account string) (*big.Int, error)
+}
+
+func (c *chain) ID() relay.ID { return c.id }
+
+func (c *chain) Token() Token { return c.token }
+
+func (c *chain) Accounts(ctx context.Context) ([]string, error) { return c.accounts(ctx) }
+
+func (c *chain) Balance(ctx context.Context, account string) (*big.Int, error) {
+	return c.balance(ctx, account)
+}
+
+// NewEVMChain returns a Chain which takes balances from the balance monitor of c, and falls back to querying the
+// node if it is disabled or has not seen the account yet.
+func NewEVMChain(c legacyevm.Chain, accounts AccountsFunc) Chain {
+	return &chain{
+		id:       relay.ID{Network: relay.EVM, ChainID: c.ID().String()},
+		token:    Token{Symbol: "ETH", Decimals: 18},
+		accounts: accounts,
+		balance: func(ctx context.Context, account string) (*big.Int, error) {
+			if !common.IsHexAddress(account) {
+				return nil, fmt.Errorf("invalid EVM address: %s", account)
+			}
+			addr := common.HexToAddress(account)
+			if bal := c.BalanceMonitor().GetEthBalance(addr); bal != nil {
+				return bal.ToInt(), nil
+			}
+			return c.Client().BalanceAt(ctx, addr, nil)
+		},
+	}
+}
+
+// NewSolanaChain returns a Chain for the Solana chain c, with balances in lamports.
+func NewSolanaChain(c pkgsolana.Chain, accounts AccountsFunc) Chain {
+	return &chain{
+		id:       relay.ID{Network: relay.Solana, ChainID: c.ID()},
+		token:    Token{Symbol: "SOL", Decimals: 9},
+		accounts: accounts,
+		balance: func(ctx context.Context, account string) (*big.Int, error) {
+			pk, err := solana.PublicKeyFromBase58(account)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Solana address: %w", err)
+			}
+			r, err := c.Reader()
+			if err != nil {
+				return nil, err
+			}
+			bal, err := r.Balance(pk)
+			if err != nil {
+				return nil, err
+			}
+			return new(big.Int).SetUint64(bal), nil
+		},
+	}
+}
+
+// NewCosmosChain returns a Chain for the Cosmos chain c, with balances in the gas token of the chain.
+func NewCosmosChain(c adapters.Chain, accounts AccountsFunc) Chain {
+	denom := c.Config().GasToken()
+	return &chain{
+		id:       relay.ID{Network: relay.Cosmos, ChainID: c.ID()},
+		token:    cosmosToken(denom),
+		accounts: accounts,
+		balance: func(ctx context.Context, account string) (*big.Int, error) {
+			addr, err := sdk.AccAddressFromBech32(account)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Cosmos address: %w", err)
+			}
+			r, err := c.Reader("")
+			if err != nil {
+				return nil, err
+			}
+			coin, err := r.Balance(addr, denom)
+			if err != nil {
+				return nil, err
+			}
+			return coin.Amount.BigInt(), nil
+		},
+	}
+}
+
+// cosmosToken returns the whole token of denom, as registered relative to the gas token of the chain, e.g. COSM with 6
+// decimals for ucosm.
+func cosmosToken(denom string) Token {
+	unit, ok := sdk.GetDenomUnit(denom)
+	if !ok || !unit.IsPositive() {
+		return Token{Symbol: strings.ToUpper(denom)}
+	}
+	var decimals int32
+	for one := sdk.OneDec(); unit.LT(one); unit = unit.MulInt64(10) {
+		decimals++
+	}
+	if decimals > 0 {
+		denom = denom[1:]
+	}
+	return Token{Symbol: strings.ToUpper(denom), Decimals: decimals}
+}
+
+// NewStarkNetChain returns a Chain for the StarkNet chain c, with balances of the ETH fee token in wei.
+func NewStarkNetChain(c starkchain.Chain, accounts AccountsFunc) Chain {
+	return &chain{
+		id:       relay.ID{Network: relay.StarkNet, ChainID: c.ID()},
+		token:    Token{Symbol: "ETH", Decimals: 18},
+		accounts: accounts,
+		balance: func(ctx context.Context, account string) (*big.Int, error) {
+			r, err := c.Reader()
+			if err != nil {
+				return nil, err
+			}
+			res, err := r.CallContract(ctx, starknet.CallOps{
+				ContractAddress: caigotypes.StrToFelt(StarkNetFeeToken),
+				Selector:        "balanceOf",
+				Calldata:        []string{account},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return parseUint256(res)
+		},
+	}
+}
+
+// parseUint256 parses a Cairo uint256, which is returned as its low and high 128 bits.
+func parseUint256(res []string) (*big.Int, error) {
+	if len(res) != 2 {
+		return nil, fmt.Errorf("expected uint256 of 2 felts, got %d", len(res))
+	}
+	low, ok := new(big.Int).SetString(res[0], 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid low felt: %s", res[0])
+	}
+	high, ok := new(big.Int).SetString(res[1], 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid high felt: %s", res[1])
+	}
+	return high.Lsh(high, 128).Add(high, low), nil
+}
+
+// NewAptosChain returns a Chain for the Aptos chain c, with balances in octas.
+func NewAptosChain(c *aptos.Chain, accounts AccountsFunc) Chain {
+	return &chain{
+		id:       relay.ID{Network: relay.Aptos, ChainID: c.ID()},
+		token:    Token{Symbol: "APT", Decimals: 8},
+		accounts: accounts,
+		balance:  c.Balance,
+	}
+}
@@ -0,0 +1,232 @@
+package balancemonitor
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+var promAccountBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "account_balance",
+	Help: "The balance of the account in whole units of the native token of the chain",
+}, []string{"chainFamily", "chainID", "account", "token"})
+
+// Token is the native token of a chain, in which balances are denominated.
+type Token struct {
+	Symbol string
+	// Decimals is the number of decimals of the base unit of the token, e.g. 18 for wei.
+	Decimals int32
+}
+
+// Chain is a chain whose account balances are monitored.
+type Chain interface {
+	ID() relay.ID
+	Token() Token
+	// Accounts returns the accounts of the node on the chain.
+	Accounts(ctx context.Context) ([]string, error)
+	// Balance returns the balance of account in base units of the token.
+	Balance(ctx context.Context, account string) (*big.Int, error)
+}
+
+// AccountBalance is the balance of an account, as of its last poll.
+type AccountBalance struct {
+	ChainID relay.ID
+	Account string
+	Token   Token
+	// Balance is in base units of the token. It is the last known balance if Err is set, or nil if there is none.
+	Balance *big.Int
+	// Low is true if the balance is below the low balance threshold of the chain family.
+	Low       bool
+	Err       error
+	UpdatedAt time.Time
+}
+
+// Amount returns the balance in whole tokens.
+func (b AccountBalance) Amount() decimal.Decimal {
+	if b.Balance == nil {
+		return decimal.Zero
+	}
+	return decimal.NewFromBigInt(b.Balance, -b.Token.Decimals)
+}
+
+// Monitor periodically polls the balances of the accounts of the node on every chain.
+type Monitor interface {
+	services.Service
+	// Balances returns the balance of every account, ordered by chain and account.
+	Balances() []AccountBalance
+}
+
+type monitor struct {
+	services.StateMachine
+	cfg    config.BalanceMonitor
+	chains func() []Chain
+	lggr   logger.SugaredLogger
+	now    func() time.Time
+
+	mu       sync.RWMutex
+	balances map[relay.ID]map[string]AccountBalance
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+// New returns a Monitor which polls the balances of every account on chains every cfg.PollPeriod(). Chains are
+// listed on every poll, so that chains which are enabled or disabled at runtime are picked up.
+func New(cfg config.BalanceMonitor, chains func() []Chain, lggr logger.Logger) Monitor {
+	return &monitor{
+		cfg:      cfg,
+		chains:   chains,
+		lggr:     logger.Sugared(lggr.Named("BalanceMonitor")),
+		now:      time.Now,
+		balances: make(map[relay.ID]map[string]AccountBalance),
+		chStop:   make(services.StopChan),
+	}
+}
+
+func (m *monitor) Start(context.Context) error {
+	return m.StartOnce("BalanceMonitor", func() error {
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *monitor) Close() error {
+	return m.StopOnce("BalanceMonitor", func() error {
+		close(m.chStop)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *monitor) Name() string {
+	return m.lggr.Name()
+}
+
+func (m *monitor) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy()}
+}
+
+func (m *monitor) Balances() []AccountBalance {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var balances []AccountBalance
+	for _, accounts := range m.balances {
+		for _, b := range accounts {
+			balances = append(balances, b)
+		}
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		a, b := balances[i], balances[j]
+		if a.ChainID.Network != b.ChainID.Network {
+			return a.ChainID.Network < b.ChainID.Network
+		}
+		if a.ChainID.ChainID != b.ChainID.ChainID {
+			return a.ChainID.ChainID < b.ChainID.ChainID
+		}
+		return a.Account < b.Account
+	})
+	return balances
+}
+
+func (m *monitor) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(m.cfg.PollPeriod())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.pollAll(ctx)
+		}
+	}
+}
+
+// pollAll polls every chain, and forgets the balances of chains which are gone.
+func (m *monitor) pollAll(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.PollPeriod())
+	defer cancel()
+	seen := make(map[relay.ID]struct{})
+	for _, c := range m.chains() {
+		seen[c.ID()] = struct{}{}
+		m.poll(ctx, c)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, accounts := range m.balances {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		for account, b := range accounts {
+			promAccountBalance.DeleteLabelValues(id.Network, id.ChainID, account, b.Token.Symbol)
+		}
+		delete(m.balances, id)
+	}
+}
+
+// poll updates the balance of every account of c. Accounts which cannot be listed keep their previous balances, and
+// accounts whose balance cannot be fetched keep their previous balance along with the error.
+func (m *monitor) poll(ctx context.Context, c Chain) {
+	id, token := c.ID(), c.Token()
+	lggr := m.lggr.With("chainFamily", id.Network, "chainID", id.ChainID)
+	accounts, err := c.Accounts(ctx)
+	if err != nil {
+		lggr.Warnw("Failed to list accounts", "err", err)
+		return
+	}
+
+	m.mu.RLock()
+	prev := m.balances[id]
+	m.mu.RUnlock()
+
+	threshold := m.cfg.LowBalance(id.Network).Shift(token.Decimals)
+	balances := make(map[string]AccountBalance, len(accounts))
+	for _, account := range accounts {
+		b := AccountBalance{ChainID: id, Account: account, Token: token, UpdatedAt: m.now()}
+		bal, err := c.Balance(ctx, account)
+		if err != nil {
+			lggr.Warnw("Failed to fetch balance", "account", account, "err", err)
+			if p, ok := prev[account]; ok {
+				b.Balance, b.UpdatedAt = p.Balance, p.UpdatedAt
+			}
+			b.Err = err
+		} else {
+			b.Balance = bal
+		}
+		if b.Balance != nil {
+			b.Low = threshold.IsPositive() && decimal.NewFromBigInt(b.Balance, 0).LessThan(threshold)
+			if b.Low && err == nil {
+				lggr.Warnw("Account balance is low", "account", account, "balance", b.Amount(), "token", token.Symbol)
+			}
+			f, _ := b.Amount().Float64()
+			promAccountBalance.WithLabelValues(id.Network, id.ChainID, account, token.Symbol).Set(f)
+		}
+		balances[account] = b
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for account, b := range prev {
+		if _, ok := balances[account]; !ok {
+			promAccountBalance.DeleteLabelValues(id.Network, id.ChainID, account, b.Token.Symbol)
+		}
+	}
+	m.balances[id] = balances
+}
@@ -0,0 +1,136 @@
+package balancemonitor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+type testConfig struct {
+	lowBalance map[string]decimal.Decimal
+}
+
+func (c testConfig) Enabled() bool             { return true }
+func (c testConfig) PollPeriod() time.Duration { return time.Hour }
+func (c testConfig) LowBalance(network string) decimal.Decimal {
+	return c.lowBalance[network]
+}
+
+type fakeChain struct {
+	id          relay.ID
+	token       Token
+	accountsErr error
+	balances    map[string]*big.Int
+	errs        map[string]error
+}
+
+func (f *fakeChain) ID() relay.ID { return f.id }
+
+func (f *fakeChain) Token() Token { return f.token }
+
+func (f *fakeChain) Accounts(context.Context) ([]string, error) {
+	if f.accountsErr != nil {
+		return nil, f.accountsErr
+	}
+	var accounts []string
+	for a := range f.balances {
+		accounts = append(accounts, a)
+	}
+	for a := range f.errs {
+		accounts = append(accounts, a)
+	}
+	return accounts, nil
+}
+
+func (f *fakeChain) Balance(_ context.Context, account string) (*big.Int, error) {
+	if err := f.errs[account]; err != nil {
+		return nil, err
+	}
+	return f.balances[account], nil
+}
+
+func TestMonitor_Balances(t *testing.T) {
+	ctx := testutils.Context(t)
+	sol := &fakeChain{
+		id:    relay.ID{Network: relay.Solana, ChainID: "devnet"},
+		token: Token{Symbol: "SOL", Decimals: 9},
+		balances: map[string]*big.Int{
+			"b": big.NewInt(500_000_000),   // 0.5 SOL
+			"a": big.NewInt(2_000_000_000), // 2 SOL
+		},
+	}
+	stark := &fakeChain{
+		id:       relay.ID{Network: relay.StarkNet, ChainID: "SN_GOERLI"},
+		token:    Token{Symbol: "ETH", Decimals: 18},
+		balances: map[string]*big.Int{"0x1": big.NewInt(1)},
+	}
+	chains := []Chain{stark, sol}
+	cfg := testConfig{lowBalance: map[string]decimal.Decimal{relay.Solana: decimal.NewFromInt(1)}}
+	m := New(cfg, func() []Chain { return chains }, logger.TestLogger(t)).(*monitor)
+
+	m.pollAll(ctx)
+	got := m.Balances()
+	require.Len(t, got, 3)
+	assert.Equal(t, sol.id, got[0].ChainID)
+	assert.Equal(t, "a", got[0].Account)
+	assert.False(t, got[0].Low)
+	assert.Equal(t, "2", got[0].Amount().String())
+	assert.Equal(t, "b", got[1].Account)
+	assert.True(t, got[1].Low)
+	assert.Equal(t, "0.5", got[1].Amount().String())
+	assert.Equal(t, stark.id, got[2].ChainID)
+	assert.False(t, got[2].Low, "no threshold for starknet")
+	assert.Equal(t, "0.000000000000000001", got[2].Amount().String())
+
+	t.Run("failed balance keeps previous balance", func(t *testing.T) {
+		sol.errs = map[string]error{"a": errors.New("rpc down")}
+		delete(sol.balances, "a")
+		m.pollAll(ctx)
+		got := m.Balances()
+		require.Len(t, got, 3)
+		assert.Equal(t, "a", got[0].Account)
+		assert.EqualError(t, got[0].Err, "rpc down")
+		assert.Equal(t, big.NewInt(2_000_000_000), got[0].Balance)
+	})
+
+	t.Run("failed accounts keep previous balances", func(t *testing.T) {
+		stark.accountsErr = errors.New("no jobs")
+		m.pollAll(ctx)
+		got := m.Balances()
+		require.Len(t, got, 3)
+		assert.Equal(t, stark.id, got[2].ChainID)
+		assert.NoError(t, got[2].Err)
+	})
+
+	t.Run("removed chain is forgotten", func(t *testing.T) {
+		chains = []Chain{sol}
+		m.pollAll(ctx)
+		got := m.Balances()
+		require.Len(t, got, 2)
+		for _, b := range got {
+			assert.Equal(t, sol.id, b.ChainID)
+		}
+	})
+}
+
+func Test_parseUint256(t *testing.T) {
+	got, err := parseUint256([]string{"0x2", "0x1"})
+	require.NoError(t, err)
+	exp := new(big.Int).Lsh(big.NewInt(1), 128)
+	assert.Equal(t, exp.Add(exp, big.NewInt(2)), got)
+
+	_, err = parseUint256([]string{"0x2"})
+	require.Error(t, err)
+	_, err = parseUint256([]string{"0x2", "zz"})
+	require.Error(t, err)
+}
@@ -3,10 +3,14 @@ package chainlink
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"net/http"
+	"slices"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -20,29 +24,39 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/balancemonitor"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	"github.com/smartcontractkit/chainlink/v2/core/services/dbmaintenance"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/policy"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/loopjob"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
@@ -50,16 +64,20 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/archive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/promreporter"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/tenancy"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -75,6 +93,14 @@ type Application interface {
 	GetSqlxDB() *sqlx.DB
 	GetConfig() GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	// ReloadConfig re-reads the config and secrets files, and applies the changes which are safe to apply without a
+	// restart: the log level, SQL logging, the UI CSA keys feature, and the nodes and gas estimator bounds of the EVM
+	// chains. The other changes are reported as requiring a restart.
+	ReloadConfig(ctx context.Context) (*ConfigReload, error)
+	// SetChainEnabled enables or disables the EVM chain at runtime. Disabling it stops the jobs running on it, then
+	// its head tracker, log poller, transaction manager and other services. It is not persisted, so the chain is
+	// enabled again on restart, as configured.
+	SetChainEnabled(ctx context.Context, id relay.ID, enabled bool) (commontypes.ChainStatus, error)
 	GetKeyStore() keystore.Master
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
@@ -86,22 +112,71 @@ type Application interface {
 	// V2 Jobs (TOML specified)
 	JobSpawner() job.Spawner
 	JobORM() job.ORM
+	// ReplicaJobORM returns a job ORM whose listings of jobs and pipeline runs are served by the read-only replica of
+	// the database, if configured. It is meant for the web UI and API only.
+	ReplicaJobORM() job.ORM
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	// BridgeCircuitBreakers returns the circuit breakers tracking the health of the bridges.
+	BridgeCircuitBreakers() *bridges.CircuitBreakers
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	TenancyORM() tenancy.ORM
 	TxmStorageService() txmgr.EvmTxStore
+	// DatabaseMaintenance returns the database maintenance service, or nil if it is disabled.
+	DatabaseMaintenance() dbmaintenance.Maintenance
+	// BalanceMonitor returns the monitor of the account balances on every chain, or nil if it is disabled.
+	BalanceMonitor() balancemonitor.Monitor
+	// TxFeeAccounting returns the ledger of the fees paid by the EVM transactions of the node, or nil if EVM is
+	// disabled.
+	TxFeeAccounting() feeaccounting.Accounting
+	// RunArchive returns the archive of pruned pipeline runs, or nil if it is disabled.
+	RunArchive() archive.Archiver
+	// Drain stops the node from accepting new work, so that it can be terminated without interrupting runs. New
+	// pipeline runs, which include the observations of OCR jobs, are rejected and the node is no longer ready. It cannot
+	// be undone. The node is ready to terminate once the runs in progress are done and the pending EVM transactions are
+	// broadcast, or once timeout elapsed.
+	Drain(ctx context.Context, timeout time.Duration) DrainStatus
+	// DrainStatus returns the progress of draining, if started.
+	DrainStatus(ctx context.Context) DrainStatus
+	// P2PPeers returns the state of the connections to remote peers, and the traffic with them of the running OCR
+	// instances. It returns ErrP2PDisabled if the node has no P2P peer.
+	P2PPeers() ([]ocrcommon.P2PPeer, error)
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	// ReplaceJob deletes the job with the given ID and creates the given job in a single transaction.
+	ReplaceJob(ctx context.Context, jobID int32, job *job.Job) error
+	// ShadowRunJob executes a run of the pipeline of job, which does not need to be created, without persisting it.
+	// Any overrides are merged into the pipeline vars.
+	ShadowRunJob(ctx context.Context, job job.Job, overrides map[string]interface{}) (*pipeline.Run, error)
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
+	// VerifyWebhookSignature checks the signature of a request to run a webhook job, if the job requires one.
+	VerifyWebhookSignature(jobUUID uuid.UUID, header http.Header, body []byte) error
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
-	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
+	// RunJobV2 executes a run of the job synchronously. Any overrides are merged into the pipeline vars before the
+	// run starts, replacing the values the node would otherwise provide.
+	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}, overrides map[string]interface{}) (int64, error)
+	// ReplayJobRun executes the pipeline of a finished run again, with the inputs captured by the run, and saves the
+	// replay linked to the run. The ethtx tasks, which write on-chain, are stubbed with their results in the run if
+	// stubOnChainWrites is true.
+	ReplayJobRun(ctx context.Context, runID int64, stubOnChainWrites bool) (*pipeline.Run, error)
 
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// VRFRequestManagers returns the request managers of the VRF V2 and V2 Plus jobs, keyed by job ID.
+	VRFRequestManagers() map[int32]vrfv2.RequestManager
+	// VRFSubscriptionMonitors returns the subscription monitors of the VRF V2 and V2 Plus jobs, keyed by job ID.
+	VRFSubscriptionMonitors() map[int32]vrfv2.SubscriptionReporter
+	// FunctionsListeners returns the request listeners of the Functions jobs, keyed by job ID.
+	FunctionsListeners() map[int32]functions.FunctionsListener
+	// FunctionsSecretsRotators returns the DON-hosted secrets rotators of the Functions jobs, keyed by job ID.
+	FunctionsSecretsRotators() map[int32]functions.SecretsRotator
+	// Gateways returns the gateways of the Gateway jobs, keyed by job ID.
+	Gateways() map[int32]gateway.Gateway
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast is
 	// set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error
@@ -118,14 +193,25 @@ type Application interface {
 type ChainlinkApplication struct {
 	relayers                 *CoreRelayerChainInteroperators
 	jobORM                   job.ORM
+	replicaJobORM            job.ORM
 	jobSpawner               job.Spawner
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
+	tenancyORM               tenancy.ORM
 	txmStorageService        txmgr.EvmTxStore
+	databaseMaintenance      dbmaintenance.Maintenance
+	balanceMonitor           balancemonitor.Monitor
+	txFeeAccounting          feeaccounting.Accounting
+	runArchive               archive.Archiver
+	drainer                  *drainer
+	peerWrapper              *ocrcommon.SingletonPeerWrapper
 	FeedsService             feeds.Service
+	vrfDelegate              *vrf.Delegate
+	ocr2Delegate             *ocr2.Delegate
+	gatewayDelegate          *gateway.Delegate
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
@@ -145,6 +231,7 @@ type ChainlinkApplication struct {
 
 	started     bool
 	startStopMu sync.Mutex
+	reloadMu    sync.Mutex
 }
 
 type ApplicationOpts struct {
@@ -152,6 +239,7 @@ type ApplicationOpts struct {
 	Logger                     logger.Logger
 	MailMon                    *mailbox.Monitor
 	SqlxDB                     *sqlx.DB
+	ReplicaDB                  *pg.ReplicaDB
 	KeyStore                   keystore.Master
 	RelayerChainInteroperators *CoreRelayerChainInteroperators
 	AuditLogger                audit.AuditLogger
@@ -198,6 +286,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		srvcs = append(srvcs, auditLogger)
 	}
 
+	if opts.ReplicaDB != nil {
+		srvcs = append(srvcs, opts.ReplicaDB)
+	}
+
 	var profiler *pyroscope.Profiler
 	if cfg.Pyroscope().ServerAddress() != "" {
 		globalLogger.Debug("Pyroscope (automatic pprof profiling) is enabled")
@@ -280,15 +372,37 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	case sessions.LocalAuth:
 		authenticationProvider = localauth.NewORM(db, cfg.WebServer().SessionTimeout().Duration(), globalLogger, cfg.Database(), auditLogger)
 		sessionReaper = localauth.NewSessionReaper(db.DB, cfg.WebServer(), globalLogger)
+		if requiredRole := cfg.WebServer().MFA().RequiredForRole(); requiredRole != "" {
+			authenticationProvider = sessions.NewMFAEnforcingProvider(authenticationProvider, sessions.UserRole(requiredRole))
+		}
 	default:
 		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
 	}
 
+	var (
+		jobPipelineCfg      = cfg.JobPipeline()
+		databaseMaintenance dbmaintenance.Maintenance
+		runArchive          archive.Archiver
+	)
+	if archiveCfg := cfg.Database().Maintenance().Archive(); archiveCfg.Enabled() {
+		var err error
+		if runArchive, err = archive.NewFromConfig(db, archiveCfg, globalLogger); err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize pipeline run archive")
+		}
+	}
+	if maintenanceCfg := cfg.Database().Maintenance(); maintenanceCfg.Enabled() {
+		globalLogger.Infow("DatabaseMaintenance: database maintenance is enabled, the pipeline run and transaction reapers are disabled", "interval", maintenanceCfg.Interval())
+		databaseMaintenance = dbmaintenance.New(db, maintenanceCfg, runArchive, globalLogger)
+		srvcs = append(srvcs, databaseMaintenance)
+		jobPipelineCfg = maintainedJobPipeline{jobPipelineCfg}
+	}
+	srvcs = append(srvcs, dbmaintenance.NewPartitionManager(db, cfg.Database().Partitions(), globalLogger))
+
 	var (
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg.Database(), cfg.JobPipeline().MaxSuccessfulRuns())
 		bridgeORM      = bridges.NewORM(db, globalLogger, cfg.Database())
 		mercuryORM     = mercury.NewORM(db, globalLogger, cfg.Database())
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, jobPipelineCfg, cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
 		jobORM         = job.NewORM(db, pipelineORM, bridgeORM, keyStore, globalLogger, cfg.Database())
 		txmORM         = txmgr.NewTxStore(db, globalLogger, cfg.Database())
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
@@ -301,6 +415,32 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	srvcs = append(srvcs, pipelineORM)
 
+	vrfDelegate := vrf.NewDelegate(
+		db,
+		keyStore,
+		pipelineRunner,
+		pipelineORM,
+		legacyEVMChains,
+		globalLogger,
+		cfg.Database(),
+		mailMon)
+	gatewayDelegate := gateway.NewDelegate(
+		legacyEVMChains,
+		keyStore.Eth(),
+		db,
+		cfg.Database(),
+		globalLogger)
+
+	loopJobCmds, err := loopjob.ParsePluginCmds(env.JobPluginCmds.Get())
+	if err != nil {
+		return nil, err
+	}
+	loopJobDelegate := loopjob.NewDelegate(
+		globalLogger,
+		plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register),
+		loopJobCmds)
+	srvcs = append(srvcs, loopJobDelegate)
+
 	var (
 		delegates = map[job.Type]job.Delegate{
 			job.DirectRequest: directrequest.NewDelegate(
@@ -316,21 +456,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger,
 				legacyEVMChains,
 				mailMon),
-			job.VRF: vrf.NewDelegate(
-				db,
-				keyStore,
-				pipelineRunner,
-				pipelineORM,
-				legacyEVMChains,
-				globalLogger,
-				cfg.Database(),
-				mailMon),
+			job.VRF: vrfDelegate,
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				jobORM,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				globalLogger,
@@ -340,12 +473,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger,
 				legacyEVMChains,
 				keyStore.Eth()),
-			job.Gateway: gateway.NewDelegate(
-				legacyEVMChains,
-				keyStore.Eth(),
-				db,
-				cfg.Database(),
-				globalLogger),
+			job.Gateway: gatewayDelegate,
+			job.LOOP:    loopJobDelegate,
 			job.Stream: streams.NewDelegate(
 				globalLogger,
 				streamRegistry,
@@ -353,6 +482,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				cfg.JobPipeline()),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
+		ocr2Delegate     *ocr2.Delegate
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -403,7 +533,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Debug("Off-chain reporting v2 enabled")
 		registrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register)
 		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.Insecure(), cfg.JobPipeline(), cfg.Database(), registrarConfig)
-		delegates[job.OffchainReporting2] = ocr2.NewDelegate(
+		ocr2Delegate = ocr2.NewDelegate(
 			db,
 			jobORM,
 			bridgeORM,
@@ -421,6 +551,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			opts.RelayerChainInteroperators,
 			mailMon,
 		)
+		delegates[job.OffchainReporting2] = ocr2Delegate
 		delegates[job.Bootstrap] = ocrbootstrap.NewDelegateBootstrap(
 			db,
 			jobORM,
@@ -440,8 +571,33 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	for _, c := range legacyEVMChains.Slice() {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
-	jobSpawner := job.NewSpawner(jobORM, cfg.Database(), healthChecker, delegates, db, globalLogger, lbs)
+	jobSpawner := job.NewSpawner(jobORM, cfg.Database(), healthChecker, RelayerChainChecker{Relayers: relayerChainInterops}, delegates, db, globalLogger, lbs)
 	srvcs = append(srvcs, jobSpawner, pipelineRunner)
+	if ocr2Delegate != nil {
+		ocr2Delegate.SetJobRestarter(jobSpawner)
+	}
+	if peerWrapper != nil {
+		peerWrapper.SetRestartHook(func(ctx context.Context) {
+			restartP2PJobs(ctx, jobSpawner, globalLogger)
+		})
+	}
+
+	var balanceMonitor balancemonitor.Monitor
+	if bmCfg := cfg.BalanceMonitor(); bmCfg.Enabled() {
+		balanceMonitor = balancemonitor.New(bmCfg, balanceMonitorChains(relayerChainInterops, keyStore, jobSpawner), globalLogger)
+		srvcs = append(srvcs, balanceMonitor)
+	}
+
+	var txFeeAccounting feeaccounting.Accounting
+	if cfg.EVMEnabled() {
+		txFeeAccounting = feeaccounting.New(feeaccounting.NewORM(db, globalLogger, cfg.Database()), globalLogger)
+		srvcs = append(srvcs, txFeeAccounting)
+	}
+
+	// The external initiators connected over gRPC trigger the webhook jobs started by the job spawner.
+	if eiGRPC := cfg.JobPipeline().ExternalInitiatorGRPC(); eiGRPC.Enabled() {
+		srvcs = append(srvcs, webhook.NewGRPCServer(eiGRPC, cfg.JobPipeline(), db, webhook.NewORM(db, globalLogger, cfg.Database()), webhookJobRunner, globalLogger))
+	}
 
 	// We start the log poller after the job spawner
 	// so jobs have a chance to apply their initial log filters.
@@ -485,14 +641,25 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	return &ChainlinkApplication{
 		relayers:                 opts.RelayerChainInteroperators,
 		jobORM:                   jobORM,
+		replicaJobORM:            jobORM.WithReplica(opts.ReplicaDB),
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		tenancyORM:               tenancy.NewORM(db, globalLogger, cfg.Database()),
 		txmStorageService:        txmORM,
+		databaseMaintenance:      databaseMaintenance,
+		balanceMonitor:           balanceMonitor,
+		txFeeAccounting:          txFeeAccounting,
+		runArchive:               runArchive,
+		drainer:                  newDrainer(pipelineRunner, evmPendingTxs(relayerChainInterops, txmORM), globalLogger),
+		peerWrapper:              peerWrapper,
 		FeedsService:             feedsService,
+		vrfDelegate:              vrfDelegate,
+		ocr2Delegate:             ocr2Delegate,
+		gatewayDelegate:          gatewayDelegate,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
@@ -522,6 +689,159 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+func (app *ChainlinkApplication) ReloadConfig(ctx context.Context) (r *ConfigReload, err error) {
+	app.reloadMu.Lock()
+	defer app.reloadMu.Unlock()
+
+	cfg, ok := app.Config.(*generalConfig)
+	if !ok {
+		return nil, errors.New("config does not support reloading")
+	}
+	next, r, err := cfg.reload()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload config: %w", err)
+	}
+	defer func() {
+		data := map[string]interface{}{
+			"applied":         r.Applied,
+			"restartRequired": r.RestartRequired,
+			"reinitialized":   r.Reinitialized,
+		}
+		if err != nil {
+			data["err"] = err.Error()
+		}
+		app.AuditLogger.Audit(audit.ConfigUpdated, data)
+	}()
+
+	reloadChains := make(map[int]bool)
+	for _, c := range r.Applied {
+		switch c.Field {
+		case "Log.Level":
+			if err = app.SetLogLevel(next.logLevel()); err != nil {
+				return r, err
+			}
+			r.Reinitialized = append(r.Reinitialized, "Logger")
+		case "Database.LogQueries":
+			app.Config.SetLogSQL(next.logSQL())
+		case "Feature.UICSAKeys":
+			cfg.setUICSAKeys(next.FeatureUICSAKeys())
+		default:
+			if m := evmFieldRegex.FindStringSubmatch(c.Field); m != nil {
+				i, _ := strconv.Atoi(m[1])
+				reloadChains[i] = true
+			}
+		}
+	}
+	for i, evmCfg := range next.EVMConfigs() {
+		if !reloadChains[i] || !evmCfg.IsEnabled() {
+			continue
+		}
+		var chain legacyevm.Chain
+		chain, err = app.relayers.LegacyEVMChains().Get(evmCfg.ChainID.String())
+		if err != nil {
+			return r, err
+		}
+		var reinitialized []string
+		reinitialized, err = chain.ReloadConfig(ctx, evmCfg)
+		r.Reinitialized = append(r.Reinitialized, reinitialized...)
+		if err != nil {
+			return r, err
+		}
+	}
+
+	cfg.setReloaded(next)
+	app.logger.Infow("Reloaded config", "applied", len(r.Applied), "restartRequired", len(r.RestartRequired), "reinitialized", r.Reinitialized)
+	return r, nil
+}
+
+func (app *ChainlinkApplication) SetChainEnabled(ctx context.Context, id relay.ID, enabled bool) (commontypes.ChainStatus, error) {
+	app.reloadMu.Lock()
+	defer app.reloadMu.Unlock()
+
+	if enabled {
+		if err := app.enableChain(ctx, id); err != nil {
+			return commontypes.ChainStatus{}, err
+		}
+	} else {
+		if err := app.disableChain(ctx, id); err != nil {
+			return commontypes.ChainStatus{}, err
+		}
+	}
+	return app.relayers.ChainStatus(ctx, id)
+}
+
+func (app *ChainlinkApplication) disableChain(ctx context.Context, id relay.ID) error {
+	if id.Network != relay.EVM {
+		return fmt.Errorf("%w: %s", ErrChainNotToggleable, id.Name())
+	}
+	chain, err := app.relayers.LegacyEVMChains().Get(id.ChainID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", chains.ErrNotFound, err)
+	}
+	app.logger.Infow("Disabling chain", "chain", id.Name())
+
+	// stop the jobs first, so that they don't use the chain while it is closing
+	app.jobSpawner.DisableChain(id)
+
+	var removed []services.ServiceCtx
+	if app.Config.Feature().LogPoller() {
+		if err = chain.LogPoller().Close(); err != nil {
+			app.logger.Errorw("Failed to close log poller of disabled chain", "chain", id.Name(), "err", err)
+		}
+		removed = append(removed, chain.LogPoller())
+	}
+	relayer, err := app.relayers.DisableEVMChain(ctx, id)
+	if relayer != nil {
+		removed = append(removed, relayer)
+	}
+
+	app.startStopMu.Lock()
+	defer app.startStopMu.Unlock()
+	for _, srv := range removed {
+		if err2 := app.HealthChecker.Unregister(srv.Name()); err2 != nil {
+			app.logger.Warnw("Failed to unregister service of disabled chain from health checker", "service", srv.Name(), "err", err2)
+		}
+		app.srvcs = slices.DeleteFunc(app.srvcs, func(s services.ServiceCtx) bool { return s == srv })
+	}
+	return err
+}
+
+func (app *ChainlinkApplication) enableChain(ctx context.Context, id relay.ID) error {
+	app.logger.Infow("Enabling chain", "chain", id.Name())
+	relayer, err := app.relayers.EnableEVMChain(ctx, id)
+	if err != nil {
+		return err
+	}
+	// keep the start order of the services, for them to be closed in the right order: the relayer before the job
+	// spawner, and the log poller after it
+	before, after := []services.ServiceCtx{relayer}, []services.ServiceCtx(nil)
+	if app.Config.Feature().LogPoller() {
+		if err = relayer.Chain().LogPoller().Start(ctx); err != nil {
+			return fmt.Errorf("failed to start log poller of chain %s: %w", id.Name(), err)
+		}
+		after = append(after, relayer.Chain().LogPoller())
+	}
+
+	app.startStopMu.Lock()
+	for _, srv := range append(before, after...) {
+		if err = app.HealthChecker.Register(srv); err != nil {
+			app.logger.Warnw("Failed to register service of enabled chain with health checker", "service", srv.Name(), "err", err)
+		}
+	}
+	i := slices.IndexFunc(app.srvcs, func(s services.ServiceCtx) bool { return s == app.jobSpawner })
+	if i < 0 {
+		i = len(app.srvcs)
+	}
+	app.srvcs = slices.Insert(app.srvcs, i, before...)
+	app.srvcs = slices.Insert(app.srvcs, min(i+len(before)+1, len(app.srvcs)), after...)
+	app.startStopMu.Unlock()
+
+	app.jobSpawner.EnableChain(id)
+	// the jobs registering with the log broadcaster are started in the background, unlike at startup
+	relayer.Chain().LogBroadcaster().DependentReady()
+	return nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -658,10 +978,64 @@ func (app *ChainlinkApplication) JobORM() job.ORM {
 	return app.jobORM
 }
 
+func (app *ChainlinkApplication) ReplicaJobORM() job.ORM {
+	return app.replicaJobORM
+}
+
+func (app *ChainlinkApplication) DatabaseMaintenance() dbmaintenance.Maintenance {
+	return app.databaseMaintenance
+}
+
+func (app *ChainlinkApplication) BalanceMonitor() balancemonitor.Monitor {
+	return app.balanceMonitor
+}
+
+func (app *ChainlinkApplication) TxFeeAccounting() feeaccounting.Accounting {
+	return app.txFeeAccounting
+}
+
+func (app *ChainlinkApplication) RunArchive() archive.Archiver {
+	return app.runArchive
+}
+
+func (app *ChainlinkApplication) Drain(ctx context.Context, timeout time.Duration) DrainStatus {
+	return app.drainer.Drain(ctx, timeout)
+}
+
+func (app *ChainlinkApplication) DrainStatus(ctx context.Context) DrainStatus {
+	return app.drainer.Status(ctx)
+}
+
+// ErrP2PDisabled is returned by P2PPeers if P2P is disabled, or neither OCR nor OCR2 is enabled.
+var ErrP2PDisabled = errors.New("P2P is disabled")
+
+func (app *ChainlinkApplication) P2PPeers() ([]ocrcommon.P2PPeer, error) {
+	if app.peerWrapper == nil {
+		return nil, ErrP2PDisabled
+	}
+	return app.peerWrapper.Peers(), nil
+}
+
+// restartP2PJobs restarts the active jobs using the P2P peer, after it restarted.
+func restartP2PJobs(ctx context.Context, spawner job.Spawner, lggr logger.Logger) {
+	for jobID, jb := range spawner.ActiveJobs() {
+		switch jb.Type {
+		case job.OffchainReporting, job.OffchainReporting2, job.Bootstrap:
+			if err := spawner.RestartJob(ctx, jobID); err != nil {
+				lggr.Errorw("Failed to restart job after the P2P peer restarted", "jobID", jobID, "err", err)
+			}
+		}
+	}
+}
+
 func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	return app.pipelineRunner.BridgeCircuitBreakers()
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -670,6 +1044,10 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) TenancyORM() tenancy.ORM {
+	return app.tenancyORM
+}
+
 // TODO BCF-2516 remove this all together remove EVM specifics
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.GetRelayers().LegacyEVMChains().ChainNodeConfigs()
@@ -697,9 +1075,23 @@ func (app *ChainlinkApplication) WakeSessionReaper() {
 }
 
 func (app *ChainlinkApplication) AddJobV2(ctx context.Context, j *job.Job) error {
+	if err := app.checkJobPolicy(*j); err != nil {
+		return err
+	}
 	return app.jobSpawner.CreateJob(j, pg.WithParentCtx(ctx))
 }
 
+// checkJobPolicy checks j against the policy rules of the node. Warnings are only logged.
+func (app *ChainlinkApplication) checkJobPolicy(j job.Job) error {
+	violations, err := job.CheckPolicy(app.Config.JobPipeline().PolicyRules(), j)
+	for _, v := range violations {
+		if v.Severity == policy.SeverityWarning {
+			app.logger.Warnw("Job spec violates policy", "type", j.Type, "externalJobID", j.ExternalJobID, "rule", v.Rule, "violation", v.Message)
+		}
+	}
+	return err
+}
+
 func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) error {
 	// Do not allow the job to be deleted if it is managed by the Feeds Manager
 	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
@@ -714,15 +1106,63 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(jobID, pg.WithParentCtx(ctx))
 }
 
+func (app *ChainlinkApplication) ReplaceJob(ctx context.Context, jobID int32, j *job.Job) error {
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be replaced in the feeds manager")
+	}
+	if err = app.checkJobPolicy(*j); err != nil {
+		return err
+	}
+
+	q := pg.NewQ(app.sqlxDB, app.logger, app.Config.Database(), pg.WithParentCtx(ctx))
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if txerr := app.jobSpawner.DeleteJob(jobID, pg.WithQueryer(tx), pg.WithParentCtx(ctx)); txerr != nil {
+			return txerr
+		}
+		return app.jobSpawner.CreateJob(j, pg.WithQueryer(tx), pg.WithParentCtx(ctx))
+	})
+	if err == nil {
+		return nil
+	}
+
+	// The deletion was rolled back, so stop the services of the new job, if any, and restart the existing job.
+	if j.ID != 0 {
+		if derr := app.jobSpawner.DeleteJob(j.ID, pg.WithParentCtx(ctx)); derr != nil {
+			app.logger.Debugw("Stopped the services of the job which failed to replace the existing job", "jobID", j.ID, "err", derr)
+		}
+		j.ID = 0
+	}
+	existing, ferr := app.jobORM.FindJob(ctx, jobID)
+	if errors.Is(ferr, sql.ErrNoRows) {
+		return err
+	} else if ferr != nil {
+		return multierr.Combine(err, errors.Wrapf(ferr, "failed to find job %d to restart it", jobID))
+	}
+	if serr := app.jobSpawner.StartService(ctx, existing); serr != nil {
+		return multierr.Combine(err, errors.Wrapf(serr, "failed to restart job %d", jobID))
+	}
+	return err
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
 
+func (app *ChainlinkApplication) VerifyWebhookSignature(jobUUID uuid.UUID, header http.Header, body []byte) error {
+	return app.webhookJobRunner.VerifySignature(jobUUID, header, body)
+}
+
 // Only used for local testing, not supported by the UI.
 func (app *ChainlinkApplication) RunJobV2(
 	ctx context.Context,
 	jobID int32,
 	meta map[string]interface{},
+	overrides map[string]interface{},
 ) (int64, error) {
 	if build.IsProd() {
 		return 0, errors.New("manual job runs not supported on secure builds")
@@ -778,11 +1218,73 @@ func (app *ChainlinkApplication) RunJobV2(
 				},
 			}
 		}
+		mergeVars(vars, overrides)
 		runID, _, err = app.pipelineRunner.ExecuteAndInsertFinishedRun(ctx, *jb.PipelineSpec, pipeline.NewVarsFrom(vars), app.logger, saveTasks)
 	}
 	return runID, err
 }
 
+func (app *ChainlinkApplication) ShadowRunJob(ctx context.Context, jb job.Job, overrides map[string]interface{}) (*pipeline.Run, error) {
+	vars := map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    jb.ID,
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"meta": map[string]interface{}{},
+		},
+	}
+	mergeVars(vars, overrides)
+	spec := pipeline.Spec{
+		DotDagSource:    jb.Pipeline.Source,
+		MaxTaskDuration: jb.MaxTaskDuration,
+		JobID:           jb.ID,
+		JobName:         jb.Name.ValueOrZero(),
+		JobType:         string(jb.Type),
+	}
+	run, _, err := app.pipelineRunner.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(vars), app.logger)
+	return run, err
+}
+
+func (app *ChainlinkApplication) ReplayJobRun(ctx context.Context, runID int64, stubOnChainWrites bool) (*pipeline.Run, error) {
+	original, err := app.pipelineORM.FindRun(runID)
+	if err != nil {
+		return nil, err
+	}
+	if original.PipelineSpec.JobID == 0 {
+		return nil, errors.Errorf("the job of run %d no longer exists", runID)
+	}
+	if !stubOnChainWrites && !app.Config.JobPipeline().ReplayOnChainWritesEnabled() {
+		p, err := pipeline.Parse(original.PipelineSpec.DotDagSource)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(p.Tasks, func(t pipeline.Task) bool { return t.Type() == pipeline.TaskTypeETHTx }) {
+			return nil, errors.New("replaying the on-chain writes of job runs requires JobPipeline.ReplayOnChainWritesEnabled")
+		}
+	}
+	var stubbed []pipeline.TaskType
+	if stubOnChainWrites {
+		stubbed = []pipeline.TaskType{pipeline.TaskTypeETHTx}
+	}
+	return app.pipelineRunner.ReplayRun(ctx, original, stubbed, app.logger)
+}
+
+// mergeVars recursively merges src into dst. Nested maps are merged key by key, any other value in src replaces the
+// value in dst.
+func mergeVars(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeVars(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -795,6 +1297,32 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) VRFRequestManagers() map[int32]vrfv2.RequestManager {
+	return app.vrfDelegate.RequestManagers()
+}
+
+func (app *ChainlinkApplication) VRFSubscriptionMonitors() map[int32]vrfv2.SubscriptionReporter {
+	return app.vrfDelegate.SubscriptionMonitors()
+}
+
+func (app *ChainlinkApplication) FunctionsListeners() map[int32]functions.FunctionsListener {
+	if app.ocr2Delegate == nil {
+		return nil
+	}
+	return app.ocr2Delegate.FunctionsListeners()
+}
+
+func (app *ChainlinkApplication) FunctionsSecretsRotators() map[int32]functions.SecretsRotator {
+	if app.ocr2Delegate == nil {
+		return nil
+	}
+	return app.ocr2Delegate.SecretsRotators()
+}
+
+func (app *ChainlinkApplication) Gateways() map[int32]gateway.Gateway {
+	return app.gatewayDelegate.Gateways()
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
@@ -838,3 +1366,10 @@ func (app *ChainlinkApplication) GetWebAuthnConfiguration() sessions.WebAuthnCon
 func (app *ChainlinkApplication) ID() uuid.UUID {
 	return app.Config.AppID()
 }
+
+// maintainedJobPipeline disables the pipeline run reaper, since old runs are pruned by the database maintenance service.
+type maintainedJobPipeline struct {
+	config.JobPipeline
+}
+
+func (maintainedJobPipeline) ReaperInterval() time.Duration { return 0 }
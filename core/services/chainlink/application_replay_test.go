@@ -0,0 +1,71 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestChainlinkApplication_ReplayJobRun(t *testing.T) {
+	ctx := testutils.Context(t)
+	const (
+		ethTxSource = `
+			encode [type=ethabiencode abi="f(uint256 x)" data=<{"x": 1}>];
+			submit [type=ethtx to="0x0000000000000000000000000000000000000001" data="$(encode)"];
+			encode -> submit;
+		`
+		memoSource = `memo [type=memo value="42"];`
+	)
+
+	setup := func(t *testing.T, source string, replayOnChainWrites bool) (*ChainlinkApplication, *pipelinemocks.Runner) {
+		opts := GeneralConfigOpts{}
+		if replayOnChainWrites {
+			opts.ConfigStrings = []string{"[JobPipeline]\nReplayOnChainWritesEnabled = true"}
+		}
+		cfg, err := opts.New()
+		require.NoError(t, err)
+
+		orm := pipelinemocks.NewORM(t)
+		orm.On("FindRun", int64(1)).Return(pipeline.Run{ID: 1, PipelineSpec: pipeline.Spec{JobID: 1, DotDagSource: source}}, nil)
+		runner := pipelinemocks.NewRunner(t)
+		return &ChainlinkApplication{Config: cfg, pipelineORM: orm, pipelineRunner: runner}, runner
+	}
+
+	t.Run("stubbed on-chain writes", func(t *testing.T) {
+		app, runner := setup(t, ethTxSource, false)
+		runner.On("ReplayRun", mock.Anything, mock.Anything, []pipeline.TaskType{pipeline.TaskTypeETHTx}, mock.Anything).Return(&pipeline.Run{ID: 2}, nil)
+
+		run, err := app.ReplayJobRun(ctx, 1, true)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), run.ID)
+	})
+
+	t.Run("on-chain writes not enabled", func(t *testing.T) {
+		app, _ := setup(t, ethTxSource, false)
+
+		_, err := app.ReplayJobRun(ctx, 1, false)
+		require.ErrorContains(t, err, "requires JobPipeline.ReplayOnChainWritesEnabled")
+	})
+
+	t.Run("on-chain writes enabled", func(t *testing.T) {
+		app, runner := setup(t, ethTxSource, true)
+		runner.On("ReplayRun", mock.Anything, mock.Anything, []pipeline.TaskType(nil), mock.Anything).Return(&pipeline.Run{ID: 2}, nil)
+
+		_, err := app.ReplayJobRun(ctx, 1, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("no on-chain writes", func(t *testing.T) {
+		app, runner := setup(t, memoSource, false)
+		runner.On("ReplayRun", mock.Anything, mock.Anything, []pipeline.TaskType(nil), mock.Anything).Return(&pipeline.Run{ID: 2}, nil)
+
+		_, err := app.ReplayJobRun(ctx, 1, false)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,136 @@
+package chainlink
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/params"
+	pkgsolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+	starkchain "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/chain"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
+	"github.com/smartcontractkit/chainlink/v2/core/services/balancemonitor"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+// balanceMonitorChains returns a func listing the chains of relayers whose balances are monitored. The accounts of a
+// chain are the keys of its family plus the transmitters of the OCR2 jobs on the chain, which are the only accounts
+// known for StarkNet. Chains served by LOOPP relayers are skipped, since they are not accessible from the node.
+func balanceMonitorChains(relayers RelayerChainInteroperators, ks keystore.Master, spawner job.Spawner) func() []balancemonitor.Chain {
+	transmitters := func(id relay.ID) []string {
+		var accounts []string
+		for _, j := range spawner.ActiveJobs() {
+			if j.OCR2OracleSpec == nil || !j.OCR2OracleSpec.TransmitterID.Valid {
+				continue
+			}
+			if rid, err := j.OCR2OracleSpec.RelayID(); err == nil && rid == id {
+				accounts = append(accounts, j.OCR2OracleSpec.TransmitterID.String)
+			}
+		}
+		return accounts
+	}
+	// withTransmitters returns the accounts of keys plus the transmitters on id, without duplicates.
+	withTransmitters := func(id relay.ID, keys func() ([]string, error)) balancemonitor.AccountsFunc {
+		return func(context.Context) ([]string, error) {
+			accounts, err := keys()
+			if err != nil {
+				return nil, err
+			}
+			seen := make(map[string]struct{}, len(accounts))
+			for _, a := range accounts {
+				seen[a] = struct{}{}
+			}
+			for _, a := range transmitters(id) {
+				if _, ok := seen[a]; !ok {
+					seen[a] = struct{}{}
+					accounts = append(accounts, a)
+				}
+			}
+			return accounts, nil
+		}
+	}
+	ext := func(network relay.Network) (exts []loop.RelayerExt) {
+		for _, r := range relayers.List(FilterRelayersByType(network)).Slice() {
+			if sa, ok := r.(*relay.ServerAdapter); ok {
+				exts = append(exts, sa.RelayerExt)
+			}
+		}
+		return
+	}
+
+	return func() (chains []balancemonitor.Chain) {
+		for _, c := range relayers.LegacyEVMChains().Slice() {
+			chainID := c.ID()
+			id := relay.ID{Network: relay.EVM, ChainID: chainID.String()}
+			chains = append(chains, balancemonitor.NewEVMChain(c, withTransmitters(id, func() ([]string, error) {
+				return evmAccounts(ks, chainID)
+			})))
+		}
+		for _, c := range relayers.LegacyCosmosChains().Slice() {
+			prefix := c.Config().Bech32Prefix()
+			id := relay.ID{Network: relay.Cosmos, ChainID: c.ID()}
+			chains = append(chains, balancemonitor.NewCosmosChain(c, withTransmitters(id, func() (accounts []string, err error) {
+				keys, err := ks.Cosmos().GetAll()
+				if err != nil {
+					return nil, err
+				}
+				for _, k := range keys {
+					a, err := params.CreateBech32Address(k.PublicKeyStr(), prefix)
+					if err != nil {
+						return nil, err
+					}
+					accounts = append(accounts, a)
+				}
+				return
+			})))
+		}
+		for _, e := range ext(relay.Solana) {
+			if c, ok := e.(pkgsolana.Chain); ok {
+				id := relay.ID{Network: relay.Solana, ChainID: c.ID()}
+				chains = append(chains, balancemonitor.NewSolanaChain(c, withTransmitters(id, func() (accounts []string, err error) {
+					keys, err := ks.Solana().GetAll()
+					for _, k := range keys {
+						accounts = append(accounts, k.PublicKeyStr())
+					}
+					return
+				})))
+			}
+		}
+		for _, e := range ext(relay.StarkNet) {
+			if c, ok := e.(starkchain.Chain); ok {
+				id := relay.ID{Network: relay.StarkNet, ChainID: c.ID()}
+				chains = append(chains, balancemonitor.NewStarkNetChain(c, withTransmitters(id, func() ([]string, error) {
+					return nil, nil
+				})))
+			}
+		}
+		for _, e := range ext(relay.Aptos) {
+			if c, ok := e.(*aptos.Chain); ok {
+				id := relay.ID{Network: relay.Aptos, ChainID: c.ID()}
+				chains = append(chains, balancemonitor.NewAptosChain(c, withTransmitters(id, func() (accounts []string, err error) {
+					keys, err := ks.Aptos().GetAll()
+					for _, k := range keys {
+						accounts = append(accounts, k.Account())
+					}
+					return
+				})))
+			}
+		}
+		return
+	}
+}
+
+func evmAccounts(ks keystore.Master, chainID *big.Int) ([]string, error) {
+	addrs, err := ks.Eth().EnabledAddressesForChain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]string, len(addrs))
+	for i, a := range addrs {
+		accounts[i] = a.Hex()
+	}
+	return accounts, nil
+}
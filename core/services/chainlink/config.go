@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana"
 	stkcfg "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
 	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/config/docs"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
@@ -34,6 +35,8 @@ import (
 type Config struct {
 	toml.Core
 
+	EVMProfiles evmcfg.EVMProfiles `toml:",omitempty"`
+
 	EVM evmcfg.EVMConfigs `toml:",omitempty"`
 
 	Cosmos coscfg.TOMLConfigs `toml:",omitempty"`
@@ -41,6 +44,8 @@ type Config struct {
 	Solana solana.TOMLConfigs `toml:",omitempty"`
 
 	Starknet stkcfg.TOMLConfigs `toml:",omitempty"`
+
+	Aptos aptos.TOMLConfigs `toml:",omitempty"`
 }
 
 // TOMLString returns a TOML encoded string.
@@ -89,6 +94,15 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// validateProfiles returns an error if an EVM chain inherits from an undefined profile.
+// This is used before defaults have been applied, since they resolve the profiles.
+func (c *Config) validateProfiles() error {
+	if err := c.EVM.ValidateProfiles(c.EVMProfiles); err != nil {
+		return fmt.Errorf("invalid configuration: %w", config.NamedMultiErrorList(err, "EVM"))
+	}
+	return nil
+}
+
 // setDefaults initializes unset fields with default values.
 func (c *Config) setDefaults() {
 	core := docs.CoreDefaults()
@@ -96,12 +110,21 @@ func (c *Config) setDefaults() {
 	c.Core = core
 
 	for i := range c.EVM {
-		if input := c.EVM[i]; input == nil {
+		input := c.EVM[i]
+		if input == nil {
 			c.EVM[i] = &evmcfg.EVMConfig{Chain: evmcfg.Defaults(nil)}
-		} else {
-			input.Chain = evmcfg.Defaults(input.ChainID, &input.Chain)
+			continue
+		}
+		with := []*evmcfg.Chain{&input.Chain}
+		if input.Profile != nil {
+			if profile := c.EVMProfiles.Chain(*input.Profile); profile != nil {
+				with = []*evmcfg.Chain{profile, &input.Chain}
+			}
 		}
+		input.Chain = evmcfg.Defaults(input.ChainID, with...)
 	}
+	// Profiles are partial, so they are dropped once folded into the chains inheriting from them.
+	c.EVMProfiles = nil
 
 	for i := range c.Cosmos {
 		if c.Cosmos[i] == nil {
@@ -123,11 +146,22 @@ func (c *Config) setDefaults() {
 		}
 		c.Starknet[i].Chain.SetDefaults()
 	}
+
+	for i := range c.Aptos {
+		if c.Aptos[i] == nil {
+			c.Aptos[i] = new(aptos.TOMLConfig)
+		}
+		c.Aptos[i].ChainConfig.SetDefaults()
+	}
 }
 
 func (c *Config) SetFrom(f *Config) (err error) {
 	c.Core.SetFrom(&f.Core)
 
+	if err0 := c.EVMProfiles.SetFrom(&f.EVMProfiles); err0 != nil {
+		err = multierr.Append(err, config.NamedMultiErrorList(err0, "EVMProfiles"))
+	}
+
 	if err1 := c.EVM.SetFrom(&f.EVM); err1 != nil {
 		err = multierr.Append(err, config.NamedMultiErrorList(err1, "EVM"))
 	}
@@ -144,6 +178,10 @@ func (c *Config) SetFrom(f *Config) (err error) {
 		err = multierr.Append(err, config.NamedMultiErrorList(err4, "Starknet"))
 	}
 
+	if err5 := c.Aptos.SetFrom(&f.Aptos); err5 != nil {
+		err = multierr.Append(err, config.NamedMultiErrorList(err5, "Aptos"))
+	}
+
 	_, err = utils.MultiErrorList(err)
 
 	return err
@@ -248,6 +286,12 @@ func (s *Secrets) setEnv() error {
 			return err
 		}
 	}
+	if dbReplicaURL := env.DatabaseReplicaURL.Get(); dbReplicaURL != "" {
+		s.Database.ReplicaURL = new(models.SecretURL)
+		if err := s.Database.ReplicaURL.UnmarshalText([]byte(dbReplicaURL)); err != nil {
+			return err
+		}
+	}
 	if env.DatabaseAllowSimplePasswords.IsTrue() {
 		s.Database.AllowSimplePasswords = new(bool)
 		*s.Database.AllowSimplePasswords = true
@@ -0,0 +1,42 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+type balanceMonitorConfig struct {
+	c toml.BalanceMonitor
+}
+
+func (b *balanceMonitorConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *balanceMonitorConfig) PollPeriod() time.Duration {
+	return b.c.PollPeriod.Duration()
+}
+
+func (b *balanceMonitorConfig) LowBalance(network string) decimal.Decimal {
+	var v *decimal.Decimal
+	switch network {
+	case relay.EVM:
+		v = b.c.LowBalance.EVM
+	case relay.Solana:
+		v = b.c.LowBalance.Solana
+	case relay.Cosmos:
+		v = b.c.LowBalance.Cosmos
+	case relay.StarkNet:
+		v = b.c.LowBalance.StarkNet
+	case relay.Aptos:
+		v = b.c.LowBalance.Aptos
+	}
+	if v == nil {
+		return decimal.Zero
+	}
+	return *v
+}
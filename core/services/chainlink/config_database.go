@@ -50,6 +50,10 @@ func (l *lockConfig) LeaseRefreshInterval() time.Duration {
 	return l.c.LeaseRefreshInterval.Duration()
 }
 
+func (l *lockConfig) WarmStandby() bool {
+	return *l.c.WarmStandby
+}
+
 type listenerConfig struct {
 	c toml.DatabaseListener
 }
@@ -66,6 +70,120 @@ func (l *listenerConfig) FallbackPollInterval() time.Duration {
 	return l.c.FallbackPollInterval.Duration()
 }
 
+type maintenanceConfig struct {
+	c toml.DatabaseMaintenance
+}
+
+func (m *maintenanceConfig) Enabled() bool {
+	return *m.c.Enabled
+}
+
+func (m *maintenanceConfig) Interval() time.Duration {
+	return m.c.Interval.Duration()
+}
+
+func (m *maintenanceConfig) Window() *config.TimeWindow {
+	if w := m.c.Window; w != nil && !w.IsZero() {
+		return w
+	}
+	return nil
+}
+
+func (m *maintenanceConfig) BatchSize() uint32 {
+	return *m.c.BatchSize
+}
+
+func (m *maintenanceConfig) PipelineRuns() config.MaintenancePolicy {
+	return &maintenancePolicy{m.c.PipelineRuns}
+}
+
+func (m *maintenanceConfig) Logs() config.MaintenancePolicy {
+	return &maintenancePolicy{m.c.Logs}
+}
+
+func (m *maintenanceConfig) Transactions() config.MaintenancePolicy {
+	return &maintenancePolicy{m.c.Transactions}
+}
+
+func (m *maintenanceConfig) Heads() config.MaintenancePolicy {
+	return &maintenancePolicy{m.c.Heads}
+}
+
+func (m *maintenanceConfig) Archive() config.Archive {
+	return &archiveConfig{m.c.Archive}
+}
+
+type maintenancePolicy struct {
+	c toml.MaintenancePolicy
+}
+
+func (p *maintenancePolicy) Retention() time.Duration {
+	return p.c.Retention.Duration()
+}
+
+func (p *maintenancePolicy) Vacuum() bool {
+	return *p.c.Vacuum
+}
+
+type archiveConfig struct {
+	c toml.DatabaseArchive
+}
+
+func (a *archiveConfig) Enabled() bool {
+	return *a.c.Enabled
+}
+
+func (a *archiveConfig) URL() *url.URL {
+	if a.c.URL.IsZero() {
+		return nil
+	}
+	return a.c.URL.URL()
+}
+
+func (a *archiveConfig) Endpoint() *url.URL {
+	if a.c.Endpoint.IsZero() {
+		return nil
+	}
+	return a.c.Endpoint.URL()
+}
+
+type partitionsConfig struct {
+	c toml.DatabasePartitions
+}
+
+func (p *partitionsConfig) CheckInterval() time.Duration {
+	return p.c.CheckInterval.Duration()
+}
+
+func (p *partitionsConfig) Premake() uint32 {
+	return *p.c.Premake
+}
+
+func (p *partitionsConfig) LogsBlockRange() uint64 {
+	return *p.c.LogsBlockRange
+}
+
+func (p *partitionsConfig) PipelineRunsInterval() time.Duration {
+	return p.c.PipelineRunsInterval.Duration()
+}
+
+type replicaConfig struct {
+	c toml.DatabaseReplica
+	s toml.DatabaseSecrets
+}
+
+func (r *replicaConfig) URL() *url.URL {
+	return r.s.ReplicaURL.URL()
+}
+
+func (r *replicaConfig) MaxLag() time.Duration {
+	return r.c.MaxLag.Duration()
+}
+
+func (r *replicaConfig) LagCheckInterval() time.Duration {
+	return r.c.LagCheckInterval.Duration()
+}
+
 var _ config.Database = (*databaseConfig)(nil)
 
 type databaseConfig struct {
@@ -93,6 +211,25 @@ func (d *databaseConfig) Listener() config.Listener {
 	}
 }
 
+func (d *databaseConfig) Maintenance() config.Maintenance {
+	return &maintenanceConfig{
+		c: d.c.Maintenance,
+	}
+}
+
+func (d *databaseConfig) Partitions() config.Partitions {
+	return &partitionsConfig{
+		c: d.c.Partitions,
+	}
+}
+
+func (d *databaseConfig) Replica() config.Replica {
+	return &replicaConfig{
+		c: d.c.Replica,
+		s: d.s,
+	}
+}
+
 func (d *databaseConfig) DefaultIdleInTxSessionTimeout() time.Duration {
 	return d.c.DefaultIdleInTxSessionTimeout.Duration()
 }
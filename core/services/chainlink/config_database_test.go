@@ -41,9 +41,34 @@ func TestDatabaseConfig(t *testing.T) {
 	assert.Equal(t, lock.LockingMode(), "none")
 	assert.Equal(t, lock.LeaseDuration(), 1*time.Minute)
 	assert.Equal(t, lock.LeaseRefreshInterval(), 1*time.Second)
+	assert.True(t, lock.WarmStandby())
 
 	l := db.Listener()
 	assert.Equal(t, l.MaxReconnectDuration(), 1*time.Minute)
 	assert.Equal(t, l.MinReconnectInterval(), 5*time.Minute)
 	assert.Equal(t, l.FallbackPollInterval(), 2*time.Minute)
+
+	m := db.Maintenance()
+	assert.True(t, m.Enabled())
+	assert.Equal(t, m.Interval(), 30*time.Minute)
+	assert.Equal(t, m.Window(), &config.TimeWindow{Start: 1 * time.Hour, End: 5 * time.Hour})
+	assert.Equal(t, m.BatchSize(), uint32(500))
+	assert.Equal(t, m.PipelineRuns().Retention(), 48*time.Hour)
+	assert.False(t, m.PipelineRuns().Vacuum())
+	assert.Equal(t, m.Transactions().Retention(), 336*time.Hour)
+	assert.True(t, m.Heads().Vacuum())
+	assert.True(t, m.Archive().Enabled())
+	assert.Equal(t, m.Archive().URL().String(), "s3://bucket/chainlink")
+	assert.Equal(t, m.Archive().Endpoint().String(), "http://localhost:9000")
+
+	p := db.Partitions()
+	assert.Equal(t, p.CheckInterval(), 30*time.Minute)
+	assert.Equal(t, p.Premake(), uint32(4))
+	assert.Equal(t, p.LogsBlockRange(), uint64(100_000))
+	assert.Equal(t, p.PipelineRunsInterval(), 24*time.Hour)
+
+	r := db.Replica()
+	assert.Equal(t, r.MaxLag(), 1*time.Minute)
+	assert.Equal(t, r.LagCheckInterval(), 1*time.Second)
+	assert.Nil(t, r.URL())
 }
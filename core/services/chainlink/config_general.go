@@ -18,11 +18,13 @@ import (
 	starknet "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/config"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
 	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
+	"github.com/smartcontractkit/chainlink/v2/core/config/secretsprovider"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -47,6 +49,18 @@ type generalConfig struct {
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
 	passwordMu sync.RWMutex // passwords are set after initialization
+
+	featureMu sync.RWMutex // for the mutable field Feature.UICSAKeys
+
+	// reloadOpts re-read the files the config was loaded from, and are nil unless loaded by Setup.
+	reloadOpts *GeneralConfigOpts
+	// secretsStrings are the secrets TOML documents last loaded.
+	secretsStrings []string
+	tomlMu         sync.RWMutex // for inputTOML and effectiveTOML, which change on reload
+
+	// secretsResolver fetches the secrets referenced from the secrets TOML.
+	secretsResolver *secretsprovider.Resolver
+	skipEnv         bool
 }
 
 // GeneralConfigOpts holds configuration options for creating a coreconfig.GeneralConfig via New().
@@ -63,6 +77,13 @@ type GeneralConfigOpts struct {
 	OverrideFn func(*Config, *Secrets)
 
 	SkipEnv bool
+
+	// SecretsResolver fetches the secrets referenced from the secrets TOML, like ${vault:secret/data/chainlink#password}.
+	// Defaults to secretsprovider.NewDefaultResolver.
+	SecretsResolver *secretsprovider.Resolver
+
+	configFiles, secretsFiles []string
+	setup                     bool
 }
 
 func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) error {
@@ -91,11 +112,17 @@ func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) e
 	}
 
 	o.SecretsStrings = secrets
+	o.configFiles, o.secretsFiles, o.setup = configFiles, secretsFiles, true
 	return nil
 }
 
 // parseConfig sets Config from the given TOML string, overriding any existing duplicate Config fields.
 func (o *GeneralConfigOpts) parseConfig(config string) error {
+	config, err := env.Interpolate(config)
+	if err != nil {
+		return fmt.Errorf("failed to interpolate config TOML: %w", err)
+	}
+
 	var c Config
 	if err2 := commonconfig.DecodeTOML(strings.NewReader(config), &c); err2 != nil {
 		return fmt.Errorf("failed to decode config TOML: %w", err2)
@@ -110,6 +137,11 @@ func (o *GeneralConfigOpts) parseConfig(config string) error {
 
 // parseSecrets sets Secrets from the given TOML string. Errors on overrides
 func (o *GeneralConfigOpts) parseSecrets(secrets string) error {
+	secrets, err := env.InterpolateSecrets(secrets, o.SecretsResolver.Fetch)
+	if err != nil {
+		return fmt.Errorf("failed to interpolate secrets TOML: %w", err)
+	}
+
 	var s Secrets
 	if err2 := commonconfig.DecodeTOML(strings.NewReader(secrets), &s); err2 != nil {
 		return fmt.Errorf("failed to decode secrets TOML: %w", err2)
@@ -137,6 +169,9 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 
 	_, warning := utils.MultiErrorList(o.Config.warnings())
 
+	if err = o.Config.validateProfiles(); err != nil {
+		return nil, err
+	}
 	o.Config.setDefaults()
 	if !o.SkipEnv {
 		err = o.Secrets.setEnv()
@@ -166,6 +201,13 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		c:             &o.Config,
 		secrets:       &o.Secrets,
 		warning:       warning,
+
+		secretsStrings:  o.SecretsStrings,
+		secretsResolver: o.SecretsResolver,
+		skipEnv:         o.SkipEnv,
+	}
+	if o.setup {
+		cfg.reloadOpts = &GeneralConfigOpts{SkipEnv: o.SkipEnv, SecretsResolver: o.SecretsResolver, configFiles: o.configFiles, secretsFiles: o.secretsFiles}
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -174,7 +216,46 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 	return cfg, nil
 }
 
+// RefetchSecrets fetches the secrets referenced from the secrets TOML again, bypassing the cache, and replaces the
+// secrets except for the passwords, which may have been overridden by flags. It is used when the secrets fetched at
+// startup are rejected, in case they were rotated, and must not be called once they are in use.
+func (g *generalConfig) RefetchSecrets() error {
+	if g.secretsResolver == nil || !g.secretsResolver.Fetched() {
+		return errors.New("no secrets were fetched from a secrets provider")
+	}
+	g.secretsResolver.Refresh()
+
+	g.tomlMu.RLock()
+	opts := GeneralConfigOpts{SecretsStrings: g.secretsStrings, SecretsResolver: g.secretsResolver}
+	g.tomlMu.RUnlock()
+	if err := opts.parse(); err != nil {
+		return err
+	}
+	if !g.skipEnv {
+		if err := opts.Secrets.setEnv(); err != nil {
+			return err
+		}
+	}
+
+	g.passwordMu.Lock()
+	defer g.passwordMu.Unlock()
+	opts.Secrets.Password = g.secrets.Password
+	if err := opts.Secrets.Validate(); err != nil {
+		return err
+	}
+	secretsTOML, err := opts.Secrets.TOMLString()
+	if err != nil {
+		return err
+	}
+	*g.secrets = opts.Secrets
+	g.secretsTOML = secretsTOML
+	return nil
+}
+
 func (o *GeneralConfigOpts) parse() (err error) {
+	if o.SecretsResolver == nil {
+		o.SecretsResolver = secretsprovider.NewDefaultResolver()
+	}
 	for _, c := range o.ConfigStrings {
 		err := o.parseConfig(c)
 		if err != nil {
@@ -209,6 +290,10 @@ func (g *generalConfig) StarknetConfigs() starknet.TOMLConfigs {
 	return g.c.Starknet
 }
 
+func (g *generalConfig) AptosConfigs() aptos.TOMLConfigs {
+	return g.c.Aptos
+}
+
 func (g *generalConfig) Validate() error {
 	return g.validate(g.secrets.Validate)
 }
@@ -257,9 +342,10 @@ func validateEnv() (err error) {
 }
 
 func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
+	input, effective := g.ConfigTOML()
 	log("# Secrets:\n%s\n", g.secretsTOML)
-	log("# Input Configuration:\n%s\n", g.inputTOML)
-	log("# Effective Configuration, with defaults applied:\n%s\n", g.effectiveTOML)
+	log("# Input Configuration:\n%s\n", input)
+	log("# Effective Configuration, with defaults applied:\n%s\n", effective)
 	if g.warning != nil {
 		warn("# Configuration warning:\n%s\n", g.warning)
 	}
@@ -267,10 +353,14 @@ func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
 
 // ConfigTOML implements chainlink.ConfigV2
 func (g *generalConfig) ConfigTOML() (user, effective string) {
+	g.tomlMu.RLock()
+	defer g.tomlMu.RUnlock()
 	return g.inputTOML, g.effectiveTOML
 }
 
 func (g *generalConfig) Feature() coreconfig.Feature {
+	g.featureMu.RLock()
+	defer g.featureMu.RUnlock()
 	return &featureConfig{c: g.c.Feature}
 }
 
@@ -291,6 +381,8 @@ func (g *generalConfig) FeatureLogPoller() bool {
 }
 
 func (g *generalConfig) FeatureUICSAKeys() bool {
+	g.featureMu.RLock()
+	defer g.featureMu.RUnlock()
 	return *g.c.Feature.UICSAKeys
 }
 
@@ -345,6 +437,15 @@ func (g *generalConfig) StarkNetEnabled() bool {
 	return false
 }
 
+func (g *generalConfig) AptosEnabled() bool {
+	for _, c := range g.c.Aptos {
+		if c.IsEnabled() {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *generalConfig) WebServer() config.WebServer {
 	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir}
 }
@@ -472,7 +573,8 @@ func (g *generalConfig) RootDir() string {
 
 func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
 	return &telemetryIngressConfig{
-		c: g.c.TelemetryIngress,
+		c:       g.c.TelemetryIngress,
+		rootDir: g.RootDir,
 	}
 }
 
@@ -508,4 +610,12 @@ func (g *generalConfig) Tracing() coreconfig.Tracing {
 	return &tracingConfig{s: g.c.Tracing}
 }
 
+func (g *generalConfig) BalanceMonitor() coreconfig.BalanceMonitor {
+	return &balanceMonitorConfig{c: g.c.BalanceMonitor}
+}
+
+func (g *generalConfig) Keystore() coreconfig.Keystore {
+	return &keystoreConfig{c: g.c.Keystore}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
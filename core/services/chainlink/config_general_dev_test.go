@@ -25,6 +25,7 @@ func TestTOMLGeneralConfig_DevModeInsecureConfig(t *testing.T) {
 		assert.False(t, config.Insecure().DisableRateLimiting())
 		assert.False(t, config.Insecure().InfiniteDepthQueries())
 		assert.False(t, config.Insecure().OCRDevelopmentMode())
+		assert.False(t, config.Insecure().SimulatedEVMChains())
 	})
 
 	t.Run("insecure config ignore override on non-dev builds", func(t *testing.T) {
@@ -34,6 +35,7 @@ func TestTOMLGeneralConfig_DevModeInsecureConfig(t *testing.T) {
 				*c.Insecure.DisableRateLimiting = true
 				*c.Insecure.InfiniteDepthQueries = true
 				*c.Insecure.OCRDevelopmentMode = true
+				*c.Insecure.SimulatedEVMChains = true
 			}}.New(logger.TestLogger(t))
 		require.NoError(t, err)
 
@@ -41,6 +43,7 @@ func TestTOMLGeneralConfig_DevModeInsecureConfig(t *testing.T) {
 		assert.True(t, config.Insecure().DisableRateLimiting())
 		assert.True(t, config.Insecure().InfiniteDepthQueries())
 		assert.True(t, config.OCRDevelopmentMode())
+		assert.True(t, config.Insecure().SimulatedEVMChains())
 	})
 
 	t.Run("ParseConfig accepts insecure values on dev builds", func(t *testing.T) {
@@ -45,6 +45,12 @@ func (g *generalConfig) SetLogSQL(logSQL bool) {
 	g.logMu.Unlock()
 }
 
+func (g *generalConfig) setUICSAKeys(enabled bool) {
+	g.featureMu.Lock()
+	g.c.Feature.UICSAKeys = &enabled
+	g.featureMu.Unlock()
+}
+
 func (g *generalConfig) SetPasswords(keystore, vrf *string) {
 	g.passwordMu.Lock()
 	defer g.passwordMu.Unlock()
@@ -29,3 +29,9 @@ func (i *insecureConfig) InfiniteDepthQueries() bool {
 	return build.IsDev() && i.c.InfiniteDepthQueries != nil &&
 		*i.c.InfiniteDepthQueries
 }
+
+func (i *insecureConfig) SimulatedEVMChains() bool {
+	// SimulatedEVMChains is allowed in TestBuilds as well
+	return (build.IsDev() || build.IsTest()) && i.c.SimulatedEVMChains != nil &&
+		*i.c.SimulatedEVMChains
+}
@@ -19,4 +19,5 @@ func TestInsecureConfig(t *testing.T) {
 	assert.False(t, ins.DisableRateLimiting())
 	assert.False(t, ins.OCRDevelopmentMode())
 	assert.False(t, ins.InfiniteDepthQueries())
+	assert.False(t, ins.SimulatedEVMChains())
 }
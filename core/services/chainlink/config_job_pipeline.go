@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"net"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -38,6 +39,10 @@ func (j *jobPipelineConfig) ReaperThreshold() time.Duration {
 	return j.c.ReaperThreshold.Duration()
 }
 
+func (j *jobPipelineConfig) ReplayOnChainWritesEnabled() bool {
+	return *j.c.ReplayOnChainWritesEnabled
+}
+
 func (j *jobPipelineConfig) ResultWriteQueueDepth() uint64 {
 	return uint64(*j.c.ResultWriteQueueDepth)
 }
@@ -45,3 +50,99 @@ func (j *jobPipelineConfig) ResultWriteQueueDepth() uint64 {
 func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 	return *j.c.ExternalInitiatorsEnabled
 }
+
+func (j *jobPipelineConfig) ExternalInitiatorGRPC() config.ExternalInitiatorGRPC {
+	return &externalInitiatorGRPCConfig{c: j.c.ExternalInitiatorGRPC}
+}
+
+func (j *jobPipelineConfig) PolicyRules() (rules []config.JobPolicyRule) {
+	for _, r := range j.c.PolicyRules {
+		rules = append(rules, &jobPolicyRuleConfig{c: r})
+	}
+	return
+}
+
+func (j *jobPipelineConfig) BridgeCircuitBreaker() config.BridgeCircuitBreaker {
+	return &bridgeCircuitBreakerConfig{c: j.c.BridgeCircuitBreaker}
+}
+
+var _ config.ExternalInitiatorGRPC = (*externalInitiatorGRPCConfig)(nil)
+
+type externalInitiatorGRPCConfig struct {
+	c toml.JobPipelineExternalInitiatorGRPC
+}
+
+func (e *externalInitiatorGRPCConfig) Enabled() bool {
+	return *e.c.Enabled
+}
+
+func (e *externalInitiatorGRPCConfig) ListenIP() net.IP {
+	return *e.c.ListenIP
+}
+
+func (e *externalInitiatorGRPCConfig) Port() uint16 {
+	return *e.c.Port
+}
+
+func (e *externalInitiatorGRPCConfig) CertPath() string {
+	return *e.c.CertPath
+}
+
+func (e *externalInitiatorGRPCConfig) KeyPath() string {
+	return *e.c.KeyPath
+}
+
+func (e *externalInitiatorGRPCConfig) ClientCAPath() string {
+	return *e.c.ClientCAPath
+}
+
+func (e *externalInitiatorGRPCConfig) HeartbeatInterval() time.Duration {
+	return e.c.HeartbeatInterval.Duration()
+}
+
+var _ config.BridgeCircuitBreaker = (*bridgeCircuitBreakerConfig)(nil)
+
+type bridgeCircuitBreakerConfig struct {
+	c toml.JobPipelineBridgeCircuitBreaker
+}
+
+func (b *bridgeCircuitBreakerConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *bridgeCircuitBreakerConfig) FailureThreshold() uint32 {
+	return *b.c.FailureThreshold
+}
+
+func (b *bridgeCircuitBreakerConfig) LatencySLO() time.Duration {
+	return b.c.LatencySLO.Duration()
+}
+
+func (b *bridgeCircuitBreakerConfig) OpenTimeout() time.Duration {
+	return b.c.OpenTimeout.Duration()
+}
+
+var _ config.JobPolicyRule = (*jobPolicyRuleConfig)(nil)
+
+type jobPolicyRuleConfig struct {
+	c toml.JobPipelinePolicyRule
+}
+
+func (r *jobPolicyRuleConfig) Name() string {
+	return *r.c.Name
+}
+
+func (r *jobPolicyRuleConfig) Expr() string {
+	return *r.c.Expr
+}
+
+func (r *jobPolicyRuleConfig) Severity() string {
+	if r.c.Severity == nil {
+		return "error"
+	}
+	return *r.c.Severity
+}
+
+func (r *jobPolicyRuleConfig) JobTypes() []string {
+	return r.c.JobTypes
+}
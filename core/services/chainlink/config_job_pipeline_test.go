@@ -28,6 +28,32 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, uint64(123456), jp.MaxSuccessfulRuns())
 	assert.Equal(t, 4*time.Hour, jp.ReaperInterval())
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
+	assert.True(t, jp.ReplayOnChainWritesEnabled())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
+
+	grpc := jp.ExternalInitiatorGRPC()
+	assert.True(t, grpc.Enabled())
+	assert.Equal(t, "192.158.1.39", grpc.ListenIP().String())
+	assert.Equal(t, uint16(6698), grpc.Port())
+	assert.Equal(t, "ei/cert/path", grpc.CertPath())
+	assert.Equal(t, "ei/key/path", grpc.KeyPath())
+	assert.Equal(t, "ei/ca/path", grpc.ClientCAPath())
+	assert.Equal(t, 5*time.Second, grpc.HeartbeatInterval())
+
+	breaker := jp.BridgeCircuitBreaker()
+	assert.True(t, breaker.Enabled())
+	assert.Equal(t, uint32(3), breaker.FailureThreshold())
+	assert.Equal(t, 2*time.Second, breaker.LatencySLO())
+	assert.Equal(t, time.Minute, breaker.OpenTimeout())
+
+	rules := jp.PolicyRules()
+	require.Len(t, rules, 2)
+	assert.Equal(t, "gas-limit", rules[0].Name())
+	assert.Equal(t, "gasLimit < 500000", rules[0].Expr())
+	assert.Equal(t, "error", rules[0].Severity())
+	assert.Equal(t, []string{"directrequest"}, rules[0].JobTypes())
+	assert.Equal(t, "max-task-duration", rules[1].Name())
+	assert.Equal(t, "warning", rules[1].Severity())
+	assert.Empty(t, rules[1].JobTypes())
 }
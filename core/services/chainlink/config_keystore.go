@@ -0,0 +1,15 @@
+package chainlink
+
+import "github.com/smartcontractkit/chainlink/v2/core/config/toml"
+
+type keystoreConfig struct {
+	c toml.Keystore
+}
+
+func (k *keystoreConfig) MasterKeyFile() string {
+	return *k.c.MasterKeyFile
+}
+
+func (k *keystoreConfig) AWSKMSKeyID() string {
+	return *k.c.AWSKMSKeyID
+}
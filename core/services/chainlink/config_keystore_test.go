@@ -0,0 +1,20 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystoreConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	ks := cfg.Keystore()
+	assert.Equal(t, "/path/to/master.key", ks.MasterKeyFile())
+	assert.Equal(t, "", ks.AWSKMSKeyID())
+}
@@ -0,0 +1,206 @@
+package chainlink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+// ConfigFindingSeverity is the severity of a ConfigFinding.
+type ConfigFindingSeverity string
+
+const (
+	// ConfigFindingError is a finding which prevents the node from working as configured.
+	ConfigFindingError ConfigFindingSeverity = "error"
+	// ConfigFindingWarning is a finding which hints at a misconfiguration.
+	ConfigFindingWarning ConfigFindingSeverity = "warning"
+)
+
+// ConfigFinding is an issue found by linting the config.
+type ConfigFinding struct {
+	Severity   ConfigFindingSeverity `json:"severity"`
+	Code       string                `json:"code"`
+	Field      string                `json:"field,omitempty"`
+	Message    string                `json:"message"`
+	Suggestion string                `json:"suggestion,omitempty"`
+}
+
+// ConfigLintOpts are the options of LintConfig.
+type ConfigLintOpts struct {
+	// SkipRPC skips the checks which dial the RPC nodes.
+	SkipRPC bool
+	// RPCTimeout bounds the checks of each RPC node.
+	RPCTimeout time.Duration
+}
+
+// deprecatedFields match the deprecated fields of the user config, along with their replacement.
+var deprecatedFields = []struct {
+	field      *regexp.Regexp
+	value      string // optional, if only the value is deprecated
+	suggestion string
+}{
+	{field: regexp.MustCompile(`^TelemetryIngress\.URL$`), suggestion: "use URL of [[TelemetryIngress.Endpoints]] instead"},
+	{field: regexp.MustCompile(`^TelemetryIngress\.ServerPubKey$`), suggestion: "use ServerPubKey of [[TelemetryIngress.Endpoints]] instead"},
+	{field: regexp.MustCompile(`^EVM(Profiles)?\[\d+\]\.GasEstimator\.Mode$`), value: "L2Suggested", suggestion: "use Mode = 'SuggestedPrice' instead"},
+}
+
+type configLinter struct {
+	// lookupHost resolves the host of an RPC URL.
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+	// probe returns the capabilities of the node at the RPC URL.
+	probe func(ctx context.Context, u *url.URL) (evmclient.Capabilities, error)
+}
+
+// LintConfig checks cfg beyond its validation: it flags the deprecated fields of the user config, the EVM finality
+// settings which conflict with the chain defaults and, unless skipped, the RPC nodes which cannot be reached or serve
+// another chain. Findings are sorted by field.
+func LintConfig(ctx context.Context, cfg GeneralConfig, opts ConfigLintOpts) ([]ConfigFinding, error) {
+	l := configLinter{
+		lookupHost: net.DefaultResolver.LookupHost,
+		probe: func(ctx context.Context, u *url.URL) (evmclient.Capabilities, error) {
+			c, err := rpc.DialContext(ctx, u.String())
+			if err != nil {
+				return evmclient.Capabilities{}, err
+			}
+			defer c.Close()
+			return evmclient.ProbeCapabilities(ctx, c)
+		},
+	}
+	return l.lint(ctx, cfg, opts)
+}
+
+func (l *configLinter) lint(ctx context.Context, cfg GeneralConfig, opts ConfigLintOpts) ([]ConfigFinding, error) {
+	user, _ := cfg.ConfigTOML()
+	findings, err := lintDeprecated(user)
+	if err != nil {
+		return nil, err
+	}
+	for i, c := range cfg.EVMConfigs() {
+		if !c.IsEnabled() {
+			continue
+		}
+		findings = append(findings, lintFinality(i, c)...)
+		if !opts.SkipRPC {
+			findings = append(findings, l.lintRPCs(ctx, i, c, opts.RPCTimeout)...)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool { return findings[i].Field < findings[j].Field })
+	return findings, nil
+}
+
+func lintDeprecated(user string) ([]ConfigFinding, error) {
+	fields, err := flattenTOML(user)
+	if err != nil {
+		return nil, err
+	}
+	var findings []ConfigFinding
+	for field, v := range fields {
+		for _, d := range deprecatedFields {
+			if !d.field.MatchString(field) || (d.value != "" && d.value != v) {
+				continue
+			}
+			msg := fmt.Sprintf("%s is deprecated", field)
+			if d.value != "" {
+				msg = fmt.Sprintf("%s = '%s' is deprecated", field, v)
+			}
+			findings = append(findings, ConfigFinding{
+				Severity:   ConfigFindingWarning,
+				Code:       "deprecated",
+				Field:      field,
+				Message:    msg,
+				Suggestion: d.suggestion,
+			})
+		}
+	}
+	return findings, nil
+}
+
+// lintFinality compares the finality settings of the chain with its defaults, if it has any.
+func lintFinality(i int, c *evmcfg.EVMConfig) (findings []ConfigFinding) {
+	defaults, name := evmcfg.DefaultsNamed(c.ChainID)
+	field := func(name string) string { return fmt.Sprintf("EVM[%d].%s", i, name) }
+	if name != "" {
+		if *defaults.FinalityTagEnabled && !*c.FinalityTagEnabled {
+			findings = append(findings, ConfigFinding{
+				Severity:   ConfigFindingWarning,
+				Code:       "finality-tag-disabled",
+				Field:      field("FinalityTagEnabled"),
+				Message:    fmt.Sprintf("FinalityTagEnabled is disabled, but enabled by default for chain %s (%s)", c.ChainID, name),
+				Suggestion: "remove the override, unless the RPC nodes do not support the finalized block tag",
+			})
+		}
+		if !*c.FinalityTagEnabled && *c.FinalityDepth < *defaults.FinalityDepth {
+			findings = append(findings, ConfigFinding{
+				Severity:   ConfigFindingWarning,
+				Code:       "finality-depth-below-default",
+				Field:      field("FinalityDepth"),
+				Message:    fmt.Sprintf("FinalityDepth %d is lower than the default %d for chain %s (%s), so deeper re-orgs are not detected", *c.FinalityDepth, *defaults.FinalityDepth, c.ChainID, name),
+				Suggestion: fmt.Sprintf("set FinalityDepth to at least %d", *defaults.FinalityDepth),
+			})
+		}
+	}
+	if keep := *c.LogKeepBlocksDepth; keep > 0 && keep < *c.FinalityDepth {
+		findings = append(findings, ConfigFinding{
+			Severity:   ConfigFindingWarning,
+			Code:       "log-keep-depth-below-finality",
+			Field:      field("LogKeepBlocksDepth"),
+			Message:    fmt.Sprintf("LogKeepBlocksDepth %d is lower than FinalityDepth %d, so logs may be pruned before they are finalized", keep, *c.FinalityDepth),
+			Suggestion: fmt.Sprintf("set LogKeepBlocksDepth to at least %d", *c.FinalityDepth),
+		})
+	}
+	return
+}
+
+// lintRPCs checks that every node of the chain resolves, serves the chain, and supports the finalized block tag if
+// enabled.
+func (l *configLinter) lintRPCs(ctx context.Context, i int, c *evmcfg.EVMConfig, timeout time.Duration) (findings []ConfigFinding) {
+	for j, n := range c.Nodes {
+		u := (*url.URL)(n.HTTPURL)
+		field := fmt.Sprintf("EVM[%d].Nodes[%d].HTTPURL", i, j)
+		if u == nil {
+			if n.WSURL == nil {
+				continue
+			}
+			u = (*url.URL)(n.WSURL)
+			field = fmt.Sprintf("EVM[%d].Nodes[%d].WSURL", i, j)
+		}
+		finding := func(code, msg string, args ...any) ConfigFinding {
+			return ConfigFinding{Severity: ConfigFindingError, Code: code, Field: field, Message: fmt.Sprintf("node %s: ", *n.Name) + fmt.Sprintf(msg, args...)}
+		}
+
+		nodeCtx, cancel := ctx, func() {}
+		if timeout > 0 {
+			nodeCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		if _, err := l.lookupHost(nodeCtx, u.Hostname()); err != nil {
+			cancel()
+			findings = append(findings, finding("rpc-unresolved", "failed to resolve host %s: %v", u.Hostname(), err))
+			continue
+		}
+		caps, err := l.probe(nodeCtx, u)
+		cancel()
+		if err != nil {
+			findings = append(findings, finding("rpc-unreachable", "failed to query: %v", err))
+			continue
+		}
+		if caps.ChainID.Cmp(c.ChainID.ToInt()) != 0 {
+			findings = append(findings, finding("rpc-chain-id-mismatch", "serves chain ID %s, but is configured for chain ID %s", caps.ChainID, c.ChainID))
+			continue
+		}
+		if *c.FinalityTagEnabled && !caps.FinalityTag {
+			f := finding("rpc-finality-tag-unsupported", "does not support the finalized block tag, which is enabled by FinalityTagEnabled")
+			f.Suggestion = "use a node which supports the finalized block tag, or disable FinalityTagEnabled"
+			findings = append(findings, f)
+		}
+	}
+	return
+}
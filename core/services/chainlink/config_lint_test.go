@@ -0,0 +1,118 @@
+//go:build !dev
+
+package chainlink
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestConfig_Lint(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{`
+[TelemetryIngress]
+URL = 'https://telemetry.example.com'
+
+[[EVM]]
+ChainID = '137'
+FinalityDepth = 100
+LogKeepBlocksDepth = 50
+
+[EVM.GasEstimator]
+Mode = 'L2Suggested'
+
+[[EVM.Nodes]]
+Name = 'unresolved'
+HTTPURL = 'https://unresolved.example.com'
+
+[[EVM.Nodes]]
+Name = 'unreachable'
+HTTPURL = 'https://unreachable.example.com'
+
+[[EVM.Nodes]]
+Name = 'mainnet'
+HTTPURL = 'https://mainnet.example.com'
+
+[[EVM.Nodes]]
+Name = 'polygon'
+WSURL = 'wss://polygon.example.com'
+
+[[EVM]]
+ChainID = '1'
+FinalityTagEnabled = true
+
+[[EVM.Nodes]]
+Name = 'no-finality-tag'
+HTTPURL = 'https://no-finality-tag.example.com'
+`},
+		SkipEnv: true,
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	l := configLinter{
+		lookupHost: func(ctx context.Context, host string) ([]string, error) {
+			if host == "unresolved.example.com" {
+				return nil, errors.New("no such host")
+			}
+			return []string{"127.0.0.1"}, nil
+		},
+		probe: func(ctx context.Context, u *url.URL) (evmclient.Capabilities, error) {
+			switch u.Host {
+			case "unreachable.example.com":
+				return evmclient.Capabilities{}, errors.New("connection refused")
+			case "mainnet.example.com", "no-finality-tag.example.com":
+				return evmclient.Capabilities{ChainID: big.NewInt(1)}, nil
+			}
+			return evmclient.Capabilities{ChainID: big.NewInt(137), FinalityTag: true}, nil
+		},
+	}
+
+	t.Run("skip rpc", func(t *testing.T) {
+		findings, err := l.lint(testutils.Context(t), cfg, ConfigLintOpts{SkipRPC: true})
+		require.NoError(t, err)
+		assert.Equal(t, []ConfigFinding{
+			{Severity: ConfigFindingWarning, Code: "finality-depth-below-default", Field: "EVM[0].FinalityDepth",
+				Message:    "FinalityDepth 100 is lower than the default 500 for chain 137 (Polygon Mainnet), so deeper re-orgs are not detected",
+				Suggestion: "set FinalityDepth to at least 500"},
+			{Severity: ConfigFindingWarning, Code: "deprecated", Field: "EVM[0].GasEstimator.Mode",
+				Message: "EVM[0].GasEstimator.Mode = 'L2Suggested' is deprecated", Suggestion: "use Mode = 'SuggestedPrice' instead"},
+			{Severity: ConfigFindingWarning, Code: "log-keep-depth-below-finality", Field: "EVM[0].LogKeepBlocksDepth",
+				Message:    "LogKeepBlocksDepth 50 is lower than FinalityDepth 100, so logs may be pruned before they are finalized",
+				Suggestion: "set LogKeepBlocksDepth to at least 100"},
+			{Severity: ConfigFindingWarning, Code: "deprecated", Field: "TelemetryIngress.URL",
+				Message: "TelemetryIngress.URL is deprecated", Suggestion: "use URL of [[TelemetryIngress.Endpoints]] instead"},
+		}, findings)
+	})
+
+	t.Run("rpc", func(t *testing.T) {
+		findings, err := l.lint(testutils.Context(t), cfg, ConfigLintOpts{})
+		require.NoError(t, err)
+		var rpcFindings []ConfigFinding
+		for _, f := range findings {
+			if f.Severity == ConfigFindingError {
+				rpcFindings = append(rpcFindings, f)
+			}
+		}
+		assert.Equal(t, []ConfigFinding{
+			{Severity: ConfigFindingError, Code: "rpc-unresolved", Field: "EVM[0].Nodes[0].HTTPURL",
+				Message: "node unresolved: failed to resolve host unresolved.example.com: no such host"},
+			{Severity: ConfigFindingError, Code: "rpc-unreachable", Field: "EVM[0].Nodes[1].HTTPURL",
+				Message: "node unreachable: failed to query: connection refused"},
+			{Severity: ConfigFindingError, Code: "rpc-chain-id-mismatch", Field: "EVM[0].Nodes[2].HTTPURL",
+				Message: "node mainnet: serves chain ID 1, but is configured for chain ID 137"},
+			{Severity: ConfigFindingError, Code: "rpc-finality-tag-unsupported", Field: "EVM[1].Nodes[0].HTTPURL",
+				Message:    "node no-finality-tag: does not support the finalized block tag, which is enabled by FinalityTagEnabled",
+				Suggestion: "use a node which supports the finalized block tag, or disable FinalityTagEnabled"},
+		}, rpcFindings)
+	})
+}
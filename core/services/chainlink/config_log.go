@@ -1,6 +1,9 @@
 package chainlink
 
 import (
+	"net/url"
+	"time"
+
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -61,3 +64,76 @@ func (l *logConfig) DefaultLevel() zapcore.Level {
 func (l *logConfig) Level() zapcore.Level {
 	return l.level()
 }
+
+func (l *logConfig) Sinks() (sinks []config.LogSink) {
+	for _, s := range l.c.Sinks {
+		sinks = append(sinks, &sinkConfig{c: s, level: l.level})
+	}
+	return
+}
+
+type sinkConfig struct {
+	c     toml.LogSink
+	level func() zapcore.Level
+}
+
+func (s *sinkConfig) Name() string {
+	return *s.c.Name
+}
+
+func (s *sinkConfig) Type() string {
+	return *s.c.Type
+}
+
+func (s *sinkConfig) URL() *url.URL {
+	if s.c.URL == nil || s.c.URL.IsZero() {
+		return nil
+	}
+	return s.c.URL.URL()
+}
+
+func (s *sinkConfig) Level() zapcore.Level {
+	if s.c.Level == nil {
+		return s.level()
+	}
+	return zapcore.Level(*s.c.Level)
+}
+
+func (s *sinkConfig) Topic() string {
+	if s.c.Topic == nil {
+		return ""
+	}
+	return *s.c.Topic
+}
+
+func (s *sinkConfig) BufferSize() uint32 {
+	if s.c.BufferSize == nil {
+		return 10000
+	}
+	return *s.c.BufferSize
+}
+
+func (s *sinkConfig) BatchSize() uint32 {
+	if s.c.BatchSize == nil {
+		return 500
+	}
+	return *s.c.BatchSize
+}
+
+func (s *sinkConfig) FlushInterval() time.Duration {
+	if s.c.FlushInterval == nil {
+		return time.Second
+	}
+	return s.c.FlushInterval.Duration()
+}
+
+func (s *sinkConfig) DropWhenFull() bool {
+	if s.c.DropWhenFull == nil {
+		return true
+	}
+	return *s.c.DropWhenFull
+}
+
+func (s *sinkConfig) Labels() map[string]string {
+	return s.c.Labels
+}
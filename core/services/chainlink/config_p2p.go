@@ -52,6 +52,13 @@ func (v *p2pv2) AnnounceAddresses() []string {
 	return nil
 }
 
+func (v *p2pv2) AnnounceAddressesRefreshInterval() commonconfig.Duration {
+	if d := v.c.AnnounceAddressesRefreshInterval; d != nil {
+		return *d
+	}
+	return commonconfig.Duration{}
+}
+
 func (v *p2pv2) DefaultBootstrappers() (locators []commontypes.BootstrapperLocator) {
 	if d := v.c.DefaultBootstrappers; d != nil {
 		return *d
@@ -26,6 +26,7 @@ func TestP2PConfig(t *testing.T) {
 	v2 := p2p.V2()
 	assert.False(t, v2.Enabled())
 	assert.Equal(t, []string{"a", "b", "c"}, v2.AnnounceAddresses())
+	assert.Equal(t, 5*time.Minute, v2.AnnounceAddressesRefreshInterval().Duration())
 	assert.ElementsMatch(
 		t,
 		[]commontypes.BootstrapperLocator{
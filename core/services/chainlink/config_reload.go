@@ -0,0 +1,170 @@
+package chainlink
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	gotoml "github.com/pelletier/go-toml/v2"
+)
+
+// ConfigChange is a field of the config which changed on reload.
+type ConfigChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// ConfigReload is the outcome of reloading the config and secrets files.
+type ConfigReload struct {
+	// Applied are the changes which were applied to the running node.
+	Applied []ConfigChange `json:"applied"`
+	// RestartRequired are the changes which only take effect once the node is restarted.
+	RestartRequired []ConfigChange `json:"restartRequired"`
+	// Reinitialized are the services which were re-initialized to apply the changes.
+	Reinitialized []string `json:"reinitialized"`
+}
+
+// reloadableFields match the fields of the effective config which are applied by a reload.
+var reloadableFields = []*regexp.Regexp{
+	regexp.MustCompile(`^Log\.Level$`),
+	regexp.MustCompile(`^Database\.LogQueries$`),
+	regexp.MustCompile(`^Feature\.UICSAKeys$`),
+	regexp.MustCompile(`^EVM\[\d+\]\.Nodes\[`),
+	regexp.MustCompile(`^EVM\[\d+\]\.GasEstimator\.(PriceDefault|PriceMin|PriceMax|BumpMin|LimitMax)$`),
+}
+
+var evmFieldRegex = regexp.MustCompile(`^EVM\[(\d+)\]\.`)
+
+// redacted replaces the values of URL fields, which may embed credentials.
+const redacted = "xxxxx"
+
+// reload re-reads the files the config was loaded from, and returns the new config and the changes from the config
+// last loaded. The new config is validated, but not applied.
+func (g *generalConfig) reload() (*generalConfig, *ConfigReload, error) {
+	if g.reloadOpts == nil {
+		return nil, nil, errors.New("config was not loaded from files")
+	}
+	opts := *g.reloadOpts
+	if err := opts.Setup(opts.configFiles, opts.secretsFiles); err != nil {
+		return nil, nil, err
+	}
+	cfg, err := opts.New()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+	next := cfg.(*generalConfig)
+
+	_, effective := g.ConfigTOML()
+	changes, err := diffTOML(effective, next.effectiveTOML)
+	if err != nil {
+		return nil, nil, err
+	}
+	var r ConfigReload
+	for _, c := range changes {
+		if isReloadable(c.Field, changes) {
+			r.Applied = append(r.Applied, c)
+		} else {
+			r.RestartRequired = append(r.RestartRequired, c)
+		}
+	}
+	g.tomlMu.RLock()
+	secretsChanged := !slices.Equal(g.secretsStrings, next.secretsStrings)
+	g.tomlMu.RUnlock()
+	if secretsChanged {
+		r.RestartRequired = append(r.RestartRequired, ConfigChange{Field: "Secrets", From: redacted, To: redacted})
+	}
+	return next, &r, nil
+}
+
+// setReloaded replaces the config TOML with the one reloaded.
+func (g *generalConfig) setReloaded(next *generalConfig) {
+	g.tomlMu.Lock()
+	defer g.tomlMu.Unlock()
+	g.inputTOML, g.effectiveTOML, g.secretsStrings = next.inputTOML, next.effectiveTOML, next.secretsStrings
+}
+
+// isReloadable returns true if the field can be applied at runtime. Fields of an EVM chain are not, if the ID of the
+// chain changed as well.
+func isReloadable(field string, changes []ConfigChange) bool {
+	if m := evmFieldRegex.FindStringSubmatch(field); m != nil {
+		chainID := fmt.Sprintf("EVM[%s].ChainID", m[1])
+		if slices.ContainsFunc(changes, func(c ConfigChange) bool { return c.Field == chainID }) {
+			return false
+		}
+	}
+	return slices.ContainsFunc(reloadableFields, func(r *regexp.Regexp) bool { return r.MatchString(field) })
+}
+
+// diffTOML returns the fields which differ between the TOML documents, sorted by name.
+func diffTOML(from, to string) ([]ConfigChange, error) {
+	fromFields, err := flattenTOML(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := flattenTOML(to)
+	if err != nil {
+		return nil, err
+	}
+	var changes []ConfigChange
+	for field, v := range fromFields {
+		if w := toFields[field]; v != w {
+			changes = append(changes, newConfigChange(field, v, w))
+		}
+	}
+	for field, w := range toFields {
+		if _, ok := fromFields[field]; !ok {
+			changes = append(changes, newConfigChange(field, "", w))
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes, nil
+}
+
+func newConfigChange(field, from, to string) ConfigChange {
+	name := strings.TrimRight(field, "[]0123456789")
+	if strings.HasSuffix(name, "URL") || strings.HasSuffix(name, "URLs") {
+		if from != "" {
+			from = redacted
+		}
+		if to != "" {
+			to = redacted
+		}
+	}
+	return ConfigChange{Field: field, From: from, To: to}
+}
+
+// flattenTOML returns the values of the TOML document keyed by their path, like EVM[0].Nodes[1].Name.
+func flattenTOML(doc string) (map[string]string, error) {
+	var m map[string]any
+	if err := gotoml.Unmarshal([]byte(doc), &m); err != nil {
+		return nil, fmt.Errorf("failed to decode config TOML: %w", err)
+	}
+	fields := make(map[string]string)
+	flatten(fields, "", m)
+	return fields, nil
+}
+
+func flatten(fields map[string]string, path string, v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, w := range v {
+			if path != "" {
+				k = path + "." + k
+			}
+			flatten(fields, k, w)
+		}
+	case []any:
+		for i, w := range v {
+			flatten(fields, fmt.Sprintf("%s[%d]", path, i), w)
+		}
+	default:
+		fields[path] = fmt.Sprint(v)
+	}
+}
@@ -2,16 +2,19 @@ package chainlink
 
 import (
 	"net/url"
+	"path/filepath"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
 
 type telemetryIngressConfig struct {
-	c toml.TelemetryIngress
+	c       toml.TelemetryIngress
+	rootDir func() string
 }
 
 type telemetryIngressEndpointConfig struct {
@@ -46,6 +49,18 @@ func (t *telemetryIngressConfig) UseBatchSend() bool {
 	return *t.c.UseBatchSend
 }
 
+func (t *telemetryIngressConfig) SpillMaxSize() utils.FileSize {
+	return *t.c.SpillMaxSize
+}
+
+func (t *telemetryIngressConfig) SpillDir() string {
+	s := *t.c.SpillDir
+	if s == "" {
+		s = filepath.Join(t.rootDir(), "telemetry-spill")
+	}
+	return s
+}
+
 // Deprecated: Use TelemetryIngressEndpoint.ServerPubKey, this field will be removed in future versions
 func (t *telemetryIngressConfig) ServerPubKey() string {
 	return *t.c.ServerPubKey
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/kylelemons/godebug/diff"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ import (
 	solcfg "github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 	stkcfg "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
@@ -226,6 +228,7 @@ func TestConfig_Marshal(t *testing.T) {
 				OCRDevelopmentMode:   ptr(false),
 				InfiniteDepthQueries: ptr(false),
 				DisableRateLimiting:  ptr(false),
+				SimulatedEVMChains:   ptr(false),
 			},
 			Tracing: toml.Tracing{
 				Enabled:         ptr(true),
@@ -277,6 +280,7 @@ func TestConfig_Marshal(t *testing.T) {
 			Enabled:              ptr(false),
 			LeaseDuration:        &minute,
 			LeaseRefreshInterval: &second,
+			WarmStandby:          ptr(true),
 		},
 		Backup: toml.DatabaseBackup{
 			Dir:              ptr("test/backup/dir"),
@@ -284,6 +288,43 @@ func TestConfig_Marshal(t *testing.T) {
 			Mode:             &legacy.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
 		},
+		Maintenance: toml.DatabaseMaintenance{
+			Enabled:   ptr(true),
+			Interval:  commonconfig.MustNewDuration(30 * time.Minute),
+			Window:    &legacy.TimeWindow{Start: time.Hour, End: 5 * time.Hour},
+			BatchSize: ptr[uint32](500),
+			PipelineRuns: toml.MaintenancePolicy{
+				Retention: commonconfig.MustNewDuration(48 * time.Hour),
+				Vacuum:    ptr(false),
+			},
+			Logs: toml.MaintenancePolicy{
+				Retention: commonconfig.MustNewDuration(720 * time.Hour),
+				Vacuum:    ptr(true),
+			},
+			Transactions: toml.MaintenancePolicy{
+				Retention: commonconfig.MustNewDuration(336 * time.Hour),
+				Vacuum:    ptr(true),
+			},
+			Heads: toml.MaintenancePolicy{
+				Retention: commonconfig.MustNewDuration(24 * time.Hour),
+				Vacuum:    ptr(true),
+			},
+			Archive: toml.DatabaseArchive{
+				Enabled:  ptr(true),
+				URL:      mustURL("s3://bucket/chainlink"),
+				Endpoint: mustURL("http://localhost:9000"),
+			},
+		},
+		Partitions: toml.DatabasePartitions{
+			CheckInterval:        commonconfig.MustNewDuration(30 * time.Minute),
+			Premake:              ptr[uint32](4),
+			LogsBlockRange:       ptr[uint64](100_000),
+			PipelineRunsInterval: commonconfig.MustNewDuration(24 * time.Hour),
+		},
+		Replica: toml.DatabaseReplica{
+			MaxLag:           commonconfig.MustNewDuration(time.Minute),
+			LagCheckInterval: &second,
+		},
 	}
 	full.TelemetryIngress = toml.TelemetryIngress{
 		UniConn:      ptr(true),
@@ -293,6 +334,8 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: commonconfig.MustNewDuration(time.Minute),
 		SendTimeout:  commonconfig.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		SpillMaxSize: ptr[utils.FileSize](100 * utils.MB),
+		SpillDir:     ptr("telemetry/spill"),
 		URL:          ptr(commonconfig.URL{}),
 		ServerPubKey: ptr(""),
 		Endpoints: []toml.TelemetryIngressEndpoint{{
@@ -313,6 +356,32 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxAgeDays: ptr[int64](17),
 			MaxBackups: ptr[int64](9),
 		},
+		Sinks: []toml.LogSink{
+			{
+				Name:          ptr("loki"),
+				Type:          ptr("loki"),
+				URL:           mustURL("http://loki:3100/loki/api/v1/push"),
+				Topic:         ptr(""),
+				Level:         ptr(toml.LogLevel(zapcore.WarnLevel)),
+				BufferSize:    ptr[uint32](1000),
+				BatchSize:     ptr[uint32](100),
+				FlushInterval: commonconfig.MustNewDuration(5 * time.Second),
+				DropWhenFull:  ptr(false),
+				Labels:        map[string]string{"env": "test"},
+			},
+			{
+				Name:          ptr("kafka"),
+				Type:          ptr("kafka"),
+				URL:           mustURL("http://rest-proxy:8082"),
+				Level:         ptr(toml.LogLevel(zapcore.InfoLevel)),
+				Topic:         ptr("chainlink-logs"),
+				BufferSize:    ptr[uint32](10000),
+				BatchSize:     ptr[uint32](500),
+				FlushInterval: commonconfig.MustNewDuration(time.Second),
+				DropWhenFull:  ptr(true),
+				Labels:        map[string]string{"app": "chainlink"},
+			},
+		},
 	}
 	full.WebServer = toml.WebServer{
 		AuthenticationMethod:    ptr("local"),
@@ -328,8 +397,9 @@ func TestConfig_Marshal(t *testing.T) {
 		StartTimeout:            commonconfig.MustNewDuration(15 * time.Second),
 		ListenIP:                mustIP("192.158.1.37"),
 		MFA: toml.WebServerMFA{
-			RPID:     ptr("test-rpid"),
-			RPOrigin: ptr("test-rp-origin"),
+			RPID:            ptr("test-rpid"),
+			RPOrigin:        ptr("test-rp-origin"),
+			RequiredForRole: ptr("admin"),
 		},
 		LDAP: toml.WebServerLDAP{
 			ServerTLS:                   ptr(true),
@@ -356,6 +426,29 @@ func TestConfig_Marshal(t *testing.T) {
 			Unauthenticated:       ptr[int64](7),
 			UnauthenticatedPeriod: commonconfig.MustNewDuration(time.Minute),
 		},
+		PublicStatus: toml.WebServerPublicStatus{
+			Enabled:         ptr(true),
+			RateLimit:       ptr[int64](3),
+			RateLimitPeriod: commonconfig.MustNewDuration(30 * time.Second),
+		},
+		RouteLimits: toml.WebServerRouteLimits{
+			TrustedProxies: &[]string{"10.0.0.1/32"},
+			Sessions: toml.WebServerRouteLimit{
+				AllowedCIDRs:    &[]string{"10.0.0.0/8", "2001:db8::/32"},
+				RateLimit:       ptr[int64](5),
+				RateLimitPeriod: commonconfig.MustNewDuration(time.Minute),
+			},
+			GraphQLMutations: toml.WebServerRouteLimit{
+				AllowedCIDRs:    &[]string{"192.168.0.0/16"},
+				RateLimit:       ptr[int64](20),
+				RateLimitPeriod: commonconfig.MustNewDuration(time.Minute),
+			},
+			RunTriggers: toml.WebServerRouteLimit{
+				AllowedCIDRs:    &[]string{},
+				RateLimit:       ptr[int64](100),
+				RateLimitPeriod: commonconfig.MustNewDuration(10 * time.Second),
+			},
+		},
 		TLS: toml.WebServerTLS{
 			CertPath:      ptr("tls/cert/path"),
 			Host:          ptr("tls-host"),
@@ -366,16 +459,45 @@ func TestConfig_Marshal(t *testing.T) {
 		},
 	}
 	full.JobPipeline = toml.JobPipeline{
-		ExternalInitiatorsEnabled: ptr(true),
-		MaxRunDuration:            commonconfig.MustNewDuration(time.Hour),
-		MaxSuccessfulRuns:         ptr[uint64](123456),
-		ReaperInterval:            commonconfig.MustNewDuration(4 * time.Hour),
-		ReaperThreshold:           commonconfig.MustNewDuration(7 * 24 * time.Hour),
-		ResultWriteQueueDepth:     ptr[uint32](10),
+		ExternalInitiatorsEnabled:  ptr(true),
+		MaxRunDuration:             commonconfig.MustNewDuration(time.Hour),
+		MaxSuccessfulRuns:          ptr[uint64](123456),
+		ReaperInterval:             commonconfig.MustNewDuration(4 * time.Hour),
+		ReaperThreshold:            commonconfig.MustNewDuration(7 * 24 * time.Hour),
+		ReplayOnChainWritesEnabled: ptr(true),
+		ResultWriteQueueDepth:      ptr[uint32](10),
 		HTTPRequest: toml.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commonconfig.MustNewDuration(time.Minute),
 		},
+		ExternalInitiatorGRPC: toml.JobPipelineExternalInitiatorGRPC{
+			Enabled:           ptr(true),
+			ListenIP:          mustIP("192.158.1.39"),
+			Port:              ptr[uint16](6698),
+			CertPath:          ptr("ei/cert/path"),
+			KeyPath:           ptr("ei/key/path"),
+			ClientCAPath:      ptr("ei/ca/path"),
+			HeartbeatInterval: commonconfig.MustNewDuration(5 * time.Second),
+		},
+		BridgeCircuitBreaker: toml.JobPipelineBridgeCircuitBreaker{
+			Enabled:          ptr(true),
+			FailureThreshold: ptr[uint32](3),
+			LatencySLO:       commonconfig.MustNewDuration(2 * time.Second),
+			OpenTimeout:      commonconfig.MustNewDuration(time.Minute),
+		},
+		PolicyRules: []toml.JobPipelinePolicyRule{
+			{
+				Name:     ptr("gas-limit"),
+				Expr:     ptr("gasLimit < 500000"),
+				Severity: ptr("error"),
+				JobTypes: []string{"directrequest"},
+			},
+			{
+				Name:     ptr("max-task-duration"),
+				Expr:     ptr("maxTaskDuration exists"),
+				Severity: ptr("warning"),
+			},
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -415,8 +537,9 @@ func TestConfig_Marshal(t *testing.T) {
 		PeerID:                    mustPeerID("12D3KooWMoejJznyDuEk5aX6GvbjaG12UzeornPCBNzMRqdwrFJw"),
 		TraceLogging:              ptr(true),
 		V2: toml.P2PV2{
-			Enabled:           ptr(false),
-			AnnounceAddresses: &[]string{"a", "b", "c"},
+			Enabled:                          ptr(false),
+			AnnounceAddresses:                &[]string{"a", "b", "c"},
+			AnnounceAddressesRefreshInterval: commonconfig.MustNewDuration(5 * time.Minute),
 			DefaultBootstrappers: &[]ocrcommontypes.BootstrapperLocator{
 				{PeerID: "12D3KooWMoejJznyDuEk5aX6GvbjaG12UzeornPCBNzMRqdwrFJw", Addrs: []string{"foo:42", "bar:10"}},
 				{PeerID: "12D3KooWMoejJznyDuEk5aX6GvbjaG12UzeornPCBNzMRqdwrFJw", Addrs: []string{"test:99"}},
@@ -522,7 +645,10 @@ func TestConfig_Marshal(t *testing.T) {
 					{
 						Key: mustAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"),
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
-							PriceMax: assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFFFFFFFFFFFFFF")),
+							PriceMax:     assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFFFFFFFFFFFFFF")),
+							BumpStrategy: ptr("Oracle"),
+							BumpPercent:  ptr[uint16](50),
+							BumpMin:      assets.GWei(20),
 						},
 					},
 				},
@@ -545,7 +671,18 @@ func TestConfig_Marshal(t *testing.T) {
 					ReaperInterval:       &minute,
 					ReaperThreshold:      &minute,
 					ResendAfterThreshold: &hour,
+					InclusionTarget:      &minute,
 					ForwardersEnabled:    ptr(true),
+					AccountAbstraction: evmcfg.AccountAbstraction{
+						Enabled:        ptr(true),
+						Mode:           ptr("userop"),
+						BundlerURL:     mustURL("https://bundler.example"),
+						EntryPoint:     mustAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789"),
+						Account:        mustAddress("0x7c8c2A3f96F7e6A2b4F6A3E9d2C1b0A9F8E7d6c5"),
+						Paymaster:      mustAddress("0x0b7e23C6F242f5345320814Ac8a1b4E58707d292"),
+						PaymasterInput: ptr(hexutil.Bytes{0x01, 0x02}),
+						PollPeriod:     &second,
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -554,12 +691,21 @@ func TestConfig_Marshal(t *testing.T) {
 					SamplingInterval: &hour,
 				},
 
+				HeadLagMonitor: evmcfg.HeadLagMonitor{
+					Enabled:         ptr(true),
+					PollInterval:    &minute,
+					MaxBlocksBehind: ptr[uint32](7),
+					MaxTimeBehind:   &hour,
+					ReferenceURLs:   []*commonconfig.URL{mustURL("https://rpc.example.com")},
+				},
+
 				NodePool: evmcfg.NodePool{
 					PollFailureThreshold: ptr[uint32](5),
 					PollInterval:         &minute,
 					SelectionMode:        &selectionMode,
 					SyncThreshold:        ptr[uint32](13),
 					LeaseDuration:        &zeroSeconds,
+					PruningHorizon:       ptr[uint32](256),
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -573,6 +719,16 @@ func TestConfig_Marshal(t *testing.T) {
 					Automation: evmcfg.Automation{
 						GasLimit: ptr[uint32](540),
 					},
+					CalldataCompression: evmcfg.CalldataCompression{
+						Mode: ptr("LibZip"),
+					},
+				},
+				VRFSubscriptionMonitor: evmcfg.VRFSubscriptionMonitor{
+					Enabled:         ptr(true),
+					PollInterval:    &minute,
+					CostWindow:      &hour,
+					RunwayThreshold: commonconfig.MustNewDuration(48 * time.Hour),
+					AlertWebhookURL: mustURL("https://alerts.example.com/vrf"),
 				},
 			},
 			Nodes: []*evmcfg.Node{
@@ -637,6 +793,18 @@ func TestConfig_Marshal(t *testing.T) {
 			},
 		},
 	}
+	full.Aptos = []*aptos.TOMLConfig{
+		{
+			ChainID: ptr("2"),
+			Enabled: ptr(true),
+			ChainConfig: aptos.ChainConfig{
+				RequestTimeout: commoncfg.MustNewDuration(time.Minute + 4*time.Second),
+			},
+			Nodes: []*aptos.Node{
+				{Name: ptr("primary"), URL: commoncfg.MustParseURL("http://aptos.node/v1")},
+			},
+		},
+	}
 	full.Cosmos = []*coscfg.TOMLConfig{
 		{
 			ChainID: ptr("Malaga-420"),
@@ -671,6 +839,21 @@ func TestConfig_Marshal(t *testing.T) {
 			CertFile: ptr("/path/to/cert.pem"),
 		},
 	}
+	full.BalanceMonitor = toml.BalanceMonitor{
+		Enabled:    ptr(true),
+		PollPeriod: commonconfig.MustNewDuration(2 * time.Minute),
+		LowBalance: toml.BalanceMonitorLowBalance{
+			EVM:      mustDecimal("0.5"),
+			Solana:   mustDecimal("1"),
+			Cosmos:   mustDecimal("10"),
+			StarkNet: mustDecimal("0.05"),
+			Aptos:    mustDecimal("2"),
+		},
+	}
+	full.Keystore = toml.Keystore{
+		MasterKeyFile: ptr("/path/to/master.key"),
+		AWSKMSKeyID:   ptr(""),
+	}
 
 	for _, tt := range []struct {
 		name   string
@@ -687,6 +870,7 @@ DevWebServer = false
 OCRDevelopmentMode = false
 InfiniteDepthQueries = false
 DisableRateLimiting = false
+SimulatedEVMChains = false
 
 [Tracing]
 Enabled = true
@@ -735,6 +919,44 @@ FallbackPollInterval = '2m0s'
 Enabled = false
 LeaseDuration = '1m0s'
 LeaseRefreshInterval = '1s'
+WarmStandby = true
+
+[Database.Maintenance]
+Enabled = true
+Interval = '30m0s'
+Window = '01:00-05:00'
+BatchSize = 500
+
+[Database.Maintenance.PipelineRuns]
+Retention = '48h0m0s'
+Vacuum = false
+
+[Database.Maintenance.Logs]
+Retention = '720h0m0s'
+Vacuum = true
+
+[Database.Maintenance.Transactions]
+Retention = '336h0m0s'
+Vacuum = true
+
+[Database.Maintenance.Heads]
+Retention = '24h0m0s'
+Vacuum = true
+
+[Database.Maintenance.Archive]
+Enabled = true
+URL = 's3://bucket/chainlink'
+Endpoint = 'http://localhost:9000'
+
+[Database.Partitions]
+CheckInterval = '30m0s'
+Premake = 4
+LogsBlockRange = 100000
+PipelineRunsInterval = '24h0m0s'
+
+[Database.Replica]
+MaxLag = '1m0s'
+LagCheckInterval = '1s'
 `},
 		{"TelemetryIngress", Config{Core: toml.Core{TelemetryIngress: full.TelemetryIngress}}, `[TelemetryIngress]
 UniConn = true
@@ -744,6 +966,8 @@ MaxBatchSize = 4321
 SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
+SpillMaxSize = '100.00mb'
+SpillDir = 'telemetry/spill'
 URL = ''
 ServerPubKey = ''
 
@@ -764,6 +988,34 @@ Dir = 'log/file/dir'
 MaxSize = '100.00gb'
 MaxAgeDays = 17
 MaxBackups = 9
+
+[[Log.Sinks]]
+Name = 'loki'
+Type = 'loki'
+URL = 'http://loki:3100/loki/api/v1/push'
+Level = 'warn'
+Topic = ''
+BufferSize = 1000
+BatchSize = 100
+FlushInterval = '5s'
+DropWhenFull = false
+
+[Log.Sinks.Labels]
+env = 'test'
+
+[[Log.Sinks]]
+Name = 'kafka'
+Type = 'kafka'
+URL = 'http://rest-proxy:8082'
+Level = 'info'
+Topic = 'chainlink-logs'
+BufferSize = 10000
+BatchSize = 500
+FlushInterval = '1s'
+DropWhenFull = true
+
+[Log.Sinks.Labels]
+app = 'chainlink'
 `},
 		{"WebServer", Config{Core: toml.Core{WebServer: full.WebServer}}, `[WebServer]
 AuthenticationMethod = 'local'
@@ -801,6 +1053,7 @@ UpstreamSyncRateLimit = '2m0s'
 [WebServer.MFA]
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
+RequiredForRole = 'admin'
 
 [WebServer.RateLimit]
 Authenticated = 42
@@ -808,6 +1061,29 @@ AuthenticatedPeriod = '1s'
 Unauthenticated = 7
 UnauthenticatedPeriod = '1m0s'
 
+[WebServer.PublicStatus]
+Enabled = true
+RateLimit = 3
+RateLimitPeriod = '30s'
+
+[WebServer.RouteLimits]
+TrustedProxies = ['10.0.0.1/32']
+
+[WebServer.RouteLimits.Sessions]
+AllowedCIDRs = ['10.0.0.0/8', '2001:db8::/32']
+RateLimit = 5
+RateLimitPeriod = '1m0s'
+
+[WebServer.RouteLimits.GraphQLMutations]
+AllowedCIDRs = ['192.168.0.0/16']
+RateLimit = 20
+RateLimitPeriod = '1m0s'
+
+[WebServer.RouteLimits.RunTriggers]
+AllowedCIDRs = []
+RateLimit = 100
+RateLimitPeriod = '10s'
+
 [WebServer.TLS]
 CertPath = 'tls/cert/path'
 ForceRedirect = true
@@ -826,11 +1102,38 @@ MaxRunDuration = '1h0m0s'
 MaxSuccessfulRuns = 123456
 ReaperInterval = '4h0m0s'
 ReaperThreshold = '168h0m0s'
+ReplayOnChainWritesEnabled = true
 ResultWriteQueueDepth = 10
 
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.ExternalInitiatorGRPC]
+Enabled = true
+ListenIP = '192.158.1.39'
+Port = 6698
+CertPath = 'ei/cert/path'
+KeyPath = 'ei/key/path'
+ClientCAPath = 'ei/ca/path'
+HeartbeatInterval = '5s'
+
+[JobPipeline.BridgeCircuitBreaker]
+Enabled = true
+FailureThreshold = 3
+LatencySLO = '2s'
+OpenTimeout = '1m0s'
+
+[[JobPipeline.PolicyRules]]
+Name = 'gas-limit'
+Expr = 'gasLimit < 500000'
+Severity = 'error'
+JobTypes = ['directrequest']
+
+[[JobPipeline.PolicyRules]]
+Name = 'max-task-duration'
+Expr = 'maxTaskDuration exists'
+Severity = 'warning'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -869,6 +1172,7 @@ TraceLogging = true
 [P2P.V2]
 Enabled = false
 AnnounceAddresses = ['a', 'b', 'c']
+AnnounceAddressesRefreshInterval = '5m0s'
 DefaultBootstrappers = ['12D3KooWMoejJznyDuEk5aX6GvbjaG12UzeornPCBNzMRqdwrFJw@foo:42/bar:10', '12D3KooWMoejJznyDuEk5aX6GvbjaG12UzeornPCBNzMRqdwrFJw@test:99']
 DeltaDial = '1m0s'
 DeltaReconcile = '1s'
@@ -942,6 +1246,17 @@ MaxQueued = 99
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
+InclusionTarget = '1m0s'
+
+[EVM.Transactions.AccountAbstraction]
+Enabled = true
+Mode = 'userop'
+BundlerURL = 'https://bundler.example'
+EntryPoint = '0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789'
+Account = '0x7c8c2A3f96F7e6A2b4F6A3E9d2C1b0A9F8E7d6c5'
+Paymaster = '0x0b7e23C6F242f5345320814Ac8a1b4E58707d292'
+PaymasterInput = '0x0102'
+PollPeriod = '1s'
 
 [EVM.BalanceMonitor]
 Enabled = true
@@ -985,11 +1300,21 @@ HistoryDepth = 15
 MaxBufferSize = 17
 SamplingInterval = '1h0m0s'
 
+[EVM.HeadLagMonitor]
+Enabled = true
+PollInterval = '1m0s'
+MaxBlocksBehind = 7
+MaxTimeBehind = '1h0m0s'
+ReferenceURLs = ['https://rpc.example.com']
+
 [[EVM.KeySpecific]]
 Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
+BumpStrategy = 'Oracle'
+BumpPercent = 50
+BumpMin = '20 gwei'
 
 [EVM.NodePool]
 PollFailureThreshold = 5
@@ -997,6 +1322,7 @@ PollInterval = '1m0s'
 SelectionMode = 'HighestHead'
 SyncThreshold = 13
 LeaseDuration = '0s'
+PruningHorizon = 256
 
 [EVM.OCR]
 ContractConfirmations = 11
@@ -1010,6 +1336,16 @@ ObservationGracePeriod = '1s'
 [EVM.OCR2.Automation]
 GasLimit = 540
 
+[EVM.OCR2.CalldataCompression]
+Mode = 'LibZip'
+
+[EVM.VRFSubscriptionMonitor]
+Enabled = true
+PollInterval = '1m0s'
+CostWindow = '1h0m0s'
+RunwayThreshold = '48h0m0s'
+AlertWebhookURL = 'https://alerts.example.com/vrf'
+
 [[EVM.Nodes]]
 Name = 'foo'
 WSURL = 'wss://web.socket/test/foo'
@@ -1095,6 +1431,15 @@ ConfirmationPoll = '42s'
 [[Starknet.Nodes]]
 Name = 'primary'
 URL = 'http://stark.node'
+`},
+		{"Aptos", Config{Aptos: full.Aptos}, `[[Aptos]]
+ChainID = '2'
+Enabled = true
+RequestTimeout = '1m4s'
+
+[[Aptos.Nodes]]
+Name = 'primary'
+URL = 'http://aptos.node/v1'
 `},
 		{"Mercury", Config{Core: toml.Core{Mercury: full.Mercury}}, `[Mercury]
 [Mercury.Cache]
@@ -1104,6 +1449,10 @@ LatestReportDeadline = '1m42s'
 
 [Mercury.TLS]
 CertFile = '/path/to/cert.pem'
+`},
+		{"Keystore", Config{Core: toml.Core{Keystore: full.Keystore}}, `[Keystore]
+MasterKeyFile = '/path/to/master.key'
+AWSKMSKeyID = ''
 `},
 		{"full", full, fullTOML},
 		{"multi-chain", multiChain, multiChainTOML},
@@ -1135,12 +1484,25 @@ func TestConfig_full(t *testing.T) {
 			if got.EVM[c].Nodes[n].SendOnly == nil {
 				got.EVM[c].Nodes[n].SendOnly = ptr(true)
 			}
+			if got.EVM[c].Nodes[n].ReadOnly == nil {
+				got.EVM[c].Nodes[n].ReadOnly = ptr(false)
+			}
+			if got.EVM[c].Nodes[n].Archive == nil {
+				got.EVM[c].Nodes[n].Archive = ptr(false)
+			}
 			if got.EVM[c].Nodes[n].Order == nil {
 				got.EVM[c].Nodes[n].Order = ptr(int32(100))
 			}
 		}
 	}
 
+	// Except for EVM.Profile, which requires a profile to inherit from.
+	for c := range got.EVM {
+		if got.EVM[c].Profile == nil {
+			got.EVM[c].Profile = ptr("")
+		}
+	}
+
 	// Except for TelemetryIngress.ServerPubKey as this will be removed in the future
 	// and its only use is to signal to NOPs that these fields are no longer allowed
 	if got.TelemetryIngress.ServerPubKey == nil {
@@ -1164,7 +1526,7 @@ func TestConfig_Validate(t *testing.T) {
 		toml string
 		exp  string
 	}{
-		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 6 errors:
+		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 7 errors:
 	- Database.Lock.LeaseRefreshInterval: invalid value (6s): must be less than or equal to half of LeaseDuration (10s)
 	- WebServer: 8 errors:
 		- LDAP.BaseDN: invalid value (<nil>): LDAP BaseDN can not be empty
@@ -1175,12 +1537,27 @@ func TestConfig_Validate(t *testing.T) {
 		- LDAP.RunUserGroupCN: invalid value (<nil>): LDAP ReadUserGroupCN can not be empty
 		- LDAP.RunUserGroupCN: invalid value (<nil>): LDAP RunUserGroupCN can not be empty
 		- LDAP.ReadUserGroupCN: invalid value (<nil>): LDAP ReadUserGroupCN can not be empty
+	- JobPipeline: 6 errors:
+		- ExternalInitiatorGRPC.Enabled: invalid value (true): requires ExternalInitiatorsEnabled
+		- PolicyRules[0].Expr: invalid value (gasLimit < lots): invalid expression "gasLimit < lots": invalid number "lots"
+		- PolicyRules[1].Name: invalid value (gas-limit): duplicate - must be unique
+		- PolicyRules[1].Severity: invalid value (fatal): must be one of: error, warning
+		- ExternalInitiatorGRPC: 4 errors:
+			- CertPath: empty: must be provided and non-empty
+			- KeyPath: empty: must be provided and non-empty
+			- ClientCAPath: empty: must be provided and non-empty
+			- HeartbeatInterval: invalid value (0s): must be positive
+		- BridgeCircuitBreaker: 2 errors:
+			- FailureThreshold: invalid value (0): must be positive
+			- OpenTimeout: invalid value (0s): must be positive
 	- EVM: 8 errors:
 		- 1.ChainID: invalid value (1): duplicate - must be unique
 		- 0.Nodes.1.Name: invalid value (foo): duplicate - must be unique
 		- 3.Nodes.4.WSURL: invalid value (ws://dupe.com): duplicate - must be unique
-		- 0: 3 errors:
+		- 0: 5 errors:
 			- GasEstimator.BumpTxDepth: invalid value (11): must be less than or equal to Transactions.MaxInFlight
+			- Transactions.AccountAbstraction.Mode: invalid value (paymaster): paymaster transactions are only supported by ChainType zksync
+			- Transactions.AccountAbstraction.Paymaster: missing: required for paymaster mode
 			- GasEstimator: 6 errors:
 				- BumpPercent: invalid value (1): may not be less than Geth's default of 10
 				- TipCapDefault: invalid value (3 wei): must be greater than or equal to TipCapMinimum
@@ -1189,9 +1566,7 @@ func TestConfig_Validate(t *testing.T) {
 				- PriceMax: invalid value (10 gwei): must be greater than or equal to PriceDefault
 				- BlockHistory.BlockHistorySize: invalid value (0): must be greater than or equal to 1 with BlockHistory Mode
 			- Nodes: 2 errors:
-				- 0: 2 errors:
-					- WSURL: missing: required for primary nodes
-					- HTTPURL: missing: required for all nodes
+				- 0.HTTPURL: missing: required for all nodes
 				- 1.HTTPURL: missing: required for all nodes
 		- 1: 6 errors:
 			- ChainType: invalid value (Foo): must not be set with this chain id
@@ -1201,7 +1576,11 @@ func TestConfig_Validate(t *testing.T) {
 			- GasEstimator: 2 errors:
 				- FeeCapDefault: invalid value (101 wei): must be equal to PriceMax (99 wei) since you are using FixedPrice estimation with gas bumping disabled in EIP1559 mode - PriceMax will be used as the FeeCap for transactions instead of FeeCapDefault
 				- PriceMax: invalid value (1 gwei): must be greater than or equal to PriceDefault
-			- KeySpecific.Key: invalid value (0xde709f2102306220921060314715629080e2fb77): duplicate - must be unique
+			- KeySpecific: 2 errors:
+				- Key: invalid value (0xde709f2102306220921060314715629080e2fb77): duplicate - must be unique
+				- 1.GasEstimator: 2 errors:
+						- BumpStrategy: invalid value (Random): must be one of Percent, Fixed or Oracle
+						- BumpPercent: invalid value (5): may not be less than Geth's default of 10
 		- 2: 5 errors:
 			- ChainType: invalid value (Arbitrum): only "optimismBedrock" can be used with this chain id
 			- Nodes: missing: must have at least one node
@@ -1209,17 +1588,15 @@ func TestConfig_Validate(t *testing.T) {
 			- FinalityDepth: invalid value (0): must be greater than or equal to 1
 			- MinIncomingConfirmations: invalid value (0): must be greater than or equal to 1
 		- 3.Nodes: 5 errors:
-				- 0: 3 errors:
+				- 0: 2 errors:
 					- Name: missing: required for all nodes
-					- WSURL: missing: required for primary nodes
 					- HTTPURL: empty: required for all nodes
 				- 1: 3 errors:
 					- Name: missing: required for all nodes
 					- WSURL: invalid value (http): must be ws or wss
 					- HTTPURL: missing: required for all nodes
-				- 2: 3 errors:
+				- 2: 2 errors:
 					- Name: empty: required for all nodes
-					- WSURL: missing: required for primary nodes
 					- HTTPURL: invalid value (ws): must be http or https
 				- 3.HTTPURL: missing: required for all nodes
 				- 4.HTTPURL: missing: required for all nodes
@@ -1487,6 +1864,78 @@ func TestConfig_setDefaults(t *testing.T) {
 	cfgtest.AssertFieldsNotNil(t, c.Core)
 }
 
+func TestConfig_EVMProfiles(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{`
+[[EVMProfiles]]
+Name = 'l2'
+FinalityDepth = 200
+LogPollInterval = '2s'
+
+[EVMProfiles.GasEstimator]
+Mode = 'SuggestedPrice'
+
+[[EVM]]
+ChainID = '10'
+Profile = 'l2'
+
+[[EVM]]
+ChainID = '8453'
+Profile = 'l2'
+FinalityDepth = 300
+
+[[EVM]]
+ChainID = '1'
+`, `
+[[EVMProfiles]]
+Name = 'l2'
+LogPollInterval = '1s'
+`},
+	}
+	c, err := opts.New()
+	require.NoError(t, err)
+
+	chains := c.EVMConfigs()
+	require.Len(t, chains, 3)
+	optimism, base, mainnet := chains[0], chains[1], chains[2]
+	assert.Equal(t, uint32(200), *optimism.FinalityDepth)
+	assert.Equal(t, uint32(300), *base.FinalityDepth, "chain overrides profile")
+	assert.Equal(t, uint32(50), *mainnet.FinalityDepth, "chain without profile keeps its defaults")
+	for _, ch := range []*evmcfg.EVMConfig{optimism, base} {
+		assert.Equal(t, commonconfig.MustNewDuration(time.Second), ch.LogPollInterval, "later files override profile")
+		assert.Equal(t, "SuggestedPrice", *ch.GasEstimator.Mode)
+		assert.Equal(t, "optimismBedrock", *ch.ChainType, "chain defaults apply under profile")
+	}
+	assert.Equal(t, "BlockHistory", *mainnet.GasEstimator.Mode)
+
+	user, effective := c.ConfigTOML()
+	assert.Contains(t, user, "[[EVMProfiles]]")
+	assert.NotContains(t, effective, "EVMProfiles", "profiles are resolved into the chains")
+	assert.Contains(t, effective, "Profile = 'l2'")
+
+	t.Run("undefined", func(t *testing.T) {
+		opts := GeneralConfigOpts{ConfigStrings: []string{`
+[[EVM]]
+ChainID = '10'
+Profile = 'missing'
+`}}
+		_, err := opts.New()
+		require.EqualError(t, err, "invalid configuration: EVM.0.Profile: invalid value (missing): must be the Name of an EVMProfiles entry")
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		opts := GeneralConfigOpts{ConfigStrings: []string{`
+[[EVMProfiles]]
+Name = 'l2'
+
+[[EVMProfiles]]
+Name = 'l2'
+`}}
+		_, err := opts.New()
+		require.EqualError(t, err, "invalid configuration: EVMProfiles.1.Name: invalid value (l2): duplicate - must be unique")
+	})
+}
+
 func Test_validateEnv(t *testing.T) {
 	t.Setenv("LOG_LEVEL", "warn")
 	t.Setenv("DATABASE_URL", "foo")
@@ -84,6 +84,58 @@ func (r *rateLimitConfig) UnauthenticatedPeriod() time.Duration {
 	return r.c.UnauthenticatedPeriod.Duration()
 }
 
+type publicStatusConfig struct {
+	c toml.WebServerPublicStatus
+}
+
+func (p *publicStatusConfig) Enabled() bool {
+	return *p.c.Enabled
+}
+
+func (p *publicStatusConfig) RateLimit() int64 {
+	return *p.c.RateLimit
+}
+
+func (p *publicStatusConfig) RateLimitPeriod() time.Duration {
+	return p.c.RateLimitPeriod.Duration()
+}
+
+type routeLimitsConfig struct {
+	c toml.WebServerRouteLimits
+}
+
+func (r *routeLimitsConfig) TrustedProxies() []string {
+	return *r.c.TrustedProxies
+}
+
+func (r *routeLimitsConfig) Sessions() config.RouteLimit {
+	return &routeLimitConfig{c: r.c.Sessions}
+}
+
+func (r *routeLimitsConfig) GraphQLMutations() config.RouteLimit {
+	return &routeLimitConfig{c: r.c.GraphQLMutations}
+}
+
+func (r *routeLimitsConfig) RunTriggers() config.RouteLimit {
+	return &routeLimitConfig{c: r.c.RunTriggers}
+}
+
+type routeLimitConfig struct {
+	c toml.WebServerRouteLimit
+}
+
+func (r *routeLimitConfig) AllowedCIDRs() []string {
+	return *r.c.AllowedCIDRs
+}
+
+func (r *routeLimitConfig) RateLimit() int64 {
+	return *r.c.RateLimit
+}
+
+func (r *routeLimitConfig) RateLimitPeriod() time.Duration {
+	return r.c.RateLimitPeriod.Duration()
+}
+
 type mfaConfig struct {
 	c toml.WebServerMFA
 }
@@ -96,6 +148,10 @@ func (m *mfaConfig) RPOrigin() string {
 	return *m.c.RPOrigin
 }
 
+func (m *mfaConfig) RequiredForRole() string {
+	return *m.c.RequiredForRole
+}
+
 type webServerConfig struct {
 	c       toml.WebServer
 	s       toml.WebServerSecrets
@@ -110,6 +166,14 @@ func (w *webServerConfig) RateLimit() config.RateLimit {
 	return &rateLimitConfig{c: w.c.RateLimit}
 }
 
+func (w *webServerConfig) PublicStatus() config.PublicStatus {
+	return &publicStatusConfig{c: w.c.PublicStatus}
+}
+
+func (w *webServerConfig) RouteLimits() config.RouteLimits {
+	return &routeLimitsConfig{c: w.c.RouteLimits}
+}
+
 func (w *webServerConfig) MFA() config.MFA {
 	return &mfaConfig{c: w.c.MFA}
 }
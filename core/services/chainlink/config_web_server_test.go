@@ -42,8 +42,23 @@ func TestWebServerConfig(t *testing.T) {
 	assert.Equal(t, int64(7), rl.Unauthenticated())
 	assert.Equal(t, 1*time.Minute, rl.UnauthenticatedPeriod())
 
+	ps := ws.PublicStatus()
+	assert.True(t, ps.Enabled())
+	assert.Equal(t, int64(3), ps.RateLimit())
+	assert.Equal(t, 30*time.Second, ps.RateLimitPeriod())
+
+	rls := ws.RouteLimits()
+	assert.Equal(t, []string{"10.0.0.1/32"}, rls.TrustedProxies())
+	assert.Equal(t, []string{"10.0.0.0/8", "2001:db8::/32"}, rls.Sessions().AllowedCIDRs())
+	assert.Equal(t, int64(5), rls.Sessions().RateLimit())
+	assert.Equal(t, time.Minute, rls.Sessions().RateLimitPeriod())
+	assert.Equal(t, []string{"192.168.0.0/16"}, rls.GraphQLMutations().AllowedCIDRs())
+	assert.Equal(t, int64(100), rls.RunTriggers().RateLimit())
+	assert.Equal(t, 10*time.Second, rls.RunTriggers().RateLimitPeriod())
+
 	mf := ws.MFA()
 	assert.Equal(t, "test-rpid", mf.RPID())
 	assert.Equal(t, "test-rp-origin", mf.RPOrigin())
+	assert.Equal(t, "admin", mf.RequiredForRole())
 
 }
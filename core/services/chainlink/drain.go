@@ -0,0 +1,108 @@
+package chainlink
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// DrainStatus is the progress of draining the node before it is terminated.
+type DrainStatus struct {
+	// Draining is true once draining started. It is never reset, the node must be restarted to accept work again.
+	Draining  bool
+	StartedAt time.Time
+	// Deadline is the time after which the node is ready to terminate, even if work is still in flight.
+	Deadline time.Time
+	// InFlightRuns is the number of pipeline runs in progress.
+	InFlightRuns int
+	// PendingTxs is the number of EVM transactions which are not broadcast yet, on every chain.
+	PendingTxs int
+	// ReadyToTerminate is true once no runs are in progress and every transaction is broadcast, or the deadline
+	// passed. It is never reset.
+	ReadyToTerminate bool
+	// TimedOut is true if the deadline passed while work was still in flight.
+	TimedOut bool
+}
+
+type drainer struct {
+	runner     pipeline.Runner
+	pendingTxs func(ctx context.Context) (int, error)
+	lggr       logger.SugaredLogger
+	now        func() time.Time
+
+	mu     sync.Mutex
+	status DrainStatus
+}
+
+func newDrainer(runner pipeline.Runner, pendingTxs func(ctx context.Context) (int, error), lggr logger.Logger) *drainer {
+	return &drainer{
+		runner:     runner,
+		pendingTxs: pendingTxs,
+		lggr:       logger.Sugared(lggr.Named("Drainer")),
+		now:        time.Now,
+	}
+}
+
+// Drain starts draining, unless it already started, and returns the status.
+func (d *drainer) Drain(ctx context.Context, timeout time.Duration) DrainStatus {
+	d.mu.Lock()
+	if !d.status.Draining {
+		now := d.now()
+		d.status = DrainStatus{Draining: true, StartedAt: now, Deadline: now.Add(timeout)}
+		d.runner.Drain()
+		d.lggr.Infow("Draining node, no new work is accepted", "timeout", timeout)
+	}
+	d.mu.Unlock()
+	return d.Status(ctx)
+}
+
+// Status checks the work still in flight, if draining and not ready to terminate yet, and returns the status.
+func (d *drainer) Status(ctx context.Context) DrainStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.status.Draining || d.status.ReadyToTerminate {
+		return d.status
+	}
+
+	d.status.InFlightRuns = d.runner.InFlightRuns()
+	pending, err := d.pendingTxs(ctx)
+	if err != nil {
+		d.lggr.Warnw("Failed to count pending transactions", "err", err)
+	} else {
+		d.status.PendingTxs = pending
+	}
+
+	switch {
+	case err == nil && d.status.InFlightRuns == 0 && d.status.PendingTxs == 0:
+		d.status.ReadyToTerminate = true
+		d.lggr.Infow("Node drained, ready to terminate", "elapsed", d.now().Sub(d.status.StartedAt))
+	case !d.now().Before(d.status.Deadline):
+		d.status.ReadyToTerminate, d.status.TimedOut = true, true
+		d.lggr.Warnw("Timed out draining node, ready to terminate with work in flight",
+			"inFlightRuns", d.status.InFlightRuns, "pendingTxs", d.status.PendingTxs)
+	}
+	return d.status
+}
+
+// evmPendingTxs returns a func counting the unstarted and in progress transactions on every enabled EVM chain.
+func evmPendingTxs(relayers RelayerChainInteroperators, txStore txmgr.EvmTxStore) func(ctx context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) {
+		var pending int
+		for _, c := range relayers.LegacyEVMChains().Slice() {
+			for _, state := range []txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress} {
+				n, err := txStore.CountTransactionsByState(ctx, state, c.ID())
+				if err != nil {
+					return 0, err
+				}
+				pending += int(n)
+			}
+		}
+		return pending, nil
+	}
+}
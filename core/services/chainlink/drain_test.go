@@ -0,0 +1,72 @@
+package chainlink
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestDrainer(t *testing.T) {
+	ctx := testutils.Context(t)
+	now := time.Now()
+
+	setup := func(t *testing.T, inFlightRuns int, pendingTxs func(context.Context) (int, error)) *drainer {
+		runner := pipelinemocks.NewRunner(t)
+		runner.On("Drain").Return().Maybe()
+		runner.On("InFlightRuns").Return(inFlightRuns).Maybe()
+		d := newDrainer(runner, pendingTxs, logger.TestLogger(t))
+		d.now = func() time.Time { return now }
+		return d
+	}
+	pending := func(n int, err error) func(context.Context) (int, error) {
+		return func(context.Context) (int, error) { return n, err }
+	}
+
+	t.Run("not draining", func(t *testing.T) {
+		d := setup(t, 1, pending(1, nil))
+		assert.Equal(t, DrainStatus{}, d.Status(ctx))
+	})
+
+	t.Run("drained", func(t *testing.T) {
+		d := setup(t, 0, pending(0, nil))
+		s := d.Drain(ctx, time.Minute)
+		assert.True(t, s.Draining)
+		assert.True(t, s.ReadyToTerminate)
+		assert.False(t, s.TimedOut)
+		assert.Equal(t, now.Add(time.Minute), s.Deadline)
+	})
+
+	t.Run("work in flight", func(t *testing.T) {
+		d := setup(t, 2, pending(3, nil))
+		s := d.Drain(ctx, time.Minute)
+		assert.True(t, s.Draining)
+		assert.False(t, s.ReadyToTerminate)
+		assert.Equal(t, 2, s.InFlightRuns)
+		assert.Equal(t, 3, s.PendingTxs)
+
+		// draining again keeps the deadline of the first drain
+		now = now.Add(time.Minute)
+		s = d.Drain(ctx, time.Hour)
+		assert.Equal(t, now, s.Deadline)
+		assert.True(t, s.ReadyToTerminate)
+		assert.True(t, s.TimedOut)
+	})
+
+	t.Run("failed to count pending txs", func(t *testing.T) {
+		d := setup(t, 0, pending(0, errors.New("db down")))
+		s := d.Drain(ctx, time.Minute)
+		assert.False(t, s.ReadyToTerminate)
+
+		now = now.Add(time.Minute)
+		s = d.Status(ctx)
+		assert.True(t, s.ReadyToTerminate)
+		assert.True(t, s.TimedOut)
+	})
+}
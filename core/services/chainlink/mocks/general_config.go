@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	aptos "github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
+
 	chainlinkconfig "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/config"
+
 	config "github.com/smartcontractkit/chainlink/v2/core/config"
 
 	cosmosconfig "github.com/smartcontractkit/chainlink-cosmos/pkg/cosmos/config"
@@ -46,6 +49,44 @@ func (_m *GeneralConfig) AppID() uuid.UUID {
 	return r0
 }
 
+// AptosConfigs provides a mock function with given fields:
+func (_m *GeneralConfig) AptosConfigs() aptos.TOMLConfigs {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AptosConfigs")
+	}
+
+	var r0 aptos.TOMLConfigs
+	if rf, ok := ret.Get(0).(func() aptos.TOMLConfigs); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(aptos.TOMLConfigs)
+		}
+	}
+
+	return r0
+}
+
+// AptosEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) AptosEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AptosEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // AuditLogger provides a mock function with given fields:
 func (_m *GeneralConfig) AuditLogger() config.AuditLogger {
 	ret := _m.Called()
@@ -86,6 +127,26 @@ func (_m *GeneralConfig) AutoPprof() config.AutoPprof {
 	return r0
 }
 
+// BalanceMonitor provides a mock function with given fields:
+func (_m *GeneralConfig) BalanceMonitor() config.BalanceMonitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BalanceMonitor")
+	}
+
+	var r0 config.BalanceMonitor
+	if rf, ok := ret.Get(0).(func() config.BalanceMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.BalanceMonitor)
+		}
+	}
+
+	return r0
+}
+
 // ConfigTOML provides a mock function with given fields:
 func (_m *GeneralConfig) ConfigTOML() (string, string) {
 	ret := _m.Called()
@@ -346,6 +407,26 @@ func (_m *GeneralConfig) Keeper() config.Keeper {
 	return r0
 }
 
+// Keystore provides a mock function with given fields:
+func (_m *GeneralConfig) Keystore() config.Keystore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keystore")
+	}
+
+	var r0 config.Keystore
+	if rf, ok := ret.Get(0).(func() config.Keystore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Keystore)
+		}
+	}
+
+	return r0
+}
+
 // Log provides a mock function with given fields:
 func (_m *GeneralConfig) Log() config.Log {
 	ret := _m.Called()
@@ -15,11 +15,17 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 )
 
-var ErrNoSuchRelayer = errors.New("relayer does not exist")
+var (
+	ErrNoSuchRelayer = errors.New("relayer does not exist")
+	// ErrChainNotToggleable is returned when enabling or disabling a chain which does not support it at runtime.
+	ErrChainNotToggleable = errors.New("only evm chains can be enabled or disabled at runtime")
+)
 
 // RelayerChainInteroperators
 // encapsulates relayers and chains and is the primary entry point for
@@ -79,12 +85,18 @@ type CoreRelayerChainInteroperators struct {
 	// we keep an explicit list of services because the legacy implementations have more than
 	// just the relayer service
 	srvs []services.ServiceCtx
+
+	// newEVMRelayer recreates the relayer of an EVM chain, to enable it again after it was disabled.
+	newEVMRelayer func(ctx context.Context, chainID string) (evmrelay.LoopRelayAdapter, error)
+	// disabled are the last known statuses of the chains disabled at runtime.
+	disabled map[relay.ID]types.ChainStatus
 }
 
 func NewCoreRelayerChainInteroperators(initFuncs ...CoreRelayerChainInitFunc) (*CoreRelayerChainInteroperators, error) {
 	cr := &CoreRelayerChainInteroperators{
 		loopRelayers: make(map[relay.ID]loop.Relayer),
 		srvs:         make([]services.ServiceCtx, 0),
+		disabled:     make(map[relay.ID]types.ChainStatus),
 	}
 	for _, initFn := range initFuncs {
 		err := initFn(cr)
@@ -114,6 +126,9 @@ func InitEVM(ctx context.Context, factory RelayerFactory, config EVMFactoryConfi
 			legacyMap[id.ChainID] = a.Chain()
 		}
 		op.legacyChains.EVMChains = legacyevm.NewLegacyChains(legacyMap, config.AppConfig.EVMConfigs())
+		op.newEVMRelayer = func(ctx context.Context, chainID string) (evmrelay.LoopRelayAdapter, error) {
+			return factory.NewEVMRelayer(ctx, config, chainID)
+		}
 		return nil
 	}
 }
@@ -172,6 +187,23 @@ func InitStarknet(ctx context.Context, factory RelayerFactory, config StarkNetFa
 	}
 }
 
+// InitAptos is a option for instantiating Aptos relayers
+func InitAptos(ctx context.Context, factory RelayerFactory, config AptosFactoryConfig) CoreRelayerChainInitFunc {
+	return func(op *CoreRelayerChainInteroperators) error {
+		aptosRelayers, err := factory.NewAptos(config.Keystore, config.TOMLConfigs)
+		if err != nil {
+			return fmt.Errorf("failed to setup Aptos relayer: %w", err)
+		}
+
+		for id, relayer := range aptosRelayers {
+			op.srvs = append(op.srvs, relayer)
+			op.loopRelayers[id] = relayer
+		}
+
+		return nil
+	}
+}
+
 // Get a [loop.Relayer] by id
 func (rs *CoreRelayerChainInteroperators) Get(id relay.ID) (loop.Relayer, error) {
 	rs.mu.Lock()
@@ -185,6 +217,24 @@ func (rs *CoreRelayerChainInteroperators) Get(id relay.ID) (loop.Relayer, error)
 
 // LegacyEVMChains returns a container with all the evm chains
 // TODO BCF-2511
+// RelayerChainChecker implements [job.ChainChecker], reporting a chain as ready once its relayer is started and healthy.
+type RelayerChainChecker struct {
+	Relayers LoopRelayerStorer
+}
+
+var _ job.ChainChecker = RelayerChainChecker{}
+
+func (c RelayerChainChecker) ChainReady(id relay.ID) error {
+	r, err := c.Relayers.Get(id)
+	if err != nil {
+		return err
+	}
+	if err = r.Ready(); err != nil {
+		return err
+	}
+	return r.HealthReport()[r.Name()]
+}
+
 func (rs *CoreRelayerChainInteroperators) LegacyEVMChains() legacyevm.LegacyChainContainer {
 	rs.mu.Lock()
 	defer rs.mu.Unlock()
@@ -201,6 +251,12 @@ func (rs *CoreRelayerChainInteroperators) LegacyCosmosChains() LegacyCosmosConta
 
 // ChainStatus gets [types.ChainStatus]
 func (rs *CoreRelayerChainInteroperators) ChainStatus(ctx context.Context, id relay.ID) (types.ChainStatus, error) {
+	rs.mu.Lock()
+	stat, disabled := rs.disabled[id]
+	rs.mu.Unlock()
+	if disabled {
+		return stat, nil
+	}
 
 	lr, err := rs.Get(id)
 	if err != nil {
@@ -223,10 +279,17 @@ func (rs *CoreRelayerChainInteroperators) ChainStatuses(ctx context.Context, off
 	for rid := range rs.loopRelayers {
 		relayerIds = append(relayerIds, rid)
 	}
+	for rid := range rs.disabled {
+		relayerIds = append(relayerIds, rid)
+	}
 	sort.Slice(relayerIds, func(i, j int) bool {
 		return relayerIds[i].String() < relayerIds[j].String()
 	})
 	for _, rid := range relayerIds {
+		if stat, disabled := rs.disabled[rid]; disabled {
+			stats = append(stats, stat)
+			continue
+		}
 		lr := rs.loopRelayers[rid]
 		stat, err := lr.GetChainStatus(ctx)
 		if err != nil {
@@ -246,6 +309,94 @@ func (rs *CoreRelayerChainInteroperators) ChainStatuses(ctx context.Context, off
 	return stats[offset:], cnt, nil
 }
 
+// DisableEVMChain closes the relayer of the EVM chain, along with its chain, and removes them until the chain is
+// enabled again with [CoreRelayerChainInteroperators.EnableEVMChain]. The chain is still reported by
+// [CoreRelayerChainInteroperators.ChainStatus], as disabled. The closed relayer is returned.
+func (rs *CoreRelayerChainInteroperators) DisableEVMChain(ctx context.Context, id relay.ID) (evmrelay.LoopRelayAdapter, error) {
+	if id.Network != relay.EVM {
+		return nil, fmt.Errorf("%w: %s", ErrChainNotToggleable, id.Name())
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	lr, exist := rs.loopRelayers[id]
+	if !exist {
+		if _, disabled := rs.disabled[id]; disabled {
+			return nil, fmt.Errorf("chain %s is already disabled", id.Name())
+		}
+		return nil, fmt.Errorf("%w: %w: %s", chains.ErrNotFound, ErrNoSuchRelayer, id)
+	}
+	adapter, ok := lr.(evmrelay.LoopRelayAdapter)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrChainNotToggleable, id.Name())
+	}
+	stat, err := adapter.GetChainStatus(ctx)
+	if err != nil {
+		stat = types.ChainStatus{ID: id.ChainID}
+	}
+	stat.Enabled = false
+
+	delete(rs.loopRelayers, id)
+	rs.removeService(adapter)
+	if kv, ok := rs.legacyChains.EVMChains.(legacyEVMChainsKV); ok {
+		kv.Delete(id.ChainID)
+	}
+	rs.disabled[id] = stat
+
+	if err = adapter.Close(); err != nil {
+		return adapter, fmt.Errorf("failed to close relayer of chain %s: %w", id.Name(), err)
+	}
+	return adapter, nil
+}
+
+// EnableEVMChain recreates and starts the relayer of the EVM chain, after it was disabled with
+// [CoreRelayerChainInteroperators.DisableEVMChain]. The started relayer is returned.
+func (rs *CoreRelayerChainInteroperators) EnableEVMChain(ctx context.Context, id relay.ID) (evmrelay.LoopRelayAdapter, error) {
+	if id.Network != relay.EVM || rs.newEVMRelayer == nil {
+		return nil, fmt.Errorf("%w: %s", ErrChainNotToggleable, id.Name())
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if _, disabled := rs.disabled[id]; !disabled {
+		if _, exist := rs.loopRelayers[id]; exist {
+			return nil, fmt.Errorf("chain %s is already enabled", id.Name())
+		}
+		return nil, fmt.Errorf("%w: %w: %s", chains.ErrNotFound, ErrNoSuchRelayer, id)
+	}
+	adapter, err := rs.newEVMRelayer(ctx, id.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create relayer of chain %s: %w", id.Name(), err)
+	}
+	if err = adapter.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start relayer of chain %s: %w", id.Name(), err)
+	}
+
+	delete(rs.disabled, id)
+	rs.loopRelayers[id] = adapter
+	rs.srvs = append(rs.srvs, adapter)
+	if kv, ok := rs.legacyChains.EVMChains.(legacyEVMChainsKV); ok {
+		kv.Put(id.ChainID, adapter.Chain())
+	}
+	return adapter, nil
+}
+
+// removeService removes the service from srvs. rs.mu must be held.
+func (rs *CoreRelayerChainInteroperators) removeService(srv services.ServiceCtx) {
+	for i := range rs.srvs {
+		if rs.srvs[i] == srv {
+			rs.srvs = append(rs.srvs[:i], rs.srvs[i+1:]...)
+			return
+		}
+	}
+}
+
+// legacyEVMChainsKV is implemented by the containers of EVM chains which can be changed at runtime.
+type legacyEVMChainsKV interface {
+	Put(id string, chn legacyevm.Chain)
+	Delete(id string)
+}
+
 func (rs *CoreRelayerChainInteroperators) Node(ctx context.Context, name string) (types.NodeStatus, error) {
 	// This implementation is round-about
 	// TODO BFC-2511, may be better in the loop.Relayer interface itself
@@ -323,21 +474,30 @@ func FilterRelayersByType(network relay.Network) func(id relay.ID) bool {
 func (rs *CoreRelayerChainInteroperators) List(filter FilterFn) RelayerChainInteroperators {
 
 	matches := make(map[relay.ID]loop.Relayer)
+	disabled := make(map[relay.ID]types.ChainStatus)
 	rs.mu.Lock()
 	for id, relayer := range rs.loopRelayers {
 		if filter(id) {
 			matches[id] = relayer
 		}
 	}
+	for id, stat := range rs.disabled {
+		if filter(id) {
+			disabled[id] = stat
+		}
+	}
 	rs.mu.Unlock()
 	return &CoreRelayerChainInteroperators{
 		loopRelayers: matches,
+		disabled:     disabled,
 	}
 }
 
 // Returns a slice of [loop.Relayer]. A typically usage pattern to is
 // use [List(criteria)].Slice() for range based operations
 func (rs *CoreRelayerChainInteroperators) Slice() []loop.Relayer {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
 	var result []loop.Relayer
 	for _, r := range rs.loopRelayers {
 		result = append(result, r)
@@ -454,6 +454,52 @@ func TestCoreRelayerChainInteroperators(t *testing.T) {
 		assert.Nil(t, cr)
 		assert.ErrorIs(t, err, errBadFunc)
 	})
+
+	t.Run("disable and enable evm chain", func(t *testing.T) {
+		cr, err := chainlink.NewCoreRelayerChainInteroperators(
+			chainlink.InitEVM(testctx, factory, chainlink.EVMFactoryConfig{
+				ChainOpts: legacyevm.ChainOpts{
+					AppConfig: cfg,
+					MailMon:   &mailbox.Monitor{},
+					DB:        db,
+				},
+				CSAETHKeystore: keyStore,
+			}),
+			chainlink.InitSolana(testctx, factory, chainlink.SolanaFactoryConfig{
+				Keystore:    keyStore.Solana(),
+				TOMLConfigs: cfg.SolanaConfigs()}),
+		)
+		require.NoError(t, err)
+		evmID := relay.ID{Network: relay.EVM, ChainID: evmChainID1.String()}
+
+		_, err = cr.DisableEVMChain(testctx, relay.ID{Network: relay.Solana, ChainID: solanaChainID1})
+		assert.ErrorIs(t, err, chainlink.ErrChainNotToggleable)
+		_, err = cr.EnableEVMChain(testctx, evmID)
+		assert.Error(t, err, "chain is not disabled")
+
+		_, err = cr.DisableEVMChain(testctx, evmID)
+		require.NoError(t, err)
+		_, err = cr.Get(evmID)
+		assert.ErrorIs(t, err, chainlink.ErrNoSuchRelayer)
+		_, err = cr.LegacyEVMChains().Get(evmID.ChainID)
+		assert.Error(t, err)
+		stat, err := cr.ChainStatus(testctx, evmID)
+		require.NoError(t, err)
+		assert.False(t, stat.Enabled)
+		stats, cnt, err := cr.List(chainlink.FilterRelayersByType(relay.EVM)).ChainStatuses(testctx, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 2, cnt)
+		assert.False(t, stats[0].Enabled)
+
+		relayer, err := cr.EnableEVMChain(testctx, evmID)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+		_, err = cr.Get(evmID)
+		assert.NoError(t, err)
+		c, err := cr.LegacyEVMChains().Get(evmID.ChainID)
+		require.NoError(t, err)
+		assert.Equal(t, relayer.Chain(), c)
+	})
 }
 
 func ptr[T any](t T) *T { return &t }
@@ -17,6 +17,7 @@ import (
 	starkchain "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/chain"
 	"github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -58,33 +59,51 @@ func (r *RelayerFactory) NewEVM(ctx context.Context, config EVMFactoryConfig) (m
 	if err != nil {
 		return nil, err
 	}
-	legacyChains := evmrelay.NewLegacyChainsFromRelayerExtenders(evmRelayExtenders)
 	for _, ext := range evmRelayExtenders.Slice() {
 		relayID := relay.ID{Network: relay.EVM, ChainID: ext.Chain().ID().String()}
-		chain, err2 := legacyChains.Get(relayID.ChainID)
-		if err2 != nil {
-			return nil, err2
-		}
-
-		relayerOpts := evmrelay.RelayerOpts{
-			DB:             ccOpts.DB,
-			QConfig:        ccOpts.AppConfig.Database(),
-			CSAETHKeystore: config.CSAETHKeystore,
-			MercuryPool:    r.MercuryPool,
-		}
-		relayer, err2 := evmrelay.NewRelayer(lggr.Named(relayID.ChainID), chain, relayerOpts)
+		relayer, err2 := r.newEVMRelayer(lggr, config, ext)
 		if err2 != nil {
 			err = errors.Join(err, err2)
 			continue
 		}
 
-		relayers[relayID] = evmrelay.NewLoopRelayServerAdapter(relayer, ext)
+		relayers[relayID] = relayer
 	}
 
 	// always return err because it is accumulating individual errors
 	return relayers, err
 }
 
+// NewEVMRelayer creates the relayer of the single EVM chain with the given ID, e.g. to recreate it after it was
+// closed at runtime.
+func (r *RelayerFactory) NewEVMRelayer(ctx context.Context, config EVMFactoryConfig, chainID string) (evmrelay.LoopRelayAdapter, error) {
+	lggr := r.Logger.Named("EVM")
+	ccOpts := legacyevm.ChainRelayExtenderConfig{
+		Logger:    lggr,
+		KeyStore:  config.CSAETHKeystore.Eth(),
+		ChainOpts: config.ChainOpts,
+	}
+	ext, err := evmrelay.NewChainRelayerExtender(ctx, ccOpts, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return r.newEVMRelayer(lggr, config, ext)
+}
+
+func (r *RelayerFactory) newEVMRelayer(lggr logger.Logger, config EVMFactoryConfig, ext evmrelay.EVMChainRelayerExtender) (evmrelay.LoopRelayAdapter, error) {
+	relayerOpts := evmrelay.RelayerOpts{
+		DB:             config.DB,
+		QConfig:        config.AppConfig.Database(),
+		CSAETHKeystore: config.CSAETHKeystore,
+		MercuryPool:    r.MercuryPool,
+	}
+	relayer, err := evmrelay.NewRelayer(lggr.Named(ext.Chain().ID().String()), ext.Chain(), relayerOpts)
+	if err != nil {
+		return nil, err
+	}
+	return evmrelay.NewLoopRelayServerAdapter(relayer, ext), nil
+}
+
 type SolanaFactoryConfig struct {
 	Keystore keystore.Solana
 	solana.TOMLConfigs
@@ -225,6 +244,69 @@ func (r *RelayerFactory) NewStarkNet(ks keystore.StarkNet, chainCfgs config.TOML
 
 }
 
+type AptosFactoryConfig struct {
+	Keystore keystore.Aptos
+	aptos.TOMLConfigs
+}
+
+// NewAptos returns a relayer per enabled Aptos chain. The relayers are LOOPPs when CL_APTOS_CMD is set, and the
+// embedded stub otherwise.
+func (r *RelayerFactory) NewAptos(ks keystore.Aptos, chainCfgs aptos.TOMLConfigs) (map[relay.ID]loop.Relayer, error) {
+	aptosRelayers := make(map[relay.ID]loop.Relayer)
+
+	var (
+		aptosLggr = r.Logger.Named("Aptos")
+		signer    = &keystore.AptosSigner{Aptos: ks}
+	)
+
+	unique := make(map[string]struct{})
+	// create one relayer per chain id
+	for _, chainCfg := range chainCfgs {
+		relayID := relay.ID{Network: relay.Aptos, ChainID: *chainCfg.ChainID}
+		_, alreadyExists := unique[relayID.Name()]
+		if alreadyExists {
+			return nil, fmt.Errorf("duplicate chain definitions for %s", relayID.Name())
+		}
+		unique[relayID.Name()] = struct{}{}
+
+		// skip disabled chains from further processing
+		if !chainCfg.IsEnabled() {
+			aptosLggr.Warnw("Skipping disabled chain", "id", chainCfg.ChainID)
+			continue
+		}
+
+		lggr := aptosLggr.Named(relayID.ChainID)
+
+		if cmdName := env.AptosPluginCmd.Get(); cmdName != "" {
+			// setup the aptos relayer to be a LOOP
+			cfgTOML, err := toml.Marshal(struct {
+				Aptos aptos.TOMLConfig
+			}{Aptos: *chainCfg})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal Aptos configs: %w", err)
+			}
+
+			aptosCmdFn, err := plugins.NewCmdFactory(r.Register, plugins.CmdConfig{
+				ID:  relayID.Name(),
+				Cmd: cmdName,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Aptos LOOP command: %w", err)
+			}
+			aptosRelayers[relayID] = loop.NewRelayerService(lggr, r.GRPCOpts, aptosCmdFn, string(cfgTOML), signer)
+		} else {
+			// fallback to the embedded stub, which only reads the state of the chain
+			chain, err := aptos.NewChain(chainCfg, lggr)
+			if err != nil {
+				return nil, err
+			}
+
+			aptosRelayers[relayID] = relay.NewServerAdapter(aptos.NewRelayer(lggr, chain), chain)
+		}
+	}
+	return aptosRelayers, nil
+}
+
 type CosmosFactoryConfig struct {
 	Keystore keystore.Cosmos
 	coscfg.TOMLConfigs
@@ -5,6 +5,7 @@ import (
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana"
 	stkcfg "github.com/smartcontractkit/chainlink-starknet/relayer/pkg/chainlink/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/aptos"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 )
@@ -17,6 +18,7 @@ type GeneralConfig interface {
 	CosmosConfigs() coscfg.TOMLConfigs
 	SolanaConfigs() solana.TOMLConfigs
 	StarknetConfigs() stkcfg.TOMLConfigs
+	AptosConfigs() aptos.TOMLConfigs
 	// ConfigTOML returns both the user provided and effective configuration as TOML.
 	ConfigTOML() (user, effective string)
 }
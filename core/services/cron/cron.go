@@ -3,54 +3,85 @@ package cron
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+// scheduleParser parses schedules the same way the cron runner does.
+var scheduleParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
+	jobORM         job.ORM
 	pipelineRunner pipeline.Runner
 	chStop         services.StopChan
+	wg             sync.WaitGroup
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
+	jobORM job.ORM,
 	pipelineRunner pipeline.Runner,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
 		"jobID", jobSpec.ID,
-		"schedule", jobSpec.CronSpec.CronSchedule,
+		"schedule", jobSpec.CronSpec.Schedule(),
 	)
 
 	return &Cron{
 		cronRunner:     cronRunner(),
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
+		jobORM:         jobORM,
 		pipelineRunner: pipelineRunner,
 		chStop:         make(chan struct{}),
 	}, nil
 }
 
+// NextScheduledRun returns the first occurrence of the spec's schedule after
+// the given time, or the zero time if there is none.
+func NextScheduledRun(spec job.CronSpec, after time.Time) (time.Time, error) {
+	schedule, err := scheduleParser.Parse(spec.Schedule())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(after), nil
+}
+
 // Start implements the job.Service interface.
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	schedule, err := scheduleParser.Parse(cr.jobSpec.CronSpec.Schedule())
 	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
+		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err, "schedule", cr.jobSpec.CronSpec.Schedule(), "jobID", cr.jobSpec.ID)
 		return err
 	}
+	if cr.jobSpec.CronSpec.CatchUpPolicy == job.CronCatchUpRunMissed {
+		now := time.Now()
+		cr.wg.Add(1)
+		go func() {
+			defer cr.wg.Done()
+			cr.catchUp(schedule, now)
+		}()
+	}
+	cr.cronRunner.Schedule(schedule, cron.FuncJob(cr.runPipeline))
 	cr.cronRunner.Start()
 	return nil
 }
@@ -60,10 +91,74 @@ func (cr *Cron) Start(context.Context) error {
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
 	cr.cronRunner.Stop()
+	close(cr.chStop)
+	cr.wg.Wait()
 	return nil
 }
 
+// catchUp runs the occurrences of the schedule missed between the last run of
+// the job and now, up to MaxCatchUpRuns of the most recent ones.
+func (cr *Cron) catchUp(schedule cron.Schedule, now time.Time) {
+	since, err := cr.lastRunAt()
+	if err != nil {
+		cr.logger.Errorw("Failed to look up the last run, skipping catch-up", "err", err)
+		return
+	}
+	missed := missedRuns(schedule, since, now, int(cr.jobSpec.CronSpec.MaxCatchUpRuns))
+	if len(missed) == 0 {
+		return
+	}
+	cr.logger.Infow("Running missed occurrences", "count", len(missed), "since", since)
+	for _, scheduledAt := range missed {
+		select {
+		case <-cr.chStop:
+			return
+		default:
+		}
+		cr.run(map[string]interface{}{
+			"catchUp":       true,
+			"scheduledTime": scheduledAt.UTC().Format(time.RFC3339),
+		})
+	}
+}
+
+// lastRunAt returns the time of the latest run of the job, or its creation
+// time if it never ran.
+func (cr *Cron) lastRunAt() (time.Time, error) {
+	ids, err := cr.jobORM.FindPipelineRunIDsByJobID(cr.jobSpec.ID, 0, 1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(ids) == 0 {
+		return cr.jobSpec.CreatedAt, nil
+	}
+	run, err := cr.jobORM.FindPipelineRunByID(ids[0])
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to load pipeline run %d", ids[0])
+	}
+	return run.CreatedAt, nil
+}
+
+// missedRuns returns up to max of the latest occurrences of schedule after
+// since and no later than now, oldest first.
+func missedRuns(schedule cron.Schedule, since, now time.Time, max int) (missed []time.Time) {
+	if since.IsZero() || max <= 0 {
+		return nil
+	}
+	for t := schedule.Next(since); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		missed = append(missed, t)
+		if len(missed) > max {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
+
 func (cr *Cron) runPipeline() {
+	cr.run(map[string]interface{}{})
+}
+
+func (cr *Cron) run(meta map[string]interface{}) {
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
 
@@ -74,13 +169,15 @@ func (cr *Cron) runPipeline() {
 			"name":          cr.jobSpec.Name.ValueOrZero(),
 		},
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta": meta,
 		},
 	})
 
 	run := pipeline.NewRun(*cr.jobSpec.PipelineSpec, vars)
 
+	ctx, span := tracing.Start(logctx.WithJobID(ctx, cr.jobSpec.ID), "job.trigger", tracing.Trigger.String("cron"))
 	_, err := cr.pipelineRunner.Run(ctx, run, cr.logger, false, nil)
+	tracing.End(span, err)
 	if err != nil {
 		cr.logger.Errorf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID)
 	}
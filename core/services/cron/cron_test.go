@@ -1,7 +1,9 @@
 package cron_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 )
@@ -38,7 +41,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, jobORM, lggr)
 
 	require.NoError(t, jobORM.CreateJob(jb))
 	serviceArray, err := delegate.ServicesForSpec(*jb)
@@ -67,7 +70,7 @@ func TestCronV2Schedule(t *testing.T) {
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, nil, runner, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -75,3 +78,53 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+func TestCronV2CatchUp(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		ID:            42,
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			CronSchedule:   "0 0 0 1 1 *",
+			Timezone:       "UTC",
+			CatchUpPolicy:  job.CronCatchUpRunMissed,
+			MaxCatchUpRuns: 2,
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	lastYear := time.Now().UTC().Year() - 1
+	jobORM := jobmocks.NewORM(t)
+	jobORM.On("FindPipelineRunIDsByJobID", spec.ID, 0, 1).Return([]int64{7}, nil)
+	jobORM.On("FindPipelineRunByID", int64(7)).Return(pipeline.Run{
+		CreatedAt: time.Date(lastYear-3, time.June, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	var scheduled []string
+	runner := pipelinemocks.NewRunner(t)
+	awaiter := cltest.NewAwaiter()
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			run := args.Get(1).(*pipeline.Run)
+			meta := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})["meta"].(map[string]interface{})
+			assert.Equal(t, true, meta["catchUp"])
+			scheduled = append(scheduled, meta["scheduledTime"].(string))
+			if len(scheduled) == 2 {
+				awaiter.ItHappened()
+			}
+		}).
+		Return(false, nil).
+		Twice()
+
+	service, err := cron.NewCronFromJobSpec(spec, jobORM, runner, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+	awaiter.AwaitOrFail(t)
+	require.NoError(t, service.Close())
+
+	assert.Equal(t, []string{
+		fmt.Sprintf("%d-01-01T00:00:00Z", lastYear),
+		fmt.Sprintf("%d-01-01T00:00:00Z", lastYear+1),
+	}, scheduled)
+}
@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
@@ -34,8 +37,32 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
-		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
+	if spec.Timezone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") || strings.HasPrefix(spec.CronSchedule, "TZ=") {
+			return jb, errors.New("timezone cannot be combined with a time zone in the cron schedule")
+		}
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(spec.Schedule()); err != nil {
+		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.Schedule())
+	}
+
+	switch spec.CatchUpPolicy {
+	case "":
+		spec.CatchUpPolicy = job.CronCatchUpSkip
+		fallthrough
+	case job.CronCatchUpSkip:
+		if spec.MaxCatchUpRuns != 0 {
+			return jb, errors.Errorf("maxCatchUpRuns requires catchUpPolicy '%s'", job.CronCatchUpRunMissed)
+		}
+	case job.CronCatchUpRunMissed:
+		if spec.MaxCatchUpRuns == 0 {
+			return jb, errors.Errorf("catchUpPolicy '%s' requires maxCatchUpRuns to be greater than 0", job.CronCatchUpRunMissed)
+		}
+	default:
+		return jb, errors.Errorf("invalid catchUpPolicy '%s', must be one of '%s' or '%s'", spec.CatchUpPolicy, job.CronCatchUpSkip, job.CronCatchUpRunMissed)
 	}
 
 	return jb, nil
@@ -77,6 +77,138 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "timezone and catch-up policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 1 1 * *"
+timezone        = "America/New_York"
+catchUpPolicy   = "runMissed"
+maxCatchUpRuns  = 5
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "America/New_York", s.CronSpec.Timezone)
+				assert.Equal(t, "CRON_TZ=America/New_York 0 0 1 1 * *", s.CronSpec.Schedule())
+				assert.Equal(t, job.CronCatchUpRunMissed, s.CronSpec.CatchUpPolicy)
+				assert.Equal(t, uint32(5), s.CronSpec.MaxCatchUpRuns)
+			},
+		},
+		{
+			name: "default catch-up policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1h"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.CronCatchUpSkip, s.CronSpec.CatchUpPolicy)
+			},
+		},
+		{
+			name: "invalid timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 1 1 * *"
+timezone        = "Mars/Olympus_Mons"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.ErrorContains(t, err, "invalid timezone 'Mars/Olympus_Mons'")
+			},
+		},
+		{
+			name: "timezone with CRON_TZ",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+timezone        = "UTC"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "timezone cannot be combined with a time zone in the cron schedule")
+			},
+		},
+		{
+			name: "invalid catch-up policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1h"
+catchUpPolicy   = "all"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "invalid catchUpPolicy 'all', must be one of 'skip' or 'runMissed'")
+			},
+		},
+		{
+			name: "runMissed without maxCatchUpRuns",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1h"
+catchUpPolicy   = "runMissed"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "catchUpPolicy 'runMissed' requires maxCatchUpRuns to be greater than 0")
+			},
+		},
+		{
+			name: "maxCatchUpRuns with skip",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1h"
+maxCatchUpRuns  = 3
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "maxCatchUpRuns requires catchUpPolicy 'runMissed'")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,279 @@
+package dbmaintenance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/archive"
+)
+
+var (
+	promRowsDeleted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_maintenance_rows_deleted",
+		Help: "The number of rows deleted by the database maintenance service",
+	}, []string{"table"})
+	promLastRun = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_maintenance_last_run_timestamp",
+		Help: "The unix timestamp of the last maintenance run of the table",
+	}, []string{"table"})
+	promTableSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_maintenance_table_size_bytes",
+		Help: "The total size of the table, including its indexes and TOAST data",
+	}, []string{"table"})
+	promTableGrowth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_maintenance_table_growth_bytes_per_day",
+		Help: "The projected daily growth of the table, from its sizes after the last two maintenance runs",
+	}, []string{"table"})
+)
+
+// table is a table with a retention policy.
+type table struct {
+	name   string
+	policy func(config.Maintenance) config.MaintenancePolicy
+	// prune deletes at most $2 rows which are older than $1, and selects the number of deleted rows.
+	prune string
+	// archived tables are archived by the archiver, if set, instead of pruned.
+	archived bool
+}
+
+// countDeleted wraps a DELETE statement which returns its deleted rows in a query which selects their number.
+func countDeleted(stmt string) string {
+	return "WITH deleted AS (" + stmt + ") SELECT count(*) FROM deleted"
+}
+
+// Deleting transactions relies on foreign keys to delete their attempts and receipts. The rows of the partitioned
+// tables are selected by their keys, since their ctids are only unique within a partition.
+var tables = []table{
+	{"pipeline_runs", config.Maintenance.PipelineRuns, pipeline.DeleteRunsQuery(`DELETE FROM pipeline_runs WHERE id IN (
+	SELECT id FROM pipeline_runs WHERE finished_at < $1 ORDER BY finished_at LIMIT $2
+) RETURNING id`), true},
+	{"evm.logs", config.Maintenance.Logs, countDeleted(`DELETE FROM evm.logs WHERE (evm_chain_id, block_hash, log_index) IN (
+	SELECT evm_chain_id, block_hash, log_index FROM evm.logs WHERE block_timestamp < $1 LIMIT $2
+) RETURNING 1`), false},
+	{"evm.txes", config.Maintenance.Transactions, countDeleted(`DELETE FROM evm.txes WHERE id IN (
+	SELECT id FROM evm.txes WHERE state IN ('confirmed', 'fatal_error') AND created_at < $1 ORDER BY id LIMIT $2
+) RETURNING 1`), false},
+	{"evm.heads", config.Maintenance.Heads, countDeleted(`DELETE FROM evm.heads WHERE id IN (
+	SELECT id FROM evm.heads WHERE created_at < $1 ORDER BY id LIMIT $2
+) RETURNING 1`), false},
+}
+
+// tableSizeQuery selects the total size and estimated row count of table $1, including all its partitions.
+const tableSizeQuery = `WITH RECURSIVE tree AS (
+	SELECT $1::regclass::oid AS oid
+	UNION ALL
+	SELECT i.inhrelid FROM pg_inherits i JOIN tree ON i.inhparent = tree.oid
+)
+SELECT COALESCE(sum(pg_total_relation_size(c.oid)), 0)::bigint, COALESCE(sum(GREATEST(c.reltuples, 0)), 0)::bigint
+FROM tree JOIN pg_class c ON c.oid = tree.oid`
+
+// TableStats are the stats of a table, as of its last maintenance run.
+type TableStats struct {
+	Table     string
+	Retention time.Duration // zero if rows are kept forever
+
+	LastRun     time.Time // zero if it never ran
+	Duration    time.Duration
+	RowsDeleted int64
+	// Complete is false if the run was interrupted, e.g. because the window closed, before every old row was deleted.
+	Complete bool
+	Err      error
+
+	SizeBytes     int64
+	EstimatedRows int64
+	// GrowthPerDay is the projected daily growth of the table in bytes, from its sizes after the last two runs. It is
+	// negative if the table shrinks.
+	GrowthPerDay int64
+}
+
+// Maintenance prunes old rows from the largest tables of the database, according to the retention policy of each
+// table, and tracks their size.
+type Maintenance interface {
+	services.Service
+	// Stats returns the stats of each table, as of its last run.
+	Stats() []TableStats
+}
+
+type maintenance struct {
+	services.StateMachine
+	db       *sqlx.DB
+	cfg      config.Maintenance
+	archiver archive.Archiver
+	lggr     logger.SugaredLogger
+	now      func() time.Time
+
+	mu    sync.RWMutex
+	stats map[string]TableStats
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+// New returns a Maintenance which prunes tables every cfg.Interval(), within cfg.Window() if set. Pipeline runs are
+// archived by archiver before they are deleted, unless it is nil.
+func New(db *sqlx.DB, cfg config.Maintenance, archiver archive.Archiver, lggr logger.Logger) Maintenance {
+	return &maintenance{
+		db:       db,
+		cfg:      cfg,
+		archiver: archiver,
+		lggr:     logger.Sugared(lggr.Named("DatabaseMaintenance")),
+		now:      time.Now,
+		stats:    make(map[string]TableStats),
+		chStop:   make(services.StopChan),
+	}
+}
+
+func (m *maintenance) Start(context.Context) error {
+	return m.StartOnce("DatabaseMaintenance", func() error {
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *maintenance) Close() error {
+	return m.StopOnce("DatabaseMaintenance", func() error {
+		close(m.chStop)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *maintenance) Name() string {
+	return m.lggr.Name()
+}
+
+func (m *maintenance) HealthReport() map[string]error {
+	report := map[string]error{m.Name(): m.Healthy()}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for name, s := range m.stats {
+		if s.Err != nil {
+			report[m.Name()+"."+name] = s.Err
+		}
+	}
+	return report
+}
+
+func (m *maintenance) Stats() []TableStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	stats := make([]TableStats, 0, len(tables))
+	for _, t := range tables {
+		s, ok := m.stats[t.name]
+		if !ok {
+			s = TableStats{Table: t.name, Retention: t.policy(m.cfg).Retention()}
+		}
+		stats = append(stats, s)
+	}
+	return stats
+}
+
+func (m *maintenance) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(m.cfg.Interval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.runOnce(ctx)
+		}
+	}
+}
+
+func (m *maintenance) inWindow() bool {
+	w := m.cfg.Window()
+	return w == nil || w.Contains(m.now())
+}
+
+func (m *maintenance) runOnce(ctx context.Context) {
+	if !m.inWindow() {
+		m.lggr.Debugw("Skipping database maintenance outside of the window", "window", m.cfg.Window())
+		return
+	}
+	for _, t := range tables {
+		if ctx.Err() != nil {
+			return
+		}
+		m.runTable(ctx, t)
+	}
+}
+
+func (m *maintenance) runTable(ctx context.Context, t table) {
+	policy := t.policy(m.cfg)
+	start := m.now()
+	s := TableStats{Table: t.name, Retention: policy.Retention(), LastRun: start, Complete: true}
+
+	if s.Retention > 0 {
+		s.RowsDeleted, s.Complete, s.Err = m.prune(ctx, t, start.Add(-s.Retention))
+		promRowsDeleted.WithLabelValues(t.name).Add(float64(s.RowsDeleted))
+		if s.Err != nil {
+			m.lggr.Errorw("Failed to prune table", "table", t.name, "rowsDeleted", s.RowsDeleted, "err", s.Err)
+		} else if s.RowsDeleted > 0 && policy.Vacuum() {
+			// VACUUM cannot be cancelled midway without losing its work, so it is not limited by the window.
+			if _, err := m.db.ExecContext(ctx, "VACUUM ANALYZE "+t.name); err != nil {
+				m.lggr.Warnw("Pruned table, but failed to run VACUUM ANALYZE", "table", t.name, "err", err)
+			}
+		}
+	}
+	s.Duration = m.now().Sub(start)
+
+	if err := m.db.QueryRowxContext(ctx, tableSizeQuery, t.name).Scan(&s.SizeBytes, &s.EstimatedRows); err != nil {
+		m.lggr.Warnw("Failed to get table size", "table", t.name, "err", err)
+	}
+
+	m.mu.Lock()
+	prev, ok := m.stats[t.name]
+	if ok && prev.SizeBytes > 0 && s.SizeBytes > 0 {
+		if elapsed := s.LastRun.Sub(prev.LastRun); elapsed > 0 {
+			s.GrowthPerDay = int64(float64(s.SizeBytes-prev.SizeBytes) * float64(24*time.Hour) / float64(elapsed))
+		}
+	}
+	m.stats[t.name] = s
+	m.mu.Unlock()
+
+	promLastRun.WithLabelValues(t.name).Set(float64(s.LastRun.Unix()))
+	promTableSize.WithLabelValues(t.name).Set(float64(s.SizeBytes))
+	promTableGrowth.WithLabelValues(t.name).Set(float64(s.GrowthPerDay))
+	m.lggr.Debugw("Database maintenance of table completed", "table", t.name, "rowsDeleted", s.RowsDeleted,
+		"complete", s.Complete, "duration", s.Duration, "sizeBytes", s.SizeBytes, "growthPerDay", s.GrowthPerDay)
+}
+
+// prune deletes rows older than cutoff in batches, until none are left or the window closes.
+func (m *maintenance) prune(ctx context.Context, t table, cutoff time.Time) (deleted int64, complete bool, err error) {
+	limit := int64(m.cfg.BatchSize())
+	for {
+		if !m.inWindow() {
+			m.lggr.Infow("Database maintenance window closed, pruning will resume in the next window", "table", t.name)
+			return deleted, false, nil
+		}
+		var n int64
+		if t.archived && m.archiver != nil {
+			if n, err = m.archiver.ArchiveRuns(ctx, cutoff, limit); err != nil {
+				return deleted, false, fmt.Errorf("failed to archive rows older than %s: %w", cutoff, err)
+			}
+		} else if err = m.db.GetContext(ctx, &n, t.prune, cutoff, limit); err != nil {
+			return deleted, false, fmt.Errorf("failed to delete rows older than %s: %w", cutoff, err)
+		}
+		deleted += n
+		if n < limit {
+			return deleted, true, nil
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package dbmaintenance
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/archive"
+)
+
+type testPolicy struct {
+	retention time.Duration
+	vacuum    bool
+}
+
+func (p testPolicy) Retention() time.Duration { return p.retention }
+func (p testPolicy) Vacuum() bool             { return p.vacuum }
+
+type testConfig struct {
+	window    *config.TimeWindow
+	batchSize uint32
+	heads     testPolicy
+}
+
+func (c testConfig) Enabled() bool                          { return true }
+func (c testConfig) Interval() time.Duration                { return time.Hour }
+func (c testConfig) Window() *config.TimeWindow             { return c.window }
+func (c testConfig) BatchSize() uint32                      { return c.batchSize }
+func (c testConfig) PipelineRuns() config.MaintenancePolicy { return testPolicy{} }
+func (c testConfig) Logs() config.MaintenancePolicy         { return testPolicy{} }
+func (c testConfig) Transactions() config.MaintenancePolicy { return testPolicy{} }
+func (c testConfig) Heads() config.MaintenancePolicy        { return c.heads }
+func (c testConfig) Archive() config.Archive                { return nil }
+
+// fakeArchiver archives batches of the given sizes.
+type fakeArchiver struct {
+	batches []int64
+}
+
+func (f *fakeArchiver) ArchiveRuns(ctx context.Context, cutoff time.Time, limit int64) (int64, error) {
+	if len(f.batches) == 0 {
+		return 0, nil
+	}
+	n := f.batches[0]
+	f.batches = f.batches[1:]
+	return n, nil
+}
+
+func (f *fakeArchiver) FindRun(ctx context.Context, id int64) (archive.Run, error) {
+	return archive.Run{}, sql.ErrNoRows
+}
+
+func TestMaintenance_window(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig{window: &config.TimeWindow{Start: 2 * time.Hour, End: 4 * time.Hour}, batchSize: 10, heads: testPolicy{retention: time.Hour}}
+	m := New(nil, cfg, nil, logger.TestLogger(t)).(*maintenance)
+	m.now = func() time.Time { return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+	// The database is not queried outside of the window.
+	m.runOnce(testutils.Context(t))
+	stats := m.Stats()
+	require.Len(t, stats, len(tables))
+	for _, s := range stats {
+		assert.Zero(t, s.LastRun, s.Table)
+	}
+	assert.Equal(t, "evm.heads", stats[3].Table)
+	assert.Equal(t, time.Hour, stats[3].Retention)
+}
+
+func TestMaintenance_archive(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig{batchSize: 10}
+	archiver := &fakeArchiver{batches: []int64{10, 10, 3}}
+	m := New(nil, cfg, archiver, logger.TestLogger(t)).(*maintenance)
+
+	// Pipeline runs are archived instead of deleted, until a batch is not full.
+	require.Equal(t, "pipeline_runs", tables[0].name)
+	deleted, complete, err := m.prune(testutils.Context(t), tables[0], time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(23), deleted)
+	assert.True(t, complete)
+	assert.Empty(t, archiver.batches)
+}
+
+func TestMaintenance_runOnce(t *testing.T) {
+	testutils.SkipShortDB(t)
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	chainID := testutils.NewRandomEVMChainID()
+	now := time.Now()
+	for _, age := range []time.Duration{0, 2 * time.Hour, 3 * time.Hour} {
+		_, err := db.Exec(`INSERT INTO evm.heads (hash, number, parent_hash, created_at, timestamp, evm_chain_id)
+VALUES ($1, $2, $3, $4, $4, $5)`, utils.NewHash(), int64(age/time.Hour), utils.NewHash(), now.Add(-age), chainID.String())
+		require.NoError(t, err)
+	}
+
+	cfg := testConfig{batchSize: 1, heads: testPolicy{retention: time.Hour, vacuum: true}}
+	m := New(db, cfg, nil, logger.TestLogger(t)).(*maintenance)
+	m.runOnce(testutils.Context(t))
+
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT count(*) FROM evm.heads WHERE evm_chain_id = $1`, chainID.String()))
+	assert.Equal(t, 1, count)
+
+	s := m.Stats()[3]
+	assert.Equal(t, "evm.heads", s.Table)
+	require.NoError(t, s.Err)
+	assert.Equal(t, int64(2), s.RowsDeleted)
+	assert.True(t, s.Complete)
+	assert.NotZero(t, s.LastRun)
+	assert.Positive(t, s.SizeBytes)
+	assert.Len(t, m.HealthReport(), 1)
+
+	// Pruning stops when the window closes.
+	_, err := db.Exec(`INSERT INTO evm.heads (hash, number, parent_hash, created_at, timestamp, evm_chain_id)
+VALUES ($1, 10, $2, $3, $3, $4)`, utils.NewHash(), utils.NewHash(), now.Add(-2*time.Hour), chainID.String())
+	require.NoError(t, err)
+	window := &config.TimeWindow{Start: 0, End: time.Hour}
+	m.cfg = testConfig{window: window, batchSize: 1, heads: cfg.heads}
+	calls := 0
+	m.now = func() time.Time {
+		calls++
+		if calls > 1 {
+			return time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+		}
+		return time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	}
+	m.runOnce(testutils.Context(t))
+	s = m.Stats()[3]
+	assert.Zero(t, s.RowsDeleted)
+	assert.False(t, s.Complete)
+}
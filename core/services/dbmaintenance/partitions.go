@@ -0,0 +1,262 @@
+package dbmaintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// partition is a partition of a partitioned table, covering the range of keys [from, to).
+type partition struct {
+	parent string
+	name   string // qualified like the text of its regclass
+	from   string
+	to     string
+	// primaryKey is created on each partition, since the primary keys do not include the partition keys.
+	primaryKey string
+	// defaultPartition is the default partition of parent, and exclusion a check which excludes the range of the
+	// partition from it.
+	defaultPartition string
+	exclusion        string
+}
+
+// exclusionName returns the name of the constraint of the default partition which excludes the range of p.
+func (p partition) exclusionName() string {
+	return p.name[strings.LastIndex(p.name, ".")+1:] + "_excluded"
+}
+
+// exclude adds the exclusion of p to the default partition, without checking its rows, which takes a brief lock.
+func (p partition) exclude(ctx context.Context, db *sqlx.DB) error {
+	_, err := db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s DROP CONSTRAINT IF EXISTS %[2]s, ADD CONSTRAINT %[2]s CHECK (%s) NOT VALID`,
+		p.defaultPartition, p.exclusionName(), p.exclusion))
+	return err
+}
+
+// validateExclusion checks that no row of the default partition falls in the range of p. It scans the default
+// partition, but does not block reads or writes.
+func (p partition) validateExclusion(ctx context.Context, db *sqlx.DB) error {
+	_, err := db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s VALIDATE CONSTRAINT %s`, p.defaultPartition, p.exclusionName()))
+	return err
+}
+
+func (p partition) dropExclusion(ctx context.Context, db *sqlx.DB) error {
+	_, err := db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s`, p.defaultPartition, p.exclusionName()))
+	return err
+}
+
+// create creates p, once its validated exclusion lets Postgres skip the scan of the default partition, which would
+// otherwise lock the whole table while it checks that none of its rows belong to p. The exclusion is dropped again,
+// since the rows which fall in no partition must still go to the default partition.
+func (p partition) create(tx *sqlx.Tx) error {
+	if _, err := tx.Exec(fmt.Sprintf(`CREATE TABLE %s PARTITION OF %s FOR VALUES FROM (%s) TO (%s)`, p.name, p.parent, p.from, p.to)); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(fmt.Sprintf(`ALTER TABLE %s ADD PRIMARY KEY (%s)`, p.name, p.primaryKey)); err != nil {
+		return err
+	}
+	_, err := tx.Exec(fmt.Sprintf(`ALTER TABLE %s DROP CONSTRAINT %s`, p.defaultPartition, p.exclusionName()))
+	return err
+}
+
+// logsPartitions returns the next premake partitions of evm.logs for a chain, after the one which contains
+// latestBlock. The partition of latestBlock is never created, since the default partition may have rows in its range.
+func logsPartitions(chainID *ubig.Big, latestBlock int64, blockRange uint64, premake uint32) []partition {
+	size := int64(blockRange)
+	next := (latestBlock/size + 1) * size
+	parts := make([]partition, 0, premake)
+	for i := int64(0); i < int64(premake); i++ {
+		start := next + i*size
+		parts = append(parts, partition{
+			parent:     "evm.logs",
+			name:       fmt.Sprintf("evm.logs_%s_%d", chainID, start),
+			from:       fmt.Sprintf("%s, %d", chainID, start),
+			to:         fmt.Sprintf("%s, %d", chainID, start+size),
+			primaryKey: "block_hash, log_index, evm_chain_id",
+
+			defaultPartition: "evm.logs_default",
+			exclusion:        fmt.Sprintf("evm_chain_id <> %s OR block_number < %d OR block_number >= %d", chainID, start, start+size),
+		})
+	}
+	return parts
+}
+
+// pipelineRunsPartitions returns the next premake partitions of pipeline_runs, after the one which contains now.
+func pipelineRunsPartitions(now time.Time, interval time.Duration, premake uint32) []partition {
+	current := now.UTC().Truncate(interval)
+	parts := make([]partition, 0, premake)
+	for i := 1; i <= int(premake); i++ {
+		start := current.Add(time.Duration(i) * interval)
+		parts = append(parts, partition{
+			parent:     "pipeline_runs",
+			name:       "pipeline_runs_p" + start.Format("20060102_1504"),
+			from:       "'" + start.Format(time.RFC3339) + "'",
+			to:         "'" + start.Add(interval).Format(time.RFC3339) + "'",
+			primaryKey: "id",
+
+			defaultPartition: "pipeline_runs_default",
+			exclusion:        fmt.Sprintf("created_at < '%s' OR created_at >= '%s'", start.Format(time.RFC3339), start.Add(interval).Format(time.RFC3339)),
+		})
+	}
+	return parts
+}
+
+type partitionManager struct {
+	services.StateMachine
+	db   *sqlx.DB
+	cfg  config.Partitions
+	lggr logger.SugaredLogger
+	now  func() time.Time
+
+	mu  sync.RWMutex
+	err error
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewPartitionManager returns a service which creates the partitions of evm.logs and pipeline_runs ahead of time,
+// every cfg.CheckInterval().
+func NewPartitionManager(db *sqlx.DB, cfg config.Partitions, lggr logger.Logger) services.Service {
+	return &partitionManager{
+		db:     db,
+		cfg:    cfg,
+		lggr:   logger.Sugared(lggr.Named("PartitionManager")),
+		now:    time.Now,
+		chStop: make(services.StopChan),
+	}
+}
+
+func (p *partitionManager) Start(context.Context) error {
+	return p.StartOnce("PartitionManager", func() error {
+		p.wg.Add(1)
+		go p.run()
+		return nil
+	})
+}
+
+func (p *partitionManager) Close() error {
+	return p.StopOnce("PartitionManager", func() error {
+		close(p.chStop)
+		p.wg.Wait()
+		return nil
+	})
+}
+
+func (p *partitionManager) Name() string {
+	return p.lggr.Name()
+}
+
+func (p *partitionManager) HealthReport() map[string]error {
+	report := map[string]error{p.Name(): p.Healthy()}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.err != nil {
+		report[p.Name()+".Partitions"] = p.err
+	}
+	return report
+}
+
+func (p *partitionManager) run() {
+	defer p.wg.Done()
+	ctx, cancel := p.chStop.NewCtx()
+	defer cancel()
+
+	p.runOnce(ctx)
+	ticker := time.NewTicker(p.cfg.CheckInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.runOnce(ctx)
+		}
+	}
+}
+
+func (p *partitionManager) runOnce(ctx context.Context) {
+	parts, err := p.missingPartitions(ctx)
+	p.mu.Lock()
+	p.err = err
+	p.mu.Unlock()
+	if err != nil {
+		p.lggr.Errorw("Failed to list missing partitions", "err", err)
+		return
+	}
+	for _, part := range parts {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := p.create(ctx, part); err != nil {
+			// Typically because rows of the default partition fall in its range, e.g. after a replay of old logs.
+			// It is retried at the next check.
+			p.lggr.Warnw("Failed to create partition", "table", part.parent, "partition", part.name, "err", err)
+			continue
+		}
+		p.lggr.Infow("Created partition", "table", part.parent, "partition", part.name, "from", part.from, "to", part.to)
+	}
+}
+
+// create creates part in three transactions, so that the default partition is only scanned by the validation of the
+// exclusion of part, which does not block the writes of rows outside of its range.
+func (p *partitionManager) create(ctx context.Context, part partition) error {
+	err := part.exclude(ctx, p.db)
+	if err == nil {
+		err = part.validateExclusion(ctx, p.db)
+	}
+	if err == nil {
+		err = pg.SqlTransaction(ctx, p.db.DB, p.lggr, part.create)
+	}
+	if err != nil {
+		// The exclusion rejects the rows in the range of part until it exists, so it is dropped even after stopping.
+		if dropErr := part.dropExclusion(context.WithoutCancel(ctx), p.db); dropErr != nil {
+			p.lggr.Warnw("Failed to drop exclusion of partition from default partition", "partition", part.name, "err", dropErr)
+		}
+	}
+	return err
+}
+
+// missingPartitions returns the partitions which should exist, but do not yet.
+func (p *partitionManager) missingPartitions(ctx context.Context) ([]partition, error) {
+	var latest []struct {
+		EVMChainID  *ubig.Big `db:"evm_chain_id"`
+		BlockNumber int64     `db:"block_number"`
+	}
+	if err := p.db.SelectContext(ctx, &latest, `SELECT evm_chain_id, max(block_number) AS block_number
+FROM evm.log_poller_blocks GROUP BY evm_chain_id`); err != nil {
+		return nil, fmt.Errorf("failed to get latest log poller blocks: %w", err)
+	}
+	var parts []partition
+	for _, l := range latest {
+		parts = append(parts, logsPartitions(l.EVMChainID, l.BlockNumber, p.cfg.LogsBlockRange(), p.cfg.Premake())...)
+	}
+	parts = append(parts, pipelineRunsPartitions(p.now(), p.cfg.PipelineRunsInterval(), p.cfg.Premake())...)
+
+	var existing []string
+	if err := p.db.SelectContext(ctx, &existing, `SELECT inhrelid::regclass::text FROM pg_inherits
+WHERE inhparent IN ('evm.logs'::regclass, 'pipeline_runs'::regclass)`); err != nil {
+		return nil, fmt.Errorf("failed to list partitions: %w", err)
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		exists[name] = true
+	}
+	missing := parts[:0]
+	for _, part := range parts {
+		if !exists[part.name] {
+			missing = append(missing, part)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,82 @@
+package dbmaintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type testPartitionsConfig struct{}
+
+func (testPartitionsConfig) CheckInterval() time.Duration        { return time.Hour }
+func (testPartitionsConfig) Premake() uint32                     { return 2 }
+func (testPartitionsConfig) LogsBlockRange() uint64              { return 1000 }
+func (testPartitionsConfig) PipelineRunsInterval() time.Duration { return 24 * time.Hour }
+
+func Test_logsPartitions(t *testing.T) {
+	t.Parallel()
+
+	parts := logsPartitions(ubig.NewI(137), 2500, 1000, 2)
+	require.Len(t, parts, 2)
+	assert.Equal(t, partition{parent: "evm.logs", name: "evm.logs_137_3000", from: "137, 3000", to: "137, 4000",
+		primaryKey: "block_hash, log_index, evm_chain_id", defaultPartition: "evm.logs_default",
+		exclusion: "evm_chain_id <> 137 OR block_number < 3000 OR block_number >= 4000"}, parts[0])
+	assert.Equal(t, "logs_137_3000_excluded", parts[0].exclusionName())
+	assert.Equal(t, "evm.logs_137_4000", parts[1].name)
+	assert.Equal(t, "137, 5000", parts[1].to)
+
+	// The partition of the latest block is never created, even at its first block.
+	parts = logsPartitions(ubig.NewI(1), 3000, 1000, 1)
+	assert.Equal(t, "1, 4000", parts[0].from)
+}
+
+func Test_pipelineRunsPartitions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 5, 17, 30, 0, 0, time.FixedZone("", 2*60*60))
+	parts := pipelineRunsPartitions(now, 24*time.Hour, 2)
+	require.Len(t, parts, 2)
+	assert.Equal(t, partition{parent: "pipeline_runs", name: "pipeline_runs_p20240306_0000", from: "'2024-03-06T00:00:00Z'",
+		to: "'2024-03-07T00:00:00Z'", primaryKey: "id", defaultPartition: "pipeline_runs_default",
+		exclusion: "created_at < '2024-03-06T00:00:00Z' OR created_at >= '2024-03-07T00:00:00Z'"}, parts[0])
+	assert.Equal(t, "pipeline_runs_p20240306_0000_excluded", parts[0].exclusionName())
+	assert.Equal(t, "pipeline_runs_p20240307_0000", parts[1].name)
+}
+
+func TestPartitionManager_runOnce(t *testing.T) {
+	testutils.SkipShortDB(t)
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	chainID := testutils.NewRandomEVMChainID()
+	_, err := db.Exec(`INSERT INTO evm.log_poller_blocks (evm_chain_id, block_hash, block_number, block_timestamp, finalized_block_number, created_at)
+VALUES ($1, $2, 1500, NOW(), 1500, NOW())`, ubig.New(chainID), utils.NewHash())
+	require.NoError(t, err)
+
+	p := NewPartitionManager(db, testPartitionsConfig{}, logger.TestLogger(t)).(*partitionManager)
+	p.runOnce(testutils.Context(t))
+	require.NoError(t, p.err)
+
+	missing, err := p.missingPartitions(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	var partitions []string
+	require.NoError(t, db.Select(&partitions, `SELECT inhrelid::regclass::text FROM pg_inherits WHERE inhparent = 'evm.logs'::regclass ORDER BY 1`))
+	assert.Contains(t, partitions, "evm.logs_"+chainID.String()+"_2000")
+	assert.Contains(t, partitions, "evm.logs_"+chainID.String()+"_3000")
+	assert.Contains(t, partitions, "evm.logs_default")
+
+	var exclusions int
+	require.NoError(t, db.Get(&exclusions, `SELECT count(*) FROM pg_constraint WHERE conname LIKE '%\_excluded'
+AND conrelid IN ('evm.logs_default'::regclass, 'pipeline_runs_default'::regclass)`))
+	assert.Zero(t, exclusions)
+}
@@ -8,11 +8,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -371,10 +374,14 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 		},
 	})
 	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
+	ctx = logctx.WithChainID(logctx.WithJobID(ctx, l.job.ID), evmChainID.String())
+	ctx, span := tracing.Start(ctx, "job.trigger", tracing.Trigger.String("log"),
+		attribute.String("logTxHash", request.Raw.TxHash.Hex()), attribute.Int64("logBlockNumber", int64(request.Raw.BlockNumber)))
 	_, err := l.pipelineRunner.Run(ctx, run, l.logger, true, func(tx pg.Queryer) error {
 		l.markLogConsumed(lb, pg.WithQueryer(tx))
 		return nil
 	})
+	tracing.End(span, err)
 	if ctx.Err() != nil {
 		return
 	} else if err != nil {
@@ -0,0 +1,221 @@
+package feeaccounting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// ingestInterval is how often the fees of new receipts are ingested. It is well below the retention of the
+	// transactions, so that their fees are in the ledger before they are reaped.
+	ingestInterval = time.Minute
+	ingestBatch    = 1000
+)
+
+// GroupBy is how the fees of a report are aggregated. Fees are always aggregated per chain.
+type GroupBy string
+
+const (
+	GroupByChain GroupBy = "chain"
+	GroupByKey   GroupBy = "key"
+	GroupByJob   GroupBy = "job"
+)
+
+// ReportQuery selects the fees of a report.
+type ReportQuery struct {
+	// From and To are the range of the confirmation times of the transactions, To being exclusive.
+	From, To time.Time
+	GroupBy  GroupBy
+	// ChainID restricts the report to a chain, if set.
+	ChainID *big.Int
+}
+
+func (q ReportQuery) Validate() error {
+	switch q.GroupBy {
+	case GroupByChain, GroupByKey, GroupByJob:
+	default:
+		return fmt.Errorf("invalid group by: %q", q.GroupBy)
+	}
+	if !q.From.Before(q.To) {
+		return errors.New("from must be before to")
+	}
+	return nil
+}
+
+// ReportRow is the aggregated fees of a chain, and of a key or a job of it depending on the GroupBy of the report.
+type ReportRow struct {
+	ChainID ubig.Big `db:"evm_chain_id"`
+	// Address is the key, if grouped by key.
+	Address *common.Address
+	// JobID is the job, if grouped by job. It is nil for the transactions which were not sent by a job. JobName is
+	// nil if the job was deleted.
+	JobID   *int32
+	JobName *string
+	TxCount int64
+	GasUsed int64
+	// Fee is the total fee paid, in wei.
+	Fee assets.Wei
+}
+
+// Accounting keeps a ledger of the fees paid by the EVM transactions of the node, from their receipts, and reports
+// them per chain, key or job.
+type Accounting interface {
+	services.Service
+	Report(ctx context.Context, q ReportQuery) ([]ReportRow, error)
+}
+
+type accounting struct {
+	services.StateMachine
+	orm  ORM
+	lggr logger.SugaredLogger
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+// New returns an Accounting which ingests the fees of new receipts every minute.
+func New(orm ORM, lggr logger.Logger) Accounting {
+	return &accounting{
+		orm:    orm,
+		lggr:   logger.Sugared(lggr.Named("TxFeeAccounting")),
+		chStop: make(services.StopChan),
+	}
+}
+
+func (a *accounting) Start(context.Context) error {
+	return a.StartOnce("TxFeeAccounting", func() error {
+		a.wg.Add(1)
+		go a.run()
+		return nil
+	})
+}
+
+func (a *accounting) Close() error {
+	return a.StopOnce("TxFeeAccounting", func() error {
+		close(a.chStop)
+		a.wg.Wait()
+		return nil
+	})
+}
+
+func (a *accounting) Name() string {
+	return a.lggr.Name()
+}
+
+func (a *accounting) HealthReport() map[string]error {
+	return map[string]error{a.Name(): a.Healthy()}
+}
+
+func (a *accounting) Report(ctx context.Context, q ReportQuery) ([]ReportRow, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	return a.orm.Report(ctx, q)
+}
+
+func (a *accounting) run() {
+	defer a.wg.Done()
+	ctx, cancel := a.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(ingestInterval)
+	defer ticker.Stop()
+	for {
+		if err := a.ingest(ctx); err != nil && ctx.Err() == nil {
+			a.lggr.Errorw("Failed to ingest transaction fees", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ingest adds the fees of the receipts after the last ingested one to the ledger.
+func (a *accounting) ingest(ctx context.Context) error {
+	lastID, err := a.orm.LastReceiptID(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		receipts, err := a.orm.ReceiptsAfter(ctx, lastID, ingestBatch)
+		if err != nil {
+			return err
+		}
+		if len(receipts) == 0 {
+			return nil
+		}
+		if err = a.orm.InsertFees(ctx, feesOf(receipts)); err != nil {
+			return err
+		}
+		lastID = receipts[len(receipts)-1].ReceiptID
+		if len(receipts) < ingestBatch {
+			return nil
+		}
+	}
+}
+
+// feesOf returns the fees of receipts. A transaction with several receipts, e.g. after a re-org, is only counted once,
+// with its last receipt.
+func feesOf(receipts []TxReceipt) []TxFee {
+	type key struct {
+		chainID string
+		txHash  common.Hash
+	}
+	idx := make(map[key]int)
+	var fees []TxFee
+	for _, r := range receipts {
+		gasPrice := gasPriceOf(r)
+		fee := TxFee{
+			ChainID:     r.ChainID,
+			ReceiptID:   r.ReceiptID,
+			TxHash:      r.Receipt.TxHash,
+			FromAddress: r.FromAddress,
+			JobID:       r.JobID,
+			GasUsed:     r.Receipt.GasUsed,
+			GasPrice:    *assets.NewWei(gasPrice),
+			Fee:         *assets.NewWei(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(r.Receipt.GasUsed))),
+			ConfirmedAt: r.CreatedAt,
+		}
+		if r.Receipt.BlockNumber != nil {
+			fee.BlockNumber = r.Receipt.BlockNumber.Int64()
+		}
+		k := key{r.ChainID.String(), r.Receipt.TxHash}
+		if i, ok := idx[k]; ok {
+			fees[i] = fee
+			continue
+		}
+		idx[k] = len(fees)
+		fees = append(fees, fee)
+	}
+	return fees
+}
+
+// gasPriceOf returns the price paid per gas by the transaction of r. It is the effective gas price of the receipt, if
+// the RPC returned it, or else the gas price of a legacy attempt. For a dynamic fee attempt without it, the fee cap is
+// an upper bound of the price paid.
+func gasPriceOf(r TxReceipt) *big.Int {
+	switch {
+	case r.Receipt.EffectiveGasPrice != nil:
+		return r.Receipt.EffectiveGasPrice
+	case r.GasPrice != nil:
+		return r.GasPrice.ToInt()
+	case r.GasFeeCap != nil:
+		return r.GasFeeCap.ToInt()
+	default:
+		return new(big.Int)
+	}
+}
@@ -0,0 +1,125 @@
+package feeaccounting_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting/mocks"
+)
+
+func testReceipt(id int64, txHash common.Hash, gasUsed uint64, effectiveGasPrice *big.Int) feeaccounting.TxReceipt {
+	return feeaccounting.TxReceipt{
+		ReceiptID: id,
+		Receipt: evmtypes.Receipt{
+			TxHash:            txHash,
+			BlockNumber:       big.NewInt(100 + id),
+			GasUsed:           gasUsed,
+			EffectiveGasPrice: effectiveGasPrice,
+		},
+		ChainID:     *ubig.NewI(1),
+		FromAddress: common.HexToAddress("0x1"),
+		CreatedAt:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestAccounting_Ingest(t *testing.T) {
+	t.Parallel()
+
+	jobID := int32(7)
+	effective := testReceipt(1, common.HexToHash("0x1"), 21_000, big.NewInt(10))
+	effective.JobID = &jobID
+	effective.GasPrice = assets.NewWeiI(20)
+	legacy := testReceipt(2, common.HexToHash("0x2"), 50_000, nil)
+	legacy.GasPrice = assets.NewWeiI(30)
+	dynamic := testReceipt(3, common.HexToHash("0x3"), 100, nil)
+	dynamic.GasFeeCap = assets.NewWeiI(40)
+	reorged := testReceipt(4, common.HexToHash("0x1"), 21_000, big.NewInt(11))
+	reorged.JobID = &jobID
+
+	orm := mocks.NewORM(t)
+	orm.On("LastReceiptID", mock.Anything).Return(int64(0), nil).Once()
+	orm.On("ReceiptsAfter", mock.Anything, int64(0), 1000).Return([]feeaccounting.TxReceipt{effective, legacy, dynamic, reorged}, nil).Once()
+	inserted := make(chan []feeaccounting.TxFee, 1)
+	orm.On("InsertFees", mock.Anything, mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
+		inserted <- args.Get(1).([]feeaccounting.TxFee)
+	})
+
+	a := feeaccounting.New(orm, logger.TestLogger(t))
+	servicetest.Run(t, a)
+
+	var fees []feeaccounting.TxFee
+	select {
+	case fees = <-inserted:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for fees")
+	}
+	require.Len(t, fees, 3)
+
+	// the receipt after the re-org replaces the first one
+	assert.Equal(t, int64(4), fees[0].ReceiptID)
+	assert.Equal(t, int64(104), fees[0].BlockNumber)
+	assert.Equal(t, &jobID, fees[0].JobID)
+	assert.Equal(t, "11", fees[0].GasPrice.ToInt().String())
+	assert.Equal(t, "231000", fees[0].Fee.ToInt().String())
+
+	assert.Equal(t, "30", fees[1].GasPrice.ToInt().String())
+	assert.Equal(t, "1500000", fees[1].Fee.ToInt().String())
+	assert.Nil(t, fees[1].JobID)
+
+	assert.Equal(t, "40", fees[2].GasPrice.ToInt().String())
+	assert.Equal(t, "4000", fees[2].Fee.ToInt().String())
+}
+
+func TestAccounting_Report(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	a := feeaccounting.New(orm, logger.TestLogger(t))
+	ctx := testutils.Context(t)
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	_, err := a.Report(ctx, feeaccounting.ReportQuery{From: from, To: to, GroupBy: "feed"})
+	require.ErrorContains(t, err, `invalid group by: "feed"`)
+	_, err = a.Report(ctx, feeaccounting.ReportQuery{From: to, To: from, GroupBy: feeaccounting.GroupByChain})
+	require.ErrorContains(t, err, "from must be before to")
+
+	q := feeaccounting.ReportQuery{From: from, To: to, GroupBy: feeaccounting.GroupByJob, ChainID: big.NewInt(1)}
+	rows := []feeaccounting.ReportRow{{ChainID: *ubig.NewI(1), TxCount: 1}}
+	orm.On("Report", mock.Anything, q).Return(rows, nil).Once()
+	got, err := a.Report(ctx, q)
+	require.NoError(t, err)
+	assert.Equal(t, rows, got)
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x2")
+	jobID := int32(3)
+	jobName := "eth/usd, mainnet"
+	var buf bytes.Buffer
+	require.NoError(t, feeaccounting.WriteCSV(&buf, []feeaccounting.ReportRow{
+		{ChainID: *ubig.NewI(1), Address: &address, TxCount: 2, GasUsed: 42_000, Fee: *assets.NewWeiI(420_000)},
+		{ChainID: *ubig.NewI(10), JobID: &jobID, JobName: &jobName, TxCount: 1, GasUsed: 21_000, Fee: *assets.NewWeiI(21_000)},
+	}))
+	assert.Equal(t, `chain_id,address,job_id,job_name,tx_count,gas_used,fee_wei
+1,0x0000000000000000000000000000000000000002,,,2,42000,420000
+10,,3,"eth/usd, mainnet",1,21000,21000
+`, buf.String())
+}
@@ -0,0 +1,43 @@
+package feeaccounting
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+var csvHeader = []string{"chain_id", "address", "job_id", "job_name", "tx_count", "gas_used", "fee_wei"}
+
+// WriteCSV writes the rows of a report as CSV, with a header. The columns which the report is not grouped by are
+// empty.
+func WriteCSV(w io.Writer, rows []ReportRow) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		var address, jobID, jobName string
+		if r.Address != nil {
+			address = r.Address.Hex()
+		}
+		if r.JobID != nil {
+			jobID = strconv.FormatInt(int64(*r.JobID), 10)
+		}
+		if r.JobName != nil {
+			jobName = *r.JobName
+		}
+		if err := cw.Write([]string{
+			r.ChainID.String(),
+			address,
+			jobID,
+			jobName,
+			strconv.FormatInt(r.TxCount, 10),
+			strconv.FormatInt(r.GasUsed, 10),
+			r.Fee.ToInt().String(),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,147 @@
+package feeaccounting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+
+// ORM reads the receipts of the transactions of the node and stores their fees in the ledger.
+type ORM interface {
+	// LastReceiptID returns the ID of the last receipt whose fee is in the ledger, or 0 if there is none.
+	LastReceiptID(ctx context.Context) (int64, error)
+	// ReceiptsAfter returns at most limit receipts of the transactions of the node after the receipt with afterID,
+	// ordered by ID.
+	ReceiptsAfter(ctx context.Context, afterID int64, limit int) ([]TxReceipt, error)
+	// InsertFees inserts fees into the ledger, replacing the fees of the same transactions, e.g. after a re-org.
+	InsertFees(ctx context.Context, fees []TxFee) error
+	// Report aggregates the fees of the ledger over q.
+	Report(ctx context.Context, q ReportQuery) ([]ReportRow, error)
+}
+
+// TxReceipt is the receipt of a transaction of the node, along with the attempt and the transaction it belongs to.
+type TxReceipt struct {
+	ReceiptID   int64
+	Receipt     evmtypes.Receipt
+	ChainID     ubig.Big `db:"evm_chain_id"`
+	FromAddress common.Address
+	// GasPrice is set for legacy attempts, and GasFeeCap for dynamic fee attempts.
+	GasPrice  *assets.Wei
+	GasFeeCap *assets.Wei
+	// JobID is the job the transaction was sent by, if any.
+	JobID     *int32
+	CreatedAt time.Time
+}
+
+// TxFee is the fee paid by a transaction of the node.
+type TxFee struct {
+	ChainID     ubig.Big `db:"evm_chain_id"`
+	ReceiptID   int64
+	TxHash      common.Hash
+	FromAddress common.Address
+	JobID       *int32
+	BlockNumber int64
+	GasUsed     uint64
+	GasPrice    assets.Wei
+	Fee         assets.Wei
+	ConfirmedAt time.Time
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{q: pg.NewQ(db, lggr, cfg)}
+}
+
+func (o *orm) LastReceiptID(ctx context.Context) (id int64, err error) {
+	err = o.q.WithOpts(pg.WithParentCtx(ctx)).Get(&id, `SELECT COALESCE(MAX(receipt_id), 0) FROM evm.tx_fees`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last receipt ID: %w", err)
+	}
+	return id, nil
+}
+
+// The job of a transaction is the JobID of its meta, or else the job of the pipeline run of its task run.
+func (o *orm) ReceiptsAfter(ctx context.Context, afterID int64, limit int) (receipts []TxReceipt, err error) {
+	err = o.q.WithOpts(pg.WithParentCtx(ctx)).Select(&receipts, `SELECT r.id AS receipt_id, r.receipt, r.created_at,
+	t.evm_chain_id, t.from_address, a.gas_price, a.gas_fee_cap,
+	COALESCE((t.meta->>'JobID')::int, j.id) AS job_id
+FROM evm.receipts r
+JOIN evm.tx_attempts a ON a.hash = r.tx_hash
+JOIN evm.txes t ON t.id = a.eth_tx_id
+LEFT JOIN pipeline_task_runs ptr ON ptr.id = t.pipeline_task_run_id
+LEFT JOIN pipeline_runs pr ON pr.id = ptr.pipeline_run_id
+LEFT JOIN jobs j ON j.pipeline_spec_id = pr.pipeline_spec_id
+WHERE r.id > $1
+ORDER BY r.id
+LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipts: %w", err)
+	}
+	return receipts, nil
+}
+
+func (o *orm) InsertFees(ctx context.Context, fees []TxFee) error {
+	if len(fees) == 0 {
+		return nil
+	}
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).ExecQNamed(`INSERT INTO evm.tx_fees (evm_chain_id, receipt_id, tx_hash, from_address, job_id, block_number, gas_used, gas_price, fee, confirmed_at)
+VALUES (:evm_chain_id, :receipt_id, :tx_hash, :from_address, :job_id, :block_number, :gas_used, :gas_price, :fee, :confirmed_at)
+ON CONFLICT (evm_chain_id, tx_hash) DO UPDATE SET
+	receipt_id = EXCLUDED.receipt_id,
+	block_number = EXCLUDED.block_number,
+	gas_used = EXCLUDED.gas_used,
+	gas_price = EXCLUDED.gas_price,
+	fee = EXCLUDED.fee,
+	confirmed_at = EXCLUDED.confirmed_at`, fees)
+	if err != nil {
+		return fmt.Errorf("failed to insert fees: %w", err)
+	}
+	return nil
+}
+
+func (o *orm) Report(ctx context.Context, q ReportQuery) (rows []ReportRow, err error) {
+	var cols, groups string
+	switch q.GroupBy {
+	case GroupByChain:
+		cols = "NULL::bytea AS address, NULL::int AS job_id, NULL::text AS job_name"
+	case GroupByKey:
+		cols = "f.from_address AS address, NULL::int AS job_id, NULL::text AS job_name"
+		groups = ", f.from_address"
+	case GroupByJob:
+		cols = "NULL::bytea AS address, f.job_id, MAX(j.name) AS job_name"
+		groups = ", f.job_id"
+	default:
+		return nil, fmt.Errorf("invalid group by: %q", q.GroupBy)
+	}
+	var chainID *ubig.Big
+	if q.ChainID != nil {
+		chainID = ubig.New(q.ChainID)
+	}
+	err = o.q.WithOpts(pg.WithParentCtx(ctx)).Select(&rows, `SELECT f.evm_chain_id, `+cols+`,
+	COUNT(*) AS tx_count, SUM(f.gas_used)::bigint AS gas_used, SUM(f.fee) AS fee
+FROM evm.tx_fees f
+LEFT JOIN jobs j ON j.id = f.job_id
+WHERE f.confirmed_at >= $1 AND f.confirmed_at < $2 AND ($3::numeric IS NULL OR f.evm_chain_id = $3)
+GROUP BY f.evm_chain_id`+groups+`
+ORDER BY f.evm_chain_id`+groups, q.From, q.To, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to report fees: %w", err)
+	}
+	return rows, nil
+}
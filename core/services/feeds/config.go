@@ -4,10 +4,13 @@ import (
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 )
 
 type JobConfig interface {
 	DefaultHTTPTimeout() commonconfig.Duration
+	PolicyRules() []config.JobPolicyRule
 }
 
 type InsecureConfig interface {
@@ -25,6 +25,7 @@ import (
 	pb "github.com/smartcontractkit/chainlink/v2/core/services/feeds/proto"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/policy"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
@@ -714,6 +715,18 @@ func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
 		return errors.Wrap(err, "failed to approve job spec due to bridge check")
 	}
 
+	violations, err := job.CheckPolicy(s.jobCfg.PolicyRules(), *j)
+	for _, v := range violations {
+		if v.Severity == policy.SeverityWarning {
+			logger.Warnw("Job spec violates policy", "rule", v.Rule, "violation", v.Message)
+		}
+	}
+	if err != nil {
+		logger.Errorw("Failed to approve job spec due to policy check", "err", err.Error())
+
+		return errors.Wrap(err, "failed to approve job spec due to policy check")
+	}
+
 	q := s.q.WithOpts(pctx)
 	err = q.Transaction(func(tx pg.Queryer) error {
 		var (
@@ -122,6 +122,8 @@ type FunctionsListener interface {
 	job.ServiceCtx
 
 	HandleOffchainRequest(ctx context.Context, request *OffchainRequest) error
+	// ReplayRequest runs a past request again, without saving or transmitting its result.
+	ReplayRequest(ctx context.Context, replay *ReplayRequest) (*ReplayResult, error)
 }
 
 type functionsListener struct {
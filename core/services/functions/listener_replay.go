@@ -0,0 +1,100 @@
+package functions
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// ReplayRequest is a past request to run again through the computation path of the node.
+type ReplayRequest struct {
+	// RequestID is the ID of the past request, if known. Its flags are then loaded from the
+	// database and its original outcome is returned along with the replay result.
+	RequestID         *RequestID
+	SubscriptionOwner common.Address
+	SubscriptionId    uint64
+	Data              RequestData
+	// NodeProvidedSecrets replaces the secrets referenced by Data, when set. Threshold
+	// decryption of the referenced secrets requires the participation of the DON, which
+	// only decrypts the secrets of pending requests.
+	NodeProvidedSecrets *string
+}
+
+// ReplayResult is the outcome of a replayed request. Nothing is persisted or transmitted.
+type ReplayResult struct {
+	Result  []byte
+	Error   []byte
+	Domains []string
+
+	SecretsSize         int
+	SecretsDuration     time.Duration
+	ComputationDuration time.Duration
+
+	// Original is the stored past request, if its ID was given and it was found.
+	Original *Request
+}
+
+// ReplayRequest runs a past request through the secrets and computation path of the listener,
+// without saving its result. Only internal errors are returned as errors; user errors are set in
+// the Error of the result, as they would be reported onchain.
+func (l *functionsListener) ReplayRequest(ctx context.Context, replay *ReplayRequest) (*ReplayResult, error) {
+	result := &ReplayResult{}
+	var flags RequestFlags
+	if replay.RequestID != nil {
+		original, err := l.pluginORM.FindById(*replay.RequestID, pg.WithParentCtx(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find request %s: %w", formatRequestId(*replay.RequestID), err)
+		}
+		copy(flags[:], original.Flags)
+		result.Original = original
+	}
+
+	// a fresh ID avoids colliding with pending requests in the decryption queue and the EA
+	var requestID RequestID
+	if _, err := rand.Read(requestID[:]); err != nil {
+		return nil, err
+	}
+	requestIDStr := formatRequestId(requestID)
+	l.logger.Infow("replaying request", "replayID", requestIDStr, "requestID", replay.RequestID)
+
+	eaClient, err := l.bridgeAccessor.NewExternalAdapterClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ExternalAdapterClient: %w", err)
+	}
+
+	requestData := replay.Data
+	var nodeProvidedSecrets string
+	if replay.NodeProvidedSecrets != nil {
+		nodeProvidedSecrets = *replay.NodeProvidedSecrets
+	} else {
+		start := time.Now()
+		secrets, userErr, internalErr := l.getSecrets(ctx, eaClient, requestID, replay.SubscriptionOwner, &requestData)
+		result.SecretsDuration = time.Since(start)
+		if internalErr != nil {
+			return nil, fmt.Errorf("internal error during getSecrets: %w", internalErr)
+		}
+		if userErr != nil {
+			result.Error = []byte(userErr.Error())
+			return result, nil
+		}
+		nodeProvidedSecrets = secrets
+	}
+	result.SecretsSize = len(nodeProvidedSecrets)
+	if uint32(result.SecretsSize) > l.getMaxSecretsSize(flags) {
+		result.Error = []byte("secrets size too big")
+		return result, nil
+	}
+
+	start := time.Now()
+	result.Result, result.Error, result.Domains, err = eaClient.RunComputation(ctx, requestIDStr, l.job.Name.ValueOrZero(), replay.SubscriptionOwner.Hex(), replay.SubscriptionId, flags, nodeProvidedSecrets, &requestData)
+	result.ComputationDuration = time.Since(start)
+	if err != nil {
+		return nil, fmt.Errorf("internal adapter error: %w", err)
+	}
+	return result, nil
+}
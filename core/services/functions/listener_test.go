@@ -432,3 +432,58 @@ func TestFunctionsListener_ORMDoesNotFreezeHandlersForever(t *testing.T) {
 	servicetest.Run(t, uni.service)
 	ormCallExited.Wait() // should not freeze
 }
+
+func TestFunctionsListener_ReplayRequest(t *testing.T) {
+	testutils.SkipShortDB(t)
+	t.Parallel()
+
+	uni := NewFunctionsListenerUniverse(t, 0, 1_000_000)
+	flags := packFlags(0, 0) // tier no 0 of secrets size, allows up to 10 bytes
+	original := &functions_service.Request{RequestID: RequestID, State: functions_service.RESULT_READY, Flags: flags[:], Error: ErrorBytes}
+	uni.pluginORM.On("FindById", RequestID, mock.Anything).Return(original, nil)
+	uni.bridgeAccessor.On("NewExternalAdapterClient").Return(uni.eaClient, nil)
+
+	t.Run("runs the computation without saving the result", func(t *testing.T) {
+		secrets := "secrets"
+		uni.eaClient.On("RunComputation", mock.Anything, mock.Anything, mock.Anything, SubscriptionOwner.Hex(), SubscriptionID, functions_service.RequestFlags(flags), secrets, mock.Anything).Return(ResultBytes, nil, []string{"example.com"}, nil).Once()
+
+		requestID := RequestID
+		result, err := uni.service.ReplayRequest(testutils.Context(t), &functions_service.ReplayRequest{
+			RequestID:           &requestID,
+			SubscriptionOwner:   SubscriptionOwner,
+			SubscriptionId:      SubscriptionID,
+			Data:                functions_service.RequestData{Source: "return 1"},
+			NodeProvidedSecrets: &secrets,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ResultBytes, result.Result)
+		assert.Empty(t, result.Error)
+		assert.Equal(t, []string{"example.com"}, result.Domains)
+		assert.Equal(t, len(secrets), result.SecretsSize)
+		assert.Equal(t, original, result.Original)
+		uni.pluginORM.AssertNotCalled(t, "SetResult", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns user errors in the result", func(t *testing.T) {
+		secrets := "too many secrets"
+		requestID := RequestID
+		result, err := uni.service.ReplayRequest(testutils.Context(t), &functions_service.ReplayRequest{
+			RequestID:           &requestID,
+			SubscriptionOwner:   SubscriptionOwner,
+			SubscriptionId:      SubscriptionID,
+			NodeProvidedSecrets: &secrets,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "secrets size too big", string(result.Error))
+	})
+
+	t.Run("returns internal errors", func(t *testing.T) {
+		uni.eaClient.On("RunComputation", mock.Anything, mock.Anything, mock.Anything, SubscriptionOwner.Hex(), SubscriptionID, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, nil, errors.New("error")).Once()
+
+		_, err := uni.service.ReplayRequest(testutils.Context(t), &functions_service.ReplayRequest{
+			SubscriptionOwner: SubscriptionOwner,
+			SubscriptionId:    SubscriptionID,
+		})
+		require.ErrorContains(t, err, "internal adapter error")
+	})
+}
@@ -50,6 +50,36 @@ func (_m *FunctionsListener) HandleOffchainRequest(ctx context.Context, request
 	return r0
 }
 
+// ReplayRequest provides a mock function with given fields: ctx, replay
+func (_m *FunctionsListener) ReplayRequest(ctx context.Context, replay *functions.ReplayRequest) (*functions.ReplayResult, error) {
+	ret := _m.Called(ctx, replay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRequest")
+	}
+
+	var r0 *functions.ReplayResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *functions.ReplayRequest) (*functions.ReplayResult, error)); ok {
+		return rf(ctx, replay)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *functions.ReplayRequest) *functions.ReplayResult); ok {
+		r0 = rf(ctx, replay)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*functions.ReplayResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *functions.ReplayRequest) error); ok {
+		r1 = rf(ctx, replay)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *FunctionsListener) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -0,0 +1,262 @@
+package functions
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/tdh2/go/tdh2/tdh2easy"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// SecretsRotation is the result of the rotation of the DON-hosted secrets of a slot.
+type SecretsRotation struct {
+	Owner           common.Address
+	SlotID          uint
+	PreviousVersion uint64
+	Version         uint64 // zero if the node does not have the key of the owner and did not upload the secrets
+	Expiration      int64  // unix time in milliseconds
+	Err             error
+}
+
+// SecretsRotator periodically re-encrypts and re-uploads the configured DON-hosted secrets
+// before they expire. All nodes of the DON take part in the threshold decryption of the
+// secrets, and the node holding the key of their owner uploads them.
+type SecretsRotator interface {
+	job.ServiceCtx
+	services.HealthReporter
+
+	// Rotate rotates all configured secrets immediately, regardless of their expiration.
+	Rotate(ctx context.Context) []SecretsRotation
+}
+
+type secretsRotator struct {
+	services.StateMachine
+	config         config.SecretsRotationConfig
+	decryptTimeout time.Duration
+	chainID        *big.Int
+	storage        s4.Storage
+	decryptor      threshold.Decryptor
+	publicKey      *tdh2easy.PublicKey
+	ethKeystore    keystore.Eth
+	clock          utils.Clock
+	lggr           logger.Logger
+	chStop         services.StopChan
+	wg             sync.WaitGroup
+
+	rotateMu sync.Mutex // serializes scheduled and manual rotations
+
+	errorsMu sync.RWMutex
+	errors   map[s4.Key]error // last rotation error of each slot, Version is always 0
+}
+
+var _ SecretsRotator = (*secretsRotator)(nil)
+
+func NewSecretsRotator(cfg config.SecretsRotationConfig, decryptTimeout time.Duration, chainID *big.Int, storage s4.Storage, decryptor threshold.Decryptor, publicKey *tdh2easy.PublicKey, ethKeystore keystore.Eth, clock utils.Clock, lggr logger.Logger) SecretsRotator {
+	return &secretsRotator{
+		config:         cfg,
+		decryptTimeout: decryptTimeout,
+		chainID:        chainID,
+		storage:        storage,
+		decryptor:      decryptor,
+		publicKey:      publicKey,
+		ethKeystore:    ethKeystore,
+		clock:          clock,
+		lggr:           lggr.Named("SecretsRotator"),
+		chStop:         make(services.StopChan),
+		errors:         make(map[s4.Key]error),
+	}
+}
+
+// Start complies with job.Service
+func (r *secretsRotator) Start(context.Context) error {
+	return r.StartOnce("SecretsRotator", func() error {
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+// Close complies with job.Service
+func (r *secretsRotator) Close() error {
+	return r.StopOnce("SecretsRotator", func() error {
+		close(r.chStop)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *secretsRotator) Name() string { return r.lggr.Name() }
+
+// HealthReport reports the last rotation error of each configured slot.
+func (r *secretsRotator) HealthReport() map[string]error {
+	r.errorsMu.RLock()
+	defer r.errorsMu.RUnlock()
+	var err error
+	for key, rotationErr := range r.errors {
+		err = errors.Join(err, fmt.Errorf("failed to rotate secrets of owner %s and slot %d: %w", key.Address, key.SlotId, rotationErr))
+	}
+	return map[string]error{r.Name(): errors.Join(r.Healthy(), err)}
+}
+
+func (r *secretsRotator) run() {
+	defer r.wg.Done()
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(time.Duration(r.config.CheckFrequencySec) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.rotate(ctx, false)
+		}
+	}
+}
+
+func (r *secretsRotator) Rotate(ctx context.Context) []SecretsRotation {
+	return r.rotate(ctx, true)
+}
+
+// rotate rotates the configured secrets that expire within RenewBeforeSec, or all of them if force is set,
+// and returns the attempted rotations.
+func (r *secretsRotator) rotate(ctx context.Context, force bool) (rotations []SecretsRotation) {
+	r.rotateMu.Lock()
+	defer r.rotateMu.Unlock()
+
+	renewBefore := int64(r.config.RenewBeforeSec) * 1000
+	for _, secret := range r.config.Secrets {
+		rotation := SecretsRotation{Owner: secret.Owner, SlotID: secret.SlotID}
+		row, err := r.latest(ctx, secret.Owner, secret.SlotID)
+		if err == nil {
+			if !force && row.Expiration-r.clock.Now().UnixMilli() > renewBefore {
+				r.setError(secret.Owner, secret.SlotID, nil)
+				continue
+			}
+			rotation.PreviousVersion = row.Version
+			rotation.Version, rotation.Expiration, err = r.rotateSlot(ctx, secret, row.Version)
+		}
+		rotation.Err = err
+		r.setError(secret.Owner, secret.SlotID, err)
+		if err != nil {
+			r.lggr.Errorw("Failed to rotate DON-hosted secrets", "owner", secret.Owner, "slotID", secret.SlotID, "err", err)
+		} else if rotation.Version == 0 {
+			r.lggr.Debugw("Decrypted DON-hosted secrets for their rotation by the node of the owner", "owner", secret.Owner, "slotID", secret.SlotID, "version", rotation.PreviousVersion)
+		} else {
+			r.lggr.Infow("Rotated DON-hosted secrets", "owner", secret.Owner, "slotID", secret.SlotID, "previousVersion", rotation.PreviousVersion, "version", rotation.Version, "expiration", rotation.Expiration)
+		}
+		rotations = append(rotations, rotation)
+	}
+	return rotations
+}
+
+// latest returns the row of the slot in the local S4 snapshot.
+func (r *secretsRotator) latest(ctx context.Context, owner common.Address, slotID uint) (*s4.SnapshotRow, error) {
+	rows, err := r.storage.List(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list S4 records: %w", err)
+	}
+	for _, row := range rows {
+		if row.SlotId == slotID {
+			return row, nil
+		}
+	}
+	return nil, s4.ErrNotFound
+}
+
+// rotateSlot decrypts the given version of the secrets of a slot with the DON, encrypts them again
+// with the DON public key and uploads them with the next version. Nodes without the key of the owner
+// only take part in the decryption, and return a zero version.
+func (r *secretsRotator) rotateSlot(ctx context.Context, secret config.RotatedSecretConfig, version uint64) (newVersion uint64, expiration int64, err error) {
+	record, _, err := r.storage.Get(ctx, &s4.Key{Address: secret.Owner, SlotId: secret.SlotID, Version: version})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to fetch secrets: %w", err)
+	}
+
+	decryptCtx, cancel := context.WithTimeout(ctx, r.decryptTimeout)
+	defer cancel()
+	plaintext, err := r.decryptor.Decrypt(decryptCtx, rotationCiphertextID(secret.Owner, secret.SlotID, version), record.Payload)
+	if err != nil {
+		return 0, 0, fmt.Errorf("threshold decryption of secrets failed: %w", err)
+	}
+
+	privateKey, err := r.ownerKey(secret.Owner)
+	if err != nil || privateKey == nil {
+		return 0, 0, err
+	}
+	ciphertext, err := tdh2easy.Encrypt(r.publicKey, plaintext)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to encrypt secrets: %w", err)
+	}
+	payload, err := ciphertext.Marshal()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to marshal encrypted secrets: %w", err)
+	}
+
+	key := &s4.Key{Address: secret.Owner, SlotId: secret.SlotID, Version: version + 1}
+	newRecord := &s4.Record{
+		Payload:    payload,
+		Expiration: r.clock.Now().Add(time.Duration(secret.TTLSec) * time.Second).UnixMilli(),
+	}
+	signature, err := s4.NewEnvelopeFromRecord(key, newRecord).Sign(privateKey)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to sign secrets: %w", err)
+	}
+	if err = r.storage.Put(ctx, key, newRecord, signature); err != nil {
+		return 0, 0, fmt.Errorf("failed to upload secrets: %w", err)
+	}
+	return key.Version, newRecord.Expiration, nil
+}
+
+// ownerKey returns the private key of the owner, or nil if it is not an enabled key of the node.
+func (r *secretsRotator) ownerKey(owner common.Address) (*ecdsa.PrivateKey, error) {
+	keys, err := r.ethKeystore.EnabledKeysForChain(r.chainID)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Address == owner {
+			return key.ToEcdsaPrivKey(), nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *secretsRotator) setError(owner common.Address, slotID uint, err error) {
+	r.errorsMu.Lock()
+	defer r.errorsMu.Unlock()
+	key := s4.Key{Address: owner, SlotId: slotID}
+	if err == nil {
+		delete(r.errors, key)
+	} else {
+		r.errors[key] = err
+	}
+}
+
+// rotationCiphertextID is the same on all nodes of the DON rotating the slot, so that they
+// decrypt the secrets together.
+func rotationCiphertextID(owner common.Address, slotID uint, version uint64) []byte {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], uint64(slotID))
+	binary.BigEndian.PutUint64(b[8:], version)
+	return crypto.Keccak256([]byte("rotation"), owner.Bytes(), b[:])
+}
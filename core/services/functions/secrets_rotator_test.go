@@ -0,0 +1,167 @@
+package functions_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/tdh2/go/tdh2/tdh2easy"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
+	thresholdmocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+	s4mocks "github.com/smartcontractkit/chainlink/v2/core/services/s4/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestSecretsRotator_Rotate(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1)
+	now := time.Now()
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	otherKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	_, publicKey, shares, err := tdh2easy.GenerateKeys(1, 2)
+	require.NoError(t, err)
+
+	cfg := config.SecretsRotationConfig{
+		CheckFrequencySec: 60,
+		RenewBeforeSec:    3600,
+		Secrets: []config.RotatedSecretConfig{
+			{Owner: key.Address, SlotID: 1, TTLSec: 86400},
+		},
+	}
+	plaintext := []byte(`{"apiKey":"secret"}`)
+	oldPayload := []byte("old ciphertext")
+
+	newRotator := func(t *testing.T, storage s4.Storage, decryptor *thresholdmocks.Decryptor, keys ...ethkey.KeyV2) functions.SecretsRotator {
+		ethKeystore := ksmocks.NewEth(t)
+		ethKeystore.On("EnabledKeysForChain", chainID).Return(keys, nil).Maybe()
+		rotator := functions.NewSecretsRotator(cfg, time.Second, chainID, storage, decryptor, publicKey, ethKeystore, utils.NewFixedClock(now), logger.TestLogger(t))
+		servicetest.Run(t, rotator)
+		return rotator
+	}
+
+	t.Run("re-encrypts and uploads the next version", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		decryptor := thresholdmocks.NewDecryptor(t)
+		storage.On("List", mock.Anything, key.Address).Return([]*s4.SnapshotRow{{SlotId: 0, Version: 9}, {SlotId: 1, Version: 4, Expiration: now.Add(time.Hour).UnixMilli()}}, nil)
+		storage.On("Get", mock.Anything, &s4.Key{Address: key.Address, SlotId: 1, Version: 4}).Return(&s4.Record{Payload: oldPayload}, &s4.Metadata{}, nil)
+		decryptor.On("Decrypt", mock.Anything, mock.Anything, oldPayload).Return(plaintext, nil)
+		storage.On("Put", mock.Anything, &s4.Key{Address: key.Address, SlotId: 1, Version: 5}, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			k := args.Get(1).(*s4.Key)
+			record := args.Get(2).(*s4.Record)
+			assert.Equal(t, now.Add(24*time.Hour).UnixMilli(), record.Expiration)
+
+			signer, err2 := s4.NewEnvelopeFromRecord(k, record).GetSignerAddress(args.Get(3).([]byte))
+			require.NoError(t, err2)
+			assert.Equal(t, key.Address, signer)
+
+			var ciphertext tdh2easy.Ciphertext
+			require.NoError(t, ciphertext.UnmarshalVerify(record.Payload, publicKey))
+			share, err2 := tdh2easy.Decrypt(&ciphertext, shares[0])
+			require.NoError(t, err2)
+			decrypted, err2 := tdh2easy.Aggregate(&ciphertext, []*tdh2easy.DecryptionShare{share}, 2)
+			require.NoError(t, err2)
+			assert.Equal(t, plaintext, decrypted)
+		}).Return(nil).Once()
+
+		rotator := newRotator(t, storage, decryptor, otherKey, key)
+		rotations := rotator.Rotate(testutils.Context(t))
+		require.Len(t, rotations, 1)
+		assert.NoError(t, rotations[0].Err)
+		assert.Equal(t, uint64(4), rotations[0].PreviousVersion)
+		assert.Equal(t, uint64(5), rotations[0].Version)
+		assert.Equal(t, now.Add(24*time.Hour).UnixMilli(), rotations[0].Expiration)
+		assert.NoError(t, rotator.HealthReport()[rotator.Name()])
+	})
+
+	t.Run("only decrypts without the key of the owner", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		decryptor := thresholdmocks.NewDecryptor(t)
+		storage.On("List", mock.Anything, key.Address).Return([]*s4.SnapshotRow{{SlotId: 1, Version: 4}}, nil)
+		storage.On("Get", mock.Anything, mock.Anything).Return(&s4.Record{Payload: oldPayload}, &s4.Metadata{}, nil)
+		decryptor.On("Decrypt", mock.Anything, mock.Anything, oldPayload).Return(plaintext, nil)
+
+		rotator := newRotator(t, storage, decryptor, otherKey)
+		rotations := rotator.Rotate(testutils.Context(t))
+		require.Len(t, rotations, 1)
+		assert.NoError(t, rotations[0].Err)
+		assert.Zero(t, rotations[0].Version)
+		storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reports failures in the health report", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		decryptor := thresholdmocks.NewDecryptor(t)
+		storage.On("List", mock.Anything, key.Address).Return([]*s4.SnapshotRow{{SlotId: 1, Version: 4}}, nil)
+		storage.On("Get", mock.Anything, mock.Anything).Return(&s4.Record{Payload: oldPayload}, &s4.Metadata{}, nil)
+		decryptor.On("Decrypt", mock.Anything, mock.Anything, oldPayload).Return(nil, errors.New("timeout")).Once()
+
+		rotator := newRotator(t, storage, decryptor, key)
+		rotations := rotator.Rotate(testutils.Context(t))
+		require.Len(t, rotations, 1)
+		assert.ErrorContains(t, rotations[0].Err, "threshold decryption of secrets failed")
+		assert.ErrorContains(t, rotator.HealthReport()[rotator.Name()], "failed to rotate secrets of owner")
+	})
+
+	t.Run("fails if the slot is empty", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		storage.On("List", mock.Anything, key.Address).Return(nil, nil)
+
+		rotator := newRotator(t, storage, thresholdmocks.NewDecryptor(t), key)
+		rotations := rotator.Rotate(testutils.Context(t))
+		require.Len(t, rotations, 1)
+		assert.ErrorIs(t, rotations[0].Err, s4.ErrNotFound)
+	})
+}
+
+func TestSecretsRotator_Run(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	_, publicKey, _, err := tdh2easy.GenerateKeys(1, 2)
+	require.NoError(t, err)
+	now := time.Now()
+	cfg := config.SecretsRotationConfig{
+		CheckFrequencySec: 1,
+		RenewBeforeSec:    3600,
+		Secrets: []config.RotatedSecretConfig{
+			{Owner: key.Address, SlotID: 0, TTLSec: 86400},
+		},
+	}
+
+	storage := s4mocks.NewStorage(t)
+	listed := make(chan struct{}, 1)
+	// secrets expiring after RenewBeforeSec are not rotated
+	storage.On("List", mock.Anything, key.Address).Return([]*s4.SnapshotRow{{SlotId: 0, Version: 1, Expiration: now.Add(2 * time.Hour).UnixMilli()}}, nil).Run(func(mock.Arguments) {
+		select {
+		case listed <- struct{}{}:
+		default:
+		}
+	})
+	rotator := functions.NewSecretsRotator(cfg, time.Second, big.NewInt(1), storage, thresholdmocks.NewDecryptor(t), publicKey, ksmocks.NewEth(t), utils.NewFixedClock(now), logger.TestLogger(t))
+	servicetest.Run(t, rotator)
+
+	select {
+	case <-listed:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the rotation check")
+	}
+	assert.NoError(t, rotator.HealthReport()[rotator.Name()])
+}
@@ -26,9 +26,30 @@ type DONConfig struct {
 	HandlerConfig json.RawMessage
 	Members       []NodeConfig
 	F             int
+	// Disabled handlers reject user messages until they are enabled at runtime.
+	Disabled bool
+	Policy   HandlerPolicy
 }
 
 type NodeConfig struct {
 	Name    string
 	Address string
 }
+
+// HandlerPolicy is enforced by the gateway on user messages before they reach the handler of a DON,
+// in addition to any checks of the handler itself. It can be changed at runtime.
+type HandlerPolicy struct {
+	// RateLimit limits user messages globally and per sender, no limit applies if nil.
+	RateLimit *HandlerRateLimit
+	// AllowedSenders, if not empty, are the only addresses allowed to send user messages.
+	AllowedSenders []string
+	// AllowedMethods, if not empty, are the only methods allowed in user messages.
+	AllowedMethods []string
+}
+
+type HandlerRateLimit struct {
+	GlobalRPS      float64
+	GlobalBurst    int
+	PerSenderRPS   float64
+	PerSenderBurst int
+}
@@ -38,6 +38,10 @@ type ConnectionManager interface {
 	network.ConnectionAcceptor
 
 	DONConnectionManager(donId string) *donConnectionManager
+	// NewDONConnectionManager creates the connection manager of a new DON, to be added with AddDON.
+	NewDONConnectionManager(donConfig *config.DONConfig) (*donConnectionManager, error)
+	// AddDON starts accepting connections from the nodes of the DON, which needs a handler.
+	AddDON(ctx context.Context, donConnMgr *donConnectionManager) error
 	GetPort() int
 }
 
@@ -46,6 +50,9 @@ type connectionManager struct {
 
 	config             *config.ConnectionManagerConfig
 	dons               map[string]*donConnectionManager
+	donsStarted        bool
+	donsMu             sync.RWMutex
+	codec              api.Codec
 	wsServer           network.WebSocketServer
 	clock              utils.Clock
 	connAttempts       map[string]*connAttempt
@@ -56,6 +63,8 @@ type connectionManager struct {
 
 func (m *connectionManager) HealthReport() map[string]error {
 	hr := map[string]error{m.Name(): m.Healthy()}
+	m.donsMu.RLock()
+	defer m.donsMu.RUnlock()
 	for _, d := range m.dons {
 		for _, n := range d.nodes {
 			services.CopyHealth(hr, n.conn.HealthReport())
@@ -89,67 +98,99 @@ type connAttempt struct {
 }
 
 func NewConnectionManager(gwConfig *config.GatewayConfig, clock utils.Clock, lggr logger.Logger) (ConnectionManager, error) {
-	codec := &api.JsonRPCCodec{}
-	dons := make(map[string]*donConnectionManager)
+	connMgr := &connectionManager{
+		config:       &gwConfig.ConnectionManagerConfig,
+		dons:         make(map[string]*donConnectionManager),
+		codec:        &api.JsonRPCCodec{},
+		connAttempts: make(map[string]*connAttempt),
+		clock:        clock,
+		lggr:         lggr.Named("ConnectionManager"),
+	}
 	for _, donConfig := range gwConfig.Dons {
 		donConfig := donConfig
-		if donConfig.DonId == "" {
-			return nil, errors.New("empty DON ID")
-		}
-		_, ok := dons[donConfig.DonId]
+		_, ok := connMgr.dons[donConfig.DonId]
 		if ok {
 			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
 		}
-		nodes := make(map[string]*nodeState)
-		for _, nodeConfig := range donConfig.Members {
-			nodeAddress := strings.ToLower(nodeConfig.Address)
-			_, ok := nodes[nodeAddress]
-			if ok {
-				return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
-			}
-			nodes[nodeAddress] = &nodeState{conn: network.NewWSConnectionWrapper(lggr)}
-			if nodes[nodeAddress].conn == nil {
-				return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
-			}
-		}
-		dons[donConfig.DonId] = &donConnectionManager{
-			donConfig:  &donConfig,
-			codec:      codec,
-			nodes:      nodes,
-			shutdownCh: make(chan struct{}),
-			lggr:       lggr.Named("DONConnectionManager." + donConfig.DonId),
+		donConnMgr, err := newDONConnectionManager(&donConfig, connMgr.codec, lggr)
+		if err != nil {
+			return nil, err
 		}
-	}
-	connMgr := &connectionManager{
-		config:       &gwConfig.ConnectionManagerConfig,
-		dons:         dons,
-		connAttempts: make(map[string]*connAttempt),
-		clock:        clock,
-		lggr:         lggr.Named("ConnectionManager"),
+		connMgr.dons[donConfig.DonId] = donConnMgr
 	}
 	wsServer := network.NewWebSocketServer(&gwConfig.NodeServerConfig, connMgr, lggr)
 	connMgr.wsServer = wsServer
 	return connMgr, nil
 }
 
+func newDONConnectionManager(donConfig *config.DONConfig, codec api.Codec, lggr logger.Logger) (*donConnectionManager, error) {
+	if donConfig.DonId == "" {
+		return nil, errors.New("empty DON ID")
+	}
+	nodes := make(map[string]*nodeState)
+	for _, nodeConfig := range donConfig.Members {
+		nodeAddress := strings.ToLower(nodeConfig.Address)
+		_, ok := nodes[nodeAddress]
+		if ok {
+			return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
+		}
+		nodes[nodeAddress] = &nodeState{conn: network.NewWSConnectionWrapper(lggr)}
+		if nodes[nodeAddress].conn == nil {
+			return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
+		}
+	}
+	return &donConnectionManager{
+		donConfig:  donConfig,
+		codec:      codec,
+		nodes:      nodes,
+		shutdownCh: make(chan struct{}),
+		lggr:       lggr.Named("DONConnectionManager." + donConfig.DonId),
+	}, nil
+}
+
 func (m *connectionManager) DONConnectionManager(donId string) *donConnectionManager {
+	m.donsMu.RLock()
+	defer m.donsMu.RUnlock()
 	return m.dons[donId]
 }
 
+func (m *connectionManager) NewDONConnectionManager(donConfig *config.DONConfig) (*donConnectionManager, error) {
+	return newDONConnectionManager(donConfig, m.codec, m.lggr)
+}
+
+func (m *connectionManager) AddDON(ctx context.Context, donConnMgr *donConnectionManager) error {
+	donId := donConnMgr.donConfig.DonId
+	if donConnMgr.handler == nil {
+		return fmt.Errorf("no handler set for DON %s", donId)
+	}
+	m.donsMu.Lock()
+	defer m.donsMu.Unlock()
+	if _, ok := m.dons[donId]; ok {
+		return fmt.Errorf("duplicate DON ID %s", donId)
+	}
+	if m.donsStarted {
+		if err := donConnMgr.start(ctx, m.config.HeartbeatIntervalSec); err != nil {
+			donConnMgr.close()
+			return err
+		}
+	}
+	m.dons[donId] = donConnMgr
+	m.lggr.Infow("added DON", "donID", donId, "nodes", len(donConnMgr.nodes))
+	return nil
+}
+
 func (m *connectionManager) Start(ctx context.Context) error {
 	return m.StartOnce("ConnectionManager", func() error {
 		m.lggr.Info("starting connection manager")
+		m.donsMu.Lock()
 		for _, donConnMgr := range m.dons {
-			donConnMgr.closeWait.Add(len(donConnMgr.nodes))
-			for nodeAddress, nodeState := range donConnMgr.nodes {
-				if err := nodeState.conn.Start(ctx); err != nil {
-					return err
-				}
-				go donConnMgr.readLoop(nodeAddress, nodeState)
+			if err := donConnMgr.start(ctx, m.config.HeartbeatIntervalSec); err != nil {
+				m.donsMu.Unlock()
+				return err
 			}
-			donConnMgr.closeWait.Add(1)
-			go donConnMgr.heartbeatLoop(m.config.HeartbeatIntervalSec)
 		}
+		m.donsStarted = true
+		m.donsMu.Unlock()
 		return m.wsServer.Start(ctx)
 	})
 }
@@ -158,14 +199,11 @@ func (m *connectionManager) Close() error {
 	return m.StopOnce("ConnectionManager", func() (err error) {
 		m.lggr.Info("closing connection manager")
 		err = multierr.Combine(err, m.wsServer.Close())
+		m.donsMu.Lock()
+		defer m.donsMu.Unlock()
+		m.donsStarted = false
 		for _, donConnMgr := range m.dons {
-			close(donConnMgr.shutdownCh)
-			for _, nodeState := range donConnMgr.nodes {
-				nodeState.conn.Close()
-			}
-		}
-		for _, donConnMgr := range m.dons {
-			donConnMgr.closeWait.Wait()
+			donConnMgr.close()
 		}
 		return
 	})
@@ -178,8 +216,8 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 		return "", nil, multierr.Append(network.ErrAuthHeaderParse, err)
 	}
 	nodeAddress := "0x" + hex.EncodeToString(signer)
-	donConnMgr, ok := m.dons[authHeaderElems.DonId]
-	if !ok {
+	donConnMgr := m.DONConnectionManager(authHeaderElems.DonId)
+	if donConnMgr == nil {
 		return "", nil, network.ErrAuthInvalidDonId
 	}
 	nodeState, ok := donConnMgr.nodes[nodeAddress]
@@ -255,6 +293,28 @@ func (m *donConnectionManager) SetHandler(handler handlers.Handler) {
 	m.handler = handler
 }
 
+// start starts the connections to the nodes of the DON, along with their read and heartbeat loops.
+func (m *donConnectionManager) start(ctx context.Context, heartbeatIntervalSec uint32) error {
+	for nodeAddress, nodeState := range m.nodes {
+		if err := nodeState.conn.Start(ctx); err != nil {
+			return err
+		}
+		m.closeWait.Add(1)
+		go m.readLoop(nodeAddress, nodeState)
+	}
+	m.closeWait.Add(1)
+	go m.heartbeatLoop(heartbeatIntervalSec)
+	return nil
+}
+
+func (m *donConnectionManager) close() {
+	close(m.shutdownCh)
+	for _, nodeState := range m.nodes {
+		nodeState.conn.Close()
+	}
+	m.closeWait.Wait()
+}
+
 func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
 	if msg == nil {
 		return errors.New("nil message")
@@ -8,12 +8,15 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -227,3 +230,44 @@ func TestConnectionManager_SendToNode_Failures(t *testing.T) {
 	err = donMgr.SendToNode(testutils.Context(t), "some_other_node", message)
 	require.Error(t, err)
 }
+
+func TestConnectionManager_AddDON(t *testing.T) {
+	t.Parallel()
+
+	cfg, _ := newTestConfig(t, 1)
+	clock := utils.NewFixedClock(time.Now())
+	mgr, err := gateway.NewConnectionManager(cfg, clock, logger.TestLogger(t))
+	require.NoError(t, err)
+	servicetest.Run(t, mgr)
+
+	newNode := gc.NewTestNodes(t, 1)[0]
+	newDON := &config.DONConfig{
+		DonId:       "my_don_2",
+		HandlerName: "dummy",
+		Members:     []config.NodeConfig{{Name: "new_node", Address: newNode.Address}},
+	}
+	donMgr, err := mgr.NewDONConnectionManager(newDON)
+	require.NoError(t, err)
+
+	// a handler is required
+	require.Error(t, mgr.AddDON(testutils.Context(t), donMgr))
+
+	donMgr.SetHandler(handler_mocks.NewHandler(t))
+	require.NoError(t, mgr.AddDON(testutils.Context(t), donMgr))
+	require.Equal(t, donMgr, mgr.DONConnectionManager("my_don_2"))
+
+	// nodes of the new DON can connect
+	authHeaderElems := network.AuthHeaderElems{
+		Timestamp: uint32(clock.Now().Unix()),
+		DonId:     "my_don_2",
+		GatewayId: "my_gateway_no_3",
+	}
+	_, _, err = mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, newNode.PrivateKey))
+	require.NoError(t, err)
+
+	// duplicate DON ID
+	duplicate, err := mgr.NewDONConnectionManager(&config.DONConfig{DonId: "my_don_1"})
+	require.NoError(t, err)
+	duplicate.SetHandler(handler_mocks.NewHandler(t))
+	require.Error(t, mgr.AddDON(testutils.Context(t), duplicate))
+}
@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"encoding/json"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -22,6 +23,9 @@ type Delegate struct {
 	db           *sqlx.DB
 	cfg          pg.QConfig
 	lggr         logger.Logger
+
+	gatewaysMu sync.RWMutex
+	gateways   map[int32]Gateway
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -33,6 +37,7 @@ func NewDelegate(legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth, d
 		db:           db,
 		cfg:          cfg,
 		lggr:         lggr,
+		gateways:     make(map[int32]Gateway),
 	}
 }
 
@@ -42,9 +47,25 @@ func (d *Delegate) JobType() job.Type {
 
 func (d *Delegate) BeforeJobCreated(spec job.Job)                {}
 func (d *Delegate) AfterJobCreated(spec job.Job)                 {}
-func (d *Delegate) BeforeJobDeleted(spec job.Job)                {}
 func (d *Delegate) OnDeleteJob(spec job.Job, q pg.Queryer) error { return nil }
 
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {
+	d.gatewaysMu.Lock()
+	defer d.gatewaysMu.Unlock()
+	delete(d.gateways, spec.ID)
+}
+
+// Gateways returns the gateways of the running Gateway jobs, keyed by job ID.
+func (d *Delegate) Gateways() map[int32]Gateway {
+	d.gatewaysMu.RLock()
+	defer d.gatewaysMu.RUnlock()
+	gateways := make(map[int32]Gateway, len(d.gateways))
+	for id, gw := range d.gateways {
+		gateways[id] = gw
+	}
+	return gateways
+}
+
 // ServicesForSpec returns the scheduler to be used for running observer jobs
 func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err error) {
 	if spec.GatewaySpec == nil {
@@ -62,6 +83,9 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 		return nil, err
 	}
 
+	d.gatewaysMu.Lock()
+	d.gateways[spec.ID] = gateway
+	d.gatewaysMu.Unlock()
 	return []job.ServiceCtx{gateway}, nil
 }
 
@@ -3,8 +3,11 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 
 	"go.uber.org/multierr"
 
@@ -34,6 +37,16 @@ type Gateway interface {
 
 	GetUserPort() int
 	GetNodePort() int
+
+	// Handlers returns the status of the handler of each DON, sorted by DON ID.
+	Handlers() []HandlerStatus
+	// AddHandler creates the handler of a new DON and connects to its nodes, without restarting the gateway.
+	AddHandler(ctx context.Context, donConfig config.DONConfig) error
+	// SetHandlerEnabled enables or disables the handler of a DON. Disabled handlers reject user messages,
+	// but keep receiving the messages of their nodes.
+	SetHandlerEnabled(donId string, enabled bool) error
+	// SetHandlerPolicy replaces the policy enforced on the user messages of the handler of a DON.
+	SetHandlerPolicy(donId string, policy config.HandlerPolicy) error
 }
 
 type HandlerType = string
@@ -45,11 +58,15 @@ type HandlerFactory interface {
 type gateway struct {
 	services.StateMachine
 
-	codec      api.Codec
-	httpServer gw_net.HttpServer
-	handlers   map[string]handlers.Handler
-	connMgr    ConnectionManager
-	lggr       logger.Logger
+	codec          api.Codec
+	httpServer     gw_net.HttpServer
+	connMgr        ConnectionManager
+	handlerFactory HandlerFactory
+	lggr           logger.Logger
+
+	handlersMu      sync.RWMutex
+	handlers        map[string]*handlerEntry
+	handlersStarted bool
 }
 
 func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger) (Gateway, error) {
@@ -60,34 +77,36 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 		return nil, err
 	}
 
-	handlerMap := make(map[string]handlers.Handler)
+	gw := newGateway(codec, httpServer, make(map[string]*handlerEntry), connMgr, lggr)
+	gw.handlerFactory = handlerFactory
 	for _, donConfig := range config.Dons {
 		donConfig := donConfig
-		_, ok := handlerMap[donConfig.DonId]
-		if ok {
+		if _, ok := gw.handlers[donConfig.DonId]; ok {
 			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
 		}
 		donConnMgr := connMgr.DONConnectionManager(donConfig.DonId)
 		if donConnMgr == nil {
 			return nil, fmt.Errorf("connection manager ID %s not found", donConfig.DonId)
 		}
-		for idx, nodeConfig := range donConfig.Members {
-			donConfig.Members[idx].Address = strings.ToLower(nodeConfig.Address)
-			if !common.IsHexAddress(nodeConfig.Address) {
-				return nil, fmt.Errorf("invalid node address %s", nodeConfig.Address)
-			}
-		}
-		handler, err := handlerFactory.NewHandler(donConfig.HandlerName, donConfig.HandlerConfig, &donConfig, donConnMgr)
+		entry, err := gw.newHandlerEntry(&donConfig, donConnMgr)
 		if err != nil {
 			return nil, err
 		}
-		handlerMap[donConfig.DonId] = handler
-		donConnMgr.SetHandler(handler)
+		gw.handlers[donConfig.DonId] = entry
+		donConnMgr.SetHandler(entry.handler)
 	}
-	return NewGateway(codec, httpServer, handlerMap, connMgr, lggr), nil
+	return gw, nil
 }
 
 func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) Gateway {
+	entries := make(map[string]*handlerEntry, len(handlers))
+	for donId, handler := range handlers {
+		entries[donId] = &handlerEntry{handler: handler, enabled: true}
+	}
+	return newGateway(codec, httpServer, entries, connMgr, lggr)
+}
+
+func newGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]*handlerEntry, connMgr ConnectionManager, lggr logger.Logger) *gateway {
 	gw := &gateway{
 		codec:      codec,
 		httpServer: httpServer,
@@ -99,13 +118,30 @@ func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[stri
 	return gw
 }
 
+// newHandlerEntry validates the node addresses of the DON and creates its handler.
+func (g *gateway) newHandlerEntry(donConfig *config.DONConfig, don handlers.DON) (*handlerEntry, error) {
+	for idx, nodeConfig := range donConfig.Members {
+		donConfig.Members[idx].Address = strings.ToLower(nodeConfig.Address)
+		if !common.IsHexAddress(nodeConfig.Address) {
+			return nil, fmt.Errorf("invalid node address %s", nodeConfig.Address)
+		}
+	}
+	handler, err := g.handlerFactory.NewHandler(donConfig.HandlerName, donConfig.HandlerConfig, donConfig, don)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := newHandlerEntry(handler, donConfig.HandlerName, !donConfig.Disabled, donConfig.Policy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy of DON %s: %w", donConfig.DonId, err)
+	}
+	return entry, nil
+}
+
 func (g *gateway) Start(ctx context.Context) error {
 	return g.StartOnce("Gateway", func() error {
 		g.lggr.Info("starting gateway")
-		for _, handler := range g.handlers {
-			if err := handler.Start(ctx); err != nil {
-				return err
-			}
+		if err := g.startHandlers(ctx); err != nil {
+			return err
 		}
 		if err := g.connMgr.Start(ctx); err != nil {
 			return err
@@ -119,13 +155,28 @@ func (g *gateway) Close() error {
 		g.lggr.Info("closing gateway")
 		err = multierr.Combine(err, g.httpServer.Close())
 		err = multierr.Combine(err, g.connMgr.Close())
-		for _, handler := range g.handlers {
-			err = multierr.Combine(err, handler.Close())
+		g.handlersMu.Lock()
+		defer g.handlersMu.Unlock()
+		g.handlersStarted = false
+		for _, entry := range g.handlers {
+			err = multierr.Combine(err, entry.handler.Close())
 		}
 		return
 	})
 }
 
+func (g *gateway) startHandlers(ctx context.Context) error {
+	g.handlersMu.Lock()
+	defer g.handlersMu.Unlock()
+	for _, entry := range g.handlers {
+		if err := entry.handler.Start(ctx); err != nil {
+			return err
+		}
+	}
+	g.handlersStarted = true
+	return nil
+}
+
 // Called by the server
 func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int) {
 	// decode
@@ -139,11 +190,19 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	if err = msg.Validate(); err != nil {
 		return newError(g.codec, msg.Body.MessageId, api.UserMessageParseError, err.Error())
 	}
-	// find correct handler
-	handler, ok := g.handlers[msg.Body.DonId]
+	// find correct handler and enforce its policy
+	g.handlersMu.RLock()
+	entry, ok := g.handlers[msg.Body.DonId]
 	if !ok {
+		g.handlersMu.RUnlock()
 		return newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
 	}
+	errCode, errMsg := entry.check(msg)
+	handler := entry.handler
+	g.handlersMu.RUnlock()
+	if errCode != api.NoError {
+		return newError(g.codec, msg.Body.MessageId, errCode, errMsg)
+	}
 	// send to the handler
 	responseCh := make(chan handlers.UserCallbackPayload, 1)
 	err = handler.HandleUserMessage(ctx, msg, responseCh)
@@ -188,3 +247,76 @@ func (g *gateway) GetUserPort() int {
 func (g *gateway) GetNodePort() int {
 	return g.connMgr.GetPort()
 }
+
+func (g *gateway) Handlers() []HandlerStatus {
+	g.handlersMu.RLock()
+	defer g.handlersMu.RUnlock()
+	statuses := make([]HandlerStatus, 0, len(g.handlers))
+	for donId, entry := range g.handlers {
+		statuses = append(statuses, entry.status(donId))
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].DonId < statuses[j].DonId })
+	return statuses
+}
+
+func (g *gateway) AddHandler(ctx context.Context, donConfig config.DONConfig) error {
+	if g.handlerFactory == nil || g.connMgr == nil {
+		return errors.New("gateway does not support adding handlers")
+	}
+	donConfig.Members = append([]config.NodeConfig(nil), donConfig.Members...)
+
+	g.handlersMu.Lock()
+	defer g.handlersMu.Unlock()
+	if _, ok := g.handlers[donConfig.DonId]; ok {
+		return fmt.Errorf("%w: DON ID %s", ErrHandlerExists, donConfig.DonId)
+	}
+	donConnMgr, err := g.connMgr.NewDONConnectionManager(&donConfig)
+	if err != nil {
+		return err
+	}
+	entry, err := g.newHandlerEntry(&donConfig, donConnMgr)
+	if err != nil {
+		return err
+	}
+	donConnMgr.SetHandler(entry.handler)
+	if g.handlersStarted {
+		if err = entry.handler.Start(ctx); err != nil {
+			return err
+		}
+	}
+	if err = g.connMgr.AddDON(ctx, donConnMgr); err != nil {
+		if g.handlersStarted {
+			err = multierr.Combine(err, entry.handler.Close())
+		}
+		return err
+	}
+	g.handlers[donConfig.DonId] = entry
+	g.lggr.Infow("added handler", "donID", donConfig.DonId, "handlerName", donConfig.HandlerName, "enabled", entry.enabled)
+	return nil
+}
+
+func (g *gateway) SetHandlerEnabled(donId string, enabled bool) error {
+	g.handlersMu.Lock()
+	defer g.handlersMu.Unlock()
+	entry, ok := g.handlers[donId]
+	if !ok {
+		return fmt.Errorf("%w: DON ID %s", ErrHandlerNotFound, donId)
+	}
+	entry.enabled = enabled
+	g.lggr.Infow("set handler enabled", "donID", donId, "enabled", enabled)
+	return nil
+}
+
+func (g *gateway) SetHandlerPolicy(donId string, policy config.HandlerPolicy) error {
+	g.handlersMu.Lock()
+	defer g.handlersMu.Unlock()
+	entry, ok := g.handlers[donId]
+	if !ok {
+		return fmt.Errorf("%w: DON ID %s", ErrHandlerNotFound, donId)
+	}
+	if err := entry.setPolicy(policy); err != nil {
+		return err
+	}
+	g.lggr.Infow("set handler policy", "donID", donId, "policy", entry.policy)
+	return nil
+}
@@ -2,6 +2,7 @@ package gateway_test
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -154,6 +156,12 @@ func newGatewayWithMockHandler(t *testing.T) (gateway.Gateway, *handler_mocks.Ha
 }
 
 func newSignedRequest(t *testing.T, messageId string, method string, donID string, payload []byte) []byte {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return newSignedRequestFrom(t, privateKey, messageId, method, donID, payload)
+}
+
+func newSignedRequestFrom(t *testing.T, privateKey *ecdsa.PrivateKey, messageId string, method string, donID string, payload []byte) []byte {
 	msg := &api.Message{
 		Body: api.MessageBody{
 			MessageId: messageId,
@@ -162,8 +170,6 @@ func newSignedRequest(t *testing.T, messageId string, method string, donID strin
 			Payload:   payload,
 		},
 	}
-	privateKey, err := crypto.GenerateKey()
-	require.NoError(t, err)
 	require.NoError(t, msg.Sign(privateKey))
 	codec := api.JsonRPCCodec{}
 	rawRequest, err := codec.EncodeRequest(msg)
@@ -245,3 +251,139 @@ func TestGateway_ProcessRequest_HandlerError(t *testing.T) {
 	requireJsonRPCError(t, response, "abcd", -32600, "failure")
 	require.Equal(t, 400, statusCode)
 }
+
+func TestGateway_NewGatewayFromConfig_Policy(t *testing.T) {
+	t.Parallel()
+
+	tomlConfig := buildConfig(`
+[[dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+Disabled = true
+
+[dons.Policy]
+AllowedSenders = ["0x0001020304050607080900010203040506070809"]
+AllowedMethods = ["request"]
+
+[dons.Policy.RateLimit]
+GlobalRPS = 10.0
+GlobalBurst = 10
+PerSenderRPS = 1.0
+PerSenderBurst = 1
+`)
+
+	lggr := logger.TestLogger(t)
+	gw, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	require.NoError(t, err)
+	require.Equal(t, []gateway.HandlerStatus{{
+		DonId:       "my_don",
+		HandlerName: "dummy",
+		Enabled:     false,
+		Policy: config.HandlerPolicy{
+			RateLimit:      &config.HandlerRateLimit{GlobalRPS: 10, GlobalBurst: 10, PerSenderRPS: 1, PerSenderBurst: 1},
+			AllowedSenders: []string{"0x0001020304050607080900010203040506070809"},
+			AllowedMethods: []string{"request"},
+		},
+	}}, gw.Handlers())
+}
+
+func TestGateway_NewGatewayFromConfig_InvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	tomlConfig := buildConfig(`
+[[dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+
+[dons.Policy.RateLimit]
+GlobalRPS = 0.0
+`)
+
+	lggr := logger.TestLogger(t)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	require.ErrorContains(t, err, "invalid policy of DON my_don")
+}
+
+func TestGateway_ProcessRequest_HandlerPolicy(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithMockHandler(t)
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("handled"))
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	t.Run("disabled", func(t *testing.T) {
+		require.NoError(t, gw.SetHandlerEnabled("testDON", false))
+		response, statusCode := gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, "abcd", "request", "testDON", []byte{}))
+		requireJsonRPCError(t, response, "abcd", -32602, "handler disabled")
+		require.Equal(t, 400, statusCode)
+
+		require.NoError(t, gw.SetHandlerEnabled("testDON", true))
+		response, _ = gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, "abcd", "request", "testDON", []byte{}))
+		requireJsonRPCError(t, response, "abcd", -32600, "handled")
+	})
+
+	t.Run("allowed senders", func(t *testing.T) {
+		require.NoError(t, gw.SetHandlerPolicy("testDON", config.HandlerPolicy{AllowedSenders: []string{sender}}))
+		response, _ := gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, "abcd", "request", "testDON", []byte{}))
+		requireJsonRPCError(t, response, "abcd", -32600, "sender not allowed")
+
+		response, _ = gw.ProcessRequest(testutils.Context(t), newSignedRequestFrom(t, privateKey, "abcd", "request", "testDON", []byte{}))
+		requireJsonRPCError(t, response, "abcd", -32600, "handled")
+	})
+
+	t.Run("allowed methods", func(t *testing.T) {
+		require.NoError(t, gw.SetHandlerPolicy("testDON", config.HandlerPolicy{AllowedMethods: []string{"request"}}))
+		response, _ := gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, "abcd", "other", "testDON", []byte{}))
+		requireJsonRPCError(t, response, "abcd", -32600, "method not allowed")
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		rateLimit := &config.HandlerRateLimit{GlobalRPS: 100, GlobalBurst: 100, PerSenderRPS: 0.001, PerSenderBurst: 1}
+		require.NoError(t, gw.SetHandlerPolicy("testDON", config.HandlerPolicy{RateLimit: rateLimit}))
+		response, _ := gw.ProcessRequest(testutils.Context(t), newSignedRequestFrom(t, privateKey, "abcd", "request", "testDON", []byte{}))
+		requireJsonRPCError(t, response, "abcd", -32600, "handled")
+		response, _ = gw.ProcessRequest(testutils.Context(t), newSignedRequestFrom(t, privateKey, "abcd", "request", "testDON", []byte{}))
+		requireJsonRPCError(t, response, "abcd", -32600, "rate-limited")
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		require.Error(t, gw.SetHandlerPolicy("testDON", config.HandlerPolicy{AllowedSenders: []string{"0xnot_an_address"}}))
+		require.ErrorIs(t, gw.SetHandlerPolicy("unknownDON", config.HandlerPolicy{}), gateway.ErrHandlerNotFound)
+		require.ErrorIs(t, gw.SetHandlerEnabled("unknownDON", true), gateway.ErrHandlerNotFound)
+	})
+}
+
+func TestGateway_AddHandler(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	gw, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig("")), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	require.NoError(t, err)
+	servicetest.Run(t, gw)
+
+	donConfig := config.DONConfig{
+		DonId:       "my_don",
+		HandlerName: "dummy",
+		Members:     []config.NodeConfig{{Name: "node one", Address: "0x0001020304050607080900010203040506070809"}},
+		Policy:      config.HandlerPolicy{AllowedMethods: []string{"request"}},
+	}
+	require.NoError(t, gw.AddHandler(testutils.Context(t), donConfig))
+	handlers := gw.Handlers()
+	require.Len(t, handlers, 1)
+	assert.Equal(t, "my_don", handlers[0].DonId)
+	assert.True(t, handlers[0].Enabled)
+	assert.Equal(t, []string{"request"}, handlers[0].Policy.AllowedMethods)
+
+	require.ErrorIs(t, gw.AddHandler(testutils.Context(t), donConfig), gateway.ErrHandlerExists)
+
+	donConfig.DonId = "my_don_2"
+	donConfig.Members = []config.NodeConfig{{Name: "node one", Address: "0xnot_an_address"}}
+	require.ErrorContains(t, gw.AddHandler(testutils.Context(t), donConfig), "invalid node address")
+
+	donConfig.Members = nil
+	donConfig.HandlerName = "no_such_handler"
+	require.ErrorContains(t, gw.AddHandler(testutils.Context(t), donConfig), "unsupported handler type")
+	require.Len(t, gw.Handlers(), 1)
+}
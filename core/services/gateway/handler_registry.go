@@ -0,0 +1,121 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+var (
+	ErrHandlerNotFound = errors.New("handler not found")
+	ErrHandlerExists   = errors.New("handler already exists")
+)
+
+const (
+	msgHandlerDisabled  = "handler disabled"
+	msgSenderNotAllowed = "sender not allowed"
+	msgMethodNotAllowed = "method not allowed"
+	msgRateLimited      = "rate-limited"
+)
+
+// HandlerStatus is the runtime state of the handler of a DON.
+type HandlerStatus struct {
+	DonId       string
+	HandlerName HandlerType
+	Enabled     bool
+	Policy      config.HandlerPolicy
+}
+
+// handlerEntry is the handler of a DON along with the policy enforced by the gateway.
+// Entries are guarded by the handlers mutex of the gateway.
+type handlerEntry struct {
+	handler     handlers.Handler
+	handlerName HandlerType
+	enabled     bool
+	policy      config.HandlerPolicy
+	rateLimiter *hc.RateLimiter
+	senders     map[string]struct{}
+	methods     map[string]struct{}
+}
+
+func newHandlerEntry(handler handlers.Handler, handlerName HandlerType, enabled bool, policy config.HandlerPolicy) (*handlerEntry, error) {
+	entry := &handlerEntry{handler: handler, handlerName: handlerName, enabled: enabled}
+	if err := entry.setPolicy(policy); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// setPolicy replaces the policy of the entry, resetting its rate limits.
+func (e *handlerEntry) setPolicy(policy config.HandlerPolicy) error {
+	var rateLimiter *hc.RateLimiter
+	if policy.RateLimit != nil {
+		var err error
+		rateLimiter, err = hc.NewRateLimiter(hc.RateLimiterConfig{
+			GlobalRPS:      policy.RateLimit.GlobalRPS,
+			GlobalBurst:    policy.RateLimit.GlobalBurst,
+			PerSenderRPS:   policy.RateLimit.PerSenderRPS,
+			PerSenderBurst: policy.RateLimit.PerSenderBurst,
+		})
+		if err != nil {
+			return fmt.Errorf("invalid rate limit: %w", err)
+		}
+	}
+	senders := make(map[string]struct{}, len(policy.AllowedSenders))
+	allowedSenders := make([]string, 0, len(policy.AllowedSenders))
+	for _, sender := range policy.AllowedSenders {
+		if !common.IsHexAddress(sender) {
+			return fmt.Errorf("invalid allowed sender address %s", sender)
+		}
+		sender = strings.ToLower(sender)
+		senders[sender] = struct{}{}
+		allowedSenders = append(allowedSenders, sender)
+	}
+	policy.AllowedSenders = allowedSenders
+	policy.AllowedMethods = append([]string(nil), policy.AllowedMethods...)
+	methods := make(map[string]struct{}, len(policy.AllowedMethods))
+	for _, method := range policy.AllowedMethods {
+		methods[method] = struct{}{}
+	}
+	e.policy, e.rateLimiter, e.senders, e.methods = policy, rateLimiter, senders, methods
+	return nil
+}
+
+// check returns the error with which a validated user message is rejected, if any.
+func (e *handlerEntry) check(msg *api.Message) (api.ErrorCode, string) {
+	if !e.enabled {
+		return api.UnsupportedDONIdError, msgHandlerDisabled
+	}
+	if len(e.senders) > 0 {
+		if _, ok := e.senders[msg.Body.Sender]; !ok {
+			return api.HandlerError, msgSenderNotAllowed
+		}
+	}
+	if len(e.methods) > 0 {
+		if _, ok := e.methods[msg.Body.Method]; !ok {
+			return api.HandlerError, msgMethodNotAllowed
+		}
+	}
+	if e.rateLimiter != nil && !e.rateLimiter.Allow(msg.Body.Sender) {
+		return api.HandlerError, msgRateLimited
+	}
+	return api.NoError, ""
+}
+
+func (e *handlerEntry) status(donId string) HandlerStatus {
+	policy := e.policy
+	policy.AllowedSenders = append([]string(nil), e.policy.AllowedSenders...)
+	policy.AllowedMethods = append([]string(nil), e.policy.AllowedMethods...)
+	if e.policy.RateLimit != nil {
+		rateLimit := *e.policy.RateLimit
+		policy.RateLimit = &rateLimit
+	}
+	return HandlerStatus{DonId: donId, HandlerName: e.handlerName, Enabled: e.enabled, Policy: policy}
+}
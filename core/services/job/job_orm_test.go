@@ -1492,6 +1492,62 @@ func Test_FindPipelineRunIDsByJobID(t *testing.T) {
 	})
 }
 
+func Test_QueryPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, config.Database())
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.Database(), config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db, logger.TestLogger(t), config.Database())
+	orm := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore, config.Database())
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config.Database())
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config.Database())
+	jb, err := webhook.ValidatedWebhookSpec(testspecs.GetWebhookSpecNoBody(uuid.New(), bridge.Name.String(), bridge2.Name.String()), nil)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	running := mustInsertPipelineRun(t, pipelineORM, jb)
+	createdAt := time.Now().Add(-time.Minute)
+	errored := pipeline.Run{
+		PipelineSpecID: jb.PipelineSpecID,
+		State:          pipeline.RunStatusErrored,
+		AllErrors:      pipeline.RunErrors{null.StringFrom("dial tcp: connection refused")},
+		FatalErrors:    pipeline.RunErrors{null.StringFrom("dial tcp: connection refused")},
+		CreatedAt:      createdAt,
+		FinishedAt:     null.TimeFrom(createdAt.Add(30 * time.Second)),
+	}
+	require.NoError(t, pipelineORM.InsertRun(&errored))
+
+	for _, tt := range []struct {
+		name  string
+		query job.PipelineRunsQuery
+		want  []int64
+	}{
+		{"all", job.NewPipelineRunsQuery(), []int64{errored.ID, running.ID}},
+		{"job type", job.NewPipelineRunsQuery().JobType(job.Webhook), []int64{errored.ID, running.ID}},
+		{"other job type", job.NewPipelineRunsQuery().JobType(job.Cron), nil},
+		{"state", job.NewPipelineRunsQuery().State(pipeline.RunStatusRunning), []int64{running.ID}},
+		{"error search", job.NewPipelineRunsQuery().ErrorContains("refused"), []int64{errored.ID}},
+		{"error search mismatch", job.NewPipelineRunsQuery().ErrorContains("timeout"), nil},
+		{"min duration", job.NewPipelineRunsQuery().MinDuration(10 * time.Second), []int64{errored.ID}},
+		{"max duration", job.NewPipelineRunsQuery().MaxDuration(10 * time.Second), nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, count, err := orm.QueryPipelineRuns(tt.query, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), count)
+			var ids []int64
+			for _, r := range runs {
+				ids = append(ids, r.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
 func Test_FindPipelineRunsByIDs(t *testing.T) {
 	t.Parallel()
 
@@ -1713,6 +1769,105 @@ func Test_CountPipelineRunsByJobID(t *testing.T) {
 	})
 }
 
+func Test_FindFeedLiveness(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, config.Database())
+	require.NoError(t, keyStore.OCR().Add(cltest.DefaultOCRKey))
+	require.NoError(t, keyStore.P2P().Add(cltest.DefaultP2PKey))
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.Database(), config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db, logger.TestLogger(t), config.Database())
+	relayExtenders := evmtest.NewChainRelayExtenders(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config, KeyStore: keyStore.Eth()})
+	legacyChains := evmrelay.NewLegacyChainsFromRelayerExtenders(relayExtenders)
+	orm := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore, config.Database())
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config.Database())
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config.Database())
+
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	jb, err := ocr.ValidatedOracleSpecToml(legacyChains,
+		testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			JobID:              uuid.New().String(),
+			TransmitterAddress: address.Hex(),
+			DS1BridgeName:      bridge.Name.String(),
+			DS2BridgeName:      bridge2.Name.String(),
+		}).Toml(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	t.Run("without completed runs", func(t *testing.T) {
+		mustInsertPipelineRun(t, pipelineORM, jb)
+
+		feeds, err2 := orm.FindFeedLiveness(testutils.Context(t))
+		require.NoError(t, err2)
+		require.Len(t, feeds, 1)
+		assert.Equal(t, job.FeedLiveness{
+			Type:       job.OffchainReporting,
+			Network:    "evm",
+			ChainID:    jb.OCROracleSpec.EVMChainID.String(),
+			ContractID: jb.OCROracleSpec.ContractAddress.String(),
+		}, feeds[0])
+	})
+
+	t.Run("with a completed run", func(t *testing.T) {
+		run := mustInsertPipelineRun(t, pipelineORM, jb)
+		finishedAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+		_, err2 := db.Exec(`UPDATE pipeline_runs SET state = $1, finished_at = $2 WHERE id = $3`, pipeline.RunStatusCompleted, finishedAt, run.ID)
+		require.NoError(t, err2)
+
+		feeds, err2 := orm.FindFeedLiveness(testutils.Context(t))
+		require.NoError(t, err2)
+		require.Len(t, feeds, 1)
+		require.NotNil(t, feeds[0].LastRunAt)
+		assert.True(t, finishedAt.Equal(*feeds[0].LastRunAt))
+	})
+}
+
+func Test_JobVersions(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config.Database())
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.Database(), config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db, logger.TestLogger(t), config.Database())
+	orm := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore, config.Database())
+
+	externalJobID := uuid.New()
+	v1 := job.JobVersion{ExternalJobID: externalJobID, Definition: "v1", Status: job.JobVersionLive}
+	require.NoError(t, orm.CreateJobVersion(&v1))
+	assert.Equal(t, int32(1), v1.Version)
+
+	// staging again replaces the staged version
+	v2 := job.JobVersion{ExternalJobID: externalJobID, Definition: "v2", Status: job.JobVersionStaged}
+	require.NoError(t, orm.CreateJobVersion(&v2))
+	v3 := job.JobVersion{ExternalJobID: externalJobID, Definition: "v3", Status: job.JobVersionStaged}
+	require.NoError(t, orm.CreateJobVersion(&v3))
+	assert.Equal(t, int32(2), v3.Version)
+
+	require.NoError(t, orm.SetLiveJobVersion(v3.ID))
+	versions, err := orm.FindJobVersions(externalJobID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "v3", versions[0].Definition)
+	assert.Equal(t, job.JobVersionLive, versions[0].Status)
+	assert.Equal(t, job.JobVersionRetired, versions[1].Status)
+
+	// rolling back
+	require.NoError(t, orm.SetLiveJobVersion(v1.ID))
+	v, err := orm.FindJobVersion(v1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.JobVersionLive, v.Status)
+	v, err = orm.FindJobVersion(v3.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.JobVersionRetired, v.Status)
+}
+
 func mustInsertPipelineRun(t *testing.T, orm pipeline.ORM, j job.Job) pipeline.Run {
 	t.Helper()
 
@@ -115,6 +115,31 @@ func (_m *ORM) CreateJob(jb *job.Job, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// CreateJobVersion provides a mock function with given fields: v, qopts
+func (_m *ORM) CreateJobVersion(v *job.JobVersion, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, v)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.JobVersion, ...pg.QOpt) error); ok {
+		r0 = rf(v, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: id, qopts
 func (_m *ORM) DeleteJob(id int32, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -158,6 +183,36 @@ func (_m *ORM) DismissError(ctx context.Context, errorID int64) error {
 	return r0
 }
 
+// FindFeedLiveness provides a mock function with given fields: ctx
+func (_m *ORM) FindFeedLiveness(ctx context.Context) ([]job.FeedLiveness, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFeedLiveness")
+	}
+
+	var r0 []job.FeedLiveness
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]job.FeedLiveness, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []job.FeedLiveness); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.FeedLiveness)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJob provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJob(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -314,6 +369,78 @@ func (_m *ORM) FindJobTx(ctx context.Context, id int32) (job.Job, error) {
 	return r0, r1
 }
 
+// FindJobVersion provides a mock function with given fields: id, qopts
+func (_m *ORM) FindJobVersion(id int64, qopts ...pg.QOpt) (job.JobVersion, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobVersion")
+	}
+
+	var r0 job.JobVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) (job.JobVersion, error)); ok {
+		return rf(id, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) job.JobVersion); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Get(0).(job.JobVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(id, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindJobVersions provides a mock function with given fields: externalJobID, qopts
+func (_m *ORM) FindJobVersions(externalJobID uuid.UUID, qopts ...pg.QOpt) ([]job.JobVersion, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, externalJobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobVersions")
+	}
+
+	var r0 []job.JobVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, ...pg.QOpt) ([]job.JobVersion, error)); ok {
+		return rf(externalJobID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, ...pg.QOpt) []job.JobVersion); ok {
+		r0 = rf(externalJobID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.JobVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, ...pg.QOpt) error); ok {
+		r1 = rf(externalJobID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJobWithoutSpecErrors provides a mock function with given fields: id
 func (_m *ORM) FindJobWithoutSpecErrors(id int32) (job.Job, error) {
 	ret := _m.Called(id)
@@ -641,6 +768,43 @@ func (_m *ORM) FindTaskResultByRunIDAndTaskName(runID int64, taskName string, qo
 	return r0, r1
 }
 
+// FindTenantJobs provides a mock function with given fields: tenant, offset, limit
+func (_m *ORM) FindTenantJobs(tenant string, offset int, limit int) ([]job.Job, int, error) {
+	ret := _m.Called(tenant, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTenantJobs")
+	}
+
+	var r0 []job.Job
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]job.Job, int, error)); ok {
+		return rf(tenant, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []job.Job); ok {
+		r0 = rf(tenant, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(tenant, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(tenant, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // InsertJob provides a mock function with given fields: _a0, qopts
 func (_m *ORM) InsertJob(_a0 *job.Job, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -728,6 +892,43 @@ func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run,
 	return r0, r1, r2
 }
 
+// QueryPipelineRuns provides a mock function with given fields: query, offset, size
+func (_m *ORM) QueryPipelineRuns(query job.PipelineRunsQuery, offset int, size int) ([]pipeline.Run, int, error) {
+	ret := _m.Called(query, offset, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryPipelineRuns")
+	}
+
+	var r0 []pipeline.Run
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsQuery, int, int) ([]pipeline.Run, int, error)); ok {
+		return rf(query, offset, size)
+	}
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsQuery, int, int) []pipeline.Run); ok {
+		r0 = rf(query, offset, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsQuery, int, int) int); ok {
+		r1 = rf(query, offset, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(job.PipelineRunsQuery, int, int) error); ok {
+		r2 = rf(query, offset, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // RecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -753,6 +954,68 @@ func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) er
 	return r0
 }
 
+// SetLiveJobVersion provides a mock function with given fields: id, qopts
+func (_m *ORM) SetLiveJobVersion(id int64, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLiveJobVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TenantPipelineRuns provides a mock function with given fields: tenant, offset, size
+func (_m *ORM) TenantPipelineRuns(tenant string, offset int, size int) ([]pipeline.Run, int, error) {
+	ret := _m.Called(tenant, offset, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantPipelineRuns")
+	}
+
+	var r0 []pipeline.Run
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]pipeline.Run, int, error)); ok {
+		return rf(tenant, offset, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []pipeline.Run); ok {
+		r0 = rf(tenant, offset, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(tenant, offset, size)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(tenant, offset, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // TryRecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
 	_va := make([]interface{}, len(qopts))
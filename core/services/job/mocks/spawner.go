@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
+
+	relay "github.com/smartcontractkit/chainlink/v2/core/services/relay"
 )
 
 // Spawner is an autogenerated mock type for the Spawner type
@@ -104,6 +106,36 @@ func (_m *Spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// DisableChain provides a mock function with given fields: id
+func (_m *Spawner) DisableChain(id relay.ID) {
+	_m.Called(id)
+}
+
+// EnableChain provides a mock function with given fields: id
+func (_m *Spawner) EnableChain(id relay.ID) {
+	_m.Called(id)
+}
+
+// FailedJobs provides a mock function with given fields:
+func (_m *Spawner) FailedJobs() map[int32]job.StartFailure {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailedJobs")
+	}
+
+	var r0 map[int32]job.StartFailure
+	if rf, ok := ret.Get(0).(func() map[int32]job.StartFailure); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]job.StartFailure)
+		}
+	}
+
+	return r0
+}
+
 // HealthReport provides a mock function with given fields:
 func (_m *Spawner) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -160,6 +192,24 @@ func (_m *Spawner) Ready() error {
 	return r0
 }
 
+// RestartJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) RestartJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -203,6 +253,26 @@ func (_m *Spawner) StartService(ctx context.Context, spec job.Job, qopts ...pg.Q
 	return r0
 }
 
+// WaitingJobs provides a mock function with given fields:
+func (_m *Spawner) WaitingJobs() map[int32][]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitingJobs")
+	}
+
+	var r0 map[int32][]string
+	if rf, ok := ret.Get(0).(func() map[int32][]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32][]string)
+		}
+	}
+
+	return r0
+}
+
 // NewSpawner creates a new instance of Spawner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSpawner(t interface {
@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +26,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/profitability"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/services/signatures/secp256k1"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -43,6 +45,7 @@ const (
 	Keeper                  Type = (Type)(pipeline.KeeperJobType)
 	LegacyGasStationServer  Type = (Type)(pipeline.LegacyGasStationServerJobType)
 	LegacyGasStationSidecar Type = (Type)(pipeline.LegacyGasStationSidecarJobType)
+	LOOP                    Type = (Type)(pipeline.LOOPJobType)
 	OffchainReporting       Type = (Type)(pipeline.OffchainReportingJobType)
 	OffchainReporting2      Type = (Type)(pipeline.OffchainReporting2JobType)
 	Stream                  Type = (Type)(pipeline.StreamJobType)
@@ -65,6 +68,12 @@ func (t Type) SupportsAsync() bool {
 	return supportsAsync[t]
 }
 
+// SupportsShadowPipeline returns true if jobs of the type may have a shadow observation source, run alongside their
+// observation source without affecting their results.
+func (t Type) SupportsShadowPipeline() bool {
+	return supportsShadowPipeline[t]
+}
+
 func (t Type) SchemaVersion() uint32 {
 	return schemaVersions[t]
 }
@@ -81,6 +90,7 @@ var (
 		Keeper:                  false, // observationSource is injected in the upkeep executor
 		LegacyGasStationServer:  false,
 		LegacyGasStationSidecar: false,
+		LOOP:                    false,
 		OffchainReporting2:      false, // bootstrap jobs do not require it
 		OffchainReporting:       false, // bootstrap jobs do not require it
 		Stream:                  true,
@@ -98,12 +108,17 @@ var (
 		Keeper:                  true,
 		LegacyGasStationServer:  false,
 		LegacyGasStationSidecar: false,
+		LOOP:                    false,
 		OffchainReporting2:      false,
 		OffchainReporting:       false,
 		Stream:                  true,
 		VRF:                     true,
 		Webhook:                 true,
 	}
+	supportsShadowPipeline = map[Type]bool{
+		OffchainReporting2: true, // median plugin only
+		OffchainReporting:  true,
+	}
 	schemaVersions = map[Type]uint32{
 		BlockHeaderFeeder:       1,
 		BlockhashStore:          1,
@@ -115,6 +130,7 @@ var (
 		Keeper:                  1,
 		LegacyGasStationServer:  1,
 		LegacyGasStationSidecar: 1,
+		LOOP:                    1,
 		OffchainReporting2:      1,
 		OffchainReporting:       1,
 		Stream:                  1,
@@ -158,17 +174,79 @@ type Job struct {
 	EALSpecID                     *int32
 	LiquidityBalancerSpec         *LiquidityBalancerSpec
 	LiquidityBalancerSpecID       *int32
+	LOOPSpec                      *LOOPSpec
+	LOOPSpecID                    *int32
 	PipelineSpecID                int32
 	PipelineSpec                  *pipeline.Spec
 	JobSpecErrors                 []SpecError
 	Type                          Type
 	SchemaVersion                 uint32
-	GasLimit                      clnull.Uint32 `toml:"gasLimit"`
-	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
+	GasLimit                      clnull.Uint32  `toml:"gasLimit"`
+	ForwardingAllowed             bool           `toml:"forwardingAllowed"`
+	DependsOn                     pq.StringArray `toml:"dependsOn"`
+	DependsOnChains               pq.StringArray `toml:"dependsOnChains"`
 	Name                          null.String
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
-	CreatedAt                     time.Time
+	ShadowPipelineSpecID          *int32
+	ShadowPipelineSpec            *pipeline.Spec
+	ShadowPipeline                pipeline.Pipeline `toml:"shadowObservationSource"`
+	// Tenant is the tenant which owns the job, or null if it is node-wide. It is set by the node, not by the spec.
+	Tenant    null.String `toml:"-"`
+	CreatedAt time.Time
+}
+
+// RelayIDs returns the chains the job runs on, according to its spec, and the chains it depends on. Invalid chain IDs
+// are skipped, since they are reported when the spec is validated.
+func (j Job) RelayIDs() (ids []relay.ID) {
+	add := func(id relay.ID) {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	addEVM := func(chainID *big.Big) {
+		if chainID != nil {
+			add(relay.NewID(relay.EVM, chainID.String()))
+		}
+	}
+	switch {
+	case j.OCROracleSpec != nil:
+		addEVM(j.OCROracleSpec.EVMChainID)
+	case j.OCR2OracleSpec != nil:
+		if id, err := j.OCR2OracleSpec.RelayID(); err == nil {
+			add(id)
+		}
+	case j.BootstrapSpec != nil:
+		spec := j.BootstrapSpec.AsOCR2Spec()
+		if id, err := spec.RelayID(); err == nil {
+			add(id)
+		}
+	case j.DirectRequestSpec != nil:
+		addEVM(j.DirectRequestSpec.EVMChainID)
+	case j.FluxMonitorSpec != nil:
+		addEVM(j.FluxMonitorSpec.EVMChainID)
+	case j.KeeperSpec != nil:
+		addEVM(j.KeeperSpec.EVMChainID)
+	case j.VRFSpec != nil:
+		addEVM(j.VRFSpec.EVMChainID)
+	case j.BlockhashStoreSpec != nil:
+		addEVM(j.BlockhashStoreSpec.EVMChainID)
+	case j.BlockHeaderFeederSpec != nil:
+		addEVM(j.BlockHeaderFeederSpec.EVMChainID)
+	case j.LegacyGasStationServerSpec != nil:
+		addEVM(j.LegacyGasStationServerSpec.EVMChainID)
+	case j.LegacyGasStationSidecarSpec != nil:
+		addEVM(j.LegacyGasStationSidecarSpec.EVMChainID)
+	case j.EALSpec != nil:
+		addEVM(j.EALSpec.EVMChainID)
+	}
+	for _, chain := range j.DependsOnChains {
+		var id relay.ID
+		if err := id.UnmarshalString(chain); err == nil {
+			add(id)
+		}
+	}
+	return
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -203,6 +281,42 @@ func (j *Job) SetID(value string) error {
 	return nil
 }
 
+// JobVersionStatus is the status of a version of a job.
+type JobVersionStatus string
+
+const (
+	// JobVersionStaged is validated, but not running until it is switched to.
+	JobVersionStaged JobVersionStatus = "staged"
+	// JobVersionLive is the version of the running job.
+	JobVersionLive JobVersionStatus = "live"
+	// JobVersionRetired was live before, and can be switched back to.
+	JobVersionRetired JobVersionStatus = "retired"
+)
+
+// JobVersion is a version of the job with ExternalJobID, as defined by its TOML spec.
+type JobVersion struct {
+	ID            int64
+	ExternalJobID uuid.UUID
+	Version       int32
+	Definition    string
+	Status        JobVersionStatus
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// FeedLiveness is the time of the latest completed run of a job serving a feed: an OCR, flux monitor, or OCR2 median
+// or mercury job.
+type FeedLiveness struct {
+	Type       Type
+	Network    string
+	ChainID    string
+	ContractID string
+	// FeedID is set for mercury jobs.
+	FeedID *common.Hash
+	// LastRunAt is nil if the job has not completed a run yet.
+	LastRunAt *time.Time
+}
+
 type SpecError struct {
 	ID          int64
 	JobID       int32
@@ -316,6 +430,8 @@ var ForwardersSupportedPlugins = []types.OCR2PluginType{types.Median, types.DKG,
 
 // OCR2OracleSpec defines the job spec for OCR2 jobs.
 // Relay config is chain specific config for a relay (chain adapter).
+// If P2PV2BootstrappersRegistry is set, the bootstrappers are read from this on-chain registry instead of
+// P2PV2Bootstrappers, and refreshed every P2PV2BootstrappersRefreshInterval.
 type OCR2OracleSpec struct {
 	ID         int32         `toml:"-"`
 	ContractID string        `toml:"contractID"`
@@ -325,6 +441,8 @@ type OCR2OracleSpec struct {
 	ChainID                           string               `toml:"chainID"`
 	RelayConfig                       JSONConfig           `toml:"relayConfig"`
 	P2PV2Bootstrappers                pq.StringArray       `toml:"p2pv2Bootstrappers"`
+	P2PV2BootstrappersRegistry        null.String          `toml:"p2pv2BootstrappersRegistry"`
+	P2PV2BootstrappersRefreshInterval *models.Interval     `toml:"p2pv2BootstrappersRefreshInterval"`
 	OCRKeyBundleID                    null.String          `toml:"ocrKeyBundleID"`
 	MonitoringEndpoint                null.String          `toml:"monitoringEndpoint"`
 	TransmitterID                     null.String          `toml:"transmitterID"`
@@ -418,8 +536,15 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// SignatureSecret is the shared secret the requests of external initiators
+	// are signed with. Signatures are not checked when it is empty.
+	SignatureSecret    string          `json:"-" toml:"-"`
+	SignatureHeader    string          `json:"signatureHeader" toml:"-"`
+	SignatureAlgorithm string          `json:"signatureAlgorithm" toml:"-"`
+	TimestampHeader    string          `json:"timestampHeader" toml:"-"`
+	ReplayWindow       models.Interval `json:"replayWindow" toml:"-"`
+	CreatedAt          time.Time       `json:"createdAt" toml:"-"`
+	UpdatedAt          time.Time       `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -446,11 +571,34 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// CronCatchUpPolicy determines what a cron job does with the occurrences of
+// its schedule missed while the node was down.
+type CronCatchUpPolicy string
+
+const (
+	// CronCatchUpSkip drops missed occurrences; the job resumes with the next one.
+	CronCatchUpSkip CronCatchUpPolicy = "skip"
+	// CronCatchUpRunMissed runs up to MaxCatchUpRuns of the most recent missed
+	// occurrences when the job starts.
+	CronCatchUpRunMissed CronCatchUpPolicy = "runMissed"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID             int32             `toml:"-"`
+	CronSchedule   string            `toml:"schedule"`
+	Timezone       string            `toml:"timezone"`
+	CatchUpPolicy  CronCatchUpPolicy `toml:"catchUpPolicy"`
+	MaxCatchUpRuns uint32            `toml:"maxCatchUpRuns"`
+	CreatedAt      time.Time         `toml:"-"`
+	UpdatedAt      time.Time         `toml:"-"`
+}
+
+// Schedule returns the cron schedule with the spec's timezone applied.
+func (s CronSpec) Schedule() string {
+	if s.Timezone == "" {
+		return s.CronSchedule
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", s.Timezone, s.CronSchedule)
 }
 
 func (s CronSpec) GetID() string {
@@ -493,8 +641,46 @@ type KeeperSpec struct {
 	MinIncomingConfirmations *uint32             `toml:"minIncomingConfirmations"`
 	FromAddress              ethkey.EIP55Address `toml:"fromAddress"`
 	EVMChainID               *big.Big            `toml:"evmChainID"`
-	CreatedAt                time.Time           `toml:"-"`
-	UpdatedAt                time.Time           `toml:"-"`
+	// MaxGasPrice, if set, defers the performs of the job's upkeeps while the estimated gas price exceeds it.
+	MaxGasPrice *assets.Wei `toml:"maxGasPrice"`
+	// UpkeepMaxGasPrices overrides MaxGasPrice for specific upkeeps, keyed by decimal upkeep ID.
+	UpkeepMaxGasPrices UpkeepGasPrices `toml:"upkeepMaxGasPrices"`
+	// Profitability, if set, defers the performs whose estimated gas cost exceeds their expected payment.
+	Profitability *profitability.Config `toml:"profitability"`
+	CreatedAt     time.Time             `toml:"-"`
+	UpdatedAt     time.Time             `toml:"-"`
+}
+
+// MaxGasPriceForUpkeep returns the gas price ceiling of the given upkeep, or nil if it has none.
+func (s *KeeperSpec) MaxGasPriceForUpkeep(upkeepID *big.Big) *assets.Wei {
+	if p, ok := s.UpkeepMaxGasPrices[upkeepID.String()]; ok {
+		return p
+	}
+	return s.MaxGasPrice
+}
+
+// UpkeepGasPrices maps upkeep IDs to gas prices.
+type UpkeepGasPrices map[string]*assets.Wei
+
+// Value returns this instance serialized for database storage.
+func (p UpkeepGasPrices) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return json.Marshal(p)
+}
+
+// Scan reads the database value and returns an instance.
+func (p *UpkeepGasPrices) Scan(value interface{}) error {
+	if value == nil {
+		*p = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, p)
 }
 
 type VRFSpec struct {
@@ -773,6 +959,29 @@ func (s *GatewaySpec) SetID(value string) error {
 	return nil
 }
 
+// LOOPSpec defines the job spec of the job types implemented by LOOP plugins.
+type LOOPSpec struct {
+	ID int32 `toml:"-"`
+	// PluginName is the name the plugin implementing the job is registered with.
+	PluginName   string     `toml:"pluginName"`
+	PluginConfig JSONConfig `toml:"pluginConfig"`
+	CreatedAt    time.Time  `toml:"-"`
+	UpdatedAt    time.Time  `toml:"-"`
+}
+
+func (s LOOPSpec) GetID() string {
+	return fmt.Sprintf("%v", s.ID)
+}
+
+func (s *LOOPSpec) SetID(value string) error {
+	ID, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return err
+	}
+	s.ID = int32(ID)
+	return nil
+}
+
 // EALSpec defines the job spec for the gas station.
 type EALSpec struct {
 	ID int32
@@ -4,6 +4,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 )
 
@@ -71,3 +74,38 @@ func TestOCR2OracleSpec_RelayIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestJob_RelayIDs(t *testing.T) {
+	evm1 := relay.NewID(relay.EVM, "1")
+	solana := relay.NewID(relay.Solana, "mainnet")
+	tests := []struct {
+		name string
+		job  Job
+		want []relay.ID
+	}{
+		{name: "no chain", job: Job{Type: Webhook}},
+		{
+			name: "evm spec",
+			job:  Job{DirectRequestSpec: &DirectRequestSpec{EVMChainID: big.NewI(1)}},
+			want: []relay.ID{evm1},
+		},
+		{
+			name: "ocr2 spec",
+			job:  Job{OCR2OracleSpec: &OCR2OracleSpec{Relay: relay.Solana, ChainID: "mainnet"}},
+			want: []relay.ID{solana},
+		},
+		{
+			name: "dependencies are deduplicated and invalid ones skipped",
+			job: Job{
+				KeeperSpec:      &KeeperSpec{EVMChainID: big.NewI(1)},
+				DependsOnChains: []string{"evm.1", "solana.mainnet", "invalid"},
+			},
+			want: []relay.ID{evm1, solana},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.job.RelayIDs())
+		})
+	}
+}
@@ -49,6 +49,8 @@ type ORM interface {
 	InsertJob(job *Job, qopts ...pg.QOpt) error
 	CreateJob(jb *Job, qopts ...pg.QOpt) error
 	FindJobs(offset, limit int) ([]Job, int, error)
+	// FindTenantJobs is like FindJobs, for the jobs of a tenant.
+	FindTenantJobs(tenant string, offset, limit int) ([]Job, int, error)
 	FindJobTx(ctx context.Context, id int32) (Job, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobByExternalJobID(uuid uuid.UUID, qopts ...pg.QOpt) (Job, error)
@@ -63,6 +65,10 @@ type ORM interface {
 	FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error)
 	Close() error
 	PipelineRuns(jobID *int32, offset, size int) ([]pipeline.Run, int, error)
+	// TenantPipelineRuns is like PipelineRuns, for the runs of the jobs of a tenant.
+	TenantPipelineRuns(tenant string, offset, size int) ([]pipeline.Run, int, error)
+	// QueryPipelineRuns is like PipelineRuns, for the runs matching query.
+	QueryPipelineRuns(query PipelineRunsQuery, offset, size int) ([]pipeline.Run, int, error)
 
 	FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []int64, err error)
 	FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error)
@@ -76,6 +82,18 @@ type ORM interface {
 
 	FindTaskResultByRunIDAndTaskName(runID int64, taskName string, qopts ...pg.QOpt) ([]byte, error)
 	AssertBridgesExist(p pipeline.Pipeline) error
+
+	// CreateJobVersion inserts v as the next version of its job. A staged version replaces the staged version of the
+	// job, if any.
+	CreateJobVersion(v *JobVersion, qopts ...pg.QOpt) error
+	// FindJobVersions returns the versions of the job with externalJobID, latest first.
+	FindJobVersions(externalJobID uuid.UUID, qopts ...pg.QOpt) ([]JobVersion, error)
+	FindJobVersion(id int64, qopts ...pg.QOpt) (JobVersion, error)
+	// SetLiveJobVersion marks the version with id as live, and the version which was live before as retired.
+	SetLiveJobVersion(id int64, qopts ...pg.QOpt) error
+
+	// FindFeedLiveness returns the liveness of the jobs serving feeds, ordered by job ID.
+	FindFeedLiveness(ctx context.Context) ([]FeedLiveness, error)
 }
 
 type ORMConfig interface {
@@ -89,6 +107,7 @@ type orm struct {
 	lggr        logger.SugaredLogger
 	cfg         pg.QConfig
 	bridgeORM   bridges.ORM
+	replica     *pg.ReplicaDB
 }
 
 var _ ORM = (*orm)(nil)
@@ -104,6 +123,21 @@ func NewORM(db *sqlx.DB, pipelineORM pipeline.ORM, bridgeORM bridges.ORM, keySto
 		cfg:         cfg,
 	}
 }
+
+// WithReplica returns a copy of the ORM whose listings of jobs and pipeline runs, as shown by the web UI and API, are
+// served by the replica while it keeps up with the primary. The copy must not be used by services which rely on these
+// listings being up to date.
+func (o *orm) WithReplica(replica *pg.ReplicaDB) *orm {
+	c := *o
+	c.replica = replica
+	return &c
+}
+
+// readQ returns a Q for listings, which may run on the replica.
+func (o *orm) readQ() pg.Q {
+	return o.replica.ReadQ(o.q)
+}
+
 func (o *orm) Close() error {
 	return nil
 }
@@ -144,6 +178,9 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	if err := o.AssertBridgesExist(p); err != nil {
 		return err
 	}
+	if err := o.AssertBridgesExist(jb.ShadowPipeline); err != nil {
+		return errors.Wrap(err, "shadowObservationSource")
+	}
 
 	var jobID int32
 	err := q.Transaction(func(tx pg.Queryer) error {
@@ -283,11 +320,11 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				}
 			}
 
-			sql := `INSERT INTO ocr2_oracle_specs (contract_id, feed_id, relay, relay_config, plugin_type, plugin_config, p2pv2_bootstrappers, ocr_key_bundle_id, transmitter_id,
-					blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations,
+			sql := `INSERT INTO ocr2_oracle_specs (contract_id, feed_id, relay, relay_config, plugin_type, plugin_config, p2pv2_bootstrappers, p2pv2_bootstrappers_registry,
+					p2pv2_bootstrappers_refresh_interval, ocr_key_bundle_id, transmitter_id, blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations,
 					created_at, updated_at)
-			VALUES (:contract_id, :feed_id, :relay, :relay_config, :plugin_type, :plugin_config, :p2pv2_bootstrappers, :ocr_key_bundle_id, :transmitter_id,
-					 :blockchain_timeout, :contract_config_tracker_poll_interval, :contract_config_confirmations,
+			VALUES (:contract_id, :feed_id, :relay, :relay_config, :plugin_type, :plugin_config, :p2pv2_bootstrappers, :p2pv2_bootstrappers_registry,
+					 :p2pv2_bootstrappers_refresh_interval, :ocr_key_bundle_id, :transmitter_id, :blockchain_timeout, :contract_config_tracker_poll_interval, :contract_config_confirmations,
 					NOW(), NOW())
 			RETURNING id;`
 			err = pg.PrepareQueryRowx(tx, sql, &specID, jb.OCR2OracleSpec)
@@ -300,8 +337,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				return errors.New("evm chain id must be defined")
 			}
 			var specID int32
-			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :from_address, :evm_chain_id, NOW(), NOW())
+			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, max_gas_price, upkeep_max_gas_prices, profitability, created_at, updated_at)
+			VALUES (:contract_address, :from_address, :evm_chain_id, :max_gas_price, :upkeep_max_gas_prices, :profitability, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.KeeperSpec); err != nil {
 				return errors.Wrap(err, "failed to create KeeperSpec")
@@ -309,8 +346,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.KeeperSpecID = &specID
 		case Cron:
 			var specID int32
-			sql := `INSERT INTO cron_specs (cron_schedule, created_at, updated_at)
-			VALUES (:cron_schedule, NOW(), NOW())
+			sql := `INSERT INTO cron_specs (cron_schedule, timezone, catch_up_policy, max_catch_up_runs, created_at, updated_at)
+			VALUES (:cron_schedule, :timezone, :catch_up_policy, :max_catch_up_runs, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.CronSpec); err != nil {
 				return errors.Wrap(err, "failed to create CronSpec")
@@ -440,6 +477,15 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				return errors.Wrap(err, "failed to create GatewaySpec for jobSpec")
 			}
 			jb.GatewaySpecID = &specID
+		case LOOP:
+			var specID int32
+			sql := `INSERT INTO loop_specs (plugin_name, plugin_config, created_at, updated_at)
+			VALUES (:plugin_name, :plugin_config, NOW(), NOW())
+			RETURNING id;`
+			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.LOOPSpec); err != nil {
+				return errors.Wrap(err, "failed to create LOOPSpec for jobSpec")
+			}
+			jb.LOOPSpecID = &specID
 		case Stream:
 			// 'stream' type has no associated spec, nothing to do here
 		default:
@@ -453,6 +499,18 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 		jb.PipelineSpecID = pipelineSpecID
 
+		if jb.ShadowPipeline.Source != "" {
+			shadowPipelineSpecID, err2 := o.pipelineORM.CreateSpec(jb.ShadowPipeline, jb.MaxTaskDuration, pg.WithQueryer(tx))
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to create shadow pipeline spec")
+			}
+			jb.ShadowPipelineSpecID = &shadowPipelineSpecID
+		}
+
+		if err = o.assertNoDependencyCycle(jb, tx); err != nil {
+			return err
+		}
+
 		err = o.InsertJob(jb, pg.WithQueryer(tx))
 		jobID = jb.ID
 		return errors.Wrap(err, "failed to insert job")
@@ -518,32 +576,67 @@ func areSendingKeysDefined(jb *Job, keystore keystore.Master) (bool, error) {
 
 func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	query := `INSERT INTO webhook_specs (signature_secret, signature_header, signature_algorithm, timestamp_header, replay_window, created_at, updated_at)
+			VALUES (:signature_secret, :signature_header, :signature_algorithm, :timestamp_header, :replay_window, NOW(), NOW())
 			RETURNING *;`
 	return q.GetNamed(query, webhookSpec, webhookSpec)
 }
 
+// assertNoDependencyCycle checks that none of the jobs the given job depends on, directly or not, depend on it.
+func (o *orm) assertNoDependencyCycle(jb *Job, tx pg.Queryer) error {
+	if len(jb.DependsOn) == 0 {
+		return nil
+	}
+	for i, dependency := range jb.DependsOn {
+		externalJobID, err := uuid.Parse(dependency)
+		if err != nil {
+			return errors.Wrapf(err, "invalid dependency %s", dependency)
+		}
+		jb.DependsOn[i] = externalJobID.String()
+	}
+	var cycle bool
+	err := tx.Get(&cycle, `WITH RECURSIVE dependencies(external_job_id) AS (
+		SELECT unnest($1::text[])
+	UNION
+		SELECT unnest(jobs.depends_on) FROM jobs JOIN dependencies ON jobs.external_job_id::text = dependencies.external_job_id
+	)
+	SELECT EXISTS (SELECT 1 FROM dependencies WHERE external_job_id = $2)`, jb.DependsOn, jb.ExternalJobID.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to check job dependencies")
+	}
+	if cycle {
+		return errors.Errorf("job %s cannot depend on a job which depends on it", jb.ExternalJobID)
+	}
+	return nil
+}
+
 func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	var query string
 
+	if job.DependsOn == nil {
+		job.DependsOn = pq.StringArray{}
+	}
+	if job.DependsOnChains == nil {
+		job.DependsOnChains = pq.StringArray{}
+	}
+
 	// if job has id, emplace otherwise insert with a new id.
 	if job.ID == 0 {
 		query = `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
-				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, loop_spec_id,
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, depends_on, depends_on_chains, shadow_pipeline_spec_id, tenant, created_at)
 		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
-				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
-		        :legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, :loop_spec_id,
+		        :legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :depends_on, :depends_on_chains, :shadow_pipeline_spec_id, :tenant, NOW())
 		RETURNING *;`
 	} else {
 		query = `INSERT INTO jobs (id, pipeline_spec_id, name, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
-			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, loop_spec_id,
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, depends_on, depends_on_chains, shadow_pipeline_spec_id, tenant, created_at)
 		VALUES (:id, :pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
-				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, :loop_spec_id,
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :depends_on, :depends_on_chains, :shadow_pipeline_spec_id, :tenant, NOW())
 		RETURNING *;`
 	}
 	return q.GetNamed(query, job, job)
@@ -561,6 +654,7 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 		WITH deleted_jobs AS (
 			DELETE FROM jobs WHERE id = $1 RETURNING
 				pipeline_spec_id,
+				shadow_pipeline_spec_id,
 				ocr_oracle_spec_id,
 				ocr2_oracle_spec_id,
 				keeper_spec_id,
@@ -572,7 +666,8 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 				blockhash_store_spec_id,
 				bootstrap_spec_id,
 				block_header_feeder_spec_id,
-				gateway_spec_id
+				gateway_spec_id,
+				loop_spec_id
 		),
 		deleted_oracle_specs AS (
 			DELETE FROM ocr_oracle_specs WHERE id IN (SELECT ocr_oracle_spec_id FROM deleted_jobs)
@@ -609,8 +704,13 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 		),
 		deleted_gateway_specs AS (
 			DELETE FROM gateway_specs WHERE id IN (SELECT gateway_spec_id FROM deleted_jobs)
+		),
+		deleted_loop_specs AS (
+			DELETE FROM loop_specs WHERE id IN (SELECT loop_spec_id FROM deleted_jobs)
 		)
-		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)`
+		-- Runs are deleted with their pipeline specs, and their task runs and round stats by the
+		-- delete_pipeline_spec_runs trigger.
+		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs UNION SELECT shadow_pipeline_spec_id FROM deleted_jobs)`
 	res, cancel, err := q.ExecQIter(query, id)
 	defer cancel()
 	if err != nil {
@@ -676,16 +776,112 @@ func (o *orm) FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error) {
 	return *specErr, errors.Wrap(err, "FindSpecError failed")
 }
 
+func (o *orm) CreateJobVersion(v *JobVersion, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.Transaction(func(tx pg.Queryer) error {
+		if v.Status == JobVersionStaged {
+			if _, err := tx.Exec(`DELETE FROM job_versions WHERE external_job_id = $1 AND status = $2`, v.ExternalJobID, JobVersionStaged); err != nil {
+				return errors.Wrap(err, "failed to delete staged job version")
+			}
+		}
+		stmt := `INSERT INTO job_versions (external_job_id, version, definition, status, created_at, updated_at)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3, NOW(), NOW() FROM job_versions WHERE external_job_id = $1
+		RETURNING *`
+		return errors.Wrap(tx.Get(v, stmt, v.ExternalJobID, v.Definition, v.Status), "CreateJobVersion failed")
+	})
+}
+
+func (o *orm) FindJobVersions(externalJobID uuid.UUID, qopts ...pg.QOpt) (versions []JobVersion, err error) {
+	stmt := `SELECT * FROM job_versions WHERE external_job_id = $1 ORDER BY version DESC`
+	err = o.q.WithOpts(qopts...).Select(&versions, stmt, externalJobID)
+	return versions, errors.Wrap(err, "FindJobVersions failed")
+}
+
+func (o *orm) FindJobVersion(id int64, qopts ...pg.QOpt) (v JobVersion, err error) {
+	err = o.q.WithOpts(qopts...).Get(&v, `SELECT * FROM job_versions WHERE id = $1`, id)
+	return v, errors.Wrap(err, "FindJobVersion failed")
+}
+
+func (o *orm) FindFeedLiveness(ctx context.Context) ([]FeedLiveness, error) {
+	var rows []struct {
+		Type            Type
+		Relay           *string
+		ChainID         *string `db:"chain_id"`
+		ContractAddress *ethkey.EIP55Address
+		ContractID      *string      `db:"contract_id"`
+		FeedID          *common.Hash `db:"feed_id"`
+		LastRunAt       *time.Time   `db:"last_run_at"`
+	}
+	stmt := `SELECT jobs.type, ocr2spec.relay,
+	COALESCE(ocrspec.evm_chain_id::text, fmspec.evm_chain_id::text, ocr2spec.relay_config->>'chainID') AS chain_id,
+	COALESCE(ocrspec.contract_address, fmspec.contract_address) AS contract_address,
+	ocr2spec.contract_id, ocr2spec.feed_id,
+	(SELECT MAX(finished_at) FROM pipeline_runs WHERE pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id AND pipeline_runs.state = $1) AS last_run_at
+FROM jobs
+LEFT JOIN ocr_oracle_specs ocrspec ON ocrspec.id = jobs.ocr_oracle_spec_id
+LEFT JOIN flux_monitor_specs fmspec ON fmspec.id = jobs.flux_monitor_spec_id
+LEFT JOIN ocr2_oracle_specs ocr2spec ON ocr2spec.id = jobs.ocr2_oracle_spec_id
+WHERE jobs.type IN ($2, $3) OR (jobs.type = $4 AND ocr2spec.plugin_type IN ($5, $6))
+ORDER BY jobs.id`
+	err := o.readQ().WithOpts(pg.WithParentCtx(ctx)).Select(&rows, stmt, pipeline.RunStatusCompleted, OffchainReporting, FluxMonitor,
+		OffchainReporting2, types.Median, types.Mercury)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindFeedLiveness failed")
+	}
+	feeds := make([]FeedLiveness, len(rows))
+	for i, r := range rows {
+		f := FeedLiveness{Type: r.Type, Network: string(relay.EVM), FeedID: r.FeedID, LastRunAt: r.LastRunAt}
+		if r.Relay != nil {
+			f.Network = *r.Relay
+		}
+		if r.ChainID != nil {
+			f.ChainID = *r.ChainID
+		}
+		if r.ContractAddress != nil {
+			f.ContractID = r.ContractAddress.String()
+		} else if r.ContractID != nil {
+			f.ContractID = *r.ContractID
+		}
+		feeds[i] = f
+	}
+	return feeds, nil
+}
+
+func (o *orm) SetLiveJobVersion(id int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.Transaction(func(tx pg.Queryer) error {
+		var externalJobID uuid.UUID
+		if err := tx.Get(&externalJobID, `SELECT external_job_id FROM job_versions WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return errors.Wrap(err, "failed to find job version")
+		}
+		if _, err := tx.Exec(`UPDATE job_versions SET status = $1, updated_at = NOW() WHERE external_job_id = $2 AND status = $3`,
+			JobVersionRetired, externalJobID, JobVersionLive); err != nil {
+			return errors.Wrap(err, "failed to retire live job version")
+		}
+		_, err := tx.Exec(`UPDATE job_versions SET status = $1, updated_at = NOW() WHERE id = $2`, JobVersionLive, id)
+		return errors.Wrap(err, "failed to set live job version")
+	})
+}
+
 func (o *orm) FindJobs(offset, limit int) (jobs []Job, count int, err error) {
-	err = o.q.Transaction(func(tx pg.Queryer) error {
-		sql := `SELECT count(*) FROM jobs;`
-		err = tx.QueryRowx(sql).Scan(&count)
+	return o.findJobs("", offset, limit)
+}
+
+func (o *orm) FindTenantJobs(tenant string, offset, limit int) ([]Job, int, error) {
+	return o.findJobs(tenant, offset, limit)
+}
+
+// findJobs returns the jobs of tenant, or all jobs if tenant is empty.
+func (o *orm) findJobs(tenant string, offset, limit int) (jobs []Job, count int, err error) {
+	err = o.readQ().Transaction(func(tx pg.Queryer) error {
+		sql := `SELECT count(*) FROM jobs WHERE $1 = '' OR tenant = $1;`
+		err = tx.QueryRowx(sql, tenant).Scan(&count)
 		if err != nil {
 			return err
 		}
 
-		sql = `SELECT * FROM jobs ORDER BY created_at DESC, id DESC OFFSET $1 LIMIT $2;`
-		err = tx.Select(&jobs, sql, offset, limit)
+		sql = `SELECT * FROM jobs WHERE $1 = '' OR tenant = $1 ORDER BY created_at DESC, id DESC OFFSET $2 LIMIT $3;`
+		err = tx.Select(&jobs, sql, tenant, offset, limit)
 		if err != nil {
 			return err
 		}
@@ -909,7 +1105,7 @@ func (o *orm) findJob(jb *Job, col string, arg interface{}, qopts ...pg.QOpt) er
 
 func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		query := `SELECT jobs.id, dot_dag_source FROM jobs JOIN pipeline_specs ON pipeline_specs.id = jobs.pipeline_spec_id WHERE dot_dag_source ILIKE '%' || $1 || '%' ORDER BY id`
+		query := `SELECT jobs.id, dot_dag_source FROM jobs JOIN pipeline_specs ON pipeline_specs.id IN (jobs.pipeline_spec_id, jobs.shadow_pipeline_spec_id) WHERE dot_dag_source ILIKE '%' || $1 || '%' ORDER BY id`
 		var rows *sqlx.Rows
 		rows, err = tx.Queryx(query, name)
 		if err != nil {
@@ -964,7 +1160,7 @@ func (o *orm) PipelineRunsByJobsIDs(ids []int32) (runs []pipeline.Run, err error
 	return runs, errors.Wrap(err, "PipelineRunsByJobsIDs failed")
 }
 
-func (o *orm) loadPipelineRunIDs(jobID *int32, offset, limit int, tx pg.Queryer) (ids []int64, err error) {
+func (o *orm) loadPipelineRunIDs(filter string, offset, limit int, tx pg.Queryer) (ids []int64, err error) {
 	lggr := logger.Sugared(o.lggr)
 
 	var res sql.NullInt64
@@ -977,13 +1173,6 @@ func (o *orm) loadPipelineRunIDs(jobID *int32, offset, limit int, tx pg.Queryer)
 	}
 	maxID := res.Int64
 
-	var filter string
-	if jobID != nil {
-		filter = fmt.Sprintf("JOIN jobs USING(pipeline_spec_id) WHERE jobs.id = %d AND ", *jobID)
-	} else {
-		filter = "WHERE "
-	}
-
 	stmt := fmt.Sprintf(`SELECT p.id FROM pipeline_runs AS p %s p.id >= $3 AND p.id <= $4
 			ORDER BY p.id DESC OFFSET $1 LIMIT $2`, filter)
 
@@ -1064,8 +1253,8 @@ func (o *orm) FindTaskResultByRunIDAndTaskName(runID int64, taskName string, qop
 
 // FindPipelineRunIDsByJobID fetches the ids of pipeline runs for a job.
 func (o *orm) FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []int64, err error) {
-	err = o.q.Transaction(func(tx pg.Queryer) error {
-		ids, err = o.loadPipelineRunIDs(&jobID, offset, limit, tx)
+	err = o.readQ().Transaction(func(tx pg.Queryer) error {
+		ids, err = o.loadPipelineRunIDs(NewPipelineRunsQuery().Job(jobID).filter(), offset, limit, tx)
 		return err
 	})
 	return ids, errors.Wrap(err, "FindPipelineRunIDsByJobID failed")
@@ -1123,7 +1312,7 @@ WHERE id = $1
 
 // CountPipelineRunsByJobID returns the total number of pipeline runs for a job.
 func (o *orm) CountPipelineRunsByJobID(jobID int32) (count int32, err error) {
-	err = o.q.Transaction(func(tx pg.Queryer) error {
+	err = o.readQ().Transaction(func(tx pg.Queryer) error {
 		stmt := "SELECT COUNT(*) FROM pipeline_runs JOIN jobs USING (pipeline_spec_id) WHERE jobs.id = $1"
 		if err = tx.Get(&count, stmt, jobID); err != nil {
 			return errors.Wrap(err, "error counting runs")
@@ -1159,18 +1348,27 @@ func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
 // PipelineRuns returns pipeline runs for a job, with spec and taskruns loaded, latest first
 // If jobID is nil, returns all pipeline runs
 func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run, count int, err error) {
-	var filter string
+	q := NewPipelineRunsQuery()
 	if jobID != nil {
-		filter = fmt.Sprintf("JOIN jobs USING(pipeline_spec_id) WHERE jobs.id = %d", *jobID)
+		q = q.Job(*jobID)
 	}
-	err = o.q.Transaction(func(tx pg.Queryer) error {
-		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs %s`, filter)
+	return o.QueryPipelineRuns(q, offset, size)
+}
+
+func (o *orm) TenantPipelineRuns(tenant string, offset, size int) (runs []pipeline.Run, count int, err error) {
+	return o.QueryPipelineRuns(NewPipelineRunsQuery().Tenant(tenant), offset, size)
+}
+
+func (o *orm) QueryPipelineRuns(query PipelineRunsQuery, offset, size int) (runs []pipeline.Run, count int, err error) {
+	filter := query.filter()
+	err = o.readQ().Transaction(func(tx pg.Queryer) error {
+		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs AS p %s TRUE`, filter)
 		if err = tx.QueryRowx(sql).Scan(&count); err != nil {
 			return errors.Wrap(err, "error counting runs")
 		}
 
 		var ids []int64
-		ids, err = o.loadPipelineRunIDs(jobID, offset, size, tx)
+		ids, err = o.loadPipelineRunIDs(filter, offset, size, tx)
 		runs, err = o.loadPipelineRunsByID(ids, tx)
 
 		return err
@@ -1240,6 +1438,7 @@ func LoadAllJobsTypes(tx pg.Queryer, jobs []Job) error {
 func LoadAllJobTypes(tx pg.Queryer, job *Job) error {
 	return multierr.Combine(
 		loadJobType(tx, job, "PipelineSpec", "pipeline_specs", &job.PipelineSpecID),
+		loadJobType(tx, job, "ShadowPipelineSpec", "pipeline_specs", job.ShadowPipelineSpecID),
 		loadJobType(tx, job, "FluxMonitorSpec", "flux_monitor_specs", job.FluxMonitorSpecID),
 		loadJobType(tx, job, "DirectRequestSpec", "direct_request_specs", job.DirectRequestSpecID),
 		loadJobType(tx, job, "OCROracleSpec", "ocr_oracle_specs", job.OCROracleSpecID),
@@ -1254,6 +1453,7 @@ func LoadAllJobTypes(tx pg.Queryer, job *Job) error {
 		loadJobType(tx, job, "LegacyGasStationSidecarSpec", "legacy_gas_station_sidecar_specs", job.LegacyGasStationSidecarSpecID),
 		loadJobType(tx, job, "BootstrapSpec", "bootstrap_specs", job.BootstrapSpecID),
 		loadJobType(tx, job, "GatewaySpec", "gateway_specs", job.GatewaySpecID),
+		loadJobType(tx, job, "LOOPSpec", "loop_specs", job.LOOPSpecID),
 	)
 }
 
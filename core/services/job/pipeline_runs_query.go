@@ -0,0 +1,149 @@
+package job
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// TaskRunStatus is the status of a task run, which pipeline runs can be filtered by.
+type TaskRunStatus string
+
+const (
+	TaskRunStatusRunning   TaskRunStatus = "running"
+	TaskRunStatusCompleted TaskRunStatus = "completed"
+	TaskRunStatusErrored   TaskRunStatus = "errored"
+)
+
+// PipelineRunsQuery filters pipeline runs. The zero value matches all runs, and the filters are set with its
+// methods, which can be chained:
+//
+//	NewPipelineRunsQuery().JobType(Webhook).ErrorContains("timeout").MinDuration(time.Minute)
+type PipelineRunsQuery struct {
+	jobID       *int32
+	tenant      string
+	jobType     Type
+	state       pipeline.RunStatus
+	errorSearch string
+	minDuration time.Duration
+	maxDuration time.Duration
+	taskType    pipeline.TaskType
+	taskStatus  TaskRunStatus
+}
+
+// NewPipelineRunsQuery returns a query matching all pipeline runs.
+func NewPipelineRunsQuery() PipelineRunsQuery {
+	return PipelineRunsQuery{}
+}
+
+// Job filters the runs of the job with id.
+func (q PipelineRunsQuery) Job(id int32) PipelineRunsQuery {
+	q.jobID = &id
+	return q
+}
+
+// Tenant filters the runs of the jobs of tenant.
+func (q PipelineRunsQuery) Tenant(tenant string) PipelineRunsQuery {
+	q.tenant = tenant
+	return q
+}
+
+// JobType filters the runs of jobs of type t.
+func (q PipelineRunsQuery) JobType(t Type) PipelineRunsQuery {
+	q.jobType = t
+	return q
+}
+
+// State filters the runs in state.
+func (q PipelineRunsQuery) State(state pipeline.RunStatus) PipelineRunsQuery {
+	q.state = state
+	return q
+}
+
+// ErrorContains filters the runs with errors matching the full-text search, like "timeout" or "connection refused".
+func (q PipelineRunsQuery) ErrorContains(search string) PipelineRunsQuery {
+	q.errorSearch = search
+	return q
+}
+
+// MinDuration filters the finished runs which took at least d.
+func (q PipelineRunsQuery) MinDuration(d time.Duration) PipelineRunsQuery {
+	q.minDuration = d
+	return q
+}
+
+// MaxDuration filters the finished runs which took at most d.
+func (q PipelineRunsQuery) MaxDuration(d time.Duration) PipelineRunsQuery {
+	q.maxDuration = d
+	return q
+}
+
+// Task filters the runs with a task run of type taskType, if set, in status, if set.
+func (q PipelineRunsQuery) Task(taskType pipeline.TaskType, status TaskRunStatus) PipelineRunsQuery {
+	q.taskType = taskType
+	q.taskStatus = status
+	return q
+}
+
+// filter returns the clause which filters pipeline runs, aliased p, to be completed with the conditions of the
+// caller. The values are quoted literals, since the caller binds its own parameters.
+func (q PipelineRunsQuery) filter() string {
+	var conds []string
+	join := q.jobID != nil || q.tenant != "" || q.jobType != ""
+	if q.jobID != nil {
+		conds = append(conds, fmt.Sprintf("jobs.id = %d", *q.jobID))
+	}
+	if q.tenant != "" {
+		conds = append(conds, fmt.Sprintf("jobs.tenant = %s", pq.QuoteLiteral(q.tenant)))
+	}
+	if q.jobType != "" {
+		conds = append(conds, fmt.Sprintf("jobs.type = %s", pq.QuoteLiteral(string(q.jobType))))
+	}
+	if q.state != "" {
+		conds = append(conds, fmt.Sprintf("p.state = %s", pq.QuoteLiteral(string(q.state))))
+	}
+	if q.errorSearch != "" {
+		// matches idx_pipeline_runs_all_errors_search
+		conds = append(conds, fmt.Sprintf(`jsonb_to_tsvector('simple', p.all_errors, '["string"]') @@ plainto_tsquery('simple', %s)`, pq.QuoteLiteral(q.errorSearch)))
+	}
+	if q.minDuration > 0 || q.maxDuration > 0 {
+		// matches idx_pipeline_runs_duration
+		conds = append(conds, "p.finished_at IS NOT NULL")
+		if q.minDuration > 0 {
+			conds = append(conds, fmt.Sprintf("p.finished_at - p.created_at >= interval '%d microseconds'", q.minDuration.Microseconds()))
+		}
+		if q.maxDuration > 0 {
+			conds = append(conds, fmt.Sprintf("p.finished_at - p.created_at <= interval '%d microseconds'", q.maxDuration.Microseconds()))
+		}
+	}
+	if q.taskType != "" || q.taskStatus != "" {
+		taskConds := []string{"t.pipeline_run_id = p.id"}
+		if q.taskType != "" {
+			taskConds = append(taskConds, fmt.Sprintf("t.type = %s", pq.QuoteLiteral(string(q.taskType))))
+		}
+		switch q.taskStatus {
+		case TaskRunStatusRunning:
+			taskConds = append(taskConds, "t.finished_at IS NULL")
+		case TaskRunStatusCompleted:
+			taskConds = append(taskConds, "t.finished_at IS NOT NULL AND t.error IS NULL")
+		case TaskRunStatusErrored:
+			taskConds = append(taskConds, "t.error IS NOT NULL")
+		}
+		conds = append(conds, fmt.Sprintf("EXISTS (SELECT 1 FROM pipeline_task_runs AS t WHERE %s)", strings.Join(taskConds, " AND ")))
+	}
+
+	var sb strings.Builder
+	if join {
+		sb.WriteString("JOIN jobs USING(pipeline_spec_id) ")
+	}
+	sb.WriteString("WHERE ")
+	for _, c := range conds {
+		sb.WriteString(c)
+		sb.WriteString(" AND ")
+	}
+	return sb.String()
+}
@@ -0,0 +1,38 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestPipelineRunsQuery_filter(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name  string
+		query PipelineRunsQuery
+		want  string
+	}{
+		{"all", NewPipelineRunsQuery(), "WHERE "},
+		{"job", NewPipelineRunsQuery().Job(7), "JOIN jobs USING(pipeline_spec_id) WHERE jobs.id = 7 AND "},
+		{"tenant", NewPipelineRunsQuery().Tenant("o'neil"), "JOIN jobs USING(pipeline_spec_id) WHERE jobs.tenant = 'o''neil' AND "},
+		{"job type and state", NewPipelineRunsQuery().JobType(Webhook).State(pipeline.RunStatusErrored),
+			"JOIN jobs USING(pipeline_spec_id) WHERE jobs.type = 'webhook' AND p.state = 'errored' AND "},
+		{"error search", NewPipelineRunsQuery().ErrorContains("connection refused"),
+			`WHERE jsonb_to_tsvector('simple', p.all_errors, '["string"]') @@ plainto_tsquery('simple', 'connection refused') AND `},
+		{"durations", NewPipelineRunsQuery().MinDuration(time.Second).MaxDuration(time.Minute),
+			"WHERE p.finished_at IS NOT NULL AND p.finished_at - p.created_at >= interval '1000000 microseconds' AND p.finished_at - p.created_at <= interval '60000000 microseconds' AND "},
+		{"task", NewPipelineRunsQuery().Task(pipeline.TaskTypeHTTP, TaskRunStatusErrored),
+			"WHERE EXISTS (SELECT 1 FROM pipeline_task_runs AS t WHERE t.pipeline_run_id = p.id AND t.type = 'http' AND t.error IS NOT NULL) AND "},
+		{"task status", NewPipelineRunsQuery().Task("", TaskRunStatusRunning),
+			"WHERE EXISTS (SELECT 1 FROM pipeline_task_runs AS t WHERE t.pipeline_run_id = p.id AND t.finished_at IS NULL) AND "},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.query.filter())
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package job
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/policy"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// ErrPolicyViolation is returned when a job spec violates a policy rule with the error severity.
+var ErrPolicyViolation = errors.New("job spec violates policy")
+
+// PolicyFacts returns the values of the policy fields of jb.
+func PolicyFacts(jb Job) policy.Facts {
+	facts := policy.Facts{
+		"type":              string(jb.Type),
+		"schemaVersion":     int64(jb.SchemaVersion),
+		"forwardingAllowed": jb.ForwardingAllowed,
+	}
+	if jb.Name.Valid {
+		facts["name"] = jb.Name.String
+	}
+	if jb.GasLimit.Valid {
+		facts["gasLimit"] = int64(jb.GasLimit.Uint32)
+	}
+	if d := jb.MaxTaskDuration.Duration(); d > 0 {
+		facts["maxTaskDuration"] = d
+	}
+
+	bridges, tasks := []string{}, []string{}
+	for _, t := range jb.Pipeline.Tasks {
+		tasks = append(tasks, string(t.Type()))
+		if bt, ok := t.(*pipeline.BridgeTask); ok {
+			bridges = append(bridges, bt.Name)
+		}
+	}
+	facts["bridges"] = bridges
+	facts["tasks"] = tasks
+	return facts
+}
+
+// CheckPolicy returns the violations of rules by jb. The error wraps ErrPolicyViolation if any rule with the error
+// severity is violated.
+func CheckPolicy(rules []config.JobPolicyRule, jb Job) ([]policy.Violation, error) {
+	var prules []policy.Rule
+	for _, r := range rules {
+		expr, err := policy.Parse(r.Expr())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid policy rule %s", r.Name())
+		}
+		prules = append(prules, policy.Rule{
+			Name:     r.Name(),
+			Expr:     expr,
+			Severity: policy.Severity(r.Severity()),
+			JobTypes: r.JobTypes(),
+		})
+	}
+
+	violations := policy.Check(prules, PolicyFacts(jb))
+	var msgs []string
+	for _, v := range violations {
+		if v.Severity == policy.SeverityError {
+			msgs = append(msgs, v.Message)
+		}
+	}
+	if len(msgs) > 0 {
+		return violations, fmt.Errorf("%w: %s", ErrPolicyViolation, strings.Join(msgs, "; "))
+	}
+	return violations, nil
+}
@@ -0,0 +1,359 @@
+// Package policy implements the rules which job specs are checked against when jobs are created. A rule compares a
+// field of the job with a value:
+//
+//	gasLimit < 500000
+//	maxTaskDuration exists
+//	maxTaskDuration <= 30s
+//	bridges in ['coingecko', 'coinmarketcap']
+//	type not in ['webhook']
+//
+// A comparison with a field which the job spec does not set is satisfied, so fields which must be set are required
+// with exists.
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// Kind is the type of the value of a field.
+type Kind int
+
+const (
+	KindString Kind = iota
+	KindNumber
+	KindDuration
+	KindBool
+	// KindList is a list of strings. It is only compared with in and not in.
+	KindList
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindNumber:
+		return "number"
+	case KindDuration:
+		return "duration"
+	case KindBool:
+		return "bool"
+	case KindList:
+		return "list"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Fields are the fields of a job which rules can check.
+var Fields = map[string]Kind{
+	"type":              KindString,
+	"name":              KindString,
+	"schemaVersion":     KindNumber,
+	"gasLimit":          KindNumber,
+	"maxTaskDuration":   KindDuration,
+	"forwardingAllowed": KindBool,
+	// bridges are the names of the bridges of the bridge tasks of the pipeline.
+	"bridges": KindList,
+	// tasks are the types of the tasks of the pipeline.
+	"tasks": KindList,
+}
+
+// Facts are the values of the Fields of a job: a string, int64, time.Duration, bool or []string depending on the
+// Kind. Fields which are not set by the job spec are missing.
+type Facts map[string]interface{}
+
+// Expr is a parsed rule expression.
+type Expr struct {
+	src   string
+	field string
+	op    string
+	value interface{}
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Parse parses the rule expression s, and checks that its field is one of Fields and can be compared with its value.
+func Parse(s string) (*Expr, error) {
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) < 2 {
+		return nil, errors.Errorf("invalid expression %q: must be a field, an operator and a value, or a field followed by exists", s)
+	}
+	e := &Expr{src: strings.TrimSpace(s), field: toks[0]}
+	kind, ok := Fields[e.field]
+	if !ok {
+		return nil, errors.Errorf("unknown field %q: must be one of: %s", e.field, fieldNames())
+	}
+
+	e.op, toks = toks[1], toks[2:]
+	if e.op == "not" && len(toks) > 0 && toks[0] == "in" {
+		e.op, toks = "not in", toks[1:]
+	}
+	switch e.op {
+	case "exists":
+		if len(toks) > 0 {
+			return nil, errors.Errorf("invalid expression %q: unexpected %q after exists", s, toks[0])
+		}
+		return e, nil
+	case "in", "not in":
+		if kind != KindString && kind != KindList {
+			return nil, errors.Errorf("invalid expression %q: %s is a %s, only strings and lists can be compared with %s", s, e.field, kind, e.op)
+		}
+		e.value, err = parseList(toks)
+	case "<", "<=", ">", ">=":
+		if kind != KindNumber && kind != KindDuration {
+			return nil, errors.Errorf("invalid expression %q: %s is a %s, only numbers and durations can be compared with %s", s, e.field, kind, e.op)
+		}
+		e.value, err = parseValue(kind, toks)
+	case "==", "!=":
+		if kind == KindList {
+			return nil, errors.Errorf("invalid expression %q: %s is a list, lists can only be compared with in and not in", s, e.field)
+		}
+		e.value, err = parseValue(kind, toks)
+	default:
+		return nil, errors.Errorf("invalid expression %q: unknown operator %q", s, e.op)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid expression %q", s)
+	}
+	return e, nil
+}
+
+func fieldNames() string {
+	names := make([]string, 0, len(Fields))
+	for name := range Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func parseValue(kind Kind, toks []string) (interface{}, error) {
+	if len(toks) != 1 {
+		return nil, errors.New("must be compared with a single value")
+	}
+	tok := toks[0]
+	switch kind {
+	case KindString:
+		return unquote(tok)
+	case KindNumber:
+		n, err := strconv.ParseInt(tok, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid number %q", tok)
+		}
+		return n, nil
+	case KindDuration:
+		d, err := time.ParseDuration(tok)
+		if err != nil {
+			return nil, errors.Errorf("invalid duration %q", tok)
+		}
+		return d, nil
+	case KindBool:
+		b, err := strconv.ParseBool(tok)
+		if err != nil {
+			return nil, errors.Errorf("invalid bool %q", tok)
+		}
+		return b, nil
+	}
+	return nil, errors.Errorf("unsupported kind %s", kind)
+}
+
+func parseList(toks []string) ([]string, error) {
+	if len(toks) < 2 || toks[0] != "[" || toks[len(toks)-1] != "]" {
+		return nil, errors.New("must be compared with a list like ['a', 'b']")
+	}
+	var list []string
+	for i, tok := range toks[1 : len(toks)-1] {
+		if i%2 == 1 {
+			if tok != "," {
+				return nil, errors.Errorf("expected , in list, got %q", tok)
+			}
+			continue
+		}
+		s, err := unquote(tok)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+func unquote(tok string) (string, error) {
+	if len(tok) < 2 || (tok[0] != '\'' && tok[0] != '"') || tok[len(tok)-1] != tok[0] {
+		return "", errors.Errorf("invalid string %q: must be quoted", tok)
+	}
+	return tok[1 : len(tok)-1], nil
+}
+
+// lex splits s into names, operators, quoted strings and list punctuation.
+func lex(s string) (toks []string, err error) {
+	for i := 0; i < len(s); {
+		ch := s[i]
+		switch {
+		case unicode.IsSpace(rune(ch)):
+			i++
+		case ch == '[' || ch == ']' || ch == ',':
+			toks = append(toks, string(ch))
+			i++
+		case ch == '\'' || ch == '"':
+			end := strings.IndexByte(s[i+1:], ch)
+			if end < 0 {
+				return nil, errors.Errorf("invalid expression %q: unterminated string", s)
+			}
+			toks = append(toks, s[i:i+end+2])
+			i += end + 2
+		case strings.ContainsRune("<>=!", rune(ch)):
+			j := i + 1
+			if j < len(s) && s[j] == '=' {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		default:
+			j := i
+			for j < len(s) && !unicode.IsSpace(rune(s[j])) && !strings.ContainsRune("[],'\"<>=!", rune(s[j])) {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+// Eval returns true if the facts satisfy the expression.
+func (e *Expr) Eval(facts Facts) bool {
+	v, ok := facts[e.field]
+	if e.op == "exists" {
+		return ok
+	}
+	if !ok {
+		return true
+	}
+
+	switch e.op {
+	case "in", "not in":
+		allowed := e.value.([]string)
+		var values []string
+		switch v := v.(type) {
+		case string:
+			values = []string{v}
+		case []string:
+			values = v
+		}
+		for _, s := range values {
+			if contains(allowed, s) == (e.op == "not in") {
+				return false
+			}
+		}
+		return true
+	case "==":
+		return v == e.value
+	case "!=":
+		return v != e.value
+	}
+
+	cmp := compare(v, e.value)
+	switch e.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+func compare(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	case time.Duration:
+		b := b.(time.Duration)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	}
+	return 0
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Severity is what happens when a rule is violated.
+type Severity string
+
+const (
+	// SeverityError rejects the job spec.
+	SeverityError Severity = "error"
+	// SeverityWarning only reports the violation.
+	SeverityWarning Severity = "warning"
+)
+
+// Rule is a named expression which job specs must satisfy.
+type Rule struct {
+	Name     string
+	Expr     *Expr
+	Severity Severity
+	// JobTypes restricts the rule to jobs of these types, or applies it to all jobs if empty.
+	JobTypes []string
+}
+
+// Violation is a rule which a job spec does not satisfy.
+type Violation struct {
+	Rule     string
+	Severity Severity
+	Message  string
+}
+
+func (v Violation) Error() string {
+	return v.Message
+}
+
+// Check returns the violations of the rules by the facts of a job.
+func Check(rules []Rule, facts Facts) (violations []Violation) {
+	jobType, _ := facts["type"].(string)
+	for _, r := range rules {
+		if len(r.JobTypes) > 0 && !contains(r.JobTypes, jobType) {
+			continue
+		}
+		if r.Expr.Eval(facts) {
+			continue
+		}
+		msg := fmt.Sprintf("rule %s: %s is not satisfied", r.Name, r.Expr)
+		if v, ok := facts[r.Expr.field]; ok {
+			msg += fmt.Sprintf(" (%s is %v)", r.Expr.field, v)
+		} else {
+			msg += fmt.Sprintf(" (%s is not set)", r.Expr.field)
+		}
+		violations = append(violations, Violation{Rule: r.Name, Severity: r.Severity, Message: msg})
+	}
+	return
+}
@@ -0,0 +1,130 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		expr string
+		err  string
+	}{
+		{expr: "gasLimit < 500000"},
+		{expr: "gasLimit>=1"},
+		{expr: "maxTaskDuration exists"},
+		{expr: "maxTaskDuration <= 30s"},
+		{expr: "bridges in ['coingecko', \"coinmarketcap\"]"},
+		{expr: "type not in ['webhook']"},
+		{expr: "forwardingAllowed == false"},
+		{expr: "name != 'test'"},
+		{expr: "gasLimit", err: "must be a field, an operator and a value"},
+		{expr: "gas < 1", err: `unknown field "gas"`},
+		{expr: "gasLimit ~ 1", err: `unknown operator "~"`},
+		{expr: "gasLimit < lots", err: `invalid number "lots"`},
+		{expr: "gasLimit < 1 2", err: "must be compared with a single value"},
+		{expr: "maxTaskDuration < 30", err: `invalid duration "30"`},
+		{expr: "bridges == 'a'", err: "lists can only be compared with in and not in"},
+		{expr: "gasLimit in ['1']", err: "only strings and lists can be compared with in"},
+		{expr: "name < 'a'", err: "only numbers and durations can be compared with <"},
+		{expr: "bridges in 'a'", err: "must be compared with a list"},
+		{expr: "bridges in ['a' 'b']", err: "expected , in list"},
+		{expr: "name == test", err: "must be quoted"},
+		{expr: "name == 'test", err: "unterminated string"},
+		{expr: "maxTaskDuration exists 1", err: `unexpected "1" after exists`},
+	} {
+		t.Run(tt.expr, func(t *testing.T) {
+			e, err := Parse(tt.expr)
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expr, e.String())
+		})
+	}
+}
+
+func TestExpr_Eval(t *testing.T) {
+	t.Parallel()
+
+	facts := Facts{
+		"type":              "directrequest",
+		"schemaVersion":     int64(1),
+		"gasLimit":          int64(300000),
+		"forwardingAllowed": true,
+		"maxTaskDuration":   10 * time.Second,
+		"bridges":           []string{"coingecko", "coinmarketcap"},
+		"tasks":             []string{"bridge", "jsonparse"},
+	}
+	for _, tt := range []struct {
+		expr string
+		want bool
+	}{
+		{"gasLimit < 500000", true},
+		{"gasLimit < 300000", false},
+		{"gasLimit <= 300000", true},
+		{"gasLimit > 300000", false},
+		{"gasLimit >= 300000", true},
+		{"gasLimit == 300000", true},
+		{"gasLimit != 300000", false},
+		{"maxTaskDuration exists", true},
+		{"maxTaskDuration <= 30s", true},
+		{"maxTaskDuration > 30s", false},
+		{"name exists", false},
+		{"name == 'test'", true},
+		{"forwardingAllowed == false", false},
+		{"type in ['directrequest', 'webhook']", true},
+		{"type not in ['directrequest']", false},
+		{"bridges in ['coingecko', 'coinmarketcap', 'kaiko']", true},
+		{"bridges in ['coingecko']", false},
+		{"tasks not in ['http']", true},
+		{"tasks not in ['http', 'bridge']", false},
+	} {
+		t.Run(tt.expr, func(t *testing.T) {
+			e, err := Parse(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, e.Eval(facts))
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	mustParse := func(s string) *Expr {
+		e, err := Parse(s)
+		require.NoError(t, err)
+		return e
+	}
+	rules := []Rule{
+		{Name: "gas-limit", Expr: mustParse("gasLimit < 500000"), Severity: SeverityError, JobTypes: []string{"directrequest"}},
+		{Name: "max-task-duration", Expr: mustParse("maxTaskDuration exists"), Severity: SeverityWarning},
+		{Name: "bridges", Expr: mustParse("bridges in ['coingecko']"), Severity: SeverityError},
+	}
+
+	t.Run("satisfied", func(t *testing.T) {
+		violations := Check(rules, Facts{"type": "directrequest", "gasLimit": int64(1), "maxTaskDuration": time.Second, "bridges": []string{"coingecko"}})
+		assert.Empty(t, violations)
+	})
+
+	t.Run("violated", func(t *testing.T) {
+		violations := Check(rules, Facts{"type": "directrequest", "gasLimit": int64(500000), "bridges": []string{"kaiko"}})
+		assert.Equal(t, []Violation{
+			{Rule: "gas-limit", Severity: SeverityError, Message: "rule gas-limit: gasLimit < 500000 is not satisfied (gasLimit is 500000)"},
+			{Rule: "max-task-duration", Severity: SeverityWarning, Message: "rule max-task-duration: maxTaskDuration exists is not satisfied (maxTaskDuration is not set)"},
+			{Rule: "bridges", Severity: SeverityError, Message: "rule bridges: bridges in ['coingecko'] is not satisfied (bridges is [kaiko])"},
+		}, violations)
+	})
+
+	t.Run("job types", func(t *testing.T) {
+		violations := Check(rules, Facts{"type": "webhook", "gasLimit": int64(500000), "maxTaskDuration": time.Second})
+		assert.Empty(t, violations)
+	})
+}
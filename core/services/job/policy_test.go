@@ -0,0 +1,74 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/policy"
+)
+
+type policyRule struct {
+	name, expr, severity string
+	jobTypes             []string
+}
+
+func (r policyRule) Name() string       { return r.name }
+func (r policyRule) Expr() string       { return r.expr }
+func (r policyRule) Severity() string   { return r.severity }
+func (r policyRule) JobTypes() []string { return r.jobTypes }
+
+func TestCheckPolicy(t *testing.T) {
+	var jb Job
+	tree, err := toml.Load(`
+type = "directrequest"
+schemaVersion = 1
+name = "test"
+gasLimit = 600000
+maxTaskDuration = "10s"
+observationSource = """
+    ds    [type=bridge name="coingecko"]
+    parse [type=jsonparse path="data"]
+    ds -> parse
+"""
+`)
+	require.NoError(t, err)
+	require.NoError(t, tree.Unmarshal(&jb))
+
+	facts := PolicyFacts(jb)
+	assert.Equal(t, policy.Facts{
+		"type":              "directrequest",
+		"schemaVersion":     int64(1),
+		"name":              "test",
+		"gasLimit":          int64(600000),
+		"forwardingAllowed": false,
+		"maxTaskDuration":   10 * time.Second,
+		"bridges":           []string{"coingecko"},
+		"tasks":             []string{"bridge", "jsonparse"},
+	}, facts)
+
+	t.Run("warning", func(t *testing.T) {
+		violations, err := CheckPolicy([]config.JobPolicyRule{
+			policyRule{name: "gas-limit", expr: "gasLimit < 500000", severity: "warning"},
+			policyRule{name: "bridges", expr: "bridges in ['coingecko']", severity: "error"},
+		}, jb)
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "gas-limit", violations[0].Rule)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		violations, err := CheckPolicy([]config.JobPolicyRule{
+			policyRule{name: "gas-limit", expr: "gasLimit < 500000", severity: "error"},
+			policyRule{name: "webhook-only", expr: "tasks not in ['bridge']", severity: "error", jobTypes: []string{"webhook"}},
+		}, jb)
+		require.Len(t, violations, 1)
+		require.True(t, errors.Is(err, ErrPolicyViolation))
+		assert.EqualError(t, err, "job spec violates policy: rule gas-limit: gasLimit < 500000 is not satisfied (gasLimit is 600000)")
+	})
+}
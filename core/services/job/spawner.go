@@ -5,17 +5,22 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/jmoiron/sqlx"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 )
 
 //go:generate mockery --quiet --name Spawner --output ./mocks/ --case=underscore
@@ -34,6 +39,19 @@ type (
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
+		// WaitingJobs returns the jobs which are not started yet, with the dependencies they are waiting on.
+		WaitingJobs() map[int32][]string
+		// DisableChain stops the jobs running on or depending on the chain, and the jobs depending on them. They wait
+		// for the chain to be enabled again, as do the jobs created in the meantime.
+		DisableChain(id relay.ID)
+		// EnableChain starts the jobs waiting on the chain, once their other dependencies are met.
+		EnableChain(id relay.ID)
+		// FailedJobs returns the jobs whose services failed to start, which are retried with an exponential backoff
+		// until they are quarantined.
+		FailedJobs() map[int32]StartFailure
+		// RestartJob stops the services of the job, and the jobs depending on it, and starts them again. It also
+		// resets the failures of a job which failed to start, and lifts its quarantine.
+		RestartJob(ctx context.Context, jobID int32) error
 
 		// StartService starts services for the given job spec.
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -46,18 +64,32 @@ type (
 		Unregister(name string) error
 	}
 
+	// ChainChecker reports whether the chains jobs depend on are ready.
+	ChainChecker interface {
+		ChainReady(id relay.ID) error
+	}
+
 	spawner struct {
 		services.StateMachine
 		orm              ORM
 		config           Config
 		checker          Checker
+		chains           ChainChecker
 		jobTypeDelegates map[Type]Delegate
 		activeJobs       map[int32]activeJob
-		activeJobsMu     sync.RWMutex
-		q                pg.Q
-		lggr             logger.Logger
+		// waitingJobs are the jobs waiting on their dependencies to be started, guarded by activeJobsMu.
+		waitingJobs map[int32]waitingJob
+		// disabledChains are the chains disabled at runtime, guarded by activeJobsMu.
+		disabledChains map[relay.ID]struct{}
+		// failedJobs are the jobs whose services failed to start, guarded by activeJobsMu.
+		failedJobs   map[int32]failedJob
+		activeJobsMu sync.RWMutex
+		q            pg.Q
+		lggr         logger.Logger
 
 		chStop              services.StopChan
+		chDependencyStarted chan struct{}
+		wg                  sync.WaitGroup
 		lbDependentAwaiters []utils.DependentAwaiter
 	}
 
@@ -81,26 +113,74 @@ type (
 		OnDeleteJob(spec Job, q pg.Queryer) error
 	}
 
+	// SpecValidator is implemented by the delegates which validate the specs of their jobs beyond parsing them, like
+	// the delegates of job types implemented by plugins. CreateJob rejects the jobs which fail validation.
+	SpecValidator interface {
+		ValidateSpec(ctx context.Context, spec Job) error
+	}
+
 	activeJob struct {
 		delegate Delegate
 		spec     Job
 		services []ServiceCtx
+		// started is true once all the services are started without error.
+		started bool
+	}
+
+	waitingJob struct {
+		spec      Job
+		waitingOn []string
+	}
+
+	failedJob struct {
+		spec Job
+		StartFailure
 	}
+
+	// StartFailure is the state of a job whose services failed to start.
+	StartFailure struct {
+		// Failures is the number of consecutive attempts to start the job which failed.
+		Failures  int
+		LastError string
+		// NextAttempt is when the job is started again, or zero if it is quarantined.
+		NextAttempt time.Time
+		// Quarantined is true once the job failed to start maxStartFailures times. It is only started again by
+		// RestartJob.
+		Quarantined bool
+	}
+)
+
+// dependencyCheckInterval is how often waiting jobs are checked, for their chains to become ready.
+var dependencyCheckInterval = 15 * time.Second
+
+var (
+	// minStartBackoff is the delay before the first retry of a job which failed to start. It doubles with every
+	// failure, up to maxStartBackoff.
+	minStartBackoff = 10 * time.Second
+	maxStartBackoff = 10 * time.Minute
+	// maxStartFailures is the number of consecutive failures after which a job is quarantined.
+	maxStartFailures = 8
 )
 
 var _ Spawner = (*spawner)(nil)
 
-func NewSpawner(orm ORM, config Config, checker Checker, jobTypeDelegates map[Type]Delegate, db *sqlx.DB, lggr logger.Logger, lbDependentAwaiters []utils.DependentAwaiter) *spawner {
+// NewSpawner returns a new Spawner. The chain dependencies of jobs are never met if chains is nil.
+func NewSpawner(orm ORM, config Config, checker Checker, chains ChainChecker, jobTypeDelegates map[Type]Delegate, db *sqlx.DB, lggr logger.Logger, lbDependentAwaiters []utils.DependentAwaiter) *spawner {
 	namedLogger := lggr.Named("JobSpawner")
 	s := &spawner{
 		orm:                 orm,
 		config:              config,
 		checker:             checker,
+		chains:              chains,
 		jobTypeDelegates:    jobTypeDelegates,
 		q:                   pg.NewQ(db, namedLogger, config),
 		lggr:                namedLogger,
 		activeJobs:          make(map[int32]activeJob),
+		waitingJobs:         make(map[int32]waitingJob),
+		disabledChains:      make(map[relay.ID]struct{}),
+		failedJobs:          make(map[int32]failedJob),
 		chStop:              make(services.StopChan),
+		chDependencyStarted: make(chan struct{}, 1),
 		lbDependentAwaiters: lbDependentAwaiters,
 	}
 	return s
@@ -110,6 +190,8 @@ func NewSpawner(orm ORM, config Config, checker Checker, jobTypeDelegates map[Ty
 func (js *spawner) Start(ctx context.Context) error {
 	return js.StartOnce("JobSpawner", func() error {
 		js.startAllServices(ctx)
+		js.wg.Add(1)
+		go js.runWaitingJobsLoop()
 		return nil
 
 	})
@@ -118,6 +200,7 @@ func (js *spawner) Start(ctx context.Context) error {
 func (js *spawner) Close() error {
 	return js.StopOnce("JobSpawner", func() error {
 		close(js.chStop)
+		js.wg.Wait()
 		js.stopAllServices()
 		return nil
 
@@ -142,7 +225,8 @@ func (js *spawner) startAllServices(ctx context.Context) {
 		return
 	}
 
-	for _, spec := range specs {
+	// Start the jobs after the jobs they depend on
+	for _, spec := range dependencyOrder(specs) {
 		if err = js.StartService(ctx, spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %q: %v", spec.Name.ValueOrZero(), err)
 		}
@@ -155,10 +239,227 @@ func (js *spawner) startAllServices(ctx context.Context) {
 }
 
 func (js *spawner) stopAllServices() {
-	jobIDs := js.activeJobIDs()
-	for _, jobID := range jobIDs {
-		js.stopService(jobID)
+	// Stop the jobs before the jobs they depend on
+	jobs := dependencyOrder(maps.Values(js.ActiveJobs()))
+	for i := len(jobs) - 1; i >= 0; i-- {
+		js.stopService(jobs[i].ID)
+	}
+}
+
+// runWaitingJobsLoop starts the waiting jobs once their dependencies are met: whenever a job
+// is started, and periodically for the chains to become ready. The failed jobs are retried
+// along with them, once their backoff elapsed.
+func (js *spawner) runWaitingJobsLoop() {
+	defer js.wg.Done()
+	ctx, cancel := js.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(dependencyCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-js.chStop:
+			return
+		case <-js.chDependencyStarted:
+		case <-ticker.C:
+		}
+		js.startWaitingJobs(ctx)
+		js.retryFailedJobs(ctx)
+	}
+}
+
+func (js *spawner) retryFailedJobs(ctx context.Context) {
+	now := time.Now()
+	var due []Job
+	js.activeJobsMu.RLock()
+	for _, fj := range js.failedJobs {
+		if !fj.Quarantined && !fj.NextAttempt.After(now) {
+			due = append(due, fj.spec)
+		}
+	}
+	js.activeJobsMu.RUnlock()
+
+	for _, jb := range dependencyOrder(due) {
+		if err := js.startService(ctx, jb, true); err != nil {
+			js.lggr.Errorf("Couldn't start service %q: %v", jb.Name.ValueOrZero(), err)
+		}
+	}
+}
+
+// recordStartFailure backs off the next attempt to start the job, or quarantines it after
+// maxStartFailures consecutive failures. activeJobsMu must be held.
+func (js *spawner) recordStartFailure(jb Job, err error) {
+	fj := js.failedJobs[jb.ID]
+	fj.spec = jb
+	fj.Failures++
+	fj.LastError = err.Error()
+	lggr := js.lggr.With("jobID", jb.ID, "failures", fj.Failures)
+	if fj.Failures >= maxStartFailures {
+		fj.Quarantined = true
+		fj.NextAttempt = time.Time{}
+		lggr.Criticalw("Job is quarantined, as its services failed to start too many times. Fix the cause and restart the job.", "err", err)
+	} else {
+		backoff := maxStartBackoff
+		if shift := fj.Failures - 1; shift < 32 && minStartBackoff<<shift < maxStartBackoff {
+			backoff = minStartBackoff << shift
+		}
+		fj.NextAttempt = time.Now().Add(backoff)
+		lggr.Warnw("Job failed to start, retrying after backoff", "backoff", backoff, "err", err)
+	}
+	js.failedJobs[jb.ID] = fj
+}
+
+func (js *spawner) startWaitingJobs(ctx context.Context) {
+	for _, jb := range dependencyOrder(js.waitingJobSpecs()) {
+		if err := js.startService(ctx, jb, true); err != nil {
+			js.lggr.Errorf("Couldn't start service %q: %v", jb.Name.ValueOrZero(), err)
+		}
+	}
+}
+
+func (js *spawner) waitingJobSpecs() []Job {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+	jobs := make([]Job, 0, len(js.waitingJobs))
+	for _, wj := range js.waitingJobs {
+		jobs = append(jobs, wj.spec)
+	}
+	return jobs
+}
+
+// unmetDependencies returns the dependencies of the job which are not started or not ready.
+// activeJobsMu must be held.
+func (js *spawner) unmetDependencies(jb Job) (unmet []string) {
+	for _, dependency := range jb.DependsOn {
+		started := false
+		for _, aj := range js.activeJobs {
+			if aj.started && strings.EqualFold(aj.spec.ExternalJobID.String(), dependency) {
+				started = true
+				break
+			}
+		}
+		if !started {
+			unmet = append(unmet, "job "+dependency)
+		}
 	}
+	for _, chain := range jb.DependsOnChains {
+		var id relay.ID
+		err := id.UnmarshalString(chain)
+		if _, disabled := js.disabledChains[id]; err == nil && disabled {
+			// reported below
+			continue
+		}
+		if err == nil {
+			if js.chains == nil {
+				err = pkgerrors.New("chain readiness is unknown")
+			} else {
+				err = js.chains.ChainReady(id)
+			}
+		}
+		if err != nil {
+			unmet = append(unmet, "chain "+chain)
+		}
+	}
+	for _, id := range jb.RelayIDs() {
+		if _, disabled := js.disabledChains[id]; disabled {
+			unmet = append(unmet, disabledChainDependency(id))
+		}
+	}
+	return
+}
+
+func disabledChainDependency(id relay.ID) string {
+	return "chain " + id.Name() + " (disabled)"
+}
+
+func (js *spawner) DisableChain(id relay.ID) {
+	js.activeJobsMu.Lock()
+	js.disabledChains[id] = struct{}{}
+	js.activeJobsMu.Unlock()
+
+	// Stop the jobs before the jobs depending on them, which are stopped along with them
+	for _, jb := range dependencyOrder(maps.Values(js.ActiveJobs())) {
+		if !slices.Contains(jb.RelayIDs(), id) {
+			continue
+		}
+		if _, active := js.ActiveJobs()[jb.ID]; !active {
+			continue
+		}
+		js.lggr.Infow("Stopping job, as its chain is disabled", "jobID", jb.ID, "chain", id.Name())
+		js.stopService(jb.ID)
+		js.activeJobsMu.Lock()
+		js.waitingJobs[jb.ID] = waitingJob{spec: jb, waitingOn: []string{disabledChainDependency(id)}}
+		js.activeJobsMu.Unlock()
+		js.stopDependents(jb)
+	}
+}
+
+func (js *spawner) EnableChain(id relay.ID) {
+	js.activeJobsMu.Lock()
+	delete(js.disabledChains, id)
+	waiting := len(js.waitingJobs) > 0
+	js.activeJobsMu.Unlock()
+
+	if waiting {
+		select {
+		case js.chDependencyStarted <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// stopDependents stops the started jobs which depend on the given job, directly or not, and
+// puts them back in the waiting jobs.
+func (js *spawner) stopDependents(jb Job) {
+	stopped := []Job{jb}
+	for len(stopped) > 0 {
+		dependency := stopped[0]
+		stopped = stopped[1:]
+		for _, aj := range js.ActiveJobs() {
+			if !slices.ContainsFunc(aj.DependsOn, func(id string) bool {
+				return strings.EqualFold(id, dependency.ExternalJobID.String())
+			}) {
+				continue
+			}
+			js.lggr.Infow("Stopping job, as a job it depends on is stopped", "jobID", aj.ID, "dependencyJobID", dependency.ID)
+			js.stopService(aj.ID)
+			js.activeJobsMu.Lock()
+			js.waitingJobs[aj.ID] = waitingJob{spec: aj, waitingOn: []string{"job " + dependency.ExternalJobID.String()}}
+			js.activeJobsMu.Unlock()
+			stopped = append(stopped, aj)
+		}
+	}
+}
+
+// dependencyOrder sorts the jobs so that each job comes after the jobs it depends on.
+// Jobs depending on jobs which are not in the list, or on each other, keep their order.
+func dependencyOrder(jobs []Job) []Job {
+	byExternalID := make(map[string]int, len(jobs))
+	for i, jb := range jobs {
+		byExternalID[jb.ExternalJobID.String()] = i
+	}
+	sorted := make([]Job, 0, len(jobs))
+	visited := make([]bool, len(jobs))
+	visiting := make([]bool, len(jobs))
+	var visit func(i int)
+	visit = func(i int) {
+		if visited[i] || visiting[i] {
+			return
+		}
+		visiting[i] = true
+		for _, dependency := range jobs[i].DependsOn {
+			if j, ok := byExternalID[strings.ToLower(dependency)]; ok {
+				visit(j)
+			}
+		}
+		visiting[i] = false
+		visited[i] = true
+		sorted = append(sorted, jobs[i])
+	}
+	for i := range jobs {
+		visit(i)
+	}
+	return sorted
 }
 
 // stopService removes the job from memory and stop the services.
@@ -189,18 +490,54 @@ func (js *spawner) stopService(jobID int32) {
 	lggr.Debug("Stopped all services for job")
 
 	delete(js.activeJobs, jobID)
+	delete(js.waitingJobs, jobID)
+	delete(js.failedJobs, jobID)
+}
+
+// unregister unregisters the services from the health checker.
+func (js *spawner) unregister(srvs []ServiceCtx) {
+	for _, srv := range srvs {
+		if c, ok := srv.(services.HealthReporter); ok {
+			if err := js.checker.Unregister(c.Name()); err != nil {
+				js.lggr.Warnw("Failed to unregister service from health checker", "service", c.Name(), "err", err)
+			}
+		}
+	}
 }
 
 func (js *spawner) StartService(ctx context.Context, jb Job, qopts ...pg.QOpt) error {
+	return js.startService(ctx, jb, false)
+}
+
+// startService starts the services of the job, unless it is waiting on dependencies. If onlyWaiting
+// is true, the job is only started if it is still waiting or failed, i.e. it was not deleted in the
+// meantime.
+func (js *spawner) startService(ctx context.Context, jb Job, onlyWaiting bool) error {
 	lggr := js.lggr.With("jobID", jb.ID)
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
+	_, waiting := js.waitingJobs[jb.ID]
+	fj, failed := js.failedJobs[jb.ID]
+	if onlyWaiting && !waiting && (!failed || fj.Quarantined) {
+		return nil
+	}
+
 	delegate, exists := js.jobTypeDelegates[jb.Type]
 	if !exists {
 		lggr.Errorw("Job type has not been registered with job.Spawner", "type", jb.Type)
 		return pkgerrors.Errorf("unregistered type %q for job: %d", jb.Type, jb.ID)
 	}
+	// Jobs waiting on dependencies are started once they are met by runWaitingJobsLoop
+	if unmet := js.unmetDependencies(jb); len(unmet) > 0 {
+		if wj, ok := js.waitingJobs[jb.ID]; !ok || !slices.Equal(wj.waitingOn, unmet) {
+			lggr.Infow("Job is waiting on dependencies", "waitingOn", unmet)
+		}
+		js.waitingJobs[jb.ID] = waitingJob{spec: jb, waitingOn: unmet}
+		return nil
+	}
+	delete(js.waitingJobs, jb.ID)
+
 	// We always add the active job in the activeJob map, even in the case
 	// that it fails to start. That way we have access to the delegate to call
 	// OnJobDeleted before deleting. However, the activeJob will only have services
@@ -214,6 +551,12 @@ func (js *spawner) StartService(ctx context.Context, jb Job, qopts ...pg.QOpt) e
 	if jb.GasLimit.Valid {
 		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
 	}
+	if jb.ShadowPipelineSpec != nil {
+		// Shadow runs are named apart, so that the metrics of their tasks do not overwrite those of the live ones.
+		jb.ShadowPipelineSpec.JobName = jb.Name.ValueOrZero() + " (shadow)"
+		jb.ShadowPipelineSpec.JobID = jb.ID
+		jb.ShadowPipelineSpec.JobType = string(jb.Type)
+	}
 
 	srvs, err := delegate.ServicesForSpec(jb)
 	if err != nil {
@@ -222,6 +565,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job, qopts ...pg.QOpt) e
 		defer cancel()
 		js.orm.TryRecordError(jb.ID, err.Error(), pg.WithParentCtx(cctx))
 		js.activeJobs[jb.ID] = aj
+		js.recordStartFailure(jb, err)
 		return pkgerrors.Wrapf(err, "failed to create services for job: %d", jb.ID)
 	}
 
@@ -232,19 +576,34 @@ func (js *spawner) StartService(ctx context.Context, jb Job, qopts ...pg.QOpt) e
 		err = ms.Start(ctx, srv)
 		if err != nil {
 			lggr.Criticalw("Error starting service for job", "err", err)
+			js.recordStartFailure(jb, err)
 			return err
 		}
 		if c, ok := srv.(services.HealthReporter); ok {
 			err = js.checker.Register(c)
 			if err != nil {
 				lggr.Errorw("Error registering service with health checker", "err", err)
+				js.unregister(aj.services)
+				err = ms.CloseBecause(err)
+				js.recordStartFailure(jb, err)
 				return err
 			}
 		}
 		aj.services = append(aj.services, srv)
 	}
 	lggr.Debugw("JobSpawner: Finished starting services for job", "count", len(srvs))
+	aj.started = true
 	js.activeJobs[jb.ID] = aj
+	if failed {
+		lggr.Infow("Job started after failing to start", "failures", fj.Failures)
+		delete(js.failedJobs, jb.ID)
+	}
+	if len(js.waitingJobs) > 0 {
+		select {
+		case js.chDependencyStarted <- struct{}{}:
+		default:
+		}
+	}
 	return nil
 }
 
@@ -265,6 +624,13 @@ func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) (err error) {
 	ctx, cancel := q.Context()
 	defer cancel()
 
+	if validator, ok := delegate.(SpecValidator); ok {
+		if err = validator.ValidateSpec(ctx, *jb); err != nil {
+			err = pkgerrors.Wrap(err, "invalid job spec")
+			return
+		}
+	}
+
 	err = js.orm.CreateJob(jb, pg.WithQueryer(q.Queryer), pg.WithParentCtx(ctx))
 	if err != nil {
 		js.lggr.Errorw("Error creating job", "type", jb.Type, "err", err)
@@ -352,6 +718,12 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	if exists {
 		// Stop the service and remove the job from memory, which will always happen even if closing the services fail.
 		js.stopService(jobID)
+		if err == nil {
+			js.stopDependents(aj.spec)
+		}
+	} else if err == nil {
+		// The job may be waiting on dependencies, or have been started by runWaitingJobsLoop in the meantime.
+		js.stopService(jobID)
 	}
 	lggr.Infow("Stopped and deleted job")
 
@@ -369,15 +741,57 @@ func (js *spawner) ActiveJobs() map[int32]Job {
 	return m
 }
 
-func (js *spawner) activeJobIDs() []int32 {
+func (js *spawner) WaitingJobs() map[int32][]string {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
 
-	ids := make([]int32, 0, len(js.activeJobs))
-	for jobID := range js.activeJobs {
-		ids = append(ids, jobID)
+	m := make(map[int32][]string, len(js.waitingJobs))
+	for jobID, wj := range js.waitingJobs {
+		m[jobID] = slices.Clone(wj.waitingOn)
+	}
+	return m
+}
+
+func (js *spawner) FailedJobs() map[int32]StartFailure {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+
+	m := make(map[int32]StartFailure, len(js.failedJobs))
+	for jobID, fj := range js.failedJobs {
+		m[jobID] = fj.StartFailure
+	}
+	return m
+}
+
+func (js *spawner) RestartJob(ctx context.Context, jobID int32) error {
+	js.activeJobsMu.RLock()
+	aj, active := js.activeJobs[jobID]
+	fj, failed := js.failedJobs[jobID]
+	wj, waiting := js.waitingJobs[jobID]
+	js.activeJobsMu.RUnlock()
+
+	var jb Job
+	switch {
+	case active:
+		jb = aj.spec
+	case failed:
+		jb = fj.spec
+	case waiting:
+		jb = wj.spec
+	default:
+		// e.g. a job which failed to start before it could be tracked
+		var err error
+		if jb, err = js.orm.FindJob(ctx, jobID); err != nil {
+			return pkgerrors.Wrapf(err, "job %d not found", jobID)
+		}
+	}
+
+	js.lggr.Infow("Restarting job", "jobID", jobID)
+	js.stopService(jobID)
+	if active {
+		js.stopDependents(jb)
 	}
-	return ids
+	return js.startService(ctx, jb, false)
 }
 
 var _ Delegate = &NullDelegate{}
@@ -0,0 +1,97 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+type spawnerConfig struct {
+	pg.QConfig
+}
+
+func (spawnerConfig) URL() url.URL { return url.URL{} }
+
+type startService struct {
+	err    error
+	starts int
+}
+
+func (s *startService) Start(context.Context) error {
+	s.starts++
+	return s.err
+}
+
+func (s *startService) Close() error { return nil }
+
+type startDelegate struct {
+	NullDelegate
+	srv *startService
+}
+
+func (d *startDelegate) ServicesForSpec(Job) ([]ServiceCtx, error) {
+	return []ServiceCtx{d.srv}, nil
+}
+
+func TestSpawner_startFailures(t *testing.T) {
+	ctx := testutils.Context(t)
+	srv := &startService{err: errors.New("boom")}
+	js := NewSpawner(nil, spawnerConfig{pg.NewQConfig(false)}, nil, nil, map[Type]Delegate{
+		DirectRequest: &startDelegate{NullDelegate: NullDelegate{Type: DirectRequest}, srv: srv},
+	}, nil, logger.TestLogger(t), nil)
+	jb := Job{ID: 1, Type: DirectRequest, PipelineSpec: &pipeline.Spec{}}
+
+	// Every failure doubles the backoff, until the job is quarantined
+	for i := 1; i < maxStartFailures; i++ {
+		start := time.Now()
+		require.EqualError(t, js.startService(ctx, jb, false), "boom")
+		failure := js.FailedJobs()[jb.ID]
+		assert.Equal(t, i, failure.Failures)
+		assert.Equal(t, "boom", failure.LastError)
+		assert.False(t, failure.Quarantined)
+		backoff := min(minStartBackoff<<(i-1), maxStartBackoff)
+		assert.WithinRange(t, failure.NextAttempt, start.Add(backoff), time.Now().Add(backoff))
+	}
+	assert.Empty(t, js.ActiveJobs())
+
+	// Failed jobs are only retried once their backoff elapsed
+	js.retryFailedJobs(ctx)
+	assert.Equal(t, maxStartFailures-1, srv.starts)
+	js.activeJobsMu.Lock()
+	fj := js.failedJobs[jb.ID]
+	fj.NextAttempt = time.Now()
+	js.failedJobs[jb.ID] = fj
+	js.activeJobsMu.Unlock()
+	js.retryFailedJobs(ctx)
+	assert.Equal(t, maxStartFailures, srv.starts)
+
+	failure := js.FailedJobs()[jb.ID]
+	assert.True(t, failure.Quarantined)
+	assert.Zero(t, failure.NextAttempt)
+
+	// Quarantined jobs are not retried
+	js.activeJobsMu.Lock()
+	fj = js.failedJobs[jb.ID]
+	fj.NextAttempt = time.Now()
+	js.failedJobs[jb.ID] = fj
+	js.activeJobsMu.Unlock()
+	js.retryFailedJobs(ctx)
+	assert.Equal(t, maxStartFailures, srv.starts)
+
+	// Restarting lifts the quarantine
+	srv.err = nil
+	require.NoError(t, js.RestartJob(ctx, jb.ID))
+	assert.Equal(t, maxStartFailures+1, srv.starts)
+	assert.Empty(t, js.FailedJobs())
+	assert.Contains(t, js.ActiveJobs(), jb.ID)
+}
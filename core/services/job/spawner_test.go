@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
@@ -101,7 +102,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.Database(), config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db, lggr, config.Database()), keyStore, config.Database())
 		a := utils.NewDependentAwaiter()
 		a.AddDependents(1)
-		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{}, db, lggr, []utils.DependentAwaiter{a})
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, nil, map[job.Type]job.Delegate{}, db, lggr, []utils.DependentAwaiter{a})
 		// Starting the spawner should signal to the dependents
 		result := make(chan bool)
 		go func() {
@@ -140,7 +141,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		dB := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
 		delegateB := &delegate{jobB.Type, []job.ServiceCtx{serviceB1, serviceB2}, 0, make(chan struct{}), dB}
 
-		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, nil, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
 			jobB.Type: delegateB,
 		}, db, lggr, nil)
@@ -190,7 +191,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
 		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
 		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
-		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, nil, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
 		}, db, lggr, nil)
 
@@ -210,6 +211,116 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 
 	clearDB(t, db)
 
+	t.Run("starts jobs after the jobs they depend on", func(t *testing.T) {
+		jobA := cltest.MakeDirectRequestJobSpec(t)
+		jobA.ExternalJobID = uuid.New()
+		jobB := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		jobB.DependsOn = []string{jobA.ExternalJobID.String()}
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.Database(), config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db, lggr, config.Database()), keyStore, config.Database())
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+
+		eventuallyA := cltest.NewAwaiter()
+		serviceA := mocks.NewServiceCtx(t)
+		serviceA.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyA.ItHappened() })
+		dA := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA}, 0, nil, dA}
+
+		eventuallyB := cltest.NewAwaiter()
+		serviceB := mocks.NewServiceCtx(t)
+		serviceB.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyB.ItHappened() })
+		dB := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
+		delegateB := &delegate{jobB.Type, []job.ServiceCtx{serviceB}, 0, nil, dB}
+
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, nil, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+			jobB.Type: delegateB,
+		}, db, lggr, nil)
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+
+		require.NoError(t, spawner.CreateJob(jobB))
+		assert.Equal(t, map[int32][]string{jobB.ID: {"job " + jobA.ExternalJobID.String()}}, spawner.WaitingJobs())
+		assert.NotContains(t, spawner.ActiveJobs(), jobB.ID)
+
+		require.NoError(t, spawner.CreateJob(jobA))
+		eventuallyA.AwaitOrFail(t)
+		eventuallyB.AwaitOrFail(t)
+		gomega.NewWithT(t).Eventually(func() bool {
+			_, exists := spawner.ActiveJobs()[jobB.ID]
+			return exists
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(true))
+		assert.Empty(t, spawner.WaitingJobs())
+
+		// Deleting the dependency stops the dependent job, which waits for it again
+		serviceA.On("Close").Return(nil).Once()
+		serviceB.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.DeleteJob(jobA.ID))
+		assert.Equal(t, map[int32][]string{jobB.ID: {"job " + jobA.ExternalJobID.String()}}, spawner.WaitingJobs())
+		assert.Empty(t, spawner.ActiveJobs())
+
+		require.NoError(t, spawner.DeleteJob(jobB.ID))
+		assert.Empty(t, spawner.WaitingJobs())
+		require.NoError(t, spawner.Close())
+	})
+
+	t.Run("stops the jobs of a disabled chain until it is enabled", func(t *testing.T) {
+		jobA := cltest.MakeDirectRequestJobSpec(t)
+		jobA.ExternalJobID = uuid.New()
+		jobB := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		jobB.DependsOn = []string{jobA.ExternalJobID.String()}
+		chainID := relay.NewID(relay.EVM, testutils.FixtureChainID.String())
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.Database(), config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db, lggr, config.Database()), keyStore, config.Database())
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+
+		serviceA := mocks.NewServiceCtx(t)
+		serviceA.On("Start", mock.Anything).Return(nil)
+		dA := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA}, 0, nil, dA}
+
+		serviceB := mocks.NewServiceCtx(t)
+		serviceB.On("Start", mock.Anything).Return(nil)
+		dB := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
+		delegateB := &delegate{jobB.Type, []job.ServiceCtx{serviceB}, 0, nil, dB}
+
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, nil, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+			jobB.Type: delegateB,
+		}, db, lggr, nil)
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		require.NoError(t, spawner.CreateJob(jobA))
+		require.NoError(t, spawner.CreateJob(jobB))
+		gomega.NewWithT(t).Eventually(func() int {
+			return len(spawner.ActiveJobs())
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(2))
+
+		// Disabling the chain stops the job running on it, and the job depending on that one
+		serviceA.On("Close").Return(nil).Once()
+		serviceB.On("Close").Return(nil).Once()
+		spawner.DisableChain(chainID)
+		assert.Empty(t, spawner.ActiveJobs())
+		assert.Equal(t, map[int32][]string{
+			jobA.ID: {"chain " + chainID.Name() + " (disabled)"},
+			jobB.ID: {"job " + jobA.ExternalJobID.String()},
+		}, spawner.WaitingJobs())
+
+		spawner.EnableChain(chainID)
+		gomega.NewWithT(t).Eventually(func() int {
+			return len(spawner.ActiveJobs())
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(2))
+		assert.Empty(t, spawner.WaitingJobs())
+
+		serviceA.On("Close").Return(nil).Once()
+		serviceB.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.DeleteJob(jobB.ID))
+		require.NoError(t, spawner.DeleteJob(jobA.ID))
+		require.NoError(t, spawner.Close())
+	})
+
+	clearDB(t, db)
+
 	t.Run("closes job services on 'DeleteJob()'", func(t *testing.T) {
 		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
 
@@ -224,7 +335,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
 		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
 		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
-		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, nil, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
 		}, db, lggr, nil)
 
@@ -307,7 +418,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 			keyStore.OCR2(), keyStore.DKGSign(), keyStore.DKGEncrypt(), ethKeyStore, testRelayGetter, mailMon)
 		delegateOCR2 := &delegate{jobOCR2VRF.Type, []job.ServiceCtx{}, 0, nil, d}
 
-		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, nil, map[job.Type]job.Delegate{
 			jobOCR2VRF.Type: delegateOCR2,
 		}, db, lggr, nil)
 
@@ -0,0 +1,264 @@
+package job
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/exp/maps"
+)
+
+// TemplateVarType is the type of a variable of a job spec template.
+type TemplateVarType string
+
+const (
+	TemplateVarString      TemplateVarType = "string"
+	TemplateVarInt         TemplateVarType = "int"
+	TemplateVarBool        TemplateVarType = "bool"
+	TemplateVarAddress     TemplateVarType = "address"
+	TemplateVarKeyBundleID TemplateVarType = "keyBundleID"
+)
+
+const templateVarFunc = "var"
+
+var (
+	templateVarTypes = map[TemplateVarType]struct{}{
+		TemplateVarString:      {},
+		TemplateVarInt:         {},
+		TemplateVarBool:        {},
+		TemplateVarAddress:     {},
+		TemplateVarKeyBundleID: {},
+	}
+	keyBundleIDRegexp = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{64}$`)
+	// templateVarRegexp matches the actions declaring a template variable.
+	templateVarRegexp = regexp.MustCompile(`{{-?\s*` + templateVarFunc + `\s`)
+)
+
+// SpecTemplate is a TOML job spec template. Its variables are declared and used with the
+// var action, which takes the name and the type of the variable:
+//
+//	contractID = {{ var "contractAddress" "address" }}
+//	[relayConfig]
+//	chainID = {{ var "chainID" "int" }}
+//
+// The action renders the value as a TOML literal, quoting and escaping strings,
+// so it must not be enclosed in quotes.
+type SpecTemplate struct {
+	tmpl *template.Template
+	// Vars are the types of the variables declared by the template, by name.
+	Vars map[string]TemplateVarType
+}
+
+// IsSpecTemplate returns true if the TOML spec declares template variables.
+func IsSpecTemplate(ts string) bool {
+	return templateVarRegexp.MatchString(ts)
+}
+
+// ParseSpecTemplate parses the template and the variables it declares. Every use of a
+// variable must declare the same type.
+func ParseSpecTemplate(ts string) (*SpecTemplate, error) {
+	t := &SpecTemplate{Vars: map[string]TemplateVarType{}}
+	tmpl, err := template.New("spec").
+		Funcs(template.FuncMap{templateVarFunc: func(string, string) (string, error) {
+			return "", errors.New("template is not rendered")
+		}}).
+		Parse(ts)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid spec template")
+	}
+	t.tmpl = tmpl
+
+	var errs error
+	walkTemplate(tmpl.Tree.Root, func(cmd *parse.CommandNode) {
+		if ident, ok := cmd.Args[0].(*parse.IdentifierNode); !ok || ident.Ident != templateVarFunc {
+			return
+		}
+		if len(cmd.Args) != 3 {
+			errs = multierr.Append(errs, errors.Errorf("%s: var takes the name and the type of the variable", cmd))
+			return
+		}
+		name, ok := cmd.Args[1].(*parse.StringNode)
+		if !ok {
+			errs = multierr.Append(errs, errors.Errorf("%s: the name of the variable must be a string constant", cmd))
+			return
+		}
+		typ, ok := cmd.Args[2].(*parse.StringNode)
+		if !ok {
+			errs = multierr.Append(errs, errors.Errorf("%s: the type of the variable must be a string constant", cmd))
+			return
+		}
+		varType := TemplateVarType(typ.Text)
+		if _, ok := templateVarTypes[varType]; !ok {
+			errs = multierr.Append(errs, errors.Errorf("variable %s has unknown type %s", name.Text, typ.Text))
+			return
+		}
+		if declared, ok := t.Vars[name.Text]; ok && declared != varType {
+			errs = multierr.Append(errs, errors.Errorf("variable %s is declared as both %s and %s", name.Text, declared, varType))
+			return
+		}
+		t.Vars[name.Text] = varType
+	})
+	if errs != nil {
+		return nil, errors.Wrap(errs, "invalid spec template")
+	}
+	return t, nil
+}
+
+// walkTemplate calls fn with every command of the template.
+func walkTemplate(node parse.Node, fn func(*parse.CommandNode)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walkTemplate(child, fn)
+		}
+	case *parse.ActionNode:
+		walkTemplate(n.Pipe, fn)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			walkTemplate(cmd, fn)
+		}
+	case *parse.CommandNode:
+		fn(n)
+		for _, arg := range n.Args {
+			walkTemplate(arg, fn)
+		}
+	case *parse.IfNode:
+		walkTemplate(n.Pipe, fn)
+		walkTemplate(n.List, fn)
+		walkTemplate(n.ElseList, fn)
+	case *parse.RangeNode:
+		walkTemplate(n.Pipe, fn)
+		walkTemplate(n.List, fn)
+		walkTemplate(n.ElseList, fn)
+	case *parse.WithNode:
+		walkTemplate(n.Pipe, fn)
+		walkTemplate(n.List, fn)
+		walkTemplate(n.ElseList, fn)
+	}
+}
+
+// Render validates the values against the declared variables, and returns the spec with
+// the variables replaced by their TOML encoded values. Every declared variable must have
+// a value, and every value must belong to a declared variable.
+func (t *SpecTemplate) Render(values map[string]string) (string, error) {
+	var errs error
+	encoded := make(map[string]string, len(values))
+	names := maps.Keys(values)
+	sort.Strings(names)
+	for _, name := range names {
+		varType, ok := t.Vars[name]
+		if !ok {
+			errs = multierr.Append(errs, errors.Errorf("variable %s is not declared by the template", name))
+			continue
+		}
+		v, err := encodeTemplateVar(varType, values[name])
+		if err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "invalid %s value for variable %s", varType, name))
+			continue
+		}
+		encoded[name] = v
+	}
+	declared := maps.Keys(t.Vars)
+	sort.Strings(declared)
+	for _, name := range declared {
+		if _, ok := values[name]; !ok {
+			errs = multierr.Append(errs, errors.Errorf("missing value for variable %s (%s)", name, t.Vars[name]))
+		}
+	}
+	if errs != nil {
+		return "", errs
+	}
+
+	var b strings.Builder
+	err := template.Must(t.tmpl.Clone()).Funcs(template.FuncMap{templateVarFunc: func(name, _ string) (string, error) {
+		return encoded[name], nil
+	}}).Execute(&b, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to render spec template")
+	}
+	return b.String(), nil
+}
+
+// RenderSpecTemplate renders the TOML spec if it is a template. Specs which are not templates
+// are returned unchanged, as long as no values are given.
+func RenderSpecTemplate(ts string, values map[string]string) (string, error) {
+	if !IsSpecTemplate(ts) {
+		if len(values) > 0 {
+			return "", errors.New("template variables given but the spec does not declare any")
+		}
+		return ts, nil
+	}
+	t, err := ParseSpecTemplate(ts)
+	if err != nil {
+		return "", err
+	}
+	return t.Render(values)
+}
+
+func encodeTemplateVar(varType TemplateVarType, value string) (string, error) {
+	switch varType {
+	case TemplateVarString:
+		return quoteTOML(value), nil
+	case TemplateVarInt:
+		i, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return "", errors.Errorf("%q is not an integer", value)
+		}
+		return i.String(), nil
+	case TemplateVarBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", errors.Errorf("%q is not a boolean", value)
+		}
+		return strconv.FormatBool(b), nil
+	case TemplateVarAddress:
+		if !common.IsHexAddress(value) {
+			return "", errors.Errorf("%q is not a hex address", value)
+		}
+		return quoteTOML(common.HexToAddress(value).Hex()), nil
+	case TemplateVarKeyBundleID:
+		if !keyBundleIDRegexp.MatchString(value) {
+			return "", errors.Errorf("%q is not a 32 bytes hex key bundle ID", value)
+		}
+		return quoteTOML(strings.ToLower(strings.TrimPrefix(value, "0x"))), nil
+	default:
+		return "", errors.Errorf("unknown type %s", varType)
+	}
+}
+
+// quoteTOML returns s as a TOML basic string.
+func quoteTOML(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
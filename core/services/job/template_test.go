@@ -0,0 +1,119 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const specTemplate = `
+type            = "offchainreporting2"
+schemaVersion   = 1
+name            = {{ var "name" "string" }}
+contractID      = {{ var "contractAddress" "address" }}
+ocrKeyBundleID  = {{ var "keyBundleID" "keyBundleID" }}
+forwardingAllowed = {{ var "forwardingAllowed" "bool" }}
+observationSource = """
+ds [type=bridge name="bridge-api0"];
+"""
+
+[relayConfig]
+chainID = {{ var "chainID" "int" }}
+`
+
+func TestSpecTemplate_Parse(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsSpecTemplate(specTemplate))
+	assert.True(t, IsSpecTemplate(`name = {{- var "name" "string" }}`))
+	assert.False(t, IsSpecTemplate(`name = "{{ .name }}"`))
+
+	tmpl, err := ParseSpecTemplate(specTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]TemplateVarType{
+		"name":              TemplateVarString,
+		"contractAddress":   TemplateVarAddress,
+		"keyBundleID":       TemplateVarKeyBundleID,
+		"forwardingAllowed": TemplateVarBool,
+		"chainID":           TemplateVarInt,
+	}, tmpl.Vars)
+
+	for _, tc := range []struct {
+		name     string
+		template string
+		err      string
+	}{
+		{"unknown type", `a = {{ var "a" "float" }}`, "invalid spec template: variable a has unknown type float"},
+		{"conflicting types", `a = {{ var "a" "int" }}
+b = {{ var "a" "string" }}`, "invalid spec template: variable a is declared as both int and string"},
+		{"missing type", `a = {{ var "a" }}`, `invalid spec template: var "a": var takes the name and the type of the variable`},
+		{"variable name", `a = {{ var .a "int" }}`, "invalid spec template: var .a \"int\": the name of the variable must be a string constant"},
+		{"invalid syntax", `a = {{ var "a" "int" `, "invalid spec template: template: spec:1: unclosed action"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSpecTemplate(tc.template)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestSpecTemplate_Render(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := ParseSpecTemplate(specTemplate)
+	require.NoError(t, err)
+
+	t.Run("valid values", func(t *testing.T) {
+		spec, err := tmpl.Render(map[string]string{
+			"name":              `ETH/USD "feed"`,
+			"contractAddress":   "0x613a38ac1659769640aae063c651f48e0250454c",
+			"keyBundleID":       "0xF5BF259689B26F1374EFB3C9A9868796953A0F814BB2D39B968D0E61B58620A5",
+			"forwardingAllowed": "true",
+			"chainID":           "421613",
+		})
+		require.NoError(t, err)
+
+		tree, err := toml.Load(spec)
+		require.NoError(t, err)
+		assert.Equal(t, `ETH/USD "feed"`, tree.Get("name"))
+		assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", tree.Get("contractID"))
+		assert.Equal(t, "f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5", tree.Get("ocrKeyBundleID"))
+		assert.Equal(t, true, tree.Get("forwardingAllowed"))
+		assert.Equal(t, int64(421613), tree.Get("relayConfig.chainID"))
+		assert.Equal(t, "ds [type=bridge name=\"bridge-api0\"];\n", tree.Get("observationSource"))
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, err := tmpl.Render(map[string]string{
+			"name":              "ETH/USD",
+			"contractAddress":   "0x613a",
+			"keyBundleID":       "f5bf",
+			"forwardingAllowed": "yes",
+			"unknown":           "1",
+		})
+		assert.EqualError(t, err, `invalid address value for variable contractAddress: "0x613a" is not a hex address; `+
+			`invalid bool value for variable forwardingAllowed: "yes" is not a boolean; `+
+			`invalid keyBundleID value for variable keyBundleID: "f5bf" is not a 32 bytes hex key bundle ID; `+
+			`variable unknown is not declared by the template; `+
+			`missing value for variable chainID (int)`)
+	})
+}
+
+func TestRenderSpecTemplate(t *testing.T) {
+	t.Parallel()
+
+	spec := `type = "cron"`
+	rendered, err := RenderSpecTemplate(spec, nil)
+	require.NoError(t, err)
+	assert.Equal(t, spec, rendered)
+
+	_, err = RenderSpecTemplate(spec, map[string]string{"a": "1"})
+	assert.EqualError(t, err, "template variables given but the spec does not declare any")
+
+	rendered, err = RenderSpecTemplate(`schedule = {{ var "schedule" "string" }}`, map[string]string{"schedule": "CRON_TZ=UTC 0 0 1 1 *"})
+	require.NoError(t, err)
+	assert.Equal(t, `schedule = "CRON_TZ=UTC 0 0 1 1 *"`, rendered)
+}
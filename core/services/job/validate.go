@@ -3,8 +3,11 @@ package job
 import (
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 )
 
 var (
@@ -64,11 +67,48 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
+	if jb.ShadowPipeline.Source != "" {
+		if !jb.Type.SupportsShadowPipeline() {
+			return "", errors.Errorf("shadowObservationSource is not supported for %v", jb.Type)
+		}
+		if jb.ShadowPipeline.RequiresPreInsert() {
+			return "", errors.New("async=true tasks are not supported in shadowObservationSource")
+		}
+	}
 	// spec.CustomRevertsPipelineEnabled == false, default is custom reverted txns pipeline disabled
 
 	if strings.Contains(ts, "<{}>") {
 		return "", errors.Errorf("'<{}>' syntax is not supported. Please use \"{}\" instead")
 	}
+	if err = validateDependencies(jb); err != nil {
+		return "", err
+	}
 
 	return jb.Type, nil
 }
+
+// validateDependencies checks that the jobs a job depends on are given by external job ID,
+// and the chains by relay ID, e.g. evm.1.
+func validateDependencies(jb Job) error {
+	seen := map[uuid.UUID]struct{}{}
+	for _, dependency := range jb.DependsOn {
+		externalJobID, err := uuid.Parse(dependency)
+		if err != nil {
+			return errors.Errorf("dependsOn: %q is not an external job ID", dependency)
+		}
+		if externalJobID == jb.ExternalJobID {
+			return errors.New("dependsOn: a job cannot depend on itself")
+		}
+		if _, ok := seen[externalJobID]; ok {
+			return errors.Errorf("dependsOn: duplicate job %s", externalJobID)
+		}
+		seen[externalJobID] = struct{}{}
+	}
+	for _, chain := range jb.DependsOnChains {
+		var id relay.ID
+		if err := id.UnmarshalString(chain); err != nil {
+			return errors.Wrapf(err, "dependsOnChains: %q is not a relay ID, e.g. evm.1", chain)
+		}
+	}
+	return nil
+}
@@ -90,6 +90,111 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "shadow observation source",
+			spec: `
+type="offchainreporting"
+schemaVersion=1
+observationSource="""
+ds [type=http]
+"""
+shadowObservationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "shadow observation source not supported",
+			spec: `
+type="vrf"
+schemaVersion=1
+observationSource="""
+ds [type=http]
+"""
+shadowObservationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "shadowObservationSource is not supported for vrf")
+			},
+		},
+		{
+			name: "shadow observation source async check",
+			spec: `
+type="offchainreporting"
+schemaVersion=1
+observationSource="""
+ds [type=http]
+"""
+shadowObservationSource="""
+ds [type=bridge async=true]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "async=true tasks are not supported in shadowObservationSource")
+			},
+		},
+		{
+			name: "invalid job dependency",
+			spec: `
+type="bootstrap"
+schemaVersion=1
+dependsOn=["not-a-uuid"]
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, `dependsOn: "not-a-uuid" is not an external job ID`)
+			},
+		},
+		{
+			name: "self dependency",
+			spec: `
+type="bootstrap"
+schemaVersion=1
+externalJobID="0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"
+dependsOn=["0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"]
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "dependsOn: a job cannot depend on itself")
+			},
+		},
+		{
+			name: "duplicate job dependency",
+			spec: `
+type="bootstrap"
+schemaVersion=1
+dependsOn=["0eec7e1d-d0d2-476c-a1a8-72dfb6633f46", "0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"]
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "dependsOn: duplicate job 0eec7e1d-d0d2-476c-a1a8-72dfb6633f46")
+			},
+		},
+		{
+			name: "invalid chain dependency",
+			spec: `
+type="bootstrap"
+schemaVersion=1
+dependsOnChains=["1"]
+`,
+			assertion: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `dependsOnChains: "1" is not a relay ID, e.g. evm.1`)
+			},
+		},
+		{
+			name: "dependencies",
+			spec: `
+type="bootstrap"
+schemaVersion=1
+dependsOn=["0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"]
+dependsOnChains=["evm.1", "solana.mainnet"]
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
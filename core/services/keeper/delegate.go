@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/profitability"
 )
 
 // To make sure Delegate struct implements job.Delegate interface
@@ -117,8 +118,16 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 		chain.GasEstimator(),
 		svcLogger,
 		chain.Config().Keeper(),
+		chain.Config().EVM().GasEstimator(),
 		effectiveKeeperAddress,
 	)
+	if cfg := spec.KeeperSpec.Profitability; cfg != nil {
+		prices, err := profitability.NewFeedPriceSource(cfg.LinkNativeFeed, chain.Client())
+		if err != nil {
+			return nil, err
+		}
+		upkeepExecuter.profitability = profitability.NewChecker(*cfg, prices, spec.ID, svcLogger)
+	}
 
 	return []job.ServiceCtx{
 		registrySynchronizer,
@@ -25,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/profitability"
 )
 
 const (
@@ -45,6 +46,12 @@ var (
 	},
 		[]string{"upkeepID"},
 	)
+	promUpkeepPerformDeferrals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeep_perform_deferrals",
+		Help: "The number of times the perform of an upkeep was deferred because the estimated gas price exceeded its max gas price",
+	},
+		[]string{"upkeepID"},
+	)
 )
 
 type UpkeepExecuterConfig interface {
@@ -53,12 +60,18 @@ type UpkeepExecuterConfig interface {
 	Registry() config.Registry
 }
 
+// UpkeepExecuterGasConfig is the subset of the chain's gas estimator config used by the UpkeepExecuter
+type UpkeepExecuterGasConfig interface {
+	PriceMaxKey(common.Address) *assets.Wei
+}
+
 // UpkeepExecuter implements the logic to communicate with KeeperRegistry
 type UpkeepExecuter struct {
 	services.StateMachine
 	chStop                 services.StopChan
 	ethClient              evmclient.Client
 	config                 UpkeepExecuterConfig
+	gasConfig              UpkeepExecuterGasConfig
 	executionQueue         chan struct{}
 	headBroadcaster        httypes.HeadBroadcasterRegistry
 	gasEstimator           gas.EvmFeeEstimator
@@ -69,6 +82,8 @@ type UpkeepExecuter struct {
 	logger                 logger.Logger
 	wgDone                 sync.WaitGroup
 	effectiveKeeperAddress common.Address
+	// profitability, if set, defers unprofitable performs.
+	profitability *profitability.Checker
 }
 
 // NewUpkeepExecuter is the constructor of UpkeepExecuter
@@ -81,6 +96,7 @@ func NewUpkeepExecuter(
 	gasEstimator gas.EvmFeeEstimator,
 	logger logger.Logger,
 	config UpkeepExecuterConfig,
+	gasConfig UpkeepExecuterGasConfig,
 	effectiveKeeperAddress common.Address,
 ) *UpkeepExecuter {
 	return &UpkeepExecuter{
@@ -92,6 +108,7 @@ func NewUpkeepExecuter(
 		job:                    job,
 		mailbox:                mailbox.NewSingle[*evmtypes.Head](),
 		config:                 config,
+		gasConfig:              gasConfig,
 		orm:                    orm,
 		pr:                     pr,
 		effectiveKeeperAddress: effectiveKeeperAddress,
@@ -211,6 +228,26 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	ctxService, cancel := ex.chStop.CtxCancel(context.WithTimeout(context.Background(), time.Minute))
 	defer cancel()
 
+	maxGasPrice := ex.job.KeeperSpec.MaxGasPriceForUpkeep(upkeep.UpkeepID)
+	if maxGasPrice != nil || ex.profitability != nil {
+		gasPrice, err := ex.estimateGasPrice(ctxService, upkeep, head)
+		if err != nil {
+			svcLogger.Warnw("failed to estimate gas price, performing upkeep regardless of its max gas price and profitability", "err", err)
+		} else if maxGasPrice != nil && gasPrice.Cmp(maxGasPrice) > 0 {
+			// The upkeep is not marked as performed, so that it is checked again on the next heads,
+			// as long as it remains eligible.
+			svcLogger.Infow("deferring upkeep perform: estimated gas price exceeds max gas price", "gasPrice", gasPrice, "maxGasPrice", maxGasPrice)
+			promUpkeepPerformDeferrals.WithLabelValues(upkeep.PrettyID()).Inc()
+			return
+		} else if ex.profitability != nil {
+			performGas := uint64(upkeep.ExecuteGas) + uint64(ex.config.Registry().PerformGasOverhead())
+			if !ex.profitability.Allow(ctxService, performGas, gasPrice) {
+				// Likewise, unprofitable performs are deferred until the gas price drops.
+				return
+			}
+		}
+	}
+
 	evmChainID := ""
 	if ex.job.KeeperSpec.EVMChainID != nil {
 		evmChainID = ex.job.KeeperSpec.EVMChainID.String()
@@ -245,6 +282,17 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	}
 }
 
+// estimateGasPrice returns the gas price a perform of the upkeep would currently pay. For dynamic fees, this is the
+// base fee of the head plus the tip, capped by the fee cap.
+func (ex *UpkeepExecuter) estimateGasPrice(ctx context.Context, upkeep UpkeepRegistration, head *evmtypes.Head) (*assets.Wei, error) {
+	gasLimit := maxUpkeepPerformGas + ex.config.Registry().PerformGasOverhead()
+	fee, _, err := ex.gasEstimator.GetFee(ctx, nil, gasLimit, ex.gasConfig.PriceMaxKey(upkeep.Registry.FromAddress.Address()))
+	if err != nil {
+		return nil, err
+	}
+	return fee.EffectivePrice(head.BaseFeePerGas)
+}
+
 func (ex *UpkeepExecuter) turnBlockHashBinary(registry Registry, head *evmtypes.Head, lookback int64) (string, error) {
 	turnBlock := head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
 	block, err := ex.ethClient.HeadByNumber(context.Background(), big.NewInt(turnBlock))
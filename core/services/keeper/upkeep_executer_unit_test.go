@@ -4,10 +4,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
@@ -77,3 +83,64 @@ func TestBuildJobSpec(t *testing.T) {
 
 	require.Equal(t, expected, spec)
 }
+
+type registryConfig struct {
+	config.Registry
+	pgo uint32
+}
+
+func (r registryConfig) PerformGasOverhead() uint32 { return r.pgo }
+
+type executerConfig struct {
+	UpkeepExecuterConfig
+	registry registryConfig
+}
+
+func (c executerConfig) Registry() config.Registry { return c.registry }
+
+type gasConfig struct{}
+
+func (gasConfig) PriceMaxKey(common.Address) *assets.Wei { return assets.GWei(500) }
+
+func TestUpkeepExecuter_EstimateGasPrice(t *testing.T) {
+	upkeep := UpkeepRegistration{
+		Registry: Registry{FromAddress: ethkey.EIP55Address(testutils.NewAddress().Hex())},
+		UpkeepID: big.NewI(4),
+	}
+
+	tests := []struct {
+		name     string
+		fee      gas.EvmFee
+		baseFee  *assets.Wei
+		expected *assets.Wei
+	}{
+		{"legacy", gas.EvmFee{Legacy: assets.GWei(30)}, assets.GWei(10), assets.GWei(30)},
+		{"dynamic", gas.EvmFee{DynamicTipCap: assets.GWei(2), DynamicFeeCap: assets.GWei(100)}, assets.GWei(10), assets.GWei(12)},
+		{"dynamic capped by fee cap", gas.EvmFee{DynamicTipCap: assets.GWei(2), DynamicFeeCap: assets.GWei(100)}, assets.GWei(200), assets.GWei(100)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			estimator := gasmocks.NewEvmFeeEstimator(t)
+			estimator.On("GetFee", mock.Anything, []byte(nil), uint32(5_000_000+9), assets.GWei(500)).Return(tc.fee, uint32(0), nil)
+			ex := &UpkeepExecuter{
+				gasEstimator: estimator,
+				config:       executerConfig{registry: registryConfig{pgo: 9}},
+				gasConfig:    gasConfig{},
+			}
+
+			price, err := ex.estimateGasPrice(testutils.Context(t), upkeep, &evmtypes.Head{BaseFeePerGas: tc.baseFee})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, price)
+		})
+	}
+}
+
+func TestKeeperSpec_MaxGasPriceForUpkeep(t *testing.T) {
+	spec := job.KeeperSpec{
+		MaxGasPrice:        assets.GWei(100),
+		UpkeepMaxGasPrices: job.UpkeepGasPrices{"4": assets.GWei(50)},
+	}
+	require.Equal(t, assets.GWei(50), spec.MaxGasPriceForUpkeep(big.NewI(4)))
+	require.Equal(t, assets.GWei(100), spec.MaxGasPriceForUpkeep(big.NewI(5)))
+	require.Nil(t, (&job.KeeperSpec{}).MaxGasPriceForUpkeep(big.NewI(4)))
+}
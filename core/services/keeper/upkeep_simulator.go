@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	iregistry21 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
+	type_and_version "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/type_and_version_interface_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// upkeepFailureReasons are the names of the UpkeepFailureReason enum of the v2.1 registry.
+var upkeepFailureReasons = []string{
+	"NONE", // unused
+	"UPKEEP_CANCELLED",
+	"UPKEEP_PAUSED",
+	"TARGET_CHECK_REVERTED",
+	"UPKEEP_NOT_NEEDED",
+	"PERFORM_DATA_EXCEEDS_LIMIT",
+	"INSUFFICIENT_BALANCE",
+	"CALLBACK_REVERTED",
+	"REVERT_DATA_EXCEEDS_LIMIT",
+	"REGISTRY_PAUSED",
+}
+
+// UpkeepSimulationRequest identifies the upkeep to simulate.
+type UpkeepSimulationRequest struct {
+	Registry ethkey.EIP55Address
+	UpkeepID *big.Int
+	// TriggerData is passed to checkUpkeep for log and conditional trigger upkeeps of v2.1 registries.
+	// If it is nil, the upkeep is checked without trigger data.
+	TriggerData []byte
+	// CallbackValues, if set, are passed to checkCallback instead of calling checkUpkeep, as the
+	// node does after a successful StreamsLookup. Only supported by v2.1 registries.
+	CallbackValues    [][]byte
+	CallbackExtraData []byte
+}
+
+// UpkeepSimulation is the result of simulating the check of an upkeep at the latest block.
+type UpkeepSimulation struct {
+	RegistryVersion string
+	BlockNumber     int64
+	UpkeepNeeded    bool
+	PerformData     []byte
+	// FailureReason explains why the upkeep is not needed. It is set by v2.1 registries, and when the
+	// check of a v1.x registry reverts.
+	FailureReason string
+	// CheckGasUsed is the gas used by the check of the upkeep. It is only reported by v2.1 registries.
+	CheckGasUsed *big.Int
+	// GasLimit is the perform gas limit of the upkeep.
+	GasLimit *big.Int
+	// PerformGasUsed is the gas used by the simulated perform, if the upkeep is needed. It is only
+	// reported by v2.1 registries.
+	PerformGasUsed *big.Int
+	// PerformSimulationSucceeded reports whether the simulated perform succeeded. It is only set if
+	// PerformGasUsed is.
+	PerformSimulationSucceeded bool
+}
+
+// SimulateUpkeep checks the upkeep at the latest block through the registry, the way the node would before
+// performing it, and returns the decoded result. Registries v1.0 to v1.3 and v2.1 are supported.
+func SimulateUpkeep(ctx context.Context, client evmclient.Client, req UpkeepSimulationRequest) (*UpkeepSimulation, error) {
+	if req.UpkeepID == nil {
+		return nil, errors.New("upkeep ID is required")
+	}
+	head, err := client.HeadByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest head")
+	}
+	if head == nil {
+		return nil, errors.New("latest head is nil")
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: big.NewInt(head.Number)}
+
+	tv, err := type_and_version.NewTypeAndVersionInterface(req.Registry.Address(), client)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create type and interface wrapper")
+	}
+	typeAndVersion, err := tv.TypeAndVersion(opts)
+	if err == nil && strings.HasPrefix(typeAndVersion, "KeeperRegistry 2.1") {
+		sim, err2 := simulateUpkeep2_1(opts, client, req)
+		if err2 != nil {
+			return nil, err2
+		}
+		sim.BlockNumber = head.Number
+		return sim, nil
+	}
+
+	if len(req.CallbackValues) > 0 {
+		return nil, errors.New("checkCallback is only supported by v2.1 registries")
+	}
+	rw, err := NewRegistryWrapper(req.Registry, client)
+	if err != nil {
+		return nil, err
+	}
+	sim, err := simulateUpkeep1_x(opts, client, rw, req.UpkeepID)
+	if err != nil {
+		return nil, err
+	}
+	sim.BlockNumber = head.Number
+	return sim, nil
+}
+
+func simulateUpkeep1_x(opts *bind.CallOpts, client evmclient.Client, rw *RegistryWrapper, upkeepID *big.Int) (*UpkeepSimulation, error) {
+	var registryABI abi.ABI
+	switch rw.Version {
+	case RegistryVersion_1_0, RegistryVersion_1_1:
+		registryABI = Registry1_1ABI
+	case RegistryVersion_1_2:
+		registryABI = Registry1_2ABI
+	case RegistryVersion_1_3:
+		registryABI = Registry1_3ABI
+	default:
+		return nil, newUnsupportedVersionError("checkUpkeep", rw.Version)
+	}
+	sim := &UpkeepSimulation{RegistryVersion: rw.Version.String()}
+
+	upkeep, err := rw.GetUpkeep(opts, upkeepID)
+	if err != nil {
+		return nil, err
+	}
+	sim.GasLimit = new(big.Int).SetUint64(uint64(upkeep.ExecuteGas))
+
+	// checkUpkeep is not a view function, and must be called from the zero address.
+	data, err := registryABI.Pack("checkUpkeep", upkeepID, common.Address{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack checkUpkeep")
+	}
+	to := rw.Address.Address()
+	b, err := client.CallContract(opts.Context, ethereum.CallMsg{To: &to, Data: data}, opts.BlockNumber)
+	if err != nil {
+		if rpcErr := evmclient.ExtractRPCErrorOrNil(err); rpcErr != nil {
+			// the registry reverts if the upkeep is not needed
+			sim.FailureReason = rpcErr.Message
+			return sim, nil
+		}
+		return nil, errors.Wrap(err, "failed to call checkUpkeep")
+	}
+	out, err := registryABI.Unpack("checkUpkeep", b)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unpack checkUpkeep result")
+	}
+	sim.UpkeepNeeded = true
+	sim.PerformData = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	sim.GasLimit = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	return sim, nil
+}
+
+func simulateUpkeep2_1(opts *bind.CallOpts, client evmclient.Client, req UpkeepSimulationRequest) (*UpkeepSimulation, error) {
+	registry, err := iregistry21.NewIKeeperRegistryMaster(req.Registry.Address(), client)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create keeper registry 2_1 contract wrapper")
+	}
+	sim := &UpkeepSimulation{RegistryVersion: "v2.1"}
+
+	var failureReason uint8
+	switch {
+	case len(req.CallbackValues) > 0:
+		res, err2 := registry.CheckCallback(opts, req.UpkeepID, req.CallbackValues, req.CallbackExtraData)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to call checkCallback")
+		}
+		sim.UpkeepNeeded, sim.PerformData, failureReason, sim.CheckGasUsed = res.UpkeepNeeded, res.PerformData, res.UpkeepFailureReason, res.GasUsed
+		info, err2 := registry.GetUpkeep(opts, req.UpkeepID)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to get upkeep")
+		}
+		sim.GasLimit = new(big.Int).SetUint64(uint64(info.PerformGas))
+	case req.TriggerData != nil:
+		res, err2 := registry.CheckUpkeep(opts, req.UpkeepID, req.TriggerData)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to call checkUpkeep")
+		}
+		sim.UpkeepNeeded, sim.PerformData, failureReason, sim.CheckGasUsed, sim.GasLimit = res.UpkeepNeeded, res.PerformData, res.UpkeepFailureReason, res.GasUsed, res.GasLimit
+	default:
+		res, err2 := registry.CheckUpkeep0(opts, req.UpkeepID)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to call checkUpkeep")
+		}
+		sim.UpkeepNeeded, sim.PerformData, failureReason, sim.CheckGasUsed, sim.GasLimit = res.UpkeepNeeded, res.PerformData, res.UpkeepFailureReason, res.GasUsed, res.GasLimit
+	}
+	if failureReason != 0 {
+		sim.FailureReason = upkeepFailureReason(failureReason)
+	}
+
+	if sim.UpkeepNeeded {
+		perform, err2 := registry.SimulatePerformUpkeep(opts, req.UpkeepID, sim.PerformData)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to call simulatePerformUpkeep")
+		}
+		sim.PerformSimulationSucceeded, sim.PerformGasUsed = perform.Success, perform.GasUsed
+	}
+	return sim, nil
+}
+
+func upkeepFailureReason(reason uint8) string {
+	if int(reason) < len(upkeepFailureReasons) {
+		return upkeepFailureReasons[reason]
+	}
+	return fmt.Sprintf("UNKNOWN_%d", reason)
+}
+
+// ParseUpkeepSimulationRequest parses the request from its API representation. The upkeep ID may be decimal or
+// 0x-prefixed hex, and the trigger data and callback values 0x-prefixed hex.
+func ParseUpkeepSimulationRequest(registry, upkeepID string, triggerData *string, callbackValues []string, callbackExtraData *string) (req UpkeepSimulationRequest, err error) {
+	req.Registry, err = ethkey.NewEIP55Address(registry)
+	if err != nil {
+		return req, errors.Wrap(err, "invalid registry address")
+	}
+	var ok bool
+	req.UpkeepID, ok = new(big.Int).SetString(upkeepID, 0)
+	if !ok {
+		return req, errors.Errorf("invalid upkeep ID: %s", upkeepID)
+	}
+	if triggerData != nil {
+		req.TriggerData, err = hexutil.Decode(*triggerData)
+		if err != nil {
+			return req, errors.Wrap(err, "invalid trigger data")
+		}
+	}
+	for i, v := range callbackValues {
+		b, err2 := hexutil.Decode(v)
+		if err2 != nil {
+			return req, errors.Wrapf(err2, "invalid callback value %d", i)
+		}
+		req.CallbackValues = append(req.CallbackValues, b)
+	}
+	if callbackExtraData != nil {
+		req.CallbackExtraData, err = hexutil.Decode(*callbackExtraData)
+		if err != nil {
+			return req, errors.Wrap(err, "invalid callback extra data")
+		}
+	}
+	return req, nil
+}
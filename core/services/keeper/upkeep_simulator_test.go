@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	iregistry21 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/type_and_version_interface_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+)
+
+func TestSimulateUpkeep_Registry2_1(t *testing.T) {
+	registryABI := evmtypes.MustGetABI(iregistry21.IKeeperRegistryMasterABI)
+	tvABI := evmtypes.MustGetABI(type_and_version_interface_wrapper.TypeAndVersionInterfaceABI)
+	performData := []byte{0x01, 0x02}
+
+	client := evmclimocks.NewClient(t)
+	client.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 100}, nil)
+	expectCall := func(method abi.Method, out ...interface{}) {
+		b, err := method.Outputs.Pack(out...)
+		require.NoError(t, err)
+		client.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return bytes.HasPrefix(msg.Data, method.ID)
+		}), big.NewInt(100)).Return(b, nil).Once()
+	}
+	expectCall(tvABI.Methods["typeAndVersion"], "KeeperRegistry 2.1.0")
+	expectCall(registryABI.Methods["checkUpkeep0"], true, performData, uint8(0), big.NewInt(50_000), big.NewInt(500_000), big.NewInt(1), big.NewInt(1))
+	expectCall(registryABI.Methods["simulatePerformUpkeep"], true, big.NewInt(120_000))
+
+	req, err := keeper.ParseUpkeepSimulationRequest(testutils.NewAddress().Hex(), "42", nil, nil, nil)
+	require.NoError(t, err)
+	sim, err := keeper.SimulateUpkeep(testutils.Context(t), client, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "v2.1", sim.RegistryVersion)
+	assert.Equal(t, int64(100), sim.BlockNumber)
+	assert.True(t, sim.UpkeepNeeded)
+	assert.Equal(t, performData, sim.PerformData)
+	assert.Empty(t, sim.FailureReason)
+	assert.Equal(t, big.NewInt(50_000), sim.CheckGasUsed)
+	assert.Equal(t, big.NewInt(500_000), sim.GasLimit)
+	assert.Equal(t, big.NewInt(120_000), sim.PerformGasUsed)
+	assert.True(t, sim.PerformSimulationSucceeded)
+}
+
+func TestParseUpkeepSimulationRequest(t *testing.T) {
+	registry := testutils.NewAddress().Hex()
+	triggerData := "0x0102"
+
+	req, err := keeper.ParseUpkeepSimulationRequest(registry, "0x2a", &triggerData, []string{"0x03"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), req.UpkeepID)
+	assert.Equal(t, []byte{0x01, 0x02}, req.TriggerData)
+	assert.Equal(t, [][]byte{{0x03}}, req.CallbackValues)
+	assert.Nil(t, req.CallbackExtraData)
+
+	_, err = keeper.ParseUpkeepSimulationRequest("0x01", "42", nil, nil, nil)
+	require.ErrorContains(t, err, "invalid registry address")
+	_, err = keeper.ParseUpkeepSimulationRequest(registry, "abc", nil, nil, nil)
+	require.ErrorContains(t, err, "invalid upkeep ID")
+	_, err = keeper.ParseUpkeepSimulationRequest(registry, "42", nil, []string{"03"}, nil)
+	require.ErrorContains(t, err, "invalid callback value 0")
+}
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"math/big"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -43,5 +45,29 @@ func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
 		return j, errors.New("There should be no 'observationSource' parameter included in the toml")
 	}
 
+	if spec.MaxGasPrice != nil && spec.MaxGasPrice.Cmp(assets.NewWeiI(0)) <= 0 {
+		return j, errors.Errorf("maxGasPrice must be positive, given: %s", spec.MaxGasPrice)
+	}
+	if len(spec.UpkeepMaxGasPrices) > 0 {
+		// normalize the upkeep IDs, so they can be looked up by their decimal representation
+		prices := make(job.UpkeepGasPrices, len(spec.UpkeepMaxGasPrices))
+		for id, price := range spec.UpkeepMaxGasPrices {
+			upkeepID, ok := new(big.Int).SetString(id, 0)
+			if !ok || upkeepID.Sign() < 0 {
+				return j, errors.Errorf("upkeepMaxGasPrices: invalid upkeep ID: %s", id)
+			}
+			if price == nil || price.Cmp(assets.NewWeiI(0)) <= 0 {
+				return j, errors.Errorf("upkeepMaxGasPrices: max gas price of upkeep %s must be positive", id)
+			}
+			prices[upkeepID.String()] = price
+		}
+		spec.UpkeepMaxGasPrices = prices
+	}
+	if spec.Profitability != nil {
+		if err := spec.Profitability.Validate(); err != nil {
+			return j, errors.Wrap(err, "profitability")
+		}
+	}
+
 	return j, nil
 }
@@ -4,7 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/profitability"
 )
 
 func TestValidatedKeeperSpec(t *testing.T) {
@@ -20,6 +27,10 @@ func TestValidatedKeeperSpec(t *testing.T) {
 		fromAddr     string
 		createdAt    time.Time
 		updatedAt    time.Time
+
+		maxGasPrice        *assets.Wei
+		upkeepMaxGasPrices job.UpkeepGasPrices
+		profitability      *profitability.Config
 	}
 
 	tests := []struct {
@@ -71,6 +82,118 @@ func TestValidatedKeeperSpec(t *testing.T) {
 			wantErr: false,
 		},
 
+		{
+			name: "valid job spec with max gas prices",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "example keeper spec"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromAddress                 = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						    maxGasPrice                 = "100 gwei"
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+
+						    [upkeepMaxGasPrices]
+						    "0x10" = "50 gwei"
+						    "17"   = "1 ether"
+					    `,
+			},
+			want: want{
+				id:           0,
+				contractAddr: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+				fromAddr:     "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+				maxGasPrice:  assets.GWei(100),
+				upkeepMaxGasPrices: job.UpkeepGasPrices{
+					"16": assets.GWei(50),
+					"17": assets.Ether(1),
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "valid job spec with profitability",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "example keeper spec"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromAddress                 = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+
+						    [profitability]
+						    linkNativeFeed       = "0xDC530D9457755926550b59e8ECcdaE7624181557"
+						    expectedPayment      = "0.1 link"
+						    maxCostPercent       = 80
+						    transmitUnprofitable = true
+					    `,
+			},
+			want: want{
+				id:           0,
+				contractAddr: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+				fromAddr:     "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+				profitability: &profitability.Config{
+					LinkNativeFeed:       common.HexToAddress("0xDC530D9457755926550b59e8ECcdaE7624181557"),
+					ExpectedPayment:      commonassets.NewLinkFromJuels(100_000_000_000_000_000),
+					MaxCostPercent:       80,
+					TransmitUnprofitable: true,
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "invalid job spec because of missing expected payment",
+			args: args{
+				tomlString: `
+						type            = "keeper"
+						name            = "invalid keeper spec example"
+						contractAddress = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						fromAddress     = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						externalJobID   = "123e4567-e89b-12d3-a456-426655440002"
+
+						[profitability]
+						linkNativeFeed = "0xDC530D9457755926550b59e8ECcdaE7624181557"
+					`,
+			},
+			want:    want{},
+			wantErr: true,
+		},
+
+		{
+			name: "invalid job spec because of non positive max gas price",
+			args: args{
+				tomlString: `
+						type            = "keeper"
+						name            = "invalid keeper spec example"
+						contractAddress = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						fromAddress     = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						maxGasPrice     = "0 gwei"
+						externalJobID   = "123e4567-e89b-12d3-a456-426655440002"
+					`,
+			},
+			want:    want{},
+			wantErr: true,
+		},
+
+		{
+			name: "invalid job spec because of invalid upkeep ID",
+			args: args{
+				tomlString: `
+						type            = "keeper"
+						name            = "invalid keeper spec example"
+						contractAddress = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						fromAddress     = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						externalJobID   = "123e4567-e89b-12d3-a456-426655440002"
+
+						[upkeepMaxGasPrices]
+						"abc" = "50 gwei"
+					`,
+			},
+			want:    want{},
+			wantErr: true,
+		},
+
 		{
 			name: "invalid job spec because of type",
 			args: args{
@@ -190,6 +313,9 @@ func TestValidatedKeeperSpec(t *testing.T) {
 			require.Equal(t, tt.want.fromAddr, got.KeeperSpec.FromAddress.Hex())
 			require.Equal(t, tt.want.createdAt, got.KeeperSpec.CreatedAt)
 			require.Equal(t, tt.want.updatedAt, got.KeeperSpec.UpdatedAt)
+			require.Equal(t, tt.want.maxGasPrice, got.KeeperSpec.MaxGasPrice)
+			require.Equal(t, tt.want.upkeepMaxGasPrices, got.KeeperSpec.UpkeepMaxGasPrices)
+			require.Equal(t, tt.want.profitability, got.KeeperSpec.Profitability)
 		})
 	}
 
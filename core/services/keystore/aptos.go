@@ -0,0 +1,177 @@
+package keystore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/aptoskey"
+)
+
+//go:generate mockery --quiet --name Aptos --output ./mocks/ --case=underscore --filename aptos.go
+
+type Aptos interface {
+	Get(id string) (aptoskey.Key, error)
+	GetAll() ([]aptoskey.Key, error)
+	Create() (aptoskey.Key, error)
+	Add(key aptoskey.Key) error
+	Delete(id string) (aptoskey.Key, error)
+	Import(keyJSON []byte, password string) (aptoskey.Key, error)
+	Export(id string, password string) ([]byte, error)
+	EnsureKey() error
+	Sign(ctx context.Context, id string, msg []byte) (signature []byte, err error)
+}
+
+// AptosSigner adapts Aptos to [loop.Keystore].
+type AptosSigner struct {
+	Aptos
+}
+
+func (s *AptosSigner) Accounts(ctx context.Context) (accounts []string, err error) {
+	ks, err := s.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range ks {
+		accounts = append(accounts, k.PublicKeyStr())
+	}
+	return
+}
+
+type aptos struct {
+	*keyManager
+}
+
+var _ Aptos = &aptos{}
+
+func newAptosKeyStore(km *keyManager) *aptos {
+	return &aptos{
+		km,
+	}
+}
+
+func (ks *aptos) Get(id string) (aptoskey.Key, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return aptoskey.Key{}, ErrLocked
+	}
+	return ks.getByID(id)
+}
+
+func (ks *aptos) GetAll() (keys []aptoskey.Key, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for _, key := range ks.keyRing.Aptos {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (ks *aptos) Create() (aptoskey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return aptoskey.Key{}, ErrLocked
+	}
+	key, err := aptoskey.New()
+	if err != nil {
+		return aptoskey.Key{}, err
+	}
+	return key, ks.safeAddKey(key)
+}
+
+func (ks *aptos) Add(key aptoskey.Key) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.Aptos[key.ID()]; found {
+		return fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	return ks.safeAddKey(key)
+}
+
+func (ks *aptos) Delete(id string) (aptoskey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return aptoskey.Key{}, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return aptoskey.Key{}, err
+	}
+	err = ks.safeRemoveKey(key)
+	return key, err
+}
+
+func (ks *aptos) Import(keyJSON []byte, password string) (aptoskey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return aptoskey.Key{}, ErrLocked
+	}
+	key, err := aptoskey.FromEncryptedJSON(keyJSON, password)
+	if err != nil {
+		return aptoskey.Key{}, errors.Wrap(err, "AptosKeyStore#ImportKey failed to decrypt key")
+	}
+	if _, found := ks.keyRing.Aptos[key.ID()]; found {
+		return aptoskey.Key{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	return key, ks.keyManager.safeAddKey(key)
+}
+
+func (ks *aptos) Export(id string, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return key.ToEncryptedJSON(password, ks.scryptParams)
+}
+
+func (ks *aptos) EnsureKey() error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if len(ks.keyRing.Aptos) > 0 {
+		return nil
+	}
+
+	key, err := aptoskey.New()
+	if err != nil {
+		return err
+	}
+
+	ks.logger.Infof("Created Aptos key with ID %s", key.ID())
+
+	return ks.safeAddKey(key)
+}
+
+func (ks *aptos) Sign(_ context.Context, id string, msg []byte) (signature []byte, err error) {
+	k, err := ks.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return k.Sign(msg)
+}
+
+func (ks *aptos) getByID(id string) (aptoskey.Key, error) {
+	key, found := ks.keyRing.Aptos[id]
+	if !found {
+		return aptoskey.Key{}, KeyNotFoundError{ID: id, KeyType: "Aptos"}
+	}
+	return key, nil
+}
@@ -0,0 +1,112 @@
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/cloudauth"
+)
+
+// AWSKMSKey is a master key held by AWS KMS. Data keys are wrapped and unwrapped with the KMS Encrypt and Decrypt
+// actions, so the master key never leaves KMS.
+type AWSKMSKey struct {
+	client *http.Client
+	keyID  string
+	region string
+	// endpoint overrides the regional endpoint.
+	endpoint string
+	creds    cloudauth.AWSCredentials
+	now      func() time.Time
+}
+
+// NewAWSKMSKeyFromEnv returns an AWSKMSKey for the key ID, alias or ARN, configured with the standard AWS_REGION
+// (or AWS_DEFAULT_REGION), AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN and AWS_ENDPOINT_URL_KMS
+// environment variables.
+func NewAWSKMSKeyFromEnv(client *http.Client, keyID string) *AWSKMSKey {
+	region := cloudauth.AWSRegionFromEnv()
+	// arn:aws:kms:<region>:<account>:key/<id>
+	if parts := strings.Split(keyID, ":"); len(parts) > 3 && parts[0] == "arn" {
+		region = parts[3]
+	}
+	return &AWSKMSKey{
+		client:   client,
+		keyID:    keyID,
+		region:   region,
+		endpoint: os.Getenv("AWS_ENDPOINT_URL_KMS"),
+		creds:    cloudauth.AWSCredentialsFromEnv(),
+		now:      time.Now,
+	}
+}
+
+func (a *AWSKMSKey) ID() string { return "aws-kms:" + a.keyID }
+
+func (a *AWSKMSKey) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	var resp struct {
+		CiphertextBlob []byte
+	}
+	if err := a.call(ctx, "Encrypt", map[string]any{"KeyId": a.keyID, "Plaintext": dataKey}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CiphertextBlob, nil
+}
+
+func (a *AWSKMSKey) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	var resp struct {
+		Plaintext []byte
+	}
+	if err := a.call(ctx, "Decrypt", map[string]any{"KeyId": a.keyID, "CiphertextBlob": wrapped}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Plaintext, nil
+}
+
+// call invokes the KMS action. Blobs are base64 encoded in both directions, as encoding/json does for []byte.
+func (a *AWSKMSKey) call(ctx context.Context, action string, params map[string]any, result any) error {
+	if a.region == "" {
+		return errors.New("AWS_REGION is not set")
+	}
+	if !a.creds.Valid() {
+		return errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+	}
+	endpoint := a.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com", a.region)
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(endpoint, "/")+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+	cloudauth.SignV4(req, body, a.creds, a.region, "kms", a.now())
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("kms %s responded with %s: %s", action, resp.Status, strings.TrimSpace(string(b)))
+	}
+	if err = json.Unmarshal(b, result); err != nil {
+		return fmt.Errorf("failed to decode kms %s response: %w", action, err)
+	}
+	return nil
+}
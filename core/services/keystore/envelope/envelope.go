@@ -0,0 +1,154 @@
+// Package envelope implements envelope encryption of the key material stored in the database: each write is
+// encrypted with a fresh data key, which is itself wrapped by a master key held outside the database, in a file or a
+// KMS.
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DefaultTimeout bounds each call to the master key.
+const DefaultTimeout = 30 * time.Second
+
+// dataKeySize is the size of the AES-256 data keys.
+const dataKeySize = 32
+
+// ErrMasterKeyMismatch is returned when opening an envelope wrapped by another master key.
+var ErrMasterKeyMismatch = errors.New("envelope was sealed with a different master key")
+
+// MasterKey wraps and unwraps data keys.
+type MasterKey interface {
+	// ID identifies the master key. It is stored in the envelope, in clear.
+	ID() string
+	// Wrap encrypts the data key.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	// Unwrap decrypts a data key returned by Wrap.
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// NewMasterKey returns the master key configured with either the path of a key file, or the ID of an AWS KMS key.
+// It returns nil if neither is set, i.e. envelope encryption is disabled.
+func NewMasterKey(file, awsKMSKeyID string) (MasterKey, error) {
+	switch {
+	case file != "" && awsKMSKeyID != "":
+		return nil, errors.New("only one of a master key file or an AWS KMS key may be set")
+	case file != "":
+		return NewFileKey(file)
+	case awsKMSKeyID != "":
+		return NewAWSKMSKeyFromEnv(http.DefaultClient, awsKMSKeyID), nil
+	}
+	return nil, nil
+}
+
+type sealed struct {
+	Envelope *envelope `json:"envelope"`
+}
+
+type envelope struct {
+	MasterKeyID    string `json:"masterKeyID"`
+	WrappedDataKey []byte `json:"wrappedDataKey"`
+	Nonce          []byte `json:"nonce"`
+	Ciphertext     []byte `json:"ciphertext"`
+}
+
+// Seal encrypts plaintext with a new data key, wrapped by mk.
+func Seal(ctx context.Context, mk MasterKey, plaintext []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	nonce, ciphertext, err := encrypt(dataKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := mk.Wrap(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key with master key %s: %w", mk.ID(), err)
+	}
+	return json.Marshal(sealed{&envelope{
+		MasterKeyID:    mk.ID(),
+		WrappedDataKey: wrapped,
+		Nonce:          nonce,
+		Ciphertext:     ciphertext,
+	}})
+}
+
+// Open decrypts an envelope returned by Seal.
+func Open(ctx context.Context, mk MasterKey, b []byte) ([]byte, error) {
+	var s sealed
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	if s.Envelope == nil {
+		return nil, errors.New("not an envelope")
+	}
+	if s.Envelope.MasterKeyID != mk.ID() {
+		return nil, fmt.Errorf("%w: sealed with %s but the master key is %s", ErrMasterKeyMismatch, s.Envelope.MasterKeyID, mk.ID())
+	}
+	dataKey, err := mk.Unwrap(ctx, s.Envelope.WrappedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with master key %s: %w", mk.ID(), err)
+	}
+	return decrypt(dataKey, s.Envelope.Nonce, s.Envelope.Ciphertext)
+}
+
+// IsSealed returns true if b is an envelope returned by Seal.
+func IsSealed(b []byte) bool {
+	if !bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		return false
+	}
+	var s sealed
+	return json.Unmarshal(b, &s) == nil && s.Envelope != nil
+}
+
+// MasterKeyID returns the ID of the master key which sealed b, or "" if b is not an envelope.
+func MasterKeyID(b []byte) string {
+	var s sealed
+	if json.Unmarshal(b, &s) != nil || s.Envelope == nil {
+		return ""
+	}
+	return s.Envelope.MasterKeyID
+}
+
+func encrypt(key, plaintext []byte) (nonce, ciphertext []byte, err error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce = make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return nonce, gcm.Seal(nil, nonce, plaintext, nil), nil
+}
+
+func decrypt(key, nonce, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, errors.New("invalid nonce")
+	}
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("invalid key size %d: must be %d bytes", len(key), dataKeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
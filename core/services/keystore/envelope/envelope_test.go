@@ -0,0 +1,182 @@
+package envelope
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/cloudauth"
+)
+
+func newTestFileKey(t *testing.T) *FileKey {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(key)+"\n"), 0600))
+	fk, err := NewFileKey(path)
+	require.NoError(t, err)
+	return fk
+}
+
+func TestSealOpen(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mk := newTestFileKey(t)
+	plaintext := []byte(`{"cipher":"aes-128-ctr","ciphertext":"00"}`)
+	assert.False(t, IsSealed(plaintext))
+	assert.False(t, IsSealed(nil))
+
+	b, err := Seal(ctx, mk, plaintext)
+	require.NoError(t, err)
+	assert.True(t, IsSealed(b))
+	assert.Equal(t, mk.ID(), MasterKeyID(b))
+	assert.NotContains(t, string(b), "aes-128-ctr")
+
+	got, err := Open(ctx, mk, b)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, got)
+
+	// each seal uses a new data key
+	b2, err := Seal(ctx, mk, plaintext)
+	require.NoError(t, err)
+	assert.NotEqual(t, b, b2)
+
+	other := newTestFileKey(t)
+	_, err = Open(ctx, other, b)
+	require.ErrorIs(t, err, ErrMasterKeyMismatch)
+
+	// tampering is detected
+	var s sealed
+	require.NoError(t, json.Unmarshal(b, &s))
+	s.Envelope.Ciphertext[0] ^= 0xff
+	tampered, err := json.Marshal(s)
+	require.NoError(t, err)
+	_, err = Open(ctx, mk, tampered)
+	require.Error(t, err)
+}
+
+func TestNewFileKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	_, err := NewFileKey(filepath.Join(dir, "missing"))
+	require.ErrorContains(t, err, "failed to read master key file")
+
+	short := filepath.Join(dir, "short")
+	require.NoError(t, os.WriteFile(short, []byte("0xdeadbeef"), 0600))
+	_, err = NewFileKey(short)
+	require.EqualError(t, err, "master key must be 32 bytes, got 4")
+
+	invalid := filepath.Join(dir, "invalid")
+	require.NoError(t, os.WriteFile(invalid, []byte("not hex"), 0600))
+	_, err = NewFileKey(invalid)
+	require.ErrorContains(t, err, "must hold hex encoded bytes")
+
+	// the ID does not depend on the path
+	key := strings.Repeat("ab", dataKeySize)
+	a, b := filepath.Join(dir, "a"), filepath.Join(dir, "b")
+	require.NoError(t, os.WriteFile(a, []byte(key), 0600))
+	require.NoError(t, os.WriteFile(b, []byte("0x"+key), 0600))
+	ka, err := NewFileKey(a)
+	require.NoError(t, err)
+	kb, err := NewFileKey(b)
+	require.NoError(t, err)
+	assert.Equal(t, ka.ID(), kb.ID())
+	assert.True(t, strings.HasPrefix(ka.ID(), "file:"))
+}
+
+func TestNewMasterKey(t *testing.T) {
+	t.Parallel()
+
+	mk, err := NewMasterKey("", "")
+	require.NoError(t, err)
+	assert.Nil(t, mk)
+
+	_, err = NewMasterKey("master.key", "alias/chainlink")
+	require.Error(t, err)
+
+	mk, err = NewMasterKey("", "alias/chainlink")
+	require.NoError(t, err)
+	assert.Equal(t, "aws-kms:alias/chainlink", mk.ID())
+}
+
+func TestAWSKMSKey(t *testing.T) {
+	t.Parallel()
+
+	// the fake KMS "encrypts" by prefixing the key ID
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/20240102/us-east-1/kms/aws4_request") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var req struct {
+			KeyId          string
+			Plaintext      []byte
+			CiphertextBlob []byte
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.KeyId != "key-id" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"NotFoundException"}`))
+			return
+		}
+		var resp any
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.Encrypt":
+			resp = map[string]any{"KeyId": req.KeyId, "CiphertextBlob": append([]byte(req.KeyId), req.Plaintext...)}
+		case "TrentService.Decrypt":
+			resp = map[string]any{"KeyId": req.KeyId, "Plaintext": req.CiphertextBlob[len(req.KeyId):]}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+
+	newKey := func(keyID string) *AWSKMSKey {
+		return &AWSKMSKey{
+			client:   srv.Client(),
+			keyID:    keyID,
+			region:   "us-east-1",
+			endpoint: srv.URL,
+			creds:    cloudauth.AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"},
+			now:      func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) },
+		}
+	}
+	ctx := context.Background()
+
+	mk := newKey("key-id")
+	b, err := Seal(ctx, mk, []byte("keys"))
+	require.NoError(t, err)
+	assert.Equal(t, "aws-kms:key-id", MasterKeyID(b))
+	got, err := Open(ctx, mk, b)
+	require.NoError(t, err)
+	assert.Equal(t, "keys", string(got))
+
+	_, err = Seal(ctx, newKey("missing"), []byte("keys"))
+	require.ErrorContains(t, err, "kms Encrypt responded with 400 Bad Request")
+
+	noCreds := newKey("key-id")
+	noCreds.creds = cloudauth.AWSCredentials{}
+	_, err = Seal(ctx, noCreds, []byte("keys"))
+	require.ErrorContains(t, err, "AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+}
+
+func TestNewAWSKMSKeyFromEnv_Region(t *testing.T) {
+	t.Setenv("AWS_REGION", "us-east-1")
+	assert.Equal(t, "us-east-1", NewAWSKMSKeyFromEnv(http.DefaultClient, "alias/chainlink").region)
+	assert.Equal(t, "eu-west-2", NewAWSKMSKeyFromEnv(http.DefaultClient, "arn:aws:kms:eu-west-2:123456789012:key/abcd").region)
+}
@@ -0,0 +1,60 @@
+package envelope
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FileKey is a master key read from a file, holding 32 hex encoded bytes.
+type FileKey struct {
+	key []byte
+	id  string
+}
+
+// NewFileKey reads the master key from the file at path.
+func NewFileKey(path string) (*FileKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read master key file: %w", err)
+	}
+	key, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(b)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("master key file %s must hold hex encoded bytes: %w", path, err)
+	}
+	return newFileKey(key)
+}
+
+func newFileKey(key []byte) (*FileKey, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("master key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	// The ID is a fingerprint of the key, so that it is stable when the file is moved.
+	sum := sha256.Sum256(key)
+	return &FileKey{key: key, id: "file:" + hex.EncodeToString(sum[:8])}, nil
+}
+
+func (f *FileKey) ID() string { return f.id }
+
+func (f *FileKey) Wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	nonce, ciphertext, err := encrypt(f.key, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(nonce, ciphertext...), nil
+}
+
+func (f *FileKey) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	gcm, err := newGCM(f.key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+	return gcm.Open(nil, wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():], nil)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
@@ -36,6 +37,9 @@ type Eth interface {
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
 	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignDigest signs digest, like the EIP-712 hash of a transaction with a non-standard format, with the key of
+	// fromAddress. The signature is in the [R || S || V] format, where V is 0 or 1.
+	SignDigest(fromAddress common.Address, digest common.Hash) ([]byte, error)
 
 	EnabledKeysForChain(chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -319,6 +323,19 @@ func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *bi
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
+func (ks *eth) SignDigest(address common.Address, digest common.Hash) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(address.String())
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(digest[:], key.ToEcdsaPrivKey())
+}
+
 // EnabledKeysForChain returns all keys that are enabled for the given chain
 func (ks *eth) EnabledKeysForChain(chainID *big.Int) (sendingKeys []ethkey.KeyV2, err error) {
 	if chainID == nil {
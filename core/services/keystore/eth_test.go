@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -349,6 +350,27 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignDigest(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, config.Database())
+	ethKeyStore := keyStore.Eth()
+
+	k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	digest := crypto.Keccak256Hash([]byte("digest"))
+
+	_, err := ethKeyStore.SignDigest(testutils.NewAddress(), digest)
+	require.EqualError(t, err, "Key not found")
+
+	sig, err := ethKeyStore.SignDigest(k.Address, digest)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(digest[:], sig)
+	require.NoError(t, err)
+	require.Equal(t, k.Address, crypto.PubkeyToAddress(*pub))
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,7 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/envelope"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -20,7 +21,19 @@ func mustNewEthKey(t *testing.T) *ethkey.KeyV2 {
 }
 
 func ExposedNewMaster(t *testing.T, db *sqlx.DB, cfg pg.QConfig) *master {
-	return newMaster(db, utils.FastScryptParams, logger.TestLogger(t), cfg)
+	return newMaster(db, utils.FastScryptParams, nil, logger.TestLogger(t), cfg)
+}
+
+func ExposedNewMasterWithMasterKey(t *testing.T, db *sqlx.DB, masterKey envelope.MasterKey, cfg pg.QConfig) *master {
+	return newMaster(db, utils.FastScryptParams, masterKey, logger.TestLogger(t), cfg)
+}
+
+func (m *master) ExportedEncryptedKeys() []byte {
+	ekr, err := m.orm.getEncryptedKeyRing()
+	if err != nil {
+		panic(err)
+	}
+	return ekr.EncryptedKeys
 }
 
 func (m *master) ExportedSave() error {
@@ -0,0 +1,48 @@
+package aptoskey
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const keyTypeIdentifier = "Aptos"
+
+// FromEncryptedJSON gets key from json and password
+func FromEncryptedJSON(keyJSON []byte, password string) (Key, error) {
+	return keys.FromEncryptedJSON(
+		keyTypeIdentifier,
+		keyJSON,
+		password,
+		adulteratedPassword,
+		func(_ keys.EncryptedKeyExport, rawPrivKey []byte) (Key, error) {
+			return Raw(rawPrivKey).Key(), nil
+		},
+	)
+}
+
+// ToEncryptedJSON returns encrypted JSON representing key
+func (key Key) ToEncryptedJSON(password string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	return keys.ToEncryptedJSON(
+		keyTypeIdentifier,
+		key.Raw(),
+		key,
+		password,
+		scryptParams,
+		adulteratedPassword,
+		func(id string, key Key, cryptoJSON keystore.CryptoJSON) keys.EncryptedKeyExport {
+			return keys.EncryptedKeyExport{
+				KeyType:   id,
+				PublicKey: hex.EncodeToString(key.pubKey),
+				Crypto:    cryptoJSON,
+			}
+		},
+	)
+}
+
+func adulteratedPassword(password string) string {
+	return "aptoskey" + password
+}
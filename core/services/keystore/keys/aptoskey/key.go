@@ -0,0 +1,116 @@
+package aptoskey
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	crypto_rand "crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// ed25519Scheme is the authentication key scheme of single ed25519 keys, appended to the public key when deriving the
+// account address.
+const ed25519Scheme byte = 0x00
+
+// Raw represents the Aptos private key
+type Raw []byte
+
+// Key gets the Key
+func (raw Raw) Key() Key {
+	privKey := ed25519.NewKeyFromSeed(raw)
+	pubKey := make([]byte, ed25519.PublicKeySize)
+	copy(pubKey, privKey[ed25519.PublicKeySize:])
+	return Key{
+		privkey: privKey,
+		pubKey:  pubKey,
+	}
+}
+
+// String returns description
+func (raw Raw) String() string {
+	return "<Aptos Raw Private Key>"
+}
+
+// GoString wraps String()
+func (raw Raw) GoString() string {
+	return raw.String()
+}
+
+var _ fmt.GoStringer = &Key{}
+
+// Key represents Aptos key
+type Key struct {
+	privkey ed25519.PrivateKey
+	pubKey  ed25519.PublicKey
+}
+
+// New creates new Key
+func New() (Key, error) {
+	return newFrom(crypto_rand.Reader)
+}
+
+// MustNewInsecure return Key if no error
+func MustNewInsecure(reader io.Reader) Key {
+	key, err := newFrom(reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func newFrom(reader io.Reader) (Key, error) {
+	pub, priv, err := ed25519.GenerateKey(reader)
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{
+		privkey: priv,
+		pubKey:  pub,
+	}, nil
+}
+
+// ID gets Key ID, the public key, since the authentication key of an account can be rotated, and
+// the public key is needed to sign transactions.
+func (key Key) ID() string {
+	return key.PublicKeyStr()
+}
+
+// Account returns the address of the account created for the key, the hex encoded sha3-256
+// hash of the public key and the ed25519 scheme.
+func (key Key) Account() string {
+	authKey := sha3.Sum256(append([]byte(key.pubKey), ed25519Scheme))
+	return "0x" + hex.EncodeToString(authKey[:])
+}
+
+// GetPublic get Key's public key
+func (key Key) GetPublic() ed25519.PublicKey {
+	return key.pubKey
+}
+
+// PublicKeyStr returns hex encoded public key
+func (key Key) PublicKeyStr() string {
+	return hex.EncodeToString(key.pubKey)
+}
+
+// Raw from private key
+func (key Key) Raw() Raw {
+	return key.privkey.Seed()
+}
+
+// String is the print-friendly format of the Key
+func (key Key) String() string {
+	return fmt.Sprintf("AptosKey{PrivateKey: <redacted>, Account: %s}", key.Account())
+}
+
+// GoString wraps String()
+func (key Key) GoString() string {
+	return key.String()
+}
+
+// Sign is used to sign a message
+func (key Key) Sign(msg []byte) ([]byte, error) {
+	return key.privkey.Sign(crypto_rand.Reader, msg, crypto.Hash(0))
+}
@@ -0,0 +1,31 @@
+package aptoskey
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestAptosKey_Account(t *testing.T) {
+	seed, err := hex.DecodeString("9bf49a6a0755f953811fce125f2683d50429c3bb49e074147e0089a52eae155f")
+	require.NoError(t, err)
+	key := Raw(seed).Key()
+	assert.Equal(t, key.ID(), key.PublicKeyStr())
+	assert.Len(t, key.Account(), 66)
+	pub, err := hex.DecodeString(key.PublicKeyStr())
+	require.NoError(t, err)
+	authKey := sha3.Sum256(append(pub, 0x00))
+	assert.Equal(t, "0x"+hex.EncodeToString(authKey[:]), key.Account())
+
+	msg := []byte("hello aptos")
+	sig, err := key.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(key.GetPublic(), msg, sig))
+
+	assert.Equal(t, key, key.Raw().Key())
+	assert.NotContains(t, key.String(), hex.EncodeToString(seed))
+}
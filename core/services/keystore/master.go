@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -11,6 +12,8 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/envelope"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/aptoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/cosmoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/dkgencryptkey"
@@ -49,9 +52,13 @@ type Master interface {
 	Solana() Solana
 	Cosmos() Cosmos
 	StarkNet() StarkNet
+	Aptos() Aptos
 	VRF() VRF
 	Unlock(password string) error
 	IsEmpty() (bool, error)
+	// RotateMasterKey re-encrypts the key ring with a new data key, wrapped by masterKey, which is then used for all
+	// subsequent writes.
+	RotateMasterKey(masterKey envelope.MasterKey) error
 }
 
 type master struct {
@@ -64,21 +71,29 @@ type master struct {
 	p2p        *p2p
 	solana     *solana
 	starknet   *starknet
+	aptos      *aptos
 	vrf        *vrf
 	dkgSign    *dkgSign
 	dkgEncrypt *dkgEncrypt
 }
 
 func New(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.QConfig) Master {
-	return newMaster(db, scryptParams, lggr, cfg)
+	return newMaster(db, scryptParams, nil, lggr, cfg)
 }
 
-func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.QConfig) *master {
+// NewWithMasterKey returns a Master which envelope encrypts the key ring with masterKey. Key rings stored without
+// envelope encryption are re-encrypted on Unlock.
+func NewWithMasterKey(db *sqlx.DB, scryptParams utils.ScryptParams, masterKey envelope.MasterKey, lggr logger.Logger, cfg pg.QConfig) Master {
+	return newMaster(db, scryptParams, masterKey, lggr, cfg)
+}
+
+func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, masterKey envelope.MasterKey, lggr logger.Logger, cfg pg.QConfig) *master {
 	orm := NewORM(db, lggr, cfg)
 	km := &keyManager{
 		orm:          orm,
 		keystateORM:  orm,
 		scryptParams: scryptParams,
+		masterKey:    masterKey,
 		lock:         &sync.RWMutex{},
 		logger:       lggr.Named("KeyStore"),
 	}
@@ -93,6 +108,7 @@ func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger,
 		p2p:        newP2PKeyStore(km),
 		solana:     newSolanaKeyStore(km),
 		starknet:   newStarkNetKeyStore(km),
+		aptos:      newAptosKeyStore(km),
 		vrf:        newVRFKeyStore(km),
 		dkgSign:    newDKGSignKeyStore(km),
 		dkgEncrypt: newDKGEncryptKeyStore(km),
@@ -139,6 +155,10 @@ func (ks *master) StarkNet() StarkNet {
 	return ks.starknet
 }
 
+func (ks *master) Aptos() Aptos {
+	return ks.aptos
+}
+
 func (ks *master) VRF() VRF {
 	return ks.vrf
 }
@@ -157,11 +177,13 @@ type keyManager struct {
 	orm          ORM
 	keystateORM  keystateORM
 	scryptParams utils.ScryptParams
-	keyRing      *keyRing
-	keyStates    *keyStates
-	lock         *sync.RWMutex
-	password     string
-	logger       logger.Logger
+	// masterKey wraps the data keys of the key ring. Envelope encryption is disabled if nil.
+	masterKey envelope.MasterKey
+	keyRing   *keyRing
+	keyStates *keyStates
+	lock      *sync.RWMutex
+	password  string
+	logger    logger.Logger
 }
 
 func (km *keyManager) IsEmpty() (bool, error) {
@@ -182,6 +204,18 @@ func (km *keyManager) Unlock(password string) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to get encrypted key ring")
 	}
+	sealed := envelope.IsSealed(ekr.EncryptedKeys)
+	if sealed {
+		if km.masterKey == nil {
+			return errors.Errorf("key ring is envelope encrypted with master key %s, but no master key is configured", envelope.MasterKeyID(ekr.EncryptedKeys))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), envelope.DefaultTimeout)
+		defer cancel()
+		ekr.EncryptedKeys, err = envelope.Open(ctx, km.masterKey, ekr.EncryptedKeys)
+		if err != nil {
+			return errors.Wrap(err, "unable to open envelope encrypted key ring")
+		}
+	}
 	kr, err := ekr.Decrypt(password)
 	if err != nil {
 		return errors.Wrap(err, "unable to decrypt encrypted key ring")
@@ -196,6 +230,39 @@ func (km *keyManager) Unlock(password string) error {
 	km.keyStates = ks
 
 	km.password = password
+
+	if km.masterKey != nil && !sealed && len(ekr.EncryptedKeys) > 0 {
+		// re-encrypt the key ring stored before envelope encryption was enabled
+		if err = km.save(); err != nil {
+			km.password = ""
+			return errors.Wrap(err, "unable to envelope encrypt key ring")
+		}
+		km.logger.Infow("Envelope encrypted key ring", "masterKeyID", km.masterKey.ID())
+	}
+	return nil
+}
+
+func (km *keyManager) RotateMasterKey(masterKey envelope.MasterKey) error {
+	if masterKey == nil {
+		return errors.New("master key is required")
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	old := km.masterKey
+	km.masterKey = masterKey
+	// save always seals with a new data key, so the whole key ring is re-encrypted
+	if err := km.save(); err != nil {
+		km.masterKey = old
+		return errors.Wrap(err, "unable to re-encrypt key ring")
+	}
+	if old != nil {
+		km.logger.Infow("Rotated keystore master key", "oldMasterKeyID", old.ID(), "masterKeyID", masterKey.ID())
+	} else {
+		km.logger.Infow("Enabled keystore envelope encryption", "masterKeyID", masterKey.ID())
+	}
 	return nil
 }
 
@@ -205,6 +272,14 @@ func (km *keyManager) save(callbacks ...func(pg.Queryer) error) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to encrypt keyRing")
 	}
+	if km.masterKey != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), envelope.DefaultTimeout)
+		defer cancel()
+		ekb.EncryptedKeys, err = envelope.Seal(ctx, km.masterKey, ekb.EncryptedKeys)
+		if err != nil {
+			return errors.Wrap(err, "unable to envelope encrypt keyRing")
+		}
+	}
 	return km.orm.saveEncryptedKeyRing(&ekb, callbacks...)
 }
 
@@ -274,6 +349,8 @@ func GetFieldNameForKey(unknownKey Key) (string, error) {
 		return "Solana", nil
 	case starkkey.Key:
 		return "StarkNet", nil
+	case aptoskey.Key:
+		return "Aptos", nil
 	case vrfkey.KeyV2:
 		return "VRF", nil
 	case dkgsignkey.Key:
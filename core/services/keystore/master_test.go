@@ -2,6 +2,9 @@ package keystore_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/envelope"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -61,3 +65,55 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_EnvelopeEncryption(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	newMasterKey := func(t *testing.T, key string) envelope.MasterKey {
+		path := filepath.Join(t.TempDir(), "master.key")
+		require.NoError(t, os.WriteFile(path, []byte(strings.Repeat(key, 32)), 0600))
+		mk, err := envelope.NewFileKey(path)
+		require.NoError(t, err)
+		return mk
+	}
+	mk1, mk2 := newMasterKey(t, "01"), newMasterKey(t, "02")
+
+	// store a key ring without envelope encryption
+	plain := keystore.ExposedNewMaster(t, db, cfg.Database())
+	require.NoError(t, plain.Unlock(cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, plain.Eth())
+	require.False(t, envelope.IsSealed(plain.ExportedEncryptedKeys()))
+
+	t.Run("re-encrypts a plain key ring on unlock", func(t *testing.T) {
+		ks := keystore.ExposedNewMasterWithMasterKey(t, db, mk1, cfg.Database())
+		require.NoError(t, ks.Unlock(cltest.Password))
+		encrypted := ks.ExportedEncryptedKeys()
+		require.True(t, envelope.IsSealed(encrypted))
+		require.Equal(t, mk1.ID(), envelope.MasterKeyID(encrypted))
+		_, err := ks.Eth().Get(key.Address.Hex())
+		require.NoError(t, err)
+	})
+
+	t.Run("requires the master key", func(t *testing.T) {
+		ks := keystore.ExposedNewMaster(t, db, cfg.Database())
+		require.ErrorContains(t, ks.Unlock(cltest.Password), "no master key is configured")
+		ks = keystore.ExposedNewMasterWithMasterKey(t, db, mk2, cfg.Database())
+		require.ErrorIs(t, ks.Unlock(cltest.Password), envelope.ErrMasterKeyMismatch)
+	})
+
+	t.Run("rotates the master key", func(t *testing.T) {
+		ks := keystore.ExposedNewMasterWithMasterKey(t, db, mk1, cfg.Database())
+		require.ErrorIs(t, ks.RotateMasterKey(mk2), keystore.ErrLocked)
+		require.NoError(t, ks.Unlock(cltest.Password))
+		require.NoError(t, ks.RotateMasterKey(mk2))
+		require.Equal(t, mk2.ID(), envelope.MasterKeyID(ks.ExportedEncryptedKeys()))
+
+		ks = keystore.ExposedNewMasterWithMasterKey(t, db, mk2, cfg.Database())
+		require.NoError(t, ks.Unlock(cltest.Password))
+		_, err := ks.Eth().Get(key.Address.Hex())
+		require.NoError(t, err)
+	})
+}
@@ -535,6 +535,36 @@ func (_m *Eth) Import(keyJSON []byte, password string, chainIDs ...*big.Int) (et
 	return r0, r1
 }
 
+// SignDigest provides a mock function with given fields: fromAddress, digest
+func (_m *Eth) SignDigest(fromAddress common.Address, digest common.Hash) ([]byte, error) {
+	ret := _m.Called(fromAddress, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignDigest")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Address, common.Hash) ([]byte, error)); ok {
+		return rf(fromAddress, digest)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, common.Hash) []byte); ok {
+		r0 = rf(fromAddress, digest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, common.Hash) error); ok {
+		r1 = rf(fromAddress, digest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: fromAddress, tx, chainID
 func (_m *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(fromAddress, tx, chainID)
@@ -4,6 +4,8 @@ package mocks
 
 import (
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	envelope "github.com/smartcontractkit/chainlink/v2/core/services/keystore/envelope"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -12,6 +14,26 @@ type Master struct {
 	mock.Mock
 }
 
+// Aptos provides a mock function with given fields:
+func (_m *Master) Aptos() keystore.Aptos {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Aptos")
+	}
+
+	var r0 keystore.Aptos
+	if rf, ok := ret.Get(0).(func() keystore.Aptos); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.Aptos)
+		}
+	}
+
+	return r0
+}
+
 // CSA provides a mock function with given fields:
 func (_m *Master) CSA() keystore.CSA {
 	ret := _m.Called()
@@ -200,6 +222,24 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// RotateMasterKey provides a mock function with given fields: masterKey
+func (_m *Master) RotateMasterKey(masterKey envelope.MasterKey) error {
+	ret := _m.Called(masterKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateMasterKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(envelope.MasterKey) error); ok {
+		r0 = rf(masterKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -0,0 +1,281 @@
+package loopjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/plugins"
+)
+
+// closeTimeout bounds how long the plugin of a job has to close its services.
+const closeTimeout = 30 * time.Second
+
+// pluginClient is the node side of a plugin.
+type pluginClient interface {
+	ValidateSpec(ctx context.Context, spec Spec) error
+	StartJob(ctx context.Context, spec Spec) error
+	CloseJob(ctx context.Context, jobID int32) error
+}
+
+var _ pluginClient = (*rpcClient)(nil)
+
+// ParsePluginCmds parses the comma separated name=cmd pairs of CL_JOB_PLUGINS.
+func ParsePluginCmds(s string) (map[string]string, error) {
+	cmds := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, cmd, ok := strings.Cut(pair, "=")
+		name, cmd = strings.TrimSpace(name), strings.TrimSpace(cmd)
+		if !ok || name == "" || cmd == "" {
+			return nil, fmt.Errorf("invalid job plugin %q: expected name=cmd", pair)
+		}
+		if _, exists := cmds[name]; exists {
+			return nil, fmt.Errorf("duplicate job plugin %q", name)
+		}
+		cmds[name] = cmd
+	}
+	return cmds, nil
+}
+
+// Delegate runs the jobs of type loop with the plugins implementing them. The process of a plugin is started with
+// its first job, and restarted if it exits.
+type Delegate struct {
+	services.StateMachine
+	lggr      logger.Logger
+	registrar plugins.RegistrarConfig
+	cmds      map[string]string
+
+	mu      sync.Mutex
+	plugins map[string]*loopPlugin
+	// dial starts the plugin with name, returning its client. It is overridden in tests.
+	dial func(name string) (*plugin.Client, pluginClient, error)
+}
+
+var (
+	_ job.Delegate      = (*Delegate)(nil)
+	_ job.SpecValidator = (*Delegate)(nil)
+)
+
+type loopPlugin struct {
+	cmdFn  func() *exec.Cmd
+	proc   *plugin.Client
+	client pluginClient
+}
+
+// NewDelegate returns a delegate running the plugins of cmds, which are keyed by the names jobs refer to them by.
+func NewDelegate(lggr logger.Logger, registrar plugins.RegistrarConfig, cmds map[string]string) *Delegate {
+	d := &Delegate{
+		lggr:      lggr.Named("LOOPJob"),
+		registrar: registrar,
+		cmds:      cmds,
+		plugins:   map[string]*loopPlugin{},
+	}
+	d.dial = d.dialPlugin
+	return d
+}
+
+func (d *Delegate) JobType() job.Type {
+	return job.LOOP
+}
+
+// Plugins returns the names of the registered plugins.
+func (d *Delegate) Plugins() []string {
+	names := make([]string, 0, len(d.cmds))
+	for name := range d.cmds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (d *Delegate) Start(context.Context) error {
+	return d.StartOnce("LOOPJobDelegate", func() error {
+		if len(d.cmds) > 0 {
+			d.lggr.Infow("Registered job plugins", "plugins", d.Plugins())
+		}
+		return nil
+	})
+}
+
+// Close stops the processes of the plugins.
+func (d *Delegate) Close() error {
+	return d.StopOnce("LOOPJobDelegate", func() error {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		for _, p := range d.plugins {
+			if p.proc != nil {
+				p.proc.Kill()
+			}
+		}
+		return nil
+	})
+}
+
+func (d *Delegate) Name() string {
+	return d.lggr.Name()
+}
+
+// HealthReport reports the plugins whose process exited.
+func (d *Delegate) HealthReport() map[string]error {
+	report := map[string]error{d.Name(): d.Healthy()}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for name, p := range d.plugins {
+		var err error
+		if p.proc != nil && p.proc.Exited() {
+			err = errors.New("plugin exited")
+		}
+		report[d.Name()+"."+name] = err
+	}
+	return report
+}
+
+func (d *Delegate) BeforeJobCreated(spec job.Job)                {}
+func (d *Delegate) AfterJobCreated(spec job.Job)                 {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job)                {}
+func (d *Delegate) OnDeleteJob(spec job.Job, q pg.Queryer) error { return nil }
+
+// ValidateSpec validates the spec of a job with the plugin implementing it.
+func (d *Delegate) ValidateSpec(ctx context.Context, jb job.Job) error {
+	spec, err := specOf(jb)
+	if err != nil {
+		return err
+	}
+	client, err := d.plugin(jb.LOOPSpec.PluginName)
+	if err != nil {
+		return err
+	}
+	return client.ValidateSpec(ctx, spec)
+}
+
+// ServicesForSpec returns a service starting the job in its plugin.
+func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
+	spec, err := specOf(jb)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := d.cmds[jb.LOOPSpec.PluginName]; !ok {
+		return nil, fmt.Errorf("job plugin %q is not registered", jb.LOOPSpec.PluginName)
+	}
+	return []job.ServiceCtx{&jobService{d: d, name: jb.LOOPSpec.PluginName, spec: spec}}, nil
+}
+
+func specOf(jb job.Job) (Spec, error) {
+	if jb.LOOPSpec == nil {
+		return Spec{}, fmt.Errorf("loopjob.Delegate expects a *job.LOOPSpec to be present, got %v", jb)
+	}
+	return Spec{
+		JobID:         jb.ID,
+		ExternalJobID: jb.ExternalJobID,
+		Name:          jb.Name.ValueOrZero(),
+		Config:        jb.LOOPSpec.PluginConfig.Bytes(),
+	}, nil
+}
+
+// plugin returns the client of the plugin with name, starting its process if it is not running.
+func (d *Delegate) plugin(name string) (pluginClient, error) {
+	if _, ok := d.cmds[name]; !ok {
+		return nil, fmt.Errorf("job plugin %q is not registered", name)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if p, ok := d.plugins[name]; ok && p.client != nil && (p.proc == nil || !p.proc.Exited()) {
+		return p.client, nil
+	}
+	proc, client, err := d.dial(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start job plugin %q: %w", name, err)
+	}
+	p := d.plugins[name]
+	if p == nil {
+		p = &loopPlugin{}
+		d.plugins[name] = p
+	}
+	p.proc, p.client = proc, client
+	return client, nil
+}
+
+// runningPlugin returns the client of the plugin with name, or nil if its process is not running.
+func (d *Delegate) runningPlugin(name string) pluginClient {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if p, ok := d.plugins[name]; ok && p.client != nil && (p.proc == nil || !p.proc.Exited()) {
+		return p.client
+	}
+	return nil
+}
+
+// dialPlugin starts the process of the plugin with name. It must be called with mu held.
+func (d *Delegate) dialPlugin(name string) (*plugin.Client, pluginClient, error) {
+	p := d.plugins[name]
+	if p == nil {
+		cmdFn, _, err := d.registrar.RegisterLOOP(d.lggr.Name()+"."+name, d.cmds[name])
+		if err != nil {
+			return nil, nil, err
+		}
+		p = &loopPlugin{cmdFn: cmdFn}
+		d.plugins[name] = p
+	}
+	proc := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  handshakeConfig,
+		Plugins:          plugin.PluginSet{pluginName: &rpcPlugin{}},
+		Cmd:              p.cmdFn(),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolNetRPC},
+		Logger:           loop.HCLogLogger(d.lggr.Named(name)),
+	})
+	rpcClient, err := proc.Client()
+	if err != nil {
+		proc.Kill()
+		return nil, nil, err
+	}
+	raw, err := rpcClient.Dispense(pluginName)
+	if err != nil {
+		proc.Kill()
+		return nil, nil, err
+	}
+	d.lggr.Infow("Started job plugin", "plugin", name)
+	return proc, raw.(pluginClient), nil
+}
+
+// jobService starts a job in its plugin, and stops it when closed.
+type jobService struct {
+	d    *Delegate
+	name string
+	spec Spec
+}
+
+func (s *jobService) Start(ctx context.Context) error {
+	client, err := s.d.plugin(s.name)
+	if err != nil {
+		return err
+	}
+	return client.StartJob(ctx, s.spec)
+}
+
+func (s *jobService) Close() error {
+	client := s.d.runningPlugin(s.name)
+	if client == nil {
+		// the services of the job stopped with the plugin
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	return client.CloseJob(ctx, s.spec.JobID)
+}
@@ -0,0 +1,183 @@
+package loopjob
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+type testService struct {
+	name   string
+	events *[]string
+	mu     *sync.Mutex
+	err    error
+}
+
+func (s *testService) Start(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.events = append(*s.events, "start "+s.name)
+	return s.err
+}
+
+func (s *testService) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.events = append(*s.events, "close "+s.name)
+	return nil
+}
+
+type testJobType struct {
+	mu     sync.Mutex
+	events []string
+	specs  []Spec
+}
+
+func (j *testJobType) ValidateSpec(_ context.Context, spec Spec) error {
+	if string(spec.Config) == `{"invalid":true}` {
+		return errors.New("invalid config")
+	}
+	return nil
+}
+
+func (j *testJobType) NewServices(_ context.Context, spec Spec) ([]Service, error) {
+	j.mu.Lock()
+	j.specs = append(j.specs, spec)
+	j.mu.Unlock()
+	var err error
+	if spec.Name == "broken" {
+		err = errors.New("boom")
+	}
+	return []Service{
+		&testService{name: "a", events: &j.events, mu: &j.mu},
+		&testService{name: "b", events: &j.events, mu: &j.mu, err: err},
+	}, nil
+}
+
+func (j *testJobType) Events() []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]string{}, j.events...)
+}
+
+func newTestDelegate(t *testing.T, jobType JobType) *Delegate {
+	client, _ := plugin.TestPluginRPCConn(t, plugin.PluginSet{pluginName: &rpcPlugin{jobType: jobType}}, nil)
+	t.Cleanup(func() { assert.NoError(t, client.Close()) })
+	raw, err := client.Dispense(pluginName)
+	require.NoError(t, err)
+
+	d := NewDelegate(logger.TestLogger(t), nil, map[string]string{"test": "/bin/test-plugin"})
+	d.dial = func(name string) (*plugin.Client, pluginClient, error) {
+		return nil, raw.(pluginClient), nil
+	}
+	return d
+}
+
+func TestDelegate(t *testing.T) {
+	t.Parallel()
+
+	jobType := &testJobType{}
+	d := newTestDelegate(t, jobType)
+	ctx := testutils.Context(t)
+	jb := job.Job{ID: 7, Type: job.LOOP, Name: null.StringFrom("my job"), LOOPSpec: &job.LOOPSpec{PluginName: "test", PluginConfig: job.JSONConfig{"foo": "bar"}}}
+
+	t.Run("validates specs with the plugin", func(t *testing.T) {
+		require.NoError(t, d.ValidateSpec(ctx, jb))
+
+		invalid := jb
+		invalid.LOOPSpec = &job.LOOPSpec{PluginName: "test", PluginConfig: job.JSONConfig{"invalid": true}}
+		require.ErrorContains(t, d.ValidateSpec(ctx, invalid), "invalid config")
+
+		unknown := jb
+		unknown.LOOPSpec = &job.LOOPSpec{PluginName: "other"}
+		require.ErrorContains(t, d.ValidateSpec(ctx, unknown), `job plugin "other" is not registered`)
+		_, err := d.ServicesForSpec(unknown)
+		require.ErrorContains(t, err, `job plugin "other" is not registered`)
+	})
+
+	t.Run("runs jobs in the plugin", func(t *testing.T) {
+		srvs, err := d.ServicesForSpec(jb)
+		require.NoError(t, err)
+		require.Len(t, srvs, 1)
+
+		require.NoError(t, srvs[0].Start(ctx))
+		require.Len(t, jobType.specs, 1)
+		assert.Equal(t, Spec{JobID: 7, Name: "my job", Config: []byte(`{"foo":"bar"}`)}, jobType.specs[0])
+		require.ErrorContains(t, srvs[0].Start(ctx), "job 7 is already started")
+
+		require.NoError(t, srvs[0].Close())
+		assert.Equal(t, []string{"start a", "start b", "close b", "close a"}, jobType.Events())
+	})
+
+	t.Run("closes the started services of jobs which fail to start", func(t *testing.T) {
+		jobType.mu.Lock()
+		jobType.events = nil
+		jobType.mu.Unlock()
+
+		broken := jb
+		broken.ID = 8
+		broken.Name = null.StringFrom("broken")
+		srvs, err := d.ServicesForSpec(broken)
+		require.NoError(t, err)
+		require.ErrorContains(t, srvs[0].Start(ctx), "boom")
+		assert.Equal(t, []string{"start a", "start b", "close a"}, jobType.Events())
+	})
+}
+
+func TestParsePluginCmds(t *testing.T) {
+	t.Parallel()
+
+	cmds, err := ParsePluginCmds("")
+	require.NoError(t, err)
+	assert.Empty(t, cmds)
+
+	cmds, err = ParsePluginCmds("foo=/bin/foo, bar = /usr/local/bin/bar ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "/bin/foo", "bar": "/usr/local/bin/bar"}, cmds)
+
+	_, err = ParsePluginCmds("foo")
+	require.ErrorContains(t, err, "expected name=cmd")
+	_, err = ParsePluginCmds("foo=/bin/foo,foo=/bin/bar")
+	require.ErrorContains(t, err, `duplicate job plugin "foo"`)
+}
+
+func TestValidatedSpec(t *testing.T) {
+	t.Parallel()
+
+	jb, err := ValidatedSpec(`
+type = "loop"
+schemaVersion = 1
+name = "my job"
+pluginName = "test"
+
+[pluginConfig]
+foo = "bar"
+`)
+	require.NoError(t, err)
+	assert.Equal(t, job.LOOP, jb.Type)
+	assert.Equal(t, "test", jb.LOOPSpec.PluginName)
+	assert.Equal(t, job.JSONConfig{"foo": "bar"}, jb.LOOPSpec.PluginConfig)
+
+	_, err = ValidatedSpec(`
+type = "loop"
+schemaVersion = 1
+`)
+	require.ErrorContains(t, err, "pluginName is required")
+
+	_, err = ValidatedSpec(`
+type = "cron"
+schemaVersion = 1
+pluginName = "test"
+`)
+	require.ErrorContains(t, err, "unsupported type cron")
+}
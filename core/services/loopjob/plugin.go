@@ -0,0 +1,53 @@
+// Package loopjob implements the job types of LOOP plugins, which run out of process and are registered with the
+// node by name, so that experimental job types can be developed without changes to the node.
+//
+// Plugins implement JobType and call Serve from their main function. The node runs the plugins configured with
+// CL_JOB_PLUGINS, and delegates the jobs of type "loop" to the plugin named by their pluginName:
+//
+//	type = "loop"
+//	schemaVersion = 1
+//	name = "my job"
+//	pluginName = "my-plugin"
+//
+//	[pluginConfig]
+//	foo = "bar"
+package loopjob
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-plugin"
+)
+
+// Spec is the spec of a job, as seen by the plugin implementing it.
+type Spec struct {
+	JobID         int32
+	ExternalJobID uuid.UUID
+	Name          string
+	// Config is the JSON encoded pluginConfig of the job.
+	Config []byte
+}
+
+// Service is a service of a job, started when the job is started and closed when it is stopped.
+type Service interface {
+	Start(context.Context) error
+	Close() error
+}
+
+// JobType is a job type implemented by a plugin.
+type JobType interface {
+	// ValidateSpec validates the spec of a job before it is created.
+	ValidateSpec(ctx context.Context, spec Spec) error
+	// NewServices returns the services of a job, which are started in order and closed in reverse order.
+	NewServices(ctx context.Context, spec Spec) ([]Service, error)
+}
+
+// Serve serves jobType to the node which started the plugin, and blocks until the node stops it. It must be called
+// from the main function of the plugin.
+func Serve(jobType JobType) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: handshakeConfig,
+		Plugins:         plugin.PluginSet{pluginName: &rpcPlugin{jobType: jobType}},
+	})
+}
@@ -0,0 +1,114 @@
+package loopjob
+
+import (
+	"context"
+	"fmt"
+	"net/rpc"
+	"sync"
+
+	"github.com/hashicorp/go-plugin"
+	"go.uber.org/multierr"
+)
+
+const pluginName = "job"
+
+var handshakeConfig = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "CL_LOOP_JOB_PLUGIN",
+	MagicCookieValue: "a0c1d5e4-3c54-4b8e-9b5f-2d0f6e1c7a93",
+}
+
+// rpcPlugin serves a JobType over net/rpc.
+type rpcPlugin struct {
+	jobType JobType
+}
+
+var _ plugin.Plugin = (*rpcPlugin)(nil)
+
+func (p *rpcPlugin) Server(*plugin.MuxBroker) (any, error) {
+	return &rpcServer{jobType: p.jobType, jobs: map[int32][]Service{}}, nil
+}
+
+func (p *rpcPlugin) Client(_ *plugin.MuxBroker, c *rpc.Client) (any, error) {
+	return &rpcClient{c: c}, nil
+}
+
+// rpcServer runs the services of the jobs of a JobType in the plugin.
+type rpcServer struct {
+	jobType JobType
+
+	mu   sync.Mutex
+	jobs map[int32][]Service
+}
+
+func (s *rpcServer) ValidateSpec(spec Spec, _ *struct{}) error {
+	return s.jobType.ValidateSpec(context.Background(), spec)
+}
+
+func (s *rpcServer) StartJob(spec Spec, _ *struct{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[spec.JobID]; ok {
+		return fmt.Errorf("job %d is already started", spec.JobID)
+	}
+
+	ctx := context.Background()
+	srvs, err := s.jobType.NewServices(ctx, spec)
+	if err != nil {
+		return fmt.Errorf("failed to create services: %w", err)
+	}
+	for i, srv := range srvs {
+		if err = srv.Start(ctx); err != nil {
+			return multierr.Append(fmt.Errorf("failed to start service %d: %w", i, err), closeServices(srvs[:i]))
+		}
+	}
+	s.jobs[spec.JobID] = srvs
+	return nil
+}
+
+func (s *rpcServer) CloseJob(jobID int32, _ *struct{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	srvs, ok := s.jobs[jobID]
+	if !ok {
+		return nil
+	}
+	delete(s.jobs, jobID)
+	return closeServices(srvs)
+}
+
+// closeServices closes srvs in reverse order.
+func closeServices(srvs []Service) (err error) {
+	for i := len(srvs) - 1; i >= 0; i-- {
+		err = multierr.Append(err, srvs[i].Close())
+	}
+	return
+}
+
+// rpcClient is the node side of a plugin.
+type rpcClient struct {
+	c *rpc.Client
+}
+
+func (c *rpcClient) ValidateSpec(ctx context.Context, spec Spec) error {
+	return c.call(ctx, "Plugin.ValidateSpec", spec)
+}
+
+func (c *rpcClient) StartJob(ctx context.Context, spec Spec) error {
+	return c.call(ctx, "Plugin.StartJob", spec)
+}
+
+func (c *rpcClient) CloseJob(ctx context.Context, jobID int32) error {
+	return c.call(ctx, "Plugin.CloseJob", jobID)
+}
+
+// call calls method of the plugin, returning early if ctx is done.
+func (c *rpcClient) call(ctx context.Context, method string, args any) error {
+	call := c.c.Go(method, args, &struct{}{}, make(chan *rpc.Call, 1))
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-call.Done:
+		return call.Error
+	}
+}
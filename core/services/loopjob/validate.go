@@ -0,0 +1,40 @@
+package loopjob
+
+import (
+	"github.com/google/uuid"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// ValidatedSpec parses the spec of a loop job. The spec is validated further by its plugin when the job is created.
+func ValidatedSpec(tomlString string) (job.Job, error) {
+	var jb = job.Job{ExternalJobID: uuid.New()}
+
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml error on load")
+	}
+
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on spec")
+	}
+
+	var spec job.LOOPSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on job")
+	}
+
+	jb.LOOPSpec = &spec
+	if jb.Type != job.LOOP {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+	if spec.PluginName == "" {
+		return jb, errors.New("pluginName is required")
+	}
+
+	return jb, nil
+}
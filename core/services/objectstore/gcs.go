@@ -0,0 +1,85 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/cloudauth"
+)
+
+// gcs is a bucket of Google Cloud Storage, accessed with its JSON API.
+type gcs struct {
+	client   *http.Client
+	bucket   string
+	endpoint string
+	tokens   *cloudauth.GCPTokenSource
+}
+
+func newGCSFromEnv(client *http.Client, bucket, endpoint string) *gcs {
+	if endpoint == "" {
+		endpoint = "https://storage.googleapis.com"
+	}
+	return &gcs{
+		client:   client,
+		bucket:   bucket,
+		endpoint: strings.TrimRight(endpoint, "/"),
+		tokens:   cloudauth.NewGCPTokenSourceFromEnv(client),
+	}
+}
+
+func (g *gcs) Put(ctx context.Context, key string, data []byte) error {
+	u := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=media&name=%s", g.endpoint, url.PathEscape(g.bucket), url.QueryEscape(key))
+	_, err := g.do(ctx, http.MethodPost, u, key, data)
+	return err
+}
+
+func (g *gcs) Get(ctx context.Context, key string) ([]byte, error) {
+	u := fmt.Sprintf("%s/storage/v1/b/%s/o/%s?alt=media", g.endpoint, url.PathEscape(g.bucket), url.PathEscape(key))
+	return g.do(ctx, http.MethodGet, u, key, nil)
+}
+
+func (g *gcs) do(ctx context.Context, method, u, key string, body []byte) ([]byte, error) {
+	b, status, err := g.request(ctx, method, u, body)
+	if (status == http.StatusUnauthorized || status == http.StatusForbidden) && !g.tokens.Static() {
+		// The cached token may have been revoked, so retry once with a new one.
+		g.tokens.Invalidate()
+		b, status, err = g.request(ctx, method, u, body)
+	}
+	if status == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	return b, err
+}
+
+func (g *gcs) request(ctx context.Context, method, u string, body []byte) ([]byte, int, error) {
+	token, err := g.tokens.Token(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get access token: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, fmt.Errorf("cloud storage responded with %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	return b, resp.StatusCode, nil
+}
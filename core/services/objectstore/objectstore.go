@@ -0,0 +1,67 @@
+// Package objectstore reads and writes objects in S3, Google Cloud Storage or a local directory.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// ErrNotFound is returned by Get if the object does not exist.
+var ErrNotFound = errors.New("object not found")
+
+// Store is a bucket of objects.
+type Store interface {
+	// Put creates or replaces the object with the key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the object with the key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// New returns the Store of u, which is one of:
+//   - s3://bucket/prefix, authenticated with the standard AWS environment variables
+//   - gs://bucket/prefix, authenticated with GOOGLE_OAUTH_ACCESS_TOKEN or the service account of the instance
+//   - file:///path/to/dir
+//
+// The keys of the objects are relative to the prefix. endpoint overrides the endpoint of S3 or GCS if set, e.g. for
+// S3 compatible stores.
+func New(u *url.URL, endpoint string, client *http.Client) (Store, error) {
+	prefix := strings.Trim(u.Path, "/")
+	switch u.Scheme {
+	case "s3":
+		if u.Host == "" {
+			return nil, errors.New("s3 URL must include a bucket")
+		}
+		return &prefixed{newS3FromEnv(client, u.Host, endpoint), prefix}, nil
+	case "gs":
+		if u.Host == "" {
+			return nil, errors.New("gs URL must include a bucket")
+		}
+		return &prefixed{newGCSFromEnv(client, u.Host, endpoint), prefix}, nil
+	case "file":
+		if u.Path == "" {
+			return nil, errors.New("file URL must include a path")
+		}
+		return &dir{root: u.Path}, nil
+	default:
+		return nil, fmt.Errorf("unsupported object store scheme %q: must be s3, gs or file", u.Scheme)
+	}
+}
+
+// prefixed prepends a prefix to the keys of a Store.
+type prefixed struct {
+	Store
+	prefix string
+}
+
+func (p *prefixed) Put(ctx context.Context, key string, data []byte) error {
+	return p.Store.Put(ctx, path.Join(p.prefix, key), data)
+}
+
+func (p *prefixed) Get(ctx context.Context, key string) ([]byte, error) {
+	return p.Store.Get(ctx, path.Join(p.prefix, key))
+}
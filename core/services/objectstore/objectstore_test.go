@@ -0,0 +1,156 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils/cloudauth"
+)
+
+// fakeBucket serves the objects of a bucket, keyed by the path of their requests.
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	// authorized returns true if the request is authenticated.
+	authorized func(r *http.Request) bool
+	// key returns the key of a request.
+	key func(r *http.Request) string
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !b.authorized(r) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("denied"))
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := b.key(r)
+	switch r.Method {
+	case http.MethodPut, http.MethodPost:
+		data, _ := io.ReadAll(r.Body)
+		b.objects[key] = data
+	case http.MethodGet:
+		data, ok := b.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}
+}
+
+func testStore(t *testing.T, s Store) {
+	ctx := context.Background()
+	require.NoError(t, s.Put(ctx, "a/b.jsonl.gz", []byte("data")))
+	data, err := s.Get(ctx, "a/b.jsonl.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	_, err = s.Get(ctx, "a/missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	b := &fakeBucket{
+		objects: make(map[string][]byte),
+		authorized: func(r *http.Request) bool {
+			return strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") &&
+				strings.Contains(r.Header.Get("Authorization"), "/us-east-1/s3/aws4_request") &&
+				r.Header.Get("X-Amz-Content-Sha256") != ""
+		},
+		key: func(r *http.Request) string { return r.URL.Path },
+	}
+	srv := httptest.NewServer(b)
+	t.Cleanup(srv.Close)
+
+	s := &s3{client: srv.Client(), bucket: "bucket", region: "us-east-1", endpoint: srv.URL, now: time.Now,
+		creds: cloudauth.AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}}
+	testStore(t, &prefixed{s, "archive"})
+	assert.Contains(t, b.objects, "/bucket/archive/a/b.jsonl.gz")
+
+	s.creds.SecretAccessKey = ""
+	_, err := s.Get(context.Background(), "a/b.jsonl.gz")
+	assert.EqualError(t, err, "AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+	s.creds = cloudauth.AWSCredentials{AccessKeyID: "other", SecretAccessKey: "secret"}
+	_, err = s.Get(context.Background(), "a/b.jsonl.gz")
+	assert.EqualError(t, err, "s3 responded with 403 Forbidden: denied")
+
+	u, err := (&s3{bucket: "bucket", region: "eu-west-1"}).objectURL("a/b")
+	require.NoError(t, err)
+	assert.Equal(t, "https://bucket.s3.eu-west-1.amazonaws.com/a/b", u)
+}
+
+func TestGCS(t *testing.T) {
+	t.Parallel()
+
+	b := &fakeBucket{
+		objects: make(map[string][]byte),
+		authorized: func(r *http.Request) bool {
+			return r.Header.Get("Authorization") == "Bearer token"
+		},
+		key: func(r *http.Request) string {
+			if r.Method == http.MethodPost {
+				return r.URL.Query().Get("name")
+			}
+			return strings.TrimPrefix(r.URL.Path, "/storage/v1/b/bucket/o/")
+		},
+	}
+	srv := httptest.NewServer(b)
+	t.Cleanup(srv.Close)
+
+	g := &gcs{client: srv.Client(), bucket: "bucket", endpoint: srv.URL, tokens: cloudauth.NewGCPTokenSource(srv.Client(), "token", "")}
+	testStore(t, &prefixed{g, "archive"})
+	assert.Contains(t, b.objects, "archive/a/b.jsonl.gz")
+
+	g.tokens = cloudauth.NewGCPTokenSource(srv.Client(), "other", "")
+	_, err := g.Get(context.Background(), "archive/a/b.jsonl.gz")
+	assert.EqualError(t, err, "cloud storage responded with 403 Forbidden: denied")
+}
+
+func TestDir(t *testing.T) {
+	t.Parallel()
+
+	u := &url.URL{Scheme: "file", Path: t.TempDir()}
+	s, err := New(u, "", nil)
+	require.NoError(t, err)
+	testStore(t, s)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		url, err string
+	}{
+		{"s3://bucket/prefix", ""},
+		{"gs://bucket", ""},
+		{"s3:///prefix", "s3 URL must include a bucket"},
+		{"gs:///prefix", "gs URL must include a bucket"},
+		{"file://", "file URL must include a path"},
+		{"ftp://host/dir", `unsupported object store scheme "ftp": must be s3, gs or file`},
+	} {
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			require.NoError(t, err)
+			_, err = New(u, "", http.DefaultClient)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/libocr/gethwrappers/offchainaggregator"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
 
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
 )
 
@@ -54,7 +55,10 @@ func NewOCRContractTransmitter(
 	}
 }
 
-func (oc *OCRContractTransmitter) Transmit(ctx context.Context, report []byte, rs, ss [][32]byte, vs [32]byte) error {
+func (oc *OCRContractTransmitter) Transmit(ctx context.Context, report []byte, rs, ss [][32]byte, vs [32]byte) (err error) {
+	ctx, span := tracing.Start(ctx, "ocr.transmit")
+	defer func() { tracing.End(span, err) }()
+
 	payload, err := oc.contractABI.Pack("transmit", report, rs, ss, vs)
 	if err != nil {
 		return errors.Wrap(err, "abi.Pack failed")
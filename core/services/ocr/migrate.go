@@ -0,0 +1,118 @@
+package ocr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// OCR2MigrationOpts are the settings of an OCR2 job which have no equivalent in an OCR job.
+type OCR2MigrationOpts struct {
+	// ContractID is the address of the OCR2 aggregator, the OCR contract address is used if empty.
+	ContractID string
+	// OCRKeyBundleID is the OCR2 key bundle of the job, the OCR2.KeyBundleID of the node is used if empty.
+	OCRKeyBundleID string
+	// JuelsPerFeeCoinSource is the pipeline of the median plugin computing the LINK per native token.
+	// Bootstrap jobs do not need it.
+	JuelsPerFeeCoinSource string
+}
+
+// MigrateToOCR2Spec returns the TOML spec of the OCR2 job equivalent to the given OCR job:
+// a median job, or a bootstrap job for bootstrap peers. The job keeps its external job ID,
+// name, pipeline, transmitter, bootstrappers and contract config tracking settings.
+// OCR timeouts with no OCR2 job equivalent (observationTimeout, databaseTimeout, etc.)
+// are dropped, as they are part of the OCR2 contract config.
+func MigrateToOCR2Spec(jb job.Job, opts OCR2MigrationOpts) (string, error) {
+	if jb.Type != job.OffchainReporting || jb.OCROracleSpec == nil {
+		return "", errors.Errorf("job %d is not an OCR job", jb.ID)
+	}
+	spec := jb.OCROracleSpec
+	if spec.EVMChainID == nil {
+		return "", errors.New("evmChainID is missing")
+	}
+	contractID := opts.ContractID
+	if contractID == "" {
+		contractID = spec.ContractAddress.String()
+	}
+
+	var b strings.Builder
+	if spec.IsBootstrapPeer {
+		b.WriteString("type = \"bootstrap\"\n")
+	} else {
+		b.WriteString("type = \"offchainreporting2\"\n")
+		b.WriteString("pluginType = \"median\"\n")
+	}
+	b.WriteString("schemaVersion = 1\n")
+	if jb.Name.Valid {
+		fmt.Fprintf(&b, "name = %s\n", strconv.Quote(jb.Name.String))
+	}
+	fmt.Fprintf(&b, "externalJobID = %q\n", jb.ExternalJobID)
+	b.WriteString("relay = \"evm\"\n")
+	fmt.Fprintf(&b, "contractID = %q\n", contractID)
+	writeInterval(&b, "blockchainTimeout", spec.BlockchainTimeout)
+	writeInterval(&b, "contractConfigTrackerPollInterval", spec.ContractConfigTrackerPollInterval)
+	if spec.ContractConfigConfirmations > 0 {
+		fmt.Fprintf(&b, "contractConfigConfirmations = %d\n", spec.ContractConfigConfirmations)
+	}
+
+	if !spec.IsBootstrapPeer {
+		if spec.TransmitterAddress == nil {
+			return "", errors.New("transmitterAddress is missing")
+		}
+		if strings.TrimSpace(opts.JuelsPerFeeCoinSource) == "" {
+			return "", errors.New("juelsPerFeeCoinSource is required for median jobs")
+		}
+		if jb.PipelineSpec == nil {
+			return "", errors.New("observationSource is missing")
+		}
+		if opts.OCRKeyBundleID != "" {
+			fmt.Fprintf(&b, "ocrKeyBundleID = %q\n", opts.OCRKeyBundleID)
+		}
+		fmt.Fprintf(&b, "transmitterID = %q\n", spec.TransmitterAddress.String())
+		bootstrappers := make([]string, len(spec.P2PV2Bootstrappers))
+		for i, bootstrapper := range spec.P2PV2Bootstrappers {
+			bootstrappers[i] = strconv.Quote(bootstrapper)
+		}
+		fmt.Fprintf(&b, "p2pv2Bootstrappers = [%s]\n", strings.Join(bootstrappers, ", "))
+		if jb.ForwardingAllowed {
+			b.WriteString("forwardingAllowed = true\n")
+		}
+		if jb.GasLimit.Valid {
+			fmt.Fprintf(&b, "gasLimit = %d\n", jb.GasLimit.Uint32)
+		}
+		writeInterval(&b, "maxTaskDuration", jb.MaxTaskDuration)
+		if err := writePipeline(&b, "observationSource", jb.PipelineSpec.DotDagSource); err != nil {
+			return "", err
+		}
+	}
+
+	fmt.Fprintf(&b, "\n[relayConfig]\nchainID = %s\n", spec.EVMChainID.String())
+
+	if !spec.IsBootstrapPeer {
+		b.WriteString("\n[pluginConfig]\n")
+		if err := writePipeline(&b, "juelsPerFeeCoinSource", opts.JuelsPerFeeCoinSource); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+func writeInterval(b *strings.Builder, key string, interval models.Interval) {
+	if !interval.IsZero() {
+		fmt.Fprintf(b, "%s = %q\n", key, interval.Duration().String())
+	}
+}
+
+// writePipeline writes the pipeline as a multi-line literal string, so that it is kept verbatim.
+func writePipeline(b *strings.Builder, key string, source string) error {
+	if strings.Contains(source, "'''") {
+		return errors.Errorf("%s cannot contain '''", key)
+	}
+	fmt.Fprintf(b, "%s = '''\n%s\n'''\n", key, strings.Trim(source, "\n"))
+	return nil
+}
@@ -0,0 +1,136 @@
+package ocr_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const ocrSpecToMigrate = `
+type               = "offchainreporting"
+schemaVersion      = 1
+name               = "ETH/USD"
+externalJobID      = "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+evmChainID         = 0
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+isBootstrapPeer    = false
+keyBundleID        = "73e8966a78ca09bb912e9565cfb79fbe8a6048fab1f0cf49b18047c3895e0447"
+transmitterAddress = "0xaA07d525B4006a2f927D79CA78a23A8ee680A32A"
+observationTimeout = "10s"
+blockchainTimeout  = "20s"
+contractConfigTrackerPollInterval = "1m"
+contractConfigConfirmations = 3
+observationSource = """
+    ds1          [type=http method=GET url="https://chain.link/voter_turnout/USA-2020" requestData="{\\"hi\\": \\"hello\\"}"];
+    ds1_parse    [type=jsonparse path="one,two"];
+    ds1 -> ds1_parse -> answer1;
+    answer1 [type=median index=0];
+"""
+`
+
+const juelsPerFeeCoinSource = `
+    link [type=bridge name=link_eth];
+    link_parse [type=jsonparse path="data,result"];
+    link -> link_parse;
+`
+
+func TestMigrateToOCR2Spec(t *testing.T) {
+	t.Parallel()
+
+	c := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Insecure.OCRDevelopmentMode = testutils.Ptr(false)
+	})
+	validatedOCRJob := func(t *testing.T, toml string) job.Job {
+		jb, err := ocr.ValidatedOracleSpecTomlCfg(func(id *big.Int) (evmconfig.ChainScopedConfig, error) {
+			return evmtest.NewChainScopedConfig(t, c), nil
+		}, toml)
+		require.NoError(t, err)
+		jb.PipelineSpec = &pipeline.Spec{DotDagSource: jb.Pipeline.Source}
+		return jb
+	}
+
+	t.Run("median", func(t *testing.T) {
+		jb := validatedOCRJob(t, ocrSpecToMigrate)
+		toml, err := ocr.MigrateToOCR2Spec(jb, ocr.OCR2MigrationOpts{
+			ContractID:            "0x0000000000000000000000000000000000000001",
+			OCRKeyBundleID:        "f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5",
+			JuelsPerFeeCoinSource: juelsPerFeeCoinSource,
+		})
+		require.NoError(t, err)
+
+		ocr2Job, err := validate.ValidatedOracleSpecToml(c.OCR2(), c.Insecure(), toml)
+		require.NoError(t, err)
+		assert.Equal(t, job.OffchainReporting2, ocr2Job.Type)
+		assert.Equal(t, jb.ExternalJobID, ocr2Job.ExternalJobID)
+		assert.Equal(t, "ETH/USD", ocr2Job.Name.String)
+		assert.Equal(t, jb.Pipeline.Source, ocr2Job.Pipeline.Source)
+		spec := ocr2Job.OCR2OracleSpec
+		assert.Equal(t, "median", string(spec.PluginType))
+		assert.Equal(t, "0x0000000000000000000000000000000000000001", spec.ContractID)
+		assert.Equal(t, "f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5", spec.OCRKeyBundleID.String)
+		assert.Equal(t, "0xaA07d525B4006a2f927D79CA78a23A8ee680A32A", spec.TransmitterID.String)
+		assert.Equal(t, []string(jb.OCROracleSpec.P2PV2Bootstrappers), []string(spec.P2PV2Bootstrappers))
+		assert.Equal(t, jb.OCROracleSpec.BlockchainTimeout, spec.BlockchainTimeout)
+		assert.Equal(t, jb.OCROracleSpec.ContractConfigTrackerPollInterval, spec.ContractConfigTrackerPollInterval)
+		assert.Equal(t, uint16(3), spec.ContractConfigConfirmations)
+		assert.Equal(t, int64(0), spec.RelayConfig["chainID"])
+		assert.Equal(t, strings.TrimPrefix(juelsPerFeeCoinSource, "\n"), spec.PluginConfig["juelsPerFeeCoinSource"])
+	})
+
+	t.Run("contract address and node key bundle by default", func(t *testing.T) {
+		jb := validatedOCRJob(t, ocrSpecToMigrate)
+		toml, err := ocr.MigrateToOCR2Spec(jb, ocr.OCR2MigrationOpts{JuelsPerFeeCoinSource: juelsPerFeeCoinSource})
+		require.NoError(t, err)
+
+		ocr2Job, err := validate.ValidatedOracleSpecToml(c.OCR2(), c.Insecure(), toml)
+		require.NoError(t, err)
+		assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", ocr2Job.OCR2OracleSpec.ContractID)
+		assert.False(t, ocr2Job.OCR2OracleSpec.OCRKeyBundleID.Valid)
+	})
+
+	t.Run("bootstrap", func(t *testing.T) {
+		jb := validatedOCRJob(t, `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+evmChainID         = 0
+isBootstrapPeer    = true
+blockchainTimeout  = "20s"
+`)
+		toml, err := ocr.MigrateToOCR2Spec(jb, ocr.OCR2MigrationOpts{})
+		require.NoError(t, err)
+
+		bootstrapJob, err := ocrbootstrap.ValidatedBootstrapSpecToml(toml)
+		require.NoError(t, err)
+		assert.Equal(t, job.Bootstrap, bootstrapJob.Type)
+		assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", bootstrapJob.BootstrapSpec.ContractID)
+		assert.Equal(t, jb.OCROracleSpec.BlockchainTimeout, bootstrapJob.BootstrapSpec.BlockchainTimeout)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := ocr.MigrateToOCR2Spec(job.Job{ID: 1, Type: job.FluxMonitor}, ocr.OCR2MigrationOpts{})
+		assert.EqualError(t, err, "job 1 is not an OCR job")
+
+		jb := validatedOCRJob(t, ocrSpecToMigrate)
+		_, err = ocr.MigrateToOCR2Spec(jb, ocr.OCR2MigrationOpts{})
+		assert.EqualError(t, err, "juelsPerFeeCoinSource is required for median jobs")
+
+		_, err = ocr.MigrateToOCR2Spec(jb, ocr.OCR2MigrationOpts{JuelsPerFeeCoinSource: "a [type=memo value='''1'''];"})
+		assert.EqualError(t, err, "juelsPerFeeCoinSource cannot contain '''")
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 type ValidationConfig interface {
@@ -118,6 +119,10 @@ var (
 	nonBootstrapParams = map[string]struct{}{
 		"observationSource": {},
 	}
+	// Optional non-bootstrap parameters
+	optionalNonBootstrapParams = map[string]struct{}{
+		"shadowObservationSource": {},
+	}
 )
 
 func validateTimingParameters(cfg ValidationConfig, evmOcrCfg evmconfig.OCR, insecureCfg insecureConfig, spec job.OCROracleSpec, ocrCfg job.OCRConfig) error {
@@ -130,6 +135,9 @@ func validateBootstrapSpec(tree *toml.Tree) error {
 	for k := range bootstrapParams {
 		expected[k] = struct{}{}
 	}
+	for k := range optionalNonBootstrapParams {
+		notExpected[k] = struct{}{}
+	}
 	return ocrcommon.ValidateExplicitlySetKeys(tree, expected, notExpected, "bootstrap")
 }
 
@@ -153,10 +161,12 @@ func validateNonBootstrapSpec(tree *toml.Tree, spec job.Job, ocrObservationTimeo
 	if time.Duration(spec.MaxTaskDuration) > observationTimeout {
 		return errors.Errorf("max task duration must be < observation timeout")
 	}
-	for _, task := range spec.Pipeline.Tasks {
-		timeout, set := task.TaskTimeout()
-		if set && timeout > observationTimeout {
-			return errors.Errorf("individual max task duration must be < observation timeout")
+	for _, tasks := range [][]pipeline.Task{spec.Pipeline.Tasks, spec.ShadowPipeline.Tasks} {
+		for _, task := range tasks {
+			timeout, set := task.TaskTimeout()
+			if set && timeout > observationTimeout {
+				return errors.Errorf("individual max task duration must be < observation timeout")
+			}
 		}
 	}
 	return nil
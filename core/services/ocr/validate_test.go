@@ -327,6 +327,49 @@ ds1          [type=bridge name=voter_turnout timeout="30s"];
 				require.Contains(t, err.Error(), "individual max task duration must be < observation timeout")
 			},
 		},
+		{
+			name: "shadow individual max task duration > observation timeout should error",
+			toml: `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pPeerID          = "12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq"
+p2pv2Bootstrappers = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+isBootstrapPeer    = false
+keyBundleID        = "73e8966a78ca09bb912e9565cfb79fbe8a6048fab1f0cf49b18047c3895e0447"
+monitoringEndpoint = "chain.link:4321"
+transmitterAddress = "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4"
+observationTimeout = "10s"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+shadowObservationSource = """
+ds1          [type=bridge name=voter_turnout_v2 timeout="30s"];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "individual max task duration must be < observation timeout")
+			},
+		},
+		{
+			name: "shadow observation source on bootstrap node",
+			toml: `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pPeerID          = "12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq"
+p2pv2Bootstrappers = []
+isBootstrapPeer    = true
+shadowObservationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "unrecognised key for bootstrap peer: shadowObservationSource")
+			},
+		},
 		{
 			name: "toml parse doesn't panic",
 			toml: string(hexutil.MustDecode("0x2222220d5c22223b22225c0d21222222")),
@@ -0,0 +1,160 @@
+package ocr2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+// DefaultBootstrappersRefreshInterval is the interval between reads of the bootstrappers registry, if the job does not
+// set p2pv2BootstrappersRefreshInterval.
+const DefaultBootstrappersRefreshInterval = 10 * time.Minute
+
+// bootstrappersRegistryABI is the ABI of an on-chain registry of the bootstrappers of OCR2 contracts. The on-chain
+// OCR2 config only has the peer IDs of the oracles, so the addresses of the bootstrappers are registered separately:
+//
+//	function getBootstrappers(address ocrContract) external view returns (string[] memory);
+//
+// Each bootstrapper is formatted like in p2pv2Bootstrappers, i.e. peerID@host:port.
+var bootstrappersRegistryABI = evmtypes.MustGetABI(`[{"inputs":[{"internalType":"address","name":"ocrContract","type":"address"}],"name":"getBootstrappers","outputs":[{"internalType":"string[]","name":"","type":"string[]"}],"stateMutability":"view","type":"function"}]`)
+
+// JobRestarter restarts the services of a job. It is implemented by the job spawner.
+type JobRestarter interface {
+	RestartJob(ctx context.Context, jobID int32) error
+}
+
+// evmBootstrappersRegistry reads the bootstrappers of an OCR2 contract from a registry contract.
+type evmBootstrappersRegistry struct {
+	client   evmclient.Client
+	registry common.Address
+	contract common.Address
+}
+
+func (r *evmBootstrappersRegistry) Bootstrappers(ctx context.Context) ([]commontypes.BootstrapperLocator, error) {
+	data, err := bootstrappersRegistryABI.Pack("getBootstrappers", r.contract)
+	if err != nil {
+		return nil, err
+	}
+	b, err := r.client.CallContract(ctx, ethereum.CallMsg{To: &r.registry, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call bootstrappers registry %s", r.registry)
+	}
+	out, err := bootstrappersRegistryABI.Unpack("getBootstrappers", b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unpack bootstrappers from registry %s", r.registry)
+	}
+	peers, ok := out[0].([]string)
+	if !ok || len(peers) == 0 {
+		return nil, errors.Errorf("bootstrappers registry %s has no bootstrappers for contract %s", r.registry, r.contract)
+	}
+	bootstrappers, err := ocrcommon.ParseBootstrapPeers(peers)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bootstrapper in registry %s", r.registry)
+	}
+	return bootstrappers, nil
+}
+
+// bootstrappersWatcher reads the bootstrappers of a job every interval, and calls onChange once if they differ from
+// the bootstrappers the job started with. The bootstrappers of an oracle cannot change while it runs, so onChange
+// is expected to restart the job.
+type bootstrappersWatcher struct {
+	services.StateMachine
+	read     func(ctx context.Context) ([]commontypes.BootstrapperLocator, error)
+	current  []commontypes.BootstrapperLocator
+	interval time.Duration
+	onChange func()
+	lggr     logger.SugaredLogger
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+func newBootstrappersWatcher(read func(ctx context.Context) ([]commontypes.BootstrapperLocator, error), current []commontypes.BootstrapperLocator, interval time.Duration, onChange func(), lggr logger.Logger) *bootstrappersWatcher {
+	return &bootstrappersWatcher{
+		read:     read,
+		current:  current,
+		interval: interval,
+		onChange: onChange,
+		lggr:     logger.Sugared(lggr.Named("BootstrappersWatcher")),
+		chStop:   make(services.StopChan),
+	}
+}
+
+func (w *bootstrappersWatcher) Start(context.Context) error {
+	return w.StartOnce("BootstrappersWatcher", func() error {
+		w.wg.Add(1)
+		go w.run()
+		return nil
+	})
+}
+
+func (w *bootstrappersWatcher) Close() error {
+	return w.StopOnce("BootstrappersWatcher", func() error {
+		close(w.chStop)
+		w.wg.Wait()
+		return nil
+	})
+}
+
+func (w *bootstrappersWatcher) run() {
+	defer w.wg.Done()
+	ctx, cancel := w.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if w.changed(ctx) {
+				w.onChange()
+				return
+			}
+		}
+	}
+}
+
+func (w *bootstrappersWatcher) changed(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, w.interval)
+	defer cancel()
+	bootstrappers, err := w.read(ctx)
+	if err != nil {
+		w.lggr.Warnw("Failed to read bootstrappers", "err", err)
+		return false
+	}
+	if sameBootstrappers(bootstrappers, w.current) {
+		return false
+	}
+	w.lggr.Infow("Bootstrappers changed", "old", w.current, "new", bootstrappers)
+	return true
+}
+
+// sameBootstrappers compares bootstrappers regardless of their order.
+func sameBootstrappers(a, b []commontypes.BootstrapperLocator) bool {
+	key := func(bs []commontypes.BootstrapperLocator) []string {
+		keys := make([]string, len(bs))
+		for i, b := range bs {
+			keys[i] = fmt.Sprintf("%s@%v", b.PeerID, b.Addrs)
+		}
+		slices.Sort(keys)
+		return keys
+	}
+	return slices.Equal(key(a), key(b))
+}
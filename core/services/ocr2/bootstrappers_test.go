@@ -0,0 +1,106 @@
+package ocr2
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+const (
+	bootstrapper1 = "12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"
+	bootstrapper2 = "12D3KooWLtxTiCXe4EjHjvamVjzz2UQRQFNDwULGJ5LvH7A9GRtN@127.0.0.1:5002"
+)
+
+func TestEVMBootstrappersRegistry(t *testing.T) {
+	registryAddr := testutils.NewAddress()
+	contract := testutils.NewAddress()
+
+	setup := func(t *testing.T, peers []string) *evmBootstrappersRegistry {
+		client := evmclimocks.NewClient(t)
+		out, err := bootstrappersRegistryABI.Methods["getBootstrappers"].Outputs.Pack(peers)
+		require.NoError(t, err)
+		client.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			args, err := bootstrappersRegistryABI.Methods["getBootstrappers"].Inputs.Unpack(msg.Data[4:])
+			return err == nil && *msg.To == registryAddr && args[0].(common.Address) == contract
+		}), mock.Anything).Return(out, nil)
+		return &evmBootstrappersRegistry{client: client, registry: registryAddr, contract: contract}
+	}
+
+	t.Run("bootstrappers", func(t *testing.T) {
+		r := setup(t, []string{bootstrapper1, bootstrapper2})
+		bootstrappers, err := r.Bootstrappers(testutils.Context(t))
+		require.NoError(t, err)
+		expected, err := ocrcommon.ParseBootstrapPeers([]string{bootstrapper1, bootstrapper2})
+		require.NoError(t, err)
+		assert.Equal(t, expected, bootstrappers)
+	})
+
+	t.Run("no bootstrappers", func(t *testing.T) {
+		r := setup(t, []string{})
+		_, err := r.Bootstrappers(testutils.Context(t))
+		assert.ErrorContains(t, err, "has no bootstrappers for contract")
+	})
+
+	t.Run("invalid bootstrapper", func(t *testing.T) {
+		r := setup(t, []string{"/invalid/peer/address"})
+		_, err := r.Bootstrappers(testutils.Context(t))
+		assert.ErrorContains(t, err, "invalid bootstrapper in registry")
+	})
+}
+
+func TestBootstrappersWatcher(t *testing.T) {
+	peers := func(t *testing.T, ps ...string) []commontypes.BootstrapperLocator {
+		bootstrappers, err := ocrcommon.ParseBootstrapPeers(ps)
+		require.NoError(t, err)
+		return bootstrappers
+	}
+	current := peers(t, bootstrapper1, bootstrapper2)
+
+	var reads atomic.Int32
+	var latest atomic.Pointer[[]commontypes.BootstrapperLocator]
+	read := func(ctx context.Context) ([]commontypes.BootstrapperLocator, error) {
+		// the first read fails, the next ones return the latest bootstrappers
+		if reads.Add(1) == 1 {
+			return nil, errors.New("rpc down")
+		}
+		return *latest.Load(), nil
+	}
+	reordered := peers(t, bootstrapper2, bootstrapper1)
+	latest.Store(&reordered)
+
+	changed := make(chan struct{})
+	w := newBootstrappersWatcher(read, current, 10*time.Millisecond, func() { close(changed) }, logger.TestLogger(t))
+	require.NoError(t, w.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, w.Close()) })
+
+	// neither a failed read nor reordered bootstrappers are a change
+	testutils.AssertEventually(t, func() bool { return reads.Load() > 2 })
+	select {
+	case <-changed:
+		t.Fatal("unexpected change")
+	default:
+	}
+
+	updated := peers(t, bootstrapper1)
+	latest.Store(&updated)
+	select {
+	case <-changed:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for change")
+	}
+}
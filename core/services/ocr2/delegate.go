@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/smartcontractkit/libocr/commontypes"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	ocr2keepers20 "github.com/smartcontractkit/chainlink-automation/pkg/v2"
@@ -40,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	functions_srv "github.com/smartcontractkit/chainlink/v2/core/services/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
@@ -119,6 +122,12 @@ type Delegate struct {
 	mailMon           *mailbox.Monitor
 
 	legacyChains legacyevm.LegacyChainContainer // legacy: use relayers instead
+
+	functionsMu        sync.RWMutex
+	functionsListeners map[int32]functions_srv.FunctionsListener
+	secretsRotators    map[int32]functions_srv.SecretsRotator
+
+	jobRestarter JobRestarter
 }
 
 type DelegateConfig interface {
@@ -247,6 +256,8 @@ func NewDelegate(
 		RelayGetter:           relayers,
 		isNewlyCreatedJob:     false,
 		mailMon:               mailMon,
+		functionsListeners:    make(map[int32]functions_srv.FunctionsListener),
+		secretsRotators:       make(map[int32]functions_srv.SecretsRotator),
 	}
 }
 
@@ -258,8 +269,37 @@ func (d *Delegate) BeforeJobCreated(spec job.Job) {
 	// This is only called first time the job is created
 	d.isNewlyCreatedJob = true
 }
-func (d *Delegate) AfterJobCreated(spec job.Job)  {}
-func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job) {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {
+	d.functionsMu.Lock()
+	defer d.functionsMu.Unlock()
+	delete(d.functionsListeners, spec.ID)
+	delete(d.secretsRotators, spec.ID)
+}
+
+// FunctionsListeners returns the request listeners of the Functions jobs, keyed by job ID.
+func (d *Delegate) FunctionsListeners() map[int32]functions_srv.FunctionsListener {
+	d.functionsMu.RLock()
+	defer d.functionsMu.RUnlock()
+	listeners := make(map[int32]functions_srv.FunctionsListener, len(d.functionsListeners))
+	for id, l := range d.functionsListeners {
+		listeners[id] = l
+	}
+	return listeners
+}
+
+// SecretsRotators returns the DON-hosted secrets rotators of the Functions jobs, keyed by job ID.
+// Jobs without a secretsRotation plugin config are omitted.
+func (d *Delegate) SecretsRotators() map[int32]functions_srv.SecretsRotator {
+	d.functionsMu.RLock()
+	defer d.functionsMu.RUnlock()
+	rotators := make(map[int32]functions_srv.SecretsRotator, len(d.secretsRotators))
+	for id, r := range d.secretsRotators {
+		rotators[id] = r
+	}
+	return rotators
+}
+
 func (d *Delegate) OnDeleteJob(jb job.Job, q pg.Queryer) error {
 	// If the job spec is malformed in any way, we report the error but return nil so that
 	//  the job deletion itself isn't blocked.
@@ -339,6 +379,12 @@ func (d *Delegate) cleanupEVM(jb job.Job, q pg.Queryer, relayID relay.ID) error
 	return nil
 }
 
+// SetJobRestarter sets the restarter of the jobs whose bootstrappers change in their registry. It must be set before
+// any job starts.
+func (d *Delegate) SetJobRestarter(r JobRestarter) {
+	d.jobRestarter = r
+}
+
 // ServicesForSpec returns the OCR2 services that need to run for this job
 func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 	spec := jb.OCR2OracleSpec
@@ -407,7 +453,13 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		"DatabaseTimeout", lc.DatabaseTimeout,
 	)
 
-	bootstrapPeers, err := ocrcommon.GetValidatedBootstrapPeers(spec.P2PV2Bootstrappers, d.peerWrapper.P2PConfig().V2().DefaultBootstrappers())
+	var bootstrapPeers []commontypes.BootstrapperLocator
+	var watcher *bootstrappersWatcher
+	if spec.P2PV2BootstrappersRegistry.Valid {
+		bootstrapPeers, watcher, err = d.bootstrappersFromRegistry(jb, rid, lggr)
+	} else {
+		bootstrapPeers, err = ocrcommon.GetValidatedBootstrapPeers(spec.P2PV2Bootstrappers, d.peerWrapper.P2PConfig().V2().DefaultBootstrappers())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -427,6 +479,24 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 	spec.CaptureEATelemetry = d.cfg.OCR2().CaptureEATelemetry()
 
 	ctx := lggrCtx.ContextWithValues(context.Background())
+	srvs, err := d.newServicesForPlugin(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc, ocrLogger)
+	if err != nil || watcher == nil {
+		return srvs, err
+	}
+	return append(srvs, watcher), nil
+}
+
+func (d *Delegate) newServicesForPlugin(
+	ctx context.Context,
+	lggr logger.SugaredLogger,
+	jb job.Job,
+	bootstrapPeers []commontypes.BootstrapperLocator,
+	kb ocr2key.KeyBundle,
+	ocrDB *db,
+	lc ocrtypes.LocalConfig,
+	ocrLogger commontypes.Logger,
+) ([]job.ServiceCtx, error) {
+	spec := jb.OCR2OracleSpec
 	switch spec.PluginType {
 	case types.Mercury:
 		return d.newServicesMercury(ctx, lggr, jb, bootstrapPeers, kb, ocrDB, lc, ocrLogger)
@@ -461,6 +531,55 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 	}
 }
 
+// bootstrappersFromRegistry reads the bootstrappers of the job from its registry, and returns a watcher restarting the
+// job when they change. If the registry cannot be read, the bootstrappers of the spec or the default ones are used
+// until it can.
+func (d *Delegate) bootstrappersFromRegistry(jb job.Job, rid relay.ID, lggr logger.SugaredLogger) ([]commontypes.BootstrapperLocator, *bootstrappersWatcher, error) {
+	spec := jb.OCR2OracleSpec
+	if rid.Network != relay.EVM {
+		return nil, nil, errors.Errorf("p2pv2BootstrappersRegistry is not supported by relay %s", rid.Network)
+	}
+	chain, err := d.legacyChains.Get(rid.ChainID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get EVM chain %s: %w", rid.ChainID, err)
+	}
+	registry := &evmBootstrappersRegistry{
+		client:   chain.Client(),
+		registry: common.HexToAddress(spec.P2PV2BootstrappersRegistry.String),
+		contract: common.HexToAddress(spec.ContractID),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.cfg.OCR2().BlockchainTimeout())
+	defer cancel()
+	bootstrapPeers, err := registry.Bootstrappers(ctx)
+	if err != nil {
+		var err2 error
+		bootstrapPeers, err2 = ocrcommon.GetValidatedBootstrapPeers(spec.P2PV2Bootstrappers, d.peerWrapper.P2PConfig().V2().DefaultBootstrappers())
+		if err2 != nil {
+			return nil, nil, err
+		}
+		lggr.Warnw("Failed to read bootstrappers from registry, using the bootstrappers of the spec until it can be read", "err", err)
+	}
+
+	interval := DefaultBootstrappersRefreshInterval
+	if spec.P2PV2BootstrappersRefreshInterval != nil {
+		interval = spec.P2PV2BootstrappersRefreshInterval.Duration()
+	}
+	restart := func() {
+		if d.jobRestarter == nil {
+			lggr.Warn("Bootstrappers changed, but the job cannot be restarted")
+			return
+		}
+		// The restart closes the watcher, so it must not wait for it.
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			lggr.ErrorIf(d.jobRestarter.RestartJob(ctx, jb.ID), "Failed to restart job with new bootstrappers")
+		}()
+	}
+	return bootstrapPeers, newBootstrappersWatcher(registry.Bootstrappers, bootstrapPeers, interval, restart, lggr), nil
+}
+
 func GetEVMEffectiveTransmitterID(jb *job.Job, chain legacyevm.Chain, lggr logger.SugaredLogger) (string, error) {
 	spec := jb.OCR2OracleSpec
 	if spec.PluginType == types.Mercury {
@@ -511,6 +630,14 @@ func defaultPathFromPluginName(pluginName string) string {
 	return fmt.Sprintf("chainlink-%s", pluginName)
 }
 
+// promFactoryDecorator returns a decorator wrapping reporting plugin factories with the latency and error metrics of
+// promwrapper, labelled with the plugin name and the chain of the job.
+func promFactoryDecorator(name string, rid relay.ID) func(ocrtypes.ReportingPluginFactory) ocrtypes.ReportingPluginFactory {
+	return func(wrapped ocrtypes.ReportingPluginFactory) ocrtypes.ReportingPluginFactory {
+		return promwrapper.NewPromFactory(wrapped, name, rid.Network, rid.ChainID)
+	}
+}
+
 func (d *Delegate) newServicesGenericPlugin(
 	ctx context.Context,
 	lggr logger.SugaredLogger,
@@ -634,7 +761,7 @@ func (d *Delegate) newServicesGenericPlugin(
 	ta := generic.NewTelemetryAdapter(d.monitoringEndpointGen)
 
 	plugin := reportingplugins.NewLOOPPService(pluginLggr, grpcOpts, cmdFn, pluginConfig, providerClientConn, pr, ta, errorLog)
-	oracleArgs.ReportingPluginFactory = plugin
+	oracleArgs.ReportingPluginFactory = promwrapper.NewPromFactory(plugin, p.PluginName, rid.Network, rid.ChainID)
 	srvs = append(srvs, plugin)
 
 	oracle, err := libocr2.NewOracle(oracleArgs)
@@ -717,7 +844,10 @@ func (d *Delegate) newServicesMercury(
 
 	chEnhancedTelem := make(chan ocrcommon.EnhancedTelemetryMercuryData, 100)
 
-	mercuryServices, err2 := mercury.NewServices(jb, mercuryProvider, d.pipelineRunner, lggr, oracleArgsNoPlugin, d.cfg.JobPipeline(), chEnhancedTelem, d.mercuryORM, (mercuryutils.FeedID)(*spec.FeedID))
+	promDecorator := func(wrapped ocr3types.MercuryPluginFactory) ocr3types.MercuryPluginFactory {
+		return promwrapper.NewPromMercuryFactory(wrapped, "Mercury", rid.Network, rid.ChainID)
+	}
+	mercuryServices, err2 := mercury.NewServices(jb, mercuryProvider, d.pipelineRunner, lggr, oracleArgsNoPlugin, d.cfg.JobPipeline(), chEnhancedTelem, d.mercuryORM, (mercuryutils.FeedID)(*spec.FeedID), promDecorator)
 
 	if ocrcommon.ShouldCollectEnhancedTelemetryMercury(jb) {
 		enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, chEnhancedTelem, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(rid.Network, rid.ChainID, spec.FeedID.String(), synchronization.EnhancedEAMercury), lggr.Named("EnhancedTelemetryMercury"))
@@ -769,7 +899,7 @@ func (d *Delegate) newServicesMedian(
 		return nil, ErrRelayNotEnabled{Err: err, PluginName: "median", Relay: spec.Relay}
 	}
 
-	medianServices, err2 := median.NewMedianServices(ctx, jb, d.isNewlyCreatedJob, relayer, d.pipelineRunner, lggr, oracleArgsNoPlugin, mConfig, enhancedTelemChan, errorLog)
+	medianServices, err2 := median.NewMedianServices(ctx, jb, d.isNewlyCreatedJob, relayer, d.pipelineRunner, lggr, oracleArgsNoPlugin, mConfig, enhancedTelemChan, errorLog, promFactoryDecorator("Median", rid))
 
 	if ocrcommon.ShouldCollectEnhancedTelemetry(&jb) {
 		enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, enhancedTelemChan, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(rid.Network, rid.ChainID, spec.ContractID, synchronization.EnhancedEA), lggr.Named("EnhancedTelemetry"))
@@ -976,10 +1106,10 @@ func (d *Delegate) newServicesOCR2VRF(
 		lggr.ErrorIf(d.jobORM.RecordError(jb.ID, msg), "unable to record error")
 	})
 	dkgReportingPluginFactoryDecorator := func(wrapped ocrtypes.ReportingPluginFactory) ocrtypes.ReportingPluginFactory {
-		return promwrapper.NewPromFactory(wrapped, "DKG", string(relay.EVM), chain.ID())
+		return promwrapper.NewPromFactory(wrapped, "DKG", string(relay.EVM), chain.ID().String())
 	}
 	vrfReportingPluginFactoryDecorator := func(wrapped ocrtypes.ReportingPluginFactory) ocrtypes.ReportingPluginFactory {
-		return promwrapper.NewPromFactory(wrapped, "OCR2VRF", string(relay.EVM), chain.ID())
+		return promwrapper.NewPromFactory(wrapped, "OCR2VRF", string(relay.EVM), chain.ID().String())
 	}
 	noopMonitoringEndpoint := telemetry.NoopAgent{}
 	oracles, err2 := ocr2vrf.NewOCR2VRF(ocr2vrf.DKGVRFArgs{
@@ -1462,12 +1592,25 @@ func (d *Delegate) newServicesOCR2Functions(
 		EthKeystore:       d.ethKs,
 		ThresholdKeyShare: thresholdKeyShare,
 		LogPollerWrapper:  functionsProvider.LogPollerWrapper(),
+		ReportingPluginFactoryDecorator: func(name string, wrapped ocrtypes.ReportingPluginFactory) ocrtypes.ReportingPluginFactory {
+			return promwrapper.NewPromFactory(wrapped, name, rid.Network, rid.ChainID)
+		},
 	}
 
 	functionsServices, err := functions.NewFunctionsServices(&functionsOracleArgs, &thresholdOracleArgs, &s4OracleArgs, &functionsServicesConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "error calling NewFunctionsServices")
 	}
+	d.functionsMu.Lock()
+	for _, srv := range functionsServices {
+		switch srv := srv.(type) {
+		case functions_srv.FunctionsListener:
+			d.functionsListeners[jb.ID] = srv
+		case functions_srv.SecretsRotator:
+			d.secretsRotators[jb.ID] = srv
+		}
+	}
+	d.functionsMu.Unlock()
 
 	return append([]job.ServiceCtx{functionsProvider, thresholdProvider, s4Provider}, functionsServices...), nil
 }
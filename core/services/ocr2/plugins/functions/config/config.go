@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"google.golang.org/protobuf/proto"
 
 	decryptionPluginConfig "github.com/smartcontractkit/tdh2/go/ocr2/decryptionplugin/config"
@@ -47,6 +48,7 @@ type PluginConfig struct {
 	RateLimiter                        *common.RateLimiterConfig             `json:"rateLimiter"`
 	S4Constraints                      *s4.Constraints                       `json:"s4Constraints"`
 	DecryptionQueueConfig              *DecryptionQueueConfig                `json:"decryptionQueueConfig"`
+	SecretsRotation                    *SecretsRotationConfig                `json:"secretsRotation"`
 }
 
 type DecryptionQueueConfig struct {
@@ -57,6 +59,22 @@ type DecryptionQueueConfig struct {
 	DecryptRequestTimeoutSec uint32 `json:"decryptRequestTimeoutSec"`
 }
 
+// SecretsRotationConfig configures the periodic re-encryption and re-upload of DON-hosted
+// secrets before they expire. The secrets are decrypted by the DON and uploaded by the node
+// holding the Eth key of their owner, as S4 records must be signed by their owner. Each rotation increments the version of the
+// slot, so requests must reference the new version once it is uploaded.
+type SecretsRotationConfig struct {
+	CheckFrequencySec uint32                `json:"checkFrequencySec"`
+	RenewBeforeSec    uint32                `json:"renewBeforeSec"` // Rotate secrets expiring in less than this duration
+	Secrets           []RotatedSecretConfig `json:"secrets"`
+}
+
+type RotatedSecretConfig struct {
+	Owner  gethcommon.Address `json:"owner"`
+	SlotID uint               `json:"slotId"`
+	TTLSec uint64             `json:"ttlSec"` // Expiration of the secrets after each rotation
+}
+
 func ValidatePluginConfig(config PluginConfig) error {
 	if config.DecryptionQueueConfig != nil {
 		if config.DecryptionQueueConfig.MaxQueueLength <= 0 {
@@ -75,6 +93,43 @@ func ValidatePluginConfig(config PluginConfig) error {
 			return errors.New("missing or invalid decryptionQueueConfig decryptRequestTimeoutSec")
 		}
 	}
+	if config.S4Constraints != nil {
+		if err := config.S4Constraints.Validate(); err != nil {
+			return fmt.Errorf("invalid s4Constraints: %w", err)
+		}
+	}
+	if config.SecretsRotation != nil {
+		if err := validateSecretsRotationConfig(config.SecretsRotation, config.S4Constraints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSecretsRotationConfig(config *SecretsRotationConfig, constraints *s4.Constraints) error {
+	if config.CheckFrequencySec == 0 {
+		return errors.New("missing or invalid secretsRotation checkFrequencySec")
+	}
+	if config.RenewBeforeSec == 0 {
+		return errors.New("missing or invalid secretsRotation renewBeforeSec")
+	}
+	seen := make(map[s4.Key]bool, len(config.Secrets))
+	for _, secret := range config.Secrets {
+		key := s4.Key{Address: secret.Owner, SlotId: secret.SlotID}
+		if seen[key] {
+			return fmt.Errorf("duplicate secretsRotation secret for owner %s and slot %d", secret.Owner, secret.SlotID)
+		}
+		seen[key] = true
+		if secret.TTLSec <= uint64(config.RenewBeforeSec) {
+			return fmt.Errorf("secretsRotation ttlSec of owner %s and slot %d must be greater than renewBeforeSec", secret.Owner, secret.SlotID)
+		}
+		if constraints != nil && secret.TTLSec > constraints.MaxExpirationLengthSec {
+			return fmt.Errorf("secretsRotation ttlSec of owner %s and slot %d exceeds s4Constraints maxExpirationLengthSec", secret.Owner, secret.SlotID)
+		}
+		if constraints != nil && secret.SlotID >= constraints.MaxSlotsPerUser {
+			return fmt.Errorf("secretsRotation slotId %d of owner %s exceeds s4Constraints maxSlotsPerUser", secret.SlotID, secret.Owner)
+		}
+	}
 	return nil
 }
 
@@ -3,7 +3,10 @@ package config_test
 import (
 	"testing"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,3 +42,59 @@ func TestS4ConfigDecoder(t *testing.T) {
 	assert.Equal(t, 200, limits.MaxObservationLength)
 	assert.Equal(t, 300, limits.MaxReportLength)
 }
+
+func TestValidatePluginConfig_SecretsRotation(t *testing.T) {
+	t.Parallel()
+
+	owner := gethcommon.HexToAddress("0x1234")
+	newConfig := func(secrets ...config.RotatedSecretConfig) config.PluginConfig {
+		return config.PluginConfig{
+			S4Constraints: &s4.Constraints{MaxSlotsPerUser: 5, MaxExpirationLengthSec: 7 * 86400},
+			SecretsRotation: &config.SecretsRotationConfig{
+				CheckFrequencySec: 60,
+				RenewBeforeSec:    3600,
+				Secrets:           secrets,
+			},
+		}
+	}
+
+	require.NoError(t, config.ValidatePluginConfig(newConfig(
+		config.RotatedSecretConfig{Owner: owner, SlotID: 0, TTLSec: 86400},
+		config.RotatedSecretConfig{Owner: owner, SlotID: 1, TTLSec: 86400},
+	)))
+
+	cfg := newConfig()
+	cfg.SecretsRotation.CheckFrequencySec = 0
+	assert.ErrorContains(t, config.ValidatePluginConfig(cfg), "checkFrequencySec")
+	cfg = newConfig()
+	cfg.SecretsRotation.RenewBeforeSec = 0
+	assert.ErrorContains(t, config.ValidatePluginConfig(cfg), "renewBeforeSec")
+
+	assert.ErrorContains(t, config.ValidatePluginConfig(newConfig(
+		config.RotatedSecretConfig{Owner: owner, SlotID: 0, TTLSec: 86400},
+		config.RotatedSecretConfig{Owner: owner, SlotID: 0, TTLSec: 86400},
+	)), "duplicate")
+	assert.ErrorContains(t, config.ValidatePluginConfig(newConfig(
+		config.RotatedSecretConfig{Owner: owner, SlotID: 0, TTLSec: 3600},
+	)), "must be greater than renewBeforeSec")
+	assert.ErrorContains(t, config.ValidatePluginConfig(newConfig(
+		config.RotatedSecretConfig{Owner: owner, SlotID: 0, TTLSec: 8 * 86400},
+	)), "maxExpirationLengthSec")
+	assert.ErrorContains(t, config.ValidatePluginConfig(newConfig(
+		config.RotatedSecretConfig{Owner: owner, SlotID: 5, TTLSec: 86400},
+	)), "maxSlotsPerUser")
+}
+
+func TestValidatePluginConfig_S4Constraints(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, config.ValidatePluginConfig(config.PluginConfig{
+		S4Constraints: &s4.Constraints{MaxPayloadSizeBytes: 10, MaxTotalPayloadBytesPerUser: 100, EvictionPolicy: s4.EvictionPolicyLRU},
+	}))
+	assert.ErrorContains(t, config.ValidatePluginConfig(config.PluginConfig{
+		S4Constraints: &s4.Constraints{EvictionPolicy: "fifo"},
+	}), "unknown eviction policy")
+	assert.ErrorContains(t, config.ValidatePluginConfig(config.PluginConfig{
+		S4Constraints: &s4.Constraints{MaxPayloadSizeBytes: 100, MaxTotalPayloadBytesPerUser: 10},
+	}), "maxTotalPayloadBytesPerUser")
+}
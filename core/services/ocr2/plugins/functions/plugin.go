@@ -10,8 +10,11 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/tdh2/go/tdh2/tdh2easy"
+
 	"github.com/smartcontractkit/libocr/commontypes"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
@@ -49,6 +52,9 @@ type FunctionsServicesConfig struct {
 	EthKeystore       keystore.Eth
 	ThresholdKeyShare []byte
 	LogPollerWrapper  evmrelayTypes.LogPollerWrapper
+	// ReportingPluginFactoryDecorator, if set, wraps the reporting plugin factories of the Functions, Threshold and
+	// S4 oracles, e.g. with metrics. It is passed the name of the plugin.
+	ReportingPluginFactoryDecorator func(name string, wrapped types.ReportingPluginFactory) types.ReportingPluginFactory
 }
 
 const (
@@ -74,6 +80,7 @@ func NewFunctionsServices(functionsOracleArgs, thresholdOracleArgs, s4OracleArgs
 	allServices := []job.ServiceCtx{}
 
 	var decryptor threshold.Decryptor
+	var thresholdPublicKey *tdh2easy.PublicKey
 	// thresholdOracleArgs nil check will be removed once the Threshold plugin is fully integrated w/ Functions
 	if len(conf.ThresholdKeyShare) > 0 && thresholdOracleArgs != nil && pluginConfig.DecryptionQueueConfig != nil {
 		decryptionQueue := threshold.NewDecryptionQueue(
@@ -84,11 +91,21 @@ func NewFunctionsServices(functionsOracleArgs, thresholdOracleArgs, s4OracleArgs
 			conf.Logger.Named("DecryptionQueue"),
 		)
 		decryptor = decryptionQueue
+		publicKey, _, err2 := threshold.UnmarshalKeys(conf.ThresholdKeyShare)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to unmarshal threshold keys")
+		}
+		thresholdPublicKey = &publicKey
 		thresholdServicesConfig := threshold.ThresholdServicesConfig{
 			DecryptionQueue:    decryptionQueue,
 			KeyshareWithPubKey: conf.ThresholdKeyShare,
 			ConfigParser:       config.ThresholdConfigParser{},
 		}
+		if conf.ReportingPluginFactoryDecorator != nil {
+			thresholdServicesConfig.ReportingPluginFactoryDecorator = func(wrapped types.ReportingPluginFactory) types.ReportingPluginFactory {
+				return conf.ReportingPluginFactoryDecorator("Threshold", wrapped)
+			}
+		}
 		thresholdService, err2 := threshold.NewThresholdService(thresholdOracleArgs, &thresholdServicesConfig)
 		if err2 != nil {
 			return nil, errors.Wrap(err2, "error calling NewThresholdServices")
@@ -103,6 +120,23 @@ func NewFunctionsServices(functionsOracleArgs, thresholdOracleArgs, s4OracleArgs
 		s4Storage = s4.NewStorage(conf.Logger, *pluginConfig.S4Constraints, s4ORM, utils.NewRealClock())
 	}
 
+	if pluginConfig.SecretsRotation != nil && s4Storage != nil && decryptor != nil {
+		secretsRotator := functions.NewSecretsRotator(
+			*pluginConfig.SecretsRotation,
+			time.Duration(pluginConfig.DecryptionQueueConfig.DecryptRequestTimeoutSec)*time.Second,
+			conf.Chain.ID(),
+			s4Storage,
+			decryptor,
+			thresholdPublicKey,
+			conf.EthKeystore,
+			utils.NewRealClock(),
+			conf.Logger,
+		)
+		allServices = append(allServices, secretsRotator)
+	} else if pluginConfig.SecretsRotation != nil {
+		conf.Logger.Warn("S4Constraints or threshold configuration is missing. DON-hosted secrets rotation is disabled.")
+	}
+
 	offchainTransmitter := functions.NewOffchainTransmitter(DefaultOffchainTransmitterChannelSize)
 	listenerLogger := conf.Logger.Named("FunctionsListener")
 	bridgeAccessor := functions.NewBridgeAccessor(conf.BridgeORM, FunctionsBridgeName, MaxAdapterResponseBytes)
@@ -128,6 +162,9 @@ func NewFunctionsServices(functionsOracleArgs, thresholdOracleArgs, s4OracleArgs
 		ContractVersion:     pluginConfig.ContractVersion,
 		OffchainTransmitter: offchainTransmitter,
 	}
+	if conf.ReportingPluginFactoryDecorator != nil {
+		functionsOracleArgs.ReportingPluginFactory = conf.ReportingPluginFactoryDecorator("Functions", functionsOracleArgs.ReportingPluginFactory)
+	}
 	functionsReportingPluginOracle, err := libocr2.NewOracle(*functionsOracleArgs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to call NewOracle to create a Functions Reporting Plugin")
@@ -167,6 +204,9 @@ func NewFunctionsServices(functionsOracleArgs, thresholdOracleArgs, s4OracleArgs
 			ORM:           s4ORM,
 			ConfigDecoder: config.S4ConfigDecoder,
 		}
+		if conf.ReportingPluginFactoryDecorator != nil {
+			s4OracleArgs.ReportingPluginFactory = conf.ReportingPluginFactoryDecorator("S4", s4OracleArgs.ReportingPluginFactory)
+		}
 		s4ReportingPluginOracle, err := libocr2.NewOracle(*s4OracleArgs)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to call NewOracle to create a S4 Reporting Plugin")
@@ -82,7 +82,7 @@ func NewMedianServices(ctx context.Context,
 	cfg MedianConfig,
 	chEnhancedTelem chan ocrcommon.EnhancedTelemetryData,
 	errorLog loop.ErrorLog,
-
+	decorateFactory func(ocr2types.ReportingPluginFactory) ocr2types.ReportingPluginFactory,
 ) (srvs []job.ServiceCtx, err error) {
 	var pluginConfig config.PluginConfig
 	err = json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig)
@@ -180,6 +180,10 @@ func NewMedianServices(ctx context.Context,
 		}
 	}
 
+	if decorateFactory != nil {
+		argsNoPlugin.ReportingPluginFactory = decorateFactory(argsNoPlugin.ReportingPluginFactory)
+	}
+
 	var oracle libocr.Oracle
 	oracle, err = libocr.NewOracle(argsNoPlugin)
 	if err != nil {
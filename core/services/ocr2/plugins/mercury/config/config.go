@@ -27,30 +27,57 @@ type PluginConfig struct {
 
 	LinkFeedID   *mercuryutils.FeedID `json:"linkFeedID" toml:"linkFeedID"`
 	NativeFeedID *mercuryutils.FeedID `json:"nativeFeedID" toml:"nativeFeedID"`
+
+	// FailoverServers are additional mercury servers, which are used when the
+	// server at ServerURL is unhealthy, or also transmitted to if
+	// SplitTransmission is set.
+	FailoverServers []Server `json:"failoverServers" toml:"failoverServers"`
+	// SplitTransmission sends every report to all the servers, relying on
+	// the servers to dedupe them, instead of only to the healthiest one.
+	SplitTransmission bool `json:"splitTransmission" toml:"splitTransmission"`
+}
+
+// Server is a mercury server a report can be transmitted to.
+type Server struct {
+	RawURL string `json:"url" toml:"url"`
+	// PubKey defaults to the ServerPubKey of the plugin config.
+	PubKey utils.PlainHexBytes `json:"pubKey" toml:"pubKey"`
+}
+
+// URL returns the URL of the server without the wss:// scheme.
+func (s Server) URL() string {
+	return wssRegexp.ReplaceAllString(s.RawURL, "")
 }
 
 func ValidatePluginConfig(config PluginConfig, feedID mercuryutils.FeedID) (merr error) {
 	if config.RawServerURL == "" {
 		merr = errors.New("mercury: ServerURL must be specified")
-	} else {
-		var normalizedURI string
-		if schemeRegexp.MatchString(config.RawServerURL) {
-			normalizedURI = config.RawServerURL
-		} else {
-			normalizedURI = fmt.Sprintf("wss://%s", config.RawServerURL)
-		}
-		uri, err := url.ParseRequestURI(normalizedURI)
-		if err != nil {
-			merr = pkgerrors.Wrap(err, "Mercury: invalid value for ServerURL")
-		} else if uri.Scheme != "wss" {
-			merr = pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, config.RawServerURL, uri.Scheme)
-		}
+	} else if err := validateServerURL(config.RawServerURL, "ServerURL"); err != nil {
+		merr = err
 	}
 
 	if len(config.ServerPubKey) != 32 {
 		merr = errors.Join(merr, errors.New("mercury: ServerPubKey is required and must be a 32-byte hex string"))
 	}
 
+	seen := map[string]bool{config.ServerURL(): true}
+	for i, s := range config.FailoverServers {
+		if s.RawURL == "" {
+			merr = errors.Join(merr, fmt.Errorf("mercury: FailoverServers[%d]: url must be specified", i))
+		} else if err := validateServerURL(s.RawURL, fmt.Sprintf("FailoverServers[%d].url", i)); err != nil {
+			merr = errors.Join(merr, err)
+		} else if seen[s.URL()] {
+			merr = errors.Join(merr, fmt.Errorf("mercury: FailoverServers[%d]: duplicate server url %s", i, s.URL()))
+		}
+		seen[s.URL()] = true
+		if len(s.PubKey) != 0 && len(s.PubKey) != 32 {
+			merr = errors.Join(merr, fmt.Errorf("mercury: FailoverServers[%d]: pubKey must be a 32-byte hex string", i))
+		}
+	}
+	if config.SplitTransmission && len(config.FailoverServers) == 0 {
+		merr = errors.Join(merr, errors.New("mercury: splitTransmission requires failoverServers"))
+	}
+
 	switch feedID.Version() {
 	case 1:
 		if config.LinkFeedID != nil {
@@ -76,6 +103,34 @@ func ValidatePluginConfig(config PluginConfig, feedID mercuryutils.FeedID) (merr
 	return merr
 }
 
+func validateServerURL(rawURL string, name string) error {
+	var normalizedURI string
+	if schemeRegexp.MatchString(rawURL) {
+		normalizedURI = rawURL
+	} else {
+		normalizedURI = fmt.Sprintf("wss://%s", rawURL)
+	}
+	uri, err := url.ParseRequestURI(normalizedURI)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "Mercury: invalid value for %s", name)
+	} else if uri.Scheme != "wss" {
+		return pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, rawURL, uri.Scheme)
+	}
+	return nil
+}
+
+// Servers returns the primary server followed by the failover servers.
+func (p PluginConfig) Servers() []Server {
+	servers := []Server{{RawURL: p.RawServerURL, PubKey: p.ServerPubKey}}
+	for _, s := range p.FailoverServers {
+		if len(s.PubKey) == 0 {
+			s.PubKey = p.ServerPubKey
+		}
+		servers = append(servers, s)
+	}
+	return servers
+}
+
 var schemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://`)
 var wssRegexp = regexp.MustCompile(`^wss://`)
 
@@ -145,3 +145,78 @@ func Test_PluginConfig_ServerURL(t *testing.T) {
 	pc = PluginConfig{RawServerURL: "wss://example.com:1234/foo"}
 	assert.Equal(t, "example.com:1234/foo", pc.ServerURL())
 }
+
+func Test_PluginConfig_FailoverServers(t *testing.T) {
+	t.Run("with valid values", func(t *testing.T) {
+		rawToml := `
+			ServerURL = "example.com:80"
+			ServerPubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+			SplitTransmission = true
+
+			[[FailoverServers]]
+			URL = "wss://failover1.example.com:80"
+
+			[[FailoverServers]]
+			URL = "failover2.example.com:80"
+			PubKey = "0000000000000000000000000000000000000000000000000000000000000001"
+		`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+
+		err = ValidatePluginConfig(mc, v1FeedId)
+		require.NoError(t, err)
+
+		servers := mc.Servers()
+		require.Len(t, servers, 3)
+		assert.Equal(t, "example.com:80", servers[0].URL())
+		assert.Equal(t, mc.ServerPubKey, servers[0].PubKey)
+		assert.Equal(t, "failover1.example.com:80", servers[1].URL())
+		assert.Equal(t, mc.ServerPubKey, servers[1].PubKey)
+		assert.Equal(t, "failover2.example.com:80", servers[2].URL())
+		assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000001", servers[2].PubKey.String())
+	})
+
+	t.Run("with invalid values", func(t *testing.T) {
+		rawToml := `
+			ServerURL = "example.com:80"
+			ServerPubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+
+			[[FailoverServers]]
+
+			[[FailoverServers]]
+			URL = "wss://example.com:80"
+
+			[[FailoverServers]]
+			URL = "http://failover.example.com"
+			PubKey = "01"
+		`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+
+		err = ValidatePluginConfig(mc, v1FeedId)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "mercury: FailoverServers[0]: url must be specified")
+		assert.Contains(t, err.Error(), "mercury: FailoverServers[1]: duplicate server url example.com:80")
+		assert.Contains(t, err.Error(), `Mercury: invalid scheme specified for MercuryServer, got: "http://failover.example.com"`)
+		assert.Contains(t, err.Error(), "mercury: FailoverServers[2]: pubKey must be a 32-byte hex string")
+	})
+
+	t.Run("split transmission without failover servers", func(t *testing.T) {
+		rawToml := `
+			ServerURL = "example.com:80"
+			ServerPubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+			SplitTransmission = true
+		`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+
+		err = ValidatePluginConfig(mc, v1FeedId)
+		assert.EqualError(t, err, "mercury: splitTransmission requires failoverServers")
+	})
+}
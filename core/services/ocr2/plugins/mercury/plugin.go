@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	relaymercuryv1 "github.com/smartcontractkit/chainlink-data-streams/mercury/v1"
@@ -39,6 +40,7 @@ func NewServices(
 	chEnhancedTelem chan ocrcommon.EnhancedTelemetryMercuryData,
 	orm types.DataSourceORM,
 	feedID utils.FeedID,
+	decorateFactory func(ocr3types.MercuryPluginFactory) ocr3types.MercuryPluginFactory,
 ) ([]job.ServiceCtx, error) {
 	if jb.PipelineSpec == nil {
 		return nil, errors.New("expected job to have a non-nil PipelineSpec")
@@ -122,6 +124,10 @@ func NewServices(
 		return nil, errors.Errorf("unknown Mercury report schema version: %d", feedID.Version())
 	}
 
+	if decorateFactory != nil {
+		argsNoPlugin.MercuryPluginFactory = decorateFactory(argsNoPlugin.MercuryPluginFactory)
+	}
+
 	oracle, err := libocr2.NewOracle(argsNoPlugin)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -397,6 +397,7 @@ func (r *EvmRegistry) registerEvents(chainID uint64, addr common.Address) error
 		Name:      UpkeepFilterName(addr),
 		EventSigs: append(upkeepStateEvents, upkeepActiveEvents...),
 		Addresses: []common.Address{addr},
+		Priority:  logpoller.FilterPriorityCritical,
 	})
 }
 
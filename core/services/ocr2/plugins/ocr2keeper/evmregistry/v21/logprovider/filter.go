@@ -15,7 +15,12 @@ type upkeepFilter struct {
 	// selector is the filter selector in log trigger config
 	selector uint8
 	topics   []common.Hash
-	upkeepID *big.Int
+	// topicFilters are the additional topic filters of the upkeep's log filter expression, matched with OR
+	// semantics together with selector and topics.
+	topicFilters [][]common.Hash
+	// predicates are the data predicates of the upkeep's log filter expression.
+	predicates []dataPredicate
+	upkeepID   *big.Int
 	// configUpdateBlock is the block number the filter was last updated at
 	configUpdateBlock uint64
 	// lastPollBlock is the last block number the logs were fetched for this upkeep
@@ -38,6 +43,8 @@ func (f upkeepFilter) Clone() upkeepFilter {
 		upkeepID:          f.upkeepID,
 		selector:          f.selector,
 		topics:            topics,
+		topicFilters:      f.topicFilters,
+		predicates:        f.predicates,
 		addr:              addr,
 		configUpdateBlock: f.configUpdateBlock,
 		lastPollBlock:     f.lastPollBlock,
@@ -57,8 +64,39 @@ func (f upkeepFilter) Select(logs ...logpoller.Log) []logpoller.Log {
 	return selected
 }
 
-// match returns a bool indicating if the log's topics data matches selector and indexed topics in upkeep filter.
+// match returns a bool indicating if the log matches the topic filters and data predicates of the upkeep filter.
 func (f upkeepFilter) match(log logpoller.Log) bool {
+	if !f.matchTopics(log) {
+		return false
+	}
+	for _, p := range f.predicates {
+		if !p.eval(log.Data) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchTopics returns a bool indicating if the log's topics match the trigger config or any additional topic filter.
+func (f upkeepFilter) matchTopics(log logpoller.Log) bool {
+	if len(f.topicFilters) == 0 {
+		// the log poller only returns logs with the topic0 of the trigger config
+		return f.matchSelector(log)
+	}
+	// logs of several events are returned by the log poller, so topic0 needs to be matched as well
+	if len(log.Topics) > 0 && bytes.Equal(f.topics[0].Bytes(), log.Topics[0]) && f.matchSelector(log) {
+		return true
+	}
+	for _, topics := range f.topicFilters {
+		if matchTopicFilter(topics, log) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSelector returns a bool indicating if the log's topics data matches selector and indexed topics in upkeep filter.
+func (f upkeepFilter) matchSelector(log logpoller.Log) bool {
 	filters := f.topics[1:]
 	selector := f.selector
 
@@ -84,3 +122,17 @@ func (f upkeepFilter) match(log logpoller.Log) bool {
 	}
 	return true
 }
+
+// matchTopicFilter returns a bool indicating if the log's topics match the given topic filter, where zero topics
+// match any value.
+func matchTopicFilter(topics []common.Hash, log logpoller.Log) bool {
+	for i, topic := range topics {
+		if topic == (common.Hash{}) {
+			continue
+		}
+		if len(log.Topics) <= i || !bytes.Equal(topic.Bytes(), log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package logprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// maxTopicFilters is the maximum number of additional topic filters of an upkeep.
+	maxTopicFilters = 8
+	// maxDataPredicates is the maximum number of data predicates of an upkeep.
+	maxDataPredicates = 8
+	// wordSize is the size of the words of the log data the data predicates are evaluated on.
+	wordSize = 32
+)
+
+// PredicateOp is the comparison operator of a data predicate.
+type PredicateOp string
+
+const (
+	PredicateOpEq  PredicateOp = "eq"
+	PredicateOpNeq PredicateOp = "neq"
+	PredicateOpGt  PredicateOp = "gt"
+	PredicateOpGte PredicateOp = "gte"
+	PredicateOpLt  PredicateOp = "lt"
+	PredicateOpLte PredicateOp = "lte"
+)
+
+// LogFilterExpression extends the log trigger config of an upkeep with filters that are evaluated by the node
+// before the logs are checked, so that upkeeps listening to chatty contracts do not waste check pipeline runs on
+// irrelevant logs. It is read from the "logFilter" key of the upkeep's offchain config, when the offchain config
+// is JSON.
+type LogFilterExpression struct {
+	// Topics are additional topic filters, each listing topic0 to topic3. A log is selected if it matches the
+	// trigger config or any of these filters. Zero or missing topics after topic0 match any value.
+	Topics [][]common.Hash `json:"topics,omitempty"`
+	// Data are predicates on the 32 byte words of the log data, which must all hold for a log to be selected.
+	Data []DataPredicate `json:"data,omitempty"`
+}
+
+// DataPredicate compares a 32 byte word of the log data, as an unsigned integer, to a value.
+type DataPredicate struct {
+	// Word is the index of the word in the log data.
+	Word uint        `json:"word"`
+	Op   PredicateOp `json:"op"`
+	// Value is the decimal or 0x prefixed hex value to compare the word to.
+	Value string `json:"value"`
+}
+
+// ParseLogFilterExpression returns the log filter expression of the given offchain config, or nil if the config
+// is not JSON or has no "logFilter" key.
+func ParseLogFilterExpression(offchainConfig []byte) (*LogFilterExpression, error) {
+	if len(offchainConfig) == 0 || !json.Valid(offchainConfig) {
+		return nil, nil
+	}
+	var cfg struct {
+		LogFilter *LogFilterExpression `json:"logFilter"`
+	}
+	if err := json.Unmarshal(offchainConfig, &cfg); err != nil || cfg.LogFilter == nil {
+		// not an object, or not meant for the node
+		return nil, nil
+	}
+	if _, _, err := cfg.LogFilter.compile(); err != nil {
+		return nil, err
+	}
+	return cfg.LogFilter, nil
+}
+
+// compile validates the expression and returns its topic filters, padded to 4 topics, and its parsed data
+// predicates.
+func (e *LogFilterExpression) compile() ([][]common.Hash, []dataPredicate, error) {
+	if e == nil {
+		return nil, nil, nil
+	}
+	if len(e.Topics) > maxTopicFilters {
+		return nil, nil, fmt.Errorf("too many topic filters: %d, max is %d", len(e.Topics), maxTopicFilters)
+	}
+	if len(e.Data) > maxDataPredicates {
+		return nil, nil, fmt.Errorf("too many data predicates: %d, max is %d", len(e.Data), maxDataPredicates)
+	}
+	var topicFilters [][]common.Hash
+	for i, topics := range e.Topics {
+		if len(topics) == 0 || len(topics) > 4 {
+			return nil, nil, fmt.Errorf("topic filter %d: must have 1 to 4 topics", i)
+		}
+		if topics[0] == (common.Hash{}) {
+			return nil, nil, fmt.Errorf("topic filter %d: invalid topic0: zeroed", i)
+		}
+		padded := make([]common.Hash, 4)
+		copy(padded, topics)
+		topicFilters = append(topicFilters, padded)
+	}
+	var predicates []dataPredicate
+	for i, p := range e.Data {
+		switch p.Op {
+		case PredicateOpEq, PredicateOpNeq, PredicateOpGt, PredicateOpGte, PredicateOpLt, PredicateOpLte:
+		default:
+			return nil, nil, fmt.Errorf("data predicate %d: invalid op %q", i, p.Op)
+		}
+		value, ok := new(big.Int).SetString(p.Value, 0)
+		if !ok || value.Sign() < 0 || value.BitLen() > 8*wordSize {
+			return nil, nil, fmt.Errorf("data predicate %d: invalid value %q", i, p.Value)
+		}
+		predicates = append(predicates, dataPredicate{word: p.Word, op: p.Op, value: value})
+	}
+	return topicFilters, predicates, nil
+}
+
+type dataPredicate struct {
+	word  uint
+	op    PredicateOp
+	value *big.Int
+}
+
+// eval returns whether the predicate holds for the given log data. Logs with data too short to contain the word
+// never match.
+func (p dataPredicate) eval(data []byte) bool {
+	start := uint64(p.word) * wordSize
+	if uint64(len(data)) < start+wordSize {
+		return false
+	}
+	c := new(big.Int).SetBytes(data[start : start+wordSize]).Cmp(p.value)
+	switch p.op {
+	case PredicateOpEq:
+		return c == 0
+	case PredicateOpNeq:
+		return c != 0
+	case PredicateOpGt:
+		return c > 0
+	case PredicateOpGte:
+		return c >= 0
+	case PredicateOpLt:
+		return c < 0
+	case PredicateOpLte:
+		return c <= 0
+	default:
+		return false
+	}
+}
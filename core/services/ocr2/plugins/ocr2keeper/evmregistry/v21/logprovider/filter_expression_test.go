@@ -0,0 +1,50 @@
+package logprovider
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogFilterExpression(t *testing.T) {
+	sig := "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+	tests := []struct {
+		name           string
+		offchainConfig string
+		want           *LogFilterExpression
+		wantErr        string
+	}{
+		{"empty config", "", nil, ""},
+		{"not JSON", "\xa1\x00\x01", nil, ""},
+		{"not an object", "[1]", nil, ""},
+		{"no log filter", `{"maxGasPrice":1}`, nil, ""},
+		{
+			"topics and data",
+			`{"logFilter":{"topics":[["` + sig + `"]],"data":[{"word":1,"op":"gt","value":"100"}]}}`,
+			&LogFilterExpression{
+				Topics: [][]common.Hash{{common.HexToHash(sig)}},
+				Data:   []DataPredicate{{Word: 1, Op: PredicateOpGt, Value: "100"}},
+			},
+			"",
+		},
+		{"zero topic0", `{"logFilter":{"topics":[["` + common.Hash{}.Hex() + `"]]}}`, nil, "topic filter 0: invalid topic0: zeroed"},
+		{"empty topic filter", `{"logFilter":{"topics":[[]]}}`, nil, "topic filter 0: must have 1 to 4 topics"},
+		{"invalid op", `{"logFilter":{"data":[{"word":0,"op":"like","value":"1"}]}}`, nil, `data predicate 0: invalid op "like"`},
+		{"invalid value", `{"logFilter":{"data":[{"word":0,"op":"eq","value":"-1"}]}}`, nil, `data predicate 0: invalid value "-1"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseLogFilterExpression([]byte(tc.offchainConfig))
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
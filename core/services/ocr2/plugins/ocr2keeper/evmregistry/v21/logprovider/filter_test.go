@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 )
@@ -194,3 +195,81 @@ func TestUpkeepFilter_Select(t *testing.T) {
 		})
 	}
 }
+
+func TestUpkeepFilter_Select_Expression(t *testing.T) {
+	var zeroBytes [32]byte
+	emptyTopic := common.BytesToHash(zeroBytes[:])
+	sig1 := common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111")
+	sig2 := common.HexToHash("0x2222222222222222222222222222222222222222222222222222222222222222")
+	sig3 := common.HexToHash("0x3333333333333333333333333333333333333333333333333333333333333333")
+	topic1 := common.HexToHash("0x7b")
+	amount := func(v int64) []byte {
+		return common.BigToHash(big.NewInt(v)).Bytes()
+	}
+
+	log1 := logpoller.Log{Topics: [][]byte{sig1.Bytes(), topic1.Bytes()}, Data: amount(10)}
+	log2 := logpoller.Log{Topics: [][]byte{sig2.Bytes(), topic1.Bytes()}, Data: amount(10)}
+	log3 := logpoller.Log{Topics: [][]byte{sig2.Bytes(), sig3.Bytes()}, Data: amount(10)}
+	log4 := logpoller.Log{Topics: [][]byte{sig3.Bytes()}, Data: amount(10)}
+	log5 := logpoller.Log{Topics: [][]byte{sig1.Bytes(), topic1.Bytes()}, Data: append(amount(1), amount(1000)...)}
+	log6 := logpoller.Log{Topics: [][]byte{sig1.Bytes(), topic1.Bytes()}}
+
+	tests := []struct {
+		name         string
+		expr         LogFilterExpression
+		logs         []logpoller.Log
+		expectedLogs []logpoller.Log
+	}{
+		{
+			"topic filters are OR-ed with the trigger config",
+			LogFilterExpression{
+				Topics: [][]common.Hash{{sig2, topic1}},
+			},
+			[]logpoller.Log{log1, log2, log3, log4},
+			[]logpoller.Log{log1, log2},
+		},
+		{
+			"zero topics match any value",
+			LogFilterExpression{
+				Topics: [][]common.Hash{{sig2}, {sig3, emptyTopic}},
+			},
+			[]logpoller.Log{log1, log2, log3, log4},
+			[]logpoller.Log{log1, log2, log3, log4},
+		},
+		{
+			"data predicates must all hold",
+			LogFilterExpression{
+				Data: []DataPredicate{
+					{Word: 0, Op: PredicateOpLt, Value: "10"},
+					{Word: 1, Op: PredicateOpGte, Value: "0x3e8"},
+				},
+			},
+			[]logpoller.Log{log1, log5, log6},
+			[]logpoller.Log{log5},
+		},
+		{
+			"data predicates apply to logs of all topic filters",
+			LogFilterExpression{
+				Topics: [][]common.Hash{{sig2}},
+				Data:   []DataPredicate{{Word: 0, Op: PredicateOpEq, Value: "10"}},
+			},
+			[]logpoller.Log{log1, log2, log4, log5, log6},
+			[]logpoller.Log{log1, log2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			topicFilters, predicates, err := tc.expr.compile()
+			require.NoError(t, err)
+			filter := upkeepFilter{
+				selector:     1,
+				topics:       []common.Hash{sig1, topic1, emptyTopic, emptyTopic},
+				topicFilters: topicFilters,
+				predicates:   predicates,
+				upkeepID:     big.NewInt(1),
+			}
+			assert.Equal(t, tc.expectedLogs, filter.Select(tc.logs...))
+		})
+	}
+}
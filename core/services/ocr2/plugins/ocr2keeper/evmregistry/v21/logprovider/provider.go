@@ -55,6 +55,8 @@ type FilterOptions struct {
 	UpkeepID      *big.Int
 	TriggerConfig LogTriggerConfig
 	UpdateBlock   uint64
+	// Expression is the optional log filter expression of the upkeep's offchain config.
+	Expression *LogFilterExpression
 }
 
 type LogTriggersLifeCycle interface {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -63,7 +64,11 @@ func (p *logEventProvider) RegisterFilter(ctx context.Context, opts FilterOption
 	if err := p.validateLogTriggerConfig(cfg); err != nil {
 		return fmt.Errorf("invalid log trigger config: %w", err)
 	}
-	lpFilter := p.newLogFilter(upkeepID, cfg)
+	topicFilters, predicates, err := opts.Expression.compile()
+	if err != nil {
+		return fmt.Errorf("invalid log filter expression: %w", err)
+	}
+	lpFilter := p.newLogFilter(upkeepID, cfg, topicFilters...)
 
 	// using lock to facilitate multiple events causing filter registration
 	// at the same time.
@@ -95,6 +100,8 @@ func (p *logEventProvider) RegisterFilter(ctx context.Context, opts FilterOption
 	filter.selector = cfg.FilterSelector
 	filter.addr = cfg.ContractAddress.Bytes()
 	filter.topics = []common.Hash{cfg.Topic0, cfg.Topic1, cfg.Topic2, cfg.Topic3}
+	filter.topicFilters = topicFilters
+	filter.predicates = predicates
 
 	if err := p.register(ctx, lpFilter, filter); err != nil {
 		return fmt.Errorf("failed to register upkeep filter %s: %w", filter.upkeepID.String(), err)
@@ -157,13 +164,18 @@ func (p *logEventProvider) UnregisterFilter(upkeepID *big.Int) error {
 	return nil
 }
 
-// newLogFilter creates logpoller.Filter from the given upkeep config
-func (p *logEventProvider) newLogFilter(upkeepID *big.Int, cfg LogTriggerConfig) logpoller.Filter {
+// newLogFilter creates logpoller.Filter from the given upkeep config and additional topic filters
+func (p *logEventProvider) newLogFilter(upkeepID *big.Int, cfg LogTriggerConfig, topicFilters ...[]common.Hash) logpoller.Filter {
+	// log poller filter treats this event sigs slice as an array of topic0
+	eventSigs := []common.Hash{common.BytesToHash(cfg.Topic0[:])}
+	for _, topics := range topicFilters {
+		if !slices.Contains(eventSigs, topics[0]) {
+			eventSigs = append(eventSigs, topics[0])
+		}
+	}
 	return logpoller.Filter{
-		Name: p.filterName(upkeepID),
-		// log poller filter treats this event sigs slice as an array of topic0
-		// since we don't support multiple events right now, only put one topic0 here
-		EventSigs: []common.Hash{common.BytesToHash(cfg.Topic0[:])},
+		Name:      p.filterName(upkeepID),
+		EventSigs: eventSigs,
 		Addresses: []common.Address{cfg.ContractAddress},
 		Retention: LogRetention,
 	}
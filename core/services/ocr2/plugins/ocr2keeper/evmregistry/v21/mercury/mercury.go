@@ -78,6 +78,8 @@ type MercuryConfigProvider interface {
 	SetUpkeepAllowed(string, interface{}, time.Duration)
 	GetPluginRetry(string) (interface{}, bool)
 	SetPluginRetry(string, interface{}, time.Duration)
+	GetReport(string) (interface{}, bool)
+	SetReport(string, interface{}, time.Duration)
 }
 
 type HttpClient interface {
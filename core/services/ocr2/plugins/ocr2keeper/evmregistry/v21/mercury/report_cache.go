@@ -0,0 +1,64 @@
+package mercury
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// ReportCacheExpiration decides how long a report fetched from mercury is shared between the upkeeps requesting
+	// the same feed at the same time. It is kept short, so that the cache only dedupes the requests of a check round.
+	ReportCacheExpiration = 5 * time.Second
+	// feedAccessExpiration decides how long an upkeep which was served a feed by the mercury v0.3 server is allowed
+	// to get the feed's reports from the cache.
+	feedAccessExpiration = 10 * time.Minute
+)
+
+// CachedReports returns the cached reports of the feeds of the lookup, in the order of the feeds. It only returns
+// true if the reports of all the feeds are cached.
+func CachedReports(mercuryConfig MercuryConfigProvider, sl *StreamsLookup) ([][]byte, bool) {
+	reports := make([][]byte, len(sl.Feeds))
+	for i, feed := range sl.Feeds {
+		report, ok := CachedReport(mercuryConfig, sl, feed)
+		if !ok {
+			return nil, false
+		}
+		reports[i] = report
+	}
+	return reports, true
+}
+
+// CachedReport returns the cached report of the feed at the time of the lookup.
+func CachedReport(mercuryConfig MercuryConfigProvider, sl *StreamsLookup, feed string) ([]byte, bool) {
+	if sl.IsMercuryV03() {
+		// mercury v0.3 checks the access of the upkeep to each feed it requests, so reports are only shared with
+		// upkeeps the server already served the feed to.
+		if _, ok := mercuryConfig.GetReport(feedAccessKey(sl.UpkeepId, feed)); !ok {
+			return nil, false
+		}
+	}
+	v, ok := mercuryConfig.GetReport(reportKey(sl, feed))
+	if !ok {
+		return nil, false
+	}
+	report, ok := v.([]byte)
+	return report, ok
+}
+
+// CacheReport caches the report of the feed at the time of the lookup, as served to the upkeep of the lookup.
+func CacheReport(mercuryConfig MercuryConfigProvider, sl *StreamsLookup, feed string, report []byte) {
+	mercuryConfig.SetReport(reportKey(sl, feed), report, ReportCacheExpiration)
+	if sl.IsMercuryV03() {
+		mercuryConfig.SetReport(feedAccessKey(sl.UpkeepId, feed), true, feedAccessExpiration)
+	}
+}
+
+func reportKey(sl *StreamsLookup, feed string) string {
+	return fmt.Sprintf("report|%s|%s|%s|%s", sl.FeedParamKey, strings.ToLower(feed), sl.TimeParamKey, sl.Time)
+}
+
+func feedAccessKey(upkeepID *big.Int, feed string) string {
+	return fmt.Sprintf("access|%s|%s", upkeepID, strings.ToLower(feed))
+}
@@ -65,6 +65,12 @@ func (m *MockMercuryConfigProvider) SetPluginRetry(s string, i interface{}, d ti
 	m.Called(s, i, d)
 }
 
+func (m *MockMercuryConfigProvider) GetReport(s string) (interface{}, bool) {
+	return nil, false
+}
+
+func (m *MockMercuryConfigProvider) SetReport(s string, i interface{}, d time.Duration) {}
+
 type MockBlockSubscriber struct {
 	mock.Mock
 }
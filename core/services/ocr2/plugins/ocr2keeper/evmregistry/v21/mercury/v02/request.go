@@ -95,6 +95,12 @@ func (c *client) DoRequest(ctx context.Context, streamsLookup *mercury.StreamsLo
 }
 
 func (c *client) singleFeedRequest(ctx context.Context, ch chan<- mercury.MercuryData, index int, sl *mercury.StreamsLookup) {
+	if report, ok := mercury.CachedReport(c.mercuryConfig, sl, sl.Feeds[index]); ok {
+		c.lggr.Debugf("at block %s upkeep %s using cached report of feed %s", sl.Time.String(), sl.UpkeepId.String(), sl.Feeds[index])
+		ch <- mercury.MercuryData{Index: index, Bytes: [][]byte{report}, Retryable: false, State: encoding.NoPipelineError}
+		return
+	}
+
 	var httpRequest *http.Request
 	var err error
 
@@ -169,6 +175,7 @@ func (c *client) singleFeedRequest(ctx context.Context, ch chan<- mercury.Mercur
 				state = encoding.InvalidMercuryResponse
 				return err
 			}
+			mercury.CacheReport(c.mercuryConfig, sl, sl.Feeds[index], blobBytes)
 			ch <- mercury.MercuryData{
 				Index:     index,
 				Bytes:     [][]byte{blobBytes},
@@ -32,6 +32,8 @@ const (
 
 type MockMercuryConfigProvider struct {
 	cache *cache.Cache
+	// reports caches the reports if set
+	reports *cache.Cache
 	mock.Mock
 }
 
@@ -72,6 +74,19 @@ func (m *MockMercuryConfigProvider) SetPluginRetry(s string, i interface{}, d ti
 	m.cache.Set(s, i, d)
 }
 
+func (m *MockMercuryConfigProvider) GetReport(s string) (interface{}, bool) {
+	if m.reports == nil {
+		return nil, false
+	}
+	return m.reports.Get(s)
+}
+
+func (m *MockMercuryConfigProvider) SetReport(s string, i interface{}, d time.Duration) {
+	if m.reports != nil {
+		m.reports.Set(s, i, d)
+	}
+}
+
 type MockHttpClient struct {
 	mock.Mock
 }
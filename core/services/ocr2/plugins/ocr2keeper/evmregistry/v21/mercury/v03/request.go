@@ -65,6 +65,10 @@ func (c *client) DoRequest(ctx context.Context, streamsLookup *mercury.StreamsLo
 	if len(streamsLookup.Feeds) == 0 {
 		return encoding.NoPipelineError, encoding.UpkeepFailureReasonInvalidRevertDataInput, [][]byte{}, false, 0 * time.Second, fmt.Errorf("invalid revert data input: feed param key %s, time param key %s, feeds %s", streamsLookup.FeedParamKey, streamsLookup.TimeParamKey, streamsLookup.Feeds)
 	}
+	if reports, ok := mercury.CachedReports(c.mercuryConfig, streamsLookup); ok {
+		c.lggr.Debugf("at timestamp %s upkeep %s using cached reports of feeds %s", streamsLookup.Time.String(), streamsLookup.UpkeepId.String(), streamsLookup.Feeds)
+		return encoding.NoPipelineError, encoding.UpkeepFailureReasonNone, reports, false, 0 * time.Second, nil
+	}
 	resultLen := 1 // Only 1 multi-feed request is made for all feeds
 	ch := make(chan mercury.MercuryData, resultLen)
 	c.threadCtrl.Go(func(ctx context.Context) {
@@ -209,6 +213,9 @@ func (c *client) multiFeedsRequest(ctx context.Context, ch chan<- mercury.Mercur
 				}
 				reportBytes = append(reportBytes, b)
 			}
+			for i, rsp := range response.Reports {
+				mercury.CacheReport(c.mercuryConfig, sl, rsp.FeedID, reportBytes[i])
+			}
 			ch <- mercury.MercuryData{
 				Index:     0,
 				Bytes:     reportBytes,
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -31,6 +32,8 @@ const (
 
 type MockMercuryConfigProvider struct {
 	cache *cache.Cache
+	// reports caches the reports if set
+	reports *cache.Cache
 	mock.Mock
 }
 
@@ -71,6 +74,19 @@ func (m *MockMercuryConfigProvider) SetPluginRetry(s string, i interface{}, d ti
 	m.cache.Set(s, i, d)
 }
 
+func (m *MockMercuryConfigProvider) GetReport(s string) (interface{}, bool) {
+	if m.reports == nil {
+		return nil, false
+	}
+	return m.reports.Get(s)
+}
+
+func (m *MockMercuryConfigProvider) SetReport(s string, i interface{}, d time.Duration) {
+	if m.reports != nil {
+		m.reports.Set(s, i, d)
+	}
+}
+
 type MockHttpClient struct {
 	mock.Mock
 }
@@ -534,3 +550,51 @@ func TestV03_MultiFeedRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestV03_DoRequest_ReportCache(t *testing.T) {
+	c := setupClient(t)
+	defer c.Close()
+	c.mercuryConfig.(*MockMercuryConfigProvider).reports = cache.New(mercury.ReportCacheExpiration, cleanupInterval)
+	hc := mocks.NewHttpClient(t)
+	c.httpClient = hc
+
+	feed := "0x4554482d5553442d415242495452554d2d544553544e45540000000000000000"
+	newResponse := func() *http.Response {
+		b, err := json.Marshal(MercuryV03Response{Reports: []MercuryV03Report{{FeedID: feed, FullReport: "0x1234"}}})
+		require.NoError(t, err)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(b))}
+	}
+	newLookup := func(upkeepID int64, ts int64) *mercury.StreamsLookup {
+		return &mercury.StreamsLookup{
+			StreamsLookupError: &mercury.StreamsLookupError{
+				FeedParamKey: mercury.FeedIDs,
+				Feeds:        []string{feed},
+				TimeParamKey: mercury.Timestamp,
+				Time:         big.NewInt(ts),
+			},
+			UpkeepId: big.NewInt(upkeepID),
+		}
+	}
+	hc.On("Do", mock.Anything).Return(newResponse(), nil).Once()
+	hc.On("Do", mock.Anything).Return(newResponse(), nil).Once()
+	hc.On("Do", mock.Anything).Return(newResponse(), nil).Once()
+
+	for _, tc := range []struct {
+		name     string
+		lookup   *mercury.StreamsLookup
+		requests int
+	}{
+		{"first lookup is requested", newLookup(1, 100), 1},
+		{"same lookup is served from cache", newLookup(1, 100), 1},
+		{"lookup of another time is requested", newLookup(1, 101), 2},
+		{"upkeep not served the feed yet is requested", newLookup(2, 100), 3},
+		{"upkeep served the feed is served from cache", newLookup(2, 101), 3},
+	} {
+		state, _, values, retryable, _, err := c.DoRequest(testutils.Context(t), tc.lookup, "")
+		require.NoError(t, err, tc.name)
+		assert.Equal(t, encoding.NoPipelineError, state, tc.name)
+		assert.False(t, retryable, tc.name)
+		assert.Equal(t, [][]byte{{0x12, 0x34}}, values, tc.name)
+		hc.AssertNumberOfCalls(t, "Do", tc.requests)
+	}
+}
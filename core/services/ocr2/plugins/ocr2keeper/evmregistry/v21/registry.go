@@ -31,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/mercury/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -41,7 +42,9 @@ const (
 	// defaultAllowListExpiration decides how long an upkeep's allow list info will be valid for.
 	defaultAllowListExpiration = 10 * time.Minute
 	// cleanupInterval decides when the expired items in cache will be deleted.
-	cleanupInterval            = 5 * time.Minute
+	cleanupInterval = 5 * time.Minute
+	// reportCacheCleanupInterval decides when the expired reports in cache will be deleted.
+	reportCacheCleanupInterval = time.Minute
 	logTriggerRefreshBatchSize = 32
 )
 
@@ -93,6 +96,7 @@ func NewEvmRegistry(
 		Abi:              core.StreamsCompatibleABI,
 		AllowListCache:   cache.New(defaultAllowListExpiration, cleanupInterval),
 		pluginRetryCache: cache.New(defaultPluginRetryExpiration, cleanupInterval),
+		reportCache:      cache.New(mercury.ReportCacheExpiration, reportCacheCleanupInterval),
 	}
 	hc := http.DefaultClient
 
@@ -119,13 +123,14 @@ func NewEvmRegistry(
 }
 
 var upkeepStateEvents = []common.Hash{
-	iregistry21.IKeeperRegistryMasterUpkeepRegistered{}.Topic(),       // adds new upkeep id to registry
-	iregistry21.IKeeperRegistryMasterUpkeepReceived{}.Topic(),         // adds new upkeep id to registry via migration
-	iregistry21.IKeeperRegistryMasterUpkeepUnpaused{}.Topic(),         // unpauses an upkeep
-	iregistry21.IKeeperRegistryMasterUpkeepPaused{}.Topic(),           // pauses an upkeep
-	iregistry21.IKeeperRegistryMasterUpkeepMigrated{}.Topic(),         // migrated an upkeep, equivalent to cancel from this registry's perspective
-	iregistry21.IKeeperRegistryMasterUpkeepCanceled{}.Topic(),         // cancels an upkeep
-	iregistry21.IKeeperRegistryMasterUpkeepTriggerConfigSet{}.Topic(), // trigger config was changed
+	iregistry21.IKeeperRegistryMasterUpkeepRegistered{}.Topic(),        // adds new upkeep id to registry
+	iregistry21.IKeeperRegistryMasterUpkeepReceived{}.Topic(),          // adds new upkeep id to registry via migration
+	iregistry21.IKeeperRegistryMasterUpkeepUnpaused{}.Topic(),          // unpauses an upkeep
+	iregistry21.IKeeperRegistryMasterUpkeepPaused{}.Topic(),            // pauses an upkeep
+	iregistry21.IKeeperRegistryMasterUpkeepMigrated{}.Topic(),          // migrated an upkeep, equivalent to cancel from this registry's perspective
+	iregistry21.IKeeperRegistryMasterUpkeepCanceled{}.Topic(),          // cancels an upkeep
+	iregistry21.IKeeperRegistryMasterUpkeepTriggerConfigSet{}.Topic(),  // trigger config was changed
+	iregistry21.IKeeperRegistryMasterUpkeepOffchainConfigSet{}.Topic(), // offchain config, which may hold a log filter expression, was changed
 }
 
 type MercuryConfig struct {
@@ -134,6 +139,8 @@ type MercuryConfig struct {
 	// AllowListCache stores the upkeeps privileges. In 2.1, this only includes a JSON bytes for allowed to use mercury
 	AllowListCache   *cache.Cache
 	pluginRetryCache *cache.Cache
+	// reportCache stores the reports fetched from mercury, shared between the upkeeps requesting the same feeds
+	reportCache *cache.Cache
 }
 
 func NewMercuryConfig(credentials *models.MercuryCredentials, abi abi.ABI) *MercuryConfig {
@@ -142,6 +149,7 @@ func NewMercuryConfig(credentials *models.MercuryCredentials, abi abi.ABI) *Merc
 		Abi:              abi,
 		AllowListCache:   cache.New(defaultPluginRetryExpiration, cleanupInterval),
 		pluginRetryCache: cache.New(defaultPluginRetryExpiration, cleanupInterval),
+		reportCache:      cache.New(mercury.ReportCacheExpiration, reportCacheCleanupInterval),
 	}
 }
 
@@ -165,6 +173,14 @@ func (c *MercuryConfig) SetPluginRetry(k string, v interface{}, d time.Duration)
 	c.pluginRetryCache.Set(k, v, d)
 }
 
+func (c *MercuryConfig) GetReport(k string) (interface{}, bool) {
+	return c.reportCache.Get(k)
+}
+
+func (c *MercuryConfig) SetReport(k string, v interface{}, d time.Duration) {
+	c.reportCache.Set(k, v, d)
+}
+
 type EvmRegistry struct {
 	services.StateMachine
 	threadCtrl       utils.ThreadControl
@@ -467,6 +483,11 @@ func (r *EvmRegistry) processUpkeepStateLog(l logpoller.Log) error {
 		if err := r.updateTriggerConfig(l.Id, l.TriggerConfig, rawLog.BlockNumber); err != nil {
 			r.lggr.Warnf("failed to update trigger config upon KeeperRegistryMasterUpkeepTriggerConfigSet for upkeep ID %s: %s", l.Id.String(), err)
 		}
+	case *iregistry21.IKeeperRegistryMasterUpkeepOffchainConfigSet:
+		r.lggr.Debugf("KeeperRegistryUpkeepOffchainConfigSet log detected for upkeep ID %s in transaction %s", l.Id.String(), txHash)
+		if err := r.updateTriggerConfig(l.Id, nil, rawLog.BlockNumber); err != nil {
+			r.lggr.Warnf("failed to update trigger config upon KeeperRegistryMasterUpkeepOffchainConfigSet for upkeep ID %s: %s", l.Id.String(), err)
+		}
 	case *iregistry21.IKeeperRegistryMasterUpkeepRegistered:
 		uid := &ocr2keepers.UpkeepIdentifier{}
 		uid.FromBigInt(l.Id)
@@ -506,6 +527,7 @@ func (r *EvmRegistry) registerEvents(_ uint64, addr common.Address) error {
 		Name:      RegistryUpkeepFilterName(addr),
 		EventSigs: upkeepStateEvents,
 		Addresses: []common.Address{addr},
+		Priority:  logpoller.FilterPriorityCritical,
 	})
 }
 
@@ -599,10 +621,16 @@ func (r *EvmRegistry) updateTriggerConfig(id *big.Int, cfg []byte, logBlock uint
 			r.lggr.Warnw("failed to unpack log upkeep config", "upkeepID", id.String(), "err", err)
 			return nil
 		}
+		expr, err := r.fetchLogFilterExpression(id)
+		if err != nil {
+			// Upkeep has been setup with an improper filter expression. Log a warning and register the filter without it.
+			r.lggr.Warnw("failed to get log filter expression of upkeep", "upkeepID", id.String(), "err", err)
+		}
 		if err := r.logEventProvider.RegisterFilter(r.ctx, logprovider.FilterOptions{
 			TriggerConfig: logprovider.LogTriggerConfig(parsed),
 			UpkeepID:      id,
 			UpdateBlock:   logBlock,
+			Expression:    expr,
 		}); err != nil {
 			return errors.Wrap(err, "failed to register log filter")
 		}
@@ -622,3 +650,13 @@ func (r *EvmRegistry) fetchTriggerConfig(id *big.Int) ([]byte, error) {
 	}
 	return cfg, nil
 }
+
+// fetchLogFilterExpression fetches the log filter expression from the offchain config of an upkeep, if any.
+func (r *EvmRegistry) fetchLogFilterExpression(id *big.Int) (*logprovider.LogFilterExpression, error) {
+	opts := r.buildCallOpts(r.ctx, nil)
+	info, err := r.registry.GetUpkeep(opts, id)
+	if err != nil {
+		return nil, err
+	}
+	return logprovider.ParseLogFilterExpression(info.OffchainConfig)
+}
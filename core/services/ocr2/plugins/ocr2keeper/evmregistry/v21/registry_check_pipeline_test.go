@@ -690,6 +690,7 @@ func setupEVMRegistry(t *testing.T) *EvmRegistry {
 			},
 			Abi:            streamsLookupCompatibleABI,
 			AllowListCache: cache.New(defaultAllowListExpiration, cleanupInterval),
+			reportCache:    cache.New(defaultAllowListExpiration, cleanupInterval),
 		},
 		hc: mockHttpClient,
 	}
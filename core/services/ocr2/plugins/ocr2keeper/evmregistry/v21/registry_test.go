@@ -543,9 +543,17 @@ func (p *mockLogEventProvider) RegisterFilter(ctx context.Context, opts logprovi
 type mockRegistry struct {
 	Registry
 	GetUpkeepTriggerConfigFn func(opts *bind.CallOpts, upkeepId *big.Int) ([]byte, error)
+	GetUpkeepFn              func(opts *bind.CallOpts, id *big.Int) (encoding.UpkeepInfo, error)
 	ParseLogFn               func(log coreTypes.Log) (generated.AbigenLog, error)
 }
 
+func (r *mockRegistry) GetUpkeep(opts *bind.CallOpts, id *big.Int) (encoding.UpkeepInfo, error) {
+	if r.GetUpkeepFn == nil {
+		return encoding.UpkeepInfo{}, nil
+	}
+	return r.GetUpkeepFn(opts, id)
+}
+
 func (r *mockRegistry) ParseLog(log coreTypes.Log) (generated.AbigenLog, error) {
 	return r.ParseLogFn(log)
 }
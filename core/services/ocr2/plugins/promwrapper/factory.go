@@ -1,8 +1,6 @@
 package promwrapper
 
 import (
-	"math/big"
-
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 )
 
@@ -12,7 +10,7 @@ type promFactory struct {
 	wrapped   types.ReportingPluginFactory
 	name      string
 	chainType string
-	chainID   *big.Int
+	chainID   string
 }
 
 func (p *promFactory) NewReportingPlugin(config types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
@@ -25,7 +23,7 @@ func (p *promFactory) NewReportingPlugin(config types.ReportingPluginConfig) (ty
 	return prom, info, nil
 }
 
-func NewPromFactory(wrapped types.ReportingPluginFactory, name, chainType string, chainID *big.Int) types.ReportingPluginFactory {
+func NewPromFactory(wrapped types.ReportingPluginFactory, name, chainType, chainID string) types.ReportingPluginFactory {
 	return &promFactory{
 		wrapped:   wrapped,
 		name:      name,
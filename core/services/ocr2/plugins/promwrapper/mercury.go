@@ -0,0 +1,132 @@
+package promwrapper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+var (
+	_ ocr3types.MercuryPluginFactory = &promMercuryFactory{}
+	_ ocr3types.MercuryPlugin        = &promMercuryPlugin{}
+)
+
+type promMercuryFactory struct {
+	wrapped   ocr3types.MercuryPluginFactory
+	name      string
+	chainType string
+	chainID   string
+}
+
+func (p *promMercuryFactory) NewMercuryPlugin(config ocr3types.MercuryPluginConfig) (ocr3types.MercuryPlugin, ocr3types.MercuryPluginInfo, error) {
+	plugin, info, err := p.wrapped.NewMercuryPlugin(config)
+	if err != nil {
+		return nil, ocr3types.MercuryPluginInfo{}, err
+	}
+
+	prom := NewMercury(plugin, p.name, p.chainType, p.chainID, config, nil)
+	return prom, info, nil
+}
+
+func NewPromMercuryFactory(wrapped ocr3types.MercuryPluginFactory, name, chainType, chainID string) ocr3types.MercuryPluginFactory {
+	return &promMercuryFactory{
+		wrapped:   wrapped,
+		name:      name,
+		chainType: chainType,
+		chainID:   chainID,
+	}
+}
+
+// promMercuryPlugin consumes a mercury plugin and wraps its functions with the metrics of the matching OCR2 phases.
+type promMercuryPlugin struct {
+	wrapped ocr3types.MercuryPlugin
+	// metrics holds the labels, the observation end times and the backend. Its wrapped plugin is unset.
+	metrics *promPlugin
+}
+
+func NewMercury(
+	plugin ocr3types.MercuryPlugin,
+	name string,
+	chainType string,
+	chainID string,
+	config ocr3types.MercuryPluginConfig,
+	backend PrometheusBackend,
+) ocr3types.MercuryPlugin {
+	// Apply passed-in Prometheus backend if one is given.
+	var prometheusBackend PrometheusBackend = &defaultPrometheusBackend{}
+	if backend != nil {
+		prometheusBackend = backend
+	}
+
+	return &promMercuryPlugin{
+		wrapped: plugin,
+		metrics: &promPlugin{
+			name:              name,
+			chainType:         chainType,
+			chainID:           chainID,
+			oracleID:          fmt.Sprintf("%d", config.OracleID),
+			configDigest:      common.Bytes2Hex(config.ConfigDigest[:]),
+			prometheusBackend: prometheusBackend,
+		},
+	}
+}
+
+func (p *promMercuryPlugin) Observation(ctx context.Context, timestamp types.ReportTimestamp, previousReport types.Report) (o types.Observation, err error) {
+	start := time.Now().UTC()
+	labelValues := getLabelsValues(p.metrics, timestamp)
+	defer func() {
+		duration := float64(time.Now().UTC().Sub(start))
+		p.metrics.prometheusBackend.SetObservationDuration(labelValues, duration)
+		if err != nil {
+			p.metrics.prometheusBackend.IncrementErrors(labelValues, "Observation")
+		}
+		p.metrics.observationEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of Observation()
+	}()
+
+	return p.wrapped.Observation(ctx, timestamp, previousReport)
+}
+
+func (p *promMercuryPlugin) Report(timestamp types.ReportTimestamp, previousReport types.Report, observations []types.AttributedObservation) (shouldReport bool, report types.Report, err error) {
+	start := time.Now().UTC()
+
+	// Report latency between Observation() and Report().
+	labelValues := getLabelsValues(p.metrics, timestamp)
+	if observationEndTime, ok := p.metrics.observationEndTimes.LoadAndDelete(timestamp); ok {
+		latency := float64(start.Sub(observationEndTime.(time.Time)))
+		p.metrics.prometheusBackend.SetObservationToReportLatency(labelValues, latency)
+	}
+
+	defer func() {
+		duration := float64(time.Now().UTC().Sub(start))
+		p.metrics.prometheusBackend.SetReportDuration(labelValues, duration)
+		if err != nil {
+			p.metrics.prometheusBackend.IncrementErrors(labelValues, "Report")
+		}
+	}()
+
+	return p.wrapped.Report(timestamp, previousReport, observations)
+}
+
+func (p *promMercuryPlugin) Close() (err error) {
+	start := time.Now().UTC()
+	defer func() {
+		duration := float64(time.Now().UTC().Sub(start))
+		labelValues := []string{
+			p.metrics.chainType,    // chainType
+			p.metrics.chainID,      // chainID
+			p.metrics.name,         // plugin
+			p.metrics.oracleID,     // oracleID
+			p.metrics.configDigest, // configDigest
+		}
+		p.metrics.prometheusBackend.SetCloseDuration(labelValues, duration)
+		if err != nil {
+			p.metrics.prometheusBackend.IncrementErrors(labelValues, "Close")
+		}
+	}()
+
+	return p.wrapped.Close()
+}
@@ -0,0 +1,85 @@
+package promwrapper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper/mocks"
+)
+
+// fakeMercuryPlugin has varied intra-phase latencies, and fails to report.
+type fakeMercuryPlugin struct{}
+
+func (fakeMercuryPlugin) Observation(context.Context, types.ReportTimestamp, types.Report) (types.Observation, error) {
+	time.Sleep(oDuration)
+	return nil, nil
+}
+func (fakeMercuryPlugin) Report(types.ReportTimestamp, types.Report, []types.AttributedObservation) (bool, types.Report, error) {
+	time.Sleep(rDuration)
+	return false, nil, errors.New("report")
+}
+func (fakeMercuryPlugin) Close() error {
+	return nil
+}
+
+var _ ocr3types.MercuryPlugin = &fakeMercuryPlugin{}
+
+func TestMercuryPlugin_GetLatencies(t *testing.T) {
+	configDigest := common.BytesToHash(crypto.Keccak256([]byte("foobar")))
+	reportTimestamp := types.ReportTimestamp{
+		ConfigDigest: types.ConfigDigest(configDigest),
+		Epoch:        1,
+		Round:        1,
+	}
+	labelValues := []string{"EVM", "1", "test-mercury", "0", common.Bytes2Hex(configDigest[:])}
+
+	backend := mocks.NewPrometheusBackend(t)
+	backend.On("SetObservationDuration", labelValues, mock.Anything).Run(func(args mock.Arguments) {
+		duration := time.Duration(args[1].(float64))
+		require.Greater(t, duration, oDuration)
+		require.Less(t, duration, rDuration)
+	}).Return()
+	backend.On("SetObservationToReportLatency", labelValues, mock.Anything).Run(func(args mock.Arguments) {
+		latency := time.Duration(args[1].(float64))
+		require.Greater(t, latency, oToRLatency)
+		require.Less(t, latency, rToALatency)
+	}).Return()
+	backend.On("SetReportDuration", labelValues, mock.Anything).Run(func(args mock.Arguments) {
+		duration := time.Duration(args[1].(float64))
+		require.Greater(t, duration, rDuration)
+		require.Less(t, duration, aDuration)
+	}).Return()
+	backend.On("IncrementErrors", labelValues, "Report").Once().Return()
+	backend.On("SetCloseDuration", labelValues, mock.Anything).Return()
+
+	plugin := NewMercury(
+		&fakeMercuryPlugin{},
+		"test-mercury",
+		"EVM",
+		"1",
+		ocr3types.MercuryPluginConfig{ConfigDigest: reportTimestamp.ConfigDigest},
+		backend,
+	)
+
+	_, err := plugin.Observation(testutils.Context(t), reportTimestamp, nil)
+	require.NoError(t, err)
+	time.Sleep(oToRLatency)
+
+	_, _, err = plugin.Report(reportTimestamp, nil, nil)
+	require.EqualError(t, err, "report")
+	_, ok := plugin.(*promMercuryPlugin).metrics.observationEndTimes.Load(reportTimestamp)
+	require.False(t, ok)
+
+	require.NoError(t, plugin.Close())
+}
@@ -9,6 +9,11 @@ type PrometheusBackend struct {
 	mock.Mock
 }
 
+// IncrementErrors provides a mock function with given fields: _a0, _a1
+func (_m *PrometheusBackend) IncrementErrors(_a0 []string, _a1 string) {
+	_m.Called(_a0, _a1)
+}
+
 // SetAcceptFinalizedReportToTransmitAcceptedReportLatency provides a mock function with given fields: _a0, _a1
 func (_m *PrometheusBackend) SetAcceptFinalizedReportToTransmitAcceptedReportLatency(_a0 []string, _a1 float64) {
 	_m.Called(_a0, _a1)
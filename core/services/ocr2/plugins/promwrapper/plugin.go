@@ -1,12 +1,12 @@
 // promwrapper wraps another OCR2 reporting plugin and provides standardized prometheus metrics
 // for each of the OCR2 phases (Query, Observation, Report, ShouldAcceptFinalizedReport,
-// ShouldTransmitAcceptedReport, and Close).
+// ShouldTransmitAcceptedReport, and Close), and for the errors they return. Mercury plugins,
+// which only have the Observation, Report and Close phases, are wrapped with the same metrics.
 package promwrapper
 
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"sync"
 	"time"
 
@@ -40,7 +40,7 @@ var (
 	getLabelsValues = func(p *promPlugin, t types.ReportTimestamp) []string {
 		return []string{
 			p.chainType,                         // chainType
-			p.chainID.String(),                  // chainID
+			p.chainID,                           // chainID
 			p.name,                              // plugin
 			p.oracleID,                          // oracleID
 			common.Bytes2Hex(t.ConfigDigest[:]), // configDigest
@@ -130,6 +130,13 @@ var (
 		},
 		labels,
 	)
+	promErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocr2_reporting_plugin_errors",
+			Help: "The number of errors returned by the OCR2 plugin's methods",
+		},
+		[]string{"chainType", "chainID", "plugin", "oracleID", "configDigest", "function"},
+	)
 )
 
 //go:generate mockery --quiet --name PrometheusBackend --output ./mocks/ --case=underscore
@@ -149,6 +156,9 @@ type (
 		SetObservationToReportLatency([]string, float64)
 		SetReportToAcceptFinalizedReportLatency([]string, float64)
 		SetAcceptFinalizedReportToTransmitAcceptedReportLatency([]string, float64)
+
+		// Errors, by the name of the method returning them.
+		IncrementErrors([]string, string)
 	}
 
 	defaultPrometheusBackend struct{} // implements PrometheusBackend
@@ -158,7 +168,7 @@ type (
 		wrapped                       types.ReportingPlugin
 		name                          string
 		chainType                     string
-		chainID                       *big.Int
+		chainID                       string
 		oracleID                      string
 		configDigest                  string
 		queryEndTimes                 sync.Map
@@ -209,11 +219,15 @@ func (*defaultPrometheusBackend) SetAcceptFinalizedReportToTransmitAcceptedRepor
 	promAcceptFinalizedReportToTransmitAcceptedReportLatency.WithLabelValues(labelValues...).Observe(latency)
 }
 
+func (*defaultPrometheusBackend) IncrementErrors(labelValues []string, function string) {
+	promErrors.WithLabelValues(append(labelValues, function)...).Inc()
+}
+
 func New(
 	plugin types.ReportingPlugin,
 	name string,
 	chainType string,
-	chainID *big.Int,
+	chainID string,
 	config types.ReportingPluginConfig,
 	backend PrometheusBackend,
 ) types.ReportingPlugin {
@@ -234,18 +248,22 @@ func New(
 	}
 }
 
-func (p *promPlugin) Query(ctx context.Context, timestamp types.ReportTimestamp) (types.Query, error) {
+func (p *promPlugin) Query(ctx context.Context, timestamp types.ReportTimestamp) (q types.Query, err error) {
 	start := time.Now().UTC()
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
-		p.prometheusBackend.SetQueryDuration(getLabelsValues(p, timestamp), duration)
+		labelValues := getLabelsValues(p, timestamp)
+		p.prometheusBackend.SetQueryDuration(labelValues, duration)
+		if err != nil {
+			p.prometheusBackend.IncrementErrors(labelValues, "Query")
+		}
 		p.queryEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of Query()
 	}()
 
 	return p.wrapped.Query(ctx, timestamp)
 }
 
-func (p *promPlugin) Observation(ctx context.Context, timestamp types.ReportTimestamp, query types.Query) (types.Observation, error) {
+func (p *promPlugin) Observation(ctx context.Context, timestamp types.ReportTimestamp, query types.Query) (o types.Observation, err error) {
 	start := time.Now().UTC()
 
 	// Report latency between Query() and Observation().
@@ -260,13 +278,16 @@ func (p *promPlugin) Observation(ctx context.Context, timestamp types.ReportTime
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetObservationDuration(labelValues, duration)
+		if err != nil {
+			p.prometheusBackend.IncrementErrors(labelValues, "Observation")
+		}
 		p.observationEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of Observe()
 	}()
 
 	return p.wrapped.Observation(ctx, timestamp, query)
 }
 
-func (p *promPlugin) Report(ctx context.Context, timestamp types.ReportTimestamp, query types.Query, observations []types.AttributedObservation) (bool, types.Report, error) {
+func (p *promPlugin) Report(ctx context.Context, timestamp types.ReportTimestamp, query types.Query, observations []types.AttributedObservation) (shouldReport bool, report types.Report, err error) {
 	start := time.Now().UTC()
 
 	// Report latency between Observation() and Report().
@@ -281,13 +302,16 @@ func (p *promPlugin) Report(ctx context.Context, timestamp types.ReportTimestamp
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetReportDuration(labelValues, duration)
+		if err != nil {
+			p.prometheusBackend.IncrementErrors(labelValues, "Report")
+		}
 		p.reportEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of Report()
 	}()
 
 	return p.wrapped.Report(ctx, timestamp, query, observations)
 }
 
-func (p *promPlugin) ShouldAcceptFinalizedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (bool, error) {
+func (p *promPlugin) ShouldAcceptFinalizedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (accept bool, err error) {
 	start := time.Now().UTC()
 
 	// Report latency between Report() and ShouldAcceptFinalizedReport().
@@ -302,13 +326,16 @@ func (p *promPlugin) ShouldAcceptFinalizedReport(ctx context.Context, timestamp
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetShouldAcceptFinalizedReportDuration(labelValues, duration)
+		if err != nil {
+			p.prometheusBackend.IncrementErrors(labelValues, "ShouldAcceptFinalizedReport")
+		}
 		p.acceptFinalizedReportEndTimes.Store(timestamp, time.Now().UTC()) // note time at end of ShouldAcceptFinalizedReport()
 	}()
 
 	return p.wrapped.ShouldAcceptFinalizedReport(ctx, timestamp, report)
 }
 
-func (p *promPlugin) ShouldTransmitAcceptedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (bool, error) {
+func (p *promPlugin) ShouldTransmitAcceptedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (transmit bool, err error) {
 	start := time.Now().UTC()
 
 	// Report latency between ShouldAcceptFinalizedReport() and ShouldTransmitAcceptedReport().
@@ -322,24 +349,30 @@ func (p *promPlugin) ShouldTransmitAcceptedReport(ctx context.Context, timestamp
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		p.prometheusBackend.SetShouldTransmitAcceptedReportDuration(labelValues, duration)
+		if err != nil {
+			p.prometheusBackend.IncrementErrors(labelValues, "ShouldTransmitAcceptedReport")
+		}
 	}()
 
 	return p.wrapped.ShouldTransmitAcceptedReport(ctx, timestamp, report)
 }
 
 // Note: the 'Close' method does not have access to a report timestamp, as it is not part of report generation.
-func (p *promPlugin) Close() error {
+func (p *promPlugin) Close() (err error) {
 	start := time.Now().UTC()
 	defer func() {
 		duration := float64(time.Now().UTC().Sub(start))
 		labelValues := []string{
-			p.chainType,        // chainType
-			p.chainID.String(), // chainID
-			p.name,             // plugin
-			p.oracleID,         // oracleID
-			p.configDigest,     // configDigest
+			p.chainType,    // chainType
+			p.chainID,      // chainID
+			p.name,         // plugin
+			p.oracleID,     // oracleID
+			p.configDigest, // configDigest
 		}
 		p.prometheusBackend.SetCloseDuration(labelValues, duration)
+		if err != nil {
+			p.prometheusBackend.IncrementErrors(labelValues, "Close")
+		}
 	}()
 
 	return p.wrapped.Close()
@@ -2,7 +2,7 @@ package promwrapper
 
 import (
 	"context"
-	"math/big"
+	"errors"
 	"testing"
 	"time"
 
@@ -68,12 +68,12 @@ var _ types.ReportingPlugin = &fakeReportingPlugin{}
 func TestPlugin_MustInstantiate(t *testing.T) {
 	// Ensure instantiation without panic for no override backend.
 	var reportingPlugin = &fakeReportingPlugin{}
-	promPlugin := New(reportingPlugin, "test", "EVM", big.NewInt(1), types.ReportingPluginConfig{}, nil)
+	promPlugin := New(reportingPlugin, "test", "EVM", "1", types.ReportingPluginConfig{}, nil)
 	require.NotEqual(t, nil, promPlugin)
 
 	// Ensure instantiation without panic for override provided.
 	backend := mocks.NewPrometheusBackend(t)
-	promPlugin = New(reportingPlugin, "test-2", "EVM", big.NewInt(1), types.ReportingPluginConfig{}, backend)
+	promPlugin = New(reportingPlugin, "test-2", "EVM", "1", types.ReportingPluginConfig{}, backend)
 	require.NotEqual(t, nil, promPlugin)
 }
 
@@ -190,7 +190,7 @@ func TestPlugin_GetLatencies(t *testing.T) {
 		reportingPlugin,
 		"test-plugin",
 		"EVM",
-		big.NewInt(1),
+		"1",
 		types.ReportingPluginConfig{ConfigDigest: reportTimestamp.ConfigDigest},
 		backend,
 	).(*promPlugin)
@@ -238,3 +238,58 @@ func TestPlugin_GetLatencies(t *testing.T) {
 	err = promPlugin.Close()
 	require.NoError(t, err)
 }
+
+// failingReportingPlugin returns an error from each of its methods.
+type failingReportingPlugin struct{}
+
+func (failingReportingPlugin) Query(context.Context, types.ReportTimestamp) (types.Query, error) {
+	return nil, errors.New("query")
+}
+func (failingReportingPlugin) Observation(context.Context, types.ReportTimestamp, types.Query) (types.Observation, error) {
+	return nil, errors.New("observation")
+}
+func (failingReportingPlugin) Report(context.Context, types.ReportTimestamp, types.Query, []types.AttributedObservation) (bool, types.Report, error) {
+	return false, nil, errors.New("report")
+}
+func (failingReportingPlugin) ShouldAcceptFinalizedReport(context.Context, types.ReportTimestamp, types.Report) (bool, error) {
+	return false, errors.New("accept")
+}
+func (failingReportingPlugin) ShouldTransmitAcceptedReport(context.Context, types.ReportTimestamp, types.Report) (bool, error) {
+	return false, errors.New("transmit")
+}
+func (failingReportingPlugin) Close() error {
+	return errors.New("close")
+}
+
+func TestPlugin_CountErrors(t *testing.T) {
+	backend := mocks.NewPrometheusBackend(t)
+	backend.On("SetQueryDuration", mock.Anything, mock.Anything).Return()
+	backend.On("SetObservationDuration", mock.Anything, mock.Anything).Return()
+	backend.On("SetReportDuration", mock.Anything, mock.Anything).Return()
+	backend.On("SetShouldAcceptFinalizedReportDuration", mock.Anything, mock.Anything).Return()
+	backend.On("SetShouldTransmitAcceptedReportDuration", mock.Anything, mock.Anything).Return()
+	backend.On("SetCloseDuration", mock.Anything, mock.Anything).Return()
+	backend.On("SetQueryToObservationLatency", mock.Anything, mock.Anything).Return()
+	backend.On("SetObservationToReportLatency", mock.Anything, mock.Anything).Return()
+	backend.On("SetReportToAcceptFinalizedReportLatency", mock.Anything, mock.Anything).Return()
+	backend.On("SetAcceptFinalizedReportToTransmitAcceptedReportLatency", mock.Anything, mock.Anything).Return()
+	for _, function := range []string{"Query", "Observation", "Report", "ShouldAcceptFinalizedReport", "ShouldTransmitAcceptedReport", "Close"} {
+		backend.On("IncrementErrors", mock.Anything, function).Once().Return()
+	}
+
+	promPlugin := New(&failingReportingPlugin{}, "test-plugin", "EVM", "1", types.ReportingPluginConfig{}, backend)
+	ctx := testutils.Context(t)
+	reportTimestamp := types.ReportTimestamp{Epoch: 1, Round: 1}
+
+	_, err := promPlugin.Query(ctx, reportTimestamp)
+	require.EqualError(t, err, "query")
+	_, err = promPlugin.Observation(ctx, reportTimestamp, nil)
+	require.EqualError(t, err, "observation")
+	_, _, err = promPlugin.Report(ctx, reportTimestamp, nil, nil)
+	require.EqualError(t, err, "report")
+	_, err = promPlugin.ShouldAcceptFinalizedReport(ctx, reportTimestamp, nil)
+	require.EqualError(t, err, "accept")
+	_, err = promPlugin.ShouldTransmitAcceptedReport(ctx, reportTimestamp, nil)
+	require.EqualError(t, err, "transmit")
+	require.EqualError(t, promPlugin.Close(), "close")
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/libocr/commontypes"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	decryptionPlugin "github.com/smartcontractkit/tdh2/go/ocr2/decryptionplugin"
 	decryptionPluginConfig "github.com/smartcontractkit/tdh2/go/ocr2/decryptionplugin/config"
@@ -19,6 +20,8 @@ type ThresholdServicesConfig struct {
 	DecryptionQueue    decryptionPlugin.DecryptionQueuingService
 	KeyshareWithPubKey []byte
 	ConfigParser       decryptionPluginConfig.ConfigParser
+	// ReportingPluginFactoryDecorator, if set, wraps the reporting plugin factory, e.g. with metrics.
+	ReportingPluginFactoryDecorator func(types.ReportingPluginFactory) types.ReportingPluginFactory
 }
 
 func NewThresholdService(sharedOracleArgs *libocr2.OCR2OracleArgs, conf *ThresholdServicesConfig) (job.ServiceCtx, error) {
@@ -45,6 +48,10 @@ func NewThresholdService(sharedOracleArgs *libocr2.OCR2OracleArgs, conf *Thresho
 		Logger:           sharedOracleArgs.Logger,
 	}
 
+	if conf.ReportingPluginFactoryDecorator != nil {
+		sharedOracleArgs.ReportingPluginFactory = conf.ReportingPluginFactoryDecorator(sharedOracleArgs.ReportingPluginFactory)
+	}
+
 	thresholdReportingPluginOracle, err := libocr2.NewOracle(*sharedOracleArgs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to call NewOracle to create a Threshold Reporting Plugin")
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
 	"github.com/pelletier/go-toml"
 	pkgerrors "github.com/pkg/errors"
@@ -57,6 +58,9 @@ func ValidatedOracleSpecToml(config OCR2Config, insConf InsecureConfig, tomlStri
 			return jb, err
 		}
 	}
+	if err = validateBootstrappersRegistry(spec); err != nil {
+		return jb, err
+	}
 
 	if err = validateSpec(tree, jb); err != nil {
 		return jb, err
@@ -84,6 +88,25 @@ var (
 	}
 )
 
+func validateBootstrappersRegistry(spec job.OCR2OracleSpec) error {
+	if !spec.P2PV2BootstrappersRegistry.Valid {
+		if spec.P2PV2BootstrappersRefreshInterval != nil {
+			return errors.New("p2pv2BootstrappersRefreshInterval requires p2pv2BootstrappersRegistry")
+		}
+		return nil
+	}
+	if spec.Relay != relay.EVM {
+		return pkgerrors.Errorf("p2pv2BootstrappersRegistry is not supported by relay %s", spec.Relay)
+	}
+	if !common.IsHexAddress(spec.P2PV2BootstrappersRegistry.String) {
+		return pkgerrors.Errorf("invalid p2pv2BootstrappersRegistry address: %s", spec.P2PV2BootstrappersRegistry.String)
+	}
+	if spec.P2PV2BootstrappersRefreshInterval != nil && spec.P2PV2BootstrappersRefreshInterval.Duration() <= 0 {
+		return errors.New("p2pv2BootstrappersRefreshInterval must be positive")
+	}
+	return nil
+}
+
 func validateTimingParameters(ocr2Conf OCR2Config, insConf InsecureConfig, spec job.OCR2OracleSpec) error {
 	lc, err := ToLocalConfig(ocr2Conf, insConf, spec)
 	if err != nil {
@@ -98,6 +121,10 @@ func validateSpec(tree *toml.Tree, spec job.Job) error {
 		return err
 	}
 
+	if spec.ShadowPipeline.Source != "" && spec.OCR2OracleSpec.PluginType != types.Median {
+		return pkgerrors.Errorf("shadowObservationSource is not supported by pluginType %s", spec.OCR2OracleSpec.PluginType)
+	}
+
 	switch spec.OCR2OracleSpec.PluginType {
 	case types.Median:
 		if spec.Pipeline.Source == "" {
@@ -200,6 +200,80 @@ chainID = 1337
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "bootstrappers registry",
+			toml: `
+type                              = "offchainreporting2"
+pluginType                        = "median"
+schemaVersion                     = 1
+relay                             = "evm"
+contractID                        = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2BootstrappersRegistry        = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+p2pv2BootstrappersRefreshInterval = "5m"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "0x5FbDB2315678afecb367f032d93F642f64180aa3", os.OCR2OracleSpec.P2PV2BootstrappersRegistry.String)
+				assert.Equal(t, 5*time.Minute, os.OCR2OracleSpec.P2PV2BootstrappersRefreshInterval.Duration())
+			},
+		},
+		{
+			name: "invalid bootstrappers registry",
+			toml: `
+type                       = "offchainreporting2"
+pluginType                 = "median"
+schemaVersion              = 1
+relay                      = "evm"
+contractID                 = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2BootstrappersRegistry = "registry"
+observationSource = """
+ds1 [type=bridge name=voter_turnout];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, "invalid p2pv2BootstrappersRegistry address: registry")
+			},
+		},
+		{
+			name: "bootstrappers refresh interval without registry",
+			toml: `
+type                              = "offchainreporting2"
+pluginType                        = "median"
+schemaVersion                     = 1
+relay                             = "evm"
+contractID                        = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pv2BootstrappersRefreshInterval = "5m"
+observationSource = """
+ds1 [type=bridge name=voter_turnout];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, "p2pv2BootstrappersRefreshInterval requires p2pv2BootstrappersRegistry")
+			},
+		},
 		{
 			name: "non-zero timeouts",
 			toml: `
@@ -395,6 +469,38 @@ KeyID               = "6f3b82406688b8ddb944c6f2e6d808f014c8fa8d568d639c25019568c
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "shadow observation source with non-median plugin",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "dkg"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+maxTaskDuration = "1s"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "dkg"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+shadowObservationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+EncryptionPublicKey = "0e86e8cf899ae9a1b43e023bbe8825b103659bb8d6d4e54f6a3cfae7b106069c"
+SigningPublicKey    = "eb62dbd2beb7c1524275a8019022f6ce6a7e86c9e65e3099452a2b96fc2432b1"
+KeyID               = "6f3b82406688b8ddb944c6f2e6d808f014c8fa8d568d639c25019568c715fbf0"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, "shadowObservationSource is not supported by pluginType dkg")
+			},
+		},
 		{
 			name: "DKG encryption key is not hex",
 			toml: `
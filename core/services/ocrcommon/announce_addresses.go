@@ -0,0 +1,63 @@
+package ocrcommon
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"slices"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// ipResolver resolves host names, like net.Resolver.
+type ipResolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// announceHostNames returns whether any of the announce addresses has a host name rather than an IP address.
+func announceHostNames(addrs []string) bool {
+	for _, a := range addrs {
+		host, _, err := net.SplitHostPort(a)
+		if err != nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(host); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAnnounceAddresses replaces the host names of the announce addresses by the IP addresses they resolve to, since
+// libocr only announces IP addresses. Addresses which are not in host:port form are left for libocr to report. Host
+// names which fail to resolve are left out, and reported in the returned error along with the other addresses.
+func resolveAnnounceAddresses(ctx context.Context, r ipResolver, addrs []string) (resolved []string, err error) {
+	add := func(a string) {
+		if !slices.Contains(resolved, a) {
+			resolved = append(resolved, a)
+		}
+	}
+	for _, a := range addrs {
+		host, port, serr := net.SplitHostPort(a)
+		if serr != nil {
+			add(a)
+			continue
+		}
+		if _, perr := netip.ParseAddr(host); perr == nil {
+			add(a)
+			continue
+		}
+		ips, lerr := r.LookupNetIP(ctx, "ip", host)
+		if lerr != nil {
+			err = multierr.Append(err, errors.Wrapf(lerr, "failed to resolve announce address %s", a))
+			continue
+		}
+		// DNS servers may rotate the order of the records
+		slices.SortFunc(ips, func(a, b netip.Addr) int { return a.Compare(b) })
+		for _, ip := range ips {
+			add(net.JoinHostPort(ip.Unmap().String(), port))
+		}
+	}
+	return resolved, err
+}
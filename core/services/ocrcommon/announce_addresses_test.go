@@ -0,0 +1,47 @@
+package ocrcommon
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type fakeResolver map[string][]netip.Addr
+
+func (r fakeResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	ips, ok := r[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return ips, nil
+}
+
+func TestAnnounceAddresses(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, announceHostNames([]string{"1.2.3.4:9999", "[a52d:0:a88:1274::abcd]:1337", "invalid"}))
+	assert.True(t, announceHostNames([]string{"1.2.3.4:9999", "node.example.com:9999"}))
+
+	r := fakeResolver{
+		"node.example.com": {netip.MustParseAddr("5.6.7.8"), netip.MustParseAddr("1.2.3.4"), netip.MustParseAddr("::ffff:9.9.9.9")},
+		"v6.example.com":   {netip.MustParseAddr("a52d::1")},
+	}
+
+	t.Run("resolved", func(t *testing.T) {
+		resolved, err := resolveAnnounceAddresses(testutils.Context(t), r, []string{"1.2.3.4:9999", "node.example.com:9999", "v6.example.com:1337"})
+		assert.NoError(t, err)
+		// duplicates are removed, and resolved addresses sorted regardless of the order of the records
+		assert.Equal(t, []string{"1.2.3.4:9999", "5.6.7.8:9999", "9.9.9.9:9999", "[a52d::1]:1337"}, resolved)
+	})
+
+	t.Run("unresolved", func(t *testing.T) {
+		resolved, err := resolveAnnounceAddresses(testutils.Context(t), r, []string{"missing.example.com:9999", "v6.example.com:1337"})
+		assert.ErrorContains(t, err, "failed to resolve announce address missing.example.com:9999")
+		assert.Equal(t, []string{"[a52d::1]:1337"}, resolved)
+	})
+}
@@ -10,7 +10,10 @@ import (
 	ocr1types "github.com/smartcontractkit/libocr/offchainreporting/types"
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -30,6 +33,8 @@ type inMemoryDataSource struct {
 	current bridges.BridgeMetaData
 	mu      sync.RWMutex
 
+	shadow *shadowObserver
+
 	chEnhancedTelemetry chan<- EnhancedTelemetryData
 }
 
@@ -68,6 +73,7 @@ func NewDataSourceV1(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr lo
 				spec:                spec,
 				lggr:                lggr,
 				chEnhancedTelemetry: chEnhancedTelemetry,
+				shadow:              newShadowObserver(pr, jb, lggr),
 			},
 			saver: s,
 		},
@@ -83,6 +89,7 @@ func NewDataSourceV2(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr lo
 				spec:                spec,
 				lggr:                lggr,
 				chEnhancedTelemetry: enhancedTelemChan,
+				shadow:              newShadowObserver(pr, jb, lggr),
 			},
 			saver: s,
 		},
@@ -115,15 +122,8 @@ func (ds *inMemoryDataSource) currentAnswer() (*big.Int, *big.Int) {
 	return ds.current.LatestAnswer, ds.current.UpdatedAt
 }
 
-// The context passed in here has a timeout of (ObservationTimeout + ObservationGracePeriod).
-// Upon context cancellation, its expected that we return any usable values within ObservationGracePeriod.
-func (ds *inMemoryDataSource) executeRun(ctx context.Context, timestamp ObservationTimestamp) (*pipeline.Run, pipeline.FinalResult, error) {
-	md, err := bridges.MarshalBridgeMetaData(ds.currentAnswer())
-	if err != nil {
-		ds.lggr.Warnw("unable to attach metadata for run", "err", err)
-	}
-
-	vars := pipeline.NewVarsFrom(map[string]interface{}{
+func (ds *inMemoryDataSource) newVars(md map[string]interface{}) pipeline.Vars {
+	return pipeline.NewVarsFrom(map[string]interface{}{
 		"jb": map[string]interface{}{
 			"databaseID":    ds.jb.ID,
 			"externalJobID": ds.jb.ExternalJobID,
@@ -133,12 +133,38 @@ func (ds *inMemoryDataSource) executeRun(ctx context.Context, timestamp Observat
 			"meta": md,
 		},
 	})
+}
+
+// The context passed in here has a timeout of (ObservationTimeout + ObservationGracePeriod).
+// Upon context cancellation, its expected that we return any usable values within ObservationGracePeriod.
+func (ds *inMemoryDataSource) executeRun(ctx context.Context, timestamp ObservationTimestamp) (run *pipeline.Run, finalResult pipeline.FinalResult, err error) {
+	ctx, span := tracing.Start(logctx.WithJobID(ctx, ds.spec.JobID), "job.trigger", tracing.Trigger.String("ocr"),
+		attribute.String("configDigest", timestamp.ConfigDigest), attribute.Int64("epoch", int64(timestamp.Epoch)),
+		attribute.Int64("round", int64(timestamp.Round)))
+	defer func() { tracing.End(span, err) }()
 
-	run, trrs, err := ds.pipelineRunner.ExecuteRun(ctx, ds.spec, vars, ds.lggr)
+	md, err := bridges.MarshalBridgeMetaData(ds.currentAnswer())
+	if err != nil {
+		ds.lggr.Warnw("unable to attach metadata for run", "err", err)
+	}
+
+	// The shadow observation source runs in parallel and is only compared with the live one, so it never delays nor
+	// affects the observation.
+	compareShadow := func(observation) {}
+	if ds.shadow != nil {
+		compareShadow = ds.shadow.observe(ctx, ds.newVars(md))
+	}
+
+	run, trrs, err := ds.pipelineRunner.ExecuteRun(ctx, ds.spec, ds.newVars(md), ds.lggr)
 	if err != nil {
-		return nil, pipeline.FinalResult{}, errors.Wrapf(err, "error executing run for spec ID %v", ds.spec.ID)
+		err = errors.Wrapf(err, "error executing run for spec ID %v", ds.spec.ID)
+		compareShadow(observation{err: err})
+		return nil, pipeline.FinalResult{}, err
 	}
-	finalResult := trrs.FinalResult(ds.lggr)
+	finalResult = trrs.FinalResult(ds.lggr)
+	var live observation
+	live.value, live.err = toObservation(finalResult, ds.spec.JobID)
+	compareShadow(live)
 	promSetBridgeParseMetrics(ds, &trrs)
 	promSetFinalResultMetrics(ds, &finalResult)
 
@@ -157,9 +183,19 @@ func (ds *inMemoryDataSource) executeRun(ctx context.Context, timestamp Observat
 
 // parse uses the FinalResult into a big.Int and stores it in the bridge metadata
 func (ds *inMemoryDataSource) parse(finalResult pipeline.FinalResult) (*big.Int, error) {
+	result, err := toObservation(finalResult, ds.spec.JobID)
+	if err != nil {
+		return nil, err
+	}
+	ds.updateAnswer(result)
+	return result, nil
+}
+
+// toObservation converts the FinalResult into a big.Int
+func toObservation(finalResult pipeline.FinalResult, jobID int32) (*big.Int, error) {
 	result, err := finalResult.SingularResult()
 	if err != nil {
-		return nil, errors.Wrapf(err, "error getting singular result for job ID %v", ds.spec.JobID)
+		return nil, errors.Wrapf(err, "error getting singular result for job ID %v", jobID)
 	}
 
 	if result.Error != nil {
@@ -170,7 +206,6 @@ func (ds *inMemoryDataSource) parse(finalResult pipeline.FinalResult) (*big.Int,
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot convert observation to decimal")
 	}
-	ds.updateAnswer(asDecimal.BigInt())
 	return asDecimal.BigInt(), nil
 }
 
@@ -1,6 +1,7 @@
 package ocrcommon_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -142,3 +144,70 @@ func Test_NewDataSourceV1(t *testing.T) {
 	assert.Equal(t, mockValue, new(big.Int).Set(val).String()) // returns expected value after pipeline run
 	assert.Equal(t, &pipeline.Run{}, ms.r)                     // expected data properly passed to channel
 }
+
+func Test_NewDataSourceV2_ShadowObservationSource(t *testing.T) {
+	newJob := func(id int32) job.Job {
+		return job.Job{
+			ID:                 id,
+			Name:               null.StringFrom("shadow"),
+			Type:               job.OffchainReporting2,
+			ShadowPipelineSpec: &pipeline.Spec{ID: 2, JobID: id},
+		}
+	}
+	isLive := mock.MatchedBy(func(spec pipeline.Spec) bool { return spec.ID == 1 })
+	isShadow := mock.MatchedBy(func(spec pipeline.Spec) bool { return spec.ID == 2 })
+	result := func(value string) pipeline.TaskRunResults {
+		return pipeline.TaskRunResults{{Result: pipeline.Result{Value: value}, Task: &pipeline.HTTPTask{}}}
+	}
+
+	t.Run("divergence", func(t *testing.T) {
+		runner := pipelinemocks.NewRunner(t)
+		runner.On("ExecuteRun", mock.Anything, isLive, mock.Anything, mock.Anything).
+			Return(&pipeline.Run{}, result(mockValue), nil).Once()
+		runner.On("ExecuteRun", mock.Anything, isShadow, mock.Anything, mock.Anything).
+			Return(&pipeline.Run{}, result("110000000"), nil).Once()
+
+		ds := ocrcommon.NewDataSourceV2(runner, newJob(1), pipeline.Spec{ID: 1, JobID: 1}, logger.TestLogger(t), &mockSaver{}, nil)
+		val, err := ds.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, mockValue, val.String()) // the shadow observation is never reported
+
+		require.Eventually(t, func() bool {
+			return promtestutil.ToFloat64(ocrcommon.PromShadowObservationValues.WithLabelValues("1", "shadow")) == 110000000
+		}, testutils.WaitTimeout(t), testutils.TestInterval)
+		assert.Equal(t, float64(10), promtestutil.ToFloat64(ocrcommon.PromShadowObservationDivergence.WithLabelValues("1", "shadow")))
+	})
+
+	t.Run("shadow error", func(t *testing.T) {
+		runner := pipelinemocks.NewRunner(t)
+		runner.On("ExecuteRun", mock.Anything, isLive, mock.Anything, mock.Anything).
+			Return(&pipeline.Run{}, result(mockValue), nil).Once()
+		runner.On("ExecuteRun", mock.Anything, isShadow, mock.Anything, mock.Anything).
+			Return(nil, nil, errors.New("boom")).Once()
+
+		ds := ocrcommon.NewDataSourceV2(runner, newJob(2), pipeline.Spec{ID: 1, JobID: 2}, logger.TestLogger(t), &mockSaver{}, nil)
+		val, err := ds.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, mockValue, val.String())
+
+		require.Eventually(t, func() bool {
+			return promtestutil.ToFloat64(ocrcommon.PromShadowObservationErrors.WithLabelValues("2", "shadow")) == 1
+		}, testutils.WaitTimeout(t), testutils.TestInterval)
+	})
+
+	t.Run("live error", func(t *testing.T) {
+		runner := pipelinemocks.NewRunner(t)
+		runner.On("ExecuteRun", mock.Anything, isLive, mock.Anything, mock.Anything).
+			Return(nil, nil, errors.New("boom")).Once()
+		runner.On("ExecuteRun", mock.Anything, isShadow, mock.Anything, mock.Anything).
+			Return(&pipeline.Run{}, result(mockValue), nil).Once()
+
+		ds := ocrcommon.NewDataSourceV2(runner, newJob(3), pipeline.Spec{ID: 1, JobID: 3}, logger.TestLogger(t), &mockSaver{}, nil)
+		_, err := ds.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.Error(t, err)
+
+		require.Eventually(t, func() bool {
+			return promtestutil.ToFloat64(ocrcommon.PromShadowObservationValues.WithLabelValues("3", "shadow")) == cast.ToFloat64(mockValue)
+		}, testutils.WaitTimeout(t), testutils.TestInterval)
+	})
+}
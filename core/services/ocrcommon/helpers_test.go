@@ -3,5 +3,5 @@ package ocrcommon
 import ocrnetworking "github.com/smartcontractkit/libocr/networking"
 
 func (p *SingletonPeerWrapper) PeerConfig() (ocrnetworking.PeerConfig, error) {
-	return p.peerConfig()
+	return p.peerConfig(p.p2pCfg.V2().AnnounceAddresses())
 }
@@ -0,0 +1,333 @@
+package ocrcommon
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocr1types "github.com/smartcontractkit/libocr/offchainreporting/types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// P2PPeer is the state of the connection to a remote peer, and the traffic with it of the running OCR instances.
+type P2PPeer struct {
+	PeerID string
+	// Connected is true while a connection to the peer is established.
+	Connected bool
+	// LastConnectedAt is when the last connection to the peer was established, if any.
+	LastConnectedAt time.Time
+	// DialFailures is the number of failed dials of the peer since the node started.
+	DialFailures    int64
+	LastDialError   string
+	LastDialErrorAt time.Time
+	// Instances is the traffic with the peer of the running OCR instances it is an oracle of.
+	Instances []P2PInstanceTraffic
+}
+
+// P2PInstanceTraffic is the traffic of an OCR instance, identified by its config digest, with a remote peer since the
+// instance started. Bytes are the sizes of the OCR messages, without the overhead of the transport.
+type P2PInstanceTraffic struct {
+	ConfigDigest     string
+	Since            time.Time
+	MessagesSent     int64
+	MessagesReceived int64
+	BytesSent        int64
+	BytesReceived    int64
+}
+
+// MessageRates returns the average number of messages sent and received per second, since the instance started.
+func (t P2PInstanceTraffic) MessageRates(now time.Time) (sent, received float64) {
+	elapsed := now.Sub(t.Since).Seconds()
+	if elapsed <= 0 {
+		return 0, 0
+	}
+	return float64(t.MessagesSent) / elapsed, float64(t.MessagesReceived) / elapsed
+}
+
+// Log messages of the libocr peer which change the state of the connection to a remote peer, identified by the
+// remotePeerID field.
+const (
+	logConnectionEstablished = "Connection established"
+	logConnectionExited      = "authenticatedConnectionLoop: exited"
+	logDialError             = "Dial error"
+)
+
+type peerState struct {
+	P2PPeer
+	// connections is the number of established connections. A new connection replaces the previous one, which exits
+	// after the new one is established.
+	connections int
+}
+
+// p2pStats collects the state of the connections to remote peers from the logs of the libocr peer, which does not
+// expose it otherwise, and the traffic of the OCR instances from their endpoints.
+type p2pStats struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	self      string
+	peers     map[string]*peerState
+	instances map[*instanceTraffic]struct{}
+}
+
+func newP2PStats() *p2pStats {
+	return &p2pStats{
+		now:       time.Now,
+		peers:     make(map[string]*peerState),
+		instances: make(map[*instanceTraffic]struct{}),
+	}
+}
+
+func (s *p2pStats) setSelf(peerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.self = peerID
+}
+
+func (s *p2pStats) observe(msg string, fields commontypes.LogFields) {
+	if msg != logConnectionEstablished && msg != logConnectionExited && msg != logDialError {
+		return
+	}
+	remotePeerID, ok := fields["remotePeerID"]
+	if !ok {
+		return
+	}
+	peerID := fmt.Sprint(remotePeerID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.peers[peerID]
+	if !ok {
+		p = &peerState{P2PPeer: P2PPeer{PeerID: peerID}}
+		s.peers[peerID] = p
+	}
+	switch msg {
+	case logConnectionEstablished:
+		p.connections++
+		p.LastConnectedAt = s.now()
+	case logConnectionExited:
+		if p.connections > 0 {
+			p.connections--
+		}
+	case logDialError:
+		p.DialFailures++
+		p.LastDialError = fmt.Sprint(fields["error"])
+		p.LastDialErrorAt = s.now()
+	}
+}
+
+// Peers returns the remote peers which were connected or dialed, or which are oracles of a running OCR instance,
+// ordered by peer ID.
+func (s *p2pStats) Peers() []P2PPeer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	peers := make(map[string]*P2PPeer, len(s.peers))
+	for id, ps := range s.peers {
+		p := ps.P2PPeer
+		p.Connected = ps.connections > 0
+		peers[id] = &p
+	}
+	for it := range s.instances {
+		for id, t := range it.snapshot() {
+			if id == s.self {
+				continue
+			}
+			p, ok := peers[id]
+			if !ok {
+				p = &P2PPeer{PeerID: id}
+				peers[id] = p
+			}
+			p.Instances = append(p.Instances, t)
+		}
+	}
+
+	list := make([]P2PPeer, 0, len(peers))
+	for _, p := range peers {
+		sort.Slice(p.Instances, func(i, j int) bool { return p.Instances[i].ConfigDigest < p.Instances[j].ConfigDigest })
+		list = append(list, *p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].PeerID < list[j].PeerID })
+	return list
+}
+
+// instanceTraffic counts the traffic of an OCR instance with each of its oracles.
+type instanceTraffic struct {
+	mu      sync.Mutex
+	peerIDs []string
+	traffic []P2PInstanceTraffic // by oracle ID
+}
+
+func newInstanceTraffic(configDigest string, peerIDs []string, since time.Time) *instanceTraffic {
+	traffic := make([]P2PInstanceTraffic, len(peerIDs))
+	for i := range traffic {
+		traffic[i] = P2PInstanceTraffic{ConfigDigest: configDigest, Since: since}
+	}
+	return &instanceTraffic{peerIDs: peerIDs, traffic: traffic}
+}
+
+func (it *instanceTraffic) sent(to commontypes.OracleID, n int) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if int(to) < len(it.traffic) {
+		it.traffic[to].MessagesSent++
+		it.traffic[to].BytesSent += int64(n)
+	}
+}
+
+func (it *instanceTraffic) broadcast(n int) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	for i := range it.traffic {
+		it.traffic[i].MessagesSent++
+		it.traffic[i].BytesSent += int64(n)
+	}
+}
+
+func (it *instanceTraffic) received(from commontypes.OracleID, n int) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if int(from) < len(it.traffic) {
+		it.traffic[from].MessagesReceived++
+		it.traffic[from].BytesReceived += int64(n)
+	}
+}
+
+func (it *instanceTraffic) snapshot() map[string]P2PInstanceTraffic {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	m := make(map[string]P2PInstanceTraffic, len(it.peerIDs))
+	for i, id := range it.peerIDs {
+		m[id] = it.traffic[i]
+	}
+	return m
+}
+
+// newEndpoint returns ep, counting its traffic until it is closed.
+func (s *p2pStats) newEndpoint(ep commontypes.BinaryNetworkEndpoint, configDigest string, peerIDs []string) commontypes.BinaryNetworkEndpoint {
+	it := newInstanceTraffic(configDigest, peerIDs, s.now())
+	s.mu.Lock()
+	s.instances[it] = struct{}{}
+	s.mu.Unlock()
+	return &statsEndpoint{
+		BinaryNetworkEndpoint: ep,
+		stats:                 s,
+		traffic:               it,
+		chReceive:             make(chan commontypes.BinaryMessageWithSender),
+		chStop:                make(services.StopChan),
+	}
+}
+
+// statsEndpoint counts the traffic of an endpoint. Received messages are forwarded from the wrapped endpoint.
+type statsEndpoint struct {
+	commontypes.BinaryNetworkEndpoint
+	stats   *p2pStats
+	traffic *instanceTraffic
+
+	chReceive chan commontypes.BinaryMessageWithSender
+	chStop    services.StopChan
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func (e *statsEndpoint) SendTo(payload []byte, to commontypes.OracleID) {
+	e.traffic.sent(to, len(payload))
+	e.BinaryNetworkEndpoint.SendTo(payload, to)
+}
+
+func (e *statsEndpoint) Broadcast(payload []byte) {
+	e.traffic.broadcast(len(payload))
+	e.BinaryNetworkEndpoint.Broadcast(payload)
+}
+
+func (e *statsEndpoint) Receive() <-chan commontypes.BinaryMessageWithSender {
+	return e.chReceive
+}
+
+func (e *statsEndpoint) Start() error {
+	if err := e.BinaryNetworkEndpoint.Start(); err != nil {
+		return err
+	}
+	e.wg.Add(1)
+	go e.forward()
+	return nil
+}
+
+func (e *statsEndpoint) forward() {
+	defer e.wg.Done()
+	chReceive := e.BinaryNetworkEndpoint.Receive()
+	for {
+		select {
+		case msg, ok := <-chReceive:
+			if !ok {
+				return
+			}
+			e.traffic.received(msg.Sender, len(msg.Msg))
+			select {
+			case e.chReceive <- msg:
+			case <-e.chStop:
+				return
+			}
+		case <-e.chStop:
+			return
+		}
+	}
+}
+
+func (e *statsEndpoint) Close() error {
+	e.closeOnce.Do(func() {
+		close(e.chStop)
+		e.stats.mu.Lock()
+		delete(e.stats.instances, e.traffic)
+		e.stats.mu.Unlock()
+	})
+	err := e.BinaryNetworkEndpoint.Close()
+	e.wg.Wait()
+	return err
+}
+
+// statsLogger observes the logs of the libocr peer.
+type statsLogger struct {
+	commontypes.Logger
+	stats *p2pStats
+}
+
+func (l statsLogger) Info(msg string, fields commontypes.LogFields) {
+	l.stats.observe(msg, fields)
+	l.Logger.Info(msg, fields)
+}
+
+func (l statsLogger) Warn(msg string, fields commontypes.LogFields) {
+	l.stats.observe(msg, fields)
+	l.Logger.Warn(msg, fields)
+}
+
+type ocr1StatsEndpointFactory struct {
+	ocr1types.BinaryNetworkEndpointFactory
+	stats *p2pStats
+}
+
+func (f *ocr1StatsEndpointFactory) NewEndpoint(cd ocr1types.ConfigDigest, peerIDs []string, v2bootstrappers []commontypes.BootstrapperLocator, failureThreshold int, tokenBucketRefillRate float64, tokenBucketSize int) (commontypes.BinaryNetworkEndpoint, error) {
+	ep, err := f.BinaryNetworkEndpointFactory.NewEndpoint(cd, peerIDs, v2bootstrappers, failureThreshold, tokenBucketRefillRate, tokenBucketSize)
+	if err != nil {
+		return nil, err
+	}
+	return f.stats.newEndpoint(ep, cd.Hex(), peerIDs), nil
+}
+
+type ocr2StatsEndpointFactory struct {
+	ocr2types.BinaryNetworkEndpointFactory
+	stats *p2pStats
+}
+
+func (f *ocr2StatsEndpointFactory) NewEndpoint(cd ocr2types.ConfigDigest, peerIDs []string, v2bootstrappers []commontypes.BootstrapperLocator, failureThreshold int, limits ocr2types.BinaryNetworkEndpointLimits) (commontypes.BinaryNetworkEndpoint, error) {
+	ep, err := f.BinaryNetworkEndpointFactory.NewEndpoint(cd, peerIDs, v2bootstrappers, failureThreshold, limits)
+	if err != nil {
+		return nil, err
+	}
+	return f.stats.newEndpoint(ep, cd.Hex(), peerIDs), nil
+}
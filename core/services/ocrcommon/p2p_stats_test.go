@@ -0,0 +1,88 @@
+package ocrcommon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+)
+
+type fakeEndpoint struct {
+	sent      map[commontypes.OracleID]int
+	broadcast int
+	chReceive chan commontypes.BinaryMessageWithSender
+}
+
+func (e *fakeEndpoint) SendTo(payload []byte, to commontypes.OracleID) { e.sent[to]++ }
+func (e *fakeEndpoint) Broadcast(payload []byte)                       { e.broadcast++ }
+func (e *fakeEndpoint) Receive() <-chan commontypes.BinaryMessageWithSender {
+	return e.chReceive
+}
+func (e *fakeEndpoint) Start() error { return nil }
+func (e *fakeEndpoint) Close() error { return nil }
+
+func TestP2PStats(t *testing.T) {
+	const (
+		self  = "12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq"
+		peer1 = "12D3KooWL3XJ9EMCyZvmmGXL2LMiVBtrVa2BuESsJiXkSj7333Jw"
+		peer2 = "12D3KooWLtxTiCXe4EjHjvamVjzz2UQRQFNDwULGJ5LvH7A9GRtN"
+	)
+	now := time.Now()
+	s := newP2PStats()
+	s.now = func() time.Time { return now }
+	s.setSelf(self)
+
+	t.Run("connections", func(t *testing.T) {
+		s.observe(logConnectionEstablished, commontypes.LogFields{"remotePeerID": peer1})
+		// a new connection replaces the previous one, which exits after it
+		s.observe(logConnectionEstablished, commontypes.LogFields{"remotePeerID": peer1})
+		s.observe(logConnectionExited, commontypes.LogFields{"remotePeerID": peer1})
+		s.observe(logDialError, commontypes.LogFields{"remotePeerID": peer2, "error": errors.New("connection refused")})
+		s.observe(logDialError, commontypes.LogFields{"remotePeerID": peer2, "error": errors.New("i/o timeout")})
+		s.observe("Dialing", commontypes.LogFields{"remotePeerID": peer2})
+		s.observe(logConnectionEstablished, nil)
+
+		assert.Equal(t, []P2PPeer{
+			{PeerID: peer1, Connected: true, LastConnectedAt: now},
+			{PeerID: peer2, DialFailures: 2, LastDialError: "i/o timeout", LastDialErrorAt: now},
+		}, s.Peers())
+
+		s.observe(logConnectionExited, commontypes.LogFields{"remotePeerID": peer1})
+		assert.False(t, s.Peers()[0].Connected)
+	})
+
+	t.Run("traffic", func(t *testing.T) {
+		inner := &fakeEndpoint{sent: make(map[commontypes.OracleID]int), chReceive: make(chan commontypes.BinaryMessageWithSender)}
+		ep := s.newEndpoint(inner, "digest", []string{self, peer1, peer2})
+		require.NoError(t, ep.Start())
+
+		ep.SendTo([]byte("abc"), 1)
+		ep.Broadcast([]byte("abcde"))
+		inner.chReceive <- commontypes.BinaryMessageWithSender{Msg: []byte("ab"), Sender: 2}
+		<-ep.Receive()
+		assert.Equal(t, 1, inner.sent[1])
+		assert.Equal(t, 1, inner.broadcast)
+
+		peers := s.Peers()
+		require.Len(t, peers, 2)
+		assert.Equal(t, []P2PInstanceTraffic{
+			{ConfigDigest: "digest", Since: now, MessagesSent: 2, BytesSent: 8},
+		}, peers[0].Instances)
+		assert.Equal(t, []P2PInstanceTraffic{
+			{ConfigDigest: "digest", Since: now, MessagesSent: 1, BytesSent: 5, MessagesReceived: 1, BytesReceived: 2},
+		}, peers[1].Instances)
+
+		sent, received := peers[1].Instances[0].MessageRates(now.Add(2 * time.Second))
+		assert.Equal(t, 0.5, sent)
+		assert.Equal(t, 0.5, received)
+
+		require.NoError(t, ep.Close())
+		for _, p := range s.Peers() {
+			assert.Empty(t, p.Instances)
+		}
+	})
+}
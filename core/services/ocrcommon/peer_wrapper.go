@@ -3,10 +3,15 @@ package ocrcommon
 import (
 	"context"
 	"io"
+	"net"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/libocr/commontypes"
 	ocrnetworking "github.com/smartcontractkit/libocr/networking"
 	ocr1types "github.com/smartcontractkit/libocr/offchainreporting/types"
 	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -26,14 +31,25 @@ type PeerWrapperOCRConfig interface {
 }
 
 type (
+	// peerFactories are the factories of a libocr peer.
+	peerFactories struct {
+		ocr1Endpoints     ocr1types.BinaryNetworkEndpointFactory
+		ocr1Bootstrappers ocr1types.BootstrapperFactory
+		ocr2Endpoints     ocr2types.BinaryNetworkEndpointFactory
+		ocr2Bootstrappers ocr2types.BootstrapperFactory
+
+		// Used at shutdown to stop all of this peer's goroutines
+		closer io.Closer
+	}
+
+	// peerAdapterOCR1 creates endpoints and bootstrappers from the running peer, which changes when the peer restarts.
 	peerAdapterOCR1 struct {
-		ocr1types.BinaryNetworkEndpointFactory
-		ocr1types.BootstrapperFactory
+		p *SingletonPeerWrapper
 	}
 
+	// peerAdapterOCR2 creates endpoints and bootstrappers from the running peer, which changes when the peer restarts.
 	peerAdapterOCR2 struct {
-		ocr2types.BinaryNetworkEndpointFactory
-		ocr2types.BootstrapperFactory
+		p *SingletonPeerWrapper
 	}
 
 	// SingletonPeerWrapper manages all libocr peers for the application
@@ -46,9 +62,19 @@ type (
 		db       *sqlx.DB
 		lggr     logger.Logger
 		PeerID   p2pkey.PeerID
+		stats    *p2pStats
+		resolver ipResolver
 
-		// Used at shutdown to stop all of this peer's goroutines
-		peerCloser io.Closer
+		// onRestart is called after the peer restarted to announce new addresses, so that the jobs using it restart.
+		onRestart func(ctx context.Context)
+
+		peerMu sync.RWMutex
+		peer   *peerFactories
+		// announceAddresses are the resolved announce addresses of the peer.
+		announceAddresses []string
+
+		chStop services.StopChan
+		wg     sync.WaitGroup
 
 		// OCR1 peer adapter
 		Peer1 *peerAdapterOCR1
@@ -58,6 +84,46 @@ type (
 	}
 )
 
+var errPeerNotRunning = errors.New("P2P peer is not running")
+
+// PeerID is the same across restarts of the peer.
+func (a *peerAdapterOCR1) PeerID() string { return a.p.PeerID.Raw() }
+
+// PeerID is the same across restarts of the peer.
+func (a *peerAdapterOCR2) PeerID() string { return a.p.PeerID.Raw() }
+
+func (a *peerAdapterOCR1) NewEndpoint(cd ocr1types.ConfigDigest, peerIDs []string, v2bootstrappers []commontypes.BootstrapperLocator, failureThreshold int, tokenBucketRefillRate float64, tokenBucketSize int) (commontypes.BinaryNetworkEndpoint, error) {
+	peer := a.p.currentPeer()
+	if peer == nil {
+		return nil, errPeerNotRunning
+	}
+	return peer.ocr1Endpoints.NewEndpoint(cd, peerIDs, v2bootstrappers, failureThreshold, tokenBucketRefillRate, tokenBucketSize)
+}
+
+func (a *peerAdapterOCR1) NewBootstrapper(cd ocr1types.ConfigDigest, peerIDs []string, v2bootstrappers []commontypes.BootstrapperLocator, failureThreshold int) (commontypes.Bootstrapper, error) {
+	peer := a.p.currentPeer()
+	if peer == nil {
+		return nil, errPeerNotRunning
+	}
+	return peer.ocr1Bootstrappers.NewBootstrapper(cd, peerIDs, v2bootstrappers, failureThreshold)
+}
+
+func (a *peerAdapterOCR2) NewEndpoint(cd ocr2types.ConfigDigest, peerIDs []string, v2bootstrappers []commontypes.BootstrapperLocator, failureThreshold int, limits ocr2types.BinaryNetworkEndpointLimits) (commontypes.BinaryNetworkEndpoint, error) {
+	peer := a.p.currentPeer()
+	if peer == nil {
+		return nil, errPeerNotRunning
+	}
+	return peer.ocr2Endpoints.NewEndpoint(cd, peerIDs, v2bootstrappers, failureThreshold, limits)
+}
+
+func (a *peerAdapterOCR2) NewBootstrapper(cd ocr2types.ConfigDigest, peerIDs []string, v2bootstrappers []commontypes.BootstrapperLocator, f int) (commontypes.Bootstrapper, error) {
+	peer := a.p.currentPeer()
+	if peer == nil {
+		return nil, errPeerNotRunning
+	}
+	return peer.ocr2Bootstrappers.NewBootstrapper(cd, peerIDs, v2bootstrappers, f)
+}
+
 func ValidatePeerWrapperConfig(config config.P2P) error {
 	if len(config.V2().ListenAddresses()) == 0 {
 		return errors.New("no P2P.V2.ListenAddresses specified")
@@ -76,39 +142,147 @@ func NewSingletonPeerWrapper(keyStore keystore.Master, p2pCfg config.P2P, ocrCfg
 		dbConfig: dbConfig,
 		db:       db,
 		lggr:     lggr.Named("SingletonPeerWrapper"),
+		stats:    newP2PStats(),
+		resolver: net.DefaultResolver,
+		chStop:   make(services.StopChan),
 	}
 }
 
+// SetRestartHook sets the function called after the peer restarted to announce new addresses. The endpoints of the
+// running OCR instances are closed with the previous peer, so it is expected to restart the jobs using the peer.
+func (p *SingletonPeerWrapper) SetRestartHook(onRestart func(ctx context.Context)) {
+	p.onRestart = onRestart
+}
+
 func (p *SingletonPeerWrapper) IsStarted() bool { return p.Ready() == nil }
 
 // Start starts SingletonPeerWrapper.
-func (p *SingletonPeerWrapper) Start(context.Context) error {
+func (p *SingletonPeerWrapper) Start(ctx context.Context) error {
 	return p.StartOnce("SingletonPeerWrapper", func() error {
-		peerConfig, err := p.peerConfig()
+		announceAddresses := p.resolveAnnounceAddresses(ctx)
+		peerConfig, err := p.peerConfig(announceAddresses)
 		if err != nil {
 			return err
 		}
+		if err = p.startPeer(peerConfig); err != nil {
+			return err
+		}
+		p.announceAddresses = announceAddresses
+		p.Peer1 = &peerAdapterOCR1{p}
+		p.Peer2 = &peerAdapterOCR2{p}
+
+		interval := p.p2pCfg.V2().AnnounceAddressesRefreshInterval().Duration()
+		if interval > 0 && announceHostNames(p.p2pCfg.V2().AnnounceAddresses()) {
+			p.wg.Add(1)
+			go p.refreshAnnounceAddresses(interval)
+		}
+		return nil
+	})
+}
+
+func (p *SingletonPeerWrapper) startPeer(peerConfig ocrnetworking.PeerConfig) error {
+	p.lggr.Debugw("Creating OCR/OCR2 Peer", "config", peerConfig)
+	// Note: creates and starts the peer
+	peer, err := ocrnetworking.NewPeer(peerConfig)
+	if err != nil {
+		return errors.Wrap(err, "error calling NewPeer")
+	}
+	p.peerMu.Lock()
+	defer p.peerMu.Unlock()
+	p.peer = &peerFactories{
+		ocr1Endpoints:     &ocr1StatsEndpointFactory{peer.OCR1BinaryNetworkEndpointFactory(), p.stats},
+		ocr1Bootstrappers: peer.OCR1BootstrapperFactory(),
+		ocr2Endpoints:     &ocr2StatsEndpointFactory{peer.OCR2BinaryNetworkEndpointFactory(), p.stats},
+		ocr2Bootstrappers: peer.OCR2BootstrapperFactory(),
+		closer:            peer,
+	}
+	return nil
+}
+
+func (p *SingletonPeerWrapper) closePeer() error {
+	p.peerMu.Lock()
+	defer p.peerMu.Unlock()
+	if p.peer == nil {
+		return nil
+	}
+	err := p.peer.closer.Close()
+	p.peer = nil
+	return err
+}
+
+func (p *SingletonPeerWrapper) currentPeer() *peerFactories {
+	p.peerMu.RLock()
+	defer p.peerMu.RUnlock()
+	return p.peer
+}
+
+// resolveAnnounceAddresses returns the announce addresses with their host names resolved. Failures are logged, and
+// the addresses which resolved are announced.
+func (p *SingletonPeerWrapper) resolveAnnounceAddresses(ctx context.Context) []string {
+	addrs := p.p2pCfg.V2().AnnounceAddresses()
+	if !announceHostNames(addrs) {
+		return addrs
+	}
+	resolved, err := resolveAnnounceAddresses(ctx, p.resolver, addrs)
+	if err != nil {
+		p.lggr.Errorw("Failed to resolve announce addresses", "err", err, "announceAddresses", addrs, "resolved", resolved)
+	}
+	return resolved
+}
+
+// refreshAnnounceAddresses resolves the announce addresses every interval, and restarts the peer when they change,
+// since libocr announces the addresses the peer started with.
+func (p *SingletonPeerWrapper) refreshAnnounceAddresses(interval time.Duration) {
+	defer p.wg.Done()
+	ctx, cancel := p.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
 
-		p.lggr.Debugw("Creating OCR/OCR2 Peer", "config", peerConfig)
-		// Note: creates and starts the peer
-		peer, err := ocrnetworking.NewPeer(peerConfig)
+		resolveCtx, resolveCancel := context.WithTimeout(ctx, interval)
+		resolved, err := resolveAnnounceAddresses(resolveCtx, p.resolver, p.p2pCfg.V2().AnnounceAddresses())
+		resolveCancel()
 		if err != nil {
-			return errors.Wrap(err, "error calling NewPeer")
+			// keep announcing the previous addresses rather than dropping those which failed to resolve
+			p.lggr.Warnw("Failed to resolve announce addresses", "err", err)
+			continue
 		}
-		p.Peer1 = &peerAdapterOCR1{
-			peer.OCR1BinaryNetworkEndpointFactory(),
-			peer.OCR1BootstrapperFactory(),
+		if slices.Equal(resolved, p.announceAddresses) {
+			continue
 		}
-		p.Peer2 = &peerAdapterOCR2{
-			peer.OCR2BinaryNetworkEndpointFactory(),
-			peer.OCR2BootstrapperFactory(),
+
+		p.lggr.Infow("Announce addresses changed, restarting peer", "old", p.announceAddresses, "new", resolved)
+		if err = p.restartPeer(resolved); err != nil {
+			p.lggr.Errorw("Failed to restart peer", "err", err)
+			continue
 		}
-		p.peerCloser = peer
-		return nil
-	})
+		p.announceAddresses = resolved
+		if p.onRestart != nil {
+			p.onRestart(ctx)
+		}
+	}
+}
+
+func (p *SingletonPeerWrapper) restartPeer(announceAddresses []string) error {
+	peerConfig, err := p.peerConfig(announceAddresses)
+	if err != nil {
+		return err
+	}
+	// the new peer listens on the same addresses
+	if err = p.closePeer(); err != nil {
+		p.lggr.Warnw("Failed to close peer", "err", err)
+	}
+	return p.startPeer(peerConfig)
 }
 
-func (p *SingletonPeerWrapper) peerConfig() (ocrnetworking.PeerConfig, error) {
+func (p *SingletonPeerWrapper) peerConfig(announceAddresses []string) (ocrnetworking.PeerConfig, error) {
 	// Peer wrapper panics if no p2p keys are present.
 	if ks, err := p.keyStore.P2P().GetAll(); err == nil && len(ks) == 0 {
 		return ocrnetworking.PeerConfig{}, errors.Errorf("No P2P keys found in keystore. Peer wrapper will not be fully initialized")
@@ -118,17 +292,18 @@ func (p *SingletonPeerWrapper) peerConfig() (ocrnetworking.PeerConfig, error) {
 		return ocrnetworking.PeerConfig{}, err
 	}
 	p.PeerID = key.PeerID()
+	p.stats.setSelf(p.PeerID.Raw())
 
 	discovererDB := NewDiscovererDatabase(p.db.DB, p.PeerID.Raw())
 
 	config := p.p2pCfg
 	peerConfig := ocrnetworking.PeerConfig{
 		PrivKey: key.PrivKey,
-		Logger:  commonlogger.NewOCRWrapper(p.lggr, p.ocrCfg.TraceLogging(), func(string) {}),
+		Logger:  statsLogger{commonlogger.NewOCRWrapper(p.lggr, p.ocrCfg.TraceLogging(), func(string) {}), p.stats},
 
 		// V2 config
 		V2ListenAddresses:    config.V2().ListenAddresses(),
-		V2AnnounceAddresses:  config.V2().AnnounceAddresses(), // NewPeer will handle the fallback to listen addresses for us.
+		V2AnnounceAddresses:  announceAddresses, // NewPeer will handle the fallback to listen addresses for us.
 		V2DeltaReconcile:     config.V2().DeltaReconcile().Duration(),
 		V2DeltaDial:          config.V2().DeltaDial().Duration(),
 		V2DiscovererDatabase: discovererDB,
@@ -144,11 +319,10 @@ func (p *SingletonPeerWrapper) peerConfig() (ocrnetworking.PeerConfig, error) {
 
 // Close closes the peer and peerstore
 func (p *SingletonPeerWrapper) Close() error {
-	return p.StopOnce("SingletonPeerWrapper", func() (err error) {
-		if p.peerCloser != nil {
-			err = p.peerCloser.Close()
-		}
-		return err
+	return p.StopOnce("SingletonPeerWrapper", func() error {
+		close(p.chStop)
+		p.wg.Wait()
+		return p.closePeer()
 	})
 }
 
@@ -160,6 +334,12 @@ func (p *SingletonPeerWrapper) HealthReport() map[string]error {
 	return map[string]error{p.Name(): p.Healthy()}
 }
 
+// Peers returns the state of the connections to remote peers, and the traffic with them of the running OCR
+// instances.
+func (p *SingletonPeerWrapper) Peers() []P2PPeer {
+	return p.stats.Peers()
+}
+
 func (p *SingletonPeerWrapper) P2PConfig() config.P2P {
 	return p.p2pCfg
 }
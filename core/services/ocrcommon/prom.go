@@ -23,6 +23,24 @@ var (
 		Help: "Median value returned by ocr job",
 	},
 		[]string{"job_id", "job_name"})
+
+	PromShadowObservationValues = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ocr_shadow_observation_values",
+		Help: "Value returned by the shadow observation source of ocr job",
+	},
+		[]string{"job_id", "job_name"})
+
+	PromShadowObservationDivergence = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ocr_shadow_observation_divergence_percent",
+		Help: "Absolute difference between the shadow and live observations of ocr job, in percent of the live observation",
+	},
+		[]string{"job_id", "job_name"})
+
+	PromShadowObservationErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_shadow_observation_errors_total",
+		Help: "Shadow observations of ocr job which failed",
+	},
+		[]string{"job_id", "job_name"})
 )
 
 // promSetBridgeParseMetrics will parse pipeline.TaskRunResults for bridge tasks, get the pipeline.TaskTypeJSONParse task and update prometheus metrics with it
@@ -0,0 +1,112 @@
+package ocrcommon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// observation is the result of an observation run.
+type observation struct {
+	value *big.Int
+	err   error
+}
+
+// shadowObserver runs the shadow observation source of a job alongside its observation source. Shadow observations
+// are recorded and compared with the live ones, but never reported.
+type shadowObserver struct {
+	pipelineRunner pipeline.Runner
+	jb             job.Job
+	spec           pipeline.Spec
+	lggr           logger.Logger
+}
+
+// newShadowObserver returns nil if the job has no shadow observation source.
+func newShadowObserver(pr pipeline.Runner, jb job.Job, lggr logger.Logger) *shadowObserver {
+	if jb.ShadowPipelineSpec == nil {
+		return nil
+	}
+	return &shadowObserver{
+		pipelineRunner: pr,
+		jb:             jb,
+		spec:           *jb.ShadowPipelineSpec,
+		lggr:           lggr.Named("ShadowObservation"),
+	}
+}
+
+// observe starts a shadow run in the background, and returns the function the live observation must always be
+// passed to once known. The shadow run has the same deadline as the live one, but is not cancelled when the live one
+// returns.
+func (s *shadowObserver) observe(ctx context.Context, vars pipeline.Vars) func(live observation) {
+	chLive := make(chan observation, 1)
+	shadowCtx := context.WithoutCancel(ctx)
+	cancel := func() {}
+	if deadline, ok := ctx.Deadline(); ok {
+		shadowCtx, cancel = context.WithDeadline(shadowCtx, deadline)
+	}
+
+	go func() {
+		defer cancel()
+		var shadow observation
+		_, trrs, err := s.pipelineRunner.ExecuteRun(shadowCtx, s.spec, vars, s.lggr)
+		if err != nil {
+			shadow.err = fmt.Errorf("error executing shadow run for spec ID %v: %w", s.spec.ID, err)
+		} else {
+			shadow.value, shadow.err = toObservation(trrs.FinalResult(s.lggr), s.spec.JobID)
+		}
+
+		s.record(shadow, <-chLive)
+	}()
+
+	return func(live observation) {
+		chLive <- live
+	}
+}
+
+func (s *shadowObserver) record(shadow, live observation) {
+	jobID, jobName := fmt.Sprintf("%d", s.jb.ID), s.jb.Name.String
+	if shadow.err != nil {
+		s.lggr.Warnw("Shadow observation failed",
+			"err", shadow.err,
+			"liveObservation", live.value,
+			"liveErr", live.err,
+		)
+		PromShadowObservationErrors.WithLabelValues(jobID, jobName).Inc()
+		return
+	}
+	// The metrics are set once done logging.
+	defer PromShadowObservationValues.WithLabelValues(jobID, jobName).Set(toFloat(shadow.value))
+	if live.err != nil {
+		s.lggr.Warnw("Shadow observation succeeded but the live observation failed",
+			"shadowObservation", shadow.value,
+			"liveErr", live.err,
+		)
+		return
+	}
+
+	var divergence float64
+	if live.value.Sign() != 0 {
+		diff := decimal.NewFromBigInt(new(big.Int).Sub(shadow.value, live.value), 0).Abs()
+		divergence, _ = diff.Div(decimal.NewFromBigInt(live.value, 0).Abs()).Mul(decimal.NewFromInt(100)).Float64()
+	} else if shadow.value.Sign() != 0 {
+		s.lggr.Warnw("Shadow observation is not zero but the live observation is", "shadowObservation", shadow.value)
+		return
+	}
+	s.lggr.Debugw("Shadow observation",
+		"shadowObservation", shadow.value,
+		"liveObservation", live.value,
+		"divergencePercent", divergence,
+	)
+	PromShadowObservationDivergence.WithLabelValues(jobID, jobName).Set(divergence)
+}
+
+func toFloat(v *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
@@ -32,6 +32,9 @@ type eaTelemetry struct {
 	ProviderReceivedTimestamp     int64
 	ProviderDataStreamEstablished int64
 	ProviderIndicatedTime         int64
+	StatusCode                    int64
+	// DataPoints are the numeric values of the data of the response.
+	DataPoints map[string]float64
 }
 
 type EnhancedTelemetryData struct {
@@ -156,8 +159,10 @@ func parseEATelemetry(b []byte) (eaTelemetry, error) {
 	}
 
 	type eaTelem struct {
-		TelemTimestamps eaTimestamps `json:"timestamps"`
-		TelemMeta       eaMeta       `json:"meta"`
+		TelemTimestamps eaTimestamps   `json:"timestamps"`
+		TelemMeta       eaMeta         `json:"meta"`
+		StatusCode      int64          `json:"statusCode"`
+		Data            map[string]any `json:"data"`
 	}
 	t := eaTelem{}
 
@@ -165,12 +170,24 @@ func parseEATelemetry(b []byte) (eaTelemetry, error) {
 		return eaTelemetry{}, err
 	}
 
+	var dataPoints map[string]float64
+	for name, v := range t.Data {
+		if f, ok := v.(float64); ok {
+			if dataPoints == nil {
+				dataPoints = make(map[string]float64)
+			}
+			dataPoints[name] = f
+		}
+	}
+
 	return eaTelemetry{
 		DataSource:                    t.TelemMeta.AdapterName,
 		ProviderRequestedTimestamp:    t.TelemTimestamps.ProviderRequestedTimestamp,
 		ProviderReceivedTimestamp:     t.TelemTimestamps.ProviderReceivedTimestamp,
 		ProviderDataStreamEstablished: t.TelemTimestamps.ProviderDataStreamEstablished,
 		ProviderIndicatedTime:         t.TelemTimestamps.ProviderIndicatedTime,
+		StatusCode:                    t.StatusCode,
+		DataPoints:                    dataPoints,
 	}, nil
 }
 
@@ -239,31 +256,11 @@ func (e *EnhancedTelemetryService[T]) collectAndSend(trrs *pipeline.TaskRunResul
 			bridgeName = b.Name
 		}
 
-		if trr.Result.Error != nil {
-			e.lggr.Warnw(fmt.Sprintf("cannot get bridge response from bridge task, job=%d, id=%s, name=%q", e.job.ID, trr.Task.DotID(), bridgeName), "err", trr.Result.Error, "jobID", e.job.ID, "dotID", trr.Task.DotID(), "bridgeName", bridgeName)
-			continue
-		}
-		bridgeRawResponse, ok := trr.Result.Value.(string)
-		if !ok {
-			e.lggr.Warnw(fmt.Sprintf("cannot parse bridge response from bridge task, job=%d, id=%s, name=%q: expected string, got: %v (type %T)", e.job.ID, trr.Task.DotID(), bridgeName, trr.Result.Value, trr.Result.Value), "jobID", e.job.ID, "dotID", trr.Task.DotID(), "bridgeName", bridgeName)
-			continue
-		}
-		eaTelem, err := parseEATelemetry([]byte(bridgeRawResponse))
-		if err != nil {
-			e.lggr.Warnw(fmt.Sprintf("cannot parse EA telemetry, job=%d, id=%s, name=%q", e.job.ID, trr.Task.DotID(), bridgeName), "err", err, "jobID", e.job.ID, "dotID", trr.Task.DotID(), "bridgeName", bridgeName)
-			continue
-		}
-		value := e.getParsedValue(trrs, trr)
-
 		t := &telem.EnhancedEA{
-			DataSource:                    eaTelem.DataSource,
-			Value:                         value,
+			BridgeName:                    bridgeName,
+			DotId:                         trr.Task.DotID(),
 			BridgeTaskRunStartedTimestamp: trr.CreatedAt.UnixMilli(),
 			BridgeTaskRunEndedTimestamp:   trr.FinishedAt.Time.UnixMilli(),
-			ProviderRequestedTimestamp:    eaTelem.ProviderRequestedTimestamp,
-			ProviderReceivedTimestamp:     eaTelem.ProviderReceivedTimestamp,
-			ProviderDataStreamEstablished: eaTelem.ProviderDataStreamEstablished,
-			ProviderIndicatedTime:         eaTelem.ProviderIndicatedTime,
 			Feed:                          contract,
 			ChainId:                       chainID,
 			Observation:                   observation,
@@ -272,6 +269,30 @@ func (e *EnhancedTelemetryService[T]) collectAndSend(trrs *pipeline.TaskRunResul
 			Epoch:                         int64(timestamp.Epoch),
 		}
 
+		if trr.Result.Error != nil {
+			// failed requests are sent too, so that they can be attributed to the bridge
+			t.Error = trr.Result.Error.Error()
+		} else {
+			bridgeRawResponse, ok := trr.Result.Value.(string)
+			if !ok {
+				e.lggr.Warnw(fmt.Sprintf("cannot parse bridge response from bridge task, job=%d, id=%s, name=%q: expected string, got: %v (type %T)", e.job.ID, trr.Task.DotID(), bridgeName, trr.Result.Value, trr.Result.Value), "jobID", e.job.ID, "dotID", trr.Task.DotID(), "bridgeName", bridgeName)
+				continue
+			}
+			eaTelem, err := parseEATelemetry([]byte(bridgeRawResponse))
+			if err != nil {
+				e.lggr.Warnw(fmt.Sprintf("cannot parse EA telemetry, job=%d, id=%s, name=%q", e.job.ID, trr.Task.DotID(), bridgeName), "err", err, "jobID", e.job.ID, "dotID", trr.Task.DotID(), "bridgeName", bridgeName)
+				continue
+			}
+			t.DataSource = eaTelem.DataSource
+			t.Value = e.getParsedValue(trrs, trr)
+			t.ProviderRequestedTimestamp = eaTelem.ProviderRequestedTimestamp
+			t.ProviderReceivedTimestamp = eaTelem.ProviderReceivedTimestamp
+			t.ProviderDataStreamEstablished = eaTelem.ProviderDataStreamEstablished
+			t.ProviderIndicatedTime = eaTelem.ProviderIndicatedTime
+			t.StatusCode = eaTelem.StatusCode
+			t.DataPoints = eaTelem.DataPoints
+		}
+
 		bytes, err := proto.Marshal(t)
 		if err != nil {
 			e.lggr.Warnw("protobuf marshal failed", "err", err)
@@ -1,6 +1,7 @@
 package ocrcommon
 
 import (
+	"errors"
 	"math/big"
 	"sync"
 	"testing"
@@ -32,6 +33,12 @@ import (
 )
 
 const bridgeResponse = `{
+			"statusCode":200,
+			"data":{
+				"result":123456789.1234567,
+				"volume":1000,
+				"symbol":"ETH"
+			},
 			"meta":{
 				"adapterName":"data-source-name"
 			},
@@ -171,6 +178,8 @@ func TestParseEATelemetry(t *testing.T) {
 	assert.Equal(t, ea.ProviderReceivedTimestamp, int64(-92233720368547760))
 	assert.Equal(t, ea.ProviderDataStreamEstablished, int64(1))
 	assert.Equal(t, ea.ProviderIndicatedTime, int64(-123456789))
+	assert.Equal(t, int64(200), ea.StatusCode)
+	assert.Equal(t, map[string]float64{"result": 123456789.1234567, "volume": 1000}, ea.DataPoints)
 
 	_, err = parseEATelemetry(nil)
 	assert.Error(t, err)
@@ -270,11 +279,16 @@ func TestSendEATelemetry(t *testing.T) {
 		Round:                         15,
 		Epoch:                         738,
 		ConfigDigest:                  "config digest hex",
+		DotId:                         "ds1",
+		StatusCode:                    200,
+		DataPoints:                    map[string]float64{"result": 123456789.1234567, "volume": 1000},
 	}
 
-	expectedMessage, _ := proto.Marshal(&expectedTelemetry)
 	wg.Wait()
-	assert.Equal(t, expectedMessage, sentMessage)
+	// the encoding of data_points is not deterministic
+	var sentTelemetry telem.EnhancedEA
+	require.NoError(t, proto.Unmarshal(sentMessage, &sentTelemetry))
+	assert.True(t, proto.Equal(&expectedTelemetry, &sentTelemetry), "expected %v, got %v", &expectedTelemetry, &sentTelemetry)
 	//enhancedTelemService.StopOnce("EnhancedTelemetryService", func() error { return nil })
 	doneCh <- struct{}{}
 }
@@ -311,7 +325,9 @@ func TestCollectAndSend(t *testing.T) {
 	ingressClient := mocks.NewTelemetryService(t)
 	ingressAgent := telemetry.NewIngressAgentWrapper(ingressClient)
 	monitoringEndpoint := ingressAgent.GenMonitoringEndpoint("test-network", "test-chainID", "0xa", synchronization.EnhancedEA)
+	var sentMessage []byte
 	ingressClient.On("Send", mock.Anything, mock.AnythingOfType("[]uint8"), mock.AnythingOfType("string"), mock.AnythingOfType("TelemetryType")).Return().Run(func(args mock.Arguments) {
+		sentMessage = args[1].([]byte)
 		wg.Done()
 	})
 
@@ -387,6 +403,33 @@ func TestCollectAndSend(t *testing.T) {
 	assert.Equal(t, 2, logs.Len())
 	assert.Contains(t, logs.All()[0].Message, "cannot parse bridge response from bridge task")
 	assert.Contains(t, logs.All()[1].Message, "cannot get json parse value")
+
+	failedTrrs := pipeline.TaskRunResults{
+		pipeline.TaskRunResult{
+			Task: &pipeline.BridgeTask{
+				Name:     "test-bridge",
+				BaseTask: pipeline.NewBaseTask(0, "ds1", nil, nil, 0),
+			},
+			Result: pipeline.Result{
+				Error: errors.New("got error from http://adapter: (status code 500)"),
+			},
+		}}
+	wg.Add(1)
+	enhancedTelemChan <- EnhancedTelemetryData{
+		TaskRunResults: failedTrrs,
+		FinalResults:   *finalResult,
+		RepTimestamp:   observationTimestamp,
+	}
+	wg.Wait()
+	var sent telem.EnhancedEA
+	require.NoError(t, proto.Unmarshal(sentMessage, &sent))
+	assert.Equal(t, "test-bridge", sent.BridgeName)
+	assert.Equal(t, "ds1", sent.DotId)
+	assert.Equal(t, "got error from http://adapter: (status code 500)", sent.Error)
+	assert.Empty(t, sent.DataSource)
+	// only the unparsable response of the previous run is logged
+	require.Equal(t, 3, logs.Len())
+	assert.Contains(t, logs.All()[2].Message, "cannot parse EA telemetry")
 	doneCh <- struct{}{}
 }
 
@@ -1,10 +1,15 @@
 package pg
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
 	_ "github.com/jackc/pgx/v4/stdlib" // need to make sure pgx driver is registered before opening connection
 	"github.com/jmoiron/sqlx"
 	"github.com/scylladb/go-reflectx"
@@ -52,6 +57,33 @@ func NewConnection(uri string, dialect dialects.DialectName, config ConnectionCo
 	return db, disallowReplica(db)
 }
 
+// NewReplicaConnection returns a connection pool for a read-only replica of the database. Unlike NewConnection, it
+// does not connect until used, so that an unavailable replica does not prevent the node from starting. The session
+// defaults are set by each connection, and make transactions read-only, so that writes are rejected even if uri does
+// not point to a replica.
+func NewReplicaConnection(uri url.URL, dialect dialects.DialectName, config ConnectionConfig) (*sqlx.DB, error) {
+	db, err := sqlx.Open(string(dialect), withReplicaSessionDefaults(uri, config).String())
+	if err != nil {
+		return nil, err
+	}
+	db.MapperFunc(reflectx.CamelToSnakeASCII)
+	db.SetMaxOpenConns(config.MaxOpenConns())
+	db.SetMaxIdleConns(config.MaxIdleConns())
+	return db, nil
+}
+
+// withReplicaSessionDefaults returns uri with the session defaults of NewConnection, as run-time parameters.
+func withReplicaSessionDefaults(uri url.URL, config ConnectionConfig) *url.URL {
+	q := uri.Query()
+	q.Set("TimeZone", "UTC")
+	q.Set("lock_timeout", strconv.FormatInt(config.DefaultLockTimeout().Milliseconds(), 10))
+	q.Set("idle_in_transaction_session_timeout", strconv.FormatInt(config.DefaultIdleInTxSessionTimeout().Milliseconds(), 10))
+	q.Set("default_transaction_isolation", strings.ToLower(defaultIsolation.String()))
+	q.Set("default_transaction_read_only", "on")
+	uri.RawQuery = q.Encode()
+	return &uri
+}
+
 func disallowReplica(db *sqlx.DB) error {
 	var val string
 	err := db.Get(&val, "SHOW session_replication_role")
@@ -65,3 +97,10 @@ func disallowReplica(db *sqlx.DB) error {
 
 	return nil
 }
+
+// IsAuthError returns true if the database rejected the credentials, e.g. because they were rotated.
+func IsAuthError(err error) bool {
+	var pgErr *pgconn.PgError
+	// invalid_password or invalid_authorization_specification
+	return errors.As(err, &pgErr) && (pgErr.Code == "28P01" || pgErr.Code == "28000")
+}
@@ -1,9 +1,12 @@
 package pg
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
 	_ "github.com/jackc/pgx/v4/stdlib"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
@@ -33,3 +36,13 @@ func Test_disallowReplica(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func TestIsAuthError(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsAuthError(fmt.Errorf("failed to connect: %w", &pgconn.PgError{Code: "28P01"})))
+	require.True(t, IsAuthError(&pgconn.PgError{Code: "28000"}))
+	require.False(t, IsAuthError(&pgconn.PgError{Code: "23505"}))
+	require.False(t, IsAuthError(errors.New("connection refused")))
+	require.False(t, IsAuthError(nil))
+}
@@ -3,6 +3,7 @@ package pg
 import (
 	"context"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/jmoiron/sqlx"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
@@ -18,11 +21,23 @@ import (
 
 // LockedDB bounds DB connection and DB locks.
 type LockedDB interface {
+	services.HealthReporter
 	Open(ctx context.Context) error
+	// OpenStandby connects to DB like Open, but does not take the lease. TakeLease must be called before any DB
+	// operation which requires the lease.
+	OpenStandby(ctx context.Context) error
+	// TakeLease blocks until the lease is taken, if locking is enabled and the lease is not held yet.
+	TakeLease(ctx context.Context) error
 	Close() error
 	DB() *sqlx.DB
 }
 
+// LeaseHealthName is the name of the health check of the lease.
+const LeaseHealthName = "DatabaseLease"
+
+// ErrStandby is reported by the health check of the lease until it is taken.
+var ErrStandby = errors.New("standby: waiting for the database lease held by another node")
+
 type LockedDBConfig interface {
 	ConnectionConfig
 	URL() url.URL
@@ -37,6 +52,7 @@ type lockedDb struct {
 	lggr          logger.Logger
 	db            *sqlx.DB
 	leaseLock     LeaseLock
+	leased        atomic.Bool
 	statsReporter *StatsReporter
 }
 
@@ -61,7 +77,17 @@ func OpenUnlockedDB(appID uuid.UUID, cfg LockedDBConfig) (db *sqlx.DB, err error
 // If any of the steps fails or ctx is cancelled, it reverts everything.
 // This is a blocking function and it may execute long due to DB locks acquisition.
 // NOT THREAD SAFE
-func (l *lockedDb) Open(ctx context.Context) (err error) {
+func (l *lockedDb) Open(ctx context.Context) error {
+	return l.open(ctx, true)
+}
+
+// OpenStandby function connects to DB like Open, but defers taking the lease to TakeLease.
+// NOT THREAD SAFE
+func (l *lockedDb) OpenStandby(ctx context.Context) error {
+	return l.open(ctx, false)
+}
+
+func (l *lockedDb) open(ctx context.Context, takeLease bool) (err error) {
 	// If Open succeeded previously, db will not be nil
 	if l.db != nil {
 		l.lggr.Panic("calling Open() twice")
@@ -97,15 +123,33 @@ func (l *lockedDb) Open(ctx context.Context) (err error) {
 			LeaseRefreshInterval: l.lockCfg.LeaseRefreshInterval(),
 		}
 		l.leaseLock = NewLeaseLock(l.db, l.appID, l.lggr, cfg)
-		if err = l.leaseLock.TakeAndHold(ctx); err != nil {
+		if !takeLease {
+			l.lggr.Infow("Opened database as a standby, the lease is taken later", "clientID", l.leaseLock.ClientID())
+			return
+		}
+		if err = l.TakeLease(ctx); err != nil {
 			defer revert()
-			return errors.Wrap(err, "failed to take initial lease on database")
+			return err
 		}
 	}
 
 	return
 }
 
+// TakeLease function blocks until the lease is taken, or ctx is cancelled.
+// It has no effect if the lease is already held or locking is disabled.
+// NOT THREAD SAFE
+func (l *lockedDb) TakeLease(ctx context.Context) error {
+	if l.leaseLock == nil || l.leased.Load() {
+		return nil
+	}
+	if err := l.leaseLock.TakeAndHold(ctx); err != nil {
+		return errors.Wrap(err, "failed to take initial lease on database")
+	}
+	l.leased.Store(true)
+	return nil
+}
+
 // Close function releases DB locks (if acquired by Open) and closes DB connection.
 // Closing of a closed LockedDB instance has no effect.
 // NOT THREAD SAFE
@@ -113,6 +157,7 @@ func (l *lockedDb) Close() error {
 	defer func() {
 		l.db = nil
 		l.leaseLock = nil
+		l.leased.Store(false)
 		l.statsReporter = nil
 	}()
 
@@ -135,10 +180,24 @@ func (l *lockedDb) Close() error {
 }
 
 // DB returns DB connection if Opened successfully, or nil.
-func (l lockedDb) DB() *sqlx.DB {
+func (l *lockedDb) DB() *sqlx.DB {
 	return l.db
 }
 
+func (l *lockedDb) Name() string { return LeaseHealthName }
+
+// Ready returns ErrStandby if locking is enabled and the lease is not taken yet.
+func (l *lockedDb) Ready() error {
+	if l.lockCfg.LockingMode() == "lease" && !l.leased.Load() {
+		return ErrStandby
+	}
+	return nil
+}
+
+func (l *lockedDb) HealthReport() map[string]error {
+	return map[string]error{l.Name(): l.Ready()}
+}
+
 func openDB(appID uuid.UUID, cfg LockedDBConfig) (db *sqlx.DB, err error) {
 	uri := cfg.URL()
 	static.SetConsumerName(&uri, "App", &appID)
@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,6 +87,39 @@ func TestLockedDB_TwoInstances(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestLockedDB_Standby(t *testing.T) {
+	testutils.SkipShortDB(t)
+	config := configtest.NewGeneralConfig(t, lease)
+	lggr := logger.TestLogger(t)
+
+	// the leader is another node
+	ldb1 := pg.NewLockedDB(uuid.New(), config.Database(), config.Database().Lock(), lggr)
+	require.NoError(t, ldb1.Open(testutils.Context(t)))
+	require.NoError(t, ldb1.Ready())
+
+	// the standby connects without waiting for the lease
+	ldb2 := pg.NewLockedDB(config.AppID(), config.Database(), config.Database().Lock(), lggr)
+	require.NoError(t, ldb2.OpenStandby(testutils.Context(t)))
+	require.NotNil(t, ldb2.DB())
+	require.ErrorIs(t, ldb2.Ready(), pg.ErrStandby)
+	require.Equal(t, map[string]error{pg.LeaseHealthName: pg.ErrStandby}, ldb2.HealthReport())
+	defer func() {
+		require.NoError(t, ldb2.Close())
+	}()
+
+	// the standby keeps waiting while the leader holds the lease
+	ctx, cancel := context.WithTimeout(testutils.Context(t), 2*time.Second)
+	defer cancel()
+	require.Error(t, ldb2.TakeLease(ctx))
+	require.ErrorIs(t, ldb2.Ready(), pg.ErrStandby)
+
+	// the standby takes over once the leader releases the lease
+	require.NoError(t, ldb1.Close())
+	require.NoError(t, ldb2.TakeLease(testutils.Context(t)))
+	require.NoError(t, ldb2.Ready())
+	require.Equal(t, map[string]error{pg.LeaseHealthName: nil}, ldb2.HealthReport())
+}
+
 func TestOpenUnlockedDB(t *testing.T) {
 	testutils.SkipShortDB(t)
 	config := configtest.NewGeneralConfig(t, nil)
@@ -0,0 +1,161 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ReplicaConfig configures the read-only replica of the database.
+type ReplicaConfig interface {
+	MaxLag() time.Duration
+	LagCheckInterval() time.Duration
+}
+
+// replicaLagQuery returns the replication lag in seconds. A replica which has replayed all the WAL it received is
+// caught up, even if the primary has been idle since the last replayed transaction. NULL means the lag is unknown,
+// e.g. because nothing has been replayed yet.
+const replicaLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())
+END`
+
+// ReplicaDB routes read-only queries to a replica of the primary database, while the replica lags behind the primary
+// by at most MaxLag. Otherwise, and until the first lag check succeeds, queries fall back to the primary.
+//
+// Only reads which tolerate slightly stale data should use the replica. Writes, locks and the reads which services
+// use to track their own state must stay on the primary.
+type ReplicaDB struct {
+	services.StateMachine
+	primary *sqlx.DB
+	replica *sqlx.DB
+	cfg     ReplicaConfig
+	lggr    logger.SugaredLogger
+
+	usable atomic.Bool
+	lag    atomic.Int64 // time.Duration
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewReplicaDB returns a ReplicaDB which owns the replica connection, and closes it when closed. A nil replica routes
+// every query to the primary.
+func NewReplicaDB(primary, replica *sqlx.DB, cfg ReplicaConfig, lggr logger.Logger) *ReplicaDB {
+	return &ReplicaDB{
+		primary: primary,
+		replica: replica,
+		cfg:     cfg,
+		lggr:    logger.Sugared(lggr.Named("ReplicaDB")),
+		chStop:  make(services.StopChan),
+	}
+}
+
+func (r *ReplicaDB) Start(ctx context.Context) error {
+	return r.StartOnce("ReplicaDB", func() error {
+		if r.replica == nil {
+			return nil
+		}
+		r.checkLag(ctx)
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *ReplicaDB) Close() error {
+	return r.StopOnce("ReplicaDB", func() error {
+		close(r.chStop)
+		r.wg.Wait()
+		if r.replica == nil {
+			return nil
+		}
+		return r.replica.Close()
+	})
+}
+
+func (r *ReplicaDB) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *ReplicaDB) HealthReport() map[string]error {
+	report := map[string]error{r.Name(): r.Healthy()}
+	if r.replica != nil && !r.usable.Load() {
+		report[r.Name()+".Replica"] = fmt.Errorf("replica is unavailable or lags by more than %s, reads fall back to the primary", r.cfg.MaxLag())
+	}
+	return report
+}
+
+func (r *ReplicaDB) run() {
+	defer r.wg.Done()
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(r.cfg.LagCheckInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.checkLag(ctx)
+		}
+	}
+}
+
+func (r *ReplicaDB) checkLag(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LagCheckInterval())
+	defer cancel()
+
+	var seconds sql.NullFloat64
+	err := r.replica.GetContext(ctx, &seconds, replicaLagQuery)
+	lag := time.Duration(seconds.Float64 * float64(time.Second))
+	usable := err == nil && seconds.Valid && lag <= r.cfg.MaxLag()
+	r.lag.Store(int64(lag))
+
+	if was := r.usable.Swap(usable); was != usable {
+		switch {
+		case usable:
+			r.lggr.Infow("Replica caught up, routing reads to the replica", "lag", lag)
+		case err != nil:
+			r.lggr.Warnw("Failed to check replica lag, routing reads to the primary", "err", err)
+		case !seconds.Valid:
+			r.lggr.Warn("Replica lag is unknown, routing reads to the primary")
+		default:
+			r.lggr.Warnw("Replica lags behind, routing reads to the primary", "lag", lag, "maxLag", r.cfg.MaxLag())
+		}
+	}
+}
+
+// Lag returns the replication lag of the replica, as of the last check.
+func (r *ReplicaDB) Lag() time.Duration {
+	return time.Duration(r.lag.Load())
+}
+
+// DB returns the replica if it is usable, or else the primary.
+func (r *ReplicaDB) DB() *sqlx.DB {
+	if r.replica != nil && r.usable.Load() {
+		return r.replica
+	}
+	return r.primary
+}
+
+// ReadQ returns q for a read-only query, on the replica if it is usable. q is returned as is if it does not use the
+// primary database directly, e.g. because it is part of a transaction. A nil ReplicaDB always returns q.
+func (r *ReplicaDB) ReadQ(q Q) Q {
+	if r == nil || q.db != r.primary || q.Queryer != Queryer(q.db) {
+		return q
+	}
+	db := r.DB()
+	q.db, q.Queryer = db, db
+	return q
+}
@@ -0,0 +1,238 @@
+// Package archive exports pipeline runs to an object store before they are pruned, and finds them again by ID.
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/objectstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// Run is an archived pipeline run, with its task runs. Archives are gzipped JSON lines of runs.
+type Run struct {
+	ID             int64                     `json:"id" db:"id"`
+	JobID          int32                     `json:"jobID" db:"job_id"`
+	PipelineSpecID int32                     `json:"pipelineSpecID" db:"pipeline_spec_id"`
+	State          pipeline.RunStatus        `json:"state" db:"state"`
+	Meta           pipeline.JSONSerializable `json:"meta" db:"meta"`
+	AllErrors      pipeline.RunErrors        `json:"allErrors" db:"all_errors"`
+	FatalErrors    pipeline.RunErrors        `json:"fatalErrors" db:"fatal_errors"`
+	Inputs         pipeline.JSONSerializable `json:"inputs" db:"inputs"`
+	Outputs        pipeline.JSONSerializable `json:"outputs" db:"outputs"`
+	CreatedAt      time.Time                 `json:"createdAt" db:"created_at"`
+	FinishedAt     null.Time                 `json:"finishedAt" db:"finished_at"`
+	TaskRuns       []pipeline.TaskRun        `json:"taskRuns" db:"-"`
+
+	// ObjectKey is the key of the object which the run was found in, and ArchivedAt the time it was archived.
+	ObjectKey  string    `json:"-" db:"-"`
+	ArchivedAt time.Time `json:"-" db:"-"`
+}
+
+// PipelineRun returns the run as a pipeline.Run.
+func (r Run) PipelineRun() pipeline.Run {
+	return pipeline.Run{
+		ID:               r.ID,
+		PipelineSpecID:   r.PipelineSpecID,
+		Meta:             r.Meta,
+		AllErrors:        r.AllErrors,
+		FatalErrors:      r.FatalErrors,
+		Inputs:           r.Inputs,
+		Outputs:          r.Outputs,
+		CreatedAt:        r.CreatedAt,
+		FinishedAt:       r.FinishedAt,
+		PipelineTaskRuns: r.TaskRuns,
+		State:            r.State,
+	}
+}
+
+//go:generate mockery --quiet --name Archiver --output ./mocks/ --case=underscore
+
+// Archiver archives pipeline runs to an object store.
+type Archiver interface {
+	// ArchiveRuns archives at most limit runs which finished before cutoff, deletes them, and returns their number.
+	ArchiveRuns(ctx context.Context, cutoff time.Time, limit int64) (int64, error)
+	// FindRun returns the archived run with the id, or an error wrapping sql.ErrNoRows if it was never archived.
+	FindRun(ctx context.Context, id int64) (Run, error)
+}
+
+type archiver struct {
+	db    *sqlx.DB
+	store objectstore.Store
+	lggr  logger.SugaredLogger
+	now   func() time.Time
+}
+
+// New returns an Archiver which writes to store, and indexes the archives in db.
+func New(db *sqlx.DB, store objectstore.Store, lggr logger.Logger) Archiver {
+	return &archiver{
+		db:    db,
+		store: store,
+		lggr:  logger.Sugared(lggr.Named("PipelineRunArchiver")),
+		now:   time.Now,
+	}
+}
+
+// NewFromConfig returns an Archiver which writes to the object store of cfg.
+func NewFromConfig(db *sqlx.DB, cfg config.Archive, lggr logger.Logger) (Archiver, error) {
+	var endpoint string
+	if u := cfg.Endpoint(); u != nil {
+		endpoint = u.String()
+	}
+	store, err := objectstore.New(cfg.URL(), endpoint, http.DefaultClient)
+	if err != nil {
+		return nil, err
+	}
+	return New(db, store, lggr), nil
+}
+
+const selectRuns = `SELECT pipeline_runs.id, COALESCE(jobs.id, 0) AS job_id, pipeline_runs.pipeline_spec_id,
+	pipeline_runs.state, pipeline_runs.meta, pipeline_runs.all_errors, pipeline_runs.fatal_errors, pipeline_runs.inputs,
+	pipeline_runs.outputs, pipeline_runs.created_at, pipeline_runs.finished_at
+FROM pipeline_runs LEFT JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+WHERE pipeline_runs.id = ANY($1) ORDER BY pipeline_runs.id`
+
+func (a *archiver) ArchiveRuns(ctx context.Context, cutoff time.Time, limit int64) (int64, error) {
+	var ids []int64
+	if err := a.db.SelectContext(ctx, &ids, `SELECT id FROM pipeline_runs WHERE finished_at < $1 ORDER BY finished_at LIMIT $2`, cutoff, limit); err != nil {
+		return 0, fmt.Errorf("failed to select runs: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	runs, err := a.loadRuns(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	if len(runs) == 0 {
+		return 0, nil
+	}
+	data, err := encode(runs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode runs: %w", err)
+	}
+
+	now := a.now().UTC()
+	minID, maxID := runs[0].ID, runs[len(runs)-1].ID
+	key := fmt.Sprintf("pipeline_runs/%s/%d-%d-%d.jsonl.gz", now.Format("2006/01/02"), minID, maxID, now.UnixNano())
+	if err = a.store.Put(ctx, key, data); err != nil {
+		return 0, fmt.Errorf("failed to write archive %s: %w", key, err)
+	}
+
+	ids = ids[:0]
+	for _, r := range runs {
+		ids = append(ids, r.ID)
+	}
+	var deleted int64
+	err = pg.SqlxTransaction(ctx, a.db, a.lggr, func(tx pg.Queryer) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO pipeline_run_archives (object_key, min_run_id, max_run_id, run_count, created_at)
+VALUES ($1, $2, $3, $4, $5)`, key, minID, maxID, len(runs), now); err != nil {
+			return fmt.Errorf("failed to index archive: %w", err)
+		}
+		return tx.GetContext(ctx, &deleted, pipeline.DeleteRunsQuery(`DELETE FROM pipeline_runs WHERE id = ANY($1) RETURNING id`), ids)
+	})
+	if err != nil {
+		// The archive is orphaned, but harmless: the runs are archived again by the next attempt.
+		return 0, fmt.Errorf("failed to delete archived runs: %w", err)
+	}
+	a.lggr.Debugw("Archived pipeline runs", "key", key, "runs", len(runs), "deleted", deleted)
+	return deleted, nil
+}
+
+func (a *archiver) loadRuns(ctx context.Context, ids []int64) ([]Run, error) {
+	var runs []Run
+	if err := a.db.SelectContext(ctx, &runs, selectRuns, ids); err != nil {
+		return nil, fmt.Errorf("failed to load runs: %w", err)
+	}
+	var taskRuns []pipeline.TaskRun
+	if err := a.db.SelectContext(ctx, &taskRuns, `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = ANY($1) ORDER BY pipeline_run_id, created_at, id`, ids); err != nil {
+		return nil, fmt.Errorf("failed to load task runs: %w", err)
+	}
+	byRun := make(map[int64][]pipeline.TaskRun)
+	for _, tr := range taskRuns {
+		byRun[tr.PipelineRunID] = append(byRun[tr.PipelineRunID], tr)
+	}
+	for i := range runs {
+		runs[i].TaskRuns = byRun[runs[i].ID]
+	}
+	return runs, nil
+}
+
+func (a *archiver) FindRun(ctx context.Context, id int64) (Run, error) {
+	var archives []struct {
+		ObjectKey string    `db:"object_key"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	if err := a.db.SelectContext(ctx, &archives, `SELECT object_key, created_at FROM pipeline_run_archives
+WHERE min_run_id <= $1 AND max_run_id >= $1 ORDER BY id DESC`, id); err != nil {
+		return Run{}, fmt.Errorf("failed to find archives: %w", err)
+	}
+	// Runs are archived in order of completion rather than ID, so the ranges of archives may overlap.
+	for _, archive := range archives {
+		data, err := a.store.Get(ctx, archive.ObjectKey)
+		if err != nil {
+			return Run{}, fmt.Errorf("failed to read archive %s: %w", archive.ObjectKey, err)
+		}
+		runs, err := decode(data)
+		if err != nil {
+			return Run{}, fmt.Errorf("failed to decode archive %s: %w", archive.ObjectKey, err)
+		}
+		for _, r := range runs {
+			if r.ID == id {
+				r.ObjectKey, r.ArchivedAt = archive.ObjectKey, archive.CreatedAt
+				return r, nil
+			}
+		}
+	}
+	return Run{}, fmt.Errorf("archived run %d: %w", id, sql.ErrNoRows)
+}
+
+// encode returns the runs as gzipped JSON lines.
+func encode(runs []Run) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for _, r := range runs {
+		if err := enc.Encode(r); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decode returns the runs of gzipped JSON lines.
+func decode(data []byte) ([]Run, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	var runs []Run
+	dec := json.NewDecoder(zr)
+	for {
+		var r Run
+		if err := dec.Decode(&r); errors.Is(err, io.EOF) {
+			return runs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		runs = append(runs, r)
+	}
+}
@@ -0,0 +1,98 @@
+package archive
+
+import (
+	"database/sql"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/objectstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_encode(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	runs := []Run{
+		{
+			ID:             1,
+			JobID:          2,
+			PipelineSpecID: 3,
+			State:          pipeline.RunStatusErrored,
+			AllErrors:      pipeline.RunErrors{null.String{}, null.StringFrom("boom")},
+			FatalErrors:    pipeline.RunErrors{null.StringFrom("boom")},
+			Inputs:         pipeline.JSONSerializable{Val: map[string]interface{}{"a": "b"}, Valid: true},
+			CreatedAt:      created,
+			FinishedAt:     null.TimeFrom(created.Add(time.Second)),
+			TaskRuns: []pipeline.TaskRun{{
+				ID:         uuid.New(),
+				Type:       pipeline.TaskTypeHTTP,
+				Error:      null.StringFrom("boom"),
+				CreatedAt:  created,
+				FinishedAt: null.TimeFrom(created.Add(time.Second)),
+				DotID:      "fetch",
+			}},
+		},
+		{ID: 4, State: pipeline.RunStatusCompleted, Outputs: pipeline.JSONSerializable{Val: []interface{}{"out"}, Valid: true}, CreatedAt: created},
+	}
+	data, err := encode(runs)
+	require.NoError(t, err)
+	decoded, err := decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, runs, decoded)
+}
+
+func TestArchiver(t *testing.T) {
+	testutils.SkipShortDB(t)
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	var specID int32
+	require.NoError(t, db.Get(&specID, `INSERT INTO pipeline_specs (dot_dag_source, created_at) VALUES ('', NOW()) RETURNING id`))
+	now := time.Now()
+	var ids []int64
+	for _, age := range []time.Duration{0, 2 * time.Hour, 3 * time.Hour} {
+		var id int64
+		require.NoError(t, db.Get(&id, `INSERT INTO pipeline_runs (state, pipeline_spec_id, outputs, created_at, finished_at)
+VALUES ('completed', $1, '["out"]', $2, $2) RETURNING id`, specID, now.Add(-age)))
+		_, err := db.Exec(`INSERT INTO pipeline_task_runs (dot_id, pipeline_run_id, id, type, created_at) VALUES ('ds', $1, $2, 'http', $3)`,
+			id, uuid.New(), now.Add(-age))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	store, err := objectstore.New(&url.URL{Scheme: "file", Path: t.TempDir()}, "", nil)
+	require.NoError(t, err)
+	a := New(db, store, logger.TestLogger(t))
+
+	n, err := a.ArchiveRuns(ctx, now.Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT count(*) FROM pipeline_runs WHERE pipeline_spec_id = $1`, specID))
+	assert.Equal(t, 1, count)
+	require.NoError(t, db.Get(&count, `SELECT count(*) FROM pipeline_task_runs WHERE pipeline_run_id = ANY($1)`, ids))
+	assert.Equal(t, 1, count)
+
+	run, err := a.FindRun(ctx, ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, ids[1], run.ID)
+	assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	require.Len(t, run.TaskRuns, 1)
+	assert.Equal(t, "ds", run.TaskRuns[0].DotID)
+	assert.NotEmpty(t, run.ObjectKey)
+	assert.NotZero(t, run.ArchivedAt)
+
+	_, err = a.FindRun(ctx, ids[0])
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -23,6 +23,7 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	cnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -39,6 +40,7 @@ const (
 	KeeperJobType                  string = "keeper"
 	LegacyGasStationServerJobType  string = "legacygasstationserver"
 	LegacyGasStationSidecarJobType string = "legacygasstationsidecar"
+	LOOPJobType                    string = "loop"
 	OffchainReporting2JobType      string = "offchainreporting2"
 	OffchainReportingJobType       string = "offchainreporting"
 	StreamJobType                  string = "stream"
@@ -70,6 +72,7 @@ type (
 		MaxRunDuration() time.Duration
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
+		BridgeCircuitBreaker() coreconfig.BridgeCircuitBreaker
 	}
 
 	BridgeConfig interface {
@@ -387,6 +390,7 @@ const (
 	TaskTypeLowercase        TaskType = "lowercase"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
+	TaskTypeMercuryVerify    TaskType = "mercuryverify"
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
@@ -477,6 +481,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LengthTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLessThan:
 		task = &LessThanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMercuryVerify:
+		task = &MercuryVerifyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLookup:
 		task = &LookupTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLowercase:
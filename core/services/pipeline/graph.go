@@ -273,3 +273,53 @@ func Parse(text string) (*Pipeline, error) {
 
 	return p, nil
 }
+
+// DAG is the parsed graph of a pipeline, for rendering and validating it without parsing its DOT source.
+type DAG struct {
+	// Nodes are the tasks of the pipeline, ordered topologically.
+	Nodes []DAGNode `json:"nodes"`
+	Edges []DAGEdge `json:"edges"`
+}
+
+// DAGNode is a task of a pipeline.
+type DAGNode struct {
+	ID         string            `json:"id"`
+	Type       TaskType          `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// DAGEdge is a dependency of a task on another one.
+type DAGEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Implicit edges are the ones added for the variables of a task referencing another one, rather than in the
+	// DOT source. The result of the task they come from is not passed as an input.
+	Implicit bool `json:"implicit"`
+}
+
+// DAG returns the graph of the pipeline.
+func (p *Pipeline) DAG() DAG {
+	attrs := make(map[string]map[string]string)
+	if p.tree != nil {
+		for nodes := p.tree.Nodes(); nodes.Next(); {
+			node := nodes.Node().(*GraphNode)
+			attrs[node.dotID] = node.attrs
+		}
+	}
+
+	dag := DAG{Nodes: make([]DAGNode, 0, len(p.Tasks)), Edges: []DAGEdge{}}
+	for _, task := range p.Tasks {
+		nodeAttrs := make(map[string]string, len(attrs[task.DotID()]))
+		for k, v := range attrs[task.DotID()] {
+			// the type is already a field of the node
+			if k != "type" {
+				nodeAttrs[k] = v
+			}
+		}
+		dag.Nodes = append(dag.Nodes, DAGNode{ID: task.DotID(), Type: task.Type(), Attributes: nodeAttrs})
+		for _, input := range task.Inputs() {
+			dag.Edges = append(dag.Edges, DAGEdge{From: input.InputTask.DotID(), To: task.DotID(), Implicit: !input.PropagateResult})
+		}
+	}
+	return dag
+}
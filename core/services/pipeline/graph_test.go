@@ -267,3 +267,27 @@ func TestParse(t *testing.T) {
 	}
 
 }
+
+func TestPipeline_DAG(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+		ds1 [type=http method=GET url="https://example.com" allowunrestrictednetworkaccess=true];
+		ds1_parse [type=jsonparse path="data,price"];
+		answer [type=multiply times="$(ds1_parse)" input="$(ds1)"];
+		ds1 -> ds1_parse -> answer;
+	`)
+	require.NoError(t, err)
+
+	dag := p.DAG()
+	assert.Equal(t, []pipeline.DAGNode{
+		{ID: "ds1", Type: pipeline.TaskTypeHTTP, Attributes: map[string]string{"method": "GET", "url": "https://example.com", "allowunrestrictednetworkaccess": "true"}},
+		{ID: "ds1_parse", Type: pipeline.TaskTypeJSONParse, Attributes: map[string]string{"path": "data,price"}},
+		{ID: "answer", Type: pipeline.TaskTypeMultiply, Attributes: map[string]string{"times": "$(ds1_parse)", "input": "$(ds1)"}},
+	}, dag.Nodes)
+	assert.Equal(t, []pipeline.DAGEdge{
+		{From: "ds1", To: "ds1_parse"},
+		{From: "ds1", To: "answer", Implicit: true},
+		{From: "ds1_parse", To: "answer"},
+	}, dag.Edges)
+}
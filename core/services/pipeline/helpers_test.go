@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 const (
@@ -39,6 +40,7 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.config = config
 	t.bridgeConfig = bridgeConfig
 	t.orm = orm
+	t.breakers = bridges.NewCircuitBreakers(config.BridgeCircuitBreaker(), logger.NullLogger)
 	t.uuid = id
 	t.httpClient = httpClient
 	t.specId = specId
@@ -3,9 +3,11 @@
 package mocks
 
 import (
-	config "github.com/smartcontractkit/chainlink-common/pkg/config"
+	config "github.com/smartcontractkit/chainlink/v2/core/config"
 	mock "github.com/stretchr/testify/mock"
 
+	pkgconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	time "time"
 )
 
@@ -14,6 +16,26 @@ type Config struct {
 	mock.Mock
 }
 
+// BridgeCircuitBreaker provides a mock function with given fields:
+func (_m *Config) BridgeCircuitBreaker() config.BridgeCircuitBreaker {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreaker")
+	}
+
+	var r0 config.BridgeCircuitBreaker
+	if rf, ok := ret.Get(0).(func() config.BridgeCircuitBreaker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.BridgeCircuitBreaker)
+		}
+	}
+
+	return r0
+}
+
 // DefaultHTTPLimit provides a mock function with given fields:
 func (_m *Config) DefaultHTTPLimit() int64 {
 	ret := _m.Called()
@@ -33,18 +55,18 @@ func (_m *Config) DefaultHTTPLimit() int64 {
 }
 
 // DefaultHTTPTimeout provides a mock function with given fields:
-func (_m *Config) DefaultHTTPTimeout() config.Duration {
+func (_m *Config) DefaultHTTPTimeout() pkgconfig.Duration {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for DefaultHTTPTimeout")
 	}
 
-	var r0 config.Duration
-	if rf, ok := ret.Get(0).(func() config.Duration); ok {
+	var r0 pkgconfig.Duration
+	if rf, ok := ret.Get(0).(func() pkgconfig.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(config.Duration)
+		r0 = ret.Get(0).(pkgconfig.Duration)
 	}
 
 	return r0
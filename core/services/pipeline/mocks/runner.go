@@ -5,7 +5,10 @@ package mocks
 import (
 	context "context"
 
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
 	logger "github.com/smartcontractkit/chainlink/v2/core/logger"
+
 	mock "github.com/stretchr/testify/mock"
 
 	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -20,6 +23,26 @@ type Runner struct {
 	mock.Mock
 }
 
+// BridgeCircuitBreakers provides a mock function with given fields:
+func (_m *Runner) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakers")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -38,6 +61,11 @@ func (_m *Runner) Close() error {
 	return r0
 }
 
+// Drain provides a mock function with given fields:
+func (_m *Runner) Drain() {
+	_m.Called()
+}
+
 // ExecuteAndInsertFinishedRun provides a mock function with given fields: ctx, spec, vars, l, saveSuccessfulTaskRuns
 func (_m *Runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (int64, pipeline.FinalResult, error) {
 	ret := _m.Called(ctx, spec, vars, l, saveSuccessfulTaskRuns)
@@ -132,6 +160,24 @@ func (_m *Runner) HealthReport() map[string]error {
 	return r0
 }
 
+// InFlightRuns provides a mock function with given fields:
+func (_m *Runner) InFlightRuns() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InFlightRuns")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // InsertFinishedRun provides a mock function with given fields: run, saveSuccessfulTaskRuns, qopts
 func (_m *Runner) InsertFinishedRun(run *pipeline.Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -223,6 +269,36 @@ func (_m *Runner) Ready() error {
 	return r0
 }
 
+// ReplayRun provides a mock function with given fields: ctx, original, stubbed, l
+func (_m *Runner) ReplayRun(ctx context.Context, original pipeline.Run, stubbed []pipeline.TaskType, l logger.Logger) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, original, stubbed, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, []pipeline.TaskType, logger.Logger) (*pipeline.Run, error)); ok {
+		return rf(ctx, original, stubbed, l)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, []pipeline.TaskType, logger.Logger) *pipeline.Run); ok {
+		r0 = rf(ctx, original, stubbed, l)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Run, []pipeline.TaskType, logger.Logger) error); ok {
+		r1 = rf(ctx, original, stubbed, l)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeRun provides a mock function with given fields: taskID, value, err
 func (_m *Runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(taskID, value, err)
@@ -60,10 +60,14 @@ type Run struct {
 	FinishedAt       null.Time        `json:"finishedAt"`
 	PipelineTaskRuns []TaskRun        `json:"taskRuns"`
 	State            RunStatus        `json:"state"`
+	// ReplayOfRunID is the ID of the run which this run replays, if any.
+	ReplayOfRunID null.Int `json:"replayOfRunID"`
 
 	Pending bool
 	// FailSilently is used to signal that a task with the failEarly flag has failed, and we want to not put this in the db
 	FailSilently bool
+	// Stubs are the results of the tasks, by dot ID, which are not executed by the run.
+	Stubs map[string]Result `json:"-" db:"-"`
 }
 
 func (r Run) GetID() string {
@@ -286,11 +286,30 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 	return
 }
 
+// DeleteRunsQuery wraps deleteRuns, a statement which deletes pipeline runs and returns their ids, in a query which
+// also deletes their task runs and flux monitor round stats, unsets the replayed run of their replays, and selects the
+// number of deleted runs.
+//
+// pipeline_runs is partitioned, so it cannot be referenced by foreign keys, and every deletion of runs must go through
+// this query to not leave orphaned rows behind, apart from the deletion of runs with their pipeline specs, which the
+// delete_pipeline_spec_runs trigger cleans up after.
+func DeleteRunsQuery(deleteRuns string) string {
+	return `WITH deleted_runs AS (
+` + deleteRuns + `
+), deleted_task_runs AS (
+	DELETE FROM pipeline_task_runs WHERE pipeline_run_id IN (SELECT id FROM deleted_runs)
+), deleted_round_stats AS (
+	DELETE FROM flux_monitor_round_stats_v2 WHERE pipeline_run_id IN (SELECT id FROM deleted_runs)
+), unset_replays AS (
+	UPDATE pipeline_runs SET replay_of_run_id = NULL WHERE replay_of_run_id IN (SELECT id FROM deleted_runs)
+)
+SELECT count(*) FROM deleted_runs`
+}
+
 // DeleteRun cleans up a run that failed and is marked failEarly (should leave no trace of the run)
 func (o *orm) DeleteRun(id int64) error {
-	// NOTE: this will cascade and wipe pipeline_task_runs too
-	_, err := o.q.Exec(`DELETE FROM pipeline_runs WHERE id = $1`, id)
-	return err
+	var deleted int64
+	return o.q.Get(&deleted, DeleteRunsQuery(`DELETE FROM pipeline_runs WHERE id = $1 RETURNING id`), id)
 }
 
 func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error) {
@@ -421,8 +440,8 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 
 	q := o.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
-		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, replay_of_run_id)
+		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :replay_of_run_id)
 		RETURNING id;`
 
 		query, args, e := tx.BindNamed(sql, run)
@@ -465,28 +484,19 @@ func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration)
 	rowsDeleted := int64(0)
 
 	err := pg.Batch(func(_, limit uint) (count uint, err error) {
-		result, cancel, err := q.ExecQIter(`
-WITH batched_pipeline_runs AS (
-	SELECT * FROM pipeline_runs
+		var rowsAffected int64
+		err = q.Get(&rowsAffected, DeleteRunsQuery(`DELETE FROM pipeline_runs WHERE id IN (
+	SELECT id FROM pipeline_runs
 	WHERE finished_at < ($1)
 	ORDER BY finished_at ASC
 	LIMIT $2
-)
-DELETE FROM pipeline_runs
-USING batched_pipeline_runs
-WHERE pipeline_runs.id = batched_pipeline_runs.id`,
+) RETURNING id`),
 			queryThreshold,
 			limit,
 		)
-		defer cancel()
 		if err != nil {
 			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to delete old pipeline_runs")
 		}
-
-		rowsAffected, err := result.RowsAffected()
-		if err != nil {
-			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to get rows affected")
-		}
 		rowsDeleted += rowsAffected
 
 		return uint(rowsAffected), err
@@ -672,21 +682,17 @@ func (o *orm) Prune(tx pg.Queryer, pipelineSpecID int32) {
 }
 
 func (o *orm) execPrune(q pg.Queryer, pipelineSpecID int32) {
-	res, err := q.ExecContext(o.ctx, `DELETE FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = $2 AND id NOT IN (
+	var rowsAffected int64
+	err := q.GetContext(o.ctx, &rowsAffected, DeleteRunsQuery(`DELETE FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = $2 AND id NOT IN (
 SELECT id FROM pipeline_runs
 WHERE pipeline_spec_id = $1 AND state = $2
 ORDER BY id DESC
 LIMIT $3
-)`, pipelineSpecID, RunStatusCompleted, o.maxSuccessfulRuns)
+) RETURNING id`), pipelineSpecID, RunStatusCompleted, o.maxSuccessfulRuns)
 	if err != nil {
 		o.lggr.Errorw("Failed to prune runs", "err", err, "pipelineSpecID", pipelineSpecID)
 		return
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		o.lggr.Errorw("Failed to get RowsAffected while pruning runs", "err", err, "pipelineSpecID", pipelineSpecID)
-		return
-	}
 	if rowsAffected == 0 {
 		// check the spec still exists and garbage collect if necessary
 		var exists bool
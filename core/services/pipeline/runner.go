@@ -2,22 +2,29 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -50,15 +57,33 @@ type Runner interface {
 	// Note that the spec MUST have a DOT graph for this to work.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
+	// ReplayRun executes the pipeline of a finished run again with the inputs of the run, and saves the replay, linked
+	// to the run, with all its task runs. The tasks of the stubbed types are not executed: their results are those of
+	// the run, if it saved them, or else nil.
+	ReplayRun(ctx context.Context, original Run, stubbed []TaskType, l logger.Logger) (*Run, error)
+
 	OnRunFinished(func(*Run))
+
+	// BridgeCircuitBreakers returns the circuit breakers tracking the health of the bridges requested by bridge tasks.
+	BridgeCircuitBreakers() *bridges.CircuitBreakers
+
+	// Drain stops the runner from accepting new runs, which then fail with ErrDraining. Runs in progress, and runs
+	// resumed after their async tasks complete, are not affected. It cannot be undone.
+	Drain()
+	// InFlightRuns returns the number of runs in progress.
+	InFlightRuns() int
 }
 
+// ErrDraining is returned for new runs once the runner is draining.
+var ErrDraining = errors.New("pipeline runner is draining, no new runs are accepted")
+
 type runner struct {
 	services.StateMachine
 	orm                    ORM
 	btORM                  bridges.ORM
 	config                 Config
 	bridgeConfig           BridgeConfig
+	bridgeBreakers         *bridges.CircuitBreakers
 	legacyEVMChains        legacyevm.LegacyChainContainer
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
@@ -67,6 +92,9 @@ type runner struct {
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
 
+	draining atomic.Bool
+	inFlight atomic.Int64
+
 	// test helper
 	runFinished func(*Run)
 
@@ -110,6 +138,7 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, l
 		btORM:                  btORM,
 		config:                 cfg,
 		bridgeConfig:           bridgeCfg,
+		bridgeBreakers:         bridges.NewCircuitBreakers(cfg.BridgeCircuitBreaker(), lggr),
 		legacyEVMChains:        legacyChains,
 		ethKeyStore:            ethks,
 		vrfKeyStore:            vrfks,
@@ -213,6 +242,30 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+func (r *runner) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	return r.bridgeBreakers
+}
+
+func (r *runner) Drain() {
+	if r.draining.CompareAndSwap(false, true) {
+		r.lggr.Infow("Draining, no new runs are accepted", "inFlightRuns", r.InFlightRuns())
+	}
+}
+
+func (r *runner) InFlightRuns() int {
+	return int(r.inFlight.Load())
+}
+
+// startRun counts a run in flight. New runs are rejected once the runner is draining, while resumed runs are let
+// through since they were started before. The returned func must be called once the run is done.
+func (r *runner) startRun(resumed bool) (done func(), err error) {
+	if !resumed && r.draining.Load() {
+		return nil, ErrDraining
+	}
+	r.inFlight.Add(1)
+	return func() { r.inFlight.Add(-1) }, nil
+}
+
 // github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 var overtime = 100 * time.Millisecond
 
@@ -232,6 +285,12 @@ func (r *runner) ExecuteRun(
 	vars Vars,
 	l logger.Logger,
 ) (*Run, TaskRunResults, error) {
+	done, err := r.startRun(false)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer done()
+
 	// Pipeline runs may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
 	var cancel func()
@@ -284,6 +343,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
 			task.(*BridgeTask).orm = r.btORM
+			task.(*BridgeTask).breakers = r.bridgeBreakers
 			task.(*BridgeTask).specId = spec.ID
 			// URL is "safe" because it comes from the node's own database. We
 			// must use the unrestrictedHTTPClient because some node operators
@@ -318,9 +378,14 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) TaskRunResults {
-	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
+	ctx = logctx.WithJobID(ctx, run.PipelineSpec.JobID)
+	l = l.With(logctx.Fields(ctx)...).With("jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	ctx, span := tracing.Start(ctx, "pipeline.run", attribute.String("jobName", run.PipelineSpec.JobName),
+		attribute.String("jobType", run.PipelineSpec.JobType))
+	defer span.End()
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -338,7 +403,12 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		taskRun := taskRun
 		// execute
 		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			var result TaskRunResult
+			if stub, ok := run.Stubs[taskRun.task.DotID()]; ok {
+				result = stubTaskRunResult(taskRun, stub)
+			} else {
+				result = r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			}
 
 			logTaskRunToPrometheus(result, run.PipelineSpec)
 
@@ -414,6 +484,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
+			span.SetStatus(codes.Error, "run errored")
 			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
 		} else {
 			run.State = RunStatusCompleted
@@ -468,7 +539,10 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	ctx, span := tracing.Start(ctx, "pipeline.task", attribute.String("taskName", taskRun.task.DotID()),
+		attribute.String("taskType", string(taskRun.task.Type())), attribute.Int("attempt", int(taskRun.attempts)))
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	tracing.End(span, result.Error)
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -537,7 +611,79 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 
 }
 
+func (r *runner) ReplayRun(ctx context.Context, original Run, stubbed []TaskType, l logger.Logger) (*Run, error) {
+	if !original.FinishedAt.Valid {
+		return nil, pkgerrors.Errorf("run %d is not finished", original.ID)
+	}
+	inputs, ok := original.Inputs.Val.(map[string]interface{})
+	if !ok {
+		return nil, pkgerrors.Errorf("run %d has no captured inputs", original.ID)
+	}
+
+	done, err := r.startRun(false)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	// the spec of the original run, which was loaded from the database, is never pre-initialized
+	spec := original.PipelineSpec
+	spec.Pipeline = nil
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := NewVarsFrom(inputs)
+	run := NewRun(spec, vars)
+	run.ReplayOfRunID = null.IntFrom(original.ID)
+	for _, task := range p.Tasks {
+		if !slices.Contains(stubbed, task.Type()) {
+			continue
+		}
+		if run.Stubs == nil {
+			run.Stubs = make(map[string]Result)
+		}
+		var stub Result
+		if tr := original.ByDotID(task.DotID()); tr != nil {
+			stub.Value = tr.Output.Val
+			if tr.Error.Valid {
+				stub.Error = errors.New(tr.Error.String)
+			}
+		}
+		run.Stubs[task.DotID()] = stub
+	}
+
+	r.run(ctx, p, run, vars, l)
+	if run.Pending {
+		return nil, pkgerrors.Errorf("unexpected async run replaying run %d", original.ID)
+	}
+	if err = r.orm.InsertFinishedRun(run, true); err != nil {
+		return nil, pkgerrors.Wrapf(err, "error inserting the replay of run %d", original.ID)
+	}
+	return run, nil
+}
+
+// stubTaskRunResult returns the result of taskRun, which is stubbed instead of executed.
+func stubTaskRunResult(taskRun *memoryTaskRun, stub Result) TaskRunResult {
+	now := time.Now()
+	return TaskRunResult{
+		ID:         taskRun.task.Base().uuid,
+		Task:       taskRun.task,
+		Result:     stub,
+		CreatedAt:  now,
+		FinishedAt: null.TimeFrom(now),
+		Attempts:   taskRun.attempts,
+	}
+}
+
 func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(tx pg.Queryer) error) (incomplete bool, err error) {
+	done, err := r.startRun(run.ID != 0)
+	if err != nil {
+		return false, err
+	}
+	defer done()
+
 	pipeline, err := r.InitializePipeline(run.PipelineSpec)
 	if err != nil {
 		return false, err
@@ -982,3 +982,63 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_Drain(t *testing.T) {
+	ctx := testutils.Context(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, lggr, nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `succeed [type=memo value=1]`}
+	vars := pipeline.NewVarsFrom(nil)
+
+	_, trrs, err := r.ExecuteRun(ctx, spec, vars, lggr)
+	require.NoError(t, err)
+	require.Len(t, trrs, 1)
+	assert.Zero(t, r.InFlightRuns())
+
+	r.Drain()
+
+	_, _, err = r.ExecuteRun(ctx, spec, vars, lggr)
+	require.ErrorIs(t, err, pipeline.ErrDraining)
+	run := pipeline.NewRun(spec, vars)
+	_, err = r.Run(ctx, run, lggr, false, nil)
+	require.ErrorIs(t, err, pipeline.ErrDraining)
+	assert.Zero(t, r.InFlightRuns())
+}
+
+func Test_PipelineRunner_ReplayRun(t *testing.T) {
+	ctx := testutils.Context(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, lggr, nil, nil)
+
+	original := pipeline.Run{
+		ID: 1,
+		PipelineSpec: pipeline.Spec{DotDagSource: `
+value [type=jsonparse data="$(foo)" path="a"]
+tx    [type=ethtx to="0x0000000000000000000000000000000000000001" data="0x"]
+value -> tx
+`},
+		Inputs:     pipeline.JSONSerializable{Val: map[string]interface{}{"foo": `{"a": "bar"}`}, Valid: true},
+		FinishedAt: null.TimeFrom(time.Now()),
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "value", Output: pipeline.JSONSerializable{Val: "bar", Valid: true}},
+			{DotID: "tx", Output: pipeline.JSONSerializable{Val: "0xabc", Valid: true}},
+		},
+	}
+	orm.On("InsertFinishedRun", mock.Anything, true).Return(nil).Once()
+
+	run, err := r.ReplayRun(ctx, original, []pipeline.TaskType{pipeline.TaskTypeETHTx}, lggr)
+	require.NoError(t, err)
+	assert.Equal(t, null.IntFrom(1), run.ReplayOfRunID)
+	assert.False(t, run.HasErrors())
+	require.NotNil(t, run.ByDotID("value"))
+	assert.Equal(t, "bar", run.ByDotID("value").Output.Val)
+	require.NotNil(t, run.ByDotID("tx"))
+	assert.Equal(t, "0xabc", run.ByDotID("tx").Output.Val)
+
+	_, err = r.ReplayRun(ctx, pipeline.Run{ID: 2}, nil, lggr)
+	require.EqualError(t, err, "run 2 is not finished")
+}
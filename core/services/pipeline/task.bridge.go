@@ -48,6 +48,12 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeSchemaErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_schema_errors_total",
+		Help: "Bridge responses not matching the response schema of the bridge, scoped by name",
+	},
+		[]string{"name"},
+	)
 )
 
 // Return types:
@@ -65,6 +71,7 @@ type BridgeTask struct {
 
 	specId       int32
 	orm          bridges.ORM
+	breakers     *bridges.CircuitBreakers
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
@@ -108,10 +115,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bridge, err := t.getBridgeFromName(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bridge.URL)
 
 	var metaMap MapParam
 
@@ -165,8 +173,19 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
+	// Bridges whose circuit breaker is open fail fast, without falling back to the cache, so that they are quickly
+	// excluded from aggregation.
+	if err = t.breakers.Allow(bridge.Name); err != nil {
+		lggr.Debugw("Bridge task: bridge is unhealthy, skipping request",
+			"err", err,
+			"url", url.String(),
+		)
+		return Result{Error: err}, runInfo
+	}
+
 	var cachedResponse bool
 	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	t.breakers.Record(bridge.Name, elapsed, err)
 	if err != nil {
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
@@ -209,6 +228,19 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
+	// Responses are validated and coerced against the response schema of the bridge, if any, before being cached
+	// and passed on.
+	responseBytes, err = bridge.ResponseSchema.Apply(responseBytes)
+	if err != nil {
+		promBridgeSchemaErrors.WithLabelValues(t.Name).Inc()
+		lggr.Warnw("Bridge task: response does not match the response schema of the bridge",
+			"err", err,
+			"url", url.String(),
+			"cached", cachedResponse,
+		)
+		return Result{Error: err}, runInfo
+	}
+
 	if !cachedResponse && cacheTTL > 0 {
 		err := t.orm.UpsertBridgeResponse(t.dotID, t.specId, responseBytes)
 		if err != nil {
@@ -234,12 +266,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+func (t BridgeTask) getBridgeFromName(name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
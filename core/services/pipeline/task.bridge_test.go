@@ -23,6 +23,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -1016,3 +1017,100 @@ func TestBridgeTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestBridgeTask_ResponseSchema(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	schema := bridges.ResponseSchema{{Path: "data.result", Type: bridges.ResponseFieldDecimal}}
+
+	for _, tc := range []struct {
+		name     string
+		response string
+		exp      string
+		err      string
+	}{
+		{"coerced", `{"data":{"result":"9700.5"}}`, `{"data":{"result":9700.5}}`, ""},
+		{"changed shape", `{"data":{"price":9700.5}}`, "", "bridge response does not match its schema: field data.result: missing"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, err := io.WriteString(w, tc.response)
+				require.NoError(t, err)
+			}))
+			defer s.Close()
+
+			orm := bridgesMocks.NewORM(t)
+			orm.On("FindBridge", bridges.BridgeName("adapter")).Return(bridges.BridgeType{
+				Name:           "adapter",
+				URL:            cltest.WebURL(t, s.URL),
+				ResponseSchema: schema,
+			}, nil)
+
+			task := pipeline.BridgeTask{
+				BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+				Name:        "adapter",
+				RequestData: btcUSDPairing,
+			}
+			task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.False(t, runInfo.IsRetryable)
+			if tc.err != "" {
+				require.Error(t, result.Error)
+				assert.True(t, errors.Is(result.Error, bridges.ErrResponseSchema))
+				assert.EqualError(t, result.Error, tc.err)
+				return
+			}
+			require.NoError(t, result.Error)
+			assert.JSONEq(t, tc.exp, result.Value.(string))
+		})
+	}
+}
+
+func TestBridgeTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.BridgeCircuitBreaker.Enabled = ptr(true)
+		c.JobPipeline.BridgeCircuitBreaker.FailureThreshold = ptr[uint32](2)
+		c.JobPipeline.BridgeCircuitBreaker.OpenTimeout = commonconfig.MustNewDuration(time.Hour)
+	})
+
+	var requests atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer s.Close()
+
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", bridges.BridgeName("adapter")).Return(bridges.BridgeType{
+		Name: "adapter",
+		URL:  cltest.WebURL(t, s.URL),
+	}, nil)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        "adapter",
+		RequestData: btcUSDPairing,
+	}
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+
+	for i := 0; i < 2; i++ {
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.False(t, errors.Is(result.Error, bridges.ErrCircuitOpen))
+	}
+
+	// The bridge is unhealthy, so the task fails without requesting it.
+	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	assert.True(t, errors.Is(result.Error, bridges.ErrCircuitOpen))
+	assert.False(t, runInfo.IsRetryable)
+	assert.Equal(t, int32(2), requests.Load())
+}
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/multierr"
 
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -101,6 +102,8 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
 		return Result{Error: err}, runInfo
 	}
+	ctx = logctx.WithChainID(ctx, string(chainID))
+	lggr = lggr.With(logctx.ChainID, string(chainID))
 
 	var selectedGas uint32
 	if gasUnlimited {
@@ -0,0 +1,61 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/verifier"
+)
+
+// MercuryVerifyTask verifies the signatures of a full Mercury (Data Streams)
+// report against the onchain signing addresses of the DON, and decodes it.
+//
+// Return types:
+//
+//	map[string]interface{} with the feedID, version, configDigest, epoch,
+//	round and signers of the report, and its decoded fields under "report"
+type MercuryVerifyTask struct {
+	BaseTask `mapstructure:",squash"`
+	Report   string `json:"report"`
+	Signers  string `json:"signers"`
+	F        string `json:"f"`
+}
+
+var _ Task = (*MercuryVerifyTask)(nil)
+
+func (t *MercuryVerifyTask) Type() TaskType {
+	return TaskTypeMercuryVerify
+}
+
+func (t *MercuryVerifyTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		report  BytesParam
+		signers AddressSliceParam
+		f       Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&report, From(VarExpr(t.Report, vars), NonemptyString(t.Report), Input(inputs, 0))), "report"),
+		errors.Wrap(ResolveParam(&signers, From(VarExpr(t.Signers, vars), JSONWithVarExprs(t.Signers, vars, false))), "signers"),
+		errors.Wrap(ResolveParam(&f, From(VarExpr(t.F, vars), NonemptyString(t.F))), "f"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if len(signers) == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "signers must not be empty")}, runInfo
+	}
+
+	r, err := verifier.Verify(report, signers, int(f))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to verify report")}, runInfo
+	}
+	return Result{Value: r.ToMap()}, runInfo
+}
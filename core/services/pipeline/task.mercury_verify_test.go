@@ -0,0 +1,95 @@
+package pipeline_test
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
+	v1types "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/v1/types"
+)
+
+func TestMercuryVerifyTask(t *testing.T) {
+	t.Parallel()
+
+	feedID := [32]byte{0, 1, 2, 3}
+	report, err := v1types.GetSchema().Pack(feedID, uint32(42), big.NewInt(100), big.NewInt(99), big.NewInt(101), uint64(5), [32]byte{}, uint64(4), uint64(1000))
+	require.NoError(t, err)
+	reportCtx := ocrtypes.ReportContext{ReportTimestamp: ocrtypes.ReportTimestamp{Epoch: 1, Round: 2}}
+
+	keys := []ocr2key.KeyBundle{
+		ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM),
+		ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM),
+	}
+	var sigs []ocrtypes.AttributedOnchainSignature
+	var signers []interface{}
+	for _, k := range keys {
+		sig, err2 := k.Sign(reportCtx, report)
+		require.NoError(t, err2)
+		sigs = append(sigs, ocrtypes.AttributedOnchainSignature{Signature: sig})
+		signers = append(signers, common.BytesToAddress(k.PublicKey()).Hex())
+	}
+	fullReport := hexutil.Encode(mercury.BuildSamplePayload(report, reportCtx, sigs))
+
+	t.Run("verifies and decodes the report", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"report":  fullReport,
+			"signers": signers,
+		})
+		task := pipeline.MercuryVerifyTask{
+			BaseTask: pipeline.NewBaseTask(0, "verify", nil, nil, 0),
+			Report:   "$(report)",
+			Signers:  "$(signers)",
+			F:        "1",
+		}
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsPending)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+
+		out := result.Value.(map[string]interface{})
+		assert.Equal(t, hexutil.Encode(feedID[:]), out["feedID"])
+		assert.Equal(t, uint16(1), out["version"])
+		assert.Equal(t, uint32(1), out["epoch"])
+		assert.Equal(t, uint8(2), out["round"])
+		assert.Len(t, out["signers"], 2)
+		fields := out["report"].(map[string]interface{})
+		assert.Equal(t, hexutil.Encode(feedID[:]), fields["feedId"])
+		assert.Equal(t, big.NewInt(100), fields["benchmarkPrice"])
+	})
+
+	t.Run("errors if the report is not signed by the signers", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"report": fullReport,
+		})
+		task := pipeline.MercuryVerifyTask{
+			BaseTask: pipeline.NewBaseTask(0, "verify", nil, nil, 0),
+			Report:   "$(report)",
+			Signers:  `["0x0000000000000000000000000000000000000001"]`,
+			F:        "1",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.ErrorContains(t, result.Error, "unauthorized signer")
+	})
+
+	t.Run("errors without signers", func(t *testing.T) {
+		task := pipeline.MercuryVerifyTask{
+			BaseTask: pipeline.NewBaseTask(0, "verify", nil, nil, 0),
+			F:        "1",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: fullReport}})
+		require.ErrorContains(t, result.Error, "signers")
+	})
+}
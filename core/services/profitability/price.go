@@ -0,0 +1,67 @@
+package profitability
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/aggregator_v3_interface"
+)
+
+// priceTTL is how long the price of a feed is cached, so that it is not read for every transmission.
+const priceTTL = time.Minute
+
+// PriceSource provides the price of LINK in the native token of a chain.
+type PriceSource interface {
+	// LinkNativePrice returns the price of 1 LINK in the native token.
+	LinkNativePrice(ctx context.Context) (decimal.Decimal, error)
+}
+
+type feedPriceSource struct {
+	feed aggregator_v3_interface.AggregatorV3InterfaceInterface
+	now  func() time.Time
+
+	mu        sync.Mutex
+	decimals  *int32
+	price     decimal.Decimal
+	updatedAt time.Time
+}
+
+// NewFeedPriceSource returns a PriceSource reading the price from the LINK/native price feed at address.
+func NewFeedPriceSource(address common.Address, backend bind.ContractBackend) (PriceSource, error) {
+	feed, err := aggregator_v3_interface.NewAggregatorV3Interface(address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create price feed: %w", err)
+	}
+	return &feedPriceSource{feed: feed, now: time.Now}, nil
+}
+
+func (s *feedPriceSource) LinkNativePrice(ctx context.Context) (decimal.Decimal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.updatedAt.IsZero() && s.now().Sub(s.updatedAt) < priceTTL {
+		return s.price, nil
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	if s.decimals == nil {
+		d, err := s.feed.Decimals(opts)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("failed to get decimals of price feed: %w", err)
+		}
+		decimals := int32(d)
+		s.decimals = &decimals
+	}
+	round, err := s.feed.LatestRoundData(opts)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get latest round of price feed: %w", err)
+	}
+	s.price = decimal.NewFromBigInt(round.Answer, -*s.decimals)
+	s.updatedAt = s.now()
+	return s.price, nil
+}
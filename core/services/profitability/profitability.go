@@ -0,0 +1,135 @@
+// Package profitability skips the transmissions of jobs whose estimated gas cost exceeds the payment expected for
+// them, converting the cost from the native token to LINK with a LINK/native price feed.
+package profitability
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+
+	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var promSkippedUnprofitable = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "transmissions_skipped_unprofitable",
+	Help: "The number of transmissions which were skipped because their estimated gas cost exceeded the maximum cost for their expected payment",
+}, []string{"jobID"})
+
+const defaultMaxCostPercent = 100
+
+// Config configures the profitability check of the transmissions of a job.
+type Config struct {
+	// LinkNativeFeed is the address of the LINK/native price feed, whose answer is the price of 1 LINK in the native
+	// token of the chain.
+	LinkNativeFeed common.Address `json:"linkNativeFeed" toml:"linkNativeFeed"`
+	// ExpectedPayment is the payment expected for a transmission.
+	ExpectedPayment *commonassets.Link `json:"expectedPayment" toml:"expectedPayment"`
+	// MaxCostPercent is the maximum estimated cost of a transmission, as a percentage of its expected payment. It
+	// defaults to 100, so that only the transmissions which would cost more than they pay are skipped.
+	MaxCostPercent uint32 `json:"maxCostPercent,omitempty" toml:"maxCostPercent"`
+	// TransmitUnprofitable overrides the check, so that unprofitable transmissions are logged but not skipped, e.g. to
+	// evaluate the thresholds of a job before enforcing them.
+	TransmitUnprofitable bool `json:"transmitUnprofitable,omitempty" toml:"transmitUnprofitable"`
+}
+
+func (c Config) Validate() error {
+	if c.LinkNativeFeed == (common.Address{}) {
+		return errors.New("linkNativeFeed is required")
+	}
+	if c.ExpectedPayment == nil {
+		return errors.New("expectedPayment is required")
+	}
+	if c.ExpectedPayment.ToInt().Sign() < 0 {
+		return errors.New("expectedPayment must not be negative")
+	}
+	return nil
+}
+
+// MaxCost returns the maximum cost of a transmission.
+func (c Config) MaxCost() *commonassets.Link {
+	pct := c.MaxCostPercent
+	if pct == 0 {
+		pct = defaultMaxCostPercent
+	}
+	maxCost := new(big.Int).Mul(c.ExpectedPayment.ToInt(), big.NewInt(int64(pct)))
+	return (*commonassets.Link)(maxCost.Div(maxCost, big.NewInt(100)))
+}
+
+// Value returns this instance serialized for database storage.
+func (c *Config) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return json.Marshal(c)
+}
+
+// Scan reads the database value and returns an instance.
+func (c *Config) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("unable to convert %v of %T to Config", value, value)
+	}
+	return json.Unmarshal(b, c)
+}
+
+// Checker checks the profitability of the transmissions of a job.
+type Checker struct {
+	cfg     Config
+	prices  PriceSource
+	lggr    logger.SugaredLogger
+	skipped prometheus.Counter
+}
+
+// NewChecker returns a Checker of the transmissions of the job with jobID, whose costs are converted to LINK with
+// prices.
+func NewChecker(cfg Config, prices PriceSource, jobID int32, lggr logger.Logger) *Checker {
+	return &Checker{
+		cfg:     cfg,
+		prices:  prices,
+		lggr:    logger.Sugared(lggr.Named("Profitability")),
+		skipped: promSkippedUnprofitable.WithLabelValues(strconv.FormatInt(int64(jobID), 10)),
+	}
+}
+
+// Allow returns false if a transmission using gas at gasPrice should be skipped, because its estimated cost exceeds
+// the maximum cost of the job. Transmissions are allowed if their cost cannot be estimated, e.g. because the price
+// feed is unavailable, so that the check never stops a job by itself.
+func (c *Checker) Allow(ctx context.Context, gas uint64, gasPrice *assets.Wei) bool {
+	price, err := c.prices.LinkNativePrice(ctx)
+	if err != nil {
+		c.lggr.Warnw("Failed to get LINK/native price, transmitting regardless of profitability", "err", err)
+		return true
+	}
+	if !price.IsPositive() {
+		c.lggr.Warnw("Invalid LINK/native price, transmitting regardless of profitability", "price", price)
+		return true
+	}
+
+	costWei := new(big.Int).Mul(gasPrice.ToInt(), new(big.Int).SetUint64(gas))
+	cost := (*commonassets.Link)(decimal.NewFromBigInt(costWei, 0).Div(price).Ceil().BigInt())
+	maxCost := c.cfg.MaxCost()
+	if cost.Cmp(maxCost) <= 0 {
+		return true
+	}
+
+	lggr := c.lggr.With("gas", gas, "gasPrice", gasPrice, "cost", cost, "maxCost", maxCost, "expectedPayment", c.cfg.ExpectedPayment, "linkNativePrice", price)
+	if c.cfg.TransmitUnprofitable {
+		lggr.Infow("Transmission is unprofitable, transmitting regardless since transmitUnprofitable is set")
+		return true
+	}
+	lggr.Infow("Skipping unprofitable transmission: estimated cost exceeds max cost")
+	c.skipped.Inc()
+	return false
+}
@@ -0,0 +1,149 @@
+package profitability
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/aggregator_v3_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type fixedPrice struct {
+	price decimal.Decimal
+	err   error
+}
+
+func (f fixedPrice) LinkNativePrice(context.Context) (decimal.Decimal, error) { return f.price, f.err }
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	feed := testutils.NewAddress()
+	require.NoError(t, Config{LinkNativeFeed: feed, ExpectedPayment: commonassets.NewLinkFromJuels(1)}.Validate())
+	require.ErrorContains(t, Config{ExpectedPayment: commonassets.NewLinkFromJuels(1)}.Validate(), "linkNativeFeed is required")
+	require.ErrorContains(t, Config{LinkNativeFeed: feed}.Validate(), "expectedPayment is required")
+	require.ErrorContains(t, Config{LinkNativeFeed: feed, ExpectedPayment: commonassets.NewLinkFromJuels(-1)}.Validate(), "expectedPayment must not be negative")
+}
+
+func TestConfig_MaxCost(t *testing.T) {
+	t.Parallel()
+
+	payment := commonassets.NewLinkFromJuels(1000)
+	assert.Equal(t, "1000", Config{ExpectedPayment: payment}.MaxCost().String())
+	assert.Equal(t, "800", Config{ExpectedPayment: payment, MaxCostPercent: 80}.MaxCost().String())
+	assert.Equal(t, "1500", Config{ExpectedPayment: payment, MaxCostPercent: 150}.MaxCost().String())
+}
+
+func TestChecker_Allow(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	// 1 LINK is worth 0.005 native, so a transmission using 100k gas at 10 gwei costs 0.001 native, or 0.2 LINK.
+	price := fixedPrice{price: decimal.RequireFromString("0.005")}
+	gasPrice := assets.GWei(10)
+	cfg := Config{LinkNativeFeed: testutils.NewAddress(), ExpectedPayment: commonassets.NewLinkFromJuels(300_000_000_000_000_000)}
+
+	t.Run("profitable", func(t *testing.T) {
+		c := NewChecker(cfg, price, 1, logger.TestLogger(t))
+		assert.True(t, c.Allow(ctx, 100_000, gasPrice))
+		assert.Equal(t, float64(0), testutil.ToFloat64(c.skipped))
+	})
+
+	t.Run("unprofitable", func(t *testing.T) {
+		c := NewChecker(cfg, price, 2, logger.TestLogger(t))
+		assert.False(t, c.Allow(ctx, 200_000, gasPrice))
+		assert.Equal(t, float64(1), testutil.ToFloat64(c.skipped))
+	})
+
+	t.Run("above max cost percent", func(t *testing.T) {
+		cfg := cfg
+		cfg.MaxCostPercent = 50
+		c := NewChecker(cfg, price, 3, logger.TestLogger(t))
+		assert.False(t, c.Allow(ctx, 100_000, gasPrice))
+		assert.Equal(t, float64(1), testutil.ToFloat64(c.skipped))
+	})
+
+	t.Run("transmit unprofitable", func(t *testing.T) {
+		cfg := cfg
+		cfg.TransmitUnprofitable = true
+		c := NewChecker(cfg, price, 4, logger.TestLogger(t))
+		assert.True(t, c.Allow(ctx, 200_000, gasPrice))
+		assert.Equal(t, float64(0), testutil.ToFloat64(c.skipped))
+	})
+
+	t.Run("price unavailable", func(t *testing.T) {
+		c := NewChecker(cfg, fixedPrice{err: errors.New("rpc down")}, 5, logger.TestLogger(t))
+		assert.True(t, c.Allow(ctx, 200_000, gasPrice))
+		c = NewChecker(cfg, fixedPrice{price: decimal.Zero}, 5, logger.TestLogger(t))
+		assert.True(t, c.Allow(ctx, 200_000, gasPrice))
+		assert.Equal(t, float64(0), testutil.ToFloat64(c.skipped))
+	})
+}
+
+type fakeFeed struct {
+	aggregator_v3_interface.AggregatorV3InterfaceInterface
+	answer *big.Int
+	calls  int
+}
+
+func (f *fakeFeed) Decimals(*bind.CallOpts) (uint8, error) { return 18, nil }
+
+func (f *fakeFeed) LatestRoundData(*bind.CallOpts) (aggregator_v3_interface.LatestRoundData, error) {
+	f.calls++
+	return aggregator_v3_interface.LatestRoundData{Answer: f.answer}, nil
+}
+
+func TestFeedPriceSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	feed := &fakeFeed{answer: big.NewInt(5_000_000_000_000_000)}
+	now := time.Now()
+	s := &feedPriceSource{feed: feed, now: func() time.Time { return now }}
+
+	price, err := s.LinkNativePrice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "0.005", price.String())
+
+	// the price is cached
+	feed.answer = big.NewInt(6_000_000_000_000_000)
+	price, err = s.LinkNativePrice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "0.005", price.String())
+	assert.Equal(t, 1, feed.calls)
+
+	now = now.Add(priceTTL)
+	price, err = s.LinkNativePrice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "0.006", price.String())
+	assert.Equal(t, 2, feed.calls)
+}
+
+func TestConfig_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{LinkNativeFeed: common.HexToAddress("0x1"), ExpectedPayment: commonassets.NewLinkFromJuels(42), MaxCostPercent: 90}
+	v, err := cfg.Value()
+	require.NoError(t, err)
+	var got Config
+	require.NoError(t, got.Scan(v))
+	assert.Equal(t, *cfg, got)
+
+	v, err = (*Config)(nil).Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
@@ -0,0 +1,80 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// ChainWriter submits transactions to contracts, so that product plugins can write to any chain family without chain
+// specific code. It mirrors the ChainReader: plugins refer to contracts and methods by chain agnostic names, which the
+// relayer of each chain family maps to its own contracts and encoding with the config the ChainWriter was created with.
+type ChainWriter interface {
+	services.Service
+
+	// SubmitTransaction encodes args for method of contractName, and submits it as a transaction to toAddress with
+	// value. transactionID identifies the transaction, so that submitting it again does not send it twice, and its
+	// status can be queried.
+	SubmitTransaction(ctx context.Context, contractName, method string, args any, transactionID string, toAddress string, value *big.Int) error
+	// GetTransactionStatus returns the status of the transaction submitted as transactionID.
+	GetTransactionStatus(ctx context.Context, transactionID string) (TransactionStatus, error)
+}
+
+// TransactionStatus is the status of a transaction submitted with a ChainWriter.
+type TransactionStatus int
+
+const (
+	// Unknown means that the transaction was not found.
+	Unknown TransactionStatus = iota
+	// Pending means that the transaction was not broadcast yet.
+	Pending
+	// Unconfirmed means that the transaction was broadcast, but not included in a block yet.
+	Unconfirmed
+	// Confirmed means that the transaction was included in a block.
+	Confirmed
+	// Fatal means that the transaction can never be included, e.g. it was rejected by the chain.
+	Fatal
+)
+
+func (s TransactionStatus) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Unconfirmed:
+		return "unconfirmed"
+	case Confirmed:
+		return "confirmed"
+	case Fatal:
+		return "fatal"
+	default:
+		return "unknown"
+	}
+}
+
+// ChainWriterRelayer is implemented by the relayers which support writes. config is the chain family specific config
+// of the ChainWriter, mapping the chain agnostic contract and method names to its contracts.
+type ChainWriterRelayer interface {
+	NewChainWriter(ctx context.Context, config []byte) (ChainWriter, error)
+}
+
+// ErrChainWriterUnsupported is returned for relayers which do not implement ChainWriterRelayer, including the ones
+// running in a LOOPP until writes are supported over GRPC.
+var ErrChainWriterUnsupported = errors.New("relayer does not support ChainWriter")
+
+// NewChainWriter returns a ChainWriter of relayer configured with config, or ErrChainWriterUnsupported if relayer does
+// not support writes.
+func NewChainWriter(ctx context.Context, relayer any, config []byte) (ChainWriter, error) {
+	r, ok := relayer.(ChainWriterRelayer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrChainWriterUnsupported, relayer)
+	}
+	return r.NewChainWriter(ctx, config)
+}
+
+// NewChainWriter creates a ChainWriter with the adapted relayer, if it supports writes.
+func (r *ServerAdapter) NewChainWriter(ctx context.Context, config []byte) (ChainWriter, error) {
+	return NewChainWriter(ctx, r.RelayerAdapter.Relayer, config)
+}
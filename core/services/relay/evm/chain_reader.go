@@ -2,57 +2,192 @@ package evm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
 
 type ChainReaderService interface {
 	services.ServiceCtx
 	commontypes.ChainReader
+	// GetLatestEvents returns the newest limit decoded events of the event read readName of contractName, newest first.
+	GetLatestEvents(ctx context.Context, contractName, readName string, limit int) ([]ChainReaderEvent, error)
+	// GetEventsAfterSequence returns the decoded events of the event read readName of contractName whose sequence
+	// field is greater than after, or all of them if after is nil, ordered by sequence.
+	GetEventsAfterSequence(ctx context.Context, contractName, readName string, after *big.Int) ([]ChainReaderEvent, error)
 }
 
+var _ ChainReaderService = (*chainReader)(nil)
+
 type chainReader struct {
 	lggr       logger.Logger
 	contractID common.Address
+	config     types.ChainReaderConfig
 	lp         logpoller.LogPoller
+	proxy      *proxyResolver
+
+	stopCh commonservices.StopChan
+	wg     sync.WaitGroup
 }
 
-// NewChainReaderService constructor for ChainReader
-func NewChainReaderService(lggr logger.Logger, lp logpoller.LogPoller, contractID common.Address, config types.ChainReaderConfig) (*chainReader, error) {
+// bytecodeVerificationTimeout bounds how long fetching the bytecode of the contract may take.
+const bytecodeVerificationTimeout = 30 * time.Second
+
+// NewChainReaderService constructor for ChainReader. If config.VerifyBytecode is set, the configured methods and events
+// are checked against the bytecode deployed at contractID, fetched with client. If ImplementationABIs are
+// configured, the implementation of contractID is tracked across upgrades of the proxy, to select the ABI to decode with.
+func NewChainReaderService(lggr logger.Logger, lp logpoller.LogPoller, client BytecodeClient, contractID common.Address, config types.ChainReaderConfig) (*chainReader, error) {
 	if err := validateChainReaderConfig(config); err != nil {
 		return nil, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
 	}
+	if config.VerifyBytecode {
+		ctx, cancel := context.WithTimeout(context.Background(), bytecodeVerificationTimeout)
+		defer cancel()
+		if err := verifyChainReaderBytecode(ctx, client, config, contractID); err != nil {
+			return nil, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+		}
+	}
 
 	// TODO BCF-2814 implement initialisation of chain reading definitions and pass them into chainReader
-	return &chainReader{lggr.Named("ChainReader"), contractID, lp}, nil
+	lggr = lggr.Named("ChainReader")
+	cr := &chainReader{
+		lggr:       lggr,
+		contractID: contractID,
+		config:     config,
+		lp:         lp,
+		stopCh:     make(commonservices.StopChan),
+	}
+	if hasImplementationABIs(config) {
+		cr.proxy = newProxyResolver(lggr, client, lp, contractID)
+	}
+	return cr, nil
+}
+
+// hasImplementationABIs returns true if any contract of cfg is read with the ABI of the implementation of its proxy.
+func hasImplementationABIs(cfg types.ChainReaderConfig) bool {
+	for _, reader := range cfg.ChainContractReaders {
+		if len(reader.ImplementationABIs) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 func (cr *chainReader) Name() string { return cr.lggr.Name() }
 
-func (cr *chainReader) initialize() error {
+func (cr *chainReader) initialize(ctx context.Context) error {
 	// Initialize chain reader, start cache polling loop, etc.
-	return nil
+	return cr.registerEventFilter(ctx)
+}
+
+// eventFilterName is the name of the LogPoller filter of the events read by the ChainReader.
+func (cr *chainReader) eventFilterName() string {
+	return logpoller.FilterName("ChainReader", cr.contractID.Hex())
+}
+
+// registerEventFilter registers the LogPoller filter of the configured events, so that they can be read with
+// GetLatestEvents.
+func (cr *chainReader) registerEventFilter(ctx context.Context) error {
+	var sigs evmtypes.HashArray
+	for _, contractName := range sortedKeys(cr.config.ChainContractReaders) {
+		reader := cr.config.ChainContractReaders[contractName]
+		abis := []string{reader.ContractABI}
+		for _, implementationABI := range reader.ImplementationABIs {
+			abis = append(abis, implementationABI)
+		}
+		for _, contractABI := range abis {
+			parsed, err := abi.JSON(strings.NewReader(contractABI))
+			if err != nil {
+				return fmt.Errorf("invalid abi for contract %q: %w", contractName, err)
+			}
+			for _, def := range reader.ChainReaderDefinitions {
+				if def.ReadType != types.Event {
+					continue
+				}
+				if event, ok := parsed.Events[def.ChainSpecificName]; ok && !slices.Contains(sigs, event.ID) {
+					sigs = append(sigs, event.ID)
+				}
+			}
+		}
+	}
+	if len(sigs) == 0 {
+		return nil
+	}
+	return cr.lp.RegisterFilter(logpoller.Filter{
+		Name:      cr.eventFilterName(),
+		EventSigs: sigs,
+		Addresses: evmtypes.AddressArray{cr.contractID},
+	}, pg.WithParentCtx(ctx))
 }
 
 func (cr *chainReader) Start(ctx context.Context) error {
-	if err := cr.initialize(); err != nil {
+	if err := cr.initialize(ctx); err != nil {
+		return fmt.Errorf("Failed to initialize ChainReader: %w", err)
+	}
+	if cr.proxy == nil {
+		return nil
+	}
+	if err := cr.proxy.start(ctx); err != nil {
 		return fmt.Errorf("Failed to initialize ChainReader: %w", err)
 	}
+	cr.wg.Add(1)
+	go cr.refreshProxy()
 	return nil
 }
 
-func (cr *chainReader) Close() error { return nil }
+func (cr *chainReader) refreshProxy() {
+	defer cr.wg.Done()
+	ctx, cancel := cr.stopCh.NewCtx()
+	defer cancel()
+	ticker := time.NewTicker(proxyRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := cr.proxy.refresh(ctx); err != nil {
+				cr.lggr.Warnw("Failed to refresh proxy implementation", "err", err)
+			}
+		}
+	}
+}
+
+func (cr *chainReader) Close() error {
+	close(cr.stopCh)
+	cr.wg.Wait()
+	if cr.proxy == nil {
+		return nil
+	}
+	return cr.proxy.close()
+}
+
+// implementation returns the implementation of the contract, if it is a proxy whose implementations have their own
+// ABIs, or the zero address.
+func (cr *chainReader) implementation() common.Address {
+	if cr.proxy == nil {
+		return common.Address{}
+	}
+	return cr.proxy.Implementation()
+}
 
 func (cr *chainReader) Ready() error { return nil }
 
@@ -60,8 +195,116 @@ func (cr *chainReader) HealthReport() map[string]error {
 	return map[string]error{cr.Name(): nil}
 }
 
+// GetLatestValue decodes the newest event of the event read method of the contract bc.Name into returnVal. Events are
+// filtered by the params of the read in the config, so params is ignored. ErrNotFound is returned if the contract did
+// not emit any matching event, and ErrStaleEvent if the newest one is older than the max staleness of the read. Method
+// reads are not implemented yet.
 func (cr *chainReader) GetLatestValue(ctx context.Context, bc commontypes.BoundContract, method string, params any, returnVal any) error {
-	return commontypes.UnimplementedError("Unimplemented method GetLatestValue called")
+	ctx = logctx.WithBinding(ctx, bc.Name, method)
+	if def, ok := cr.config.ChainContractReaders[bc.Name].ChainReaderDefinitions[method]; ok && def.ReadType != types.Event {
+		return commontypes.UnimplementedError("Unimplemented method GetLatestValue called")
+	}
+	binding, err := newEventBinding(cr.config, bc.Name, method, cr.contractID, cr.implementation())
+	if err != nil {
+		return fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+	}
+	events, err := cr.readEvents(ctx, binding, func(ctx context.Context) ([]ChainReaderEvent, error) {
+		return binding.latestEvents(ctx, cr.lp, logpoller.Unconfirmed, 1)
+	})
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return fmt.Errorf("%w: contract %q did not emit any %s event", ErrNotFound, bc.Name, binding.def.ChainSpecificName)
+	}
+	return binding.decodeInto(events[0], returnVal)
+}
+
+// GetLatestEvents returns the newest limit decoded events of the event read readName of contractName, newest first,
+// so that plugins can reconcile a short recent history rather than only the latest event. Fewer events are returned if
+// the contract did not emit as many. ErrStaleEvent is returned if the newest event is older than the max staleness of
+// the read.
+func (cr *chainReader) GetLatestEvents(ctx context.Context, contractName, readName string, limit int) ([]ChainReaderEvent, error) {
+	ctx = logctx.WithBinding(ctx, contractName, readName)
+	binding, err := newEventBinding(cr.config, contractName, readName, cr.contractID, cr.implementation())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+	}
+	return cr.readEvents(ctx, binding, func(ctx context.Context) ([]ChainReaderEvent, error) {
+		return binding.latestEvents(ctx, cr.lp, logpoller.Unconfirmed, limit)
+	})
+}
+
+// GetEventsAfterSequence returns the decoded events of the event read readName of contractName whose sequence field is
+// greater than after, ordered by sequence. Consumers pass the sequence of the last event they consumed, to consume each
+// event exactly once, or nil to consume them from the start.
+func (cr *chainReader) GetEventsAfterSequence(ctx context.Context, contractName, readName string, after *big.Int) ([]ChainReaderEvent, error) {
+	ctx = logctx.WithBinding(ctx, contractName, readName)
+	binding, err := newEventBinding(cr.config, contractName, readName, cr.contractID, cr.implementation())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+	}
+	return cr.readEvents(ctx, binding, func(ctx context.Context) ([]ChainReaderEvent, error) {
+		return binding.eventsAfterSequence(ctx, cr.lp, logpoller.Unconfirmed, after)
+	})
+}
+
+// readEvents executes read with the budget of binding. ctx is expected to carry the binding fields, which are attached
+// to the failures logged here and passed down to the LogPoller queries.
+func (cr *chainReader) readEvents(ctx context.Context, binding *eventBinding, read func(context.Context) ([]ChainReaderEvent, error)) ([]ChainReaderEvent, error) {
+	events, err := readWithBudget(ctx, binding.def, read)
+	if err != nil {
+		logctx.Logger(ctx, cr.lggr).Debugw("Failed to read events", "event", binding.def.ChainSpecificName, "err", err)
+	}
+	return events, err
+}
+
+// ErrNotFound is returned by GetLatestValue if no event matches its read.
+var ErrNotFound = errors.New("not found")
+
+// ErrStaleEvent is returned by event reads whose newest matching log is older than the MaxStaleness of their definition.
+var ErrStaleEvent = errors.New("newest event is stale")
+
+// checkStaleness returns ErrStaleEvent if an event emitted at blockTime is older than the MaxStaleness of def at now.
+func checkStaleness(def types.ChainReaderDefinition, blockTime time.Time, now time.Time) error {
+	if def.MaxStaleness == nil {
+		return nil
+	}
+	if age := now.Sub(blockTime); age > def.MaxStaleness.Duration() {
+		return fmt.Errorf("%w: %s was emitted %s ago, at %s, more than the max staleness of %s", ErrStaleEvent, def.ChainSpecificName, age.Round(time.Second), blockTime.UTC().Format(time.RFC3339), def.MaxStaleness)
+	}
+	return nil
+}
+
+// readWithBudget executes read with the timeout of def, and retries it up to def.Retries times, as long as the
+// deadline of ctx leaves time for another attempt.
+func readWithBudget[T any](ctx context.Context, def types.ChainReaderDefinition, read func(context.Context) (T, error)) (v T, err error) {
+	var timeout time.Duration
+	if def.Timeout != nil {
+		timeout = def.Timeout.Duration()
+	}
+	for attempt := uint32(0); ; attempt++ {
+		v, err = readAttempt(ctx, timeout, read)
+		if err == nil || attempt == def.Retries || ctx.Err() != nil {
+			break
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+			break
+		}
+	}
+	if err != nil && def.Retries > 0 {
+		return v, fmt.Errorf("%w (retries: %d)", err, def.Retries)
+	}
+	return v, err
+}
+
+func readAttempt[T any](ctx context.Context, timeout time.Duration, read func(context.Context) (T, error)) (T, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return read(ctx)
 }
 
 func validateChainReaderConfig(cfg types.ChainReaderConfig) error {
@@ -70,22 +313,12 @@ func validateChainReaderConfig(cfg types.ChainReaderConfig) error {
 	}
 
 	for contractName, chainContractReader := range cfg.ChainContractReaders {
-		abi, err := abi.JSON(strings.NewReader(chainContractReader.ContractABI))
-		if err != nil {
-			return fmt.Errorf("invalid abi: %w", err)
+		if err := validateChainContractReader(contractName, chainContractReader.ContractABI, chainContractReader); err != nil {
+			return err
 		}
-
-		for chainReadingDefinitionName, chainReaderDefinition := range chainContractReader.ChainReaderDefinitions {
-			switch chainReaderDefinition.ReadType {
-			case types.Method:
-				err = validateMethods(abi, chainReaderDefinition)
-			case types.Event:
-				err = validateEvents(abi, chainReaderDefinition)
-			default:
-				return fmt.Errorf("%w: invalid chainreading definition read type: %d for contract: %q", commontypes.ErrInvalidConfig, chainReaderDefinition.ReadType, contractName)
-			}
-			if err != nil {
-				return fmt.Errorf("%w: invalid chainreading definition: %q for contract: %q, err: %w", commontypes.ErrInvalidConfig, chainReadingDefinitionName, contractName, err)
+		for implementation, implementationABI := range chainContractReader.ImplementationABIs {
+			if err := validateChainContractReader(contractName, implementationABI, chainContractReader); err != nil {
+				return fmt.Errorf("implementation %s: %w", implementation, err)
 			}
 		}
 	}
@@ -93,6 +326,28 @@ func validateChainReaderConfig(cfg types.ChainReaderConfig) error {
 	return nil
 }
 
+func validateChainContractReader(contractName string, contractABI string, chainContractReader types.ChainContractReader) error {
+	abi, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		return fmt.Errorf("invalid abi: %w", err)
+	}
+
+	for chainReadingDefinitionName, chainReaderDefinition := range chainContractReader.ChainReaderDefinitions {
+		switch chainReaderDefinition.ReadType {
+		case types.Method:
+			err = validateMethods(abi, chainReaderDefinition)
+		case types.Event:
+			err = validateEvents(abi, chainReaderDefinition)
+		default:
+			return fmt.Errorf("%w: invalid chainreading definition read type: %d for contract: %q", commontypes.ErrInvalidConfig, chainReaderDefinition.ReadType, contractName)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: invalid chainreading definition: %q for contract: %q, err: %w", commontypes.ErrInvalidConfig, chainReadingDefinitionName, contractName, err)
+		}
+	}
+	return nil
+}
+
 func validateEvents(contractABI abi.ABI, chainReaderDefinition types.ChainReaderDefinition) error {
 	event, methodExists := contractABI.Events[chainReaderDefinition.ChainSpecificName]
 	if !methodExists {
@@ -100,11 +355,26 @@ func validateEvents(contractABI abi.ABI, chainReaderDefinition types.ChainReader
 	}
 
 	var abiEventIndexedInputs []abi.Argument
+	sequenceFieldFound := false
 	for _, eventInput := range event.Inputs {
+		if eventInput.Name == chainReaderDefinition.SequenceField {
+			if !eventInput.Indexed || eventInput.Type.T != abi.UintTy {
+				return fmt.Errorf("sequence field: %s must be an indexed uint input", chainReaderDefinition.SequenceField)
+			}
+			if _, ok := chainReaderDefinition.Params[eventInput.Name]; ok {
+				return fmt.Errorf("sequence field: %s cannot be a param", chainReaderDefinition.SequenceField)
+			}
+			sequenceFieldFound = true
+			// the events are not filtered by their sequence, so it is not a param
+			continue
+		}
 		if eventInput.Indexed {
 			abiEventIndexedInputs = append(abiEventIndexedInputs, eventInput)
 		}
 	}
+	if chainReaderDefinition.SequenceField != "" && !sequenceFieldFound {
+		return fmt.Errorf("sequence field: %s doesn't exist", chainReaderDefinition.SequenceField)
+	}
 
 	var chainReaderEventParams []string
 	for chainReaderEventParam := range chainReaderDefinition.Params {
@@ -126,6 +396,12 @@ func validateMethods(abi abi.ABI, chainReaderDefinition types.ChainReaderDefinit
 	if !methodExists {
 		return fmt.Errorf("method: %q doesn't exist", chainReaderDefinition.ChainSpecificName)
 	}
+	if chainReaderDefinition.MaxStaleness != nil {
+		return fmt.Errorf("maxStaleness is only supported by event reads")
+	}
+	if chainReaderDefinition.SequenceField != "" {
+		return fmt.Errorf("sequenceField is only supported by event reads")
+	}
 
 	var methodNames []string
 	for methodName := range chainReaderDefinition.Params {
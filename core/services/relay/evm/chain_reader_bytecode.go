@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// BytecodeClient is the subset of the EVM client used to fetch deployed bytecode.
+type BytecodeClient interface {
+	ProxyClient
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+var (
+	// eip1167Prefix and eip1167Suffix surround the implementation address in the code of EIP-1167 minimal proxies.
+	eip1167Prefix = common.FromHex("0x363d3d373d3d3d363d73")
+	eip1167Suffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
+)
+
+// maxProxyDepth bounds how many proxies are followed to the implementation.
+const maxProxyDepth = 3
+
+// verifyChainReaderBytecode checks that the methods and events of cfg exist in the bytecode deployed at address, and
+// returns an error listing those which do not. EIP-1967, beacon and EIP-1167 proxies are followed to their
+// implementation.
+//
+// Contracts dispatch methods by comparing the call data to their selectors, and emit events with their topic, so both
+// are pushed as constants in the bytecode. This is a heuristic: a missing constant means the config does not match
+// the contract, but a present one does not guarantee that it does.
+func verifyChainReaderBytecode(ctx context.Context, client BytecodeClient, cfg evmtypes.ChainReaderConfig, address common.Address) error {
+	code, err := fetchBytecode(ctx, client, address)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, contractName := range sortedKeys(cfg.ChainContractReaders) {
+		reader := cfg.ChainContractReaders[contractName]
+		contractABI, err := abi.JSON(strings.NewReader(reader.ContractABI))
+		if err != nil {
+			return fmt.Errorf("invalid abi for contract %q: %w", contractName, err)
+		}
+		for _, readName := range sortedKeys(reader.ChainReaderDefinitions) {
+			def := reader.ChainReaderDefinitions[readName]
+			switch def.ReadType {
+			case evmtypes.Method:
+				method := contractABI.Methods[def.ChainSpecificName]
+				if !containsPushed(code, method.ID) {
+					missing = append(missing, fmt.Sprintf("method %q (selector %s) of read %q", method.Sig, hexutil.Encode(method.ID), readName))
+				}
+			case evmtypes.Event:
+				event := contractABI.Events[def.ChainSpecificName]
+				if event.Anonymous {
+					continue
+				}
+				if !containsPushed(code, event.ID.Bytes()) {
+					missing = append(missing, fmt.Sprintf("event %q (topic %s) of read %q", event.Sig, event.ID, readName))
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("contract at %s does not implement: %s", address, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// fetchBytecode returns the code deployed at address, followed by the code of its implementation if it is a proxy.
+func fetchBytecode(ctx context.Context, client BytecodeClient, address common.Address) ([]byte, error) {
+	var code []byte
+	for i := 0; i < maxProxyDepth; i++ {
+		c, err := client.CodeAt(ctx, address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch code of %s: %w", address, err)
+		}
+		if len(c) == 0 {
+			return nil, fmt.Errorf("no contract is deployed at %s", address)
+		}
+		code = append(code, c...)
+
+		impl, err := proxyImplementation(ctx, client, address, c)
+		if err != nil {
+			return nil, err
+		}
+		if impl == (common.Address{}) {
+			break
+		}
+		address = impl
+	}
+	return code, nil
+}
+
+// proxyImplementation returns the implementation of a proxy with code deployed at address, or the zero address if it
+// is not a proxy.
+func proxyImplementation(ctx context.Context, client BytecodeClient, address common.Address, code []byte) (common.Address, error) {
+	if len(code) == len(eip1167Prefix)+common.AddressLength+len(eip1167Suffix) &&
+		bytes.HasPrefix(code, eip1167Prefix) && bytes.HasSuffix(code, eip1167Suffix) {
+		return common.BytesToAddress(code[len(eip1167Prefix) : len(eip1167Prefix)+common.AddressLength]), nil
+	}
+	return resolveProxyImplementation(ctx, client, address)
+}
+
+// containsPushed returns true if code pushes value as a constant. Compilers push values with the smallest PUSH
+// opcode, so leading zero bytes are left out.
+func containsPushed(code []byte, value []byte) bool {
+	value = bytes.TrimLeft(value, "\x00")
+	if len(value) == 0 {
+		return true
+	}
+	// PUSH1 is 0x60, and PUSHn is 0x5f+n
+	push := append([]byte{byte(0x5f + len(value))}, value...)
+	return bytes.Contains(code, push)
+}
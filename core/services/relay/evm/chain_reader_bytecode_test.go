@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+const bytecodeTestABI = `[
+	{"type":"function","name":"latestAnswer","inputs":[],"outputs":[{"type":"int256"}],"stateMutability":"view"},
+	{"type":"event","name":"AnswerUpdated","inputs":[{"name":"current","type":"int256","indexed":true}],"anonymous":false}
+]`
+
+type storageKey struct {
+	address common.Address
+	slot    common.Hash
+}
+
+type fakeBytecodeClient struct {
+	code    map[common.Address][]byte
+	storage map[storageKey]common.Hash
+	// beacons maps beacons to the implementation they return
+	beacons map[common.Address]common.Address
+}
+
+func (c *fakeBytecodeClient) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	return c.code[account], nil
+}
+
+func (c *fakeBytecodeClient) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "eth_getStorageAt" {
+		return errors.New("unexpected method " + method)
+	}
+	*result.(*common.Hash) = c.storage[storageKey{args[0].(common.Address), args[1].(common.Hash)}]
+	return nil
+}
+
+func (c *fakeBytecodeClient) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	impl, ok := c.beacons[*msg.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	return common.BytesToHash(impl.Bytes()).Bytes(), nil
+}
+
+func pushed(b []byte) []byte {
+	return append([]byte{byte(0x5f + len(b))}, b...)
+}
+
+func TestVerifyChainReaderBytecode(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(bytecodeTestABI))
+	require.NoError(t, err)
+	selector := contractABI.Methods["latestAnswer"].ID
+	topic := contractABI.Events["AnswerUpdated"].ID.Bytes()
+
+	cfg := evmtypes.ChainReaderConfig{
+		ChainContractReaders: map[string]evmtypes.ChainContractReader{
+			"Aggregator": {
+				ContractABI: bytecodeTestABI,
+				ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{
+					"LatestAnswer":  {ChainSpecificName: "latestAnswer", ReadType: evmtypes.Method},
+					"AnswerUpdated": {ChainSpecificName: "AnswerUpdated", ReadType: evmtypes.Event},
+				},
+			},
+		},
+	}
+	ctx := testutils.Context(t)
+	address := testutils.NewAddress()
+	impl := testutils.NewAddress()
+	full := append(append([]byte{0x80}, pushed(selector)...), pushed(topic)...)
+
+	t.Run("implemented", func(t *testing.T) {
+		client := &fakeBytecodeClient{code: map[common.Address][]byte{address: full}}
+		require.NoError(t, verifyChainReaderBytecode(ctx, client, cfg, address))
+	})
+
+	t.Run("not deployed", func(t *testing.T) {
+		client := &fakeBytecodeClient{}
+		require.ErrorContains(t, verifyChainReaderBytecode(ctx, client, cfg, address), "no contract is deployed at "+address.String())
+	})
+
+	t.Run("missing method and event", func(t *testing.T) {
+		client := &fakeBytecodeClient{code: map[common.Address][]byte{address: {0x60, 0x80}}}
+		err := verifyChainReaderBytecode(ctx, client, cfg, address)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `method "latestAnswer()" (selector 0x50d25bcd) of read "LatestAnswer"`)
+		assert.Contains(t, err.Error(), `event "AnswerUpdated(int256)"`)
+	})
+
+	t.Run("EIP-1967 proxy", func(t *testing.T) {
+		client := &fakeBytecodeClient{
+			code:    map[common.Address][]byte{address: {0x60, 0x80}, impl: full},
+			storage: map[storageKey]common.Hash{{address, eip1967ImplementationSlot}: common.BytesToHash(impl.Bytes())},
+		}
+		require.NoError(t, verifyChainReaderBytecode(ctx, client, cfg, address))
+	})
+
+	t.Run("EIP-1167 proxy", func(t *testing.T) {
+		clone := append(append(append([]byte{}, eip1167Prefix...), impl.Bytes()...), eip1167Suffix...)
+		client := &fakeBytecodeClient{code: map[common.Address][]byte{address: clone, impl: full}}
+		require.NoError(t, verifyChainReaderBytecode(ctx, client, cfg, address))
+	})
+}
+
+func TestContainsPushed(t *testing.T) {
+	assert.True(t, containsPushed([]byte{0x63, 0x12, 0x34, 0x56, 0x78}, []byte{0x12, 0x34, 0x56, 0x78}))
+	// leading zero bytes are pushed with a smaller opcode
+	assert.True(t, containsPushed([]byte{0x62, 0x34, 0x56, 0x78}, []byte{0x00, 0x34, 0x56, 0x78}))
+	assert.False(t, containsPushed([]byte{0x63, 0x34, 0x56, 0x78}, []byte{0x00, 0x34, 0x56, 0x78}))
+	assert.False(t, containsPushed([]byte{0x12, 0x34, 0x56, 0x78}, []byte{0x12, 0x34, 0x56, 0x78}))
+}
@@ -0,0 +1,263 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// ConformanceStatus is the outcome of executing a single chain reader definition.
+type ConformanceStatus string
+
+const (
+	// ConformancePass means the read was executed and all configured return values were decoded
+	ConformancePass ConformanceStatus = "pass"
+	// ConformanceFail means the read could not be executed or decoded
+	ConformanceFail ConformanceStatus = "fail"
+	// ConformanceNoData means an event read found no events in the lookback window, so decoding could not be checked
+	ConformanceNoData ConformanceStatus = "no data"
+)
+
+// ConformanceResult is the result of executing a single chain reader definition against a live contract.
+type ConformanceResult struct {
+	ContractName string            `json:"contractName"`
+	ReadName     string            `json:"readName"`
+	ReadType     string            `json:"readType"`
+	Status       ConformanceStatus `json:"status"`
+	Error        string            `json:"error,omitempty"`
+	// Values are the decoded sample values, keyed by return value name
+	Values  map[string]any `json:"values,omitempty"`
+	Latency time.Duration  `json:"latency"`
+}
+
+// ConformanceReport is the result of executing every read of a ChainReaderConfig against a live contract.
+type ConformanceReport struct {
+	Address common.Address `json:"address"`
+	// Implementation is the implementation of the contract, if it is an EIP-1967 proxy
+	Implementation *common.Address     `json:"implementation,omitempty"`
+	Results        []ConformanceResult `json:"results"`
+}
+
+// Passed returns true if no read failed.
+func (r ConformanceReport) Passed() bool {
+	for _, res := range r.Results {
+		if res.Status == ConformanceFail {
+			return false
+		}
+	}
+	return true
+}
+
+// ConformanceClient is the subset of the EVM client used to execute reads.
+type ConformanceClient interface {
+	ProxyClient
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// RunChainReaderConformance executes every read configured in cfg against the contract deployed at address, and reports
+// whether each one could be executed and its return values decoded. Reads are encoded and decoded with the same bindings
+// as the ChainReader. Method reads are executed at the latest block, with their configured params as arguments. Event
+// reads decode the most recent matching event within the last eventLookback blocks, and report no data if it is older
+// than their max staleness. Reads are bounded by their configured timeouts, and retried as configured. If
+// implementation ABIs are configured and the contract is an EIP-1967 proxy, the reads are decoded with the ABI of its
+// current implementation.
+//
+// An error is only returned if cfg is invalid; failures of individual reads are recorded in the report.
+func RunChainReaderConformance(ctx context.Context, client ConformanceClient, cfg evmtypes.ChainReaderConfig, address common.Address, eventLookback uint64) (report ConformanceReport, err error) {
+	if err = validateChainReaderConfig(cfg); err != nil {
+		return report, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+	}
+	report.Address = address
+	var implementation common.Address
+	if hasImplementationABIs(cfg) {
+		if implementation, err = resolveProxyImplementation(ctx, client, address); err != nil {
+			return report, err
+		}
+		if implementation != (common.Address{}) {
+			report.Implementation = &implementation
+		}
+	}
+
+	for _, contractName := range sortedKeys(cfg.ChainContractReaders) {
+		reader := cfg.ChainContractReaders[contractName]
+		for _, readName := range sortedKeys(reader.ChainReaderDefinitions) {
+			def := reader.ChainReaderDefinitions[readName]
+			res := ConformanceResult{ContractName: contractName, ReadName: readName}
+			start := time.Now()
+			switch def.ReadType {
+			case evmtypes.Method:
+				res.ReadType = "method"
+				var binding *methodBinding
+				if binding, err = newMethodBinding(cfg, contractName, readName, address, implementation); err != nil {
+					return report, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+				}
+				res.Values, err = readWithBudget(ctx, def, func(ctx context.Context) (map[string]any, error) {
+					return conformanceCallMethod(ctx, client, binding)
+				})
+			case evmtypes.Event:
+				res.ReadType = "event"
+				var binding *eventBinding
+				if binding, err = newEventBinding(cfg, contractName, readName, address, implementation); err != nil {
+					return report, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+				}
+				res.Values, err = readWithBudget(ctx, def, func(ctx context.Context) (map[string]any, error) {
+					return conformanceQueryEvent(ctx, client, binding, eventLookback)
+				})
+			}
+			res.Latency = time.Since(start)
+			switch {
+			case errors.Is(err, ErrStaleEvent):
+				res.Status = ConformanceNoData
+				res.Error = err.Error()
+			case err != nil:
+				res.Status = ConformanceFail
+				res.Error = err.Error()
+			case res.Values == nil:
+				res.Status = ConformanceNoData
+			default:
+				res.Status = ConformancePass
+			}
+			report.Results = append(report.Results, res)
+		}
+	}
+	return report, nil
+}
+
+func conformanceCallMethod(ctx context.Context, client ConformanceClient, binding *methodBinding) (map[string]any, error) {
+	data, err := binding.callData()
+	if err != nil {
+		return nil, err
+	}
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &binding.address, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call failed: %w", err)
+	}
+	values := make(map[string]any)
+	if err = binding.decodeInto(output, &values); err != nil {
+		return nil, err
+	}
+	return values, checkReturnValues(values, binding.def.ReturnValues)
+}
+
+func conformanceQueryEvent(ctx context.Context, client ConformanceClient, binding *eventBinding, lookback uint64) (map[string]any, error) {
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch latest block: %w", err)
+	}
+	var from uint64
+	if latest > lookback {
+		from = latest - lookback
+	}
+
+	// the indexed inputs without a param, like the sequence field, match any value
+	topics := [][]common.Hash{{binding.event.ID}}
+	for _, input := range binding.event.Inputs {
+		if input.Indexed {
+			topics = append(topics, nil)
+		}
+	}
+	for topic, want := range binding.filters {
+		topics[topic] = []common.Hash{want}
+	}
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(latest),
+		Addresses: []common.Address{binding.address},
+		Topics:    topics,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("log query failed: %w", err)
+	}
+	if len(logs) == 0 {
+		return nil, nil
+	}
+
+	log := logs[len(logs)-1]
+	if binding.def.MaxStaleness != nil {
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(log.BlockNumber))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch block of the event: %w", err)
+		}
+		if err = checkStaleness(binding.def, time.Unix(int64(header.Time), 0), time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	topicBytes := make([][]byte, len(log.Topics))
+	for i, topic := range log.Topics {
+		topicBytes[i] = topic.Bytes()
+	}
+	event, err := binding.decode(logpoller.Log{
+		BlockNumber: int64(log.BlockNumber),
+		LogIndex:    int64(log.Index),
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		Address:     log.Address,
+		Topics:      topicBytes,
+		Data:        log.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]any)
+	if err = binding.decodeInto(event, &values); err != nil {
+		return nil, err
+	}
+	return values, checkReturnValues(values, binding.def.ReturnValues)
+}
+
+// convertABIParam converts a JSON compatible param to the Go type expected by the abi encoder.
+func convertABIParam(t abi.Type, param any) (any, error) {
+	b, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.New(t.GetType())
+	if err = json.Unmarshal(b, v.Interface()); err != nil {
+		// large integers are usually given as (hex or decimal) strings, to avoid losing precision
+		s, ok := param.(string)
+		if !ok || json.Unmarshal([]byte(s), v.Interface()) != nil {
+			return nil, fmt.Errorf("cannot convert %s to %s: %w", b, t, err)
+		}
+	}
+	return v.Elem().Interface(), nil
+}
+
+func checkReturnValues(values map[string]any, returnValues []string) error {
+	var missing []string
+	for _, name := range returnValues {
+		if _, ok := values[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("return values [%s] not found in decoded output", strings.Join(missing, ","))
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
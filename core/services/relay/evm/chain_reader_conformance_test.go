@@ -0,0 +1,209 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+const conformanceTestABI = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"balance","type":"uint256"}]},
+	{"type":"function","name":"paused","stateMutability":"view","inputs":[],"outputs":[{"name":"paused","type":"bool"}]},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+type conformanceTestClient struct {
+	abi            abi.ABI
+	logs           []types.Log
+	implementation common.Address
+	blockTime      time.Time
+}
+
+func (c *conformanceTestClient) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	method, err := c.abi.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "balanceOf":
+		return method.Outputs.Pack(big.NewInt(42))
+	default:
+		return nil, errors.New("execution reverted")
+	}
+}
+
+func (c *conformanceTestClient) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "eth_getStorageAt" {
+		return errors.New("unexpected method " + method)
+	}
+	if args[1] == eip1967ImplementationSlot {
+		*result.(*common.Hash) = common.BytesToHash(c.implementation.Bytes())
+	}
+	return nil
+}
+
+func (c *conformanceTestClient) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if q.FromBlock.Int64() != 900 || q.ToBlock.Int64() != 1000 {
+		return nil, errors.New("unexpected block range")
+	}
+	// one topic for the signature and each indexed input
+	if len(q.Topics) != 3 || q.Topics[0][0] != c.abi.Events["Transfer"].ID {
+		return nil, errors.New("unexpected topics")
+	}
+	return c.logs, nil
+}
+
+func (c *conformanceTestClient) BlockNumber(context.Context) (uint64, error) {
+	return 1000, nil
+}
+
+func (c *conformanceTestClient) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number, Time: uint64(c.blockTime.Unix())}, nil
+}
+
+func TestRunChainReaderConformance(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.JSON(strings.NewReader(conformanceTestABI))
+	require.NoError(t, err)
+	from, to := testutils.NewAddress(), testutils.NewAddress()
+	data, err := contractABI.Events["Transfer"].Inputs.NonIndexed().Pack(big.NewInt(7))
+	require.NoError(t, err)
+	client := &conformanceTestClient{abi: contractABI, logs: []types.Log{{
+		Topics: []common.Hash{contractABI.Events["Transfer"].ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:   data,
+	}}}
+
+	cfg := evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+		"Token": {
+			ContractABI: conformanceTestABI,
+			ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{
+				"Balance": {
+					ChainSpecificName: "balanceOf",
+					Params:            map[string]any{"owner": from.Hex()},
+					ReturnValues:      []string{"balance"},
+					ReadType:          evmtypes.Method,
+				},
+				"Paused": {
+					ChainSpecificName: "paused",
+					ReadType:          evmtypes.Method,
+				},
+				"Transfers": {
+					ChainSpecificName: "Transfer",
+					Params:            map[string]any{"from": from.Hex(), "to": to.Hex()},
+					ReturnValues:      []string{"from", "value"},
+					ReadType:          evmtypes.Event,
+				},
+			},
+		},
+	}}
+
+	report, err := RunChainReaderConformance(testutils.Context(t), client, cfg, testutils.NewAddress(), 100)
+	require.NoError(t, err)
+	require.Len(t, report.Results, 3)
+	assert.False(t, report.Passed())
+
+	balance := report.Results[0]
+	assert.Equal(t, "Balance", balance.ReadName)
+	assert.Equal(t, ConformancePass, balance.Status)
+	assert.Equal(t, big.NewInt(42), balance.Values["balance"])
+
+	paused := report.Results[1]
+	assert.Equal(t, ConformanceFail, paused.Status)
+	assert.Contains(t, paused.Error, "execution reverted")
+
+	transfers := report.Results[2]
+	assert.Equal(t, ConformancePass, transfers.Status)
+	assert.Equal(t, from, transfers.Values["from"])
+	assert.Equal(t, big.NewInt(7), transfers.Values["value"])
+
+	t.Run("event without data", func(t *testing.T) {
+		client.logs = nil
+		report, err := RunChainReaderConformance(testutils.Context(t), client, evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+			"Token": {ContractABI: conformanceTestABI, ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{"Transfers": cfg.ChainContractReaders["Token"].ChainReaderDefinitions["Transfers"]}},
+		}}, testutils.NewAddress(), 100)
+		require.NoError(t, err)
+		assert.True(t, report.Passed())
+		assert.Equal(t, ConformanceNoData, report.Results[0].Status)
+	})
+
+	t.Run("stale event", func(t *testing.T) {
+		def := cfg.ChainContractReaders["Token"].ChainReaderDefinitions["Transfers"]
+		def.MaxStaleness = commonconfig.MustNewDuration(time.Hour)
+		staleCfg := evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+			"Token": {ContractABI: conformanceTestABI, ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{"Transfers": def}},
+		}}
+		staleClient := &conformanceTestClient{abi: contractABI, logs: []types.Log{{
+			BlockNumber: 950,
+			Topics:      []common.Hash{contractABI.Events["Transfer"].ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:        data,
+		}}, blockTime: time.Now().Add(-2 * time.Hour)}
+
+		report, err := RunChainReaderConformance(testutils.Context(t), staleClient, staleCfg, testutils.NewAddress(), 100)
+		require.NoError(t, err)
+		assert.True(t, report.Passed())
+		assert.Equal(t, ConformanceNoData, report.Results[0].Status)
+		assert.Contains(t, report.Results[0].Error, "newest event is stale: Transfer was emitted 2h0m")
+
+		staleClient.blockTime = time.Now().Add(-time.Minute)
+		report, err = RunChainReaderConformance(testutils.Context(t), staleClient, staleCfg, testutils.NewAddress(), 100)
+		require.NoError(t, err)
+		assert.Equal(t, ConformancePass, report.Results[0].Status)
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		implementation := testutils.NewAddress()
+		proxyClient := &conformanceTestClient{abi: contractABI, implementation: implementation}
+		report, err := RunChainReaderConformance(testutils.Context(t), proxyClient, evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+			"Token": {
+				ContractABI:        `[{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"amount","type":"uint256"}]}]`,
+				ImplementationABIs: map[common.Address]string{implementation: conformanceTestABI},
+				ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{
+					"Balance": cfg.ChainContractReaders["Token"].ChainReaderDefinitions["Balance"],
+				},
+			},
+		}}, testutils.NewAddress(), 100)
+		require.NoError(t, err)
+		require.NotNil(t, report.Implementation)
+		assert.Equal(t, implementation, *report.Implementation)
+		// the return value is only named balance in the ABI of the implementation
+		assert.Equal(t, ConformancePass, report.Results[0].Status)
+	})
+
+	t.Run("proxy without implementation ABIs", func(t *testing.T) {
+		proxyClient := &conformanceTestClient{abi: contractABI, implementation: testutils.NewAddress()}
+		report, err := RunChainReaderConformance(testutils.Context(t), proxyClient, evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+			"Token": {ContractABI: conformanceTestABI, ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{"Balance": cfg.ChainContractReaders["Token"].ChainReaderDefinitions["Balance"]}},
+		}}, testutils.NewAddress(), 100)
+		require.NoError(t, err)
+		// like the ChainReader, the implementation is only resolved to select its ABI
+		assert.Nil(t, report.Implementation)
+		assert.Equal(t, ConformancePass, report.Results[0].Status)
+	})
+
+	t.Run("missing return value", func(t *testing.T) {
+		def := cfg.ChainContractReaders["Token"].ChainReaderDefinitions["Balance"]
+		def.ReturnValues = []string{"amount"}
+		report, err := RunChainReaderConformance(testutils.Context(t), client, evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+			"Token": {ContractABI: conformanceTestABI, ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{"Balance": def}},
+		}}, testutils.NewAddress(), 100)
+		require.NoError(t, err)
+		assert.Equal(t, ConformanceFail, report.Results[0].Status)
+		assert.Contains(t, report.Results[0].Error, "return values [amount] not found")
+	})
+}
@@ -0,0 +1,240 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// EventCursor is the position of an event in the chain, which events are ordered by.
+type EventCursor struct {
+	BlockNumber int64
+	LogIndex    int64
+}
+
+// After returns true if c is after other.
+func (c EventCursor) After(other EventCursor) bool {
+	return c.BlockNumber > other.BlockNumber || (c.BlockNumber == other.BlockNumber && c.LogIndex > other.LogIndex)
+}
+
+// ChainReaderEvent is a decoded event of a ChainReader event read.
+type ChainReaderEvent struct {
+	Cursor    EventCursor
+	TxHash    common.Hash
+	BlockHash common.Hash
+	// Values are the decoded inputs of the event, keyed by name.
+	Values map[string]any
+	// Sequence is the value of the sequence field of the read, if it has one.
+	Sequence *big.Int
+}
+
+// eventScanWindow is the number of blocks the newest events are first looked for in, before looking further back.
+const eventScanWindow = 1000
+
+// eventBinding decodes the logs of an event read of a ChainReaderConfig, emitted by the contract at address.
+type eventBinding struct {
+	address common.Address
+	def     types.ChainReaderDefinition
+	event   abi.Event
+	filters map[int]common.Hash // indexed topics the events must match, by index in the topics of the log
+	// sequenceTopic is the index of the sequence field in the topics of the log, or 0 if the read has none.
+	sequenceTopic int
+	// item decodes the values of events into the return values of GetLatestValue.
+	item *codecItem
+}
+
+// newEventBinding returns the binding of the event read readName of contractName in chainReader, decoding with the ABI
+// of implementation if the contract is a proxy pointing to it.
+func newEventBinding(chainReader types.ChainReaderConfig, contractName, readName string, address, implementation common.Address) (*eventBinding, error) {
+	reader, ok := chainReader.ChainContractReaders[contractName]
+	if !ok {
+		return nil, fmt.Errorf("contract %q is not configured", contractName)
+	}
+	def, ok := reader.ChainReaderDefinitions[readName]
+	if !ok {
+		return nil, fmt.Errorf("read %q of contract %q is not configured", readName, contractName)
+	}
+	if def.ReadType != types.Event {
+		return nil, fmt.Errorf("read %q of contract %q is not an event", readName, contractName)
+	}
+	contractABI, err := implementationABI(reader, implementation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid abi for contract %q: %w", contractName, err)
+	}
+	if err = validateEvents(contractABI, def); err != nil {
+		return nil, err
+	}
+
+	event := contractABI.Events[def.ChainSpecificName]
+	filters := map[int]common.Hash{}
+	sequenceTopic := 0
+	topic := 1
+	for _, input := range event.Inputs {
+		if !input.Indexed {
+			continue
+		}
+		if input.Name == def.SequenceField {
+			sequenceTopic = topic
+		}
+		if param, ok := def.Params[input.Name]; ok {
+			arg, err := convertABIParam(input.Type, param)
+			if err != nil {
+				return nil, fmt.Errorf("param %q: %w", input.Name, err)
+			}
+			topics, err := abi.MakeTopics([]any{arg})
+			if err != nil {
+				return nil, fmt.Errorf("param %q: %w", input.Name, err)
+			}
+			filters[topic] = topics[0][0]
+		}
+		topic++
+	}
+	return &eventBinding{address: address, def: def, event: event, filters: filters, sequenceTopic: sequenceTopic,
+		item: newArgsCodecItem(event.Inputs)}, nil
+}
+
+func (b *eventBinding) matches(log logpoller.Log) bool {
+	topics := log.GetTopics()
+	for i, want := range b.filters {
+		if i >= len(topics) || topics[i] != want {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *eventBinding) decode(log logpoller.Log) (ChainReaderEvent, error) {
+	event := ChainReaderEvent{
+		Cursor:    EventCursor{BlockNumber: log.BlockNumber, LogIndex: log.LogIndex},
+		TxHash:    log.TxHash,
+		BlockHash: log.BlockHash,
+		Values:    make(map[string]any),
+	}
+	if err := b.event.Inputs.NonIndexed().UnpackIntoMap(event.Values, log.Data); err != nil {
+		return event, fmt.Errorf("failed to decode event data: %w", err)
+	}
+	var indexed abi.Arguments
+	for _, input := range b.event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	topics := log.GetTopics()
+	if len(topics) == 0 {
+		return event, errors.New("missing event signature")
+	}
+	if err := abi.ParseTopicsIntoMap(event.Values, indexed, topics[1:]); err != nil {
+		return event, fmt.Errorf("failed to decode event topics: %w", err)
+	}
+	if b.sequenceTopic > 0 {
+		event.Sequence = new(big.Int).SetBytes(topics[b.sequenceTopic].Bytes())
+	}
+	return event, nil
+}
+
+// decodeInto sets into, a pointer to a struct or a map, to the values of event, like the codec decodes items.
+func (b *eventBinding) decodeInto(event ChainReaderEvent, into any) error {
+	values := make([]any, len(b.event.Inputs))
+	for i, input := range b.event.Inputs {
+		values[i] = event.Values[input.Name]
+	}
+	return b.item.decodeValues(values, into)
+}
+
+// latestEvents returns the newest limit matching events with confs confirmations, newest first. The LogPoller is
+// searched backwards from the latest confirmed block, in windows doubling in size, until enough events are found.
+// ErrStaleEvent is returned if the newest event is older than the max staleness of the read.
+func (b *eventBinding) latestEvents(ctx context.Context, lp logpoller.LogPoller, confs logpoller.Confirmations, limit int) ([]ChainReaderEvent, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive, got %d", limit)
+	}
+	latest, err := lp.LatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	var events []ChainReaderEvent
+	end := confirmedBlock(latest, confs)
+	for window := int64(eventScanWindow); end >= 0 && len(events) < limit; window *= 2 {
+		start := max(end-window+1, 0)
+		logs, err := lp.Logs(start, end, b.event.ID, b.address, pg.WithParentCtx(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get logs: %w", err)
+		}
+		for i := len(logs) - 1; i >= 0 && len(events) < limit; i-- {
+			if !b.matches(logs[i]) {
+				continue
+			}
+			if len(events) == 0 {
+				if err = checkStaleness(b.def, logs[i].BlockTimestamp, time.Now()); err != nil {
+					return nil, err
+				}
+			}
+			event, err := b.decode(logs[i])
+			if err != nil {
+				return nil, fmt.Errorf("event in block %d at index %d: %w", logs[i].BlockNumber, logs[i].LogIndex, err)
+			}
+			events = append(events, event)
+		}
+		end = start - 1
+	}
+	return events, nil
+}
+
+// eventsAfterSequence returns the matching events with confs confirmations whose sequence field is greater than after,
+// or all of them if after is nil, ordered by sequence. They are read from the LogPoller with a range query on the topic
+// of the sequence field.
+func (b *eventBinding) eventsAfterSequence(ctx context.Context, lp logpoller.LogPoller, confs logpoller.Confirmations, after *big.Int) ([]ChainReaderEvent, error) {
+	if b.sequenceTopic == 0 {
+		return nil, fmt.Errorf("read of %s has no sequence field", b.def.ChainSpecificName)
+	}
+	from := new(big.Int)
+	if after != nil {
+		if after.Sign() < 0 {
+			return nil, fmt.Errorf("sequence must not be negative, got %s", after)
+		}
+		from.Add(after, big.NewInt(1))
+	}
+	if from.BitLen() > 256 {
+		// no sequence is greater than the max uint256
+		return nil, nil
+	}
+
+	logs, err := lp.IndexedLogsTopicGreaterThan(b.event.ID, b.address, b.sequenceTopic, common.BigToHash(from), confs, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs: %w", err)
+	}
+	events := make([]ChainReaderEvent, 0, len(logs))
+	for _, log := range logs {
+		if !b.matches(log) {
+			continue
+		}
+		event, err := b.decode(log)
+		if err != nil {
+			return nil, fmt.Errorf("event in block %d at index %d: %w", log.BlockNumber, log.LogIndex, err)
+		}
+		events = append(events, event)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Sequence.Cmp(events[j].Sequence) < 0
+	})
+	return events, nil
+}
+
+// confirmedBlock returns the latest block with confs confirmations.
+func confirmedBlock(latest logpoller.LogPollerBlock, confs logpoller.Confirmations) int64 {
+	if confs == logpoller.Finalized {
+		return latest.FinalizedBlockNumber
+	}
+	return latest.BlockNumber - int64(confs)
+}
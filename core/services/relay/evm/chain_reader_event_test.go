@@ -0,0 +1,297 @@
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+func TestChainReader_GetLatestEvents(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.JSON(strings.NewReader(conformanceTestABI))
+	require.NoError(t, err)
+	event := contractABI.Events["Transfer"]
+	from, to, address := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	now := time.Now()
+	newLog := func(block, index int64, to common.Address, value int64) logpoller.Log {
+		data, err := event.Inputs.NonIndexed().Pack(big.NewInt(value))
+		require.NoError(t, err)
+		return logpoller.Log{
+			BlockNumber:    block,
+			LogIndex:       index,
+			BlockTimestamp: now,
+			Address:        address,
+			EventSig:       event.ID,
+			Topics:         [][]byte{event.ID.Bytes(), common.BytesToHash(from.Bytes()).Bytes(), common.BytesToHash(to.Bytes()).Bytes()},
+			Data:           data,
+		}
+	}
+	maxStaleness := commonconfig.MustNewDuration(time.Hour)
+	cfg := evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+		"Token": {
+			ContractABI: conformanceTestABI,
+			ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{
+				"Transfers": {
+					ChainSpecificName: "Transfer",
+					Params:            map[string]any{"from": from.Hex(), "to": to.Hex()},
+					ReadType:          evmtypes.Event,
+					MaxStaleness:      maxStaleness,
+				},
+				"Balance": {ChainSpecificName: "balanceOf", Params: map[string]any{"owner": from.Hex()}, ReadType: evmtypes.Method},
+			},
+		},
+	}}
+
+	lp := lpmocks.NewLogPoller(t)
+	cr, err := NewChainReaderService(logger.TestLogger(t), lp, nil, address, cfg)
+	require.NoError(t, err)
+	ctx := testutils.Context(t)
+
+	t.Run("invalid read", func(t *testing.T) {
+		_, err := cr.GetLatestEvents(ctx, "Token", "Balance", 2)
+		require.ErrorIs(t, err, commontypes.ErrInvalidConfig)
+		require.ErrorContains(t, err, "is not an event")
+		_, err = cr.GetLatestEvents(ctx, "Token", "Transfers", 0)
+		require.ErrorContains(t, err, "limit must be positive")
+	})
+
+	t.Run("newest events", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 2500}, nil).Once()
+		lp.On("Logs", int64(1501), int64(2500), event.ID, address, mock.Anything).Return([]logpoller.Log{
+			newLog(2000, 1, to, 3),
+			newLog(2100, 0, testutils.NewAddress(), 4), // another recipient
+		}, nil).Once()
+		// the window doubles until enough events are found
+		lp.On("Logs", int64(0), int64(1500), event.ID, address, mock.Anything).Return([]logpoller.Log{
+			newLog(10, 0, to, 1),
+			newLog(1000, 2, to, 2),
+		}, nil).Once()
+
+		events, err := cr.GetLatestEvents(ctx, "Token", "Transfers", 2)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, EventCursor{BlockNumber: 2000, LogIndex: 1}, events[0].Cursor)
+		assert.Equal(t, big.NewInt(3), events[0].Values["value"])
+		assert.Equal(t, to, events[0].Values["to"])
+		assert.Equal(t, EventCursor{BlockNumber: 1000, LogIndex: 2}, events[1].Cursor)
+		assert.Equal(t, big.NewInt(2), events[1].Values["value"])
+	})
+
+	t.Run("fewer events than the limit", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 500}, nil).Once()
+		lp.On("Logs", int64(0), int64(500), event.ID, address, mock.Anything).Return([]logpoller.Log{newLog(10, 0, to, 1)}, nil).Once()
+
+		events, err := cr.GetLatestEvents(ctx, "Token", "Transfers", 5)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, big.NewInt(1), events[0].Values["value"])
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		stale := newLog(10, 0, to, 1)
+		stale.BlockTimestamp = now.Add(-2 * time.Hour)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 500}, nil).Once()
+		lp.On("Logs", int64(0), int64(500), event.ID, address, mock.Anything).Return([]logpoller.Log{stale}, nil).Once()
+
+		_, err := cr.GetLatestEvents(ctx, "Token", "Transfers", 5)
+		require.ErrorIs(t, err, ErrStaleEvent)
+	})
+
+	t.Run("log poller failure", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{}, errors.New("db down")).Once()
+
+		_, err := cr.GetLatestEvents(ctx, "Token", "Transfers", 5)
+		require.ErrorContains(t, err, "db down")
+	})
+}
+
+func TestChainReader_GetLatestValue(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.JSON(strings.NewReader(conformanceTestABI))
+	require.NoError(t, err)
+	event := contractABI.Events["Transfer"]
+	from, to, address := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(7))
+	require.NoError(t, err)
+	transfer := logpoller.Log{
+		BlockNumber:    10,
+		BlockTimestamp: time.Now(),
+		Address:        address,
+		EventSig:       event.ID,
+		Topics:         [][]byte{event.ID.Bytes(), common.BytesToHash(from.Bytes()).Bytes(), common.BytesToHash(to.Bytes()).Bytes()},
+		Data:           data,
+	}
+	cfg := evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+		"Token": {
+			ContractABI: conformanceTestABI,
+			ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{
+				"Transfers": {
+					ChainSpecificName: "Transfer",
+					Params:            map[string]any{"from": from.Hex(), "to": to.Hex()},
+					ReadType:          evmtypes.Event,
+					MaxStaleness:      commonconfig.MustNewDuration(time.Hour),
+				},
+				"Balance": {ChainSpecificName: "balanceOf", Params: map[string]any{"owner": from.Hex()}, ReadType: evmtypes.Method},
+			},
+		},
+	}}
+
+	lp := lpmocks.NewLogPoller(t)
+	cr, err := NewChainReaderService(logger.TestLogger(t), lp, nil, address, cfg)
+	require.NoError(t, err)
+	ctx := testutils.Context(t)
+	bc := commontypes.BoundContract{Address: address.Hex(), Name: "Token"}
+
+	t.Run("newest event", func(t *testing.T) {
+		// the binding fields are passed down to the LogPoller, so that its queries can be attributed to the read
+		withBinding := mock.MatchedBy(func(opt pg.QOpt) bool {
+			var q pg.Q
+			opt(&q)
+			return assert.ObjectsAreEqual([]interface{}{logctx.ContractName, "Token", logctx.ReadName, "Transfers"}, logctx.Fields(q.ParentCtx))
+		})
+		lp.On("LatestBlock", withBinding).Return(logpoller.LogPollerBlock{BlockNumber: 500}, nil).Once()
+		lp.On("Logs", int64(0), int64(500), event.ID, address, mock.Anything).Return([]logpoller.Log{transfer}, nil).Once()
+
+		var value struct {
+			From  common.Address
+			To    common.Address
+			Value *big.Int
+		}
+		require.NoError(t, cr.GetLatestValue(ctx, bc, "Transfers", nil, &value))
+		assert.Equal(t, from, value.From)
+		assert.Equal(t, to, value.To)
+		assert.Equal(t, big.NewInt(7), value.Value)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 500}, nil).Once()
+		lp.On("Logs", int64(0), int64(500), event.ID, address, mock.Anything).Return(nil, nil).Once()
+
+		var value map[string]any
+		require.ErrorIs(t, cr.GetLatestValue(ctx, bc, "Transfers", nil, &value), ErrNotFound)
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		stale := transfer
+		stale.BlockTimestamp = time.Now().Add(-2 * time.Hour)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 500}, nil).Once()
+		lp.On("Logs", int64(0), int64(500), event.ID, address, mock.Anything).Return([]logpoller.Log{stale}, nil).Once()
+
+		var value map[string]any
+		require.ErrorIs(t, cr.GetLatestValue(ctx, bc, "Transfers", nil, &value), ErrStaleEvent)
+	})
+
+	t.Run("method read", func(t *testing.T) {
+		var value map[string]any
+		err := cr.GetLatestValue(ctx, bc, "Balance", nil, &value)
+		assert.ErrorIs(t, err, commontypes.UnimplementedError("Unimplemented method GetLatestValue called"))
+	})
+}
+
+func TestChainReader_GetEventsAfterSequence(t *testing.T) {
+	t.Parallel()
+
+	const requestABI = `[{"type":"event","name":"Request","inputs":[{"name":"requestId","type":"uint256","indexed":true},{"name":"requester","type":"address","indexed":true},{"name":"data","type":"bytes","indexed":false}]}]`
+	contractABI, err := abi.JSON(strings.NewReader(requestABI))
+	require.NoError(t, err)
+	event := contractABI.Events["Request"]
+	requester, address := testutils.NewAddress(), testutils.NewAddress()
+	newLog := func(block int64, requestID int64, requester common.Address) logpoller.Log {
+		data, err := event.Inputs.NonIndexed().Pack([]byte{byte(requestID)})
+		require.NoError(t, err)
+		return logpoller.Log{
+			BlockNumber: block,
+			Address:     address,
+			EventSig:    event.ID,
+			Topics:      [][]byte{event.ID.Bytes(), common.BigToHash(big.NewInt(requestID)).Bytes(), common.BytesToHash(requester.Bytes()).Bytes()},
+			Data:        data,
+		}
+	}
+	cfg := evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+		"Coordinator": {
+			ContractABI: requestABI,
+			ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{
+				"Requests": {
+					ChainSpecificName: "Request",
+					Params:            map[string]any{"requester": requester.Hex()},
+					ReadType:          evmtypes.Event,
+					SequenceField:     "requestId",
+				},
+				"AllRequests": {
+					ChainSpecificName: "Request",
+					Params:            map[string]any{"requestId": "1", "requester": requester.Hex()},
+					ReadType:          evmtypes.Event,
+				},
+			},
+		},
+	}}
+
+	lp := lpmocks.NewLogPoller(t)
+	cr, err := NewChainReaderService(logger.TestLogger(t), lp, nil, address, cfg)
+	require.NoError(t, err)
+	ctx := testutils.Context(t)
+
+	t.Run("no sequence field", func(t *testing.T) {
+		_, err := cr.GetEventsAfterSequence(ctx, "Coordinator", "AllRequests", big.NewInt(0))
+		require.ErrorContains(t, err, "has no sequence field")
+	})
+
+	t.Run("events after sequence", func(t *testing.T) {
+		lp.On("IndexedLogsTopicGreaterThan", event.ID, address, 1, common.BigToHash(big.NewInt(6)), logpoller.Unconfirmed, mock.Anything).Return([]logpoller.Log{
+			newLog(10, 7, requester),
+			newLog(11, 9, requester),
+			newLog(11, 8, requester),
+			newLog(12, 10, testutils.NewAddress()), // another requester
+		}, nil).Once()
+
+		events, err := cr.GetEventsAfterSequence(ctx, "Coordinator", "Requests", big.NewInt(5))
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		for i, want := range []int64{7, 8, 9} {
+			assert.Equal(t, big.NewInt(want), events[i].Sequence)
+			assert.Equal(t, big.NewInt(want), events[i].Values["requestId"])
+			assert.Equal(t, []byte{byte(want)}, events[i].Values["data"])
+		}
+	})
+
+	t.Run("from the start", func(t *testing.T) {
+		lp.On("IndexedLogsTopicGreaterThan", event.ID, address, 1, common.Hash{}, logpoller.Unconfirmed, mock.Anything).Return([]logpoller.Log{
+			newLog(10, 2, requester),
+			newLog(9, 1, requester),
+		}, nil).Once()
+
+		events, err := cr.GetEventsAfterSequence(ctx, "Coordinator", "Requests", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, big.NewInt(1), events[0].Sequence)
+		assert.Equal(t, big.NewInt(2), events[1].Sequence)
+	})
+
+	t.Run("after max sequence", func(t *testing.T) {
+		maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+		events, err := cr.GetEventsAfterSequence(ctx, "Coordinator", "Requests", maxUint256)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
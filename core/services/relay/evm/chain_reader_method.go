@@ -0,0 +1,74 @@
+package evm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// methodBinding encodes the calls of a method read of a ChainReaderConfig to the contract at address, and decodes their
+// return values like the codec decodes items.
+type methodBinding struct {
+	address common.Address
+	def     types.ChainReaderDefinition
+	method  abi.Method
+	inputs  *codecItem
+	outputs *codecItem
+}
+
+// newMethodBinding returns the binding of the method read readName of contractName in chainReader, encoding and
+// decoding with the ABI of implementation if the contract is a proxy pointing to it.
+func newMethodBinding(chainReader types.ChainReaderConfig, contractName, readName string, address, implementation common.Address) (*methodBinding, error) {
+	reader, ok := chainReader.ChainContractReaders[contractName]
+	if !ok {
+		return nil, fmt.Errorf("contract %q is not configured", contractName)
+	}
+	def, ok := reader.ChainReaderDefinitions[readName]
+	if !ok {
+		return nil, fmt.Errorf("read %q of contract %q is not configured", readName, contractName)
+	}
+	if def.ReadType != types.Method {
+		return nil, fmt.Errorf("read %q of contract %q is not a method", readName, contractName)
+	}
+	contractABI, err := implementationABI(reader, implementation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid abi for contract %q: %w", contractName, err)
+	}
+	if err = validateMethods(contractABI, def); err != nil {
+		return nil, err
+	}
+	method := contractABI.Methods[def.ChainSpecificName]
+	return &methodBinding{address: address, def: def, method: method,
+		inputs: newArgsCodecItem(method.Inputs), outputs: newArgsCodecItem(method.Outputs)}, nil
+}
+
+// callData returns the calldata of the method, called with the params of the read as arguments.
+func (b *methodBinding) callData() ([]byte, error) {
+	params := b.def.Params
+	if params == nil {
+		params = map[string]any{}
+	}
+	args, err := b.inputs.argumentValues(params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+	data, err := b.method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode call: %w", err)
+	}
+	return append(append([]byte{}, b.method.ID...), data...), nil
+}
+
+// decodeInto sets into, a pointer to a struct or a map, to the return values in output, like the codec decodes items.
+func (b *methodBinding) decodeInto(output []byte, into any) error {
+	values, err := b.method.Outputs.Unpack(output)
+	if err != nil {
+		return fmt.Errorf("%w: failed to decode return values: %w", commontypes.ErrInvalidType, err)
+	}
+	return b.outputs.decodeValues(values, into)
+}
@@ -0,0 +1,213 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// ProxyClient is the subset of the EVM client used to resolve the implementation of proxies.
+type ProxyClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+var (
+	// eip1967ImplementationSlot is the storage slot of the implementation of EIP-1967 proxies.
+	eip1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// eip1967BeaconSlot is the storage slot of the beacon of EIP-1967 beacon proxies.
+	eip1967BeaconSlot = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+	// beaconImplementationSelector is the selector of implementation(), which beacons return their implementation with.
+	beaconImplementationSelector = crypto.Keccak256([]byte("implementation()"))[:4]
+
+	// upgradedEventSig and beaconUpgradedEventSig are emitted by EIP-1967 proxies when they are upgraded, and
+	// upgradedEventSig by beacons too.
+	upgradedEventSig       = crypto.Keccak256Hash([]byte("Upgraded(address)"))
+	beaconUpgradedEventSig = crypto.Keccak256Hash([]byte("BeaconUpgraded(address)"))
+)
+
+// resolveProxyImplementation returns the implementation of the EIP-1967 proxy deployed at address, following its beacon
+// if it is a beacon proxy, or the zero address if it is not a proxy.
+func resolveProxyImplementation(ctx context.Context, client ProxyClient, address common.Address) (common.Address, error) {
+	implementation, _, err := resolveProxy(ctx, client, address)
+	return implementation, err
+}
+
+// resolveProxy returns the implementation of the EIP-1967 proxy deployed at address, and its beacon if it is a beacon
+// proxy, whose upgrades change the implementation without any event of the proxy.
+func resolveProxy(ctx context.Context, client ProxyClient, address common.Address) (implementation, beacon common.Address, err error) {
+	var slot common.Hash
+	if err = client.CallContext(ctx, &slot, "eth_getStorageAt", address, eip1967ImplementationSlot, "latest"); err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to fetch proxy implementation of %s: %w", address, err)
+	}
+	if impl := common.BytesToAddress(slot.Bytes()); impl != (common.Address{}) {
+		return impl, common.Address{}, nil
+	}
+	if err = client.CallContext(ctx, &slot, "eth_getStorageAt", address, eip1967BeaconSlot, "latest"); err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to fetch proxy beacon of %s: %w", address, err)
+	}
+	beacon = common.BytesToAddress(slot.Bytes())
+	if beacon == (common.Address{}) {
+		return common.Address{}, common.Address{}, nil
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &beacon, Data: beaconImplementationSelector}, nil)
+	if err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed to fetch implementation of beacon %s: %w", beacon, err)
+	}
+	if len(out) != common.HashLength {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid implementation of beacon %s: %x", beacon, out)
+	}
+	return common.BytesToAddress(out), beacon, nil
+}
+
+// implementationABI returns the ABI of reader to decode with while its proxy points to implementation.
+func implementationABI(reader evmtypes.ChainContractReader, implementation common.Address) (abi.ABI, error) {
+	contractABI := reader.ContractABI
+	if a, ok := reader.ImplementationABIs[implementation]; ok {
+		contractABI = a
+	}
+	return abi.JSON(strings.NewReader(contractABI))
+}
+
+// proxyRefreshInterval is how often the LogPoller is checked for upgrades of a proxy.
+const proxyRefreshInterval = 15 * time.Second
+
+// proxyResolver tracks the implementation of a proxy, resolving it again whenever the proxy, or its beacon if it is a
+// beacon proxy, emits an upgrade event.
+type proxyResolver struct {
+	lggr    logger.Logger
+	client  ProxyClient
+	lp      logpoller.LogPoller
+	address common.Address
+
+	mu             sync.RWMutex
+	implementation common.Address
+	beacon         common.Address
+	resolved       bool
+	// upgradedAt is the block of the latest upgrade event which was handled.
+	upgradedAt int64
+}
+
+func newProxyResolver(lggr logger.Logger, client ProxyClient, lp logpoller.LogPoller, address common.Address) *proxyResolver {
+	return &proxyResolver{lggr: lggr.Named("ProxyResolver"), client: client, lp: lp, address: address}
+}
+
+func (r *proxyResolver) filterName() string {
+	return logpoller.FilterName("ChainReader proxy upgrades", r.address)
+}
+
+// addresses returns the addresses whose upgrade events change the implementation of the proxy.
+func (r *proxyResolver) addresses() []common.Address {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.beacon == (common.Address{}) {
+		return []common.Address{r.address}
+	}
+	return []common.Address{r.address, r.beacon}
+}
+
+func (r *proxyResolver) registerFilter(ctx context.Context) error {
+	if err := r.lp.RegisterFilter(logpoller.Filter{
+		Name:      r.filterName(),
+		EventSigs: []common.Hash{upgradedEventSig, beaconUpgradedEventSig},
+		Addresses: r.addresses(),
+	}, pg.WithParentCtx(ctx)); err != nil {
+		return fmt.Errorf("failed to register filter of proxy upgrades: %w", err)
+	}
+	return nil
+}
+
+// start registers the filter of upgrade events, and resolves the current implementation. If it cannot be resolved yet,
+// it is retried by refresh.
+func (r *proxyResolver) start(ctx context.Context) error {
+	if err := r.registerFilter(ctx); err != nil {
+		return err
+	}
+	if latest, err := r.lp.LatestBlock(pg.WithParentCtx(ctx)); err == nil {
+		r.mu.Lock()
+		r.upgradedAt = latest.BlockNumber
+		r.mu.Unlock()
+	}
+	if err := r.resolve(ctx); err != nil {
+		r.lggr.Warnw("Failed to resolve proxy implementation, will retry", "proxy", r.address, "err", err)
+	}
+	return nil
+}
+
+func (r *proxyResolver) close() error {
+	return r.lp.UnregisterFilter(r.filterName())
+}
+
+// resolve resolves the implementation of the proxy, and adds its beacon to the filter of upgrade events when it
+// changes.
+func (r *proxyResolver) resolve(ctx context.Context) error {
+	impl, beacon, err := resolveProxy(ctx, r.client, r.address)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.resolved = true
+	if impl != r.implementation {
+		r.lggr.Infow("Resolved proxy implementation", "proxy", r.address, "implementation", impl, "previous", r.implementation)
+		r.implementation = impl
+	}
+	beaconChanged := beacon != r.beacon
+	r.beacon = beacon
+	r.mu.Unlock()
+	if beaconChanged && beacon != (common.Address{}) {
+		if err = r.registerFilter(ctx); err != nil {
+			// resolved again by the next refresh, so that the upgrades of the beacon are not missed
+			r.mu.Lock()
+			r.resolved, r.beacon = false, common.Address{}
+			r.mu.Unlock()
+			return err
+		}
+	}
+	return nil
+}
+
+// refresh resolves the implementation again if the proxy was upgraded since the last refresh, or if it could not be
+// resolved yet.
+func (r *proxyResolver) refresh(ctx context.Context) error {
+	r.mu.RLock()
+	from, resolved := r.upgradedAt+1, r.resolved
+	r.mu.RUnlock()
+	logs, err := r.lp.LatestLogEventSigsAddrsWithConfs(from, []common.Hash{upgradedEventSig, beaconUpgradedEventSig}, r.addresses(), logpoller.Unconfirmed, pg.WithParentCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to query proxy upgrades: %w", err)
+	}
+	if len(logs) == 0 && resolved {
+		return nil
+	}
+	if err = r.resolve(ctx); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, l := range logs {
+		if l.BlockNumber > r.upgradedAt {
+			r.upgradedAt = l.BlockNumber
+		}
+	}
+	return nil
+}
+
+// Implementation returns the current implementation of the proxy, or the zero address if it is not a proxy.
+func (r *proxyResolver) Implementation() common.Address {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.implementation
+}
@@ -0,0 +1,126 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	mocklogpoller "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+func TestResolveProxyImplementation(t *testing.T) {
+	ctx := testutils.Context(t)
+	proxy, beacon, impl := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+
+	t.Run("not a proxy", func(t *testing.T) {
+		got, err := resolveProxyImplementation(ctx, &fakeBytecodeClient{}, proxy)
+		require.NoError(t, err)
+		assert.Equal(t, common.Address{}, got)
+	})
+
+	t.Run("EIP-1967 proxy", func(t *testing.T) {
+		client := &fakeBytecodeClient{storage: map[storageKey]common.Hash{{proxy, eip1967ImplementationSlot}: common.BytesToHash(impl.Bytes())}}
+		got, err := resolveProxyImplementation(ctx, client, proxy)
+		require.NoError(t, err)
+		assert.Equal(t, impl, got)
+	})
+
+	t.Run("beacon proxy", func(t *testing.T) {
+		client := &fakeBytecodeClient{
+			storage: map[storageKey]common.Hash{{proxy, eip1967BeaconSlot}: common.BytesToHash(beacon.Bytes())},
+			beacons: map[common.Address]common.Address{beacon: impl},
+		}
+		got, err := resolveProxyImplementation(ctx, client, proxy)
+		require.NoError(t, err)
+		assert.Equal(t, impl, got)
+	})
+
+	t.Run("beacon reverts", func(t *testing.T) {
+		client := &fakeBytecodeClient{storage: map[storageKey]common.Hash{{proxy, eip1967BeaconSlot}: common.BytesToHash(beacon.Bytes())}}
+		_, err := resolveProxyImplementation(ctx, client, proxy)
+		require.ErrorContains(t, err, "failed to fetch implementation of beacon")
+	})
+}
+
+func TestImplementationABI(t *testing.T) {
+	v1, v2 := testutils.NewAddress(), testutils.NewAddress()
+	reader := evmtypes.ChainContractReader{
+		ContractABI:        `[{"type":"function","name":"v1","inputs":[],"outputs":[]}]`,
+		ImplementationABIs: map[common.Address]string{v2: `[{"type":"function","name":"v2","inputs":[],"outputs":[]}]`},
+	}
+
+	a, err := implementationABI(reader, v1)
+	require.NoError(t, err)
+	assert.Contains(t, a.Methods, "v1")
+
+	a, err = implementationABI(reader, v2)
+	require.NoError(t, err)
+	assert.Contains(t, a.Methods, "v2")
+}
+
+func TestProxyResolver(t *testing.T) {
+	ctx := testutils.Context(t)
+	proxy, v1, v2 := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	client := &fakeBytecodeClient{storage: map[storageKey]common.Hash{{proxy, eip1967ImplementationSlot}: common.BytesToHash(v1.Bytes())}}
+	lp := mocklogpoller.NewLogPoller(t)
+	r := newProxyResolver(logger.TestLogger(t), client, lp, proxy)
+
+	lp.On("RegisterFilter", mock.MatchedBy(func(f logpoller.Filter) bool {
+		return f.Name == r.filterName() && len(f.Addresses) == 1 && f.Addresses[0] == proxy
+	}), mock.Anything).Return(nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 10}, nil).Once()
+	require.NoError(t, r.start(ctx))
+	assert.Equal(t, v1, r.Implementation())
+
+	sigs := []common.Hash{upgradedEventSig, beaconUpgradedEventSig}
+	lp.On("LatestLogEventSigsAddrsWithConfs", int64(11), sigs, []common.Address{proxy}, logpoller.Unconfirmed, mock.Anything).Return(nil, nil).Once()
+	require.NoError(t, r.refresh(ctx))
+	assert.Equal(t, v1, r.Implementation())
+
+	client.storage[storageKey{proxy, eip1967ImplementationSlot}] = common.BytesToHash(v2.Bytes())
+	lp.On("LatestLogEventSigsAddrsWithConfs", int64(11), sigs, []common.Address{proxy}, logpoller.Unconfirmed, mock.Anything).Return([]logpoller.Log{{BlockNumber: 12}}, nil).Once()
+	require.NoError(t, r.refresh(ctx))
+	assert.Equal(t, v2, r.Implementation())
+
+	// upgrades which were handled are not queried again
+	lp.On("LatestLogEventSigsAddrsWithConfs", int64(13), sigs, []common.Address{proxy}, logpoller.Unconfirmed, mock.Anything).Return(nil, nil).Once()
+	require.NoError(t, r.refresh(ctx))
+
+	lp.On("UnregisterFilter", r.filterName(), mock.Anything).Return(nil).Once()
+	require.NoError(t, r.close())
+}
+
+func TestProxyResolver_Beacon(t *testing.T) {
+	ctx := testutils.Context(t)
+	proxy, beacon, v1, v2 := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	client := &fakeBytecodeClient{
+		storage: map[storageKey]common.Hash{{proxy, eip1967BeaconSlot}: common.BytesToHash(beacon.Bytes())},
+		beacons: map[common.Address]common.Address{beacon: v1},
+	}
+	lp := mocklogpoller.NewLogPoller(t)
+	r := newProxyResolver(logger.TestLogger(t), client, lp, proxy)
+
+	lp.On("RegisterFilter", mock.MatchedBy(func(f logpoller.Filter) bool {
+		return len(f.Addresses) == 1 && f.Addresses[0] == proxy
+	}), mock.Anything).Return(nil).Once()
+	// the upgrades of the beacon are watched once it is resolved
+	lp.On("RegisterFilter", mock.MatchedBy(func(f logpoller.Filter) bool {
+		return f.Name == r.filterName() && len(f.Addresses) == 2 && f.Addresses[0] == proxy && f.Addresses[1] == beacon
+	}), mock.Anything).Return(nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 10}, nil).Once()
+	require.NoError(t, r.start(ctx))
+	assert.Equal(t, v1, r.Implementation())
+
+	client.beacons[beacon] = v2
+	sigs := []common.Hash{upgradedEventSig, beaconUpgradedEventSig}
+	lp.On("LatestLogEventSigsAddrsWithConfs", int64(11), sigs, []common.Address{proxy, beacon}, logpoller.Unconfirmed, mock.Anything).Return([]logpoller.Log{{Address: beacon, BlockNumber: 12}}, nil).Once()
+	require.NoError(t, r.refresh(ctx))
+	assert.Equal(t, v2, r.Implementation())
+}
@@ -1,15 +1,24 @@
 package evm
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	mocklogpoller "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -70,19 +79,19 @@ func TestNewChainReader(t *testing.T) {
 		params["param"] = ""
 		chainReaderConfig := chainReaderTestHelper{}.makeChainReaderConfig(contractABI, params)
 		chain.On("LogPoller").Return(lp)
-		_, err := NewChainReaderService(lggr, chain.LogPoller(), contractID, chainReaderConfig)
+		_, err := NewChainReaderService(lggr, chain.LogPoller(), nil, contractID, chainReaderConfig)
 		assert.NoError(t, err)
 	})
 
 	t.Run("invalid config", func(t *testing.T) {
 		invalidChainReaderConfig := chainReaderTestHelper{}.makeChainReaderConfig(contractABI, map[string]any{}) // missing param
-		_, err := NewChainReaderService(lggr, chain.LogPoller(), contractID, invalidChainReaderConfig)
+		_, err := NewChainReaderService(lggr, chain.LogPoller(), nil, contractID, invalidChainReaderConfig)
 		assert.ErrorIs(t, err, commontypes.ErrInvalidConfig)
 	})
 
 	t.Run("ChainReader config is empty", func(t *testing.T) {
 		emptyChainReaderConfig := evmtypes.ChainReaderConfig{}
-		_, err := NewChainReaderService(lggr, chain.LogPoller(), contractID, emptyChainReaderConfig)
+		_, err := NewChainReaderService(lggr, chain.LogPoller(), nil, contractID, emptyChainReaderConfig)
 		assert.ErrorIs(t, err, commontypes.ErrInvalidConfig)
 		assert.ErrorContains(t, err, "no contract readers defined")
 	})
@@ -91,14 +100,31 @@ func TestNewChainReader(t *testing.T) {
 func TestChainReaderStartClose(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	lp := mocklogpoller.NewLogPoller(t)
+	contractID := testutils.NewAddress()
 	cr := chainReader{
-		lggr: lggr,
-		lp:   lp,
+		lggr:   lggr,
+		lp:     lp,
+		proxy:  newProxyResolver(lggr, &fakeBytecodeClient{}, lp, contractID),
+		stopCh: make(commonservices.StopChan),
 	}
+	lp.On("RegisterFilter", mock.Anything, mock.Anything).Return(nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1}, nil).Once()
+	lp.On("UnregisterFilter", cr.proxy.filterName(), mock.Anything).Return(nil).Once()
 	err := cr.Start(testutils.Context(t))
 	assert.NoError(t, err)
 	err = cr.Close()
 	assert.NoError(t, err)
+
+	t.Run("without implementation ABIs", func(t *testing.T) {
+		lp := mocklogpoller.NewLogPoller(t)
+		cr, err := NewChainReaderService(lggr, lp, nil, contractID, evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+			"Token": {ContractABI: conformanceTestABI},
+		}})
+		require.NoError(t, err)
+		assert.Nil(t, cr.proxy)
+		require.NoError(t, cr.Start(testutils.Context(t)))
+		require.NoError(t, cr.Close())
+	})
 }
 
 // TODO Chain Reading Definitions return values are WIP, waiting on codec work and BCF-2789
@@ -152,6 +178,32 @@ func TestValidateChainReaderConfig_HappyPath(t *testing.T) {
 										}`,
 		})
 
+	testCases = append(testCases,
+		testCase{
+			name:     "methodWithTimeoutAndRetries",
+			abiInput: `{"inputs":[],"name":"latestRound","outputs":[{"internalType":"uint80","name":"","type":"uint80"}],"stateMutability":"view","type":"function"}`,
+			chainReadingDefinitions: `"latestRound":{
+											"chainSpecificName": "latestRound",
+											"readType": 0,
+											"timeout": "2s",
+											"retries": 2
+										}`,
+		})
+
+	testCases = append(testCases,
+		testCase{
+			name:     "eventWithSequenceField",
+			abiInput: `{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"requestId","type":"uint256"},{"indexed":true,"internalType":"address","name":"requester","type":"address"}],"name":"Request","type":"event"}`,
+			chainReadingDefinitions: `"Request":{
+											"chainSpecificName": "Request",
+											"params":{
+												"requester": "0x0"
+											},
+											"readType": 1,
+											"sequenceField": "requestId"
+										}`,
+		})
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			cfg, err := chainReaderTestHelper{}.makeChainReaderConfigFromStrings(tc.abiInput, tc.chainReadingDefinitions)
@@ -241,6 +293,88 @@ func TestValidateChainReaderConfig_BadPath(t *testing.T) {
 		},
 	)
 
+	testCases = append(testCases,
+		testCase{
+			name:     "method with max staleness",
+			abiInput: `{"constant":true,"inputs":[],"name":"someName","payable":false,"stateMutability":"view","type":"function"}`,
+			chainReadingDefinitions: `"TestMethod":{
+											"chainSpecificName": "someName",
+											"readType": 0,
+											"maxStaleness": "1h"
+										}`,
+			expected: fmt.Errorf("invalid chainreading definition: \"TestMethod\" for contract: \"testContract\", err: maxStaleness is only supported by event reads"),
+		},
+	)
+
+	testCases = append(testCases,
+		testCase{
+			name:     "sequence field is not indexed",
+			abiInput: `{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"requestId","type":"uint256"},{"indexed":true,"internalType":"int256","name":"delta","type":"int256"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Request","type":"event"}`,
+			chainReadingDefinitions: `"Request":{
+											"chainSpecificName": "Request",
+											"params":{"delta": "0x0"},
+											"readType": 1,
+											"sequenceField": "amount"
+										}`,
+			expected: fmt.Errorf("invalid chainreading definition: \"Request\" for contract: \"testContract\", err: sequence field: amount must be an indexed uint input"),
+		},
+	)
+
+	testCases = append(testCases,
+		testCase{
+			name:     "sequence field is signed",
+			abiInput: `{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"requestId","type":"uint256"},{"indexed":true,"internalType":"int256","name":"delta","type":"int256"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Request","type":"event"}`,
+			chainReadingDefinitions: `"Request":{
+											"chainSpecificName": "Request",
+											"params":{"requestId": "0x0"},
+											"readType": 1,
+											"sequenceField": "delta"
+										}`,
+			expected: fmt.Errorf("invalid chainreading definition: \"Request\" for contract: \"testContract\", err: sequence field: delta must be an indexed uint input"),
+		},
+	)
+
+	testCases = append(testCases,
+		testCase{
+			name:     "sequence field is a param",
+			abiInput: `{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"requestId","type":"uint256"},{"indexed":true,"internalType":"int256","name":"delta","type":"int256"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Request","type":"event"}`,
+			chainReadingDefinitions: `"Request":{
+											"chainSpecificName": "Request",
+											"params":{"requestId": "0x0", "delta": "0x0"},
+											"readType": 1,
+											"sequenceField": "requestId"
+										}`,
+			expected: fmt.Errorf("invalid chainreading definition: \"Request\" for contract: \"testContract\", err: sequence field: requestId cannot be a param"),
+		},
+	)
+
+	testCases = append(testCases,
+		testCase{
+			name:     "sequence field doesn't exist",
+			abiInput: `{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"requestId","type":"uint256"},{"indexed":true,"internalType":"int256","name":"delta","type":"int256"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Request","type":"event"}`,
+			chainReadingDefinitions: `"Request":{
+											"chainSpecificName": "Request",
+											"params":{"requestId": "0x0", "delta": "0x0"},
+											"readType": 1,
+											"sequenceField": "nonce"
+										}`,
+			expected: fmt.Errorf("invalid chainreading definition: \"Request\" for contract: \"testContract\", err: sequence field: nonce doesn't exist"),
+		},
+	)
+
+	testCases = append(testCases,
+		testCase{
+			name:     "method with sequence field",
+			abiInput: `{"constant":true,"inputs":[],"name":"someName","payable":false,"stateMutability":"view","type":"function"}`,
+			chainReadingDefinitions: `"TestMethod":{
+											"chainSpecificName": "someName",
+											"readType": 0,
+											"sequenceField": "id"
+										}`,
+			expected: fmt.Errorf("invalid chainreading definition: \"TestMethod\" for contract: \"testContract\", err: sequenceField is only supported by event reads"),
+		},
+	)
+
 	testCases = append(testCases, testCase{
 		name:     "invalid abi",
 		abiInput: `broken abi`,
@@ -270,3 +404,77 @@ func TestValidateChainReaderConfig_BadPath(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateChainReaderConfig_ImplementationABIs(t *testing.T) {
+	implementation := testutils.NewAddress()
+	cfg := chainReaderTestHelper{}.makeChainReaderConfig(`[{"type":"function","name":"name","inputs":[],"outputs":[]}]`, nil)
+	reader := cfg.ChainContractReaders["MyContract"]
+	reader.ImplementationABIs = map[common.Address]string{implementation: `[{"type":"function","name":"symbol","inputs":[],"outputs":[]}]`}
+	cfg.ChainContractReaders["MyContract"] = reader
+
+	err := validateChainReaderConfig(cfg)
+	assert.ErrorContains(t, err, "implementation "+implementation.String())
+	assert.ErrorContains(t, err, `method: "name" doesn't exist`)
+}
+
+func TestReadWithBudget(t *testing.T) {
+	timeout := commonconfig.MustNewDuration(50 * time.Millisecond)
+	slow := func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+
+	t.Run("timeout", func(t *testing.T) {
+		start := time.Now()
+		_, err := readWithBudget(testutils.Context(t), evmtypes.ChainReaderDefinition{Timeout: timeout}, slow)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		var attempts int
+		v, err := readWithBudget(testutils.Context(t), evmtypes.ChainReaderDefinition{Timeout: timeout, Retries: 2}, func(ctx context.Context) (int, error) {
+			if attempts++; attempts < 3 {
+				return slow(ctx)
+			}
+			return 42, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 42, v)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		var attempts int
+		_, err := readWithBudget(testutils.Context(t), evmtypes.ChainReaderDefinition{Retries: 1}, func(ctx context.Context) (int, error) {
+			attempts++
+			return 0, errors.New("rpc failed")
+		})
+		require.EqualError(t, err, "rpc failed (retries: 1)")
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("no budget left for retries", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 80*time.Millisecond)
+		defer cancel()
+		var attempts int
+		_, err := readWithBudget(ctx, evmtypes.ChainReaderDefinition{Timeout: timeout, Retries: 5}, func(ctx context.Context) (int, error) {
+			attempts++
+			return slow(ctx)
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestCheckStaleness(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	def := evmtypes.ChainReaderDefinition{ChainSpecificName: "Transfer"}
+	require.NoError(t, checkStaleness(def, now.Add(-24*time.Hour), now))
+
+	def.MaxStaleness = commonconfig.MustNewDuration(time.Hour)
+	require.NoError(t, checkStaleness(def, now.Add(-time.Hour), now))
+	err := checkStaleness(def, now.Add(-90*time.Minute), now)
+	require.ErrorIs(t, err, ErrStaleEvent)
+	require.EqualError(t, err, "newest event is stale: Transfer was emitted 1h30m0s ago, at 2024-01-01T10:30:00Z, more than the max staleness of 1h0m0s")
+}
@@ -0,0 +1,199 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// TriggerHandler handles the events of a trigger, like by starting a workflow. Events which fail to be handled are
+// delivered again, along with the events after them.
+type TriggerHandler func(ctx context.Context, event ChainReaderEvent) error
+
+// TriggerCursorStore persists the cursors of the triggers of a chain, which are the last events they delivered.
+type TriggerCursorStore interface {
+	// LoadCursor returns the cursor of the trigger with id, or nil if it did not deliver any event yet.
+	LoadCursor(ctx context.Context, id string) (*EventCursor, error)
+	SaveCursor(ctx context.Context, id string, cursor EventCursor) error
+	DeleteCursor(ctx context.Context, id string) error
+}
+
+// ChainReaderTriggerConfig configures a trigger on an event read of a ChainReaderConfig.
+type ChainReaderTriggerConfig struct {
+	// ID identifies the trigger and its cursor, like the workflow and the name of its trigger.
+	ID string
+	// ContractName and ReadName are the contract and the event read of the ChainReaderConfig, whose params filter the
+	// indexed inputs of the events.
+	ContractName string
+	ReadName     string
+	Address      common.Address
+	// Confirmations are the confirmations of the events before they are delivered, or logpoller.Finalized to only
+	// deliver finalized events, which cannot be reorged out after they were delivered.
+	Confirmations logpoller.Confirmations
+	// FromBlock is the block the first events are delivered from, when the trigger has no cursor yet.
+	FromBlock int64
+	// PollPeriod is how often new events are polled from the LogPoller.
+	PollPeriod time.Duration
+}
+
+// ChainReaderTrigger delivers the decoded events of a ChainReader event read to a handler, in order, at least once.
+// Events are read from the LogPoller after the cursor of the trigger, which is only saved once the handler succeeded,
+// so that the events which were not handled before a failure or a restart are delivered again.
+type ChainReaderTrigger struct {
+	commonservices.StateMachine
+	lggr    logger.Logger
+	lp      logpoller.LogPoller
+	cursors TriggerCursorStore
+	cfg     ChainReaderTriggerConfig
+	handler TriggerHandler
+
+	binding *eventBinding
+
+	stopCh commonservices.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewChainReaderTrigger returns a trigger on the event read of chainReader configured by cfg, delivering to handler.
+func NewChainReaderTrigger(lggr logger.Logger, lp logpoller.LogPoller, cursors TriggerCursorStore, chainReader types.ChainReaderConfig, cfg ChainReaderTriggerConfig, handler TriggerHandler) (*ChainReaderTrigger, error) {
+	if cfg.ID == "" {
+		return nil, errors.New("trigger id is required")
+	}
+	binding, err := newEventBinding(chainReader, cfg.ContractName, cfg.ReadName, cfg.Address, common.Address{})
+	if err != nil {
+		return nil, err
+	}
+	if cfg.PollPeriod <= 0 {
+		return nil, errors.New("poll period must be positive")
+	}
+
+	return &ChainReaderTrigger{
+		lggr:    logger.Sugared(lggr).Named("ChainReaderTrigger").With("id", cfg.ID, "contract", cfg.ContractName, "read", cfg.ReadName),
+		lp:      lp,
+		cursors: cursors,
+		cfg:     cfg,
+		handler: handler,
+		binding: binding,
+		stopCh:  make(commonservices.StopChan),
+	}, nil
+}
+
+// filterName is the name of the LogPoller filter of the trigger.
+func (t *ChainReaderTrigger) filterName() string {
+	return logpoller.FilterName("ChainReaderTrigger", t.cfg.ID)
+}
+
+func (t *ChainReaderTrigger) Start(context.Context) error {
+	return t.StartOnce("ChainReaderTrigger", func() error {
+		err := t.lp.RegisterFilter(logpoller.Filter{
+			Name:      t.filterName(),
+			EventSigs: evmtypes.HashArray{t.binding.event.ID},
+			Addresses: evmtypes.AddressArray{t.cfg.Address},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register filter: %w", err)
+		}
+		t.wg.Add(1)
+		go t.run()
+		return nil
+	})
+}
+
+func (t *ChainReaderTrigger) Close() error {
+	return t.StopOnce("ChainReaderTrigger", func() error {
+		close(t.stopCh)
+		t.wg.Wait()
+		return nil
+	})
+}
+
+func (t *ChainReaderTrigger) Name() string { return t.lggr.Name() }
+
+func (t *ChainReaderTrigger) HealthReport() map[string]error {
+	return map[string]error{t.Name(): t.Healthy()}
+}
+
+// Remove unregisters the filter of the trigger and deletes its cursor, once the trigger is removed for good.
+func (t *ChainReaderTrigger) Remove(ctx context.Context) error {
+	if err := t.lp.UnregisterFilter(t.filterName(), pg.WithParentCtx(ctx)); err != nil {
+		return fmt.Errorf("failed to unregister filter: %w", err)
+	}
+	return t.cursors.DeleteCursor(ctx, t.cfg.ID)
+}
+
+func (t *ChainReaderTrigger) run() {
+	defer t.wg.Done()
+	ctx, cancel := t.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(t.cfg.PollPeriod)
+	defer ticker.Stop()
+	for {
+		if err := t.deliver(ctx); err != nil && ctx.Err() == nil {
+			t.lggr.Errorw("Failed to deliver events, retrying", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver hands the events after the cursor of the trigger to its handler, saving the cursor after each one.
+func (t *ChainReaderTrigger) deliver(ctx context.Context) error {
+	latest, err := t.lp.LatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get latest block: %w", err)
+	}
+	end := confirmedBlock(latest, t.cfg.Confirmations)
+
+	cursor, err := t.cursors.LoadCursor(ctx, t.cfg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load cursor: %w", err)
+	}
+	start := t.cfg.FromBlock
+	if cursor != nil {
+		start = cursor.BlockNumber
+	}
+	if end < start {
+		return nil
+	}
+
+	logs, err := t.lp.Logs(start, end, t.binding.event.ID, t.cfg.Address, pg.WithParentCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get logs: %w", err)
+	}
+	for _, log := range logs {
+		at := EventCursor{BlockNumber: log.BlockNumber, LogIndex: log.LogIndex}
+		if cursor != nil && !at.After(*cursor) {
+			continue
+		}
+		if !t.binding.matches(log) {
+			continue
+		}
+		event, err := t.binding.decode(log)
+		if err != nil {
+			// undecodable events are skipped, since they would block the trigger forever
+			t.lggr.Errorw("Failed to decode event, skipping it", "block", log.BlockNumber, "logIndex", log.LogIndex, "txHash", log.TxHash, "err", err)
+		} else if err = t.handler(ctx, event); err != nil {
+			return fmt.Errorf("failed to handle event in block %d at index %d: %w", log.BlockNumber, log.LogIndex, err)
+		}
+		if err = t.cursors.SaveCursor(ctx, t.cfg.ID, at); err != nil {
+			return fmt.Errorf("failed to save cursor: %w", err)
+		}
+		cursor = &at
+	}
+	return nil
+}
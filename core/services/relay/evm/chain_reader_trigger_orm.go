@@ -0,0 +1,59 @@
+package evm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/jmoiron/sqlx"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+type triggerCursorORM struct {
+	q       pg.Q
+	chainID ubig.Big
+}
+
+var _ TriggerCursorStore = (*triggerCursorORM)(nil)
+
+// NewTriggerCursorORM returns a TriggerCursorStore persisting the cursors of the triggers of the chain with chainID.
+func NewTriggerCursorORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, chainID *big.Int) TriggerCursorStore {
+	return &triggerCursorORM{q: pg.NewQ(db, lggr, cfg), chainID: *ubig.New(chainID)}
+}
+
+func (o *triggerCursorORM) LoadCursor(ctx context.Context, id string) (*EventCursor, error) {
+	var cursor EventCursor
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).Get(&cursor, `SELECT block_number AS "blocknumber", log_index AS "logindex"
+FROM evm.chain_reader_trigger_cursors WHERE evm_chain_id = $1 AND trigger_id = $2`, o.chainID, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load trigger cursor: %w", err)
+	}
+	return &cursor, nil
+}
+
+func (o *triggerCursorORM) SaveCursor(ctx context.Context, id string, cursor EventCursor) error {
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).ExecQ(`INSERT INTO evm.chain_reader_trigger_cursors (evm_chain_id, trigger_id, block_number, log_index, updated_at)
+VALUES ($1, $2, $3, $4, now())
+ON CONFLICT (evm_chain_id, trigger_id) DO UPDATE SET block_number = EXCLUDED.block_number, log_index = EXCLUDED.log_index, updated_at = now()`,
+		o.chainID, id, cursor.BlockNumber, cursor.LogIndex)
+	if err != nil {
+		return fmt.Errorf("failed to save trigger cursor: %w", err)
+	}
+	return nil
+}
+
+func (o *triggerCursorORM) DeleteCursor(ctx context.Context, id string) error {
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).ExecQ(`DELETE FROM evm.chain_reader_trigger_cursors WHERE evm_chain_id = $1 AND trigger_id = $2`, o.chainID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete trigger cursor: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+type memCursorStore struct {
+	mu      sync.Mutex
+	cursors map[string]EventCursor
+}
+
+func (s *memCursorStore) LoadCursor(_ context.Context, id string) (*EventCursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.cursors[id]; ok {
+		return &c, nil
+	}
+	return nil, nil
+}
+
+func (s *memCursorStore) SaveCursor(_ context.Context, id string, cursor EventCursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[id] = cursor
+	return nil
+}
+
+func (s *memCursorStore) DeleteCursor(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cursors, id)
+	return nil
+}
+
+func TestChainReaderTrigger(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.JSON(strings.NewReader(conformanceTestABI))
+	require.NoError(t, err)
+	event := contractABI.Events["Transfer"]
+	from, to, address := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	newLog := func(block, index int64, to common.Address, value int64) logpoller.Log {
+		data, err := event.Inputs.NonIndexed().Pack(big.NewInt(value))
+		require.NoError(t, err)
+		return logpoller.Log{
+			BlockNumber: block,
+			LogIndex:    index,
+			Address:     address,
+			EventSig:    event.ID,
+			Topics:      [][]byte{event.ID.Bytes(), common.BytesToHash(from.Bytes()).Bytes(), common.BytesToHash(to.Bytes()).Bytes()},
+			Data:        data,
+		}
+	}
+	chainReader := evmtypes.ChainReaderConfig{ChainContractReaders: map[string]evmtypes.ChainContractReader{
+		"Token": {
+			ContractABI: conformanceTestABI,
+			ChainReaderDefinitions: map[string]evmtypes.ChainReaderDefinition{
+				"Transfers": {
+					ChainSpecificName: "Transfer",
+					Params:            map[string]any{"from": from.Hex(), "to": to.Hex()},
+					ReadType:          evmtypes.Event,
+				},
+				"Balance": {ChainSpecificName: "balanceOf", Params: map[string]any{"owner": from.Hex()}, ReadType: evmtypes.Method},
+			},
+		},
+	}}
+	cfg := ChainReaderTriggerConfig{ID: "workflow-1", ContractName: "Token", ReadName: "Transfers", Address: address, Confirmations: 2, FromBlock: 10, PollPeriod: time.Hour}
+
+	t.Run("invalid config", func(t *testing.T) {
+		bad := cfg
+		bad.ReadName = "Balance"
+		_, err := NewChainReaderTrigger(logger.TestLogger(t), nil, nil, chainReader, bad, nil)
+		require.ErrorContains(t, err, "is not an event")
+		bad.ReadName = "Missing"
+		_, err = NewChainReaderTrigger(logger.TestLogger(t), nil, nil, chainReader, bad, nil)
+		require.ErrorContains(t, err, "is not configured")
+	})
+
+	lp := lpmocks.NewLogPoller(t)
+	store := &memCursorStore{cursors: map[string]EventCursor{}}
+	var delivered []ChainReaderEvent
+	fail := true
+	trigger, err := NewChainReaderTrigger(logger.TestLogger(t), lp, store, chainReader, cfg, func(_ context.Context, e ChainReaderEvent) error {
+		if e.Cursor.BlockNumber == 12 && fail {
+			fail = false
+			return errors.New("workflow unavailable")
+		}
+		delivered = append(delivered, e)
+		return nil
+	})
+	require.NoError(t, err)
+	ctx := testutils.Context(t)
+
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 14, FinalizedBlockNumber: 5}, nil)
+	lp.On("Logs", int64(10), int64(12), event.ID, address, mock.Anything).Return([]logpoller.Log{
+		newLog(10, 1, to, 1),
+		newLog(11, 0, testutils.NewAddress(), 2), // another recipient
+		newLog(12, 3, to, 3),
+	}, nil).Once()
+
+	// the handler fails on the second event, which is delivered again on the next poll
+	require.ErrorContains(t, trigger.deliver(ctx), "workflow unavailable")
+	require.Len(t, delivered, 1)
+	assert.Equal(t, EventCursor{BlockNumber: 10, LogIndex: 1}, store.cursors[cfg.ID])
+	assert.Equal(t, from, delivered[0].Values["from"])
+	assert.Equal(t, to, delivered[0].Values["to"])
+	assert.Equal(t, big.NewInt(1), delivered[0].Values["value"])
+
+	lp.On("Logs", int64(10), int64(12), event.ID, address, mock.Anything).Return([]logpoller.Log{
+		newLog(10, 1, to, 1),
+		newLog(12, 3, to, 3),
+	}, nil).Once()
+	require.NoError(t, trigger.deliver(ctx))
+	require.Len(t, delivered, 2)
+	assert.Equal(t, big.NewInt(3), delivered[1].Values["value"])
+	assert.Equal(t, EventCursor{BlockNumber: 12, LogIndex: 3}, store.cursors[cfg.ID])
+
+	t.Run("finalized", func(t *testing.T) {
+		finalized := cfg
+		finalized.Confirmations = logpoller.Finalized
+		trigger, err := NewChainReaderTrigger(logger.TestLogger(t), lp, store, chainReader, finalized, func(context.Context, ChainReaderEvent) error {
+			t.Fatal("unexpected event")
+			return nil
+		})
+		require.NoError(t, err)
+		// the finalized block is before the cursor, so no logs are read
+		require.NoError(t, trigger.deliver(ctx))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		lp.On("UnregisterFilter", "ChainReaderTrigger - workflow-1", mock.Anything).Return(nil).Once()
+		require.NoError(t, trigger.Remove(ctx))
+		assert.NotContains(t, store.cursors, cfg.ID)
+	})
+}
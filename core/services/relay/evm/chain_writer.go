@@ -0,0 +1,208 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// ChainWriterTxStore finds the transactions submitted by a ChainWriter.
+type ChainWriterTxStore interface {
+	FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string, chainID *big.Int) (*txmgr.Tx, error)
+}
+
+type chainWriter struct {
+	commonservices.StateMachine
+	lggr    logger.Logger
+	chainID *big.Int
+	txm     txmgr.TxManager
+	txStore ChainWriterTxStore
+	codec   commontypes.Codec
+	// methods key is contract name, then method name.
+	methods map[string]map[string]writeMethod
+}
+
+type writeMethod struct {
+	selector    []byte
+	fromAddress common.Address
+	gasLimit    uint32
+	// itemType is the codec item type of the arguments of the method.
+	itemType string
+}
+
+var _ relay.ChainWriter = (*chainWriter)(nil)
+
+// NewChainWriterService returns the EVM ChainWriter, which encodes the arguments of the configured methods with their
+// ABI, and submits them as transactions through txm.
+func NewChainWriterService(lggr logger.Logger, chainID *big.Int, txm txmgr.TxManager, txStore ChainWriterTxStore, config types.ChainWriterConfig) (*chainWriter, error) {
+	cw := &chainWriter{
+		lggr:    lggr.Named("ChainWriter"),
+		chainID: chainID,
+		txm:     txm,
+		txStore: txStore,
+		methods: make(map[string]map[string]writeMethod, len(config.Contracts)),
+	}
+	codecs := make(map[string]types.ChainCodecConfig)
+	for contractName, contract := range config.Contracts {
+		methods, err := newWriteMethods(contractName, contract, codecs)
+		if err != nil {
+			return nil, fmt.Errorf("%w: contract %q: %w", commontypes.ErrInvalidConfig, contractName, err)
+		}
+		cw.methods[contractName] = methods
+	}
+	var err error
+	if cw.codec, err = NewCodec(codecs); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// abiEntry is an entry of a JSON ABI. The inputs are kept as JSON, since the codec is configured with the JSON ABI of
+// the arguments.
+type abiEntry struct {
+	Type   string          `json:"type"`
+	Name   string          `json:"name"`
+	Inputs json.RawMessage `json:"inputs"`
+}
+
+// newWriteMethods returns the methods of contract, and adds the codec configs of their arguments to codecs.
+func newWriteMethods(contractName string, contract types.ChainContractWriter, codecs map[string]types.ChainCodecConfig) (map[string]writeMethod, error) {
+	parsed, err := abi.JSON(strings.NewReader(contract.ContractABI))
+	if err != nil {
+		return nil, fmt.Errorf("invalid contractABI: %w", err)
+	}
+	var entries []abiEntry
+	if err = json.Unmarshal([]byte(contract.ContractABI), &entries); err != nil {
+		return nil, fmt.Errorf("invalid contractABI: %w", err)
+	}
+
+	methods := make(map[string]writeMethod, len(contract.Configs))
+	for name, def := range contract.Configs {
+		m, ok := parsed.Methods[def.ChainSpecificName]
+		if !ok {
+			return nil, fmt.Errorf("method %q: %s is not a method of the contract", name, def.ChainSpecificName)
+		}
+		if def.FromAddress == (common.Address{}) {
+			return nil, fmt.Errorf("method %q: fromAddress must be set", name)
+		}
+		if def.GasLimit == 0 {
+			return nil, fmt.Errorf("method %q: gasLimit must be set", name)
+		}
+		inputs := "[]"
+		if i := slices.IndexFunc(entries, func(e abiEntry) bool {
+			return e.Type == "function" && e.Name == def.ChainSpecificName
+		}); i >= 0 && len(entries[i].Inputs) > 0 {
+			inputs = string(entries[i].Inputs)
+		}
+		itemType := contractName + "." + name
+		codecs[itemType] = types.ChainCodecConfig{TypeABI: inputs}
+		methods[name] = writeMethod{
+			selector:    m.ID,
+			fromAddress: def.FromAddress,
+			gasLimit:    def.GasLimit,
+			itemType:    itemType,
+		}
+	}
+	return methods, nil
+}
+
+func (cw *chainWriter) Name() string { return cw.lggr.Name() }
+
+func (cw *chainWriter) Start(context.Context) error {
+	return cw.StartOnce(cw.Name(), func() error { return nil })
+}
+
+func (cw *chainWriter) Close() error {
+	return cw.StopOnce(cw.Name(), func() error { return nil })
+}
+
+func (cw *chainWriter) HealthReport() map[string]error {
+	return map[string]error{cw.Name(): cw.Healthy()}
+}
+
+// SubmitTransaction encodes args, a struct or a map with a field per argument of the method, and creates a transaction
+// with txm. transactionID is the idempotency key of the transaction, so submitting it again returns without creating
+// another one.
+func (cw *chainWriter) SubmitTransaction(ctx context.Context, contractName, method string, args any, transactionID string, toAddress string, value *big.Int) error {
+	m, ok := cw.methods[contractName][method]
+	if !ok {
+		return fmt.Errorf("%w: method %q of contract %q is not configured", commontypes.ErrInvalidType, method, contractName)
+	}
+	if !common.IsHexAddress(toAddress) {
+		return fmt.Errorf("%w: invalid toAddress %q", commontypes.ErrInvalidType, toAddress)
+	}
+	data, err := cw.codec.Encode(ctx, args, m.itemType)
+	if err != nil {
+		return fmt.Errorf("failed to encode the arguments of %s: %w", m.itemType, err)
+	}
+	var v big.Int
+	if value != nil {
+		v.Set(value)
+	}
+	key := idempotencyKey(transactionID)
+	_, err = cw.txm.CreateTransaction(ctx, txmgr.TxRequest{
+		IdempotencyKey: &key,
+		FromAddress:    m.fromAddress,
+		ToAddress:      common.HexToAddress(toAddress),
+		EncodedPayload: append(slices.Clone(m.selector), data...),
+		Value:          v,
+		FeeLimit:       m.gasLimit,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create transaction %s: %w", transactionID, err)
+	}
+	return nil
+}
+
+// GetTransactionStatus maps the state of the transaction in the txm to the chain agnostic status.
+func (cw *chainWriter) GetTransactionStatus(ctx context.Context, transactionID string) (relay.TransactionStatus, error) {
+	tx, err := cw.txStore.FindTxWithIdempotencyKey(ctx, idempotencyKey(transactionID), cw.chainID)
+	if err != nil {
+		return relay.Unknown, fmt.Errorf("failed to find transaction %s: %w", transactionID, err)
+	}
+	if tx == nil {
+		return relay.Unknown, nil
+	}
+	switch tx.State {
+	case txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress:
+		return relay.Pending, nil
+	case txmgrcommon.TxUnconfirmed:
+		return relay.Unconfirmed, nil
+	case txmgrcommon.TxConfirmed, txmgrcommon.TxConfirmedMissingReceipt:
+		return relay.Confirmed, nil
+	case txmgrcommon.TxFatalError:
+		return relay.Fatal, nil
+	default:
+		return relay.Unknown, nil
+	}
+}
+
+// idempotencyKey namespaces transaction IDs, since idempotency keys are unique across all the users of the txm.
+func idempotencyKey(transactionID string) string {
+	return "chainwriter-" + transactionID
+}
+
+// NewChainWriter returns a ChainWriter of the chain of the relayer. config is a JSON ChainWriterConfig.
+func (r *Relayer) NewChainWriter(_ context.Context, config []byte) (relay.ChainWriter, error) {
+	var cfg types.ChainWriterConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, err)
+	}
+	return NewChainWriterService(r.lggr, r.chain.ID(), r.chain.TxManager(), txmgr.NewTxStore(r.db, r.lggr, r.pgCfg), cfg)
+}
@@ -0,0 +1,116 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+const chainWriterTestABI = `[{"type":"function","name":"transmit","inputs":[{"name":"report","type":"bytes"},{"name":"round","type":"uint32"}],"outputs":[]}]`
+
+type fakeChainWriterTxStore map[string]*txmgr.Tx
+
+func (s fakeChainWriterTxStore) FindTxWithIdempotencyKey(_ context.Context, key string, _ *big.Int) (*txmgr.Tx, error) {
+	return s[key], nil
+}
+
+func newTestChainWriter(t *testing.T, txm txmgr.TxManager, store ChainWriterTxStore) *chainWriter {
+	cw, err := NewChainWriterService(logger.TestLogger(t), big.NewInt(1), txm, store, types.ChainWriterConfig{
+		Contracts: map[string]types.ChainContractWriter{
+			"aggregator": {
+				ContractABI: chainWriterTestABI,
+				Configs: map[string]types.ChainWriterDefinition{
+					"submit": {ChainSpecificName: "transmit", FromAddress: testutils.NewAddress(), GasLimit: 100_000},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return cw
+}
+
+func TestChainWriter_SubmitTransaction(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	to := testutils.NewAddress()
+	txm := txmmocks.NewMockEvmTxManager(t)
+	cw := newTestChainWriter(t, txm, fakeChainWriterTxStore{})
+
+	parsed, err := abi.JSON(strings.NewReader(chainWriterTestABI))
+	require.NoError(t, err)
+	expected, err := parsed.Pack("transmit", []byte{1, 2}, uint32(7))
+	require.NoError(t, err)
+
+	txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+		return *req.IdempotencyKey == "chainwriter-tx-1" && req.ToAddress == to && req.FeeLimit == 100_000 &&
+			req.Value.Cmp(big.NewInt(5)) == 0 && bytes.Equal(expected, req.EncodedPayload)
+	})).Return(txmgr.Tx{}, nil).Once()
+	args := map[string]any{"report": []byte{1, 2}, "round": uint32(7)}
+	require.NoError(t, cw.SubmitTransaction(ctx, "aggregator", "submit", args, "tx-1", to.Hex(), big.NewInt(5)))
+
+	err = cw.SubmitTransaction(ctx, "aggregator", "unknown", args, "tx-2", to.Hex(), nil)
+	require.ErrorIs(t, err, commontypes.ErrInvalidType)
+	err = cw.SubmitTransaction(ctx, "aggregator", "submit", args, "tx-2", "not an address", nil)
+	require.ErrorIs(t, err, commontypes.ErrInvalidType)
+}
+
+func TestChainWriter_GetTransactionStatus(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	store := fakeChainWriterTxStore{}
+	cw := newTestChainWriter(t, txmmocks.NewMockEvmTxManager(t), store)
+
+	for state, status := range map[txmgrtypes.TxState]relay.TransactionStatus{
+		txmgrcommon.TxUnstarted:               relay.Pending,
+		txmgrcommon.TxInProgress:              relay.Pending,
+		txmgrcommon.TxUnconfirmed:             relay.Unconfirmed,
+		txmgrcommon.TxConfirmed:               relay.Confirmed,
+		txmgrcommon.TxConfirmedMissingReceipt: relay.Confirmed,
+		txmgrcommon.TxFatalError:              relay.Fatal,
+	} {
+		store["chainwriter-tx"] = &txmgr.Tx{State: state}
+		got, err := cw.GetTransactionStatus(ctx, "tx")
+		require.NoError(t, err)
+		assert.Equal(t, status, got, state)
+	}
+
+	got, err := cw.GetTransactionStatus(ctx, "missing")
+	require.NoError(t, err)
+	assert.Equal(t, relay.Unknown, got)
+}
+
+func TestNewChainWriterService_invalidConfig(t *testing.T) {
+	t.Parallel()
+	for name, def := range map[string]types.ChainWriterDefinition{
+		"unknown method":  {ChainSpecificName: "foo", FromAddress: testutils.NewAddress(), GasLimit: 1},
+		"no from address": {ChainSpecificName: "transmit", GasLimit: 1},
+		"no gas limit":    {ChainSpecificName: "transmit", FromAddress: testutils.NewAddress()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewChainWriterService(logger.TestLogger(t), big.NewInt(1), nil, nil, types.ChainWriterConfig{
+				Contracts: map[string]types.ChainContractWriter{
+					"aggregator": {ContractABI: chainWriterTestABI, Configs: map[string]types.ChainWriterDefinition{"submit": def}},
+				},
+			})
+			require.ErrorIs(t, err, commontypes.ErrInvalidConfig)
+		})
+	}
+}
@@ -0,0 +1,591 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+type codec struct {
+	items map[string]*codecItem
+}
+
+var _ commontypes.Codec = (*codec)(nil)
+
+// codecItem is an item type, which is ABI encoded as args, or packed as fields if any.
+type codecItem struct {
+	args   abi.Arguments
+	packed []packedField
+	// addresses are the off-chain representations of address args, keyed by arg index.
+	addresses map[int]evmtypes.AddressRepresentation
+
+	// The names, map keys and Go types of args are resolved once, so that encoding and decoding only reflect on the
+	// values. Values of the Go types the abi encoder expects are encoded as is, the others are converted.
+	fieldNames []string
+	mapKeys    []reflect.Value
+	goTypes    []reflect.Type
+	// fields caches the structMapping of args, keyed by struct type.
+	fields sync.Map
+}
+
+type packedField struct {
+	name string
+	typ  abi.Type
+	// size is the number of bytes of the field, or zero for the rest of the data.
+	size int
+}
+
+// NewCodec returns a codec of the item types configured in configs, keyed by item type.
+func NewCodec(configs map[string]evmtypes.ChainCodecConfig) (commontypes.Codec, error) {
+	c := &codec{items: make(map[string]*codecItem, len(configs))}
+	for itemType, cfg := range configs {
+		item, err := newCodecItem(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%w: item type %q: %w", commontypes.ErrInvalidConfig, itemType, err)
+		}
+		c.items[itemType] = item
+	}
+	return c, nil
+}
+
+func newCodecItem(cfg evmtypes.ChainCodecConfig) (*codecItem, error) {
+	item := &codecItem{}
+	if err := item.setArgs(cfg); err != nil {
+		return nil, err
+	}
+	item.resolveArgs()
+	for name, representation := range cfg.Addresses {
+		if representation != evmtypes.AddressString && representation != evmtypes.AddressBytes {
+			return nil, fmt.Errorf("invalid representation %q of address %q, must be %q or %q", representation, name, evmtypes.AddressString, evmtypes.AddressBytes)
+		}
+		i := slices.IndexFunc(item.args, func(a abi.Argument) bool { return a.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("address %q is not a field", name)
+		}
+		if item.args[i].Type.T != abi.AddressTy {
+			return nil, fmt.Errorf("field %q is not an address", name)
+		}
+		if item.addresses == nil {
+			item.addresses = make(map[int]evmtypes.AddressRepresentation)
+		}
+		item.addresses[i] = representation
+	}
+	return item, nil
+}
+
+// newArgsCodecItem returns the item of args, like the inputs of an event, which are decoded by the ChainReader.
+func newArgsCodecItem(args abi.Arguments) *codecItem {
+	item := &codecItem{}
+	for _, a := range args {
+		item.args = append(item.args, abi.Argument{Name: a.Name, Type: a.Type})
+	}
+	item.resolveArgs()
+	return item
+}
+
+func (ci *codecItem) resolveArgs() {
+	for _, a := range ci.args {
+		ci.fieldNames = append(ci.fieldNames, abi.ToCamelCase(a.Name))
+		ci.mapKeys = append(ci.mapKeys, reflect.ValueOf(a.Name))
+		ci.goTypes = append(ci.goTypes, a.Type.GetType())
+	}
+}
+
+func (ci *codecItem) setArgs(cfg evmtypes.ChainCodecConfig) error {
+	switch {
+	case cfg.TypeABI != "" && len(cfg.Packed) > 0:
+		return fmt.Errorf("only one of typeAbi and packed can be set")
+	case cfg.TypeABI != "":
+		var args []abi.ArgumentMarshaling
+		if err := json.Unmarshal([]byte(cfg.TypeABI), &args); err != nil {
+			return fmt.Errorf("invalid typeAbi: %w", err)
+		}
+		for _, a := range args {
+			t, err := abi.NewType(a.Type, a.InternalType, a.Components)
+			if err != nil {
+				return fmt.Errorf("invalid type of argument %q: %w", a.Name, err)
+			}
+			ci.args = append(ci.args, abi.Argument{Name: a.Name, Type: t})
+		}
+		return nil
+	case len(cfg.Packed) > 0:
+		for i, f := range cfg.Packed {
+			pf, err := newPackedField(f, i == len(cfg.Packed)-1)
+			if err != nil {
+				return fmt.Errorf("invalid packed field %q: %w", f.Name, err)
+			}
+			ci.packed = append(ci.packed, pf)
+			ci.args = append(ci.args, abi.Argument{Name: f.Name, Type: pf.typ})
+		}
+		return nil
+	}
+	return fmt.Errorf("one of typeAbi or packed must be set")
+}
+
+func newPackedField(f evmtypes.PackedField, last bool) (packedField, error) {
+	t, err := abi.NewType(f.Type, "", nil)
+	if err != nil {
+		return packedField{}, err
+	}
+	pf := packedField{name: f.Name, typ: t, size: f.Size}
+	var natural int
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		natural = t.Size / 8
+	case abi.AddressTy:
+		natural = common.AddressLength
+	case abi.BoolTy:
+		natural = 1
+	case abi.FixedBytesTy:
+		natural = t.Size
+	case abi.BytesTy, abi.StringTy:
+		if !last {
+			return pf, fmt.Errorf("%s can only be the last field", f.Type)
+		}
+		if f.Size < 0 {
+			return pf, fmt.Errorf("size must not be negative")
+		}
+		return pf, nil
+	default:
+		return pf, fmt.Errorf("type %s cannot be packed", f.Type)
+	}
+	switch {
+	case f.Size == 0:
+		pf.size = natural
+	case f.Size < 0 || f.Size > natural:
+		return pf, fmt.Errorf("size %d is out of range [1, %d] of %s", f.Size, natural, f.Type)
+	case f.Size != natural && t.T != abi.IntTy && t.T != abi.UintTy:
+		// only integers can be truncated, since the others would lose their meaning
+		return pf, fmt.Errorf("size of %s must be %d", f.Type, natural)
+	}
+	return pf, nil
+}
+
+func (c *codec) item(itemType string) (*codecItem, error) {
+	item, ok := c.items[itemType]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown item type %q", commontypes.ErrInvalidType, itemType)
+	}
+	return item, nil
+}
+
+// Encode encodes item, which is a struct or a map with a field per argument. Struct fields are mapped to arguments by
+// their evm tag, or else by name, and the components of tuple arguments can be flattened into the struct with tags.
+func (c *codec) Encode(_ context.Context, item any, itemType string) (raw []byte, err error) {
+	defer func(start time.Time) { c.observeCodec(itemType, codecOperationEncode, start, err) }(time.Now())
+	return c.encode(item, itemType)
+}
+
+func (c *codec) encode(item any, itemType string) ([]byte, error) {
+	ci, err := c.item(itemType)
+	if err != nil {
+		return nil, err
+	}
+	values, err := ci.argumentValues(item)
+	if err != nil {
+		return nil, err
+	}
+	if len(ci.packed) == 0 {
+		return ci.args.Pack(values...)
+	}
+	var out []byte
+	for i, f := range ci.packed {
+		b, err := packField(f, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: field %q: %w", commontypes.ErrInvalidType, f.name, err)
+		}
+		out = append(out, b...)
+	}
+	return out, nil
+}
+
+// Decode decodes raw into into, which is a pointer to a struct or a map with a field per argument. Struct fields are
+// mapped like by Encode, except that only some components of flattened tuples need to be fields.
+func (c *codec) Decode(_ context.Context, raw []byte, into any, itemType string) (err error) {
+	defer func(start time.Time) { c.observeCodec(itemType, codecOperationDecode, start, err) }(time.Now())
+	return c.decode(raw, into, itemType)
+}
+
+func (c *codec) decode(raw []byte, into any, itemType string) error {
+	ci, err := c.item(itemType)
+	if err != nil {
+		return err
+	}
+	var values []any
+	if len(ci.packed) == 0 {
+		if values, err = ci.args.Unpack(raw); err != nil {
+			return fmt.Errorf("%w: %w", commontypes.ErrInvalidType, err)
+		}
+	} else {
+		for _, f := range ci.packed {
+			var v any
+			if v, raw, err = unpackField(f, raw); err != nil {
+				return fmt.Errorf("%w: field %q: %w", commontypes.ErrInvalidType, f.name, err)
+			}
+			values = append(values, v)
+		}
+		if len(raw) > 0 {
+			return fmt.Errorf("%w: %w", commontypes.ErrInvalidType, sizeBoundError{fmt.Errorf("%d unexpected trailing bytes", len(raw))})
+		}
+	}
+	return ci.decodeValues(values, into)
+}
+
+// decodeValues sets into to values, the decoded values of the args of ci, like Decode.
+func (ci *codecItem) decodeValues(values []any, into any) (err error) {
+	for i, representation := range ci.addresses {
+		values[i] = renderAddress(values[i].(common.Address), representation)
+	}
+	if m, ok := into.(*map[string]any); ok {
+		if *m == nil {
+			*m = make(map[string]any, len(values))
+		}
+		for i, a := range ci.args {
+			(*m)[a.Name] = values[i]
+		}
+		return nil
+	}
+	if err = ci.copyFields(into, values); err == nil || len(ci.addresses) > 0 || ci.hasCodecTags(into) {
+		return err
+	}
+	// the abi decoder converts between struct types with the same layout, e.g. of tuples
+	if err = ci.args.Copy(into, values); err != nil {
+		return fmt.Errorf("%w: %w", commontypes.ErrInvalidType, err)
+	}
+	return nil
+}
+
+// copyFields sets the fields of the struct into points to to values, which must all be assignable. Nothing is set
+// otherwise.
+func (ci *codecItem) copyFields(into any, values []any) error {
+	v := reflect.ValueOf(into)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: cannot decode into %T, it must be a pointer to a struct or a map", commontypes.ErrInvalidType, into)
+	}
+	v = v.Elem()
+	m := ci.structMapping(v.Type())
+	if m.err != nil {
+		return m.err
+	}
+	for i, a := range ci.args {
+		if _, ok := m.flattened[i]; ok {
+			continue
+		}
+		if m.fields[i] == nil {
+			return fmt.Errorf("%w: field %q not found in %T", commontypes.ErrInvalidType, a.Name, into)
+		}
+		if ft, vt := v.FieldByIndex(m.fields[i]).Type(), reflect.TypeOf(values[i]); vt == nil || !vt.AssignableTo(ft) {
+			return fmt.Errorf("%w: field %q of type %s cannot be set to %s", commontypes.ErrInvalidType, a.Name, ft, vt)
+		}
+	}
+	// flattened fields are set into a copy, so that nothing is set if one of them cannot be
+	decoded := reflect.New(v.Type()).Elem()
+	decoded.Set(v)
+	for i, flattened := range m.flattened {
+		if err := setFlattened(decoded, values[i], flattened); err != nil {
+			return err
+		}
+	}
+	for i := range ci.args {
+		if m.fields[i] != nil {
+			decoded.FieldByIndex(m.fields[i]).Set(reflect.ValueOf(values[i]))
+		}
+	}
+	v.Set(decoded)
+	return nil
+}
+
+// renderAddress returns the off-chain representation of address.
+func renderAddress(address common.Address, representation evmtypes.AddressRepresentation) any {
+	if representation == evmtypes.AddressBytes {
+		return address.Bytes()
+	}
+	return address.Hex()
+}
+
+// parseAddress accepts the string and bytes representations of an address, as well as common.Address. Strings must be
+// hex, and have a valid EIP-55 checksum unless they are all lower or upper case.
+func parseAddress(value any) (common.Address, error) {
+	switch a := value.(type) {
+	case common.Address:
+		return a, nil
+	case []byte:
+		if len(a) != common.AddressLength {
+			return common.Address{}, fmt.Errorf("address must be %d bytes, got %d", common.AddressLength, len(a))
+		}
+		return common.BytesToAddress(a), nil
+	case string:
+		if !common.IsHexAddress(a) {
+			return common.Address{}, fmt.Errorf("invalid address %q", a)
+		}
+		address := common.HexToAddress(a)
+		digits := strings.TrimPrefix(strings.TrimPrefix(a, "0x"), "0X")
+		if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && address.Hex()[2:] != digits {
+			return common.Address{}, fmt.Errorf("invalid EIP-55 checksum of address %q", a)
+		}
+		return address, nil
+	}
+	return common.Address{}, fmt.Errorf("cannot convert %T to an address", value)
+}
+
+func (c *codec) GetMaxEncodingSize(_ context.Context, n int, itemType string) (int, error) {
+	return c.maxSize(n, itemType)
+}
+
+func (c *codec) GetMaxDecodingSize(_ context.Context, n int, itemType string) (int, error) {
+	return c.maxSize(n, itemType)
+}
+
+func (c *codec) maxSize(n int, itemType string) (int, error) {
+	ci, err := c.item(itemType)
+	if err != nil {
+		return 0, err
+	}
+	var size int
+	if len(ci.packed) > 0 {
+		for _, f := range ci.packed {
+			if f.size == 0 {
+				size += n
+			} else {
+				size += f.size
+			}
+		}
+		return size, nil
+	}
+	for _, a := range ci.args {
+		s, err := abiEncodedSize(a.Type, n)
+		if err != nil {
+			return 0, fmt.Errorf("%w: argument %q: %w", commontypes.ErrInvalidType, a.Name, err)
+		}
+		size += s
+	}
+	return size, nil
+}
+
+// abiEncodedSize returns the size of t when ABI encoded as a top level argument, with n elements if it is dynamic.
+func abiEncodedSize(t abi.Type, n int) (int, error) {
+	switch t.T {
+	case abi.BytesTy, abi.StringTy:
+		// offset, length, and the data padded to words
+		return 64 + (n+31)/32*32, nil
+	case abi.SliceTy:
+		if isDynamicType(*t.Elem) {
+			return 0, fmt.Errorf("nested dynamically sized elements")
+		}
+		elem, err := staticSize(*t.Elem)
+		if err != nil {
+			return 0, err
+		}
+		return 64 + n*elem, nil
+	}
+	if isDynamicType(t) {
+		return 0, fmt.Errorf("nested dynamically sized elements")
+	}
+	return staticSize(t)
+}
+
+// staticSize returns the size of the statically sized type t when ABI encoded.
+func staticSize(t abi.Type) (int, error) {
+	switch t.T {
+	case abi.ArrayTy:
+		elem, err := staticSize(*t.Elem)
+		return t.Size * elem, err
+	case abi.TupleTy:
+		var size int
+		for _, e := range t.TupleElems {
+			s, err := staticSize(*e)
+			if err != nil {
+				return 0, err
+			}
+			size += s
+		}
+		return size, nil
+	}
+	if isDynamicType(t) {
+		return 0, fmt.Errorf("nested dynamically sized elements")
+	}
+	return 32, nil
+}
+
+func isDynamicType(t abi.Type) bool {
+	switch t.T {
+	case abi.BytesTy, abi.StringTy, abi.SliceTy:
+		return true
+	case abi.ArrayTy:
+		return isDynamicType(*t.Elem)
+	case abi.TupleTy:
+		for _, e := range t.TupleElems {
+			if isDynamicType(*e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// argumentValues returns the values of the args of ci from item, converted to the types expected by the abi encoder.
+func (ci *codecItem) argumentValues(item any) ([]any, error) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	var m *structMapping
+	switch v.Kind() {
+	case reflect.Map:
+	case reflect.Struct:
+		if m = ci.structMapping(v.Type()); m.err != nil {
+			return nil, m.err
+		}
+	default:
+		return nil, fmt.Errorf("%w: cannot encode %T, it must be a struct or a map", commontypes.ErrInvalidType, item)
+	}
+	values := make([]any, len(ci.args))
+	for i, a := range ci.args {
+		var field reflect.Value
+		if m == nil {
+			field = v.MapIndex(ci.mapKeys[i])
+		} else if flattened, ok := m.flattened[i]; ok {
+			if missing, ok := m.incomplete[i]; ok {
+				return nil, modifierErrorf("field %q not found in %T", missing, item)
+			}
+			value, err := flattenedValue(v, ci.goTypes[i], flattened)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+			continue
+		} else if m.fields[i] != nil {
+			field = v.FieldByIndex(m.fields[i])
+		}
+		if !field.IsValid() {
+			return nil, fmt.Errorf("%w: field %q not found in %T", commontypes.ErrInvalidType, a.Name, item)
+		}
+		if field.Kind() == reflect.Interface && !field.IsNil() {
+			field = field.Elem()
+		}
+		if _, ok := ci.addresses[i]; ok {
+			address, err := parseAddress(field.Interface())
+			if err != nil {
+				return nil, fmt.Errorf("%w: field %q: %w", commontypes.ErrInvalidType, a.Name, err)
+			}
+			values[i] = address
+			continue
+		}
+		if field.Type() == ci.goTypes[i] {
+			values[i] = field.Interface()
+			continue
+		}
+		value, err := convertABIParam(a.Type, field.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("%w: field %q: %w", commontypes.ErrInvalidType, a.Name, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func packField(f packedField, value any) ([]byte, error) {
+	switch f.typ.T {
+	case abi.IntTy, abi.UintTy:
+		i := reflect.ValueOf(value)
+		n, ok := value.(*big.Int)
+		if !ok {
+			if f.typ.T == abi.IntTy {
+				n = big.NewInt(i.Int())
+			} else {
+				n = new(big.Int).SetUint64(i.Uint())
+			}
+		}
+		bits := f.size * 8
+		if f.typ.T == abi.IntTy {
+			limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+			if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+				return nil, sizeBoundError{fmt.Errorf("%s overflows %d bytes", n, f.size)}
+			}
+			if n.Sign() < 0 {
+				// two's complement
+				n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+			}
+		} else if n.Sign() < 0 || n.BitLen() > bits {
+			return nil, sizeBoundError{fmt.Errorf("%s overflows %d bytes", n, f.size)}
+		}
+		return n.FillBytes(make([]byte, f.size)), nil
+	case abi.AddressTy:
+		a := value.(common.Address)
+		return a.Bytes(), nil
+	case abi.BoolTy:
+		if value.(bool) {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case abi.FixedBytesTy:
+		b := make([]byte, f.size)
+		reflect.Copy(reflect.ValueOf(b), reflect.ValueOf(value))
+		return b, nil
+	case abi.BytesTy:
+		return value.([]byte), nil
+	case abi.StringTy:
+		return []byte(value.(string)), nil
+	}
+	return nil, fmt.Errorf("type %s cannot be packed", f.typ)
+}
+
+// unpackField decodes f from the start of raw, and returns it with the rest of raw.
+func unpackField(f packedField, raw []byte) (any, []byte, error) {
+	size := f.size
+	if size == 0 {
+		size = len(raw)
+	}
+	if len(raw) < size {
+		return nil, nil, sizeBoundError{fmt.Errorf("needs %d bytes, only %d left", size, len(raw))}
+	}
+	b, rest := raw[:size], raw[size:]
+	switch f.typ.T {
+	case abi.IntTy, abi.UintTy:
+		n := new(big.Int).SetBytes(b)
+		if f.typ.T == abi.IntTy && len(b) > 0 && b[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(size*8)))
+		}
+		return toABIInteger(f.typ, n), rest, nil
+	case abi.AddressTy:
+		return common.BytesToAddress(b), rest, nil
+	case abi.BoolTy:
+		if b[0] > 1 {
+			return nil, nil, fmt.Errorf("invalid bool %d", b[0])
+		}
+		return b[0] == 1, rest, nil
+	case abi.FixedBytesTy:
+		v := reflect.New(f.typ.GetType()).Elem()
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v.Interface(), rest, nil
+	case abi.BytesTy:
+		return append([]byte{}, b...), rest, nil
+	case abi.StringTy:
+		return string(b), rest, nil
+	}
+	return nil, nil, fmt.Errorf("type %s cannot be packed", f.typ)
+}
+
+// toABIInteger converts n to the Go type the abi encoder expects for t.
+func toABIInteger(t abi.Type, n *big.Int) any {
+	v := reflect.New(t.GetType()).Elem()
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(n.Int64())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(n.Uint64())
+	default:
+		return n
+	}
+	return v.Interface()
+}
@@ -0,0 +1,220 @@
+package evm
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// modifierErrorf returns an invalid type error marked as a modifierError.
+func modifierErrorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %w", commontypes.ErrInvalidType, modifierError{fmt.Errorf(format, args...)})
+}
+
+// codecTag is the struct tag which maps a struct field to the field of an item it is not named after, like
+// `evm:"answer"`. The fields of tuples are flattened into the struct by their path, like `evm:"config.threshold"`, so
+// that consumers can adapt the field names and nesting of items without changing the codec config. Fields tagged with
+// "-" are ignored.
+const codecTag = "evm"
+
+// structMapping maps the args of a codec item to the fields of a struct type.
+type structMapping struct {
+	// fields are the indices of the struct fields of args, or nil if an arg is flattened or not a field of the struct.
+	fields [][]int
+	// flattened are the struct fields the components of tuple args are flattened into, by arg index.
+	flattened map[int][]flattenedField
+	// incomplete are the first components of the flattened args which are not a field of the struct, which can be
+	// decoded but not encoded.
+	incomplete map[int]string
+	// tagged is set if the struct has codec tags.
+	tagged bool
+	err    error
+}
+
+type flattenedField struct {
+	// tag is the path of the component, like config.threshold.
+	tag string
+	// path are the names of the Go fields of the component in the value of the arg.
+	path  []string
+	typ   abi.Type
+	index []int
+}
+
+// structMapping returns the mapping of the args of ci to the fields of the struct type t. Fields are mapped by their
+// codec tag, or else by name.
+func (ci *codecItem) structMapping(t reflect.Type) *structMapping {
+	if m, ok := ci.fields.Load(t); ok {
+		return m.(*structMapping)
+	}
+	m := newStructMapping(ci, t)
+	ci.fields.Store(t, m)
+	return m
+}
+
+func newStructMapping(ci *codecItem, t reflect.Type) *structMapping {
+	m := &structMapping{fields: make([][]int, len(ci.args))}
+	tagged := make([]bool, len(ci.args))
+	paths := map[int][][]string{}
+	for _, f := range reflect.VisibleFields(t) {
+		tag, ok := f.Tag.Lookup(codecTag)
+		if !ok || !f.IsExported() {
+			continue
+		}
+		m.tagged = true
+		if tag == "-" {
+			continue
+		}
+		path := strings.Split(tag, ".")
+		i := slices.IndexFunc(ci.args, func(a abi.Argument) bool { return a.Name == path[0] })
+		if i < 0 {
+			m.err = modifierErrorf("field %s of %s is tagged with unknown field %q", f.Name, t, path[0])
+			return m
+		}
+		if len(path) == 1 {
+			if tagged[i] {
+				m.err = modifierErrorf("field %q is tagged more than once in %s", path[0], t)
+				return m
+			}
+			tagged[i] = true
+			m.fields[i] = f.Index
+			continue
+		}
+		goPath, typ, err := componentPath(ci.args[i].Type, path[1:])
+		if err != nil {
+			m.err = modifierErrorf("field %s of %s is tagged with %q: %w", f.Name, t, tag, err)
+			return m
+		}
+		if m.flattened == nil {
+			m.flattened = make(map[int][]flattenedField)
+		}
+		m.flattened[i] = append(m.flattened[i], flattenedField{tag: tag, path: goPath, typ: typ, index: f.Index})
+		paths[i] = append(paths[i], path[1:])
+	}
+
+	for i, name := range ci.fieldNames {
+		if _, ok := m.flattened[i]; ok {
+			if tagged[i] {
+				m.err = modifierErrorf("field %q is both tagged and flattened in %s", ci.args[i].Name, t)
+				return m
+			}
+			if missing := missingComponent(ci.args[i].Type, paths[i]); missing != "" {
+				if m.incomplete == nil {
+					m.incomplete = make(map[int]string)
+				}
+				m.incomplete[i] = ci.args[i].Name + "." + missing
+			}
+			continue
+		}
+		if tagged[i] {
+			continue
+		}
+		if f, ok := t.FieldByName(name); ok {
+			if _, isTagged := f.Tag.Lookup(codecTag); !isTagged {
+				m.fields[i] = f.Index
+			}
+		}
+	}
+	return m
+}
+
+// hasCodecTags returns true if into points to a struct with codec tags, which is then only decoded by them and field
+// names.
+func (ci *codecItem) hasCodecTags(into any) bool {
+	t := reflect.TypeOf(into)
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	return ci.structMapping(t.Elem()).tagged
+}
+
+// componentPath returns the names of the Go fields and the type of the component of the tuple type t at path.
+func componentPath(t abi.Type, path []string) ([]string, abi.Type, error) {
+	var goPath []string
+	for _, name := range path {
+		if t.T != abi.TupleTy {
+			return nil, t, fmt.Errorf("%s is not a tuple", t)
+		}
+		j := slices.Index(t.TupleRawNames, name)
+		if j < 0 {
+			return nil, t, fmt.Errorf("no component %q", name)
+		}
+		goPath = append(goPath, t.TupleType.Field(j).Name)
+		t = *t.TupleElems[j]
+	}
+	return goPath, t, nil
+}
+
+// missingComponent returns the path of the first component of the tuple type t which is not covered by paths, or the
+// empty string if they all are.
+func missingComponent(t abi.Type, paths [][]string) string {
+	for j, name := range t.TupleRawNames {
+		var sub [][]string
+		covered := false
+		for _, p := range paths {
+			if p[0] != name {
+				continue
+			}
+			if len(p) == 1 {
+				covered = true
+				break
+			}
+			sub = append(sub, p[1:])
+		}
+		if covered {
+			continue
+		}
+		if len(sub) == 0 {
+			return name
+		}
+		if missing := missingComponent(*t.TupleElems[j], sub); missing != "" {
+			return name + "." + missing
+		}
+	}
+	return ""
+}
+
+// setFlattened sets the flattened struct fields of v to the components of the tuple value.
+func setFlattened(v reflect.Value, value any, flattened []flattenedField) error {
+	for _, f := range flattened {
+		component := reflect.ValueOf(value)
+		for _, goName := range f.path {
+			component = component.FieldByName(goName)
+		}
+		field := v.FieldByIndex(f.index)
+		if !component.Type().AssignableTo(field.Type()) {
+			return modifierErrorf("field %q of type %s cannot be set to %s", f.tag, field.Type(), component.Type())
+		}
+		field.Set(component)
+	}
+	return nil
+}
+
+// flattenedValue returns the tuple value of type goType, from the struct fields of v its components are flattened into.
+func flattenedValue(v reflect.Value, goType reflect.Type, flattened []flattenedField) (any, error) {
+	value := reflect.New(goType).Elem()
+	for _, f := range flattened {
+		component := value
+		for _, goName := range f.path {
+			component = component.FieldByName(goName)
+		}
+		field := v.FieldByIndex(f.index)
+		if field.Kind() == reflect.Interface && !field.IsNil() {
+			field = field.Elem()
+		}
+		if field.Type() == component.Type() {
+			component.Set(field)
+			continue
+		}
+		converted, err := convertABIParam(f.typ, field.Interface())
+		if err != nil {
+			return nil, modifierErrorf("field %q: %w", f.tag, err)
+		}
+		component.Set(reflect.ValueOf(converted))
+	}
+	return value.Interface(), nil
+}
@@ -0,0 +1,78 @@
+package evm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promCodecDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "evm_codec_duration_seconds",
+		Help:    "Duration of encoding and decoding items with the EVM codec, by item type",
+		Buckets: prometheus.ExponentialBuckets(1e-6, 4, 10), // 1µs to 262ms
+	}, []string{"itemType", "operation"})
+	promCodecErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_codec_errors",
+		Help: "Number of items which failed to be encoded or decoded with the EVM codec, by item type and class of error",
+	}, []string{"itemType", "operation", "class"})
+)
+
+const (
+	codecOperationEncode = "encode"
+	codecOperationDecode = "decode"
+)
+
+// Classes of codec errors.
+const (
+	// codecErrorUnknownItemType is the class of items of types which are not configured. They are counted under the
+	// unknown item type, so that callers cannot create arbitrary labels.
+	codecErrorUnknownItemType = "unknown_item_type"
+	// codecErrorSizeBound is the class of values which overflow the size of their field, and of data which is shorter
+	// or longer than its item.
+	codecErrorSizeBound = "size_bound_exceeded"
+	// codecErrorModifier is the class of items which could not be mapped to or from their struct with its evm tags.
+	codecErrorModifier = "modifier_failure"
+	// codecErrorInvalidType is the class of the other items, whose values do not have the types of their fields.
+	codecErrorInvalidType = "invalid_type"
+)
+
+const codecUnknownItemType = "unknown"
+
+// sizeBoundError marks the errors of values overflowing the size of their field, or of data shorter or longer than
+// its item.
+type sizeBoundError struct{ error }
+
+func (e sizeBoundError) Unwrap() error { return e.error }
+
+// modifierError marks the errors of mapping items to or from structs with their evm tags.
+type modifierError struct{ error }
+
+func (e modifierError) Unwrap() error { return e.error }
+
+// codecErrorClass returns the class of an error of the codec.
+func codecErrorClass(err error) string {
+	var sizeBound sizeBoundError
+	var modifier modifierError
+	switch {
+	case errors.As(err, &sizeBound):
+		return codecErrorSizeBound
+	case errors.As(err, &modifier):
+		return codecErrorModifier
+	}
+	return codecErrorInvalidType
+}
+
+// observeCodec records the duration of an operation on an item of itemType started at start, or its error.
+func (c *codec) observeCodec(itemType, operation string, start time.Time, err error) {
+	if _, ok := c.items[itemType]; !ok {
+		promCodecErrors.WithLabelValues(codecUnknownItemType, operation, codecErrorUnknownItemType).Inc()
+		return
+	}
+	promCodecDuration.WithLabelValues(itemType, operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		promCodecErrors.WithLabelValues(itemType, operation, codecErrorClass(err)).Inc()
+	}
+}
@@ -0,0 +1,387 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+type codecTestReportContext struct {
+	ConfigDigest  [32]byte
+	EpochAndRound *big.Int
+	Delta         int32
+	Transmitter   common.Address
+	Extra         []byte
+}
+
+func newTestCodec(t testing.TB) commontypes.Codec {
+	c, err := NewCodec(map[string]evmtypes.ChainCodecConfig{
+		"standard": {TypeABI: `[{"name":"answer","type":"int192"},{"name":"observers","type":"bytes"}]`},
+		"packed": {Packed: []evmtypes.PackedField{
+			{Name: "configDigest", Type: "bytes32"},
+			{Name: "epochAndRound", Type: "uint256", Size: 5},
+			{Name: "delta", Type: "int32", Size: 2},
+			{Name: "transmitter", Type: "address"},
+			{Name: "extra", Type: "bytes"},
+		}},
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func TestCodec_Standard(t *testing.T) {
+	ctx := testutils.Context(t)
+	c := newTestCodec(t)
+
+	raw, err := c.Encode(ctx, map[string]any{"answer": big.NewInt(-5), "observers": []byte{1, 2}}, "standard")
+	require.NoError(t, err)
+	require.Len(t, raw, 4*32)
+
+	var decoded map[string]any
+	require.NoError(t, c.Decode(ctx, raw, &decoded, "standard"))
+	assert.Equal(t, big.NewInt(-5), decoded["answer"])
+	assert.Equal(t, []byte{1, 2}, decoded["observers"])
+
+	var s struct {
+		Answer    *big.Int
+		Observers []byte
+	}
+	require.NoError(t, c.Decode(ctx, raw, &s, "standard"))
+	assert.Equal(t, big.NewInt(-5), s.Answer)
+
+	size, err := c.GetMaxEncodingSize(ctx, 33, "standard")
+	require.NoError(t, err)
+	assert.Equal(t, 32+64+64, size)
+}
+
+func TestCodec_Tuple(t *testing.T) {
+	ctx := testutils.Context(t)
+	c, err := NewCodec(map[string]evmtypes.ChainCodecConfig{
+		"tuple": {TypeABI: `[{"name":"point","type":"tuple","components":[{"name":"x","type":"uint64"},{"name":"y","type":"uint64"}]}]`},
+	})
+	require.NoError(t, err)
+
+	type point struct{ X, Y uint64 }
+	type item struct{ Point point }
+	raw, err := c.Encode(ctx, item{Point: point{X: 1, Y: 2}}, "tuple")
+	require.NoError(t, err)
+
+	// the values decoded as anonymous structs are converted to the fields of the struct
+	var decoded item
+	require.NoError(t, c.Decode(ctx, raw, &decoded, "tuple"))
+	assert.Equal(t, item{Point: point{X: 1, Y: 2}}, decoded)
+}
+
+func TestCodec_Packed(t *testing.T) {
+	ctx := testutils.Context(t)
+	c := newTestCodec(t)
+	item := codecTestReportContext{
+		ConfigDigest:  [32]byte{1},
+		EpochAndRound: big.NewInt(0x0102030405),
+		Delta:         -2,
+		Transmitter:   common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+		Extra:         []byte{0xff},
+	}
+
+	raw, err := c.Encode(ctx, item, "packed")
+	require.NoError(t, err)
+	assert.Equal(t, "0x0100000000000000000000000000000000000000000000000000000000000000"+
+		"0102030405"+"fffe"+"00000000000000000000000000000000000000aa"+"ff", hexutil.Encode(raw))
+
+	var decoded codecTestReportContext
+	require.NoError(t, c.Decode(ctx, raw, &decoded, "packed"))
+	assert.Equal(t, item, decoded)
+
+	size, err := c.GetMaxDecodingSize(ctx, 10, "packed")
+	require.NoError(t, err)
+	assert.Equal(t, 32+5+2+20+10, size)
+
+	t.Run("overflow", func(t *testing.T) {
+		overflow := item
+		overflow.EpochAndRound = new(big.Int).Lsh(big.NewInt(1), 40)
+		_, err := c.Encode(ctx, overflow, "packed")
+		require.ErrorIs(t, err, commontypes.ErrInvalidType)
+		require.ErrorContains(t, err, "overflows 5 bytes")
+
+		overflow = item
+		overflow.Delta = 1 << 15
+		_, err = c.Encode(ctx, overflow, "packed")
+		require.ErrorContains(t, err, "overflows 2 bytes")
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		err := c.Decode(ctx, raw[:36], &decoded, "packed")
+		require.ErrorIs(t, err, commontypes.ErrInvalidType)
+		require.ErrorContains(t, err, `field "epochAndRound": needs 5 bytes, only 4 left`)
+	})
+}
+
+func TestCodec_Addresses(t *testing.T) {
+	ctx := testutils.Context(t)
+	c, err := NewCodec(map[string]evmtypes.ChainCodecConfig{
+		"transfer": {
+			TypeABI:   `[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}]`,
+			Addresses: map[string]evmtypes.AddressRepresentation{"from": evmtypes.AddressString, "to": evmtypes.AddressBytes},
+		},
+	})
+	require.NoError(t, err)
+	from := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	to := common.HexToAddress("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359")
+
+	type transfer struct {
+		From   string
+		To     []byte
+		Amount *big.Int
+	}
+	raw, err := c.Encode(ctx, transfer{From: from.Hex(), To: to.Bytes(), Amount: big.NewInt(1)}, "transfer")
+	require.NoError(t, err)
+
+	var decoded transfer
+	require.NoError(t, c.Decode(ctx, raw, &decoded, "transfer"))
+	assert.Equal(t, transfer{From: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", To: to.Bytes(), Amount: big.NewInt(1)}, decoded)
+
+	var m map[string]any
+	require.NoError(t, c.Decode(ctx, raw, &m, "transfer"))
+	assert.Equal(t, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", m["from"])
+	assert.Equal(t, to.Bytes(), m["to"])
+
+	t.Run("both formats are accepted", func(t *testing.T) {
+		for _, v := range []any{from, from.Bytes(), from.Hex(), strings.ToLower(from.Hex()), "0x" + strings.ToUpper(from.Hex()[2:])} {
+			encoded, err := c.Encode(ctx, map[string]any{"from": v, "to": to.Hex(), "amount": big.NewInt(1)}, "transfer")
+			require.NoError(t, err)
+			assert.Equal(t, raw, encoded)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tt := range []struct {
+			from any
+			err  string
+		}{
+			{"0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "invalid EIP-55 checksum"},
+			{"0x5aAeb6", "invalid address"},
+			{[]byte{1, 2}, "address must be 20 bytes, got 2"},
+			{42, "cannot convert int to an address"},
+		} {
+			_, err := c.Encode(ctx, map[string]any{"from": tt.from, "to": to, "amount": big.NewInt(1)}, "transfer")
+			require.ErrorIs(t, err, commontypes.ErrInvalidType)
+			require.ErrorContains(t, err, tt.err)
+		}
+	})
+
+	t.Run("wrong field type", func(t *testing.T) {
+		var wrong struct {
+			From   common.Address
+			To     []byte
+			Amount *big.Int
+		}
+		require.ErrorIs(t, c.Decode(ctx, raw, &wrong, "transfer"), commontypes.ErrInvalidType)
+	})
+}
+
+func TestCodec_UnknownItemType(t *testing.T) {
+	c := newTestCodec(t)
+	_, err := c.Encode(testutils.Context(t), map[string]any{}, "unknown")
+	require.ErrorIs(t, err, commontypes.ErrInvalidType)
+}
+
+func TestNewCodec_InvalidConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		cfg  evmtypes.ChainCodecConfig
+		err  string
+	}{
+		{"empty", evmtypes.ChainCodecConfig{}, "one of typeAbi or packed must be set"},
+		{"both", evmtypes.ChainCodecConfig{TypeABI: `[]`, Packed: []evmtypes.PackedField{{Name: "a", Type: "bool"}}}, "only one of typeAbi and packed can be set"},
+		{"dynamic not last", evmtypes.ChainCodecConfig{Packed: []evmtypes.PackedField{{Name: "a", Type: "bytes"}, {Name: "b", Type: "bool"}}}, "bytes can only be the last field"},
+		{"too large", evmtypes.ChainCodecConfig{Packed: []evmtypes.PackedField{{Name: "a", Type: "uint8", Size: 2}}}, "size 2 is out of range [1, 1] of uint8"},
+		{"truncated address", evmtypes.ChainCodecConfig{Packed: []evmtypes.PackedField{{Name: "a", Type: "address", Size: 4}}}, "size of address must be 20"},
+		{"array", evmtypes.ChainCodecConfig{Packed: []evmtypes.PackedField{{Name: "a", Type: "uint8[]"}}}, "type uint8[] cannot be packed"},
+		{"unknown address", evmtypes.ChainCodecConfig{TypeABI: `[{"name":"a","type":"address"}]`, Addresses: map[string]evmtypes.AddressRepresentation{"b": evmtypes.AddressString}}, `address "b" is not a field`},
+		{"not an address", evmtypes.ChainCodecConfig{TypeABI: `[{"name":"a","type":"bytes20"}]`, Addresses: map[string]evmtypes.AddressRepresentation{"a": evmtypes.AddressBytes}}, `field "a" is not an address`},
+		{"address representation", evmtypes.ChainCodecConfig{TypeABI: `[{"name":"a","type":"address"}]`, Addresses: map[string]evmtypes.AddressRepresentation{"a": "base58"}}, `invalid representation "base58" of address "a"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCodec(map[string]evmtypes.ChainCodecConfig{"item": tt.cfg})
+			require.ErrorIs(t, err, commontypes.ErrInvalidConfig)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func BenchmarkCodec_Encode(b *testing.B) {
+	c := newTestCodec(b)
+	ctx := context.Background()
+	item := codecTestReportContext{ConfigDigest: [32]byte{1}, EpochAndRound: big.NewInt(5), Delta: 2, Extra: []byte{1}}
+	b.Run("standard", func(b *testing.B) {
+		m := map[string]any{"answer": big.NewInt(-5), "observers": []byte{1, 2}}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := c.Encode(ctx, m, "standard"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("packed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := c.Encode(ctx, item, "packed"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkCodec_Decode(b *testing.B) {
+	c := newTestCodec(b)
+	ctx := context.Background()
+	raw, err := c.Encode(ctx, codecTestReportContext{ConfigDigest: [32]byte{1}, EpochAndRound: big.NewInt(5), Delta: 2, Extra: []byte{1}}, "packed")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var decoded codecTestReportContext
+		if err := c.Decode(ctx, raw, &decoded, "packed"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestCodec_StructTags(t *testing.T) {
+	ctx := testutils.Context(t)
+	c, err := NewCodec(map[string]evmtypes.ChainCodecConfig{
+		"config": {TypeABI: `[{"name":"answer","type":"int192"},{"name":"config","type":"tuple","components":[{"name":"threshold","type":"uint8"},{"name":"fee","type":"tuple","components":[{"name":"base","type":"uint32"},{"name":"multiplier","type":"uint16"}]}]}]`},
+	})
+	require.NoError(t, err)
+
+	type flat struct {
+		Price          *big.Int `evm:"answer"`
+		Threshold      uint8    `evm:"config.threshold"`
+		BaseFee        int64    `evm:"config.fee.base"`
+		FeeMultiplier  uint16   `evm:"config.fee.multiplier"`
+		NotInTheReport string
+	}
+	item := flat{Price: big.NewInt(-7), Threshold: 3, BaseFee: 100, FeeMultiplier: 2}
+	raw, err := c.Encode(ctx, item, "config")
+	require.NoError(t, err)
+
+	// the same item encoded from a map
+	expected, err := c.Encode(ctx, map[string]any{
+		"answer": big.NewInt(-7),
+		"config": map[string]any{"threshold": 3, "fee": map[string]any{"base": 100, "multiplier": 2}},
+	}, "config")
+	require.NoError(t, err)
+	assert.Equal(t, expected, raw)
+
+	t.Run("decode", func(t *testing.T) {
+		var decoded struct {
+			Price         *big.Int `evm:"answer"`
+			Answer        *big.Int `evm:"-"`
+			Threshold     uint8    `evm:"config.threshold"`
+			FeeMultiplier uint16   `evm:"config.fee.multiplier"`
+		}
+		require.NoError(t, c.Decode(ctx, raw, &decoded, "config"))
+		assert.Equal(t, big.NewInt(-7), decoded.Price)
+		assert.Nil(t, decoded.Answer)
+		assert.Equal(t, uint8(3), decoded.Threshold)
+		assert.Equal(t, uint16(2), decoded.FeeMultiplier)
+	})
+
+	t.Run("decode into mismatched type", func(t *testing.T) {
+		var decoded struct {
+			Price     *big.Int `evm:"answer"`
+			Threshold string   `evm:"config.threshold"`
+		}
+		err := c.Decode(ctx, raw, &decoded, "config")
+		require.ErrorIs(t, err, commontypes.ErrInvalidType)
+		require.ErrorContains(t, err, `field "config.threshold" of type string cannot be set to uint8`)
+		assert.Nil(t, decoded.Price)
+	})
+
+	t.Run("incomplete tuple", func(t *testing.T) {
+		var partial struct {
+			Price     *big.Int `evm:"answer"`
+			Threshold uint8    `evm:"config.threshold"`
+		}
+		_, err := c.Encode(ctx, partial, "config")
+		require.ErrorIs(t, err, commontypes.ErrInvalidType)
+		require.ErrorContains(t, err, `field "config.fee" not found`)
+	})
+
+	t.Run("invalid tags", func(t *testing.T) {
+		var unknown struct {
+			Price *big.Int `evm:"price"`
+		}
+		require.ErrorContains(t, c.Decode(ctx, raw, &unknown, "config"), `tagged with unknown field "price"`)
+
+		var notATuple struct {
+			Price *big.Int `evm:"answer.value"`
+		}
+		require.ErrorContains(t, c.Decode(ctx, raw, &notATuple, "config"), "int192 is not a tuple")
+
+		var noComponent struct {
+			Price *big.Int `evm:"config.gas"`
+		}
+		_, err := c.Encode(ctx, noComponent, "config")
+		require.ErrorContains(t, err, `no component "gas"`)
+
+		var twice struct {
+			Price  *big.Int `evm:"answer"`
+			Answer *big.Int `evm:"answer"`
+		}
+		require.ErrorContains(t, c.Decode(ctx, raw, &twice, "config"), `field "answer" is tagged more than once`)
+	})
+}
+
+func TestCodec_Metrics(t *testing.T) {
+	ctx := testutils.Context(t)
+	c, err := NewCodec(map[string]evmtypes.ChainCodecConfig{
+		"metrics": {Packed: []evmtypes.PackedField{{Name: "round", Type: "uint32", Size: 1}}},
+	})
+	require.NoError(t, err)
+	errorCount := func(operation, class string) float64 {
+		return testutil.ToFloat64(promCodecErrors.WithLabelValues("metrics", operation, class))
+	}
+
+	raw, err := c.Encode(ctx, map[string]any{"round": uint32(1)}, "metrics")
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, c.Decode(ctx, raw, &decoded, "metrics"))
+	assert.Equal(t, 1, testutil.CollectAndCount(promCodecDuration.WithLabelValues("metrics", codecOperationEncode).(prometheus.Histogram)))
+	assert.Equal(t, float64(0), errorCount(codecOperationEncode, codecErrorSizeBound))
+
+	_, err = c.Encode(ctx, map[string]any{"round": uint32(256)}, "metrics")
+	require.Error(t, err)
+	assert.Equal(t, float64(1), errorCount(codecOperationEncode, codecErrorSizeBound))
+
+	require.Error(t, c.Decode(ctx, []byte{1, 2}, &decoded, "metrics"))
+	assert.Equal(t, float64(1), errorCount(codecOperationDecode, codecErrorSizeBound))
+
+	_, err = c.Encode(ctx, "round", "metrics")
+	require.Error(t, err)
+	assert.Equal(t, float64(1), errorCount(codecOperationEncode, codecErrorInvalidType))
+
+	var tagged struct {
+		Round uint32 `evm:"epoch"`
+	}
+	require.Error(t, c.Decode(ctx, raw, &tagged, "metrics"))
+	assert.Equal(t, float64(1), errorCount(codecOperationDecode, codecErrorModifier))
+
+	before := testutil.ToFloat64(promCodecErrors.WithLabelValues(codecUnknownItemType, codecOperationDecode, codecErrorUnknownItemType))
+	require.Error(t, c.Decode(ctx, raw, &decoded, "missing"))
+	assert.Equal(t, before+1, testutil.ToFloat64(promCodecErrors.WithLabelValues(codecUnknownItemType, codecOperationDecode, codecErrorUnknownItemType)))
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/chains/evmutil"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/smartcontractkit/chainlink/v2/common/logctx"
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
@@ -49,6 +52,10 @@ type contractTransmitter struct {
 	lp                  logpoller.LogPoller
 	lggr                logger.Logger
 	reportToEvmTxMeta   ReportToEthMetadata
+	// profitability, if set, skips unprofitable transmissions.
+	profitability *transmitProfitability
+	// compression, if set, compresses the calldata of transmissions.
+	compression *calldataCompression
 }
 
 func transmitterFilterName(addr common.Address) string {
@@ -89,7 +96,17 @@ func NewOCRContractTransmitter(
 }
 
 // Transmit sends the report to the on-chain smart contract's Transmit method.
-func (oc *contractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+func (oc *contractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) (err error) {
+	ctx, span := tracing.Start(ctx, "ocr.transmit", attribute.String("configDigest", reportCtx.ConfigDigest.Hex()),
+		attribute.Int64("epoch", int64(reportCtx.Epoch)), attribute.Int64("round", int64(reportCtx.Round)))
+	defer func() { tracing.End(span, err) }()
+
+	return oc.transmit(ctx, evmutil.RawReportContext(reportCtx), report, signatures)
+}
+
+// transmit sends the report with the raw report context to the Transmit method of the contract, which is shared by
+// OCR2 and OCR3 contracts.
+func (oc *contractTransmitter) transmit(ctx context.Context, rawReportCtx [3][32]byte, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
 	var rs [][32]byte
 	var ss [][32]byte
 	var vs [32]byte
@@ -105,19 +122,26 @@ func (oc *contractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.
 		ss = append(ss, s)
 		vs[i] = v
 	}
-	rawReportCtx := evmutil.RawReportContext(reportCtx)
+	lggr := oc.lggr.With(logctx.Fields(ctx)...)
 
 	txMeta, err := oc.reportToEvmTxMeta(report)
 	if err != nil {
-		oc.lggr.Warnw("failed to generate tx metadata for report", "err", err)
+		lggr.Warnw("failed to generate tx metadata for report", "err", err)
 	}
 
-	oc.lggr.Debugw("Transmitting report", "report", hex.EncodeToString(report), "rawReportCtx", rawReportCtx, "contractAddress", oc.contractAddress, "txMeta", txMeta)
+	lggr.Debugw("Transmitting report", "report", hex.EncodeToString(report), "rawReportCtx", rawReportCtx, "contractAddress", oc.contractAddress, "txMeta", txMeta)
 
 	payload, err := oc.contractABI.Pack("transmit", rawReportCtx, []byte(report), rs, ss, vs)
 	if err != nil {
 		return errors.Wrap(err, "abi.Pack failed")
 	}
+	if oc.compression != nil {
+		payload = oc.compression.compress(oc.contractAddress, payload)
+	}
+
+	if oc.profitability != nil && !oc.profitability.allow(ctx, oc.transmitter.FromAddress(), oc.contractAddress, payload) {
+		return nil
+	}
 
 	return errors.Wrap(oc.transmitter.CreateEthTransaction(ctx, oc.contractAddress, payload, txMeta), "failed to send Eth transaction")
 }
@@ -154,6 +178,7 @@ func callContract(ctx context.Context, addr common.Address, contractABI abi.ABI,
 	if err != nil {
 		return nil, err
 	}
+	ctx = logctx.With(ctx, logctx.ReadName, method)
 	output, err := caller.CallContract(ctx, ethereum.CallMsg{To: &addr, Data: input}, nil)
 	if err != nil {
 		return nil, err
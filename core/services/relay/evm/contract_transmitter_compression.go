@@ -0,0 +1,90 @@
+package evm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promTransmitCalldataBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr2_transmit_calldata_bytes",
+		Help: "Number of bytes of calldata of OCR2 transmissions before compression",
+	}, []string{"chainID", "contractAddress"})
+	promTransmitCompressedCalldataBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr2_transmit_compressed_calldata_bytes",
+		Help: "Number of bytes of calldata of OCR2 transmissions after compression, which are sent instead",
+	}, []string{"chainID", "contractAddress"})
+)
+
+// CalldataCompressionLibZip is the calldata compression mode of LibZip.cdCompress of Solady.
+const CalldataCompressionLibZip = "LibZip"
+
+// calldataCompression compresses the calldata of transmissions with the run-length encoding of LibZip.cdCompress of
+// Solady, which contracts decompress with LibZip.cdFallback in their fallback function. Since the first 4 bytes of the
+// compressed calldata are inverted, they hardly ever match the selector of a function of the contract.
+type calldataCompression struct {
+	chainID string
+}
+
+// compress returns the compressed payload, or payload if compressing does not shrink it.
+func (c *calldataCompression) compress(contractAddress common.Address, payload []byte) []byte {
+	compressed := cdCompress(payload)
+	if len(compressed) >= len(payload) {
+		compressed = payload
+	}
+	promTransmitCalldataBytes.WithLabelValues(c.chainID, contractAddress.Hex()).Add(float64(len(payload)))
+	promTransmitCompressedCalldataBytes.WithLabelValues(c.chainID, contractAddress.Hex()).Add(float64(len(compressed)))
+	return compressed
+}
+
+// cdCompress compresses data like LibZip.cdCompress of Solady: runs of up to 128 zero bytes and of up to 32 0xff bytes
+// are encoded as a zero byte followed by the length of the run minus one, with the high bit set for 0xff bytes, and
+// then the first 4 bytes of the encoded data are inverted.
+func cdCompress(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	var zeros, ones int
+	rle := func(ff bool, n int) {
+		b := byte(n - 1)
+		if ff {
+			b |= 0x80
+		}
+		out = append(out, 0x00, b)
+	}
+	flushOnes := func() {
+		if ones > 0 {
+			rle(true, ones)
+			ones = 0
+		}
+	}
+	flushZeros := func() {
+		if zeros > 0 {
+			rle(false, zeros)
+			zeros = 0
+		}
+	}
+	for _, c := range data {
+		switch c {
+		case 0x00:
+			flushOnes()
+			if zeros++; zeros == 0x80 {
+				flushZeros()
+			}
+		case 0xff:
+			flushZeros()
+			if ones++; ones == 0x20 {
+				flushOnes()
+			}
+		default:
+			flushOnes()
+			flushZeros()
+			out = append(out, c)
+		}
+	}
+	flushOnes()
+	flushZeros()
+	for i := 0; i < 4 && i < len(out); i++ {
+		out[i] ^= 0xff
+	}
+	return out
+}
@@ -0,0 +1,118 @@
+package evm
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// cdDecompress decompresses data like LibZip.cdDecompress of Solady, which inverts the first 4 bytes of data before
+// decoding it.
+func cdDecompress(data []byte) []byte {
+	data = bytes.Clone(data)
+	for i := 0; i < 4 && i < len(data); i++ {
+		data[i] ^= 0xff
+	}
+	var out []byte
+	for i := 0; i < len(data); i++ {
+		if data[i] != 0x00 {
+			out = append(out, data[i])
+			continue
+		}
+		i++
+		run := bytes.Repeat([]byte{0x00}, int(data[i]&0x7f)+1)
+		if data[i]&0x80 != 0 {
+			run = bytes.Repeat([]byte{0xff}, int(data[i]&0x7f)+1)
+		}
+		out = append(out, run...)
+	}
+	return out
+}
+
+func TestCdCompress(t *testing.T) {
+	t.Parallel()
+
+	data := append(append(common.FromHex("0x12345678"), make([]byte, 32)...), 0xff, 0xff, 0x01)
+	assert.Equal(t, "edcba987001f008101", common.Bytes2Hex(cdCompress(data)))
+	// the encoded data is inverted, not the selector before encoding it
+	assert.Equal(t, "fffcfe", common.Bytes2Hex(cdCompress(common.FromHex("0x0000000001"))))
+	assert.Equal(t, "ff7cf8ff80", common.Bytes2Hex(cdCompress(common.FromHex("0xffffffff07ff"))))
+
+	for _, data := range [][]byte{
+		nil,
+		common.FromHex("0x00000000"),
+		common.FromHex("0xffffffff01"),
+		common.FromHex("0x01"),
+		common.FromHex("0x0000"),
+		common.FromHex("0x00ff00ff00"),
+		bytes.Repeat([]byte{0x00}, 300),
+		bytes.Repeat([]byte{0xff}, 70),
+		append(append(common.FromHex("0xb1dc65a4"), bytes.Repeat([]byte{0x00}, 129)...), bytes.Repeat([]byte{0xff, 0x00, 0x42}, 40)...),
+	} {
+		assert.Equal(t, data, cdDecompress(cdCompress(data)), "data %x", data)
+	}
+
+	// random calldata, with long runs of zero and 0xff bytes like ABI encoded arguments
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		var data []byte
+		for len(data) < 300 {
+			switch rnd.Intn(3) {
+			case 0:
+				data = append(data, bytes.Repeat([]byte{0x00}, rnd.Intn(200))...)
+			case 1:
+				data = append(data, bytes.Repeat([]byte{0xff}, rnd.Intn(50))...)
+			default:
+				data = append(data, byte(rnd.Intn(256)))
+			}
+		}
+		assert.Equal(t, data, cdDecompress(cdCompress(data)), "data %x", data)
+	}
+}
+
+func TestContractTransmitter_CalldataCompression(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.JSON(strings.NewReader(ocr2aggregator.OCR2AggregatorABI))
+	require.NoError(t, err)
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("RegisterFilter", mock.Anything).Return(nil)
+	transmitter := &recordingTransmitter{}
+	address := testutils.NewAddress()
+	ct, err := NewOCRContractTransmitter(address, evmclimocks.NewClient(t), contractABI, transmitter, lp, logger.TestLogger(t), nil)
+	require.NoError(t, err)
+	ct.compression = &calldataCompression{chainID: "1337"}
+
+	signature := make([]byte, 65)
+	signature[0] = 1
+	report := ocrtypes.Report(make([]byte, 256))
+	reportCtx := ocrtypes.ReportContext{ReportTimestamp: ocrtypes.ReportTimestamp{ConfigDigest: ocrtypes.ConfigDigest{0x00, 0x01}, Epoch: 2, Round: 3}}
+	require.NoError(t, ct.Transmit(testutils.Context(t), reportCtx, report, []ocrtypes.AttributedOnchainSignature{{Signature: signature}}))
+
+	require.Len(t, transmitter.payloads, 1)
+	compressed := transmitter.payloads[0]
+	payload := cdDecompress(compressed)
+	args, err := contractABI.Methods["transmit"].Inputs.Unpack(payload[4:])
+	require.NoError(t, err)
+	assert.Equal(t, []byte(report), args[1])
+	assert.Less(t, len(compressed), len(payload))
+
+	assert.Equal(t, float64(len(payload)), testutil.ToFloat64(promTransmitCalldataBytes.WithLabelValues("1337", address.Hex())))
+	assert.Equal(t, float64(len(compressed)), testutil.ToFloat64(promTransmitCompressedCalldataBytes.WithLabelValues("1337", address.Hex())))
+}
@@ -0,0 +1,54 @@
+package evm
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/profitability"
+)
+
+type gasEstimatorClient interface {
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
+// transmitProfitability skips the transmissions whose estimated gas cost exceeds the payment expected for them.
+type transmitProfitability struct {
+	checker     *profitability.Checker
+	client      gasEstimatorClient
+	estimator   gas.EvmFeeEstimator
+	latestHead  func() *evmtypes.Head
+	gasLimit    uint32
+	maxGasPrice func(common.Address) *assets.Wei
+	lggr        logger.Logger
+}
+
+// allow returns false if the transmission of payload from from to to should be skipped. Transmissions whose cost
+// cannot be estimated are allowed.
+func (p *transmitProfitability) allow(ctx context.Context, from, to common.Address, payload []byte) bool {
+	gasUsed, err := p.client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &to, Data: payload})
+	if err != nil {
+		p.lggr.Warnw("Failed to estimate gas of transmission, transmitting regardless of profitability", "err", err)
+		return true
+	}
+	fee, _, err := p.estimator.GetFee(ctx, payload, p.gasLimit, p.maxGasPrice(from))
+	if err != nil {
+		p.lggr.Warnw("Failed to estimate fee of transmission, transmitting regardless of profitability", "err", err)
+		return true
+	}
+	var baseFee *assets.Wei
+	if head := p.latestHead(); head != nil {
+		baseFee = head.BaseFeePerGas
+	}
+	gasPrice, err := fee.EffectivePrice(baseFee)
+	if err != nil {
+		p.lggr.Warnw("Failed to estimate gas price of transmission, transmitting regardless of profitability", "err", err)
+		return true
+	}
+	return p.checker.Allow(ctx, gasUsed, gasPrice)
+}
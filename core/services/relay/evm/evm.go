@@ -32,6 +32,7 @@ import (
 	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/profitability"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	mercuryutils "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
@@ -158,9 +159,20 @@ func (r *Relayer) NewMercuryProvider(rargs commontypes.RelayArgs, pargs commonty
 		return nil, pkgerrors.Wrap(err, "failed to get CSA key for mercury connection")
 	}
 
-	client, err := r.mercuryPool.Checkout(context.Background(), privKey, mercuryConfig.ServerPubKey, mercuryConfig.ServerURL())
-	if err != nil {
-		return nil, err
+	var clients []wsrpc.Client
+	for _, server := range mercuryConfig.Servers() {
+		c, err2 := r.mercuryPool.Checkout(context.Background(), privKey, server.PubKey, server.URL())
+		if err2 != nil {
+			for _, checkedOut := range clients {
+				err2 = errors.Join(err2, checkedOut.Close())
+			}
+			return nil, err2
+		}
+		clients = append(clients, c)
+	}
+	client := clients[0]
+	if len(clients) > 1 {
+		client = wsrpc.NewFailoverClient(lggr, clients, mercuryConfig.SplitTransmission)
 	}
 
 	// FIXME: We actually know the version here since it's in the feed ID, can
@@ -431,7 +443,7 @@ func newContractTransmitter(lggr logger.Logger, rargs commontypes.RelayArgs, tra
 		return nil, pkgerrors.Wrap(err, "failed to create transmitter")
 	}
 
-	return NewOCRContractTransmitter(
+	ct, err := NewOCRContractTransmitter(
 		configWatcher.contractAddress,
 		configWatcher.chain.Client(),
 		configWatcher.contractABI,
@@ -440,6 +452,34 @@ func newContractTransmitter(lggr logger.Logger, rargs commontypes.RelayArgs, tra
 		lggr,
 		nil,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// both the chain and the contract must opt in, since contracts which do not decompress calldata reject it
+	if relayConfig.CalldataCompression && configWatcher.chain.Config().EVM().OCR2().CalldataCompression().Mode() == CalldataCompressionLibZip {
+		ct.compression = &calldataCompression{chainID: configWatcher.chain.ID().String()}
+	}
+
+	if cfg := relayConfig.Profitability; cfg != nil {
+		if err = cfg.Validate(); err != nil {
+			return nil, pkgerrors.Wrap(err, "invalid profitability config")
+		}
+		prices, err := profitability.NewFeedPriceSource(cfg.LinkNativeFeed, configWatcher.chain.Client())
+		if err != nil {
+			return nil, err
+		}
+		ct.profitability = &transmitProfitability{
+			checker:     profitability.NewChecker(*cfg, prices, rargs.JobID, lggr),
+			client:      configWatcher.chain.Client(),
+			estimator:   configWatcher.chain.GasEstimator(),
+			latestHead:  configWatcher.chain.HeadTracker().LatestChain,
+			gasLimit:    gasLimit,
+			maxGasPrice: configWatcher.chain.Config().EVM().GasEstimator().PriceMaxKey,
+			lggr:        lggr,
+		}
+	}
+	return ct, nil
 }
 
 func (r *Relayer) NewMedianProvider(rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (commontypes.MedianProvider, error) {
@@ -483,9 +523,9 @@ func (r *Relayer) NewMedianProvider(rargs commontypes.RelayArgs, pargs commontyp
 	}
 
 	// allow fallback until chain reader is default and median contract is removed, but still log just in case
-	var chainReaderService commontypes.ChainReader
+	var chainReaderService ChainReaderService
 	if relayConfig.ChainReader != nil {
-		if chainReaderService, err = NewChainReaderService(lggr, r.chain.LogPoller(), contractID, *relayConfig.ChainReader); err != nil {
+		if chainReaderService, err = NewChainReaderService(lggr, r.chain.LogPoller(), r.chain.Client(), contractID, *relayConfig.ChainReader); err != nil {
 			return nil, err
 		}
 	} else {
@@ -511,14 +551,18 @@ type medianProvider struct {
 	contractTransmitter ContractTransmitter
 	reportCodec         median.ReportCodec
 	medianContract      *medianContract
-	chainReader         commontypes.ChainReader
+	chainReader         ChainReaderService
 	ms                  services.MultiStart
 }
 
 func (p *medianProvider) Name() string { return p.lggr.Name() }
 
 func (p *medianProvider) Start(ctx context.Context) error {
-	return p.ms.Start(ctx, p.configWatcher, p.contractTransmitter, p.medianContract)
+	srvs := []services.StartClose{p.configWatcher, p.contractTransmitter, p.medianContract}
+	if p.chainReader != nil {
+		srvs = append(srvs, p.chainReader)
+	}
+	return p.ms.Start(ctx, srvs...)
 }
 
 func (p *medianProvider) Close() error { return p.ms.Close() }
@@ -530,6 +574,9 @@ func (p *medianProvider) HealthReport() map[string]error {
 	services.CopyHealth(hp, p.configWatcher.HealthReport())
 	services.CopyHealth(hp, p.contractTransmitter.HealthReport())
 	services.CopyHealth(hp, p.medianContract.HealthReport())
+	if p.chainReader != nil {
+		services.CopyHealth(hp, p.chainReader.HealthReport())
+	}
 	return hp
 }
 
@@ -557,6 +604,8 @@ func (p *medianProvider) ContractConfigTracker() ocrtypes.ContractConfigTracker
 	return p.configWatcher.ContractConfigTracker()
 }
 
+// ChainReader returns the ChainReaderService of the contract, if the relay config has one, whose events can be read
+// with its GetLatestEvents.
 func (p *medianProvider) ChainReader() commontypes.ChainReader {
 	return p.chainReader
 }
@@ -188,11 +188,11 @@ func (cp *configPoller) LatestBlockHeight(ctx context.Context) (blockHeight uint
 func (cp *configPoller) UpdateRoutes(activeCoordinator common.Address, proposedCoordinator common.Address) error {
 	cp.targetContract.Store(&activeCoordinator)
 	// Register filters for both active and proposed
-	err := cp.destChainLogPoller.RegisterFilter(logpoller.Filter{Name: configPollerFilterName(activeCoordinator), EventSigs: []common.Hash{ConfigSet}, Addresses: []common.Address{activeCoordinator}})
+	err := cp.destChainLogPoller.RegisterFilter(logpoller.Filter{Name: configPollerFilterName(activeCoordinator), EventSigs: []common.Hash{ConfigSet}, Addresses: []common.Address{activeCoordinator}, Priority: logpoller.FilterPriorityCritical})
 	if err != nil {
 		return err
 	}
-	err = cp.destChainLogPoller.RegisterFilter(logpoller.Filter{Name: configPollerFilterName(proposedCoordinator), EventSigs: []common.Hash{ConfigSet}, Addresses: []common.Address{activeCoordinator}})
+	err = cp.destChainLogPoller.RegisterFilter(logpoller.Filter{Name: configPollerFilterName(proposedCoordinator), EventSigs: []common.Hash{ConfigSet}, Addresses: []common.Address{activeCoordinator}, Priority: logpoller.FilterPriorityCritical})
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,8 @@
 package evm
 
 import (
+	"context"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -30,3 +32,8 @@ func NewLoopRelayServerAdapter(r *Relayer, cs EVMChainRelayerExtender) *LoopRela
 func (la *LoopRelayer) Chain() legacyevm.Chain {
 	return la.ext.Chain()
 }
+
+// NewChainWriter returns a ChainWriter of the chain, see Relayer.NewChainWriter.
+func (la *LoopRelayer) NewChainWriter(ctx context.Context, config []byte) (relay.ChainWriter, error) {
+	return relay.NewChainWriter(ctx, la.Relayer, config)
+}
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jmoiron/sqlx"
@@ -25,6 +26,7 @@ type ORM interface {
 	DeleteTransmitRequests(reqs []*pb.TransmitRequest, qopts ...pg.QOpt) error
 	GetTransmitRequests(jobID int32, qopts ...pg.QOpt) ([]*Transmission, error)
 	PruneTransmitRequests(jobID int32, maxSize int, qopts ...pg.QOpt) error
+	PruneExpiredTransmitRequests(jobID int32, maxAge time.Duration, qopts ...pg.QOpt) (int64, error)
 	LatestReport(ctx context.Context, feedID [32]byte, qopts ...pg.QOpt) (report []byte, err error)
 }
 
@@ -162,6 +164,21 @@ func (o *orm) PruneTransmitRequests(jobID int32, maxSize int, qopts ...pg.QOpt)
 	`, jobID, maxSize)
 }
 
+// PruneExpiredTransmitRequests deletes the requests of the given job ID that
+// were inserted more than maxAge ago, and returns the number of deleted rows.
+func (o *orm) PruneExpiredTransmitRequests(jobID int32, maxAge time.Duration, qopts ...pg.QOpt) (int64, error) {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`
+		DELETE FROM mercury_transmit_requests
+		WHERE job_id = $1 AND created_at < $2
+	`, jobID, time.Now().Add(-maxAge))
+	defer cancel()
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
 func (o *orm) LatestReport(ctx context.Context, feedID [32]byte, qopts ...pg.QOpt) (report []byte, err error) {
 	q := o.q.WithOpts(qopts...)
 	err = q.GetContext(ctx, &report, `SELECT report FROM feed_latest_reports WHERE feed_id = $1`, feedID[:])
@@ -2,6 +2,7 @@ package mercury
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/rand"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -208,6 +209,40 @@ func TestORM_PruneTransmitRequests(t *testing.T) {
 	}, transmissions)
 }
 
+func TestORM_PruneExpiredTransmitRequests(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	jobID := rand.Int32() // foreign key constraints disabled so value doesn't matter
+	pgtest.MustExec(t, db, `SET CONSTRAINTS mercury_transmit_requests_job_id_fkey DEFERRED`)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS feed_latest_reports_job_id_fkey DEFERRED`)
+
+	lggr := logger.TestLogger(t)
+	orm := NewORM(db, lggr, pgtest.NewQConfig(true))
+
+	reports := sampleReports
+
+	err := orm.InsertTransmitRequest(&pb.TransmitRequest{Payload: reports[0]}, jobID, ocrtypes.ReportContext{})
+	require.NoError(t, err)
+	err = orm.InsertTransmitRequest(&pb.TransmitRequest{Payload: reports[1]}, jobID, ocrtypes.ReportContext{})
+	require.NoError(t, err)
+	pgtest.MustExec(t, db, `UPDATE mercury_transmit_requests SET created_at = NOW() - interval '2 hours' WHERE payload_hash = $1`, hashPayload(reports[0]))
+
+	// No rows older than max age for another job ID, expect no-op
+	n, err := orm.PruneExpiredTransmitRequests(-1, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	// Expect the row older than max age to be pruned
+	n, err = orm.PruneExpiredTransmitRequests(jobID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	transmissions, err := orm.GetTransmitRequests(jobID)
+	require.NoError(t, err)
+	require.Equal(t, []*Transmission{
+		{Req: &pb.TransmitRequest{Payload: reports[1]}},
+	}, transmissions)
+}
+
 func TestORM_InsertTransmitRequest_LatestReport(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	jobID := rand.Int32() // foreign key constraints disabled so value doesn't matter
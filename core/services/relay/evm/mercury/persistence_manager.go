@@ -5,6 +5,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -17,6 +20,17 @@ import (
 var (
 	flushDeletesFrequency = time.Second
 	pruneFrequency        = time.Hour
+	// maxTransmitAge bounds how long a transmit request is kept in the
+	// database, so that reports which could never be transmitted are not
+	// replayed forever after restarts.
+	maxTransmitAge = 24 * time.Hour
+)
+
+var transmitQueueReplayCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "mercury_transmit_queue_replay_count",
+	Help: "Running count of untransmitted transmissions loaded from the database on startup",
+},
+	[]string{"feedID"},
 )
 
 type PersistenceManager struct {
@@ -33,19 +47,26 @@ type PersistenceManager struct {
 	jobID int32
 
 	maxTransmitQueueSize  int
+	maxTransmitAge        time.Duration
 	flushDeletesFrequency time.Duration
 	pruneFrequency        time.Duration
+
+	expiredCount prometheus.Counter
+	replayCount  prometheus.Counter
 }
 
-func NewPersistenceManager(lggr logger.Logger, orm ORM, jobID int32, maxTransmitQueueSize int, flushDeletesFrequency, pruneFrequency time.Duration) *PersistenceManager {
+func NewPersistenceManager(lggr logger.Logger, orm ORM, jobID int32, feedID string, maxTransmitQueueSize int, maxTransmitAge, flushDeletesFrequency, pruneFrequency time.Duration) *PersistenceManager {
 	return &PersistenceManager{
 		lggr:                  lggr.Named("MercuryPersistenceManager"),
 		orm:                   orm,
 		stopCh:                make(services.StopChan),
 		jobID:                 jobID,
 		maxTransmitQueueSize:  maxTransmitQueueSize,
+		maxTransmitAge:        maxTransmitAge,
 		flushDeletesFrequency: flushDeletesFrequency,
 		pruneFrequency:        pruneFrequency,
+		expiredCount:          transmitQueueDropCount.WithLabelValues(feedID, "expired"),
+		replayCount:           transmitQueueReplayCount.WithLabelValues(feedID),
 	}
 }
 
@@ -78,8 +99,37 @@ func (pm *PersistenceManager) AsyncDelete(req *pb.TransmitRequest) {
 	pm.addToDeleteQueue(req)
 }
 
+// Load returns the untransmitted requests of the job, after pruning the
+// expired ones.
 func (pm *PersistenceManager) Load(ctx context.Context) ([]*Transmission, error) {
-	return pm.orm.GetTransmitRequests(pm.jobID, pg.WithParentCtx(ctx))
+	if err := pm.pruneExpired(ctx); err != nil {
+		return nil, err
+	}
+	transmissions, err := pm.orm.GetTransmitRequests(pm.jobID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(transmissions) > 0 {
+		pm.lggr.Infow("Replaying untransmitted transmit requests", "count", len(transmissions))
+		pm.replayCount.Add(float64(len(transmissions)))
+	}
+	return transmissions, nil
+}
+
+// pruneExpired deletes the requests older than maxTransmitAge, if set.
+func (pm *PersistenceManager) pruneExpired(ctx context.Context) error {
+	if pm.maxTransmitAge == 0 {
+		return nil
+	}
+	n, err := pm.orm.PruneExpiredTransmitRequests(pm.jobID, pm.maxTransmitAge, pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		pm.lggr.Warnw("Dropped expired transmit requests", "count", n, "maxAge", pm.maxTransmitAge)
+		pm.expiredCount.Add(float64(n))
+	}
+	return nil
 }
 
 func (pm *PersistenceManager) runFlushDeletesLoop() {
@@ -119,6 +169,9 @@ func (pm *PersistenceManager) runPruneLoop() {
 			ticker.Stop()
 			return
 		case <-ticker.C:
+			if err := pm.pruneExpired(ctx); err != nil {
+				pm.lggr.Errorw("Failed to prune expired transmit requests", "err", err)
+			}
 			if err := pm.orm.PruneTransmitRequests(pm.jobID, pm.maxTransmitQueueSize, pg.WithParentCtx(ctx), pg.WithLongQueryTimeout()); err != nil {
 				pm.lggr.Errorw("Failed to prune transmit requests table", "err", err)
 			} else {
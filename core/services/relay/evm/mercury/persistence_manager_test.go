@@ -23,7 +23,7 @@ func bootstrapPersistenceManager(t *testing.T, jobID int32, db *sqlx.DB) (*Persi
 	t.Helper()
 	lggr, observedLogs := logger.TestLoggerObserved(t, zapcore.DebugLevel)
 	orm := NewORM(db, lggr, pgtest.NewQConfig(true))
-	return NewPersistenceManager(lggr, orm, jobID, 2, 5*time.Millisecond, 5*time.Millisecond), observedLogs
+	return NewPersistenceManager(lggr, orm, jobID, "", 2, time.Hour, 5*time.Millisecond, 5*time.Millisecond), observedLogs
 }
 
 func TestPersistenceManager(t *testing.T) {
@@ -34,6 +34,20 @@ var transmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	[]string{"feedID", "capacity"},
 )
 
+var transmitQueueOldestAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "mercury_transmit_queue_oldest_age_seconds",
+	Help: "Time since the oldest transmission in the transmit queue was queued, or was loaded from the database on startup",
+},
+	[]string{"feedID"},
+)
+
+var transmitQueueDropCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "mercury_transmit_queue_drop_count",
+	Help: "Running count of transmissions dropped without being transmitted, by reason (overflow: the queue was full, expired: the transmission exceeded the max age in the database)",
+},
+	[]string{"feedID", "reason"},
+)
+
 // Prometheus' default interval is 15s, set this to under 7.5s to avoid
 // aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 const promInterval = 6500 * time.Millisecond
@@ -53,18 +67,28 @@ type TransmitQueue struct {
 	closed bool
 
 	// monitor loop
-	stopMonitor       func()
-	transmitQueueLoad prometheus.Gauge
+	stopMonitor            func()
+	transmitQueueLoad      prometheus.Gauge
+	transmitQueueOldestAge prometheus.Gauge
+	transmitQueueDropCount prometheus.Counter
 }
 
 type Transmission struct {
 	Req       *pb.TransmitRequest    // the payload to transmit
 	ReportCtx ocrtypes.ReportContext // contains priority information (latest epoch/round wins)
+
+	queuedAt time.Time
 }
 
 // maxlen controls how many items will be stored in the queue
 // 0 means unlimited - be careful, this can cause memory leaks
 func NewTransmitQueue(lggr logger.Logger, feedID string, maxlen int, transmissions []*Transmission, asyncDeleter asyncDeleter) *TransmitQueue {
+	now := time.Now()
+	for _, t := range transmissions {
+		if t.queuedAt.IsZero() {
+			t.queuedAt = now
+		}
+	}
 	pq := priorityQueue(transmissions)
 	heap.Init(&pq) // ensure the heap is ordered
 	mu := new(sync.RWMutex)
@@ -79,6 +103,8 @@ func NewTransmitQueue(lggr logger.Logger, feedID string, maxlen int, transmissio
 		false,
 		nil,
 		transmitQueueLoad.WithLabelValues(feedID, fmt.Sprintf("%d", maxlen)),
+		transmitQueueOldestAge.WithLabelValues(feedID),
+		transmitQueueDropCount.WithLabelValues(feedID, "overflow"),
 	}
 }
 
@@ -97,9 +123,10 @@ func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.Report
 		if transmission, ok := removed.(*Transmission); ok {
 			tq.asyncDeleter.AsyncDelete(transmission.Req)
 		}
+		tq.transmitQueueDropCount.Inc()
 	}
 
-	heap.Push(tq.pq, &Transmission{req, reportCtx})
+	heap.Push(tq.pq, &Transmission{Req: req, ReportCtx: reportCtx, queuedAt: time.Now()})
 	tq.cond.Signal()
 
 	return true
@@ -171,8 +198,22 @@ func (tq *TransmitQueue) monitorLoop(c <-chan time.Time, chStop <-chan struct{},
 func (tq *TransmitQueue) report() {
 	tq.mu.RLock()
 	length := tq.pq.Len()
+	oldestAge := tq.oldestAge()
 	tq.mu.RUnlock()
 	tq.transmitQueueLoad.Set(float64(length))
+	tq.transmitQueueOldestAge.Set(oldestAge.Seconds())
+}
+
+// oldestAge returns the time since the oldest transmission was queued, or 0 if the queue is empty
+// Not thread-safe
+func (tq *TransmitQueue) oldestAge() (age time.Duration) {
+	now := time.Now()
+	for _, t := range *tq.pq {
+		if a := now.Sub(t.queuedAt); a > age {
+			age = a
+		}
+	}
+	return age
 }
 
 func (tq *TransmitQueue) Ready() error {
@@ -3,6 +3,7 @@ package mercury
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -139,9 +140,27 @@ func Test_Queue(t *testing.T) {
 			},
 		}
 		transmitQueue := NewTransmitQueue(lggr, "foo feed ID", 7, transmissions, deleter)
+		assert.False(t, transmissions[0].queuedAt.IsZero())
 
 		transmission := transmitQueue.BlockingPop()
 		assert.Equal(t, transmission.Req.Payload, []byte("new1"))
 		assert.True(t, transmitQueue.IsEmpty())
 	})
 }
+
+func Test_Queue_OldestAge(t *testing.T) {
+	t.Parallel()
+	testTransmissions := createTestTransmissions(t)
+	transmitQueue := NewTransmitQueue(logger.TestLogger(t), "foo feed ID", 7, nil, nil)
+	assert.Equal(t, time.Duration(0), transmitQueue.oldestAge())
+
+	for _, tt := range testTransmissions {
+		require.True(t, transmitQueue.Push(tt.tr, tt.ctx))
+	}
+	// simulate a transmission queued a minute ago
+	(*transmitQueue.pq)[0].queuedAt = time.Now().Add(-time.Minute)
+
+	age := transmitQueue.oldestAge()
+	assert.GreaterOrEqual(t, age, time.Minute)
+	assert.Less(t, age, 2*time.Minute)
+}
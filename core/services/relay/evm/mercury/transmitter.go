@@ -150,7 +150,7 @@ func getPayloadTypes() abi.Arguments {
 
 func NewTransmitter(lggr logger.Logger, cfgTracker ConfigTracker, rpcClient wsrpc.Client, fromAccount ed25519.PublicKey, jobID int32, feedID [32]byte, db *sqlx.DB, cfg pg.QConfig, codec TransmitterReportDecoder) *mercuryTransmitter {
 	feedIDHex := fmt.Sprintf("0x%x", feedID[:])
-	persistenceManager := NewPersistenceManager(lggr, NewORM(db, lggr, cfg), jobID, maxTransmitQueueSize, flushDeletesFrequency, pruneFrequency)
+	persistenceManager := NewPersistenceManager(lggr, NewORM(db, lggr, cfg), jobID, mercuryutils.FeedID(feedID).String(), maxTransmitQueueSize, maxTransmitAge, flushDeletesFrequency, pruneFrequency)
 	return &mercuryTransmitter{
 		services.StateMachine{},
 		lggr.Named("MercuryTransmitter").With("feedID", feedIDHex),
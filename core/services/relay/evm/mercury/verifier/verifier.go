@@ -0,0 +1,200 @@
+// Package verifier verifies full Mercury (Data Streams) reports offchain, the
+// way the onchain Verifier contract does, so that services consuming reports
+// through the node can validate them without calling the contract.
+package verifier
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/chains/evmutil"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
+	v1types "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/v1/types"
+	v2types "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/v2/types"
+	v3types "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/v3/types"
+)
+
+var (
+	ErrIncorrectSignatureCount = errors.New("incorrect signature count")
+	ErrBadSignature            = errors.New("bad signature")
+	ErrUnauthorizedSigner      = errors.New("unauthorized signer")
+	ErrDuplicateSigner         = errors.New("duplicate signer")
+)
+
+// payloadTypes is the encoding of the full reports returned by the Mercury server. It mirrors mercury.PayloadTypes,
+// which cannot be imported since the pipeline, which the mercury package depends on, uses the verifier.
+var payloadTypes = abi.Arguments{
+	{Name: "reportContext", Type: mustNewType("bytes32[3]")},
+	{Name: "report", Type: mustNewType("bytes")},
+	{Name: "rawRs", Type: mustNewType("bytes32[]")},
+	{Name: "rawSs", Type: mustNewType("bytes32[]")},
+	{Name: "rawVs", Type: mustNewType("bytes32")},
+}
+
+func mustNewType(t string) abi.Type {
+	result, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(fmt.Sprintf("Unexpected error during abi.NewType: %s", err))
+	}
+	return result
+}
+
+var schemas = map[utils.FeedVersion]abi.Arguments{
+	1: v1types.GetSchema(),
+	2: v2types.GetSchema(),
+	3: v3types.GetSchema(),
+}
+
+// Report is a full report decoded from the payload returned by the Mercury
+// server.
+type Report struct {
+	FeedID        utils.FeedID
+	ReportContext ocrtypes.ReportContext
+	// Report is the signed report, which is encoded with the schema of the
+	// version of the feed.
+	Report ocrtypes.Report
+	// Fields are the decoded fields of the report, keyed by their name in the
+	// schema of the version of the feed.
+	Fields map[string]interface{}
+	// Signatures are the 65 byte signatures of the report, in order.
+	Signatures [][]byte
+	// Signers are the addresses recovered from the signatures. They are only
+	// set by Verify.
+	Signers []common.Address
+}
+
+// Decode decodes the full report, without checking its signatures.
+func Decode(fullReport []byte) (*Report, error) {
+	values, err := payloadTypes.Unpack(fullReport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode full report: %w", err)
+	}
+	var payload struct {
+		ReportContext [3][32]byte
+		Report        []byte
+		RawRs         [][32]byte
+		RawSs         [][32]byte
+		RawVs         [32]byte
+	}
+	if err = payloadTypes.Copy(&payload, values); err != nil {
+		return nil, fmt.Errorf("failed to decode full report: %w", err)
+	}
+	if len(payload.RawRs) != len(payload.RawSs) {
+		return nil, fmt.Errorf("%w: got %d rs and %d ss", ErrIncorrectSignatureCount, len(payload.RawRs), len(payload.RawSs))
+	}
+	if len(payload.RawRs) > len(payload.RawVs) {
+		return nil, fmt.Errorf("%w: got %d signatures, max is %d", ErrIncorrectSignatureCount, len(payload.RawRs), len(payload.RawVs))
+	}
+
+	r := &Report{
+		ReportContext: reportContext(payload.ReportContext),
+		Report:        payload.Report,
+	}
+	if len(r.Report) < len(r.FeedID) {
+		return nil, fmt.Errorf("invalid length for report: %d", len(r.Report))
+	}
+	r.FeedID = utils.BytesToFeedID(r.Report[:len(r.FeedID)])
+	schema, ok := schemas[r.FeedID.Version()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported feed version %d", r.FeedID.Version())
+	}
+	r.Fields = make(map[string]interface{})
+	if err = schema.UnpackIntoMap(r.Fields, r.Report); err != nil {
+		return nil, fmt.Errorf("failed to decode v%d report: %w", r.FeedID.Version(), err)
+	}
+	for i := range payload.RawRs {
+		sig := make([]byte, 0, 65)
+		sig = append(sig, payload.RawRs[i][:]...)
+		sig = append(sig, payload.RawSs[i][:]...)
+		sig = append(sig, payload.RawVs[i])
+		r.Signatures = append(r.Signatures, sig)
+	}
+	return r, nil
+}
+
+// Verify decodes the full report and checks that it is signed by exactly f+1
+// distinct signers of the DON, given by the onchain public keys (addresses) of
+// its oracles, as the Verifier contract requires.
+func Verify(fullReport []byte, signers []common.Address, f int) (*Report, error) {
+	if f < 0 {
+		return nil, fmt.Errorf("invalid f: %d", f)
+	}
+	r, err := Decode(fullReport)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Signatures) != f+1 {
+		return nil, fmt.Errorf("%w: expected %d, got %d", ErrIncorrectSignatureCount, f+1, len(r.Signatures))
+	}
+
+	authorized := make(map[common.Address]bool, len(signers))
+	for _, s := range signers {
+		authorized[s] = true
+	}
+	hash := sigData(r.ReportContext, r.Report)
+	seen := make(map[common.Address]bool, len(r.Signatures))
+	for i, sig := range r.Signatures {
+		pubKey, err := crypto.SigToPub(hash, sig)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: %v", ErrBadSignature, i, err)
+		}
+		signer := crypto.PubkeyToAddress(*pubKey)
+		if !authorized[signer] {
+			return nil, fmt.Errorf("%w %s of signature %d", ErrUnauthorizedSigner, signer, i)
+		}
+		if seen[signer] {
+			return nil, fmt.Errorf("%w %s of signature %d", ErrDuplicateSigner, signer, i)
+		}
+		seen[signer] = true
+		r.Signers = append(r.Signers, signer)
+	}
+	return r, nil
+}
+
+// reportContext decodes the raw report context, following evmutil.RawReportContext.
+func reportContext(raw [3][32]byte) (ctx ocrtypes.ReportContext) {
+	ctx.ConfigDigest = raw[0]
+	ctx.Epoch = binary.BigEndian.Uint32(raw[1][27:31])
+	ctx.Round = raw[1][31]
+	ctx.ExtraHash = raw[2]
+	return ctx
+}
+
+// sigData returns the hash signed by the EVM onchain keyring of the oracles.
+func sigData(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) []byte {
+	rawReportContext := evmutil.RawReportContext(reportCtx)
+	data := crypto.Keccak256(report)
+	data = append(data, rawReportContext[0][:]...)
+	data = append(data, rawReportContext[1][:]...)
+	data = append(data, rawReportContext[2][:]...)
+	return crypto.Keccak256(data)
+}
+
+// ToMap returns the report as a map of JSON friendly values, with the decoded
+// fields of the report under "report".
+func (r *Report) ToMap() map[string]interface{} {
+	fields := make(map[string]interface{}, len(r.Fields))
+	for k, v := range r.Fields {
+		if b, ok := v.([32]byte); ok {
+			v = hexutil.Encode(b[:])
+		}
+		fields[k] = v
+	}
+	return map[string]interface{}{
+		"feedID":       r.FeedID.String(),
+		"version":      uint16(r.FeedID.Version()),
+		"configDigest": r.ReportContext.ConfigDigest.Hex(),
+		"epoch":        r.ReportContext.Epoch,
+		"round":        r.ReportContext.Round,
+		"signers":      r.Signers,
+		"report":       fields,
+	}
+}
@@ -0,0 +1,102 @@
+package verifier
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
+	v3types "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/v3/types"
+)
+
+var sampleFeedID = [32]byte{0, 3, 107, 74, 167, 229, 124, 167, 182, 138, 225, 191, 69, 101, 63, 86, 182, 86, 253, 58, 163, 53, 239, 127, 174, 105, 107, 102, 63, 27, 132, 114}
+
+func buildSampleV3Report(t *testing.T) ocrtypes.Report {
+	report, err := v3types.GetSchema().Pack(sampleFeedID, uint32(42), uint32(43), big.NewInt(1), big.NewInt(2), uint32(44), big.NewInt(100), big.NewInt(99), big.NewInt(101))
+	require.NoError(t, err)
+	return report
+}
+
+func signFullReport(t *testing.T, report ocrtypes.Report, reportCtx ocrtypes.ReportContext, keys ...ocr2key.KeyBundle) []byte {
+	var sigs []ocrtypes.AttributedOnchainSignature
+	for i, k := range keys {
+		sig, err := k.Sign(reportCtx, report)
+		require.NoError(t, err)
+		sigs = append(sigs, ocrtypes.AttributedOnchainSignature{Signature: sig, Signer: commontypes.OracleID(i)})
+	}
+	return mercury.BuildSamplePayload(report, reportCtx, sigs)
+}
+
+func signer(k ocr2key.KeyBundle) common.Address {
+	return common.BytesToAddress(k.PublicKey())
+}
+
+func TestVerify(t *testing.T) {
+	keys := make([]ocr2key.KeyBundle, 4)
+	var signers []common.Address
+	for i := range keys {
+		keys[i] = ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+		signers = append(signers, signer(keys[i]))
+	}
+	report := buildSampleV3Report(t)
+	reportCtx := ocrtypes.ReportContext{
+		ReportTimestamp: ocrtypes.ReportTimestamp{ConfigDigest: ocrtypes.ConfigDigest{1}, Epoch: 5, Round: 6},
+		ExtraHash:       [32]byte{7},
+	}
+
+	t.Run("valid report", func(t *testing.T) {
+		r, err := Verify(signFullReport(t, report, reportCtx, keys[2], keys[0]), signers, 1)
+		require.NoError(t, err)
+		assert.Equal(t, sampleFeedID, [32]byte(r.FeedID))
+		assert.Equal(t, reportCtx, r.ReportContext)
+		assert.Equal(t, report, r.Report)
+		assert.Equal(t, []common.Address{signers[2], signers[0]}, r.Signers)
+		assert.Equal(t, uint32(43), r.Fields["observationsTimestamp"])
+		assert.Equal(t, big.NewInt(100), r.Fields["benchmarkPrice"])
+		assert.Equal(t, big.NewInt(101), r.Fields["ask"])
+	})
+
+	t.Run("incorrect signature count", func(t *testing.T) {
+		_, err := Verify(signFullReport(t, report, reportCtx, keys[0]), signers, 1)
+		assert.ErrorIs(t, err, ErrIncorrectSignatureCount)
+	})
+
+	t.Run("unauthorized signer", func(t *testing.T) {
+		_, err := Verify(signFullReport(t, report, reportCtx, keys[0], keys[3]), signers[:3], 1)
+		assert.ErrorIs(t, err, ErrUnauthorizedSigner)
+	})
+
+	t.Run("duplicate signer", func(t *testing.T) {
+		_, err := Verify(signFullReport(t, report, reportCtx, keys[1], keys[1]), signers, 1)
+		assert.ErrorIs(t, err, ErrDuplicateSigner)
+	})
+
+	t.Run("tampered report context", func(t *testing.T) {
+		signed := reportCtx
+		signed.Round++
+		fullReport := signFullReport(t, report, signed, keys[0], keys[1])
+		// re-encode the signatures of the other round with the original context
+		r, err := Decode(fullReport)
+		require.NoError(t, err)
+		var sigs []ocrtypes.AttributedOnchainSignature
+		for _, sig := range r.Signatures {
+			sigs = append(sigs, ocrtypes.AttributedOnchainSignature{Signature: sig})
+		}
+		_, err = Verify(mercury.BuildSamplePayload(report, reportCtx, sigs), signers, 1)
+		assert.ErrorIs(t, err, ErrUnauthorizedSigner)
+	})
+
+	t.Run("invalid full report", func(t *testing.T) {
+		_, err := Verify([]byte{1, 2, 3}, signers, 1)
+		assert.ErrorContains(t, err, "failed to decode full report")
+	})
+}
@@ -0,0 +1,234 @@
+package wsrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+const (
+	// healthScoreDecay is the weight of the previous health score when a
+	// request completes, so that the score tracks the success rate of the
+	// recent requests to a server.
+	healthScoreDecay = 0.9
+)
+
+var serverHealthScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "mercury_server_health_score",
+	Help: "Health score of a mercury server of a failover pool, between 0 and 1, based on the success rate of the recent requests to it",
+},
+	[]string{"serverURL"},
+)
+
+var _ Client = &failoverClient{}
+
+type scoredClient struct {
+	Client
+	// score is the exponentially weighted success rate of the requests to the client
+	score float64
+}
+
+// failoverClient sends requests to the healthiest of several mercury servers,
+// failing over to the next ones if a request fails. In split mode, reports are
+// transmitted to all the servers, which are expected to dedupe them.
+type failoverClient struct {
+	services.StateMachine
+	lggr  logger.Logger
+	split bool
+
+	mu      sync.RWMutex
+	clients []*scoredClient
+}
+
+// NewFailoverClient returns a client for the given clients, in order of
+// preference. If split is set, every transmit is sent to all the clients.
+func NewFailoverClient(lggr logger.Logger, clients []Client, split bool) Client {
+	fc := &failoverClient{
+		lggr:  lggr.Named("WSRPCFailover"),
+		split: split,
+	}
+	for _, c := range clients {
+		fc.clients = append(fc.clients, &scoredClient{Client: c, score: 1})
+		serverHealthScore.WithLabelValues(c.ServerURL()).Set(1)
+	}
+	return fc
+}
+
+func (fc *failoverClient) Start(ctx context.Context) error {
+	return fc.StartOnce("WSRPC Failover Client", func() error {
+		for i, c := range fc.clients {
+			if err := c.Start(ctx); err != nil {
+				for _, started := range fc.clients[:i] {
+					if cerr := started.Close(); cerr != nil {
+						err = errors.Join(err, cerr)
+					}
+				}
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (fc *failoverClient) Close() error {
+	return fc.StopOnce("WSRPC Failover Client", func() (err error) {
+		for _, c := range fc.clients {
+			err = errors.Join(err, c.Close())
+		}
+		return
+	})
+}
+
+func (fc *failoverClient) Name() string {
+	return "EVM.Mercury.WSRPCFailoverClient"
+}
+
+func (fc *failoverClient) HealthReport() map[string]error {
+	return map[string]error{fc.Name(): fc.Healthy()}
+}
+
+// Healthy if any of the servers is connected
+func (fc *failoverClient) Healthy() (err error) {
+	if err = fc.StateMachine.Healthy(); err != nil {
+		return err
+	}
+	var merr error
+	for _, c := range fc.clients {
+		cerr := healthy(c.Client)
+		if cerr == nil {
+			return nil
+		}
+		merr = errors.Join(merr, fmt.Errorf("%s: %w", c.ServerURL(), cerr))
+	}
+	return merr
+}
+
+func (fc *failoverClient) Transmit(ctx context.Context, req *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+	if fc.split {
+		return fc.transmitToAll(ctx, req)
+	}
+	var merr error
+	for _, c := range fc.ranked() {
+		resp, err := c.Transmit(ctx, req)
+		fc.observe(c, err)
+		if err == nil {
+			return resp, nil
+		}
+		merr = errors.Join(merr, fmt.Errorf("%s: %w", c.ServerURL(), err))
+		if ctx.Err() != nil {
+			break
+		}
+		fc.lggr.Warnw("Transmit failed, failing over to next mercury server", "serverURL", c.ServerURL(), "err", err)
+	}
+	return nil, merr
+}
+
+// transmitToAll sends the request to all the servers concurrently. It returns
+// the response of the healthiest server the transmit succeeded on.
+func (fc *failoverClient) transmitToAll(ctx context.Context, req *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+	clients := fc.ranked()
+	resps := make([]*pb.TransmitResponse, len(clients))
+	errs := make([]error, len(clients))
+	var wg sync.WaitGroup
+	wg.Add(len(clients))
+	for i, c := range clients {
+		go func(i int, c *scoredClient) {
+			defer wg.Done()
+			resps[i], errs[i] = c.Transmit(ctx, req)
+			fc.observe(c, errs[i])
+		}(i, c)
+	}
+	wg.Wait()
+
+	var merr error
+	for i, c := range clients {
+		if errs[i] == nil {
+			return resps[i], nil
+		}
+		merr = errors.Join(merr, fmt.Errorf("%s: %w", c.ServerURL(), errs[i]))
+	}
+	return nil, merr
+}
+
+func (fc *failoverClient) LatestReport(ctx context.Context, req *pb.LatestReportRequest) (*pb.LatestReportResponse, error) {
+	var merr error
+	for _, c := range fc.ranked() {
+		resp, err := c.LatestReport(ctx, req)
+		fc.observe(c, err)
+		if err == nil {
+			return resp, nil
+		}
+		merr = errors.Join(merr, fmt.Errorf("%s: %w", c.ServerURL(), err))
+		if ctx.Err() != nil {
+			break
+		}
+		fc.lggr.Warnw("LatestReport failed, failing over to next mercury server", "serverURL", c.ServerURL(), "err", err)
+	}
+	return nil, merr
+}
+
+// ServerURL returns the URL of the healthiest server
+func (fc *failoverClient) ServerURL() string {
+	return fc.ranked()[0].ServerURL()
+}
+
+// RawClient returns the raw client of the healthiest server
+func (fc *failoverClient) RawClient() pb.MercuryClient {
+	return fc.ranked()[0].RawClient()
+}
+
+// ranked returns the clients ordered by health: connected servers first, then
+// by descending score, then in order of preference.
+func (fc *failoverClient) ranked() []*scoredClient {
+	fc.mu.RLock()
+	defer fc.mu.RUnlock()
+	type rankedClient struct {
+		*scoredClient
+		connected bool
+		score     float64
+	}
+	rcs := make([]rankedClient, len(fc.clients))
+	for i, c := range fc.clients {
+		rcs[i] = rankedClient{c, healthy(c.Client) == nil, c.score}
+	}
+	sort.SliceStable(rcs, func(i, j int) bool {
+		if rcs[i].connected != rcs[j].connected {
+			return rcs[i].connected
+		}
+		return rcs[i].score > rcs[j].score
+	})
+	ranked := make([]*scoredClient, len(rcs))
+	for i, rc := range rcs {
+		ranked[i] = rc.scoredClient
+	}
+	return ranked
+}
+
+// healthy returns whether the client is connected, for the clients that report it
+func healthy(c Client) error {
+	if h, ok := c.(interface{ Healthy() error }); ok {
+		return h.Healthy()
+	}
+	return c.Ready()
+}
+
+// observe updates the health score of the client with the outcome of a request
+func (fc *failoverClient) observe(c *scoredClient, err error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	c.score *= healthScoreDecay
+	if err == nil {
+		c.score += 1 - healthScoreDecay
+	}
+	serverHealthScore.WithLabelValues(c.ServerURL()).Set(c.score)
+}
@@ -0,0 +1,132 @@
+package wsrpc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+type mockServer struct {
+	mocks.MockWSRPCClient
+	url       string
+	healthErr error
+	transmits atomic.Int32
+}
+
+func (m *mockServer) ServerURL() string { return m.url }
+func (m *mockServer) Healthy() error    { return m.healthErr }
+
+func newMockServer(url string, transmitErr error) *mockServer {
+	m := &mockServer{url: url}
+	m.TransmitF = func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+		m.transmits.Add(1)
+		if transmitErr != nil {
+			return nil, transmitErr
+		}
+		return &pb.TransmitResponse{Code: 1, Error: m.url}, nil
+	}
+	m.LatestReportF = func(ctx context.Context, in *pb.LatestReportRequest) (*pb.LatestReportResponse, error) {
+		if transmitErr != nil {
+			return nil, transmitErr
+		}
+		return &pb.LatestReportResponse{Error: m.url}, nil
+	}
+	return m
+}
+
+func Test_FailoverClient_Transmit(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+	req := &pb.TransmitRequest{}
+
+	t.Run("transmits to the primary server if it is healthy", func(t *testing.T) {
+		primary, failover := newMockServer("primary", nil), newMockServer("failover", nil)
+		fc := NewFailoverClient(lggr, []Client{primary, failover}, false)
+
+		resp, err := fc.Transmit(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "primary", resp.Error)
+		assert.Equal(t, int32(0), failover.transmits.Load())
+		assert.Equal(t, "primary", fc.ServerURL())
+	})
+
+	t.Run("fails over if the primary server fails", func(t *testing.T) {
+		primary, failover := newMockServer("primary", errors.New("boom")), newMockServer("failover", nil)
+		fc := NewFailoverClient(lggr, []Client{primary, failover}, false)
+
+		resp, err := fc.Transmit(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "failover", resp.Error)
+
+		// the failed primary now has a lower score than the failover server
+		resp, err = fc.Transmit(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "failover", resp.Error)
+		assert.Equal(t, int32(1), primary.transmits.Load())
+		assert.Equal(t, "failover", fc.ServerURL())
+	})
+
+	t.Run("prefers connected servers", func(t *testing.T) {
+		primary, failover := newMockServer("primary", nil), newMockServer("failover", nil)
+		primary.healthErr = errors.New("disconnected")
+		fc := NewFailoverClient(lggr, []Client{primary, failover}, false)
+		servicetest.Run(t, fc)
+
+		resp, err := fc.Transmit(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "failover", resp.Error)
+		assert.Equal(t, int32(0), primary.transmits.Load())
+		require.NoError(t, fc.(*failoverClient).Healthy())
+
+		failover.healthErr = errors.New("disconnected")
+		assert.ErrorContains(t, fc.(*failoverClient).Healthy(), "primary: disconnected")
+	})
+
+	t.Run("returns all the errors if all servers fail", func(t *testing.T) {
+		primary, failover := newMockServer("primary", errors.New("boom")), newMockServer("failover", errors.New("bang"))
+		fc := NewFailoverClient(lggr, []Client{primary, failover}, false)
+
+		_, err := fc.Transmit(ctx, req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "primary: boom")
+		assert.Contains(t, err.Error(), "failover: bang")
+	})
+
+	t.Run("split transmission transmits to all servers", func(t *testing.T) {
+		primary, failover := newMockServer("primary", errors.New("boom")), newMockServer("failover", nil)
+		fc := NewFailoverClient(lggr, []Client{primary, failover}, true)
+
+		resp, err := fc.Transmit(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "failover", resp.Error)
+		assert.Equal(t, int32(1), primary.transmits.Load())
+		assert.Equal(t, int32(1), failover.transmits.Load())
+
+		failover2 := newMockServer("failover2", errors.New("bang"))
+		fc = NewFailoverClient(lggr, []Client{primary, failover2}, true)
+		_, err = fc.Transmit(ctx, req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "primary: boom")
+		assert.Contains(t, err.Error(), "failover2: bang")
+	})
+}
+
+func Test_FailoverClient_LatestReport(t *testing.T) {
+	ctx := testutils.Context(t)
+	primary, failover := newMockServer("primary", errors.New("boom")), newMockServer("failover", nil)
+	fc := NewFailoverClient(logger.TestLogger(t), []Client{primary, failover}, true)
+
+	resp, err := fc.LatestReport(ctx, &pb.LatestReportRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "failover", resp.Error)
+}
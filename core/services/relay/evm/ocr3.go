@@ -0,0 +1,135 @@
+package evm
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/common/tracing"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// OCR3Provider provides the components of OCR3 plugins whose reports have info of type RI, transmitted to EVM
+// contracts.
+type OCR3Provider[RI any] interface {
+	commontypes.ConfigProvider
+	OCR3ContractTransmitter() ocr3types.ContractTransmitter[RI]
+	// ChainReader returns the ChainReaderService of the contract, or nil if the relay config has none.
+	ChainReader() ChainReaderService
+}
+
+var _ OCR3Provider[struct{}] = (*ocr3Provider[struct{}])(nil)
+
+// NewOCR3Provider returns the OCR3Provider of the contract of rargs. OCR3 contracts emit the same ConfigSet event and
+// digest their configs the same way as OCR2 contracts, so their config is tracked by the config poller of OCR2
+// providers, while reports are transmitted with an OCR3 report context.
+func NewOCR3Provider[RI any](r *Relayer, rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (OCR3Provider[RI], error) {
+	lggr := r.lggr.Named("OCR3Provider").Named(rargs.ExternalJobID.String())
+	relayOpts := types.NewRelayOpts(rargs)
+	relayConfig, err := relayOpts.RelayConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relay config: %w", err)
+	}
+	expectedChainID := relayConfig.ChainID.String()
+	if expectedChainID != r.chain.ID().String() {
+		return nil, fmt.Errorf("internal error: chain id in spec does not match this relayer's chain: have %s expected %s", relayConfig.ChainID.String(), r.chain.ID().String())
+	}
+
+	configWatcher, err := newConfigProvider(lggr, r.chain, relayOpts)
+	if err != nil {
+		return nil, err
+	}
+	contractTransmitter, err := newContractTransmitter(lggr, rargs, pargs.TransmitterID, r.ks.Eth(), configWatcher, configTransmitterOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &ocr3Provider[RI]{
+		configWatcher:       configWatcher,
+		contractTransmitter: newOCR3ContractTransmitter[RI](contractTransmitter),
+	}
+	if relayConfig.ChainReader != nil {
+		provider.chainReader, err = NewChainReaderService(lggr, r.chain.LogPoller(), r.chain.Client(), common.HexToAddress(relayOpts.ContractID), *relayConfig.ChainReader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return provider, nil
+}
+
+type ocr3Provider[RI any] struct {
+	*configWatcher
+	contractTransmitter *ocr3ContractTransmitter[RI]
+	chainReader         ChainReaderService
+	ms                  services.MultiStart
+}
+
+func (p *ocr3Provider[RI]) Start(ctx context.Context) error {
+	srvs := []services.StartClose{p.configWatcher}
+	if p.chainReader != nil {
+		srvs = append(srvs, p.chainReader)
+	}
+	return p.ms.Start(ctx, srvs...)
+}
+
+func (p *ocr3Provider[RI]) Close() error { return p.ms.Close() }
+
+func (p *ocr3Provider[RI]) HealthReport() map[string]error {
+	hp := p.configWatcher.HealthReport()
+	if p.chainReader != nil {
+		services.CopyHealth(hp, p.chainReader.HealthReport())
+	}
+	return hp
+}
+
+func (p *ocr3Provider[RI]) OCR3ContractTransmitter() ocr3types.ContractTransmitter[RI] {
+	return p.contractTransmitter
+}
+
+func (p *ocr3Provider[RI]) ChainReader() ChainReaderService {
+	return p.chainReader
+}
+
+// RawOCR3ReportContext returns the report context OCR3 contracts verify the signatures of reports with: the config
+// digest, the sequence number of the report in the last 8 bytes of the second word, and an empty third word.
+func RawOCR3ReportContext(digest ocrtypes.ConfigDigest, seqNr uint64) [3][32]byte {
+	var rawReportCtx [3][32]byte
+	rawReportCtx[0] = digest
+	binary.BigEndian.PutUint64(rawReportCtx[1][24:], seqNr)
+	return rawReportCtx
+}
+
+var _ ocr3types.ContractTransmitter[struct{}] = (*ocr3ContractTransmitter[struct{}])(nil)
+
+// ocr3ContractTransmitter transmits OCR3 reports with the Transmit method of the contract, which OCR3 contracts share
+// with OCR2 contracts, so that the transmitter is not forked for each OCR3 product.
+type ocr3ContractTransmitter[RI any] struct {
+	contractTransmitter *contractTransmitter
+}
+
+func newOCR3ContractTransmitter[RI any](contractTransmitter *contractTransmitter) *ocr3ContractTransmitter[RI] {
+	return &ocr3ContractTransmitter[RI]{contractTransmitter: contractTransmitter}
+}
+
+// Transmit sends the report of sequence number seqNr to the on-chain smart contract's Transmit method.
+func (t *ocr3ContractTransmitter[RI]) Transmit(ctx context.Context, digest ocrtypes.ConfigDigest, seqNr uint64, reportWithInfo ocr3types.ReportWithInfo[RI], signatures []ocrtypes.AttributedOnchainSignature) (err error) {
+	ctx, span := tracing.Start(ctx, "ocr3.transmit", attribute.String("configDigest", digest.Hex()),
+		attribute.Int64("seqNr", int64(seqNr)))
+	defer func() { tracing.End(span, err) }()
+
+	return t.contractTransmitter.transmit(ctx, RawOCR3ReportContext(digest, seqNr), reportWithInfo.Report, signatures)
+}
+
+// FromAccount returns the account from which the transmitter invokes the contract
+func (t *ocr3ContractTransmitter[RI]) FromAccount() (ocrtypes.Account, error) {
+	return t.contractTransmitter.FromAccount()
+}
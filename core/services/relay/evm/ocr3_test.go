@@ -0,0 +1,73 @@
+package evm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type recordingTransmitter struct {
+	mockTransmitter
+	payloads [][]byte
+}
+
+func (r *recordingTransmitter) CreateEthTransaction(_ context.Context, _ gethcommon.Address, payload []byte, _ *txmgr.TxMeta) error {
+	r.payloads = append(r.payloads, payload)
+	return nil
+}
+
+func TestRawOCR3ReportContext(t *testing.T) {
+	t.Parallel()
+
+	digest := ocrtypes.ConfigDigest{0x00, 0x01, 0xaa}
+	rawReportCtx := RawOCR3ReportContext(digest, 0x0102030405060708)
+	assert.Equal(t, [32]byte(digest), rawReportCtx[0])
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000102030405060708", gethcommon.Bytes2Hex(rawReportCtx[1][:]))
+	assert.Equal(t, [32]byte{}, rawReportCtx[2])
+}
+
+func TestOCR3ContractTransmitter(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.JSON(strings.NewReader(ocr2aggregator.OCR2AggregatorABI))
+	require.NoError(t, err)
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("RegisterFilter", mock.Anything).Return(nil)
+	transmitter := &recordingTransmitter{}
+	ct, err := NewOCRContractTransmitter(testutils.NewAddress(), evmclimocks.NewClient(t), contractABI, transmitter, lp, logger.TestLogger(t), nil)
+	require.NoError(t, err)
+	ot := newOCR3ContractTransmitter[struct{}](ct)
+
+	digest := ocrtypes.ConfigDigest{0x00, 0x01, 0xbb}
+	signature := make([]byte, 65)
+	signature[64] = 1
+	report := ocr3types.ReportWithInfo[struct{}]{Report: ocrtypes.Report{0xde, 0xad}}
+	require.NoError(t, ot.Transmit(testutils.Context(t), digest, 1<<40, report, []ocrtypes.AttributedOnchainSignature{{Signature: signature}}))
+
+	require.Len(t, transmitter.payloads, 1)
+	args, err := contractABI.Methods["transmit"].Inputs.Unpack(transmitter.payloads[0][4:])
+	require.NoError(t, err)
+	assert.Equal(t, RawOCR3ReportContext(digest, 1<<40), args[0])
+	assert.Equal(t, []byte{0xde, 0xad}, args[1])
+	assert.Equal(t, byte(1), args[4].([32]byte)[0])
+
+	from, err := ot.FromAccount()
+	require.NoError(t, err)
+	assert.Equal(t, sampleAddress.String(), string(from))
+}
@@ -139,26 +139,50 @@ func NewChainRelayerExtenders(ctx context.Context, opts legacyevm.ChainRelayExte
 	var result []*ChainRelayerExt
 	var err error
 	for i := range enabled {
-
-		cid := enabled[i].ChainID.String()
-		privOpts := legacyevm.ChainRelayExtenderConfig{
-			Logger:    opts.Logger.Named(cid),
-			ChainOpts: opts.ChainOpts,
-			KeyStore:  opts.KeyStore,
-		}
-
-		privOpts.Logger.Infow(fmt.Sprintf("Loading chain %s", cid), "evmChainID", cid)
-		chain, err2 := legacyevm.NewTOMLChain(ctx, enabled[i], privOpts)
+		s, err2 := newChainRelayerExt(ctx, enabled[i], opts)
 		if err2 != nil {
-			err = multierr.Combine(err, fmt.Errorf("failed to create chain %s: %w", cid, err2))
+			err = multierr.Combine(err, err2)
 			continue
 		}
-
-		s := &ChainRelayerExt{
-			chain: chain,
-		}
 		result = append(result, s)
 	}
 	// always return because it's accumulating errors
 	return newChainRelayerExtsFromSlice(result, opts.AppConfig), err
 }
+
+// NewChainRelayerExtender creates the extender of the single chain with the given ID, which must be enabled in the
+// config. It is used to recreate a chain at runtime, after it was closed.
+func NewChainRelayerExtender(ctx context.Context, opts legacyevm.ChainRelayExtenderConfig, chainID string) (*ChainRelayerExt, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	for _, cfg := range opts.AppConfig.EVMConfigs() {
+		if cfg.ChainID.String() != chainID {
+			continue
+		}
+		if !cfg.IsEnabled() {
+			return nil, fmt.Errorf("evm chain %s is disabled in the config", chainID)
+		}
+		return newChainRelayerExt(ctx, cfg, opts)
+	}
+	return nil, fmt.Errorf("evm chain %s is not configured", chainID)
+}
+
+func newChainRelayerExt(ctx context.Context, cfg *toml.EVMConfig, opts legacyevm.ChainRelayExtenderConfig) (*ChainRelayerExt, error) {
+	cid := cfg.ChainID.String()
+	privOpts := legacyevm.ChainRelayExtenderConfig{
+		Logger:    opts.Logger.Named(cid),
+		ChainOpts: opts.ChainOpts,
+		KeyStore:  opts.KeyStore,
+	}
+
+	privOpts.Logger.Infow(fmt.Sprintf("Loading chain %s", cid), "evmChainID", cid)
+	chain, err := legacyevm.NewTOMLChain(ctx, cfg, privOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chain %s: %w", cid, err)
+	}
+
+	return &ChainRelayerExt{
+		chain: chain,
+	}, nil
+}
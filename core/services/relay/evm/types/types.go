@@ -13,20 +13,28 @@ import (
 
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/profitability"
 )
 
 type ChainReaderConfig struct {
 	// ChainContractReaders key is contract name
 	ChainContractReaders map[string]ChainContractReader `json:"chainContractReaders"`
+	// VerifyBytecode checks that the configured methods and events exist in the bytecode of the contract when the
+	// ChainReader is created, instead of failing to read them at runtime.
+	VerifyBytecode bool `json:"verifyBytecode"`
 }
 
 type ChainContractReader struct {
 	ContractABI string `json:"contractABI"`
+	// ImplementationABIs are the ABIs to decode with instead of ContractABI, while the contract is an EIP-1967 proxy
+	// pointing to the implementation they are keyed by, so that reads keep decoding after upgrades change the layout.
+	ImplementationABIs map[common.Address]string `json:"implementationABIs"`
 	// ChainReaderDefinitions key is chainAgnostic read name.
 	ChainReaderDefinitions map[string]ChainReaderDefinition `json:"chainReaderDefinitions"`
 }
@@ -37,6 +45,69 @@ type ChainReaderDefinition struct {
 	ReturnValues      []string       `json:"returnValues"`
 	CacheEnabled      bool           `json:"cacheEnabled"`
 	ReadType          ReadType       `json:"readType"`
+	// Timeout bounds each attempt of the read, so that one slow RPC call cannot consume the deadline shared with the
+	// other reads of an observation. Unset, the read is only bounded by the deadline of the caller.
+	Timeout *commonconfig.Duration `json:"timeout,omitempty"`
+	// Retries is how many times a failed read is retried, as long as the deadline of the caller leaves time for another
+	// attempt.
+	Retries uint32 `json:"retries,omitempty"`
+	// MaxStaleness is how old the newest matching log of an event read may be, so that plugins are not silently handed
+	// ancient data. Unset, logs of any age are returned.
+	MaxStaleness *commonconfig.Duration `json:"maxStaleness,omitempty"`
+	// SequenceField is an indexed uint input of an event read which increases with each event, like a request id, so
+	// that the events after a sequence number can be read, to consume each event exactly once. It is not a param.
+	SequenceField string `json:"sequenceField,omitempty"`
+}
+
+type ChainWriterConfig struct {
+	// Contracts key is the chain agnostic contract name.
+	Contracts map[string]ChainContractWriter `json:"contracts"`
+}
+
+type ChainContractWriter struct {
+	ContractABI string `json:"contractABI"`
+	// Configs key is the chain agnostic method name.
+	Configs map[string]ChainWriterDefinition `json:"configs"`
+}
+
+type ChainWriterDefinition struct {
+	ChainSpecificName string `json:"chainSpecificName"` // chain specific contract method name.
+	// FromAddress is the sending key the transactions of the method are sent from.
+	FromAddress common.Address `json:"fromAddress"`
+	// GasLimit is the gas limit of the transactions of the method.
+	GasLimit uint32 `json:"gasLimit"`
+}
+
+// ChainCodecConfig configures how an item type is encoded. Items are either ABI encoded as TypeABI, or packed like
+// abi.encodePacked as the Packed fields, in order.
+type ChainCodecConfig struct {
+	// TypeABI is the JSON ABI of the arguments the item is ABI encoded as.
+	TypeABI string `json:"typeAbi,omitempty"`
+	// Packed are the fields of the item, when it is packed instead of ABI encoded.
+	Packed []PackedField `json:"packed,omitempty"`
+	// Addresses are the off-chain representations of address fields, keyed by field name. Fields which are not set are
+	// represented as common.Address.
+	Addresses map[string]AddressRepresentation `json:"addresses,omitempty"`
+}
+
+// AddressRepresentation is the off-chain representation of an address field.
+type AddressRepresentation string
+
+const (
+	// AddressString represents addresses as EIP-55 checksummed hex strings.
+	AddressString AddressRepresentation = "string"
+	// AddressBytes represents addresses as 20 byte slices.
+	AddressBytes AddressRepresentation = "bytes"
+)
+
+// PackedField is a field of a packed item.
+type PackedField struct {
+	Name string `json:"name"`
+	// Type is an ABI elementary type: address, bool, intN, uintN, bytesN, bytes or string.
+	Type string `json:"type"`
+	// Size is the number of bytes the field is packed into. Zero means the size of Type, or the rest of the data for
+	// bytes and string, which can only be the last field.
+	Size int `json:"size,omitempty"`
 }
 
 type ReadType int64
@@ -55,6 +126,11 @@ type RelayConfig struct {
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
+	// Profitability, if set, skips the transmissions whose estimated gas cost exceeds their expected payment.
+	Profitability *profitability.Config `json:"profitability"`
+	// CalldataCompression opts the contract into the EVM.OCR2.CalldataCompression of its chain, if its contract
+	// decompresses calldata.
+	CalldataCompression bool `json:"calldataCompression"`
 
 	// Mercury-specific
 	FeedID *common.Hash `json:"feedID"`
@@ -17,6 +17,7 @@ const (
 	Cosmos   = "cosmos"
 	Solana   = "solana"
 	StarkNet = "starknet"
+	Aptos    = "aptos"
 )
 
 var SupportedRelays = map[Network]struct{}{
@@ -24,6 +25,7 @@ var SupportedRelays = map[Network]struct{}{
 	Cosmos:   {},
 	Solana:   {},
 	StarkNet: {},
+	Aptos:    {},
 }
 
 // ID uniquely identifies a relayer by network and chain id
@@ -44,7 +46,7 @@ func NewID(n Network, c ChainID) ID {
 }
 
 var idRegex = regexp.MustCompile(
-	fmt.Sprintf("^((%s)|(%s)|(%s)|(%s))\\.", EVM, Cosmos, Solana, StarkNet),
+	fmt.Sprintf("^((%s)|(%s)|(%s)|(%s)|(%s))\\.", EVM, Cosmos, Solana, StarkNet, Aptos),
 )
 
 func (i *ID) UnmarshalString(s string) error {
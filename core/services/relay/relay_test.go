@@ -1,9 +1,11 @@
 package relay
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -33,6 +35,11 @@ func TestIdentifier_UnmarshalString(t *testing.T) {
 			wantErr: false,
 			want:    fields{Network: EVM, ChainID: "1"},
 		},
+		{name: "aptos",
+			args:    args{s: "aptos.2"},
+			wantErr: false,
+			want:    fields{Network: Aptos, ChainID: "2"},
+		},
 		{name: "bad network",
 			args:    args{s: "notANetwork.1"},
 			wantErr: true,
@@ -185,3 +192,28 @@ func TestRelayerServerAdapter(t *testing.T) {
 		}
 	}
 }
+
+type fakeChainWriterRelayer struct {
+	ChainWriter
+	config []byte
+}
+
+func (r *fakeChainWriterRelayer) NewChainWriter(_ context.Context, config []byte) (ChainWriter, error) {
+	r.config = config
+	return r, nil
+}
+
+func TestNewChainWriter(t *testing.T) {
+	ctx := testutils.Context(t)
+	r := &fakeChainWriterRelayer{}
+	cw, err := NewChainWriter(ctx, r, []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, r, cw)
+	assert.Equal(t, []byte("{}"), r.config)
+
+	_, err = NewChainWriter(ctx, struct{}{}, nil)
+	require.ErrorIs(t, err, ErrChainWriterUnsupported)
+
+	_, err = NewServerAdapter(&mockRelayer{}, mockRelayerExt{}).NewChainWriter(ctx, nil)
+	require.ErrorIs(t, err, ErrChainWriterUnsupported)
+}
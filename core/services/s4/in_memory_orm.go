@@ -74,6 +74,17 @@ func (o *inMemoryOrm) Update(row *Row, qopts ...pg.QOpt) error {
 	return nil
 }
 
+func (o *inMemoryOrm) Delete(address *big.Big, slotId uint, qopts ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.rows, key{
+		address: address.Hex(),
+		slot:    slotId,
+	})
+	return nil
+}
+
 func (o *inMemoryOrm) DeleteExpired(limit uint, now time.Time, qopts ...pg.QOpt) (int64, error) {
 	o.mu.Lock()
 	defer o.mu.Unlock()
@@ -101,13 +112,15 @@ func (o *inMemoryOrm) GetSnapshot(addressRange *AddressRange, qopts ...pg.QOpt)
 	now := time.Now().UnixMilli()
 	var rows []*SnapshotRow
 	for _, mrow := range o.rows {
-		if mrow.Row.Expiration > now {
+		if mrow.Row.Expiration > now && addressRange.Contains(mrow.Row.Address) {
 			rows = append(rows, &SnapshotRow{
-				Address:    big.New(mrow.Row.Address.ToInt()),
-				SlotId:     mrow.Row.SlotId,
-				Version:    mrow.Row.Version,
-				Expiration: mrow.Row.Expiration,
-				Confirmed:  mrow.Row.Confirmed,
+				Address:     big.New(mrow.Row.Address.ToInt()),
+				SlotId:      mrow.Row.SlotId,
+				Version:     mrow.Row.Version,
+				Expiration:  mrow.Row.Expiration,
+				Confirmed:   mrow.Row.Confirmed,
+				PayloadSize: uint64(len(mrow.Row.Payload)),
+				UpdatedAt:   mrow.UpdatedAt,
 			})
 		}
 	}
@@ -18,6 +18,31 @@ type ORM struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: address, slotId, qopts
+func (_m *ORM) Delete(address *big.Big, slotId uint, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, slotId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Big, uint, ...pg.QOpt) error); ok {
+		r0 = rf(address, slotId, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteExpired provides a mock function with given fields: limit, utcNow, qopts
 func (_m *ORM) DeleteExpired(limit uint, utcNow time.Time, qopts ...pg.QOpt) (int64, error) {
 	_va := make([]interface{}, len(qopts))
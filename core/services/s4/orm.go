@@ -26,6 +26,7 @@ type SnapshotRow struct {
 	Expiration  int64
 	Confirmed   bool
 	PayloadSize uint64
+	UpdatedAt   time.Time
 }
 
 //go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
@@ -44,6 +45,9 @@ type ORM interface {
 	// UpdatedAt field value is ignored.
 	Update(row *Row, qopts ...pg.QOpt) error
 
+	// Delete deletes the row identified by (Address, SlotId) pair, if any.
+	Delete(address *big.Big, slotId uint, qopts ...pg.QOpt) error
+
 	// DeleteExpired deletes any entries having Expiration < utcNow,
 	// up to the given limit.
 	// Returns the number of deleted rows.
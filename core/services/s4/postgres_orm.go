@@ -74,6 +74,14 @@ RETURNING id;`, o.tableName)
 	return err
 }
 
+func (o orm) Delete(address *big.Big, slotId uint, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE namespace=$1 AND address=$2 AND slot_id=$3;`, o.tableName)
+	_, err := q.Exec(stmt, o.namespace, address, slotId)
+	return err
+}
+
 func (o orm) DeleteExpired(limit uint, utcNow time.Time, qopts ...pg.QOpt) (int64, error) {
 	q := o.q.WithOpts(qopts...)
 
@@ -90,7 +98,7 @@ func (o orm) GetSnapshot(addressRange *AddressRange, qopts ...pg.QOpt) ([]*Snaps
 	q := o.q.WithOpts(qopts...)
 	rows := make([]*SnapshotRow, 0)
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, octet_length(payload) AS payload_size FROM %s WHERE namespace = $1 AND address >= $2 AND address <= $3;`, o.tableName)
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, octet_length(payload) AS payload_size, updated_at FROM %s WHERE namespace = $1 AND address >= $2 AND address <= $3;`, o.tableName)
 	if err := q.Select(&rows, stmt, o.namespace, addressRange.MinAddress, addressRange.MaxAddress); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, err
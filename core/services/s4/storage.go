@@ -2,6 +2,12 @@ package s4
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -11,11 +17,73 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+var (
+	promStorageEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s4_storage_evictions",
+		Help: "Number of records evicted to keep a user within its S4 storage quota",
+	}, []string{"policy"})
+
+	promStorageEvictedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s4_storage_evicted_bytes",
+		Help: "Total payload size of the records evicted to keep a user within its S4 storage quota",
+	}, []string{"policy"})
+
+	promStorageQuotaRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s4_storage_quota_rejections",
+		Help: "Number of records rejected because they would exceed the S4 storage quota of a user",
+	}, []string{"policy"})
+)
+
+// EvictionPolicy specifies which records of a user are removed when a new record exceeds the user quota.
+type EvictionPolicy string
+
+const (
+	// EvictionPolicyNone rejects records exceeding the user quota (default).
+	EvictionPolicyNone EvictionPolicy = "none"
+	// EvictionPolicyLRU evicts the least recently updated records first.
+	EvictionPolicyLRU EvictionPolicy = "lru"
+	// EvictionPolicyTTL evicts the records expiring soonest first.
+	EvictionPolicyTTL EvictionPolicy = "ttl"
+)
+
 // Constraints specifies the global storage constraints.
 type Constraints struct {
 	MaxPayloadSizeBytes    uint   `json:"maxPayloadSizeBytes"`
 	MaxSlotsPerUser        uint   `json:"maxSlotsPerUser"`
 	MaxExpirationLengthSec uint64 `json:"maxExpirationLengthSec"`
+	// Per-user quotas, zero means no limit.
+	MaxEntriesPerUser           uint           `json:"maxEntriesPerUser"`
+	MaxTotalPayloadBytesPerUser uint           `json:"maxTotalPayloadBytesPerUser"`
+	EvictionPolicy              EvictionPolicy `json:"evictionPolicy"`
+}
+
+// Validate returns an error if the constraints are inconsistent.
+func (c Constraints) Validate() error {
+	switch c.EvictionPolicy {
+	case "", EvictionPolicyNone, EvictionPolicyLRU, EvictionPolicyTTL:
+	default:
+		return fmt.Errorf("unknown eviction policy %q", c.EvictionPolicy)
+	}
+	if c.MaxTotalPayloadBytesPerUser > 0 && c.MaxTotalPayloadBytesPerUser < c.MaxPayloadSizeBytes {
+		return fmt.Errorf("maxTotalPayloadBytesPerUser %d is lower than maxPayloadSizeBytes %d", c.MaxTotalPayloadBytesPerUser, c.MaxPayloadSizeBytes)
+	}
+	return nil
+}
+
+func (c Constraints) evictionPolicy() EvictionPolicy {
+	if c.EvictionPolicy == "" {
+		return EvictionPolicyNone
+	}
+	return c.EvictionPolicy
+}
+
+func (c Constraints) hasQuotas() bool {
+	return c.MaxEntriesPerUser > 0 || c.MaxTotalPayloadBytesPerUser > 0
+}
+
+func (c Constraints) withinQuotas(entries uint, totalPayloadBytes uint64) bool {
+	return (c.MaxEntriesPerUser == 0 || entries <= c.MaxEntriesPerUser) &&
+		(c.MaxTotalPayloadBytesPerUser == 0 || totalPayloadBytes <= uint64(c.MaxTotalPayloadBytesPerUser))
 }
 
 // Key identifies a versioned user record.
@@ -58,6 +126,8 @@ type Storage interface {
 	Get(ctx context.Context, key *Key) (*Record, *Metadata, error)
 
 	// Put creates (or updates) a record identified by the specified key.
+	// If the record exceeds the user quotas, either ErrQuotaExceeded is returned
+	// or other records of the user are evicted, depending on the eviction policy.
 	// For signature calculation see envelope.go
 	Put(ctx context.Context, key *Key, record *Record, signature []byte) error
 
@@ -71,6 +141,8 @@ type storage struct {
 	contraints Constraints
 	orm        ORM
 	clock      utils.Clock
+	// putMu serializes quota checks and evictions with the updates they account for.
+	putMu sync.Mutex
 }
 
 var _ Storage = (*storage)(nil)
@@ -160,5 +232,91 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 	copy(row.Payload, record.Payload)
 	copy(row.Signature, signature)
 
-	return s.orm.Update(row, pg.WithParentCtx(ctx))
+	if !s.contraints.hasQuotas() {
+		return s.orm.Update(row, pg.WithParentCtx(ctx))
+	}
+
+	s.putMu.Lock()
+	defer s.putMu.Unlock()
+
+	evicted, err := s.selectEvictions(ctx, row)
+	if err != nil {
+		return err
+	}
+	if err = s.orm.Update(row, pg.WithParentCtx(ctx)); err != nil {
+		return err
+	}
+	return s.evict(ctx, evicted)
+}
+
+// selectEvictions returns the records of the user to evict for the given row to fit within the user quotas.
+// ErrQuotaExceeded is returned if the row does not fit and the eviction policy does not allow evicting them.
+func (s *storage) selectEvictions(ctx context.Context, row *Row) ([]*SnapshotRow, error) {
+	sar, err := NewSingleAddressRange(row.Address)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := s.orm.GetSnapshot(sar, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// The slot being written is replaced, and expired rows are about to be deleted.
+	now := s.clock.Now().UnixMilli()
+	others := make([]*SnapshotRow, 0, len(snapshot))
+	entries, totalPayloadBytes := uint(1), uint64(len(row.Payload))
+	for _, sr := range snapshot {
+		if sr.SlotId == row.SlotId || sr.Expiration <= now {
+			continue
+		}
+		others = append(others, sr)
+		entries++
+		totalPayloadBytes += sr.PayloadSize
+	}
+	if s.contraints.withinQuotas(entries, totalPayloadBytes) {
+		return nil, nil
+	}
+
+	policy := s.contraints.evictionPolicy()
+	switch policy {
+	case EvictionPolicyLRU:
+		sort.SliceStable(others, func(i, j int) bool {
+			return others[i].UpdatedAt.Before(others[j].UpdatedAt)
+		})
+	case EvictionPolicyTTL:
+		sort.SliceStable(others, func(i, j int) bool {
+			return others[i].Expiration < others[j].Expiration
+		})
+	default:
+		promStorageQuotaRejections.WithLabelValues(string(policy)).Inc()
+		return nil, ErrQuotaExceeded
+	}
+
+	var evicted []*SnapshotRow
+	for _, sr := range others {
+		if s.contraints.withinQuotas(entries, totalPayloadBytes) {
+			break
+		}
+		evicted = append(evicted, sr)
+		entries--
+		totalPayloadBytes -= sr.PayloadSize
+	}
+	if !s.contraints.withinQuotas(entries, totalPayloadBytes) {
+		promStorageQuotaRejections.WithLabelValues(string(policy)).Inc()
+		return nil, ErrQuotaExceeded
+	}
+	return evicted, nil
+}
+
+func (s *storage) evict(ctx context.Context, rows []*SnapshotRow) error {
+	policy := string(s.contraints.evictionPolicy())
+	for _, sr := range rows {
+		if err := s.orm.Delete(sr.Address, sr.SlotId, pg.WithParentCtx(ctx)); err != nil {
+			return fmt.Errorf("failed to evict slot %d of %s: %w", sr.SlotId, sr.Address, err)
+		}
+		promStorageEvictions.WithLabelValues(policy).Inc()
+		promStorageEvictedBytes.WithLabelValues(policy).Add(float64(sr.PayloadSize))
+		s.lggr.Debugw("evicted record exceeding user quota", "address", sr.Address, "slotId", sr.SlotId, "version", sr.Version, "policy", policy)
+	}
+	return nil
 }
@@ -234,3 +234,72 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_Quotas(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	addressRange, err := s4.NewSingleAddressRange(big.New(address.Big()))
+	require.NoError(t, err)
+	existing := []*s4.SnapshotRow{
+		{Address: big.New(address.Big()), SlotId: 0, Expiration: now.Add(time.Minute).UnixMilli(), PayloadSize: 10, UpdatedAt: now.Add(-time.Minute)},
+		{Address: big.New(address.Big()), SlotId: 1, Expiration: now.Add(2 * time.Minute).UnixMilli(), PayloadSize: 10, UpdatedAt: now.Add(-2 * time.Minute)},
+		{Address: big.New(address.Big()), SlotId: 2, Expiration: now.Add(-time.Minute).UnixMilli(), PayloadSize: 10, UpdatedAt: now.Add(-3 * time.Minute)},
+	}
+
+	put := func(t *testing.T, storage s4.Storage, slotId uint, payloadSize int) error {
+		key := &s4.Key{Address: address, SlotId: slotId, Version: 1}
+		record := &s4.Record{Payload: make([]byte, payloadSize), Expiration: now.Add(time.Hour).UnixMilli()}
+		signature, err2 := s4.NewEnvelopeFromRecord(key, record).Sign(privateKey)
+		require.NoError(t, err2)
+		return storage.Put(testutils.Context(t), key, record, signature)
+	}
+	setup := func(t *testing.T, c s4.Constraints) (*mocks.ORM, s4.Storage) {
+		orm := mocks.NewORM(t)
+		return orm, s4.NewStorage(logger.TestLogger(t), c, orm, utils.NewFixedClock(now))
+	}
+
+	t.Run("within quotas", func(t *testing.T) {
+		orm, storage := setup(t, s4.Constraints{MaxSlotsPerUser: 5, MaxPayloadSizeBytes: 32, MaxExpirationLengthSec: 3600, MaxEntriesPerUser: 3})
+		orm.On("GetSnapshot", addressRange, mock.Anything).Return(existing, nil).Once()
+		orm.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+		require.NoError(t, put(t, storage, 3, 10))
+	})
+
+	t.Run("replaced slot is not counted", func(t *testing.T) {
+		orm, storage := setup(t, s4.Constraints{MaxSlotsPerUser: 5, MaxPayloadSizeBytes: 32, MaxExpirationLengthSec: 3600, MaxEntriesPerUser: 2, MaxTotalPayloadBytesPerUser: 40})
+		orm.On("GetSnapshot", addressRange, mock.Anything).Return(existing, nil).Once()
+		orm.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+		require.NoError(t, put(t, storage, 1, 30))
+	})
+
+	t.Run("rejected without eviction policy", func(t *testing.T) {
+		orm, storage := setup(t, s4.Constraints{MaxSlotsPerUser: 5, MaxPayloadSizeBytes: 32, MaxExpirationLengthSec: 3600, MaxEntriesPerUser: 2})
+		orm.On("GetSnapshot", addressRange, mock.Anything).Return(existing, nil).Once()
+		assert.ErrorIs(t, put(t, storage, 3, 10), s4.ErrQuotaExceeded)
+	})
+
+	t.Run("lru evicts least recently updated", func(t *testing.T) {
+		orm, storage := setup(t, s4.Constraints{MaxSlotsPerUser: 5, MaxPayloadSizeBytes: 32, MaxExpirationLengthSec: 3600, MaxEntriesPerUser: 2, EvictionPolicy: s4.EvictionPolicyLRU})
+		orm.On("GetSnapshot", addressRange, mock.Anything).Return(existing, nil).Once()
+		orm.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+		orm.On("Delete", big.New(address.Big()), uint(1), mock.Anything).Return(nil).Once()
+		require.NoError(t, put(t, storage, 3, 10))
+	})
+
+	t.Run("ttl evicts soonest expiring", func(t *testing.T) {
+		orm, storage := setup(t, s4.Constraints{MaxSlotsPerUser: 5, MaxPayloadSizeBytes: 32, MaxExpirationLengthSec: 3600, MaxTotalPayloadBytesPerUser: 32, EvictionPolicy: s4.EvictionPolicyTTL})
+		orm.On("GetSnapshot", addressRange, mock.Anything).Return(existing, nil).Once()
+		orm.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+		orm.On("Delete", big.New(address.Big()), uint(0), mock.Anything).Return(nil).Once()
+		require.NoError(t, put(t, storage, 3, 20))
+	})
+
+	t.Run("nothing evicted when update fails", func(t *testing.T) {
+		orm, storage := setup(t, s4.Constraints{MaxSlotsPerUser: 5, MaxPayloadSizeBytes: 32, MaxExpirationLengthSec: 3600, MaxEntriesPerUser: 1, EvictionPolicy: s4.EvictionPolicyLRU})
+		orm.On("GetSnapshot", addressRange, mock.Anything).Return(existing, nil).Once()
+		orm.On("Update", mock.Anything, mock.Anything).Return(s4.ErrVersionTooLow).Once()
+		assert.ErrorIs(t, put(t, storage, 3, 10), s4.ErrVersionTooLow)
+	})
+}
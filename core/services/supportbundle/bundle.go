@@ -0,0 +1,334 @@
+// Package supportbundle gathers the diagnostics of a node into a single archive, to attach to support tickets.
+package supportbundle
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+)
+
+const (
+	// maxErrorLogs is the number of the most recent error logs included in a bundle.
+	maxErrorLogs = 1000
+	// maxLogsRead is how much of the end of the log file is searched for error logs.
+	maxLogsRead = 16 * 1024 * 1024
+)
+
+// Node is the part of the application a bundle is gathered from.
+type Node interface {
+	GetConfig() chainlink.GeneralConfig
+	GetHealthChecker() services.Checker
+	GetKeyStore() keystore.Master
+	GetRelayers() chainlink.RelayerChainInteroperators
+}
+
+var _ Node = (chainlink.Application)(nil)
+
+// Manifest describes a bundle.
+type Manifest struct {
+	Version   string
+	Commit    string
+	CreatedAt time.Time
+	// Errors are the sections which could not be gathered, with the reason why.
+	Errors map[string]string `json:",omitempty"`
+}
+
+// FileName returns the name of the archive of a bundle created at t.
+func FileName(t time.Time) string {
+	return fmt.Sprintf("chainlink-support-bundle-%s.tar.gz", t.UTC().Format("20060102T150405Z"))
+}
+
+// Write gathers a bundle from node, and writes it to w as a gzipped tarball. It contains:
+//   - config.toml: the effective config, with the paths, queries and credentials of URLs and the values of headers redacted
+//   - health.json: the health checks of the services
+//   - errors.log: the most recent error logs, if logs are written to disk
+//   - nodes.json: the state of the RPC nodes of each chain
+//   - keys.json: the IDs of the keys, which are their public parts
+//   - evm.json: the LogPoller lag and transaction queue of each EVM chain
+//
+// Sections which cannot be gathered are reported in manifest.json, instead of failing the bundle.
+func Write(ctx context.Context, node Node, w io.Writer, now time.Time) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	dir := strings.TrimSuffix(FileName(now), ".tar.gz")
+
+	m := Manifest{Version: static.Version, Commit: static.Sha, CreatedAt: now.UTC(), Errors: map[string]string{}}
+	sections := []struct {
+		name   string
+		gather func() ([]byte, error)
+	}{
+		{"config.toml", func() ([]byte, error) { return gatherConfig(node) }},
+		{"health.json", func() ([]byte, error) { return gatherHealth(node) }},
+		{"errors.log", func() ([]byte, error) { return gatherErrorLogs(node) }},
+		{"nodes.json", func() ([]byte, error) { return gatherNodes(ctx, node) }},
+		{"keys.json", func() ([]byte, error) { return gatherKeys(node) }},
+		{"evm.json", func() ([]byte, error) { return gatherEVM(ctx, node) }},
+	}
+	for _, s := range sections {
+		b, err := s.gather()
+		if err != nil {
+			m.Errors[s.name] = err.Error()
+		}
+		if b == nil {
+			continue
+		}
+		if err := writeFile(tw, dir+"/"+s.name, b, now); err != nil {
+			return err
+		}
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(tw, dir+"/manifest.json", b, now); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeFile(tw *tar.Writer, name string, b []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(b)), ModTime: modTime}); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if _, err := tw.Write(b); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func gatherConfig(node Node) ([]byte, error) {
+	_, effective := node.GetConfig().ConfigTOML()
+	return []byte(Sanitize(effective)), nil
+}
+
+type healthCheck struct {
+	Name   string
+	Passed bool
+	Output string `json:",omitempty"`
+}
+
+func gatherHealth(node Node) ([]byte, error) {
+	_, errs := node.GetHealthChecker().IsHealthy()
+	checks := make([]healthCheck, 0, len(errs))
+	for name, err := range errs {
+		c := healthCheck{Name: name, Passed: err == nil}
+		if err != nil {
+			c.Output = Sanitize(err.Error())
+		}
+		checks = append(checks, c)
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name < checks[j].Name })
+	return json.MarshalIndent(checks, "", "  ")
+}
+
+// errorLevels are the levels of the logs included in errors.log, as written by the disk logger.
+var errorLevels = map[string]bool{"error": true, "crit": true, "panic": true, "fatal": true}
+
+func gatherErrorLogs(node Node) ([]byte, error) {
+	file := node.GetConfig().Log().File()
+	if file.MaxSize() <= 0 {
+		return nil, fmt.Errorf("logs are not written to disk, set Log.File.MaxSize to enable it")
+	}
+	f, err := os.Open(logger.Config{Dir: file.Dir()}.LogsFile())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if size := info.Size(); size > maxLogsRead {
+		if _, err := f.Seek(size-maxLogsRead, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	var lines []string
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		// the disk logger writes tab separated lines, starting with the time and the level
+		fields := strings.SplitN(sc.Text(), "\t", 3)
+		if len(fields) < 3 || !errorLevels[fields[1]] {
+			continue
+		}
+		lines = append(lines, Sanitize(sc.Text()))
+		if len(lines) > maxErrorLogs {
+			lines = lines[1:]
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	for _, l := range lines {
+		buf.WriteString(l)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+type nodeStatus struct {
+	ChainID string
+	Name    string
+	State   string
+}
+
+func gatherNodes(ctx context.Context, node Node) ([]byte, error) {
+	stats, _, err := node.GetRelayers().NodeStatuses(ctx, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	// the config of the nodes is left out, since their URLs often contain API keys
+	nodes := make([]nodeStatus, len(stats))
+	for i, s := range stats {
+		nodes[i] = nodeStatus{ChainID: s.ChainID, Name: s.Name, State: s.State}
+	}
+	return json.MarshalIndent(nodes, "", "  ")
+}
+
+type keyInfo struct {
+	Type string
+	ID   string
+}
+
+func gatherKeys(node Node) ([]byte, error) {
+	ks := node.GetKeyStore()
+	var (
+		keys = []keyInfo{}
+		errs []string
+	)
+	add := func(typ string, ids []string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", typ, err))
+			return
+		}
+		for _, id := range ids {
+			keys = append(keys, keyInfo{Type: typ, ID: id})
+		}
+	}
+	add(keyIDs("CSA", ks.CSA().GetAll))
+	add(keyIDs("Eth", ks.Eth().GetAll))
+	add(keyIDs("P2P", ks.P2P().GetAll))
+	add(keyIDs("OCR", ks.OCR().GetAll))
+	add(keyIDs("OCR2", ks.OCR2().GetAll))
+	add(keyIDs("Solana", ks.Solana().GetAll))
+	add(keyIDs("Cosmos", ks.Cosmos().GetAll))
+	add(keyIDs("StarkNet", ks.StarkNet().GetAll))
+	add(keyIDs("Aptos", ks.Aptos().GetAll))
+	add(keyIDs("VRF", ks.VRF().GetAll))
+	add(keyIDs("DKGSign", ks.DKGSign().GetAll))
+	add(keyIDs("DKGEncrypt", ks.DKGEncrypt().GetAll))
+
+	b, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return b, fmt.Errorf("failed to list keys: %s", strings.Join(errs, "; "))
+	}
+	return b, nil
+}
+
+func keyIDs[K interface{ ID() string }](typ string, getAll func() ([]K, error)) (string, []string, error) {
+	keys, err := getAll()
+	if err != nil {
+		return typ, nil, err
+	}
+	ids := make([]string, len(keys))
+	for i, k := range keys {
+		ids[i] = k.ID()
+	}
+	return typ, ids, nil
+}
+
+type evmChain struct {
+	ChainID string
+	// Head is the number of the latest head of the chain, as tracked by the head tracker.
+	Head int64
+	// LogPollerBlock is the number of the latest block processed by the LogPoller.
+	LogPollerBlock int64  `json:",omitempty"`
+	LogPollerLag   int64  `json:",omitempty"`
+	LogPollerError string `json:",omitempty"`
+	// Txs counts the transactions in each state of the queue.
+	Txs      map[string]uint32
+	TxsError string `json:",omitempty"`
+}
+
+var txQueueStates = []txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed}
+
+func gatherEVM(ctx context.Context, node Node) ([]byte, error) {
+	chains := []evmChain{}
+	for _, c := range node.GetRelayers().LegacyEVMChains().Slice() {
+		ec := evmChain{ChainID: c.ID().String(), Txs: map[string]uint32{}}
+		if h := c.HeadTracker().LatestChain(); h != nil {
+			ec.Head = h.Number
+		}
+		if b, err := c.LogPoller().LatestBlock(); err != nil {
+			ec.LogPollerError = err.Error()
+		} else {
+			ec.LogPollerBlock = b.BlockNumber
+			if ec.Head > 0 {
+				ec.LogPollerLag = ec.Head - b.BlockNumber
+			}
+		}
+		for _, state := range txQueueStates {
+			n, err := c.TxManager().CountTransactionsByState(ctx, state)
+			if err != nil {
+				ec.TxsError = err.Error()
+				break
+			}
+			ec.Txs[string(state)] = n
+		}
+		chains = append(chains, ec)
+	}
+	return json.MarshalIndent(chains, "", "  ")
+}
+
+var (
+	urlRegexp     = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s'"]+`)
+	headersRegexp = regexp.MustCompile(`(?m)^(\s*Headers\s*=\s*)\[.*\]`)
+)
+
+const redacted = "xxxxx"
+
+// Sanitize redacts the credentials, paths and queries of the URLs in s, since they often contain API keys, and the
+// values of the Headers of the config.
+func Sanitize(s string) string {
+	s = urlRegexp.ReplaceAllStringFunc(s, func(raw string) string {
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" {
+			return raw
+		}
+		sanitized := u.Scheme + "://" + u.Host
+		if u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+			sanitized += "/" + redacted
+		}
+		return sanitized
+	})
+	return headersRegexp.ReplaceAllString(s, "${1}['"+redacted+"']")
+}
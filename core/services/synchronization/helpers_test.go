@@ -12,14 +12,22 @@ import (
 
 // NewTestTelemetryIngressClient calls NewTelemetryIngressClient and injects telemClient.
 func NewTestTelemetryIngressClient(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, telemClient telemPb.TelemClient) TelemetryService {
-	tc := NewTelemetryIngressClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, "test", "test")
+	tc := NewTelemetryIngressClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, "test", "test", nil)
 	tc.(*telemetryIngressClient).telemClient = telemClient
 	return tc
 }
 
 // NewTestTelemetryIngressBatchClient calls NewTelemetryIngressBatchClient and injects telemClient.
 func NewTestTelemetryIngressBatchClient(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, telemClient telemPb.TelemClient, sendInterval time.Duration, uniconn bool) TelemetryService {
-	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn, "test", "test")
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn, "test", "test", nil)
+	tc.(*telemetryIngressBatchClient).close = func() error { return nil }
+	tc.(*telemetryIngressBatchClient).telemClient = telemClient
+	return tc
+}
+
+// NewTestTelemetryIngressBatchClientWithSpill calls NewTelemetryIngressBatchClient with spill and injects telemClient.
+func NewTestTelemetryIngressBatchClientWithSpill(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, telemClient telemPb.TelemClient, sendInterval time.Duration, spill *TelemetrySpill) TelemetryService {
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, false, logger.TestLogger(t), 100, 50, sendInterval, time.Second, false, "test", "test", spill)
 	tc.(*telemetryIngressBatchClient).close = func() error { return nil }
 	tc.(*telemetryIngressBatchClient).telemClient = telemClient
 	return tc
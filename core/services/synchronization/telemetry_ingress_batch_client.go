@@ -62,11 +62,14 @@ type telemetryIngressBatchClient struct {
 	workersMutex sync.Mutex
 
 	useUniConn bool
+
+	spill *TelemetrySpill
 }
 
 // NewTelemetryIngressBatchClient returns a client backed by wsrpc that
-// can send telemetry to the telemetry ingress server
-func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool, network string, chainID string) TelemetryService {
+// can send telemetry to the telemetry ingress server. If spill is not nil, telemetry
+// which cannot be sent is spilled to it, and replayed once the server is reachable.
+func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool, network string, chainID string, spill *TelemetrySpill) TelemetryService {
 	return &telemetryIngressBatchClient{
 		telemBufferSize:   telemBufferSize,
 		telemMaxBatchSize: telemMaxBatchSize,
@@ -81,6 +84,7 @@ func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks key
 		chDone:            make(services.StopChan),
 		workers:           make(map[string]*telemetryIngressBatchWorker),
 		useUniConn:        useUniconn,
+		spill:             spill,
 	}
 }
 
@@ -133,6 +137,14 @@ func (tc *telemetryIngressBatchClient) Start(ctx context.Context) error {
 			}
 		}
 
+		if tc.spill != nil {
+			tc.wgDone.Add(1)
+			go func() {
+				defer tc.wgDone.Done()
+				replaySpill(tc.spill, tc.telemSendInterval, tc.telemSendTimeout, int(tc.telemMaxBatchSize), tc.chDone, tc.lggr, tc.replay)
+			}()
+		}
+
 		return nil
 	})
 }
@@ -170,19 +182,51 @@ func (tc *telemetryIngressBatchClient) getCSAPrivateKey() (privkey []byte, err e
 	return keys[0].Raw(), nil
 }
 
-// Send directs incoming telmetry messages to the worker responsible for pushing it to
-// the ingress server. If the worker telemetry buffer is full, messages are dropped
-// and a warning is logged.
-func (tc *telemetryIngressBatchClient) Send(ctx context.Context, telemData []byte, contractID string, telemType TelemetryType) {
+// replay sends spilled telemetry to the ingress server, in one batch per contract and telemetry type.
+func (tc *telemetryIngressBatchClient) replay(ctx context.Context, payloads []TelemPayload) error {
 	if tc.useUniConn && !tc.connected.Load() {
-		tc.lggr.Warnw("not connected to telemetry endpoint", "endpoint", tc.url.String())
-		return
+		return errors.New("not connected to telemetry endpoint")
 	}
+	var (
+		order   []string
+		batches = map[string]*telemPb.TelemBatchRequest{}
+	)
+	for _, p := range payloads {
+		key := fmt.Sprintf("%s_%s", p.ContractID, p.TelemType)
+		req, ok := batches[key]
+		if !ok {
+			req = &telemPb.TelemBatchRequest{ContractId: p.ContractID, TelemetryType: string(p.TelemType)}
+			batches[key] = req
+			order = append(order, key)
+		}
+		req.Telemetry = append(req.Telemetry, p.Telemetry)
+	}
+	for _, key := range order {
+		req := batches[key]
+		req.SentAt = time.Now().UnixNano()
+		if _, err := tc.telemClient.TelemBatch(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Send directs incoming telmetry messages to the worker responsible for pushing it to
+// the ingress server. If the worker telemetry buffer is full, or the client is not connected,
+// messages are spilled if the client has a spill, and otherwise dropped with a warning.
+func (tc *telemetryIngressBatchClient) Send(ctx context.Context, telemData []byte, contractID string, telemType TelemetryType) {
 	payload := TelemPayload{
 		Telemetry:  telemData,
 		TelemType:  telemType,
 		ContractID: contractID,
 	}
+	if tc.useUniConn && !tc.connected.Load() {
+		if tc.spillPayload(payload) {
+			return
+		}
+		tc.lggr.Warnw("not connected to telemetry endpoint", "endpoint", tc.url.String())
+		return
+	}
 	worker := tc.findOrCreateWorker(payload)
 
 	select {
@@ -191,10 +235,25 @@ func (tc *telemetryIngressBatchClient) Send(ctx context.Context, telemData []byt
 	case <-ctx.Done():
 		return
 	default:
+		if tc.spillPayload(payload) {
+			return
+		}
 		worker.logBufferFullWithExpBackoff(payload)
 	}
 }
 
+// spillPayload spills payload, if the client has a spill. It returns true if payload was spilled.
+func (tc *telemetryIngressBatchClient) spillPayload(payload TelemPayload) bool {
+	if tc.spill == nil {
+		return false
+	}
+	if err := tc.spill.Spill(payload); err != nil {
+		tc.lggr.Warnw("Could not spill telemetry", "err", err)
+		return false
+	}
+	return true
+}
+
 // findOrCreateWorker finds a worker by ContractID or creates a new one if none exists
 func (tc *telemetryIngressBatchClient) findOrCreateWorker(payload TelemPayload) *telemetryIngressBatchWorker {
 	tc.workersMutex.Lock()
@@ -216,6 +275,7 @@ func (tc *telemetryIngressBatchClient) findOrCreateWorker(payload TelemPayload)
 			payload.TelemType,
 			tc.globalLogger,
 			tc.logging,
+			tc.spill,
 		)
 		worker.Start()
 		tc.workers[workerKey] = worker
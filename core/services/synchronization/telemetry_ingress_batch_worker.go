@@ -29,10 +29,12 @@ type telemetryIngressBatchWorker struct {
 	logging           bool
 	lggr              logger.Logger
 	dropMessageCount  atomic.Uint32
+	spill             *TelemetrySpill
 }
 
 // NewTelemetryIngressBatchWorker returns a worker for a given contractID that can send
-// telemetry to the ingress server via WSRPC
+// telemetry to the ingress server via WSRPC. If spill is not nil, batches which could not
+// be sent are spilled to it.
 func NewTelemetryIngressBatchWorker(
 	telemMaxBatchSize uint,
 	telemSendInterval time.Duration,
@@ -45,6 +47,7 @@ func NewTelemetryIngressBatchWorker(
 	telemType TelemetryType,
 	globalLogger logger.Logger,
 	logging bool,
+	spill *TelemetrySpill,
 ) *telemetryIngressBatchWorker {
 	return &telemetryIngressBatchWorker{
 		telemSendInterval: telemSendInterval,
@@ -58,6 +61,7 @@ func NewTelemetryIngressBatchWorker(
 		telemType:         telemType,
 		logging:           logging,
 		lggr:              globalLogger.Named("TelemetryIngressBatchWorker"),
+		spill:             spill,
 	}
 }
 
@@ -84,8 +88,12 @@ func (tw *telemetryIngressBatchWorker) Start() {
 
 				if err != nil {
 					tw.lggr.Warnf("Could not send telemetry: %v", err)
+					tw.spillBatch(telemBatchReq)
 					continue
 				}
+				if tw.spill != nil {
+					tw.spill.MarkSent(tw.payloads(telemBatchReq)...)
+				}
 				if tw.logging {
 					tw.lggr.Debugw("Successfully sent telemetry to ingress server", "contractID", telemBatchReq.ContractId, "telemType", telemBatchReq.TelemetryType, "telemetry", telemBatchReq.Telemetry)
 				}
@@ -115,6 +123,27 @@ func (tw *telemetryIngressBatchWorker) logBufferFullWithExpBackoff(payload Telem
 	}
 }
 
+// spillBatch spills the telemetry of a batch which could not be sent, if the worker has a spill.
+func (tw *telemetryIngressBatchWorker) spillBatch(req *telemPb.TelemBatchRequest) {
+	if tw.spill == nil {
+		return
+	}
+	for _, p := range tw.payloads(req) {
+		if err := tw.spill.Spill(p); err != nil {
+			tw.lggr.Warnw("Could not spill telemetry, dropping it", "err", err, "droppedCount", len(req.Telemetry))
+			return
+		}
+	}
+}
+
+func (tw *telemetryIngressBatchWorker) payloads(req *telemPb.TelemBatchRequest) []TelemPayload {
+	ps := make([]TelemPayload, len(req.Telemetry))
+	for i, t := range req.Telemetry {
+		ps[i] = TelemPayload{Telemetry: t, TelemType: tw.telemType, ContractID: tw.contractID}
+	}
+	return ps
+}
+
 // BuildTelemBatchReq reads telemetry off the worker channel and packages it into a batch request
 func (tw *telemetryIngressBatchWorker) BuildTelemBatchReq() *telemPb.TelemBatchRequest {
 	var telemBatch [][]byte
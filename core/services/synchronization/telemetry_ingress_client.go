@@ -48,11 +48,14 @@ type telemetryIngressClient struct {
 	chDone           services.StopChan
 	dropMessageCount atomic.Uint32
 	chTelemetry      chan TelemPayload
+
+	spill *TelemetrySpill
 }
 
 // NewTelemetryIngressClient returns a client backed by wsrpc that
-// can send telemetry to the telemetry ingress server
-func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, network string, chainID string) TelemetryService {
+// can send telemetry to the telemetry ingress server. If spill is not nil, telemetry
+// which cannot be sent is spilled to it, and replayed once the server is reachable.
+func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, network string, chainID string, spill *TelemetrySpill) TelemetryService {
 	return &telemetryIngressClient{
 		url:             url,
 		ks:              ks,
@@ -61,6 +64,7 @@ func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore
 		lggr:            lggr.Named("TelemetryIngressClient").Named(network).Named(chainID),
 		chTelemetry:     make(chan TelemPayload, telemBufferSize),
 		chDone:          make(services.StopChan),
+		spill:           spill,
 	}
 }
 
@@ -124,6 +128,13 @@ func (tc *telemetryIngressClient) connect(clientPrivKey []byte) {
 
 		// Start handler for telemetry
 		tc.handleTelemetry()
+		if tc.spill != nil {
+			tc.wgDone.Add(1)
+			go func() {
+				defer tc.wgDone.Done()
+				replaySpill(tc.spill, spillReplayInterval, spillReplayTimeout, spillReplayBatchSize, tc.chDone, tc.lggr, tc.replay)
+			}()
+		}
 
 		// Wait for close
 		<-tc.chDone
@@ -150,8 +161,12 @@ func (tc *telemetryIngressClient) handleTelemetry() {
 				_, err := tc.telemClient.Telem(ctx, telemReq)
 				if err != nil {
 					tc.lggr.Errorf("Could not send telemetry: %v", err)
+					tc.spillPayload(p)
 					continue
 				}
+				if tc.spill != nil {
+					tc.spill.MarkSent(p)
+				}
 				if tc.logging {
 					tc.lggr.Debugw("successfully sent telemetry to ingress server", "contractID", p.ContractID, "telemetry", p.Telemetry)
 				}
@@ -162,6 +177,34 @@ func (tc *telemetryIngressClient) handleTelemetry() {
 	}()
 }
 
+// replay sends spilled telemetry to the ingress server, one message at a time.
+func (tc *telemetryIngressClient) replay(ctx context.Context, payloads []TelemPayload) error {
+	for _, p := range payloads {
+		_, err := tc.telemClient.Telem(ctx, &telemPb.TelemRequest{
+			Telemetry:     p.Telemetry,
+			Address:       p.ContractID,
+			TelemetryType: string(p.TelemType),
+			SentAt:        time.Now().UnixNano(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spillPayload spills payload, if the client has a spill. It returns true if payload was spilled.
+func (tc *telemetryIngressClient) spillPayload(payload TelemPayload) bool {
+	if tc.spill == nil {
+		return false
+	}
+	if err := tc.spill.Spill(payload); err != nil {
+		tc.lggr.Warnw("Could not spill telemetry", "err", err)
+		return false
+	}
+	return true
+}
+
 // logBufferFullWithExpBackoff logs messages at
 // 1
 // 2
@@ -197,7 +240,8 @@ func (tc *telemetryIngressClient) getCSAPrivateKey() (privkey []byte, err error)
 
 // Send sends telemetry to the ingress server using wsrpc if the client is ready.
 // Also stores telemetry in a small buffer in case of backpressure from wsrpc,
-// throwing away messages once buffer is full
+// spilling messages once buffer is full if the client has a spill, and otherwise
+// throwing them away
 func (tc *telemetryIngressClient) Send(ctx context.Context, telemData []byte, contractID string, telemType TelemetryType) {
 	payload := TelemPayload{
 		Telemetry:  telemData,
@@ -211,6 +255,9 @@ func (tc *telemetryIngressClient) Send(ctx context.Context, telemData []byte, co
 	case <-ctx.Done():
 		return
 	default:
+		if tc.spillPayload(payload) {
+			return
+		}
 		tc.logBufferFullWithExpBackoff(payload)
 	}
 }
@@ -0,0 +1,335 @@
+package synchronization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	promTelemetrySpilled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_ingress_spilled_count",
+		Help: "Number of telemetry messages spilled to disk, because the endpoint was unreachable or its buffer was full",
+	}, []string{"endpoint"})
+	promTelemetryReplayed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_ingress_replayed_count",
+		Help: "Number of telemetry messages spilled to disk which were replayed to the endpoint",
+	}, []string{"endpoint"})
+	promTelemetrySpillSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "telemetry_ingress_spill_size_bytes",
+		Help: "Size of the telemetry spilled to disk and waiting to be replayed",
+	}, []string{"endpoint"})
+)
+
+// ErrTelemetrySpillFull is returned when spilling would exceed the max size of the spill.
+var ErrTelemetrySpillFull = errors.New("telemetry spill is full")
+
+const (
+	spillFileExt = ".telem"
+	// spillSentCacheSize is how many hashes of sent telemetry are remembered for deduplication.
+	spillSentCacheSize = 10_000
+	// spillReplayInterval, spillReplayTimeout and spillReplayBatchSize configure the replay of clients which do not
+	// send in batches. Batch clients replay with the interval, timeout and size of their batches.
+	spillReplayInterval  = 5 * time.Second
+	spillReplayTimeout   = 10 * time.Second
+	spillReplayBatchSize = 100
+)
+
+// TelemetrySpill stores telemetry on disk while an endpoint cannot take it, either because it is unreachable or
+// because its buffer is full, and replays it once the endpoint is reachable again, so that restarts of the ingress
+// service do not leave gaps in the telemetry.
+//
+// Each message is stored in its own file named by the hash of its content, so that a message spilled more than once
+// is stored, and replayed, only once. Messages which were recently sent are not spilled nor replayed.
+type TelemetrySpill struct {
+	dir      string
+	maxSize  int64
+	lggr     logger.Logger
+	spilled  prometheus.Counter
+	replayed prometheus.Counter
+	sizeG    prometheus.Gauge
+
+	mu   sync.Mutex
+	size int64
+	sent *recentHashes
+}
+
+type spilledTelemetry struct {
+	Telemetry  []byte
+	TelemType  TelemetryType
+	ContractID string
+	SpilledAt  time.Time
+}
+
+// NewTelemetrySpill returns a spill storing up to maxSize bytes of telemetry in dir, which is created if necessary.
+// Telemetry spilled by a previous run of the node is picked up for replay.
+func NewTelemetrySpill(dir string, maxSize utils.FileSize, endpoint string, lggr logger.Logger) (*TelemetrySpill, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create telemetry spill directory: %w", err)
+	}
+	s := &TelemetrySpill{
+		dir:      dir,
+		maxSize:  int64(maxSize),
+		lggr:     lggr.Named("TelemetrySpill"),
+		spilled:  promTelemetrySpilled.WithLabelValues(endpoint),
+		replayed: promTelemetryReplayed.WithLabelValues(endpoint),
+		sizeG:    promTelemetrySpillSize.WithLabelValues(endpoint),
+		sent:     newRecentHashes(spillSentCacheSize),
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read telemetry spill directory: %w", err)
+	}
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), spillFileExt) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		s.size += info.Size()
+	}
+	s.sizeG.Set(float64(s.size))
+	if s.size > 0 {
+		s.lggr.Infow("Found spilled telemetry to replay", "dir", dir, "size", utils.FileSize(s.size))
+	}
+	return s, nil
+}
+
+func hashTelemetry(p TelemPayload) string {
+	h := sha256.New()
+	h.Write([]byte(p.ContractID))
+	h.Write([]byte{0})
+	h.Write([]byte(p.TelemType))
+	h.Write([]byte{0})
+	h.Write(p.Telemetry)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Spill stores p on disk, unless it is already stored or was recently sent.
+func (s *TelemetrySpill) Spill(p TelemPayload) error {
+	hash := hashTelemetry(p)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sent.contains(hash) {
+		return nil
+	}
+	path := filepath.Join(s.dir, hash+spillFileExt)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	b, err := json.Marshal(spilledTelemetry{Telemetry: p.Telemetry, TelemType: p.TelemType, ContractID: p.ContractID, SpilledAt: time.Now()})
+	if err != nil {
+		return err
+	}
+	if s.size+int64(len(b)) > s.maxSize {
+		return ErrTelemetrySpillFull
+	}
+	// write then rename, so that a crash never leaves a partial file behind
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	s.size += int64(len(b))
+	s.sizeG.Set(float64(s.size))
+	s.spilled.Inc()
+	return nil
+}
+
+// MarkSent records that ps were sent, so that they are not spilled nor replayed.
+func (s *TelemetrySpill) MarkSent(ps ...TelemPayload) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range ps {
+		s.sent.add(hashTelemetry(p))
+	}
+}
+
+type spillFile struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+// next returns up to limit of the oldest spilled messages, and the files they are stored in. Messages which were sent
+// since they were spilled are removed instead.
+func (s *TelemetrySpill) next(limit int) ([]TelemPayload, []spillFile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	var files []spillFile
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), spillFileExt) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, spillFile{name: e.Name(), size: info.Size(), modTime: info.ModTime()})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+
+	var (
+		payloads []TelemPayload
+		taken    []spillFile
+		dupes    []spillFile
+	)
+	for _, f := range files {
+		if len(payloads) >= limit {
+			break
+		}
+		if s.sent.contains(strings.TrimSuffix(f.name, spillFileExt)) {
+			dupes = append(dupes, f)
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(s.dir, f.name))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, nil, err
+		}
+		var st spilledTelemetry
+		if err := json.Unmarshal(b, &st); err != nil {
+			s.lggr.Warnw("Removing corrupted spilled telemetry", "file", f.name, "err", err)
+			dupes = append(dupes, f)
+			continue
+		}
+		payloads = append(payloads, TelemPayload{Telemetry: st.Telemetry, TelemType: st.TelemType, ContractID: st.ContractID})
+		taken = append(taken, f)
+	}
+	s.removeLocked(dupes)
+	return payloads, taken, nil
+}
+
+func (s *TelemetrySpill) removeLocked(files []spillFile) {
+	for _, f := range files {
+		if err := os.Remove(filepath.Join(s.dir, f.name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			s.lggr.Warnw("Failed to remove spilled telemetry", "file", f.name, "err", err)
+			continue
+		}
+		s.size -= f.size
+	}
+	if s.size < 0 {
+		s.size = 0
+	}
+	s.sizeG.Set(float64(s.size))
+}
+
+// Replay sends the spilled messages with send, in batches of up to batchSize from the oldest, until none are left or
+// sending fails. It returns how many messages were replayed.
+func (s *TelemetrySpill) Replay(ctx context.Context, batchSize int, send func(context.Context, []TelemPayload) error) (int, error) {
+	var replayed int
+	for ctx.Err() == nil {
+		payloads, files, err := s.next(batchSize)
+		if err != nil {
+			return replayed, err
+		}
+		if len(payloads) == 0 {
+			return replayed, nil
+		}
+		if err := send(ctx, payloads); err != nil {
+			return replayed, err
+		}
+		s.mu.Lock()
+		for _, p := range payloads {
+			s.sent.add(hashTelemetry(p))
+		}
+		s.removeLocked(files)
+		s.mu.Unlock()
+		s.replayed.Add(float64(len(payloads)))
+		replayed += len(payloads)
+	}
+	return replayed, ctx.Err()
+}
+
+// Size returns the size of the spilled telemetry.
+func (s *TelemetrySpill) Size() utils.FileSize {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return utils.FileSize(s.size)
+}
+
+// recentHashes is a set of the most recently added hashes, bounded to a fixed size.
+type recentHashes struct {
+	set  map[string]struct{}
+	ring []string
+	next int
+}
+
+func newRecentHashes(size int) *recentHashes {
+	return &recentHashes{set: make(map[string]struct{}, size), ring: make([]string, size)}
+}
+
+func (r *recentHashes) add(hash string) {
+	if _, ok := r.set[hash]; ok {
+		return
+	}
+	if old := r.ring[r.next]; old != "" {
+		delete(r.set, old)
+	}
+	r.ring[r.next] = hash
+	r.set[hash] = struct{}{}
+	r.next = (r.next + 1) % len(r.ring)
+}
+
+func (r *recentHashes) contains(hash string) bool {
+	_, ok := r.set[hash]
+	return ok
+}
+
+// replaySpill replays spill with send on every tick of interval, until chDone is closed.
+func replaySpill(spill *TelemetrySpill, interval, timeout time.Duration, batchSize int, chDone <-chan struct{}, lggr logger.Logger, send func(context.Context, []TelemPayload) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-chDone:
+			return
+		case <-ticker.C:
+		}
+		if spill.Size() == 0 {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		go func() {
+			select {
+			case <-chDone:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		n, err := spill.Replay(ctx, batchSize, send)
+		cancel()
+		if n > 0 {
+			lggr.Infow("Replayed spilled telemetry", "count", n, "remaining", spill.Size())
+		}
+		if err != nil {
+			lggr.Debugw("Could not replay spilled telemetry", "err", err)
+		}
+	}
+}
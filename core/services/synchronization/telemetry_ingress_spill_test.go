@@ -0,0 +1,146 @@
+package synchronization_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestTelemetrySpill(t *testing.T) {
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	lggr := logger.TestLogger(t)
+
+	p1 := synchronization.TelemPayload{Telemetry: []byte("telem 1"), ContractID: "0x1", TelemType: synchronization.OCR}
+	p2 := synchronization.TelemPayload{Telemetry: []byte("telem 2"), ContractID: "0x1", TelemType: synchronization.OCR}
+	p3 := synchronization.TelemPayload{Telemetry: []byte("telem 3"), ContractID: "0x2", TelemType: synchronization.OCR2Median}
+
+	spill, err := synchronization.NewTelemetrySpill(dir, utils.MB, "test", lggr)
+	require.NoError(t, err)
+	require.Equal(t, utils.FileSize(0), spill.Size())
+
+	require.NoError(t, spill.Spill(p1))
+	size := spill.Size()
+	require.NotZero(t, size)
+	// spilling the same telemetry again is a no-op
+	require.NoError(t, spill.Spill(p1))
+	require.Equal(t, size, spill.Size())
+
+	require.NoError(t, spill.Spill(p2))
+	// telemetry which was already sent is not spilled
+	spill.MarkSent(p3)
+	require.NoError(t, spill.Spill(p3))
+
+	t.Run("picked up after restart", func(t *testing.T) {
+		restarted, err := synchronization.NewTelemetrySpill(dir, utils.MB, "test", lggr)
+		require.NoError(t, err)
+		require.Equal(t, spill.Size(), restarted.Size())
+	})
+
+	t.Run("replay stops on error", func(t *testing.T) {
+		n, err := spill.Replay(ctx, 10, func(context.Context, []synchronization.TelemPayload) error {
+			return errors.New("unavailable")
+		})
+		require.Error(t, err)
+		require.Zero(t, n)
+		require.NotZero(t, spill.Size())
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		var replayed []synchronization.TelemPayload
+		n, err := spill.Replay(ctx, 1, func(_ context.Context, ps []synchronization.TelemPayload) error {
+			require.Len(t, ps, 1)
+			replayed = append(replayed, ps...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+		assert.ElementsMatch(t, []synchronization.TelemPayload{p1, p2}, replayed)
+		require.Equal(t, utils.FileSize(0), spill.Size())
+
+		// replayed telemetry is not spilled again
+		require.NoError(t, spill.Spill(p1))
+		require.Equal(t, utils.FileSize(0), spill.Size())
+	})
+
+	t.Run("full", func(t *testing.T) {
+		small, err := synchronization.NewTelemetrySpill(t.TempDir(), utils.FileSize(150), "test", lggr)
+		require.NoError(t, err)
+		require.NoError(t, small.Spill(p1))
+		require.ErrorIs(t, small.Spill(p2), synchronization.ErrTelemetrySpillFull)
+	})
+}
+
+func TestTelemetryIngressBatchClient_SpillAndReplay(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	telemClient := mocks.NewTelemClient(t)
+	csaKeystore := new(ksmocks.CSA)
+	csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+
+	spill, err := synchronization.NewTelemetrySpill(t.TempDir(), utils.MB, "test", logger.TestLogger(t))
+	require.NoError(t, err)
+
+	sendInterval := time.Millisecond * 5
+	telemIngressClient := synchronization.NewTestTelemetryIngressBatchClientWithSpill(t, &url.URL{}, "33333333333", csaKeystore, telemClient, sendInterval, spill)
+
+	// the ingress server fails the first batch, and is available afterwards
+	var (
+		mu       sync.Mutex
+		failed   bool
+		received = map[string]int{}
+	)
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(func(_ context.Context, req *telemPb.TelemBatchRequest) (*telemPb.TelemResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !failed {
+			failed = true
+			return nil, errors.New("unavailable")
+		}
+		for _, telem := range req.Telemetry {
+			received[string(telem)]++
+		}
+		return nil, nil
+	})
+
+	servicetest.Run(t, telemIngressClient)
+
+	testCtx := testutils.Context(t)
+	telemIngressClient.Send(testCtx, []byte("telem 1"), "0x1", synchronization.OCR)
+	telemIngressClient.Send(testCtx, []byte("telem 2"), "0x1", synchronization.OCR)
+
+	// the failed batch is spilled, then replayed once
+	received1, received2 := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return received["telem 1"]
+	}, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return received["telem 2"]
+	}
+	g.Eventually(received1).Should(gomega.Equal(1))
+	g.Eventually(received2).Should(gomega.Equal(1))
+	g.Eventually(spill.Size).Should(gomega.BeZero())
+	g.Consistently(received1, 100*time.Millisecond).Should(gomega.Equal(1))
+	g.Consistently(received2, 100*time.Millisecond).Should(gomega.Equal(1))
+}
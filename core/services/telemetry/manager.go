@@ -2,7 +2,11 @@ package telemetry
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"net/url"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -16,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 //// Client encapsulates all the functionality needed to
@@ -37,6 +42,8 @@ type Manager struct {
 	sendTimeout                 time.Duration
 	uniConn                     bool
 	useBatchSend                bool
+	spillMaxSize                utils.FileSize
+	spillDir                    string
 	MonitoringEndpointGenerator MonitoringEndpointGenerator
 
 	//legacyMode means that we are sending all telemetry to a single endpoint.
@@ -87,6 +94,8 @@ func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logg
 		sendTimeout:  cfg.SendTimeout(),
 		uniConn:      cfg.UniConn(),
 		useBatchSend: cfg.UseBatchSend(),
+		spillMaxSize: cfg.SpillMaxSize(),
+		spillDir:     cfg.SpillDir(),
 		legacyMode:   false,
 	}
 	for _, e := range cfg.Endpoints() {
@@ -146,22 +155,38 @@ func (m *Manager) HealthReport() map[string]error {
 	return hr
 }
 
-// GenMonitoringEndpoint creates a new monitoring endpoints based on the existing available endpoints defined in the core config TOML, if no endpoint for the network and chainID exists, a NOOP agent will be used and the telemetry will not be sent
+// GenMonitoringEndpoint creates a new monitoring endpoints based on the existing available endpoints defined in the core config TOML, if no endpoint for the network and chainID exists, a NOOP agent will be used and the telemetry will not be sent.
+// If several endpoints are defined for the network and chainID, the telemetry is sent to each of them.
 func (m *Manager) GenMonitoringEndpoint(network string, chainID string, contractID string, telemType synchronization.TelemetryType) commontypes.MonitoringEndpoint {
 
-	e, found := m.getEndpoint(network, chainID)
+	endpoints := m.getEndpoints(network, chainID)
 
-	if !found {
+	if len(endpoints) == 0 {
 		m.lggr.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry %q for contactID %q will NOT be sent", network, chainID, telemType, contractID)
 		return &NoopAgent{}
 	}
 
-	if m.useBatchSend {
-		return NewIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+	agents := make(multiAgent, len(endpoints))
+	for i, e := range endpoints {
+		if m.useBatchSend {
+			agents[i] = NewIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+		} else {
+			agents[i] = NewIngressAgent(e.client, network, chainID, contractID, telemType)
+		}
+	}
+	if len(agents) == 1 {
+		return agents[0]
 	}
+	return agents
+}
 
-	return NewIngressAgent(e.client, network, chainID, contractID, telemType)
+// multiAgent sends telemetry to each of several endpoints.
+type multiAgent []commontypes.MonitoringEndpoint
 
+func (a multiAgent) SendLog(log []byte) {
+	for _, e := range a {
+		e.SendLog(log)
+	}
 }
 
 func (m *Manager) addEndpoint(e config.TelemetryIngressEndpoint) error {
@@ -181,15 +206,26 @@ func (m *Manager) addEndpoint(e config.TelemetryIngressEndpoint) error {
 		return errors.New("cannot add telemetry endpoint, ServerPubKey cannot be empty")
 	}
 
-	if _, found := m.getEndpoint(e.Network(), e.ChainID()); found {
-		return errors.Errorf("cannot add telemetry endpoint for network %q and chainID %q, endpoint already exists", e.Network(), e.ChainID())
+	for _, existing := range m.getEndpoints(e.Network(), e.ChainID()) {
+		if existing.URL.String() == e.URL().String() {
+			return errors.Errorf("cannot add telemetry endpoint for network %q and chainID %q, endpoint already exists", e.Network(), e.ChainID())
+		}
+	}
+
+	var spill *synchronization.TelemetrySpill
+	if m.spillMaxSize > 0 {
+		var err error
+		spill, err = synchronization.NewTelemetrySpill(m.endpointSpillDir(e), m.spillMaxSize, e.URL().String(), m.lggr)
+		if err != nil {
+			return errors.Wrapf(err, "cannot add telemetry endpoint for network %q and chainID %q", e.Network(), e.ChainID())
+		}
 	}
 
 	var tClient synchronization.TelemetryService
 	if m.useBatchSend {
-		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, m.logging, m.lggr, m.bufferSize, m.maxBatchSize, m.sendInterval, m.sendTimeout, m.uniConn, e.Network(), e.ChainID())
+		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, m.logging, m.lggr, m.bufferSize, m.maxBatchSize, m.sendInterval, m.sendTimeout, m.uniConn, e.Network(), e.ChainID(), spill)
 	} else {
-		tClient = synchronization.NewTelemetryIngressClient(e.URL(), e.ServerPubKey(), m.ks, m.logging, m.lggr, m.bufferSize, e.Network(), e.ChainID())
+		tClient = synchronization.NewTelemetryIngressClient(e.URL(), e.ServerPubKey(), m.ks, m.logging, m.lggr, m.bufferSize, e.Network(), e.ChainID(), spill)
 	}
 
 	te := telemetryEndpoint{
@@ -204,16 +240,24 @@ func (m *Manager) addEndpoint(e config.TelemetryIngressEndpoint) error {
 	return nil
 }
 
-func (m *Manager) getEndpoint(network string, chainID string) (*telemetryEndpoint, bool) {
+// endpointSpillDir returns the directory telemetry for e is spilled to, which is unique to its network, chainID and URL.
+func (m *Manager) endpointSpillDir(e config.TelemetryIngressEndpoint) string {
+	h := sha256.Sum256([]byte(e.URL().String()))
+	name := fmt.Sprintf("%s-%s-%s", e.Network(), e.ChainID(), hex.EncodeToString(h[:4]))
+	return filepath.Join(m.spillDir, strings.ToLower(strings.ReplaceAll(name, string(filepath.Separator), "_")))
+}
+
+func (m *Manager) getEndpoints(network string, chainID string) []*telemetryEndpoint {
 	//in legacy mode we send telemetry to a single endpoint
 	if m.legacyMode && len(m.endpoints) == 1 {
-		return m.endpoints[0], true
+		return m.endpoints
 	}
 
+	var endpoints []*telemetryEndpoint
 	for _, e := range m.endpoints {
 		if e.Network == strings.ToUpper(network) && e.ChainID == strings.ToUpper(chainID) {
-			return e, true
+			endpoints = append(endpoints, e)
 		}
 	}
-	return nil, false
+	return endpoints
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"os"
 	"reflect"
 	"strings"
 	"testing"
@@ -23,6 +24,7 @@ import (
 	mocks3 "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	mocks2 "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
@@ -34,6 +36,8 @@ func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
 	tic.On("SendTimeout").Return(time.Second * 7)
 	tic.On("UniConn").Return(true)
 	tic.On("UseBatchSend").Return(useBatchSend)
+	tic.On("SpillMaxSize").Return(utils.FileSize(0))
+	tic.On("SpillDir").Return("")
 
 	return tic
 }
@@ -198,6 +202,60 @@ func TestNewManager(t *testing.T) {
 	require.Equal(t, 4, len(hr))
 }
 
+func TestMultipleEndpoints(t *testing.T) {
+	tic := mocks.NewTelemetryIngress(t)
+	tic.On("BufferSize").Return(uint(123))
+	tic.On("Logging").Return(true)
+	tic.On("MaxBatchSize").Return(uint(51))
+	tic.On("SendInterval").Return(time.Millisecond * 512)
+	tic.On("SendTimeout").Return(time.Second * 7)
+	tic.On("UniConn").Return(true)
+	tic.On("UseBatchSend").Return(true)
+	tic.On("SpillMaxSize").Return(utils.FileSize(utils.MB))
+	spillDir := t.TempDir()
+	tic.On("SpillDir").Return(spillDir)
+
+	var endpoints []config.TelemetryIngressEndpoint
+	for _, u := range []string{"http://primary.test", "http://secondary.test"} {
+		te := mocks.NewTelemetryIngressEndpoint(t)
+		te.On("Network").Return("EVM")
+		te.On("ChainID").Return("1")
+		te.On("ServerPubKey").Return("some-pubkey")
+		parsed, err := url.Parse(u)
+		require.NoError(t, err)
+		te.On("URL").Return(parsed)
+		endpoints = append(endpoints, te)
+	}
+	tic.On("Endpoints").Return(endpoints)
+
+	lggr, obsLogs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
+	tm := NewManager(tic, mocks3.NewCSA(t), lggr)
+	require.Len(t, tm.endpoints, 2)
+	require.Equal(t, 0, obsLogs.FilterMessageSnippet("already exists").Len())
+
+	// each endpoint spills to its own directory
+	dirs, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	require.Len(t, dirs, 2)
+
+	var sent [2][][]byte
+	for i := range tm.endpoints {
+		i := i
+		clientMock := mocks2.NewTelemetryService(t)
+		clientMock.On("Send", mock.Anything, mock.AnythingOfType("[]uint8"), "some-contractID", synchronization.OCR).Return().Run(func(args mock.Arguments) {
+			sent[i] = append(sent[i], args[1].([]byte))
+		})
+		tm.endpoints[i].client = clientMock
+	}
+
+	me := tm.GenMonitoringEndpoint("evm", "1", "some-contractID", synchronization.OCR)
+	me.SendLog([]byte("message-1"))
+	me.SendLog([]byte("message-2"))
+	for i := range sent {
+		require.Equal(t, [][]byte{[]byte("message-1"), []byte("message-2")}, sent[i])
+	}
+}
+
 func TestCorrectEndpointRouting(t *testing.T) {
 	tic := setupMockConfig(t, true)
 	tic.On("Endpoints").Return(nil)
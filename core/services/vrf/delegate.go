@@ -2,6 +2,8 @@ package vrf
 
 import (
 	"fmt"
+	"runtime"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
@@ -32,6 +34,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 )
 
+// proofQueueSizePerWorker bounds the number of requests queued for proof generation, per CPU core.
+const proofQueueSizePerWorker = 64
+
 type Delegate struct {
 	q            pg.Q
 	pr           pipeline.Runner
@@ -40,6 +45,11 @@ type Delegate struct {
 	legacyChains legacyevm.LegacyChainContainer
 	lggr         logger.Logger
 	mailMon      *mailbox.Monitor
+	proofPool    *v2.ProofWorkerPool
+
+	mu                   sync.RWMutex
+	requestManagers      map[int32]v2.RequestManager
+	subscriptionMonitors map[int32]v2.SubscriptionReporter
 }
 
 func NewDelegate(
@@ -52,13 +62,16 @@ func NewDelegate(
 	cfg pg.QConfig,
 	mailMon *mailbox.Monitor) *Delegate {
 	return &Delegate{
-		q:            pg.NewQ(db, lggr, cfg),
-		ks:           ks,
-		pr:           pr,
-		porm:         porm,
-		legacyChains: legacyChains,
-		lggr:         lggr.Named("VRF"),
-		mailMon:      mailMon,
+		q:                    pg.NewQ(db, lggr, cfg),
+		ks:                   ks,
+		pr:                   pr,
+		porm:                 porm,
+		legacyChains:         legacyChains,
+		lggr:                 lggr.Named("VRF"),
+		mailMon:              mailMon,
+		proofPool:            v2.NewProofWorkerPool(runtime.NumCPU(), proofQueueSizePerWorker*runtime.NumCPU()),
+		requestManagers:      make(map[int32]v2.RequestManager),
+		subscriptionMonitors: make(map[int32]v2.SubscriptionReporter),
 	}
 }
 
@@ -68,9 +81,64 @@ func (d *Delegate) JobType() job.Type {
 
 func (d *Delegate) BeforeJobCreated(job.Job)              {}
 func (d *Delegate) AfterJobCreated(job.Job)               {}
-func (d *Delegate) BeforeJobDeleted(job.Job)              {}
 func (d *Delegate) OnDeleteJob(job.Job, pg.Queryer) error { return nil }
 
+func (d *Delegate) BeforeJobDeleted(jb job.Job) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.requestManagers, jb.ID)
+	delete(d.subscriptionMonitors, jb.ID)
+}
+
+// RequestManagers returns the request managers of the VRF V2 and V2 Plus jobs, keyed by job ID.
+func (d *Delegate) RequestManagers() map[int32]v2.RequestManager {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	managers := make(map[int32]v2.RequestManager, len(d.requestManagers))
+	for id, m := range d.requestManagers {
+		managers[id] = m
+	}
+	return managers
+}
+
+func (d *Delegate) addRequestManager(jobID int32, m v2.RequestManager) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requestManagers[jobID] = m
+}
+
+// SubscriptionMonitors returns the subscription monitors of the VRF V2 and V2 Plus jobs, keyed by job ID.
+// Jobs on chains without an enabled EVM.VRFSubscriptionMonitor are omitted.
+func (d *Delegate) SubscriptionMonitors() map[int32]v2.SubscriptionReporter {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	monitors := make(map[int32]v2.SubscriptionReporter, len(d.subscriptionMonitors))
+	for id, m := range d.subscriptionMonitors {
+		monitors[id] = m
+	}
+	return monitors
+}
+
+// newSubscriptionMonitor returns the subscription monitor of the job, or nil if it is disabled.
+func (d *Delegate) newSubscriptionMonitor(jb job.Job, l logger.Logger, chain legacyevm.Chain, coordinator v2.CoordinatorV2_X) *v2.SubscriptionMonitor {
+	cfg := chain.Config().EVM().VRFSubscriptionMonitor()
+	if !cfg.Enabled() {
+		return nil
+	}
+	m := v2.NewSubscriptionMonitor(l, chain.ID(), coordinator, cfg)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscriptionMonitors[jb.ID] = m
+	return m
+}
+
+func servicesWithMonitor(lsn v2.Listener, m *v2.SubscriptionMonitor) []job.ServiceCtx {
+	if m == nil {
+		return []job.ServiceCtx{lsn}
+	}
+	return []job.ServiceCtx{lsn, m}
+}
+
 // ServicesForSpec satisfies the job.Delegate interface.
 func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 	if jb.VRFSpec == nil || jb.PipelineSpec == nil {
@@ -163,28 +231,32 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				return nil, errors.Wrap(err2, "NewAggregatorV3Interface")
 			}
 
-			return []job.ServiceCtx{
-				v2.New(
-					chain.Config().EVM(),
-					chain.Config().EVM().GasEstimator(),
-					lV2Plus,
-					chain,
-					chain.ID(),
-					d.q,
-					v2.NewCoordinatorV2_5(coordinatorV2Plus),
-					batchCoordinatorV2,
-					vrfOwner,
-					aggregator,
-					d.pr,
-					d.ks.Eth(),
-					jb,
-					func() {},
-					// the lookback in the deduper must be >= the lookback specified for the log poller
-					// otherwise we will end up re-delivering logs that were already delivered.
-					vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
-					vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
-				),
-			}, nil
+			coordinator := v2.NewCoordinatorV2_5(coordinatorV2Plus)
+			subMonitor := d.newSubscriptionMonitor(jb, lV2Plus, chain, coordinator)
+			lsn := v2.New(
+				chain.Config().EVM(),
+				chain.Config().EVM().GasEstimator(),
+				lV2Plus,
+				chain,
+				chain.ID(),
+				d.q,
+				coordinator,
+				batchCoordinatorV2,
+				vrfOwner,
+				aggregator,
+				d.pr,
+				d.ks.Eth(),
+				jb,
+				func() {},
+				// the lookback in the deduper must be >= the lookback specified for the log poller
+				// otherwise we will end up re-delivering logs that were already delivered.
+				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
+				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
+				subMonitor,
+				d.proofPool,
+			)
+			d.addRequestManager(jb.ID, lsn)
+			return servicesWithMonitor(lsn, subMonitor), nil
 		}
 		if _, ok := task.(*pipeline.VRFTaskV2); ok {
 			if err2 := CheckFromAddressesExist(jb, d.ks.Eth()); err != nil {
@@ -218,14 +290,16 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				lV2.Infow("Running without VRFOwnerAddress set on the spec")
 			}
 
-			return []job.ServiceCtx{v2.New(
+			coordinator := v2.NewCoordinatorV2(coordinatorV2)
+			subMonitor := d.newSubscriptionMonitor(jb, lV2, chain, coordinator)
+			lsn := v2.New(
 				chain.Config().EVM(),
 				chain.Config().EVM().GasEstimator(),
 				lV2,
 				chain,
 				chain.ID(),
 				d.q,
-				v2.NewCoordinatorV2(coordinatorV2),
+				coordinator,
 				batchCoordinatorV2,
 				vrfOwner,
 				aggregator,
@@ -237,8 +311,11 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				// otherwise we will end up re-delivering logs that were already delivered.
 				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
 				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
-			),
-			}, nil
+				subMonitor,
+				d.proofPool,
+			)
+			d.addRequestManager(jb.ID, lsn)
+			return servicesWithMonitor(lsn, subMonitor), nil
 		}
 		if _, ok := task.(*pipeline.VRFTask); ok {
 			return []job.ServiceCtx{&v1.Listener{
@@ -64,6 +64,13 @@ const (
 	txMetaGlobalSubId = "GlobalSubId"
 )
 
+// Listener is a VRF V2 or V2 Plus listener, which fulfills requests for the job's key and
+// allows operators to inspect and manually fulfill its pending requests.
+type Listener interface {
+	job.ServiceCtx
+	RequestManager
+}
+
 func New(
 	cfg vrfcommon.Config,
 	feeCfg vrfcommon.FeeConfig,
@@ -81,7 +88,9 @@ func New(
 	reqAdded func(),
 	inflightCache vrfcommon.InflightCache,
 	fulfillmentDeduper *vrfcommon.LogDeduper,
-) job.ServiceCtx {
+	subMonitor *SubscriptionMonitor,
+	proofPool *ProofWorkerPool,
+) Listener {
 	return &listenerV2{
 		cfg:                   cfg,
 		feeCfg:                feeCfg,
@@ -103,6 +112,8 @@ func New(
 		aggregator:            aggregator,
 		inflightCache:         inflightCache,
 		fulfillmentLogDeduper: fulfillmentDeduper,
+		subMonitor:            subMonitor,
+		proofPool:             proofPool,
 	}
 }
 
@@ -152,6 +163,19 @@ type listenerV2 struct {
 	// inflightCache is a cache of in-flight requests, used to prevent
 	// re-processing of requests that are in-flight or already fulfilled.
 	inflightCache vrfcommon.InflightCache
+
+	// pending holds the unfulfilled requests found by the latest log poll,
+	// for inspection by operators.
+	pendingMu sync.RWMutex
+	pending   []pendingRequest
+
+	// subMonitor tracks the subscriptions of the observed requests. It is nil if
+	// the VRF subscription monitor is disabled.
+	subMonitor *SubscriptionMonitor
+
+	// proofPool runs the pipelines generating the proofs of pending requests. It is
+	// shared by all VRF jobs of the node.
+	proofPool *ProofWorkerPool
 }
 
 func (lsn *listenerV2) HealthReport() map[string]error {
@@ -83,6 +83,7 @@ func (lsn *listenerV2) runLogListener(
 					"elapsed", time.Since(start))
 				continue
 			}
+			lsn.setPending(pending)
 
 			// process pending requests and insert any fulfillments into the inflight cache
 			lsn.processPendingVRFRequests(ctx, pending)
@@ -272,6 +273,7 @@ func (lsn *listenerV2) getUnfulfilled(logs []logpoller.Log, ll logger.Logger) (u
 	var (
 		requested       = make(map[string]RandomWordsRequested)
 		requestedLP     = make(map[string]logpoller.Log)
+		fulfilledLP     = make(map[string]logpoller.Log)
 		errs            error
 		expectedKeyHash = lsn.job.VRFSpec.PublicKey.MustHash()
 	)
@@ -285,6 +287,7 @@ func (lsn *listenerV2) getUnfulfilled(logs []logpoller.Log, ll logger.Logger) (u
 				continue
 			}
 			fulfilled[parsed.RequestID().String()] = parsed
+			fulfilledLP[parsed.RequestID().String()] = l
 		} else if l.EventSig == lsn.coordinator.RandomWordsRequestedTopic() {
 			parsed, err2 := lsn.coordinator.ParseRandomWordsRequested(l.ToGethLog())
 			if err2 != nil {
@@ -307,6 +310,16 @@ func (lsn *listenerV2) getUnfulfilled(logs []logpoller.Log, ll logger.Logger) (u
 		ll.Errorw("encountered parse errors", "err", errs)
 	}
 
+	if lsn.subMonitor != nil {
+		// requests are observed first, so that their fulfillments can be attributed to their subscriptions
+		for reqID, req := range requested {
+			lsn.subMonitor.observeRequest(req, requestedLP[reqID].BlockTimestamp)
+		}
+		for reqID, f := range fulfilled {
+			lsn.subMonitor.observeFulfillment(f, fulfilledLP[reqID].BlockTimestamp)
+		}
+	}
+
 	if len(fulfilled) > 0 || len(requested) > 0 {
 		ll.Infow("found logs", "fulfilled", len(fulfilled), "requested", len(requested))
 	} else {
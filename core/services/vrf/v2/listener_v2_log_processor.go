@@ -308,12 +308,23 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 	// Add very conservative upper bound estimate on verification costs.
 	batchMaxGas := config.MaxGasLimit() + 400_000
 
+	// Size batches dynamically so that they still fit into a block, since the block
+	// gas limit may be lower than the coordinator's config implies, or change over time.
+	var blockGasLimit uint64
+	if header, err2 := lsn.chain.Client().HeaderByNumber(ctx, nil); err2 != nil {
+		lsn.l.Warnw("Couldn't get latest header, not limiting batches by block gas limit", "err", err2)
+	} else if header != nil {
+		blockGasLimit = header.GasLimit
+	}
+	maxBatchGas := maxBatchGasEstimate(blockGasLimit, float64(lsn.job.VRFSpec.BatchFulfillmentGasMultiplier))
+
 	l := lsn.l.With(
 		"subID", subID,
 		"eligibleSubReqs", len(reqs),
 		"startBalance", startBalance.String(),
 		"startBalanceNoReserved", startBalanceNoReserved.String(),
 		"batchMaxGas", batchMaxGas,
+		"blockGasLimit", blockGasLimit,
 		"subIsActive", subIsActive,
 		"nativePayment", nativePayment,
 	)
@@ -372,7 +383,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 		observeRequestSimDuration(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, lsn.coordinator.Version(), unfulfilled)
 
 		pipelines := lsn.runPipelines(ctx, l, maxGasPriceWei, unfulfilled)
-		batches := newBatchFulfillments(batchMaxGas, lsn.coordinator.Version())
+		batches := newBatchFulfillments(batchMaxGas, maxBatchGas, lsn.coordinator.Version())
 		outOfBalance := false
 		for _, p := range pipelines {
 			ll := l.With("reqID", p.req.req.RequestID().String(),
@@ -467,7 +478,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 		var processedRequestIDs []string
 		for _, batch := range batches.fulfillments {
 			l.Debugw("Processing batch", "batchSize", len(batch.proofs))
-			p := lsn.processBatch(l, subID, startBalanceNoReserved, batchMaxGas, batch, batch.fromAddress)
+			p := lsn.processBatch(l, subID, startBalanceNoReserved, batchMaxGas, blockGasLimit, batch, batch.fromAddress)
 			processedRequestIDs = append(processedRequestIDs, p...)
 		}
 
@@ -786,53 +797,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 			}
 
 			ll.Infow("Enqueuing fulfillment")
-			var transaction txmgr.Tx
-			err = lsn.q.Transaction(func(tx pg.Queryer) error {
-				if err = lsn.pipelineRunner.InsertFinishedRun(p.run, true, pg.WithQueryer(tx)); err != nil {
-					return err
-				}
-
-				var maxLink, maxEth *string
-				tmp := p.maxFee.String()
-				if p.reqCommitment.NativePayment() {
-					maxEth = &tmp
-				} else {
-					maxLink = &tmp
-				}
-				var (
-					txMetaSubID       *uint64
-					txMetaGlobalSubID *string
-				)
-				if lsn.coordinator.Version() == vrfcommon.V2Plus {
-					txMetaGlobalSubID = ptr(p.req.req.SubID().String())
-				} else if lsn.coordinator.Version() == vrfcommon.V2 {
-					txMetaSubID = ptr(p.req.req.SubID().Uint64())
-				}
-				requestID := common.BytesToHash(p.req.req.RequestID().Bytes())
-				coordinatorAddress := lsn.coordinator.Address()
-				requestTxHash := p.req.req.Raw().TxHash
-				transaction, err = lsn.chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
-					FromAddress:    fromAddress,
-					ToAddress:      lsn.coordinator.Address(),
-					EncodedPayload: hexutil.MustDecode(p.payload),
-					FeeLimit:       p.gasLimit,
-					Meta: &txmgr.TxMeta{
-						RequestID:     &requestID,
-						MaxLink:       maxLink,
-						MaxEth:        maxEth,
-						SubID:         txMetaSubID,
-						GlobalSubID:   txMetaGlobalSubID,
-						RequestTxHash: &requestTxHash,
-					},
-					Strategy: txmgrcommon.NewSendEveryStrategy(),
-					Checker: txmgr.TransmitCheckerSpec{
-						CheckerType:           lsn.transmitCheckerType(),
-						VRFCoordinatorAddress: &coordinatorAddress,
-						VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw().BlockNumber),
-					},
-				})
-				return err
-			})
+			transaction, err := lsn.enqueueFulfillment(ctx, p, fromAddress)
 			if err != nil {
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
 				continue
@@ -850,6 +815,62 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 	return
 }
 
+// enqueueFulfillment stores the finished pipeline run of the given request and enqueues
+// its fulfillment with the coordinator.
+func (lsn *listenerV2) enqueueFulfillment(
+	ctx context.Context,
+	p vrfPipelineResult,
+	fromAddress common.Address,
+) (transaction txmgr.Tx, err error) {
+	err = lsn.q.Transaction(func(tx pg.Queryer) error {
+		if err = lsn.pipelineRunner.InsertFinishedRun(p.run, true, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+
+		var maxLink, maxEth *string
+		tmp := p.maxFee.String()
+		if p.reqCommitment.NativePayment() {
+			maxEth = &tmp
+		} else {
+			maxLink = &tmp
+		}
+		var (
+			txMetaSubID       *uint64
+			txMetaGlobalSubID *string
+		)
+		if lsn.coordinator.Version() == vrfcommon.V2Plus {
+			txMetaGlobalSubID = ptr(p.req.req.SubID().String())
+		} else if lsn.coordinator.Version() == vrfcommon.V2 {
+			txMetaSubID = ptr(p.req.req.SubID().Uint64())
+		}
+		requestID := common.BytesToHash(p.req.req.RequestID().Bytes())
+		coordinatorAddress := lsn.coordinator.Address()
+		requestTxHash := p.req.req.Raw().TxHash
+		transaction, err = lsn.chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      lsn.coordinator.Address(),
+			EncodedPayload: hexutil.MustDecode(p.payload),
+			FeeLimit:       p.gasLimit,
+			Meta: &txmgr.TxMeta{
+				RequestID:     &requestID,
+				MaxLink:       maxLink,
+				MaxEth:        maxEth,
+				SubID:         txMetaSubID,
+				GlobalSubID:   txMetaGlobalSubID,
+				RequestTxHash: &requestTxHash,
+			},
+			Strategy: txmgrcommon.NewSendEveryStrategy(),
+			Checker: txmgr.TransmitCheckerSpec{
+				CheckerType:           lsn.transmitCheckerType(),
+				VRFCoordinatorAddress: &coordinatorAddress,
+				VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw().BlockNumber),
+			},
+		})
+		return err
+	})
+	return
+}
+
 func (lsn *listenerV2) transmitCheckerType() txmgrtypes.TransmitCheckerType {
 	if lsn.coordinator.Version() == vrfcommon.V2 {
 		return txmgr.TransmitCheckerTypeVRFV2
@@ -1049,11 +1070,18 @@ func (lsn *listenerV2) runPipelines(
 	)
 
 	for i, req := range reqs {
+		i, req := i, req
 		wg.Add(1)
-		go func(i int, req pendingRequest) {
+		// requests closest to timing out are simulated first
+		deadline := req.utcTimestamp.Add(lsn.job.VRFSpec.RequestTimeout)
+		err := lsn.proofPool.submit(ctx, deadline, func() {
 			defer wg.Done()
 			results[i] = lsn.simulateFulfillment(ctx, maxGasPriceWei, req, l)
-		}(i, req)
+		})
+		if err != nil {
+			wg.Done()
+			results[i] = vrfPipelineResult{req: req, err: fmt.Errorf("queueing proof generation: %w", err)}
+		}
 	}
 	wg.Wait()
 
@@ -0,0 +1,219 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+// ErrRequestNotPending is returned by FulfillRequest if the request is not among the pending requests of the listener.
+var ErrRequestNotPending = errors.New("request is not pending")
+
+// RequestStatus describes a pending VRF request, and why it has not been fulfilled yet.
+type RequestStatus struct {
+	RequestID        *big.Int
+	SubID            *big.Int
+	Sender           common.Address
+	RequestTxHash    common.Hash
+	BlockNumber      uint64
+	ConfirmedAtBlock uint64
+	CallbackGasLimit uint32
+	NativePayment    bool
+	Attempts         int
+	// SubBalance is the LINK balance of the subscription, or the native balance for native payments.
+	// It is nil if the subscription could not be read.
+	SubBalance *big.Int
+	// MaxFee is the maximum fee of the fulfillment at the max gas price, if it was simulated.
+	MaxFee *big.Int
+	// SimulationError is the error of the simulated fulfillment, if it failed.
+	SimulationError string
+	// Reason is why the request has not been fulfilled yet. It is empty if the request
+	// is ready to be fulfilled in the next round.
+	Reason string
+}
+
+// RequestManager allows operators to inspect pending VRF requests and to fulfill them manually.
+type RequestManager interface {
+	// PendingRequests returns the status of every request found by the latest log poll.
+	PendingRequests(ctx context.Context) ([]RequestStatus, error)
+	// FulfillRequest enqueues the fulfillment of a pending request regardless of the
+	// subscription balance, force-fulfilling it through the VRF owner if the simulation fails.
+	FulfillRequest(ctx context.Context, requestID *big.Int) (txmgr.Tx, error)
+}
+
+func (lsn *listenerV2) setPending(pending []pendingRequest) {
+	lsn.pendingMu.Lock()
+	defer lsn.pendingMu.Unlock()
+	lsn.pending = pending
+}
+
+func (lsn *listenerV2) getPending() []pendingRequest {
+	lsn.pendingMu.RLock()
+	defer lsn.pendingMu.RUnlock()
+	return append([]pendingRequest(nil), lsn.pending...)
+}
+
+func (lsn *listenerV2) PendingRequests(ctx context.Context) ([]RequestStatus, error) {
+	reqs := lsn.getPending()
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	fulfilled, err := lsn.checkReqsFulfilled(ctx, lsn.l, reqs)
+	if err != nil {
+		lsn.l.Warnw("Error checking for already fulfilled requests, proceeding anyway", "err", err)
+	}
+
+	var (
+		latestHead  = lsn.getLatestHead()
+		statuses    = make([]RequestStatus, len(reqs))
+		balances    = make(map[string]*big.Int)
+		toSimulate  []pendingRequest
+		simulateIdx []int
+	)
+	for i, req := range reqs {
+		statuses[i] = RequestStatus{
+			RequestID:        req.req.RequestID(),
+			SubID:            req.req.SubID(),
+			Sender:           req.req.Sender(),
+			RequestTxHash:    req.req.Raw().TxHash,
+			BlockNumber:      req.req.Raw().BlockNumber,
+			ConfirmedAtBlock: req.confirmedAtBlock,
+			CallbackGasLimit: req.req.CallbackGasLimit(),
+			NativePayment:    req.req.NativePayment(),
+			Attempts:         req.attempts,
+		}
+		s := &statuses[i]
+
+		key := fmt.Sprintf("%s-%t", s.SubID, s.NativePayment)
+		balance, ok := balances[key]
+		if !ok {
+			balance, err = subscriptionBalance(ctx, lsn.coordinator, s.SubID, s.NativePayment)
+			if err != nil {
+				lsn.l.Debugw("Failed to get subscription balance", "subID", s.SubID, "err", err)
+			}
+			balances[key] = balance
+		}
+		s.SubBalance = balance
+
+		switch {
+		case fulfilled[i]:
+			s.Reason = "already fulfilled"
+		case time.Now().UTC().Sub(req.utcTimestamp) >= lsn.job.VRFSpec.RequestTimeout:
+			s.Reason = "request timed out"
+		case req.confirmedAtBlock > latestHead:
+			s.Reason = fmt.Sprintf("waiting for confirmations until block %d", req.confirmedAtBlock)
+		case !lsn.ready(req, latestHead):
+			s.Reason = fmt.Sprintf("backing off after %d attempts", req.attempts)
+		default:
+			toSimulate = append(toSimulate, req)
+			simulateIdx = append(simulateIdx, i)
+		}
+	}
+	if len(toSimulate) == 0 {
+		return statuses, nil
+	}
+
+	maxGasPriceWei := lsn.feeCfg.PriceMaxKey(lsn.fromAddresses()[0])
+	for j, p := range lsn.runPipelines(ctx, lsn.l, maxGasPriceWei, toSimulate) {
+		s := &statuses[simulateIdx[j]]
+		s.MaxFee = p.maxFee
+		if p.err != nil {
+			s.SimulationError = p.err.Error()
+		}
+		switch {
+		case errors.Is(p.err, errBlockhashNotInStore{}):
+			s.Reason = "blockhash not in store"
+		case errors.Is(p.err, errProofVerificationFailed{}):
+			s.Reason = "proof verification failed, likely stale blockhash"
+		case errors.Is(p.err, errPossiblyInsufficientFunds{}):
+			s.Reason = "insufficient subscription balance"
+		case p.err != nil:
+			s.Reason = "simulation failed"
+		case s.SubBalance != nil && p.maxFee != nil && s.SubBalance.Cmp(p.maxFee) < 0:
+			s.Reason = "insufficient subscription balance"
+		}
+	}
+	return statuses, nil
+}
+
+// subscriptionBalance returns the LINK or native balance of the subscription. It returns nil
+// without error if the subscription does not exist.
+func subscriptionBalance(ctx context.Context, coordinator CoordinatorV2_X, subID *big.Int, nativePayment bool) (*big.Int, error) {
+	sub, err := coordinator.GetSubscription(&bind.CallOpts{Context: ctx}, subID)
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			// the subscription no longer exists
+			return nil, nil
+		}
+		return nil, err
+	}
+	if nativePayment {
+		return sub.NativeBalance(), nil
+	}
+	return sub.Balance(), nil
+}
+
+func (lsn *listenerV2) FulfillRequest(ctx context.Context, requestID *big.Int) (txmgr.Tx, error) {
+	var (
+		req   pendingRequest
+		found bool
+	)
+	for _, r := range lsn.getPending() {
+		if r.req.RequestID().Cmp(requestID) == 0 {
+			req, found = r, true
+			break
+		}
+	}
+	if !found {
+		return txmgr.Tx{}, ErrRequestNotPending
+	}
+	l := lsn.l.With("reqID", requestID.String(), "txHash", req.req.Raw().TxHash)
+
+	if fulfilled, err := lsn.checkReqsFulfilled(ctx, l, []pendingRequest{req}); err != nil {
+		return txmgr.Tx{}, fmt.Errorf("failed to check whether request is fulfilled: %w", err)
+	} else if fulfilled[0] {
+		return txmgr.Tx{}, errors.New("request is already fulfilled")
+	}
+	if lsn.inflightCache.Contains(req.req.Raw()) {
+		return txmgr.Tx{}, errors.New("fulfillment of request is already in flight")
+	}
+
+	fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, lsn.fromAddresses()...)
+	if err != nil {
+		return txmgr.Tx{}, fmt.Errorf("failed to get from address: %w", err)
+	}
+	l = l.With("fromAddress", fromAddress)
+
+	p := lsn.simulateFulfillment(ctx, lsn.feeCfg.PriceMaxKey(fromAddress), req, l)
+	var etx txmgr.Tx
+	switch {
+	case p.err == nil:
+		l.Infow("Enqueuing manual fulfillment")
+		etx, err = lsn.enqueueFulfillment(ctx, p, fromAddress)
+	case errors.Is(p.err, errBlockhashNotInStore{}), errors.Is(p.err, errProofVerificationFailed{}):
+		return txmgr.Tx{}, fmt.Errorf("cannot fulfill request: %w", p.err)
+	default:
+		l.Warnw("Simulation failed, force-fulfilling request", "err", p.err)
+		etx, err = lsn.enqueueForceFulfillment(ctx, p, fromAddress)
+		if err != nil {
+			err = fmt.Errorf("simulation failed (%v), and force-fulfillment failed: %w", p.err, err)
+		}
+	}
+	if err != nil {
+		return txmgr.Tx{}, err
+	}
+	l.Infow("Enqueued manual fulfillment", "ethTxID", etx.GetID())
+
+	// make sure the listener doesn't fulfill the request again
+	lsn.inflightCache.Add(req.req.Raw())
+	return etx, nil
+}
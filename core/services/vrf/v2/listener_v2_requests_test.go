@@ -0,0 +1,84 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestListener_PendingRequests(t *testing.T) {
+	client := evmclimocks.NewClient(t)
+	sub, err := coordinatorV2ABI.Methods["getSubscription"].Outputs.Pack(big.NewInt(1000), uint64(3), testutils.NewAddress(), []common.Address{})
+	require.NoError(t, err)
+	client.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(sub, nil).Once()
+	client.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		for i, elem := range args.Get(1).([]rpc.BatchElem) {
+			// the first request is already fulfilled, which deletes its commitment
+			commitment := hexutil.Encode(make([]byte, 32))
+			if i > 0 {
+				commitment = hexutil.Encode(append(make([]byte, 31), 1))
+			}
+			*elem.Result.(*string) = commitment
+		}
+	}).Return(nil)
+	coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(testutils.NewAddress(), client)
+	require.NoError(t, err)
+	chain := evmmocks.NewChain(t)
+	chain.On("Client").Return(client)
+
+	request := func(id int64) RandomWordsRequested {
+		return NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId:        big.NewInt(id),
+			SubId:            1,
+			CallbackGasLimit: 100_000,
+			Raw:              types.Log{BlockNumber: 90},
+		})
+	}
+	lsn := &listenerV2{
+		l:                logger.Sugared(logger.TestLogger(t)),
+		chain:            chain,
+		coordinator:      NewCoordinatorV2(coordinator),
+		job:              job.Job{VRFSpec: &job.VRFSpec{RequestTimeout: time.Hour}},
+		latestHeadNumber: 100,
+	}
+	lsn.setPending([]pendingRequest{
+		{req: request(1), confirmedAtBlock: 95, utcTimestamp: time.Now().UTC()},
+		{req: request(2), confirmedAtBlock: 95, utcTimestamp: time.Now().UTC().Add(-2 * time.Hour)},
+		{req: request(3), confirmedAtBlock: 110, utcTimestamp: time.Now().UTC()},
+	})
+
+	statuses, err := lsn.PendingRequests(testutils.Context(t))
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	for i, reason := range []string{
+		"already fulfilled",
+		"request timed out",
+		"waiting for confirmations until block 110",
+	} {
+		assert.Equal(t, big.NewInt(int64(i+1)), statuses[i].RequestID)
+		assert.Equal(t, big.NewInt(1), statuses[i].SubID)
+		assert.Equal(t, big.NewInt(1000), statuses[i].SubBalance)
+		assert.Equal(t, reason, statuses[i].Reason)
+	}
+}
+
+func TestListener_FulfillRequest_NotPending(t *testing.T) {
+	lsn := &listenerV2{l: logger.Sugared(logger.TestLogger(t))}
+	_, err := lsn.FulfillRequest(testutils.Context(t), big.NewInt(1))
+	require.ErrorIs(t, err, ErrRequestNotPending)
+}
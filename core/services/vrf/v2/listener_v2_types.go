@@ -1,14 +1,17 @@
 package v2
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	heaps "github.com/theodesp/go-heaps"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -84,12 +87,16 @@ type batchFulfillment struct {
 	proofs        []VRFProof
 	commitments   []RequestCommitment
 	totalGasLimit uint32
-	runs          []*pipeline.Run
-	reqIDs        []*big.Int
-	maxFees       []*big.Int
-	txHashes      []common.Hash
-	fromAddress   common.Address
-	version       vrfcommon.Version
+	// estimatedGas is the gas the batch is expected to consume on-chain, including
+	// proof verification and the batch coordinator's iteration overhead.
+	estimatedGas uint64
+	gasLimits    []uint32
+	runs         []*pipeline.Run
+	reqIDs       []*big.Int
+	maxFees      []*big.Int
+	txHashes     []common.Hash
+	fromAddress  common.Address
+	version      vrfcommon.Version
 }
 
 func newBatchFulfillment(result vrfPipelineResult, fromAddress common.Address, version vrfcommon.Version) *batchFulfillment {
@@ -101,6 +108,10 @@ func newBatchFulfillment(result vrfPipelineResult, fromAddress common.Address, v
 			result.reqCommitment,
 		},
 		totalGasLimit: result.gasLimit,
+		estimatedGas:  batchRequestGasEstimate(result.gasLimit),
+		gasLimits: []uint32{
+			result.gasLimit,
+		},
 		runs: []*pipeline.Run{
 			result.run,
 		},
@@ -118,33 +129,63 @@ func newBatchFulfillment(result vrfPipelineResult, fromAddress common.Address, v
 	}
 }
 
+// split splits the batch into two halves, so that requests which cause the
+// whole batch to revert can be isolated from the rest.
+func (b *batchFulfillment) split() (left, right *batchFulfillment) {
+	mid := len(b.reqIDs) / 2
+	half := func(from, to int) *batchFulfillment {
+		h := &batchFulfillment{
+			proofs:      b.proofs[from:to],
+			commitments: b.commitments[from:to],
+			gasLimits:   b.gasLimits[from:to],
+			runs:        b.runs[from:to],
+			reqIDs:      b.reqIDs[from:to],
+			maxFees:     b.maxFees[from:to],
+			txHashes:    b.txHashes[from:to],
+			fromAddress: b.fromAddress,
+			version:     b.version,
+		}
+		for _, gasLimit := range h.gasLimits {
+			h.totalGasLimit += gasLimit
+			h.estimatedGas += batchRequestGasEstimate(gasLimit)
+		}
+		return h
+	}
+	return half(0, mid), half(mid, len(b.reqIDs))
+}
+
 // batchFulfillments manages many batchFulfillment objects.
 // It makes organizing many runs into batches that respect the
-// batchGasLimit easy via the addRun method.
+// batchGasLimit and maxBatchGas easy via the addRun method.
 type batchFulfillments struct {
 	fulfillments  []*batchFulfillment
 	batchGasLimit uint32
-	currIndex     int
-	version       vrfcommon.Version
+	// maxBatchGas caps the estimated on-chain gas of a single batch, e.g. based
+	// on the current block gas limit. Zero means no cap.
+	maxBatchGas uint64
+	currIndex   int
+	version     vrfcommon.Version
 }
 
-func newBatchFulfillments(batchGasLimit uint32, version vrfcommon.Version) *batchFulfillments {
+func newBatchFulfillments(batchGasLimit uint32, maxBatchGas uint64, version vrfcommon.Version) *batchFulfillments {
 	return &batchFulfillments{
 		fulfillments:  []*batchFulfillment{},
 		batchGasLimit: batchGasLimit,
+		maxBatchGas:   maxBatchGas,
 		currIndex:     0,
 		version:       version,
 	}
 }
 
 // addRun adds the given run to an existing batch, or creates a new
-// batch if the batchGasLimit that has been configured was exceeded.
+// batch if the batchGasLimit or maxBatchGas that has been configured was exceeded.
 func (b *batchFulfillments) addRun(result vrfPipelineResult, fromAddress common.Address) {
 	if len(b.fulfillments) == 0 {
 		b.fulfillments = append(b.fulfillments, newBatchFulfillment(result, fromAddress, b.version))
 	} else {
 		currBatch := b.fulfillments[b.currIndex]
-		if (currBatch.totalGasLimit + result.gasLimit) >= b.batchGasLimit {
+		estimatedGas := currBatch.estimatedGas + batchRequestGasEstimate(result.gasLimit)
+		if (currBatch.totalGasLimit+result.gasLimit) >= b.batchGasLimit || (b.maxBatchGas > 0 && estimatedGas > b.maxBatchGas) {
 			// don't add to curr batch, add new batch and increment index
 			b.fulfillments = append(b.fulfillments, newBatchFulfillment(result, fromAddress, b.version))
 			b.currIndex++
@@ -153,6 +194,8 @@ func (b *batchFulfillments) addRun(result vrfPipelineResult, fromAddress common.
 			currBatch.proofs = append(currBatch.proofs, result.proof)
 			currBatch.commitments = append(currBatch.commitments, result.reqCommitment)
 			currBatch.totalGasLimit += result.gasLimit
+			currBatch.estimatedGas = estimatedGas
+			currBatch.gasLimits = append(currBatch.gasLimits, result.gasLimit)
 			currBatch.runs = append(currBatch.runs, result.run)
 			currBatch.reqIDs = append(currBatch.reqIDs, result.req.req.RequestID())
 			currBatch.maxFees = append(currBatch.maxFees, result.maxFee)
@@ -166,6 +209,7 @@ func (lsn *listenerV2) processBatch(
 	subID *big.Int,
 	startBalanceNoReserveLink *big.Int,
 	maxCallbackGasLimit uint32,
+	blockGasLimit uint64,
 	batch *batchFulfillment,
 	fromAddress common.Address,
 ) (processedRequestIDs []string) {
@@ -211,6 +255,10 @@ func (lsn *listenerV2) processBatch(
 		maxCallbackGasLimit,
 		float64(lsn.job.VRFSpec.BatchFulfillmentGasMultiplier),
 	)
+	// The batch can never use more gas than fits into a block.
+	if blockGasLimit > 0 && uint64(totalGasLimitBumped) > blockGasLimit {
+		totalGasLimitBumped = uint32(blockGasLimit)
+	}
 
 	ll := l.With("numRequestsInBatch", len(batch.reqIDs),
 		"requestIDs", batch.reqIDs,
@@ -220,6 +268,27 @@ func (lsn *listenerV2) processBatch(
 		"totalGasLimitBumped", totalGasLimitBumped,
 		"gasMultiplier", lsn.job.VRFSpec.BatchFulfillmentGasMultiplier,
 	)
+
+	// Simulate the batch before enqueuing it. If it reverts, split it in halves
+	// so that a single bad request doesn't prevent the rest of the batch from
+	// being fulfilled.
+	if err = lsn.simulateBatch(ctx, fromAddress, payload, totalGasLimitBumped); err != nil {
+		if evmclient.ExtractRPCErrorOrNil(err) == nil {
+			// not a revert, e.g. the RPC is unavailable
+			ll.Warnw("Failed to simulate batch fulfillment, enqueuing anyway", "err", err)
+		} else if len(batch.reqIDs) == 1 {
+			ll.Warnw("Batch fulfillment simulation reverted for single request, requeuing request", "err", err)
+			return
+		} else {
+			ll.Infow("Batch fulfillment simulation reverted, splitting batch", "err", err)
+			left, right := batch.split()
+			processedRequestIDs = lsn.processBatch(l, subID, startBalanceNoReserveLink, maxCallbackGasLimit, blockGasLimit, left, fromAddress)
+			processedRequestIDs = append(processedRequestIDs,
+				lsn.processBatch(l, subID, startBalanceNoReserveLink, maxCallbackGasLimit, blockGasLimit, right, fromAddress)...)
+			return
+		}
+	}
+
 	ll.Info("Enqueuing batch fulfillment")
 	var ethTX txmgr.Tx
 	err = lsn.q.Transaction(func(tx pg.Queryer) error {
@@ -275,6 +344,19 @@ func (lsn *listenerV2) processBatch(
 	return
 }
 
+// simulateBatch executes the batch fulfillment against the latest block without
+// sending a transaction.
+func (lsn *listenerV2) simulateBatch(ctx context.Context, fromAddress common.Address, payload []byte, gasLimit uint32) error {
+	to := lsn.batchCoordinator.Address()
+	_, err := lsn.chain.Client().CallContract(ctx, ethereum.CallMsg{
+		From: fromAddress,
+		To:   &to,
+		Gas:  uint64(gasLimit),
+		Data: payload,
+	}, nil)
+	return err
+}
+
 // getReadyAndExpired filters out requests that are expired from the given pendingRequest slice
 // and returns requests that are ready for processing.
 func (lsn *listenerV2) getReadyAndExpired(l logger.Logger, reqs []pendingRequest) (ready []pendingRequest, expired []string) {
@@ -304,6 +386,21 @@ func batchFulfillmentGasEstimate(
 	)
 }
 
+// batchRequestGasEstimate estimates the gas a single request consumes as part of
+// a batch fulfillment.
+func batchRequestGasEstimate(callbackGasLimit uint32) uint64 {
+	return uint64(callbackGasLimit) + uint64(GasProofVerification) + BatchFulfillmentIterationGasCost
+}
+
+// maxBatchGasEstimate returns the maximum estimated gas of a batch such that the
+// bumped gas limit of the batch transaction still fits into a block.
+func maxBatchGasEstimate(blockGasLimit uint64, gasMultiplier float64) uint64 {
+	if blockGasLimit == 0 || gasMultiplier <= 0 {
+		return 0
+	}
+	return uint64(float64(blockGasLimit) / gasMultiplier)
+}
+
 func accumulateMaxLinkAndMaxEth(batch *batchFulfillment) (maxLinkStr string, maxEthStr string) {
 	maxLink := big.NewInt(0)
 	maxEth := big.NewInt(0)
@@ -17,7 +17,7 @@ import (
 
 func Test_BatchFulfillments_AddRun(t *testing.T) {
 	batchLimit := uint32(2500)
-	bfs := newBatchFulfillments(batchLimit, vrfcommon.V2)
+	bfs := newBatchFulfillments(batchLimit, 0, vrfcommon.V2)
 	fromAddress := testutils.NewAddress()
 	for i := 0; i < 4; i++ {
 		bfs.addRun(vrfPipelineResult{
@@ -55,7 +55,7 @@ func Test_BatchFulfillments_AddRun(t *testing.T) {
 
 func Test_BatchFulfillments_AddRun_V2Plus(t *testing.T) {
 	batchLimit := uint32(2500)
-	bfs := newBatchFulfillments(batchLimit, vrfcommon.V2Plus)
+	bfs := newBatchFulfillments(batchLimit, 0, vrfcommon.V2Plus)
 	fromAddress := testutils.NewAddress()
 	for i := 0; i < 4; i++ {
 		bfs.addRun(vrfPipelineResult{
@@ -90,3 +90,60 @@ func Test_BatchFulfillments_AddRun_V2Plus(t *testing.T) {
 	}, fromAddress)
 	require.Len(t, bfs.fulfillments, 2)
 }
+
+func Test_BatchFulfillments_AddRun_MaxBatchGas(t *testing.T) {
+	// room for two requests with a 100k callback each, including verification and iteration costs
+	maxBatchGas := 2 * batchRequestGasEstimate(100_000)
+	bfs := newBatchFulfillments(10_000_000, maxBatchGas, vrfcommon.V2)
+	fromAddress := testutils.NewAddress()
+	for i := 0; i < 5; i++ {
+		bfs.addRun(vrfPipelineResult{
+			gasLimit: 100_000,
+			req: pendingRequest{
+				req: NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+					RequestId: big.NewInt(int64(i)),
+				}),
+			},
+			run: pipeline.NewRun(pipeline.Spec{}, pipeline.Vars{}),
+		}, fromAddress)
+	}
+
+	require.Len(t, bfs.fulfillments, 3)
+	require.Len(t, bfs.fulfillments[0].reqIDs, 2)
+	require.Len(t, bfs.fulfillments[1].reqIDs, 2)
+	require.Len(t, bfs.fulfillments[2].reqIDs, 1)
+	require.Equal(t, maxBatchGas, bfs.fulfillments[0].estimatedGas)
+}
+
+func Test_BatchFulfillment_Split(t *testing.T) {
+	bfs := newBatchFulfillments(10_000_000, 0, vrfcommon.V2Plus)
+	fromAddress := testutils.NewAddress()
+	for i := 0; i < 3; i++ {
+		bfs.addRun(vrfPipelineResult{
+			gasLimit: uint32(100_000 * (i + 1)),
+			req: pendingRequest{
+				req: NewV2_5RandomWordsRequested(&vrf_coordinator_v2_5.VRFCoordinatorV25RandomWordsRequested{
+					RequestId: big.NewInt(int64(i)),
+				}),
+			},
+			run: pipeline.NewRun(pipeline.Spec{}, pipeline.Vars{}),
+		}, fromAddress)
+	}
+	require.Len(t, bfs.fulfillments, 1)
+
+	left, right := bfs.fulfillments[0].split()
+	require.Equal(t, []*big.Int{big.NewInt(0)}, left.reqIDs)
+	require.Equal(t, uint32(100_000), left.totalGasLimit)
+	require.Equal(t, batchRequestGasEstimate(100_000), left.estimatedGas)
+	require.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(2)}, right.reqIDs)
+	require.Equal(t, uint32(500_000), right.totalGasLimit)
+	require.Len(t, right.proofs, 2)
+	require.Len(t, right.runs, 2)
+	require.Equal(t, fromAddress, right.fromAddress)
+	require.Equal(t, vrfcommon.V2Plus, right.version)
+}
+
+func Test_MaxBatchGasEstimate(t *testing.T) {
+	require.Equal(t, uint64(0), maxBatchGasEstimate(0, 1.15))
+	require.Equal(t, uint64(15_000_000), maxBatchGasEstimate(30_000_000, 2))
+}
@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var promProofPoolQueued = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "vrf_proof_pool_queued",
+	Help: "The number of VRF requests waiting for a worker to generate their proof",
+})
+
+// ProofWorkerPool runs the pipelines generating the VRF proofs of pending requests on a fixed number of
+// workers, so that a burst of requests does not spawn an unbounded number of concurrent proof generations.
+// Queued requests are processed in order of their deadline, so that the requests closest to timing out are
+// fulfilled first. The queue is bounded, and submitting blocks while it is full.
+type ProofWorkerPool struct {
+	// slots bounds the number of queued and running tasks.
+	slots   chan struct{}
+	workers int
+
+	mu      sync.Mutex
+	queue   proofTasks
+	running int
+}
+
+// NewProofWorkerPool returns a pool running at most workers proof generations at a time, and queueing at most
+// queueSize more.
+func NewProofWorkerPool(workers, queueSize int) *ProofWorkerPool {
+	if workers < 1 {
+		workers = 1
+	}
+	return &ProofWorkerPool{
+		slots:   make(chan struct{}, workers+queueSize),
+		workers: workers,
+	}
+}
+
+// submit queues fn to run before any queued task with a later deadline. It blocks until there is room in the
+// queue, and returns the context error if ctx is done first.
+func (p *ProofWorkerPool) submit(ctx context.Context, deadline time.Time, fn func()) error {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	heap.Push(&p.queue, &proofTask{deadline: deadline, fn: fn})
+	p.dispatch()
+	return nil
+}
+
+// dispatch starts the earliest queued tasks on the idle workers. It must be called with mu held.
+func (p *ProofWorkerPool) dispatch() {
+	for p.running < p.workers && p.queue.Len() > 0 {
+		t := heap.Pop(&p.queue).(*proofTask)
+		p.running++
+		go p.run(t)
+	}
+	promProofPoolQueued.Set(float64(p.queue.Len()))
+}
+
+func (p *ProofWorkerPool) run(t *proofTask) {
+	defer func() {
+		<-p.slots
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.running--
+		p.dispatch()
+	}()
+	t.fn()
+}
+
+type proofTask struct {
+	deadline time.Time
+	fn       func()
+}
+
+// proofTasks is a min-heap of tasks ordered by deadline.
+type proofTasks []*proofTask
+
+func (h proofTasks) Len() int           { return len(h) }
+func (h proofTasks) Less(i, j int) bool { return h[i].deadline.Before(h[j].deadline) }
+func (h proofTasks) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *proofTasks) Push(x any) { *h = append(*h, x.(*proofTask)) }
+
+func (h *proofTasks) Pop() any {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return t
+}
@@ -0,0 +1,61 @@
+package v2
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestProofWorkerPool_OrdersByDeadline(t *testing.T) {
+	p := NewProofWorkerPool(1, 10)
+	ctx := testutils.Context(t)
+
+	// occupy the only worker, so that the following tasks are queued
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	require.NoError(t, p.submit(ctx, time.Now(), func() {
+		defer wg.Done()
+		<-release
+	}))
+
+	var (
+		mu    sync.Mutex
+		order []int
+	)
+	now := time.Now()
+	for _, i := range []int{3, 1, 2} {
+		i := i
+		wg.Add(1)
+		require.NoError(t, p.submit(ctx, now.Add(time.Duration(i)*time.Minute), func() {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, i)
+		}))
+	}
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, []int{1, 2, 3}, order)
+}
+
+func TestProofWorkerPool_QueueFull(t *testing.T) {
+	p := NewProofWorkerPool(1, 1)
+
+	release := make(chan struct{})
+	defer close(release)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, p.submit(testutils.Context(t), time.Now(), func() { <-release }))
+	}
+
+	ctx, cancel := context.WithTimeout(testutils.Context(t), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, p.submit(ctx, time.Now(), func() {}), context.DeadlineExceeded)
+}
@@ -0,0 +1,373 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	promSubscriptionBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_subscription_balance",
+		Help: "The balance of a VRF subscription in juels or wei",
+	}, []string{"evmChainID", "coordinatorAddress", "subID", "currency"})
+	promSubscriptionRunway = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_subscription_runway_seconds",
+		Help: "The estimated number of seconds until a VRF subscription runs dry, based on the cost of its recent fulfillments",
+	}, []string{"evmChainID", "coordinatorAddress", "subID", "currency"})
+)
+
+const webhookTimeout = 10 * time.Second
+
+// SubscriptionStatus describes the balance and estimated runway of a subscription served by a VRF job.
+type SubscriptionStatus struct {
+	EVMChainID         *big.Int
+	CoordinatorAddress common.Address
+	SubID              *big.Int
+	NativePayment      bool
+	// Balance is the LINK balance of the subscription, or the native balance for native payments.
+	// It is nil if the subscription has not been read yet, or no longer exists.
+	Balance *big.Int
+	// Fulfillments is the number of fulfillments within the cost window.
+	Fulfillments int
+	// CostPerHour is the average hourly cost of the fulfillments within the cost window.
+	CostPerHour *big.Int
+	// Runway is how long the balance is estimated to last at CostPerHour. It is nil if there were no
+	// fulfillments within the cost window, or the balance is unknown.
+	Runway *time.Duration
+	// Low reports whether Runway is below the configured threshold.
+	Low       bool
+	UpdatedAt time.Time
+	// Error is the error of the latest balance query, if it failed.
+	Error string
+}
+
+// SubscriptionReporter reports the subscriptions served by a VRF job.
+type SubscriptionReporter interface {
+	Subscriptions() []SubscriptionStatus
+}
+
+type subscriptionKey struct {
+	subID         string
+	nativePayment bool
+}
+
+type trackedRequest struct {
+	sub subscriptionKey
+	at  time.Time
+}
+
+type fulfillmentCost struct {
+	sub     subscriptionKey
+	payment *big.Int
+	at      time.Time
+}
+
+// SubscriptionMonitor tracks the subscriptions of the requests observed by a VRF listener, periodically queries
+// their balances and estimates their runway from the payments of their recent fulfillments. When the runway of a
+// subscription falls below the configured threshold, it logs a warning and posts an alert to the configured webhook.
+type SubscriptionMonitor struct {
+	services.StateMachine
+	lggr        logger.SugaredLogger
+	chainID     *big.Int
+	coordinator CoordinatorV2_X
+	cfg         config.VRFSubscriptionMonitor
+	httpClient  *http.Client
+
+	mu           sync.RWMutex
+	requests     map[string]trackedRequest
+	fulfillments map[string]fulfillmentCost
+	statuses     map[subscriptionKey]SubscriptionStatus
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func NewSubscriptionMonitor(lggr logger.Logger, chainID *big.Int, coordinator CoordinatorV2_X, cfg config.VRFSubscriptionMonitor) *SubscriptionMonitor {
+	return &SubscriptionMonitor{
+		lggr:         logger.Sugared(lggr.Named("SubscriptionMonitor")),
+		chainID:      chainID,
+		coordinator:  coordinator,
+		cfg:          cfg,
+		httpClient:   &http.Client{Timeout: webhookTimeout},
+		requests:     make(map[string]trackedRequest),
+		fulfillments: make(map[string]fulfillmentCost),
+		statuses:     make(map[subscriptionKey]SubscriptionStatus),
+		stopCh:       make(services.StopChan),
+	}
+}
+
+func (m *SubscriptionMonitor) Name() string { return m.lggr.Name() }
+
+func (m *SubscriptionMonitor) Start(context.Context) error {
+	return m.StartOnce("SubscriptionMonitor", func() error {
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *SubscriptionMonitor) Close() error {
+	return m.StopOnce("SubscriptionMonitor", func() error {
+		close(m.stopCh)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *SubscriptionMonitor) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy()}
+}
+
+// observeRequest starts tracking the subscription of req.
+func (m *SubscriptionMonitor) observeRequest(req RandomWordsRequested, at time.Time) {
+	key := subscriptionKey{subID: req.SubID().String(), nativePayment: req.NativePayment()}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[req.RequestID().String()] = trackedRequest{sub: key, at: at}
+	if _, ok := m.statuses[key]; !ok {
+		m.statuses[key] = SubscriptionStatus{
+			EVMChainID:         m.chainID,
+			CoordinatorAddress: m.coordinator.Address(),
+			SubID:              req.SubID(),
+			NativePayment:      req.NativePayment(),
+		}
+	}
+}
+
+// observeFulfillment records the payment of a fulfillment of a tracked request. Fulfillments of requests which were
+// not observed are ignored, since their subscription is unknown.
+func (m *SubscriptionMonitor) observeFulfillment(f RandomWordsFulfilled, at time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	reqID := f.RequestID().String()
+	req, ok := m.requests[reqID]
+	if !ok {
+		return
+	}
+	m.fulfillments[reqID] = fulfillmentCost{sub: req.sub, payment: f.Payment(), at: at}
+}
+
+// Subscriptions returns the latest status of every tracked subscription, ordered by subscription ID.
+func (m *SubscriptionMonitor) Subscriptions() []SubscriptionStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	statuses := make([]SubscriptionStatus, 0, len(m.statuses))
+	for _, s := range m.statuses {
+		statuses = append(statuses, s)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if c := statuses[i].SubID.Cmp(statuses[j].SubID); c != 0 {
+			return c < 0
+		}
+		return !statuses[i].NativePayment && statuses[j].NativePayment
+	})
+	return statuses
+}
+
+func (m *SubscriptionMonitor) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(m.cfg.PollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.checkAll(ctx)
+		}
+	}
+}
+
+// checkAll queries the balance of every tracked subscription and updates its runway.
+func (m *SubscriptionMonitor) checkAll(ctx context.Context) {
+	now := time.Now()
+	costs := m.prune(now)
+
+	m.mu.RLock()
+	keys := make([]subscriptionKey, 0, len(m.statuses))
+	for key := range m.statuses {
+		keys = append(keys, key)
+	}
+	m.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.PollInterval())
+	defer cancel()
+	for _, key := range keys {
+		m.mu.RLock()
+		status := m.statuses[key]
+		m.mu.RUnlock()
+		prev := status
+
+		balance, err := subscriptionBalance(ctx, m.coordinator, status.SubID, status.NativePayment)
+		status.Error = ""
+		if err != nil {
+			m.lggr.Debugw("Failed to get subscription balance", "subID", status.SubID, "err", err)
+			status.Error = err.Error()
+		} else {
+			status.Balance = balance
+		}
+		cost := costs[key]
+		m.estimate(&status, cost.total, cost.count)
+		status.UpdatedAt = now
+
+		m.mu.Lock()
+		m.statuses[key] = status
+		m.mu.Unlock()
+		m.report(ctx, prev, status)
+	}
+}
+
+type windowCost struct {
+	total *big.Int
+	count int
+}
+
+// prune drops requests and fulfillments older than the cost window, and returns the total cost of the remaining
+// fulfillments of each subscription.
+func (m *SubscriptionMonitor) prune(now time.Time) map[subscriptionKey]windowCost {
+	cutoff := now.Add(-m.cfg.CostWindow())
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for reqID, req := range m.requests {
+		if _, fulfilled := m.fulfillments[reqID]; !fulfilled && req.at.Before(cutoff) {
+			delete(m.requests, reqID)
+		}
+	}
+	costs := make(map[subscriptionKey]windowCost)
+	for reqID, f := range m.fulfillments {
+		if f.at.Before(cutoff) {
+			delete(m.fulfillments, reqID)
+			delete(m.requests, reqID)
+			continue
+		}
+		c := costs[f.sub]
+		if c.total == nil {
+			c.total = new(big.Int)
+		}
+		c.total.Add(c.total, f.payment)
+		c.count++
+		costs[f.sub] = c
+	}
+	return costs
+}
+
+// estimate sets the hourly cost and runway of status from the total cost of the fulfillments within the cost window.
+func (m *SubscriptionMonitor) estimate(status *SubscriptionStatus, total *big.Int, count int) {
+	window := m.cfg.CostWindow()
+	status.Fulfillments = count
+	status.CostPerHour = new(big.Int)
+	status.Runway = nil
+	status.Low = false
+	if total == nil || total.Sign() <= 0 {
+		return
+	}
+	status.CostPerHour.Div(new(big.Int).Mul(total, big.NewInt(int64(time.Hour))), big.NewInt(int64(window)))
+	if status.Balance == nil {
+		return
+	}
+
+	// runway = balance / (total / window)
+	runwayNanos := new(big.Int).Div(new(big.Int).Mul(status.Balance, big.NewInt(int64(window))), total)
+	runway := time.Duration(math.MaxInt64)
+	if runwayNanos.IsInt64() {
+		runway = time.Duration(runwayNanos.Int64())
+	}
+	status.Runway = &runway
+	threshold := m.cfg.RunwayThreshold()
+	status.Low = threshold > 0 && runway < threshold
+}
+
+func (m *SubscriptionMonitor) report(ctx context.Context, prev, status SubscriptionStatus) {
+	labels := []string{m.chainID.String(), status.CoordinatorAddress.Hex(), status.SubID.String(), currency(status.NativePayment)}
+	if status.Balance != nil {
+		balance, _ := new(big.Float).SetInt(status.Balance).Float64()
+		promSubscriptionBalance.WithLabelValues(labels...).Set(balance)
+	}
+	if status.Runway != nil {
+		promSubscriptionRunway.WithLabelValues(labels...).Set(status.Runway.Seconds())
+	}
+
+	l := m.lggr.With("subID", status.SubID, "nativePayment", status.NativePayment, "balance", status.Balance,
+		"costPerHour", status.CostPerHour, "runway", status.Runway)
+	if status.Low && !prev.Low {
+		l.Warnw("Subscription is projected to run dry within the runway threshold", "runwayThreshold", m.cfg.RunwayThreshold())
+		if err := m.alert(ctx, status); err != nil {
+			l.Errorw("Failed to post subscription alert", "err", err)
+		}
+	} else if !status.Low && prev.Low {
+		l.Infow("Subscription runway recovered")
+	}
+}
+
+type subscriptionAlert struct {
+	EVMChainID         string  `json:"evmChainID"`
+	CoordinatorAddress string  `json:"coordinatorAddress"`
+	SubID              string  `json:"subID"`
+	Currency           string  `json:"currency"`
+	Balance            string  `json:"balance"`
+	CostPerHour        string  `json:"costPerHour"`
+	RunwayHours        float64 `json:"runwayHours"`
+	RunwayThreshold    string  `json:"runwayThreshold"`
+}
+
+// alert posts the status to the configured webhook, if any.
+func (m *SubscriptionMonitor) alert(ctx context.Context, status SubscriptionStatus) error {
+	u := m.cfg.AlertWebhookURL()
+	if u == nil {
+		return nil
+	}
+	body, err := json.Marshal(subscriptionAlert{
+		EVMChainID:         m.chainID.String(),
+		CoordinatorAddress: status.CoordinatorAddress.Hex(),
+		SubID:              status.SubID.String(),
+		Currency:           currency(status.NativePayment),
+		Balance:            status.Balance.String(),
+		CostPerHour:        status.CostPerHour.String(),
+		RunwayHours:        status.Runway.Hours(),
+		RunwayThreshold:    m.cfg.RunwayThreshold().String(),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func currency(nativePayment bool) string {
+	if nativePayment {
+		return "native"
+	}
+	return "LINK"
+}
@@ -0,0 +1,115 @@
+package v2
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type subscriptionMonitorConfig struct {
+	webhookURL *url.URL
+}
+
+func (c subscriptionMonitorConfig) Enabled() bool                  { return true }
+func (c subscriptionMonitorConfig) PollInterval() time.Duration    { return time.Minute }
+func (c subscriptionMonitorConfig) CostWindow() time.Duration      { return 10 * time.Hour }
+func (c subscriptionMonitorConfig) RunwayThreshold() time.Duration { return 24 * time.Hour }
+func (c subscriptionMonitorConfig) AlertWebhookURL() *url.URL      { return c.webhookURL }
+
+func TestSubscriptionMonitor_CheckAll(t *testing.T) {
+	alerts := make(chan subscriptionAlert, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a subscriptionAlert
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&a))
+		alerts <- a
+	}))
+	t.Cleanup(srv.Close)
+	webhookURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	client := evmclimocks.NewClient(t)
+	sub, err := coordinatorV2ABI.Methods["getSubscription"].Outputs.Pack(big.NewInt(1000), uint64(3), testutils.NewAddress(), []common.Address{})
+	require.NoError(t, err)
+	client.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(sub, nil)
+	coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(testutils.NewAddress(), client)
+	require.NoError(t, err)
+
+	m := NewSubscriptionMonitor(logger.TestLogger(t), big.NewInt(1337), NewCoordinatorV2(coordinator), subscriptionMonitorConfig{webhookURL: webhookURL})
+
+	now := time.Now()
+	for i, at := range []time.Time{now.Add(-time.Hour), now.Add(-2 * time.Hour), now.Add(-20 * time.Hour)} {
+		reqID := big.NewInt(int64(i + 1))
+		m.observeRequest(NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId: reqID,
+			SubId:     1,
+		}), at)
+		m.observeFulfillment(NewV2RandomWordsFulfilled(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{
+			RequestId: reqID,
+			Payment:   big.NewInt(100),
+		}), at)
+	}
+	// fulfillments of untracked requests are ignored
+	m.observeFulfillment(NewV2RandomWordsFulfilled(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{
+		RequestId: big.NewInt(4),
+		Payment:   big.NewInt(100),
+	}), now)
+
+	m.checkAll(testutils.Context(t))
+
+	statuses := m.Subscriptions()
+	require.Len(t, statuses, 1)
+	s := statuses[0]
+	assert.Equal(t, big.NewInt(1), s.SubID)
+	assert.False(t, s.NativePayment)
+	assert.Equal(t, big.NewInt(1000), s.Balance)
+	// the fulfillment outside of the cost window is pruned
+	assert.Equal(t, 2, s.Fulfillments)
+	assert.Equal(t, big.NewInt(20), s.CostPerHour)
+	require.NotNil(t, s.Runway)
+	assert.Equal(t, 50*time.Hour, *s.Runway)
+	assert.False(t, s.Low)
+	assert.Empty(t, s.Error)
+
+	// a third fulfillment within the window halves the runway below the threshold
+	m.observeRequest(NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId: big.NewInt(5),
+		SubId:     1,
+	}), now)
+	m.observeFulfillment(NewV2RandomWordsFulfilled(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{
+		RequestId: big.NewInt(5),
+		Payment:   big.NewInt(400),
+	}), now)
+
+	m.checkAll(testutils.Context(t))
+
+	s = m.Subscriptions()[0]
+	assert.Equal(t, big.NewInt(60), s.CostPerHour)
+	require.NotNil(t, s.Runway)
+	assert.Less(t, *s.Runway, 24*time.Hour)
+	assert.True(t, s.Low)
+
+	select {
+	case a := <-alerts:
+		assert.Equal(t, "1337", a.EVMChainID)
+		assert.Equal(t, "1", a.SubID)
+		assert.Equal(t, "LINK", a.Currency)
+		assert.Equal(t, "1000", a.Balance)
+		assert.Equal(t, "60", a.CostPerHour)
+	default:
+		t.Fatal("expected an alert")
+	}
+}
@@ -2,7 +2,9 @@ package webhook
 
 import (
 	"context"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -26,6 +28,9 @@ type (
 
 	JobRunner interface {
 		RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
+		// VerifySignature checks that a request to run the job is signed with the secret of the job and was not
+		// accepted before. It returns an error wrapping ErrInvalidSignature otherwise.
+		VerifySignature(jobUUID uuid.UUID, header http.Header, body []byte) error
 	}
 )
 
@@ -105,6 +110,7 @@ type webhookJobRunner struct {
 	specsByUUID   map[uuid.UUID]registeredJob
 	muSpecsByUUID sync.RWMutex
 	runner        pipeline.Runner
+	replays       *replayCache
 	lggr          logger.Logger
 }
 
@@ -112,6 +118,7 @@ func newWebhookJobRunner(runner pipeline.Runner, lggr logger.Logger) *webhookJob
 	return &webhookJobRunner{
 		specsByUUID: make(map[uuid.UUID]registeredJob),
 		runner:      runner,
+		replays:     newReplayCache(),
 		lggr:        lggr.Named("JobRunner"),
 	}
 }
@@ -151,6 +158,26 @@ func (r *webhookJobRunner) spec(externalJobID uuid.UUID) (registeredJob, bool) {
 
 var ErrJobNotExists = errors.New("job does not exist")
 
+func (r *webhookJobRunner) VerifySignature(jobUUID uuid.UUID, header http.Header, body []byte) error {
+	spec, exists := r.spec(jobUUID)
+	if !exists {
+		return ErrJobNotExists
+	}
+	if spec.WebhookSpec == nil || spec.WebhookSpec.SignatureSecret == "" {
+		return nil
+	}
+
+	now := time.Now()
+	signature, expiresAt, err := verifySignature(*spec.WebhookSpec, header, body, now)
+	if err != nil {
+		return err
+	}
+	if !r.replays.add(jobUUID.String()+":"+signature, expiresAt, now) {
+		return errors.Wrap(ErrInvalidSignature, "request was already accepted")
+	}
+	return nil
+}
+
 func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
 	spec, exists := r.spec(jobUUID)
 	if !exists {
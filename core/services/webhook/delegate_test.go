@@ -1,7 +1,10 @@
 package webhook_test
 
 import (
+	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"gopkg.in/guregu/null.v4"
@@ -18,6 +21,7 @@ import (
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func TestWebhookDelegate(t *testing.T) {
@@ -93,3 +97,59 @@ func TestWebhookDelegate(t *testing.T) {
 	_, err = delegate.WebhookJobRunner().RunJob(testutils.Context(t), spec.ExternalJobID, requestBody, meta)
 	require.Equal(t, webhook.ErrJobNotExists, errors.Cause(err))
 }
+
+func TestWebhookJobRunner_VerifySignature(t *testing.T) {
+	spec := &job.Job{
+		ID:            123,
+		Type:          job.Webhook,
+		SchemaVersion: 1,
+		ExternalJobID: uuid.New(),
+		WebhookSpec: &job.WebhookSpec{
+			SignatureSecret:    "s3cr3t",
+			SignatureHeader:    "X-Signature",
+			SignatureAlgorithm: "sha512",
+			TimestampHeader:    "X-Timestamp",
+			ReplayWindow:       models.Interval(time.Minute),
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	delegate := webhook.NewDelegate(pipelinemocks.NewRunner(t), new(webhookmocks.ExternalInitiatorManager), logger.TestLogger(t))
+	runner := delegate.WebhookJobRunner()
+	body := []byte(`{"foo":"bar"}`)
+
+	signedHeader := func(ts int64, body []byte) http.Header {
+		signature, err := webhook.Signature(*spec.WebhookSpec, ts, body)
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set("X-Timestamp", strconv.FormatInt(ts, 10))
+		header.Set("X-Signature", signature)
+		return header
+	}
+
+	err := runner.VerifySignature(spec.ExternalJobID, signedHeader(time.Now().Unix(), body), body)
+	require.ErrorIs(t, err, webhook.ErrJobNotExists)
+
+	services, err := delegate.ServicesForSpec(*spec)
+	require.NoError(t, err)
+	require.NoError(t, services[0].Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, services[0].Close()) })
+
+	header := signedHeader(time.Now().Unix(), body)
+	require.NoError(t, runner.VerifySignature(spec.ExternalJobID, header, body))
+
+	err = runner.VerifySignature(spec.ExternalJobID, header, body)
+	require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	require.ErrorContains(t, err, "request was already accepted")
+
+	err = runner.VerifySignature(spec.ExternalJobID, signedHeader(time.Now().Unix(), body), []byte(`{"foo":"baz"}`))
+	require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	require.ErrorContains(t, err, "signature mismatch")
+
+	err = runner.VerifySignature(spec.ExternalJobID, signedHeader(time.Now().Add(-2*time.Minute).Unix(), body), body)
+	require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	require.ErrorContains(t, err, "outside of the 1m0s replay window")
+
+	err = runner.VerifySignature(spec.ExternalJobID, http.Header{}, body)
+	require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	require.ErrorContains(t, err, "missing X-Timestamp header")
+}
@@ -23,7 +23,8 @@ import (
 
 //go:generate mockery --quiet --name ExternalInitiatorManager --output ./mocks/ --case=underscore
 
-// ExternalInitiatorManager manages HTTP requests to remote external initiators
+// ExternalInitiatorManager manages HTTP requests to remote external initiators, and queues the notifications of the
+// external initiators connected over gRPC.
 type ExternalInitiatorManager interface {
 	Notify(ctx context.Context, webhookSpecID int32) error
 	DeleteJob(ctx context.Context, webhookSpecID int32) error
@@ -37,6 +38,7 @@ type HTTPClient interface {
 
 type externalInitiatorManager struct {
 	q          pg.Q
+	orm        ORM
 	httpclient HTTPClient
 }
 
@@ -47,12 +49,14 @@ func NewExternalInitiatorManager(db *sqlx.DB, httpclient HTTPClient, lggr logger
 	namedLogger := lggr.Named("ExternalInitiatorManager")
 	return &externalInitiatorManager{
 		q:          pg.NewQ(db, namedLogger, cfg),
+		orm:        NewORM(db, lggr, cfg),
 		httpclient: httpclient,
 	}
 }
 
 // Notify sends a POST notification to the External Initiator
-// responsible for initiating the Job Spec.
+// responsible for initiating the Job Spec. External Initiators
+// connected over gRPC are sent the notification on their stream.
 func (m externalInitiatorManager) Notify(ctx context.Context, webhookSpecID int32) error {
 	eiWebhookSpecs, jobID, err := m.Load(webhookSpecID)
 	if err != nil {
@@ -60,6 +64,16 @@ func (m externalInitiatorManager) Notify(ctx context.Context, webhookSpecID int3
 	}
 	for _, eiWebhookSpec := range eiWebhookSpecs {
 		ei := eiWebhookSpec.ExternalInitiator
+		if ei.GRPC {
+			var params []byte
+			if eiWebhookSpec.Spec.Raw != "" {
+				params = []byte(eiWebhookSpec.Spec.Raw)
+			}
+			if err := m.orm.CreateNotification(ei.ID, NotificationCreated, jobID, params, pg.WithParentCtx(ctx)); err != nil {
+				return errors.Wrapf(err, "could not notify '%s'", ei.Name)
+			}
+			continue
+		}
 		if ei.URL == nil {
 			continue
 		}
@@ -144,6 +158,12 @@ func (m externalInitiatorManager) DeleteJob(ctx context.Context, webhookSpecID i
 	}
 	for _, eiWebhookSpec := range eiWebhookSpecs {
 		ei := eiWebhookSpec.ExternalInitiator
+		if ei.GRPC {
+			if err := m.orm.CreateNotification(ei.ID, NotificationDeleted, jobID, nil, pg.WithParentCtx(ctx)); err != nil {
+				return errors.Wrapf(err, "could not delete job from external initiator '%s'", ei.Name)
+			}
+			continue
+		}
 		if ei.URL == nil {
 			continue
 		}
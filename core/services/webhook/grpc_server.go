@@ -0,0 +1,451 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook/pb"
+)
+
+const (
+	// missedHeartbeats is the number of heartbeat intervals without any message after which a stream is closed.
+	missedHeartbeats = 3
+	// notificationsBatchSize is the maximum number of notifications sent at once on a stream.
+	notificationsBatchSize = 100
+	// triggersRetention is how long triggers are remembered to acknowledge their resends with the same run.
+	triggersRetention = 24 * time.Hour
+)
+
+var (
+	// notificationsPollInterval is how often the queued notifications of the connected external initiators are sent.
+	notificationsPollInterval = time.Second
+	// triggersPruneInterval is how often the triggers older than triggersRetention are deleted.
+	triggersPruneInterval = time.Hour
+)
+
+type GRPCConfig interface {
+	ListenIP() net.IP
+	Port() uint16
+	CertPath() string
+	KeyPath() string
+	ClientCAPath() string
+	HeartbeatInterval() time.Duration
+}
+
+// GRPCServer serves the external initiators connecting over gRPC with mutual TLS. The external initiator is identified
+// by the common name of its client certificate, which must match the name of an external initiator created with gRPC
+// enabled.
+//
+// Job notifications are queued in the database and resent on every new stream until the external initiator acks them.
+// Triggers are recorded by ID before their run starts, so that a trigger resent after a lost ack starts no other run.
+type GRPCServer struct {
+	services.StateMachine
+	pb.UnimplementedExternalInitiatorServer
+
+	cfg           GRPCConfig
+	authorizerCfg AuthorizerConfig
+	orm           ORM
+	runner        JobRunner
+	newAuthorizer func(ei bridges.ExternalInitiator) Authorizer
+	lggr          logger.Logger
+
+	srv *grpc.Server
+	lis net.Listener
+
+	streamsMu sync.Mutex
+	streams   map[int64]*eiStream
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ services.Service = (*GRPCServer)(nil)
+
+func NewGRPCServer(cfg GRPCConfig, authorizerCfg AuthorizerConfig, db *sqlx.DB, orm ORM, runner JobRunner, lggr logger.Logger) *GRPCServer {
+	return &GRPCServer{
+		cfg:           cfg,
+		authorizerCfg: authorizerCfg,
+		orm:           orm,
+		runner:        runner,
+		newAuthorizer: func(ei bridges.ExternalInitiator) Authorizer {
+			return NewEIAuthorizer(db.DB, ei)
+		},
+		lggr:    lggr.Named("ExternalInitiatorGRPCServer"),
+		streams: make(map[int64]*eiStream),
+		stopCh:  make(services.StopChan),
+	}
+}
+
+// Start starts GRPCServer.
+func (s *GRPCServer) Start(context.Context) error {
+	return s.StartOnce("ExternalInitiatorGRPCServer", func() error {
+		tlsConfig, err := s.tlsConfig()
+		if err != nil {
+			return err
+		}
+		// Triggers claimed without a run were interrupted by a restart, they are run when resent.
+		if err = s.orm.DeleteUnstartedTriggers(); err != nil {
+			return err
+		}
+		addr := net.JoinHostPort(s.cfg.ListenIP().String(), strconv.Itoa(int(s.cfg.Port())))
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to listen on %s", addr)
+		}
+		s.serve(lis, grpc.Creds(credentials.NewTLS(tlsConfig)))
+
+		s.wg.Add(1)
+		go s.pruneTriggers()
+		return nil
+	})
+}
+
+func (s *GRPCServer) serve(lis net.Listener, opts ...grpc.ServerOption) {
+	s.lis = lis
+	s.srv = grpc.NewServer(opts...)
+	pb.RegisterExternalInitiatorServer(s.srv, s)
+
+	s.lggr.Infow("Serving external initiators", "addr", lis.Addr().String())
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := s.srv.Serve(lis); err != nil {
+			s.lggr.Errorw("Failed to serve external initiators", "err", err)
+		}
+	}()
+}
+
+func (s *GRPCServer) Close() error {
+	return s.StopOnce("ExternalInitiatorGRPCServer", func() error {
+		close(s.stopCh)
+		s.srv.Stop()
+		s.wg.Wait()
+		return nil
+	})
+}
+
+func (s *GRPCServer) Name() string {
+	return s.lggr.Name()
+}
+
+func (s *GRPCServer) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *GRPCServer) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(s.cfg.CertPath(), s.cfg.KeyPath())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the server certificate")
+	}
+	caPEM, err := os.ReadFile(s.cfg.ClientCAPath())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the client CA")
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.Errorf("no certificate found in client CA %s", s.cfg.ClientCAPath())
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (s *GRPCServer) pruneTriggers() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(triggersPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			ctx, cancel := s.stopCh.NewCtx()
+			n, err := s.orm.PruneTriggers(time.Now().Add(-triggersRetention), pg.WithParentCtx(ctx))
+			cancel()
+			if err != nil {
+				s.lggr.Errorw("Failed to prune external initiator triggers", "err", err)
+			} else if n > 0 {
+				s.lggr.Debugw("Pruned external initiator triggers", "count", n)
+			}
+		}
+	}
+}
+
+// authenticate returns the external initiator named by the common name of the verified client certificate.
+func (s *GRPCServer) authenticate(ctx context.Context) (bridges.ExternalInitiator, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return bridges.ExternalInitiator{}, status.Error(codes.Unauthenticated, "no peer")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return bridges.ExternalInitiator{}, status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	name := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	ei, err := s.orm.FindExternalInitiatorByName(name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ei, status.Errorf(codes.Unauthenticated, "unknown external initiator %q", name)
+	} else if err != nil {
+		s.lggr.Errorw("Failed to load external initiator", "name", name, "err", err)
+		return ei, status.Error(codes.Internal, "failed to load external initiator")
+	}
+	if !ei.GRPC {
+		return ei, status.Errorf(codes.PermissionDenied, "external initiator %s is not enabled for gRPC", ei.Name)
+	}
+	return ei, nil
+}
+
+// eiStream is the stream of a connected external initiator.
+type eiStream struct {
+	ei     bridges.ExternalInitiator
+	cancel context.CancelFunc
+
+	sendMu sync.Mutex
+	stream pb.ExternalInitiator_ConnectServer
+}
+
+func (c *eiStream) send(msg *pb.NodeMessage) error {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	return c.stream.Send(msg)
+}
+
+// register makes c the stream of its external initiator, closing the previous one.
+func (s *GRPCServer) register(c *eiStream) {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	if prev, ok := s.streams[c.ei.ID]; ok {
+		s.lggr.Infow("Replacing the stream of external initiator", "name", c.ei.Name)
+		prev.cancel()
+	}
+	s.streams[c.ei.ID] = c
+}
+
+func (s *GRPCServer) unregister(c *eiStream) {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	if s.streams[c.ei.ID] == c {
+		delete(s.streams, c.ei.ID)
+	}
+}
+
+// Connect implements pb.ExternalInitiatorServer.
+func (s *GRPCServer) Connect(stream pb.ExternalInitiator_ConnectServer) error {
+	ei, err := s.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	lggr := s.lggr.With("externalInitiator", ei.Name)
+
+	ctx, cancel := s.stopCh.Ctx(stream.Context())
+	defer cancel()
+	c := &eiStream{ei: ei, cancel: cancel, stream: stream}
+	s.register(c)
+	defer s.unregister(c)
+	lggr.Info("External initiator connected")
+	defer lggr.Info("External initiator disconnected")
+
+	msgs := make(chan *pb.InitiatorMessage)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case msgs <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	heartbeatInterval := s.cfg.HeartbeatInterval()
+	heartbeats := time.NewTicker(heartbeatInterval)
+	defer heartbeats.Stop()
+	poll := time.NewTicker(notificationsPollInterval)
+	defer poll.Stop()
+
+	// All the notifications not acked yet are sent again on a new stream.
+	var lastSent int64
+	if lastSent, err = s.sendNotifications(ctx, c, lastSent); err != nil {
+		return err
+	}
+	lastReceived := time.Now()
+	for {
+		select {
+		case <-s.stopCh:
+			return status.Error(codes.Unavailable, "node is shutting down")
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "stream closed")
+		case err := <-recvErr:
+			return err
+		case msg := <-msgs:
+			lastReceived = time.Now()
+			s.handleMessage(ctx, c, msg, lggr)
+		case <-heartbeats.C:
+			if time.Since(lastReceived) > missedHeartbeats*heartbeatInterval {
+				return status.Errorf(codes.DeadlineExceeded, "no message received for %s", time.Since(lastReceived))
+			}
+			if err := c.send(&pb.NodeMessage{Msg: &pb.NodeMessage_Heartbeat{Heartbeat: &pb.Heartbeat{Timestamp: time.Now().UnixMilli()}}}); err != nil {
+				return err
+			}
+		case <-poll.C:
+			if lastSent, err = s.sendNotifications(ctx, c, lastSent); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendNotifications sends the notifications queued after lastSent, and returns the ID of the last one sent.
+func (s *GRPCServer) sendNotifications(ctx context.Context, c *eiStream, lastSent int64) (int64, error) {
+	ns, err := s.orm.PendingNotifications(c.ei.ID, lastSent, notificationsBatchSize, pg.WithParentCtx(ctx))
+	if err != nil {
+		s.lggr.Errorw("Failed to load external initiator notifications", "externalInitiator", c.ei.Name, "err", err)
+		return lastSent, nil
+	}
+	for _, n := range ns {
+		notification := &pb.JobNotification{
+			Id:     n.ID,
+			Action: notificationAction(n.Action),
+			JobId:  n.JobID.String(),
+			Type:   c.ei.Name,
+			Params: n.Params,
+		}
+		if err := c.send(&pb.NodeMessage{Msg: &pb.NodeMessage_JobNotification{JobNotification: notification}}); err != nil {
+			return lastSent, err
+		}
+		lastSent = n.ID
+	}
+	return lastSent, nil
+}
+
+func notificationAction(action NotificationAction) pb.JobNotification_Action {
+	switch action {
+	case NotificationCreated:
+		return pb.JobNotification_ACTION_CREATED
+	case NotificationDeleted:
+		return pb.JobNotification_ACTION_DELETED
+	default:
+		return pb.JobNotification_ACTION_UNSPECIFIED
+	}
+}
+
+func (s *GRPCServer) handleMessage(ctx context.Context, c *eiStream, msg *pb.InitiatorMessage, lggr logger.Logger) {
+	switch m := msg.Msg.(type) {
+	case *pb.InitiatorMessage_NotificationAck:
+		if err := s.orm.DeleteNotification(c.ei.ID, m.NotificationAck.Id, pg.WithParentCtx(ctx)); err != nil {
+			lggr.Errorw("Failed to delete acked notification", "id", m.NotificationAck.Id, "err", err)
+		}
+	case *pb.InitiatorMessage_Trigger:
+		s.trigger(ctx, c, m.Trigger, lggr.With("triggerID", m.Trigger.Id, "jobID", m.Trigger.JobId))
+	case *pb.InitiatorMessage_Heartbeat:
+	default:
+		lggr.Warnw("Ignoring unknown message from external initiator", "msg", msg.String())
+	}
+}
+
+// trigger starts a run for the trigger, unless it was already claimed. Triggers which fail to be claimed are not acked,
+// so that the external initiator sends them again.
+func (s *GRPCServer) trigger(ctx context.Context, c *eiStream, t *pb.Trigger, lggr logger.Logger) {
+	ack := func(runID int64, err error) {
+		triggerAck := &pb.TriggerAck{Id: t.Id, RunId: runID}
+		if err != nil {
+			triggerAck.Error = err.Error()
+		}
+		if err := c.send(&pb.NodeMessage{Msg: &pb.NodeMessage_TriggerAck{TriggerAck: triggerAck}}); err != nil {
+			lggr.Warnw("Failed to ack trigger", "err", err)
+		}
+	}
+
+	if t.Id == "" {
+		ack(0, errors.New("trigger ID is required"))
+		return
+	}
+	jobUUID, err := uuid.Parse(t.JobId)
+	if err != nil {
+		ack(0, errors.Wrap(err, "invalid job ID"))
+		return
+	}
+
+	claimed, runID, err := s.orm.ClaimTrigger(c.ei.ID, t.Id, pg.WithParentCtx(ctx))
+	if err != nil {
+		lggr.Errorw("Failed to claim trigger", "err", err)
+		return
+	}
+	if !claimed {
+		// The trigger was resent, its run is acked again once it has started.
+		if runID.Valid {
+			ack(runID.Int64, nil)
+		}
+		return
+	}
+
+	canRun, err := s.newAuthorizer(c.ei).CanRun(ctx, s.authorizerCfg, jobUUID)
+	if err != nil {
+		lggr.Errorw("Failed to authorize trigger", "err", err)
+		s.deleteTrigger(c, t, lggr)
+		return
+	}
+	if !canRun {
+		s.deleteTrigger(c, t, lggr)
+		ack(0, fmt.Errorf("external initiator %s is not allowed to run job %s", c.ei.Name, jobUUID))
+		return
+	}
+
+	// The run outlives the stream, its ack is resent if the trigger is.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		runCtx, cancel := s.stopCh.NewCtx()
+		defer cancel()
+		runID, err := s.runner.RunJob(runCtx, jobUUID, string(t.Body), pipeline.JSONSerializable{})
+		if err != nil {
+			lggr.Warnw("Failed to run job for trigger", "err", err)
+			s.deleteTrigger(c, t, lggr)
+			ack(0, err)
+			return
+		}
+		if err := s.orm.SetTriggerRun(c.ei.ID, t.Id, runID, pg.WithParentCtx(runCtx)); err != nil {
+			lggr.Errorw("Failed to record the run of trigger", "runID", runID, "err", err)
+		}
+		ack(runID, nil)
+	}()
+}
+
+func (s *GRPCServer) deleteTrigger(c *eiStream, t *pb.Trigger, lggr logger.Logger) {
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+	if err := s.orm.DeleteTrigger(c.ei.ID, t.Id, pg.WithParentCtx(ctx)); err != nil {
+		lggr.Errorw("Failed to delete trigger", "err", err)
+	}
+}
@@ -0,0 +1,289 @@
+package webhook_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook/pb"
+)
+
+type grpcConfig struct {
+	certPath, keyPath, caPath string
+}
+
+func (c grpcConfig) ListenIP() net.IP                 { return net.IPv4(127, 0, 0, 1) }
+func (c grpcConfig) Port() uint16                     { return 0 }
+func (c grpcConfig) CertPath() string                 { return c.certPath }
+func (c grpcConfig) KeyPath() string                  { return c.keyPath }
+func (c grpcConfig) ClientCAPath() string             { return c.caPath }
+func (c grpcConfig) HeartbeatInterval() time.Duration { return time.Second }
+
+type authorizerConfig struct{}
+
+func (authorizerConfig) ExternalInitiatorsEnabled() bool { return true }
+
+type jobRunner struct {
+	webhook.JobRunner
+	runs chan uuid.UUID
+}
+
+func (r *jobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
+	r.runs <- jobUUID
+	if requestBody == "fail" {
+		return 0, webhook.ErrJobNotExists
+	}
+	return 42, nil
+}
+
+// testPKI writes a CA and a server certificate signed by it to a temporary directory, and returns the TLS
+// configuration of a client with a certificate for the given common name.
+func testPKI(t *testing.T, clientName string) (grpcConfig, *tls.Config) {
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(serial int64, name string, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	}
+
+	cfg := grpcConfig{
+		certPath: filepath.Join(dir, "server.crt"),
+		keyPath:  filepath.Join(dir, "server.key"),
+		caPath:   filepath.Join(dir, "ca.crt"),
+	}
+	serverCert, serverKey := issue(2, "node", x509.ExtKeyUsageServerAuth)
+	require.NoError(t, os.WriteFile(cfg.certPath, serverCert, 0600))
+	require.NoError(t, os.WriteFile(cfg.keyPath, serverKey, 0600))
+	require.NoError(t, os.WriteFile(cfg.caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0600))
+
+	clientCert, clientKey := issue(3, clientName, x509.ExtKeyUsageClientAuth)
+	cert, err := tls.X509KeyPair(clientCert, clientKey)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	return cfg, &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: roots, MinVersion: tls.VersionTLS12}
+}
+
+func startGRPCServer(t *testing.T, orm *webhookmocks.ORM, runner webhook.JobRunner, canRun bool, clientName string) pb.ExternalInitiator_ConnectClient {
+	cfg, clientTLS := testPKI(t, clientName)
+
+	orm.On("DeleteUnstartedTriggers").Return(nil).Once()
+	server := webhook.NewGRPCServer(cfg, authorizerConfig{}, nil, orm, runner, logger.TestLogger(t))
+	server.SetCanRun(canRun)
+	require.NoError(t, server.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, server.Close()) })
+
+	conn, err := grpc.Dial(server.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	stream, err := pb.NewExternalInitiatorClient(conn).Connect(testutils.Context(t))
+	require.NoError(t, err)
+	return stream
+}
+
+func TestGRPCServer_Authentication(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown external initiator", func(t *testing.T) {
+		orm := webhookmocks.NewORM(t)
+		orm.On("FindExternalInitiatorByName", "unknown").Return(bridges.ExternalInitiator{}, sql.ErrNoRows).Once()
+
+		stream := startGRPCServer(t, orm, &jobRunner{}, true, "unknown")
+		_, err := stream.Recv()
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("external initiator without gRPC", func(t *testing.T) {
+		orm := webhookmocks.NewORM(t)
+		orm.On("FindExternalInitiatorByName", "legacy").Return(bridges.ExternalInitiator{ID: 1, Name: "legacy"}, nil).Once()
+
+		stream := startGRPCServer(t, orm, &jobRunner{}, true, "legacy")
+		_, err := stream.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestGRPCServer_Notifications(t *testing.T) {
+	t.Parallel()
+
+	ei := bridges.ExternalInitiator{ID: 1, Name: "ei", GRPC: true}
+	jobID := uuid.New()
+	orm := webhookmocks.NewORM(t)
+	orm.On("FindExternalInitiatorByName", ei.Name).Return(ei, nil).Once()
+	orm.On("PendingNotifications", ei.ID, int64(0), mock.Anything, mock.Anything).Return([]webhook.Notification{
+		{ID: 7, ExternalInitiatorID: ei.ID, Action: webhook.NotificationCreated, JobID: jobID, Params: []byte(`{"foo":"bar"}`)},
+		{ID: 8, ExternalInitiatorID: ei.ID, Action: webhook.NotificationDeleted, JobID: jobID},
+	}, nil).Once()
+	orm.On("PendingNotifications", ei.ID, int64(8), mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	acked := make(chan int64, 1)
+	orm.On("DeleteNotification", ei.ID, int64(7), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		acked <- args.Get(1).(int64)
+	}).Once()
+
+	stream := startGRPCServer(t, orm, &jobRunner{}, true, ei.Name)
+
+	msg, err := stream.Recv()
+	require.NoError(t, err)
+	created := msg.GetJobNotification()
+	require.NotNil(t, created)
+	assert.Equal(t, int64(7), created.Id)
+	assert.Equal(t, pb.JobNotification_ACTION_CREATED, created.Action)
+	assert.Equal(t, jobID.String(), created.JobId)
+	assert.Equal(t, ei.Name, created.Type)
+	assert.JSONEq(t, `{"foo":"bar"}`, string(created.Params))
+
+	msg, err = stream.Recv()
+	require.NoError(t, err)
+	deleted := msg.GetJobNotification()
+	require.NotNil(t, deleted)
+	assert.Equal(t, int64(8), deleted.Id)
+	assert.Equal(t, pb.JobNotification_ACTION_DELETED, deleted.Action)
+
+	require.NoError(t, stream.Send(&pb.InitiatorMessage{Msg: &pb.InitiatorMessage_NotificationAck{NotificationAck: &pb.NotificationAck{Id: 7}}}))
+	select {
+	case id := <-acked:
+		assert.Equal(t, int64(7), id)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the ack")
+	}
+}
+
+func TestGRPCServer_Triggers(t *testing.T) {
+	t.Parallel()
+
+	ei := bridges.ExternalInitiator{ID: 1, Name: "ei", GRPC: true}
+	jobID := uuid.New()
+	trigger := func(stream pb.ExternalInitiator_ConnectClient, id string, body string) *pb.TriggerAck {
+		require.NoError(t, stream.Send(&pb.InitiatorMessage{Msg: &pb.InitiatorMessage_Trigger{Trigger: &pb.Trigger{Id: id, JobId: jobID.String(), Body: []byte(body)}}}))
+		for {
+			msg, err := stream.Recv()
+			require.NoError(t, err)
+			if ack := msg.GetTriggerAck(); ack != nil {
+				return ack
+			}
+		}
+	}
+	newORM := func(t *testing.T) *webhookmocks.ORM {
+		orm := webhookmocks.NewORM(t)
+		orm.On("FindExternalInitiatorByName", ei.Name).Return(ei, nil).Once()
+		orm.On("PendingNotifications", ei.ID, int64(0), mock.Anything, mock.Anything).Return(nil, nil)
+		return orm
+	}
+
+	t.Run("runs the job once", func(t *testing.T) {
+		orm := newORM(t)
+		orm.On("ClaimTrigger", ei.ID, "t1", mock.Anything).Return(true, null.Int{}, nil).Once()
+		orm.On("SetTriggerRun", ei.ID, "t1", int64(42), mock.Anything).Return(nil).Once()
+		orm.On("ClaimTrigger", ei.ID, "t1", mock.Anything).Return(false, null.IntFrom(42), nil).Once()
+		runner := &jobRunner{runs: make(chan uuid.UUID, 2)}
+
+		stream := startGRPCServer(t, orm, runner, true, ei.Name)
+
+		ack := trigger(stream, "t1", "body")
+		assert.Equal(t, "t1", ack.Id)
+		assert.Equal(t, int64(42), ack.RunId)
+		assert.Empty(t, ack.Error)
+		assert.Equal(t, jobID, <-runner.runs)
+
+		// The resent trigger is acked with the run of the first one.
+		ack = trigger(stream, "t1", "body")
+		assert.Equal(t, int64(42), ack.RunId)
+		assert.Empty(t, ack.Error)
+		assert.Empty(t, runner.runs)
+	})
+
+	t.Run("failed run", func(t *testing.T) {
+		orm := newORM(t)
+		orm.On("ClaimTrigger", ei.ID, "t2", mock.Anything).Return(true, null.Int{}, nil).Once()
+		orm.On("DeleteTrigger", ei.ID, "t2", mock.Anything).Return(nil).Once()
+		runner := &jobRunner{runs: make(chan uuid.UUID, 1)}
+
+		stream := startGRPCServer(t, orm, runner, true, ei.Name)
+
+		ack := trigger(stream, "t2", "fail")
+		assert.Equal(t, int64(0), ack.RunId)
+		assert.Equal(t, webhook.ErrJobNotExists.Error(), ack.Error)
+	})
+
+	t.Run("not allowed to run the job", func(t *testing.T) {
+		orm := newORM(t)
+		orm.On("ClaimTrigger", ei.ID, "t3", mock.Anything).Return(true, null.Int{}, nil).Once()
+		orm.On("DeleteTrigger", ei.ID, "t3", mock.Anything).Return(nil).Once()
+
+		stream := startGRPCServer(t, orm, &jobRunner{}, false, ei.Name)
+
+		ack := trigger(stream, "t3", "body")
+		assert.Contains(t, ack.Error, "external initiator ei is not allowed to run job")
+	})
+
+	t.Run("invalid job ID", func(t *testing.T) {
+		orm := newORM(t)
+
+		stream := startGRPCServer(t, orm, &jobRunner{}, true, ei.Name)
+
+		require.NoError(t, stream.Send(&pb.InitiatorMessage{Msg: &pb.InitiatorMessage_Trigger{Trigger: &pb.Trigger{Id: "t4", JobId: "1"}}}))
+		msg, err := stream.Recv()
+		require.NoError(t, err)
+		require.NotNil(t, msg.GetTriggerAck())
+		assert.Contains(t, msg.GetTriggerAck().Error, "invalid job ID")
+	})
+}
@@ -0,0 +1,22 @@
+package webhook
+
+import (
+	"net"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+// SetCanRun replaces the authorizer of the external initiators with one which allows them to run any job, or none.
+func (s *GRPCServer) SetCanRun(canRun bool) {
+	s.newAuthorizer = func(bridges.ExternalInitiator) Authorizer {
+		if canRun {
+			return &alwaysAuthorizer{}
+		}
+		return &neverAuthorizer{}
+	}
+}
+
+// Addr returns the address the server listens on.
+func (s *GRPCServer) Addr() net.Addr {
+	return s.lis.Addr()
+}
@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+
+// ORM persists the job notifications and the triggers exchanged with the external initiators connected over gRPC.
+type ORM interface {
+	FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error)
+
+	// CreateNotification queues a notification for the external initiator, until it is acked.
+	CreateNotification(eiID int64, action NotificationAction, jobID uuid.UUID, params []byte, qopts ...pg.QOpt) error
+	// PendingNotifications returns the notifications queued for the external initiator with an ID greater than afterID,
+	// in order.
+	PendingNotifications(eiID int64, afterID int64, limit int, qopts ...pg.QOpt) ([]Notification, error)
+	DeleteNotification(eiID int64, id int64, qopts ...pg.QOpt) error
+
+	// ClaimTrigger records the trigger so that it starts a single run. If the trigger was already claimed, it returns
+	// false, along with the ID of its run if it has started.
+	ClaimTrigger(eiID int64, triggerID string, qopts ...pg.QOpt) (claimed bool, runID null.Int, err error)
+	SetTriggerRun(eiID int64, triggerID string, runID int64, qopts ...pg.QOpt) error
+	DeleteTrigger(eiID int64, triggerID string, qopts ...pg.QOpt) error
+	// DeleteUnstartedTriggers deletes the claims of the triggers whose run never started, so that they are run again
+	// when resent.
+	DeleteUnstartedTriggers(qopts ...pg.QOpt) error
+	PruneTriggers(before time.Time, qopts ...pg.QOpt) (int64, error)
+}
+
+// NotificationAction is the change of a job an external initiator is notified of.
+type NotificationAction string
+
+const (
+	NotificationCreated NotificationAction = "created"
+	NotificationDeleted NotificationAction = "deleted"
+)
+
+// Notification is a job notification queued for an external initiator connected over gRPC.
+type Notification struct {
+	ID                  int64
+	ExternalInitiatorID int64
+	Action              NotificationAction
+	JobID               uuid.UUID
+	Params              []byte
+	CreatedAt           time.Time
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) *orm {
+	return &orm{pg.NewQ(db, lggr.Named("WebhookORM"), cfg)}
+}
+
+func (o *orm) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
+	var exi bridges.ExternalInitiator
+	err := o.q.Get(&exi, "SELECT * FROM external_initiators WHERE lower(external_initiators.name) = lower($1)", name)
+	return exi, err
+}
+
+func (o *orm) CreateNotification(eiID int64, action NotificationAction, jobID uuid.UUID, params []byte, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`INSERT INTO external_initiator_notifications (external_initiator_id, action, job_id, params, created_at)
+VALUES ($1, $2, $3, $4, NOW())`, eiID, action, jobID, params)
+	return errors.Wrap(err, "CreateNotification failed")
+}
+
+func (o *orm) PendingNotifications(eiID int64, afterID int64, limit int, qopts ...pg.QOpt) (ns []Notification, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&ns, `SELECT * FROM external_initiator_notifications
+WHERE external_initiator_id = $1 AND id > $2 ORDER BY id LIMIT $3`, eiID, afterID, limit)
+	return ns, errors.Wrap(err, "PendingNotifications failed")
+}
+
+func (o *orm) DeleteNotification(eiID int64, id int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`DELETE FROM external_initiator_notifications WHERE external_initiator_id = $1 AND id = $2`, eiID, id)
+	return errors.Wrap(err, "DeleteNotification failed")
+}
+
+func (o *orm) ClaimTrigger(eiID int64, triggerID string, qopts ...pg.QOpt) (claimed bool, runID null.Int, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		res, err := tx.Exec(`INSERT INTO external_initiator_triggers (external_initiator_id, trigger_id, created_at)
+VALUES ($1, $2, NOW()) ON CONFLICT DO NOTHING`, eiID, triggerID)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			claimed = true
+			return nil
+		}
+		err = tx.Get(&runID, `SELECT pipeline_run_id FROM external_initiator_triggers WHERE external_initiator_id = $1 AND trigger_id = $2`, eiID, triggerID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// The claim was deleted concurrently, the trigger is resent later.
+			return nil
+		}
+		return err
+	})
+	return claimed, runID, errors.Wrap(err, "ClaimTrigger failed")
+}
+
+func (o *orm) SetTriggerRun(eiID int64, triggerID string, runID int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE external_initiator_triggers SET pipeline_run_id = $3 WHERE external_initiator_id = $1 AND trigger_id = $2`, eiID, triggerID, runID)
+	return errors.Wrap(err, "SetTriggerRun failed")
+}
+
+func (o *orm) DeleteTrigger(eiID int64, triggerID string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`DELETE FROM external_initiator_triggers WHERE external_initiator_id = $1 AND trigger_id = $2`, eiID, triggerID)
+	return errors.Wrap(err, "DeleteTrigger failed")
+}
+
+func (o *orm) DeleteUnstartedTriggers(qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`DELETE FROM external_initiator_triggers WHERE pipeline_run_id IS NULL`)
+	return errors.Wrap(err, "DeleteUnstartedTriggers failed")
+}
+
+func (o *orm) PruneTriggers(before time.Time, qopts ...pg.QOpt) (int64, error) {
+	q := o.q.WithOpts(qopts...)
+	res, err := q.Exec(`DELETE FROM external_initiator_triggers WHERE created_at < $1`, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "PruneTriggers failed")
+	}
+	return res.RowsAffected()
+}
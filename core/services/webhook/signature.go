@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+const (
+	DefaultSignatureHeader    = "X-Chainlink-Signature"
+	DefaultSignatureAlgorithm = "sha256"
+	DefaultTimestampHeader    = "X-Chainlink-Timestamp"
+	DefaultReplayWindow       = 5 * time.Minute
+)
+
+var (
+	// ErrInvalidSignature is returned for requests which are not signed with the secret of the webhook job.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+
+	signatureAlgorithms = map[string]func() hash.Hash{
+		"sha256": sha256.New,
+		"sha512": sha512.New,
+	}
+)
+
+// Signature returns the hex encoded HMAC of the timestamp and body of a request, which external initiators send in
+// the signature header of the webhook job.
+func Signature(spec job.WebhookSpec, timestamp int64, body []byte) (string, error) {
+	newHash, ok := signatureAlgorithms[spec.SignatureAlgorithm]
+	if !ok {
+		return "", errors.Errorf("unsupported signature algorithm %q", spec.SignatureAlgorithm)
+	}
+	mac := hmac.New(newHash, []byte(spec.SignatureSecret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verifySignature checks the signature and timestamp headers of a request against the secret of the webhook job,
+// and returns the signature and the time until which it must not be accepted again.
+func verifySignature(spec job.WebhookSpec, header http.Header, body []byte, now time.Time) (signature string, expiresAt time.Time, err error) {
+	tsHeader := header.Get(spec.TimestampHeader)
+	if tsHeader == "" {
+		return "", time.Time{}, errors.Wrapf(ErrInvalidSignature, "missing %s header", spec.TimestampHeader)
+	}
+	ts, err := strconv.ParseInt(tsHeader, 10, 64)
+	if err != nil {
+		return "", time.Time{}, errors.Wrapf(ErrInvalidSignature, "invalid %s header %q", spec.TimestampHeader, tsHeader)
+	}
+	window := spec.ReplayWindow.Duration()
+	signedAt := time.Unix(ts, 0)
+	if signedAt.Before(now.Add(-window)) || signedAt.After(now.Add(window)) {
+		return "", time.Time{}, errors.Wrapf(ErrInvalidSignature, "timestamp %d is outside of the %s replay window", ts, window)
+	}
+
+	signature = header.Get(spec.SignatureHeader)
+	if signature == "" {
+		return "", time.Time{}, errors.Wrapf(ErrInvalidSignature, "missing %s header", spec.SignatureHeader)
+	}
+	expected, err := Signature(spec, ts, body)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return "", time.Time{}, errors.Wrap(ErrInvalidSignature, "signature mismatch")
+	}
+	return signature, signedAt.Add(window), nil
+}
+
+// replayCache holds the signatures of the requests accepted within their replay window, so that none of them is
+// accepted twice.
+type replayCache struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func newReplayCache() *replayCache {
+	return &replayCache{seen: make(map[string]time.Time)}
+}
+
+// add records the signature, returning false if it was already recorded.
+func (c *replayCache) add(signature string, expiresAt, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for s, exp := range c.seen {
+		if now.After(exp) {
+			delete(c.seen, s)
+		}
+	}
+	if _, ok := c.seen[signature]; ok {
+		return false
+	}
+	c.seen[signature] = expiresAt
+	return true
+}
@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"net/http"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -16,6 +18,11 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	SignatureSecret    string                             `toml:"signatureSecret"`
+	SignatureHeader    string                             `toml:"signatureHeader"`
+	SignatureAlgorithm string                             `toml:"signatureAlgorithm"`
+	TimestampHeader    string                             `toml:"timestampHeader"`
+	ReplayWindow       *models.Interval                   `toml:"replayWindow"`
 }
 
 func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -60,6 +67,48 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
 	}
+	if err = validateSignatureOptions(tomlSpec, jb.WebhookSpec); err != nil {
+		return jb, err
+	}
 
 	return jb, nil
 }
+
+// validateSignatureOptions sets the signature options of the spec, defaulting the ones not given when a secret is.
+func validateSignatureOptions(tomlSpec TOMLWebhookSpec, spec *job.WebhookSpec) error {
+	if tomlSpec.SignatureSecret == "" {
+		if tomlSpec.SignatureHeader != "" || tomlSpec.SignatureAlgorithm != "" || tomlSpec.TimestampHeader != "" || tomlSpec.ReplayWindow != nil {
+			return errors.New("signatureHeader, signatureAlgorithm, timestampHeader and replayWindow require a signatureSecret")
+		}
+		return nil
+	}
+
+	spec.SignatureSecret = tomlSpec.SignatureSecret
+	spec.SignatureHeader = DefaultSignatureHeader
+	if tomlSpec.SignatureHeader != "" {
+		spec.SignatureHeader = tomlSpec.SignatureHeader
+	}
+	spec.SignatureAlgorithm = DefaultSignatureAlgorithm
+	if tomlSpec.SignatureAlgorithm != "" {
+		spec.SignatureAlgorithm = tomlSpec.SignatureAlgorithm
+	}
+	spec.TimestampHeader = DefaultTimestampHeader
+	if tomlSpec.TimestampHeader != "" {
+		spec.TimestampHeader = tomlSpec.TimestampHeader
+	}
+	spec.ReplayWindow = models.Interval(DefaultReplayWindow)
+	if tomlSpec.ReplayWindow != nil {
+		spec.ReplayWindow = *tomlSpec.ReplayWindow
+	}
+
+	if _, ok := signatureAlgorithms[spec.SignatureAlgorithm]; !ok {
+		return errors.Errorf("unsupported signatureAlgorithm %q, must be one of sha256 or sha512", spec.SignatureAlgorithm)
+	}
+	if http.CanonicalHeaderKey(spec.SignatureHeader) == http.CanonicalHeaderKey(spec.TimestampHeader) {
+		return errors.New("signatureHeader and timestampHeader must be different")
+	}
+	if spec.ReplayWindow.Duration() <= 0 {
+		return errors.New("replayWindow must be positive")
+	}
+	return nil
+}
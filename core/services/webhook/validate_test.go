@@ -2,6 +2,7 @@ package webhook_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
@@ -142,6 +143,57 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded; unable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with signature secret",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            signatureSecret = "s3cr3t"
+            replayWindow    = "1m"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+                ds_parse    [type=jsonparse path="data,price"];
+                ds -> ds_parse;
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "s3cr3t", s.WebhookSpec.SignatureSecret)
+				assert.Equal(t, webhook.DefaultSignatureHeader, s.WebhookSpec.SignatureHeader)
+				assert.Equal(t, webhook.DefaultSignatureAlgorithm, s.WebhookSpec.SignatureAlgorithm)
+				assert.Equal(t, webhook.DefaultTimestampHeader, s.WebhookSpec.TimestampHeader)
+				assert.Equal(t, time.Minute, s.WebhookSpec.ReplayWindow.Duration())
+			},
+		},
+		{
+			name: "with unsupported signature algorithm",
+			toml: `
+            type               = "webhook"
+            schemaVersion      = 1
+            signatureSecret    = "s3cr3t"
+            signatureAlgorithm = "md5"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, `unsupported signatureAlgorithm "md5", must be one of sha256 or sha512`)
+			},
+		},
+		{
+			name: "with signature options but no secret",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            signatureHeader = "X-Signature"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "signatureHeader, signatureAlgorithm, timestampHeader and replayWindow require a signatureSecret")
+			},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
@@ -0,0 +1,40 @@
+package sessions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// ErrSessionNotFound is returned when revoking a session which does not exist, or has expired.
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionActivity describes an active session. The session ID is a secret bearer credential, so the session is
+// identified by a fingerprint of its ID instead, see SessionActivityID.
+type SessionActivity struct {
+	ID        string
+	Email     string
+	IPAddress null.String
+	UserAgent null.String
+	LastUsed  time.Time
+	CreatedAt time.Time
+}
+
+// APITokenActivity describes the API token of a user, and its last use.
+type APITokenActivity struct {
+	AccessKey string
+	Email     string
+	IPAddress null.String
+	UserAgent null.String
+	LastUsed  null.Time
+}
+
+// SessionActivityID returns the fingerprint identifying the session in its SessionActivity. It matches the SQL
+// expression encode(sha256(convert_to(id, 'UTF8')), 'hex').
+func SessionActivityID(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return hex.EncodeToString(sum[:])
+}
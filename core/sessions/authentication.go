@@ -61,6 +61,24 @@ type AuthenticationProvider interface {
 	Sessions(offset, limit int) ([]Session, error)
 	GetUserWebAuthn(email string) ([]WebAuthn, error)
 	SaveWebAuthn(token *WebAuthn) error
+	// HasMFA returns true if the user has enrolled WebAuthn or TOTP.
+	HasMFA(email string) (bool, error)
+	// BeginTOTPEnrollment returns a new TOTP secret for the user, which is only enforced once confirmed.
+	BeginTOTPEnrollment(email string) (string, error)
+	// ConfirmTOTPEnrollment enforces the pending TOTP secret of the user if code is valid, and returns new recovery codes.
+	ConfirmTOTPEnrollment(email, code string) ([]string, error)
+	// RegenerateRecoveryCodes replaces the recovery codes of the user.
+	RegenerateRecoveryCodes(email string) ([]string, error)
+	// ListSessionActivity returns the active sessions of the user, or of all users if email is empty.
+	ListSessionActivity(email string) ([]SessionActivity, error)
+	// RevokeSession deletes the session identified by its SessionActivity ID.
+	RevokeSession(activityID string) error
+	// RevokeUserSessions deletes all the sessions of the user.
+	RevokeUserSessions(email string) error
+	// ListAPITokenActivity returns the API tokens of all users, and their last use.
+	ListAPITokenActivity() ([]APITokenActivity, error)
+	// RecordAPITokenActivity records a use of the API token.
+	RecordAPITokenActivity(accessKey, ipAddress, userAgent string) error
 
 	FindExternalInitiator(eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
@@ -33,6 +33,7 @@ import (
 
 	"github.com/go-ldap/ldap/v3"
 	"github.com/jmoiron/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
@@ -251,7 +252,8 @@ func (l *ldapAuthenticator) FindUserByAPIToken(apiToken string) (sessions.User,
 	if err != nil {
 		if errors.Is(err, sessions.ErrUserSessionExpired) {
 			// API Token expired, purge
-			if _, execErr := l.q.Exec("DELETE FROM ldap_user_api_tokens WHERE token_key = $1", apiToken); err != nil {
+			if _, execErr := l.q.Exec(`WITH deleted AS (DELETE FROM api_token_activity WHERE token_key = $1)
+DELETE FROM ldap_user_api_tokens WHERE token_key = $1`, apiToken); err != nil {
 				l.lggr.Errorf("error purging stale ldap API token session: %v", execErr)
 			}
 		}
@@ -476,11 +478,13 @@ func (l *ldapAuthenticator) CreateSession(sr sessions.SessionRequest) (string, e
 	// LDAP server
 	session := sessions.NewSession()
 	_, err = l.q.Exec(
-		"INSERT INTO ldap_sessions (id, user_email, user_role, localauth_user, created_at) VALUES ($1, $2, $3, $4, now())",
+		"INSERT INTO ldap_sessions (id, user_email, user_role, localauth_user, created_at, ip_address, user_agent) VALUES ($1, $2, $3, $4, now(), $5, $6)",
 		session.ID,
 		strings.ToLower(sr.Email),
 		foundUser.Role,
 		isLocalUser,
+		null.NewString(sr.IPAddress, sr.IPAddress != ""),
+		null.NewString(sr.UserAgent, sr.UserAgent != ""),
 	)
 	if err != nil {
 		l.lggr.Errorf("unable to create new session in ldap_sessions table %v", err)
@@ -599,8 +603,9 @@ func (l *ldapAuthenticator) SetAuthToken(user *sessions.User, token *auth.Token)
 			return fmt.Errorf("error checking user presence in users table: %w", err)
 		}
 
-		// Remove any existing API tokens
-		if _, err = l.q.Exec("DELETE FROM ldap_user_api_tokens WHERE user_email = $1", user.Email); err != nil {
+		// Remove any existing API tokens, and their activity
+		if _, err = l.q.Exec(`WITH deleted AS (DELETE FROM api_token_activity WHERE token_key IN (SELECT token_key FROM ldap_user_api_tokens WHERE user_email = $1))
+DELETE FROM ldap_user_api_tokens WHERE user_email = $1`, user.Email); err != nil {
 			return fmt.Errorf("error executing DELETE FROM ldap_user_api_tokens: %w", err)
 		}
 		// Create new API token for user
@@ -628,7 +633,8 @@ func (l *ldapAuthenticator) SetAuthToken(user *sessions.User, token *auth.Token)
 
 // DeleteAuthToken clears and disables the users Authentication Token.
 func (l *ldapAuthenticator) DeleteAuthToken(user *sessions.User) error {
-	_, err := l.q.Exec("DELETE FROM ldap_user_api_tokens WHERE email = $1")
+	_, err := l.q.Exec(`WITH deleted AS (DELETE FROM api_token_activity WHERE token_key IN (SELECT token_key FROM ldap_user_api_tokens WHERE user_email = $1))
+DELETE FROM ldap_user_api_tokens WHERE user_email = $1`, strings.ToLower(user.Email))
 	return err
 }
 
@@ -637,6 +643,79 @@ func (l *ldapAuthenticator) SaveWebAuthn(token *sessions.WebAuthn) error {
 	return sessions.ErrNotSupported
 }
 
+// HasMFA returns true, as MFA is handled by the upstream LDAP server
+func (l *ldapAuthenticator) HasMFA(email string) (bool, error) {
+	return true, nil
+}
+
+// BeginTOTPEnrollment is not supported for read only LDAP
+func (l *ldapAuthenticator) BeginTOTPEnrollment(email string) (string, error) {
+	return "", sessions.ErrNotSupported
+}
+
+// ConfirmTOTPEnrollment is not supported for read only LDAP
+func (l *ldapAuthenticator) ConfirmTOTPEnrollment(email, code string) ([]string, error) {
+	return nil, sessions.ErrNotSupported
+}
+
+// RegenerateRecoveryCodes is not supported for read only LDAP
+func (l *ldapAuthenticator) RegenerateRecoveryCodes(email string) ([]string, error) {
+	return nil, sessions.ErrNotSupported
+}
+
+// ListSessionActivity returns the ldap_sessions which have not expired, of the user or of all users if email is empty.
+// LDAP sessions expire from their creation, so their last use is not tracked.
+func (l *ldapAuthenticator) ListSessionActivity(email string) ([]sessions.SessionActivity, error) {
+	var activity []sessions.SessionActivity
+	sql := `SELECT encode(sha256(convert_to(id, 'UTF8')), 'hex') AS id, user_email AS email, ip_address, user_agent, created_at AS last_used, created_at
+FROM ldap_sessions WHERE created_at + $1 >= now() AND ($2 = '' OR user_email = lower($2))
+ORDER BY created_at DESC`
+	err := l.q.Select(&activity, sql, l.config.SessionTimeout().Duration(), email)
+	return activity, err
+}
+
+// RevokeSession deletes the ldap_sessions entry identified by its activity ID.
+func (l *ldapAuthenticator) RevokeSession(activityID string) error {
+	res, err := l.q.Exec("DELETE FROM ldap_sessions WHERE encode(sha256(convert_to(id, 'UTF8')), 'hex') = $1", activityID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sessions.ErrSessionNotFound
+	}
+	return nil
+}
+
+// RevokeUserSessions deletes all the ldap_sessions entries of the user.
+func (l *ldapAuthenticator) RevokeUserSessions(email string) error {
+	_, err := l.q.Exec("DELETE FROM ldap_sessions WHERE user_email = lower($1)", email)
+	return err
+}
+
+// ListAPITokenActivity returns the API tokens of all users, and their last use.
+func (l *ldapAuthenticator) ListAPITokenActivity() ([]sessions.APITokenActivity, error) {
+	var activity []sessions.APITokenActivity
+	sql := `SELECT t.token_key AS access_key, t.user_email AS email, a.ip_address, a.user_agent, a.last_used
+FROM ldap_user_api_tokens t LEFT JOIN api_token_activity a ON a.token_key = t.token_key
+ORDER BY a.last_used DESC NULLS LAST, t.user_email`
+	err := l.q.Select(&activity, sql)
+	return activity, err
+}
+
+// RecordAPITokenActivity records a use of the API token. Uses from the same client are recorded at most once a minute.
+func (l *ldapAuthenticator) RecordAPITokenActivity(accessKey, ipAddress, userAgent string) error {
+	_, err := l.q.Exec(`INSERT INTO api_token_activity (token_key, last_used, ip_address, user_agent) VALUES ($1, now(), $2, $3)
+ON CONFLICT (token_key) DO UPDATE SET last_used = EXCLUDED.last_used, ip_address = EXCLUDED.ip_address, user_agent = EXCLUDED.user_agent
+WHERE api_token_activity.last_used < EXCLUDED.last_used - interval '1 minute'
+OR api_token_activity.ip_address IS DISTINCT FROM EXCLUDED.ip_address OR api_token_activity.user_agent IS DISTINCT FROM EXCLUDED.user_agent`,
+		accessKey, ipAddress, userAgent)
+	return err
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (l *ldapAuthenticator) Sessions(offset, limit int) ([]sessions.Session, error) {
 	var sessions []sessions.Session
@@ -2,12 +2,14 @@ package localauth
 
 import (
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
 	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
@@ -109,6 +111,9 @@ func (o *orm) AuthorizedUserWithSession(sessionID string) (user sessions.User, e
 func (o *orm) DeleteUser(email string) error {
 	return o.q.Transaction(func(tx pg.Queryer) error {
 		// session table rows are deleted on cascade through the user email constraint
+		if _, err := tx.Exec("DELETE FROM api_token_activity WHERE token_key IN (SELECT token_key FROM users WHERE email = $1)", email); err != nil {
+			return err
+		}
 		if _, err := tx.Exec("DELETE FROM users WHERE email = $1", email); err != nil {
 			return err
 		}
@@ -168,13 +173,56 @@ func (o *orm) CreateSession(sr sessions.SessionRequest) (string, error) {
 		return "", errors.New("MFA Error")
 	}
 
-	// No webauthn tokens registered for the current user, so normal authentication is now complete
-	if len(uwas) == 0 {
+	totp, err := o.getConfirmedTOTP(user.Email)
+	if err != nil {
+		lggr.Errorf("Could not fetch user's TOTP data: %v", err)
+		return "", errors.New("MFA Error")
+	}
+
+	// No MFA registered for the current user, so normal authentication is now complete
+	if len(uwas) == 0 && totp == nil {
 		lggr.Infof("No MFA for user. Creating Session")
-		session := sessions.NewSession()
-		_, err = o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+		sessionID, err := o.insertSession(user, sr)
 		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": sr.Email})
-		return session.ID, err
+		return sessionID, err
+	}
+
+	// A recovery code replaces any MFA method, and can only be used once
+	if sr.RecoveryCode != "" {
+		used, err := o.useRecoveryCode(user.Email, sr.RecoveryCode)
+		if err != nil {
+			lggr.Errorf("Could not check recovery code: %v", err)
+			return "", errors.New("MFA Error")
+		}
+		if !used {
+			o.auditLogger.Audit(audit.AuthLoginFailed2FA, map[string]interface{}{"email": sr.Email, "error": "invalid recovery code"})
+			return "", errors.New("MFA Error")
+		}
+		lggr.Infof("User used a recovery code and login will proceed")
+		return o.createMFASession(user, sr, "recovery code")
+	}
+
+	if totp != nil && sr.TOTPCode != "" {
+		counter, ok := sessions.MatchTOTP(totp.Secret, sr.TOTPCode, time.Now())
+		if !ok {
+			o.auditLogger.Audit(audit.AuthLoginFailed2FA, map[string]interface{}{"email": sr.Email, "error": "invalid TOTP code"})
+			return "", errors.New("MFA Error")
+		}
+		accepted, err := o.acceptTOTPCounter(totp.Email, counter)
+		if err != nil {
+			lggr.Errorf("Could not record TOTP code: %v", err)
+			return "", errors.New("MFA Error")
+		}
+		if !accepted {
+			o.auditLogger.Audit(audit.AuthLoginFailed2FA, map[string]interface{}{"email": sr.Email, "error": "replayed TOTP code"})
+			return "", errors.New("MFA Error")
+		}
+		lggr.Infof("User passed TOTP authentication and login will proceed")
+		return o.createMFASession(user, sr, "totp")
+	}
+
+	if len(uwas) == 0 {
+		return "", sessions.ErrTOTPRequired
 	}
 
 	// Next check if this session request includes the required WebAuthn challenge data
@@ -211,8 +259,7 @@ func (o *orm) CreateSession(sr sessions.SessionRequest) (string, error) {
 
 	lggr.Infof("User passed MFA authentication and login will proceed")
 	// This is a success so we can create the sessions
-	session := sessions.NewSession()
-	_, err = o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+	sessionID, err := o.insertSession(user, sr)
 	if err != nil {
 		return "", err
 	}
@@ -225,7 +272,24 @@ func (o *orm) CreateSession(sr sessions.SessionRequest) (string, error) {
 		o.auditLogger.Audit(audit.AuthLoginSuccessWith2FA, map[string]interface{}{"email": sr.Email, "credential": string(uwasj)})
 	}
 
-	return session.ID, nil
+	return sessionID, nil
+}
+
+func (o *orm) createMFASession(user sessions.User, sr sessions.SessionRequest, credential string) (string, error) {
+	sessionID, err := o.insertSession(user, sr)
+	if err != nil {
+		return "", err
+	}
+	o.auditLogger.Audit(audit.AuthLoginSuccessWith2FA, map[string]interface{}{"email": user.Email, "credential": credential})
+	return sessionID, nil
+}
+
+// insertSession creates a session for the user, recording the client of the session request.
+func (o *orm) insertSession(user sessions.User, sr sessions.SessionRequest) (string, error) {
+	session := sessions.NewSession()
+	_, err := o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at, ip_address, user_agent) VALUES ($1, $2, now(), now(), $3, $4)",
+		session.ID, user.Email, null.NewString(sr.IPAddress, sr.IPAddress != ""), null.NewString(sr.UserAgent, sr.UserAgent != ""))
+	return session.ID, err
 }
 
 const constantTimeEmailLength = 256
@@ -247,8 +311,8 @@ func (o *orm) ClearNonCurrentSessions(sessionID string) error {
 
 // CreateUser creates a new API user
 func (o *orm) CreateUser(user *sessions.User) error {
-	sql := "INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES ($1, $2, $3, now(), now()) RETURNING *"
-	return o.q.Get(user, sql, strings.ToLower(user.Email), user.HashedPassword, user.Role)
+	sql := "INSERT INTO users (email, hashed_password, role, tenant, created_at, updated_at) VALUES ($1, $2, $3, $4, now(), now()) RETURNING *"
+	return o.q.Get(user, sql, strings.ToLower(user.Email), user.HashedPassword, user.Role, user.Tenant)
 }
 
 // UpdateRole overwrites role field of the user specified by email.
@@ -330,13 +394,16 @@ func (o *orm) SetAuthToken(user *sessions.User, token *auth.Token) error {
 	if err != nil {
 		return errors.Wrap(err, "user")
 	}
-	sql := "UPDATE users SET token_salt = $1, token_key = $2, token_hashed_secret = $3, updated_at = now() WHERE email = $4 RETURNING *"
+	// the activity of the replaced token is deleted along with it
+	sql := `WITH replaced AS (DELETE FROM api_token_activity WHERE token_key IN (SELECT token_key FROM users WHERE email = $4))
+UPDATE users SET token_salt = $1, token_key = $2, token_hashed_secret = $3, updated_at = now() WHERE email = $4 RETURNING *`
 	return o.q.Get(user, sql, salt, token.AccessKey, hashedSecret, user.Email)
 }
 
 // DeleteAuthToken clears and disables the users Authentication Token.
 func (o *orm) DeleteAuthToken(user *sessions.User) error {
-	sql := "UPDATE users SET token_salt = '', token_key = '', token_hashed_secret = '', updated_at = now() WHERE email = $1 RETURNING *"
+	sql := `WITH deleted AS (DELETE FROM api_token_activity WHERE token_key IN (SELECT token_key FROM users WHERE email = $1))
+UPDATE users SET token_salt = '', token_key = '', token_hashed_secret = '', updated_at = now() WHERE email = $1 RETURNING *`
 	return o.q.Get(user, sql, user.Email)
 }
 
@@ -347,6 +414,180 @@ func (o *orm) SaveWebAuthn(token *sessions.WebAuthn) error {
 	return err
 }
 
+// HasMFA returns true if the user has enrolled a WebAuthn token, or confirmed a TOTP secret.
+func (o *orm) HasMFA(email string) (enrolled bool, err error) {
+	sql := `SELECT EXISTS (SELECT 1 FROM web_authns WHERE lower(email) = lower($1))
+		OR EXISTS (SELECT 1 FROM totp_secrets WHERE lower(email) = lower($1) AND confirmed_at IS NOT NULL)`
+	err = o.q.Get(&enrolled, sql, email)
+	return
+}
+
+func (o *orm) getConfirmedTOTP(email string) (*sessions.TOTP, error) {
+	var totp sessions.TOTP
+	err := o.q.Get(&totp, "SELECT * FROM totp_secrets WHERE lower(email) = lower($1) AND confirmed_at IS NOT NULL", email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &totp, nil
+}
+
+// acceptTOTPCounter records counter as the last accepted TOTP time step of the user, unless a code of this step or a
+// later one was already accepted.
+func (o *orm) acceptTOTPCounter(email string, counter int64) (bool, error) {
+	res, err := o.q.Exec("UPDATE totp_secrets SET last_counter = $2 WHERE email = $1 AND (last_counter IS NULL OR last_counter < $2)", email, counter)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// BeginTOTPEnrollment stores a new TOTP secret for the user, replacing any pending one. A confirmed secret cannot be
+// replaced.
+func (o *orm) BeginTOTPEnrollment(email string) (string, error) {
+	secret, err := sessions.NewTOTPSecret()
+	if err != nil {
+		return "", err
+	}
+	sql := `INSERT INTO totp_secrets (email, secret, created_at) VALUES ($1, $2, now())
+		ON CONFLICT (email) DO UPDATE SET secret = EXCLUDED.secret, created_at = now() WHERE totp_secrets.confirmed_at IS NULL`
+	res, err := o.q.Exec(sql, email, secret)
+	if err != nil {
+		return "", err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return "", err
+	} else if n == 0 {
+		return "", sessions.ErrTOTPAlreadyEnrolled
+	}
+	return secret, nil
+}
+
+// ConfirmTOTPEnrollment confirms the pending TOTP secret of the user if code is valid, after which TOTP is required
+// on login, and returns new recovery codes.
+func (o *orm) ConfirmTOTPEnrollment(email, code string) (codes []string, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		var totp sessions.TOTP
+		if err = tx.Get(&totp, "SELECT * FROM totp_secrets WHERE lower(email) = lower($1) AND confirmed_at IS NULL FOR UPDATE", email); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return sessions.ErrNoPendingTOTP
+			}
+			return err
+		}
+		counter, ok := sessions.MatchTOTP(totp.Secret, code, time.Now())
+		if !ok {
+			return sessions.ErrInvalidTOTPCode
+		}
+		if _, err = tx.Exec("UPDATE totp_secrets SET confirmed_at = now(), last_counter = $2 WHERE email = $1", totp.Email, counter); err != nil {
+			return err
+		}
+		codes, err = replaceRecoveryCodes(tx, totp.Email)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	o.auditLogger.Audit(audit.Auth2FAEnrolled, map[string]interface{}{"email": email, "credential": "totp"})
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user, who must have enrolled MFA.
+func (o *orm) RegenerateRecoveryCodes(email string) (codes []string, err error) {
+	enrolled, err := o.HasMFA(email)
+	if err != nil {
+		return nil, err
+	}
+	if !enrolled {
+		return nil, sessions.ErrMFANotEnrolled
+	}
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		codes, err = replaceRecoveryCodes(tx, email)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	o.auditLogger.Audit(audit.AuthRecoveryCodesRegenerated, map[string]interface{}{"email": email})
+	return codes, nil
+}
+
+func replaceRecoveryCodes(tx pg.Queryer, email string) ([]string, error) {
+	codes, err := sessions.NewRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec("DELETE FROM mfa_recovery_codes WHERE lower(email) = lower($1)", email); err != nil {
+		return nil, err
+	}
+	for _, code := range codes {
+		if _, err = tx.Exec("INSERT INTO mfa_recovery_codes (email, hashed_code, created_at) VALUES ($1, $2, now())", email, sessions.HashRecoveryCode(code)); err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+// useRecoveryCode marks the recovery code of the user as used, and returns false if there is no such unused code.
+func (o *orm) useRecoveryCode(email, code string) (bool, error) {
+	res, err := o.q.Exec("UPDATE mfa_recovery_codes SET used_at = now() WHERE lower(email) = lower($1) AND hashed_code = $2 AND used_at IS NULL", email, sessions.HashRecoveryCode(code))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// ListSessionActivity returns the sessions which have not expired, of the user or of all users if email is empty.
+func (o *orm) ListSessionActivity(email string) (activity []sessions.SessionActivity, err error) {
+	sql := `SELECT encode(sha256(convert_to(id, 'UTF8')), 'hex') AS id, email, ip_address, user_agent, last_used, created_at
+FROM sessions WHERE last_used + $1 >= now() AND ($2 = '' OR lower(email) = lower($2))
+ORDER BY last_used DESC`
+	err = o.q.Select(&activity, sql, o.sessionDuration, email)
+	return
+}
+
+// RevokeSession deletes the session identified by its activity ID.
+func (o *orm) RevokeSession(activityID string) error {
+	res, err := o.q.Exec("DELETE FROM sessions WHERE encode(sha256(convert_to(id, 'UTF8')), 'hex') = $1", activityID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sessions.ErrSessionNotFound
+	}
+	return nil
+}
+
+// RevokeUserSessions deletes all the sessions of the user.
+func (o *orm) RevokeUserSessions(email string) error {
+	return o.q.ExecQ("DELETE FROM sessions WHERE lower(email) = lower($1)", email)
+}
+
+// ListAPITokenActivity returns the API tokens of all users, and their last use.
+func (o *orm) ListAPITokenActivity() (activity []sessions.APITokenActivity, err error) {
+	sql := `SELECT users.token_key AS access_key, users.email, a.ip_address, a.user_agent, a.last_used
+FROM users LEFT JOIN api_token_activity a ON a.token_key = users.token_key
+WHERE users.token_key IS NOT NULL ORDER BY a.last_used DESC NULLS LAST, users.email`
+	err = o.q.Select(&activity, sql)
+	return
+}
+
+// RecordAPITokenActivity records a use of the API token. Uses from the same client are recorded at most once a minute.
+func (o *orm) RecordAPITokenActivity(accessKey, ipAddress, userAgent string) error {
+	return o.q.ExecQ(`INSERT INTO api_token_activity (token_key, last_used, ip_address, user_agent) VALUES ($1, now(), $2, $3)
+ON CONFLICT (token_key) DO UPDATE SET last_used = EXCLUDED.last_used, ip_address = EXCLUDED.ip_address, user_agent = EXCLUDED.user_agent
+WHERE api_token_activity.last_used < EXCLUDED.last_used - interval '1 minute'
+OR api_token_activity.ip_address IS DISTINCT FROM EXCLUDED.ip_address OR api_token_activity.user_agent IS DISTINCT FROM EXCLUDED.user_agent`,
+		accessKey, ipAddress, userAgent)
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (o *orm) Sessions(offset, limit int) (sessions []sessions.Session, err error) {
 	sql := `SELECT * FROM sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
@@ -271,6 +271,70 @@ func TestORM_WebAuthn(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestORM_TOTP(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+
+	secret, err := orm.BeginTOTPEnrollment(user.Email)
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := sessions.GenerateTOTP(secret, now)
+	require.NoError(t, err)
+	_, err = orm.ConfirmTOTPEnrollment(user.Email, code)
+	require.NoError(t, err)
+
+	login := func(code string) error {
+		_, err := orm.CreateSession(sessions.SessionRequest{Email: user.Email, Password: cltest.Password, TOTPCode: code})
+		return err
+	}
+
+	// the code used to confirm the enrollment can't be replayed
+	require.Error(t, login(code))
+
+	next, err := sessions.GenerateTOTP(secret, now.Add(30*time.Second))
+	require.NoError(t, err)
+	require.NoError(t, login(next))
+	// nor the code of a login
+	require.Error(t, login(next))
+	// nor the codes of earlier time steps
+	previous, err := sessions.GenerateTOTP(secret, now.Add(-30*time.Second))
+	require.NoError(t, err)
+	require.Error(t, login(previous))
+}
+
+func TestORM_APITokenActivity(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+
+	recorded := func(accessKey string) bool {
+		var exists bool
+		require.NoError(t, db.Get(&exists, "SELECT EXISTS (SELECT 1 FROM api_token_activity WHERE token_key = $1)", accessKey))
+		return exists
+	}
+
+	token, err := orm.CreateAndSetAuthToken(&user)
+	require.NoError(t, err)
+	require.NoError(t, orm.RecordAPITokenActivity(token.AccessKey, "127.0.0.1", "test"))
+	assert.True(t, recorded(token.AccessKey))
+
+	// the activity of a rotated token is deleted
+	rotated, err := orm.CreateAndSetAuthToken(&user)
+	require.NoError(t, err)
+	assert.False(t, recorded(token.AccessKey))
+
+	// so is the activity of a deleted token
+	require.NoError(t, orm.RecordAPITokenActivity(rotated.AccessKey, "127.0.0.1", "test"))
+	assert.True(t, recorded(rotated.AccessKey))
+	require.NoError(t, orm.DeleteAuthToken(&user))
+	assert.False(t, recorded(rotated.AccessKey))
+}
+
 func TestOrm_GenerateAuthToken(t *testing.T) {
 	t.Parallel()
 
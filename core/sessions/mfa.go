@@ -0,0 +1,70 @@
+package sessions
+
+import (
+	"errors"
+)
+
+// ErrTOTPRequired is returned on login when the user has enrolled TOTP, but sent neither a TOTP code nor a recovery
+// code.
+var ErrTOTPRequired = errors.New("MFA Error: a TOTP code or a recovery code is required")
+
+var (
+	ErrTOTPAlreadyEnrolled = errors.New("TOTP is already enrolled")
+	ErrNoPendingTOTP       = errors.New("no pending TOTP enrollment")
+	ErrInvalidTOTPCode     = errors.New("invalid TOTP code")
+	ErrMFANotEnrolled      = errors.New("MFA is not enrolled")
+)
+
+var roleRanks = map[UserRole]int{
+	UserRoleView:  1,
+	UserRoleRun:   2,
+	UserRoleEdit:  3,
+	UserRoleAdmin: 4,
+}
+
+// AtLeast returns true if the role grants all the permissions of other, i.e. admin > edit > run > view.
+func (r UserRole) AtLeast(other UserRole) bool {
+	return roleRanks[r] >= roleRanks[other]
+}
+
+type mfaEnforcingProvider struct {
+	AuthenticationProvider
+	requiredRole UserRole
+}
+
+// NewMFAEnforcingProvider returns an AuthenticationProvider which enforces MFA for the users with requiredRole or a
+// higher one: until such users have enrolled WebAuthn or TOTP, their sessions and API tokens are limited to the view
+// role, which still lets them enroll.
+func NewMFAEnforcingProvider(p AuthenticationProvider, requiredRole UserRole) AuthenticationProvider {
+	return &mfaEnforcingProvider{AuthenticationProvider: p, requiredRole: requiredRole}
+}
+
+func (p *mfaEnforcingProvider) AuthorizedUserWithSession(sessionID string) (User, error) {
+	user, err := p.AuthenticationProvider.AuthorizedUserWithSession(sessionID)
+	if err != nil {
+		return user, err
+	}
+	return p.enforce(user)
+}
+
+func (p *mfaEnforcingProvider) FindUserByAPIToken(apiToken string) (User, error) {
+	user, err := p.AuthenticationProvider.FindUserByAPIToken(apiToken)
+	if err != nil {
+		return user, err
+	}
+	return p.enforce(user)
+}
+
+func (p *mfaEnforcingProvider) enforce(user User) (User, error) {
+	if user.Role == UserRoleView || !user.Role.AtLeast(p.requiredRole) {
+		return user, nil
+	}
+	enrolled, err := p.HasMFA(user.Email)
+	if err != nil {
+		return User{}, err
+	}
+	if !enrolled {
+		user.Role = UserRoleView
+	}
+	return user, nil
+}
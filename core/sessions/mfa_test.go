@@ -0,0 +1,63 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
+)
+
+func TestUserRole_AtLeast(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, sessions.UserRoleAdmin.AtLeast(sessions.UserRoleEdit))
+	assert.True(t, sessions.UserRoleEdit.AtLeast(sessions.UserRoleEdit))
+	assert.True(t, sessions.UserRoleRun.AtLeast(sessions.UserRoleView))
+	assert.False(t, sessions.UserRoleRun.AtLeast(sessions.UserRoleEdit))
+	assert.False(t, sessions.UserRoleView.AtLeast(sessions.UserRoleRun))
+}
+
+func TestMFAEnforcingProvider(t *testing.T) {
+	t.Parallel()
+
+	admin := sessions.User{Email: "admin@example.com", Role: sessions.UserRoleAdmin}
+	runner := sessions.User{Email: "run@example.com", Role: sessions.UserRoleRun}
+
+	t.Run("caps the role until enrolled", func(t *testing.T) {
+		p := mocks.NewAuthenticationProvider(t)
+		p.On("AuthorizedUserWithSession", "session").Return(admin, nil)
+		p.On("HasMFA", admin.Email).Return(false, nil).Once()
+
+		enforcing := sessions.NewMFAEnforcingProvider(p, sessions.UserRoleEdit)
+		user, err := enforcing.AuthorizedUserWithSession("session")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleView, user.Role)
+
+		p.On("HasMFA", admin.Email).Return(true, nil).Once()
+		user, err = enforcing.AuthorizedUserWithSession("session")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+	})
+
+	t.Run("applies to API tokens", func(t *testing.T) {
+		p := mocks.NewAuthenticationProvider(t)
+		p.On("FindUserByAPIToken", "token").Return(admin, nil)
+		p.On("HasMFA", admin.Email).Return(false, nil)
+
+		user, err := sessions.NewMFAEnforcingProvider(p, sessions.UserRoleAdmin).FindUserByAPIToken("token")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleView, user.Role)
+	})
+
+	t.Run("ignores lower roles", func(t *testing.T) {
+		p := mocks.NewAuthenticationProvider(t)
+		p.On("AuthorizedUserWithSession", "session").Return(runner, nil)
+
+		user, err := sessions.NewMFAEnforcingProvider(p, sessions.UserRoleEdit).AuthorizedUserWithSession("session")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleRun, user.Role)
+	})
+}
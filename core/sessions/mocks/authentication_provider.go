@@ -44,6 +44,34 @@ func (_m *AuthenticationProvider) AuthorizedUserWithSession(sessionID string) (s
 	return r0, r1
 }
 
+// BeginTOTPEnrollment provides a mock function with given fields: email
+func (_m *AuthenticationProvider) BeginTOTPEnrollment(email string) (string, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTOTPEnrollment")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClearNonCurrentSessions provides a mock function with given fields: sessionID
 func (_m *AuthenticationProvider) ClearNonCurrentSessions(sessionID string) error {
 	ret := _m.Called(sessionID)
@@ -62,6 +90,36 @@ func (_m *AuthenticationProvider) ClearNonCurrentSessions(sessionID string) erro
 	return r0
 }
 
+// ConfirmTOTPEnrollment provides a mock function with given fields: email, code
+func (_m *AuthenticationProvider) ConfirmTOTPEnrollment(email string, code string) ([]string, error) {
+	ret := _m.Called(email, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmTOTPEnrollment")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]string, error)); ok {
+		return rf(email, code)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = rf(email, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(email, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: user
 func (_m *AuthenticationProvider) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(user)
@@ -308,6 +366,94 @@ func (_m *AuthenticationProvider) GetUserWebAuthn(email string) ([]sessions.WebA
 	return r0, r1
 }
 
+// HasMFA provides a mock function with given fields: email
+func (_m *AuthenticationProvider) HasMFA(email string) (bool, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasMFA")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAPITokenActivity provides a mock function with given fields:
+func (_m *AuthenticationProvider) ListAPITokenActivity() ([]sessions.APITokenActivity, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPITokenActivity")
+	}
+
+	var r0 []sessions.APITokenActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]sessions.APITokenActivity, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []sessions.APITokenActivity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.APITokenActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSessionActivity provides a mock function with given fields: email
+func (_m *AuthenticationProvider) ListSessionActivity(email string) ([]sessions.SessionActivity, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessionActivity")
+	}
+
+	var r0 []sessions.SessionActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]sessions.SessionActivity, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) []sessions.SessionActivity); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.SessionActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListUsers provides a mock function with given fields:
 func (_m *AuthenticationProvider) ListUsers() ([]sessions.User, error) {
 	ret := _m.Called()
@@ -338,6 +484,90 @@ func (_m *AuthenticationProvider) ListUsers() ([]sessions.User, error) {
 	return r0, r1
 }
 
+// RecordAPITokenActivity provides a mock function with given fields: accessKey, ipAddress, userAgent
+func (_m *AuthenticationProvider) RecordAPITokenActivity(accessKey string, ipAddress string, userAgent string) error {
+	ret := _m.Called(accessKey, ipAddress, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAPITokenActivity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(accessKey, ipAddress, userAgent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegenerateRecoveryCodes provides a mock function with given fields: email
+func (_m *AuthenticationProvider) RegenerateRecoveryCodes(email string) ([]string, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateRecoveryCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSession provides a mock function with given fields: activityID
+func (_m *AuthenticationProvider) RevokeSession(activityID string) error {
+	ret := _m.Called(activityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(activityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeUserSessions provides a mock function with given fields: email
+func (_m *AuthenticationProvider) RevokeUserSessions(email string) error {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *AuthenticationProvider) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
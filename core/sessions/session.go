@@ -17,16 +17,23 @@ type SessionRequest struct {
 	Email          string `json:"email"`
 	Password       string `json:"password"`
 	WebAuthnData   string `json:"webauthndata"`
+	TOTPCode       string `json:"totpcode"`
+	RecoveryCode   string `json:"recoverycode"`
 	WebAuthnConfig WebAuthnConfiguration
 	SessionStore   *WebAuthnSessionStore
+	// IPAddress and UserAgent of the client, recorded with the session.
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 // Session holds the unique id for the authenticated session.
 type Session struct {
-	ID        string    `json:"id"`
-	Email     string    `json:"email"`
-	LastUsed  time.Time `json:"lastUsed"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID        string      `json:"id"`
+	Email     string      `json:"email"`
+	LastUsed  time.Time   `json:"lastUsed"`
+	CreatedAt time.Time   `json:"createdAt"`
+	IPAddress null.String `json:"ipAddress"`
+	UserAgent null.String `json:"userAgent"`
 }
 
 // NewSession returns a session instance with ID set to a random ID and
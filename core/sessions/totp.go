@@ -0,0 +1,129 @@
+package sessions
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // TOTP uses HMAC-SHA1 for compatibility with authenticator apps
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPIssuer is the issuer shown by authenticator apps.
+	TOTPIssuer = "Chainlink"
+
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+	totpModulo = 1_000_000 // 10^totpDigits
+	// totpSkew is the number of periods before and after the current one in which codes are accepted, to allow for
+	// clock drift.
+	totpSkew = 1
+
+	// RecoveryCodeCount is the number of recovery codes generated at once.
+	RecoveryCodeCount = 10
+)
+
+// TOTP holds the TOTP secret of a user, which only enforces MFA once confirmed with a valid code.
+type TOTP struct {
+	Email       string
+	Secret      string
+	ConfirmedAt *time.Time
+	CreatedAt   time.Time
+	// LastCounter is the time step of the last accepted code. Codes of this step or earlier ones are rejected, so
+	// that they can't be replayed.
+	LastCounter *int64
+}
+
+// NewTOTPSecret returns a random base32 encoded secret.
+func NewTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
+
+// TOTPURL returns the otpauth URL of the secret, usually scanned as a QR code by authenticator apps.
+func TOTPURL(email, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", TOTPIssuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+	return (&url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + TOTPIssuer + ":" + email, RawQuery: v.Encode()}).String()
+}
+
+// ValidateTOTP returns true if code is valid for the secret at the time now, as per RFC 6238.
+func ValidateTOTP(secret, code string, now time.Time) bool {
+	_, ok := MatchTOTP(secret, code, now)
+	return ok
+}
+
+// MatchTOTP returns the time step of code if it is valid for the secret at the time now, as per RFC 6238.
+func MatchTOTP(secret, code string, now time.Time) (counter int64, ok bool) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for i := int64(-totpSkew); i <= totpSkew; i++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, uint64(current+i))), []byte(code)) == 1 {
+			counter, ok = current+i, true
+		}
+	}
+	return
+}
+
+// GenerateTOTP returns the code of the secret at the time now, as shown by authenticator apps.
+func GenerateTOTP(secret string, now time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return totpCode(key, uint64(now.Unix()/int64(totpPeriod.Seconds()))), nil
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+}
+
+func totpCode(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0xf
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, v%totpModulo)
+}
+
+// NewRecoveryCodes returns RecoveryCodeCount random single use recovery codes, like "a1b2c-3d4e5".
+func NewRecoveryCodes() ([]string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		s := hex.EncodeToString(b)
+		codes[i] = s[:5] + "-" + s[5:]
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the hash of the recovery code stored in the database. Recovery codes are random, so they
+// do not need a slow hash.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(code)), "-", "")
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
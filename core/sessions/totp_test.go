@@ -0,0 +1,77 @@
+package sessions_test
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestValidateTOTP(t *testing.T) {
+	t.Parallel()
+
+	// RFC 6238 test vector for SHA1, truncated to 6 digits
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	now := time.Unix(59, 0)
+
+	assert.True(t, sessions.ValidateTOTP(secret, "287082", now))
+	// one step of clock skew is tolerated
+	assert.True(t, sessions.ValidateTOTP(secret, "287082", now.Add(30*time.Second)))
+	assert.False(t, sessions.ValidateTOTP(secret, "287082", now.Add(90*time.Second)))
+	assert.False(t, sessions.ValidateTOTP(secret, "287083", now))
+	assert.False(t, sessions.ValidateTOTP(secret, "", now))
+	assert.False(t, sessions.ValidateTOTP("not base32!", "287082", now))
+
+	counter, ok := sessions.MatchTOTP(secret, "287082", now.Add(30*time.Second))
+	require.True(t, ok)
+	assert.Equal(t, int64(1), counter)
+	_, ok = sessions.MatchTOTP(secret, "287083", now)
+	assert.False(t, ok)
+
+	code, err := sessions.GenerateTOTP(secret, now)
+	require.NoError(t, err)
+	assert.Equal(t, "287082", code)
+}
+
+func TestNewTOTPSecret(t *testing.T) {
+	t.Parallel()
+
+	secret, err := sessions.NewTOTPSecret()
+	require.NoError(t, err)
+	other, err := sessions.NewTOTPSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+
+	u, err := url.Parse(sessions.TOTPURL("user@example.com", secret))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Chainlink:user@example.com", u.Path)
+	assert.Equal(t, secret, u.Query().Get("secret"))
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	codes, err := sessions.NewRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, sessions.RecoveryCodeCount)
+
+	format := regexp.MustCompile(`^[a-z0-9]{5}-[a-z0-9]{5}$`)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Regexp(t, format, code)
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+
+	// hashes ignore case and dashes, as the codes may be retyped
+	assert.Equal(t, sessions.HashRecoveryCode(codes[0]), sessions.HashRecoveryCode(" "+strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))+" "))
+	assert.NotEqual(t, sessions.HashRecoveryCode(codes[0]), sessions.HashRecoveryCode(codes[1]))
+}
@@ -20,7 +20,9 @@ type User struct {
 	TokenKey          null.String
 	TokenSalt         null.String
 	TokenHashedSecret null.String
-	UpdatedAt         time.Time
+	// Tenant is the tenant of the user, or null if the user is node-wide.
+	Tenant    null.String
+	UpdatedAt time.Time
 }
 
 type UserRole string
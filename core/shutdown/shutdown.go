@@ -1,6 +1,7 @@
 package shutdown
 
 import (
+	"context"
 	"os"
 	ossignal "os/signal"
 	"syscall"
@@ -14,3 +15,19 @@ func HandleShutdown(handleFunc func(sig string)) {
 	sig := <-ch
 	handleFunc(sig.String())
 }
+
+// HandleReload calls handleFunc on every SIGHUP signal, until ctx is done.
+func HandleReload(ctx context.Context, handleFunc func()) {
+	ch := make(chan os.Signal, 1)
+	ossignal.Notify(ch, syscall.SIGHUP)
+	defer ossignal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			handleFunc()
+		}
+	}
+}
@@ -43,3 +43,39 @@ func TestHandleShutdown(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleReload(t *testing.T) {
+	proc, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(testutils.Context(t))
+	defer cancel()
+	reloaded := make(chan struct{}, 2)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		HandleReload(ctx, func() {
+			reloaded <- struct{}{}
+		})
+	}()
+
+	// have to wait for ossignal.Notify
+	time.Sleep(time.Second)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, proc.Signal(syscall.SIGHUP))
+		select {
+		case <-reloaded:
+			// all good
+		case <-time.After(3 * time.Second):
+			require.Fail(t, "reload not handled within 3 seconds")
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		require.Fail(t, "HandleReload did not return within 3 seconds")
+	}
+}
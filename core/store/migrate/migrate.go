@@ -128,6 +128,23 @@ func Current(ctx context.Context, db *sql.DB, lggr logger.Logger) (int64, error)
 	return goose.EnsureDBVersion(db)
 }
 
+// Pending returns true if the database is not migrated to the latest migration yet.
+func Pending(ctx context.Context, db *sql.DB) (bool, error) {
+	current, err := goose.GetDBVersionContext(ctx, db)
+	if err != nil {
+		return false, err
+	}
+	migrations, err := goose.CollectMigrations(MIGRATIONS_DIR, 0, goose.MaxVersion)
+	if err != nil {
+		return false, err
+	}
+	last, err := migrations.Last()
+	if err != nil {
+		return false, err
+	}
+	return last.Version > current, nil
+}
+
 func Status(ctx context.Context, db *sql.DB, lggr logger.Logger) error {
 	if err := ensureMigrated(ctx, db, lggr); err != nil {
 		return err
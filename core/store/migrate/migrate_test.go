@@ -404,9 +404,17 @@ func TestMigrate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(100), ver)
 
+	pending, err := migrate.Pending(ctx, db.DB)
+	require.NoError(t, err)
+	require.True(t, pending)
+
 	err = migrate.Migrate(ctx, db.DB, lggr)
 	require.NoError(t, err)
 
+	pending, err = migrate.Pending(ctx, db.DB)
+	require.NoError(t, err)
+	require.False(t, pending)
+
 	err = migrate.Rollback(ctx, db.DB, lggr, null.IntFrom(99))
 	require.NoError(t, err)
 
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE keeper_specs
+  ADD COLUMN max_gas_price numeric(78, 0),
+  ADD COLUMN upkeep_max_gas_prices jsonb;
+
+-- +goose Down
+ALTER TABLE keeper_specs
+  DROP COLUMN max_gas_price,
+  DROP COLUMN upkeep_max_gas_prices;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE mercury_transmit_requests ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+CREATE INDEX idx_mercury_transmit_requests_job_id_created_at ON mercury_transmit_requests (job_id, created_at);
+
+-- +goose Down
+DROP INDEX idx_mercury_transmit_requests_job_id_created_at;
+ALTER TABLE mercury_transmit_requests DROP COLUMN created_at;
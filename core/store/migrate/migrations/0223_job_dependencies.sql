@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE jobs
+  ADD COLUMN depends_on text[] NOT NULL DEFAULT '{}',
+  ADD COLUMN depends_on_chains text[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE jobs
+  DROP COLUMN depends_on,
+  DROP COLUMN depends_on_chains;
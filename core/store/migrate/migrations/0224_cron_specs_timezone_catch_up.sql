@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE cron_specs
+  ADD COLUMN timezone text NOT NULL DEFAULT '',
+  ADD COLUMN catch_up_policy text NOT NULL DEFAULT 'skip',
+  ADD COLUMN max_catch_up_runs bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE cron_specs
+  DROP COLUMN timezone,
+  DROP COLUMN catch_up_policy,
+  DROP COLUMN max_catch_up_runs;
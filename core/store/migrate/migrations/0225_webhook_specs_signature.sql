@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE webhook_specs
+  ADD COLUMN signature_secret text NOT NULL DEFAULT '',
+  ADD COLUMN signature_header text NOT NULL DEFAULT '',
+  ADD COLUMN signature_algorithm text NOT NULL DEFAULT '',
+  ADD COLUMN timestamp_header text NOT NULL DEFAULT '',
+  ADD COLUMN replay_window bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE webhook_specs
+  DROP COLUMN signature_secret,
+  DROP COLUMN signature_header,
+  DROP COLUMN signature_algorithm,
+  DROP COLUMN timestamp_header,
+  DROP COLUMN replay_window;
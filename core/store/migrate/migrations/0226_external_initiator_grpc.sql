@@ -0,0 +1,28 @@
+-- +goose Up
+ALTER TABLE external_initiators ADD COLUMN grpc boolean NOT NULL DEFAULT false;
+
+CREATE TABLE external_initiator_notifications (
+    id BIGSERIAL PRIMARY KEY,
+    external_initiator_id bigint NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+    action text NOT NULL,
+    job_id uuid NOT NULL,
+    params jsonb,
+    created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_external_initiator_notifications_ei_id ON external_initiator_notifications (external_initiator_id, id);
+
+CREATE TABLE external_initiator_triggers (
+    external_initiator_id bigint NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+    trigger_id text NOT NULL,
+    pipeline_run_id bigint,
+    created_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (external_initiator_id, trigger_id)
+);
+
+CREATE INDEX idx_external_initiator_triggers_created_at ON external_initiator_triggers (created_at);
+
+-- +goose Down
+DROP TABLE external_initiator_triggers;
+DROP TABLE external_initiator_notifications;
+ALTER TABLE external_initiators DROP COLUMN grpc;
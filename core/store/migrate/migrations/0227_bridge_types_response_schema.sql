@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE bridge_types ADD COLUMN response_schema jsonb;
+
+-- +goose Down
+ALTER TABLE bridge_types DROP COLUMN response_schema;
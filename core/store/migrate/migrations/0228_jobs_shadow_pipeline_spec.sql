@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE jobs
+  ADD COLUMN shadow_pipeline_spec_id int REFERENCES pipeline_specs(id) ON DELETE SET NULL DEFERRABLE;
+
+-- +goose Down
+ALTER TABLE jobs
+  DROP COLUMN shadow_pipeline_spec_id;
@@ -0,0 +1,102 @@
+-- +goose Up
+-- evm.logs and pipeline_runs become partitioned tables. The existing tables are attached as their default partitions,
+-- so that no rows are copied, and the partitions are created ahead of time by the node. Rows which do not fall in any
+-- partition, including all the existing rows, stay in the default partitions until they are pruned.
+--
+-- The indexes of the existing tables are renamed, and recreated on the partitioned tables with the same definitions,
+-- so that Postgres attaches them to the partitioned indexes instead of building new ones. The primary keys cannot
+-- include the partition keys without changing their meaning, so they are created on each partition instead.
+
+-- evm.logs is partitioned by chain and block range.
+ALTER TABLE evm.logs RENAME TO logs_default;
+ALTER INDEX IF EXISTS evm.evm_logs_idx RENAME TO evm_logs_default_idx;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_data_word_one RENAME TO evm_logs_default_idx_data_word_one;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_data_word_two RENAME TO evm_logs_default_idx_data_word_two;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_data_word_three RENAME TO evm_logs_default_idx_data_word_three;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_data_word_four RENAME TO evm_logs_default_idx_data_word_four;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_topic_two RENAME TO evm_logs_default_idx_topic_two;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_topic_three RENAME TO evm_logs_default_idx_topic_three;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_topic_four RENAME TO evm_logs_default_idx_topic_four;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_created_at RENAME TO evm_logs_default_idx_created_at;
+ALTER INDEX IF EXISTS evm.evm_logs_idx_tx_hash RENAME TO evm_logs_default_idx_tx_hash;
+ALTER INDEX IF EXISTS evm.idx_evm_logs_ordered_by_block_and_created_at RENAME TO idx_evm_logs_default_ordered_by_block_and_created_at;
+ALTER INDEX IF EXISTS evm.evm_logs_by_timestamp RENAME TO evm_logs_default_by_timestamp;
+
+CREATE TABLE evm.logs (LIKE evm.logs_default INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY RANGE (evm_chain_id, block_number);
+ALTER TABLE evm.logs ATTACH PARTITION evm.logs_default DEFAULT;
+
+CREATE INDEX evm_logs_idx ON evm.logs (evm_chain_id, block_number, address, event_sig);
+CREATE INDEX evm_logs_idx_data_word_one ON evm.logs (substring(data from 1 for 32));
+CREATE INDEX evm_logs_idx_data_word_two ON evm.logs (substring(data from 33 for 32));
+CREATE INDEX evm_logs_idx_data_word_three ON evm.logs (substring(data from 65 for 32));
+CREATE INDEX evm_logs_idx_data_word_four ON evm.logs (substring(data from 97 for 32));
+CREATE INDEX evm_logs_idx_topic_two ON evm.logs ((topics[2]));
+CREATE INDEX evm_logs_idx_topic_three ON evm.logs ((topics[3]));
+CREATE INDEX evm_logs_idx_topic_four ON evm.logs ((topics[4]));
+CREATE INDEX evm_logs_idx_created_at ON evm.logs (created_at);
+CREATE INDEX evm_logs_idx_tx_hash ON evm.logs (tx_hash);
+CREATE INDEX idx_evm_logs_ordered_by_block_and_created_at ON evm.logs (evm_chain_id, address, event_sig, block_number, created_at);
+CREATE INDEX evm_logs_by_timestamp ON evm.logs (evm_chain_id, address, event_sig, block_timestamp, block_number);
+
+-- pipeline_runs is partitioned by creation time. Foreign keys cannot reference it without the partition key, so the
+-- task runs and flux monitor round stats of deleted runs are deleted by the node instead.
+ALTER TABLE pipeline_task_runs DROP CONSTRAINT pipeline_task_runs_pipeline_run_id_fkey;
+ALTER TABLE flux_monitor_round_stats_v2 DROP CONSTRAINT IF EXISTS flux_monitor_round_stats_v2_pipeline_run_id_fkey;
+
+ALTER TABLE pipeline_runs RENAME TO pipeline_runs_default;
+ALTER INDEX IF EXISTS idx_pipeline_runs_created_at RENAME TO idx_pipeline_runs_default_created_at;
+ALTER INDEX IF EXISTS idx_pipeline_runs_finished_at RENAME TO idx_pipeline_runs_default_finished_at;
+ALTER INDEX IF EXISTS idx_pipeline_runs_pipeline_spec_id RENAME TO idx_pipeline_runs_default_pipeline_spec_id;
+ALTER INDEX IF EXISTS idx_pipeline_runs_unfinished_runs RENAME TO idx_pipeline_runs_default_unfinished_runs;
+ALTER INDEX IF EXISTS pipeline_runs_suspended RENAME TO pipeline_runs_default_suspended;
+
+CREATE TABLE pipeline_runs (LIKE pipeline_runs_default INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY RANGE (created_at);
+ALTER SEQUENCE pipeline_runs_id_seq OWNED BY pipeline_runs.id;
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_pipeline_spec_id_fkey
+    FOREIGN KEY (pipeline_spec_id) REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE;
+ALTER TABLE pipeline_runs ATTACH PARTITION pipeline_runs_default DEFAULT;
+
+CREATE INDEX idx_pipeline_runs_created_at ON pipeline_runs USING BTREE (created_at);
+CREATE INDEX idx_pipeline_runs_finished_at ON pipeline_runs USING BTREE (finished_at);
+CREATE INDEX idx_pipeline_runs_pipeline_spec_id ON pipeline_runs USING HASH (pipeline_spec_id);
+CREATE INDEX idx_pipeline_runs_unfinished_runs ON pipeline_runs (id) WHERE finished_at IS NULL;
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+
+-- +goose Down
+-- The rows of the partitions are moved back to the default partitions, which become plain tables again.
+ALTER TABLE pipeline_runs DETACH PARTITION pipeline_runs_default;
+INSERT INTO pipeline_runs_default SELECT * FROM pipeline_runs;
+ALTER SEQUENCE pipeline_runs_id_seq OWNED BY pipeline_runs_default.id;
+DROP TABLE pipeline_runs;
+ALTER TABLE pipeline_runs_default RENAME TO pipeline_runs;
+ALTER INDEX idx_pipeline_runs_default_created_at RENAME TO idx_pipeline_runs_created_at;
+ALTER INDEX idx_pipeline_runs_default_finished_at RENAME TO idx_pipeline_runs_finished_at;
+ALTER INDEX idx_pipeline_runs_default_pipeline_spec_id RENAME TO idx_pipeline_runs_pipeline_spec_id;
+ALTER INDEX idx_pipeline_runs_default_unfinished_runs RENAME TO idx_pipeline_runs_unfinished_runs;
+ALTER INDEX pipeline_runs_default_suspended RENAME TO pipeline_runs_suspended;
+
+DELETE FROM pipeline_task_runs WHERE pipeline_run_id NOT IN (SELECT id FROM pipeline_runs);
+ALTER TABLE pipeline_task_runs ADD CONSTRAINT pipeline_task_runs_pipeline_run_id_fkey
+    FOREIGN KEY (pipeline_run_id) REFERENCES pipeline_runs (id) ON DELETE CASCADE DEFERRABLE;
+DELETE FROM flux_monitor_round_stats_v2 WHERE pipeline_run_id NOT IN (SELECT id FROM pipeline_runs);
+ALTER TABLE flux_monitor_round_stats_v2 ADD CONSTRAINT flux_monitor_round_stats_v2_pipeline_run_id_fkey
+    FOREIGN KEY (pipeline_run_id) REFERENCES pipeline_runs (id) ON DELETE CASCADE;
+
+ALTER TABLE evm.logs DETACH PARTITION evm.logs_default;
+INSERT INTO evm.logs_default SELECT * FROM evm.logs;
+DROP TABLE evm.logs;
+ALTER TABLE evm.logs_default RENAME TO logs;
+ALTER INDEX evm.evm_logs_default_idx RENAME TO evm_logs_idx;
+ALTER INDEX evm.evm_logs_default_idx_data_word_one RENAME TO evm_logs_idx_data_word_one;
+ALTER INDEX evm.evm_logs_default_idx_data_word_two RENAME TO evm_logs_idx_data_word_two;
+ALTER INDEX evm.evm_logs_default_idx_data_word_three RENAME TO evm_logs_idx_data_word_three;
+ALTER INDEX evm.evm_logs_default_idx_data_word_four RENAME TO evm_logs_idx_data_word_four;
+ALTER INDEX evm.evm_logs_default_idx_topic_two RENAME TO evm_logs_idx_topic_two;
+ALTER INDEX evm.evm_logs_default_idx_topic_three RENAME TO evm_logs_idx_topic_three;
+ALTER INDEX evm.evm_logs_default_idx_topic_four RENAME TO evm_logs_idx_topic_four;
+ALTER INDEX evm.evm_logs_default_idx_created_at RENAME TO evm_logs_idx_created_at;
+ALTER INDEX evm.evm_logs_default_idx_tx_hash RENAME TO evm_logs_idx_tx_hash;
+ALTER INDEX evm.idx_evm_logs_default_ordered_by_block_and_created_at RENAME TO idx_evm_logs_ordered_by_block_and_created_at;
+ALTER INDEX evm.evm_logs_default_by_timestamp RENAME TO evm_logs_by_timestamp;
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Users, jobs, bridges and keys may belong to a tenant. Users of a tenant only have access to the resources of their
+-- tenant, while resources and users which belong to no tenant are node-wide.
+CREATE TABLE tenants (
+    name text PRIMARY KEY CHECK (name ~ '^[a-z0-9][a-z0-9_-]{0,62}$'),
+    created_at timestamp with time zone NOT NULL
+);
+
+ALTER TABLE users ADD COLUMN tenant text REFERENCES tenants (name) DEFERRABLE;
+ALTER TABLE jobs ADD COLUMN tenant text REFERENCES tenants (name) DEFERRABLE;
+ALTER TABLE bridge_types ADD COLUMN tenant text REFERENCES tenants (name) DEFERRABLE;
+CREATE INDEX idx_jobs_tenant ON jobs (tenant) WHERE tenant IS NOT NULL;
+CREATE INDEX idx_bridge_types_tenant ON bridge_types (tenant) WHERE tenant IS NOT NULL;
+
+-- Keys are stored in the encrypted key ring, so their tenants are kept separately, by key type and ID.
+CREATE TABLE tenant_keys (
+    key_type text NOT NULL,
+    key_id text NOT NULL,
+    tenant text NOT NULL REFERENCES tenants (name) ON DELETE CASCADE DEFERRABLE,
+    created_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (key_type, key_id)
+);
+CREATE INDEX idx_tenant_keys_tenant ON tenant_keys (tenant);
+
+-- +goose Down
+DROP TABLE tenant_keys;
+ALTER TABLE bridge_types DROP COLUMN tenant;
+ALTER TABLE jobs DROP COLUMN tenant;
+ALTER TABLE users DROP COLUMN tenant;
+DROP TABLE tenants;
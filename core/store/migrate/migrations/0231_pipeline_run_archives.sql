@@ -0,0 +1,15 @@
+-- +goose Up
+-- Pipeline runs which are archived to an object store before they are pruned. Each row is an object with a batch of
+-- runs, so that archived runs can be found by ID without listing the store.
+CREATE TABLE pipeline_run_archives (
+    id bigserial PRIMARY KEY,
+    object_key text NOT NULL UNIQUE,
+    min_run_id bigint NOT NULL,
+    max_run_id bigint NOT NULL,
+    run_count bigint NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+CREATE INDEX idx_pipeline_run_archives_run_ids ON pipeline_run_archives (min_run_id, max_run_id);
+
+-- +goose Down
+DROP TABLE pipeline_run_archives;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Filters with a higher priority are backfilled first, when many filters are registered at once.
+ALTER TABLE evm.log_poller_filters ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE evm.log_poller_filters DROP COLUMN priority;
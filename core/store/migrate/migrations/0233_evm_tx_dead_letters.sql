@@ -0,0 +1,10 @@
+-- +goose Up
+-- Fatally errored transactions are dead-lettered, so that they are kept by the reaper until they are requeued.
+CREATE TABLE evm.tx_dead_letters (
+    tx_id BIGINT PRIMARY KEY REFERENCES evm.txes (id) ON DELETE CASCADE,
+    classification TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +goose Down
+DROP TABLE evm.tx_dead_letters;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- The versions of a job, identified by its external job ID. A staged version is validated but not running yet, until
+-- it is switched to and becomes the live version. Versions are not removed with their job, since switching versions
+-- replaces the job.
+CREATE TABLE job_versions (
+    id bigserial PRIMARY KEY,
+    external_job_id uuid NOT NULL,
+    version int NOT NULL,
+    definition text NOT NULL,
+    status text NOT NULL CHECK (status IN ('staged', 'live', 'retired')),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    UNIQUE (external_job_id, version)
+);
+CREATE UNIQUE INDEX idx_job_versions_live ON job_versions (external_job_id) WHERE status = 'live';
+CREATE UNIQUE INDEX idx_job_versions_staged ON job_versions (external_job_id) WHERE status = 'staged';
+
+-- +goose Down
+DROP TABLE job_versions;
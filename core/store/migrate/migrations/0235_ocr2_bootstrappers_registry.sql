@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE ocr2_oracle_specs ADD COLUMN p2pv2_bootstrappers_registry text, ADD COLUMN p2pv2_bootstrappers_refresh_interval bigint;
+
+-- +goose Down
+ALTER TABLE ocr2_oracle_specs DROP COLUMN p2pv2_bootstrappers_registry, DROP COLUMN p2pv2_bootstrappers_refresh_interval;
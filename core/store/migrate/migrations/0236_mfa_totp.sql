@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE totp_secrets (
+    "email" text PRIMARY KEY,
+    "secret" text NOT NULL,
+    "confirmed_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    CONSTRAINT fk_email
+        FOREIGN KEY(email)
+        REFERENCES users(email)
+        ON DELETE CASCADE
+);
+
+CREATE TABLE mfa_recovery_codes (
+    "id" BIGSERIAL PRIMARY KEY,
+    "email" text NOT NULL,
+    "hashed_code" text NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    CONSTRAINT fk_email
+        FOREIGN KEY(email)
+        REFERENCES users(email)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX mfa_recovery_codes_email_idx ON mfa_recovery_codes (lower(email));
+
+-- +goose Down
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS totp_secrets;
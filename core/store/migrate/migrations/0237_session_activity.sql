@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE sessions ADD COLUMN ip_address text, ADD COLUMN user_agent text;
+ALTER TABLE ldap_sessions ADD COLUMN ip_address text, ADD COLUMN user_agent text;
+
+CREATE TABLE api_token_activity (
+    "token_key" text PRIMARY KEY,
+    "last_used" timestamptz NOT NULL,
+    "ip_address" text,
+    "user_agent" text
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_token_activity;
+ALTER TABLE ldap_sessions DROP COLUMN ip_address, DROP COLUMN user_agent;
+ALTER TABLE sessions DROP COLUMN ip_address, DROP COLUMN user_agent;
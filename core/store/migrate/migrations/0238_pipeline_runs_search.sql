@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE INDEX idx_pipeline_runs_all_errors_search ON pipeline_runs USING GIN (jsonb_to_tsvector('simple', all_errors, '["string"]'));
+CREATE INDEX idx_pipeline_runs_duration ON pipeline_runs ((finished_at - created_at)) WHERE finished_at IS NOT NULL;
+CREATE INDEX idx_pipeline_task_runs_type ON pipeline_task_runs (type, pipeline_run_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_pipeline_task_runs_type;
+DROP INDEX IF EXISTS idx_pipeline_runs_duration;
+DROP INDEX IF EXISTS idx_pipeline_runs_all_errors_search;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- pipeline_runs is partitioned, so replays cannot reference the replayed run with a foreign key, and
+-- pipeline.DeleteRunsQuery clears replay_of_run_id of replays of deleted runs instead.
+ALTER TABLE pipeline_runs ADD COLUMN replay_of_run_id bigint;
+CREATE INDEX idx_pipeline_runs_replay_of_run_id ON pipeline_runs (replay_of_run_id) WHERE replay_of_run_id IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE pipeline_runs DROP COLUMN replay_of_run_id;
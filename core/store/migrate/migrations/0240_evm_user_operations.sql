@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE evm.user_operations (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    hash BYTEA NOT NULL,
+    sender BYTEA NOT NULL,
+    owner BYTEA NOT NULL,
+    to_address BYTEA NOT NULL,
+    value NUMERIC(78,0) NOT NULL,
+    call_data BYTEA NOT NULL,
+    nonce NUMERIC(78,0) NOT NULL,
+    idempotency_key TEXT,
+    state TEXT NOT NULL,
+    tx_hash BYTEA,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_user_operations_state CHECK (state IN ('pending', 'included', 'failed')),
+    CONSTRAINT chk_user_operations_hash_length CHECK (octet_length(hash) = 32),
+    CONSTRAINT chk_user_operations_sender_length CHECK (octet_length(sender) = 20)
+);
+CREATE UNIQUE INDEX idx_user_operations_hash ON evm.user_operations (evm_chain_id, hash);
+CREATE UNIQUE INDEX idx_user_operations_idempotency_key ON evm.user_operations (idempotency_key) WHERE idempotency_key IS NOT NULL;
+CREATE INDEX idx_user_operations_pending ON evm.user_operations (evm_chain_id, sender) WHERE state = 'pending';
+
+-- +goose Down
+DROP TABLE evm.user_operations;
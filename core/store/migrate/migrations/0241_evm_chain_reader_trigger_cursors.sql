@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE evm.chain_reader_trigger_cursors (
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    trigger_id TEXT NOT NULL,
+    block_number BIGINT NOT NULL,
+    log_index BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, trigger_id)
+);
+
+-- +goose Down
+DROP TABLE evm.chain_reader_trigger_cursors;
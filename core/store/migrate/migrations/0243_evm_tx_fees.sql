@@ -0,0 +1,21 @@
+-- +goose Up
+-- evm.tx_fees is the ledger of the fees paid by the transactions of the node. It is kept after the transactions and
+-- their receipts are reaped, so that the fees can be reported over any date range.
+CREATE TABLE evm.tx_fees (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    receipt_id BIGINT NOT NULL,
+    tx_hash BYTEA NOT NULL,
+    from_address BYTEA NOT NULL,
+    job_id INTEGER,
+    block_number BIGINT NOT NULL,
+    gas_used BIGINT NOT NULL,
+    gas_price NUMERIC(78,0) NOT NULL,
+    fee NUMERIC(78,0) NOT NULL,
+    confirmed_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (evm_chain_id, tx_hash)
+);
+CREATE INDEX idx_evm_tx_fees_confirmed_at ON evm.tx_fees (confirmed_at);
+
+-- +goose Down
+DROP TABLE evm.tx_fees;
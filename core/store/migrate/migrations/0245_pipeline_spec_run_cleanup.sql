@@ -0,0 +1,30 @@
+-- +goose Up
+-- Runs are deleted with their pipeline specs by the cascade of pipeline_runs_pipeline_spec_id_fkey, which bypasses
+-- pipeline.DeleteRunsQuery. Since pipeline_runs is partitioned and cannot be referenced by foreign keys, the task runs
+-- and flux monitor round stats of the runs of deleted pipeline specs are deleted by this trigger instead, and the
+-- replays of those runs no longer refer to them.
+-- +goose StatementBegin
+CREATE FUNCTION delete_pipeline_spec_runs() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+	BEGIN
+		DELETE FROM pipeline_task_runs WHERE pipeline_run_id IN (SELECT id FROM pipeline_runs WHERE pipeline_spec_id = OLD.id);
+		DELETE FROM flux_monitor_round_stats_v2 WHERE pipeline_run_id IN (SELECT id FROM pipeline_runs WHERE pipeline_spec_id = OLD.id);
+		UPDATE pipeline_runs SET replay_of_run_id = NULL
+		WHERE replay_of_run_id IN (SELECT id FROM pipeline_runs WHERE pipeline_spec_id = OLD.id) AND pipeline_spec_id <> OLD.id;
+		RETURN OLD;
+	END
+	$$;
+CREATE TRIGGER delete_pipeline_spec_runs BEFORE DELETE ON pipeline_specs FOR EACH ROW EXECUTE PROCEDURE delete_pipeline_spec_runs();
+-- +goose StatementEnd
+
+-- Task runs and round stats which were orphaned before.
+DELETE FROM pipeline_task_runs WHERE NOT EXISTS (SELECT 1 FROM pipeline_runs WHERE pipeline_runs.id = pipeline_task_runs.pipeline_run_id);
+DELETE FROM flux_monitor_round_stats_v2 WHERE pipeline_run_id IS NOT NULL
+    AND NOT EXISTS (SELECT 1 FROM pipeline_runs WHERE pipeline_runs.id = flux_monitor_round_stats_v2.pipeline_run_id);
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS delete_pipeline_spec_runs ON pipeline_specs;
+DROP FUNCTION IF EXISTS delete_pipeline_spec_runs();
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- evm.node_capabilities holds the capability profile last detected for each RPC node, so that subsystems can adapt to
+-- the nodes before they are reachable again after a restart.
+CREATE TABLE evm.node_capabilities (
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    node_name TEXT NOT NULL,
+    node_chain_id NUMERIC(78,0) NOT NULL,
+    finality_tag BOOLEAN NOT NULL,
+    dynamic_fees BOOLEAN NOT NULL,
+    max_logs_block_range BIGINT NOT NULL,
+    debug BOOLEAN NOT NULL,
+    trace BOOLEAN NOT NULL,
+    txpool BOOLEAN NOT NULL,
+    probed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, node_name)
+);
+
+-- +goose Down
+DROP TABLE evm.node_capabilities;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- last_counter is the TOTP time step of the last code accepted for the user, so that codes can't be replayed.
+ALTER TABLE totp_secrets ADD COLUMN last_counter bigint;
+
+-- +goose Down
+ALTER TABLE totp_secrets DROP COLUMN last_counter;
@@ -0,0 +1,99 @@
+package tenancy
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+
+// ORM manages tenants, and the tenants of keys. The tenants of users, jobs and bridges are managed by their own ORMs.
+type ORM interface {
+	CreateTenant(name string) (Tenant, error)
+	FindTenant(name string) (Tenant, error)
+	Tenants() ([]Tenant, error)
+	// DeleteTenant deletes a tenant, and unassigns its keys. It fails while users, jobs or bridges belong to it.
+	DeleteTenant(name string) error
+
+	// AssignKey assigns a key to a tenant, or reassigns it if it already belongs to another tenant.
+	AssignKey(keyType KeyType, keyID, tenant string) error
+	// UnassignKey makes a key node-wide.
+	UnassignKey(keyType KeyType, keyID string) error
+	// KeyTenant returns the tenant of a key, which is null if the key is node-wide.
+	KeyTenant(keyType KeyType, keyID string) (null.String, error)
+	// TenantKeyIDs returns the IDs of the keys of a type which belong to a tenant.
+	TenantKeyIDs(keyType KeyType, tenant string) ([]string, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{q: pg.NewQ(db, lggr.Named("TenancyORM"), cfg)}
+}
+
+func (o *orm) CreateTenant(name string) (t Tenant, err error) {
+	if err = ValidateName(name); err != nil {
+		return
+	}
+	err = o.q.Get(&t, `INSERT INTO tenants (name, created_at) VALUES ($1, now()) RETURNING *`, name)
+	return t, errors.Wrap(err, "CreateTenant failed")
+}
+
+func (o *orm) FindTenant(name string) (t Tenant, err error) {
+	err = o.q.Get(&t, `SELECT * FROM tenants WHERE name = $1`, name)
+	return
+}
+
+func (o *orm) Tenants() (ts []Tenant, err error) {
+	err = o.q.Select(&ts, `SELECT * FROM tenants ORDER BY name ASC`)
+	return
+}
+
+func (o *orm) DeleteTenant(name string) error {
+	res, err := o.q.Exec(`DELETE FROM tenants WHERE name = $1`, name)
+	if err != nil {
+		return errors.Wrap(err, "DeleteTenant failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "DeleteTenant failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrapf(sql.ErrNoRows, "tenant %s not found", name)
+	}
+	return nil
+}
+
+func (o *orm) AssignKey(keyType KeyType, keyID, tenant string) error {
+	_, err := o.q.Exec(`INSERT INTO tenant_keys (key_type, key_id, tenant, created_at) VALUES ($1, $2, $3, now())
+ON CONFLICT (key_type, key_id) DO UPDATE SET tenant = EXCLUDED.tenant`, keyType, keyID, tenant)
+	return errors.Wrap(err, "AssignKey failed")
+}
+
+func (o *orm) UnassignKey(keyType KeyType, keyID string) error {
+	_, err := o.q.Exec(`DELETE FROM tenant_keys WHERE key_type = $1 AND key_id = $2`, keyType, keyID)
+	return errors.Wrap(err, "UnassignKey failed")
+}
+
+func (o *orm) KeyTenant(keyType KeyType, keyID string) (tenant null.String, err error) {
+	err = o.q.Get(&tenant, `SELECT tenant FROM tenant_keys WHERE key_type = $1 AND key_id = $2`, keyType, keyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return null.String{}, nil
+	}
+	return tenant, errors.Wrap(err, "KeyTenant failed")
+}
+
+func (o *orm) TenantKeyIDs(keyType KeyType, tenant string) (ids []string, err error) {
+	err = o.q.Select(&ids, `SELECT key_id FROM tenant_keys WHERE key_type = $1 AND tenant = $2 ORDER BY key_id`, keyType, tenant)
+	return ids, errors.Wrap(err, "TenantKeyIDs failed")
+}
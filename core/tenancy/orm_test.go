@@ -0,0 +1,88 @@
+package tenancy_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/tenancy"
+)
+
+func setupORM(t *testing.T) tenancy.ORM {
+	t.Helper()
+
+	cfg := configtest.NewGeneralConfig(t, nil)
+	db := pgtest.NewSqlxDB(t)
+	return tenancy.NewORM(db, logger.TestLogger(t), cfg.Database())
+}
+
+func TestORM_Tenants(t *testing.T) {
+	t.Parallel()
+	orm := setupORM(t)
+
+	_, err := orm.CreateTenant("Team A")
+	require.Error(t, err)
+
+	created, err := orm.CreateTenant("team-a")
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", created.Name)
+	_, err = orm.CreateTenant("team-b")
+	require.NoError(t, err)
+
+	found, err := orm.FindTenant("team-a")
+	require.NoError(t, err)
+	assert.Equal(t, created.Name, found.Name)
+
+	tenants, err := orm.Tenants()
+	require.NoError(t, err)
+	require.Len(t, tenants, 2)
+	assert.Equal(t, "team-b", tenants[1].Name)
+
+	require.NoError(t, orm.DeleteTenant("team-b"))
+	require.ErrorIs(t, orm.DeleteTenant("team-b"), sql.ErrNoRows)
+	_, err = orm.FindTenant("team-b")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_Keys(t *testing.T) {
+	t.Parallel()
+	orm := setupORM(t)
+
+	_, err := orm.CreateTenant("team-a")
+	require.NoError(t, err)
+	_, err = orm.CreateTenant("team-b")
+	require.NoError(t, err)
+
+	tenant, err := orm.KeyTenant(tenancy.KeyTypeP2P, "peer1")
+	require.NoError(t, err)
+	assert.False(t, tenant.Valid)
+
+	require.NoError(t, orm.AssignKey(tenancy.KeyTypeP2P, "peer1", "team-a"))
+	require.NoError(t, orm.AssignKey(tenancy.KeyTypeP2P, "peer2", "team-a"))
+	require.NoError(t, orm.AssignKey(tenancy.KeyTypeOCR, "bundle1", "team-a"))
+	ids, err := orm.TenantKeyIDs(tenancy.KeyTypeP2P, "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"peer1", "peer2"}, ids)
+
+	require.NoError(t, orm.AssignKey(tenancy.KeyTypeP2P, "peer1", "team-b"))
+	tenant, err = orm.KeyTenant(tenancy.KeyTypeP2P, "peer1")
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("team-b"), tenant)
+
+	require.NoError(t, orm.UnassignKey(tenancy.KeyTypeP2P, "peer2"))
+	ids, err = orm.TenantKeyIDs(tenancy.KeyTypeP2P, "team-a")
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// Deleting a tenant makes its keys node-wide.
+	require.NoError(t, orm.DeleteTenant("team-a"))
+	tenant, err = orm.KeyTenant(tenancy.KeyTypeOCR, "bundle1")
+	require.NoError(t, err)
+	assert.False(t, tenant.Valid)
+}
@@ -0,0 +1,69 @@
+// Package tenancy partitions the jobs, bridges, keys and API users of a node between tenants.
+//
+// Resources and users which belong to no tenant are node-wide: node-wide users have access to the resources of every
+// tenant, while the users of a tenant only have access to the resources of their own tenant.
+package tenancy
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+// Tenant is a named owner of jobs, bridges, keys and API users.
+type Tenant struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+var nameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// ValidateName returns an error if name is not a valid tenant name: up to 63 lowercase letters, digits, dashes and
+// underscores, starting with a letter or digit.
+func ValidateName(name string) error {
+	if !nameRegexp.MatchString(name) {
+		return errors.Errorf("invalid tenant name %q: must be up to 63 lowercase letters, digits, dashes and underscores, starting with a letter or digit", name)
+	}
+	return nil
+}
+
+// KeyType is the type of a key which can be assigned to a tenant.
+type KeyType string
+
+const (
+	KeyTypeCSA    KeyType = "csa"
+	KeyTypeEth    KeyType = "eth"
+	KeyTypeOCR    KeyType = "ocr"
+	KeyTypeOCR2   KeyType = "ocr2"
+	KeyTypeP2P    KeyType = "p2p"
+	KeyTypeSolana KeyType = "solana"
+	KeyTypeVRF    KeyType = "vrf"
+)
+
+// ParseKeyType parses a KeyType.
+func ParseKeyType(s string) (KeyType, error) {
+	switch t := KeyType(s); t {
+	case KeyTypeCSA, KeyTypeEth, KeyTypeOCR, KeyTypeOCR2, KeyTypeP2P, KeyTypeSolana, KeyTypeVRF:
+		return t, nil
+	default:
+		return "", errors.Errorf("unknown key type %q", s)
+	}
+}
+
+// ErrForbidden is returned when a user accesses a resource of another tenant.
+type ErrForbidden struct {
+	Resource string
+}
+
+func (e ErrForbidden) Error() string {
+	return fmt.Sprintf("%s belongs to another tenant", e.Resource)
+}
+
+// CanAccess returns true if a user of userTenant has access to a resource of resourceTenant. Node-wide users have
+// access to every resource, while the users of a tenant only have access to the resources of their tenant.
+func CanAccess(userTenant, resourceTenant null.String) bool {
+	return !userTenant.Valid || userTenant == resourceTenant
+}
@@ -0,0 +1,44 @@
+package tenancy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/tenancy"
+)
+
+func TestValidateName(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"team-a", "a", "0_ops", "abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghij123"} {
+		assert.NoError(t, tenancy.ValidateName(name), name)
+	}
+	for _, name := range []string{"", "Team-A", "-team", "team a", "abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghij1234"} {
+		assert.Error(t, tenancy.ValidateName(name), name)
+	}
+}
+
+func TestParseKeyType(t *testing.T) {
+	t.Parallel()
+
+	kt, err := tenancy.ParseKeyType("ocr2")
+	assert.NoError(t, err)
+	assert.Equal(t, tenancy.KeyTypeOCR2, kt)
+
+	_, err = tenancy.ParseKeyType("starknet")
+	assert.Error(t, err)
+}
+
+func TestCanAccess(t *testing.T) {
+	t.Parallel()
+
+	teamA, teamB := null.StringFrom("team-a"), null.StringFrom("team-b")
+
+	assert.True(t, tenancy.CanAccess(null.String{}, null.String{}))
+	assert.True(t, tenancy.CanAccess(null.String{}, teamA))
+	assert.True(t, tenancy.CanAccess(teamA, teamA))
+	assert.False(t, tenancy.CanAccess(teamA, teamB))
+	assert.False(t, tenancy.CanAccess(teamA, null.String{}))
+}
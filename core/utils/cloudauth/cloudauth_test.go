@@ -0,0 +1,65 @@
+package cloudauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignV4(t *testing.T) {
+	t.Parallel()
+
+	// Example from https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+	SignV4(req, nil, AWSCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}, "us-east-1", "iam", now)
+
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date, Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7",
+		req.Header.Get("Authorization"))
+}
+
+func TestGCPTokenSource(t *testing.T) {
+	t.Parallel()
+
+	var tokens int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		tokens++
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "expires_in": 3600})
+	}))
+	t.Cleanup(srv.Close)
+
+	g := NewGCPTokenSource(srv.Client(), "", strings.TrimPrefix(srv.URL, "http://"))
+	assert.False(t, g.Static())
+	for i := 0; i < 2; i++ {
+		token, err := g.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token", token)
+	}
+	assert.Equal(t, 1, tokens, "token is cached")
+
+	g.Invalidate()
+	_, err := g.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, tokens)
+
+	static := NewGCPTokenSource(srv.Client(), "static", "")
+	assert.True(t, static.Static())
+	token, err := static.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "static", token)
+}
@@ -0,0 +1,92 @@
+package cloudauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// GCPTokenSource returns OAuth access tokens for Google Cloud APIs.
+type GCPTokenSource struct {
+	client *http.Client
+	// staticToken is used instead of the tokens of the metadata server, if set.
+	staticToken  string
+	metadataHost string
+	now          func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewGCPTokenSource returns a GCPTokenSource which returns staticToken if set, or else the tokens of the service
+// account of the instance, from the metadata server at metadataHost.
+func NewGCPTokenSource(client *http.Client, staticToken, metadataHost string) *GCPTokenSource {
+	return &GCPTokenSource{client: client, staticToken: staticToken, metadataHost: metadataHost, now: time.Now}
+}
+
+// NewGCPTokenSourceFromEnv returns a GCPTokenSource which returns the GOOGLE_OAUTH_ACCESS_TOKEN environment variable
+// if set, or else the tokens of the metadata server at GCE_METADATA_HOST.
+func NewGCPTokenSourceFromEnv(client *http.Client) *GCPTokenSource {
+	metadataHost := os.Getenv("GCE_METADATA_HOST")
+	if metadataHost == "" {
+		metadataHost = "metadata.google.internal"
+	}
+	return NewGCPTokenSource(client, os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"), metadataHost)
+}
+
+// Static returns true if the token is static, so retrying with a new one is pointless.
+func (g *GCPTokenSource) Static() bool {
+	return g.staticToken != ""
+}
+
+// Invalidate drops the cached token, e.g. because it was revoked, so the next call to Token gets a new one.
+func (g *GCPTokenSource) Invalidate() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.token = ""
+}
+
+// Token returns the static token, or else a cached token of the metadata server.
+func (g *GCPTokenSource) Token(ctx context.Context) (string, error) {
+	if g.staticToken != "" {
+		return g.staticToken, nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.token != "" && g.now().Before(g.expires) {
+		return g.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+g.metadataHost+"/computeMetadata/v1/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata server responded with %s", resp.Status)
+	}
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode metadata server response: %w", err)
+	}
+	if body.AccessToken == "" {
+		return "", errors.New("metadata server returned no access token")
+	}
+	// Refresh a minute early, so the token does not expire in flight.
+	g.token, g.expires = body.AccessToken, g.now().Add(time.Duration(body.ExpiresIn)*time.Second-time.Minute)
+	return g.token, nil
+}
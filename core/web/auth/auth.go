@@ -41,6 +41,7 @@ type Authenticator interface {
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(email string) (clsessions.User, error)
 	FindUserByAPIToken(apiToken string) (clsessions.User, error)
+	RecordAPITokenActivity(accessKey, ipAddress, userAgent string) error
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -103,6 +104,11 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		return auth.ErrorAuthFailed
 	}
 
+	// Recording the activity is best effort, and must not lock users out
+	if err = authr.RecordAPITokenActivity(token.AccessKey, c.ClientIP(), c.Request.UserAgent()); err != nil {
+		_ = c.Error(errors.Wrap(err, "failed to record API token activity"))
+	}
+
 	c.Set(SessionUserKey, &user)
 
 	return nil
@@ -249,3 +255,20 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 		handler(c)
 	}
 }
+
+// RequiresNodeUser is middleware which asserts that the user belongs to no tenant. The REST API is not partitioned
+// between tenants, so the users of a tenant may only use the GraphQL API, apart from managing their own credentials.
+func RequiresNodeUser(c *gin.Context) {
+	user, ok := GetAuthenticatedUser(c)
+	if !ok {
+		c.Abort()
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+		return
+	}
+	if user.Tenant.Valid {
+		c.Abort()
+		jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden: the REST API is not available to the users of a tenant"))
+		return
+	}
+	c.Next()
+}
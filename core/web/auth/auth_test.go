@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -48,7 +49,8 @@ func (u userFindFailer) FindUserByAPIToken(token string) (sessions.User, error)
 
 type userFindSuccesser struct {
 	sessions.AuthenticationProvider
-	user sessions.User
+	user      sessions.User
+	recordErr error
 }
 
 func (u userFindSuccesser) FindUser(email string) (sessions.User, error) {
@@ -59,6 +61,10 @@ func (u userFindSuccesser) FindUserByAPIToken(token string) (sessions.User, erro
 	return u.user, nil
 }
 
+func (u userFindSuccesser) RecordAPITokenActivity(accessKey, ipAddress, userAgent string) error {
+	return u.recordErr
+}
+
 func TestAuthenticateByToken_Success(t *testing.T) {
 	user := cltest.MustRandomUser(t)
 	key, secret := uuid.New().String(), uuid.New().String()
@@ -85,6 +91,32 @@ func TestAuthenticateByToken_Success(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
 }
 
+func TestAuthenticateByToken_ActivityNotRecorded(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	key, secret := uuid.New().String(), uuid.New().String()
+	apiToken := auth.Token{AccessKey: key, Secret: secret}
+	err := user.SetAuthToken(&apiToken)
+	require.NoError(t, err)
+	authr := userFindSuccesser{user: user, recordErr: errors.New("database is down")}
+
+	called := false
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+	router.GET("/", func(c *gin.Context) {
+		called = true
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req := mustRequest(t, "GET", "/", nil)
+	req.Header.Set(webauth.APIKey, key)
+	req.Header.Set(webauth.APISecret, secret)
+	router.ServeHTTP(w, req)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
+}
+
 func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	authr := userFindFailer{err: auth.ErrorAuthFailed}
 
@@ -295,9 +327,11 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
 	{"DELETE", "/v2/jobs/MOCK", false, false, true},
+	{"POST", "/v2/jobs/MOCK/migrate-ocr", false, false, true},
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
+	{"POST", "/v2/jobs/MOCK/runs/MOCK/replay", false, false, true},
 	{"GET", "/v2/features", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
 	{"GET", "/v2/log", true, true, true},
@@ -317,6 +351,12 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/nodes/evm/forwarders", true, true, true},
 	{"POST", "/v2/nodes/evm/forwarders/track", false, false, true},
 	{"DELETE", "/v2/nodes/evm/forwarders/MOCK", false, false, true},
+	{"GET", "/v2/vrf/requests", true, true, true},
+	{"POST", "/v2/vrf/requests/MOCK/fulfill", false, false, true},
+	{"POST", "/v2/functions/secrets/rotate", false, false, true},
+	{"POST", "/v2/functions/requests/replay", false, false, false},
+	{"POST", "/v2/upkeeps/MOCK/simulate", true, true, true},
+	{"POST", "/v2/mercury/reports/verify", true, true, true},
 	{"GET", "/v2/build_info", true, true, true},
 	{"GET", "/v2/ping", true, true, true},
 	{"POST", "/v2/jobs/MOCK/runs", false, true, true},
@@ -326,6 +366,35 @@ var routesRolesMap = [...]routeRules{
 // because hitting the handler are not mocked and will crash as expected
 // Iterate over the above routesRolesMap and assert each path is wrapped and
 // the user role is enforced with the correct middleware
+func TestRequiresNodeUser(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		tenant null.String
+		code   int
+	}{
+		{"node-wide", null.String{}, http.StatusOK},
+		{"tenant", null.StringFrom("team-a"), http.StatusForbidden},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set(webauth.SessionUserKey, &sessions.User{Email: "user@example.com", Tenant: tt.tenant})
+			}, webauth.RequiresNodeUser)
+			router.GET("/", func(c *gin.Context) {
+				called = true
+				c.String(http.StatusOK, "")
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, mustRequest(t, "GET", "/", nil))
+
+			assert.Equal(t, tt.code == http.StatusOK, called)
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+}
+
 func TestRBAC_Routemap_Admin(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
@@ -49,6 +49,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if err := bt.ResponseSchema.Validate(); err != nil {
+		fe.Add(fmt.Sprintf("ResponseSchema is invalid: %v", err))
+	}
 	return fe.CoerceEmptyToNil()
 }
 
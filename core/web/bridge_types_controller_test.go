@@ -86,6 +86,24 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid ResponseSchema",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithschema",
+				URL:            cltest.WebURL(t, "https://denergy.eth"),
+				ResponseSchema: bridges.ResponseSchema{{Path: "data.result", Type: bridges.ResponseFieldDecimal}},
+			},
+			nil,
+		},
+		{
+			"invalid ResponseSchema",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithschema",
+				URL:            cltest.WebURL(t, "https://denergy.eth"),
+				ResponseSchema: bridges.ResponseSchema{{Path: "data.result", Type: "float"}},
+			},
+			models.NewJSONAPIErrorsWith(`ResponseSchema is invalid: field 0: unknown type "float"`),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
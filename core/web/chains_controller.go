@@ -1,6 +1,7 @@
 package web
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
@@ -20,12 +22,16 @@ type ChainsController interface {
 	Index(c *gin.Context, size, page, offset int)
 	// Show gets a chain by id.
 	Show(*gin.Context)
+	// Enable enables a chain disabled at runtime.
+	Enable(*gin.Context)
+	// Disable disables a chain at runtime, until it is enabled again or the node restarts.
+	Disable(*gin.Context)
 }
 
 type chainsController[R jsonapi.EntityNamer] struct {
 	network       relay.Network
 	resourceName  string
-	chainStats    chainlink.ChainStatuser
+	app           chainlink.Application
 	errNotEnabled error
 	newResource   func(types.ChainStatus) R
 	lggr          logger.Logger
@@ -41,12 +47,12 @@ func (e errChainDisabled) Error() string {
 	return fmt.Sprintf("%s is disabled: Set %s=true to enable", e.name, e.tomlKey)
 }
 
-func newChainsController[R jsonapi.EntityNamer](network relay.Network, chainStats chainlink.ChainsNodesStatuser, errNotEnabled error,
+func newChainsController[R jsonapi.EntityNamer](network relay.Network, app chainlink.Application, errNotEnabled error,
 	newResource func(types.ChainStatus) R, lggr logger.Logger, auditLogger audit.AuditLogger) *chainsController[R] {
 	return &chainsController[R]{
 		network:       network,
 		resourceName:  network + "_chain",
-		chainStats:    chainStats,
+		app:           app,
 		errNotEnabled: errNotEnabled,
 		newResource:   newResource,
 		lggr:          lggr,
@@ -54,12 +60,23 @@ func newChainsController[R jsonapi.EntityNamer](network relay.Network, chainStat
 	}
 }
 
+// chainStats returns the statuses of the chains of the network. They are listed for each request, as chains can be
+// enabled and disabled at runtime.
+func (cc *chainsController[R]) chainStats() chainlink.ChainStatuser {
+	relayers := cc.app.GetRelayers()
+	if relayers == nil {
+		return nil
+	}
+	return relayers.List(chainlink.FilterRelayersByType(cc.network))
+}
+
 func (cc *chainsController[R]) Index(c *gin.Context, size, page, offset int) {
-	if cc.chainStats == nil {
+	chainStats := cc.chainStats()
+	if chainStats == nil {
 		jsonAPIError(c, http.StatusBadRequest, cc.errNotEnabled)
 		return
 	}
-	chains, count, err := cc.chainStats.ChainStatuses(c, offset, size)
+	chains, count, err := chainStats.ChainStatuses(c, offset, size)
 
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
@@ -75,12 +92,13 @@ func (cc *chainsController[R]) Index(c *gin.Context, size, page, offset int) {
 }
 
 func (cc *chainsController[R]) Show(c *gin.Context) {
-	if cc.chainStats == nil {
+	chainStats := cc.chainStats()
+	if chainStats == nil {
 		jsonAPIError(c, http.StatusBadRequest, cc.errNotEnabled)
 		return
 	}
 	relayID := relay.ID{Network: cc.network, ChainID: c.Param("ID")}
-	chain, err := cc.chainStats.ChainStatus(c, relayID)
+	chain, err := chainStats.ChainStatus(c, relayID)
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
@@ -88,3 +106,31 @@ func (cc *chainsController[R]) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, cc.newResource(chain), cc.resourceName)
 }
+
+func (cc *chainsController[R]) Enable(c *gin.Context) {
+	cc.setEnabled(c, true)
+}
+
+func (cc *chainsController[R]) Disable(c *gin.Context) {
+	cc.setEnabled(c, false)
+}
+
+func (cc *chainsController[R]) setEnabled(c *gin.Context, enabled bool) {
+	relayID := relay.ID{Network: cc.network, ChainID: c.Param("ID")}
+	chain, err := cc.app.SetChainEnabled(c, relayID, enabled)
+	if errors.Is(err, chains.ErrNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	event := audit.ChainDisabled
+	if enabled {
+		event = audit.ChainEnabled
+	}
+	cc.auditLogger.Audit(event, map[string]interface{}{"chain": relayID.Name()})
+
+	jsonAPIResponse(c, cc.newResource(chain), cc.resourceName)
+}
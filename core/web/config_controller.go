@@ -41,6 +41,24 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, ConfigV2Resource{toml}, "config")
 }
 
+// Reload re-reads the config and secrets files, and applies the changes which are safe to apply without a restart
+// Example:
+//
+//	"<application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	r, err := cc.App.ReloadConfig(c.Request.Context())
+	if err != nil {
+		status := http.StatusInternalServerError
+		if r == nil {
+			// the files could not be reloaded, e.g. the config is invalid
+			status = http.StatusUnprocessableEntity
+		}
+		jsonAPIError(c, status, err)
+		return
+	}
+	jsonAPIResponse(c, ConfigReloadResource{*r}, "configReload")
+}
+
 type ConfigV2Resource struct {
 	Config string `json:"config"`
 }
@@ -52,3 +70,15 @@ func (c ConfigV2Resource) GetID() string {
 func (c *ConfigV2Resource) SetID(string) error {
 	return nil
 }
+
+type ConfigReloadResource struct {
+	chainlink.ConfigReload
+}
+
+func (r ConfigReloadResource) GetID() string {
+	return utils.NewBytes32ID()
+}
+
+func (r *ConfigReloadResource) SetID(string) error {
+	return nil
+}
@@ -0,0 +1,34 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// DatabaseMaintenanceController reports the stats of the database maintenance service.
+type DatabaseMaintenanceController struct {
+	App chainlink.Application
+}
+
+// Index lists the stats of each table pruned by the database maintenance service, as of its last run.
+// Example:
+// "GET <application>/database_maintenance"
+func (dmc *DatabaseMaintenanceController) Index(c *gin.Context) {
+	m := dmc.App.DatabaseMaintenance()
+	if m == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("database maintenance is disabled, set Database.Maintenance.Enabled to enable it"))
+		return
+	}
+
+	resources := []presenters.DatabaseMaintenanceTableResource{}
+	for _, s := range m.Stats() {
+		resources = append(resources, presenters.NewDatabaseMaintenanceTableResource(s))
+	}
+
+	jsonAPIResponse(c, resources, "database_maintenance_tables")
+}
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// DefaultDrainTimeout is how long the node waits for the work in flight to complete once draining, unless a timeout
+// is passed.
+const DefaultDrainTimeout = time.Minute
+
+// DrainController drains the node before it is terminated.
+type DrainController struct {
+	App chainlink.Application
+}
+
+// Show returns the progress of draining the node.
+// Example:
+// "GET <application>/drain"
+func (dc *DrainController) Show(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewDrainStatusResource(dc.App.DrainStatus(c.Request.Context())), "drain_status")
+}
+
+// Create starts draining the node, which stops accepting new work, and returns its progress. The node is ready to
+// terminate once the work in flight is done, or once the timeout query param elapsed. Draining again returns the
+// progress of the first drain.
+// Example:
+// "POST <application>/drain?timeout=2m"
+func (dc *DrainController) Create(c *gin.Context) {
+	timeout := DefaultDrainTimeout
+	if t := c.Query("timeout"); t != "" {
+		d, err := time.ParseDuration(t)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid timeout"))
+			return
+		}
+		timeout = d
+	}
+
+	status := dc.App.Drain(c.Request.Context(), timeout)
+
+	dc.App.GetAuditLogger().Audit(audit.NodeDrainStarted, map[string]interface{}{"timeout": timeout.String()})
+
+	jsonAPIResponse(c, presenters.NewDrainStatusResource(status), "drain_status")
+}
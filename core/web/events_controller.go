@@ -0,0 +1,252 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+const (
+	// eventStreamBatchBlocks is the number of blocks whose logs are read at once, when backfilling a stream.
+	eventStreamBatchBlocks  = 1000
+	eventStreamWriteTimeout = 10 * time.Second
+)
+
+var eventsUpgrader = websocket.Upgrader{
+	// The origins of requests are already checked by the CORS handler of the router.
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// EventsController streams the logs of the filters registered with the LogPoller of a chain over websockets, so that
+// external systems can consume the events of contracts without running their own indexer.
+type EventsController struct {
+	App chainlink.Application
+}
+
+// StreamedEvent is a log streamed by EventsController.
+type StreamedEvent struct {
+	EVMChainID  string         `json:"evmChainID"`
+	Filter      string         `json:"filter"`
+	Address     common.Address `json:"address"`
+	EventSig    common.Hash    `json:"eventSig"`
+	Topics      []common.Hash  `json:"topics"`
+	BlockNumber int64          `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"txHash"`
+	LogIndex    int64          `json:"logIndex"`
+	Data        hexutil.Bytes  `json:"data"`
+	// Values is the data decoded with the codec entry of the event signature, if the subscription has one.
+	Values map[string]any `json:"values,omitempty"`
+	// DecodeError is set if the data could not be decoded with the codec entry of the event signature.
+	DecodeError string `json:"decodeError,omitempty"`
+}
+
+// Stream streams the logs of a LogPoller filter as JSON messages, ordered by block and log index. Logs are streamed from
+// fromBlock if set, or else from the next block, once they have the given number of confirmations. The data of logs is
+// decoded with the codec entries of the optional codec param, keyed by event signature, which are configured like the
+// codec entries of chain readers.
+// Example:
+//
+//	"<application>/ws/events?evmChainID=1&filter=name&fromBlock=100&confirmations=1"
+func (ec *EventsController) Stream(c *gin.Context) {
+	chain, err := getChain(ec.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	name := c.Query("filter")
+	filter, ok := chain.LogPoller().FilterByName(name)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("filter %q is not registered", name))
+		return
+	}
+
+	stream := &eventStream{
+		lp:         chain.LogPoller(),
+		filter:     filter,
+		chainID:    chain.ID().String(),
+		from:       -1,
+		pollPeriod: chain.Config().EVM().LogPollInterval(),
+		lggr:       ec.App.GetLogger().Named("EventsController").With("evmChainID", chain.ID(), "filter", name),
+	}
+	if fb := c.Query("fromBlock"); fb != "" {
+		if stream.from, err = strconv.ParseInt(fb, 10, 64); err != nil || stream.from < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("fromBlock must be a non-negative integer, got %q", fb))
+			return
+		}
+	}
+	if confs := c.Query("confirmations"); confs != "" {
+		if stream.confs, err = strconv.ParseInt(confs, 10, 64); err != nil || stream.confs < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("confirmations must be a non-negative integer, got %q", confs))
+			return
+		}
+	}
+	if codec := c.Query("codec"); codec != "" {
+		var entries map[common.Hash]evmtypes.ChainCodecConfig
+		if err = json.Unmarshal([]byte(codec), &entries); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid codec"))
+			return
+		}
+		if stream.codec, stream.codecSigs, err = newEventCodec(entries); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid codec"))
+			return
+		}
+	}
+
+	conn, err := eventsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader has replied with an error
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		// messages of the client are ignored, but reading is needed to notice when the connection is closed
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = stream.run(ctx, func(event StreamedEvent) error {
+		if err := conn.SetWriteDeadline(time.Now().Add(eventStreamWriteTimeout)); err != nil {
+			return err
+		}
+		return conn.WriteJSON(event)
+	})
+	if err != nil {
+		stream.lggr.Debugw("Stopped streaming events", "err", err)
+	}
+}
+
+// newEventCodec returns the codec of the entries, with an item type per event signature.
+func newEventCodec(entries map[common.Hash]evmtypes.ChainCodecConfig) (commontypes.Codec, map[common.Hash]bool, error) {
+	configs := make(map[string]evmtypes.ChainCodecConfig, len(entries))
+	sigs := make(map[common.Hash]bool, len(entries))
+	for sig, cfg := range entries {
+		configs[sig.Hex()] = cfg
+		sigs[sig] = true
+	}
+	codec, err := evm.NewCodec(configs)
+	return codec, sigs, err
+}
+
+// eventStream streams the logs of a filter from the LogPoller.
+type eventStream struct {
+	lp      logpoller.LogPoller
+	filter  logpoller.Filter
+	chainID string
+	// codec decodes the data of the logs of the event signatures of codecSigs, if set.
+	codec     commontypes.Codec
+	codecSigs map[common.Hash]bool
+	confs     int64
+	// from is the next block to stream, or -1 to start after the latest block.
+	from       int64
+	pollPeriod time.Duration
+	lggr       logger.Logger
+}
+
+// run streams the logs of the filter with send until ctx is done or send fails.
+func (s *eventStream) run(ctx context.Context, send func(StreamedEvent) error) error {
+	ticker := time.NewTicker(s.pollPeriod)
+	defer ticker.Stop()
+	for {
+		if err := s.poll(ctx, send); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll sends the logs of the blocks confirmed since the last poll. Failures of the LogPoller are logged, and the blocks
+// are read again on the next poll. Only failures of send are returned.
+func (s *eventStream) poll(ctx context.Context, send func(StreamedEvent) error) error {
+	latest, err := s.lp.LatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		s.lggr.Warnw("Failed to get latest block", "err", err)
+		return nil
+	}
+	end := latest.BlockNumber - s.confs
+	if s.from < 0 {
+		s.from = end + 1
+		return nil
+	}
+	for s.from <= end {
+		batchEnd := min(end, s.from+eventStreamBatchBlocks-1)
+		var logs []logpoller.Log
+		for _, address := range s.filter.Addresses {
+			addressLogs, err := s.lp.LogsWithSigs(s.from, batchEnd, s.filter.EventSigs, address, pg.WithParentCtx(ctx))
+			if err != nil {
+				s.lggr.Warnw("Failed to get logs", "fromBlock", s.from, "toBlock", batchEnd, "err", err)
+				return nil
+			}
+			logs = append(logs, addressLogs...)
+		}
+		sort.Slice(logs, func(i, j int) bool {
+			if logs[i].BlockNumber != logs[j].BlockNumber {
+				return logs[i].BlockNumber < logs[j].BlockNumber
+			}
+			return logs[i].LogIndex < logs[j].LogIndex
+		})
+		for _, log := range logs {
+			if err := send(s.event(ctx, log)); err != nil {
+				return err
+			}
+		}
+		s.from = batchEnd + 1
+	}
+	return nil
+}
+
+func (s *eventStream) event(ctx context.Context, log logpoller.Log) StreamedEvent {
+	event := StreamedEvent{
+		EVMChainID:  s.chainID,
+		Filter:      s.filter.Name,
+		Address:     log.Address,
+		EventSig:    log.EventSig,
+		Topics:      log.GetTopics(),
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		LogIndex:    log.LogIndex,
+		Data:        log.Data,
+	}
+	if s.codecSigs[log.EventSig] {
+		if err := s.codec.Decode(ctx, log.Data, &event.Values, log.EventSig.Hex()); err != nil {
+			event.Values = nil
+			event.DecodeError = err.Error()
+		}
+	}
+	return event
+}
@@ -0,0 +1,103 @@
+package web
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+func TestEventStream_Poll(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	sig, otherSig := common.HexToHash("0x01"), common.HexToHash("0x02")
+	addressA, addressB := testutils.NewAddress(), testutils.NewAddress()
+	sigs := []common.Hash{sig, otherSig}
+	filter := logpoller.Filter{Name: "transfers", EventSigs: sigs, Addresses: []common.Address{addressA, addressB}}
+	newLog := func(address common.Address, sig common.Hash, block, index int64, data []byte) logpoller.Log {
+		return logpoller.Log{Address: address, EventSig: sig, BlockNumber: block, LogIndex: index, Topics: [][]byte{sig.Bytes()}, Data: data}
+	}
+	codec, codecSigs, err := newEventCodec(map[common.Hash]evmtypes.ChainCodecConfig{
+		sig: {TypeABI: `[{"name":"value","type":"uint256"}]`},
+	})
+	require.NoError(t, err)
+
+	lp := lpmocks.NewLogPoller(t)
+	stream := &eventStream{lp: lp, filter: filter, chainID: "1337", codec: codec, codecSigs: codecSigs, confs: 2, from: -1, lggr: logger.TestLogger(t)}
+	var events []StreamedEvent
+	send := func(event StreamedEvent) error {
+		events = append(events, event)
+		return nil
+	}
+
+	t.Run("starts after the latest confirmed block", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 102}, nil).Once()
+		require.NoError(t, stream.poll(ctx, send))
+		assert.Equal(t, int64(101), stream.from)
+		assert.Empty(t, events)
+	})
+
+	t.Run("streams the logs of all addresses in order", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 105}, nil).Once()
+		lp.On("LogsWithSigs", int64(101), int64(103), sigs, addressA, mock.Anything).Return([]logpoller.Log{
+			newLog(addressA, sig, 101, 3, common.LeftPadBytes([]byte{7}, 32)),
+			newLog(addressA, sig, 103, 0, []byte{1}), // malformed
+		}, nil).Once()
+		lp.On("LogsWithSigs", int64(101), int64(103), sigs, addressB, mock.Anything).Return([]logpoller.Log{
+			newLog(addressB, otherSig, 101, 1, []byte{0xab}),
+		}, nil).Once()
+		require.NoError(t, stream.poll(ctx, send))
+
+		require.Len(t, events, 3)
+		assert.Equal(t, addressB, events[0].Address)
+		assert.Equal(t, "1337", events[0].EVMChainID)
+		assert.Equal(t, "transfers", events[0].Filter)
+		assert.Equal(t, []byte{0xab}, []byte(events[0].Data))
+		assert.Nil(t, events[0].Values)
+		assert.Equal(t, int64(3), events[1].LogIndex)
+		assert.Equal(t, map[string]any{"value": big.NewInt(7)}, events[1].Values)
+		assert.Equal(t, int64(103), events[2].BlockNumber)
+		assert.NotEmpty(t, events[2].DecodeError)
+		assert.Equal(t, int64(104), stream.from)
+	})
+
+	t.Run("backfills in batches", func(t *testing.T) {
+		events = nil
+		stream.from = 0
+		stream.filter.Addresses = []common.Address{addressA}
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1501}, nil).Once()
+		lp.On("LogsWithSigs", int64(0), int64(999), sigs, addressA, mock.Anything).Return([]logpoller.Log{newLog(addressA, otherSig, 5, 0, nil)}, nil).Once()
+		lp.On("LogsWithSigs", int64(1000), int64(1499), sigs, addressA, mock.Anything).Return([]logpoller.Log{newLog(addressA, otherSig, 1200, 0, nil)}, nil).Once()
+		require.NoError(t, stream.poll(ctx, send))
+
+		require.Len(t, events, 2)
+		assert.Equal(t, int64(5), events[0].BlockNumber)
+		assert.Equal(t, int64(1200), events[1].BlockNumber)
+		assert.Equal(t, int64(1500), stream.from)
+	})
+
+	t.Run("retries after log poller failures", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1510}, nil).Once()
+		lp.On("LogsWithSigs", int64(1500), int64(1508), sigs, addressA, mock.Anything).Return(nil, errors.New("db down")).Once()
+		require.NoError(t, stream.poll(ctx, send))
+		assert.Equal(t, int64(1500), stream.from)
+	})
+
+	t.Run("stops when sending fails", func(t *testing.T) {
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1510}, nil).Once()
+		lp.On("LogsWithSigs", int64(1500), int64(1508), sigs, addressA, mock.Anything).Return([]logpoller.Log{newLog(addressA, otherSig, 1501, 0, nil)}, nil).Once()
+		err := stream.poll(ctx, func(StreamedEvent) error { return errors.New("connection closed") })
+		require.ErrorContains(t, err, "connection closed")
+	})
+}
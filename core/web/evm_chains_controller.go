@@ -11,7 +11,7 @@ var ErrEVMNotEnabled = errChainDisabled{name: "EVM", tomlKey: "EVM.Enabled"}
 func NewEVMChainsController(app chainlink.Application) ChainsController {
 	return newChainsController[presenters.EVMChainResource](
 		relay.EVM,
-		app.GetRelayers().List(chainlink.FilterRelayersByType(relay.EVM)),
+		app,
 		ErrEVMNotEnabled,
 		presenters.NewEVMChainResource,
 		app.GetLogger(),
@@ -4,7 +4,9 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -47,3 +49,41 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// DeadLetters returns the paginated dead-lettered transactions, which were fatally errored and are kept until they
+// are requeued.
+func (tc *TransactionsController) DeadLetters(c *gin.Context, size, page, offset int) {
+	letters, count, err := tc.App.TxmStorageService().DeadLetters(offset, size)
+	pletters := make([]presenters.DeadLetterEthTxResource, len(letters))
+	for i, letter := range letters {
+		pletters[i] = presenters.NewDeadLetterEthTxResource(letter)
+	}
+	paginatedResponse(c, "dead_letter_transactions", size, page, pletters, count, err)
+}
+
+// Requeue moves a dead-lettered transaction back to the queue, so that it is broadcast again.
+// Example:
+//
+//	"<application>/transactions/evm/dead_letters/:ID/requeue"
+func (tc *TransactionsController) Requeue(c *gin.Context) {
+	id, err := stringutils.ToInt64(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	tx, err := tc.App.TxmStorageService().RequeueDeadLetter(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Dead-lettered transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionRequeued, map[string]interface{}{"id": id})
+	r := presenters.NewEthTxResource(*tx)
+	r.JAID = presenters.NewJAIDInt64(tx.ID)
+	jsonAPIResponse(c, r, "transaction")
+}
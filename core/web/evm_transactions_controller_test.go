@@ -16,6 +16,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestTransactionsController_Index_Success(t *testing.T) {
@@ -125,3 +126,39 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	txStore := cltest.NewTestTxStore(t, app.GetSqlxDB(), app.GetConfig().Database())
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	tx := cltest.NewEthTx(from)
+	require.NoError(t, txStore.InsertTx(&tx))
+	tx.Error = null.StringFrom("insufficient funds for gas * price + value")
+	require.NoError(t, txStore.UpdateTxFatalError(testutils.Context(t), &tx))
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/transactions/evm/dead_letters")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var letters []presenters.DeadLetterEthTxResource
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &letters, &links))
+	require.Len(t, letters, 1)
+	assert.Equal(t, fmt.Sprint(tx.ID), letters[0].ID)
+	assert.Equal(t, "insufficient_funds", letters[0].Classification)
+	assert.Equal(t, "insufficient funds for gas * price + value", letters[0].Error)
+
+	resp, cleanup = client.Post(fmt.Sprintf("/v2/transactions/evm/dead_letters/%d/requeue", tx.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Post(fmt.Sprintf("/v2/transactions/evm/dead_letters/%d/requeue", tx.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
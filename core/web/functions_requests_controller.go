@@ -0,0 +1,122 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// FunctionsRequestsController replays Functions requests to debug them.
+type FunctionsRequestsController struct {
+	App chainlink.Application
+}
+
+// ReplayFunctionsRequest is the body of a request replay. The fields other than RequestID and
+// NodeProvidedSecrets are those of the CBOR request data of the original request.
+type ReplayFunctionsRequest struct {
+	// RequestID is the optional 0x-prefixed hex ID of the original request, to replay it with its
+	// flags and compare the replay with its stored outcome.
+	RequestID         string          `json:"requestID"`
+	SubscriptionOwner common.Address  `json:"subscriptionOwner"`
+	SubscriptionID    uint64          `json:"subscriptionID"`
+	Source            string          `json:"source"`
+	Language          int             `json:"language"`
+	CodeLocation      int             `json:"codeLocation"`
+	SecretsLocation   int             `json:"secretsLocation"`
+	Secrets           hexutil.Bytes   `json:"secrets"`
+	Args              []string        `json:"args"`
+	BytesArgs         []hexutil.Bytes `json:"bytesArgs"`
+	// NodeProvidedSecrets replaces the referenced secrets, which the DON only decrypts for pending requests.
+	NodeProvidedSecrets *string `json:"nodeProvidedSecrets"`
+}
+
+// Replay runs a past request again through the secrets and computation path of a Functions job,
+// without saving or transmitting its result, and returns its output, errors and resource usage.
+// The jobID query parameter can be omitted if the node runs a single Functions job.
+// Example:
+// "POST <application>/functions/requests/replay?jobID=1"
+func (frc *FunctionsRequestsController) Replay(c *gin.Context) {
+	var body ReplayFunctionsRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	jobID, listener, err := frc.listener(c.Query("jobID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	replay := &functions.ReplayRequest{
+		SubscriptionOwner: body.SubscriptionOwner,
+		SubscriptionId:    body.SubscriptionID,
+		Data: functions.RequestData{
+			Source:          body.Source,
+			Language:        body.Language,
+			CodeLocation:    body.CodeLocation,
+			SecretsLocation: body.SecretsLocation,
+			Secrets:         body.Secrets,
+			Args:            body.Args,
+		},
+		NodeProvidedSecrets: body.NodeProvidedSecrets,
+	}
+	for _, arg := range body.BytesArgs {
+		replay.Data.BytesArgs = append(replay.Data.BytesArgs, arg)
+	}
+	id := "replay"
+	if body.RequestID != "" {
+		raw, err2 := hexutil.Decode(body.RequestID)
+		if err2 != nil || len(raw) != functions.RequestIDLength {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid request ID: %s", body.RequestID))
+			return
+		}
+		replay.RequestID = new(functions.RequestID)
+		copy(replay.RequestID[:], raw)
+		id = body.RequestID
+	}
+
+	result, err := listener.ReplayRequest(c.Request.Context(), replay)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	frc.App.GetAuditLogger().Audit(audit.FunctionsRequestReplayed, map[string]interface{}{
+		"jobID":             jobID,
+		"requestID":         body.RequestID,
+		"subscriptionOwner": body.SubscriptionOwner,
+		"subscriptionID":    body.SubscriptionID,
+	})
+	jsonAPIResponse(c, presenters.NewFunctionsRequestReplayResource(id, jobID, *result), "functions_request_replay")
+}
+
+// listener returns the listener of the given job, or of the only Functions job if jobID is empty.
+func (frc *FunctionsRequestsController) listener(jobID string) (int32, functions.FunctionsListener, error) {
+	listeners := frc.App.FunctionsListeners()
+	if jobID == "" {
+		if len(listeners) != 1 {
+			return 0, nil, fmt.Errorf("jobID is required, %d Functions jobs are running", len(listeners))
+		}
+		for id, l := range listeners {
+			return id, l, nil
+		}
+	}
+	id, err := strconv.ParseInt(jobID, 10, 32)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid job ID: %w", err)
+	}
+	l, ok := listeners[int32(id)]
+	if !ok {
+		return 0, nil, fmt.Errorf("job %d is not a running Functions job", id)
+	}
+	return int32(id), l, nil
+}
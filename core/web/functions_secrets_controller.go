@@ -0,0 +1,63 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// FunctionsSecretsController rotates the DON-hosted secrets of Functions jobs.
+type FunctionsSecretsController struct {
+	App chainlink.Application
+}
+
+// Rotate re-encrypts and re-uploads the configured DON-hosted secrets of all Functions jobs,
+// or only of the job given by the jobID query parameter, regardless of their expiration.
+// Example:
+// "POST <application>/functions/secrets/rotate?jobID=1"
+func (fsc *FunctionsSecretsController) Rotate(c *gin.Context) {
+	rotators := fsc.App.FunctionsSecretsRotators()
+	if jobID := c.Query("jobID"); jobID != "" {
+		id, err := strconv.ParseInt(jobID, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid job ID: %w", err))
+			return
+		}
+		r, ok := rotators[int32(id)]
+		if !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("job %d is not a running Functions job with secrets rotation", id))
+			return
+		}
+		rotators = map[int32]functions.SecretsRotator{int32(id): r}
+	}
+	jobIDs := make([]int32, 0, len(rotators))
+	for id := range rotators {
+		jobIDs = append(jobIDs, id)
+	}
+	slices.Sort(jobIDs)
+
+	resources := []presenters.FunctionsSecretsRotationResource{}
+	for _, jobID := range jobIDs {
+		for _, rotation := range rotators[jobID].Rotate(c.Request.Context()) {
+			resource := presenters.NewFunctionsSecretsRotationResource(jobID, rotation)
+			fsc.App.GetAuditLogger().Audit(audit.FunctionsSecretsRotated, map[string]interface{}{
+				"jobID":   jobID,
+				"owner":   resource.Owner,
+				"slotID":  resource.SlotID,
+				"version": resource.Version,
+				"error":   resource.Error,
+			})
+			resources = append(resources, resource)
+		}
+	}
+
+	jsonAPIResponse(c, resources, "functions_secrets_rotation")
+}
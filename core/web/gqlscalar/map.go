@@ -18,6 +18,9 @@ func (m *Map) UnmarshalGraphQL(input interface{}) error {
 	case Map:
 		*m = input
 		return nil
+	case map[string]interface{}:
+		*m = input
+		return nil
 	default:
 		return errors.New("wrong type")
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"golang.org/x/exp/maps"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -35,7 +36,10 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 
 	ready, errors := checker.IsReady()
 
-	if !ready {
+	// a draining node is not ready, so that no new work is routed to it
+	draining := hc.App.DrainStatus(c.Request.Context()).Draining
+
+	if !ready || draining {
 		status = http.StatusServiceUnavailable
 	}
 
@@ -45,22 +49,14 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 		return
 	}
 
-	checks := make([]presenters.Check, 0, len(errors))
-
-	for name, err := range errors {
-		status := HealthStatusPassing
-		var output string
-
-		if err != nil {
-			status = HealthStatusFailing
-			output = err.Error()
-		}
+	checks := newChecks(errors)
 
+	if draining {
 		checks = append(checks, presenters.Check{
-			JAID:   presenters.NewJAID(name),
-			Name:   name,
-			Status: status,
-			Output: output,
+			JAID:   presenters.NewJAID("Drain"),
+			Name:   "Drain",
+			Status: HealthStatusFailing,
+			Output: "node is draining",
 		})
 	}
 
@@ -81,6 +77,11 @@ func (hc *HealthController) Health(c *gin.Context) {
 
 	c.Status(status)
 
+	writeHealthReport(c, newChecks(errors), status, hc.App.GetLogger())
+}
+
+// newChecks returns a passing or failing check for every error of a health report.
+func newChecks(errors map[string]error) []presenters.Check {
 	checks := make([]presenters.Check, 0, len(errors))
 	for name, err := range errors {
 		status := HealthStatusPassing
@@ -98,21 +99,25 @@ func (hc *HealthController) Health(c *gin.Context) {
 			Output: output,
 		})
 	}
+	return checks
+}
 
+// writeHealthReport writes checks as JSON, HTML or plain text, depending on the Accept header.
+func writeHealthReport(c *gin.Context, checks []presenters.Check, status int, lggr logger.Logger) {
 	switch c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML, gin.MIMEPlain) {
 	case gin.MIMEJSON:
 		break // default
 
 	case gin.MIMEHTML:
 		if err := newCheckTree(checks).WriteHTMLTo(c.Writer); err != nil {
-			hc.App.GetLogger().Errorw("Failed to write HTML health report", "err", err)
+			lggr.Errorw("Failed to write HTML health report", "err", err)
 			c.AbortWithStatus(http.StatusInternalServerError)
 		}
 		return
 
 	case gin.MIMEPlain:
 		if err := writeTextTo(c.Writer, checks); err != nil {
-			hc.App.GetLogger().Errorw("Failed to write plaintext health report", "err", err)
+			lggr.Errorw("Failed to write plaintext health report", "err", err)
 			c.AbortWithStatus(http.StatusInternalServerError)
 		}
 		return
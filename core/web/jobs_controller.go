@@ -23,10 +23,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/loopjob"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
@@ -47,7 +49,7 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 		size = 1000
 	}
 
-	jobs, count, err := jc.App.JobORM().FindJobs(offset, size)
+	jobs, count, err := jc.App.ReplicaJobORM().FindJobs(offset, size)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -92,6 +94,8 @@ func (jc *JobsController) Show(c *gin.Context) {
 // CreateJobRequest represents a request to create and start a job (V2).
 type CreateJobRequest struct {
 	TOML string `json:"toml"`
+	// TemplateVars are the values of the variables declared by the TOML, if it is a spec template.
+	TemplateVars map[string]string `json:"templateVars,omitempty"`
 }
 
 // Create validates, saves and starts a new job.
@@ -104,7 +108,13 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jb, status, err := jc.validateJobSpec(request.TOML)
+	tomlString, err := job.RenderSpecTemplate(request.TOML, request.TemplateVars)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(tomlString)
 	if err != nil {
 		jsonAPIError(c, status, err)
 		return
@@ -158,6 +168,37 @@ func (jc *JobsController) Delete(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Restart stops and starts the services of a job, lifting its quarantine if they failed to start too many times.
+// Example:
+// "POST <application>/jobs/:ID/restart"
+func (jc *JobsController) Restart(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, err := jc.App.JobORM().FindJobTx(c, j.ID)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = jc.App.JobSpawner().RestartJob(c.Request.Context(), j.ID)
+	jc.App.GetAuditLogger().Audit(audit.JobRestarted, map[string]interface{}{"id": j.ID})
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "failed to start job"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), "jobs")
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
 	TOML string `json:"toml"`
@@ -213,7 +254,149 @@ func (jc *JobsController) Update(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// MigrateOCRJobRequest represents a request to migrate an OCR job to the equivalent OCR2 job.
+type MigrateOCRJobRequest struct {
+	ContractID            string `json:"contractID"`
+	OCRKeyBundleID        string `json:"ocrKeyBundleID"`
+	JuelsPerFeeCoinSource string `json:"juelsPerFeeCoinSource"`
+	// Replace deletes the OCR job and creates the OCR2 job in a single transaction.
+	Replace bool `json:"replace"`
+}
+
+// MigrateOCR converts an existing OCR job into the equivalent OCR2 job and validates it.
+// The OCR job is replaced by the OCR2 job if requested, otherwise only the OCR2 spec is returned.
+// Example:
+// "POST <application>/jobs/:ID/migrate-ocr"
+func (jc *JobsController) MigrateOCR(c *gin.Context) {
+	request := MigrateOCRJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	existing := job.Job{}
+	if err := existing.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	existing, err := jc.App.JobORM().FindJob(ctx, existing.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tomlString, err := ocr.MigrateToOCR2Spec(existing, ocr.OCR2MigrationOpts{
+		ContractID:            request.ContractID,
+		OCRKeyBundleID:        request.OCRKeyBundleID,
+		JuelsPerFeeCoinSource: request.JuelsPerFeeCoinSource,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to migrate job"))
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(tomlString)
+	if err != nil {
+		jsonAPIError(c, status, errors.Wrap(err, "invalid OCR2 job"))
+		return
+	}
+	if err = jc.assertMigratedBridgesExist(jb, request.JuelsPerFeeCoinSource); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.Wrap(err, "invalid OCR2 job"))
+		return
+	}
+
+	if !request.Replace {
+		jsonAPIResponse(c, presenters.NewOCRJobMigrationResource(existing.ID, tomlString, nil), "ocrJobMigration")
+		return
+	}
+
+	err = jc.App.ReplaceJob(ctx, existing.ID, &jb)
+	if err != nil {
+		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jbj, err := json.Marshal(jb)
+	if err == nil {
+		jc.App.GetAuditLogger().Audit(audit.JobMigrated, map[string]interface{}{"replacedJobID": existing.ID, "job": string(jbj)})
+	} else {
+		jc.App.GetLogger().Errorf("Could not send audit log for JobMigration", "err", err)
+	}
+
+	jsonAPIResponse(c, presenters.NewOCRJobMigrationResource(existing.ID, tomlString, &jb), "ocrJobMigration")
+}
+
+// assertMigratedBridgesExist checks that the bridges of both pipelines of a migrated job exist.
+func (jc *JobsController) assertMigratedBridgesExist(jb job.Job, juelsPerFeeCoinSource string) error {
+	if err := jc.App.JobORM().AssertBridgesExist(jb.Pipeline); err != nil {
+		return err
+	}
+	if jb.Type != job.OffchainReporting2 {
+		return nil
+	}
+	p, err := pipeline.Parse(juelsPerFeeCoinSource)
+	if err != nil {
+		return errors.Wrap(err, "invalid juelsPerFeeCoinSource pipeline")
+	}
+	return jc.App.JobORM().AssertBridgesExist(*p)
+}
+
+// Lint validates a job spec and checks it against the policy rules of the node, without creating the job.
+// Example:
+// "POST <application>/jobs/lint"
+func (jc *JobsController) Lint(c *gin.Context) {
+	request := CreateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	tomlString, err := job.RenderSpecTemplate(request.TOML, request.TemplateVars)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jb, status, err := jc.parseJobSpec(tomlString)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	violations, err := job.CheckPolicy(jc.App.GetConfig().JobPipeline().PolicyRules(), jb)
+	if err != nil && !errors.Is(err, job.ErrPolicyViolation) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobPolicyViolationResources(violations), "jobPolicyViolations")
+}
+
+// validateJobSpec parses the job spec and rejects it if it violates a policy rule with the error severity.
 func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, statusCode int, err error) {
+	jb, statusCode, err = jc.parseJobSpec(tomlString)
+	if err != nil {
+		return jb, statusCode, err
+	}
+	if _, err = job.CheckPolicy(jc.App.GetConfig().JobPipeline().PolicyRules(), jb); err != nil {
+		return jb, http.StatusBadRequest, err
+	}
+	return jb, 0, nil
+}
+
+func (jc *JobsController) parseJobSpec(tomlString string) (jb job.Job, statusCode int, err error) {
 	jobType, err := job.ValidateSpec(tomlString)
 	if err != nil {
 		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
@@ -253,6 +436,8 @@ func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, status
 		jb, err = gateway.ValidatedGatewaySpec(tomlString)
 	case job.Stream:
 		jb, err = streams.ValidatedStreamSpec(tomlString)
+	case job.LOOP:
+		jb, err = loopjob.ValidatedSpec(tomlString)
 	default:
 		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	cfgtoml "github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -430,6 +431,51 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Create_PolicyViolation(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.PolicyRules = []cfgtoml.JobPipelinePolicyRule{
+			{Name: ptr("approved-bridges"), Expr: ptr("bridges in ['approved']"), Severity: ptr("error")},
+			{Name: ptr("max-task-duration"), Expr: ptr("maxTaskDuration exists"), Severity: ptr("warning")},
+		}
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig().Database())
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig().Database())
+
+	client := app.NewHTTPClient(nil)
+
+	body, err := json.Marshal(web.CreateJobRequest{
+		TOML: testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), submitBridge.Name.String()),
+	})
+	require.NoError(t, err)
+
+	t.Run("lint", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/lint", bytes.NewReader(body))
+		defer cleanup()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		var violations []presenters.JobPolicyViolationResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &violations))
+		require.Len(t, violations, 2)
+		assert.Equal(t, "approved-bridges", violations[0].Rule)
+		assert.Equal(t, "error", violations[0].Severity)
+		assert.Equal(t, "max-task-duration", violations[1].Rule)
+		assert.Equal(t, "warning", violations[1].Severity)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+		defer cleanup()
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+		b, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "job spec violates policy: rule approved-bridges")
+	})
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -0,0 +1,56 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/envelope"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// MasterKeyController manages the master key of the keystore envelope encryption
+type MasterKeyController struct {
+	App chainlink.Application
+}
+
+// MasterKeyRotateRequest holds the new master key, either the path of a key file on the node or an AWS KMS key.
+type MasterKeyRotateRequest struct {
+	MasterKeyFile string `json:"masterKeyFile"`
+	AWSKMSKeyID   string `json:"awsKMSKeyID"`
+}
+
+// Rotate re-encrypts the keystore with the new master key, which is used from then on. The node configuration must be
+// updated with the new master key before the node is restarted.
+// Example:
+// "POST <application>/keys/master/rotate"
+func (ctrl *MasterKeyController) Rotate(c *gin.Context) {
+	var request MasterKeyRotateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	masterKey, err := envelope.NewMasterKey(request.MasterKeyFile, request.AWSKMSKeyID)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if masterKey == nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("one of masterKeyFile or awsKMSKeyID is required"))
+		return
+	}
+
+	if err = ctrl.App.GetKeyStore().RotateMasterKey(masterKey); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctrl.App.GetAuditLogger().Audit(audit.KeystoreMasterKeyRotated, map[string]interface{}{
+		"masterKeyID": masterKey.ID(),
+	})
+
+	jsonAPIResponse(c, presenters.NewMasterKeyResource(masterKey.ID()), "masterKey")
+}
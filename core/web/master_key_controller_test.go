@@ -0,0 +1,57 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/envelope"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestMasterKeyController_Rotate(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	path := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("ab", 32)), 0600))
+	mk, err := envelope.NewFileKey(path)
+	require.NoError(t, err)
+
+	rotate := func(req web.MasterKeyRotateRequest) *http.Response {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/keys/master/rotate", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	resp := rotate(web.MasterKeyRotateRequest{MasterKeyFile: path})
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var resource presenters.MasterKeyResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+	assert.Equal(t, mk.ID(), resource.ID)
+
+	// the keystore can still be used
+	_, err = app.GetKeyStore().P2P().Create()
+	require.NoError(t, err)
+
+	resp = rotate(web.MasterKeyRotateRequest{})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = rotate(web.MasterKeyRotateRequest{MasterKeyFile: filepath.Join(t.TempDir(), "missing")})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
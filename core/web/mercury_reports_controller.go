@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/verifier"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// MercuryReportsController verifies Mercury (Data Streams) reports.
+type MercuryReportsController struct{}
+
+// VerifyMercuryReportRequest is the body of a report verification request.
+type VerifyMercuryReportRequest struct {
+	// FullReport is the 0x-prefixed hex full report returned by the Mercury server.
+	FullReport string `json:"fullReport"`
+	// Signers are the onchain signing addresses of the oracles of the DON.
+	Signers []common.Address `json:"signers"`
+	// F is the maximum number of faulty oracles of the DON. The report must be signed by exactly F+1 signers.
+	F uint8 `json:"f"`
+}
+
+// Verify checks the signatures of a full report against the given DON signers, the
+// way the onchain Verifier contract does, and returns the decoded report.
+// Example:
+// "POST <application>/mercury/reports/verify"
+func (mrc *MercuryReportsController) Verify(c *gin.Context) {
+	var body VerifyMercuryReportRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	fullReport, err := hexutil.Decode(body.FullReport)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid full report"))
+		return
+	}
+	if len(body.Signers) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("signers must not be empty"))
+		return
+	}
+
+	r, err := verifier.Verify(fullReport, body.Signers, int(body.F))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewMercuryReportResource(*r), "mercury_report")
+}
@@ -0,0 +1,33 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// P2PPeersController reports the state of the P2P network.
+type P2PPeersController struct {
+	App chainlink.Application
+}
+
+// Index lists the remote peers, with the state of the connection to them and the traffic with them of the running OCR
+// instances.
+// Example:
+// "GET <application>/p2p/peers"
+func (pc *P2PPeersController) Index(c *gin.Context) {
+	peers, err := pc.App.P2PPeers()
+	if errors.Is(err, chainlink.ErrP2PDisabled) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewP2PPeerResources(peers, time.Now()), "p2p_peers")
+}
@@ -1,10 +1,13 @@
 package web
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -40,7 +43,7 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	var err error
 
 	if id == "" {
-		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(nil, offset, size)
+		pipelineRuns, count, err = prc.App.ReplicaJobORM().PipelineRuns(nil, offset, size)
 	} else {
 		jobSpec := job.Job{}
 		err = jobSpec.SetID(c.Param("ID"))
@@ -49,7 +52,7 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 			return
 		}
 
-		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(&jobSpec.ID, offset, size)
+		pipelineRuns, count, err = prc.App.ReplicaJobORM().PipelineRuns(&jobSpec.ID, offset, size)
 	}
 
 	if err != nil {
@@ -83,8 +86,10 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 }
 
 // Create triggers a pipeline run for a job.
+// For jobs referenced by their integer ID, the body may contain a JSON object of pipeline variable overrides, and the
+// optional timeout query param bounds how long to wait for the run to finish.
 // Example:
-// "POST <application>/jobs/:ID/runs"
+// "POST <application>/jobs/:ID/runs?timeout=30s"
 func (prc *PipelineRunsController) Create(c *gin.Context) {
 	respondWithPipelineRun := func(jobRunID int64) {
 		pipelineRun, err := prc.App.PipelineORM().FindRun(jobRunID)
@@ -104,7 +109,9 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	idStr := c.Param("ID")
 
 	user, isUser := auth.GetAuthenticatedUser(c)
-	ei, _ := auth.GetAuthenticatedExternalInitiator(c)
+	// External initiators are authenticated with a synthetic user holding the run role, so isUser alone does not
+	// tell them apart from users.
+	ei, isEI := auth.GetAuthenticatedExternalInitiator(c)
 	authorizer := webhook.NewAuthorizer(prc.App.GetSqlxDB().DB, user, ei)
 
 	// Is it a UUID? Then process it as a webhook job
@@ -116,10 +123,26 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			return
 		}
 		if canRun {
+			// Requests of external initiators must be signed if the job has a signature secret.
+			if isEI {
+				if err3 := prc.App.VerifyWebhookSignature(jobUUID, c.Request.Header, bodyBytes); errors.Is(err3, webhook.ErrJobNotExists) {
+					jsonAPIError(c, http.StatusNotFound, err3)
+					return
+				} else if errors.Is(err3, webhook.ErrInvalidSignature) {
+					jsonAPIError(c, http.StatusUnauthorized, err3)
+					return
+				} else if err3 != nil {
+					jsonAPIError(c, http.StatusInternalServerError, err3)
+					return
+				}
+			}
 			jobRunID, err3 := prc.App.RunWebhookJobV2(c.Request.Context(), jobUUID, string(bodyBytes), pipeline.JSONSerializable{})
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, pipeline.ErrDraining) {
+				jsonAPIError(c, http.StatusServiceUnavailable, err3)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
@@ -132,14 +155,35 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	}
 
 	// only users are allowed to run jobs using int IDs - EIs not allowed
-	if isUser {
+	if isUser && !isEI {
 		// Is it an int32? Then process it regardless of type
 		var jobID int32
 		jobID64, err := strconv.ParseInt(idStr, 10, 32)
 		if err == nil {
 			jobID = int32(jobID64)
-			jobRunID, err := prc.App.RunJobV2(c.Request.Context(), jobID, nil)
-			if err != nil {
+			var overrides map[string]interface{}
+			if len(bodyBytes) > 0 {
+				if err = json.Unmarshal(bodyBytes, &overrides); err != nil {
+					jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to unmarshal variable overrides"))
+					return
+				}
+			}
+			ctx := c.Request.Context()
+			if timeout := c.Query("timeout"); timeout != "" {
+				d, err := time.ParseDuration(timeout)
+				if err != nil {
+					jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid timeout"))
+					return
+				}
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, d)
+				defer cancel()
+			}
+			jobRunID, err := prc.App.RunJobV2(ctx, jobID, nil, overrides)
+			if errors.Is(err, pipeline.ErrDraining) {
+				jsonAPIError(c, http.StatusServiceUnavailable, err)
+				return
+			} else if err != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err)
 				return
 			}
@@ -151,6 +195,58 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// Replay executes the pipeline of a finished run of a job again with the inputs captured by the run. The ethtx tasks
+// are stubbed with their results in the original run, unless the stubOnChainWrites query param is false.
+// Example:
+// "POST <application>/jobs/:ID/runs/:runID/replay?stubOnChainWrites=false"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	_, isUser := auth.GetAuthenticatedUser(c)
+	if _, isEI := auth.GetAuthenticatedExternalInitiator(c); isEI || !isUser {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("only users can replay job runs"))
+		return
+	}
+	jobSpec := job.Job{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	original := pipeline.Run{}
+	if err := original.SetID(c.Param("runID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	stub := true
+	if s := c.Query("stubOnChainWrites"); s != "" {
+		var err error
+		if stub, err = strconv.ParseBool(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid stubOnChainWrites"))
+			return
+		}
+	}
+
+	original, err := prc.App.PipelineORM().FindRun(original.ID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && original.PipelineSpec.JobID != jobSpec.ID) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	run, err := prc.App.ReplayJobRun(c.Request.Context(), original.ID, stub)
+	if errors.Is(err, pipeline.ErrDraining) {
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	prc.App.GetAuditLogger().Audit(audit.JobRunReplayed, map[string]interface{}{"jobRunID": original.ID, "replayRunID": run.ID, "stubOnChainWrites": stub})
+	res := presenters.NewPipelineRunResource(*run, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
@@ -1,16 +1,19 @@
 package web_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/hashicorp/consul/sdk/freeport"
 	"github.com/pelletier/go-toml"
@@ -19,14 +22,18 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -145,6 +152,104 @@ func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_Create_ExternalInitiatorSignature(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.ExternalInitiatorsEnabled = ptr(true)
+		c.Database.Listener.FallbackPollInterval = commonconfig.MustNewDuration(10 * time.Millisecond)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient, cltest.UseRealExternalInitiatorManager)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	eip := cltest.CreateExternalInitiatorViaWeb(t, app, `{"name":"signed-ei"}`)
+
+	jobUUID := uuid.New()
+	jb, err := webhook.ValidatedWebhookSpec(fmt.Sprintf(`
+type               = "webhook"
+schemaVersion      = 1
+externalJobID      = "%s"
+externalInitiators = [{ name = "signed-ei", spec = "{}" }]
+signatureSecret    = "s3cr3t"
+observationSource  = """
+	ds [type=memo value="42"];
+"""
+`, jobUUID), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(testutils.Context(t), &jb))
+	cltest.AwaitJobActive(t, app.JobSpawner(), jb.ID, 3*time.Second)
+
+	body := []byte(`{"foo":"bar"}`)
+	post := func(ts int64, signature string) *http.Response {
+		headers := map[string]string{
+			static.ExternalInitiatorAccessKeyHeader: eip.AccessKey,
+			static.ExternalInitiatorSecretHeader:    eip.Secret,
+		}
+		if signature != "" {
+			headers[webhook.DefaultTimestampHeader] = strconv.FormatInt(ts, 10)
+			headers[webhook.DefaultSignatureHeader] = signature
+		}
+		resp, cleanup := cltest.UnauthenticatedPost(t, app.Server.URL+"/v2/jobs/"+jobUUID.String()+"/runs", bytes.NewReader(body), headers)
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	ts := time.Now().Unix()
+	signature, err := webhook.Signature(*jb.WebhookSpec, ts, body)
+	require.NoError(t, err)
+
+	t.Run("unsigned", func(t *testing.T) {
+		cltest.AssertServerResponse(t, post(0, ""), http.StatusUnauthorized)
+	})
+	t.Run("bad signature", func(t *testing.T) {
+		badSignature, err := webhook.Signature(job.WebhookSpec{SignatureSecret: "wrong", SignatureAlgorithm: webhook.DefaultSignatureAlgorithm}, ts, body)
+		require.NoError(t, err)
+		cltest.AssertServerResponse(t, post(ts, badSignature), http.StatusUnauthorized)
+	})
+	t.Run("signed", func(t *testing.T) {
+		cltest.AssertServerResponse(t, post(ts, signature), http.StatusOK)
+	})
+	t.Run("replayed", func(t *testing.T) {
+		cltest.AssertServerResponse(t, post(ts, signature), http.StatusUnauthorized)
+	})
+}
+
+func TestPipelineRunsController_Replay_ExternalInitiator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("route", func(t *testing.T) {
+		cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.JobPipeline.ExternalInitiatorsEnabled = ptr(true)
+		})
+		app := cltest.NewApplicationWithConfig(t, cfg, cltest.UseRealExternalInitiatorManager)
+		require.NoError(t, app.Start(testutils.Context(t)))
+
+		eip := cltest.CreateExternalInitiatorViaWeb(t, app, `{"name":"replaying-ei"}`)
+		headers := map[string]string{
+			static.ExternalInitiatorAccessKeyHeader: eip.AccessKey,
+			static.ExternalInitiatorSecretHeader:    eip.Secret,
+		}
+		resp, cleanup := cltest.UnauthenticatedPost(t, app.Server.URL+"/v2/jobs/1/runs/1/replay", nil, headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+	})
+
+	t.Run("handler", func(t *testing.T) {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set(auth.SessionExternalInitiatorKey, &bridges.ExternalInitiator{Name: "replaying-ei"})
+			c.Set(auth.SessionUserKey, &sessions.User{Role: sessions.UserRoleRun})
+		})
+		router.POST("/v2/jobs/:ID/runs/:runID/replay", (&web.PipelineRunsController{}).Replay)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v2/jobs/1/runs/1/replay", nil)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
 func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -308,9 +413,9 @@ func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, i
 	err = app.AddJobV2(testutils.Context(t), &jb)
 	require.NoError(t, err)
 
-	firstRunID, err := app.RunJobV2(testutils.Context(t), jb.ID, nil)
+	firstRunID, err := app.RunJobV2(testutils.Context(t), jb.ID, nil, nil)
 	require.NoError(t, err)
-	secondRunID, err := app.RunJobV2(testutils.Context(t), jb.ID, nil)
+	secondRunID, err := app.RunJobV2(testutils.Context(t), jb.ID, nil, nil)
 	require.NoError(t, err)
 
 	return client, jb.ID, []int64{firstRunID, secondRunID}
@@ -14,10 +14,11 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken          string                 `json:"incomingToken,omitempty"`
+	OutgoingToken          string                 `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link           `json:"minimumContractPayment"`
+	ResponseSchema         bridges.ResponseSchema `json:"responseSchema,omitempty"`
+	CreatedAt              time.Time              `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,6 +36,7 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		ResponseSchema:         b.ResponseSchema,
 		CreatedAt:              b.CreatedAt,
 	}
 }
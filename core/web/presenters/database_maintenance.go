@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/dbmaintenance"
+)
+
+// DatabaseMaintenanceTableResource is the maintenance stats of a table JSONAPI resource.
+type DatabaseMaintenanceTableResource struct {
+	JAID
+	Retention     string     `json:"retention"`
+	LastRun       *time.Time `json:"lastRun"`
+	Duration      string     `json:"duration"`
+	RowsDeleted   int64      `json:"rowsDeleted"`
+	Complete      bool       `json:"complete"`
+	Error         string     `json:"error"`
+	SizeBytes     int64      `json:"sizeBytes"`
+	EstimatedRows int64      `json:"estimatedRows"`
+	GrowthPerDay  int64      `json:"growthBytesPerDay"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DatabaseMaintenanceTableResource) GetName() string {
+	return "database_maintenance_tables"
+}
+
+// NewDatabaseMaintenanceTableResource returns a new DatabaseMaintenanceTableResource for the stats of a table.
+func NewDatabaseMaintenanceTableResource(s dbmaintenance.TableStats) DatabaseMaintenanceTableResource {
+	r := DatabaseMaintenanceTableResource{
+		JAID:          NewJAID(s.Table),
+		Retention:     s.Retention.String(),
+		Duration:      s.Duration.String(),
+		RowsDeleted:   s.RowsDeleted,
+		Complete:      s.Complete,
+		SizeBytes:     s.SizeBytes,
+		EstimatedRows: s.EstimatedRows,
+		GrowthPerDay:  s.GrowthPerDay,
+	}
+	if !s.LastRun.IsZero() {
+		r.LastRun = &s.LastRun
+	}
+	if s.Err != nil {
+		r.Error = s.Err.Error()
+	}
+	return r
+}
@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// DrainStatusResource is the JSONAPI resource of the progress of draining the node.
+type DrainStatusResource struct {
+	JAID
+	Draining         bool       `json:"draining"`
+	StartedAt        *time.Time `json:"startedAt"`
+	Deadline         *time.Time `json:"deadline"`
+	InFlightRuns     int        `json:"inFlightRuns"`
+	PendingTxs       int        `json:"pendingTxs"`
+	ReadyToTerminate bool       `json:"readyToTerminate"`
+	TimedOut         bool       `json:"timedOut"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DrainStatusResource) GetName() string {
+	return "drain_status"
+}
+
+// NewDrainStatusResource returns a new DrainStatusResource for status.
+func NewDrainStatusResource(status chainlink.DrainStatus) DrainStatusResource {
+	r := DrainStatusResource{
+		JAID:             NewJAID("drain"),
+		Draining:         status.Draining,
+		InFlightRuns:     status.InFlightRuns,
+		PendingTxs:       status.PendingTxs,
+		ReadyToTerminate: status.ReadyToTerminate,
+		TimedOut:         status.TimedOut,
+	}
+	if status.Draining {
+		r.StartedAt, r.Deadline = &status.StartedAt, &status.Deadline
+	}
+	return r
+}
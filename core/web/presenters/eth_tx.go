@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -76,3 +77,29 @@ func NewEthTxResourceFromAttempt(txa txmgr.TxAttempt) EthTxResource {
 	}
 	return r
 }
+
+// DeadLetterEthTxResource represents a dead-lettered Ethereum Transaction JSONAPI resource, identified by the ID of
+// the transaction.
+type DeadLetterEthTxResource struct {
+	EthTxResource
+	Error          string    `json:"error"`
+	Classification string    `json:"classification"`
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (DeadLetterEthTxResource) GetName() string {
+	return "evm_dead_letter_transactions"
+}
+
+// NewDeadLetterEthTxResource generates a DeadLetterEthTxResource from a txmgr.DeadLetter.
+func NewDeadLetterEthTxResource(letter txmgr.DeadLetter) DeadLetterEthTxResource {
+	r := DeadLetterEthTxResource{
+		EthTxResource:  NewEthTxResource(letter.Tx),
+		Error:          letter.Tx.Error.String,
+		Classification: string(letter.Classification),
+		DeadLetteredAt: letter.DeadLetteredAt,
+	}
+	r.JAID = NewJAIDInt64(letter.Tx.ID)
+	return r
+}
@@ -17,6 +17,7 @@ type ExternalInitiatorAuthentication struct {
 	Secret         string        `json:"incomingSecret,omitempty"`
 	OutgoingToken  string        `json:"outgoingToken,omitempty"`
 	OutgoingSecret string        `json:"outgoingSecret,omitempty"`
+	GRPC           bool          `json:"grpc,omitempty"`
 }
 
 // NewExternalInitiatorAuthentication creates an instance of ExternalInitiatorAuthentication.
@@ -30,6 +31,7 @@ func NewExternalInitiatorAuthentication(
 		Secret:         eia.Secret,
 		OutgoingToken:  ei.OutgoingToken,
 		OutgoingSecret: ei.OutgoingSecret,
+		GRPC:           ei.GRPC,
 	}
 	if ei.URL != nil {
 		result.URL = *ei.URL
@@ -60,6 +62,7 @@ type ExternalInitiatorResource struct {
 	URL           *models.WebURL `json:"url"`
 	AccessKey     string         `json:"accessKey"`
 	OutgoingToken string         `json:"outgoingToken"`
+	GRPC          bool           `json:"grpc"`
 	CreatedAt     time.Time      `json:"createdAt"`
 	UpdatedAt     time.Time      `json:"updatedAt"`
 }
@@ -71,6 +74,7 @@ func NewExternalInitiatorResource(ei bridges.ExternalInitiator) ExternalInitiato
 		URL:           ei.URL,
 		AccessKey:     ei.AccessKey,
 		OutgoingToken: ei.OutgoingToken,
+		GRPC:          ei.GRPC,
 		CreatedAt:     ei.CreatedAt,
 		UpdatedAt:     ei.UpdatedAt,
 	}
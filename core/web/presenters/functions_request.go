@@ -0,0 +1,56 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+// FunctionsRequestReplayResource is the JSONAPI resource of a replayed Functions request.
+type FunctionsRequestReplayResource struct {
+	JAID
+	JobID                 int32         `json:"jobID"`
+	Result                hexutil.Bytes `json:"result"`
+	Error                 string        `json:"error"`
+	Domains               []string      `json:"domains"`
+	SecretsSize           int           `json:"secretsSize"`
+	SecretsDurationMs     int64         `json:"secretsDurationMs"`
+	ComputationDurationMs int64         `json:"computationDurationMs"`
+	OriginalState         *string       `json:"originalState"`
+	OriginalErrorType     *string       `json:"originalErrorType"`
+	OriginalResult        hexutil.Bytes `json:"originalResult"`
+	OriginalError         *string       `json:"originalError"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r FunctionsRequestReplayResource) GetName() string {
+	return "functions_request_replay"
+}
+
+// NewFunctionsRequestReplayResource returns a new FunctionsRequestReplayResource for the replay of a request of a job.
+func NewFunctionsRequestReplayResource(id string, jobID int32, result functions.ReplayResult) FunctionsRequestReplayResource {
+	r := FunctionsRequestReplayResource{
+		JAID:                  NewJAID(id),
+		JobID:                 jobID,
+		Result:                result.Result,
+		Error:                 string(result.Error),
+		Domains:               result.Domains,
+		SecretsSize:           result.SecretsSize,
+		SecretsDurationMs:     result.SecretsDuration.Milliseconds(),
+		ComputationDurationMs: result.ComputationDuration.Milliseconds(),
+	}
+	if o := result.Original; o != nil {
+		state := o.State.String()
+		r.OriginalState = &state
+		if o.ErrorType != nil {
+			errorType := o.ErrorType.String()
+			r.OriginalErrorType = &errorType
+		}
+		r.OriginalResult = o.Result
+		if o.Error != nil {
+			originalError := string(o.Error)
+			r.OriginalError = &originalError
+		}
+	}
+	return r
+}
@@ -0,0 +1,44 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+// FunctionsSecretsRotationResource is the JSONAPI resource of the rotation of the DON-hosted
+// secrets of a slot.
+type FunctionsSecretsRotationResource struct {
+	JAID
+	JobID           int32          `json:"jobID"`
+	Owner           common.Address `json:"owner"`
+	SlotID          uint           `json:"slotID"`
+	PreviousVersion uint64         `json:"previousVersion"`
+	Version         uint64         `json:"version"`
+	Expiration      int64          `json:"expiration"`
+	Error           string         `json:"error"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r FunctionsSecretsRotationResource) GetName() string {
+	return "functions_secrets_rotation"
+}
+
+// NewFunctionsSecretsRotationResource returns a new FunctionsSecretsRotationResource for a rotation of a job.
+func NewFunctionsSecretsRotationResource(jobID int32, rotation functions.SecretsRotation) FunctionsSecretsRotationResource {
+	r := FunctionsSecretsRotationResource{
+		JAID:            NewJAID(fmt.Sprintf("%d-%s-%d", jobID, rotation.Owner.Hex(), rotation.SlotID)),
+		JobID:           jobID,
+		Owner:           rotation.Owner,
+		SlotID:          rotation.SlotID,
+		PreviousVersion: rotation.PreviousVersion,
+		Version:         rotation.Version,
+		Expiration:      rotation.Expiration,
+	}
+	if rotation.Err != nil {
+		r.Error = rotation.Err.Error()
+	}
+	return r
+}
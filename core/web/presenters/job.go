@@ -1,6 +1,7 @@
 package presenters
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/policy"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
@@ -39,6 +41,7 @@ const (
 	BlockHeaderFeederJobSpec JobSpecType = "blockheaderfeeder"
 	BootstrapJobSpec         JobSpecType = "bootstrap"
 	GatewayJobSpec           JobSpecType = "gateway"
+	LOOPJobSpec              JobSpecType = "loop"
 )
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
@@ -169,6 +172,8 @@ type OffChainReporting2Spec struct {
 	Relay                             relay.Network          `json:"relay"`
 	RelayConfig                       map[string]interface{} `json:"relayConfig"`
 	P2PV2Bootstrappers                pq.StringArray         `json:"p2pv2Bootstrappers"`
+	P2PV2BootstrappersRegistry        null.String            `json:"p2pv2BootstrappersRegistry"`
+	P2PV2BootstrappersRefreshInterval *models.Interval       `json:"p2pv2BootstrappersRefreshInterval"`
 	OCRKeyBundleID                    null.String            `json:"ocrKeyBundleID"`
 	TransmitterID                     null.String            `json:"transmitterID"`
 	ObservationTimeout                models.Interval        `json:"observationTimeout"`
@@ -188,6 +193,8 @@ func NewOffChainReporting2Spec(spec *job.OCR2OracleSpec) *OffChainReporting2Spec
 		Relay:                             spec.Relay,
 		RelayConfig:                       spec.RelayConfig,
 		P2PV2Bootstrappers:                spec.P2PV2Bootstrappers,
+		P2PV2BootstrappersRegistry:        spec.P2PV2BootstrappersRegistry,
+		P2PV2BootstrappersRefreshInterval: spec.P2PV2BootstrappersRefreshInterval,
 		OCRKeyBundleID:                    spec.OCRKeyBundleID,
 		TransmitterID:                     spec.TransmitterID,
 		BlockchainTimeout:                 spec.BlockchainTimeout,
@@ -251,17 +258,23 @@ func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule   string    `json:"schedule" tom:"schedule"`
+	Timezone       string    `json:"timezone"`
+	CatchUpPolicy  string    `json:"catchUpPolicy"`
+	MaxCatchUpRuns uint32    `json:"maxCatchUpRuns"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:   spec.CronSchedule,
+		Timezone:       spec.Timezone,
+		CatchUpPolicy:  string(spec.CatchUpPolicy),
+		MaxCatchUpRuns: spec.MaxCatchUpRuns,
+		CreatedAt:      spec.CreatedAt,
+		UpdatedAt:      spec.UpdatedAt,
 	}
 }
 
@@ -429,6 +442,23 @@ func NewGatewaySpec(spec *job.GatewaySpec) *GatewaySpec {
 	}
 }
 
+// LOOPSpec defines the spec details of a job implemented by a LOOP plugin
+type LOOPSpec struct {
+	PluginName   string                 `json:"pluginName"`
+	PluginConfig map[string]interface{} `json:"pluginConfig"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+func NewLOOPSpec(spec *job.LOOPSpec) *LOOPSpec {
+	return &LOOPSpec{
+		PluginName:   spec.PluginName,
+		PluginConfig: spec.PluginConfig,
+		CreatedAt:    spec.CreatedAt,
+		UpdatedAt:    spec.UpdatedAt,
+	}
+}
+
 // JobError represents errors on the job
 type JobError struct {
 	ID          int64     `json:"id"`
@@ -470,6 +500,7 @@ type JobResource struct {
 	BlockHeaderFeederSpec  *BlockHeaderFeederSpec  `json:"blockHeaderFeederSpec"`
 	BootstrapSpec          *BootstrapSpec          `json:"bootstrapSpec"`
 	GatewaySpec            *GatewaySpec            `json:"gatewaySpec"`
+	LOOPSpec               *LOOPSpec               `json:"loopSpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
 	Errors                 []JobError              `json:"errors"`
 }
@@ -513,6 +544,8 @@ func NewJobResource(j job.Job) *JobResource {
 		resource.BootstrapSpec = NewBootstrapSpec(j.BootstrapSpec)
 	case job.Gateway:
 		resource.GatewaySpec = NewGatewaySpec(j.GatewaySpec)
+	case job.LOOP:
+		resource.LOOPSpec = NewLOOPSpec(j.LOOPSpec)
 	case job.Stream:
 		// no spec; nothing to do
 	case job.LegacyGasStationServer, job.LegacyGasStationSidecar:
@@ -532,3 +565,59 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// OCRJobMigrationResource is the JSONAPI resource of the migration of an OCR job to OCR2.
+type OCRJobMigrationResource struct {
+	JAID
+	TOML string `json:"toml"`
+	// Replaced is true once the OCR job is replaced by the OCR2 job.
+	Replaced bool `json:"replaced"`
+	// JobID is the ID of the OCR2 job, if the OCR job was replaced.
+	JobID string `json:"jobID,omitempty"`
+}
+
+// NewOCRJobMigrationResource returns a new OCRJobMigrationResource of the OCR job with the
+// given ID, and of the OCR2 job replacing it, if any.
+func NewOCRJobMigrationResource(ocrJobID int32, toml string, replacement *job.Job) *OCRJobMigrationResource {
+	r := &OCRJobMigrationResource{
+		JAID: NewJAIDInt32(ocrJobID),
+		TOML: toml,
+	}
+	if replacement != nil {
+		r.Replaced = true
+		r.JobID = strconv.FormatInt(int64(replacement.ID), 10)
+	}
+	return r
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r OCRJobMigrationResource) GetName() string {
+	return "ocrJobMigrations"
+}
+
+// JobPolicyViolationResource is the JSONAPI resource of a violation of a policy rule by a job spec.
+type JobPolicyViolationResource struct {
+	JAID
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// NewJobPolicyViolationResources returns the resources of the violations.
+func NewJobPolicyViolationResources(violations []policy.Violation) []JobPolicyViolationResource {
+	rs := []JobPolicyViolationResource{}
+	for _, v := range violations {
+		rs = append(rs, JobPolicyViolationResource{
+			JAID:     NewJAID(v.Rule),
+			Rule:     v.Rule,
+			Severity: string(v.Severity),
+			Message:  v.Message,
+		})
+	}
+	return rs
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobPolicyViolationResource) GetName() string {
+	return "jobPolicyViolations"
+}
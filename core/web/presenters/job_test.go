@@ -128,6 +128,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -204,6 +205,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -290,6 +292,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -353,6 +356,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -363,9 +367,12 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				CronSpec: &job.CronSpec{
-					CronSchedule: cronSchedule,
-					CreatedAt:    timestamp,
-					UpdatedAt:    timestamp,
+					CronSchedule:   cronSchedule,
+					Timezone:       "UTC",
+					CatchUpPolicy:  job.CronCatchUpRunMissed,
+					MaxCatchUpRuns: 10,
+					CreatedAt:      timestamp,
+					UpdatedAt:      timestamp,
 				},
 				ExternalJobID: uuid.MustParse("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
 				PipelineSpec: &pipeline.Spec{
@@ -395,6 +402,9 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "timezone": "UTC",
+                            "catchUpPolicy": "runMissed",
+                            "maxCatchUpRuns": 10,
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z"
                         },
@@ -411,6 +421,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"loopSpec": null,
                         "errors": []
                     }
                 }
@@ -467,6 +478,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -551,6 +563,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -631,6 +644,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -711,6 +725,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -775,6 +790,7 @@ func TestJob(t *testing.T) {
 							"dotDagSource": ""
 						},
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": []
 					}
 				}
@@ -831,6 +847,7 @@ func TestJob(t *testing.T) {
 							"createdAt":"0001-01-01T00:00:00Z",
 							"updatedAt":"0001-01-01T00:00:00Z"
 						},
+						"loopSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"jobID": 0,
@@ -908,6 +925,7 @@ func TestJob(t *testing.T) {
 						"blockHeaderFeederSpec": null,
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
+						"loopSpec": null,
 						"errors": [{
 							"id": 200,
 							"description": "some error",
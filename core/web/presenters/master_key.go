@@ -0,0 +1,15 @@
+package presenters
+
+// MasterKeyResource represents the master key of the keystore envelope encryption as a JSONAPI resource.
+type MasterKeyResource struct {
+	JAID
+}
+
+// GetName implements the api2go EntityNamer interface
+func (MasterKeyResource) GetName() string {
+	return "masterKeys"
+}
+
+func NewMasterKeyResource(id string) *MasterKeyResource {
+	return &MasterKeyResource{JAID: NewJAID(id)}
+}
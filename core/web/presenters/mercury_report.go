@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/verifier"
+)
+
+// MercuryReportResource is the JSONAPI resource of a verified Mercury report.
+type MercuryReportResource struct {
+	JAID
+	FeedID       string   `json:"feedID"`
+	Version      uint16   `json:"version"`
+	ConfigDigest string   `json:"configDigest"`
+	Epoch        uint32   `json:"epoch"`
+	Round        uint8    `json:"round"`
+	Signers      []string `json:"signers"`
+	// Report are the decoded fields of the report. Integers are encoded as decimal strings.
+	Report map[string]interface{} `json:"report"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r MercuryReportResource) GetName() string {
+	return "mercury_report"
+}
+
+// NewMercuryReportResource returns a new MercuryReportResource for the verified report.
+func NewMercuryReportResource(r verifier.Report) MercuryReportResource {
+	m := r.ToMap()
+	fields := m["report"].(map[string]interface{})
+	for k, v := range fields {
+		if i, ok := v.(*big.Int); ok {
+			fields[k] = i.String()
+		}
+	}
+	res := MercuryReportResource{
+		JAID:         NewJAID(r.FeedID.String()),
+		FeedID:       r.FeedID.String(),
+		Version:      uint16(r.FeedID.Version()),
+		ConfigDigest: r.ReportContext.ConfigDigest.Hex(),
+		Epoch:        r.ReportContext.Epoch,
+		Round:        r.ReportContext.Round,
+		Report:       fields,
+	}
+	for _, s := range r.Signers {
+		res.Signers = append(res.Signers, s.Hex())
+	}
+	return res
+}
@@ -0,0 +1,77 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+// P2PPeerResource is the JSONAPI resource of the state of the connection to a remote peer.
+type P2PPeerResource struct {
+	JAID
+	Connected       bool                       `json:"connected"`
+	LastConnectedAt *time.Time                 `json:"lastConnectedAt"`
+	DialFailures    int64                      `json:"dialFailures"`
+	LastDialError   string                     `json:"lastDialError"`
+	LastDialErrorAt *time.Time                 `json:"lastDialErrorAt"`
+	Instances       []P2PInstanceTrafficResult `json:"instances"`
+}
+
+// P2PInstanceTrafficResult is the traffic of an OCR instance with a remote peer.
+type P2PInstanceTrafficResult struct {
+	ConfigDigest              string    `json:"configDigest"`
+	Since                     time.Time `json:"since"`
+	MessagesSent              int64     `json:"messagesSent"`
+	MessagesReceived          int64     `json:"messagesReceived"`
+	BytesSent                 int64     `json:"bytesSent"`
+	BytesReceived             int64     `json:"bytesReceived"`
+	MessagesSentPerSecond     float64   `json:"messagesSentPerSecond"`
+	MessagesReceivedPerSecond float64   `json:"messagesReceivedPerSecond"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r P2PPeerResource) GetName() string {
+	return "p2p_peers"
+}
+
+// NewP2PPeerResource returns a new P2PPeerResource for p, with the message rates of its instances at now.
+func NewP2PPeerResource(p ocrcommon.P2PPeer, now time.Time) P2PPeerResource {
+	optional := func(t time.Time) *time.Time {
+		if t.IsZero() {
+			return nil
+		}
+		return &t
+	}
+	r := P2PPeerResource{
+		JAID:            NewJAID(p.PeerID),
+		Connected:       p.Connected,
+		LastConnectedAt: optional(p.LastConnectedAt),
+		DialFailures:    p.DialFailures,
+		LastDialError:   p.LastDialError,
+		LastDialErrorAt: optional(p.LastDialErrorAt),
+		Instances:       []P2PInstanceTrafficResult{},
+	}
+	for _, t := range p.Instances {
+		sent, received := t.MessageRates(now)
+		r.Instances = append(r.Instances, P2PInstanceTrafficResult{
+			ConfigDigest:              t.ConfigDigest,
+			Since:                     t.Since,
+			MessagesSent:              t.MessagesSent,
+			MessagesReceived:          t.MessagesReceived,
+			BytesSent:                 t.BytesSent,
+			BytesReceived:             t.BytesReceived,
+			MessagesSentPerSecond:     sent,
+			MessagesReceivedPerSecond: received,
+		})
+	}
+	return r
+}
+
+// NewP2PPeerResources returns a slice of P2PPeerResources.
+func NewP2PPeerResources(peers []ocrcommon.P2PPeer, now time.Time) []P2PPeerResource {
+	rs := []P2PPeerResource{}
+	for _, p := range peers {
+		rs = append(rs, NewP2PPeerResource(p, now))
+	}
+	return rs
+}
@@ -23,6 +23,8 @@ type PipelineRunResource struct {
 	CreatedAt    time.Time                 `json:"createdAt"`
 	FinishedAt   null.Time                 `json:"finishedAt"`
 	PipelineSpec PipelineSpec              `json:"pipelineSpec"`
+	// ReplayOfRunID is the ID of the run which the run replays, if it is a replay.
+	ReplayOfRunID *int64 `json:"replayOfRunID,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -44,17 +46,23 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 
 	fatalErrors := pr.StringFatalErrors()
 
+	var replayOf *int64
+	if pr.ReplayOfRunID.Valid {
+		replayOf = &pr.ReplayOfRunID.Int64
+	}
+
 	return PipelineRunResource{
-		JAID:         NewJAIDInt64(pr.ID),
-		Outputs:      outputs,
-		Errors:       fatalErrors,
-		AllErrors:    pr.StringAllErrors(),
-		FatalErrors:  fatalErrors,
-		Inputs:       pr.Inputs,
-		TaskRuns:     trs,
-		CreatedAt:    pr.CreatedAt,
-		FinishedAt:   pr.FinishedAt,
-		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
+		JAID:          NewJAIDInt64(pr.ID),
+		Outputs:       outputs,
+		Errors:        fatalErrors,
+		AllErrors:     pr.StringAllErrors(),
+		FatalErrors:   fatalErrors,
+		Inputs:        pr.Inputs,
+		TaskRuns:      trs,
+		CreatedAt:     pr.CreatedAt,
+		FinishedAt:    pr.FinishedAt,
+		PipelineSpec:  NewPipelineSpec(&pr.PipelineSpec),
+		ReplayOfRunID: replayOf,
 	}
 }
 
@@ -0,0 +1,64 @@
+package presenters
+
+// Actions of a ProvisioningResultResource.
+const (
+	ProvisioningUnchanged = "unchanged"
+	ProvisioningCreate    = "create"
+	ProvisioningUpdate    = "update"
+	ProvisioningError     = "error"
+)
+
+// ProvisioningChange is a change of a field of a provisioned resource.
+type ProvisioningChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// ProvisioningResultResource is the JSONAPI resource of the reconciliation of a resource of a provisioning document.
+// In a dry run, the action is only planned.
+type ProvisioningResultResource struct {
+	JAID
+	Kind    string               `json:"kind"`
+	Name    string               `json:"name"`
+	Action  string               `json:"action"`
+	Changes []ProvisioningChange `json:"changes,omitempty"`
+	Error   string               `json:"error,omitempty"`
+	// IncomingToken is the incoming token of a created bridge, which is only shown once.
+	IncomingToken string `json:"incomingToken,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ProvisioningResultResource) GetName() string {
+	return "provisioningResults"
+}
+
+// NewProvisioningResultResource returns an unchanged result for the resource of kind with name.
+func NewProvisioningResultResource(kind, name string) ProvisioningResultResource {
+	return ProvisioningResultResource{
+		JAID:   NewJAID(kind + "/" + name),
+		Kind:   kind,
+		Name:   name,
+		Action: ProvisioningUnchanged,
+	}
+}
+
+// WithAction returns the result with action.
+func (r ProvisioningResultResource) WithAction(action string) ProvisioningResultResource {
+	r.Action = action
+	return r
+}
+
+// WithChange returns the result with the change of field, which updates the resource.
+func (r ProvisioningResultResource) WithChange(field, from, to string) ProvisioningResultResource {
+	r.Action = ProvisioningUpdate
+	r.Changes = append(r.Changes, ProvisioningChange{Field: field, From: from, To: to})
+	return r
+}
+
+// WithError returns the result failed with err. The planned changes are kept.
+func (r ProvisioningResultResource) WithError(err error) ProvisioningResultResource {
+	r.Action = ProvisioningError
+	r.Error = err.Error()
+	return r
+}
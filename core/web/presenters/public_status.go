@@ -0,0 +1,54 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// PublicStatusResource is the JSONAPI resource of the public status of the node. It only has the coarse health of the
+// node, its chains, and the liveness of its feed jobs, since it is served without authentication.
+type PublicStatusResource struct {
+	JAID
+	// Status is "passing" if all the checks of the node pass, "failing" otherwise.
+	Status string               `json:"status"`
+	Ready  bool                 `json:"ready"`
+	Chains []PublicChainStatus  `json:"chains"`
+	Feeds  []PublicFeedLiveness `json:"feeds"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PublicStatusResource) GetName() string {
+	return "public_status"
+}
+
+type PublicChainStatus struct {
+	Network string `json:"network"`
+	ChainID string `json:"chainID"`
+	Enabled bool   `json:"enabled"`
+}
+
+type PublicFeedLiveness struct {
+	Type       string     `json:"type"`
+	Network    string     `json:"network"`
+	ChainID    string     `json:"chainID"`
+	ContractID string     `json:"contractID"`
+	FeedID     *string    `json:"feedID,omitempty"`
+	LastRunAt  *time.Time `json:"lastRunAt"`
+}
+
+// NewPublicFeedLiveness returns the public liveness of the feed job f.
+func NewPublicFeedLiveness(f job.FeedLiveness) PublicFeedLiveness {
+	r := PublicFeedLiveness{
+		Type:       f.Type.String(),
+		Network:    f.Network,
+		ChainID:    f.ChainID,
+		ContractID: f.ContractID,
+		LastRunAt:  f.LastRunAt,
+	}
+	if f.FeedID != nil {
+		feedID := f.FeedID.Hex()
+		r.FeedID = &feedID
+	}
+	return r
+}
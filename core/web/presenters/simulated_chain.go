@@ -0,0 +1,32 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+// SimulatedChainResource is the state of a simulated EVM chain JSONAPI resource.
+type SimulatedChainResource struct {
+	JAID
+	Head          int64       `json:"head"`
+	BlockInterval string      `json:"blockInterval"`
+	MinGasPrice   *assets.Wei `json:"minGasPrice"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SimulatedChainResource) GetName() string {
+	return "simulated_chains"
+}
+
+// NewSimulatedChainResource returns a new SimulatedChainResource for the simulated chain with chainID.
+func NewSimulatedChainResource(chainID string, c *evmclient.SimulatedChain) SimulatedChainResource {
+	r := SimulatedChainResource{
+		JAID:          NewJAID(chainID),
+		Head:          c.Head(),
+		BlockInterval: c.BlockInterval().String(),
+	}
+	if p := c.MinGasPrice(); p != nil {
+		r.MinGasPrice = assets.NewWei(p)
+	}
+	return r
+}
@@ -0,0 +1,39 @@
+package presenters
+
+// TOTPEnrollmentResource holds the secret of a pending TOTP enrollment, to be added to an authenticator app.
+type TOTPEnrollmentResource struct {
+	JAID
+	Secret string `json:"secret"`
+	URL    string `json:"url"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r TOTPEnrollmentResource) GetName() string {
+	return "totpEnrollments"
+}
+
+func NewTOTPEnrollmentResource(email, secret, url string) *TOTPEnrollmentResource {
+	return &TOTPEnrollmentResource{
+		JAID:   NewJAID(email),
+		Secret: secret,
+		URL:    url,
+	}
+}
+
+// RecoveryCodesResource holds new MFA recovery codes. They are only shown once.
+type RecoveryCodesResource struct {
+	JAID
+	Codes []string `json:"codes"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RecoveryCodesResource) GetName() string {
+	return "recoveryCodes"
+}
+
+func NewRecoveryCodesResource(email string, codes []string) *RecoveryCodesResource {
+	return &RecoveryCodesResource{
+		JAID:  NewJAID(email),
+		Codes: codes,
+	}
+}
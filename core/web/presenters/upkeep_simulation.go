@@ -0,0 +1,56 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+)
+
+// UpkeepSimulationResource is the JSONAPI resource of a simulated upkeep check.
+type UpkeepSimulationResource struct {
+	JAID
+	EVMChainID                 string  `json:"evmChainID"`
+	RegistryAddress            string  `json:"registryAddress"`
+	RegistryVersion            string  `json:"registryVersion"`
+	BlockNumber                int64   `json:"blockNumber"`
+	UpkeepNeeded               bool    `json:"upkeepNeeded"`
+	PerformData                string  `json:"performData"`
+	FailureReason              string  `json:"failureReason"`
+	CheckGasUsed               *string `json:"checkGasUsed"`
+	GasLimit                   *string `json:"gasLimit"`
+	PerformGasUsed             *string `json:"performGasUsed"`
+	PerformSimulationSucceeded bool    `json:"performSimulationSucceeded"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepSimulationResource) GetName() string {
+	return "upkeep_simulation"
+}
+
+// NewUpkeepSimulationResource returns a new UpkeepSimulationResource for the simulation of an upkeep.
+func NewUpkeepSimulationResource(evmChainID string, req keeper.UpkeepSimulationRequest, sim keeper.UpkeepSimulation) UpkeepSimulationResource {
+	r := UpkeepSimulationResource{
+		JAID:                       NewJAID(req.UpkeepID.String()),
+		EVMChainID:                 evmChainID,
+		RegistryAddress:            req.Registry.Hex(),
+		RegistryVersion:            sim.RegistryVersion,
+		BlockNumber:                sim.BlockNumber,
+		UpkeepNeeded:               sim.UpkeepNeeded,
+		PerformData:                hexutil.Encode(sim.PerformData),
+		FailureReason:              sim.FailureReason,
+		PerformSimulationSucceeded: sim.PerformSimulationSucceeded,
+	}
+	if sim.CheckGasUsed != nil {
+		gas := sim.CheckGasUsed.String()
+		r.CheckGasUsed = &gas
+	}
+	if sim.GasLimit != nil {
+		gas := sim.GasLimit.String()
+		r.GasLimit = &gas
+	}
+	if sim.PerformGasUsed != nil {
+		gas := sim.PerformGasUsed.String()
+		r.PerformGasUsed = &gas
+	}
+	return r
+}
@@ -3,6 +3,8 @@ package presenters
 import (
 	"time"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 )
 
@@ -11,6 +13,7 @@ type UserResource struct {
 	JAID
 	Email             string            `json:"email"`
 	Role              sessions.UserRole `json:"role"`
+	Tenant            null.String       `json:"tenant"`
 	HasActiveApiToken string            `json:"hasActiveApiToken"`
 	CreatedAt         time.Time         `json:"createdAt"`
 	UpdatedAt         time.Time         `json:"updatedAt"`
@@ -33,6 +36,7 @@ func NewUserResource(u sessions.User) *UserResource {
 		JAID:              NewJAID(u.Email),
 		Email:             u.Email,
 		Role:              u.Role,
+		Tenant:            u.Tenant,
 		HasActiveApiToken: hasToken,
 		CreatedAt:         u.CreatedAt,
 		UpdatedAt:         u.UpdatedAt,
@@ -38,7 +38,8 @@ func TestUserResource(t *testing.T) {
 			  "createdAt": "2000-01-01T00:00:00Z",
 			  "updatedAt": "2000-01-01T00:00:00Z",
 			  "hasActiveApiToken": "false",
-			  "role": "admin"
+			  "role": "admin",
+			  "tenant": null
 		   }
 		}
 	 }
@@ -0,0 +1,83 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+)
+
+// VRFRequestResource is a pending VRF request JSONAPI resource.
+type VRFRequestResource struct {
+	JAID
+	JobID            int32          `json:"jobID"`
+	SubID            string         `json:"subID"`
+	Sender           common.Address `json:"sender"`
+	RequestTxHash    common.Hash    `json:"requestTxHash"`
+	BlockNumber      uint64         `json:"blockNumber"`
+	ConfirmedAtBlock uint64         `json:"confirmedAtBlock"`
+	CallbackGasLimit uint32         `json:"callbackGasLimit"`
+	NativePayment    bool           `json:"nativePayment"`
+	Attempts         int            `json:"attempts"`
+	SubBalance       *string        `json:"subBalance"`
+	MaxFee           *string        `json:"maxFee"`
+	SimulationError  string         `json:"simulationError"`
+	Reason           string         `json:"reason"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestResource) GetName() string {
+	return "vrf_request"
+}
+
+// NewVRFRequestResource returns a new VRFRequestResource for a pending request of a job.
+func NewVRFRequestResource(jobID int32, s vrfv2.RequestStatus) VRFRequestResource {
+	r := VRFRequestResource{
+		JAID:             NewJAID(s.RequestID.String()),
+		JobID:            jobID,
+		SubID:            s.SubID.String(),
+		Sender:           s.Sender,
+		RequestTxHash:    s.RequestTxHash,
+		BlockNumber:      s.BlockNumber,
+		ConfirmedAtBlock: s.ConfirmedAtBlock,
+		CallbackGasLimit: s.CallbackGasLimit,
+		NativePayment:    s.NativePayment,
+		Attempts:         s.Attempts,
+		SimulationError:  s.SimulationError,
+		Reason:           s.Reason,
+	}
+	if s.SubBalance != nil {
+		balance := s.SubBalance.String()
+		r.SubBalance = &balance
+	}
+	if s.MaxFee != nil {
+		maxFee := s.MaxFee.String()
+		r.MaxFee = &maxFee
+	}
+	return r
+}
+
+// VRFRequestFulfillmentResource is the JSONAPI resource of a manually enqueued VRF fulfillment.
+type VRFRequestFulfillmentResource struct {
+	JAID
+	JobID       int32          `json:"jobID"`
+	EthTxID     int64          `json:"ethTxID"`
+	FromAddress common.Address `json:"fromAddress"`
+	ToAddress   common.Address `json:"toAddress"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestFulfillmentResource) GetName() string {
+	return "vrf_request_fulfillment"
+}
+
+// NewVRFRequestFulfillmentResource returns a new VRFRequestFulfillmentResource for the fulfillment tx of a request.
+func NewVRFRequestFulfillmentResource(requestID string, jobID int32, tx txmgr.Tx) VRFRequestFulfillmentResource {
+	return VRFRequestFulfillmentResource{
+		JAID:        NewJAID(requestID),
+		JobID:       jobID,
+		EthTxID:     tx.ID,
+		FromAddress: tx.FromAddress,
+		ToAddress:   tx.ToAddress,
+	}
+}
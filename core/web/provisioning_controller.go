@@ -0,0 +1,366 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// ProvisioningDocument is the desired state of the resources of the node. Resources which are missing from the
+// document are left untouched.
+type ProvisioningDocument struct {
+	Chains  []ProvisionedChain          `json:"chains"`
+	Nodes   []ProvisionedNode           `json:"nodes"`
+	Bridges []bridges.BridgeTypeRequest `json:"bridges"`
+	Users   []ProvisionedUser           `json:"users"`
+	Jobs    []ProvisionedJob            `json:"jobs"`
+}
+
+// ProvisionedChain is the desired state of a chain. Its existence is only verified if Enabled is not set.
+type ProvisionedChain struct {
+	Network string `json:"network"`
+	ID      string `json:"id"`
+	Enabled *bool  `json:"enabled"`
+}
+
+// ProvisionedNode is an RPC node which must be configured. RPC nodes are configured by the config TOML, so they are
+// only verified.
+type ProvisionedNode struct {
+	Network string `json:"network"`
+	ChainID string `json:"chainID"`
+	Name    string `json:"name"`
+}
+
+// ProvisionedUser is the desired state of an API user. The password is only used to create the user.
+type ProvisionedUser struct {
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+	Password string `json:"password"`
+	Tenant   string `json:"tenant"`
+}
+
+// ProvisionedJob is the desired spec of the job with the externalJobID of the TOML.
+type ProvisionedJob struct {
+	TOML         string            `json:"toml"`
+	TemplateVars map[string]string `json:"templateVars,omitempty"`
+}
+
+// ProvisioningController reconciles the resources of the node against a desired-state document.
+type ProvisioningController struct {
+	App chainlink.Application
+}
+
+// Apply reconciles chains, RPC nodes, bridges, API users and jobs against the document, in that order so that jobs
+// can depend on the other resources. Each resource is reconciled independently, and its result is returned whether
+// it failed or not. Applying the same document again changes nothing. With ?dryRun=true, the changes are only
+// planned.
+// Example:
+// "POST <application>/apply"
+func (pc *ProvisioningController) Apply(c *gin.Context) {
+	var doc ProvisioningDocument
+	if err := c.ShouldBindJSON(&doc); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	dryRun, _ := strconv.ParseBool(c.Query("dryRun"))
+	ctx := c.Request.Context()
+
+	var results []presenters.ProvisioningResultResource
+	for _, ch := range doc.Chains {
+		results = append(results, pc.applyChain(ctx, ch, dryRun))
+	}
+	for _, n := range doc.Nodes {
+		results = append(results, pc.applyNode(ctx, n))
+	}
+	for _, btr := range doc.Bridges {
+		results = append(results, pc.applyBridge(btr, dryRun))
+	}
+	for _, u := range doc.Users {
+		results = append(results, pc.applyUser(c, u, dryRun))
+	}
+	for _, j := range doc.Jobs {
+		results = append(results, pc.applyJob(ctx, j, dryRun))
+	}
+
+	jsonAPIResponse(c, results, "provisioningResults")
+}
+
+func (pc *ProvisioningController) applyChain(ctx context.Context, ch ProvisionedChain, dryRun bool) presenters.ProvisioningResultResource {
+	relayID := relay.ID{Network: ch.Network, ChainID: ch.ID}
+	r := presenters.NewProvisioningResultResource("chain", relayID.Name())
+	relayers := pc.App.GetRelayers()
+	if relayers == nil {
+		return r.WithError(errors.New("no chains are enabled"))
+	}
+	status, err := relayers.ChainStatus(ctx, relayID)
+	if err != nil {
+		return r.WithError(err)
+	}
+	if ch.Enabled == nil || *ch.Enabled == status.Enabled {
+		return r
+	}
+
+	r = r.WithChange("enabled", strconv.FormatBool(status.Enabled), strconv.FormatBool(*ch.Enabled))
+	if dryRun {
+		return r
+	}
+	if _, err = pc.App.SetChainEnabled(ctx, relayID, *ch.Enabled); err != nil {
+		if errors.Is(err, chains.ErrNotFound) {
+			err = errors.Errorf("chain %s can't be enabled or disabled at runtime", relayID.Name())
+		}
+		return r.WithError(err)
+	}
+
+	event := audit.ChainDisabled
+	if *ch.Enabled {
+		event = audit.ChainEnabled
+	}
+	pc.App.GetAuditLogger().Audit(event, map[string]interface{}{"chain": relayID.Name()})
+	return r
+}
+
+func (pc *ProvisioningController) applyNode(ctx context.Context, n ProvisionedNode) presenters.ProvisioningResultResource {
+	relayID := relay.ID{Network: n.Network, ChainID: n.ChainID}
+	r := presenters.NewProvisioningResultResource("node", relayID.Name()+"/"+n.Name)
+	relayers := pc.App.GetRelayers()
+	if relayers == nil {
+		return r.WithError(errors.New("no chains are enabled"))
+	}
+	nodes, _, err := relayers.NodeStatuses(ctx, 0, 0, relayID)
+	if err != nil {
+		return r.WithError(err)
+	}
+	for _, node := range nodes {
+		if node.Name == n.Name {
+			return r
+		}
+	}
+	return r.WithError(errors.Errorf("RPC node %s is not configured: RPC nodes are configured by the config TOML, add it there and reload the config", n.Name))
+}
+
+func (pc *ProvisioningController) applyBridge(btr bridges.BridgeTypeRequest, dryRun bool) presenters.ProvisioningResultResource {
+	r := presenters.NewProvisioningResultResource("bridge", btr.Name.String())
+	if err := ValidateBridgeType(&btr); err != nil {
+		return r.WithError(err)
+	}
+
+	orm := pc.App.BridgeORM()
+	bt, err := orm.FindBridge(btr.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		r = r.WithAction(presenters.ProvisioningCreate)
+		if dryRun {
+			return r
+		}
+		bta, newBT, err2 := bridges.NewBridgeType(&btr)
+		if err2 != nil {
+			return r.WithError(err2)
+		}
+		if err2 = orm.CreateBridgeType(newBT); err2 != nil {
+			return r.WithError(err2)
+		}
+		r.IncomingToken = bta.IncomingToken
+		pc.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]interface{}{
+			"bridgeName":                   bta.Name,
+			"bridgeConfirmations":          bta.Confirmations,
+			"bridgeMinimumContractPayment": bta.MinimumContractPayment,
+			"bridgeURL":                    bta.URL,
+		})
+		return r
+	} else if err != nil {
+		return r.WithError(err)
+	}
+
+	if bt.URL.String() != btr.URL.String() {
+		r = r.WithChange("url", bt.URL.String(), btr.URL.String())
+	}
+	if bt.Confirmations != btr.Confirmations {
+		r = r.WithChange("confirmations", strconv.FormatUint(uint64(bt.Confirmations), 10), strconv.FormatUint(uint64(btr.Confirmations), 10))
+	}
+	if from, to := linkString(bt.MinimumContractPayment), linkString(btr.MinimumContractPayment); from != to {
+		r = r.WithChange("minimumContractPayment", from, to)
+	}
+	// A missing response schema keeps the current one, see bridges.ORM.UpdateBridgeType
+	if btr.ResponseSchema != nil && !reflect.DeepEqual(bt.ResponseSchema, btr.ResponseSchema) {
+		from, _ := json.Marshal(bt.ResponseSchema)
+		to, _ := json.Marshal(btr.ResponseSchema)
+		r = r.WithChange("responseSchema", string(from), string(to))
+	}
+	if r.Action == presenters.ProvisioningUnchanged || dryRun {
+		return r
+	}
+
+	if err = orm.UpdateBridgeType(&bt, &btr); err != nil {
+		return r.WithError(err)
+	}
+	pc.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]interface{}{
+		"bridgeName":                   bt.Name,
+		"bridgeConfirmations":          bt.Confirmations,
+		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
+		"bridgeURL":                    bt.URL,
+	})
+	return r
+}
+
+// linkString formats a minimum contract payment, where none is zero.
+func linkString(l *assets.Link) string {
+	if l == nil {
+		return "0"
+	}
+	return l.String()
+}
+
+func (pc *ProvisioningController) applyUser(c *gin.Context, u ProvisionedUser, dryRun bool) presenters.ProvisioningResultResource {
+	r := presenters.NewProvisioningResultResource("user", u.Email)
+	role, err := clsessions.GetUserRole(u.Role)
+	if err != nil {
+		return r.WithError(err)
+	}
+
+	provider := pc.App.AuthenticationProvider()
+	user, err := provider.FindUser(u.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		r = r.WithAction(presenters.ProvisioningCreate)
+		if err = utils.VerifyPasswordComplexity(u.Password, u.Email); err != nil {
+			return r.WithError(err)
+		}
+		if u.Tenant != "" {
+			if _, err = pc.App.TenancyORM().FindTenant(u.Tenant); err != nil {
+				return r.WithError(errors.Wrapf(err, "failed to find tenant %s", u.Tenant))
+			}
+		}
+		if dryRun {
+			return r
+		}
+		user, err = clsessions.NewUser(u.Email, u.Password, role)
+		if err != nil {
+			return r.WithError(err)
+		}
+		if u.Tenant != "" {
+			user.Tenant = null.StringFrom(u.Tenant)
+		}
+		if err = provider.CreateUser(&user); err != nil {
+			return r.WithError(err)
+		}
+		return r
+	} else if err != nil {
+		return r.WithError(err)
+	}
+
+	if user.Role == role {
+		return r
+	}
+	r = r.WithChange("role", string(user.Role), string(role))
+	// Don't allow the current admin user to edit themselves, as with the users API
+	if sessionUser, ok := webauth.GetAuthenticatedUser(c); ok && strings.EqualFold(sessionUser.Email, user.Email) {
+		return r.WithError(errors.New("can not change state or permissions of current admin user"))
+	}
+	if dryRun {
+		return r
+	}
+	if _, err = provider.UpdateRole(user.Email, string(role)); err != nil {
+		if errors.Is(err, clsessions.ErrNotSupported) {
+			err = errUnsupportedForAuth
+		}
+		return r.WithError(err)
+	}
+	return r
+}
+
+func (pc *ProvisioningController) applyJob(ctx context.Context, pj ProvisionedJob, dryRun bool) presenters.ProvisioningResultResource {
+	tomlString, err := job.RenderSpecTemplate(pj.TOML, pj.TemplateVars)
+	if err != nil {
+		return presenters.NewProvisioningResultResource("job", "").WithError(err)
+	}
+	jc := JobsController{App: pc.App}
+	jb, _, err := jc.validateJobSpec(tomlString)
+	if err != nil {
+		return presenters.NewProvisioningResultResource("job", jb.Name.ValueOrZero()).WithError(err)
+	}
+	if jb.ExternalJobID == uuid.Nil {
+		return presenters.NewProvisioningResultResource("job", jb.Name.ValueOrZero()).
+			WithError(errors.New("externalJobID is required to reconcile the job"))
+	}
+	r := presenters.NewProvisioningResultResource("job", jb.ExternalJobID.String())
+
+	existing, err := pc.App.JobORM().FindJobByExternalJobID(jb.ExternalJobID, pg.WithParentCtx(ctx))
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		r = r.WithAction(presenters.ProvisioningCreate)
+		if dryRun {
+			return r
+		}
+		if err = pc.App.AddJobV2(ctx, &jb); err != nil {
+			return r.WithError(err)
+		}
+		pc.recordJobVersion(ctx, jb, tomlString)
+		jbj, _ := json.Marshal(jb)
+		pc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+		return r
+	} else if err != nil {
+		return r.WithError(err)
+	}
+
+	// Jobs can't be compared field by field, so the definition of the live version of the job is compared instead.
+	// A job without one was not created from a definition, so it is replaced.
+	versions, err := pc.App.JobORM().FindJobVersions(jb.ExternalJobID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return r.WithError(err)
+	}
+	from := ""
+	for _, v := range versions {
+		if v.Status == job.JobVersionLive {
+			from = v.Definition
+			break
+		}
+	}
+	if from == tomlString {
+		return r
+	}
+	r = r.WithChange("definition", from, tomlString)
+	if dryRun {
+		return r
+	}
+	if err = pc.App.ReplaceJob(ctx, existing.ID, &jb); err != nil {
+		return r.WithError(err)
+	}
+	pc.recordJobVersion(ctx, jb, tomlString)
+	jbj, _ := json.Marshal(jb)
+	pc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"replacedJobID": existing.ID, "job": string(jbj)})
+	return r
+}
+
+// recordJobVersion records the definition of a job as its live version, so that it can be compared on the next apply.
+// The version is inserted as retired, then set live, which retires the version of the replaced job if any.
+func (pc *ProvisioningController) recordJobVersion(ctx context.Context, jb job.Job, definition string) {
+	v := job.JobVersion{ExternalJobID: jb.ExternalJobID, Definition: definition, Status: job.JobVersionRetired}
+	if err := pc.App.JobORM().CreateJobVersion(&v, pg.WithParentCtx(ctx)); err != nil {
+		pc.App.GetLogger().Warnw("Failed to record the version of the job", "jobID", jb.ID, "err", err)
+		return
+	}
+	if err := pc.App.JobORM().SetLiveJobVersion(v.ID, pg.WithParentCtx(ctx)); err != nil {
+		pc.App.GetLogger().Warnw(fmt.Sprintf("Failed to set version %d of the job live", v.Version), "jobID", jb.ID, "err", err)
+	}
+}
@@ -0,0 +1,160 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func provisioningWebhookSpec(externalJobID uuid.UUID, bridgeName string) string {
+	return fmt.Sprintf(`
+type = "webhook"
+schemaVersion = 1
+externalJobID = "%s"
+observationSource = """
+    fetch [type=bridge name="%s" requestData="{}"]
+"""
+`, externalJobID, bridgeName)
+}
+
+func applyProvisioningDocument(t *testing.T, client cltest.HTTPClientCleaner, doc web.ProvisioningDocument, dryRun bool) map[string]presenters.ProvisioningResultResource {
+	body, err := json.Marshal(doc)
+	require.NoError(t, err)
+	path := "/v2/apply"
+	if dryRun {
+		path += "?dryRun=true"
+	}
+	resp, cleanup := client.Post(path, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var results []presenters.ProvisioningResultResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &results))
+	byKind := make(map[string]presenters.ProvisioningResultResource, len(results))
+	for _, r := range results {
+		byKind[r.Kind] = r
+	}
+	return byKind
+}
+
+func TestProvisioningController_Apply(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	doc := web.ProvisioningDocument{
+		Bridges: []bridges.BridgeTypeRequest{{
+			Name:          bridges.MustParseBridgeName("provisioned"),
+			URL:           cltest.WebURL(t, "https://provisioned.example"),
+			Confirmations: 1,
+		}},
+		Users: []web.ProvisionedUser{{
+			Email:    "provisioned@chain.link",
+			Role:     "view",
+			Password: cltest.Password,
+		}},
+		Jobs: []web.ProvisionedJob{{TOML: provisioningWebhookSpec(externalJobID, "provisioned")}},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		results := applyProvisioningDocument(t, client, doc, true)
+		require.Len(t, results, 3)
+		for _, r := range results {
+			assert.Equal(t, presenters.ProvisioningCreate, r.Action, r.Error)
+		}
+
+		_, err := app.BridgeORM().FindBridge(bridges.MustParseBridgeName("provisioned"))
+		assert.Error(t, err)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		results := applyProvisioningDocument(t, client, doc, false)
+		require.Len(t, results, 3)
+		for _, r := range results {
+			assert.Equal(t, presenters.ProvisioningCreate, r.Action, r.Error)
+		}
+		assert.NotEmpty(t, results["bridge"].IncomingToken)
+
+		_, err := app.BridgeORM().FindBridge(bridges.MustParseBridgeName("provisioned"))
+		require.NoError(t, err)
+		user, err := app.AuthenticationProvider().FindUser("provisioned@chain.link")
+		require.NoError(t, err)
+		assert.Equal(t, "view", string(user.Role))
+		_, err = app.JobORM().FindJobByExternalJobID(externalJobID)
+		require.NoError(t, err)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		results := applyProvisioningDocument(t, client, doc, false)
+		require.Len(t, results, 3)
+		for _, r := range results {
+			assert.Equal(t, presenters.ProvisioningUnchanged, r.Action, r.Error)
+			assert.Empty(t, r.IncomingToken)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		doc.Bridges[0].URL = cltest.WebURL(t, "https://updated.example")
+		doc.Users[0].Role = "edit"
+		doc.Users[0].Password = ""
+		doc.Jobs[0].TOML += "\n"
+		results := applyProvisioningDocument(t, client, doc, false)
+		require.Len(t, results, 3)
+		for _, r := range results {
+			assert.Equal(t, presenters.ProvisioningUpdate, r.Action, r.Error)
+		}
+		assert.Equal(t, []presenters.ProvisioningChange{{Field: "url", From: "https://provisioned.example", To: "https://updated.example"}}, results["bridge"].Changes)
+		assert.Equal(t, []presenters.ProvisioningChange{{Field: "role", From: "view", To: "edit"}}, results["user"].Changes)
+
+		bt, err := app.BridgeORM().FindBridge(bridges.MustParseBridgeName("provisioned"))
+		require.NoError(t, err)
+		assert.Equal(t, "https://updated.example", bt.URL.String())
+		_, err = app.JobORM().FindJobByExternalJobID(externalJobID)
+		require.NoError(t, err)
+
+		results = applyProvisioningDocument(t, client, doc, false)
+		assert.Equal(t, presenters.ProvisioningUnchanged, results["job"].Action, results["job"].Error)
+	})
+}
+
+func TestProvisioningController_Apply_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	doc := web.ProvisioningDocument{
+		Users: []web.ProvisionedUser{{
+			Email: "nopassword@chain.link",
+			Role:  "view",
+		}},
+		Jobs: []web.ProvisionedJob{{TOML: `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+    ds [type=http method=GET url="https://example.com"]
+"""
+`}},
+	}
+	results := applyProvisioningDocument(t, client, doc, false)
+	require.Len(t, results, 2)
+	assert.Equal(t, presenters.ProvisioningError, results["user"].Action)
+	assert.Equal(t, presenters.ProvisioningError, results["job"].Action)
+	assert.Equal(t, "externalJobID is required to reconcile the job", results["job"].Error)
+}
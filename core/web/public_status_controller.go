@@ -0,0 +1,71 @@
+package web
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PublicStatusController serves the public status of the node to downstream consumers, without authentication. It
+// must not expose anything more than the coarse health of the node, its chains, and the liveness of its feed jobs.
+type PublicStatusController struct {
+	App chainlink.Application
+}
+
+// Show returns the public status of the node. The reasons of failures are only logged, since they may reveal details
+// of the node's setup.
+// Example:
+// "GET <application>/public/status"
+func (psc *PublicStatusController) Show(c *gin.Context) {
+	ctx := c.Request.Context()
+	lggr := psc.App.GetLogger()
+
+	checker := psc.App.GetHealthChecker()
+	healthy, _ := checker.IsHealthy()
+	ready, _ := checker.IsReady()
+	status := presenters.PublicStatusResource{
+		JAID:   presenters.NewJAID("public_status"),
+		Status: HealthStatusPassing,
+		Ready:  ready && !psc.App.DrainStatus(ctx).Draining,
+		Chains: []presenters.PublicChainStatus{},
+		Feeds:  []presenters.PublicFeedLiveness{},
+	}
+	if !healthy {
+		status.Status = HealthStatusFailing
+	}
+
+	networks := make([]string, 0, len(relay.SupportedRelays))
+	for n := range relay.SupportedRelays {
+		networks = append(networks, n)
+	}
+	sort.Strings(networks)
+	for _, network := range networks {
+		chains, _, err := psc.App.GetRelayers().List(chainlink.FilterRelayersByType(network)).ChainStatuses(ctx, 0, 0)
+		if err != nil {
+			lggr.Errorw("Failed to get chain statuses for public status", "network", network, "err", err)
+			jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to get chains"))
+			return
+		}
+		for _, ch := range chains {
+			status.Chains = append(status.Chains, presenters.PublicChainStatus{Network: network, ChainID: ch.ID, Enabled: ch.Enabled})
+		}
+	}
+
+	feeds, err := psc.App.ReplicaJobORM().FindFeedLiveness(ctx)
+	if err != nil {
+		lggr.Errorw("Failed to get feed liveness for public status", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to get feeds"))
+		return
+	}
+	for _, f := range feeds {
+		status.Feeds = append(status.Feeds, presenters.NewPublicFeedLiveness(f))
+	}
+
+	jsonAPIResponse(c, status, "public_status")
+}
@@ -0,0 +1,86 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPublicStatusController_Show(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.WebServer.PublicStatus.Enabled = ptr(true)
+		c.WebServer.PublicStatus.RateLimit = ptr[int64](2)
+		c.WebServer.PublicStatus.RateLimitPeriod = commonconfig.MustNewDuration(time.Hour)
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg)
+	require.NoError(t, app.Start(ctx))
+
+	client := clhttptest.NewTestLocalOnlyHTTPClient()
+	get := func() *http.Response {
+		req, err := http.NewRequestWithContext(ctx, "GET", app.Server.URL+"/public/status", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		return resp
+	}
+
+	// no credentials are needed
+	resp := get()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var status presenters.PublicStatusResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &status))
+	assert.Contains(t, []string{web.HealthStatusPassing, web.HealthStatusFailing}, status.Status)
+	require.Len(t, status.Chains, 1)
+	assert.Equal(t, "evm", status.Chains[0].Network)
+	assert.True(t, status.Chains[0].Enabled)
+	assert.Empty(t, status.Feeds)
+
+	// the job names, specs and errors are not exposed
+	var raw map[string]map[string]map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(t, get()), &raw))
+	assert.ElementsMatch(t, []string{"status", "ready", "chains", "feeds"}, keys(raw["data"]["attributes"]))
+
+	// rate limited
+	assert.Equal(t, http.StatusTooManyRequests, get().StatusCode)
+}
+
+func TestPublicStatusController_Disabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", app.Server.URL+"/public/status", nil)
+	require.NoError(t, err)
+	resp, err := clhttptest.NewTestLocalOnlyHTTPClient().Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+}
+
+func keys[V any](m map[string]V) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
@@ -0,0 +1,114 @@
+package resolver
+
+import (
+	"errors"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/balancemonitor"
+)
+
+var errBalanceMonitorDisabled = errors.New("balance monitor is disabled")
+
+// AccountBalanceResolver resolves the AccountBalance type.
+type AccountBalanceResolver struct {
+	balance balancemonitor.AccountBalance
+}
+
+func NewAccountBalance(balance balancemonitor.AccountBalance) *AccountBalanceResolver {
+	return &AccountBalanceResolver{balance: balance}
+}
+
+func NewAccountBalances(balances []balancemonitor.AccountBalance) []*AccountBalanceResolver {
+	var resolvers []*AccountBalanceResolver
+	for _, b := range balances {
+		resolvers = append(resolvers, NewAccountBalance(b))
+	}
+
+	return resolvers
+}
+
+// ChainFamily resolves the chain family, e.g. solana.
+func (r *AccountBalanceResolver) ChainFamily() string {
+	return r.balance.ChainID.Network
+}
+
+// ChainID resolves the ID of the chain within its family.
+func (r *AccountBalanceResolver) ChainID() string {
+	return r.balance.ChainID.ChainID
+}
+
+// Address resolves the address of the account.
+func (r *AccountBalanceResolver) Address() string {
+	return r.balance.Account
+}
+
+// Balance resolves the balance in whole tokens.
+func (r *AccountBalanceResolver) Balance() *string {
+	if r.balance.Balance == nil {
+		return nil
+	}
+	b := r.balance.Amount().String()
+	return &b
+}
+
+// Token resolves the symbol of the token.
+func (r *AccountBalanceResolver) Token() string {
+	return r.balance.Token.Symbol
+}
+
+// Decimals resolves the number of decimals of the base unit of the token.
+func (r *AccountBalanceResolver) Decimals() int32 {
+	return r.balance.Token.Decimals
+}
+
+// LowBalance resolves whether the balance is below the threshold of the chain family.
+func (r *AccountBalanceResolver) LowBalance() bool {
+	return r.balance.Low
+}
+
+// Error resolves the error of the last poll.
+func (r *AccountBalanceResolver) Error() *string {
+	if r.balance.Err == nil {
+		return nil
+	}
+	e := r.balance.Err.Error()
+	return &e
+}
+
+// UpdatedAt resolves the time the balance was fetched.
+func (r *AccountBalanceResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.balance.UpdatedAt}
+}
+
+// -- AccountBalances Query --
+
+type AccountBalancesPayloadResolver struct {
+	balances []balancemonitor.AccountBalance
+	NotFoundErrorUnionType
+}
+
+func NewAccountBalancesPayload(balances []balancemonitor.AccountBalance, err error) *AccountBalancesPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "balance monitor is disabled", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, errBalanceMonitorDisabled)
+	}}
+
+	return &AccountBalancesPayloadResolver{balances: balances, NotFoundErrorUnionType: e}
+}
+
+func (r *AccountBalancesPayloadResolver) ToAccountBalances() (*AccountBalancesResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &AccountBalancesResolver{balances: r.balances}, true
+}
+
+// AccountBalancesResolver resolves the AccountBalances type.
+type AccountBalancesResolver struct {
+	balances []balancemonitor.AccountBalance
+}
+
+func (r *AccountBalancesResolver) Results() []*AccountBalanceResolver {
+	return NewAccountBalances(r.balances)
+}
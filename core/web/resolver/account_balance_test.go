@@ -0,0 +1,137 @@
+package resolver
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/balancemonitor"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+type fakeBalanceMonitor struct {
+	services.Service
+	balances []balancemonitor.AccountBalance
+}
+
+func (f *fakeBalanceMonitor) Balances() []balancemonitor.AccountBalance { return f.balances }
+
+func TestResolver_AccountBalances(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetAccountBalances {
+			accountBalances {
+				... on AccountBalances {
+					results {
+						chainFamily
+						chainID
+						address
+						balance
+						token
+						decimals
+						lowBalance
+						error
+						updatedAt
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	updatedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "accountBalances"),
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"accountBalances"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BalanceMonitor").Return(&fakeBalanceMonitor{balances: []balancemonitor.AccountBalance{
+					{
+						ChainID:   relay.ID{Network: relay.Solana, ChainID: "devnet"},
+						Account:   "9xQeWvG816bUx9EPjHmaT23yvVM2ZWbrrpZb9PusVFin",
+						Token:     balancemonitor.Token{Symbol: "SOL", Decimals: 9},
+						Balance:   big.NewInt(500_000_000),
+						Low:       true,
+						UpdatedAt: updatedAt,
+					},
+					{
+						ChainID:   relay.ID{Network: relay.StarkNet, ChainID: "SN_GOERLI"},
+						Account:   "0x1",
+						Token:     balancemonitor.Token{Symbol: "ETH", Decimals: 18},
+						Err:       errors.New("rpc down"),
+						UpdatedAt: updatedAt,
+					},
+				}})
+			},
+			query: query,
+			result: `
+				{
+					"accountBalances": {
+						"results": [{
+							"chainFamily": "solana",
+							"chainID": "devnet",
+							"address": "9xQeWvG816bUx9EPjHmaT23yvVM2ZWbrrpZb9PusVFin",
+							"balance": "0.5",
+							"token": "SOL",
+							"decimals": 9,
+							"lowBalance": true,
+							"error": null,
+							"updatedAt": "2021-01-01T00:00:00Z"
+						}, {
+							"chainFamily": "starknet",
+							"chainID": "SN_GOERLI",
+							"address": "0x1",
+							"balance": null,
+							"token": "ETH",
+							"decimals": 18,
+							"lowBalance": false,
+							"error": "rpc down",
+							"updatedAt": "2021-01-01T00:00:00Z"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "disabled",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BalanceMonitor").Return(nil)
+			},
+			query: query,
+			result: `
+				{
+					"accountBalances": {
+						"message": "balance monitor is disabled",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
@@ -54,6 +56,24 @@ func authenticateUserIsAdmin(ctx context.Context) error {
 	return nil
 }
 
+// Asserts that the authenticated user belongs to no tenant, for resources which are shared by the whole node. Must
+// follow one of the authenticate functions above.
+func authenticateNodeUser(ctx context.Context) error {
+	if tenant := sessionTenant(ctx); tenant.Valid {
+		return TenantNotPermittedErr{tenant.String}
+	}
+	return nil
+}
+
+// sessionTenant returns the tenant of the authenticated user, which is null for node-wide users.
+func sessionTenant(ctx context.Context) null.String {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return null.String{}
+	}
+	return session.User.Tenant
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
@@ -73,3 +93,11 @@ type RoleNotPermittedErr struct {
 func (e RoleNotPermittedErr) Error() string {
 	return fmt.Sprintf("Not permitted with current role: %s", e.Role)
 }
+
+type TenantNotPermittedErr struct {
+	Tenant string
+}
+
+func (e TenantNotPermittedErr) Error() string {
+	return fmt.Sprintf("Not permitted for users of tenant: %s", e.Tenant)
+}
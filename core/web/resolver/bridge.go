@@ -1,24 +1,29 @@
 package resolver
 
 import (
+	"strings"
+	"time"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 )
 
 // BridgeResolver resolves the Bridge type.
 type BridgeResolver struct {
+	app    chainlink.Application
 	bridge bridges.BridgeType
 }
 
-func NewBridge(bridge bridges.BridgeType) *BridgeResolver {
-	return &BridgeResolver{bridge: bridge}
+func NewBridge(app chainlink.Application, bridge bridges.BridgeType) *BridgeResolver {
+	return &BridgeResolver{app: app, bridge: bridge}
 }
 
-func NewBridges(bridges []bridges.BridgeType) []*BridgeResolver {
+func NewBridges(app chainlink.Application, bridges []bridges.BridgeType) []*BridgeResolver {
 	var resolvers []*BridgeResolver
 	for _, b := range bridges {
-		resolvers = append(resolvers, NewBridge(b))
+		resolvers = append(resolvers, NewBridge(app, b))
 	}
 
 	return resolvers
@@ -54,27 +59,114 @@ func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
 }
 
+// ResponseSchema resolves the fields the bridge's responses are validated against.
+func (r *BridgeResolver) ResponseSchema() []*BridgeResponseFieldResolver {
+	resolvers := []*BridgeResponseFieldResolver{}
+	for _, f := range r.bridge.ResponseSchema {
+		resolvers = append(resolvers, &BridgeResponseFieldResolver{field: f})
+	}
+	return resolvers
+}
+
+// Health resolves the health of the bridge, as tracked by its circuit breaker.
+func (r *BridgeResolver) Health() *BridgeHealthResolver {
+	return &BridgeHealthResolver{health: r.app.BridgeCircuitBreakers().Health(r.bridge.Name)}
+}
+
+// Tenant resolves the tenant of the bridge, which is null for node-wide bridges.
+func (r *BridgeResolver) Tenant() *string {
+	return r.bridge.Tenant.Ptr()
+}
+
 // CreatedAt resolves the bridge's created at field.
 func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
 }
 
+// BridgeHealthResolver resolves the BridgeHealth type.
+type BridgeHealthResolver struct {
+	health bridges.Health
+}
+
+// Healthy resolves whether requests are sent to the bridge.
+func (r *BridgeHealthResolver) Healthy() bool {
+	return r.health.Healthy()
+}
+
+// State resolves the state of the circuit breaker of the bridge.
+func (r *BridgeHealthResolver) State() string {
+	return strings.ToUpper(string(r.health.State))
+}
+
+// Score resolves the exponentially weighted success rate of the requests to the bridge.
+func (r *BridgeHealthResolver) Score() float64 {
+	return r.health.Score
+}
+
+// ConsecutiveFailures resolves the number of consecutive failed requests to the bridge.
+func (r *BridgeHealthResolver) ConsecutiveFailures() int32 {
+	return int32(r.health.ConsecutiveFailures)
+}
+
+// OpenedAt resolves when the circuit breaker of the bridge opened, if it is not closed.
+func (r *BridgeHealthResolver) OpenedAt() *graphql.Time {
+	return optionalTime(r.health.OpenedAt)
+}
+
+// RecoversAt resolves when a probe request is let through to the bridge, if its circuit breaker is not closed.
+func (r *BridgeHealthResolver) RecoversAt() *graphql.Time {
+	return optionalTime(r.health.RecoversAt)
+}
+
+// RecoveredAt resolves when the circuit breaker of the bridge last closed after being open.
+func (r *BridgeHealthResolver) RecoveredAt() *graphql.Time {
+	return optionalTime(r.health.RecoveredAt)
+}
+
+func optionalTime(t time.Time) *graphql.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: t}
+}
+
+// BridgeResponseFieldResolver resolves the BridgeResponseField type.
+type BridgeResponseFieldResolver struct {
+	field bridges.ResponseField
+}
+
+// Path resolves the keypath of the field.
+func (r *BridgeResponseFieldResolver) Path() string {
+	return r.field.Path
+}
+
+// Type resolves the type of the field.
+func (r *BridgeResponseFieldResolver) Type() string {
+	return string(r.field.Type)
+}
+
+// Optional resolves whether the field may be missing.
+func (r *BridgeResponseFieldResolver) Optional() bool {
+	return r.field.Optional
+}
+
 // BridgePayloadResolver resolves a single bridge response
 type BridgePayloadResolver struct {
+	app    chainlink.Application
 	bridge bridges.BridgeType
 	NotFoundErrorUnionType
 }
 
-func NewBridgePayload(bridge bridges.BridgeType, err error) *BridgePayloadResolver {
+func NewBridgePayload(app chainlink.Application, bridge bridges.BridgeType, err error) *BridgePayloadResolver {
 	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
 
-	return &BridgePayloadResolver{bridge: bridge, NotFoundErrorUnionType: e}
+	return &BridgePayloadResolver{app: app, bridge: bridge, NotFoundErrorUnionType: e}
 }
 
 // ToBridge implements the Bridge union type of the payload
 func (r *BridgePayloadResolver) ToBridge() (*BridgeResolver, bool) {
 	if r.err == nil {
-		return NewBridge(r.bridge), true
+		return NewBridge(r.app, r.bridge), true
 	}
 
 	return nil, false
@@ -82,12 +174,14 @@ func (r *BridgePayloadResolver) ToBridge() (*BridgeResolver, bool) {
 
 // BridgesPayloadResolver resolves a page of bridges
 type BridgesPayloadResolver struct {
+	app     chainlink.Application
 	bridges []bridges.BridgeType
 	total   int32
 }
 
-func NewBridgesPayload(bridges []bridges.BridgeType, total int32) *BridgesPayloadResolver {
+func NewBridgesPayload(app chainlink.Application, bridges []bridges.BridgeType, total int32) *BridgesPayloadResolver {
 	return &BridgesPayloadResolver{
+		app:     app,
 		bridges: bridges,
 		total:   total,
 	}
@@ -95,7 +189,7 @@ func NewBridgesPayload(bridges []bridges.BridgeType, total int32) *BridgesPayloa
 
 // Results returns the bridges.
 func (r *BridgesPayloadResolver) Results() []*BridgeResolver {
-	return NewBridges(r.bridges)
+	return NewBridges(r.app, r.bridges)
 }
 
 // Metadata returns the pagination metadata.
@@ -105,28 +199,32 @@ func (r *BridgesPayloadResolver) Metadata() *PaginationMetadataResolver {
 
 // CreateBridgePayloadResolver
 type CreateBridgePayloadResolver struct {
+	app           chainlink.Application
 	bridge        bridges.BridgeType
 	incomingToken string
 }
 
-func NewCreateBridgePayload(bridge bridges.BridgeType, incomingToken string) *CreateBridgePayloadResolver {
+func NewCreateBridgePayload(app chainlink.Application, bridge bridges.BridgeType, incomingToken string) *CreateBridgePayloadResolver {
 	return &CreateBridgePayloadResolver{
+		app:           app,
 		bridge:        bridge,
 		incomingToken: incomingToken,
 	}
 }
 
 func (r *CreateBridgePayloadResolver) ToCreateBridgeSuccess() (*CreateBridgeSuccessResolver, bool) {
-	return NewCreateBridgeSuccessResolver(r.bridge, r.incomingToken), true
+	return NewCreateBridgeSuccessResolver(r.app, r.bridge, r.incomingToken), true
 }
 
 type CreateBridgeSuccessResolver struct {
+	app           chainlink.Application
 	bridge        bridges.BridgeType
 	incomingToken string
 }
 
-func NewCreateBridgeSuccessResolver(bridge bridges.BridgeType, incomingToken string) *CreateBridgeSuccessResolver {
+func NewCreateBridgeSuccessResolver(app chainlink.Application, bridge bridges.BridgeType, incomingToken string) *CreateBridgeSuccessResolver {
 	return &CreateBridgeSuccessResolver{
+		app:           app,
 		bridge:        bridge,
 		incomingToken: incomingToken,
 	}
@@ -134,7 +232,7 @@ func NewCreateBridgeSuccessResolver(bridge bridges.BridgeType, incomingToken str
 
 // Bridge resolves the bridge.
 func (r *CreateBridgeSuccessResolver) Bridge() *BridgeResolver {
-	return NewBridge(r.bridge)
+	return NewBridge(r.app, r.bridge)
 }
 
 // Token resolves the bridge's incoming token.
@@ -143,19 +241,20 @@ func (r *CreateBridgeSuccessResolver) IncomingToken() string {
 }
 
 type UpdateBridgePayloadResolver struct {
+	app    chainlink.Application
 	bridge *bridges.BridgeType
 	NotFoundErrorUnionType
 }
 
-func NewUpdateBridgePayload(bridge *bridges.BridgeType, err error) *UpdateBridgePayloadResolver {
+func NewUpdateBridgePayload(app chainlink.Application, bridge *bridges.BridgeType, err error) *UpdateBridgePayloadResolver {
 	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
 
-	return &UpdateBridgePayloadResolver{bridge: bridge, NotFoundErrorUnionType: e}
+	return &UpdateBridgePayloadResolver{app: app, bridge: bridge, NotFoundErrorUnionType: e}
 }
 
 func (r *UpdateBridgePayloadResolver) ToUpdateBridgeSuccess() (*UpdateBridgeSuccessResolver, bool) {
 	if r.bridge != nil {
-		return NewUpdateBridgeSuccess(*r.bridge), true
+		return NewUpdateBridgeSuccess(r.app, *r.bridge), true
 	}
 
 	return nil, false
@@ -163,36 +262,39 @@ func (r *UpdateBridgePayloadResolver) ToUpdateBridgeSuccess() (*UpdateBridgeSucc
 
 // UpdateBridgePayloadResolver resolves
 type UpdateBridgeSuccessResolver struct {
+	app    chainlink.Application
 	bridge bridges.BridgeType
 }
 
-func NewUpdateBridgeSuccess(bridge bridges.BridgeType) *UpdateBridgeSuccessResolver {
+func NewUpdateBridgeSuccess(app chainlink.Application, bridge bridges.BridgeType) *UpdateBridgeSuccessResolver {
 	return &UpdateBridgeSuccessResolver{
+		app:    app,
 		bridge: bridge,
 	}
 }
 
 // Bridge resolves the success payload's bridge.
 func (r *UpdateBridgeSuccessResolver) Bridge() *BridgeResolver {
-	return NewBridge(r.bridge)
+	return NewBridge(r.app, r.bridge)
 }
 
 // -- DeleteBridge mutation --
 
 type DeleteBridgePayloadResolver struct {
+	app    chainlink.Application
 	bridge *bridges.BridgeType
 	NotFoundErrorUnionType
 }
 
-func NewDeleteBridgePayload(bridge *bridges.BridgeType, err error) *DeleteBridgePayloadResolver {
+func NewDeleteBridgePayload(app chainlink.Application, bridge *bridges.BridgeType, err error) *DeleteBridgePayloadResolver {
 	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
 
-	return &DeleteBridgePayloadResolver{bridge: bridge, NotFoundErrorUnionType: e}
+	return &DeleteBridgePayloadResolver{app: app, bridge: bridge, NotFoundErrorUnionType: e}
 }
 
 func (r *DeleteBridgePayloadResolver) ToDeleteBridgeSuccess() (*DeleteBridgeSuccessResolver, bool) {
 	if r.bridge != nil {
-		return NewDeleteBridgeSuccess(r.bridge), true
+		return NewDeleteBridgeSuccess(r.app, r.bridge), true
 	}
 
 	return nil, false
@@ -215,15 +317,16 @@ func (r *DeleteBridgePayloadResolver) ToDeleteBridgeInvalidNameError() (*DeleteB
 }
 
 type DeleteBridgeSuccessResolver struct {
+	app    chainlink.Application
 	bridge *bridges.BridgeType
 }
 
-func NewDeleteBridgeSuccess(bridge *bridges.BridgeType) *DeleteBridgeSuccessResolver {
-	return &DeleteBridgeSuccessResolver{bridge: bridge}
+func NewDeleteBridgeSuccess(app chainlink.Application, bridge *bridges.BridgeType) *DeleteBridgeSuccessResolver {
+	return &DeleteBridgeSuccessResolver{app: app, bridge: bridge}
 }
 
 func (r *DeleteBridgeSuccessResolver) Bridge() *BridgeResolver {
-	return NewBridge(*r.bridge)
+	return NewBridge(r.app, *r.bridge)
 }
 
 type DeleteBridgeConflictErrorResolver struct {
@@ -3,15 +3,21 @@ package resolver
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -97,6 +103,20 @@ func Test_Bridge(t *testing.T) {
 						confirmations
 						outgoingToken
 						minimumContractPayment
+						responseSchema {
+							path
+							type
+							optional
+						}
+						health {
+							healthy
+							state
+							score
+							consecutiveFailures
+							openedAt
+							recoversAt
+							recoveredAt
+						}
 						createdAt
 					}
 					... on NotFoundError {
@@ -106,6 +126,21 @@ func Test_Bridge(t *testing.T) {
 				}
 			}`
 
+		healthQuery = `
+			query GetBridgeHealth{
+				bridge(id: "bridge1") {
+					... on Bridge {
+						health {
+							healthy
+							state
+							score
+							consecutiveFailures
+							recoveredAt
+						}
+					}
+				}
+			}`
+
 		name = bridges.BridgeName("bridge1")
 	)
 	bridgeURL, err := url.Parse("https://external.adapter")
@@ -124,8 +159,10 @@ func Test_Bridge(t *testing.T) {
 					Confirmations:          uint32(1),
 					OutgoingToken:          "outgoingToken",
 					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					ResponseSchema:         bridges.ResponseSchema{{Path: "data.result", Type: bridges.ResponseFieldDecimal}},
 					CreatedAt:              f.Timestamp(),
 				}, nil)
+				f.App.On("BridgeCircuitBreakers").Return(bridges.NewCircuitBreakers(configtest.NewGeneralConfig(t, nil).JobPipeline().BridgeCircuitBreaker(), logger.TestLogger(t)))
 			},
 			query: query,
 			result: `{
@@ -136,10 +173,55 @@ func Test_Bridge(t *testing.T) {
 					"confirmations": 1,
 					"outgoingToken": "outgoingToken",
 					"minimumContractPayment": "1",
+					"responseSchema": [{"path": "data.result", "type": "decimal", "optional": false}],
+					"health": {
+						"healthy": true,
+						"state": "CLOSED",
+						"score": 1,
+						"consecutiveFailures": 0,
+						"openedAt": null,
+						"recoversAt": null,
+						"recoveredAt": null
+					},
 					"createdAt": "2021-01-01T00:00:00Z"
 				}
 			}`,
 		},
+		{
+			name:          "unhealthy",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", name).Return(bridges.BridgeType{
+					Name:                   name,
+					URL:                    models.WebURL(*bridgeURL),
+					Confirmations:          uint32(1),
+					OutgoingToken:          "outgoingToken",
+					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					CreatedAt:              f.Timestamp(),
+				}, nil)
+				cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+					c.JobPipeline.BridgeCircuitBreaker.Enabled = ptr(true)
+					c.JobPipeline.BridgeCircuitBreaker.FailureThreshold = ptr[uint32](1)
+					c.JobPipeline.BridgeCircuitBreaker.OpenTimeout = commonconfig.MustNewDuration(time.Hour)
+				})
+				breakers := bridges.NewCircuitBreakers(cfg.JobPipeline().BridgeCircuitBreaker(), logger.TestLogger(t))
+				breakers.Record(name, time.Second, errors.New("bridge is down"))
+				f.App.On("BridgeCircuitBreakers").Return(breakers)
+			},
+			query: healthQuery,
+			result: `{
+				"bridge": {
+					"health": {
+						"healthy": false,
+						"state": "OPEN",
+						"score": 0.9,
+						"consecutiveFailures": 1,
+						"recoveredAt": null
+					}
+				}
+			}`,
+		},
 		{
 			name:          "not found",
 			authenticated: true,
@@ -176,6 +258,11 @@ func Test_CreateBridge(t *testing.T) {
 							confirmations
 							outgoingToken
 							minimumContractPayment
+							responseSchema {
+								path
+								type
+								optional
+							}
 							createdAt
 						}
 					}
@@ -187,8 +274,16 @@ func Test_CreateBridge(t *testing.T) {
 				"url":                    "https://external.adapter",
 				"confirmations":          1,
 				"minimumContractPayment": "1",
+				"responseSchema": []interface{}{
+					map[string]interface{}{"path": "data.result", "type": "decimal"},
+					map[string]interface{}{"path": "data.ok", "type": "bool", "optional": true},
+				},
 			},
 		}
+		schema = bridges.ResponseSchema{
+			{Path: "data.result", Type: bridges.ResponseFieldDecimal},
+			{Path: "data.ok", Type: bridges.ResponseFieldBool, Optional: true},
+		}
 	)
 	bridgeURL, err := url.Parse("https://external.adapter")
 	require.NoError(t, err)
@@ -204,12 +299,14 @@ func Test_CreateBridge(t *testing.T) {
 				f.Mocks.bridgeORM.On("CreateBridgeType", mock.IsType(&bridges.BridgeType{})).
 					Run(func(args mock.Arguments) {
 						arg := args.Get(0).(*bridges.BridgeType)
+						require.Equal(t, schema, arg.ResponseSchema)
 						*arg = bridges.BridgeType{
 							Name:                   name,
 							URL:                    models.WebURL(*bridgeURL),
 							Confirmations:          uint32(1),
 							OutgoingToken:          "outgoingToken",
 							MinimumContractPayment: assets.NewLinkFromJuels(1),
+							ResponseSchema:         schema,
 							CreatedAt:              f.Timestamp(),
 						}
 					}).
@@ -229,6 +326,10 @@ func Test_CreateBridge(t *testing.T) {
 							"confirmations": 1,
 							"outgoingToken": "outgoingToken",
 							"minimumContractPayment": "1",
+							"responseSchema": [
+								{"path": "data.result", "type": "decimal", "optional": false},
+								{"path": "data.ok", "type": "bool", "optional": true}
+							],
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -74,3 +74,32 @@ func (r *ChainsPayloadResolver) Results() []*ChainResolver {
 func (r *ChainsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
+
+// -- SetChainEnabled Mutation --
+
+type SetChainEnabledPayloadResolver struct {
+	chain *types.ChainStatus
+	NotFoundErrorUnionType
+}
+
+func NewSetChainEnabledPayload(chain *types.ChainStatus, err error) *SetChainEnabledPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "chain not found", isExpectedErrorFn: nil}
+
+	return &SetChainEnabledPayloadResolver{chain: chain, NotFoundErrorUnionType: e}
+}
+
+func (r *SetChainEnabledPayloadResolver) ToSetChainEnabledSuccess() (*SetChainEnabledSuccessResolver, bool) {
+	if r.chain != nil {
+		return &SetChainEnabledSuccessResolver{chain: *r.chain}, true
+	}
+
+	return nil, false
+}
+
+type SetChainEnabledSuccessResolver struct {
+	chain types.ChainStatus
+}
+
+func (r *SetChainEnabledSuccessResolver) Chain() *ChainResolver {
+	return NewChain(r.chain)
+}
@@ -5,11 +5,17 @@ import (
 	"fmt"
 	"testing"
 
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	evmtoml "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 )
 
 func TestResolver_Chains(t *testing.T) {
@@ -192,3 +198,82 @@ ResendAfterThreshold = '1h0m0s'
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_SetChainEnabled(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SetChainEnabled($id: ID!, $enabled: Boolean!) {
+			setChainEnabled(id: $id, enabled: $enabled) {
+				... on SetChainEnabledSuccess {
+					chain {
+						id
+						enabled
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"id": "1", "enabled": false}
+	relayID := relay.ID{Network: relay.EVM, ChainID: "1"}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "setChainEnabled"),
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"setChainEnabled"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SetChainEnabled", mock.Anything, relayID, false).Return(types.ChainStatus{ID: "1", Enabled: false}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"setChainEnabled": {
+						"chain": {
+							"id": "1",
+							"enabled": false
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SetChainEnabled", mock.Anything, relayID, false).Return(types.ChainStatus{}, chains.ErrNotFound)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"setChainEnabled": {
+						"message": "chain not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
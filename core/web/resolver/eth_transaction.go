@@ -164,3 +164,90 @@ func (r *EthTransactionsPayloadResolver) Results() []*EthTransactionResolver {
 func (r *EthTransactionsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
+
+// -- DeadLetterEthTransactions Query --
+
+type DeadLetterEthTransactionResolver struct {
+	letter txmgr.DeadLetter
+}
+
+func NewDeadLetterEthTransaction(letter txmgr.DeadLetter) *DeadLetterEthTransactionResolver {
+	return &DeadLetterEthTransactionResolver{letter: letter}
+}
+
+func NewDeadLetterEthTransactions(results []txmgr.DeadLetter) []*DeadLetterEthTransactionResolver {
+	var resolver []*DeadLetterEthTransactionResolver
+
+	for _, letter := range results {
+		resolver = append(resolver, NewDeadLetterEthTransaction(letter))
+	}
+
+	return resolver
+}
+
+// ID resolves the ID of the transaction, which is used to requeue it.
+func (r *DeadLetterEthTransactionResolver) ID() graphql.ID {
+	return int64GQLID(r.letter.Tx.ID)
+}
+
+func (r *DeadLetterEthTransactionResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.letter.Tx)
+}
+
+func (r *DeadLetterEthTransactionResolver) Error() string {
+	return r.letter.Tx.Error.String
+}
+
+func (r *DeadLetterEthTransactionResolver) Classification() string {
+	return string(r.letter.Classification)
+}
+
+func (r *DeadLetterEthTransactionResolver) DeadLetteredAt() graphql.Time {
+	return graphql.Time{Time: r.letter.DeadLetteredAt}
+}
+
+type DeadLetterEthTransactionsPayloadResolver struct {
+	results []txmgr.DeadLetter
+	total   int32
+}
+
+func NewDeadLetterEthTransactionsPayload(results []txmgr.DeadLetter, total int32) *DeadLetterEthTransactionsPayloadResolver {
+	return &DeadLetterEthTransactionsPayloadResolver{results: results, total: total}
+}
+
+func (r *DeadLetterEthTransactionsPayloadResolver) Results() []*DeadLetterEthTransactionResolver {
+	return NewDeadLetterEthTransactions(r.results)
+}
+
+func (r *DeadLetterEthTransactionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// -- RequeueDeadLetterEthTransaction Mutation --
+
+type RequeueDeadLetterEthTransactionPayloadResolver struct {
+	tx *txmgr.Tx
+	NotFoundErrorUnionType
+}
+
+func NewRequeueDeadLetterEthTransactionPayload(tx *txmgr.Tx, err error) *RequeueDeadLetterEthTransactionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "dead-lettered transaction not found"}
+
+	return &RequeueDeadLetterEthTransactionPayloadResolver{tx: tx, NotFoundErrorUnionType: e}
+}
+
+func (r *RequeueDeadLetterEthTransactionPayloadResolver) ToRequeueDeadLetterEthTransactionSuccess() (*RequeueDeadLetterEthTransactionSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &RequeueDeadLetterEthTransactionSuccessResolver{tx: *r.tx}, true
+}
+
+type RequeueDeadLetterEthTransactionSuccessResolver struct {
+	tx txmgr.Tx
+}
+
+func (r *RequeueDeadLetterEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
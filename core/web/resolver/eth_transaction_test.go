@@ -8,6 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -317,6 +319,178 @@ func TestResolver_EthTransactions(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_DeadLetterEthTransactions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetDeadLetterEthTransactions {
+			deadLetterEthTransactions {
+				results {
+					id
+					transaction {
+						from
+						state
+						data
+					}
+					error
+					classification
+					deadLetteredAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "deadLetterEthTransactions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmStore.On("DeadLetters", PageDefaultOffset, PageDefaultLimit).Return([]txmgr.DeadLetter{
+					{
+						Tx: txmgr.Tx{
+							ID:             1,
+							FromAddress:    common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81"),
+							State:          txmgrcommon.TxFatalError,
+							EncodedPayload: []byte("encoded payload"),
+							Error:          null.StringFrom("insufficient funds for gas * price + value"),
+						},
+						Classification: txmgr.DeadLetterInsufficientFunds,
+						DeadLetteredAt: f.Timestamp(),
+					},
+				}, 1, nil)
+				f.App.On("TxmStorageService").Return(f.Mocks.txmStore)
+			},
+			query: query,
+			result: `
+				{
+					"deadLetterEthTransactions": {
+						"results": [{
+							"id": "1",
+							"transaction": {
+								"from": "0x5431F5F973781809D18643b87B44921b11355d81",
+								"state": "fatal_error",
+								"data": "0x656e636f646564207061796c6f6164"
+							},
+							"error": "insufficient funds for gas * price + value",
+							"classification": "insufficient_funds",
+							"deadLetteredAt": "2021-01-01T00:00:00Z"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmStore.On("DeadLetters", PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("TxmStorageService").Return(f.Mocks.txmStore)
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"deadLetterEthTransactions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RequeueDeadLetterEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RequeueDeadLetterEthTransaction($id: ID!) {
+			requeueDeadLetterEthTransaction(id: $id) {
+				... on RequeueDeadLetterEthTransactionSuccess {
+					transaction {
+						state
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "1",
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "requeueDeadLetterEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmStore.On("RequeueDeadLetter", mock.Anything, int64(1)).Return(&txmgr.Tx{ID: 1, State: txmgrcommon.TxUnstarted}, nil)
+				f.App.On("TxmStorageService").Return(f.Mocks.txmStore)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"requeueDeadLetterEthTransaction": {
+						"transaction": {
+							"state": "unstarted"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmStore.On("RequeueDeadLetter", mock.Anything, int64(1)).Return(nil, sql.ErrNoRows)
+				f.App.On("TxmStorageService").Return(f.Mocks.txmStore)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"requeueDeadLetterEthTransaction": {
+						"code": "NOT_FOUND",
+						"message": "dead-lettered transaction not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmStore.On("RequeueDeadLetter", mock.Anything, int64(1)).Return(nil, gError)
+				f.App.On("TxmStorageService").Return(f.Mocks.txmStore)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"requeueDeadLetterEthTransaction"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_EthTransactionsAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,254 @@
+package resolver
+
+import (
+	"errors"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+var errGatewayNotFound = errors.New("gateway not found")
+
+// GatewayHandler is the handler of a DON in the gateway of a job.
+type GatewayHandler struct {
+	JobID int32
+	gateway.HandlerStatus
+}
+
+// GatewayHandlerResolver resolves the GatewayHandler type.
+type GatewayHandlerResolver struct {
+	handler GatewayHandler
+}
+
+func NewGatewayHandler(handler GatewayHandler) *GatewayHandlerResolver {
+	return &GatewayHandlerResolver{handler: handler}
+}
+
+func NewGatewayHandlers(handlers []GatewayHandler) []*GatewayHandlerResolver {
+	var resolvers []*GatewayHandlerResolver
+	for _, h := range handlers {
+		resolvers = append(resolvers, NewGatewayHandler(h))
+	}
+
+	return resolvers
+}
+
+// JobID resolves the ID of the gateway job.
+func (r *GatewayHandlerResolver) JobID() graphql.ID {
+	return graphql.ID(stringutils.FromInt32(r.handler.JobID))
+}
+
+// DonID resolves the ID of the DON of the handler.
+func (r *GatewayHandlerResolver) DonID() string {
+	return r.handler.DonId
+}
+
+// HandlerName resolves the type of the handler.
+func (r *GatewayHandlerResolver) HandlerName() string {
+	return r.handler.HandlerName
+}
+
+// Enabled resolves whether the handler accepts user messages.
+func (r *GatewayHandlerResolver) Enabled() bool {
+	return r.handler.Enabled
+}
+
+// Policy resolves the policy enforced on the user messages of the handler.
+func (r *GatewayHandlerResolver) Policy() *GatewayHandlerPolicyResolver {
+	return &GatewayHandlerPolicyResolver{policy: r.handler.Policy}
+}
+
+// GatewayHandlerPolicyResolver resolves the GatewayHandlerPolicy type.
+type GatewayHandlerPolicyResolver struct {
+	policy config.HandlerPolicy
+}
+
+// RateLimit resolves the rate limit of the handler, if any.
+func (r *GatewayHandlerPolicyResolver) RateLimit() *GatewayHandlerRateLimitResolver {
+	if r.policy.RateLimit == nil {
+		return nil
+	}
+	return &GatewayHandlerRateLimitResolver{rateLimit: *r.policy.RateLimit}
+}
+
+// AllowedSenders resolves the only senders allowed, all senders are allowed if empty.
+func (r *GatewayHandlerPolicyResolver) AllowedSenders() []string {
+	return append([]string{}, r.policy.AllowedSenders...)
+}
+
+// AllowedMethods resolves the only methods allowed, all methods are allowed if empty.
+func (r *GatewayHandlerPolicyResolver) AllowedMethods() []string {
+	return append([]string{}, r.policy.AllowedMethods...)
+}
+
+// GatewayHandlerRateLimitResolver resolves the GatewayHandlerRateLimit type.
+type GatewayHandlerRateLimitResolver struct {
+	rateLimit config.HandlerRateLimit
+}
+
+func (r *GatewayHandlerRateLimitResolver) GlobalRPS() float64 {
+	return r.rateLimit.GlobalRPS
+}
+
+func (r *GatewayHandlerRateLimitResolver) GlobalBurst() int32 {
+	return int32(r.rateLimit.GlobalBurst)
+}
+
+func (r *GatewayHandlerRateLimitResolver) PerSenderRPS() float64 {
+	return r.rateLimit.PerSenderRPS
+}
+
+func (r *GatewayHandlerRateLimitResolver) PerSenderBurst() int32 {
+	return int32(r.rateLimit.PerSenderBurst)
+}
+
+// -- GatewayHandlers Query --
+
+type GatewayHandlersPayloadResolver struct {
+	handlers []GatewayHandler
+	NotFoundErrorUnionType
+}
+
+func NewGatewayHandlersPayload(handlers []GatewayHandler, err error) *GatewayHandlersPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "gateway not found", isExpectedErrorFn: isGatewayNotFoundError}
+
+	return &GatewayHandlersPayloadResolver{handlers: handlers, NotFoundErrorUnionType: e}
+}
+
+func (r *GatewayHandlersPayloadResolver) ToGatewayHandlers() (*GatewayHandlersResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &GatewayHandlersResolver{handlers: r.handlers}, true
+}
+
+// GatewayHandlersResolver resolves the GatewayHandlers type.
+type GatewayHandlersResolver struct {
+	handlers []GatewayHandler
+}
+
+func (r *GatewayHandlersResolver) Results() []*GatewayHandlerResolver {
+	return NewGatewayHandlers(r.handlers)
+}
+
+// -- AddGatewayHandler Mutation --
+
+type AddGatewayHandlerPayloadResolver struct {
+	handler *GatewayHandler
+	// inputErrs maps an input path to a string
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewAddGatewayHandlerPayload(handler *GatewayHandler, err error, inputErrs map[string]string) *AddGatewayHandlerPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "gateway not found", isExpectedErrorFn: isGatewayNotFoundError}
+
+	return &AddGatewayHandlerPayloadResolver{handler: handler, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *AddGatewayHandlerPayloadResolver) ToAddGatewayHandlerSuccess() (*GatewayHandlerSuccessResolver, bool) {
+	if r.handler != nil {
+		return &GatewayHandlerSuccessResolver{handler: *r.handler}, true
+	}
+
+	return nil, false
+}
+
+func (r *AddGatewayHandlerPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	return toInputErrors(r.inputErrs)
+}
+
+// -- SetGatewayHandlerEnabled Mutation --
+
+type SetGatewayHandlerEnabledPayloadResolver struct {
+	handler *GatewayHandler
+	NotFoundErrorUnionType
+}
+
+func NewSetGatewayHandlerEnabledPayload(handler *GatewayHandler, err error) *SetGatewayHandlerEnabledPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: gatewayNotFoundMessage(err), isExpectedErrorFn: isGatewayNotFoundError}
+
+	return &SetGatewayHandlerEnabledPayloadResolver{handler: handler, NotFoundErrorUnionType: e}
+}
+
+func (r *SetGatewayHandlerEnabledPayloadResolver) ToSetGatewayHandlerEnabledSuccess() (*GatewayHandlerSuccessResolver, bool) {
+	if r.handler != nil {
+		return &GatewayHandlerSuccessResolver{handler: *r.handler}, true
+	}
+
+	return nil, false
+}
+
+// -- UpdateGatewayHandlerPolicy Mutation --
+
+type UpdateGatewayHandlerPolicyPayloadResolver struct {
+	handler *GatewayHandler
+	// inputErrs maps an input path to a string
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateGatewayHandlerPolicyPayload(handler *GatewayHandler, err error, inputErrs map[string]string) *UpdateGatewayHandlerPolicyPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: gatewayNotFoundMessage(err), isExpectedErrorFn: isGatewayNotFoundError}
+
+	return &UpdateGatewayHandlerPolicyPayloadResolver{handler: handler, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdateGatewayHandlerPolicyPayloadResolver) ToUpdateGatewayHandlerPolicySuccess() (*GatewayHandlerSuccessResolver, bool) {
+	if r.handler != nil {
+		return &GatewayHandlerSuccessResolver{handler: *r.handler}, true
+	}
+
+	return nil, false
+}
+
+func (r *UpdateGatewayHandlerPolicyPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	return toInputErrors(r.inputErrs)
+}
+
+// GatewayHandlerSuccessResolver resolves the success payloads of the gateway handler mutations.
+type GatewayHandlerSuccessResolver struct {
+	handler GatewayHandler
+}
+
+func (r *GatewayHandlerSuccessResolver) Handler() *GatewayHandlerResolver {
+	return NewGatewayHandler(r.handler)
+}
+
+func isGatewayNotFoundError(err error) bool {
+	return errors.Is(err, errGatewayNotFound) || errors.Is(err, gateway.ErrHandlerNotFound)
+}
+
+func gatewayNotFoundMessage(err error) string {
+	if errors.Is(err, gateway.ErrHandlerNotFound) {
+		return "handler not found"
+	}
+	return "gateway not found"
+}
+
+func toInputErrors(inputErrs map[string]string) (*InputErrorsResolver, bool) {
+	if inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+	for path, message := range inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+// handlerStatus returns the status of the handler of a DON in the gateway.
+func handlerStatus(gw gateway.Gateway, donID string) (gateway.HandlerStatus, error) {
+	for _, status := range gw.Handlers() {
+		if status.DonId == donID {
+			return status, nil
+		}
+	}
+	return gateway.HandlerStatus{}, gateway.ErrHandlerNotFound
+}
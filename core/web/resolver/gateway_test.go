@@ -0,0 +1,350 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+func newTestGateway(t *testing.T) gateway.Gateway {
+	lggr := logger.TestLogger(t)
+	cfg := &config.GatewayConfig{
+		UserServerConfig: network.HTTPServerConfig{Path: "/user"},
+		NodeServerConfig: network.WebSocketServerConfig{HTTPServerConfig: network.HTTPServerConfig{Path: "/node"}},
+		Dons: []config.DONConfig{{
+			DonId:       "my_don",
+			HandlerName: gateway.DummyHandlerType,
+			Policy:      config.HandlerPolicy{AllowedMethods: []string{"request"}},
+		}},
+	}
+	gw, err := gateway.NewGatewayFromConfig(cfg, gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	require.NoError(t, err)
+	return gw
+}
+
+func TestResolver_GatewayHandlers(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetGatewayHandlers($jobID: ID) {
+			gatewayHandlers(jobID: $jobID) {
+				... on GatewayHandlers {
+					results {
+						jobID
+						donID
+						handlerName
+						enabled
+						policy {
+							rateLimit {
+								globalRPS
+							}
+							allowedSenders
+							allowedMethods
+						}
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "gatewayHandlers"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query: query,
+			result: `
+				{
+					"gatewayHandlers": {
+						"results": [{
+							"jobID": "1",
+							"donID": "my_don",
+							"handlerName": "dummy",
+							"enabled": true,
+							"policy": {
+								"rateLimit": null,
+								"allowedSenders": [],
+								"allowedMethods": ["request"]
+							}
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "job not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query:     query,
+			variables: map[string]interface{}{"jobID": "2"},
+			result: `
+				{
+					"gatewayHandlers": {
+						"message": "gateway not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_AddGatewayHandler(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation AddGatewayHandler($jobID: ID!, $input: AddGatewayHandlerInput!) {
+			addGatewayHandler(jobID: $jobID, input: $input) {
+				... on AddGatewayHandlerSuccess {
+					handler {
+						donID
+						enabled
+						policy {
+							rateLimit {
+								perSenderBurst
+							}
+						}
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	input := func(donID string) map[string]interface{} {
+		return map[string]interface{}{
+			"donID":       donID,
+			"handlerName": "dummy",
+			"members":     []interface{}{map[string]interface{}{"name": "node", "address": "0x0001020304050607080900010203040506070809"}},
+			"f":           0,
+			"enabled":     false,
+			"policy": map[string]interface{}{
+				"rateLimit": map[string]interface{}{"globalRPS": 10, "globalBurst": 10, "perSenderRPS": 1, "perSenderBurst": 2},
+			},
+		}
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: map[string]interface{}{"jobID": "1", "input": input("new_don")}}, "addGatewayHandler"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"jobID": "1", "input": input("new_don")},
+			result: `
+				{
+					"addGatewayHandler": {
+						"handler": {
+							"donID": "new_don",
+							"enabled": false,
+							"policy": {
+								"rateLimit": {
+									"perSenderBurst": 2
+								}
+							}
+						}
+					}
+				}`,
+		},
+		{
+			name:          "duplicate DON ID",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"jobID": "1", "input": input("my_don")},
+			result: `
+				{
+					"addGatewayHandler": {
+						"errors": [{
+							"path": "input/donID",
+							"message": "handler already exists: DON ID my_don",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "job not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{})
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"jobID": "1", "input": input("new_don")},
+			result: `
+				{
+					"addGatewayHandler": {
+						"message": "gateway not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_SetGatewayHandlerEnabled(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SetGatewayHandlerEnabled($jobID: ID!, $donID: String!, $enabled: Boolean!) {
+			setGatewayHandlerEnabled(jobID: $jobID, donID: $donID, enabled: $enabled) {
+				... on SetGatewayHandlerEnabledSuccess {
+					handler {
+						donID
+						enabled
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"jobID": "1", "donID": "my_don", "enabled": false}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "setGatewayHandlerEnabled"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"setGatewayHandlerEnabled": {
+						"handler": {
+							"donID": "my_don",
+							"enabled": false
+						}
+					}
+				}`,
+		},
+		{
+			name:          "handler not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"jobID": "1", "donID": "other_don", "enabled": false},
+			result: `
+				{
+					"setGatewayHandlerEnabled": {
+						"message": "handler not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_UpdateGatewayHandlerPolicy(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation UpdateGatewayHandlerPolicy($jobID: ID!, $donID: String!, $input: GatewayHandlerPolicyInput!) {
+			updateGatewayHandlerPolicy(jobID: $jobID, donID: $donID, input: $input) {
+				... on UpdateGatewayHandlerPolicySuccess {
+					handler {
+						policy {
+							allowedSenders
+							allowedMethods
+						}
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: map[string]interface{}{"jobID": "1", "donID": "my_don", "input": map[string]interface{}{}}}, "updateGatewayHandlerPolicy"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query: mutation,
+			variables: map[string]interface{}{"jobID": "1", "donID": "my_don", "input": map[string]interface{}{
+				"allowedSenders": []interface{}{"0x000102030405060708090001020304050607080A"},
+			}},
+			result: `
+				{
+					"updateGatewayHandlerPolicy": {
+						"handler": {
+							"policy": {
+								"allowedSenders": ["0x000102030405060708090001020304050607080a"],
+								"allowedMethods": []
+							}
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid policy",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("Gateways").Return(map[int32]gateway.Gateway{1: newTestGateway(t)})
+			},
+			query: mutation,
+			variables: map[string]interface{}{"jobID": "1", "donID": "my_don", "input": map[string]interface{}{
+				"allowedSenders": []interface{}{"0xnot_an_address"},
+			}},
+			result: `
+				{
+					"updateGatewayHandlerPolicy": {
+						"errors": [{
+							"path": "input",
+							"message": "invalid allowed sender address 0xnot_an_address",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -85,6 +85,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if err := bt.ResponseSchema.Validate(); err != nil {
+		return errors.Wrap(err, "invalid response schema")
+	}
 
 	return nil
 }
@@ -2,11 +2,13 @@ package resolver
 
 import (
 	"context"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
@@ -34,6 +36,11 @@ func (r *JobResolver) ID() graphql.ID {
 	return int32GQLID(r.j.ID)
 }
 
+// Tenant resolves the tenant of the job, which is null for node-wide jobs.
+func (r *JobResolver) Tenant() *string {
+	return r.j.Tenant.Ptr()
+}
+
 // CreatedAt resolves the job's created at timestamp.
 func (r *JobResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.j.CreatedAt}
@@ -72,6 +79,20 @@ func (r *JobResolver) ObservationSource() string {
 	return r.j.PipelineSpec.DotDagSource
 }
 
+// PipelineGraph resolves the parsed graph of the job's observation source.
+func (r *JobResolver) PipelineGraph() (*PipelineGraphResolver, error) {
+	if strings.TrimSpace(r.j.PipelineSpec.DotDagSource) == "" {
+		return nil, nil
+	}
+
+	p, err := pipeline.Parse(r.j.PipelineSpec.DotDagSource)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPipelineGraph(p.DAG()), nil
+}
+
 // SchemaVersion resolves the job's schema version.
 func (r *JobResolver) SchemaVersion() int32 {
 	return int32(r.j.SchemaVersion)
@@ -91,6 +112,40 @@ func (r *JobResolver) ForwardingAllowed() *bool {
 	return &r.j.ForwardingAllowed
 }
 
+// DependsOn resolves the external job IDs of the jobs the job depends on.
+func (r *JobResolver) DependsOn() []string {
+	return append([]string{}, r.j.DependsOn...)
+}
+
+// DependsOnChains resolves the relay IDs of the chains the job depends on.
+func (r *JobResolver) DependsOnChains() []string {
+	return append([]string{}, r.j.DependsOnChains...)
+}
+
+// WaitingOn resolves the dependencies the job is waiting on to be started.
+func (r *JobResolver) WaitingOn() []string {
+	return append([]string{}, r.app.JobSpawner().WaitingJobs()[r.j.ID]...)
+}
+
+// StartFailure resolves the failures to start the services of the job, if any.
+func (r *JobResolver) StartFailure() *JobStartFailureResolver {
+	failure, ok := r.app.JobSpawner().FailedJobs()[r.j.ID]
+	if !ok {
+		return nil
+	}
+	return &JobStartFailureResolver{failure: failure}
+}
+
+// Versions resolves the recorded versions of the job, latest first.
+func (r *JobResolver) Versions() ([]*JobVersionResolver, error) {
+	versions, err := r.app.JobORM().FindJobVersions(r.j.ExternalJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobVersions(versions), nil
+}
+
 // Type resolves the job's type.
 func (r *JobResolver) Type() string {
 	return string(r.j.Type)
@@ -113,7 +168,7 @@ func (r *JobResolver) Runs(ctx context.Context, args struct {
 		limit = 100
 	}
 
-	ids, err := r.app.JobORM().FindPipelineRunIDsByJobID(r.j.ID, offset, limit)
+	ids, err := r.app.ReplicaJobORM().FindPipelineRunIDsByJobID(r.j.ID, offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +178,7 @@ func (r *JobResolver) Runs(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	count, err := r.app.JobORM().CountPipelineRunsByJobID(r.j.ID)
+	count, err := r.app.ReplicaJobORM().CountPipelineRunsByJobID(r.j.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -258,3 +313,62 @@ func NewDeleteJobSuccess(app chainlink.Application, job *job.Job) *DeleteJobSucc
 func (r *DeleteJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
+
+// JobStartFailureResolver resolves the JobStartFailure type.
+type JobStartFailureResolver struct {
+	failure job.StartFailure
+}
+
+// Failures resolves the number of consecutive failures to start the job.
+func (r *JobStartFailureResolver) Failures() int32 {
+	return int32(r.failure.Failures)
+}
+
+// LastError resolves the error of the last attempt to start the job.
+func (r *JobStartFailureResolver) LastError() string {
+	return r.failure.LastError
+}
+
+// NextAttemptAt resolves the time of the next attempt to start the job.
+func (r *JobStartFailureResolver) NextAttemptAt() *graphql.Time {
+	if r.failure.Quarantined {
+		return nil
+	}
+	return &graphql.Time{Time: r.failure.NextAttempt}
+}
+
+// Quarantined resolves whether the job is no longer started until it is restarted.
+func (r *JobStartFailureResolver) Quarantined() bool {
+	return r.failure.Quarantined
+}
+
+// -- RestartJob Mutation --
+
+type RestartJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewRestartJobPayload(app chainlink.Application, j *job.Job, err error) *RestartJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &RestartJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *RestartJobPayloadResolver) ToRestartJobSuccess() (*RestartJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &RestartJobSuccessResolver{app: r.app, j: r.j}, true
+}
+
+type RestartJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *RestartJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
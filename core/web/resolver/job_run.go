@@ -2,12 +2,15 @@ package resolver
 
 import (
 	"context"
+	"time"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/archive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
@@ -38,6 +41,90 @@ func NewJobRunStatus(status pipeline.RunStatus) JobRunStatus {
 	}
 }
 
+// toRunStatus returns the pipeline run status of the status.
+func (s JobRunStatus) toRunStatus() pipeline.RunStatus {
+	switch s {
+	case JobRunStatusRunning:
+		return pipeline.RunStatusRunning
+	case JobRunStatusSuspended:
+		return pipeline.RunStatusSuspended
+	case JobRunStatusErrored:
+		return pipeline.RunStatusErrored
+	case JobRunStatusCompleted:
+		return pipeline.RunStatusCompleted
+	default:
+		return pipeline.RunStatusUnknown
+	}
+}
+
+type TaskRunStatus string
+
+const (
+	TaskRunStatusRunning   TaskRunStatus = "RUNNING"
+	TaskRunStatusCompleted TaskRunStatus = "COMPLETED"
+	TaskRunStatusErrored   TaskRunStatus = "ERRORED"
+)
+
+func (s TaskRunStatus) toTaskRunStatus() job.TaskRunStatus {
+	switch s {
+	case TaskRunStatusRunning:
+		return job.TaskRunStatusRunning
+	case TaskRunStatusCompleted:
+		return job.TaskRunStatusCompleted
+	case TaskRunStatusErrored:
+		return job.TaskRunStatusErrored
+	default:
+		return ""
+	}
+}
+
+// JobRunsFilterInput filters the job runs of the jobRuns query.
+type JobRunsFilterInput struct {
+	JobType     *string
+	Status      *JobRunStatus
+	ErrorSearch *string
+	MinDuration *string
+	MaxDuration *string
+	TaskType    *string
+	TaskStatus  *TaskRunStatus
+}
+
+// toQuery adds the filters of the input to q.
+func (f JobRunsFilterInput) toQuery(q job.PipelineRunsQuery) (job.PipelineRunsQuery, error) {
+	if f.JobType != nil {
+		q = q.JobType(job.Type(*f.JobType))
+	}
+	if f.Status != nil {
+		q = q.State(f.Status.toRunStatus())
+	}
+	if f.ErrorSearch != nil {
+		q = q.ErrorContains(*f.ErrorSearch)
+	}
+	if f.MinDuration != nil {
+		d, err := time.ParseDuration(*f.MinDuration)
+		if err != nil {
+			return q, errors.Wrap(err, "invalid minDuration")
+		}
+		q = q.MinDuration(d)
+	}
+	if f.MaxDuration != nil {
+		d, err := time.ParseDuration(*f.MaxDuration)
+		if err != nil {
+			return q, errors.Wrap(err, "invalid maxDuration")
+		}
+		q = q.MaxDuration(d)
+	}
+	var taskType pipeline.TaskType
+	var taskStatus job.TaskRunStatus
+	if f.TaskType != nil {
+		taskType = pipeline.TaskType(*f.TaskType)
+	}
+	if f.TaskStatus != nil {
+		taskStatus = f.TaskStatus.toTaskRunStatus()
+	}
+	return q.Task(taskType, taskStatus), nil
+}
+
 var outputRetrievalErrorStr = "error: unable to retrieve outputs"
 
 type JobRunResolver struct {
@@ -151,6 +238,15 @@ func (r *JobRunResolver) FinishedAt() *graphql.Time {
 	return &graphql.Time{Time: r.run.FinishedAt.ValueOrZero()}
 }
 
+// ReplayOfID resolves the ID of the run which the run replays, if it is a replay.
+func (r *JobRunResolver) ReplayOfID() *graphql.ID {
+	if !r.run.ReplayOfRunID.Valid {
+		return nil
+	}
+	id := int64GQLID(r.run.ReplayOfRunID.Int64)
+	return &id
+}
+
 // -- JobRun query --
 
 type JobRunPayloadResolver struct {
@@ -198,6 +294,52 @@ func (r *JobRunsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
 
+// -- ArchivedJobRun query --
+
+var errRunArchiveDisabled = errors.New("the pipeline run archive is not enabled")
+
+// ArchivedJobRunResolver resolves a job run which was pruned to the run archive.
+type ArchivedJobRunResolver struct {
+	*JobRunResolver
+	run archive.Run
+}
+
+func NewArchivedJobRun(run archive.Run, app chainlink.Application) *ArchivedJobRunResolver {
+	return &ArchivedJobRunResolver{JobRunResolver: NewJobRun(run.PipelineRun(), app), run: run}
+}
+
+func (r *ArchivedJobRunResolver) JobID() graphql.ID {
+	return int32GQLID(r.run.JobID)
+}
+
+func (r *ArchivedJobRunResolver) ArchivedAt() graphql.Time {
+	return graphql.Time{Time: r.run.ArchivedAt}
+}
+
+func (r *ArchivedJobRunResolver) ObjectKey() string {
+	return r.run.ObjectKey
+}
+
+type ArchivedJobRunPayloadResolver struct {
+	run *archive.Run
+	app chainlink.Application
+	NotFoundErrorUnionType
+}
+
+func NewArchivedJobRunPayload(run *archive.Run, app chainlink.Application, err error) *ArchivedJobRunPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "archived job run not found", isExpectedErrorFn: nil}
+
+	return &ArchivedJobRunPayloadResolver{run: run, app: app, NotFoundErrorUnionType: e}
+}
+
+func (r *ArchivedJobRunPayloadResolver) ToArchivedJobRun() (*ArchivedJobRunResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewArchivedJobRun(*r.run, r.app), true
+}
+
 // -- RunJob Mutation --
 
 type RunJobPayloadResolver struct {
@@ -267,3 +409,43 @@ func (r *RunJobCannotRunErrorResolver) Code() ErrorCode {
 func (r *RunJobCannotRunErrorResolver) Message() string {
 	return r.message
 }
+
+// -- ReplayJobRun Mutation --
+
+type ReplayJobRunPayloadResolver struct {
+	run *pipeline.Run
+	app chainlink.Application
+	NotFoundErrorUnionType
+	cannotRunErr error
+}
+
+func NewReplayJobRunPayload(run *pipeline.Run, app chainlink.Application, err error, cannotRunErr error) *ReplayJobRunPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job run not found", isExpectedErrorFn: nil}
+
+	return &ReplayJobRunPayloadResolver{run: run, app: app, NotFoundErrorUnionType: e, cannotRunErr: cannotRunErr}
+}
+
+func (r *ReplayJobRunPayloadResolver) ToReplayJobRunSuccess() (*ReplayJobRunSuccessResolver, bool) {
+	if r.run == nil {
+		return nil, false
+	}
+
+	return &ReplayJobRunSuccessResolver{run: *r.run, app: r.app}, true
+}
+
+func (r *ReplayJobRunPayloadResolver) ToRunJobCannotRunError() (*RunJobCannotRunErrorResolver, bool) {
+	if r.cannotRunErr == nil {
+		return nil, false
+	}
+
+	return &RunJobCannotRunErrorResolver{message: r.cannotRunErr.Error(), code: ErrorCodeUnprocessable}, true
+}
+
+type ReplayJobRunSuccessResolver struct {
+	run pipeline.Run
+	app chainlink.Application
+}
+
+func (r *ReplayJobRunSuccessResolver) JobRun() *JobRunResolver {
+	return NewJobRun(r.run, r.app)
+}
@@ -1,8 +1,10 @@
 package resolver
 
 import (
+	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/archive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
@@ -44,7 +47,7 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 						ID: int64(200),
 					},
 				}, 1, nil)
-				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ReplicaJobORM").Return(f.Mocks.jobORM)
 			},
 			query: query,
 			result: `
@@ -64,7 +67,7 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.jobORM.On("PipelineRuns", (*int32)(nil), PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
-				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ReplicaJobORM").Return(f.Mocks.jobORM)
 			},
 			query:  query,
 			result: `null`,
@@ -82,6 +85,67 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestQuery_FilteredJobRuns(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobsRuns($filter: JobRunsFilter) {
+			jobRuns(filter: $filter) {
+				results {
+					id
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				want := job.NewPipelineRunsQuery().
+					JobType(job.Webhook).
+					State(pipeline.RunStatusErrored).
+					ErrorContains("connection refused").
+					MinDuration(30*time.Second).
+					Task(pipeline.TaskTypeHTTP, job.TaskRunStatusErrored)
+				f.Mocks.jobORM.On("QueryPipelineRuns", want, PageDefaultOffset, PageDefaultLimit).Return([]pipeline.Run{
+					{
+						ID: int64(200),
+					},
+				}, 1, nil)
+				f.App.On("ReplicaJobORM").Return(f.Mocks.jobORM)
+			},
+			query: query,
+			variables: map[string]interface{}{
+				"filter": map[string]interface{}{
+					"jobType":     "webhook",
+					"status":      "ERRORED",
+					"errorSearch": "connection refused",
+					"minDuration": "30s",
+					"taskType":    "http",
+					"taskStatus":  "ERRORED",
+				},
+			},
+			result: `
+				{
+					"jobRuns": {
+						"results": [{
+							"id": "200"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_JobRun(t *testing.T) {
 	t.Parallel()
 
@@ -284,7 +348,7 @@ func TestResolver_RunJob(t *testing.T) {
 			name:          "success without body",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil)).Return(int64(25), nil)
+				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil), (map[string]interface{})(nil)).Return(int64(25), nil)
 				f.Mocks.pipelineORM.On("FindRun", int64(25)).Return(pipeline.Run{
 					ID:             2,
 					PipelineSpecID: 5,
@@ -316,6 +380,54 @@ func TestResolver_RunJob(t *testing.T) {
 					}
 				}`,
 		},
+		{
+			name:          "success with variable overrides",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				overrides := map[string]interface{}{"jobRun": map[string]interface{}{"meta": map[string]interface{}{"foo": "bar"}}}
+				f.App.On("RunJobV2", mock.MatchedBy(func(ctx context.Context) bool {
+					_, ok := ctx.Deadline()
+					return ok
+				}), id, (map[string]interface{})(nil), overrides).Return(int64(25), nil)
+				f.Mocks.pipelineORM.On("FindRun", int64(25)).Return(pipeline.Run{
+					ID:             2,
+					PipelineSpecID: 5,
+					CreatedAt:      f.Timestamp(),
+					FinishedAt:     null.TimeFrom(f.Timestamp()),
+					Inputs:         inputs,
+					Outputs:        outputs,
+					State:          pipeline.RunStatusCompleted,
+				}, nil)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+			},
+			query: `
+				mutation RunJob($id: ID!, $input: RunJobInput) {
+					runJob(id: $id, input: $input) {
+						... on RunJobSuccess {
+							jobRun {
+								id
+								status
+							}
+						}
+					}
+				}`,
+			variables: map[string]interface{}{
+				"id": idStr,
+				"input": map[string]interface{}{
+					"vars":    map[string]interface{}{"jobRun": map[string]interface{}{"meta": map[string]interface{}{"foo": "bar"}}},
+					"timeout": "30s",
+				},
+			},
+			result: `
+				{
+					"runJob": {
+						"jobRun": {
+							"id": "2",
+							"status": "COMPLETED"
+						}
+					}
+				}`,
+		},
 		{
 			name:          "invalid ID error",
 			authenticated: true,
@@ -337,7 +449,7 @@ func TestResolver_RunJob(t *testing.T) {
 			name:          "not found job error",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil)).Return(int64(25), webhook.ErrJobNotExists)
+				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil), (map[string]interface{})(nil)).Return(int64(25), webhook.ErrJobNotExists)
 			},
 			query: mutation,
 			variables: map[string]interface{}{
@@ -355,7 +467,7 @@ func TestResolver_RunJob(t *testing.T) {
 			name:          "generic error on RunJobV2",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil)).Return(int64(25), gError)
+				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil), (map[string]interface{})(nil)).Return(int64(25), gError)
 			},
 			query: mutation,
 			variables: map[string]interface{}{
@@ -375,7 +487,7 @@ func TestResolver_RunJob(t *testing.T) {
 			name:          "generic error on FindRun",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil)).Return(int64(25), nil)
+				f.App.On("RunJobV2", mock.Anything, id, (map[string]interface{})(nil), (map[string]interface{})(nil)).Return(int64(25), nil)
 				f.Mocks.pipelineORM.On("FindRun", int64(25)).Return(pipeline.Run{}, gError)
 				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
 			},
@@ -397,3 +509,197 @@ func TestResolver_RunJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestQuery_ArchivedJobRun(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetArchivedJobRun($id: ID!) {
+			archivedJobRun(id: $id) {
+				... on ArchivedJobRun {
+					id
+					jobID
+					outputs
+					status
+					taskRuns {
+						dotID
+					}
+					objectKey
+					archivedAt
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{"id": "2"}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "archivedJobRun"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.runArchive.On("FindRun", mock.Anything, int64(2)).Return(archive.Run{
+					ID:         2,
+					JobID:      5,
+					State:      pipeline.RunStatusCompleted,
+					Outputs:    pipeline.JSONSerializable{Val: []interface{}{"out"}, Valid: true},
+					TaskRuns:   []pipeline.TaskRun{{DotID: "ds"}},
+					ObjectKey:  "pipeline_runs/2021/01/01/1-2-0.jsonl.gz",
+					ArchivedAt: f.Timestamp(),
+				}, nil)
+				f.App.On("RunArchive").Return(f.Mocks.runArchive)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"archivedJobRun": {
+						"id": "2",
+						"jobID": "5",
+						"outputs": ["out"],
+						"status": "COMPLETED",
+						"taskRuns": [{"dotID": "ds"}],
+						"objectKey": "pipeline_runs/2021/01/01/1-2-0.jsonl.gz",
+						"archivedAt": "2021-01-01T00:00:00Z"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.runArchive.On("FindRun", mock.Anything, int64(2)).Return(archive.Run{}, sql.ErrNoRows)
+				f.App.On("RunArchive").Return(f.Mocks.runArchive)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"archivedJobRun": {
+						"code": "NOT_FOUND",
+						"message": "archived job run not found"
+					}
+				}`,
+		},
+		{
+			name:          "archive disabled",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RunArchive").Return(nil)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: errRunArchiveDisabled,
+					Path:          []interface{}{"archivedJobRun"},
+					Message:       "the pipeline run archive is not enabled",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ReplayJobRun(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation ReplayJobRun($id: ID!, $input: ReplayJobRunInput) {
+			replayJobRun(id: $id, input: $input) {
+				... on ReplayJobRunSuccess {
+					jobRun {
+						id
+						replayOfID
+						status
+					}
+				}
+				... on RunJobCannotRunError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id":    "2",
+		"input": map[string]interface{}{"stubOnChainWrites": true},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "replayJobRun"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2)).Return(pipeline.Run{ID: 2, PipelineSpec: pipeline.Spec{JobID: 1}}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ReplayJobRun", mock.Anything, int64(2), true).Return(&pipeline.Run{
+					ID:            3,
+					CreatedAt:     f.Timestamp(),
+					FinishedAt:    null.TimeFrom(f.Timestamp()),
+					State:         pipeline.RunStatusCompleted,
+					ReplayOfRunID: null.IntFrom(2),
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"replayJobRun": {
+						"jobRun": {
+							"id": "3",
+							"replayOfID": "2",
+							"status": "COMPLETED"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "cannot replay",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2)).Return(pipeline.Run{ID: 2, PipelineSpec: pipeline.Spec{JobID: 1}}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ReplayJobRun", mock.Anything, int64(2), true).Return(nil, errors.New("run 2 has no captured inputs"))
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"id": "2"},
+			result: `
+				{
+					"replayJobRun": {
+						"code": "UNPROCESSABLE",
+						"message": "run 2 has no captured inputs"
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2)).Return(pipeline.Run{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"replayJobRun": {
+						"code": "NOT_FOUND",
+						"message": "job run not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
@@ -72,6 +74,7 @@ func TestResolver_Jobs(t *testing.T) {
 				plnSpecID := int32(12)
 
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ReplicaJobORM").Return(f.Mocks.jobORM)
 				f.Mocks.jobORM.On("FindJobs", 0, 50).Return([]job.Job{
 					{
 						ID:              1,
@@ -165,6 +168,17 @@ func TestResolver_Job(t *testing.T) {
 							}
 						}
 						observationSource
+						pipelineGraph {
+							nodes {
+								id
+								type
+								attributes
+							}
+							edges {
+								from
+								to
+							}
+						}
 					}
 					... on NotFoundError {
 						code
@@ -195,7 +209,15 @@ func TestResolver_Job(t *testing.T) {
 								"total": 1
 							}
 						},
-						"observationSource": "ds1 [type=bridge name=voter_turnout];"
+						"observationSource": "ds1 [type=bridge name=voter_turnout];",
+						"pipelineGraph": {
+							"nodes": [{
+								"id": "ds1",
+								"type": "bridge",
+								"attributes": {"name": "voter_turnout"}
+							}],
+							"edges": []
+						}
 					}
 				}
 			`
@@ -208,6 +230,7 @@ func TestResolver_Job(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ReplicaJobORM").Return(f.Mocks.jobORM)
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					ID:              1,
 					Name:            null.StringFrom("job1"),
@@ -252,11 +275,90 @@ func TestResolver_Job(t *testing.T) {
 				}
 			`,
 		},
+		{
+			name:          "job waiting on dependencies",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(f.t)
+				spawner.On("WaitingJobs").Return(map[int32][]string{1: {"job 00000000-0000-0000-0000-000000000002", "chain evm.1"}})
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("JobSpawner").Return(spawner)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:              1,
+					ExternalJobID:   externalJobID,
+					Type:            job.OffchainReporting,
+					DependsOn:       []string{"00000000-0000-0000-0000-000000000002"},
+					DependsOnChains: []string{"evm.1"},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							dependsOn
+							dependsOnChains
+							waitingOn
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"dependsOn": ["00000000-0000-0000-0000-000000000002"],
+						"dependsOnChains": ["evm.1"],
+						"waitingOn": ["job 00000000-0000-0000-0000-000000000002", "chain evm.1"]
+					}
+				}
+			`,
+		},
+		{
+			name:          "job quarantined after failing to start",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(f.t)
+				spawner.On("FailedJobs").Return(map[int32]job.StartFailure{1: {Failures: 8, LastError: "boom", Quarantined: true}})
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("JobSpawner").Return(spawner)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:            1,
+					ExternalJobID: externalJobID,
+					Type:          job.OffchainReporting,
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							startFailure {
+								failures
+								lastError
+								nextAttemptAt
+								quarantined
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"startFailure": {
+							"failures": 8,
+							"lastError": "boom",
+							"nextAttemptAt": null,
+							"quarantined": true
+						}
+					}
+				}
+			`,
+		},
 		{
 			name:          "show job when chainID is disabled",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ReplicaJobORM").Return(f.Mocks.jobORM)
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					ID:              1,
 					Name:            null.StringFrom("job1"),
@@ -326,6 +428,19 @@ func TestResolver_CreateJob(t *testing.T) {
 			"TOML": "some wrong value",
 		},
 	}
+	template := strings.Replace(spec, `"0x613a38AC1659769640aaE063C651F48E0250454C"`, `{{ var "contractAddress" "address" }}`, 1)
+	templateVariables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML":         template,
+			"templateVars": map[string]interface{}{"contractAddress": "0x613a38ac1659769640aae063c651f48e0250454c"},
+		},
+	}
+	invalidTemplateVariables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML":         template,
+			"templateVars": map[string]interface{}{"contractAddress": "0x613a"},
+		},
+	}
 	jb, err := directrequest.ValidatedDirectRequestSpec(spec)
 	assert.NoError(t, err)
 
@@ -354,11 +469,49 @@ func TestResolver_CreateJob(t *testing.T) {
 			before: func(f *gqlTestFramework) {
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
 				f.App.On("AddJobV2", mock.Anything, &jb).Return(nil)
+				f.Mocks.jobORM.On("CreateJobVersion", &job.JobVersion{
+					ExternalJobID: jb.ExternalJobID,
+					Definition:    spec,
+					Status:        job.JobVersionLive,
+				}, mock.Anything).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
 			},
 			query:     mutation,
 			variables: variables,
 			result:    expected,
 		},
+		{
+			name:          "success with spec template",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("AddJobV2", mock.Anything, &jb).Return(nil)
+				// the version records the rendered spec
+				f.Mocks.jobORM.On("CreateJobVersion", mock.MatchedBy(func(v *job.JobVersion) bool {
+					return v.Status == job.JobVersionLive && !strings.Contains(v.Definition, "{{")
+				}), mock.Anything).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: templateVariables,
+			result:    expected,
+		},
+		{
+			name:          "invalid template variable error",
+			authenticated: true,
+			query:         mutation,
+			variables:     invalidTemplateVariables,
+			result: `
+				{
+					"createJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "invalid address value for variable contractAddress: \"0x613a\" is not a hex address",
+							"path": "templateVars"
+						}]
+					}
+				}`,
+		},
 		{
 			name:          "invalid TOML error",
 			authenticated: true,
@@ -562,3 +715,121 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_RestartJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.New()
+	mutation := `
+		mutation RestartJob($id: ID!) {
+			restartJob(id: $id) {
+				... on RestartJobSuccess {
+					job {
+						id
+						externalJobID
+						name
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+	d, err := json.Marshal(map[string]interface{}{
+		"restartJob": map[string]interface{}{
+			"job": map[string]interface{}{
+				"id":            "123",
+				"externalJobID": extJID.String(),
+				"name":          "test-job",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	expected := string(d)
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "restartJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(f.t)
+				spawner.On("RestartJob", mock.Anything, id).Return(nil)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:            id,
+					Name:          null.StringFrom("test-job"),
+					ExternalJobID: extJID,
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("JobSpawner").Return(spawner)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    expected,
+		},
+		{
+			name:          "success when the job fails to start",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(f.t)
+				spawner.On("RestartJob", mock.Anything, id).Return(gError)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:            id,
+					Name:          null.StringFrom("test-job"),
+					ExternalJobID: extJID,
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("JobSpawner").Return(spawner)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    expected,
+		},
+		{
+			name:          "not found on FindJob()",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"restartJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "generic error on FindJob()",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{}, gError)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"restartJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -0,0 +1,214 @@
+package resolver
+
+import (
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+type JobVersionStatus string
+
+func ToJobVersionStatus(s job.JobVersionStatus) JobVersionStatus {
+	return JobVersionStatus(strings.ToUpper(string(s)))
+}
+
+// JobVersionResolver resolves the JobVersion type.
+type JobVersionResolver struct {
+	v job.JobVersion
+}
+
+func NewJobVersion(v job.JobVersion) *JobVersionResolver {
+	return &JobVersionResolver{v: v}
+}
+
+func NewJobVersions(versions []job.JobVersion) []*JobVersionResolver {
+	var resolvers []*JobVersionResolver
+	for _, v := range versions {
+		resolvers = append(resolvers, NewJobVersion(v))
+	}
+
+	return resolvers
+}
+
+func (r *JobVersionResolver) ID() graphql.ID {
+	return int64GQLID(r.v.ID)
+}
+
+func (r *JobVersionResolver) Version() int32 {
+	return r.v.Version
+}
+
+func (r *JobVersionResolver) Status() JobVersionStatus {
+	return ToJobVersionStatus(r.v.Status)
+}
+
+func (r *JobVersionResolver) Definition() string {
+	return r.v.Definition
+}
+
+func (r *JobVersionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.v.CreatedAt}
+}
+
+func (r *JobVersionResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.v.UpdatedAt}
+}
+
+// inputErrorsUnionType resolves the InputErrors member of a payload union, if there are any input errors.
+type inputErrorsUnionType struct {
+	inputErrs map[string]string
+}
+
+func (e *inputErrorsUnionType) ToInputErrors() (*InputErrorsResolver, bool) {
+	if e.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+	for path, message := range e.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+// -- StageJobVersion Mutation --
+
+type StageJobVersionPayloadResolver struct {
+	v *job.JobVersion
+	NotFoundErrorUnionType
+	inputErrorsUnionType
+}
+
+func NewStageJobVersionPayload(v *job.JobVersion, err error, inputErrs map[string]string) *StageJobVersionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &StageJobVersionPayloadResolver{v: v, NotFoundErrorUnionType: e, inputErrorsUnionType: inputErrorsUnionType{inputErrs}}
+}
+
+func (r *StageJobVersionPayloadResolver) ToStageJobVersionSuccess() (*StageJobVersionSuccessResolver, bool) {
+	if r.v == nil {
+		return nil, false
+	}
+
+	return &StageJobVersionSuccessResolver{v: *r.v}, true
+}
+
+type StageJobVersionSuccessResolver struct {
+	v job.JobVersion
+}
+
+func (r *StageJobVersionSuccessResolver) Version() *JobVersionResolver {
+	return NewJobVersion(r.v)
+}
+
+// -- ShadowRunJobVersion Mutation --
+
+type ShadowRunJobVersionPayloadResolver struct {
+	run *pipeline.Run
+	NotFoundErrorUnionType
+	inputErrorsUnionType
+}
+
+func NewShadowRunJobVersionPayload(run *pipeline.Run, err error, inputErrs map[string]string) *ShadowRunJobVersionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job version not found"}
+
+	return &ShadowRunJobVersionPayloadResolver{run: run, NotFoundErrorUnionType: e, inputErrorsUnionType: inputErrorsUnionType{inputErrs}}
+}
+
+func (r *ShadowRunJobVersionPayloadResolver) ToShadowRun() (*ShadowRunResolver, bool) {
+	if r.run == nil {
+		return nil, false
+	}
+
+	return &ShadowRunResolver{run: *r.run}, true
+}
+
+// ShadowRunResolver resolves the ShadowRun type.
+type ShadowRunResolver struct {
+	run pipeline.Run
+}
+
+func (r *ShadowRunResolver) Outputs() []*string {
+	return (&JobRunResolver{run: r.run}).Outputs()
+}
+
+func (r *ShadowRunResolver) AllErrors() []string {
+	return (&JobRunResolver{run: r.run}).AllErrors()
+}
+
+func (r *ShadowRunResolver) FatalErrors() []string {
+	return (&JobRunResolver{run: r.run}).FatalErrors()
+}
+
+func (r *ShadowRunResolver) TaskRuns() []*TaskRunResolver {
+	return NewTaskRuns(r.run.PipelineTaskRuns)
+}
+
+// -- SwitchJobVersion Mutation --
+
+type SwitchJobVersionPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+	inputErrorsUnionType
+}
+
+func NewSwitchJobVersionPayload(app chainlink.Application, j *job.Job, err error, inputErrs map[string]string) *SwitchJobVersionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job version not found"}
+
+	return &SwitchJobVersionPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e, inputErrorsUnionType: inputErrorsUnionType{inputErrs}}
+}
+
+func (r *SwitchJobVersionPayloadResolver) ToSwitchJobVersionSuccess() (*SwitchJobVersionSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &SwitchJobVersionSuccessResolver{app: r.app, j: r.j}, true
+}
+
+type SwitchJobVersionSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *SwitchJobVersionSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- RollbackJob Mutation --
+
+type RollbackJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+	inputErrorsUnionType
+}
+
+func NewRollbackJobPayload(app chainlink.Application, j *job.Job, err error, inputErrs map[string]string) *RollbackJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &RollbackJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e, inputErrorsUnionType: inputErrorsUnionType{inputErrs}}
+}
+
+func (r *RollbackJobPayloadResolver) ToRollbackJobSuccess() (*RollbackJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &RollbackJobSuccessResolver{app: r.app, j: r.j}, true
+}
+
+type RollbackJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *RollbackJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
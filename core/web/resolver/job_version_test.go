@@ -0,0 +1,446 @@
+package resolver
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+func TestResolver_StageJobVersion(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.New()
+	live := job.Job{ID: id, Name: null.StringFrom("test-job"), ExternalJobID: extJID}
+	spec := fmt.Sprintf(testspecs.DirectRequestSpecTemplate, "test-job", extJID)
+	mutation := `
+		mutation StageJobVersion($id: ID!, $input: StageJobVersionInput!) {
+			stageJobVersion(id: $id, input: $input) {
+				... on StageJobVersionSuccess {
+					version {
+						id
+						version
+						status
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id":    "123",
+		"input": map[string]interface{}{"TOML": spec},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "stageJobVersion"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(live, nil)
+				f.Mocks.jobORM.On("AssertBridgesExist", mock.Anything).Return(nil)
+				f.Mocks.jobORM.On("CreateJobVersion", mock.MatchedBy(func(v *job.JobVersion) bool {
+					return v.ExternalJobID == extJID && v.Definition == spec && v.Status == job.JobVersionStaged
+				}), mock.Anything).Run(func(args mock.Arguments) {
+					v := args.Get(0).(*job.JobVersion)
+					v.ID, v.Version = 2, 2
+				}).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"stageJobVersion": {
+						"version": {
+							"id": "2",
+							"version": 2,
+							"status": "STAGED"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "another external job ID",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(live, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": "123",
+				"input": map[string]interface{}{
+					"TOML": fmt.Sprintf(testspecs.DirectRequestSpecTemplate, "test-job", uuid.New()),
+				},
+			},
+			result: fmt.Sprintf(`
+				{
+					"stageJobVersion": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "must be the external job ID of the job: %s",
+							"path": "externalJobID"
+						}]
+					}
+				}`, extJID),
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"stageJobVersion": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ShadowRunJobVersion(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.New()
+	live := job.Job{ID: id, Name: null.StringFrom("test-job"), ExternalJobID: extJID}
+	version := job.JobVersion{
+		ID:            2,
+		ExternalJobID: extJID,
+		Version:       2,
+		Definition:    fmt.Sprintf(testspecs.DirectRequestSpecTemplate, "test-job", extJID),
+		Status:        job.JobVersionStaged,
+	}
+	mutation := `
+		mutation ShadowRunJobVersion($id: ID!) {
+			shadowRunJobVersion(id: $id) {
+				... on ShadowRun {
+					outputs
+					allErrors
+					fatalErrors
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "2",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "shadowRunJobVersion"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobVersion", int64(2), mock.Anything).Return(version, nil)
+				f.Mocks.jobORM.On("FindJobByExternalJobID", extJID, mock.Anything).Return(live, nil)
+				f.Mocks.jobORM.On("AssertBridgesExist", mock.Anything).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("ShadowRunJob", mock.Anything, mock.MatchedBy(func(jb job.Job) bool {
+					return jb.ID == id && jb.ExternalJobID == extJID
+				}), map[string]interface{}(nil)).Return(&pipeline.Run{
+					Outputs: pipeline.JSONSerializable{Val: []interface{}{"42"}, Valid: true},
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"shadowRunJobVersion": {
+						"outputs": ["42"],
+						"allErrors": [],
+						"fatalErrors": []
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobVersion", int64(2), mock.Anything).Return(job.JobVersion{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"shadowRunJobVersion": {
+						"code": "NOT_FOUND",
+						"message": "job version not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_SwitchJobVersion(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.New()
+	live := job.Job{ID: id, Name: null.StringFrom("test-job"), ExternalJobID: extJID}
+	version := job.JobVersion{
+		ID:            2,
+		ExternalJobID: extJID,
+		Version:       2,
+		Definition:    fmt.Sprintf(testspecs.DirectRequestSpecTemplate, "test-job", extJID),
+		Status:        job.JobVersionStaged,
+	}
+	mutation := `
+		mutation SwitchJobVersion($id: ID!) {
+			switchJobVersion(id: $id) {
+				... on SwitchJobVersionSuccess {
+					job {
+						id
+						externalJobID
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "2",
+	}
+	d, err := json.Marshal(map[string]interface{}{
+		"switchJobVersion": map[string]interface{}{
+			"job": map[string]interface{}{
+				"id":            "124",
+				"externalJobID": extJID.String(),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	expected := string(d)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "switchJobVersion"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobVersion", int64(2), mock.Anything).Return(version, nil)
+				f.Mocks.jobORM.On("FindJobByExternalJobID", extJID, mock.Anything).Return(live, nil)
+				f.Mocks.jobORM.On("AssertBridgesExist", mock.Anything).Return(nil)
+				f.Mocks.jobORM.On("SetLiveJobVersion", int64(2), mock.Anything).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("ReplaceJob", mock.Anything, id, mock.Anything).Run(func(args mock.Arguments) {
+					args.Get(2).(*job.Job).ID = 124
+				}).Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    expected,
+		},
+		{
+			name:          "already live",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				v := version
+				v.Status = job.JobVersionLive
+				f.Mocks.jobORM.On("FindJobVersion", int64(2), mock.Anything).Return(v, nil)
+				f.Mocks.jobORM.On("FindJobByExternalJobID", extJID, mock.Anything).Return(live, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"switchJobVersion": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "the version is already live",
+							"path": "id"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobVersion", int64(2), mock.Anything).Return(job.JobVersion{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"switchJobVersion": {
+						"code": "NOT_FOUND",
+						"message": "job version not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RollbackJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.New()
+	live := job.Job{ID: id, Name: null.StringFrom("test-job"), ExternalJobID: extJID}
+	spec := fmt.Sprintf(testspecs.DirectRequestSpecTemplate, "test-job", extJID)
+	mutation := `
+		mutation RollbackJob($id: ID!) {
+			rollbackJob(id: $id) {
+				... on RollbackJobSuccess {
+					job {
+						id
+						externalJobID
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+	d, err := json.Marshal(map[string]interface{}{
+		"rollbackJob": map[string]interface{}{
+			"job": map[string]interface{}{
+				"id":            "124",
+				"externalJobID": extJID.String(),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	expected := string(d)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "rollbackJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(live, nil)
+				f.Mocks.jobORM.On("FindJobVersions", extJID, mock.Anything).Return([]job.JobVersion{
+					{ID: 4, ExternalJobID: extJID, Version: 4, Definition: spec, Status: job.JobVersionStaged},
+					{ID: 3, ExternalJobID: extJID, Version: 3, Definition: spec, Status: job.JobVersionLive},
+					{ID: 2, ExternalJobID: extJID, Version: 2, Definition: spec, Status: job.JobVersionRetired},
+					{ID: 1, ExternalJobID: extJID, Version: 1, Definition: spec, Status: job.JobVersionRetired},
+				}, nil)
+				f.Mocks.jobORM.On("AssertBridgesExist", mock.Anything).Return(nil)
+				f.Mocks.jobORM.On("SetLiveJobVersion", int64(2), mock.Anything).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("ReplaceJob", mock.Anything, id, mock.Anything).Run(func(args mock.Arguments) {
+					args.Get(2).(*job.Job).ID = 124
+				}).Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    expected,
+		},
+		{
+			name:          "no previous version",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(live, nil)
+				f.Mocks.jobORM.On("FindJobVersions", extJID, mock.Anything).Return([]job.JobVersion{
+					{ID: 2, ExternalJobID: extJID, Version: 2, Definition: spec, Status: job.JobVersionStaged},
+					{ID: 1, ExternalJobID: extJID, Version: 1, Definition: spec, Status: job.JobVersionLive},
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rollbackJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "the job has no previous version to roll back to",
+							"path": "id"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rollbackJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
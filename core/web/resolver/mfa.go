@@ -0,0 +1,136 @@
+package resolver
+
+import (
+	"errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// mfaInputErrors returns the input errors of the MFA sentinel errors, or nil for any other error.
+func mfaInputErrors(path string, err error) map[string]string {
+	switch {
+	case errors.Is(err, sessions.ErrNotSupported), errors.Is(err, sessions.ErrTOTPAlreadyEnrolled),
+		errors.Is(err, sessions.ErrNoPendingTOTP), errors.Is(err, sessions.ErrInvalidTOTPCode),
+		errors.Is(err, sessions.ErrMFANotEnrolled):
+		return map[string]string{path: err.Error()}
+	}
+	return nil
+}
+
+func newInputErrors(inputErrs map[string]string) *InputErrorsResolver {
+	var errs []*InputErrorResolver
+	for path, message := range inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+	return NewInputErrors(errs)
+}
+
+type TOTPEnrollmentResolver struct {
+	secret string
+	url    string
+}
+
+func (r *TOTPEnrollmentResolver) Secret() string {
+	return r.secret
+}
+
+func (r *TOTPEnrollmentResolver) URL() string {
+	return r.url
+}
+
+// -- BeginTOTPEnrollment Mutation --
+
+type BeginTOTPEnrollmentPayloadResolver struct {
+	enrollment *TOTPEnrollmentResolver
+	inputErrs  map[string]string
+}
+
+func NewBeginTOTPEnrollmentPayload(email, secret string, inputErrs map[string]string) *BeginTOTPEnrollmentPayloadResolver {
+	if inputErrs != nil {
+		return &BeginTOTPEnrollmentPayloadResolver{inputErrs: inputErrs}
+	}
+	return &BeginTOTPEnrollmentPayloadResolver{
+		enrollment: &TOTPEnrollmentResolver{secret: secret, url: sessions.TOTPURL(email, secret)},
+	}
+}
+
+func (r *BeginTOTPEnrollmentPayloadResolver) ToBeginTOTPEnrollmentSuccess() (*BeginTOTPEnrollmentSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+	return &BeginTOTPEnrollmentSuccessResolver{r.enrollment}, true
+}
+
+func (r *BeginTOTPEnrollmentPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return newInputErrors(r.inputErrs), true
+	}
+	return nil, false
+}
+
+type BeginTOTPEnrollmentSuccessResolver struct {
+	enrollment *TOTPEnrollmentResolver
+}
+
+func (r *BeginTOTPEnrollmentSuccessResolver) Enrollment() *TOTPEnrollmentResolver {
+	return r.enrollment
+}
+
+// -- ConfirmTOTPEnrollment Mutation --
+
+type ConfirmTOTPEnrollmentPayloadResolver struct {
+	codes     []string
+	inputErrs map[string]string
+}
+
+func NewConfirmTOTPEnrollmentPayload(codes []string, inputErrs map[string]string) *ConfirmTOTPEnrollmentPayloadResolver {
+	return &ConfirmTOTPEnrollmentPayloadResolver{codes, inputErrs}
+}
+
+func (r *ConfirmTOTPEnrollmentPayloadResolver) ToConfirmTOTPEnrollmentSuccess() (*RecoveryCodesSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+	return &RecoveryCodesSuccessResolver{r.codes}, true
+}
+
+func (r *ConfirmTOTPEnrollmentPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return newInputErrors(r.inputErrs), true
+	}
+	return nil, false
+}
+
+// -- RegenerateRecoveryCodes Mutation --
+
+type RegenerateRecoveryCodesPayloadResolver struct {
+	codes     []string
+	inputErrs map[string]string
+}
+
+func NewRegenerateRecoveryCodesPayload(codes []string, inputErrs map[string]string) *RegenerateRecoveryCodesPayloadResolver {
+	return &RegenerateRecoveryCodesPayloadResolver{codes, inputErrs}
+}
+
+func (r *RegenerateRecoveryCodesPayloadResolver) ToRegenerateRecoveryCodesSuccess() (*RecoveryCodesSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+	return &RecoveryCodesSuccessResolver{r.codes}, true
+}
+
+func (r *RegenerateRecoveryCodesPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return newInputErrors(r.inputErrs), true
+	}
+	return nil, false
+}
+
+// RecoveryCodesSuccessResolver resolves both ConfirmTOTPEnrollmentSuccess and RegenerateRecoveryCodesSuccess.
+type RecoveryCodesSuccessResolver struct {
+	codes []string
+}
+
+func (r *RecoveryCodesSuccessResolver) RecoveryCodes() []string {
+	return r.codes
+}
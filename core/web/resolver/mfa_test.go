@@ -0,0 +1,197 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+func TestResolver_BeginTOTPEnrollment(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation BeginTOTPEnrollment {
+			beginTOTPEnrollment {
+				... on BeginTOTPEnrollmentSuccess {
+					enrollment {
+						secret
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation}, "beginTOTPEnrollment"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.authProvider.On("BeginTOTPEnrollment", session.User.Email).Return("JBSWY3DPEHPK3PXP", nil)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query: mutation,
+			result: `
+				{
+					"beginTOTPEnrollment": {
+						"enrollment": {
+							"secret": "JBSWY3DPEHPK3PXP"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "already enrolled",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.authProvider.On("BeginTOTPEnrollment", session.User.Email).Return("", sessions.ErrTOTPAlreadyEnrolled)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query: mutation,
+			result: `
+				{
+					"beginTOTPEnrollment": {
+						"errors": [{
+							"path": "totp",
+							"message": "TOTP is already enrolled",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ConfirmTOTPEnrollment(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation ConfirmTOTPEnrollment($input: ConfirmTOTPEnrollmentInput!) {
+			confirmTOTPEnrollment(input: $input) {
+				... on ConfirmTOTPEnrollmentSuccess {
+					recoveryCodes
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"code": "123456",
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "confirmTOTPEnrollment"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.authProvider.On("ConfirmTOTPEnrollment", session.User.Email, "123456").Return([]string{"abcde-fghij"}, nil)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"confirmTOTPEnrollment": {
+						"recoveryCodes": ["abcde-fghij"]
+					}
+				}`,
+		},
+		{
+			name:          "invalid code",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.authProvider.On("ConfirmTOTPEnrollment", session.User.Email, "123456").Return(nil, sessions.ErrInvalidTOTPCode)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"confirmTOTPEnrollment": {
+						"errors": [{
+							"path": "code",
+							"message": "invalid TOTP code",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RegenerateRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RegenerateRecoveryCodes {
+			regenerateRecoveryCodes {
+				... on RegenerateRecoveryCodesSuccess {
+					recoveryCodes
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation}, "regenerateRecoveryCodes"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.authProvider.On("RegenerateRecoveryCodes", session.User.Email).Return([]string{"abcde-fghij", "klmno-pqrst"}, nil)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query: mutation,
+			result: `
+				{
+					"regenerateRecoveryCodes": {
+						"recoveryCodes": ["abcde-fghij", "klmno-pqrst"]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
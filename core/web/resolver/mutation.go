@@ -1,14 +1,18 @@
 package resolver
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
+	"github.com/pelletier/go-toml/v2"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
@@ -16,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
@@ -25,6 +30,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	gwconfig "github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -33,27 +39,56 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/loopjob"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	"github.com/smartcontractkit/chainlink/v2/core/services/supportbundle"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/tenancy"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/gqlscalar"
 )
 
 type Resolver struct {
 	App chainlink.Application
 }
 
+type bridgeResponseFieldInput struct {
+	Path     string
+	Type     string
+	Optional *bool
+}
+
+func newBridgeResponseSchema(input *[]bridgeResponseFieldInput) bridges.ResponseSchema {
+	if input == nil {
+		return nil
+	}
+	schema := bridges.ResponseSchema{}
+	for _, f := range *input {
+		schema = append(schema, bridges.ResponseField{
+			Path:     f.Path,
+			Type:     bridges.ResponseFieldType(f.Type),
+			Optional: f.Optional != nil && *f.Optional,
+		})
+	}
+	return schema
+}
+
 type createBridgeInput struct {
 	Name                   string
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	ResponseSchema         *[]bridgeResponseFieldInput
 }
 
 // CreateBridge creates a new bridge.
@@ -80,6 +115,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		ResponseSchema:         newBridgeResponseSchema(args.Input.ResponseSchema),
 	}
 
 	bta, bt, err := bridges.NewBridgeType(btr)
@@ -93,6 +129,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 	if err = ValidateBridgeTypeUniqueness(btr, orm); err != nil {
 		return nil, err
 	}
+	bt.Tenant = sessionTenant(ctx)
 	if err := orm.CreateBridgeType(bt); err != nil {
 		return nil, err
 	}
@@ -104,7 +141,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		"bridgeURL":                    bta.URL,
 	})
 
-	return NewCreateBridgePayload(*bt, bta.IncomingToken), nil
+	return NewCreateBridgePayload(r.App, *bt, bta.IncomingToken), nil
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
@@ -120,6 +157,9 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 
 		return nil, err
 	}
+	if err = r.assignSessionTenantKey(ctx, tenancy.KeyTypeCSA, key.ID()); err != nil {
+		return nil, err
+	}
 
 	r.App.GetAuditLogger().Audit(audit.CSAKeyCreated, map[string]interface{}{
 		"CSAPublicKey": key.PublicKey,
@@ -136,6 +176,14 @@ func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	ok, err := r.canAccessKey(ctx, tenancy.KeyTypeCSA, string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewDeleteCSAKeyPayload(csakey.KeyV2{}, keystore.KeyNotFoundError{ID: string(args.ID), KeyType: "CSA"}), nil
+	}
+
 	key, err := r.App.GetKeyStore().CSA().Delete(string(args.ID))
 	if err != nil {
 		if errors.As(err, &keystore.KeyNotFoundError{}) {
@@ -177,6 +225,9 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	fsvc := r.App.GetFeedsService()
 
@@ -258,6 +309,9 @@ func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struc
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(args.ID)
 	if err != nil {
@@ -313,6 +367,9 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	fsvc := r.App.GetFeedsService()
 
@@ -393,6 +450,9 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	publicKey, err := crypto.PublicKeyFromHex(args.Input.PublicKey)
 	if err != nil {
@@ -437,6 +497,7 @@ type updateBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	ResponseSchema         *[]bridgeResponseFieldInput
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
@@ -465,6 +526,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		ResponseSchema:         newBridgeResponseSchema(args.Input.ResponseSchema),
 	}
 
 	taskType, err := bridges.ParseBridgeName(string(args.ID))
@@ -476,11 +538,14 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	orm := r.App.BridgeORM()
 	bridge, err := orm.FindBridge(taskType)
 	if errors.Is(err, sql.ErrNoRows) {
-		return NewUpdateBridgePayload(nil, err), nil
+		return NewUpdateBridgePayload(r.App, nil, err), nil
 	}
 	if err != nil {
 		return nil, err
 	}
+	if !tenancy.CanAccess(sessionTenant(ctx), bridge.Tenant) {
+		return NewUpdateBridgePayload(r.App, nil, sql.ErrNoRows), nil
+	}
 
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
@@ -498,7 +563,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		"bridgeURL":                    bridge.URL,
 	})
 
-	return NewUpdateBridgePayload(&bridge, nil), nil
+	return NewUpdateBridgePayload(r.App, &bridge, nil), nil
 }
 
 type updateFeedsManagerInput struct {
@@ -514,6 +579,9 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(string(args.ID))
 	if err != nil {
@@ -564,6 +632,9 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 	if err != nil {
 		return nil, err
 	}
+	if err = r.assignSessionTenantKey(ctx, tenancy.KeyTypeOCR, key.ID()); err != nil {
+		return nil, err
+	}
 
 	r.App.GetAuditLogger().Audit(audit.OCRKeyBundleCreated, map[string]interface{}{
 		"ocrKeyBundleID":                      key.ID(),
@@ -580,6 +651,14 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	ok, err := r.canAccessKey(ctx, tenancy.KeyTypeOCR, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewDeleteOCRKeyBundlePayloadResolver(ocrkey.KeyV2{}, keystore.KeyNotFoundError{ID: args.ID, KeyType: "OCR"}), nil
+	}
+
 	deletedKey, err := r.App.GetKeyStore().OCR().Delete(args.ID)
 	if err != nil {
 		if errors.As(err, &keystore.KeyNotFoundError{}) {
@@ -601,25 +680,28 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 
 	taskType, err := bridges.ParseBridgeName(string(args.ID))
 	if err != nil {
-		return NewDeleteBridgePayload(nil, err), nil
+		return NewDeleteBridgePayload(r.App, nil, err), nil
 	}
 
 	orm := r.App.BridgeORM()
 	bt, err := orm.FindBridge(taskType)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return NewDeleteBridgePayload(nil, err), nil
+			return NewDeleteBridgePayload(r.App, nil, err), nil
 		}
 
 		return nil, err
 	}
+	if !tenancy.CanAccess(sessionTenant(ctx), bt.Tenant) {
+		return NewDeleteBridgePayload(r.App, nil, sql.ErrNoRows), nil
+	}
 
 	jobsUsingBridge, err := r.App.JobORM().FindJobIDsWithBridge(string(args.ID))
 	if err != nil {
 		return nil, err
 	}
 	if len(jobsUsingBridge) > 0 {
-		return NewDeleteBridgePayload(nil, fmt.Errorf("bridge has jobs associated with it")), nil
+		return NewDeleteBridgePayload(r.App, nil, fmt.Errorf("bridge has jobs associated with it")), nil
 	}
 
 	if err = orm.DeleteBridgeType(&bt); err != nil {
@@ -627,7 +709,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	}
 
 	r.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]interface{}{"name": bt.Name})
-	return NewDeleteBridgePayload(&bt, nil), nil
+	return NewDeleteBridgePayload(r.App, &bt, nil), nil
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
@@ -639,6 +721,9 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 	if err != nil {
 		return nil, err
 	}
+	if err = r.assignSessionTenantKey(ctx, tenancy.KeyTypeP2P, key.ID()); err != nil {
+		return nil, err
+	}
 
 	const keyType = "Ed25519"
 	r.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
@@ -664,6 +749,14 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	ok, err := r.canAccessKey(ctx, tenancy.KeyTypeP2P, keyID.Raw())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewDeleteP2PKeyPayload(p2pkey.KeyV2{}, keystore.KeyNotFoundError{ID: keyID.String(), KeyType: "P2P"}), nil
+	}
+
 	key, err := r.App.GetKeyStore().P2P().Delete(keyID)
 	if err != nil {
 		if errors.As(err, &keystore.KeyNotFoundError{}) {
@@ -689,6 +782,9 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 	if err != nil {
 		return nil, err
 	}
+	if err = r.assignSessionTenantKey(ctx, tenancy.KeyTypeVRF, key.ID()); err != nil {
+		return nil, err
+	}
 
 	r.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
 		"type":                "vrf",
@@ -707,6 +803,14 @@ func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	ok, err := r.canAccessKey(ctx, tenancy.KeyTypeVRF, string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewDeleteVRFKeyPayloadResolver(vrfkey.KeyV2{}, keystore.ErrMissingVRFKey), nil
+	}
+
 	key, err := r.App.GetKeyStore().VRF().Delete(string(args.ID))
 	if err != nil {
 		if errors.Is(errors.Cause(err), keystore.ErrMissingVRFKey) {
@@ -731,6 +835,9 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(string(args.ID))
 	if err != nil {
@@ -770,6 +877,9 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(string(args.ID))
 	if err != nil {
@@ -805,6 +915,9 @@ func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(string(args.ID))
 	if err != nil {
@@ -841,6 +954,9 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(string(args.ID))
 	if err != nil {
@@ -915,6 +1031,9 @@ func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	r.App.GetConfig().SetLogSQL(args.Input.Enabled)
 
@@ -998,81 +1117,418 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 	}, nil), nil
 }
 
+func (r *Resolver) BeginTOTPEnrollment(ctx context.Context) (*BeginTOTPEnrollmentPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	secret, err := r.App.AuthenticationProvider().BeginTOTPEnrollment(session.User.Email)
+	if err != nil {
+		if inputErrs := mfaInputErrors("totp", err); inputErrs != nil {
+			return NewBeginTOTPEnrollmentPayload("", "", inputErrs), nil
+		}
+		return nil, err
+	}
+
+	return NewBeginTOTPEnrollmentPayload(session.User.Email, secret, nil), nil
+}
+
+func (r *Resolver) ConfirmTOTPEnrollment(ctx context.Context, args struct {
+	Input struct{ Code string }
+}) (*ConfirmTOTPEnrollmentPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	codes, err := r.App.AuthenticationProvider().ConfirmTOTPEnrollment(session.User.Email, args.Input.Code)
+	if err != nil {
+		if inputErrs := mfaInputErrors("code", err); inputErrs != nil {
+			return NewConfirmTOTPEnrollmentPayload(nil, inputErrs), nil
+		}
+		return nil, err
+	}
+
+	return NewConfirmTOTPEnrollmentPayload(codes, nil), nil
+}
+
+func (r *Resolver) RegenerateRecoveryCodes(ctx context.Context) (*RegenerateRecoveryCodesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	codes, err := r.App.AuthenticationProvider().RegenerateRecoveryCodes(session.User.Email)
+	if err != nil {
+		if inputErrs := mfaInputErrors("recoveryCodes", err); inputErrs != nil {
+			return NewRegenerateRecoveryCodesPayload(nil, inputErrs), nil
+		}
+		return nil, err
+	}
+
+	return NewRegenerateRecoveryCodesPayload(codes, nil), nil
+}
+
 func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	Input struct {
-		TOML string
+		TOML         string
+		TemplateVars *gqlscalar.Map
 	}
 }) (*CreateJobPayloadResolver, error) {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
-	jbt, err := job.ValidateSpec(args.Input.TOML)
+	tomlString, inputErrs := renderedJobSpec(args.Input.TOML, args.Input.TemplateVars)
+	if inputErrs != nil {
+		return NewCreateJobPayload(r.App, nil, inputErrs), nil
+	}
+	args.Input.TOML = tomlString
+
+	jb, inputErrs, err := validatedJobSpec(r.App, args.Input.TOML)
+	if inputErrs != nil {
+		return NewCreateJobPayload(r.App, nil, inputErrs), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	jb.Tenant = sessionTenant(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err = r.App.AddJobV2(ctx, &jb)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the definition of the job, so that it can be rolled back to once a new version is live
+	v := job.JobVersion{ExternalJobID: jb.ExternalJobID, Definition: args.Input.TOML, Status: job.JobVersionLive}
+	if err = r.App.JobORM().CreateJobVersion(&v, pg.WithParentCtx(ctx)); err != nil {
+		r.App.GetLogger().Warnw("Failed to record the version of the job", "jobID", jb.ID, "err", err)
+	}
+
+	jbj, _ := json.Marshal(jb)
+	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+
+	return NewCreateJobPayload(r.App, &jb, nil), nil
+}
+
+// renderedJobSpec renders a TOML spec template with the values of its variables. Invalid values are returned as
+// input errors.
+func renderedJobSpec(tomlString string, vars *gqlscalar.Map) (string, map[string]string) {
+	var templateVars map[string]string
+	if vars != nil {
+		templateVars = make(map[string]string, len(*vars))
+		for name, v := range *vars {
+			switch v := v.(type) {
+			case string:
+				templateVars[name] = v
+			case float64:
+				templateVars[name] = strconv.FormatFloat(v, 'f', -1, 64)
+			case int32, bool:
+				templateVars[name] = fmt.Sprint(v)
+			default:
+				return "", map[string]string{
+					"templateVars": fmt.Sprintf("variable %s must be a string, a number or a boolean", name),
+				}
+			}
+		}
+	}
+	rendered, err := job.RenderSpecTemplate(tomlString, templateVars)
+	if err != nil {
+		return "", map[string]string{
+			"templateVars": err.Error(),
+		}
+	}
+	return rendered, nil
+}
+
+// validatedJobSpec parses and validates a TOML job spec. Errors in the spec itself are returned as input errors.
+func validatedJobSpec(app chainlink.Application, toml string) (jb job.Job, inputErrs map[string]string, err error) {
+	jbt, err := job.ValidateSpec(toml)
 	if err != nil {
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return jb, map[string]string{
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
-		}), nil
+		}, nil
 	}
 
-	var jb job.Job
-	config := r.App.GetConfig()
+	config := app.GetConfig()
 	switch jbt {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(r.App.GetRelayers().LegacyEVMChains(), args.Input.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(app.GetRelayers().LegacyEVMChains(), toml)
 		if !config.OCR().Enabled() {
-			return nil, errors.New("The Offchain Reporting feature is disabled by configuration")
+			return jb, nil, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(r.App.GetConfig().OCR2(), r.App.GetConfig().Insecure(), args.Input.TOML)
+		jb, err = validate.ValidatedOracleSpecToml(config.OCR2(), config.Insecure(), toml)
 		if !config.OCR2().Enabled() {
-			return nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
+			return jb, nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(toml)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), args.Input.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), toml)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(toml)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+		jb, err = cron.ValidatedCronSpec(toml)
 	case job.VRF:
-		jb, err = vrfcommon.ValidatedVRFSpec(args.Input.TOML)
+		jb, err = vrfcommon.ValidatedVRFSpec(toml)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(args.Input.TOML, r.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(toml, app.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(args.Input.TOML)
+		jb, err = blockhashstore.ValidatedSpec(toml)
 	case job.BlockHeaderFeeder:
-		jb, err = blockheaderfeeder.ValidatedSpec(args.Input.TOML)
+		jb, err = blockheaderfeeder.ValidatedSpec(toml)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(args.Input.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(toml)
 	case job.Gateway:
-		jb, err = gateway.ValidatedGatewaySpec(args.Input.TOML)
+		jb, err = gateway.ValidatedGatewaySpec(toml)
+	case job.LOOP:
+		jb, err = loopjob.ValidatedSpec(toml)
 	default:
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return jb, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
+		}, nil
+	}
+	return jb, nil, err
+}
+
+func (r *Resolver) DeleteJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*DeleteJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeleteJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+	if !canAccessJob(ctx, j.Tenant) {
+		return NewDeleteJobPayload(r.App, nil, sql.ErrNoRows), nil
+	}
+
+	err = r.App.DeleteJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeleteJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": args.ID})
+	return NewDeleteJobPayload(r.App, &j, nil), nil
+}
+
+// RestartJob stops and starts the services of a job, lifting its quarantine if its services failed to start too many
+// times. A failure to start them again is reported by the startFailure of the job.
+func (r *Resolver) RestartJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*RestartJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRestartJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+	if !canAccessJob(ctx, j.Tenant) {
+		return NewRestartJobPayload(r.App, nil, sql.ErrNoRows), nil
+	}
+
+	if err = r.App.JobSpawner().RestartJob(ctx, id); err != nil {
+		r.App.GetLogger().Warnw("Failed to restart job", "jobID", id, "err", err)
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobRestarted, map[string]interface{}{"id": args.ID})
+
+	return NewRestartJobPayload(r.App, &j, nil), nil
+}
+
+// StageJobVersion validates a new version of a job, which keeps the external job ID of the job, without running it
+// until it is switched to. Staging a version replaces the version staged before, if any.
+func (r *Resolver) StageJobVersion(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input struct {
+		TOML         string
+		TemplateVars *gqlscalar.Map
+	}
+}) (*StageJobVersionPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewStageJobVersionPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+	if !canAccessJob(ctx, live.Tenant) {
+		return NewStageJobVersionPayload(nil, sql.ErrNoRows, nil), nil
+	}
+
+	tomlString, inputErrs := renderedJobSpec(args.Input.TOML, args.Input.TemplateVars)
+	if inputErrs != nil {
+		return NewStageJobVersionPayload(nil, nil, inputErrs), nil
+	}
+	if _, inputErrs = r.validatedJobVersion(tomlString, live); inputErrs != nil {
+		return NewStageJobVersionPayload(nil, nil, inputErrs), nil
+	}
+
+	v := job.JobVersion{ExternalJobID: live.ExternalJobID, Definition: tomlString, Status: job.JobVersionStaged}
+	if err = r.App.JobORM().CreateJobVersion(&v, pg.WithParentCtx(ctx)); err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobVersionStaged, map[string]interface{}{"jobID": args.ID, "version": v.Version})
+
+	return NewStageJobVersionPayload(&v, nil, nil), nil
+}
+
+// ShadowRunJobVersion runs the pipeline of a version of a job once, without persisting the run. Pipelines with an
+// ethtx task are not shadow run, since they would transmit.
+func (r *Resolver) ShadowRunJobVersion(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *runJobInput
+}) (*ShadowRunJobVersionPayloadResolver, error) {
+	if err := authenticateUserCanRun(ctx); err != nil {
+		return nil, err
+	}
+
+	v, live, err := r.findJobVersion(ctx, args.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewShadowRunJobVersionPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	jb, inputErrs := r.validatedJobVersion(v.Definition, live)
+	if inputErrs != nil {
+		return NewShadowRunJobVersionPayload(nil, nil, inputErrs), nil
+	}
+	if len(jb.Pipeline.Tasks) == 0 {
+		return NewShadowRunJobVersionPayload(nil, nil, map[string]string{
+			"id": "the job has no pipeline to run",
 		}), nil
 	}
+	for _, t := range jb.Pipeline.Tasks {
+		if t.Type() == pipeline.TaskTypeETHTx {
+			return NewShadowRunJobVersionPayload(nil, nil, map[string]string{
+				"id": fmt.Sprintf("task %s would transmit, pipelines with an ethtx task cannot be shadow run", t.DotID()),
+			}), nil
+		}
+	}
+
+	var overrides map[string]interface{}
+	if args.Input != nil {
+		if args.Input.Vars != nil {
+			overrides = *args.Input.Vars
+		}
+		if args.Input.Timeout != nil {
+			timeout, err2 := time.ParseDuration(*args.Input.Timeout)
+			if err2 != nil {
+				return nil, errors.Wrap(err2, "invalid timeout")
+			}
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
+	// the version runs as the live job
+	jb.ID = live.ID
+	run, err := r.App.ShadowRunJob(ctx, jb, overrides)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
+	return NewShadowRunJobVersionPayload(run, nil, nil), nil
+}
 
-	err = r.App.AddJobV2(ctx, &jb)
+// SwitchJobVersion replaces a job by a staged or retired version of it in a single transaction. The version which was
+// live is retired, so that it can be switched back to.
+func (r *Resolver) SwitchJobVersion(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*SwitchJobVersionPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	v, live, err := r.findJobVersion(ctx, args.ID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewSwitchJobVersionPayload(r.App, nil, err, nil), nil
+		}
+
 		return nil, err
 	}
+	if v.Status == job.JobVersionLive {
+		return NewSwitchJobVersionPayload(r.App, nil, nil, map[string]string{
+			"id": "the version is already live",
+		}), nil
+	}
 
-	jbj, _ := json.Marshal(jb)
-	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+	jb, inputErrs, err := r.switchJobVersion(ctx, v, live)
+	if inputErrs != nil {
+		return NewSwitchJobVersionPayload(r.App, nil, nil, inputErrs), nil
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	return NewCreateJobPayload(r.App, &jb, nil), nil
+	return NewSwitchJobVersionPayload(r.App, &jb, nil, nil), nil
 }
 
-func (r *Resolver) DeleteJob(ctx context.Context, args struct {
+// RollbackJob switches a job back to the version which was live before its live version.
+func (r *Resolver) RollbackJob(ctx context.Context, args struct {
 	ID graphql.ID
-}) (*DeleteJobPayloadResolver, error) {
+}) (*RollbackJobPayloadResolver, error) {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
@@ -1082,26 +1538,151 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	live, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return NewDeleteJobPayload(r.App, nil, err), nil
+			return NewRollbackJobPayload(r.App, nil, err, nil), nil
 		}
 
 		return nil, err
 	}
+	if !canAccessJob(ctx, live.Tenant) {
+		return NewRollbackJobPayload(r.App, nil, sql.ErrNoRows, nil), nil
+	}
 
-	err = r.App.DeleteJob(ctx, id)
+	versions, err := r.App.JobORM().FindJobVersions(live.ExternalJobID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// versions are latest first, so the previous version is the first retired one after the live version
+	var previous *job.JobVersion
+	liveSeen := !slices.ContainsFunc(versions, func(v job.JobVersion) bool { return v.Status == job.JobVersionLive })
+	for i, v := range versions {
+		if v.Status == job.JobVersionLive {
+			liveSeen = true
+		} else if liveSeen && v.Status == job.JobVersionRetired {
+			previous = &versions[i]
+			break
+		}
+	}
+	if previous == nil {
+		return NewRollbackJobPayload(r.App, nil, nil, map[string]string{
+			"id": "the job has no previous version to roll back to",
+		}), nil
+	}
+
+	jb, inputErrs, err := r.switchJobVersion(ctx, *previous, live)
+	if inputErrs != nil {
+		return NewRollbackJobPayload(r.App, nil, nil, inputErrs), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRollbackJobPayload(r.App, &jb, nil, nil), nil
+}
+
+// findJobVersion returns the version with the given ID and the live job it is a version of.
+func (r *Resolver) findJobVersion(ctx context.Context, gqlID graphql.ID) (v job.JobVersion, live job.Job, err error) {
+	id, err := stringutils.ToInt64(string(gqlID))
+	if err != nil {
+		return v, live, err
+	}
+
+	v, err = r.App.JobORM().FindJobVersion(id, pg.WithParentCtx(ctx))
+	if err != nil {
+		return v, live, err
+	}
+	live, err = r.App.JobORM().FindJobByExternalJobID(v.ExternalJobID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return v, live, err
+	}
+	if !canAccessJob(ctx, live.Tenant) {
+		return v, live, sql.ErrNoRows
+	}
+	return v, live, nil
+}
+
+// validatedJobVersion validates the definition of a version of the live job. Every error is an input error, since
+// the version is defined by the user.
+func (r *Resolver) validatedJobVersion(tomlString string, live job.Job) (jb job.Job, inputErrs map[string]string) {
+	jb, inputErrs, err := validatedJobSpec(r.App, tomlString)
+	if inputErrs != nil {
+		return jb, inputErrs
+	}
+	if err != nil {
+		return jb, map[string]string{"TOML spec": err.Error()}
+	}
+
+	// A version without an external job ID takes the one of the job, but it cannot be set to another one
+	var spec struct {
+		ExternalJobID *string `toml:"externalJobID"`
+	}
+	if err = toml.Unmarshal([]byte(tomlString), &spec); err != nil {
+		return jb, map[string]string{"TOML spec": errors.Wrap(err, "failed to parse TOML").Error()}
+	}
+	if spec.ExternalJobID != nil && *spec.ExternalJobID != live.ExternalJobID.String() {
+		return jb, map[string]string{
+			"externalJobID": fmt.Sprintf("must be the external job ID of the job: %s", live.ExternalJobID),
+		}
+	}
+	jb.ExternalJobID = live.ExternalJobID
+	jb.Tenant = live.Tenant
+
+	if err = r.App.JobORM().AssertBridgesExist(jb.Pipeline); err != nil {
+		return jb, map[string]string{"TOML spec": err.Error()}
+	}
+	return jb, nil
+}
+
+// switchJobVersion replaces the live job by version v, and marks v as live.
+func (r *Resolver) switchJobVersion(ctx context.Context, v job.JobVersion, live job.Job) (jb job.Job, inputErrs map[string]string, err error) {
+	if jb, inputErrs = r.validatedJobVersion(v.Definition, live); inputErrs != nil {
+		return jb, inputErrs, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err = r.App.ReplaceJob(ctx, live.ID, &jb); err != nil {
+		return jb, nil, err
+	}
+	if err = r.App.JobORM().SetLiveJobVersion(v.ID, pg.WithParentCtx(ctx)); err != nil {
+		return jb, nil, errors.Wrapf(err, "switched job %d to version %d, but failed to mark it as live", live.ID, v.Version)
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobVersionSwitched, map[string]interface{}{
+		"replacedJobID": live.ID, "jobID": jb.ID, "version": v.Version,
+	})
+	return jb, nil, nil
+}
+
+func (r *Resolver) RequeueDeadLetterEthTransaction(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*RequeueDeadLetterEthTransactionPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.App.TxmStorageService().RequeueDeadLetter(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return NewDeleteJobPayload(r.App, nil, err), nil
+			return NewRequeueDeadLetterEthTransactionPayload(nil, err), nil
 		}
 
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": args.ID})
-	return NewDeleteJobPayload(r.App, &j, nil), nil
+	r.App.GetAuditLogger().Audit(audit.EthTransactionRequeued, map[string]interface{}{"id": args.ID})
+	return NewRequeueDeadLetterEthTransactionPayload(tx, nil), nil
 }
 
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
@@ -1124,6 +1705,13 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	ok, err := r.canAccessJobID(ctx, specErr.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewDismissJobErrorPayload(nil, sql.ErrNoRows), nil
+	}
 
 	err = r.App.JobORM().DismissError(ctx, id)
 	if err != nil {
@@ -1138,8 +1726,14 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	return NewDismissJobErrorPayload(&specErr, nil), nil
 }
 
+type runJobInput struct {
+	Vars    *gqlscalar.Map
+	Timeout *string
+}
+
 func (r *Resolver) RunJob(ctx context.Context, args struct {
-	ID graphql.ID
+	ID    graphql.ID
+	Input *runJobInput
 }) (*RunJobPayloadResolver, error) {
 	if err := authenticateUserCanRun(ctx); err != nil {
 		return nil, err
@@ -1149,8 +1743,31 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	ok, err := r.canAccessJobID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewRunJobPayload(nil, r.App, webhook.ErrJobNotExists), nil
+	}
 
-	jobRunID, err := r.App.RunJobV2(ctx, jobID, nil)
+	var overrides map[string]interface{}
+	if args.Input != nil {
+		if args.Input.Vars != nil {
+			overrides = *args.Input.Vars
+		}
+		if args.Input.Timeout != nil {
+			timeout, err2 := time.ParseDuration(*args.Input.Timeout)
+			if err2 != nil {
+				return nil, errors.Wrap(err2, "invalid timeout")
+			}
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
+	jobRunID, err := r.App.RunJobV2(ctx, jobID, nil, overrides)
 	if err != nil {
 		if errors.Is(err, webhook.ErrJobNotExists) {
 			return NewRunJobPayload(nil, r.App, err), nil
@@ -1168,12 +1785,60 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+type replayJobRunInput struct {
+	StubOnChainWrites *bool
+}
+
+// ReplayJobRun executes the pipeline of a finished job run again with the inputs captured by the run, to reproduce its
+// failures. The replay is saved as a new run linked to the original.
+func (r *Resolver) ReplayJobRun(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *replayJobRunInput
+}) (*ReplayJobRunPayloadResolver, error) {
+	if err := authenticateUserCanRun(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := r.App.JobORM().FindPipelineRunByID(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewReplayJobRunPayload(nil, r.App, err, nil), nil
+		}
+
+		return nil, err
+	}
+	ok, err := r.canAccessJobID(ctx, original.PipelineSpec.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewReplayJobRunPayload(nil, r.App, sql.ErrNoRows, nil), nil
+	}
+
+	stub := args.Input == nil || args.Input.StubOnChainWrites == nil || *args.Input.StubOnChainWrites
+	run, err := r.App.ReplayJobRun(ctx, id, stub)
+	if err != nil {
+		return NewReplayJobRunPayload(nil, r.App, nil, err), nil
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobRunReplayed, map[string]interface{}{"jobRunID": id, "replayRunID": run.ID, "stubOnChainWrites": stub})
+	return NewReplayJobRunPayload(run, r.App, nil, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
 	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	var lvl zapcore.Level
 	logLvl := FromLogLevel(args.Level)
@@ -1193,6 +1858,26 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	return NewSetGlobalLogLevelPayload(args.Level, nil), nil
 }
 
+// CreateSupportBundle resolves a create support bundle mutation
+func (r *Resolver) CreateSupportBundle(ctx context.Context) (*CreateSupportBundlePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var buf bytes.Buffer
+	if err := supportbundle.Write(ctx, r.App, &buf, now); err != nil {
+		return nil, err
+	}
+
+	fileName := supportbundle.FileName(now)
+	r.App.GetAuditLogger().Audit(audit.SupportBundleCreated, map[string]interface{}{"fileName": fileName})
+	return NewCreateSupportBundlePayload(fileName, buf.Bytes()), nil
+}
+
 // CreateOCR2KeyBundle resolves a create OCR2 Key bundle mutation
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
@@ -1208,6 +1893,9 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 		// Not covering the	`chaintype.ErrInvalidChainType` since the GQL model would prevent a non-accepted chain-type from being received
 		return nil, err
 	}
+	if err = r.assignSessionTenantKey(ctx, tenancy.KeyTypeOCR2, key.ID()); err != nil {
+		return nil, err
+	}
 
 	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, map[string]interface{}{
 		"ocrKeyID":                        key.ID(),
@@ -1234,6 +1922,13 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	if err != nil {
 		return NewDeleteOCR2KeyBundlePayloadResolver(nil, err), nil
 	}
+	ok, err := r.canAccessKey(ctx, tenancy.KeyTypeOCR2, key.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewDeleteOCR2KeyBundlePayloadResolver(nil, fmt.Errorf("unable to find OCR key with id %s", id)), nil
+	}
 
 	err = r.App.GetKeyStore().OCR2().Delete(id)
 	if err != nil {
@@ -1243,3 +1938,211 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+type gatewayHandlerRateLimitInput struct {
+	GlobalRPS      float64
+	GlobalBurst    int32
+	PerSenderRPS   float64
+	PerSenderBurst int32
+}
+
+type gatewayHandlerPolicyInput struct {
+	RateLimit      *gatewayHandlerRateLimitInput
+	AllowedSenders *[]string
+	AllowedMethods *[]string
+}
+
+func (i *gatewayHandlerPolicyInput) toPolicy() gwconfig.HandlerPolicy {
+	var policy gwconfig.HandlerPolicy
+	if i == nil {
+		return policy
+	}
+	if i.RateLimit != nil {
+		policy.RateLimit = &gwconfig.HandlerRateLimit{
+			GlobalRPS:      i.RateLimit.GlobalRPS,
+			GlobalBurst:    int(i.RateLimit.GlobalBurst),
+			PerSenderRPS:   i.RateLimit.PerSenderRPS,
+			PerSenderBurst: int(i.RateLimit.PerSenderBurst),
+		}
+	}
+	if i.AllowedSenders != nil {
+		policy.AllowedSenders = *i.AllowedSenders
+	}
+	if i.AllowedMethods != nil {
+		policy.AllowedMethods = *i.AllowedMethods
+	}
+	return policy
+}
+
+type gatewayNodeInput struct {
+	Name    string
+	Address string
+}
+
+type addGatewayHandlerInput struct {
+	DonID         string
+	HandlerName   string
+	HandlerConfig *gqlscalar.Map
+	Members       []gatewayNodeInput
+	F             int32
+	Enabled       bool
+	Policy        *gatewayHandlerPolicyInput
+}
+
+// findGateway returns the gateway of a running Gateway job, which the authenticated user has access to.
+func (r *Resolver) findGateway(ctx context.Context, id graphql.ID) (int32, gateway.Gateway, error) {
+	jobID, err := stringutils.ToInt32(string(id))
+	if err != nil {
+		return 0, nil, err
+	}
+	gw, ok := r.App.Gateways()[jobID]
+	if !ok {
+		return jobID, nil, errGatewayNotFound
+	}
+	ok, err = r.canAccessJobID(ctx, jobID)
+	if err != nil {
+		return jobID, nil, err
+	}
+	if !ok {
+		return jobID, nil, errGatewayNotFound
+	}
+	return jobID, gw, nil
+}
+
+// AddGatewayHandler adds the handler of a new DON to the gateway of a job, without restarting it.
+func (r *Resolver) AddGatewayHandler(ctx context.Context, args struct {
+	JobID graphql.ID
+	Input addGatewayHandlerInput
+}) (*AddGatewayHandlerPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, gw, err := r.findGateway(ctx, args.JobID)
+	if errors.Is(err, errGatewayNotFound) {
+		return NewAddGatewayHandlerPayload(nil, err, nil), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	donConfig := gwconfig.DONConfig{
+		DonId:       args.Input.DonID,
+		HandlerName: args.Input.HandlerName,
+		F:           int(args.Input.F),
+		Disabled:    !args.Input.Enabled,
+		Policy:      args.Input.Policy.toPolicy(),
+	}
+	if args.Input.HandlerConfig != nil {
+		donConfig.HandlerConfig, err = json.Marshal(args.Input.HandlerConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range args.Input.Members {
+		donConfig.Members = append(donConfig.Members, gwconfig.NodeConfig{Name: m.Name, Address: m.Address})
+	}
+
+	if err = gw.AddHandler(ctx, donConfig); err != nil {
+		if errors.Is(err, gateway.ErrHandlerExists) {
+			return NewAddGatewayHandlerPayload(nil, nil, map[string]string{"input/donID": err.Error()}), nil
+		}
+		return NewAddGatewayHandlerPayload(nil, nil, map[string]string{"input": err.Error()}), nil
+	}
+	status, err := handlerStatus(gw, donConfig.DonId)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.GatewayHandlerAdded, map[string]interface{}{"jobID": jobID, "donID": status.DonId, "handlerName": status.HandlerName, "enabled": status.Enabled, "policy": status.Policy})
+	return NewAddGatewayHandlerPayload(&GatewayHandler{JobID: jobID, HandlerStatus: status}, nil, nil), nil
+}
+
+// SetChainEnabled enables or disables an EVM chain at runtime, until the node restarts.
+func (r *Resolver) SetChainEnabled(ctx context.Context, args struct {
+	ID      graphql.ID
+	Enabled bool
+}) (*SetChainEnabledPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	relayID := relay.ID{Network: relay.EVM, ChainID: string(args.ID)}
+	chain, err := r.App.SetChainEnabled(ctx, relayID, args.Enabled)
+	if errors.Is(err, chains.ErrNotFound) {
+		return NewSetChainEnabledPayload(nil, err), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	event := audit.ChainDisabled
+	if args.Enabled {
+		event = audit.ChainEnabled
+	}
+	r.App.GetAuditLogger().Audit(event, map[string]interface{}{"chain": relayID.Name()})
+	return NewSetChainEnabledPayload(&chain, nil), nil
+}
+
+// SetGatewayHandlerEnabled enables or disables the handler of a DON in the gateway of a job.
+func (r *Resolver) SetGatewayHandlerEnabled(ctx context.Context, args struct {
+	JobID   graphql.ID
+	DonID   string
+	Enabled bool
+}) (*SetGatewayHandlerEnabledPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, gw, err := r.findGateway(ctx, args.JobID)
+	if errors.Is(err, errGatewayNotFound) {
+		return NewSetGatewayHandlerEnabledPayload(nil, err), nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = gw.SetHandlerEnabled(args.DonID, args.Enabled); err != nil {
+		if errors.Is(err, gateway.ErrHandlerNotFound) {
+			return NewSetGatewayHandlerEnabledPayload(nil, err), nil
+		}
+		return nil, err
+	}
+	status, err := handlerStatus(gw, args.DonID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.GatewayHandlerEnabledSet, map[string]interface{}{"jobID": jobID, "donID": args.DonID, "enabled": args.Enabled})
+	return NewSetGatewayHandlerEnabledPayload(&GatewayHandler{JobID: jobID, HandlerStatus: status}, nil), nil
+}
+
+// UpdateGatewayHandlerPolicy replaces the policy of the handler of a DON in the gateway of a job.
+func (r *Resolver) UpdateGatewayHandlerPolicy(ctx context.Context, args struct {
+	JobID graphql.ID
+	DonID string
+	Input gatewayHandlerPolicyInput
+}) (*UpdateGatewayHandlerPolicyPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, gw, err := r.findGateway(ctx, args.JobID)
+	if errors.Is(err, errGatewayNotFound) {
+		return NewUpdateGatewayHandlerPolicyPayload(nil, err, nil), nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = gw.SetHandlerPolicy(args.DonID, args.Input.toPolicy()); err != nil {
+		if errors.Is(err, gateway.ErrHandlerNotFound) {
+			return NewUpdateGatewayHandlerPolicyPayload(nil, err, nil), nil
+		}
+		return NewUpdateGatewayHandlerPolicyPayload(nil, nil, map[string]string{"input": err.Error()}), nil
+	}
+	status, err := handlerStatus(gw, args.DonID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.GatewayHandlerPolicyUpdated, map[string]interface{}{"jobID": jobID, "donID": args.DonID, "policy": status.Policy})
+	return NewUpdateGatewayHandlerPolicyPayload(&GatewayHandler{JobID: jobID, HandlerStatus: status}, nil, nil), nil
+}
@@ -0,0 +1,156 @@
+package resolver
+
+import (
+	"errors"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+// P2PPeerResolver resolves the P2PPeer type.
+type P2PPeerResolver struct {
+	peer ocrcommon.P2PPeer
+	now  time.Time
+}
+
+func NewP2PPeer(peer ocrcommon.P2PPeer, now time.Time) *P2PPeerResolver {
+	return &P2PPeerResolver{peer: peer, now: now}
+}
+
+func NewP2PPeers(peers []ocrcommon.P2PPeer, now time.Time) []*P2PPeerResolver {
+	var resolvers []*P2PPeerResolver
+	for _, p := range peers {
+		resolvers = append(resolvers, NewP2PPeer(p, now))
+	}
+
+	return resolvers
+}
+
+// PeerID resolves the peer ID of the remote peer.
+func (r *P2PPeerResolver) PeerID() string {
+	return r.peer.PeerID
+}
+
+// Connected resolves whether a connection to the peer is established.
+func (r *P2PPeerResolver) Connected() bool {
+	return r.peer.Connected
+}
+
+// LastConnectedAt resolves when the last connection to the peer was established.
+func (r *P2PPeerResolver) LastConnectedAt() *graphql.Time {
+	return optionalTime(r.peer.LastConnectedAt)
+}
+
+// DialFailures resolves the number of failed dials of the peer since the node started.
+func (r *P2PPeerResolver) DialFailures() int32 {
+	return int32(r.peer.DialFailures)
+}
+
+// LastDialError resolves the error of the last failed dial of the peer.
+func (r *P2PPeerResolver) LastDialError() *string {
+	if r.peer.LastDialError == "" {
+		return nil
+	}
+	return &r.peer.LastDialError
+}
+
+// LastDialErrorAt resolves the time of the last failed dial of the peer.
+func (r *P2PPeerResolver) LastDialErrorAt() *graphql.Time {
+	return optionalTime(r.peer.LastDialErrorAt)
+}
+
+// Instances resolves the traffic with the peer of the running OCR instances.
+func (r *P2PPeerResolver) Instances() []*P2PInstanceTrafficResolver {
+	resolvers := []*P2PInstanceTrafficResolver{}
+	for _, t := range r.peer.Instances {
+		resolvers = append(resolvers, &P2PInstanceTrafficResolver{traffic: t, now: r.now})
+	}
+
+	return resolvers
+}
+
+// P2PInstanceTrafficResolver resolves the P2PInstanceTraffic type.
+type P2PInstanceTrafficResolver struct {
+	traffic ocrcommon.P2PInstanceTraffic
+	now     time.Time
+}
+
+// ConfigDigest resolves the config digest of the OCR instance.
+func (r *P2PInstanceTrafficResolver) ConfigDigest() string {
+	return r.traffic.ConfigDigest
+}
+
+// Since resolves the time the OCR instance started.
+func (r *P2PInstanceTrafficResolver) Since() graphql.Time {
+	return graphql.Time{Time: r.traffic.Since}
+}
+
+// MessagesSent resolves the number of messages sent to the peer.
+func (r *P2PInstanceTrafficResolver) MessagesSent() int32 {
+	return int32(r.traffic.MessagesSent)
+}
+
+// MessagesReceived resolves the number of messages received from the peer.
+func (r *P2PInstanceTrafficResolver) MessagesReceived() int32 {
+	return int32(r.traffic.MessagesReceived)
+}
+
+// BytesSent resolves the number of bytes sent to the peer.
+func (r *P2PInstanceTrafficResolver) BytesSent() string {
+	return stringutils.FromInt64(r.traffic.BytesSent)
+}
+
+// BytesReceived resolves the number of bytes received from the peer.
+func (r *P2PInstanceTrafficResolver) BytesReceived() string {
+	return stringutils.FromInt64(r.traffic.BytesReceived)
+}
+
+// MessagesSentPerSecond resolves the average number of messages sent to the peer per second.
+func (r *P2PInstanceTrafficResolver) MessagesSentPerSecond() float64 {
+	sent, _ := r.traffic.MessageRates(r.now)
+	return sent
+}
+
+// MessagesReceivedPerSecond resolves the average number of messages received from the peer per second.
+func (r *P2PInstanceTrafficResolver) MessagesReceivedPerSecond() float64 {
+	_, received := r.traffic.MessageRates(r.now)
+	return received
+}
+
+// -- P2PPeers Query --
+
+type P2PPeersPayloadResolver struct {
+	peers []ocrcommon.P2PPeer
+	now   time.Time
+	NotFoundErrorUnionType
+}
+
+func NewP2PPeersPayload(peers []ocrcommon.P2PPeer, now time.Time, err error) *P2PPeersPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "P2P is disabled", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, chainlink.ErrP2PDisabled)
+	}}
+
+	return &P2PPeersPayloadResolver{peers: peers, now: now, NotFoundErrorUnionType: e}
+}
+
+func (r *P2PPeersPayloadResolver) ToP2PPeers() (*P2PPeersResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &P2PPeersResolver{peers: r.peers, now: r.now}, true
+}
+
+// P2PPeersResolver resolves the P2PPeers type.
+type P2PPeersResolver struct {
+	peers []ocrcommon.P2PPeer
+	now   time.Time
+}
+
+func (r *P2PPeersResolver) Results() []*P2PPeerResolver {
+	return NewP2PPeers(r.peers, r.now)
+}
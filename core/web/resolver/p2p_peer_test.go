@@ -0,0 +1,138 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+func TestResolver_P2PPeers(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetP2PPeers {
+			p2pPeers {
+				... on P2PPeers {
+					results {
+						peerID
+						connected
+						lastConnectedAt
+						dialFailures
+						lastDialError
+						lastDialErrorAt
+						instances {
+							configDigest
+							since
+							messagesSent
+							messagesReceived
+							bytesSent
+							bytesReceived
+						}
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	at := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "p2pPeers"),
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"p2pPeers"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("P2PPeers").Return([]ocrcommon.P2PPeer{
+					{
+						PeerID:          "12D3KooWL3XJ9EMCyZvmmGXL2LMiVBtrVa2BuESsJiXkSj7333Jw",
+						Connected:       true,
+						LastConnectedAt: at,
+						Instances: []ocrcommon.P2PInstanceTraffic{{
+							ConfigDigest:     "000a",
+							Since:            at,
+							MessagesSent:     3,
+							MessagesReceived: 2,
+							BytesSent:        300,
+							BytesReceived:    200,
+						}},
+					},
+					{
+						PeerID:          "12D3KooWLtxTiCXe4EjHjvamVjzz2UQRQFNDwULGJ5LvH7A9GRtN",
+						DialFailures:    2,
+						LastDialError:   "i/o timeout",
+						LastDialErrorAt: at,
+					},
+				}, nil)
+			},
+			query: query,
+			result: `
+				{
+					"p2pPeers": {
+						"results": [{
+							"peerID": "12D3KooWL3XJ9EMCyZvmmGXL2LMiVBtrVa2BuESsJiXkSj7333Jw",
+							"connected": true,
+							"lastConnectedAt": "2021-01-01T00:00:00Z",
+							"dialFailures": 0,
+							"lastDialError": null,
+							"lastDialErrorAt": null,
+							"instances": [{
+								"configDigest": "000a",
+								"since": "2021-01-01T00:00:00Z",
+								"messagesSent": 3,
+								"messagesReceived": 2,
+								"bytesSent": "300",
+								"bytesReceived": "200"
+							}]
+						}, {
+							"peerID": "12D3KooWLtxTiCXe4EjHjvamVjzz2UQRQFNDwULGJ5LvH7A9GRtN",
+							"connected": false,
+							"lastConnectedAt": null,
+							"dialFailures": 2,
+							"lastDialError": "i/o timeout",
+							"lastDialErrorAt": "2021-01-01T00:00:00Z",
+							"instances": []
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "disabled",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("P2PPeers").Return(nil, chainlink.ErrP2PDisabled)
+			},
+			query: query,
+			result: `
+				{
+					"p2pPeers": {
+						"message": "P2P is disabled",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
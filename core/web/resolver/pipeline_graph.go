@@ -0,0 +1,96 @@
+package resolver
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/gqlscalar"
+)
+
+// PipelineGraphResolver resolves the PipelineGraph type.
+type PipelineGraphResolver struct {
+	dag pipeline.DAG
+}
+
+func NewPipelineGraph(dag pipeline.DAG) *PipelineGraphResolver {
+	return &PipelineGraphResolver{dag: dag}
+}
+
+func (r *PipelineGraphResolver) Nodes() []*PipelineGraphNodeResolver {
+	var resolvers []*PipelineGraphNodeResolver
+	for _, n := range r.dag.Nodes {
+		resolvers = append(resolvers, &PipelineGraphNodeResolver{node: n})
+	}
+	return resolvers
+}
+
+func (r *PipelineGraphResolver) Edges() []*PipelineGraphEdgeResolver {
+	var resolvers []*PipelineGraphEdgeResolver
+	for _, e := range r.dag.Edges {
+		resolvers = append(resolvers, &PipelineGraphEdgeResolver{edge: e})
+	}
+	return resolvers
+}
+
+// PipelineGraphNodeResolver resolves the PipelineGraphNode type.
+type PipelineGraphNodeResolver struct {
+	node pipeline.DAGNode
+}
+
+func (r *PipelineGraphNodeResolver) ID() string {
+	return r.node.ID
+}
+
+func (r *PipelineGraphNodeResolver) Type() string {
+	return string(r.node.Type)
+}
+
+func (r *PipelineGraphNodeResolver) Attributes() gqlscalar.Map {
+	attrs := make(gqlscalar.Map, len(r.node.Attributes))
+	for k, v := range r.node.Attributes {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// PipelineGraphEdgeResolver resolves the PipelineGraphEdge type.
+type PipelineGraphEdgeResolver struct {
+	edge pipeline.DAGEdge
+}
+
+func (r *PipelineGraphEdgeResolver) From() string {
+	return r.edge.From
+}
+
+func (r *PipelineGraphEdgeResolver) To() string {
+	return r.edge.To
+}
+
+func (r *PipelineGraphEdgeResolver) Implicit() bool {
+	return r.edge.Implicit
+}
+
+// -- ParsePipeline Query --
+
+type ParsePipelinePayloadResolver struct {
+	dag *pipeline.DAG
+	err error
+}
+
+func NewParsePipelinePayload(dag *pipeline.DAG, err error) *ParsePipelinePayloadResolver {
+	return &ParsePipelinePayloadResolver{dag: dag, err: err}
+}
+
+func (r *ParsePipelinePayloadResolver) ToPipelineGraph() (*PipelineGraphResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewPipelineGraph(*r.dag), true
+}
+
+func (r *ParsePipelinePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.err == nil {
+		return nil, false
+	}
+
+	return NewInputErrors([]*InputErrorResolver{NewInputError("source", r.err.Error())}), true
+}
@@ -0,0 +1,94 @@
+package resolver
+
+import (
+	"testing"
+)
+
+func TestResolver_ParsePipeline(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query ParsePipeline($source: String!) {
+			parsePipeline(source: $source) {
+				... on PipelineGraph {
+					nodes {
+						id
+						type
+						attributes
+					}
+					edges {
+						from
+						to
+						implicit
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: map[string]interface{}{"source": "ds1 [type=memo];"}}, "parsePipeline"),
+		{
+			name:          "success",
+			authenticated: true,
+			query:         query,
+			variables: map[string]interface{}{"source": `
+				ds1 [type=bridge name=voter_turnout];
+				ds1_parse [type=jsonparse path="data,result"];
+				ds1_multiply [type=multiply times="$(ds1_parse)"];
+				ds1 -> ds1_parse -> ds1_multiply;
+			`},
+			result: `
+				{
+					"parsePipeline": {
+						"nodes": [{
+							"id": "ds1",
+							"type": "bridge",
+							"attributes": {"name": "voter_turnout"}
+						}, {
+							"id": "ds1_parse",
+							"type": "jsonparse",
+							"attributes": {"path": "data,result"}
+						}, {
+							"id": "ds1_multiply",
+							"type": "multiply",
+							"attributes": {"times": "$(ds1_parse)"}
+						}],
+						"edges": [{
+							"from": "ds1",
+							"to": "ds1_parse",
+							"implicit": false
+						}, {
+							"from": "ds1_parse",
+							"to": "ds1_multiply",
+							"implicit": false
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid pipeline",
+			authenticated: true,
+			query:         query,
+			variables:     map[string]interface{}{"source": " "},
+			result: `
+				{
+					"parsePipeline": {
+						"errors": [{
+							"path": "source",
+							"message": "empty pipeline",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
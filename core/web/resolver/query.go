@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
@@ -13,13 +16,37 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/tenancy"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
+// AccountBalances retrieves the balances of the accounts of the node on every chain, as of the last poll of the
+// balance monitor.
+func (r *Resolver) AccountBalances(ctx context.Context) (*AccountBalancesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	m := r.App.BalanceMonitor()
+	if m == nil {
+		return NewAccountBalancesPayload(nil, errBalanceMonitorDisabled), nil
+	}
+
+	return NewAccountBalancesPayload(m.Balances(), nil), nil
+}
+
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -34,13 +61,16 @@ func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*B
 	bridge, err := r.App.BridgeORM().FindBridge(name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return NewBridgePayload(bridge, err), nil
+			return NewBridgePayload(r.App, bridge, err), nil
 		}
 
 		return nil, err
 	}
+	if !tenancy.CanAccess(sessionTenant(ctx), bridge.Tenant) {
+		return NewBridgePayload(r.App, bridges.BridgeType{}, sql.ErrNoRows), nil
+	}
 
-	return NewBridgePayload(bridge, nil), nil
+	return NewBridgePayload(r.App, bridge, nil), nil
 }
 
 // Bridges retrieves a paginated list of bridges.
@@ -55,12 +85,21 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	offset := pageOffset(args.Offset)
 	limit := pageLimit(args.Limit)
 
-	brdgs, count, err := r.App.BridgeORM().BridgeTypes(offset, limit)
+	var (
+		brdgs []bridges.BridgeType
+		count int
+		err   error
+	)
+	if tenant := sessionTenant(ctx); tenant.Valid {
+		brdgs, count, err = r.App.BridgeORM().TenantBridgeTypes(tenant.String, offset, limit)
+	} else {
+		brdgs, count, err = r.App.BridgeORM().BridgeTypes(offset, limit)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return NewBridgesPayload(brdgs, int32(count)), nil
+	return NewBridgesPayload(r.App, brdgs, int32(count)), nil
 }
 
 // Chain retrieves a chain by id.
@@ -116,6 +155,9 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(string(args.ID))
 	if err != nil {
@@ -138,6 +180,9 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	mgrs, err := r.App.GetFeedsService().ListManagers()
 	if err != nil {
@@ -171,6 +216,9 @@ func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobP
 
 		return nil, err
 	}
+	if !canAccessJob(ctx, j.Tenant) {
+		return NewJobPayload(r.App, nil, sql.ErrNoRows), nil
+	}
 
 	return NewJobPayload(r.App, &j, nil), nil
 }
@@ -187,7 +235,16 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	offset := pageOffset(args.Offset)
 	limit := pageLimit(args.Limit)
 
-	jobs, count, err := r.App.JobORM().FindJobs(offset, limit)
+	var (
+		jobs  []job.Job
+		count int
+		err   error
+	)
+	if tenant := sessionTenant(ctx); tenant.Valid {
+		jobs, count, err = r.App.ReplicaJobORM().FindTenantJobs(tenant.String, offset, limit)
+	} else {
+		jobs, count, err = r.App.ReplicaJobORM().FindJobs(offset, limit)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +261,10 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 	if err != nil {
 		return nil, err
 	}
+	ocrKeyBundles, err = filterTenantKeys(ctx, r.App, tenancy.KeyTypeOCR, ocrKeyBundles)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewOCRKeyBundlesPayloadResolver(ocrKeyBundles), nil
 }
@@ -217,6 +278,10 @@ func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error)
 	if err != nil {
 		return nil, err
 	}
+	keys, err = filterTenantKeys(ctx, r.App, tenancy.KeyTypeCSA, keys)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewCSAKeysResolver(keys), nil
 }
@@ -259,6 +324,82 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 	return npr, nil
 }
 
+// Reorgs retrieves the most recent re-orgs observed by the head tracker of an EVM chain.
+func (r *Resolver) Reorgs(ctx context.Context, args struct {
+	ChainID graphql.ID
+	Limit   *int32
+}) (*ReorgsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	chain, err := r.App.GetRelayers().LegacyEVMChains().Get(string(args.ChainID))
+	if err != nil {
+		return NewReorgsPayload(nil, chains.ErrNotFound), nil
+	}
+
+	return NewReorgsPayload(chain.HeadTracker().RecentReorgs(pageLimit(args.Limit)), nil), nil
+}
+
+// SimulateUpkeep runs checkUpkeep, or checkCallback, for an upkeep at the latest block through its registry.
+func (r *Resolver) SimulateUpkeep(ctx context.Context, args struct {
+	Input struct {
+		EVMChainID        graphql.ID
+		RegistryAddress   string
+		UpkeepID          string
+		TriggerData       *string
+		CallbackValues    *[]string
+		CallbackExtraData *string
+	}
+}) (*SimulateUpkeepPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var callbackValues []string
+	if args.Input.CallbackValues != nil {
+		callbackValues = *args.Input.CallbackValues
+	}
+	req, err := keeper.ParseUpkeepSimulationRequest(args.Input.RegistryAddress, args.Input.UpkeepID, args.Input.TriggerData, callbackValues, args.Input.CallbackExtraData)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := r.App.GetRelayers().LegacyEVMChains().Get(string(args.Input.EVMChainID))
+	if err != nil {
+		return NewSimulateUpkeepPayload(nil, chains.ErrNotFound), nil
+	}
+
+	sim, err := keeper.SimulateUpkeep(ctx, chain.Client(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSimulateUpkeepPayload(NewUpkeepSimulation(chain.ID().String(), req, *sim), nil), nil
+}
+
+// ParsePipeline parses the DOT source of a pipeline into its graph, so that it can be rendered and validated without
+// creating a job.
+func (r *Resolver) ParsePipeline(ctx context.Context, args struct{ Source string }) (*ParsePipelinePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := pipeline.Parse(args.Source)
+	if err != nil {
+		return NewParsePipelinePayload(nil, err), nil
+	}
+	dag := p.DAG()
+
+	return NewParsePipelinePayload(&dag, nil), nil
+}
+
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -268,10 +409,35 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 	if err != nil {
 		return nil, err
 	}
+	p2pKeys, err = filterTenantKeys(ctx, r.App, tenancy.KeyTypeP2P, p2pKeys)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewP2PKeysPayload(p2pKeys), nil
 }
 
+// P2PPeers retrieves the state of the connections to remote peers, and the traffic with them of the running OCR
+// instances.
+func (r *Resolver) P2PPeers(ctx context.Context) (*P2PPeersPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	peers, err := r.App.P2PPeers()
+	if err != nil {
+		if errors.Is(err, chainlink.ErrP2PDisabled) {
+			return NewP2PPeersPayload(nil, time.Now(), err), nil
+		}
+		return nil, err
+	}
+
+	return NewP2PPeersPayload(peers, time.Now(), nil), nil
+}
+
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -282,6 +448,10 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 	if err != nil {
 		return nil, err
 	}
+	keys, err = filterTenantKeys(ctx, r.App, tenancy.KeyTypeVRF, keys)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewVRFKeysPayloadResolver(keys), nil
 }
@@ -301,10 +471,60 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 		}
 		return nil, err
 	}
+	ok, err := r.canAccessKey(ctx, tenancy.KeyTypeVRF, key.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewVRFKeyPayloadResolver(vrfkey.KeyV2{}, keystore.ErrMissingVRFKey), nil
+	}
 
 	return NewVRFKeyPayloadResolver(key, nil), err
 }
 
+// VRFSubscriptions retrieves the subscriptions tracked by the subscription monitors of the VRF jobs, optionally
+// filtered by job.
+func (r *Resolver) VRFSubscriptions(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (*VRFSubscriptionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	monitors := r.App.VRFSubscriptionMonitors()
+	jobIDs := make([]int32, 0, len(monitors))
+	if args.JobID != nil {
+		id, err := stringutils.ToInt32(string(*args.JobID))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := monitors[id]; !ok {
+			return NewVRFSubscriptionsPayload(nil, chains.ErrNotFound), nil
+		}
+		jobIDs = append(jobIDs, id)
+	} else {
+		for id := range monitors {
+			jobIDs = append(jobIDs, id)
+		}
+		sort.Slice(jobIDs, func(i, j int) bool { return jobIDs[i] < jobIDs[j] })
+	}
+	jobIDs, err := r.filterTenantJobIDs(ctx, jobIDs)
+	if err != nil {
+		return nil, err
+	}
+	if args.JobID != nil && len(jobIDs) == 0 {
+		return NewVRFSubscriptionsPayload(nil, chains.ErrNotFound), nil
+	}
+
+	var subs []VRFSubscription
+	for _, id := range jobIDs {
+		for _, s := range monitors[id].Subscriptions() {
+			subs = append(subs, VRFSubscription{JobID: id, SubscriptionStatus: s})
+		}
+	}
+	return NewVRFSubscriptionsPayload(subs, nil), nil
+}
+
 // JobProposal retrieves a job proposal by ID
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
@@ -312,6 +532,9 @@ func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	id, err := stringutils.ToInt64(string(args.ID))
 	if err != nil {
@@ -359,6 +582,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
+	Filter *JobRunsFilterInput
 }) (*JobRunsPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -367,7 +591,27 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	limit := pageLimit(args.Limit)
 	offset := pageOffset(args.Offset)
 
-	runs, count, err := r.App.JobORM().PipelineRuns(nil, offset, limit)
+	var (
+		runs  []pipeline.Run
+		count int
+		err   error
+	)
+	tenant := sessionTenant(ctx)
+	switch {
+	case args.Filter != nil:
+		query := job.NewPipelineRunsQuery()
+		if tenant.Valid {
+			query = query.Tenant(tenant.String)
+		}
+		if query, err = args.Filter.toQuery(query); err != nil {
+			return nil, err
+		}
+		runs, count, err = r.App.ReplicaJobORM().QueryPipelineRuns(query, offset, limit)
+	case tenant.Valid:
+		runs, count, err = r.App.ReplicaJobORM().TenantPipelineRuns(tenant.String, offset, limit)
+	default:
+		runs, count, err = r.App.ReplicaJobORM().PipelineRuns(nil, offset, limit)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -395,10 +639,53 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	ok, err := r.canAccessJobID(ctx, jr.PipelineSpec.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewJobRunPayload(nil, r.App, sql.ErrNoRows), nil
+	}
 
 	return NewJobRunPayload(&jr, r.App, err), nil
 }
 
+// ArchivedJobRun fetches a job run which was pruned to the run archive.
+func (r *Resolver) ArchivedJobRun(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ArchivedJobRunPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	ra := r.App.RunArchive()
+	if ra == nil {
+		return nil, errRunArchiveDisabled
+	}
+	run, err := ra.FindRun(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewArchivedJobRunPayload(nil, r.App, err), nil
+		}
+
+		return nil, err
+	}
+	ok, err := r.canAccessJobID(ctx, run.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewArchivedJobRunPayload(nil, r.App, sql.ErrNoRows), nil
+	}
+
+	return NewArchivedJobRunPayload(&run, r.App, nil), nil
+}
+
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -410,6 +697,10 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 	if err != nil {
 		return nil, fmt.Errorf("error getting unlocked keys: %v", err)
 	}
+	keys, err = filterTenantKeys(ctx, r.App, tenancy.KeyTypeEth, keys)
+	if err != nil {
+		return nil, err
+	}
 
 	states, err := ks.GetStatesForKeys(keys)
 	if err != nil {
@@ -456,6 +747,9 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	cfg := r.App.GetConfig()
 	return NewConfigV2Payload(cfg.ConfigTOML()), nil
@@ -467,6 +761,9 @@ func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	hash := common.HexToHash(string(args.Hash))
 	etx, err := r.App.TxmStorageService().FindTxByHash(hash)
@@ -488,6 +785,9 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	offset := pageOffset(args.Offset)
 	limit := pageLimit(args.Limit)
@@ -500,6 +800,28 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	return NewEthTransactionsPayload(txs, int32(count)), nil
 }
 
+func (r *Resolver) DeadLetterEthTransactions(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+}) (*DeadLetterEthTransactionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	letters, count, err := r.App.TxmStorageService().DeadLetters(offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDeadLetterEthTransactionsPayload(letters, int32(count)), nil
+}
+
 func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
@@ -507,6 +829,9 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	offset := pageOffset(args.Offset)
 	limit := pageLimit(args.Limit)
@@ -519,10 +844,55 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	return NewEthTransactionsAttemptsPayload(attempts, int32(count)), nil
 }
 
+// GatewayHandlers retrieves the handlers of the gateways of the Gateway jobs, optionally filtered by job.
+func (r *Resolver) GatewayHandlers(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (*GatewayHandlersPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	gateways := r.App.Gateways()
+	jobIDs := make([]int32, 0, len(gateways))
+	if args.JobID != nil {
+		id, err := stringutils.ToInt32(string(*args.JobID))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := gateways[id]; !ok {
+			return NewGatewayHandlersPayload(nil, errGatewayNotFound), nil
+		}
+		jobIDs = append(jobIDs, id)
+	} else {
+		for id := range gateways {
+			jobIDs = append(jobIDs, id)
+		}
+		sort.Slice(jobIDs, func(i, j int) bool { return jobIDs[i] < jobIDs[j] })
+	}
+	jobIDs, err := r.filterTenantJobIDs(ctx, jobIDs)
+	if err != nil {
+		return nil, err
+	}
+	if args.JobID != nil && len(jobIDs) == 0 {
+		return NewGatewayHandlersPayload(nil, errGatewayNotFound), nil
+	}
+
+	var handlers []GatewayHandler
+	for _, id := range jobIDs {
+		for _, status := range gateways[id].Handlers() {
+			handlers = append(handlers, GatewayHandler{JobID: id, HandlerStatus: status})
+		}
+	}
+	return NewGatewayHandlersPayload(handlers, nil), nil
+}
+
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	logLevel := r.App.GetConfig().Log().Level().String()
 
@@ -538,6 +908,10 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 	if err != nil {
 		return nil, err
 	}
+	keys, err = filterTenantKeys(ctx, r.App, tenancy.KeyTypeSolana, keys)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewSolanaKeysPayload(keys), nil
 }
@@ -546,6 +920,9 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
 	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
 
 	enabled := r.App.GetConfig().Database().LogSQL()
 
@@ -562,6 +939,47 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 	if err != nil {
 		return nil, err
 	}
+	ekbs, err = filterTenantKeys(ctx, r.App, tenancy.KeyTypeOCR2, ekbs)
+	if err != nil {
+		return nil, err
+	}
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// TxFeeReport retrieves the fees paid by the EVM transactions confirmed between from and to, aggregated per chain and
+// per key or job.
+func (r *Resolver) TxFeeReport(ctx context.Context, args struct {
+	From    graphql.Time
+	To      graphql.Time
+	GroupBy string
+	ChainID *graphql.ID
+}) (*TxFeeReportPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	a := r.App.TxFeeAccounting()
+	if a == nil {
+		return NewTxFeeReportPayload(nil, errTxFeeAccountingDisabled), nil
+	}
+
+	q := feeaccounting.ReportQuery{From: args.From.Time, To: args.To.Time, GroupBy: feeaccounting.GroupBy(strings.ToLower(args.GroupBy))}
+	if args.ChainID != nil {
+		chainID, ok := new(big.Int).SetString(string(*args.ChainID), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid chain ID: %s", *args.ChainID)
+		}
+		q.ChainID = chainID
+	}
+
+	rows, err := a.Report(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTxFeeReportPayload(rows, nil), nil
+}
@@ -0,0 +1,81 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+// ReorgResolver resolves the Reorg type.
+type ReorgResolver struct {
+	reorg httypes.Reorg
+}
+
+func NewReorg(reorg httypes.Reorg) *ReorgResolver {
+	return &ReorgResolver{reorg: reorg}
+}
+
+func NewReorgs(reorgs []httypes.Reorg) []*ReorgResolver {
+	var resolvers []*ReorgResolver
+	for _, r := range reorgs {
+		resolvers = append(resolvers, NewReorg(r))
+	}
+
+	return resolvers
+}
+
+// BlockNumber resolves the number of the block which caused the re-org.
+func (r *ReorgResolver) BlockNumber() string {
+	return stringutils.FromInt64(r.reorg.BlockNumber)
+}
+
+// Depth resolves the number of replaced blocks.
+func (r *ReorgResolver) Depth() string {
+	return stringutils.FromInt64(r.reorg.Depth)
+}
+
+// OldHead resolves the hash of the replaced head.
+func (r *ReorgResolver) OldHead() string {
+	return r.reorg.OldHead.String()
+}
+
+// NewHead resolves the hash of the new head.
+func (r *ReorgResolver) NewHead() string {
+	return r.reorg.NewHead.String()
+}
+
+// DetectedAt resolves the time the re-org was observed.
+func (r *ReorgResolver) DetectedAt() graphql.Time {
+	return graphql.Time{Time: r.reorg.Detected}
+}
+
+// -- Reorgs Query --
+
+type ReorgsPayloadResolver struct {
+	reorgs []httypes.Reorg
+	NotFoundErrorUnionType
+}
+
+func NewReorgsPayload(reorgs []httypes.Reorg, err error) *ReorgsPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "chain not found", isExpectedErrorFn: nil}
+
+	return &ReorgsPayloadResolver{reorgs: reorgs, NotFoundErrorUnionType: e}
+}
+
+func (r *ReorgsPayloadResolver) ToReorgs() (*ReorgsResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &ReorgsResolver{reorgs: r.reorgs}, true
+}
+
+// ReorgsResolver resolves the Reorgs type.
+type ReorgsResolver struct {
+	reorgs []httypes.Reorg
+}
+
+func (r *ReorgsResolver) Results() []*ReorgResolver {
+	return NewReorgs(r.reorgs)
+}
@@ -0,0 +1,107 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	commonmocks "github.com/smartcontractkit/chainlink/v2/common/mocks"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+)
+
+func TestResolver_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetReorgs {
+			reorgs(chainID: "12", limit: 5) {
+				... on Reorgs {
+					results {
+						blockNumber
+						depth
+						oldHead
+						newHead
+						detectedAt
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "reorgs"),
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"reorgs"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				ht := commonmocks.NewHeadTracker[*evmtypes.Head, common.Hash](t)
+				ht.On("RecentReorgs", 5).Return([]httypes.Reorg{{
+					BlockNumber: 100,
+					Depth:       2,
+					OldHead:     common.HexToHash("0x01"),
+					NewHead:     common.HexToHash("0x02"),
+					Detected:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				}})
+				f.Mocks.chain.On("HeadTracker").Return(ht)
+				f.Mocks.legacyEVMChains.On("Get", "12").Return(f.Mocks.chain, nil)
+				f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+			},
+			query: query,
+			result: `
+				{
+					"reorgs": {
+						"results": [{
+							"blockNumber": "100",
+							"depth": "2",
+							"oldHead": "0x0000000000000000000000000000000000000000000000000000000000000001",
+							"newHead": "0x0000000000000000000000000000000000000000000000000000000000000002",
+							"detectedAt": "2021-01-01T00:00:00Z"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.legacyEVMChains.On("Get", "12").Return(nil, evmrelay.ErrNoChains)
+				f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+			},
+			query: query,
+			result: `
+				{
+					"reorgs": {
+						"message": "chain not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -9,6 +9,7 @@ import (
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/graph-gophers/graphql-go/gqltesting"
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
 
 	bridgeORMMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	evmClientMocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
@@ -25,10 +26,12 @@ import (
 	feedsMocks "github.com/smartcontractkit/chainlink/v2/core/services/feeds/mocks"
 	jobORMMocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	archiveMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/archive/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
+	tenancyMocks "github.com/smartcontractkit/chainlink/v2/core/tenancy/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/schema"
@@ -59,6 +62,8 @@ type mocks struct {
 	balM                 *evmORMMocks.BalanceMonitor
 	txmStore             *evmtxmgrmocks.EvmTxStore
 	auditLogger          *audit.AuditLoggerService
+	tenancyORM           *tenancyMocks.ORM
+	runArchive           *archiveMocks.Archiver
 }
 
 // gqlTestFramework is a framework wrapper containing the objects needed to run
@@ -118,6 +123,8 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		balM:                 evmORMMocks.NewBalanceMonitor(t),
 		txmStore:             evmtxmgrmocks.NewEvmTxStore(t),
 		auditLogger:          &audit.AuditLoggerService{},
+		tenancyORM:           tenancyMocks.NewORM(t),
+		runArchive:           archiveMocks.NewArchiver(t),
 	}
 
 	lggr := logger.TestLogger(t)
@@ -155,6 +162,15 @@ func (f *gqlTestFramework) injectAuthenticatedUser() {
 	f.Ctx = auth.SetGQLAuthenticatedSession(f.Ctx, user, "gqltesterSession")
 }
 
+// injectTenantUser injects the session of a user of a tenant into the request context
+func (f *gqlTestFramework) injectTenantUser(tenant string) {
+	f.t.Helper()
+
+	user := clsessions.User{Email: "gqltester@chain.link", Role: clsessions.UserRoleAdmin, Tenant: null.StringFrom(tenant)}
+
+	f.Ctx = auth.SetGQLAuthenticatedSession(f.Ctx, user, "gqltesterSession")
+}
+
 // GQLTestCase represents a single GQL request test.
 type GQLTestCase struct {
 	name          string
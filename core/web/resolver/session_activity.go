@@ -0,0 +1,299 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+// SessionActivity retrieves the active sessions of a user, or of all users.
+func (r *Resolver) SessionActivity(ctx context.Context, args struct {
+	Email *string
+}) (*SessionActivityPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var email string
+	if args.Email != nil {
+		email = *args.Email
+	}
+	activity, err := r.App.AuthenticationProvider().ListSessionActivity(email)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentID string
+	if session, ok := webauth.GetGQLAuthenticatedSession(ctx); ok {
+		currentID = sessions.SessionActivityID(session.SessionID)
+	}
+
+	return NewSessionActivityPayload(activity, currentID), nil
+}
+
+// APITokenActivity retrieves the API tokens of all users, and their last use.
+func (r *Resolver) APITokenActivity(ctx context.Context) (*APITokenActivityPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	activity, err := r.App.AuthenticationProvider().ListAPITokenActivity()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAPITokenActivityPayload(activity), nil
+}
+
+// RevokeSession logs out a single session.
+func (r *Resolver) RevokeSession(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*RevokeSessionPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id := string(args.ID)
+	if err := r.App.AuthenticationProvider().RevokeSession(id); err != nil {
+		if errors.Is(err, sessions.ErrSessionNotFound) {
+			return NewRevokeSessionPayload("", err), nil
+		}
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.AuthSessionRevoked, map[string]interface{}{
+		"session":   id,
+		"revokedBy": sessionEmail(ctx),
+	})
+	return NewRevokeSessionPayload(id, nil), nil
+}
+
+type revokeUserSessionsInput struct {
+	Email          string
+	RevokeAPIToken *bool
+}
+
+// RevokeUserSessions logs out all the sessions of a user, and optionally deletes their API token.
+func (r *Resolver) RevokeUserSessions(ctx context.Context, args struct {
+	Input revokeUserSessionsInput
+}) (*RevokeUserSessionsPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	provider := r.App.AuthenticationProvider()
+	user, err := provider.FindUser(args.Input.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRevokeUserSessionsPayload("", false, err), nil
+		}
+		return nil, err
+	}
+
+	if err = provider.RevokeUserSessions(user.Email); err != nil {
+		return nil, err
+	}
+	revokeAPIToken := args.Input.RevokeAPIToken != nil && *args.Input.RevokeAPIToken
+	if revokeAPIToken {
+		if err = provider.DeleteAuthToken(&user); err != nil {
+			return nil, err
+		}
+	}
+
+	r.App.GetAuditLogger().Audit(audit.AuthUserSessionsRevoked, map[string]interface{}{
+		"email":           user.Email,
+		"apiTokenRevoked": revokeAPIToken,
+		"revokedBy":       sessionEmail(ctx),
+	})
+	return NewRevokeUserSessionsPayload(user.Email, revokeAPIToken, nil), nil
+}
+
+// sessionEmail returns the email of the authenticated user.
+func sessionEmail(ctx context.Context) string {
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return ""
+	}
+	return session.User.Email
+}
+
+// SessionActivityResolver resolves the SessionActivity type.
+type SessionActivityResolver struct {
+	activity sessions.SessionActivity
+	current  bool
+}
+
+func (r *SessionActivityResolver) ID() graphql.ID {
+	return graphql.ID(r.activity.ID)
+}
+
+func (r *SessionActivityResolver) Email() string {
+	return r.activity.Email
+}
+
+func (r *SessionActivityResolver) IPAddress() *string {
+	return r.activity.IPAddress.Ptr()
+}
+
+func (r *SessionActivityResolver) UserAgent() *string {
+	return r.activity.UserAgent.Ptr()
+}
+
+func (r *SessionActivityResolver) LastUsed() graphql.Time {
+	return graphql.Time{Time: r.activity.LastUsed}
+}
+
+func (r *SessionActivityResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.activity.CreatedAt}
+}
+
+func (r *SessionActivityResolver) Current() bool {
+	return r.current
+}
+
+// APITokenActivityResolver resolves the APITokenActivity type.
+type APITokenActivityResolver struct {
+	activity sessions.APITokenActivity
+}
+
+func (r *APITokenActivityResolver) AccessKey() string {
+	return r.activity.AccessKey
+}
+
+func (r *APITokenActivityResolver) Email() string {
+	return r.activity.Email
+}
+
+func (r *APITokenActivityResolver) IPAddress() *string {
+	return r.activity.IPAddress.Ptr()
+}
+
+func (r *APITokenActivityResolver) UserAgent() *string {
+	return r.activity.UserAgent.Ptr()
+}
+
+func (r *APITokenActivityResolver) LastUsed() *graphql.Time {
+	if !r.activity.LastUsed.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.activity.LastUsed.Time}
+}
+
+// -- SessionActivity Query --
+
+type SessionActivityPayloadResolver struct {
+	activity  []sessions.SessionActivity
+	currentID string
+}
+
+func NewSessionActivityPayload(activity []sessions.SessionActivity, currentID string) *SessionActivityPayloadResolver {
+	return &SessionActivityPayloadResolver{activity: activity, currentID: currentID}
+}
+
+func (r *SessionActivityPayloadResolver) Results() []*SessionActivityResolver {
+	var resolvers []*SessionActivityResolver
+	for _, a := range r.activity {
+		resolvers = append(resolvers, &SessionActivityResolver{activity: a, current: a.ID == r.currentID})
+	}
+	return resolvers
+}
+
+// -- APITokenActivity Query --
+
+type APITokenActivityPayloadResolver struct {
+	activity []sessions.APITokenActivity
+}
+
+func NewAPITokenActivityPayload(activity []sessions.APITokenActivity) *APITokenActivityPayloadResolver {
+	return &APITokenActivityPayloadResolver{activity: activity}
+}
+
+func (r *APITokenActivityPayloadResolver) Results() []*APITokenActivityResolver {
+	var resolvers []*APITokenActivityResolver
+	for _, a := range r.activity {
+		resolvers = append(resolvers, &APITokenActivityResolver{activity: a})
+	}
+	return resolvers
+}
+
+// -- RevokeSession Mutation --
+
+type RevokeSessionPayloadResolver struct {
+	id string
+	NotFoundErrorUnionType
+}
+
+func NewRevokeSessionPayload(id string, err error) *RevokeSessionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "session not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, sessions.ErrSessionNotFound)
+	}}
+
+	return &RevokeSessionPayloadResolver{id: id, NotFoundErrorUnionType: e}
+}
+
+func (r *RevokeSessionPayloadResolver) ToRevokeSessionSuccess() (*RevokeSessionSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+	return &RevokeSessionSuccessResolver{id: r.id}, true
+}
+
+type RevokeSessionSuccessResolver struct {
+	id string
+}
+
+func (r *RevokeSessionSuccessResolver) ID() graphql.ID {
+	return graphql.ID(r.id)
+}
+
+// -- RevokeUserSessions Mutation --
+
+type RevokeUserSessionsPayloadResolver struct {
+	email           string
+	apiTokenRevoked bool
+	NotFoundErrorUnionType
+}
+
+func NewRevokeUserSessionsPayload(email string, apiTokenRevoked bool, err error) *RevokeUserSessionsPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "user not found"}
+
+	return &RevokeUserSessionsPayloadResolver{email: email, apiTokenRevoked: apiTokenRevoked, NotFoundErrorUnionType: e}
+}
+
+func (r *RevokeUserSessionsPayloadResolver) ToRevokeUserSessionsSuccess() (*RevokeUserSessionsSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+	return &RevokeUserSessionsSuccessResolver{email: r.email, apiTokenRevoked: r.apiTokenRevoked}, true
+}
+
+type RevokeUserSessionsSuccessResolver struct {
+	email           string
+	apiTokenRevoked bool
+}
+
+func (r *RevokeUserSessionsSuccessResolver) Email() string {
+	return r.email
+}
+
+func (r *RevokeUserSessionsSuccessResolver) APITokenRevoked() bool {
+	return r.apiTokenRevoked
+}
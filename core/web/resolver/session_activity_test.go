@@ -0,0 +1,255 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestResolver_SessionActivity(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetSessionActivity($email: String) {
+			sessionActivity(email: $email) {
+				results {
+					id
+					email
+					ipAddress
+					userAgent
+					lastUsed
+					current
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "sessionActivity"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.authProvider.On("ListSessionActivity", "").Return([]sessions.SessionActivity{
+					{
+						ID:        sessions.SessionActivityID("gqltesterSession"),
+						Email:     "gqltester@chain.link",
+						IPAddress: null.StringFrom("10.0.0.1"),
+						UserAgent: null.StringFrom("curl/8.0"),
+						LastUsed:  f.Timestamp(),
+						CreatedAt: f.Timestamp(),
+					},
+					{
+						ID:        "other",
+						Email:     "other@chain.link",
+						LastUsed:  f.Timestamp(),
+						CreatedAt: f.Timestamp(),
+					},
+				}, nil)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query: query,
+			result: `
+				{
+					"sessionActivity": {
+						"results": [{
+							"id": "` + sessions.SessionActivityID("gqltesterSession") + `",
+							"email": "gqltester@chain.link",
+							"ipAddress": "10.0.0.1",
+							"userAgent": "curl/8.0",
+							"lastUsed": "2021-01-01T00:00:00Z",
+							"current": true
+						}, {
+							"id": "other",
+							"email": "other@chain.link",
+							"ipAddress": null,
+							"userAgent": null,
+							"lastUsed": "2021-01-01T00:00:00Z",
+							"current": false
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_APITokenActivity(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetAPITokenActivity {
+			apiTokenActivity {
+				results {
+					accessKey
+					email
+					ipAddress
+					lastUsed
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "apiTokenActivity"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.authProvider.On("ListAPITokenActivity").Return([]sessions.APITokenActivity{
+					{AccessKey: "used", Email: "a@chain.link", IPAddress: null.StringFrom("10.0.0.1"), LastUsed: null.TimeFrom(f.Timestamp())},
+					{AccessKey: "unused", Email: "b@chain.link"},
+				}, nil)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query: query,
+			result: `
+				{
+					"apiTokenActivity": {
+						"results": [{
+							"accessKey": "used",
+							"email": "a@chain.link",
+							"ipAddress": "10.0.0.1",
+							"lastUsed": "2021-01-01T00:00:00Z"
+						}, {
+							"accessKey": "unused",
+							"email": "b@chain.link",
+							"ipAddress": null,
+							"lastUsed": null
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RevokeSession($id: ID!) {
+			revokeSession(id: $id) {
+				... on RevokeSessionSuccess {
+					id
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"id": "abcd"}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "revokeSession"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.authProvider.On("RevokeSession", "abcd").Return(nil)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeSession": {
+						"id": "abcd"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.authProvider.On("RevokeSession", "abcd").Return(sessions.ErrSessionNotFound)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeSession": {
+						"message": "session not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RevokeUserSessions(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RevokeUserSessions($input: RevokeUserSessionsInput!) {
+			revokeUserSessions(input: $input) {
+				... on RevokeUserSessionsSuccess {
+					email
+					apiTokenRevoked
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"email":          "leaked@chain.link",
+			"revokeAPIToken": true,
+		},
+	}
+	user := sessions.User{Email: "leaked@chain.link", Role: sessions.UserRoleEdit}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "revokeUserSessions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.authProvider.On("FindUser", user.Email).Return(user, nil)
+				f.Mocks.authProvider.On("RevokeUserSessions", user.Email).Return(nil)
+				f.Mocks.authProvider.On("DeleteAuthToken", mock.MatchedBy(func(u *sessions.User) bool {
+					return u.Email == user.Email
+				})).Return(nil)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeUserSessions": {
+						"email": "leaked@chain.link",
+						"apiTokenRevoked": true
+					}
+				}`,
+		},
+		{
+			name:          "user not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.authProvider.On("FindUser", user.Email).Return(sessions.User{}, sql.ErrNoRows)
+				f.App.On("AuthenticationProvider").Return(f.Mocks.authProvider)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeUserSessions": {
+						"message": "user not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -1,8 +1,11 @@
 package resolver
 
 import (
+	"time"
+
 	"github.com/graph-gophers/graphql-go"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/gqlscalar"
@@ -117,6 +120,14 @@ func (r *SpecResolver) ToGatewaySpec() (*GatewaySpecResolver, bool) {
 	return &GatewaySpecResolver{spec: *r.j.GatewaySpec}, true
 }
 
+func (r *SpecResolver) ToLOOPSpec() (*LOOPSpecResolver, bool) {
+	if r.j.Type != job.LOOP {
+		return nil, false
+	}
+
+	return &LOOPSpecResolver{spec: *r.j.LOOPSpec}, true
+}
+
 type CronSpecResolver struct {
 	spec job.CronSpec
 }
@@ -125,6 +136,36 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Timezone resolves the spec's IANA timezone.
+func (r *CronSpecResolver) Timezone() string {
+	return r.spec.Timezone
+}
+
+// CatchUpPolicy resolves the spec's policy for missed occurrences.
+func (r *CronSpecResolver) CatchUpPolicy() string {
+	if r.spec.CatchUpPolicy == "" {
+		return string(job.CronCatchUpSkip)
+	}
+	return string(r.spec.CatchUpPolicy)
+}
+
+// MaxCatchUpRuns resolves the spec's maximum number of missed occurrences to run.
+func (r *CronSpecResolver) MaxCatchUpRuns() int32 {
+	return int32(r.spec.MaxCatchUpRuns)
+}
+
+// NextScheduledRun resolves the next time the job is scheduled to run.
+func (r *CronSpecResolver) NextScheduledRun() (*graphql.Time, error) {
+	next, err := cron.NextScheduledRun(r.spec, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if next.IsZero() {
+		return nil, nil
+	}
+	return &graphql.Time{Time: next}, nil
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -519,6 +560,26 @@ func (r *OCR2SpecResolver) P2PV2Bootstrappers() *[]string {
 	return &peers
 }
 
+// P2PV2BootstrappersRegistry resolves the OCR2 spec's on-chain registry of bootstrappers
+func (r *OCR2SpecResolver) P2PV2BootstrappersRegistry() *string {
+	if !r.spec.P2PV2BootstrappersRegistry.Valid {
+		return nil
+	}
+
+	return &r.spec.P2PV2BootstrappersRegistry.String
+}
+
+// P2PV2BootstrappersRefreshInterval resolves the OCR2 spec's refresh interval of the bootstrappers
+func (r *OCR2SpecResolver) P2PV2BootstrappersRefreshInterval() *string {
+	if r.spec.P2PV2BootstrappersRefreshInterval == nil {
+		return nil
+	}
+
+	interval := r.spec.P2PV2BootstrappersRefreshInterval.Duration().String()
+
+	return &interval
+}
+
 // Relay resolves the spec's relay
 func (r *OCR2SpecResolver) Relay() string {
 	return r.spec.Relay
@@ -970,3 +1031,23 @@ func (r *GatewaySpecResolver) GatewayConfig() gqlscalar.Map {
 func (r *GatewaySpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
 }
+
+type LOOPSpecResolver struct {
+	spec job.LOOPSpec
+}
+
+func (r *LOOPSpecResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt32(r.spec.ID))
+}
+
+func (r *LOOPSpecResolver) PluginName() string {
+	return r.spec.PluginName
+}
+
+func (r *LOOPSpecResolver) PluginConfig() gqlscalar.Map {
+	return gqlscalar.Map(r.spec.PluginConfig)
+}
+
+func (r *LOOPSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
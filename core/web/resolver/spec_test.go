@@ -1,6 +1,7 @@
 package resolver
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -71,11 +72,66 @@ func TestResolver_CronSpec(t *testing.T) {
 				}
 			`,
 		},
+		{
+			name:          "cron spec with timezone and catch-up policy",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					Type: job.Cron,
+					CronSpec: &job.CronSpec{
+						CronSchedule:   "0 0 0 1 1 *",
+						Timezone:       "Europe/Berlin",
+						CatchUpPolicy:  job.CronCatchUpRunMissed,
+						MaxCatchUpRuns: 3,
+						CreatedAt:      f.Timestamp(),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on CronSpec {
+									schedule
+									timezone
+									catchUpPolicy
+									maxCatchUpRuns
+									nextScheduledRun
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: fmt.Sprintf(`
+				{
+					"job": {
+						"spec": {
+							"__typename": "CronSpec",
+							"schedule": "0 0 0 1 1 *",
+							"timezone": "Europe/Berlin",
+							"catchUpPolicy": "runMissed",
+							"maxCatchUpRuns": 3,
+							"nextScheduledRun": "%s"
+						}
+					}
+				}
+			`, nextNewYear(t, "Europe/Berlin").UTC().Format(time.RFC3339)),
+		},
 	}
 
 	RunGQLTests(t, testCases)
 }
 
+func nextNewYear(t *testing.T, timezone string) time.Time {
+	loc, err := time.LoadLocation(timezone)
+	require.NoError(t, err)
+	return time.Date(time.Now().In(loc).Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+}
+
 func TestResolver_DirectRequestSpec(t *testing.T) {
 	var (
 		id               = int32(1)
@@ -485,6 +541,8 @@ func TestResolver_OCR2Spec(t *testing.T) {
 						OCRKeyBundleID:                    null.StringFrom(keyBundleID.String()),
 						MonitoringEndpoint:                null.StringFrom("https://monitor.endpoint"),
 						P2PV2Bootstrappers:                pq.StringArray{"12D3KooWL3XJ9EMCyZvmmGXL2LMiVBtrVa2BuESsJiXkSj7333Jw@localhost:5001"},
+						P2PV2BootstrappersRegistry:        null.StringFrom("0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+						P2PV2BootstrappersRefreshInterval: models.NewInterval(5 * time.Minute),
 						Relay:                             relay.EVM,
 						RelayConfig:                       relayConfig,
 						TransmitterID:                     null.StringFrom(transmitterAddress.String()),
@@ -508,6 +566,8 @@ func TestResolver_OCR2Spec(t *testing.T) {
 									ocrKeyBundleID
 									monitoringEndpoint
 									p2pv2Bootstrappers
+									p2pv2BootstrappersRegistry
+									p2pv2BootstrappersRefreshInterval
 									relay
 									relayConfig
 									transmitterID
@@ -532,6 +592,8 @@ func TestResolver_OCR2Spec(t *testing.T) {
 							"ocrKeyBundleID": "f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5",
 							"monitoringEndpoint": "https://monitor.endpoint",
 							"p2pv2Bootstrappers": ["12D3KooWL3XJ9EMCyZvmmGXL2LMiVBtrVa2BuESsJiXkSj7333Jw@localhost:5001"],
+							"p2pv2BootstrappersRegistry": "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+							"p2pv2BootstrappersRefreshInterval": "5m0s",
 							"relay": "evm",
 							"relayConfig": {
 								"chainID": 1337
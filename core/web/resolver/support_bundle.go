@@ -0,0 +1,35 @@
+package resolver
+
+import "encoding/base64"
+
+// -- CreateSupportBundle Mutation --
+
+type CreateSupportBundlePayloadResolver struct {
+	fileName string
+	archive  []byte
+}
+
+func NewCreateSupportBundlePayload(fileName string, archive []byte) *CreateSupportBundlePayloadResolver {
+	return &CreateSupportBundlePayloadResolver{fileName: fileName, archive: archive}
+}
+
+func (r *CreateSupportBundlePayloadResolver) ToCreateSupportBundleSuccess() (*CreateSupportBundleSuccessResolver, bool) {
+	return NewCreateSupportBundleSuccess(r.fileName, r.archive), true
+}
+
+type CreateSupportBundleSuccessResolver struct {
+	fileName string
+	archive  []byte
+}
+
+func NewCreateSupportBundleSuccess(fileName string, archive []byte) *CreateSupportBundleSuccessResolver {
+	return &CreateSupportBundleSuccessResolver{fileName: fileName, archive: archive}
+}
+
+func (r *CreateSupportBundleSuccessResolver) FileName() string {
+	return r.fileName
+}
+
+func (r *CreateSupportBundleSuccessResolver) Archive() string {
+	return base64.StdEncoding.EncodeToString(r.archive)
+}
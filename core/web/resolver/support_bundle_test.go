@@ -0,0 +1,43 @@
+package resolver
+
+import (
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+func TestResolver_CreateSupportBundle(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CreateSupportBundle {
+			createSupportBundle {
+				... on CreateSupportBundleSuccess {
+					fileName
+					archive
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation}, "createSupportBundle"),
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:  mutation,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"createSupportBundle"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
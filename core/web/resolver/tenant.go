@@ -0,0 +1,459 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/tenancy"
+)
+
+type TenantKeyType string
+
+const (
+	TenantKeyTypeCSA    TenantKeyType = "CSA"
+	TenantKeyTypeETH    TenantKeyType = "ETH"
+	TenantKeyTypeOCR    TenantKeyType = "OCR"
+	TenantKeyTypeOCR2   TenantKeyType = "OCR2"
+	TenantKeyTypeP2P    TenantKeyType = "P2P"
+	TenantKeyTypeSolana TenantKeyType = "SOLANA"
+	TenantKeyTypeVRF    TenantKeyType = "VRF"
+)
+
+func FromTenantKeyType(kt TenantKeyType) (tenancy.KeyType, error) {
+	switch kt {
+	case TenantKeyTypeCSA:
+		return tenancy.KeyTypeCSA, nil
+	case TenantKeyTypeETH:
+		return tenancy.KeyTypeEth, nil
+	case TenantKeyTypeOCR:
+		return tenancy.KeyTypeOCR, nil
+	case TenantKeyTypeOCR2:
+		return tenancy.KeyTypeOCR2, nil
+	case TenantKeyTypeP2P:
+		return tenancy.KeyTypeP2P, nil
+	case TenantKeyTypeSolana:
+		return tenancy.KeyTypeSolana, nil
+	case TenantKeyTypeVRF:
+		return tenancy.KeyTypeVRF, nil
+	default:
+		return "", errors.Errorf("unknown key type %q", kt)
+	}
+}
+
+// filterTenantKeys returns the keys which the authenticated user has access to: every key for node-wide users, and
+// only the keys assigned to their tenant for the users of a tenant.
+func filterTenantKeys[K interface{ ID() string }](ctx context.Context, app chainlink.Application, keyType tenancy.KeyType, keys []K) ([]K, error) {
+	tenant := sessionTenant(ctx)
+	if !tenant.Valid {
+		return keys, nil
+	}
+
+	ids, err := app.TenancyORM().TenantKeyIDs(keyType, tenant.String)
+	if err != nil {
+		return nil, err
+	}
+	assigned := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		assigned[id] = struct{}{}
+	}
+
+	var filtered []K
+	for _, k := range keys {
+		if _, ok := assigned[k.ID()]; ok {
+			filtered = append(filtered, k)
+		}
+	}
+	return filtered, nil
+}
+
+// canAccessKey returns true if the authenticated user has access to a key.
+func (r *Resolver) canAccessKey(ctx context.Context, keyType tenancy.KeyType, keyID string) (bool, error) {
+	tenant := sessionTenant(ctx)
+	if !tenant.Valid {
+		return true, nil
+	}
+
+	keyTenant, err := r.App.TenancyORM().KeyTenant(keyType, keyID)
+	if err != nil {
+		return false, err
+	}
+	return tenancy.CanAccess(tenant, keyTenant), nil
+}
+
+// assignSessionTenantKey assigns a newly created key to the tenant of the authenticated user, if any.
+func (r *Resolver) assignSessionTenantKey(ctx context.Context, keyType tenancy.KeyType, keyID string) error {
+	tenant := sessionTenant(ctx)
+	if !tenant.Valid {
+		return nil
+	}
+	return r.App.TenancyORM().AssignKey(keyType, keyID, tenant.String)
+}
+
+// canAccessJob returns true if the authenticated user has access to a job of jobTenant.
+func canAccessJob(ctx context.Context, jobTenant null.String) bool {
+	return tenancy.CanAccess(sessionTenant(ctx), jobTenant)
+}
+
+// canAccessJobID returns true if the authenticated user has access to the job with the given ID.
+func (r *Resolver) canAccessJobID(ctx context.Context, id int32) (bool, error) {
+	if !sessionTenant(ctx).Valid {
+		return true, nil
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil && !errors.Is(err, chains.ErrNoSuchChainID) {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return canAccessJob(ctx, j.Tenant), nil
+}
+
+// filterTenantJobIDs returns the IDs of the jobs which the authenticated user has access to.
+func (r *Resolver) filterTenantJobIDs(ctx context.Context, ids []int32) ([]int32, error) {
+	if !sessionTenant(ctx).Valid {
+		return ids, nil
+	}
+
+	var filtered []int32
+	for _, id := range ids {
+		ok, err := r.canAccessJobID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered, nil
+}
+
+// findKeyID returns the canonical ID of a key in the keystore.
+func (r *Resolver) findKeyID(keyType tenancy.KeyType, id string) (string, error) {
+	ks := r.App.GetKeyStore()
+	switch keyType {
+	case tenancy.KeyTypeCSA:
+		k, err := ks.CSA().Get(id)
+		return k.ID(), err
+	case tenancy.KeyTypeEth:
+		k, err := ks.Eth().Get(id)
+		return k.ID(), err
+	case tenancy.KeyTypeOCR:
+		k, err := ks.OCR().Get(id)
+		return k.ID(), err
+	case tenancy.KeyTypeOCR2:
+		k, err := ks.OCR2().Get(id)
+		if err != nil {
+			return "", err
+		}
+		return k.ID(), nil
+	case tenancy.KeyTypeP2P:
+		peerID, err := p2pkey.MakePeerID(id)
+		if err != nil {
+			return "", err
+		}
+		k, err := ks.P2P().Get(peerID)
+		return k.ID(), err
+	case tenancy.KeyTypeSolana:
+		k, err := ks.Solana().Get(id)
+		return k.ID(), err
+	case tenancy.KeyTypeVRF:
+		k, err := ks.VRF().Get(id)
+		return k.ID(), err
+	default:
+		return "", errors.Errorf("unknown key type %q", keyType)
+	}
+}
+
+// Tenants retrieves the tenants of the node.
+func (r *Resolver) Tenants(ctx context.Context) (*TenantsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	tenants, err := r.App.TenancyORM().Tenants()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTenantsPayload(tenants), nil
+}
+
+type createTenantInput struct {
+	Name string
+}
+
+// CreateTenant creates a tenant.
+func (r *Resolver) CreateTenant(ctx context.Context, args struct {
+	Input createTenantInput
+}) (*CreateTenantPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tenancy.ValidateName(args.Input.Name); err != nil {
+		return NewCreateTenantPayload(nil, map[string]string{"name": err.Error()}), nil
+	}
+
+	tenant, err := r.App.TenancyORM().CreateTenant(args.Input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCreateTenantPayload(&tenant, nil), nil
+}
+
+// DeleteTenant deletes a tenant, which must not have any users, jobs or bridges.
+func (r *Resolver) DeleteTenant(ctx context.Context, args struct {
+	Name string
+}) (*DeleteTenantPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	orm := r.App.TenancyORM()
+	tenant, err := orm.FindTenant(args.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeleteTenantPayload(nil, err), nil
+		}
+		return nil, err
+	}
+
+	if err = orm.DeleteTenant(args.Name); err != nil {
+		return nil, err
+	}
+
+	return NewDeleteTenantPayload(&tenant, nil), nil
+}
+
+type assignKeyToTenantInput struct {
+	KeyType TenantKeyType
+	KeyID   graphql.ID
+	Tenant  *string
+}
+
+// AssignKeyToTenant assigns a key to a tenant, or makes it node-wide when no tenant is given.
+func (r *Resolver) AssignKeyToTenant(ctx context.Context, args struct {
+	Input assignKeyToTenantInput
+}) (*AssignKeyToTenantPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := authenticateNodeUser(ctx); err != nil {
+		return nil, err
+	}
+
+	keyType, err := FromTenantKeyType(args.Input.KeyType)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := r.findKeyID(keyType, string(args.Input.KeyID))
+	if err != nil {
+		if errors.Is(err, keystore.ErrLocked) {
+			return nil, err
+		}
+		return NewAssignKeyToTenantPayload(args.Input.KeyType, "", null.String{}, fmt.Errorf("%s key %s not found: %w", keyType, args.Input.KeyID, sql.ErrNoRows)), nil
+	}
+
+	orm := r.App.TenancyORM()
+	tenant := null.StringFromPtr(args.Input.Tenant)
+	if tenant.Valid {
+		if _, err = orm.FindTenant(tenant.String); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return NewAssignKeyToTenantPayload(args.Input.KeyType, "", null.String{}, fmt.Errorf("tenant %s not found: %w", tenant.String, err)), nil
+			}
+			return nil, err
+		}
+		err = orm.AssignKey(keyType, keyID, tenant.String)
+	} else {
+		err = orm.UnassignKey(keyType, keyID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAssignKeyToTenantPayload(args.Input.KeyType, keyID, tenant, nil), nil
+}
+
+type TenantResolver struct {
+	tenant tenancy.Tenant
+}
+
+func NewTenant(tenant tenancy.Tenant) *TenantResolver {
+	return &TenantResolver{tenant: tenant}
+}
+
+func NewTenants(tenants []tenancy.Tenant) []*TenantResolver {
+	var resolvers []*TenantResolver
+	for _, t := range tenants {
+		resolvers = append(resolvers, NewTenant(t))
+	}
+
+	return resolvers
+}
+
+func (r *TenantResolver) Name() string {
+	return r.tenant.Name
+}
+
+func (r *TenantResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.tenant.CreatedAt}
+}
+
+// -- Tenants Query --
+
+type TenantsPayloadResolver struct {
+	tenants []tenancy.Tenant
+}
+
+func NewTenantsPayload(tenants []tenancy.Tenant) *TenantsPayloadResolver {
+	return &TenantsPayloadResolver{tenants: tenants}
+}
+
+func (r *TenantsPayloadResolver) Results() []*TenantResolver {
+	return NewTenants(r.tenants)
+}
+
+// -- CreateTenant Mutation --
+
+type CreateTenantPayloadResolver struct {
+	tenant    *tenancy.Tenant
+	inputErrs map[string]string
+}
+
+func NewCreateTenantPayload(tenant *tenancy.Tenant, inputErrs map[string]string) *CreateTenantPayloadResolver {
+	return &CreateTenantPayloadResolver{tenant: tenant, inputErrs: inputErrs}
+}
+
+func (r *CreateTenantPayloadResolver) ToCreateTenantSuccess() (*CreateTenantSuccessResolver, bool) {
+	if r.tenant != nil {
+		return NewCreateTenantSuccess(*r.tenant), true
+	}
+
+	return nil, false
+}
+
+func (r *CreateTenantPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type CreateTenantSuccessResolver struct {
+	tenant tenancy.Tenant
+}
+
+func NewCreateTenantSuccess(tenant tenancy.Tenant) *CreateTenantSuccessResolver {
+	return &CreateTenantSuccessResolver{tenant: tenant}
+}
+
+func (r *CreateTenantSuccessResolver) Tenant() *TenantResolver {
+	return NewTenant(r.tenant)
+}
+
+// -- DeleteTenant Mutation --
+
+type DeleteTenantPayloadResolver struct {
+	tenant *tenancy.Tenant
+	NotFoundErrorUnionType
+}
+
+func NewDeleteTenantPayload(tenant *tenancy.Tenant, err error) *DeleteTenantPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "tenant not found"}
+
+	return &DeleteTenantPayloadResolver{tenant: tenant, NotFoundErrorUnionType: e}
+}
+
+func (r *DeleteTenantPayloadResolver) ToDeleteTenantSuccess() (*DeleteTenantSuccessResolver, bool) {
+	if r.tenant != nil {
+		return NewDeleteTenantSuccess(*r.tenant), true
+	}
+
+	return nil, false
+}
+
+type DeleteTenantSuccessResolver struct {
+	tenant tenancy.Tenant
+}
+
+func NewDeleteTenantSuccess(tenant tenancy.Tenant) *DeleteTenantSuccessResolver {
+	return &DeleteTenantSuccessResolver{tenant: tenant}
+}
+
+func (r *DeleteTenantSuccessResolver) Tenant() *TenantResolver {
+	return NewTenant(r.tenant)
+}
+
+// -- AssignKeyToTenant Mutation --
+
+type AssignKeyToTenantPayloadResolver struct {
+	keyType TenantKeyType
+	keyID   string
+	tenant  null.String
+	NotFoundErrorUnionType
+}
+
+func NewAssignKeyToTenantPayload(keyType TenantKeyType, keyID string, tenant null.String, err error) *AssignKeyToTenantPayloadResolver {
+	var message string
+	if err != nil {
+		message = err.Error()
+	}
+	e := NotFoundErrorUnionType{err: err, message: message}
+
+	return &AssignKeyToTenantPayloadResolver{keyType: keyType, keyID: keyID, tenant: tenant, NotFoundErrorUnionType: e}
+}
+
+func (r *AssignKeyToTenantPayloadResolver) ToAssignKeyToTenantSuccess() (*AssignKeyToTenantSuccessResolver, bool) {
+	if r.err == nil {
+		return &AssignKeyToTenantSuccessResolver{keyType: r.keyType, keyID: r.keyID, tenant: r.tenant}, true
+	}
+
+	return nil, false
+}
+
+type AssignKeyToTenantSuccessResolver struct {
+	keyType TenantKeyType
+	keyID   string
+	tenant  null.String
+}
+
+func (r *AssignKeyToTenantSuccessResolver) KeyType() TenantKeyType {
+	return r.keyType
+}
+
+func (r *AssignKeyToTenantSuccessResolver) KeyID() graphql.ID {
+	return graphql.ID(r.keyID)
+}
+
+func (r *AssignKeyToTenantSuccessResolver) Tenant() *string {
+	return r.tenant.Ptr()
+}
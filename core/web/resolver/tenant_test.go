@@ -0,0 +1,243 @@
+package resolver
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/tenancy"
+)
+
+func Test_Tenants(t *testing.T) {
+	query := `
+		query GetTenants {
+			tenants {
+				results {
+					name
+					createdAt
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "tenants"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.tenancyORM.On("Tenants").Return([]tenancy.Tenant{{Name: "team-a", CreatedAt: f.Timestamp()}}, nil)
+				f.App.On("TenancyORM").Return(f.Mocks.tenancyORM)
+			},
+			query: query,
+			result: `
+				{
+					"tenants": {
+						"results": [{
+							"name": "team-a",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"tenants"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_CreateTenant(t *testing.T) {
+	mutation := `
+		mutation CreateTenant($input: CreateTenantInput!) {
+			createTenant(input: $input) {
+				... on CreateTenantSuccess {
+					tenant {
+						name
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: map[string]interface{}{"input": map[string]interface{}{"name": "team-a"}}}, "createTenant"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.tenancyORM.On("CreateTenant", "team-a").Return(tenancy.Tenant{Name: "team-a", CreatedAt: f.Timestamp()}, nil)
+				f.App.On("TenancyORM").Return(f.Mocks.tenancyORM)
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"input": map[string]interface{}{"name": "team-a"}},
+			result:    `{"createTenant": {"tenant": {"name": "team-a"}}}`,
+		},
+		{
+			name:          "invalid name",
+			authenticated: true,
+			query:         mutation,
+			variables:     map[string]interface{}{"input": map[string]interface{}{"name": "Team A"}},
+			result: fmt.Sprintf(`{"createTenant": {"errors": [{"path": "name", "message": %q}]}}`,
+				tenancy.ValidateName("Team A").Error()),
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_AssignKeyToTenant(t *testing.T) {
+	mutation := `
+		mutation AssignKeyToTenant($input: AssignKeyToTenantInput!) {
+			assignKeyToTenant(input: $input) {
+				... on AssignKeyToTenantSuccess {
+					keyType
+					keyID
+					tenant
+				}
+				... on NotFoundError {
+					code
+				}
+			}
+		}`
+
+	key, err := csakey.NewV2()
+	require.NoError(t, err)
+	variables := map[string]interface{}{"input": map[string]interface{}{"keyType": "CSA", "keyID": key.ID(), "tenant": "team-a"}}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "assignKeyToTenant"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.csa.On("Get", key.ID()).Return(key, nil)
+				f.Mocks.keystore.On("CSA").Return(f.Mocks.csa)
+				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
+				f.Mocks.tenancyORM.On("FindTenant", "team-a").Return(tenancy.Tenant{Name: "team-a"}, nil)
+				f.Mocks.tenancyORM.On("AssignKey", tenancy.KeyTypeCSA, key.ID(), "team-a").Return(nil)
+				f.App.On("TenancyORM").Return(f.Mocks.tenancyORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    fmt.Sprintf(`{"assignKeyToTenant": {"keyType": "CSA", "keyID": %q, "tenant": "team-a"}}`, key.ID()),
+		},
+		{
+			name:          "tenant not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.csa.On("Get", key.ID()).Return(key, nil)
+				f.Mocks.keystore.On("CSA").Return(f.Mocks.csa)
+				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
+				f.Mocks.tenancyORM.On("FindTenant", "team-a").Return(tenancy.Tenant{}, sql.ErrNoRows)
+				f.App.On("TenancyORM").Return(f.Mocks.tenancyORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `{"assignKeyToTenant": {"code": "NOT_FOUND"}}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_TenantIsolation(t *testing.T) {
+	key, err := csakey.NewV2()
+	require.NoError(t, err)
+	otherKey, err := csakey.NewV2()
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "jobs of tenant",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+				f.App.On("ReplicaJobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindTenantJobs", "team-a", 0, 50).Return([]job.Job{
+					{ID: 1, Name: null.StringFrom("job1"), Tenant: null.StringFrom("team-a")},
+				}, 1, nil)
+			},
+			query:  `{ jobs { results { id tenant } metadata { total } } }`,
+			result: `{"jobs": {"results": [{"id": "1", "tenant": "team-a"}], "metadata": {"total": 1}}}`,
+		},
+		{
+			name:          "job of another tenant",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", int32(1)).Return(job.Job{ID: 1, Tenant: null.StringFrom("team-b")}, nil)
+			},
+			query:  `{ job(id: "1") { ... on NotFoundError { code } } }`,
+			result: `{"job": {"code": "NOT_FOUND"}}`,
+		},
+		{
+			name:          "keys of tenant",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+				f.Mocks.csa.On("GetAll").Return([]csakey.KeyV2{key, otherKey}, nil)
+				f.Mocks.keystore.On("CSA").Return(f.Mocks.csa)
+				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
+				f.Mocks.tenancyORM.On("TenantKeyIDs", tenancy.KeyTypeCSA, "team-a").Return([]string{key.ID()}, nil)
+				f.App.On("TenancyORM").Return(f.Mocks.tenancyORM)
+			},
+			query:  `{ csaKeys { results { id } } }`,
+			result: fmt.Sprintf(`{"csaKeys": {"results": [{"id": %q}]}}`, key.ID()),
+		},
+		{
+			name:          "delete node-wide key",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+				f.Mocks.tenancyORM.On("KeyTenant", tenancy.KeyTypeCSA, otherKey.ID()).Return(null.String{}, nil)
+				f.App.On("TenancyORM").Return(f.Mocks.tenancyORM)
+			},
+			query:  fmt.Sprintf(`mutation { deleteCSAKey(id: %q) { ... on NotFoundError { code } } }`, otherKey.ID()),
+			result: `{"deleteCSAKey": {"code": "NOT_FOUND"}}`,
+		},
+		{
+			name:          "feeds managers",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:  `{ feedsManagers { results { id } } }`,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"feedsManagers"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -0,0 +1,105 @@
+package resolver
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting"
+)
+
+var errTxFeeAccountingDisabled = errors.New("transaction fee accounting is disabled")
+
+// TxFeeReportRowResolver resolves the TxFeeReportRow type.
+type TxFeeReportRowResolver struct {
+	row feeaccounting.ReportRow
+}
+
+func NewTxFeeReportRow(row feeaccounting.ReportRow) *TxFeeReportRowResolver {
+	return &TxFeeReportRowResolver{row: row}
+}
+
+func NewTxFeeReportRows(rows []feeaccounting.ReportRow) []*TxFeeReportRowResolver {
+	var resolvers []*TxFeeReportRowResolver
+	for _, row := range rows {
+		resolvers = append(resolvers, NewTxFeeReportRow(row))
+	}
+
+	return resolvers
+}
+
+// ChainID resolves the EVM chain ID.
+func (r *TxFeeReportRowResolver) ChainID() string {
+	return r.row.ChainID.String()
+}
+
+// Address resolves the key which paid the fees, if grouped by key.
+func (r *TxFeeReportRowResolver) Address() *string {
+	if r.row.Address == nil {
+		return nil
+	}
+	a := r.row.Address.Hex()
+	return &a
+}
+
+// JobID resolves the job which sent the transactions, if grouped by job.
+func (r *TxFeeReportRowResolver) JobID() *graphql.ID {
+	if r.row.JobID == nil {
+		return nil
+	}
+	id := graphql.ID(strconv.FormatInt(int64(*r.row.JobID), 10))
+	return &id
+}
+
+// JobName resolves the name of the job, unless it was deleted.
+func (r *TxFeeReportRowResolver) JobName() *string {
+	return r.row.JobName
+}
+
+// TxCount resolves the number of transactions.
+func (r *TxFeeReportRowResolver) TxCount() int32 {
+	return int32(r.row.TxCount)
+}
+
+// GasUsed resolves the total gas used by the transactions.
+func (r *TxFeeReportRowResolver) GasUsed() string {
+	return strconv.FormatInt(r.row.GasUsed, 10)
+}
+
+// TotalFee resolves the total fee paid by the transactions, in wei.
+func (r *TxFeeReportRowResolver) TotalFee() string {
+	return r.row.Fee.ToInt().String()
+}
+
+// -- TxFeeReport Query --
+
+type TxFeeReportPayloadResolver struct {
+	rows []feeaccounting.ReportRow
+	NotFoundErrorUnionType
+}
+
+func NewTxFeeReportPayload(rows []feeaccounting.ReportRow, err error) *TxFeeReportPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "transaction fee accounting is disabled", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, errTxFeeAccountingDisabled)
+	}}
+
+	return &TxFeeReportPayloadResolver{rows: rows, NotFoundErrorUnionType: e}
+}
+
+func (r *TxFeeReportPayloadResolver) ToTxFeeReport() (*TxFeeReportResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &TxFeeReportResolver{rows: r.rows}, true
+}
+
+// TxFeeReportResolver resolves the TxFeeReport type.
+type TxFeeReportResolver struct {
+	rows []feeaccounting.ReportRow
+}
+
+func (r *TxFeeReportResolver) Results() []*TxFeeReportRowResolver {
+	return NewTxFeeReportRows(r.rows)
+}
@@ -0,0 +1,179 @@
+package resolver
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting"
+)
+
+type fakeTxFeeAccounting struct {
+	services.Service
+	query feeaccounting.ReportQuery
+	rows  []feeaccounting.ReportRow
+}
+
+func (f *fakeTxFeeAccounting) Report(_ context.Context, q feeaccounting.ReportQuery) ([]feeaccounting.ReportRow, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	if q.From != f.query.From || q.To != f.query.To || q.GroupBy != f.query.GroupBy || q.ChainID.Cmp(f.query.ChainID) != 0 {
+		return nil, nil
+	}
+	return f.rows, nil
+}
+
+func TestResolver_TxFeeReport(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetTxFeeReport($from: Time!, $to: Time!, $groupBy: TxFeeGroupBy!, $chainID: ID) {
+			txFeeReport(from: $from, to: $to, groupBy: $groupBy, chainID: $chainID) {
+				... on TxFeeReport {
+					results {
+						chainID
+						address
+						jobID
+						jobName
+						txCount
+						gasUsed
+						totalFee
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	variables := map[string]interface{}{
+		"from":    "2023-01-01T00:00:00Z",
+		"to":      "2023-02-01T00:00:00Z",
+		"groupBy": "JOB",
+		"chainID": "1",
+	}
+	jobID := int32(42)
+	jobName := "ETH/USD"
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "txFeeReport"),
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"txFeeReport"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("TxFeeAccounting").Return(&fakeTxFeeAccounting{
+					query: feeaccounting.ReportQuery{From: from, To: to, GroupBy: feeaccounting.GroupByJob, ChainID: big.NewInt(1)},
+					rows: []feeaccounting.ReportRow{
+						{ChainID: *ubig.NewI(1), JobID: &jobID, JobName: &jobName, TxCount: 3, GasUsed: 63_000, Fee: *assets.NewWeiI(630_000)},
+						{ChainID: *ubig.NewI(1), TxCount: 1, GasUsed: 21_000, Fee: *assets.NewWeiI(210_000)},
+					},
+				})
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"txFeeReport": {
+						"results": [{
+							"chainID": "1",
+							"address": null,
+							"jobID": "42",
+							"jobName": "ETH/USD",
+							"txCount": 3,
+							"gasUsed": "63000",
+							"totalFee": "630000"
+						}, {
+							"chainID": "1",
+							"address": null,
+							"jobID": null,
+							"jobName": null,
+							"txCount": 1,
+							"gasUsed": "21000",
+							"totalFee": "210000"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "grouped by key",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				address := common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+				f.App.On("TxFeeAccounting").Return(&fakeTxFeeAccounting{
+					query: feeaccounting.ReportQuery{From: from, To: to, GroupBy: feeaccounting.GroupByKey, ChainID: big.NewInt(1)},
+					rows: []feeaccounting.ReportRow{
+						{ChainID: *ubig.NewI(1), Address: &address, TxCount: 1, GasUsed: 21_000, Fee: *assets.NewWeiI(210_000)},
+					},
+				})
+			},
+			query: query,
+			variables: map[string]interface{}{
+				"from":    "2023-01-01T00:00:00Z",
+				"to":      "2023-02-01T00:00:00Z",
+				"groupBy": "KEY",
+				"chainID": "1",
+			},
+			result: `
+				{
+					"txFeeReport": {
+						"results": [{
+							"chainID": "1",
+							"address": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"jobID": null,
+							"jobName": null,
+							"txCount": 1,
+							"gasUsed": "21000",
+							"totalFee": "210000"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "disabled",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("TxFeeAccounting").Return(nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"txFeeReport": {
+						"message": "transaction fee accounting is disabled",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
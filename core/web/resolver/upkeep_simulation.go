@@ -0,0 +1,114 @@
+package resolver
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+// UpkeepSimulationResolver resolves the UpkeepSimulation type.
+type UpkeepSimulationResolver struct {
+	evmChainID string
+	req        keeper.UpkeepSimulationRequest
+	sim        keeper.UpkeepSimulation
+}
+
+func NewUpkeepSimulation(evmChainID string, req keeper.UpkeepSimulationRequest, sim keeper.UpkeepSimulation) *UpkeepSimulationResolver {
+	return &UpkeepSimulationResolver{evmChainID: evmChainID, req: req, sim: sim}
+}
+
+// UpkeepID resolves the ID of the simulated upkeep.
+func (r *UpkeepSimulationResolver) UpkeepID() string {
+	return r.req.UpkeepID.String()
+}
+
+// EVMChainID resolves the ID of the chain of the registry.
+func (r *UpkeepSimulationResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.evmChainID)
+}
+
+// RegistryAddress resolves the address of the registry of the upkeep.
+func (r *UpkeepSimulationResolver) RegistryAddress() string {
+	return r.req.Registry.Hex()
+}
+
+// RegistryVersion resolves the detected version of the registry.
+func (r *UpkeepSimulationResolver) RegistryVersion() string {
+	return r.sim.RegistryVersion
+}
+
+// BlockNumber resolves the block the upkeep was checked at.
+func (r *UpkeepSimulationResolver) BlockNumber() string {
+	return stringutils.FromInt64(r.sim.BlockNumber)
+}
+
+// UpkeepNeeded resolves whether the upkeep would be performed.
+func (r *UpkeepSimulationResolver) UpkeepNeeded() bool {
+	return r.sim.UpkeepNeeded
+}
+
+// PerformData resolves the hex encoded perform data returned by the check.
+func (r *UpkeepSimulationResolver) PerformData() string {
+	return hexutil.Encode(r.sim.PerformData)
+}
+
+// FailureReason resolves why the upkeep is not needed, if known.
+func (r *UpkeepSimulationResolver) FailureReason() *string {
+	if r.sim.FailureReason == "" {
+		return nil
+	}
+	return &r.sim.FailureReason
+}
+
+// CheckGasUsed resolves the gas used by the check.
+func (r *UpkeepSimulationResolver) CheckGasUsed() *string {
+	return bigIntString(r.sim.CheckGasUsed)
+}
+
+// GasLimit resolves the perform gas limit of the upkeep.
+func (r *UpkeepSimulationResolver) GasLimit() *string {
+	return bigIntString(r.sim.GasLimit)
+}
+
+// PerformGasUsed resolves the gas used by the simulated perform.
+func (r *UpkeepSimulationResolver) PerformGasUsed() *string {
+	return bigIntString(r.sim.PerformGasUsed)
+}
+
+// PerformSimulationSucceeded resolves whether the simulated perform succeeded.
+func (r *UpkeepSimulationResolver) PerformSimulationSucceeded() bool {
+	return r.sim.PerformSimulationSucceeded
+}
+
+// -- SimulateUpkeep Query --
+
+type SimulateUpkeepPayloadResolver struct {
+	sim *UpkeepSimulationResolver
+	NotFoundErrorUnionType
+}
+
+func NewSimulateUpkeepPayload(sim *UpkeepSimulationResolver, err error) *SimulateUpkeepPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "chain not found", isExpectedErrorFn: nil}
+
+	return &SimulateUpkeepPayloadResolver{sim: sim, NotFoundErrorUnionType: e}
+}
+
+func (r *SimulateUpkeepPayloadResolver) ToUpkeepSimulation() (*UpkeepSimulationResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return r.sim, true
+}
+
+func bigIntString(i *big.Int) *string {
+	if i == nil {
+		return nil
+	}
+	s := i.String()
+	return &s
+}
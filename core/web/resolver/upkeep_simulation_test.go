@@ -0,0 +1,76 @@
+package resolver
+
+import (
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+)
+
+func TestResolver_SimulateUpkeep(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query SimulateUpkeep($input: SimulateUpkeepInput!) {
+			simulateUpkeep(input: $input) {
+				... on UpkeepSimulation {
+					upkeepID
+					upkeepNeeded
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"evmChainID":      "12",
+			"registryAddress": testutils.NewAddress().Hex(),
+			"upkeepID":        "42",
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "simulateUpkeep"),
+		{
+			name:          "tenant user",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.injectTenantUser("team-a")
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: TenantNotPermittedErr{"team-a"},
+					Path:          []interface{}{"simulateUpkeep"},
+					Message:       "Not permitted for users of tenant: team-a",
+				},
+			},
+		},
+		{
+			name:          "chain not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.legacyEVMChains.On("Get", "12").Return(nil, evmrelay.ErrNoChains)
+				f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"simulateUpkeep": {
+						"message": "chain not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -33,6 +33,10 @@ func (r *UserResolver) Email() string {
 }
 
 // CreatedAt resolves the user's creation date
+func (r *UserResolver) Tenant() *string {
+	return r.user.Tenant.Ptr()
+}
+
 func (r *UserResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.user.CreatedAt}
 }
@@ -0,0 +1,139 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	v2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+// VRFSubscription is a subscription tracked by the subscription monitor of a VRF job.
+type VRFSubscription struct {
+	JobID int32
+	v2.SubscriptionStatus
+}
+
+// VRFSubscriptionResolver resolves the VRFSubscription type.
+type VRFSubscriptionResolver struct {
+	sub VRFSubscription
+}
+
+func NewVRFSubscription(sub VRFSubscription) *VRFSubscriptionResolver {
+	return &VRFSubscriptionResolver{sub: sub}
+}
+
+func NewVRFSubscriptions(subs []VRFSubscription) []*VRFSubscriptionResolver {
+	var resolvers []*VRFSubscriptionResolver
+	for _, s := range subs {
+		resolvers = append(resolvers, NewVRFSubscription(s))
+	}
+
+	return resolvers
+}
+
+// JobID resolves the ID of the VRF job serving the subscription.
+func (r *VRFSubscriptionResolver) JobID() graphql.ID {
+	return graphql.ID(stringutils.FromInt32(r.sub.JobID))
+}
+
+// EVMChainID resolves the ID of the chain of the coordinator.
+func (r *VRFSubscriptionResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.sub.EVMChainID.String())
+}
+
+// CoordinatorAddress resolves the address of the coordinator of the subscription.
+func (r *VRFSubscriptionResolver) CoordinatorAddress() string {
+	return r.sub.CoordinatorAddress.Hex()
+}
+
+// SubID resolves the ID of the subscription.
+func (r *VRFSubscriptionResolver) SubID() string {
+	return r.sub.SubID.String()
+}
+
+// NativePayment resolves whether the balance is the native balance of the subscription.
+func (r *VRFSubscriptionResolver) NativePayment() bool {
+	return r.sub.NativePayment
+}
+
+// Balance resolves the balance of the subscription, if it is known.
+func (r *VRFSubscriptionResolver) Balance() *string {
+	if r.sub.Balance == nil {
+		return nil
+	}
+	balance := r.sub.Balance.String()
+	return &balance
+}
+
+// Fulfillments resolves the number of fulfillments within the cost window.
+func (r *VRFSubscriptionResolver) Fulfillments() int32 {
+	return int32(r.sub.Fulfillments)
+}
+
+// CostPerHour resolves the average hourly cost of the fulfillments within the cost window.
+func (r *VRFSubscriptionResolver) CostPerHour() string {
+	if r.sub.CostPerHour == nil {
+		return "0"
+	}
+	return r.sub.CostPerHour.String()
+}
+
+// RunwayHours resolves the estimated number of hours until the subscription runs dry, if it is known.
+func (r *VRFSubscriptionResolver) RunwayHours() *float64 {
+	if r.sub.Runway == nil {
+		return nil
+	}
+	hours := r.sub.Runway.Hours()
+	return &hours
+}
+
+// Low resolves whether the runway is below the configured threshold.
+func (r *VRFSubscriptionResolver) Low() bool {
+	return r.sub.Low
+}
+
+// UpdatedAt resolves the time the balance was last queried.
+func (r *VRFSubscriptionResolver) UpdatedAt() *graphql.Time {
+	if r.sub.UpdatedAt.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: r.sub.UpdatedAt}
+}
+
+// Error resolves the error of the latest balance query, if it failed.
+func (r *VRFSubscriptionResolver) Error() *string {
+	if r.sub.Error == "" {
+		return nil
+	}
+	return &r.sub.Error
+}
+
+// -- VRFSubscriptions Query --
+
+type VRFSubscriptionsPayloadResolver struct {
+	subs []VRFSubscription
+	NotFoundErrorUnionType
+}
+
+func NewVRFSubscriptionsPayload(subs []VRFSubscription, err error) *VRFSubscriptionsPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found or subscription monitor disabled", isExpectedErrorFn: nil}
+
+	return &VRFSubscriptionsPayloadResolver{subs: subs, NotFoundErrorUnionType: e}
+}
+
+func (r *VRFSubscriptionsPayloadResolver) ToVRFSubscriptions() (*VRFSubscriptionsResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &VRFSubscriptionsResolver{subs: r.subs}, true
+}
+
+// VRFSubscriptionsResolver resolves the VRFSubscriptions type.
+type VRFSubscriptionsResolver struct {
+	subs []VRFSubscription
+}
+
+func (r *VRFSubscriptionsResolver) Results() []*VRFSubscriptionResolver {
+	return NewVRFSubscriptions(r.subs)
+}
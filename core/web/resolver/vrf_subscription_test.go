@@ -0,0 +1,125 @@
+package resolver
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	v2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+)
+
+type fakeSubscriptionReporter []v2.SubscriptionStatus
+
+func (f fakeSubscriptionReporter) Subscriptions() []v2.SubscriptionStatus { return f }
+
+func TestResolver_VRFSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetVRFSubscriptions {
+			vrfSubscriptions(jobID: "1") {
+				... on VRFSubscriptions {
+					results {
+						jobID
+						evmChainID
+						coordinatorAddress
+						subID
+						nativePayment
+						balance
+						fulfillments
+						costPerHour
+						runwayHours
+						low
+						updatedAt
+						error
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	runway := 12 * time.Hour
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "vrfSubscriptions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("VRFSubscriptionMonitors").Return(map[int32]v2.SubscriptionReporter{
+					1: fakeSubscriptionReporter{{
+						EVMChainID:         big.NewInt(12),
+						CoordinatorAddress: common.HexToAddress("0x01"),
+						SubID:              big.NewInt(7),
+						Balance:            big.NewInt(1200),
+						Fulfillments:       24,
+						CostPerHour:        big.NewInt(100),
+						Runway:             &runway,
+						Low:                true,
+						UpdatedAt:          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					}, {
+						EVMChainID:         big.NewInt(12),
+						CoordinatorAddress: common.HexToAddress("0x01"),
+						SubID:              big.NewInt(8),
+						NativePayment:      true,
+						Error:              "rpc unavailable",
+					}},
+				})
+			},
+			query: query,
+			result: `
+				{
+					"vrfSubscriptions": {
+						"results": [{
+							"jobID": "1",
+							"evmChainID": "12",
+							"coordinatorAddress": "0x0000000000000000000000000000000000000001",
+							"subID": "7",
+							"nativePayment": false,
+							"balance": "1200",
+							"fulfillments": 24,
+							"costPerHour": "100",
+							"runwayHours": 12,
+							"low": true,
+							"updatedAt": "2021-01-01T00:00:00Z",
+							"error": null
+						}, {
+							"jobID": "1",
+							"evmChainID": "12",
+							"coordinatorAddress": "0x0000000000000000000000000000000000000001",
+							"subID": "8",
+							"nativePayment": true,
+							"balance": null,
+							"fulfillments": 0,
+							"costPerHour": "0",
+							"runwayHours": null,
+							"low": false,
+							"updatedAt": null,
+							"error": "rpc unavailable"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("VRFSubscriptionMonitors").Return(map[int32]v2.SubscriptionReporter{})
+			},
+			query: query,
+			result: `
+				{
+					"vrfSubscriptions": {
+						"message": "job not found or subscription monitor disabled",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -0,0 +1,267 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/memory"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const (
+	routeLimitRejectIPNotAllowed = "ip_not_allowed"
+	routeLimitRejectRateLimited  = "rate_limited"
+)
+
+var promRouteLimitRejects = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "web_route_limit_rejects",
+	Help: "The number of requests rejected by the IP allow-list or the rate limit of a route",
+},
+	[]string{"route", "reason"},
+)
+
+// routeLimiters holds the middlewares enforcing WebServer.RouteLimits.
+type routeLimiters struct {
+	sessions         gin.HandlerFunc
+	graphQLMutations gin.HandlerFunc
+	runTriggers      gin.HandlerFunc
+}
+
+func newRouteLimiters(cfg config.RouteLimits) (*routeLimiters, error) {
+	trustedProxies, err := parsePrefixes(cfg.TrustedProxies())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid WebServer.RouteLimits.TrustedProxies")
+	}
+	sessions, err := newRouteLimiter("sessions", cfg.Sessions(), trustedProxies, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid WebServer.RouteLimits.Sessions")
+	}
+	graphQLMutations, err := newRouteLimiter("graphql_mutations", cfg.GraphQLMutations(), trustedProxies, isGraphQLMutationRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid WebServer.RouteLimits.GraphQLMutations")
+	}
+	runTriggers, err := newRouteLimiter("run_triggers", cfg.RunTriggers(), trustedProxies, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid WebServer.RouteLimits.RunTriggers")
+	}
+	return &routeLimiters{
+		sessions:         sessions.handle,
+		graphQLMutations: graphQLMutations.handle,
+		runTriggers:      runTriggers.handle,
+	}, nil
+}
+
+// routeLimiter rejects the requests of client IPs outside of its allow-list, and rate limits each client IP.
+type routeLimiter struct {
+	route          string
+	allowed        []netip.Prefix
+	limiter        *limiter.Limiter // nil when not rate limited
+	trustedProxies []netip.Prefix
+	// match selects the limited requests, or all of them when nil.
+	match func(*gin.Context) bool
+}
+
+func newRouteLimiter(route string, cfg config.RouteLimit, trustedProxies []netip.Prefix, match func(*gin.Context) bool) (*routeLimiter, error) {
+	allowed, err := parsePrefixes(cfg.AllowedCIDRs())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid AllowedCIDRs")
+	}
+	rl := &routeLimiter{route: route, allowed: allowed, trustedProxies: trustedProxies, match: match}
+	if cfg.RateLimit() > 0 {
+		if cfg.RateLimitPeriod() <= 0 {
+			return nil, errors.New("RateLimitPeriod must be positive when RateLimit is set")
+		}
+		rl.limiter = limiter.New(memory.NewStore(), limiter.Rate{
+			Period: cfg.RateLimitPeriod(),
+			Limit:  cfg.RateLimit(),
+		})
+	}
+	return rl, nil
+}
+
+func (rl *routeLimiter) handle(c *gin.Context) {
+	if len(rl.allowed) == 0 && rl.limiter == nil {
+		return
+	}
+	if rl.match != nil && !rl.match(c) {
+		return
+	}
+
+	ip, ok := clientIP(c.Request, rl.trustedProxies)
+	if len(rl.allowed) > 0 && (!ok || !containsAddr(rl.allowed, ip)) {
+		promRouteLimitRejects.WithLabelValues(rl.route, routeLimitRejectIPNotAllowed).Inc()
+		jsonAPIError(c, http.StatusForbidden, errors.New("client IP is not allowed"))
+		c.Abort()
+		return
+	}
+
+	if rl.limiter == nil {
+		return
+	}
+	key := c.Request.RemoteAddr
+	if ok {
+		key = ip.String()
+	}
+	lctx, err := rl.limiter.Get(c.Request.Context(), key)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		c.Abort()
+		return
+	}
+	if lctx.Reached {
+		promRouteLimitRejects.WithLabelValues(rl.route, routeLimitRejectRateLimited).Inc()
+		retryAfter := time.Until(time.Unix(lctx.Reset, 0))
+		c.Header("Retry-After", strconv.FormatInt(int64(retryAfter.Round(time.Second)/time.Second), 10))
+		jsonAPIError(c, http.StatusTooManyRequests, errors.New("rate limit exceeded"))
+		c.Abort()
+	}
+}
+
+// clientIP returns the IP address of the client. When the peer is a trusted proxy, the client is the rightmost
+// entry of X-Forwarded-For which is not itself a trusted proxy.
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) (netip.Addr, bool) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	ip = ip.Unmap()
+	if !containsAddr(trustedProxies, ip) {
+		return ip, true
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		addr, err := netip.ParseAddr(hop)
+		if err != nil {
+			// Unparseable hops can't be trusted, so fall back to the last proxy.
+			return ip, true
+		}
+		ip = addr.Unmap()
+		if !containsAddr(trustedProxies, ip) {
+			return ip, true
+		}
+	}
+	return ip, true
+}
+
+func containsAddr(prefixes []netip.Prefix, ip netip.Addr) bool {
+	for _, p := range prefixes {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for i, s := range cidrs {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+	return prefixes, nil
+}
+
+// isGraphQLMutationRequest returns true if the GraphQL request body defines a mutation. The body is restored for the
+// handler.
+func isGraphQLMutationRequest(c *gin.Context) bool {
+	if c.Request.Body == nil {
+		return false
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		// Err on the side of limiting.
+		return true
+	}
+	var req struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		// Rejected by the GraphQL handler anyway.
+		return false
+	}
+	return hasGraphQLMutation(req.Query)
+}
+
+// hasGraphQLMutation returns true if the GraphQL document contains the mutation keyword outside of any selection set,
+// argument list, or string. It only lexes the document as far as needed, so it errs on the side of finding one, e.g.
+// for an operation named "mutation".
+func hasGraphQLMutation(doc string) bool {
+	depth := 0
+	for i := 0; i < len(doc); {
+		ch := doc[i]
+		switch {
+		case ch == '#':
+			end := strings.IndexAny(doc[i:], "\r\n")
+			if end < 0 {
+				return false
+			}
+			i += end
+			continue
+		case strings.HasPrefix(doc[i:], `"""`):
+			i += 3
+			for i < len(doc) && !strings.HasPrefix(doc[i:], `"""`) {
+				if strings.HasPrefix(doc[i:], `\"""`) {
+					i += 3
+				}
+				i++
+			}
+			i += 3
+			continue
+		case ch == '"':
+			i++
+			for i < len(doc) && doc[i] != '"' && doc[i] != '\n' {
+				if doc[i] == '\\' {
+					i++
+				}
+				i++
+			}
+		case ch == '{' || ch == '(' || ch == '[':
+			depth++
+		case ch == '}' || ch == ')' || ch == ']':
+			depth--
+		case isGraphQLNameStart(ch):
+			j := i + 1
+			for j < len(doc) && (isGraphQLNameStart(doc[j]) || ('0' <= doc[j] && doc[j] <= '9')) {
+				j++
+			}
+			if depth == 0 && doc[i:j] == "mutation" {
+				return true
+			}
+			i = j
+			continue
+		}
+		i++
+	}
+	return false
+}
+
+func isGraphQLNameStart(ch byte) bool {
+	return ch == '_' || ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z')
+}
@@ -0,0 +1,125 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRouteLimit struct {
+	allowedCIDRs    []string
+	rateLimit       int64
+	rateLimitPeriod time.Duration
+}
+
+func (l testRouteLimit) AllowedCIDRs() []string         { return l.allowedCIDRs }
+func (l testRouteLimit) RateLimit() int64               { return l.rateLimit }
+func (l testRouteLimit) RateLimitPeriod() time.Duration { return l.rateLimitPeriod }
+
+func newTestRouteLimiterEngine(t *testing.T, cfg testRouteLimit, trustedProxies []string, match func(*gin.Context) bool) *gin.Engine {
+	proxies, err := parsePrefixes(trustedProxies)
+	require.NoError(t, err)
+	rl, err := newRouteLimiter("test", cfg, proxies, match)
+	require.NoError(t, err)
+
+	engine := gin.New()
+	engine.POST("/", rl.handle, func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	return engine
+}
+
+func serveFrom(engine *gin.Engine, remoteAddr, forwardedFor, body string) int {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestRouteLimiter_AllowedCIDRs(t *testing.T) {
+	engine := newTestRouteLimiterEngine(t, testRouteLimit{allowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}}, nil, nil)
+
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "10.1.2.3:1234", "", ""))
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "[2001:db8::1]:1234", "", ""))
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "[::ffff:10.1.2.3]:1234", "", ""))
+	assert.Equal(t, http.StatusForbidden, serveFrom(engine, "192.168.1.1:1234", "", ""))
+	// X-Forwarded-For is ignored without trusted proxies.
+	assert.Equal(t, http.StatusForbidden, serveFrom(engine, "192.168.1.1:1234", "10.1.2.3", ""))
+}
+
+func TestRouteLimiter_RateLimit(t *testing.T) {
+	engine := newTestRouteLimiterEngine(t, testRouteLimit{rateLimit: 2, rateLimitPeriod: time.Hour}, nil, nil)
+
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "10.0.0.1:1234", "", ""))
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "10.0.0.1:4321", "", ""))
+	assert.Equal(t, http.StatusTooManyRequests, serveFrom(engine, "10.0.0.1:1234", "", ""))
+	// Each client IP has its own limit.
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "10.0.0.2:1234", "", ""))
+}
+
+func TestRouteLimiter_TrustedProxies(t *testing.T) {
+	engine := newTestRouteLimiterEngine(t, testRouteLimit{allowedCIDRs: []string{"10.0.0.0/8"}}, []string{"192.168.0.0/16"}, nil)
+
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "192.168.1.1:1234", "10.1.2.3", ""))
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "192.168.1.1:1234", "10.1.2.3, 192.168.1.2", ""))
+	// Only the rightmost untrusted hop is the client, anything before it may be spoofed.
+	assert.Equal(t, http.StatusForbidden, serveFrom(engine, "192.168.1.1:1234", "10.1.2.3, 172.16.0.1", ""))
+	assert.Equal(t, http.StatusForbidden, serveFrom(engine, "192.168.1.1:1234", "not-an-ip", ""))
+	assert.Equal(t, http.StatusForbidden, serveFrom(engine, "192.168.1.1:1234", "", ""))
+}
+
+func TestRouteLimiter_GraphQLMutations(t *testing.T) {
+	engine := newTestRouteLimiterEngine(t, testRouteLimit{allowedCIDRs: []string{"10.0.0.0/8"}}, nil, isGraphQLMutationRequest)
+
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "192.168.1.1:1234", "", `{"query": "query { jobs { id } }"}`))
+	assert.Equal(t, http.StatusForbidden, serveFrom(engine, "192.168.1.1:1234", "", `{"query": "mutation { deleteJob(id: 1) { id } }"}`))
+	assert.Equal(t, http.StatusOK, serveFrom(engine, "10.1.2.3:1234", "", `{"query": "mutation { deleteJob(id: 1) { id } }"}`))
+}
+
+func TestHasGraphQLMutation(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		doc  string
+		exp  bool
+	}{
+		{"shorthand query", `{ jobs { id } }`, false},
+		{"query", `query Jobs { jobs { id } }`, false},
+		{"mutation", `mutation DeleteJob { deleteJob(id: 1) { id } }`, true},
+		{"mutation after query", `query A { a } mutation B { b }`, true},
+		{"mutation after fragment", "fragment F on Job { id }\nmutation { deleteJob(id: 1) { ...F } }", true},
+		{"field named mutation", `{ mutation }`, false},
+		{"string argument", `{ jobs(filter: "} mutation {") { id } }`, false},
+		{"escaped quote", `{ jobs(filter: "\" mutation") { id } }`, false},
+		{"block string", `{ jobs(filter: """ \""" } mutation { """) { id } }`, false},
+		{"mutation after block string", `{ jobs(filter: """ \""" """) { id } } mutation { a }`, true},
+		{"comment", "# mutation\n{ jobs { id } }", false},
+		{"name prefix", `mutations { a }`, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, hasGraphQLMutation(tt.doc))
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "not-an-addr"
+	_, ok := clientIP(req, nil)
+	assert.False(t, ok)
+
+	req.RemoteAddr = "10.0.0.1:1234"
+	ip, ok := clientIP(req, nil)
+	require.True(t, ok)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), ip)
+}
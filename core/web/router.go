@@ -81,15 +81,23 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 		sessions.Sessions(auth.SessionName, sessionStore),
 	)
 
+	routeLimits, err := newRouteLimiters(config.WebServer().RouteLimits())
+	if err != nil {
+		return nil, err
+	}
+
 	debugRoutes(app, api)
 	healthRoutes(app, api)
-	sessionRoutes(app, api)
-	v2Routes(app, api)
+	publicStatusRoutes(app, api)
+	sessionRoutes(app, api, routeLimits)
+	v2Routes(app, api, routeLimits)
 	loopRoutes(app, api)
+	eventRoutes(app, api)
 
 	guiAssetRoutes(engine, config.Insecure().DisableRateLimiting(), app.GetLogger())
 
 	api.POST("/query",
+		routeLimits.graphQLMutations,
 		auth.AuthenticateGQL(app.AuthenticationProvider(), app.GetLogger().Named("GQLHandler")),
 		loader.Middleware(app),
 		graphqlHandler(app),
@@ -198,7 +206,7 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 	}
 }
 
-func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
+func sessionRoutes(app chainlink.Application, r *gin.RouterGroup, routeLimits *routeLimiters) {
 	config := app.GetConfig()
 	rl := config.WebServer().RateLimit()
 	unauth := r.Group("/", rateLimiter(
@@ -206,7 +214,7 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 		rl.Unauthenticated(),
 	))
 	sc := NewSessionsController(app)
-	unauth.POST("/sessions", sc.Create)
+	unauth.POST("/sessions", routeLimits.sessions, sc.Create)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
@@ -220,6 +228,16 @@ func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	}, hc.Health)
 }
 
+// publicStatusRoutes serves the public status of the node without authentication, if enabled, with its own rate limit.
+func publicStatusRoutes(app chainlink.Application, r *gin.RouterGroup) {
+	ps := app.GetConfig().WebServer().PublicStatus()
+	if !ps.Enabled() {
+		return
+	}
+	psc := PublicStatusController{app}
+	r.GET("/public/status", rateLimiter(ps.RateLimitPeriod(), ps.RateLimit()), psc.Show)
+}
+
 func loopRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	loopRegistry := NewLoopRegistryServer(app)
 	r.GET("/discovery", ginHandlerFromHTTP(loopRegistry.discoveryHandler))
@@ -227,7 +245,17 @@ func loopRoutes(app chainlink.Application, r *gin.RouterGroup) {
 
 }
 
-func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
+// eventRoutes streams the logs of LogPoller filters over websockets to authenticated node users.
+func eventRoutes(app chainlink.Application, r *gin.RouterGroup) {
+	ec := EventsController{app}
+	ws := r.Group("/ws", auth.Authenticate(app.AuthenticationProvider(),
+		auth.AuthenticateByToken,
+		auth.AuthenticateBySession,
+	), auth.RequiresNodeUser)
+	ws.GET("/events", ec.Stream)
+}
+
+func v2Routes(app chainlink.Application, r *gin.RouterGroup, routeLimits *routeLimiters) {
 	unauthedv2 := r.Group("/v2")
 
 	prc := PipelineRunsController{app}
@@ -252,11 +280,22 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
+		tc := TOTPController{app}
+		authv2.POST("/enroll_totp", tc.BeginEnrollment)
+		authv2.POST("/enroll_totp/confirm", tc.ConfirmEnrollment)
+		authv2.POST("/recovery_codes", tc.RegenerateRecoveryCodes)
+
+		// The rest of the API is not partitioned between tenants.
+		authv2 = authv2.Group("", auth.RequiresNodeUser)
+
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
 		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
 		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
 
+		pvc := ProvisioningController{app}
+		authv2.POST("/apply", auth.RequiresAdminRole(pvc.Apply))
+
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
 		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
@@ -275,6 +314,17 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.GET("/config/v2", cc.Show)
+		authv2.POST("/config/reload", auth.RequiresAdminRole(cc.Reload))
+
+		sbc := SupportBundleController{app}
+		authv2.POST("/support_bundle", auth.RequiresAdminRole(sbc.Create))
+
+		dc := DrainController{app}
+		authv2.GET("/drain", dc.Show)
+		authv2.POST("/drain", auth.RequiresAdminRole(dc.Create))
+
+		p2ppc := P2PPeersController{app}
+		authv2.GET("/p2p/peers", p2ppc.Index)
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -282,6 +332,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/evm/dead_letters", paginatedRequest(txs.DeadLetters))
+		authv2.POST("/transactions/evm/dead_letters/:ID/requeue", auth.RequiresEditRole(txs.Requeue))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
@@ -295,6 +347,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
 		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
 
+		mkc := MasterKeyController{app}
+		authv2.POST("/keys/master/rotate", auth.RequiresAdminRole(mkc.Rotate))
+
 		ekc := NewETHKeysController(app)
 		authv2.GET("/keys/eth", ekc.Index)
 		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
@@ -366,13 +421,17 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/lint", jc.Lint)
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/migrate-ocr", auth.RequiresEditRole(jc.MigrateOCR))
+		authv2.POST("/jobs/:ID/restart", auth.RequiresEditRole(jc.Restart))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/jobs/:ID/runs/:runID/replay", auth.RequiresEditRole(prc.Replay))
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -397,6 +456,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		} {
 			chains.GET(chain.path, paginatedRequest(chain.cc.Index))
 			chains.GET(chain.path+"/:ID", chain.cc.Show)
+			chains.POST(chain.path+"/:ID/enable", auth.RequiresAdminRole(chain.cc.Enable))
+			chains.POST(chain.path+"/:ID/disable", auth.RequiresAdminRole(chain.cc.Disable))
 		}
 
 		nodes := authv2.Group("nodes")
@@ -422,6 +483,36 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
 		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
 
+		if app.GetConfig().Insecure().SimulatedEVMChains() {
+			scc := SimulatedChainsController{app}
+			authv2.GET("/simulated_chains/:ID", scc.Show)
+			authv2.PATCH("/simulated_chains/:ID", auth.RequiresAdminRole(scc.Update))
+			authv2.POST("/simulated_chains/:ID/mine", auth.RequiresAdminRole(scc.Mine))
+			authv2.POST("/simulated_chains/:ID/reorg", auth.RequiresAdminRole(scc.Reorg))
+			authv2.POST("/simulated_chains/:ID/fund", auth.RequiresAdminRole(scc.Fund))
+		}
+
+		vrc := VRFRequestsController{app}
+		authv2.GET("/vrf/requests", vrc.Index)
+		authv2.POST("/vrf/requests/:requestID/fulfill", auth.RequiresEditRole(vrc.Fulfill))
+
+		fsc := FunctionsSecretsController{app}
+		authv2.POST("/functions/secrets/rotate", auth.RequiresEditRole(fsc.Rotate))
+		frc := FunctionsRequestsController{app}
+		authv2.POST("/functions/requests/replay", auth.RequiresAdminRole(frc.Replay))
+
+		usc := UpkeepSimulationsController{app}
+		authv2.POST("/upkeeps/:upkeepID/simulate", usc.Simulate)
+
+		mrc := MercuryReportsController{}
+		authv2.POST("/mercury/reports/verify", mrc.Verify)
+
+		dmc := DatabaseMaintenanceController{app}
+		authv2.GET("/database_maintenance", dmc.Index)
+
+		tfc := TxFeesController{app}
+		authv2.GET("/tx_fees/report.csv", tfc.Report)
+
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
 
@@ -436,7 +527,15 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+
+	// Run triggers are limited before authentication, so that rejected clients can't brute force credentials. External
+	// initiators belong to no tenant, while the users of a tenant run the jobs of their tenant through the GraphQL API.
+	runTriggers := r.Group("/v2", routeLimits.runTriggers, auth.Authenticate(app.AuthenticationProvider(),
+		auth.AuthenticateExternalInitiator,
+		auth.AuthenticateByToken,
+		auth.AuthenticateBySession,
+	), auth.RequiresNodeUser)
+	runTriggers.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -37,6 +37,8 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("error binding json %v", err))
 		return
 	}
+	sr.IPAddress = c.ClientIP()
+	sr.UserAgent = c.Request.UserAgent()
 
 	// Does this user have 2FA enabled?
 	userWebAuthnTokens, err := sc.App.AuthenticationProvider().GetUserWebAuthn(sr.Email)
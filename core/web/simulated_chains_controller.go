@@ -0,0 +1,198 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// SimulatedChainsController controls the simulated EVM chains the node runs against when Insecure.SimulatedEVMChains
+// is enabled.
+type SimulatedChainsController struct {
+	App chainlink.Application
+}
+
+// UpdateSimulatedChainRequest changes the block production and gas price of a simulated chain.
+type UpdateSimulatedChainRequest struct {
+	// BlockInterval is how often blocks are produced, e.g. "2s". Zero produces blocks only on demand.
+	BlockInterval *string `json:"blockInterval"`
+	// MinGasPrice is the minimum gas price of transactions, e.g. "100 gwei", to simulate a gas spike. Zero removes it.
+	MinGasPrice *assets.Wei `json:"minGasPrice"`
+}
+
+// MineSimulatedChainRequest is the body of a mine request.
+type MineSimulatedChainRequest struct {
+	Blocks int `json:"blocks"`
+}
+
+// ReorgSimulatedChainRequest is the body of a reorg request.
+type ReorgSimulatedChainRequest struct {
+	Depth int `json:"depth"`
+}
+
+// FundSimulatedChainRequest is the body of a fund request. Amount is in wei, or with a unit, e.g. "10 ether".
+type FundSimulatedChainRequest struct {
+	Address common.Address `json:"address"`
+	Amount  *assets.Wei    `json:"amount"`
+}
+
+// Show returns the state of a simulated chain.
+// Example:
+// "GET <application>/simulated_chains/:ID"
+func (scc *SimulatedChainsController) Show(c *gin.Context) {
+	chainID, sim, ok := scc.getSimulatedChain(c)
+	if !ok {
+		return
+	}
+	jsonAPIResponse(c, presenters.NewSimulatedChainResource(chainID, sim), "simulated_chains")
+}
+
+// Update changes the block production and gas price of a simulated chain.
+// Example:
+// "PATCH <application>/simulated_chains/:ID"
+func (scc *SimulatedChainsController) Update(c *gin.Context) {
+	var request UpdateSimulatedChainRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var interval *time.Duration
+	if request.BlockInterval != nil {
+		d, err := time.ParseDuration(*request.BlockInterval)
+		if err != nil || d < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid block interval %q", *request.BlockInterval))
+			return
+		}
+		interval = &d
+	}
+	chainID, sim, ok := scc.getSimulatedChain(c)
+	if !ok {
+		return
+	}
+
+	if interval != nil {
+		sim.SetBlockInterval(*interval)
+	}
+	if request.MinGasPrice != nil {
+		if request.MinGasPrice.IsZero() {
+			sim.SetMinGasPrice(nil)
+		} else {
+			sim.SetMinGasPrice(request.MinGasPrice.ToInt())
+		}
+	}
+	scc.App.GetAuditLogger().Audit(audit.SimulatedChainUpdated, map[string]interface{}{
+		"evmChainID":    chainID,
+		"blockInterval": request.BlockInterval,
+		"minGasPrice":   request.MinGasPrice,
+	})
+
+	jsonAPIResponse(c, presenters.NewSimulatedChainResource(chainID, sim), "simulated_chains")
+}
+
+// Mine produces blocks on a simulated chain, one if not specified.
+// Example:
+// "POST <application>/simulated_chains/:ID/mine"
+func (scc *SimulatedChainsController) Mine(c *gin.Context) {
+	request := MineSimulatedChainRequest{Blocks: 1}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	if request.Blocks < 1 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("blocks must be at least 1"))
+		return
+	}
+	chainID, sim, ok := scc.getSimulatedChain(c)
+	if !ok {
+		return
+	}
+
+	sim.Mine(request.Blocks)
+	jsonAPIResponse(c, presenters.NewSimulatedChainResource(chainID, sim), "simulated_chains")
+}
+
+// Reorg replaces the latest blocks of a simulated chain.
+// Example:
+// "POST <application>/simulated_chains/:ID/reorg"
+func (scc *SimulatedChainsController) Reorg(c *gin.Context) {
+	var request ReorgSimulatedChainRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	chainID, sim, ok := scc.getSimulatedChain(c)
+	if !ok {
+		return
+	}
+
+	_, err := sim.Reorg(c.Request.Context(), request.Depth)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	scc.App.GetAuditLogger().Audit(audit.SimulatedChainReorged, map[string]interface{}{"evmChainID": chainID, "depth": request.Depth})
+
+	jsonAPIResponse(c, presenters.NewSimulatedChainResource(chainID, sim), "simulated_chains")
+}
+
+// Fund sends funds to an address on a simulated chain, which are available once the next block is produced.
+// Example:
+// "POST <application>/simulated_chains/:ID/fund"
+func (scc *SimulatedChainsController) Fund(c *gin.Context) {
+	var request FundSimulatedChainRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Amount == nil || request.Amount.IsZero() {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("amount must be positive"))
+		return
+	}
+	chainID, sim, ok := scc.getSimulatedChain(c)
+	if !ok {
+		return
+	}
+
+	hash, err := sim.Fund(c.Request.Context(), request.Address, request.Amount.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	scc.App.GetAuditLogger().Audit(audit.SimulatedChainFunded, map[string]interface{}{
+		"evmChainID": chainID,
+		"address":    request.Address,
+		"amount":     request.Amount,
+		"txHash":     hash,
+	})
+
+	jsonAPIResponse(c, presenters.NewSimulatedChainResource(chainID, sim), "simulated_chains")
+}
+
+func (scc *SimulatedChainsController) getSimulatedChain(c *gin.Context) (string, *evmclient.SimulatedChain, bool) {
+	chain, err := getChain(scc.App.GetRelayers().LegacyEVMChains(), c.Param("ID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return "", nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return "", nil, false
+	}
+	sim, ok := chain.Client().(*evmclient.SimulatedChain)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("chain %s is not simulated", chain.ID()))
+		return "", nil, false
+	}
+	return chain.ID().String(), sim, true
+}
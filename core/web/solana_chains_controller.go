@@ -9,7 +9,7 @@ import (
 func NewSolanaChainsController(app chainlink.Application) ChainsController {
 	return newChainsController(
 		relay.Solana,
-		app.GetRelayers().List(chainlink.FilterRelayersByType(relay.Solana)),
+		app,
 		ErrSolanaNotEnabled,
 		presenters.NewSolanaChainResource,
 		app.GetLogger(),
@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// StandbyHealthController serves the health checks of a warm standby node, which waits for the database lease
+// before starting the application.
+type StandbyHealthController struct {
+	Lease services.HealthReporter
+	Lggr  logger.Logger
+}
+
+// Readyz always fails, since a standby does not run any job.
+func (hc *StandbyHealthController) Readyz(c *gin.Context) {
+	if _, ok := c.GetQuery("full"); !ok {
+		c.Status(http.StatusServiceUnavailable)
+		return
+	}
+	jsonAPIResponseWithStatus(c, newChecks(hc.Lease.HealthReport()), "checks", http.StatusServiceUnavailable)
+}
+
+func (hc *StandbyHealthController) Health(c *gin.Context) {
+	status := http.StatusOK
+	report := hc.Lease.HealthReport()
+	for _, err := range report {
+		if err != nil {
+			status = http.StatusMultiStatus
+		}
+	}
+	c.Status(status)
+
+	writeHealthReport(c, newChecks(report), status, hc.Lggr)
+}
+
+// NewStandbyRouter returns a router serving only the health checks of a warm standby node, until it takes the lease.
+func NewStandbyRouter(lease services.HealthReporter, lggr logger.Logger) *gin.Engine {
+	engine := gin.New()
+	engine.RemoteIPHeaders = nil // don't trust default headers: "X-Forwarded-For", "X-Real-IP"
+	engine.Use(loggerFunc(lggr), gin.Recovery())
+
+	hc := StandbyHealthController{Lease: lease, Lggr: lggr}
+	engine.GET("/readyz", hc.Readyz)
+	engine.GET("/health", hc.Health)
+	engine.GET("/health.txt", func(context *gin.Context) {
+		context.Request.Header.Set("Accept", gin.MIMEPlain)
+	}, hc.Health)
+	return engine
+}
@@ -0,0 +1,89 @@
+package web_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+type standbyLease struct{ err error }
+
+func (l *standbyLease) Name() string { return pg.LeaseHealthName }
+func (l *standbyLease) Ready() error { return l.err }
+func (l *standbyLease) HealthReport() map[string]error {
+	return map[string]error{l.Name(): l.err}
+}
+
+func TestStandbyHealthController(t *testing.T) {
+	lease := &standbyLease{err: pg.ErrStandby}
+	ts := httptest.NewServer(web.NewStandbyRouter(lease, logger.TestLogger(t)))
+	t.Cleanup(ts.Close)
+
+	get := func(t *testing.T, path string) (int, string) {
+		resp, err := http.Get(ts.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(b)
+	}
+
+	t.Run("readyz", func(t *testing.T) {
+		status, _ := get(t, "/readyz")
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+
+		status, body := get(t, "/readyz?full=1")
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+		var checks struct {
+			Data []struct {
+				Attributes struct {
+					Name   string `json:"name"`
+					Status string `json:"status"`
+					Output string `json:"output"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(body), &checks))
+		require.Len(t, checks.Data, 1)
+		assert.Equal(t, pg.LeaseHealthName, checks.Data[0].Attributes.Name)
+		assert.Equal(t, web.HealthStatusFailing, checks.Data[0].Attributes.Status)
+		assert.Equal(t, pg.ErrStandby.Error(), checks.Data[0].Attributes.Output)
+	})
+
+	t.Run("health json", func(t *testing.T) {
+		status, body := get(t, "/health")
+		assert.Equal(t, http.StatusMultiStatus, status)
+		var checks struct {
+			Data []struct {
+				Attributes struct {
+					Name   string `json:"name"`
+					Status string `json:"status"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(body), &checks))
+		require.Len(t, checks.Data, 1)
+		assert.Equal(t, pg.LeaseHealthName, checks.Data[0].Attributes.Name)
+		assert.Equal(t, web.HealthStatusFailing, checks.Data[0].Attributes.Status)
+	})
+
+	t.Run("health", func(t *testing.T) {
+		status, body := get(t, "/health.txt")
+		assert.Equal(t, http.StatusMultiStatus, status)
+		assert.Equal(t, "!DatabaseLease\n\t"+pg.ErrStandby.Error()+"\n", body)
+
+		lease.err = nil
+		status, body = get(t, "/health.txt")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "-DatabaseLease\n", body)
+	})
+}
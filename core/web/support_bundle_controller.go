@@ -0,0 +1,36 @@
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/supportbundle"
+)
+
+// SupportBundleController creates support bundles of the node.
+type SupportBundleController struct {
+	App chainlink.Application
+}
+
+// Create gathers the diagnostics of the node into a gzipped tarball, to attach to support tickets.
+// Example:
+// "POST <application>/support_bundle"
+func (sbc *SupportBundleController) Create(c *gin.Context) {
+	now := time.Now()
+	var buf bytes.Buffer
+	if err := supportbundle.Write(c.Request.Context(), sbc.App, &buf, now); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	fileName := supportbundle.FileName(now)
+	sbc.App.GetAuditLogger().Audit(audit.SupportBundleCreated, map[string]interface{}{"fileName": fileName})
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, "application/gzip", buf.Bytes())
+}
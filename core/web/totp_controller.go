@@ -0,0 +1,99 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// TOTPController enrolls TOTP for the current user, and manages their recovery codes
+type TOTPController struct {
+	App chainlink.Application
+}
+
+// ConfirmTOTPRequest holds a code of the authenticator app, to confirm a TOTP enrollment.
+type ConfirmTOTPRequest struct {
+	Code string `json:"code"`
+}
+
+// BeginEnrollment returns a new TOTP secret, which is only required on login once confirmed.
+// Example:
+// "POST <application>/enroll_totp"
+func (tc *TOTPController) BeginEnrollment(c *gin.Context) {
+	user, ok := auth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	secret, err := tc.App.AuthenticationProvider().BeginTOTPEnrollment(user.Email)
+	if err != nil {
+		tc.mfaError(c, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewTOTPEnrollmentResource(user.Email, secret, sessions.TOTPURL(user.Email, secret)), "totpEnrollment")
+}
+
+// ConfirmEnrollment confirms the pending TOTP secret with a code of the authenticator app, and returns the recovery
+// codes.
+// Example:
+// "POST <application>/enroll_totp/confirm"
+func (tc *TOTPController) ConfirmEnrollment(c *gin.Context) {
+	user, ok := auth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	var request ConfirmTOTPRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	codes, err := tc.App.AuthenticationProvider().ConfirmTOTPEnrollment(user.Email, request.Code)
+	if err != nil {
+		tc.mfaError(c, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewRecoveryCodesResource(user.Email, codes), "recoveryCodes")
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the current user.
+// Example:
+// "POST <application>/recovery_codes"
+func (tc *TOTPController) RegenerateRecoveryCodes(c *gin.Context) {
+	user, ok := auth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	codes, err := tc.App.AuthenticationProvider().RegenerateRecoveryCodes(user.Email)
+	if err != nil {
+		tc.mfaError(c, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewRecoveryCodesResource(user.Email, codes), "recoveryCodes")
+}
+
+func (tc *TOTPController) mfaError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sessions.ErrNotSupported):
+		jsonAPIError(c, http.StatusBadRequest, err)
+	case errors.Is(err, sessions.ErrTOTPAlreadyEnrolled), errors.Is(err, sessions.ErrNoPendingTOTP),
+		errors.Is(err, sessions.ErrInvalidTOTPCode), errors.Is(err, sessions.ErrMFANotEnrolled):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	default:
+		tc.App.GetLogger().Errorw("Failed to update MFA", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("internal Server Error"))
+	}
+}
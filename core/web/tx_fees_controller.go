@@ -0,0 +1,70 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeaccounting"
+)
+
+// TxFeesController exports the fees paid by the EVM transactions of the node.
+type TxFeesController struct {
+	App chainlink.Application
+}
+
+// Report exports the fees of the transactions confirmed between the from and to query parameters, formatted as
+// RFC3339, as CSV. The fees are aggregated per chain, and per key or job depending on the groupBy query parameter,
+// which defaults to chain. The chainID query parameter restricts the report to a chain.
+// Example:
+// "GET <application>/tx_fees/report.csv?from=2023-01-01T00:00:00Z&to=2023-02-01T00:00:00Z&groupBy=job"
+func (tfc *TxFeesController) Report(c *gin.Context) {
+	a := tfc.App.TxFeeAccounting()
+	if a == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("transaction fee accounting is disabled"))
+		return
+	}
+
+	q, err := parseTxFeeReportQuery(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rows, err := a.Report(c.Request.Context(), q)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="tx_fees.csv"`)
+	c.Header("Content-Type", "text/csv")
+	c.Status(http.StatusOK)
+	if err = feeaccounting.WriteCSV(c.Writer, rows); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+func parseTxFeeReportQuery(c *gin.Context) (q feeaccounting.ReportQuery, err error) {
+	if q.From, err = time.Parse(time.RFC3339, c.Query("from")); err != nil {
+		return q, fmt.Errorf("invalid from: %w", err)
+	}
+	if q.To, err = time.Parse(time.RFC3339, c.Query("to")); err != nil {
+		return q, fmt.Errorf("invalid to: %w", err)
+	}
+	q.GroupBy = feeaccounting.GroupBy(strings.ToLower(c.DefaultQuery("groupBy", string(feeaccounting.GroupByChain))))
+	if s := c.Query("chainID"); s != "" {
+		chainID, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return q, fmt.Errorf("invalid chain ID: %s", s)
+		}
+		q.ChainID = chainID
+	}
+	return q, q.Validate()
+}
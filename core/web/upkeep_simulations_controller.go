@@ -0,0 +1,62 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// UpkeepSimulationsController simulates the checks of upkeeps.
+type UpkeepSimulationsController struct {
+	App chainlink.Application
+}
+
+// SimulateUpkeepRequest is the body of an upkeep simulation request. Byte values are 0x-prefixed hex.
+type SimulateUpkeepRequest struct {
+	RegistryAddress string `json:"registryAddress"`
+	// TriggerData is passed to checkUpkeep of v2.1 registries, e.g. the encoded log of a log trigger upkeep.
+	TriggerData *string `json:"triggerData"`
+	// CallbackValues, if set, are passed to checkCallback of v2.1 registries instead of calling checkUpkeep.
+	CallbackValues    []string `json:"callbackValues"`
+	CallbackExtraData *string  `json:"callbackExtraData"`
+}
+
+// Simulate runs checkUpkeep, or checkCallback, for the upkeep at the latest block of the chain given by the
+// evmChainID query parameter, and returns the decoded result, perform data and gas estimates.
+// Example:
+// "POST <application>/upkeeps/:upkeepID/simulate?evmChainID=1"
+func (usc *UpkeepSimulationsController) Simulate(c *gin.Context) {
+	var body SimulateUpkeepRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	req, err := keeper.ParseUpkeepSimulationRequest(body.RegistryAddress, c.Param("upkeepID"), body.TriggerData, body.CallbackValues, body.CallbackExtraData)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, err := getChain(usc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	sim, err := keeper.SimulateUpkeep(c.Request.Context(), chain.Client(), req)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepSimulationResource(chain.ID().String(), req, *sim), "upkeep_simulation")
+}
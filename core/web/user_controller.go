@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -53,6 +55,7 @@ func (c *UserController) Create(ctx *gin.Context) {
 		Email    string `json:"email"`
 		Password string `json:"password"`
 		Role     string `json:"role"`
+		Tenant   string `json:"tenant"`
 	}
 
 	var request newUserRequest
@@ -82,6 +85,17 @@ func (c *UserController) Create(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusBadRequest, errors.Errorf("error creating API user: %s", err))
 		return
 	}
+	if request.Tenant != "" {
+		if _, err = c.App.TenancyORM().FindTenant(request.Tenant); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				jsonAPIError(ctx, http.StatusBadRequest, errors.Errorf("tenant %s not found", request.Tenant))
+				return
+			}
+			jsonAPIError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		user.Tenant = null.StringFrom(request.Tenant)
+	}
 	if err = c.App.AuthenticationProvider().CreateUser(&user); err != nil {
 		// If this is a duplicate key error (code 23505), return a nicer error message
 		var pgErr *pgconn.PgError
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// VRFRequestsController inspects and fulfills pending VRF requests.
+type VRFRequestsController struct {
+	App chainlink.Application
+}
+
+// Index lists the pending requests of all VRF V2 and V2 Plus jobs, or only of the job
+// given by the jobID query parameter.
+// Example:
+// "GET <application>/vrf/requests?jobID=1"
+func (vrc *VRFRequestsController) Index(c *gin.Context) {
+	jobIDs, managers, err := vrc.requestManagers(c.Query("jobID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	resources := []presenters.VRFRequestResource{}
+	for _, jobID := range jobIDs {
+		statuses, err := managers[jobID].PendingRequests(c.Request.Context())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to get pending requests of job %d: %w", jobID, err))
+			return
+		}
+		for _, s := range statuses {
+			resources = append(resources, presenters.NewVRFRequestResource(jobID, s))
+		}
+	}
+
+	jsonAPIResponse(c, resources, "vrf_request")
+}
+
+// Fulfill enqueues the fulfillment of a pending VRF request, regardless of the balance of
+// its subscription.
+// Example:
+// "POST <application>/vrf/requests/:requestID/fulfill?jobID=1"
+func (vrc *VRFRequestsController) Fulfill(c *gin.Context) {
+	requestID, ok := new(big.Int).SetString(c.Param("requestID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid request ID: %s", c.Param("requestID")))
+		return
+	}
+	jobIDs, managers, err := vrc.requestManagers(c.Query("jobID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	for _, jobID := range jobIDs {
+		tx, err := managers[jobID].FulfillRequest(c.Request.Context(), requestID)
+		if errors.Is(err, vrfv2.ErrRequestNotPending) {
+			continue
+		} else if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		vrc.App.GetAuditLogger().Audit(audit.VRFRequestFulfilled, map[string]interface{}{
+			"jobID":     jobID,
+			"requestID": requestID.String(),
+			"ethTxID":   tx.ID,
+		})
+		jsonAPIResponse(c, presenters.NewVRFRequestFulfillmentResource(requestID.String(), jobID, tx), "vrf_request_fulfillment")
+		return
+	}
+	jsonAPIError(c, http.StatusNotFound, fmt.Errorf("request %s is not pending for any VRF job", requestID))
+}
+
+// requestManagers returns the request manager of the given job, or of all jobs if jobID is empty,
+// along with the sorted job IDs.
+func (vrc *VRFRequestsController) requestManagers(jobID string) ([]int32, map[int32]vrfv2.RequestManager, error) {
+	managers := vrc.App.VRFRequestManagers()
+	if jobID != "" {
+		id, err := strconv.ParseInt(jobID, 10, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid job ID: %w", err)
+		}
+		m, ok := managers[int32(id)]
+		if !ok {
+			return nil, nil, fmt.Errorf("job %d is not a running VRF V2 or V2 Plus job", id)
+		}
+		managers = map[int32]vrfv2.RequestManager{int32(id): m}
+	}
+	jobIDs := make([]int32, 0, len(managers))
+	for id := range managers {
+		jobIDs = append(jobIDs, id)
+	}
+	slices.Sort(jobIDs)
+	return jobIDs, managers, nil
+}